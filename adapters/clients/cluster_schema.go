@@ -150,6 +150,43 @@ func (c *ClusterSchema) CommitTransaction(ctx context.Context, host string,
 	return nil
 }
 
+// ProposeTransaction forwards an encoded raft entry to the leader
+func (c *ClusterSchema) ProposeTransaction(ctx context.Context, host string,
+	entry []byte,
+) error {
+	path := "/schema/consensus/propose"
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(entry))
+	if err != nil {
+		return fmt.Errorf("open http request: %w", err)
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send http request: %w", err)
+	}
+
+	defer res.Body.Close()
+	errBody, _ := io.ReadAll(res.Body)
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusConflict:
+		return cluster.ErrConcurrentTransaction
+	case http.StatusMisdirectedRequest:
+		return cluster.ErrNotLeader
+	case http.StatusServiceUnavailable:
+		return cluster.ErrConsensusNotReady
+	default:
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, errBody)
+	}
+}
+
 type txPayload struct {
 	Type          cluster.TransactionType `json:"type"`
 	ID            string                  `json:"id"`
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+)
+
+// StandbyCluster ships changes to the standby of a cross-cluster
+// replication setup
+type StandbyCluster struct {
+	client *http.Client
+	url    string
+	apiKey string
+}
+
+func NewStandbyCluster(httpClient *http.Client, standbyURL, apiKey string) *StandbyCluster {
+	return &StandbyCluster{client: httpClient, url: standbyURL, apiKey: apiKey}
+}
+
+func (c *StandbyCluster) Apply(ctx context.Context, ops []crossrepl.Op) error {
+	payload, err := json.Marshal(ops)
+	if err != nil {
+		return fmt.Errorf("marshal ops: %w", err)
+	}
+
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+	u.Path = "/v1/replication/cross-cluster/apply"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(),
+		bytes.NewReader(payload))
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		err := enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+		if res.StatusCode == http.StatusBadRequest || res.StatusCode == http.StatusUnprocessableEntity {
+			return fmt.Errorf("%w: %w", crossrepl.ErrRejected, err)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/go-openapi/strfmt"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+// FederationPeers talks to the public REST API of remote Weaviate clusters
+// which are registered as federation peers
+type FederationPeers struct {
+	client *http.Client
+}
+
+func NewFederationPeers(httpClient *http.Client) *FederationPeers {
+	return &FederationPeers{client: httpClient}
+}
+
+func (c *FederationPeers) ClassExists(ctx context.Context, peer federation.Peer,
+	class string,
+) (bool, error) {
+	res, body, err := c.get(ctx, peer, "/v1/schema/"+url.PathEscape(class), nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+}
+
+// GetObject returns nil if the object does not exist on the peer
+func (c *FederationPeers) GetObject(ctx context.Context, peer federation.Peer,
+	class string, id strfmt.UUID, tenant string,
+) (*models.Object, error) {
+	p := "/v1/objects/" + id.String()
+	if class != "" {
+		p = "/v1/objects/" + url.PathEscape(class) + "/" + id.String()
+	}
+
+	var query url.Values
+	if tenant != "" {
+		query = url.Values{"tenant": []string{tenant}}
+	}
+
+	res, body, err := c.get(ctx, peer, p, query)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var obj models.Object
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return &obj, nil
+}
+
+func (c *FederationPeers) get(ctx context.Context, peer federation.Peer,
+	path string, query url.Values,
+) (*http.Response, []byte, error) {
+	base, err := url.Parse(peer.URL)
+	if err != nil {
+		return nil, nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+	base.Path = path
+	base.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base.String(), nil)
+	if err != nil {
+		return nil, nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+	if peer.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+peer.APIKey)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, enterrors.NewErrSendHttpRequest(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return res, body, nil
+}
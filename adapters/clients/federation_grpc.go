@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/federation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// FederationGRPC resolves references to objects on federation peers in
+// batches through the gRPC API of the peers. One connection is kept per
+// peer address and reused by all queries.
+type FederationGRPC struct {
+	sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewFederationGRPC() *FederationGRPC {
+	return &FederationGRPC{conns: map[string]*grpc.ClientConn{}}
+}
+
+// ResolveReferences returns the objects of the given tenant aligned with refs,
+// nil for objects which do not exist on the peer
+func (c *FederationGRPC) ResolveReferences(ctx context.Context,
+	peer federation.Peer, refs []*crossref.Ref, tenant string,
+) ([]*models.Object, error) {
+	conn, err := c.conn(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.ResolveReferencesRequest{
+		Targets: make([]*pb.ReferenceTarget, len(refs)),
+		Tenant:  tenant,
+	}
+	for i, ref := range refs {
+		req.Targets[i] = &pb.ReferenceTarget{
+			ClassName: ref.Class,
+			Id:        ref.TargetID.String(),
+		}
+	}
+
+	if peer.APIKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+peer.APIKey)
+	}
+
+	reply, err := pb.NewFederationClient(conn).ResolveReferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.References) != len(refs) {
+		return nil, fmt.Errorf("peer %q returned %d references for %d targets",
+			peer.Name, len(reply.References), len(refs))
+	}
+
+	out := make([]*models.Object, len(refs))
+	for i, ref := range reply.References {
+		if ref == nil || !ref.Found {
+			continue
+		}
+
+		obj := &models.Object{
+			Class:              ref.ClassName,
+			ID:                 strfmt.UUID(ref.Id),
+			Vector:             ref.Vector,
+			CreationTimeUnix:   ref.CreationTimeUnix,
+			LastUpdateTimeUnix: ref.LastUpdateTimeUnix,
+		}
+		if ref.Properties != nil {
+			obj.Properties = ref.Properties.AsMap()
+		}
+		out[i] = obj
+	}
+
+	return out, nil
+}
+
+// Close closes all open peer connections
+func (c *FederationGRPC) Close() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var firstErr error
+	for addr, conn := range c.conns {
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(c.conns, addr)
+	}
+	return firstErr
+}
+
+func (c *FederationGRPC) conn(peer federation.Peer) (*grpc.ClientConn, error) {
+	key := peer.GRPCAddress
+	creds := insecure.NewCredentials()
+	if peer.UseTLS() {
+		key = "tls://" + key
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if conn, ok := c.conns[key]; ok {
+		return conn, nil
+	}
+
+	conn, err := grpc.Dial(peer.GRPCAddress, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("dial peer %q: %w", peer.Name, err)
+	}
+	c.conns[key] = conn
+	return conn, nil
+}
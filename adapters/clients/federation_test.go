@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+func TestFederationPeers(t *testing.T) {
+	id := strfmt.UUID("8f6fbb2f-0000-4000-8000-000000000001")
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/v1/schema/Article":
+			json.NewEncoder(w).Encode(models.Class{Class: "Article"})
+		case "/v1/objects/Article/" + id.String():
+			json.NewEncoder(w).Encode(models.Object{
+				Class: "Article", ID: id, Tenant: r.URL.Query().Get("tenant"),
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	c := NewFederationPeers(&http.Client{})
+	peer := federation.Peer{Name: "remote", URL: server.URL, APIKey: "secret"}
+	ctx := context.Background()
+
+	t.Run("existing class", func(t *testing.T) {
+		ok, err := c.ClassExists(ctx, peer, "Article")
+		require.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("missing class", func(t *testing.T) {
+		ok, err := c.ClassExists(ctx, peer, "Missing")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("existing object", func(t *testing.T) {
+		obj, err := c.GetObject(ctx, peer, "Article", id, "")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, id, obj.ID)
+	})
+
+	t.Run("existing object of a tenant", func(t *testing.T) {
+		obj, err := c.GetObject(ctx, peer, "Article", id, "tenant1")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, "tenant1", obj.Tenant)
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		obj, err := c.GetObject(ctx, peer, "Missing", id, "")
+		require.Nil(t, err)
+		assert.Nil(t, obj)
+	})
+}
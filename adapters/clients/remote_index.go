@@ -27,11 +27,13 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type RemoteIndex retryClient
@@ -57,6 +59,9 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return errors.Wrap(err, "open http request")
 	}
+	if err := clusterapi.SetPreconditionsHeader(ctx, req); err != nil {
+		return err
+	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
 	res, err := c.client.Do(req)
@@ -67,13 +72,21 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeStatusError(res.StatusCode, body)
 	}
 
 	return nil
 }
 
+// writeStatusError is the error for a write which was answered with an
+// unexpected status code. A failed precondition is reported as such.
+func writeStatusError(code int, body []byte) error {
+	if code == http.StatusConflict {
+		return objects.NewErrConflict("%s", bytes.TrimSpace(body))
+	}
+	return errors.Errorf("unexpected status code %d (%s)", code, body)
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -99,6 +112,9 @@ func (c *RemoteIndex) BatchPutObjects(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return duplicateErr(errors.Wrap(err, "open http request"), len(objs))
 	}
+	if err := clusterapi.SetPreconditionsHeader(ctx, req); err != nil {
+		return duplicateErr(err, len(objs))
+	}
 
 	clusterapi.IndicesPayloads.ObjectList.SetContentTypeHeaderReq(req)
 
@@ -287,6 +303,9 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return errors.Wrap(err, "open http request")
 	}
+	if err := clusterapi.SetPreconditionsHeader(ctx, req); err != nil {
+		return err
+	}
 
 	res, err := c.client.Do(req)
 	if err != nil {
@@ -302,8 +321,7 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeStatusError(res.StatusCode, body)
 	}
 
 	return nil
@@ -327,6 +345,9 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return errors.Wrap(err, "open http request")
 	}
+	if err := clusterapi.SetPreconditionsHeader(ctx, req); err != nil {
+		return err
+	}
 
 	clusterapi.IndicesPayloads.MergeDoc.SetContentTypeHeaderReq(req)
 	res, err := c.client.Do(req)
@@ -337,8 +358,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeStatusError(res.StatusCode, body)
 	}
 
 	return nil
@@ -608,6 +628,62 @@ func (c *RemoteIndex) DeleteObjectBatch(ctx context.Context, hostName, indexName
 	return batchDeleteResults
 }
 
+func (c *RemoteIndex) UpdateObjectBatch(ctx context.Context, hostName, indexName, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects", indexName, shardName)
+	method := http.MethodPatch
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	marshalled, err := clusterapi.IndicesPayloads.BatchUpdateParams.Marshal(docIDs, update, dryRun)
+	if err != nil {
+		err := errors.Wrap(err, "marshal payload")
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(marshalled))
+	if err != nil {
+		err := errors.Wrap(err, "open http request")
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	clusterapi.IndicesPayloads.BatchUpdateParams.SetContentTypeHeaderReq(req)
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		err := errors.Wrap(err, "send http request")
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		err := errors.Errorf("unexpected status code %d (%s)", res.StatusCode, body)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	if ct, ok := clusterapi.IndicesPayloads.BatchDeleteResults.
+		CheckContentTypeHeader(res); !ok {
+		err := errors.Errorf("unexpected content type: %s", ct)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		err := errors.Wrap(err, "ready body")
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	batchDeleteResults, err := clusterapi.IndicesPayloads.BatchDeleteResults.Unmarshal(resBytes)
+	if err != nil {
+		err := errors.Wrap(err, "unmarshal body")
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	return batchDeleteResults
+}
+
 func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
@@ -651,6 +727,48 @@ func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	return status, c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) GetShardUsage(ctx context.Context,
+	hostName, indexName, shardName string,
+) (map[string]models.TenantUsage, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/usage", indexName, shardName)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var usage map[string]models.TenantUsage
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.GetShardUsageResults.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		usage, err = clusterapi.IndicesPayloads.GetShardUsageResults.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return usage, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string,
 ) error {
@@ -782,6 +900,39 @@ func (c *RemoteIndex) ReInitShard(ctx context.Context,
 	return c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) SplitShards(ctx context.Context,
+	hostName, indexName string, split sharding.Split,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/shards:split", indexName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.SplitShards.Marshall(split)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	hostName, indexName string, dist scaler.ShardDist,
 ) error {
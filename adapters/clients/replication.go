@@ -76,6 +76,42 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, hreq replica.HashTreeRequest,
+) ([]replica.Digest, error) {
+	var resp []replica.Digest
+	body, err := json.Marshal(hreq)
+	if err != nil {
+		return nil, fmt.Errorf("marshal hash tree request: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_hashtree", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*90, req, body, &resp)
+	return resp, err
+}
+
+func (c *replicationClient) DigestObjectsInLeaves(ctx context.Context,
+	host, index, shard string, hreq replica.HashTreeRequest,
+) ([]replica.RepairResponse, error) {
+	var resp []replica.RepairResponse
+	body, err := json.Marshal(hreq)
+	if err != nil {
+		return nil, fmt.Errorf("marshal hash tree request: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_digest-leaves", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*90, req, body, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -258,7 +294,14 @@ func newHttpReplicaRequest(ctx context.Context, method, host, index, shard, requ
 		u.RawQuery = url.Values{replica.RequestKey: []string{requestId}}.Encode()
 	}
 
-	return http.NewRequestWithContext(ctx, method, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if err := clusterapi.SetPreconditionsHeader(ctx, req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func newHttpReplicaCMD(host, cmd, index, shard, requestId string, body io.Reader) (*http.Request, error) {
@@ -528,6 +528,47 @@ func TestReplicationDigestObjects(t *testing.T) {
 	assert.Equal(t, expected[1].Version, resp[1].Version)
 }
 
+func TestReplicationHashTree(t *testing.T) {
+	t.Parallel()
+
+	hreq := replica.HashTreeRequest{Height: 4, Level: 1, Nodes: []int{0, 1}}
+	digests := []replica.Digest{{1, 2}, {3, 4}}
+	leaves := []replica.RepairResponse{{ID: UUID1.String(), UpdateTime: 7}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var got replica.HashTreeRequest
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !assert.Equal(t, hreq, got) {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		var b []byte
+		switch r.URL.Path {
+		case "/replicas/indices/C1/shards/S1/objects/_hashtree":
+			b, _ = json.Marshal(digests)
+		case "/replicas/indices/C1/shards/S1/objects/_digest-leaves":
+			b, _ = json.Marshal(leaves)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Write(b)
+	}))
+	defer server.Close()
+
+	c := newReplicationClient(server.Client())
+	resp, err := c.HashTreeLevel(context.Background(), server.URL[7:], "C1", "S1", hreq)
+	require.Nil(t, err)
+	assert.Equal(t, digests, resp)
+
+	objs, err := c.DigestObjectsInLeaves(context.Background(), server.URL[7:], "C1", "S1", hreq)
+	require.Nil(t, err)
+	assert.Equal(t, leaves, objs)
+}
+
 func TestReplicationOverwriteObjects(t *testing.T) {
 	t.Parallel()
 
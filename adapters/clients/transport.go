@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// maxDrainBytes is how much of an unread response body is discarded on close
+// to return the connection to the pool. Larger bodies close the connection.
+const maxDrainBytes = 256 << 10
+
+// NewClusterTransport returns the transport for requests to other nodes. It
+// keeps a pool of connections per peer, compresses replication payloads and
+// shard transfers if configured and records per-peer metrics. clusterTLS and
+// metrics may be nil.
+func NewClusterTransport(config cluster.TransportConfig, clusterTLS *cluster.TLS,
+	metrics *monitoring.PrometheusMetrics,
+) http.RoundTripper {
+	config = config.WithDefaults()
+	base := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 120 * time.Second,
+		}).DialContext,
+		// no global limit, a large cluster would otherwise evict idle
+		// connections of one peer for another
+		MaxIdleConns:          0,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	var next http.RoundTripper = base
+	if clusterTLS.Enabled() {
+		next = clusterTLS.Transport(base)
+	}
+
+	t := &clusterTransport{next: next, config: config}
+	if metrics != nil {
+		t.requests = metrics.ClusterTransportRequests
+		t.bytes = metrics.ClusterTransportBytes
+	}
+	return t
+}
+
+type clusterTransport struct {
+	next     http.RoundTripper
+	config   cluster.TransportConfig
+	requests *prometheus.CounterVec
+	bytes    *prometheus.CounterVec
+}
+
+func (t *clusterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	peer := req.URL.Host
+	compress := t.config.Compression && clusterapi.CompressiblePath(req.URL.Path)
+
+	req = req.Clone(req.Context())
+	if compress {
+		req.Header.Set("Accept-Encoding", clusterapi.ContentEncodingZstd)
+		if req.Body != nil && req.Body != http.NoBody &&
+			(req.ContentLength < 0 || req.ContentLength >= int64(t.config.CompressionMinSize)) {
+			req.Body = compressBody(req.Body)
+			req.ContentLength = -1
+			req.Header.Set("Content-Encoding", clusterapi.ContentEncodingZstd)
+			req.Header.Del("Content-Length")
+			// a compressed stream can not be replayed, callers retry with a
+			// new request instead
+			req.GetBody = nil
+		}
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = &countingBody{ReadCloser: req.Body, count: t.counter(peer, "sent")}
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.observe(peer, "error")
+		return nil, err
+	}
+	if res.StatusCode >= http.StatusInternalServerError {
+		t.observe(peer, "error")
+	} else {
+		t.observe(peer, "success")
+	}
+
+	var body io.ReadCloser = &drainingBody{&countingBody{
+		ReadCloser: res.Body, count: t.counter(peer, "received"),
+	}}
+	if res.Header.Get("Content-Encoding") == clusterapi.ContentEncodingZstd {
+		if body, err = clusterapi.NewZstdReader(body); err != nil {
+			res.Body.Close()
+			t.observe(peer, "error")
+			return nil, err
+		}
+		res.Header.Del("Content-Encoding")
+		res.Header.Del("Content-Length")
+		res.ContentLength = -1
+		res.Uncompressed = true
+	}
+	res.Body = body
+	return res, nil
+}
+
+func (t *clusterTransport) observe(peer, result string) {
+	if t.requests != nil {
+		t.requests.WithLabelValues(peer, result).Inc()
+	}
+}
+
+func (t *clusterTransport) counter(peer, direction string) prometheus.Counter {
+	if t.bytes == nil {
+		return nil
+	}
+	return t.bytes.WithLabelValues(peer, direction)
+}
+
+// compressBody streams body through a zstd encoder, so large shard files are
+// never held in memory
+func compressBody(body io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer body.Close()
+		enc := clusterapi.NewZstdWriter(pw)
+		_, err := io.Copy(enc, body)
+		if closeErr := enc.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+type countingBody struct {
+	io.ReadCloser
+	count prometheus.Counter
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.count != nil && n > 0 {
+		b.count.Add(float64(n))
+	}
+	return n, err
+}
+
+// drainingBody reads the rest of a response before closing it. Callers often
+// only check the status code, without draining the connection could not be
+// reused.
+type drainingBody struct {
+	io.ReadCloser
+}
+
+func (b *drainingBody) Close() error {
+	io.CopyN(io.Discard, b.ReadCloser, maxDrainBytes)
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+func TestClusterTransport(t *testing.T) {
+	payload := strings.Repeat("replicated object ", 1000)
+
+	type received struct {
+		encoding string
+		body     string
+	}
+	var got received
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.encoding = r.Header.Get("Content-Encoding")
+		var body io.ReadCloser = r.Body
+		if got.encoding == clusterapi.ContentEncodingZstd {
+			var err error
+			body, err = clusterapi.NewZstdReader(r.Body)
+			require.Nil(t, err)
+		}
+		b, err := io.ReadAll(body)
+		require.Nil(t, err)
+		got.body = string(b)
+
+		if r.Header.Get("Accept-Encoding") != clusterapi.ContentEncodingZstd {
+			w.Write(b)
+			return
+		}
+		w.Header().Set("Content-Encoding", clusterapi.ContentEncodingZstd)
+		enc := clusterapi.NewZstdWriter(w)
+		enc.Write(b)
+		enc.Close()
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	metrics := &monitoring.PrometheusMetrics{
+		ClusterTransportRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "requests",
+		}, []string{"peer", "result"}),
+		ClusterTransportBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "bytes",
+		}, []string{"peer", "direction"}),
+	}
+
+	post := func(t *testing.T, transport http.RoundTripper, path, body string) string {
+		client := &http.Client{Transport: transport}
+		res, err := client.Post("http://"+host+path, "text/plain",
+			bytes.NewReader([]byte(body)))
+		require.Nil(t, err)
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		return string(b)
+	}
+
+	t.Run("replication payloads are compressed", func(t *testing.T) {
+		transport := NewClusterTransport(cluster.TransportConfig{Compression: true}, nil, metrics)
+
+		res := post(t, transport, "/replicas/indices/C/shards/S/objects", payload)
+		assert.Equal(t, payload, res)
+		assert.Equal(t, received{clusterapi.ContentEncodingZstd, payload}, got)
+
+		sent := testutil.ToFloat64(metrics.ClusterTransportBytes.WithLabelValues(host, "sent"))
+		assert.Greater(t, sent, float64(0))
+		assert.Less(t, sent, float64(len(payload)/10))
+		assert.Equal(t, float64(1),
+			testutil.ToFloat64(metrics.ClusterTransportRequests.WithLabelValues(host, "success")))
+	})
+
+	t.Run("small bodies are not compressed", func(t *testing.T) {
+		transport := NewClusterTransport(cluster.TransportConfig{Compression: true}, nil, nil)
+
+		res := post(t, transport, "/replicas/indices/C/shards/S/objects", "small")
+		assert.Equal(t, "small", res)
+		assert.Equal(t, received{"", "small"}, got)
+	})
+
+	t.Run("other requests are not compressed", func(t *testing.T) {
+		transport := NewClusterTransport(cluster.TransportConfig{Compression: true}, nil, nil)
+
+		res := post(t, transport, "/schema/transactions/", payload)
+		assert.Equal(t, payload, res)
+		assert.Equal(t, received{"", payload}, got)
+	})
+
+	t.Run("compression is disabled by default", func(t *testing.T) {
+		transport := NewClusterTransport(cluster.TransportConfig{}, nil, nil)
+
+		res := post(t, transport, "/indices/C/shards/S/files/segment.db", payload)
+		assert.Equal(t, payload, res)
+		assert.Equal(t, received{"", payload}, got)
+	})
+
+	t.Run("unreachable peers are recorded as errors", func(t *testing.T) {
+		transport := NewClusterTransport(cluster.TransportConfig{}, nil, metrics)
+		client := &http.Client{Transport: transport}
+
+		_, err := client.Get("http://127.0.0.1:1/nodes/status")
+		assert.NotNil(t, err)
+		assert.Equal(t, float64(1),
+			testutil.ToFloat64(metrics.ClusterTransportRequests.WithLabelValues("127.0.0.1:1", "error")))
+	})
+}
@@ -28,3 +28,9 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 )
+
+const (
+	VectorMoveObjectsAndVectors = "Move your search vector closer to or further away from the mean of the vectors of existing objects and provided vectors"
+	MovementObjects             = "Objects whose vectors the search vector is moved closer to or further away from"
+	MovementVectors             = "Vectors the search vector is moved closer to or further away from"
+)
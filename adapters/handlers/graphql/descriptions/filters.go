@@ -66,8 +66,20 @@ const (
 	WhereValueRangeGeoCoordinatesLongitude = "The longitude (in decimal format) of the geoCoordinates to search around."
 	WhereValueRangeDistance                = "The distance from the point specified via geoCoordinates."
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
+	WhereValueGeoBoundingBox               = "Specify the top left and bottom right corner of a box. The search will return any result which is located within the box. A box whose top left corner lies east of its bottom right corner crosses the antimeridian."
+	WhereValueGeoBoundingBoxTopLeft        = "The geoCoordinates of the north-west corner of the box."
+	WhereValueGeoBoundingBoxBottomRight    = "The geoCoordinates of the south-east corner of the box."
+	WhereValueGeoPolygon                   = "Specify the vertices of a polygon. The search will return any result which is located within the polygon."
+	WhereValueGeoPolygonVertices           = "The geoCoordinates of the vertices of the polygon, at least three. The last vertex is connected to the first one."
+	WhereValueGeoCoordinatesLatitude       = "The latitude (in decimal format) of the geoCoordinates."
+	WhereValueGeoCoordinatesLongitude      = "The longitude (in decimal format) of the geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueIntArray                     = "Specify Integer values for the ContainsAny and ContainsAll operators"
+	WhereValueNumberArray                  = "Specify Float values for the ContainsAny and ContainsAll operators"
+	WhereValueBooleanArray                 = "Specify Boolean values for the ContainsAny and ContainsAll operators"
+	WhereValueTextArray                    = "Specify Text values for the ContainsAny and ContainsAll operators"
+	WhereValueDateArray                    = "Specify Date values for the ContainsAny and ContainsAll operators"
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
@@ -138,7 +150,7 @@ const (
 
 // Cursor API
 const (
-	AfterID = "Show the results after a given ID"
+	AfterID = "Show the results after a given ID, or after the cursor of a filtered or sorted query"
 )
 
 const (
@@ -151,4 +163,35 @@ const (
 	GroupByPath            = "Specify the path from the objects fields to the property name (e.g. ['Things', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
+	GroupByStrategy        = "Specify how results are grouped, either by the value of the property in path (property) which is default or by clustering the top results by vector similarity (vector)"
+	GroupByCandidates      = "Specify the number of top results clustered by the vector strategy, defaults to groups times objectsPerGroup"
+)
+
+const (
+	RerankArgument = "Rerank the top results of the query, either by the relevance of a text property to a query or by a numeric property"
+	RerankProperty = "Specify the property to rerank by"
+	RerankQuery    = "Specify the query the text of the property is scored against by the reranker module. Without a query results are ordered by the numeric value of the property"
+	RerankLimit    = "Specify the number of top results to rerank"
+)
+
+const (
+	DiversifyArgument            = "Diversify the results of a vector or hybrid search by Maximal Marginal Relevance, so that nearly identical results don't crowd out the others"
+	DiversifyLambda              = "Specify how relevance is weighed against diversity, between 0 and 1. 1 only considers relevance, defaults to 0.5"
+	DiversifyCandidateMultiplier = "Specify the factor by which the limit is raised to select the diversified results from, defaults to 3"
+)
+
+const (
+	TimeDecayArgument = "Boost recent results of a hybrid or bm25 search by combining their score with a decay function over a date property"
+	TimeDecayProperty = "Specify the date property the boost is computed from"
+	TimeDecayOrigin   = "Specify the RFC3339 date with the highest boost, defaults to now"
+	TimeDecayScale    = "Specify the distance from origin plus offset at which the boost has dropped to decay, e.g. '12h' or '7d'"
+	TimeDecayOffset   = "Specify the distance from origin within which dates are boosted fully"
+	TimeDecayDecay    = "Specify the boost at scale, between 0 and 1, defaults to 0.5"
+	TimeDecayFunction = "Specify the shape of the decay, either exp which is default, gauss or linear"
+	TimeDecayWeight   = "Specify how the boost is weighed against the score, between 0 and 1. 1 only considers the boost, defaults to 0.5"
+)
+
+const (
+	CustomScoreArgument   = "Reorder the results by the value of an expression over their similarity, distance or score and numeric properties"
+	CustomScoreExpression = "Specify the expression, e.g. '0.7*similarity + 0.3*log(popularity)'. Supported are numbers, + - * / ^, parentheses and the functions log, log10, log1p, exp, sqrt, abs, pow, min and max"
 )
@@ -26,6 +26,16 @@ const GetObjectsActionsObj = "An object used to get %ss on a local Weaviate"
 
 const GetClassUUID = "The UUID of a Object, assigned by its local Weaviate"
 
+const AdditionalCursor = "The position of the Object, to be passed as the after parameter to get the next page of results"
+
+const AdditionalDidYouMean = "Spelling corrections of the query of a bm25 or hybrid search, built from the terms of the searched properties, and whether the first one was applied because the query found nothing"
+
+const BM25AutoCorrect = "Search again with the best spelling correction of the query if the query finds nothing"
+
+const AdditionalHighlights = "The query terms found in the text properties of a bm25 or hybrid search result, with their offsets in runes and a snippet marking them with <em> tags"
+
+const AdditionalQueryPlan = "How the vector search which found the Object was executed, only set if the query planner is enabled"
+
 // Network
 const (
 	NetworkGet    = "Get Objects from a Weaviate in a network"
@@ -47,4 +57,14 @@ const NetworkGetClassUUID = "The UUID of a Object, assigned by the Weaviate netw
 const ConsistencyLevel = "Determines how many replicas must acknowledge a request " +
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
+const Consistency = "Determines whether vectors which are queued for async indexing must be " +
+	"indexed before searching. Can be 'EVENTUAL' or 'INDEXED'"
+
+const Timeout = "Maximum duration of the query, e.g. '500ms' or '10s'. The query " +
+	"is cancelled server-side once the timeout is exceeded"
+
+const SkipVectors = "Don't decode the vectors of the results when reading them from storage. " +
+	"Speeds up queries which only return properties or metadata, can't be combined with " +
+	"_additional { vector }, group, diversify or groupBy with the vector strategy"
+
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
@@ -32,15 +32,26 @@ type ModulesProvider interface {
 // Build the Aggregate Kinds schema
 func Build(dbSchema *schema.Schema, config config.Config,
 	modulesProvider ModulesProvider,
+) (*graphql.Field, error) {
+	return BuildWithCache(dbSchema, config, modulesProvider, NewCache())
+}
+
+// BuildWithCache builds the Aggregate Kinds schema, reusing the types of all
+// classes which are still present in the cache
+func BuildWithCache(dbSchema *schema.Schema, config config.Config,
+	modulesProvider ModulesProvider, cache *Cache,
 ) (*graphql.Field, error) {
 	if len(dbSchema.Objects.Classes) == 0 {
 		return nil, utils.ErrEmptySchema
 	}
 
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider, cache)
 		if err != nil {
 			return nil, err
 		}
@@ -57,17 +68,31 @@ func Build(dbSchema *schema.Schema, config config.Config,
 }
 
 func classFields(databaseSchema []*models.Class,
-	config config.Config, modulesProvider ModulesProvider,
+	config config.Config, modulesProvider ModulesProvider, cache *Cache,
 ) (*graphql.Object, error) {
 	fields := graphql.Fields{}
+	present := make(map[string]struct{}, len(databaseSchema))
 
 	for _, class := range databaseSchema {
+		present[class.Class] = struct{}{}
+		if field, ok := cache.classes[class.Class]; ok {
+			fields[class.Class] = field
+			continue
+		}
+
 		field, err := classField(class, class.Description, config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
 
 		fields[class.Class] = field
+		cache.classes[class.Class] = field
+	}
+
+	for name := range cache.classes {
+		if _, ok := present[name]; !ok {
+			delete(cache.classes, name)
+		}
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -125,7 +150,8 @@ func classField(class *models.Class, description string,
 				Description: descriptions.First,
 				Type:        graphql.Int,
 			},
-			"hybrid": hybridArgument(fieldsObject, class, modulesProvider),
+			"hybrid":  hybridArgument(fieldsObject, class, modulesProvider),
+			"timeout": common_filters.TimeoutArgument(),
 		},
 		Resolve: makeResolveClass(modulesProvider, class),
 	}
@@ -240,6 +266,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
 		// not aggregatable
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// not aggregatable
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
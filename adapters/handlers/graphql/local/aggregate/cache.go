@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"sync"
+
+	"github.com/tailor-inc/graphql"
+)
+
+// Cache keeps the Aggregate types of every class between rebuilds of the
+// graphql schema. Aggregate types never reference other classes, so only
+// the changed classes need to be invalidated.
+type Cache struct {
+	lock    sync.Mutex
+	classes map[string]*graphql.Field
+}
+
+func NewCache() *Cache {
+	return &Cache{classes: map[string]*graphql.Field{}}
+}
+
+// Invalidate drops the cached types of the given classes
+func (c *Cache) Invalidate(classNames ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, name := range classNames {
+		delete(c.classes, name)
+	}
+}
+
+// InvalidateAll drops the cached types of all classes
+func (c *Cache) InvalidateAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.classes = map[string]*graphql.Field{}
+}
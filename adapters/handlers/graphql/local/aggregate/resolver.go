@@ -146,6 +146,11 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		tenant = tk.(string)
 	}
 
+	timeout, err := common_filters.ExtractTimeout(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
 	params := &aggregation.Params{
 		Filters:          filters,
 		ClassName:        className,
@@ -159,6 +164,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		Timeout:          timeout,
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
@@ -13,6 +13,7 @@ package aggregate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/aggregation"
@@ -965,3 +966,33 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 func ptInt(in int) *int {
 	return &in
 }
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(config.Config{})
+
+	t.Run("valid timeout", func(t *testing.T) {
+		query := `{ Aggregate { Car(timeout: "1500ms") { meta { count } } } }`
+
+		expectedParams := &aggregation.Params{
+			ClassName:        schema.ClassName("Car"),
+			Properties:       []aggregation.ParamProperty{},
+			IncludeMetaCount: true,
+			Timeout:          1500 * time.Millisecond,
+		}
+
+		resolver.On("Aggregate", expectedParams).
+			Return([]aggregation.Group{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Aggregate { Car(timeout: "soon") { meta { count } } } }`)
+	})
+
+	t.Run("negative timeout", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Aggregate { Car(timeout: "-1s") { meta { count } } } }`)
+	})
+}
@@ -31,6 +31,11 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	autoCorrect, ok := source["autoCorrect"]
+	if ok {
+		args.AutoCorrect = autoCorrect.(bool)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import "github.com/weaviate/weaviate/entities/searchparams"
+
+// ExtractCustomScore
+func ExtractCustomScore(source map[string]interface{}) searchparams.CustomScore {
+	var args searchparams.CustomScore
+
+	if expression, ok := source["expression"]; ok {
+		args.Expression = expression.(string)
+	}
+
+	return args
+}
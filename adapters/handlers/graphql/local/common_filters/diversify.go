@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import "github.com/weaviate/weaviate/entities/searchparams"
+
+// ExtractDiversify
+func ExtractDiversify(source map[string]interface{}) searchparams.Diversify {
+	var args searchparams.Diversify
+
+	if lambda, ok := source["lambda"]; ok {
+		args.Lambda = lambda.(float64)
+	}
+
+	if multiplier, ok := source["candidateMultiplier"]; ok {
+		args.CandidateMultiplier = multiplier.(int)
+	}
+
+	return args
+}
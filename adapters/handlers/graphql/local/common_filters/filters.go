@@ -26,18 +26,24 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewEnum(graphql.EnumConfig{
 				Name: fmt.Sprintf("%sWhereOperatorEnum", path),
 				Values: graphql.EnumValueConfigMap{
-					"And":              &graphql.EnumValueConfig{},
-					"Like":             &graphql.EnumValueConfig{},
-					"Or":               &graphql.EnumValueConfig{},
-					"Equal":            &graphql.EnumValueConfig{},
-					"Not":              &graphql.EnumValueConfig{},
-					"NotEqual":         &graphql.EnumValueConfig{},
-					"GreaterThan":      &graphql.EnumValueConfig{},
-					"GreaterThanEqual": &graphql.EnumValueConfig{},
-					"LessThan":         &graphql.EnumValueConfig{},
-					"LessThanEqual":    &graphql.EnumValueConfig{},
-					"WithinGeoRange":   &graphql.EnumValueConfig{},
-					"IsNull":           &graphql.EnumValueConfig{},
+					"And":                  &graphql.EnumValueConfig{},
+					"Like":                 &graphql.EnumValueConfig{},
+					"Or":                   &graphql.EnumValueConfig{},
+					"Equal":                &graphql.EnumValueConfig{},
+					"Not":                  &graphql.EnumValueConfig{},
+					"NotEqual":             &graphql.EnumValueConfig{},
+					"GreaterThan":          &graphql.EnumValueConfig{},
+					"GreaterThanEqual":     &graphql.EnumValueConfig{},
+					"LessThan":             &graphql.EnumValueConfig{},
+					"LessThanEqual":        &graphql.EnumValueConfig{},
+					"WithinGeoRange":       &graphql.EnumValueConfig{},
+					"WithinGeoBoundingBox": &graphql.EnumValueConfig{},
+					"WithinGeoPolygon":     &graphql.EnumValueConfig{},
+					"IsNull":               &graphql.EnumValueConfig{},
+					"Prefix":               &graphql.EnumValueConfig{},
+					"Fuzzy":                &graphql.EnumValueConfig{},
+					"ContainsAny":          &graphql.EnumValueConfig{},
+					"ContainsAll":          &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -71,10 +77,38 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        graphql.String,
 			Description: descriptions.WhereValueString,
 		},
+		"valueIntArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Int),
+			Description: descriptions.WhereValueIntArray,
+		},
+		"valueNumberArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Float),
+			Description: descriptions.WhereValueNumberArray,
+		},
+		"valueBooleanArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Boolean),
+			Description: descriptions.WhereValueBooleanArray,
+		},
+		"valueTextArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueTextArray,
+		},
+		"valueDateArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueDateArray,
+		},
 		"valueGeoRange": &graphql.InputObjectFieldConfig{
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoBoundingBox": &graphql.InputObjectFieldConfig{
+			Type:        newGeoBoundingBoxInputObject(path),
+			Description: descriptions.WhereValueGeoBoundingBox,
+		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
 	}
 
 	// Recurse into the same time.
@@ -137,3 +171,49 @@ func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 		},
 	})
 }
+
+func newGeoBoundingBoxInputObject(path string) *graphql.InputObject {
+	corner := newGeoCoordinatesInputObject(path, "WhereGeoBoundingBoxGeoCoordinatesInpObj")
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoundingBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(corner),
+				Description: descriptions.WhereValueGeoBoundingBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(corner),
+				Description: descriptions.WhereValueGeoBoundingBoxBottomRight,
+			},
+		},
+	})
+}
+
+func newGeoPolygonInputObject(path string) *graphql.InputObject {
+	vertex := newGeoCoordinatesInputObject(path, "WhereGeoPolygonGeoCoordinatesInpObj")
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"vertices": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(vertex))),
+				Description: descriptions.WhereValueGeoPolygonVertices,
+			},
+		},
+	})
+}
+
+func newGeoCoordinatesInputObject(path, name string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: path + name,
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLongitude,
+			},
+		},
+	})
+}
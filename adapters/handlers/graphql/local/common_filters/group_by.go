@@ -35,5 +35,15 @@ func ExtractGroupBy(source map[string]interface{}) searchparams.GroupBy {
 		args.ObjectsPerGroup = int(objectsPerGroup.(int))
 	}
 
+	strategy := source["strategy"]
+	if strategy != nil {
+		args.Strategy = strategy.(string)
+	}
+
+	candidates := source["candidates"]
+	if candidates != nil {
+		args.Candidates = candidates.(int)
+	}
+
 	return args
 }
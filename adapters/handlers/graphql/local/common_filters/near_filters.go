@@ -45,6 +45,8 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"moveTo":       vectorMoveField(fmt.Sprintf("%sNearVectorMoveTo", prefix)),
+		"moveAwayFrom": vectorMoveField(fmt.Sprintf("%sNearVectorMoveAwayFrom", prefix)),
 	}
 }
 
@@ -78,5 +80,49 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"moveTo":       vectorMoveField(fmt.Sprintf("%sNearObjectMoveTo", prefix)),
+		"moveAwayFrom": vectorMoveField(fmt.Sprintf("%sNearObjectMoveAwayFrom", prefix)),
 	}
 }
+
+func vectorMoveField(prefix string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: descriptions.VectorMoveObjectsAndVectors,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: prefix,
+				Fields: graphql.InputObjectConfigFieldMap{
+					"objects": &graphql.InputObjectFieldConfig{
+						Description: descriptions.MovementObjects,
+						Type:        graphql.NewList(movementObjectsInpObj(prefix)),
+					},
+					"vectors": &graphql.InputObjectFieldConfig{
+						Description: descriptions.MovementVectors,
+						Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+					},
+					"force": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Force,
+						Type:        graphql.NewNonNull(graphql.Float),
+					},
+				},
+			}),
+	}
+}
+
+func movementObjectsInpObj(prefix string) *graphql.InputObject {
+	return graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sMovementObjectsInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"id": &graphql.InputObjectFieldConfig{
+					Description: descriptions.ID,
+					Type:        graphql.String,
+				},
+				"beacon": &graphql.InputObjectFieldConfig{
+					Description: descriptions.Beacon,
+					Type:        graphql.String,
+				},
+			},
+		},
+	)
+}
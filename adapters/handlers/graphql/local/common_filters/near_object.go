@@ -47,5 +47,13 @@ func ExtractNearObject(source map[string]interface{}) (searchparams.NearObject,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if moveTo, ok := source["moveTo"]; ok {
+		args.MoveTo = extractVectorMove(moveTo)
+	}
+
+	if moveAwayFrom, ok := source["moveAwayFrom"]; ok {
+		args.MoveAwayFrom = extractVectorMove(moveAwayFrom)
+	}
+
 	return args, nil
 }
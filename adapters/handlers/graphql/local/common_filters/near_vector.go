@@ -44,5 +44,48 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if moveTo, ok := source["moveTo"]; ok {
+		args.MoveTo = extractVectorMove(moveTo)
+	}
+
+	if moveAwayFrom, ok := source["moveAwayFrom"]; ok {
+		args.MoveAwayFrom = extractVectorMove(moveAwayFrom)
+	}
+
 	return args, nil
 }
+
+func extractVectorMove(input interface{}) *searchparams.VectorMove {
+	// the type is fixed through gql config, force is required
+	source := input.(map[string]interface{})
+	move := &searchparams.VectorMove{Force: float32(source["force"].(float64))}
+
+	if objects, ok := source["objects"].([]interface{}); ok {
+		move.Objects = make([]searchparams.ObjectMove, len(objects))
+		for i, value := range objects {
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, ok := obj["id"].(string); ok {
+				move.Objects[i].ID = id
+			}
+			if beacon, ok := obj["beacon"].(string); ok {
+				move.Objects[i].Beacon = beacon
+			}
+		}
+	}
+
+	if vectors, ok := source["vectors"].([]interface{}); ok {
+		move.Vectors = make([][]float32, len(vectors))
+		for i, value := range vectors {
+			vector, _ := value.([]interface{})
+			move.Vectors[i] = make([]float32, len(vector))
+			for j, v := range vector {
+				move.Vectors[i][j] = float32(v.(float64))
+			}
+		}
+	}
+
+	return move
+}
@@ -135,6 +135,87 @@ func TestExtractFilterLike_ValueText(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterFuzzy_ValueText(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorFuzzy,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+		Value: &filters.Value{
+			Value: "schnitzle~2",
+			Type:  schema.DataTypeText,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: Fuzzy,
+			valueText: "schnitzle~2",
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterContainsAny_ValueTextArray(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorContainsAny,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+		Value: &filters.Value{
+			Value: []string{"schnitzel", "strudel"},
+			Type:  schema.DataTypeTextArray,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: ContainsAny,
+			valueTextArray: ["schnitzel", "strudel"],
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterContainsAll_ValueIntArray(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorContainsAll,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("intField"),
+		},
+		Value: &filters.Value{
+			Value: []int{1, 2},
+			Type:  schema.DataTypeIntArray,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["intField"],
+			operator: ContainsAll,
+			valueIntArray: [1, 2],
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestExtractFilterIsNull(t *testing.T) {
 	resolver := newMockResolver(t, mockParams{reportFilter: true})
 	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
@@ -160,6 +241,80 @@ func TestExtractFilterIsNull(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterGeoShapes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bounding box", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinGeoBoundingBox,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("location"),
+			},
+			Value: &filters.Value{
+				Value: filters.GeoBoundingBox{
+					TopLeft: &models.GeoCoordinates{
+						Latitude:  ptFloat32(0.6),
+						Longitude: ptFloat32(0.5),
+					},
+					BottomRight: &models.GeoCoordinates{
+						Latitude:  ptFloat32(0.5),
+						Longitude: ptFloat32(0.6),
+					},
+				},
+				Type: schema.DataTypeGeoCoordinates,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["location"],
+			operator: WithinGeoBoundingBox,
+			valueGeoBoundingBox: {
+				topLeft: { latitude: 0.6, longitude: 0.5 },
+				bottomRight: { latitude: 0.5, longitude: 0.6 }
+			}
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinGeoPolygon,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("location"),
+			},
+			Value: &filters.Value{
+				Value: filters.GeoPolygon{Vertices: []*models.GeoCoordinates{
+					{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.5)},
+					{Latitude: ptFloat32(0.6), Longitude: ptFloat32(0.6)},
+					{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.7)},
+				}},
+				Type: schema.DataTypeGeoCoordinates,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["location"],
+			operator: WithinGeoPolygon,
+			valueGeoPolygon: { vertices: [
+				{ latitude: 0.5, longitude: 0.5 },
+				{ latitude: 0.6, longitude: 0.6 },
+				{ latitude: 0.5, longitude: 0.7 }
+			] }
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractFilterGeoLocation(t *testing.T) {
 	t.Parallel()
 
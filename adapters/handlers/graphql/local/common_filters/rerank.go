@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import "github.com/weaviate/weaviate/entities/searchparams"
+
+// ExtractRerank
+func ExtractRerank(source map[string]interface{}) searchparams.Rerank {
+	var args searchparams.Rerank
+
+	if property, ok := source["property"]; ok {
+		args.Property = property.(string)
+	}
+
+	if query, ok := source["query"]; ok {
+		args.Query = query.(string)
+	}
+
+	if limit, ok := source["limit"]; ok {
+		args.Limit = limit.(int)
+	}
+
+	return args
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import "github.com/weaviate/weaviate/entities/searchparams"
+
+// ExtractTimeDecay
+func ExtractTimeDecay(source map[string]interface{}) searchparams.TimeDecay {
+	var args searchparams.TimeDecay
+
+	if property, ok := source["property"]; ok {
+		args.Property = property.(string)
+	}
+
+	if origin, ok := source["origin"]; ok {
+		args.Origin = origin.(string)
+	}
+
+	if scale, ok := source["scale"]; ok {
+		args.Scale = scale.(string)
+	}
+
+	if offset, ok := source["offset"]; ok {
+		args.Offset = offset.(string)
+	}
+
+	if decay, ok := source["decay"]; ok {
+		args.Decay = decay.(float64)
+	}
+
+	if function, ok := source["function"]; ok {
+		args.Function = function.(string)
+	}
+
+	if weight, ok := source["weight"]; ok {
+		args.Weight = weight.(float64)
+	}
+
+	return args
+}
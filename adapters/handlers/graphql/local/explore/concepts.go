@@ -16,6 +16,7 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 )
@@ -44,6 +45,7 @@ func Build(schema *models.Schema, modulesProvider ModulesProvider) *graphql.Fiel
 
 			"nearVector": nearVectorArgument(),
 			"nearObject": nearObjectArgument(),
+			"timeout":    common_filters.TimeoutArgument(),
 		},
 	}
 
@@ -106,6 +106,10 @@ func (r *resolver) resolveExplore(p graphql.ResolveParams) (interface{}, error)
 		params.Limit = param.(int)
 	}
 
+	if params.Timeout, err = common_filters.ExtractTimeout(p.Args); err != nil {
+		return nil, err
+	}
+
 	if r.modulesProvider != nil {
 		extractedParams := r.modulesProvider.CrossClassExtractSearchParams(p.Args)
 		if len(extractedParams) > 0 {
@@ -13,6 +13,7 @@ package explore
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -695,6 +696,32 @@ func Test_ExploreWithNoModules(t *testing.T) {
 	})
 }
 
+func Test_ExploreTimeout(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("valid timeout", func(t *testing.T) {
+		query := `{ Explore(nearVector: {vector: [0.1, 0.2]}, timeout: "1500ms") { beacon } }`
+
+		expectedParams := traverser.ExploreParams{
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.1, 0.2},
+			},
+			Timeout: 1500 * time.Millisecond,
+		}
+
+		resolver.On("Explore", expectedParams).
+			Return([]search.Result{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Explore(nearVector: {vector: [0.1, 0.2]}, timeout: "soon") { beacon } }`)
+	})
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, resolver *mockResolver) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"sync"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Cache keeps the Get types of every class between rebuilds of the graphql
+// schema, so that a schema change only regenerates the types of the classes
+// it touched. Classes which reference a rebuilt class are rebuilt as well, as
+// their reference unions point to the previous type of that class.
+//
+// The cache does not detect changes itself, a class which was changed has to
+// be invalidated before the next build.
+type Cache struct {
+	lock        sync.Mutex
+	beaconClass *graphql.Object
+	fusionEnum  *graphql.Enum
+	classes     map[string]*cachedClass
+}
+
+type cachedClass struct {
+	object *graphql.Object
+	field  *graphql.Field
+	// refs holds the data types of all properties, which includes the
+	// names of all referenced classes
+	refs []string
+}
+
+func NewCache() *Cache {
+	return &Cache{classes: map[string]*cachedClass{}}
+}
+
+// Invalidate drops the cached types of the given classes
+func (c *Cache) Invalidate(classNames ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, name := range classNames {
+		delete(c.classes, name)
+	}
+}
+
+// InvalidateAll drops the cached types of all classes
+func (c *Cache) InvalidateAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.classes = map[string]*cachedClass{}
+}
+
+// Len returns the number of classes with cached types
+func (c *Cache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.classes)
+}
+
+// evict drops classes which no longer exist, as well as all classes which
+// (transitively) reference a class that has to be rebuilt. Must be called
+// with the lock held.
+func (c *Cache) evict(classes []*models.Class) {
+	present := make(map[string]struct{}, len(classes))
+	rebuilt := map[string]struct{}{}
+	for _, class := range classes {
+		present[class.Class] = struct{}{}
+		if _, ok := c.classes[class.Class]; !ok {
+			rebuilt[class.Class] = struct{}{}
+		}
+	}
+	for name := range c.classes {
+		if _, ok := present[name]; !ok {
+			delete(c.classes, name)
+			rebuilt[name] = struct{}{}
+		}
+	}
+
+	for changed := len(rebuilt) > 0; changed; {
+		changed = false
+		for name, cached := range c.classes {
+			for _, ref := range cached.refs {
+				if _, ok := rebuilt[ref]; ok {
+					delete(c.classes, name)
+					rebuilt[name] = struct{}{}
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (c *Cache) add(class *models.Class, object *graphql.Object, field *graphql.Field) {
+	var refs []string
+	for _, prop := range class.Properties {
+		refs = append(refs, prop.DataType...)
+	}
+	c.classes[class.Class] = &cachedClass{object: object, field: field, refs: refs}
+}
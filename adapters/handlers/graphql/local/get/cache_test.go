@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestBuildWithCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	modules := newFakeModulesProvider()
+	cache := NewCache()
+
+	newSchema := func(classes ...*models.Class) *schema.Schema {
+		return &schema.Schema{Objects: &models.Schema{Classes: classes}}
+	}
+	manufacturer := &models.Class{
+		Class: "Manufacturer",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	car := &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "horsepower", DataType: []string{"int"}},
+			{Name: "madeBy", DataType: []string{"Manufacturer"}},
+		},
+	}
+	engine := &models.Class{
+		Class: "Engine",
+		Properties: []*models.Property{
+			{Name: "cylinders", DataType: []string{"int"}},
+		},
+	}
+
+	// build returns the Get type of every class and makes sure all of them
+	// form a valid graphql schema
+	build := func(t *testing.T, s *schema.Schema) map[string]graphql.Type {
+		field, err := BuildWithCache(s, logger, modules, cache)
+		require.Nil(t, err)
+
+		_, err = graphql.NewSchema(graphql.SchemaConfig{
+			Query: graphql.NewObject(graphql.ObjectConfig{
+				Name:   "WeaviateObj",
+				Fields: graphql.Fields{"Get": field},
+			}),
+		})
+		require.Nil(t, err)
+
+		types := map[string]graphql.Type{}
+		for name, def := range field.Type.(*graphql.Object).Fields() {
+			types[name] = def.Type.(*graphql.List).OfType
+		}
+		return types
+	}
+
+	initial := build(t, newSchema(manufacturer, car, engine))
+	require.Len(t, initial, 3)
+	assert.Equal(t, 3, cache.Len())
+
+	t.Run("unchanged classes are reused", func(t *testing.T) {
+		types := build(t, newSchema(manufacturer, car, engine))
+		for name, typ := range initial {
+			assert.Same(t, typ, types[name], name)
+		}
+	})
+
+	t.Run("invalidated class is rebuilt", func(t *testing.T) {
+		cache.Invalidate("Engine")
+		types := build(t, newSchema(manufacturer, car, engine))
+		assert.NotSame(t, initial["Engine"], types["Engine"])
+		assert.Same(t, initial["Car"], types["Car"])
+		assert.Same(t, initial["Manufacturer"], types["Manufacturer"])
+		initial = types
+	})
+
+	t.Run("referencing classes are rebuilt with the referenced class", func(t *testing.T) {
+		cache.Invalidate("Manufacturer")
+		types := build(t, newSchema(manufacturer, car, engine))
+		assert.NotSame(t, initial["Manufacturer"], types["Manufacturer"])
+		assert.NotSame(t, initial["Car"], types["Car"])
+		assert.Same(t, initial["Engine"], types["Engine"])
+		initial = types
+	})
+
+	t.Run("new class is added", func(t *testing.T) {
+		truck := &models.Class{
+			Class: "Truck",
+			Properties: []*models.Property{
+				{Name: "madeBy", DataType: []string{"Manufacturer"}},
+			},
+		}
+		types := build(t, newSchema(manufacturer, car, engine, truck))
+		require.Len(t, types, 4)
+		assert.Same(t, initial["Car"], types["Car"])
+		assert.Equal(t, 4, cache.Len())
+	})
+
+	t.Run("deleted class is dropped with its dependents", func(t *testing.T) {
+		types := build(t, newSchema(car, engine))
+		require.Len(t, types, 2)
+		assert.NotSame(t, initial["Car"], types["Car"])
+		assert.Same(t, initial["Engine"], types["Engine"])
+		assert.Equal(t, 2, cache.Len())
+	})
+}
@@ -30,16 +30,18 @@ type classBuilder struct {
 	beaconClass     *graphql.Object
 	logger          logrus.FieldLogger
 	modulesProvider ModulesProvider
+	cache           *Cache
 }
 
 func newClassBuilder(schema *schema.Schema, logger logrus.FieldLogger,
-	modulesProvider ModulesProvider,
+	modulesProvider ModulesProvider, cache *Cache,
 ) *classBuilder {
 	b := &classBuilder{}
 
 	b.logger = logger
 	b.schema = schema
 	b.modulesProvider = modulesProvider
+	b.cache = cache
 
 	b.initKnownClasses()
 	b.initBeaconClass()
@@ -52,6 +54,13 @@ func (b *classBuilder) initKnownClasses() {
 }
 
 func (b *classBuilder) initBeaconClass() {
+	// cached classes reference the beacon type of the build they were created
+	// in, so it needs to be shared by all builds using the same cache
+	if b.cache.beaconClass != nil {
+		b.beaconClass = b.cache.beaconClass
+		return
+	}
+
 	b.beaconClass = graphql.NewObject(graphql.ObjectConfig{
 		Name: "Beacon",
 		Fields: graphql.Fields{
@@ -60,6 +69,7 @@ func (b *classBuilder) initBeaconClass() {
 			},
 		},
 	})
+	b.cache.beaconClass = b.beaconClass
 }
 
 func (b *classBuilder) objects() (*graphql.Object, error) {
@@ -67,25 +77,36 @@ func (b *classBuilder) objects() (*graphql.Object, error) {
 }
 
 func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error) {
+	b.cache.evict(kindSchema.Classes)
+
 	// needs to be defined outside the individual class as there can only be one definition of an enum
-	fusionAlgoEnum := graphql.NewEnum(graphql.EnumConfig{
-		Name: "FusionEnum",
-		Values: graphql.EnumValueConfigMap{
-			"rankedFusion": &graphql.EnumValueConfig{
-				Value: common_filters.HybridRankedFusion,
-			},
-			"relativeScoreFusion": &graphql.EnumValueConfig{
-				Value: common_filters.HybridRelativeScoreFusion,
-			},
-		},
-	})
+	fusionAlgoEnum := b.cache.fusionEnum
+	if fusionAlgoEnum == nil {
+		fusionAlgoEnum = b.fusionEnum()
+		b.cache.fusionEnum = fusionAlgoEnum
+	}
+
+	// cached classes have to be known before any class is built, as the
+	// rebuilt classes may reference them
+	for _, class := range kindSchema.Classes {
+		if cached, ok := b.cache.classes[class.Class]; ok {
+			b.knownClasses[class.Class] = cached.object
+		}
+	}
+
 	classFields := graphql.Fields{}
 	for _, class := range kindSchema.Classes {
+		if cached, ok := b.cache.classes[class.Class]; ok {
+			classFields[class.Class] = cached.field
+			continue
+		}
+
 		classField, err := b.classField(class, fusionAlgoEnum)
 		if err != nil {
 			return nil, fmt.Errorf("Could not build class for %s", class.Class)
 		}
 		classFields[class.Class] = classField
+		b.cache.add(class, b.knownClasses[class.Class], classField)
 	}
 
 	classes := graphql.NewObject(graphql.ObjectConfig{
@@ -97,6 +118,20 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 	return classes, nil
 }
 
+func (b *classBuilder) fusionEnum() *graphql.Enum {
+	return graphql.NewEnum(graphql.EnumConfig{
+		Name: "FusionEnum",
+		Values: graphql.EnumValueConfigMap{
+			"rankedFusion": &graphql.EnumValueConfig{
+				Value: common_filters.HybridRankedFusion,
+			},
+			"relativeScoreFusion": &graphql.EnumValueConfig{
+				Value: common_filters.HybridRelativeScoreFusion,
+			},
+		},
+	})
+}
+
 func (b *classBuilder) classField(class *models.Class, fusionEnum *graphql.Enum) (*graphql.Field, error) {
 	classObject := b.classObject(class)
 	b.knownClasses[class.Class] = classObject
@@ -132,6 +167,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property,
+						class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -158,6 +196,10 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
+	additionalProperties["cursor"] = b.additionalCursorField()
+	additionalProperties["queryPlan"] = b.additionalQueryPlanField()
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
+	additionalProperties["didYouMean"] = b.additionalDidYouMeanField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
@@ -233,6 +275,58 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalCursorField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalCursor,
+		Type:        graphql.String,
+	}
+}
+
+func (b *classBuilder) additionalQueryPlanField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalQueryPlan,
+		Type:        graphql.String,
+	}
+}
+
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalHighlights,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property": &graphql.Field{Type: graphql.String},
+				"index":    &graphql.Field{Type: graphql.Int},
+				"snippet":  &graphql.Field{Type: graphql.String},
+				"matches": &graphql.Field{
+					Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+						Name: fmt.Sprintf("%sAdditionalHighlightsMatches", class.Class),
+						Fields: graphql.Fields{
+							"term":  &graphql.Field{Type: graphql.String},
+							"start": &graphql.Field{Type: graphql.Int},
+							"end":   &graphql.Field{Type: graphql.Int},
+						},
+					})),
+				},
+			},
+		})),
+	}
+}
+
+func (b *classBuilder) additionalDidYouMeanField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AdditionalDidYouMean,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalDidYouMean", class.Class),
+			Fields: graphql.Fields{
+				"original":     &graphql.Field{Type: graphql.String},
+				"alternatives": &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"applied":      &graphql.Field{Type: graphql.Boolean},
+			},
+		}),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -138,6 +138,66 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 	}
 }
 
+// nestedField builds the field of a nested property, an object whose fields
+// are the nested properties. The fields of object[] properties are lists.
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	obj := b.nestedObject(className, property.Name, property.NestedProperties)
+
+	var fieldType graphql.Output = obj
+	if propertyType.AsNested() == schema.DataTypeObjectArray {
+		fieldType = graphql.NewList(obj)
+	}
+
+	return &graphql.Field{
+		Description: property.Description,
+		Name:        property.Name,
+		Type:        fieldType,
+	}
+}
+
+func (b *classBuilder) nestedObject(className string, typeName string,
+	nested []*models.NestedProperty,
+) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%sObj", className, schema.UppercaseClassName(typeName)),
+		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
+			fields := graphql.Fields{}
+			for _, np := range nested {
+				if dt, ok := schema.AsNested(np.DataType); ok {
+					obj := b.nestedObject(className,
+						schema.UppercaseClassName(typeName)+schema.UppercaseClassName(np.Name),
+						np.NestedProperties)
+					var fieldType graphql.Output = obj
+					if dt == schema.DataTypeObjectArray {
+						fieldType = graphql.NewList(obj)
+					}
+					fields[np.Name] = &graphql.Field{
+						Description: np.Description,
+						Name:        np.Name,
+						Type:        fieldType,
+					}
+					continue
+				}
+
+				propertyType, err := b.schema.FindPropertyDataType(np.DataType)
+				if err != nil {
+					// We can't return an error in this FieldsThunk function, so we need to panic
+					panic(fmt.Sprintf("buildGetClass: wrong propertyType for %s.%s; %s",
+						className, np.Name, err.Error()))
+				}
+				fields[np.Name] = b.primitiveField(propertyType, &models.Property{
+					Name:        np.Name,
+					DataType:    np.DataType,
+					Description: np.Description,
+				}, className)
+			}
+			return fields
+		}),
+	})
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -225,12 +285,22 @@ func buildGetClassField(classObject *graphql.Object,
 				Type:        graphql.Int,
 			},
 
-			"sort":       sortArgument(class.Class),
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
-			"where":      whereArgument(class.Class),
-			"group":      groupArgument(class.Class),
-			"groupBy":    groupByArgument(class.Class),
+			"sort":        sortArgument(class.Class),
+			"nearVector":  nearVectorArgument(class.Class),
+			"nearObject":  nearObjectArgument(class.Class),
+			"where":       whereArgument(class.Class),
+			"group":       groupArgument(class.Class),
+			"groupBy":     groupByArgument(class.Class),
+			"rerank":      rerankArgument(class.Class),
+			"diversify":   diversifyArgument(class.Class),
+			"timeDecay":   timeDecayArgument(class.Class),
+			"customScore": customScoreArgument(class.Class),
+			"consistency": consistencyArgument(class.Class),
+			"timeout":     common_filters.TimeoutArgument(),
+			"skipVectors": &graphql.ArgumentConfig{
+				Description: descriptions.SkipVectors,
+				Type:        graphql.Boolean,
+			},
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -435,11 +505,39 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	var rerankParams *searchparams.Rerank
+	if rerank, ok := p.Args["rerank"]; ok {
+		p := common_filters.ExtractRerank(rerank.(map[string]interface{}))
+		rerankParams = &p
+	}
+
+	var diversifyParams *searchparams.Diversify
+	if diversify, ok := p.Args["diversify"]; ok {
+		p := common_filters.ExtractDiversify(diversify.(map[string]interface{}))
+		diversifyParams = &p
+	}
+
+	var timeDecayParams *searchparams.TimeDecay
+	if timeDecay, ok := p.Args["timeDecay"]; ok {
+		p := common_filters.ExtractTimeDecay(timeDecay.(map[string]interface{}))
+		timeDecayParams = &p
+	}
+
+	var customScoreParams *searchparams.CustomScore
+	if customScore, ok := p.Args["customScore"]; ok {
+		p := common_filters.ExtractCustomScore(customScore.(map[string]interface{}))
+		customScoreParams = &p
+	}
+
 	var tenant string
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
 	}
 
+	if skip, ok := p.Args["skipVectors"]; ok {
+		addlProps.SkipVector = skip.(bool)
+	}
+
 	params := dto.GetParams{
 		Filters:               filters,
 		ClassName:             className,
@@ -456,7 +554,16 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		HybridSearch:          hybridParams,
 		ReplicationProperties: replProps,
 		GroupBy:               groupByParams,
+		Rerank:                rerankParams,
+		Diversify:             diversifyParams,
+		TimeDecay:             timeDecayParams,
+		CustomScore:           customScoreParams,
 		Tenant:                tenant,
+		WaitForIndexing:       extractWaitForIndexing(p.Args),
+	}
+
+	if params.Timeout, err = common_filters.ExtractTimeout(p.Args); err != nil {
+		return nil, err
 	}
 
 	// need to perform vector search by distance
@@ -464,6 +571,9 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	setLimitBasedOnVectorSearchParams(&params)
 
 	return func() (interface{}, error) {
+		if err := p.Context.Err(); err != nil {
+			return nil, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
+		}
 		result, err := resolver.GetClass(p.Context, principalFromContext(p.Context), params)
 		if err != nil {
 			return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
@@ -556,6 +666,18 @@ func isPrimitive(selectionSet *ast.SelectionSet) bool {
 	return false
 }
 
+// isNested reports whether the selection set selects the fields of a nested
+// property. Cross-refs only select inline fragments and __typename.
+func isNested(selectionSet *ast.SelectionSet) bool {
+	for _, subSelection := range selectionSet.Selections {
+		if subsectionField, ok := subSelection.(*ast.Field); ok &&
+			subsectionField.Name.Value != "__typename" {
+			return true
+		}
+	}
+	return false
+}
+
 type additionalCheck struct {
 	modulesProvider ModulesProvider
 }
@@ -565,7 +687,8 @@ func (ac *additionalCheck) isAdditional(name string) bool {
 		name == "distance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
-		name == "group" {
+		name == "group" || name == "cursor" || name == "highlights" ||
+		name == "didYouMean" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -614,7 +737,8 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet) ||
+			(name != "_additional" && isNested(field.SelectionSet))
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -666,6 +790,22 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "cursor" {
+							additionalProps.Cursor = true
+							continue
+						}
+						if additionalProperty == "queryPlan" {
+							additionalProps.QueryPlan = true
+							continue
+						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = true
+							continue
+						}
+						if additionalProperty == "didYouMean" {
+							additionalProps.DidYouMean = true
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
@@ -30,6 +30,12 @@ func (b *classBuilder) referenceField(propertyType schema.PropertyDataType,
 	dataTypeClasses := []*graphql.Object{}
 
 	for _, refClassName := range refClasses {
+		if schema.IsFederatedClassName(refClassName) {
+			// refs to classes on federation peers are not part of the local
+			// graphql schema, they can only be retrieved as beacons
+			continue
+		}
+
 		// is a local ref
 		refClass, ok := b.knownClasses[string(refClassName)]
 		if !ok {
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+const (
+	// consistencyEventual searches the vectors which are indexed already
+	consistencyEventual = "EVENTUAL"
+	// consistencyIndexed waits until all queued vectors are indexed
+	consistencyIndexed = "INDEXED"
+)
+
+func consistencyArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Consistency,
+		Type: graphql.NewEnum(graphql.EnumConfig{
+			Name: fmt.Sprintf("%sConsistencyEnum", className),
+			Values: graphql.EnumValueConfigMap{
+				consistencyEventual: &graphql.EnumValueConfig{},
+				consistencyIndexed:  &graphql.EnumValueConfig{},
+			},
+		}),
+	}
+}
+
+func extractWaitForIndexing(args map[string]interface{}) bool {
+	consistency, ok := args["consistency"]
+	return ok && consistency.(string) == consistencyIndexed
+}
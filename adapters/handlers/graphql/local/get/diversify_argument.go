@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func diversifyArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sDiversifyInpObj", prefix),
+				Fields:      diversifyFields(),
+				Description: descriptions.DiversifyArgument,
+			},
+		),
+	}
+}
+
+func diversifyFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"lambda": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DiversifyLambda,
+			Type:        graphql.Float,
+		},
+		"candidateMultiplier": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DiversifyCandidateMultiplier,
+			Type:        graphql.Int,
+		},
+	}
+}
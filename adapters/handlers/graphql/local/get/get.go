@@ -34,12 +34,23 @@ type ModulesProvider interface {
 // Build the Local.Get part of the graphql tree
 func Build(schema *schema.Schema, logger logrus.FieldLogger,
 	modulesProvider ModulesProvider,
+) (*graphql.Field, error) {
+	return BuildWithCache(schema, logger, modulesProvider, NewCache())
+}
+
+// BuildWithCache builds the Local.Get part of the graphql tree, reusing the
+// types of all classes which are still present in the cache
+func BuildWithCache(schema *schema.Schema, logger logrus.FieldLogger,
+	modulesProvider ModulesProvider, cache *Cache,
 ) (*graphql.Field, error) {
 	if len(schema.Objects.Classes) == 0 {
 		return nil, utils.ErrEmptySchema
 	}
 
-	cb := newClassBuilder(schema, logger, modulesProvider)
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cb := newClassBuilder(schema, logger, modulesProvider, cache)
 
 	var err error
 	var objects *graphql.Object
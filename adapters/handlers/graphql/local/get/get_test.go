@@ -1204,6 +1204,34 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with movements", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								moveTo: {force: 0.5, vectors: [[0.5, 0.5]]}
+								moveAwayFrom: {force: 1, objects: [{id: "c60505f9-8271-4eec-b998-81d016648d85"}]}
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+				MoveTo: &searchparams.VectorMove{
+					Force:   0.5,
+					Vectors: [][]float32{{0.5, 0.5}},
+				},
+				MoveAwayFrom: &searchparams.VectorMove{
+					Force:   1,
+					Objects: []searchparams.ObjectMove{{ID: "c60505f9-8271-4eec-b998-81d016648d85"}},
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -2283,10 +2311,330 @@ func TestGroupBy(t *testing.T) {
 
 				tt.resolver.AssertResolve(t, query)
 			})
+
+			t.Run("groupBy vector similarity", func(t *testing.T) {
+				query := `{ Get {
+					SomeAction(
+						nearVector:{vector: [0.1, 0.2]}
+						groupBy:{path: [] groups: 2 objectsPerGroup:3 strategy: "vector" candidates: 20}
+					) {
+						_additional{group{count groupedBy {value} hits {_additional{id distance}}}
+						}
+					} } }`
+
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					NearVector: &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+					GroupBy: &searchparams.GroupBy{
+						Groups: 2, ObjectsPerGroup: 3,
+						Strategy: searchparams.GroupByStrategyVector, Candidates: 20,
+					},
+					AdditionalProperties: additional.Properties{Group: true},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
+		})
+	}
+}
+
+func TestRerank(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("rerank by a query", func(t *testing.T) {
+		query := `{ Get { SomeAction(rerank:{property: "intField" query: "foo" limit: 10}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Rerank:     &searchparams.Rerank{Property: "intField", Query: "foo", Limit: 10},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("rerank by a property", func(t *testing.T) {
+		query := `{ Get { SomeAction(rerank:{property: "intField"}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Rerank:     &searchparams.Rerank{Property: "intField"},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestDiversify(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with defaults", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector:{vector: [0.1, 0.2]} diversify:{}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+			Diversify:  &searchparams.Diversify{},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with lambda and candidate multiplier", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector:{vector: [0.1, 0.2]} diversify:{lambda: 0.7 candidateMultiplier: 5}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+			Diversify:  &searchparams.Diversify{Lambda: 0.7, CandidateMultiplier: 5},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestTimeDecay(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeAction(
+		bm25:{query: "apple"}
+		timeDecay:{property: "published" scale: "7d" offset: "1d" origin: "2023-06-01T00:00:00Z" decay: 0.3 function: "gauss" weight: 0.2}
+	) { intField } } }`
+
+	expectedParams := dto.GetParams{
+		ClassName:      "SomeAction",
+		Properties:     []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{Query: "apple", Type: "bm25"},
+		TimeDecay: &searchparams.TimeDecay{
+			Property: "published", Scale: "7d", Offset: "1d", Origin: "2023-06-01T00:00:00Z",
+			Decay: 0.3, Function: "gauss", Weight: 0.2,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestCustomScore(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeAction(
+		nearVector:{vector: [0.1, 0.2]}
+		customScore:{expression: "0.7*similarity + 0.3*log(intField)"}
+	) { intField } } }`
+
+	expectedParams := dto.GetParams{
+		ClassName:   "SomeAction",
+		Properties:  []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		NearVector:  &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+		CustomScore: &searchparams.CustomScore{Expression: "0.7*similarity + 0.3*log(intField)"},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestHighlights(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:            "SomeAction",
+		KeywordRanking:       &searchparams.KeywordRanking{Query: "apple", Type: "bm25"},
+		AdditionalProperties: additional.Properties{Highlights: true},
+	}
+
+	resolverReturn := []interface{}{
+		map[string]interface{}{
+			"_additional": map[string]interface{}{
+				"highlights": []*additional.Highlight{{
+					Property: "name",
+					Snippet:  "an <em>apple</em>",
+					Matches:  []additional.HighlightMatch{{Term: "apple", Start: 3, End: 8}},
+				}},
+			},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(resolverReturn, nil).Once()
+
+	query := `{ Get { SomeAction(bm25:{query: "apple"}) {
+		_additional { highlights { property index snippet matches { term start end } } }
+	} } }`
+	result := resolver.AssertResolve(t, query)
+
+	expected := map[string]interface{}{
+		"_additional": map[string]interface{}{
+			"highlights": []interface{}{
+				map[string]interface{}{
+					"property": "name",
+					"index":    0,
+					"snippet":  "an <em>apple</em>",
+					"matches": []interface{}{
+						map[string]interface{}{"term": "apple", "start": 3, "end": 8},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
+func TestDidYouMean(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:            "SomeAction",
+		KeywordRanking:       &searchparams.KeywordRanking{Query: "appel", Type: "bm25", AutoCorrect: true},
+		AdditionalProperties: additional.Properties{DidYouMean: true},
+	}
+
+	resolverReturn := []interface{}{
+		map[string]interface{}{
+			"_additional": map[string]interface{}{
+				"didYouMean": &additional.DidYouMean{
+					Original:     "appel",
+					Alternatives: []string{"apple", "angel"},
+					Applied:      true,
+				},
+			},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(resolverReturn, nil).Once()
+
+	query := `{ Get { SomeAction(bm25:{query: "appel", autoCorrect: true}) {
+		_additional { didYouMean { original alternatives applied } }
+	} } }`
+	result := resolver.AssertResolve(t, query)
+
+	expected := map[string]interface{}{
+		"_additional": map[string]interface{}{
+			"didYouMean": map[string]interface{}{
+				"original":     "appel",
+				"alternatives": []interface{}{"apple", "angel"},
+				"applied":      true,
+			},
+		},
+	}
+	assert.Equal(t, expected, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
+func TestConsistency(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	tests := []struct {
+		consistency     string
+		waitForIndexing bool
+	}{
+		{consistency: "INDEXED", waitForIndexing: true},
+		{consistency: "EVENTUAL", waitForIndexing: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.consistency, func(t *testing.T) {
+			query := fmt.Sprintf(`{ Get { SomeAction(consistency: %s) { intField } } }`,
+				test.consistency)
+
+			expectedParams := dto.GetParams{
+				ClassName:       "SomeAction",
+				Properties:      []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+				WaitForIndexing: test.waitForIndexing,
+			}
+
+			resolver.On("GetClass", expectedParams).
+				Return([]interface{}{}, nil).Once()
+
+			resolver.AssertResolve(t, query)
 		})
 	}
 }
 
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("valid timeout", func(t *testing.T) {
+		query := `{ Get { SomeAction(timeout: "1500ms") { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Timeout:    1500 * time.Millisecond,
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Get { SomeAction(timeout: "soon") { intField } } }`)
+	})
+
+	t.Run("negative timeout", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Get { SomeAction(timeout: "-1s") { intField } } }`)
+	})
+}
+
+func TestSkipVectors(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	query := `{ Get { SomeAction(skipVectors: true) { intField _additional { id } } } }`
+
+	expectedParams := dto.GetParams{
+		ClassName:            "SomeAction",
+		Properties:           []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		AdditionalProperties: additional.Properties{ID: true, SkipVector: true},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
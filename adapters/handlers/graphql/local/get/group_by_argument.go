@@ -45,5 +45,13 @@ func groupByFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.GroupByObjectsPerGroup,
 			Type:        graphql.NewNonNull(graphql.Int),
 		},
+		"strategy": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByStrategy,
+			Type:        graphql.String,
+		},
+		"candidates": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByCandidates,
+			Type:        graphql.Int,
+		},
 	}
 }
@@ -45,7 +45,7 @@ func hybridOperands(classObject *graphql.Object,
 
 	fieldMap := graphql.InputObjectConfigFieldMap{
 		"query": &graphql.InputObjectFieldConfig{
-			Description: "Query string",
+			Description: "Query string, supports the phrase and proximity operators of bm25",
 			Type:        graphql.String,
 		},
 		"alpha": &graphql.InputObjectFieldConfig{
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func rerankArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sRerankInpObj", prefix),
+				Fields:      rerankFields(),
+				Description: descriptions.RerankArgument,
+			},
+		),
+	}
+}
+
+func rerankFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RerankProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"query": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RerankQuery,
+			Type:        graphql.String,
+		},
+		"limit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RerankLimit,
+			Type:        graphql.Int,
+		},
+	}
+}
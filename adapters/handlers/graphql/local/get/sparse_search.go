@@ -15,15 +15,23 @@ import (
 	"fmt"
 
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 )
 
 func bm25Argument(className string) *graphql.ArgumentConfig {
 	prefix := fmt.Sprintf("GetObjects%s", className)
+	fields := bm25Fields(prefix)
+	// the sub searches of a hybrid search share the other fields, but are
+	// not corrected on their own
+	fields["autoCorrect"] = &graphql.InputObjectFieldConfig{
+		Description: descriptions.BM25AutoCorrect,
+		Type:        graphql.Boolean,
+	}
 	return &graphql.ArgumentConfig{
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sHybridGetBm25InpObj", prefix),
-				Fields: bm25Fields(prefix),
+				Fields: fields,
 			},
 		),
 	}
@@ -32,8 +40,9 @@ func bm25Argument(className string) *graphql.ArgumentConfig {
 func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"query": &graphql.InputObjectFieldConfig{
-			Description: "The query to search for",
-			Type:        graphql.String,
+			Description: "The query to search for. Phrases can be written in double quotes with an optional slop " +
+				"(\"quick fox\"~2), proximity as two words joined by NEAR/n (quick NEAR/3 fox)",
+			Type: graphql.String,
 		},
 		"properties": &graphql.InputObjectFieldConfig{
 			Description: "The properties to search in",
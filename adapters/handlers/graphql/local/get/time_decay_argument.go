@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func timeDecayArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sTimeDecayInpObj", prefix),
+				Fields:      timeDecayFields(),
+				Description: descriptions.TimeDecayArgument,
+			},
+		),
+	}
+}
+
+func timeDecayFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"origin": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayOrigin,
+			Type:        graphql.String,
+		},
+		"scale": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayScale,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"offset": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayOffset,
+			Type:        graphql.String,
+		},
+		"decay": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayDecay,
+			Type:        graphql.Float,
+		},
+		"function": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayFunction,
+			Type:        graphql.String,
+		},
+		"weight": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TimeDecayWeight,
+			Type:        graphql.Float,
+		},
+	}
+}
@@ -22,16 +22,50 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 )
 
+// Cache keeps the per-class types of the local queries between rebuilds
+type Cache struct {
+	get       *get.Cache
+	aggregate *aggregate.Cache
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		get:       get.NewCache(),
+		aggregate: aggregate.NewCache(),
+	}
+}
+
+// Invalidate drops the cached types of the given classes, they are rebuilt
+// with the next build
+func (c *Cache) Invalidate(classNames ...string) {
+	c.get.Invalidate(classNames...)
+	c.aggregate.Invalidate(classNames...)
+}
+
+// InvalidateAll drops the cached types of all classes
+func (c *Cache) InvalidateAll() {
+	c.get.InvalidateAll()
+	c.aggregate.InvalidateAll()
+}
+
 // Build the local queries from the database schema.
 func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 	config config.Config, modulesProvider *modules.Provider,
 ) (graphql.Fields, error) {
-	getField, err := get.Build(dbSchema, logger, modulesProvider)
+	return BuildWithCache(dbSchema, logger, config, modulesProvider, NewCache())
+}
+
+// BuildWithCache builds the local queries from the database schema, only
+// building the types of classes which are not present in the cache.
+func BuildWithCache(dbSchema *schema.Schema, logger logrus.FieldLogger,
+	config config.Config, modulesProvider *modules.Provider, cache *Cache,
+) (graphql.Fields, error) {
+	getField, err := get.BuildWithCache(dbSchema, logger, modulesProvider, cache.get)
 	if err != nil {
 		return nil, err
 	}
 
-	aggregateField, err := aggregate.Build(dbSchema, config, modulesProvider)
+	aggregateField, err := aggregate.BuildWithCache(dbSchema, config, modulesProvider, cache.aggregate)
 	if err != nil {
 		return nil, err
 	}
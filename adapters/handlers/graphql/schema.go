@@ -24,6 +24,9 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type Traverser interface {
@@ -50,25 +53,73 @@ type graphQL struct {
 func Build(schema *schema.Schema, traverser Traverser,
 	logger logrus.FieldLogger, config config.Config, modulesProvider *modules.Provider,
 ) (GraphQL, error) {
-	logger.WithField("action", "graphql_rebuild").
+	return NewBuilder(traverser, logger, config, modulesProvider).Build(schema)
+}
+
+// Builder constructs GraphQL APIs for successive versions of the database
+// schema. The types of every class are kept between builds, so that a
+// rebuild only has to regenerate the types of the classes that were
+// invalidated since the previous build.
+type Builder struct {
+	traverser       Traverser
+	logger          logrus.FieldLogger
+	config          config.Config
+	modulesProvider *modules.Provider
+	cache           *local.Cache
+}
+
+func NewBuilder(traverser Traverser, logger logrus.FieldLogger,
+	config config.Config, modulesProvider *modules.Provider,
+) *Builder {
+	return &Builder{
+		traverser:       traverser,
+		logger:          logger,
+		config:          config,
+		modulesProvider: modulesProvider,
+		cache:           local.NewCache(),
+	}
+}
+
+// Invalidate marks the given classes as changed. Classes which reference
+// them are rebuilt as well.
+func (b *Builder) Invalidate(classNames ...string) {
+	b.cache.Invalidate(classNames...)
+}
+
+// InvalidateAll makes the next build regenerate the types of all classes
+func (b *Builder) InvalidateAll() {
+	b.cache.InvalidateAll()
+}
+
+// Build a GraphQL API from the database schema. The schema must not be
+// modified afterwards, as the types of unchanged classes are reused by later
+// builds.
+func (b *Builder) Build(schema *schema.Schema) (GraphQL, error) {
+	b.logger.WithField("action", "graphql_rebuild").
 		WithField("schema", schema).
 		Debug("rebuilding the graphql schema")
 
-	graphqlSchema, err := buildGraphqlSchema(schema, logger, config, modulesProvider)
+	graphqlSchema, err := buildGraphqlSchema(schema, b.logger, b.config, b.modulesProvider, b.cache)
 	if err != nil {
+		// a failed build may have left partially initialized types behind
+		b.cache.InvalidateAll()
 		return nil, err
 	}
 
 	return &graphQL{
 		schema:    graphqlSchema,
-		traverser: traverser,
-		config:    config,
+		traverser: b.traverser,
+		config:    b.config,
 	}, nil
 }
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	context, span := tracing.Start(context, "graphql.Resolve",
+		attribute.String("graphql.operation.name", operationName))
+	defer span.End()
+
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
@@ -79,12 +130,17 @@ func (g *graphQL) Resolve(context context.Context, query string, operationName s
 		VariableValues: variables,
 		Context:        context,
 	})
+	if result.HasErrors() {
+		span.SetStatus(codes.Error, result.Errors[0].Message)
+	}
+
+	return result
 }
 
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
-	config config.Config, modulesProvider *modules.Provider,
+	config config.Config, modulesProvider *modules.Provider, cache *local.Cache,
 ) (graphql.Schema, error) {
-	localSchema, err := local.Build(dbSchema, logger, config, modulesProvider)
+	localSchema, err := local.BuildWithCache(dbSchema, logger, config, modulesProvider, cache)
 	if err != nil {
 		return graphql.Schema{}, err
 	}
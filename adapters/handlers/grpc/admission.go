@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/admission"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// admissionInterceptors apply the same admission control as the REST API.
+// Rejected calls fail with ResourceExhausted and a retry-after header.
+func admissionInterceptors(controller *admission.Controller) []grpc.ServerOption {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		release, err := admit(ctx, controller, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		release, err := admit(ss.Context(), controller, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(unary),
+		grpc.StreamInterceptor(stream),
+	}
+}
+
+func admit(ctx context.Context, controller *admission.Controller,
+	fullMethod string,
+) (func(), error) {
+	release, err := controller.Admit(ctx, admissionKey(ctx), admissionKind(fullMethod))
+	var rejected admission.ErrRejected
+	switch {
+	case err == nil:
+		return release, nil
+	case errors.As(err, &rejected):
+		seconds := int(math.Ceil(rejected.RetryAfter.Seconds()))
+		grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(seconds)))
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return nil, status.FromContextError(err).Err()
+	}
+}
+
+// admissionKey identifies the client by its API key or token, only a hash
+// of it is kept in memory
+func admissionKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return ""
+	}
+	token, ok := strings.CutPrefix(md["authorization"][0], "Bearer ")
+	if !ok || token == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func admissionKind(fullMethod string) admission.Kind {
+	switch path.Base(fullMethod) {
+	case "BatchInsert", "BatchStream":
+		return admission.KindBatch
+	case "Search", "SearchStream", "Aggregate", "ResolveReferences":
+		return admission.KindQuery
+	default:
+		return admission.KindOther
+	}
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdmission(t *testing.T) {
+	controller := admission.New(config.AdmissionControl{
+		Enabled:              true,
+		MaxConcurrentQueries: 1,
+	}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer key"))
+
+	release, err := admit(ctx, controller, "/weaviategrpc.Weaviate/Search")
+	require.Nil(t, err)
+
+	_, err = admit(ctx, controller, "/weaviategrpc.Weaviate/SearchStream")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// batches are not limited by the queries
+	_, err = admit(ctx, controller, "/weaviategrpc.Weaviate/BatchInsert")
+	assert.Nil(t, err)
+
+	release()
+	_, err = admit(ctx, controller, "/weaviategrpc.Federation/ResolveReferences")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func (s *Server) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	params, err := aggregateParamsFromProto(req)
+	if err != nil {
+		return nil, fmt.Errorf("extract params: %w", err)
+	}
+
+	res, err := s.traverser.Aggregate(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := res.(*aggregation.Result)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aggregation result %T", res)
+	}
+
+	return aggregateResultToProto(result, params, before), nil
+}
+
+func aggregateParamsFromProto(req *pb.AggregateRequest) (*aggregation.Params, error) {
+	out := &aggregation.Params{
+		ClassName:        schema.ClassName(req.ClassName),
+		IncludeMetaCount: req.MetaCount,
+		Tenant:           req.Tenant,
+		Properties:       make([]aggregation.ParamProperty, len(req.Properties)),
+	}
+
+	for i, prop := range req.Properties {
+		if len(prop.Aggregators) == 0 {
+			return nil, fmt.Errorf("property %q: no aggregators set", prop.Name)
+		}
+
+		aggregators := make([]aggregation.Aggregator, len(prop.Aggregators))
+		for j, name := range prop.Aggregators {
+			agg, err := aggregation.ParseAggregatorProp(name)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+			}
+			aggregators[j] = agg
+		}
+
+		out.Properties[i] = aggregation.ParamProperty{
+			Name:        schema.PropertyName(prop.Name),
+			Aggregators: aggregators,
+		}
+	}
+
+	return out, nil
+}
+
+func aggregateResultToProto(res *aggregation.Result, params *aggregation.Params,
+	start time.Time,
+) *pb.AggregateReply {
+	tookSeconds := float64(time.Since(start)) / float64(time.Second)
+	out := &pb.AggregateReply{Took: float32(tookSeconds)}
+	if len(res.Groups) == 0 {
+		return out
+	}
+
+	// without groupBy there is exactly one group
+	group := res.Groups[0]
+	out.MetaCount = int64(group.Count)
+
+	for _, paramProp := range params.Properties {
+		prop, ok := group.Properties[paramProp.Name.String()]
+		if !ok {
+			continue
+		}
+		out.Properties = append(out.Properties,
+			aggregatePropertyToProto(paramProp, prop))
+	}
+
+	return out
+}
+
+func aggregatePropertyToProto(param aggregation.ParamProperty,
+	prop aggregation.Property,
+) *pb.AggregatePropertyResult {
+	out := &pb.AggregatePropertyResult{Name: param.Name.String()}
+
+	for _, agg := range param.Aggregators {
+		switch agg.Type {
+		case aggregation.TypeAggregator.Type:
+			out.Values = append(out.Values,
+				&pb.AggregatorValue{Aggregator: agg.Type, Text: prop.SchemaType})
+			continue
+		case aggregation.TopOccurrencesType:
+			for _, item := range prop.TextAggregation.Items {
+				out.TopOccurrences = append(out.TopOccurrences,
+					&pb.TopOccurrence{Value: item.Value, Occurs: int64(item.Occurs)})
+			}
+			continue
+		}
+
+		value, ok := aggregatorValue(prop, agg.Type)
+		if !ok {
+			continue
+		}
+		if agg.Type == aggregation.CountAggregator.Type {
+			out.Count = int64(value.Number)
+			continue
+		}
+		out.Values = append(out.Values, value)
+	}
+
+	return out
+}
+
+// aggregatorValue returns the result of the aggregator for the type of the
+// property
+func aggregatorValue(prop aggregation.Property, aggregator string) (*pb.AggregatorValue, bool) {
+	out := &pb.AggregatorValue{Aggregator: aggregator}
+
+	switch prop.Type {
+	case aggregation.PropertyTypeNumerical:
+		number, ok := toFloat64(prop.NumericalAggregations[aggregator])
+		out.Number = number
+		return out, ok
+	case aggregation.PropertyTypeDate:
+		switch v := prop.DateAggregations[aggregator].(type) {
+		case string:
+			out.Text = v
+			return out, true
+		default:
+			number, ok := toFloat64(v)
+			out.Number = number
+			return out, ok
+		}
+	case aggregation.PropertyTypeText:
+		if aggregator == aggregation.CountAggregator.Type {
+			out.Number = float64(prop.TextAggregation.Count)
+			return out, true
+		}
+	case aggregation.PropertyTypeBoolean:
+		b := prop.BooleanAggregation
+		switch aggregator {
+		case aggregation.CountAggregator.Type:
+			out.Number = float64(b.Count)
+		case aggregation.TotalTrueAggregator.Type:
+			out.Number = float64(b.TotalTrue)
+		case aggregation.TotalFalseAggregator.Type:
+			out.Number = float64(b.TotalFalse)
+		case aggregation.PercentageTrueAggregator.Type:
+			out.Number = b.PercentageTrue
+		case aggregation.PercentageFalseAggregator.Type:
+			out.Number = b.PercentageFalse
+		default:
+			return nil, false
+		}
+		return out, true
+	}
+
+	return nil, false
+}
+
+func toFloat64(in interface{}) (float64, bool) {
+	switch v := in.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestAggregateParamsAndResults(t *testing.T) {
+	params, err := aggregateParamsFromProto(&pb.AggregateRequest{
+		ClassName: "Article",
+		MetaCount: true,
+		Properties: []*pb.AggregateProperty{
+			{Name: "wordCount", Aggregators: []string{"count", "mean", "maximum"}},
+			{Name: "title", Aggregators: []string{"count", "topOccurrences"}},
+		},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, schema.ClassName("Article"), params.ClassName)
+	assert.True(t, params.IncludeMetaCount)
+	require.Len(t, params.Properties, 2)
+	assert.Equal(t, aggregation.MeanAggregator, params.Properties[0].Aggregators[1])
+
+	t.Run("unknown aggregator", func(t *testing.T) {
+		_, err := aggregateParamsFromProto(&pb.AggregateRequest{
+			ClassName:  "Article",
+			Properties: []*pb.AggregateProperty{{Name: "title", Aggregators: []string{"foo"}}},
+		})
+		assert.ErrorContains(t, err, "unrecognized aggregator prop 'foo'")
+	})
+
+	res := &aggregation.Result{Groups: []aggregation.Group{{
+		Count: 10,
+		Properties: map[string]aggregation.Property{
+			"wordCount": {
+				Type: aggregation.PropertyTypeNumerical,
+				NumericalAggregations: map[string]interface{}{
+					"count": 10, "mean": 2.5, "maximum": float64(7),
+				},
+			},
+			"title": {
+				Type: aggregation.PropertyTypeText,
+				TextAggregation: aggregation.Text{
+					Count: 9,
+					Items: []aggregation.TextOccurrence{{Value: "foo", Occurs: 4}},
+				},
+			},
+		},
+	}}}
+
+	reply := aggregateResultToProto(res, params, time.Now())
+	assert.Equal(t, int64(10), reply.MetaCount)
+	require.Len(t, reply.Properties, 2)
+	assert.Equal(t, &pb.AggregatePropertyResult{
+		Name:  "wordCount",
+		Count: 10,
+		Values: []*pb.AggregatorValue{
+			{Aggregator: "mean", Number: 2.5},
+			{Aggregator: "maximum", Number: 7},
+		},
+	}, reply.Properties[0])
+	assert.Equal(t, &pb.AggregatePropertyResult{
+		Name:           "title",
+		Count:          9,
+		TopOccurrences: []*pb.TopOccurrence{{Value: "foo", Occurs: 4}},
+	}, reply.Properties[1])
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type batchAdder interface {
+	AddObjects(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, fields []*string,
+		repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+	AddObjectsChunk(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, repl *additional.ReplicationProperties,
+	) (objects.BatchObjects, objects.Backpressure, error)
+}
+
+// BatchInsert imports the objects of the request. Objects which can't be
+// imported are reported in the reply, they don't fail the whole request.
+func (s *Server) BatchInsert(ctx context.Context, req *pb.BatchInsertRequest) (*pb.BatchInsertReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	objs := make([]*models.Object, len(req.Objects))
+	for i, obj := range req.Objects {
+		objs[i] = batchObjectFromProto(obj)
+	}
+
+	var repl *additional.ReplicationProperties
+	if req.ConsistencyLevel != "" {
+		repl = &additional.ReplicationProperties{ConsistencyLevel: req.ConsistencyLevel}
+	}
+
+	res, err := s.batchManager.AddObjects(withExpectedVersions(ctx, req.Objects),
+		principal, objs, nil, repl)
+	if err != nil {
+		return nil, err
+	}
+
+	return batchResultsToProto(res, before), nil
+}
+
+func batchObjectFromProto(obj *pb.BatchObject) *models.Object {
+	out := &models.Object{
+		Class:  obj.ClassName,
+		ID:     strfmt.UUID(obj.Uuid),
+		Vector: obj.Vector,
+		Tenant: obj.Tenant,
+	}
+	if obj.Properties != nil {
+		out.Properties = obj.Properties.AsMap()
+	}
+	return out
+}
+
+// withExpectedVersions passes the preconditions of the objects, if any of
+// them has one
+func withExpectedVersions(ctx context.Context, objs []*pb.BatchObject) context.Context {
+	versions := make([]int64, len(objs))
+	conditional := false
+	for i, obj := range objs {
+		versions[i] = obj.ExpectedVersion
+		conditional = conditional || obj.ExpectedVersion != 0
+	}
+	if !conditional {
+		return ctx
+	}
+	return objects.WithExpectedVersions(ctx, versions)
+}
+
+func batchResultsToProto(res objects.BatchObjects, start time.Time) *pb.BatchInsertReply {
+	tookSeconds := float64(time.Since(start)) / float64(time.Second)
+	out := &pb.BatchInsertReply{Took: float32(tookSeconds)}
+	for _, obj := range res {
+		if obj.Err != nil {
+			out.Errors = append(out.Errors, &pb.BatchError{
+				Index: uint32(obj.OriginalIndex),
+				Error: obj.Err.Error(),
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// maxQueuedChunks limits how many chunks of a stream are received ahead of
+// the import. If the queue is full, flow control of the stream slows down
+// the client.
+const maxQueuedChunks = 4
+
+// BatchStream imports every request of the stream as a chunk and replies
+// with the errors of the chunk and the current backpressure, so clients can
+// adapt their send rate.
+func (s *Server) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	chunks := make(chan *pb.BatchStreamRequest, maxQueuedChunks)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+
+			select {
+			case chunks <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var chunkIndex uint32
+	for req := range chunks {
+		objs := make([]*models.Object, len(req.Objects))
+		for i, obj := range req.Objects {
+			objs[i] = batchObjectFromProto(obj)
+		}
+
+		var repl *additional.ReplicationProperties
+		if req.ConsistencyLevel != "" {
+			repl = &additional.ReplicationProperties{ConsistencyLevel: req.ConsistencyLevel}
+		}
+
+		res, backpressure, err := s.batchManager.AddObjectsChunk(
+			withExpectedVersions(ctx, req.Objects), principal, objs, repl)
+		if err != nil {
+			return fmt.Errorf("chunk %d: %w", chunkIndex, err)
+		}
+
+		reply := batchStreamReply(chunkIndex, res, backpressure, len(chunks))
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+		chunkIndex++
+	}
+
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// batchStreamReply adds the chunks received but not yet imported to the
+// queue depth of the batch manager
+func batchStreamReply(chunkIndex uint32, res objects.BatchObjects,
+	backpressure objects.Backpressure, queued int,
+) *pb.BatchStreamReply {
+	out := &pb.BatchStreamReply{
+		Chunk: chunkIndex,
+		Backpressure: &pb.Backpressure{
+			QueueDepth:   uint32(backpressure.QueueDepth + queued),
+			ShardBusy:    backpressure.ShardBusy,
+			RetryAfterMs: uint32(backpressure.RetryAfter.Milliseconds()),
+		},
+	}
+	for _, obj := range res {
+		if obj.Err != nil {
+			out.Errors = append(out.Errors, &pb.BatchError{
+				Index: uint32(obj.OriginalIndex),
+				Error: obj.Err.Error(),
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeBatchAdder struct {
+	objects []*models.Object
+	repl    *additional.ReplicationProperties
+	chunks  int
+}
+
+func (f *fakeBatchAdder) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	f.objects, f.repl = objs, repl
+	out := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		out[i] = objects.BatchObject{OriginalIndex: i, Object: obj}
+		if obj.Class == "Missing" {
+			out[i].Err = errors.New("class Missing not found")
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeBatchAdder) AddObjectsChunk(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, objects.Backpressure, error) {
+	f.chunks++
+	out, err := f.AddObjects(ctx, principal, objs, nil, repl)
+	return out, objects.Backpressure{ShardBusy: "commit_log", RetryAfter: time.Second}, err
+}
+
+type fakeBatchStream struct {
+	grpc.ServerStream
+	requests []*pb.BatchStreamRequest
+	replies  []*pb.BatchStreamReply
+}
+
+func (f *fakeBatchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeBatchStream) Recv() (*pb.BatchStreamRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeBatchStream) Send(reply *pb.BatchStreamReply) error {
+	f.replies = append(f.replies, reply)
+	return nil
+}
+
+func TestBatchInsert(t *testing.T) {
+	adder := &fakeBatchAdder{}
+	s := &Server{allowAnonymousAccess: true, batchManager: adder}
+
+	props, err := structpb.NewStruct(map[string]interface{}{"title": "gRPC", "count": 3})
+	require.Nil(t, err)
+
+	res, err := s.BatchInsert(context.Background(), &pb.BatchInsertRequest{
+		Objects: []*pb.BatchObject{
+			{ClassName: "Article", Uuid: existingID.String(), Properties: props, Vector: []float32{1, 2}},
+			{ClassName: "Missing", Uuid: missingID.String(), Tenant: "tenant1"},
+		},
+		ConsistencyLevel: "QUORUM",
+	})
+	require.Nil(t, err)
+
+	t.Run("objects are passed to the batch manager", func(t *testing.T) {
+		require.Len(t, adder.objects, 2)
+		assert.Equal(t, &models.Object{
+			Class:      "Article",
+			ID:         existingID,
+			Properties: map[string]interface{}{"title": "gRPC", "count": float64(3)},
+			Vector:     []float32{1, 2},
+		}, adder.objects[0])
+		assert.Equal(t, "tenant1", adder.objects[1].Tenant)
+		assert.Equal(t, "QUORUM", adder.repl.ConsistencyLevel)
+	})
+
+	t.Run("only failed objects are reported", func(t *testing.T) {
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, uint32(1), res.Errors[0].Index)
+		assert.Equal(t, "class Missing not found", res.Errors[0].Error)
+	})
+}
+
+func TestBatchStream(t *testing.T) {
+	adder := &fakeBatchAdder{}
+	s := &Server{allowAnonymousAccess: true, batchManager: adder}
+	stream := &fakeBatchStream{requests: []*pb.BatchStreamRequest{
+		{Objects: []*pb.BatchObject{{ClassName: "Article"}, {ClassName: "Missing"}}},
+		{Objects: []*pb.BatchObject{{ClassName: "Missing"}}, ConsistencyLevel: "ONE"},
+		{Objects: []*pb.BatchObject{{ClassName: "Article"}}},
+	}}
+
+	require.Nil(t, s.BatchStream(stream))
+
+	assert.Equal(t, 3, adder.chunks)
+	require.Len(t, stream.replies, 3)
+	for i, reply := range stream.replies {
+		assert.Equal(t, uint32(i), reply.Chunk)
+		assert.Equal(t, "commit_log", reply.Backpressure.ShardBusy)
+		assert.Equal(t, uint32(1000), reply.Backpressure.RetryAfterMs)
+	}
+
+	t.Run("errors are indexed within the chunk", func(t *testing.T) {
+		require.Len(t, stream.replies[0].Errors, 1)
+		assert.Equal(t, uint32(1), stream.replies[0].Errors[0].Index)
+		require.Len(t, stream.replies[1].Errors, 1)
+		assert.Equal(t, uint32(0), stream.replies[1].Errors[0].Index)
+		assert.Empty(t, stream.replies[2].Errors)
+	})
+}
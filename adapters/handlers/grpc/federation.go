@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/federation"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type objectsGetter interface {
+	GetObject(ctx context.Context, principal *models.Principal, class string,
+		id strfmt.UUID, additional additional.Properties,
+		replProps *additional.ReplicationProperties, tenant string,
+	) (*models.Object, error)
+}
+
+// ResolveReferences serves batched reference lookups of other instances
+// which federate classes of this instance. Each target is read with the
+// permissions of the calling peer and from the tenant of the request,
+// targets which don't exist are returned with found=false so the reply stays
+// aligned with the request. Requests with more than
+// federation.MaxResolveBatchSize targets are rejected.
+func (s *Server) ResolveReferences(ctx context.Context,
+	req *pb.ResolveReferencesRequest,
+) (*pb.ResolveReferencesReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "extract auth: %v", err)
+	}
+
+	if len(req.Targets) > federation.MaxResolveBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many targets: %d, at most %d are allowed per request",
+			len(req.Targets), federation.MaxResolveBatchSize)
+	}
+
+	out := &pb.ResolveReferencesReply{
+		References: make([]*pb.ResolvedReference, len(req.Targets)),
+	}
+	for i, target := range req.Targets {
+		ref, err := s.resolveReference(ctx, principal, target, req.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		out.References[i] = ref
+	}
+
+	return out, nil
+}
+
+func (s *Server) resolveReference(ctx context.Context, principal *models.Principal,
+	target *pb.ReferenceTarget, tenant string,
+) (*pb.ResolvedReference, error) {
+	out := &pb.ResolvedReference{ClassName: target.ClassName, Id: target.Id}
+	if !strfmt.IsUUID(target.Id) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id %q", target.Id)
+	}
+
+	obj, err := s.objectsManager.GetObject(ctx, principal, target.ClassName,
+		strfmt.UUID(target.Id), additional.Properties{Vector: true}, nil, tenant)
+	if err != nil {
+		var notFound objects.ErrNotFound
+		var forbidden autherrs.Forbidden
+		switch {
+		case errors.As(err, &notFound):
+			return out, nil
+		case errors.As(err, &forbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "get object %s: %v", target.Id, err)
+		}
+	}
+	if obj == nil {
+		return out, nil
+	}
+
+	props, err := propertiesToStruct(obj.Properties)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "object %s: %v", target.Id, err)
+	}
+
+	out.ClassName = obj.Class
+	out.Found = true
+	out.Properties = props
+	out.Vector = obj.Vector
+	out.CreationTimeUnix = obj.CreationTimeUnix
+	out.LastUpdateTimeUnix = obj.LastUpdateTimeUnix
+	return out, nil
+}
+
+// propertiesToStruct converts properties through their JSON representation,
+// as they may contain types such as geo coordinates and references which
+// structpb can't convert directly
+func propertiesToStruct(props models.PropertySchema) (*structpb.Struct, error) {
+	if props == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(props)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+
+	out := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, out); err != nil {
+		return nil, fmt.Errorf("convert properties: %w", err)
+	}
+	return out, nil
+}
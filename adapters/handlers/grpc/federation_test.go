@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/federation"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	existingID  = strfmt.UUID("9f4c0e59-3b1a-4c55-9a0a-6d1c4d7f6a01")
+	missingID   = strfmt.UUID("9f4c0e59-3b1a-4c55-9a0a-6d1c4d7f6a02")
+	forbiddenID = strfmt.UUID("9f4c0e59-3b1a-4c55-9a0a-6d1c4d7f6a03")
+)
+
+type fakeObjectsGetter struct {
+	tenants []string
+}
+
+func (f *fakeObjectsGetter) GetObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, additional additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string,
+) (*models.Object, error) {
+	f.tenants = append(f.tenants, tenant)
+	switch id {
+	case existingID:
+		return &models.Object{
+			Class: "Article",
+			ID:    id,
+			Properties: map[string]interface{}{
+				"title": "Federation",
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(52.37),
+					Longitude: ptFloat32(4.89),
+				},
+			},
+			Vector:           []float32{1, 2, 3},
+			CreationTimeUnix: 1000,
+		}, nil
+	case forbiddenID:
+		return nil, autherrs.NewForbidden(&models.Principal{Username: "peer"}, "get", "objects")
+	default:
+		return nil, objects.NewErrNotFound("no object with id '%s'", id)
+	}
+}
+
+func ptFloat32(f float32) *float32 {
+	return &f
+}
+
+func TestResolveReferences(t *testing.T) {
+	getter := &fakeObjectsGetter{}
+	s := &Server{allowAnonymousAccess: true, objectsManager: getter}
+	ctx := context.Background()
+
+	t.Run("found and missing targets", func(t *testing.T) {
+		res, err := s.ResolveReferences(ctx, &pb.ResolveReferencesRequest{
+			Targets: []*pb.ReferenceTarget{
+				{ClassName: "Article", Id: existingID.String()},
+				{ClassName: "Article", Id: missingID.String()},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.References, 2)
+
+		found := res.References[0]
+		assert.True(t, found.Found)
+		assert.Equal(t, "Article", found.ClassName)
+		assert.Equal(t, []float32{1, 2, 3}, found.Vector)
+		assert.Equal(t, int64(1000), found.CreationTimeUnix)
+		props := found.Properties.AsMap()
+		assert.Equal(t, "Federation", props["title"])
+		assert.Contains(t, props["location"], "latitude")
+
+		missing := res.References[1]
+		assert.False(t, missing.Found)
+		assert.Equal(t, missingID.String(), missing.Id)
+		assert.Nil(t, missing.Properties)
+	})
+
+	t.Run("forbidden target", func(t *testing.T) {
+		_, err := s.ResolveReferences(ctx, &pb.ResolveReferencesRequest{
+			Targets: []*pb.ReferenceTarget{{ClassName: "Article", Id: forbiddenID.String()}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("targets of a tenant", func(t *testing.T) {
+		getter.tenants = nil
+		res, err := s.ResolveReferences(ctx, &pb.ResolveReferencesRequest{
+			Targets: []*pb.ReferenceTarget{{ClassName: "Article", Id: existingID.String()}},
+			Tenant:  "tenant1",
+		})
+		require.Nil(t, err)
+		require.Len(t, res.References, 1)
+		assert.True(t, res.References[0].Found)
+		assert.Equal(t, []string{"tenant1"}, getter.tenants)
+	})
+
+	t.Run("too many targets", func(t *testing.T) {
+		targets := make([]*pb.ReferenceTarget, federation.MaxResolveBatchSize+1)
+		for i := range targets {
+			targets[i] = &pb.ReferenceTarget{ClassName: "Article", Id: missingID.String()}
+		}
+		_, err := s.ResolveReferences(ctx, &pb.ResolveReferencesRequest{Targets: targets})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, err := s.ResolveReferences(ctx, &pb.ResolveReferencesRequest{
+			Targets: []*pb.ReferenceTarget{{ClassName: "Article", Id: "not-a-uuid"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
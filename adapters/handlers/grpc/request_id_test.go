@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithRequestID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(requestIDHeader, "req-1"))
+	assert.Equal(t, "req-1", audit.RequestID(withRequestID(ctx)))
+
+	generated := audit.RequestID(withRequestID(context.Background()))
+	assert.NotEmpty(t, generated)
+	assert.NotEqual(t, generated, audit.RequestID(withRequestID(context.Background())))
+}
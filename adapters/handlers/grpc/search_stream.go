@@ -0,0 +1,317 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// defaultStreamBatchSize is the number of results per reply if the request
+// does not set a batch size
+const defaultStreamBatchSize = 100
+
+// SearchStream sends the results of a search in batches. Plain listings of a
+// class are paged with the cursor API, so a class can be streamed without
+// holding all of its objects in memory. Searches are executed once, their
+// results are split into batches.
+func (s *Server) SearchStream(req *pb.SearchStreamRequest, stream pb.Weaviate_SearchStreamServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.Search == nil {
+		return fmt.Errorf("search must be set")
+	}
+
+	searchParams, err := searchParamsFromProto(req.Search)
+	if err != nil {
+		return fmt.Errorf("extract params: %w", err)
+	}
+
+	if err := s.validateClassAndProperty(searchParams); err != nil {
+		return err
+	}
+
+	class, err := schema.GetClassByName(s.schemaManager.GetSchemaSkipAuth().Objects,
+		searchParams.ClassName)
+	if err != nil {
+		return err
+	}
+
+	batchSize := int(req.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultStreamBatchSize
+	}
+
+	send := func(res []any) error {
+		reply, err := flatResultsToProto(res, searchParams, class)
+		if err != nil {
+			return err
+		}
+		return stream.Send(reply)
+	}
+
+	if !isListing(searchParams) {
+		res, err := s.traverser.GetClass(ctx, principal, searchParams)
+		if err != nil {
+			return err
+		}
+		for len(res) > 0 {
+			n := batchSize
+			if n > len(res) {
+				n = len(res)
+			}
+			if err := send(res[:n]); err != nil {
+				return err
+			}
+			res = res[n:]
+		}
+		return nil
+	}
+
+	// a limit of 0 streams the whole class
+	remaining := int(req.Search.Limit)
+	params := searchParams
+	params.AdditionalProperties.Cursor = true
+	params.Cursor = &filters.Cursor{}
+	for {
+		limit := batchSize
+		if req.Search.Limit > 0 && remaining < limit {
+			limit = remaining
+		}
+		params.Cursor.Limit = limit
+		params.Pagination = &filters.Pagination{Limit: limit}
+
+		res, err := s.traverser.GetClass(ctx, principal, params)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return nil
+		}
+		if err := send(res); err != nil {
+			return err
+		}
+
+		remaining -= len(res)
+		if len(res) < limit || (req.Search.Limit > 0 && remaining <= 0) {
+			return nil
+		}
+
+		after, ok := resultCursor(res[len(res)-1])
+		if !ok {
+			return fmt.Errorf("cursor of the last result is missing")
+		}
+		params.Cursor.After = after
+	}
+}
+
+// isListing is true for requests without a vector or keyword search, their
+// results are ordered by id
+func isListing(params dto.GetParams) bool {
+	return params.NearVector == nil && params.NearObject == nil &&
+		params.HybridSearch == nil && params.KeywordRanking == nil
+}
+
+func resultCursor(raw any) (string, bool) {
+	asMap, ok := raw.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	additionalProps, ok := asMap["_additional"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	cursor, ok := additionalProps["cursor"].(string)
+	return cursor, ok
+}
+
+func flatResultsToProto(res []any, searchParams dto.GetParams,
+	class *models.Class,
+) (*pb.SearchStreamReply, error) {
+	out := &pb.SearchStreamReply{Results: make([]*pb.FlatResult, 0, len(res))}
+
+	for _, raw := range res {
+		asMap, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		additionalProps, err := extractAdditionalProps(asMap, searchParams)
+		if err != nil {
+			continue
+		}
+
+		result := &pb.FlatResult{AdditionalProperties: additionalProps}
+		for _, prop := range searchParams.Properties {
+			if !prop.IsPrimitive {
+				continue
+			}
+			value, ok := asMap[prop.Name]
+			if !ok || value == nil {
+				continue
+			}
+			classProp, err := schema.GetPropertyByName(class, prop.Name)
+			if err != nil {
+				return nil, err
+			}
+			flat, err := flatProperty(prop.Name, schema.DataType(classProp.DataType[0]), value)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+			}
+			result.Properties = append(result.Properties, flat)
+		}
+
+		out.Results = append(out.Results, result)
+	}
+
+	return out, nil
+}
+
+// flatProperty encodes the value as the typed field matching the data type
+// of the property. Data types without a typed field are encoded as
+// google.protobuf.Value.
+func flatProperty(name string, dataType schema.DataType, value any) (*pb.FlatProperty, error) {
+	out := &pb.FlatProperty{Name: name}
+
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeDate,
+		schema.DataTypeUUID, schema.DataTypeBlob:
+		out.Value = &pb.FlatProperty_TextValue{TextValue: textValue(value)}
+		return out, nil
+	case schema.DataTypeNumber:
+		if v, ok := toFloat64(value); ok {
+			out.Value = &pb.FlatProperty_NumberValue{NumberValue: v}
+			return out, nil
+		}
+	case schema.DataTypeInt:
+		if v, ok := toFloat64(value); ok {
+			out.Value = &pb.FlatProperty_IntValue{IntValue: int64(v)}
+			return out, nil
+		}
+	case schema.DataTypeBoolean:
+		if v, ok := value.(bool); ok {
+			out.Value = &pb.FlatProperty_BoolValue{BoolValue: v}
+			return out, nil
+		}
+	case schema.DataTypeTextArray, schema.DataTypeStringArray,
+		schema.DataTypeDateArray, schema.DataTypeUUIDArray:
+		if values, ok := arrayOf(value, func(v any) (string, bool) {
+			return textValue(v), true
+		}); ok {
+			out.Value = &pb.FlatProperty_TextArray{TextArray: &pb.TextArray{Values: values}}
+			return out, nil
+		}
+	case schema.DataTypeNumberArray:
+		if values, ok := arrayOf(value, toFloat64); ok {
+			out.Value = &pb.FlatProperty_NumberArray{NumberArray: &pb.NumberArray{Values: values}}
+			return out, nil
+		}
+	case schema.DataTypeIntArray:
+		if values, ok := arrayOf(value, func(v any) (int64, bool) {
+			f, ok := toFloat64(v)
+			return int64(f), ok
+		}); ok {
+			out.Value = &pb.FlatProperty_IntArray{IntArray: &pb.IntArray{Values: values}}
+			return out, nil
+		}
+	case schema.DataTypeBooleanArray:
+		if values, ok := arrayOf(value, func(v any) (bool, bool) {
+			b, ok := v.(bool)
+			return b, ok
+		}); ok {
+			out.Value = &pb.FlatProperty_BoolArray{BoolArray: &pb.BoolArray{Values: values}}
+			return out, nil
+		}
+	}
+
+	structValue, err := toStructValue(value)
+	if err != nil {
+		return nil, err
+	}
+	out.Value = &pb.FlatProperty_StructValue{StructValue: structValue}
+	return out, nil
+}
+
+// textValue formats dates as RFC 3339, like the other APIs do
+func textValue(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(value)
+}
+
+// arrayOf converts typed slices as well as []interface{} element by element
+func arrayOf[T any](value any, convert func(any) (T, bool)) ([]T, bool) {
+	var elems []any
+	switch v := value.(type) {
+	case []any:
+		elems = v
+	case []string:
+		elems = make([]any, len(v))
+		for i := range v {
+			elems[i] = v[i]
+		}
+	case []float64:
+		elems = make([]any, len(v))
+		for i := range v {
+			elems[i] = v[i]
+		}
+	case []bool:
+		elems = make([]any, len(v))
+		for i := range v {
+			elems[i] = v[i]
+		}
+	default:
+		return nil, false
+	}
+
+	out := make([]T, len(elems))
+	for i := range elems {
+		converted, ok := convert(elems[i])
+		if !ok {
+			return nil, false
+		}
+		out[i] = converted
+	}
+	return out, true
+}
+
+// toStructValue encodes arbitrary values, e.g. *models.GeoCoordinates, via
+// their JSON representation
+func toStructValue(value any) (*structpb.Value, error) {
+	if v, err := structpb.NewValue(value); err == nil {
+		return v, nil
+	}
+
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	out := &structpb.Value{}
+	if err := out.UnmarshalJSON(marshalled); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestFlatProperty(t *testing.T) {
+	date := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		dataType schema.DataType
+		value    interface{}
+		expected interface{}
+	}{
+		{"text", schema.DataTypeText, "foo", &pb.FlatProperty_TextValue{TextValue: "foo"}},
+		{"date", schema.DataTypeDate, date, &pb.FlatProperty_TextValue{TextValue: "2023-07-01T12:00:00Z"}},
+		{"number", schema.DataTypeNumber, 1.5, &pb.FlatProperty_NumberValue{NumberValue: 1.5}},
+		{"int", schema.DataTypeInt, float64(7), &pb.FlatProperty_IntValue{IntValue: 7}},
+		{"bool", schema.DataTypeBoolean, true, &pb.FlatProperty_BoolValue{BoolValue: true}},
+		{
+			"text array", schema.DataTypeTextArray, []string{"a", "b"},
+			&pb.FlatProperty_TextArray{TextArray: &pb.TextArray{Values: []string{"a", "b"}}},
+		},
+		{
+			"number array", schema.DataTypeNumberArray, []interface{}{1.5, 2.5},
+			&pb.FlatProperty_NumberArray{NumberArray: &pb.NumberArray{Values: []float64{1.5, 2.5}}},
+		},
+		{
+			"int array", schema.DataTypeIntArray, []float64{1, 2},
+			&pb.FlatProperty_IntArray{IntArray: &pb.IntArray{Values: []int64{1, 2}}},
+		},
+		{
+			"bool array", schema.DataTypeBooleanArray, []bool{true, false},
+			&pb.FlatProperty_BoolArray{BoolArray: &pb.BoolArray{Values: []bool{true, false}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop, err := flatProperty("prop", tt.dataType, tt.value)
+			require.Nil(t, err)
+			assert.Equal(t, "prop", prop.Name)
+			assert.Equal(t, tt.expected, prop.Value)
+		})
+	}
+
+	t.Run("data types without a flat encoding", func(t *testing.T) {
+		prop, err := flatProperty("location", schema.DataTypeGeoCoordinates,
+			&models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(4.5)})
+		require.Nil(t, err)
+		value, ok := prop.Value.(*pb.FlatProperty_StructValue)
+		require.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"latitude": 52.5, "longitude": 4.5},
+			value.StructValue.AsInterface())
+	})
+}
@@ -33,21 +33,28 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/federation"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
 )
 
 func CreateGRPCServer(state *state.State) *GRPCServer {
-	s := grpc.NewServer()
+	s := grpc.NewServer(append(admissionInterceptors(state.AdmissionControl),
+		requestIDInterceptors()...)...)
 
-	pb.RegisterWeaviateServer(s, &Server{
+	srv := &Server{
 		traverser: state.Traverser,
 		authComposer: composer.New(
 			state.ServerConfig.Config.Authentication,
 			state.APIKey, state.OIDC),
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
-	})
+		objectsManager:       state.ObjectsManager,
+		batchManager:         state.BatchManager,
+		federation:           state.FederationResolver,
+	}
+	pb.RegisterWeaviateServer(s, srv)
+	pb.RegisterFederationServer(s, srv)
 
 	return &GRPCServer{s}
 }
@@ -73,10 +80,14 @@ type GRPCServer struct {
 
 type Server struct {
 	pb.UnimplementedWeaviateServer
+	pb.UnimplementedFederationServer
 	traverser            *traverser.Traverser
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
+	objectsManager       objectsGetter
+	batchManager         batchAdder
+	federation           *federation.Resolver
 }
 
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
@@ -96,11 +107,26 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 		return nil, err
 	}
 
+	// references to classes of federation peers are resolved in a single
+	// fan-out once the local results are known
+	selected := searchParams.Properties
+	var remote search.SelectProperties
+	if s.federation != nil {
+		searchParams.Properties, remote = federation.SplitRemoteSelections(selected)
+	}
+
 	res, err := s.traverser.GetClass(ctx, principal, searchParams)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(remote) > 0 {
+		if err := s.federation.ResolveSelected(ctx, res, remote, searchParams.Tenant); err != nil {
+			return nil, fmt.Errorf("resolve federated references: %w", err)
+		}
+	}
+
+	searchParams.Properties = selected
 	return searchResultsToProto(res, before, searchParams)
 }
 
@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type authz struct {
+	txHandler
+}
+
+func NewAuthz(manager txManager) *authz {
+	return &authz{txHandler{manager: manager, unmarshal: unmarshalAuthzTransaction}}
+}
+
+// unmarshalAuthzTransaction handles the transactions of both the api keys
+// and the roles
+func unmarshalAuthzTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case apikey.TransactionPutKey, apikey.TransactionReadKeys:
+		return apikey.UnmarshalTransaction(txType, payload)
+	default:
+		return rbac.UnmarshalTransaction(txType, payload)
+	}
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ContentEncodingZstd is used for zstd compressed bodies between nodes
+const ContentEncodingZstd = "zstd"
+
+// replication payloads and shard file transfers are large enough to benefit
+// from compression, other cluster requests are not worth the cpu
+var regexpCompressiblePath = regexp.MustCompile(
+	`^\/replicas\/|` + urlPatternShardFiles)
+
+// CompressiblePath reports whether requests to path should be compressed if
+// compression is enabled
+func CompressiblePath(path string) bool {
+	return regexpCompressiblePath.MatchString(path)
+}
+
+var (
+	zstdEncoders = sync.Pool{New: func() any {
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return enc
+	}}
+	zstdDecoders = sync.Pool{New: func() any {
+		dec, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		return dec
+	}}
+)
+
+type zstdWriter struct {
+	*zstd.Encoder
+}
+
+// NewZstdWriter returns a pooled encoder which must be closed to flush the
+// stream. It does not close w.
+func NewZstdWriter(w io.Writer) io.WriteCloser {
+	enc := zstdEncoders.Get().(*zstd.Encoder)
+	enc.Reset(w)
+	return &zstdWriter{enc}
+}
+
+func (w *zstdWriter) Close() error {
+	if w.Encoder == nil {
+		return nil
+	}
+	err := w.Encoder.Close()
+	w.Encoder.Reset(nil)
+	zstdEncoders.Put(w.Encoder)
+	w.Encoder = nil
+	return err
+}
+
+type zstdReader struct {
+	dec  *zstd.Decoder
+	body io.Closer
+}
+
+// NewZstdReader returns a pooled decoder reading from body. Closing it closes
+// body.
+func NewZstdReader(body io.ReadCloser) (io.ReadCloser, error) {
+	dec := zstdDecoders.Get().(*zstd.Decoder)
+	if err := dec.Reset(body); err != nil {
+		zstdDecoders.Put(dec)
+		return nil, err
+	}
+	return &zstdReader{dec: dec, body: body}, nil
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.dec == nil {
+		return 0, io.ErrClosedPipe
+	}
+	return r.dec.Read(p)
+}
+
+func (r *zstdReader) Close() error {
+	if r.dec != nil {
+		r.dec.Reset(nil)
+		zstdDecoders.Put(r.dec)
+		r.dec = nil
+	}
+	return r.body.Close()
+}
+
+func acceptsZstd(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(encoding) == ContentEncodingZstd {
+			return true
+		}
+	}
+	return false
+}
+
+// compression decodes zstd compressed request bodies and compresses
+// responses if the caller accepts zstd. Decoding does not depend on the local
+// configuration, so nodes can enable compression one after the other.
+func compression(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") == ContentEncodingZstd {
+			body, err := NewZstdReader(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			defer body.Close()
+			r.Body = body
+			r.ContentLength = -1
+			r.Header.Del("Content-Encoding")
+			r.Header.Del("Content-Length")
+		}
+
+		if !acceptsZstd(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressedResponseWriter{ResponseWriter: w}
+		defer cw.close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// compressedResponseWriter only starts a zstd stream once the handler writes
+// a body, responses without a body stay untouched
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+	compress    bool
+	enc         io.WriteCloser
+}
+
+func (w *compressedResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code != http.StatusNoContent && code != http.StatusNotModified {
+		w.compress = true
+		w.Header().Set("Content-Encoding", ContentEncodingZstd)
+		w.Header().Del("Content-Length")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *compressedResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.compress {
+		return w.ResponseWriter.Write(p)
+	}
+	if w.enc == nil {
+		w.enc = NewZstdWriter(w.ResponseWriter)
+	}
+	return w.enc.Write(p)
+}
+
+func (w *compressedResponseWriter) close() {
+	if w.enc != nil {
+		w.enc.Close()
+	}
+}
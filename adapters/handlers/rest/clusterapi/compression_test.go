@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	payload := strings.Repeat("shard file ", 100)
+	echo := compression(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/empty" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		io.Copy(w, r.Body)
+	}))
+
+	compressed := func(t *testing.T, in string) []byte {
+		var buf bytes.Buffer
+		enc := NewZstdWriter(&buf)
+		_, err := enc.Write([]byte(in))
+		require.Nil(t, err)
+		require.Nil(t, enc.Close())
+		return buf.Bytes()
+	}
+
+	t.Run("compressed request and response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/indices/C/shards/S/files/f",
+			bytes.NewReader(compressed(t, payload)))
+		req.Header.Set("Content-Encoding", ContentEncodingZstd)
+		req.Header.Set("Accept-Encoding", ContentEncodingZstd)
+		rec := httptest.NewRecorder()
+		echo.ServeHTTP(rec, req)
+
+		require.Equal(t, ContentEncodingZstd, rec.Header().Get("Content-Encoding"))
+		body, err := NewZstdReader(io.NopCloser(rec.Body))
+		require.Nil(t, err)
+		out, err := io.ReadAll(body)
+		require.Nil(t, err)
+		assert.Equal(t, payload, string(out))
+	})
+
+	t.Run("plain request and response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/replicas/indices/C",
+			strings.NewReader(payload))
+		rec := httptest.NewRecorder()
+		echo.ServeHTTP(rec, req)
+
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, payload, rec.Body.String())
+	})
+
+	t.Run("responses without body are not encoded", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/empty", nil)
+		req.Header.Set("Accept-Encoding", ContentEncodingZstd)
+		rec := httptest.NewRecorder()
+		echo.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.Zero(t, rec.Body.Len())
+	})
+
+	t.Run("invalid compressed request", func(t *testing.T) {
+		var readErr error
+		handler := compression(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, readErr = io.ReadAll(r.Body)
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/replicas/indices/C",
+			strings.NewReader("not zstd"))
+		req.Header.Set("Content-Encoding", ContentEncodingZstd)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.NotNil(t, readErr)
+	})
+}
+
+func TestCompressiblePath(t *testing.T) {
+	assert.True(t, CompressiblePath("/replicas/indices/C/shards/S/objects"))
+	assert.True(t, CompressiblePath("/indices/C/shards/S/files/main.db"))
+	assert.False(t, CompressiblePath("/indices/C/shards/S/objects"))
+	assert.False(t, CompressiblePath("/schema/transactions/"))
+}
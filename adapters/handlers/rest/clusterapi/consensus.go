@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type proposer interface {
+	ApplyProposal(ctx context.Context, entry []byte) error
+}
+
+type consensus struct {
+	log proposer
+}
+
+func NewConsensus(log proposer) *consensus {
+	return &consensus{log: log}
+}
+
+// Propose appends entries forwarded by followers to the raft log
+func (c *consensus) Propose() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		defer r.Body.Close()
+
+		entry, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = c.log.ApplyProposal(r.Context(), entry)
+		switch {
+		case err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		case errors.Is(err, cluster.ErrConcurrentTransaction):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case errors.Is(err, cluster.ErrNotLeader):
+			// the follower retries once the new leader is known
+			http.Error(w, err.Error(), http.StatusMisdirectedRequest)
+		case errors.Is(err, cluster.ErrConsensusNotReady):
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
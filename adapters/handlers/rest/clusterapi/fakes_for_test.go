@@ -60,6 +60,11 @@ func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []stri
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(_ context.Context, aliases map[string]string) error {
+	f.schema.Aliases = aliases
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -197,6 +202,10 @@ func (n *NilMigrator) GetShardsStatus(ctx context.Context, className string) (ma
 	return nil, nil
 }
 
+func (n *NilMigrator) TenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error {
 	return nil
 }
@@ -213,6 +222,10 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, pa
 	return nil, nil
 }
 
+func (n *NilMigrator) MoveSharedTenant(ctx context.Context, class *models.Class, tenant, from, to string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -225,6 +238,14 @@ func (n *NilMigrator) DropProperty(ctx context.Context, className string, propNa
 	return nil
 }
 
+func (n *NilMigrator) MigrateProperties(ctx context.Context, className string) error {
+	return nil
+}
+
+func (n *NilMigrator) ReindexProperty(ctx context.Context, className string, propName string) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateVectorIndexConfigUpdate(ctx context.Context, old, updated schemaent.VectorIndexConfig) error {
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -45,6 +46,7 @@ type indices struct {
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpShardUsage          *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
@@ -73,6 +75,8 @@ const (
 		`\/shards\/(` + sh + `)\/references`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternShardUsage = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/usage`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShard = `\/indices\/(` + cl + `)` +
@@ -111,9 +115,12 @@ type shards interface {
 		filters *filters.LocalFilter) ([]uint64, error)
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
+	UpdateObjectBatch(ctx context.Context, indexName, shardName string,
+		docIDs []uint64, update objects.MergeDocument, dryRun bool) objects.BatchSimpleObjects
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string) error
+	GetShardUsage(ctx context.Context, indexName, shardName string) (map[string]models.TenantUsage, error)
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -143,6 +150,7 @@ func NewIndices(shards shards, db db) *indices {
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
+		regexpShardUsage:          regexp.MustCompile(urlPatternShardUsage),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
@@ -221,6 +229,10 @@ func (i *indices) Indices() http.Handler {
 				i.deleteObjects().ServeHTTP(w, r)
 				return
 			}
+			if r.Method == http.MethodPatch {
+				i.patchObjects().ServeHTTP(w, r)
+				return
+			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
@@ -245,6 +257,14 @@ func (i *indices) Indices() http.Handler {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case i.regexpShardUsage.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getShardUsage().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.postShardFile().ServeHTTP(w, r)
@@ -321,7 +341,7 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 	}
 
 	if err := i.shards.PutObject(r.Context(), index, shard, obj); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -469,7 +489,8 @@ func (i *indices) deleteObject() http.Handler {
 
 		err := i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id))
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, err)
+			return
 		}
 
 		w.WriteHeader(http.StatusNoContent)
@@ -507,7 +528,7 @@ func (i *indices) mergeObject() http.Handler {
 		}
 
 		if err := i.shards.MergeObject(r.Context(), index, shard, mergeDoc); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, err)
 			return
 		}
 
@@ -890,6 +911,51 @@ func (i *indices) deleteObjects() http.Handler {
 	})
 }
 
+func (i *indices) patchObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjects.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.BatchUpdateParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		docIDs, update, dryRun, err := IndicesPayloads.BatchUpdateParams.
+			Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal batch update params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results := i.shards.UpdateObjectBatch(r.Context(), index, shard, docIDs, update, dryRun)
+
+		resBytes, err := IndicesPayloads.BatchDeleteResults.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.BatchDeleteResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) getGetShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -917,6 +983,35 @@ func (i *indices) getGetShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) getShardUsage() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardUsage.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		usage, err := i.shards.GetShardUsage(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		usageBytes, err := IndicesPayloads.GetShardUsageResults.Marshal(usage)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.GetShardUsageResults.SetContentTypeHeader(w)
+		w.Write(usageBytes)
+	})
+}
+
 func (i *indices) postUpdateShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
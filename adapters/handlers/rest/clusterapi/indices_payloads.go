@@ -24,10 +24,12 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var IndicesPayloads = indicesPayloads{}
@@ -47,12 +49,15 @@ type indicesPayloads struct {
 	FindDocIDsResults         findDocIDsResultsPayload
 	BatchDeleteParams         batchDeleteParamsPayload
 	BatchDeleteResults        batchDeleteResultsPayload
+	BatchUpdateParams         batchUpdateParamsPayload
 	GetShardStatusParams      getShardStatusParamsPayload
 	GetShardStatusResults     getShardStatusResultsPayload
 	UpdateShardStatusParams   updateShardStatusParamsPayload
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
+	GetShardUsageResults      getShardUsageResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	SplitShards               splitShardsPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -79,6 +84,20 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+type splitShardsPayload struct{}
+
+func (p splitShardsPayload) Marshall(split sharding.Split) ([]byte, error) {
+	return json.Marshal(split)
+}
+
+func (p splitShardsPayload) Unmarshal(in []byte) (sharding.Split, error) {
+	var split sharding.Split
+	if err := json.Unmarshal(in, &split); err != nil {
+		return split, fmt.Errorf("unmarshal split shards payload: %w", err)
+	}
+	return split, nil
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
@@ -669,6 +688,45 @@ func (p batchDeleteResultsPayload) CheckContentTypeHeader(r *http.Response) (str
 	return ct, ct == p.MIME()
 }
 
+type batchUpdateParamsPayload struct{}
+
+func (p batchUpdateParamsPayload) Marshal(docIDs []uint64, update objects.MergeDocument,
+	dryRun bool,
+) ([]byte, error) {
+	type params struct {
+		DocIDs []uint64              `json:"docIDs"`
+		Update objects.MergeDocument `json:"update"`
+		DryRun bool                  `json:"dryRun"`
+	}
+
+	par := params{docIDs, update, dryRun}
+	return json.Marshal(par)
+}
+
+func (p batchUpdateParamsPayload) Unmarshal(in []byte) ([]uint64, objects.MergeDocument, bool, error) {
+	type params struct {
+		DocIDs []uint64              `json:"docIDs"`
+		Update objects.MergeDocument `json:"update"`
+		DryRun bool                  `json:"dryRun"`
+	}
+	var par params
+	err := json.Unmarshal(in, &par)
+	return par.DocIDs, par.Update, par.DryRun, err
+}
+
+func (p batchUpdateParamsPayload) MIME() string {
+	return "vnd.weaviate.batchupdateparams+json"
+}
+
+func (p batchUpdateParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p batchUpdateParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
 type getShardStatusParamsPayload struct{}
 
 func (p getShardStatusParamsPayload) MIME() string {
@@ -709,6 +767,31 @@ func (p getShardStatusResultsPayload) CheckContentTypeHeader(r *http.Response) (
 	return ct, ct == p.MIME()
 }
 
+type getShardUsageResultsPayload struct{}
+
+func (p getShardUsageResultsPayload) Unmarshal(in []byte) (map[string]models.TenantUsage, error) {
+	var out map[string]models.TenantUsage
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p getShardUsageResultsPayload) Marshal(in map[string]models.TenantUsage) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p getShardUsageResultsPayload) MIME() string {
+	return "application/vnd.weaviate.getshardusageresults+json"
+}
+
+func (p getShardUsageResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p getShardUsageResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type updateShardStatusParamsPayload struct{}
 
 func (p updateShardStatusParamsPayload) Marshal(targetStatus string) ([]byte, error) {
@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type replicator interface {
@@ -54,11 +55,17 @@ type replicator interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []replica.RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		req replica.HashTreeRequest) ([]replica.Digest, error)
+	DigestObjectsInLeaves(ctx context.Context, class, shardName string,
+		req replica.HashTreeRequest) ([]replica.RepairResponse, error)
 }
 
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalSplitShards(ctx context.Context, className string,
+		split sharding.Split) error
 }
 
 type replicatedIndices struct {
@@ -73,12 +80,18 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_overwrite`)
 	regxObjectsDigest = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/_digest`)
+	regxHashTree = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_hashtree`)
+	regxDigestLeaves = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_digest-leaves`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects`)
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/replication-factor:increase`)
+	regxSplitShards = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards:split`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
@@ -94,6 +107,22 @@ func (i *replicatedIndices) Indices() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxHashTree.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTree().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxDigestLeaves.MatchString(path): // before regxObjectsDigest, its prefix
+			if r.Method == http.MethodGet {
+				i.getDigestLeaves().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 		case regxObjectsDigest.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getObjectsDigest().ServeHTTP(w, r)
@@ -163,6 +192,15 @@ func (i *replicatedIndices) Indices() http.Handler {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxSplitShards.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.splitShards().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -252,6 +290,37 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) splitShards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxSplitShards.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		split, err := IndicesPayloads.SplitShards.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := i.scaler.LocalSplitShards(r.Context(), index, split); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
@@ -373,6 +442,76 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getHashTree() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTree.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		var req replica.HashTreeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "unmarshal hash tree params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.HashTreeLevel(r.Context(), index, shard, req)
+		if err != nil {
+			http.Error(w, "hash tree: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
+func (i *replicatedIndices) getDigestLeaves() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxDigestLeaves.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		var req replica.HashTreeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "unmarshal hash tree params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.DigestObjectsInLeaves(r.Context(), index, shard, req)
+		if err != nil {
+			http.Error(w, "digest objects in leaves: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxOverwriteObjects.FindStringSubmatch(r.URL.Path)
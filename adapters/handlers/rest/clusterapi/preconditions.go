@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// HeaderPreconditions carries the preconditions of a conditional write to the
+// node which stores the objects, see objects.Preconditions
+const HeaderPreconditions = "X-Weaviate-Preconditions"
+
+// SetPreconditionsHeader passes the preconditions ctx carries, if any, with r
+func SetPreconditionsHeader(ctx context.Context, r *http.Request) error {
+	p := objects.PreconditionsFrom(ctx)
+	if p == nil {
+		return nil
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshal preconditions: %w", err)
+	}
+	r.Header.Set(HeaderPreconditions, string(b))
+	return nil
+}
+
+// preconditions attaches the preconditions a request was sent with to its
+// context, so that the shard applying the write can check them
+func preconditions(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get(HeaderPreconditions)
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var p objects.Preconditions
+		if err := json.Unmarshal([]byte(header), &p); err != nil {
+			http.Error(w, "unmarshal preconditions: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(objects.WithPreconditions(r.Context(), p)))
+	})
+}
+
+// writeError answers a failed write, with 409 if the write failed because its
+// precondition wasn't met
+func writeError(w http.ResponseWriter, err error) {
+	if errors.As(err, &objects.ErrConflict{}) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestPreconditions(t *testing.T) {
+	var received objects.Preconditions
+	handler := preconditions(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = objects.PreconditionsFrom(r.Context())
+	}))
+
+	t.Run("unconditional", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/indices/C1/shards/S1/objects", nil)
+		require.Nil(t, SetPreconditionsHeader(context.Background(), req))
+		assert.Empty(t, req.Header.Get(HeaderPreconditions))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Nil(t, received)
+	})
+
+	t.Run("conditional", func(t *testing.T) {
+		p := objects.Preconditions{}
+		p.Add("73f2eb5f-5abf-447a-81ca-74b1dd168241", 1700000000000)
+		ctx := objects.WithPreconditions(context.Background(), p)
+
+		req := httptest.NewRequest(http.MethodPost, "/indices/C1/shards/S1/objects", nil)
+		require.Nil(t, SetPreconditionsHeader(ctx, req))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, p, received)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/indices/C1/shards/S1/objects", nil)
+		req.Header.Set(HeaderPreconditions, "{")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
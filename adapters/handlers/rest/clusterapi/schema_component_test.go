@@ -184,3 +184,21 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
+
+func (f *fakeScaleOutManager) MoveShard(ctx context.Context, className, shard,
+	source, target string, update func(*sharding.State) error,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) SplitShards(ctx context.Context, className string,
+	count int, update func(*sharding.State) error,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) ResumeSplit(ctx context.Context, className string,
+	update func(*sharding.State) error,
+) error {
+	return nil
+}
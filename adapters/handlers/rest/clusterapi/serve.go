@@ -17,6 +17,7 @@ import (
 	"net/http"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 func Serve(appState *state.State) {
@@ -36,13 +37,25 @@ func Serve(appState *state.State) {
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
 		http.StripPrefix("/schema/transactions/", schema.Transactions()))
+	if appState.SchemaConsensus != nil {
+		mux.Handle("/schema/consensus/propose",
+			NewConsensus(appState.SchemaConsensus).Propose())
+	}
 	mux.Handle("/classifications/transactions/",
 		http.StripPrefix("/classifications/transactions/",
 			classifications.Transactions()))
+	if appState.AuthzRepo != nil {
+		mux.Handle("/authz/transactions/",
+			http.StripPrefix("/authz/transactions/",
+				NewAuthz(appState.AuthzRepo.TxManager()).Transactions()))
+	}
+	mux.Handle("/federation/transactions/",
+		http.StripPrefix("/federation/transactions/",
+			NewFederation(appState.FederationRepo.TxManager()).Transactions()))
 
 	mux.Handle("/nodes/", nodes.Nodes())
-	mux.Handle("/indices/", indices.Indices())
-	mux.Handle("/replicas/indices/", replicatedIndices.Indices())
+	mux.Handle("/indices/", preconditions(indices.Indices()))
+	mux.Handle("/replicas/indices/", preconditions(replicatedIndices.Indices()))
 
 	mux.Handle("/backups/can-commit", backups.CanCommit())
 	mux.Handle("/backups/commit", backups.Commit())
@@ -50,7 +63,18 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/status", backups.Status())
 
 	mux.Handle("/", index())
-	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: tracing.Handler(compression(mux)),
+	}
+	if appState.ClusterTLS.Enabled() {
+		server.TLSConfig = appState.ClusterTLS.ServerConfig()
+		// certificates are provided by the tls config, so they can be rotated
+		server.ListenAndServeTLS("", "")
+		return
+	}
+	server.ListenAndServe()
 }
 
 func index() http.Handler {
@@ -38,6 +38,9 @@ type txPayload struct {
 
 type txHandler struct {
 	manager txManager
+	// unmarshal decodes the payload of incoming transactions, schema
+	// transactions are decoded if it is nil
+	unmarshal func(cluster.TransactionType, json.RawMessage) (interface{}, error)
 }
 
 func (h *txHandler) Transactions() http.Handler {
@@ -99,7 +102,11 @@ func (h *txHandler) incomingTransaction() http.Handler {
 			return
 		}
 
-		txPayload, err := ucs.UnmarshalTransaction(payload.Type, payload.Payload)
+		unmarshal := h.unmarshal
+		if unmarshal == nil {
+			unmarshal = ucs.UnmarshalTransaction
+		}
+		txPayload, err := unmarshal(payload.Type, payload.Payload)
 		if err != nil {
 			http.Error(w, errors.Wrap(err, "decode tx payload").Error(),
 				http.StatusInternalServerError)
@@ -123,15 +130,7 @@ func (h *txHandler) incomingTransaction() http.Handler {
 			http.Error(w, errors.Wrap(err, "open transaction").Error(), status)
 			return
 		}
-		if txType != ucs.ReadSchema {
-			w.WriteHeader(http.StatusCreated)
-			return
-		}
-
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-		}
+		// only read transactions respond with data
 		w.WriteHeader(http.StatusCreated)
 		w.Write(data)
 	})
@@ -36,9 +36,11 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	jobsrepo "github.com/weaviate/weaviate/adapters/repos/jobs"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	entschema "github.com/weaviate/weaviate/entities/schema"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
@@ -59,15 +61,19 @@ import (
 	modspellcheck "github.com/weaviate/weaviate/modules/text-spellcheck"
 	modcohere "github.com/weaviate/weaviate/modules/text2vec-cohere"
 	modcontextionary "github.com/weaviate/weaviate/modules/text2vec-contextionary"
+	modtext2vecgrpc "github.com/weaviate/weaviate/modules/text2vec-grpc"
 	modhuggingface "github.com/weaviate/weaviate/modules/text2vec-huggingface"
+	modonnx "github.com/weaviate/weaviate/modules/text2vec-onnx"
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	"github.com/weaviate/weaviate/usecases/admission"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -76,6 +82,8 @@ import (
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -109,6 +117,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	appState := startupRoutine(ctx)
 	setupGoProfiling(appState.ServerConfig.Config)
 
+	shutdownTracing, err := tracing.Init(ctx, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Error("could not initialize tracing, continuing without it")
+	}
+
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		// only monitoring tool supported at the moment is prometheus
 		go func() {
@@ -118,7 +133,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		}()
 	}
 
-	err := registerModules(appState)
+	err = registerModules(appState)
 	if err != nil {
 		appState.Logger.
 			WithField("action", "startup").WithError(err).
@@ -136,6 +151,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	// exports are streamed by their handler, the producer is never used
+	api.ApplicationVndApacheArrowStreamProducer = runtime.ByteStreamProducer()
 
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
@@ -145,8 +162,6 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger.WithField("action", "restapi_management").Infof(msg, args...)
 	}
 
-	clusterHttpClient := reasonableHttpClient()
-
 	var vectorRepo vectorRepo
 	var vectorMigrator migrate.Migrator
 	var migrator migrate.Migrator
@@ -156,10 +171,51 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Metrics = promMetrics
 	}
 
-	// TODO: configure http transport for efficient intra-cluster comm
+	clusterHttpClient := newClusterHttpClient(appState)
+
 	remoteIndexClient := clients.NewRemoteIndex(clusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(clusterHttpClient)
 	replicationClient := clients.NewReplicationClient(clusterHttpClient)
+	segmentTiering, err := newSegmentTiering(
+		appState.ServerConfig.Config.Persistence.SegmentTiering, appState.Metrics)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize segment tiering")
+		os.Exit(1)
+	}
+
+	tenantOffloading, err := newTenantOffloading(
+		appState.ServerConfig.Config.Persistence.TenantOffloading, appState.Modules)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize tenant offloading")
+		os.Exit(1)
+	}
+
+	var replicaRepair db.ReplicaRepair
+	if cfg := appState.ServerConfig.Config.ReplicaRepair; cfg.Enabled {
+		replicaRepair = db.ReplicaRepair{
+			Interval:          time.Duration(cfg.IntervalSeconds) * time.Second,
+			HashTreeHeight:    cfg.HashTreeHeight,
+			MaxBytesPerSecond: cfg.MaxBytesPerSecond,
+		}
+	}
+
+	var hintedHandoff db.HintedHandoff
+	if cfg := appState.ServerConfig.Config.HintedHandoff; cfg.Enabled {
+		hintedHandoff = db.HintedHandoff{
+			ReplayInterval: time.Duration(cfg.ReplayIntervalSeconds) * time.Second,
+			MaxAge:         time.Duration(cfg.MaxAgeSeconds) * time.Second,
+		}
+	}
+
+	var queryPlanner *traverser.QueryPlanner
+	if appState.ServerConfig.Config.QueryPlanner.Enabled {
+		queryPlanner = traverser.NewQueryPlanner()
+	}
+
 	repo, err := db.New(appState.Logger, db.Config{
 		ServerVersion:             config.ServerVersion,
 		GitHash:                   config.GitHash,
@@ -174,6 +230,20 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
+		FilterCacheMaxEntries:     appState.ServerConfig.Config.Persistence.FilterCacheMaxEntries,
+		SegmentTiering:            segmentTiering,
+		SegmentTieringClasses:     appState.ServerConfig.Config.Persistence.SegmentTiering.Classes,
+		DistanceMetrics:           appState.Modules,
+		AsyncIndexing:             appState.ServerConfig.Config.Persistence.AsyncIndexing.Enabled,
+		AsyncIndexingWorkers:      appState.ServerConfig.Config.Persistence.AsyncIndexing.Workers,
+		TenantOffloading:          tenantOffloading,
+		ReplicaRepair:             replicaRepair,
+		HintedHandoff:             hintedHandoff,
+		KeyManager:                configureKeyManager(ctx, appState),
+		PerTenantKeys:             appState.ServerConfig.Config.EncryptionAtRest.PerTenantKeys,
+		QueryPlanner:              queryPlanner,
+		PropertyStats:             appState.ServerConfig.Config.PropertyStats.Enabled,
+		ShardCircuitBreaker:       appState.ServerConfig.Config.ShardCircuitBreaker,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -203,21 +273,36 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
-	// TODO: configure http transport for efficient intra-cluster comm
+	localJobsRepo, err := jobsrepo.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize jobs repo")
+		os.Exit(1)
+	}
+	appState.Jobs, err = jobs.NewManager(localJobsRepo, appState.Authorizer,
+		appState.ServerConfig.Config.Jobs, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not load jobs")
+		os.Exit(1)
+	}
+
 	classificationsTxClient := clients.NewClusterClassifications(clusterHttpClient)
 	classifierRepo := classifications.NewDistributeRepo(classificationsTxClient,
 		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
-	scaler := scaler.New(appState.Cluster, vectorRepo,
+	scaler := scaler.New(appState.Cluster, vectorRepo, repo, repo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
 	appState.Scaler = scaler
 
-	// TODO: configure http transport for efficient intra-cluster comm
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
-		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
+		parseVectorIndexConfig(appState.Modules), appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, schemaTxClient, scaler,
 	)
 	if err != nil {
@@ -228,6 +313,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 
 	appState.SchemaManager = schemaManager
+	appState.SchemaConsensus = configureSchemaConsensus(appState, schemaTxClient)
 
 	appState.RemoteIndexIncoming = sharding.NewRemoteIndexIncoming(repo)
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
@@ -247,8 +333,25 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	go clusterapi.Serve(appState)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
+	repo.SetTenantPromoter(schemaManager)
 	explorer.SetSchemaGetter(schemaManager)
 	appState.Modules.SetSchemaGetter(schemaManager)
+	appState.EmbeddingCache = configureEmbeddingCache(ctx, appState)
+	if appState.EmbeddingCache != nil {
+		appState.Modules.SetEmbeddingCache(appState.EmbeddingCache)
+	}
+	if cfg := appState.ServerConfig.Config.QueryVectorCache; cfg.Enabled {
+		appState.QueryVectorCache = modules.NewQueryVectorCache(cfg.MaxEntries,
+			time.Duration(cfg.TTLSeconds)*time.Second, appState.Metrics)
+		appState.Modules.SetQueryVectorCache(appState.QueryVectorCache)
+	}
+	if cfg := appState.ServerConfig.Config.QueryResultCache; cfg.Enabled {
+		appState.QueryResultCache = traverser.NewResultCache(int64(cfg.MaxMegabytes)*1024*1024,
+			time.Duration(cfg.TTLSeconds)*time.Second, cfg.Classes, appState.Metrics)
+		appState.QueryResultCache.SetLocalReads(repo)
+		explorer.SetResultCache(appState.QueryResultCache)
+		repo.AddShardChangeRecorder(appState.QueryResultCache)
+	}
 
 	err = vectorRepo.WaitForStartup(ctx)
 	if err != nil {
@@ -259,6 +362,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	if appState.SchemaConsensus != nil {
+		if err := appState.SchemaConsensus.Open(ctx); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "startup").
+				Fatal("could not open schema raft log")
+			os.Exit(1)
+		}
+	}
+
 	objectsManager := objects.NewManager(appState.Locks,
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorRepo, appState.Modules,
@@ -267,21 +380,101 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
 
+	appState.AuditLog = configureAuditLog(appState)
+	schemaManager.SetAuditLogger(appState.AuditLog)
+	backupScheduler.SetAuditLogger(appState.AuditLog)
+	objectsManager.SetAuditLogger(appState.AuditLog)
+	batchObjectsManager.SetAuditLogger(appState.AuditLog)
+	schemaManager.SetJobs(appState.Jobs)
+	backupScheduler.SetJobs(appState.Jobs)
+
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	appState.SlowQueryLog = slowquery.New(
+		appState.ServerConfig.Config.SlowQueryLog, appState.Logger)
+	objectsTraverser.SetSlowQueryLog(appState.SlowQueryLog)
+	objectsTraverser.SetAliasResolver(schemaManager)
 	appState.Traverser = objectsTraverser
 
+	appState.AdmissionControl = admission.New(
+		appState.ServerConfig.Config.AdmissionControl, appState.Metrics)
+
+	// federation peers and the standby cluster are not members of this
+	// cluster, they must not use the intra-cluster TLS settings
+	externalHttpClient := reasonableHttpClient()
+	federationResolver, err := setupFederation(api, appState, externalHttpClient)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize federation peers")
+		os.Exit(1)
+	}
+	schemaManager.SetFederatedRefValidator(federationResolver)
+	objectsManager.SetRemoteRefResolver(federationResolver)
+	batchObjectsManager.SetRemoteRefResolver(federationResolver)
+	batchObjectsManager.SetObjectMerger(objectsManager)
+	batchObjectsManager.SetDuplicateIndex(repo)
+	appState.ObjectsManager = objectsManager
+	appState.BatchManager = batchObjectsManager
+	appState.FederationResolver = federationResolver
+
+	setupReferenceIntegrity(api, appState, repo, objectsManager)
+	setupNearDuplicates(api, appState, repo, objectsManager)
+	setupSegmentTiering(api, appState, repo)
+	setupClassProfile(api, appState, repo)
+	setupSuggest(api, appState, repo)
+	setupMultiGetObjects(api, objectsManager)
+	if err := setupBlobs(api, appState, objectsManager, batchObjectsManager); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize blob storage")
+		os.Exit(1)
+	}
+	setupTenantOffloading(api, appState, repo)
+	setupBatchStream(api, appState)
+	setupClusterShards(api, schemaManager)
+	setupAliases(api, schemaManager)
+	setupRename(api, schemaManager)
+	setupPropertyDelete(api, schemaManager)
+	setupPropertyTokenization(api, schemaManager)
+	setupSynonyms(api, schemaManager)
+	setupStopwords(api, schemaManager)
+	setupTenantQuotas(api, schemaManager)
+	setupAdmissionControl(api, appState)
+	setupAuthz(api, appState)
+	setupAPIKeys(api, appState)
+	setupSlowQueries(api, appState)
+	setupExport(api, appState, repo)
+	setupBulkImport(api, appState)
+	setupJobs(api, appState)
+	setupQueryVectorCache(api, appState)
+	setupQueryResultCache(api, appState)
+
+	crossReplication := setupCrossClusterReplication(api, appState, repo, repo,
+		externalHttpClient)
+	if crossReplication != nil {
+		repo.AddChangeRecorder(crossReplication)
+		objectsManager.SetWriteGuard(crossReplication)
+		batchObjectsManager.SetWriteGuard(crossReplication)
+		crossReplication.Start()
+	}
+
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
 		appState.Logger, appState.Modules)
 
-	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
-	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
+	var graphQLRebuilder *graphQLRebuilder
+	if !appState.ServerConfig.Config.DisableGraphQL {
+		graphQLRebuilder = newGraphQLRebuilder(appState.Logger, appState, objectsTraverser)
+		schemaManager.Subscribe(graphQLRebuilder.onSchemaChange)
+		schemaManager.RegisterSchemaUpdateCallback(graphQLRebuilder.onSchemaUpdate)
+		graphQLRebuilder.start()
+	}
 
 	setupSchemaHandlers(api, schemaManager, appState.Metrics, appState.Logger)
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
-		appState.Modules, appState.Metrics)
+		appState.Modules, appState.Metrics, schemaManager)
 	setupObjectBatchHandlers(api, batchObjectsManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, schemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
@@ -314,11 +507,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	if len(reindexTaskNames) > 0 {
 		// start reindexing inverted indexes (if requested by user) in the background
 		// allowing db to complete api configuration and start handling requests
+		job := appState.Jobs.Track(jobs.TypeReindex,
+			strings.Join(reindexTaskNames, ","), reindexCtxCancel)
 		go func() {
 			appState.Logger.
 				WithField("action", "startup").
 				Info("Reindexing inverted indexes")
-			reindexFinished <- migrator.InvertedReindex(reindexCtx, reindexTaskNames...)
+			err := migrator.InvertedReindex(reindexCtx, reindexTaskNames...)
+			job.Done(err)
+			reindexFinished <- err
 		}()
 	}
 
@@ -331,12 +528,47 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
+		if crossReplication != nil {
+			crossReplication.Shutdown()
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		// stop applying schema changes before the db shuts down
+		if appState.SchemaConsensus != nil {
+			if err := appState.SchemaConsensus.Close(); err != nil {
+				appState.Logger.WithError(err).Error("could not close schema raft log")
+			}
+		}
+
 		if err := repo.Shutdown(ctx); err != nil {
 			panic(err)
 		}
+
+		if err := appState.AuditLog.Close(); err != nil {
+			appState.Logger.WithError(err).Error("could not close audit log")
+		}
+
+		if err := shutdownTracing(ctx); err != nil {
+			appState.Logger.WithError(err).Error("could not flush traces")
+		}
+
+		if appState.AuthzRepo != nil {
+			if err := appState.AuthzRepo.Shutdown(ctx); err != nil {
+				panic(err)
+			}
+		}
+
+		if err := appState.FederationRepo.Shutdown(ctx); err != nil {
+			appState.Logger.WithError(err).Error("could not close federation store")
+		}
+
+		if appState.EmbeddingCache != nil {
+			if err := appState.EmbeddingCache.Shutdown(ctx); err != nil {
+				appState.Logger.WithError(err).Error("could not close embedding cache")
+			}
+		}
 	}
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
@@ -356,8 +588,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 
 	// manually update schema once
-	schema := schemaManager.GetSchemaSkipAuth()
-	updateSchemaCallback(schema)
+	if graphQLRebuilder != nil {
+		graphQLRebuilder.rebuildNow(schemaManager.GetSchemaSkipAuth())
+	}
 
 	// Add dimensions to all the objects in the database, if requested by the user
 	if appState.ServerConfig.Config.ReindexVectorDimensionsAtStartup {
@@ -372,6 +605,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		migrator.RecountProperties(ctx)
 	}
 
+	// complete shard splits which were interrupted, e.g. by a restart
+	schemaManager.ResumeSplits()
+
 	startGrpcServer(grpcServer, appState)
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
@@ -425,7 +661,6 @@ func startupRoutine(ctx context.Context) *state.State {
 	appState.OIDC = configureOIDC(appState)
 	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
-	appState.Authorizer = configureAuthorizer(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("configured OIDC and anonymous access client")
@@ -443,6 +678,13 @@ func startupRoutine(ctx context.Context) *state.State {
 	}
 
 	appState.Cluster = clusterState
+	appState.ClusterTLS = configureClusterTLS(appState)
+
+	// the api keys are replicated, so they need the cluster
+	appState.AuthzRepo = configureAuthzRepo(ctx, appState)
+	appState.Authorizer = configureAuthorizer(appState, appState.AuthzRepo)
+	appState.APIKeys = configureAPIKeys(appState, appState.AuthzRepo)
+	appState.FederationRepo = configureFederationRepo(ctx, appState)
 
 	appState.Logger.
 		WithField("action", "startup").
@@ -516,6 +758,22 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modtext2vecgrpc.Name]; ok {
+		appState.Modules.Register(modtext2vecgrpc.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2vecgrpc.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modonnx.Name]; ok {
+		appState.Modules.Register(modonnx.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modonnx.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modrerankertransformers.Name]; ok {
 		appState.Modules.Register(modrerankertransformers.New())
 		appState.Logger.
@@ -691,17 +949,24 @@ func registerModules(appState *state.State) error {
 	return nil
 }
 
-func initModules(ctx context.Context, appState *state.State) error {
+func moduleInitParams(appState *state.State) (moduletools.ModuleInitParams, error) {
 	storageProvider, err := modulestorage.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err != nil {
-		return errors.Wrap(err, "init storage provider")
+		return nil, errors.Wrap(err, "init storage provider")
 	}
 
 	// TODO: gh-1481 don't pass entire appState in, but only what's needed. Probably only
 	// config?
-	moduleParams := moduletools.NewInitParams(storageProvider, appState,
-		appState.Logger)
+	return moduletools.NewInitParams(storageProvider, appState,
+		appState.Logger), nil
+}
+
+func initModules(ctx context.Context, appState *state.State) error {
+	moduleParams, err := moduleInitParams(appState)
+	if err != nil {
+		return err
+	}
 
 	appState.Logger.
 		WithField("action", "startup").
@@ -717,6 +982,27 @@ func initModules(ctx context.Context, appState *state.State) error {
 	return nil
 }
 
+// parseVectorIndexConfig extends the hnsw config parsing with the validation
+// of custom distance metrics provided by modules
+func parseVectorIndexConfig(modules *modules.Provider) schemaUC.VectorConfigParser {
+	return func(in interface{}) (entschema.VectorIndexConfig, error) {
+		parsed, err := enthnsw.ParseAndValidateConfig(in)
+		if err != nil {
+			return parsed, err
+		}
+
+		return parsed, modules.ValidateVectorIndexConfig(parsed)
+	}
+}
+
+// newClusterHttpClient returns the client used to call other nodes of this
+// cluster
+func newClusterHttpClient(appState *state.State) *http.Client {
+	t := clients.NewClusterTransport(appState.ServerConfig.Config.Cluster.Transport,
+		appState.ClusterTLS, appState.Metrics)
+	return &http.Client{Transport: tracing.Transport(t)}
+}
+
 func reasonableHttpClient() *http.Client {
 	t := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -730,7 +1016,7 @@ func reasonableHttpClient() *http.Client {
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
-	return &http.Client{Transport: t}
+	return &http.Client{Transport: tracing.Transport(t)}
 }
 
 func setupGoProfiling(config config.Config) {
@@ -15,20 +15,23 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
-	"github.com/sirupsen/logrus"
-	"github.com/weaviate/weaviate/adapters/handlers/graphql"
-	"github.com/weaviate/weaviate/adapters/handlers/graphql/utils"
+	"github.com/weaviate/weaviate/adapters/clients"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
-	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/adapters/repos/authz"
+	"github.com/weaviate/weaviate/adapters/repos/embeddingcache"
+	federationrepo "github.com/weaviate/weaviate/adapters/repos/federation"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
-	"github.com/weaviate/weaviate/usecases/config"
-	"github.com/weaviate/weaviate/usecases/modules"
-	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/encryption"
 )
 
 // As soon as server is initialized but not run yet, this function will be called.
@@ -40,42 +43,6 @@ import (
 // are only available within there
 var configureServer func(*http.Server, string, string)
 
-func makeUpdateSchemaCall(logger logrus.FieldLogger, appState *state.State, traverser *traverser.Traverser) func(schema.Schema) {
-	return func(updatedSchema schema.Schema) {
-		if appState.ServerConfig.Config.DisableGraphQL {
-			return
-		}
-
-		// Note that this is thread safe; we're running in a single go-routine, because the event
-		// handlers are called when the SchemaLock is still held.
-
-		gql, err := rebuildGraphQL(
-			updatedSchema,
-			logger,
-			appState.ServerConfig.Config,
-			traverser,
-			appState.Modules,
-		)
-		if err != nil && err != utils.ErrEmptySchema {
-			logger.WithField("action", "graphql_rebuild").
-				WithError(err).Error("could not (re)build graphql provider")
-		}
-		appState.GraphQL = gql
-	}
-}
-
-func rebuildGraphQL(updatedSchema schema.Schema, logger logrus.FieldLogger,
-	config config.Config, traverser *traverser.Traverser, modulesProvider *modules.Provider,
-) (graphql.GraphQL, error) {
-	updatedGraphQL, err := graphql.Build(&updatedSchema, traverser, logger, config, modulesProvider)
-	if err != nil {
-		return nil, err
-	}
-
-	logger.WithField("action", "graphql_rebuild").Debug("successfully rebuild graphql schema")
-	return updatedGraphQL, nil
-}
-
 // configureOIDC will always be called, even if OIDC is disabled, this way the
 // middleware will still be able to provide the user with a valuable error
 // message, even when OIDC is globally disabled.
@@ -106,8 +73,183 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 	return anonymous.New(appState.ServerConfig.Config)
 }
 
-func configureAuthorizer(appState *state.State) authorization.Authorizer {
-	return authorization.New(appState.ServerConfig.Config)
+// configureAuthzRepo opens the store of roles and api keys, it returns nil if
+// neither role based access control nor dynamic api keys are enabled. It
+// needs the cluster state to replicate the keys.
+func configureAuthzRepo(ctx context.Context, appState *state.State) *authz.DistributedRepo {
+	cfg := appState.ServerConfig.Config
+	if !cfg.Authorization.RBAC.Enabled && !cfg.Authentication.APIKey.Dynamic {
+		return nil
+	}
+
+	repo, err := authz.NewRepo(ctx, cfg.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not open authz store")
+		os.Exit(1)
+	}
+	return authz.NewDistributedRepo(clients.NewClusterAuthz(newClusterHttpClient(appState)),
+		appState.Cluster, repo, appState.Logger)
+}
+
+// configureFederationRepo opens the store of the federation peers registered
+// through the api. It needs the cluster state to replicate the peers.
+func configureFederationRepo(ctx context.Context, appState *state.State) *federationrepo.DistributedRepo {
+	repo, err := federationrepo.NewRepo(ctx,
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not open federation store")
+		os.Exit(1)
+	}
+	return federationrepo.NewDistributedRepo(
+		clients.NewClusterFederation(newClusterHttpClient(appState)),
+		appState.Cluster, repo, appState.Logger)
+}
+
+// configureEmbeddingCache opens the store of cached vectorizer results, it
+// returns nil if the embedding cache is disabled
+func configureEmbeddingCache(ctx context.Context, appState *state.State) *embeddingcache.Repo {
+	cfg := appState.ServerConfig.Config
+	if !cfg.EmbeddingCache.Enabled {
+		return nil
+	}
+
+	repo, err := embeddingcache.NewRepo(ctx, cfg.Persistence.DataPath,
+		time.Duration(cfg.EmbeddingCache.TTLSeconds)*time.Second,
+		cfg.EmbeddingCache.MaxEntries, appState.Logger, appState.Metrics)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not open embedding cache")
+		os.Exit(1)
+	}
+	return repo
+}
+
+// configureKeyManager returns the provider of the keys to encrypt the data
+// at rest with, nil if encryption at rest is disabled. A key management
+// module is initialized right away, as the keys are needed to open the
+// database before the other modules are initialized.
+func configureKeyManager(ctx context.Context, appState *state.State) modulecapabilities.KeyManager {
+	cfg := appState.ServerConfig.Config
+	if !cfg.EncryptionAtRest.Enabled {
+		return nil
+	}
+
+	logger := appState.Logger.WithField("action", "startup")
+	if name := cfg.EncryptionAtRest.KeyManager; name != "" {
+		params, err := moduleInitParams(appState)
+		if err != nil {
+			logger.WithError(err).Fatal("could not init key manager module")
+			os.Exit(1)
+		}
+		manager, err := appState.Modules.InitKeyManager(ctx, name, params)
+		if err != nil {
+			logger.WithError(err).Fatal("could not init key manager module")
+			os.Exit(1)
+		}
+		return manager
+	}
+
+	masterKey, err := cfg.EncryptionAtRest.DecodeMasterKey()
+	if err != nil {
+		logger.WithError(err).Fatal("invalid encryption master key")
+		os.Exit(1)
+	}
+	manager, err := encryption.NewLocalKeyManager(
+		filepath.Join(cfg.Persistence.DataPath, "encryption_keys"), masterKey)
+	if err != nil {
+		logger.WithError(err).Fatal("could not open encryption keys")
+		os.Exit(1)
+	}
+	return manager
+}
+
+// configureClusterTLS loads the certificates for intra-cluster traffic and
+// keeps reloading them for the lifetime of the process, so they can be
+// rotated without a restart
+func configureClusterTLS(appState *state.State) *cluster.TLS {
+	cfg := appState.ServerConfig.Config.Cluster.TLS
+	if !cfg.Enabled {
+		return nil
+	}
+
+	clusterTLS, err := cluster.NewTLS(cfg, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not load cluster certificates")
+		os.Exit(1)
+	}
+	go clusterTLS.Watch(context.Background())
+	return clusterTLS
+}
+
+// configureSchemaConsensus makes the schema manager append its transactions
+// to a raft log if raft is enabled. The log is opened once the db is ready.
+func configureSchemaConsensus(appState *state.State, client cluster.RaftClient) *cluster.Raft {
+	cfg := appState.ServerConfig.Config
+	if !cfg.Cluster.Raft.Enabled {
+		appState.Logger.WithField("action", "startup").
+			Warn("schema changes use the two-phase commit, set " +
+				"CLUSTER_RAFT_BOOTSTRAP_NODES on all nodes to migrate to the raft log")
+		return nil
+	}
+
+	consensus := cluster.NewRaft(cfg.Cluster.Raft, cfg.Persistence.DataPath,
+		appState.Cluster, client, appState.ClusterTLS, appState.Logger)
+	appState.SchemaManager.SetConsensus(consensus)
+	return consensus
+}
+
+// configureAuthorizer loads the roles from disk if role based access control
+// is enabled, it is the only authorizer which needs persistence
+func configureAuthorizer(appState *state.State, repo *authz.DistributedRepo) authorization.Authorizer {
+	cfg := appState.ServerConfig.Config
+	if !cfg.Authorization.RBAC.Enabled {
+		return authorization.New(cfg)
+	}
+
+	var err error
+	appState.RBAC, err = rbac.New(cfg.Authorization.RBAC, repo)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not load roles")
+		os.Exit(1)
+	}
+	repo.SetRoles(appState.RBAC)
+
+	return appState.RBAC
+}
+
+// configureAPIKeys loads the api keys created through the api, if they are
+// enabled. They need the authorizer to be managed.
+func configureAPIKeys(appState *state.State, repo *authz.DistributedRepo) *apikey.Keys {
+	if !appState.ServerConfig.Config.Authentication.APIKey.Dynamic {
+		return nil
+	}
+
+	keys, err := apikey.NewKeys(repo, appState.Authorizer, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not load api keys")
+		os.Exit(1)
+	}
+	appState.APIKey.SetKeys(keys)
+	repo.SetAPIKeyFn(keys.Apply)
+
+	return keys
+}
+
+// configureAuditLog returns nil if the audit log is disabled, which is safe
+// to use and records nothing
+func configureAuditLog(appState *state.State) *audit.Logger {
+	l, err := audit.New(appState.ServerConfig.Config.AuditLog, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not initialize audit log")
+		os.Exit(1)
+	}
+	return l
 }
 
 func timeTillDeadline(ctx context.Context) string {
@@ -23,10 +23,14 @@
 //
 //	Consumes:
 //	  - application/json
+//	  - application/x-ndjson
 //	  - application/yaml
 //
 //	Produces:
+//	  - application/vnd.apache.arrow.stream
+//	  - application/octet-stream
 //	  - application/json
+//	  - application/x-ndjson
 //
 // swagger:meta
 package rest
@@ -135,6 +135,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorWithinGeoBoundingBox:
+		return filters.OperatorWithinGeoBoundingBox, nil
+	case models.WhereFilterOperatorWithinGeoPolygon:
+		return filters.OperatorWithinGeoPolygon, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
@@ -143,6 +147,14 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorPrefix:
+		return filters.OperatorPrefix, nil
+	case models.WhereFilterOperatorFuzzy:
+		return filters.OperatorFuzzy, nil
+	case models.WhereFilterOperatorContainsAny:
+		return filters.OperatorContainsAny, nil
+	case models.WhereFilterOperatorContainsAll:
+		return filters.OperatorContainsAll, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -168,5 +180,7 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueGeoBoundingBox == nil &&
+		in.ValueGeoPolygon == nil
 }
@@ -156,6 +156,60 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo bounding box filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft:     inputGeoCoordinates(0.6, 0.5),
+						BottomRight: inputGeoCoordinates(0.5, 0.6),
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoBoundingBox,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoBoundingBox{
+							TopLeft:     inputGeoCoordinates(0.6, 0.5),
+							BottomRight: inputGeoCoordinates(0.5, 0.6),
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Vertices: []*models.GeoCoordinates{
+							inputGeoCoordinates(0.5, 0.5),
+							inputGeoCoordinates(0.6, 0.6),
+							inputGeoCoordinates(0.5, 0.7),
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{Vertices: []*models.GeoCoordinates{
+							inputGeoCoordinates(0.5, 0.5),
+							inputGeoCoordinates(0.6, 0.6),
+							inputGeoCoordinates(0.5, 0.7),
+						}},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
 			{
 				name: "[deprected string] valid string filter",
 				input: &models.WhereFilter{
@@ -175,6 +229,101 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAny",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intField"),
+					},
+					Value: &filters.Value{
+						Value: []int{1, 2},
+						Type:  schema.DataTypeIntArray,
+					},
+				}},
+			},
+			{
+				name: "valid number array filter",
+				input: &models.WhereFilter{
+					Operator:         "ContainsAny",
+					ValueNumberArray: []float64{1.5, 2.5},
+					Path:             []string{"numberField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("numberField"),
+					},
+					Value: &filters.Value{
+						Value: []float64{1.5, 2.5},
+						Type:  schema.DataTypeNumberArray,
+					},
+				}},
+			},
+			{
+				name: "valid text array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueTextArray: []string{"foo", "bar"},
+					Path:           []string{"textField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("textField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"foo", "bar"},
+						Type:  schema.DataTypeTextArray,
+					},
+				}},
+			},
+			{
+				name: "valid date array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueDateArray: []string{"2023-01-01T00:00:00Z"},
+					Path:           []string{"dateField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("dateField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"2023-01-01T00:00:00Z"},
+						Type:  schema.DataTypeDateArray,
+					},
+				}},
+			},
+			{
+				name: "valid boolean array filter",
+				input: &models.WhereFilter{
+					Operator:          "ContainsAny",
+					ValueBooleanArray: []bool{true},
+					Path:              []string{"booleanField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("booleanField"),
+					},
+					Value: &filters.Value{
+						Value: []bool{true},
+						Type:  schema.DataTypeBooleanArray,
+					},
+				}},
+			},
 		}
 
 		for _, test := range tests {
@@ -202,6 +351,33 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'geoCoordinates' must be set"),
 			},
+			{
+				name: "geo bounding box missing corner",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft: inputGeoCoordinates(0.6, 0.5),
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBoundingBox: " +
+					"field 'bottomRight' must be set"),
+			},
+			{
+				name: "geo polygon with too few vertices",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Vertices: []*models.GeoCoordinates{
+							inputGeoCoordinates(0.5, 0.5),
+							inputGeoCoordinates(0.6, 0.6),
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoPolygon: " +
+					"field 'vertices' must contain at least 3 vertices"),
+			},
 			{
 				name: "geo missing distance object",
 				input: &models.WhereFilter{
@@ -305,6 +481,26 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("Like"),
 				expectedFilter: intFilterWithOp(filters.OperatorLike),
 			},
+			{
+				name:           "prefix",
+				input:          inputIntFilterWithOp("Prefix"),
+				expectedFilter: intFilterWithOp(filters.OperatorPrefix),
+			},
+			{
+				name:           "fuzzy",
+				input:          inputIntFilterWithOp("Fuzzy"),
+				expectedFilter: intFilterWithOp(filters.OperatorFuzzy),
+			},
+			{
+				name:           "contains any",
+				input:          inputIntFilterWithOp("ContainsAny"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAny),
+			},
+			{
+				name:           "contains all",
+				input:          inputIntFilterWithOp("ContainsAll"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAll),
+			},
 			{
 				name:           "not equal",
 				input:          inputIntFilterWithOp("NotEqual"),
@@ -515,6 +711,13 @@ func inputGeoRangeFilter(lat, lon, max float64) *models.WhereFilterGeoRange {
 	}
 }
 
+func inputGeoCoordinates(lat, lon float32) *models.GeoCoordinates {
+	return &models.GeoCoordinates{
+		Latitude:  ptFloat32(lat),
+		Longitude: ptFloat32(lon),
+	}
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
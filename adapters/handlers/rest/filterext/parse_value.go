@@ -116,6 +116,56 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo bounding box
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoBoundingBox == nil {
+			return nil, nil
+		}
+
+		if in.ValueGeoBoundingBox.TopLeft == nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: field 'topLeft' must be set")
+		}
+
+		if in.ValueGeoBoundingBox.BottomRight == nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: field 'bottomRight' must be set")
+		}
+
+		return valueFilter(filters.GeoBoundingBox{
+			TopLeft: &models.GeoCoordinates{
+				Latitude:  in.ValueGeoBoundingBox.TopLeft.Latitude,
+				Longitude: in.ValueGeoBoundingBox.TopLeft.Longitude,
+			},
+			BottomRight: &models.GeoCoordinates{
+				Latitude:  in.ValueGeoBoundingBox.BottomRight.Latitude,
+				Longitude: in.ValueGeoBoundingBox.BottomRight.Longitude,
+			},
+		}, schema.DataTypeGeoCoordinates), nil
+	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueGeoPolygon.Vertices) < filters.MinGeoPolygonVertices {
+			return nil, fmt.Errorf("valueGeoPolygon: field 'vertices' must contain at least %d vertices",
+				filters.MinGeoPolygonVertices)
+		}
+
+		vertices := make([]*models.GeoCoordinates, len(in.ValueGeoPolygon.Vertices))
+		for i, vertex := range in.ValueGeoPolygon.Vertices {
+			if vertex == nil {
+				return nil, fmt.Errorf("valueGeoPolygon: vertex %d must be set", i)
+			}
+			vertices[i] = &models.GeoCoordinates{
+				Latitude:  vertex.Latitude,
+				Longitude: vertex.Longitude,
+			}
+		}
+
+		return valueFilter(filters.GeoPolygon{Vertices: vertices},
+			schema.DataTypeGeoCoordinates), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
@@ -124,6 +174,50 @@ var valueExtractors = []valueExtractorFunc{
 
 		return valueFilter(*in.ValueString, schema.DataTypeString), nil
 	},
+	// int array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueIntArray == nil {
+			return nil, nil
+		}
+
+		values := make([]int, len(in.ValueIntArray))
+		for i, value := range in.ValueIntArray {
+			values[i] = int(value)
+		}
+		return valueFilter(values, schema.DataTypeIntArray), nil
+	},
+	// number array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueNumberArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueNumberArray, schema.DataTypeNumberArray), nil
+	},
+	// text array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueTextArray, schema.DataTypeTextArray), nil
+	},
+	// date array (as strings)
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueDateArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueDateArray, schema.DataTypeDateArray), nil
+	},
+	// boolean array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueBooleanArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueBooleanArray, schema.DataTypeBooleanArray), nil
+	},
 }
 
 func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/utils"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// graphQLRebuilder keeps the GraphQL provider in sync with the schema.
+//
+// Schema changes only take a copy of the changed classes, the rebuild itself
+// runs in the background and only regenerates the types of the changed
+// classes. Queries are served by the previous provider until the rebuild has
+// completed. Changes made while a rebuild is running are coalesced into a
+// single follow-up rebuild.
+type graphQLRebuilder struct {
+	logger    logrus.FieldLogger
+	appState  *state.State
+	builder   *graphql.Builder
+	trigger   chan struct{}
+	buildLock sync.Mutex // serializes builds
+
+	lock sync.Mutex
+	// copies of the classes of the latest schema
+	classes map[string]*models.Class
+	// classes changed since the latest copy was taken
+	changed    map[string]struct{}
+	changedAll bool
+	// latest copy of the schema which has not been built yet
+	pending *schema.Schema
+	// classes changed since the last build
+	invalid    map[string]struct{}
+	invalidAll bool
+}
+
+func newGraphQLRebuilder(logger logrus.FieldLogger, appState *state.State,
+	traverser graphql.Traverser,
+) *graphQLRebuilder {
+	return &graphQLRebuilder{
+		logger:   logger,
+		appState: appState,
+		builder: graphql.NewBuilder(traverser, logger,
+			appState.ServerConfig.Config, appState.Modules),
+		trigger: make(chan struct{}, 1),
+		classes: map[string]*models.Class{},
+		changed: map[string]struct{}{},
+		invalid: map[string]struct{}{},
+	}
+}
+
+func (r *graphQLRebuilder) start() {
+	go func() {
+		for range r.trigger {
+			r.rebuild()
+		}
+	}()
+}
+
+// onSchemaChange records which classes have to be rebuilt
+func (r *graphQLRebuilder) onSchemaChange(change schemaUC.Change) {
+	switch change.Type {
+	case schemaUC.TenantsAdded, schemaUC.TenantsDeleted, schemaUC.TenantsUpdated:
+		// tenants are not part of the graphql schema
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	switch change.Type {
+	case schemaUC.ClassRenamed, schemaUC.SchemaReplaced:
+		r.changedAll = true
+	default:
+		r.changed[change.Class] = struct{}{}
+	}
+}
+
+// onSchemaUpdate is called with the schema lock held, so it only copies the
+// changed classes and leaves the rebuild to the background
+func (r *graphQLRebuilder) onSchemaUpdate(updated schema.Schema) {
+	r.snapshot(updated)
+
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+		// a rebuild is already queued, it will pick up the latest copy
+	}
+}
+
+// rebuildNow builds the provider for the given schema and waits for it
+func (r *graphQLRebuilder) rebuildNow(updated schema.Schema) {
+	r.snapshot(updated)
+	r.rebuild()
+}
+
+func (r *graphQLRebuilder) snapshot(updated schema.Schema) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// an update without a preceding change could have changed anything
+	all := r.changedAll || len(r.changed) == 0
+
+	objects := &models.Schema{}
+	classes := map[string]*models.Class{}
+	if updated.Objects != nil {
+		*objects = *updated.Objects
+		objects.Classes = make([]*models.Class, len(updated.Objects.Classes))
+		for i, class := range updated.Objects.Classes {
+			cp, ok := r.classes[class.Class]
+			_, changed := r.changed[class.Class]
+			if all || changed || !ok {
+				cp = copyClass(class)
+				r.invalid[class.Class] = struct{}{}
+			}
+			objects.Classes[i] = cp
+			classes[class.Class] = cp
+		}
+	}
+
+	r.classes = classes
+	r.changed = map[string]struct{}{}
+	r.changedAll = false
+	r.invalidAll = r.invalidAll || all
+	r.pending = &schema.Schema{Objects: objects}
+}
+
+func (r *graphQLRebuilder) rebuild() {
+	r.buildLock.Lock()
+	defer r.buildLock.Unlock()
+
+	r.lock.Lock()
+	pending, invalid, invalidAll := r.pending, r.invalid, r.invalidAll
+	r.pending = nil
+	r.invalid = map[string]struct{}{}
+	r.invalidAll = false
+	r.lock.Unlock()
+
+	if pending == nil {
+		// already built by a concurrent rebuild
+		return
+	}
+
+	if invalidAll {
+		r.builder.InvalidateAll()
+	} else {
+		names := make([]string, 0, len(invalid))
+		for name := range invalid {
+			names = append(names, name)
+		}
+		r.builder.Invalidate(names...)
+	}
+
+	gql, err := r.builder.Build(pending)
+	if err != nil {
+		if errors.Is(err, utils.ErrEmptySchema) {
+			r.appState.SetGraphQL(nil)
+			return
+		}
+		// keep serving the previous provider
+		r.logger.WithField("action", "graphql_rebuild").
+			WithError(err).Error("could not (re)build graphql provider")
+		return
+	}
+
+	r.appState.SetGraphQL(gql)
+	r.logger.WithField("action", "graphql_rebuild").Debug("successfully rebuild graphql schema")
+}
+
+// copyClass copies a class, so that the schema manager changing it in place
+// does not affect the graphql types built from it
+func copyClass(class *models.Class) *models.Class {
+	cp := *class
+	cp.Properties = make([]*models.Property, len(class.Properties))
+	for i, prop := range class.Properties {
+		p := *prop
+		cp.Properties[i] = &p
+	}
+	return &cp
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modules"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func TestGraphQLRebuilder(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	appState := &state.State{
+		ServerConfig: &config.WeaviateConfig{},
+		Modules:      modules.NewProvider(),
+		Logger:       logger,
+	}
+	r := newGraphQLRebuilder(logger, appState, nil)
+	r.start()
+
+	live := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{
+			Class: "Manufacturer",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+			},
+		},
+		{
+			Class: "Car",
+			Properties: []*models.Property{
+				{Name: "horsepower", DataType: []string{"int"}},
+				{Name: "madeBy", DataType: []string{"Manufacturer"}},
+			},
+		},
+	}}}
+
+	fieldNames := func(t *testing.T, gql graphql.GraphQL, typeName string) []string {
+		res := gql.Resolve(context.Background(),
+			`{ __type(name: "`+typeName+`") { fields { name } } }`, "", nil)
+		require.Empty(t, res.Errors)
+		raw, err := json.Marshal(res.Data)
+		require.Nil(t, err)
+
+		var data struct {
+			Type struct {
+				Fields []struct{ Name string }
+			} `json:"__type"`
+		}
+		require.Nil(t, json.Unmarshal(raw, &data))
+		var names []string
+		for _, field := range data.Type.Fields {
+			names = append(names, field.Name)
+		}
+		return names
+	}
+
+	r.rebuildNow(live)
+	initial := appState.GetGraphQL()
+	require.NotNil(t, initial)
+	assert.Contains(t, fieldNames(t, initial, "Car"), "horsepower")
+
+	t.Run("tenant changes do not trigger a rebuild", func(t *testing.T) {
+		r.onSchemaChange(schemaUC.Change{Type: schemaUC.TenantsAdded, Class: "Car"})
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		assert.Empty(t, r.changed)
+		assert.False(t, r.changedAll)
+	})
+
+	t.Run("changed class is rebuilt in the background", func(t *testing.T) {
+		// the schema manager changes classes in place
+		car := live.Objects.Classes[1]
+		car.Properties = append(car.Properties,
+			&models.Property{Name: "color", DataType: schema.DataTypeText.PropString()})
+		r.onSchemaChange(schemaUC.Change{Type: schemaUC.PropertyAdded, Class: "Car", Property: "color"})
+		r.onSchemaUpdate(live)
+
+		assert.Eventually(t, func() bool {
+			return appState.GetGraphQL() != initial
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Contains(t, fieldNames(t, appState.GetGraphQL(), "Car"), "color")
+		// the previous provider is not affected by the change
+		assert.NotContains(t, fieldNames(t, initial, "Car"), "color")
+	})
+
+	t.Run("deleting all classes removes the provider", func(t *testing.T) {
+		live.Objects.Classes = nil
+		r.onSchemaChange(schemaUC.Change{Type: schemaUC.ClassDeleted, Class: "Car"})
+		r.onSchemaChange(schemaUC.Change{Type: schemaUC.ClassDeleted, Class: "Manufacturer"})
+		r.onSchemaUpdate(live)
+
+		assert.Eventually(t, func() bool {
+			return appState.GetGraphQL() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
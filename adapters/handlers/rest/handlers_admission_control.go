@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const admissionControlPath = "/v1/admission-control"
+
+type admissionControlHandlers struct {
+	controller *admission.Controller
+	authorizer authorization.Authorizer
+}
+
+// get returns the limits and the current load of this node
+func (h *admissionControlHandlers) get(params admin.AdmissionControlGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", "admission-control"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewAdmissionControlGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewAdmissionControlGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return admin.NewAdmissionControlGetOK().WithPayload(h.status())
+}
+
+// update replaces the limits of this node until it is restarted, the body
+// holds the complete config
+func (h *admissionControlHandlers) update(params admin.AdmissionControlUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "update", "admission-control"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewAdmissionControlUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewAdmissionControlUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.controller.Update(admissionControlConfig(params.Body)); err != nil {
+		return admin.NewAdmissionControlUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return admin.NewAdmissionControlUpdateOK().WithPayload(h.status())
+}
+
+func (h *admissionControlHandlers) status() *models.AdmissionControlStatus {
+	cfg := h.controller.Config()
+	return &models.AdmissionControlStatus{
+		Config: &models.AdmissionControlConfig{
+			Enabled:                  cfg.Enabled,
+			GlobalRequestsPerSecond:  int64(cfg.GlobalRequestsPerSecond),
+			GlobalBurst:              int64(cfg.GlobalBurst),
+			APIKeyRequestsPerSecond:  int64(cfg.APIKeyRequestsPerSecond),
+			APIKeyBurst:              int64(cfg.APIKeyBurst),
+			MaxConcurrentQueries:     int64(cfg.MaxConcurrentQueries),
+			MaxConcurrentBatches:     int64(cfg.MaxConcurrentBatches),
+			BatchQueueTimeoutSeconds: int64(cfg.BatchQueueTimeoutSeconds),
+		},
+		Stats: h.controller.Stats(),
+	}
+}
+
+func admissionControlConfig(cfg *models.AdmissionControlConfig) config.AdmissionControl {
+	if cfg == nil {
+		return config.AdmissionControl{}
+	}
+	return config.AdmissionControl{
+		Enabled:                  cfg.Enabled,
+		GlobalRequestsPerSecond:  int(cfg.GlobalRequestsPerSecond),
+		GlobalBurst:              int(cfg.GlobalBurst),
+		APIKeyRequestsPerSecond:  int(cfg.APIKeyRequestsPerSecond),
+		APIKeyBurst:              int(cfg.APIKeyBurst),
+		MaxConcurrentQueries:     int(cfg.MaxConcurrentQueries),
+		MaxConcurrentBatches:     int(cfg.MaxConcurrentBatches),
+		BatchQueueTimeoutSeconds: int(cfg.BatchQueueTimeoutSeconds),
+	}
+}
+
+// makeAddAdmissionControl rejects requests with 429 and a Retry-After hint
+// if the admission control does not accept them. The endpoint to change the
+// limits is exempt, so that an overloaded node can still be reconfigured.
+func makeAddAdmissionControl(controller *admission.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == admissionControlPath ||
+				strings.HasPrefix(r.URL.Path, "/v1/.well-known") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			release, err := controller.Admit(r.Context(), admissionKey(r), admissionKind(r))
+			var rejected admission.ErrRejected
+			switch {
+			case err == nil:
+				defer release()
+				next.ServeHTTP(w, r)
+			case errors.As(err, &rejected):
+				seconds := int(math.Ceil(rejected.RetryAfter.Seconds()))
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				writeErrorResponse(w, http.StatusTooManyRequests, err)
+			default: // the client is gone
+				writeErrorResponse(w, http.StatusServiceUnavailable, err)
+			}
+		})
+	}
+}
+
+// admissionKey identifies the client by its API key or token, only a hash
+// of it is kept in memory
+func admissionKey(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func admissionKind(r *http.Request) admission.Kind {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/batch") && r.Method != http.MethodGet:
+		return admission.KindBatch
+	case strings.HasPrefix(r.URL.Path, "/v1/graphql"),
+		r.URL.Path == "/v1/objects" && r.Method == http.MethodGet,
+		r.URL.Path == multiGetObjectsPath:
+		return admission.KindQuery
+	default:
+		return admission.KindOther
+	}
+}
+
+func setupAdmissionControl(api *operations.WeaviateAPI, appState *state.State) {
+	h := &admissionControlHandlers{
+		controller: appState.AdmissionControl,
+		authorizer: appState.Authorizer,
+	}
+	api.AdminAdmissionControlGetHandler = admin.AdmissionControlGetHandlerFunc(h.get)
+	api.AdminAdmissionControlUpdateHandler = admin.AdmissionControlUpdateHandlerFunc(h.update)
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestAdmissionControlMiddleware(t *testing.T) {
+	controller := admission.New(config.AdmissionControl{
+		Enabled:                 true,
+		APIKeyRequestsPerSecond: 1,
+		MaxConcurrentQueries:    1,
+	}, nil)
+
+	block, blocked := make(chan struct{}), make(chan struct{})
+	handler := makeAddAdmissionControl(controller)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/graphql" && r.Header.Get("Authorization") == "Bearer slow" {
+				close(blocked)
+				<-block
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+	serve := func(method, path, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if key != "" {
+			req.Header.Set("Authorization", "Bearer "+key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("api key rate", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/schema", "key1").Code)
+		rec := serve(http.MethodGet, "/v1/schema", "key1")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/schema", "key2").Code)
+	})
+
+	t.Run("exempt paths", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, admissionControlPath, "key1").Code)
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/.well-known/ready", "key1").Code)
+	})
+
+	t.Run("concurrent queries", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			serve(http.MethodPost, "/v1/graphql", "slow")
+			close(done)
+		}()
+		<-blocked
+
+		rec := serve(http.MethodPost, "/v1/graphql", "key3")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+		// requests other than queries are still served
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/meta", "key4").Code)
+
+		close(block)
+		<-done
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/graphql", "key5").Code)
+	})
+}
+
+func TestAdmissionKind(t *testing.T) {
+	for _, tc := range []struct {
+		method, path string
+		kind         admission.Kind
+	}{
+		{http.MethodPost, "/v1/batch/objects", admission.KindBatch},
+		{http.MethodPost, "/v1/batch/objects/stream", admission.KindBatch},
+		{http.MethodDelete, "/v1/batch/objects", admission.KindBatch},
+		{http.MethodPost, "/v1/graphql", admission.KindQuery},
+		{http.MethodPost, "/v1/graphql/batch", admission.KindQuery},
+		{http.MethodGet, "/v1/objects", admission.KindQuery},
+		{http.MethodGet, "/v1/objects/C/123", admission.KindOther},
+		{http.MethodPost, "/v1/objects", admission.KindOther},
+		{http.MethodPost, multiGetObjectsPath, admission.KindQuery},
+	} {
+		assert.Equal(t, tc.kind, admissionKind(httptest.NewRequest(tc.method, tc.path, nil)),
+			"%s %s", tc.method, tc.path)
+	}
+}
+
+func TestAdmissionControlEndpoint(t *testing.T) {
+	newHandlers := func(allowed string) *admissionControlHandlers {
+		return &admissionControlHandlers{
+			controller: admission.New(config.AdmissionControl{}, nil),
+			authorizer: fakeTenantAuthorizer{allowed: allowed},
+		}
+	}
+	get := func(h *admissionControlHandlers) *httptest.ResponseRecorder {
+		return writeResponse(h.get(admin.AdmissionControlGetParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, admissionControlPath, nil),
+		}, nil))
+	}
+	bind := func(body string) (admin.AdmissionControlUpdateParams, error) {
+		params := admin.NewAdmissionControlUpdateParams()
+		req := httptest.NewRequest(http.MethodPut, admissionControlPath, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		err := bindRequest(&params, req)
+		return params, err
+	}
+	update := func(h *admissionControlHandlers, body string) *httptest.ResponseRecorder {
+		params, err := bind(body)
+		require.Nil(t, err)
+		return writeResponse(h.update(params, nil))
+	}
+
+	h := newHandlers("update")
+	rec := update(h, `{"enabled": true, "max_concurrent_queries": 8}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status models.AdmissionControlStatus
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, config.AdmissionControl{Enabled: true, MaxConcurrentQueries: 8},
+		admissionControlConfig(status.Config))
+	assert.Equal(t, admissionControlConfig(status.Config), h.controller.Config())
+
+	assert.Equal(t, http.StatusUnprocessableEntity,
+		update(h, `{"enabled": true, "global_burst": -1}`).Code)
+	_, err := bind(`{`)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusForbidden, get(h).Code)
+
+	h = newHandlers("get")
+	assert.Equal(t, http.StatusOK, get(h).Code)
+	assert.Equal(t, http.StatusForbidden, update(h, `{}`).Code)
+}
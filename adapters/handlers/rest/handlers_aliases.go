@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/aliases"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type aliasManager interface {
+	SetAlias(ctx context.Context, principal *models.Principal, alias, class string) error
+	DeleteAlias(ctx context.Context, principal *models.Principal, alias string) error
+	GetAliases(ctx context.Context, principal *models.Principal) (map[string]string, error)
+}
+
+type aliasHandlers struct {
+	manager aliasManager
+}
+
+// list returns all aliases mapped to the classes they point to
+func (h *aliasHandlers) list(params aliases.AliasesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := h.manager.GetAliases(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return aliases.NewAliasesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return aliases.NewAliasesListInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	return aliases.NewAliasesListOK().WithPayload(list)
+}
+
+// get returns the class a single alias points to
+func (h *aliasHandlers) get(params aliases.AliasesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := h.manager.GetAliases(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return aliases.NewAliasesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return aliases.NewAliasesGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	class, ok := list[params.AliasName]
+	if !ok {
+		return aliases.NewAliasesGetNotFound().WithPayload(errPayloadFromSingleErr(
+			fmt.Errorf("alias %q not found", params.AliasName)))
+	}
+	return aliases.NewAliasesGetOK().
+		WithPayload(&models.ClassAlias{Alias: params.AliasName, Class: class})
+}
+
+// put creates an alias or repoints it to the class of the body
+func (h *aliasHandlers) put(params aliases.AliasesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if params.Body.Class == "" {
+		return aliases.NewAliasesPutBadRequest().WithPayload(errPayloadFromSingleErr(
+			fmt.Errorf("body must be of the form {\"class\": \"C\"}")))
+	}
+
+	err := h.manager.SetAlias(params.HTTPRequest.Context(), principal,
+		params.AliasName, params.Body.Class)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return aliases.NewAliasesPutNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return aliases.NewAliasesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return aliases.NewAliasesPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return aliases.NewAliasesPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return aliases.NewAliasesPutOK().
+		WithPayload(&models.ClassAlias{Alias: params.AliasName, Class: params.Body.Class})
+}
+
+func (h *aliasHandlers) delete(params aliases.AliasesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.DeleteAlias(params.HTTPRequest.Context(), principal, params.AliasName)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return aliases.NewAliasesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return aliases.NewAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return aliases.NewAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return aliases.NewAliasesDeleteNoContent()
+}
+
+func setupAliases(api *operations.WeaviateAPI, manager aliasManager) {
+	h := &aliasHandlers{manager: manager}
+	api.AliasesAliasesListHandler = aliases.AliasesListHandlerFunc(h.list)
+	api.AliasesAliasesGetHandler = aliases.AliasesGetHandlerFunc(h.get)
+	api.AliasesAliasesPutHandler = aliases.AliasesPutHandlerFunc(h.put)
+	api.AliasesAliasesDeleteHandler = aliases.AliasesDeleteHandlerFunc(h.delete)
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/aliases"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakeAliasManager struct {
+	aliases map[string]string
+}
+
+func (f *fakeAliasManager) SetAlias(ctx context.Context, principal *models.Principal,
+	alias, class string,
+) error {
+	if class != "ArticleV1" && class != "ArticleV2" {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("class %q does not exist", class))
+	}
+	f.aliases[alias] = class
+	return nil
+}
+
+func (f *fakeAliasManager) DeleteAlias(ctx context.Context, principal *models.Principal,
+	alias string,
+) error {
+	if _, ok := f.aliases[alias]; !ok {
+		return fmt.Errorf("alias %q: %w", alias, schemaUC.ErrNotFound)
+	}
+	delete(f.aliases, alias)
+	return nil
+}
+
+func (f *fakeAliasManager) GetAliases(ctx context.Context, principal *models.Principal,
+) (map[string]string, error) {
+	return f.aliases, nil
+}
+
+func TestAliases(t *testing.T) {
+	manager := &fakeAliasManager{aliases: map[string]string{}}
+	h := &aliasHandlers{manager: manager}
+	req := httptest.NewRequest(http.MethodGet, "/v1/aliases", nil)
+	put := func(alias, class string) int {
+		return writeResponse(h.put(aliases.AliasesPutParams{
+			HTTPRequest: req, AliasName: alias, Body: &models.ClassAlias{Class: class},
+		}, nil)).Code
+	}
+	get := func(alias string) *httptest.ResponseRecorder {
+		return writeResponse(h.get(aliases.AliasesGetParams{HTTPRequest: req, AliasName: alias}, nil))
+	}
+	del := func(alias string) int {
+		return writeResponse(h.delete(aliases.AliasesDeleteParams{HTTPRequest: req, AliasName: alias}, nil)).Code
+	}
+
+	assert.Equal(t, http.StatusOK, put("Article", "ArticleV1"))
+	assert.Equal(t, http.StatusOK, put("Article", "ArticleV2"))
+	assert.Equal(t, map[string]string{"Article": "ArticleV2"}, manager.aliases)
+
+	rec := get("Article")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got models.ClassAlias
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&got))
+	assert.Equal(t, models.ClassAlias{Alias: "Article", Class: "ArticleV2"}, got)
+
+	rec = writeResponse(h.list(aliases.AliasesListParams{HTTPRequest: req}, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"Article": "ArticleV2"}`, rec.Body.String())
+
+	assert.Equal(t, http.StatusUnprocessableEntity, put("Article", "Other"))
+	assert.Equal(t, http.StatusBadRequest, put("Article", ""))
+
+	assert.Equal(t, http.StatusNoContent, del("Article"))
+	assert.Equal(t, http.StatusNotFound, del("Article"))
+	assert.Equal(t, http.StatusNotFound, get("Article").Code)
+}
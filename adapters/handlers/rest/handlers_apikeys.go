@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+var errAPIKeysDisabled = errors.New("dynamic api keys are disabled, " +
+	"set AUTHENTICATION_APIKEY_DYNAMIC_ENABLED to enable them")
+
+type apiKeyHandlers struct {
+	keys *apikey.Keys // nil if dynamic api keys are disabled
+}
+
+func (h *apiKeyHandlers) list(params apikeys.ApikeysListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.keys == nil {
+		return apikeys.NewApikeysListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeysDisabled))
+	}
+
+	keys, err := h.keys.List(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.APIKey, len(keys))
+	for i := range keys {
+		payload[i] = apiKeyPayload(keys[i], "")
+	}
+	return apikeys.NewApikeysListOK().WithPayload(payload)
+}
+
+// create returns the token of the new key, it can't be retrieved later on
+func (h *apiKeyHandlers) create(params apikeys.ApikeysCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.keys == nil {
+		return apikeys.NewApikeysCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeysDisabled))
+	}
+
+	req := apikey.CreateKeyRequest{
+		User:      params.Body.User,
+		Scopes:    params.Body.Scopes,
+		ExpiresAt: timeFromDateTime(params.Body.ExpiresAt),
+	}
+	key, token, err := h.keys.Create(principal, req)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return apikeys.NewApikeysCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return apikeys.NewApikeysCreateCreated().WithPayload(apiKeyPayload(key, token))
+}
+
+// revoke disables a key, it still shows up in the list afterwards
+func (h *apiKeyHandlers) revoke(params apikeys.ApikeysRevokeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.keys == nil {
+		return apikeys.NewApikeysRevokeUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeysDisabled))
+	}
+
+	if err := h.keys.Revoke(principal, params.ID); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysRevokeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return apikeys.NewApikeysRevokeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return apikeys.NewApikeysRevokeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysRevokeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return apikeys.NewApikeysRevokeNoContent()
+}
+
+func (h *apiKeyHandlers) rotate(params apikeys.ApikeysRotateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.keys == nil {
+		return apikeys.NewApikeysRotateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errAPIKeysDisabled))
+	}
+
+	key, token, err := h.keys.Rotate(principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysRotateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return apikeys.NewApikeysRotateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return apikeys.NewApikeysRotateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysRotateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return apikeys.NewApikeysRotateOK().WithPayload(apiKeyPayload(key, token))
+}
+
+// apiKeyPayload never contains the hash of the key, the token is only set
+// on creation and rotation
+func apiKeyPayload(key apikey.Key, token string) *models.APIKey {
+	return &models.APIKey{
+		ID:         key.ID,
+		User:       key.User,
+		Scopes:     key.Scopes,
+		CreatedAt:  strfmt.DateTime(key.CreatedAt),
+		ExpiresAt:  dateTimeFromTime(key.ExpiresAt),
+		RotatedAt:  dateTimeFromTime(key.RotatedAt),
+		RevokedAt:  dateTimeFromTime(key.RevokedAt),
+		LastUsedAt: dateTimeFromTime(key.LastUsedAt),
+		Token:      token,
+	}
+}
+
+func dateTimeFromTime(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+	dt := strfmt.DateTime(*t)
+	return &dt
+}
+
+func timeFromDateTime(dt *strfmt.DateTime) *time.Time {
+	if dt == nil {
+		return nil
+	}
+	t := time.Time(*dt)
+	return &t
+}
+
+func setupAPIKeys(api *operations.WeaviateAPI, appState *state.State) {
+	h := &apiKeyHandlers{keys: appState.APIKeys}
+	api.ApikeysApikeysListHandler = apikeys.ApikeysListHandlerFunc(h.list)
+	api.ApikeysApikeysCreateHandler = apikeys.ApikeysCreateHandlerFunc(h.create)
+	api.ApikeysApikeysRevokeHandler = apikeys.ApikeysRevokeHandlerFunc(h.revoke)
+	api.ApikeysApikeysRotateHandler = apikeys.ApikeysRotateHandlerFunc(h.rotate)
+}
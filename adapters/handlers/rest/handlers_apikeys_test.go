@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/repos/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func TestAPIKeyEndpoints(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo, err := authz.NewRepo(context.Background(), t.TempDir(), logger)
+	require.Nil(t, err)
+	defer repo.Shutdown(context.Background())
+
+	keys, err := apikey.NewKeys(repo, &authorization.DummyAuthorizer{}, logger)
+	require.Nil(t, err)
+	h := &apiKeyHandlers{keys: keys}
+	req := httptest.NewRequest(http.MethodGet, "/v1/apikeys", nil)
+	create := func(body *models.APIKeyCreateRequest) *httptest.ResponseRecorder {
+		return writeResponse(h.create(apikeys.ApikeysCreateParams{HTTPRequest: req, Body: body}, nil))
+	}
+
+	rec := create(&models.APIKeyCreateRequest{User: "alice", Scopes: []string{"read"}})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		apikey.Key
+		Token string `json:"token"`
+	}
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&created))
+	assert.Equal(t, "alice", created.User)
+	assert.NotEmpty(t, created.Token)
+	assert.Empty(t, created.Hash)
+	assert.Nil(t, created.RevokedAt)
+
+	rec = create(&models.APIKeyCreateRequest{User: "alice", Scopes: []string{"all"}})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = writeResponse(h.rotate(apikeys.ApikeysRotateParams{HTTPRequest: req, ID: created.ID}, nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var rotated models.APIKey
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&rotated))
+	assert.Equal(t, created.ID, rotated.ID)
+	assert.NotEqual(t, created.Token, rotated.Token)
+
+	rec = writeResponse(h.revoke(apikeys.ApikeysRevokeParams{HTTPRequest: req, ID: created.ID}, nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = writeResponse(h.revoke(apikeys.ApikeysRevokeParams{HTTPRequest: req, ID: "missing"}, nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = writeResponse(h.list(apikeys.ApikeysListParams{HTTPRequest: req}, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list []apikey.Key
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Len(t, list, 1)
+	assert.NotNil(t, list[0].RevokedAt)
+	assert.Empty(t, list[0].Hash)
+}
+
+func TestAPIKeyEndpointsDisabled(t *testing.T) {
+	h := &apiKeyHandlers{}
+	res := h.list(apikeys.ApikeysListParams{}, &models.Principal{})
+	assert.IsType(t, &apikeys.ApikeysListUnprocessableEntity{}, res)
+}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+var errRBACDisabled = errors.New("role based access control is disabled, " +
+	"set AUTHORIZATION_RBAC_ENABLED to enable it")
+
+type authzHandlers struct {
+	authorizer *rbac.Authorizer // nil if role based access control is disabled
+}
+
+func (h *authzHandlers) listRoles(params authz.AuthzRolesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzRolesListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	roles, err := h.authorizer.Roles(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return authz.NewAuthzRolesListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.Role, len(roles))
+	for i := range roles {
+		payload[i] = rolePayload(roles[i])
+	}
+	return authz.NewAuthzRolesListOK().WithPayload(payload)
+}
+
+func (h *authzHandlers) getRole(params authz.AuthzRolesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzRolesGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	role, err := h.authorizer.Role(principal, params.RoleName)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return authz.NewAuthzRolesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return authz.NewAuthzRolesGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewAuthzRolesGetOK().WithPayload(rolePayload(role))
+}
+
+// putRole creates the role or replaces all of its permissions
+func (h *authzHandlers) putRole(params authz.AuthzRolesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzRolesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	role := rbac.Role{Name: params.RoleName}
+	for _, perm := range params.Body.Permissions {
+		if perm == nil {
+			continue
+		}
+		role.Permissions = append(role.Permissions, rbac.Permission{
+			Action:   perm.Action,
+			Resource: perm.Resource,
+		})
+	}
+
+	if err := h.authorizer.PutRole(principal, role); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return authz.NewAuthzRolesPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewAuthzRolesPutOK().WithPayload(rolePayload(role))
+}
+
+func (h *authzHandlers) deleteRole(params authz.AuthzRolesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzRolesDeleteUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	if err := h.authorizer.DeleteRole(principal, params.RoleName); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return authz.NewAuthzRolesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return authz.NewAuthzRolesDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewAuthzRolesDeleteNoContent()
+}
+
+func (h *authzHandlers) getUserRoles(params authz.AuthzUsersRolesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzUsersRolesGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	roles, err := h.authorizer.UserRoles(principal, params.UserName)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzUsersRolesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return authz.NewAuthzUsersRolesGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzUsersRolesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewAuthzUsersRolesGetOK().WithPayload(&models.UserRoles{Roles: roles})
+}
+
+// putUserRoles replaces all roles assigned to a user
+func (h *authzHandlers) putUserRoles(params authz.AuthzUsersRolesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if h.authorizer == nil {
+		return authz.NewAuthzUsersRolesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errRBACDisabled))
+	}
+
+	err := h.authorizer.AssignRoles(principal, params.UserName, params.Body.Roles)
+	if err == nil {
+		var roles []string
+		if roles, err = h.authorizer.UserRoles(principal, params.UserName); err == nil {
+			return authz.NewAuthzUsersRolesPutOK().WithPayload(&models.UserRoles{Roles: roles})
+		}
+	}
+
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}):
+		return authz.NewAuthzUsersRolesPutForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return authz.NewAuthzUsersRolesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return authz.NewAuthzUsersRolesPutInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func rolePayload(role rbac.Role) *models.Role {
+	payload := &models.Role{Name: role.Name, Permissions: []*models.Permission{}}
+	for _, perm := range role.Permissions {
+		payload.Permissions = append(payload.Permissions, &models.Permission{
+			Action:   perm.Action,
+			Resource: perm.Resource,
+		})
+	}
+	return payload
+}
+
+func setupAuthz(api *operations.WeaviateAPI, appState *state.State) {
+	h := &authzHandlers{authorizer: appState.RBAC}
+	api.AuthzAuthzRolesListHandler = authz.AuthzRolesListHandlerFunc(h.listRoles)
+	api.AuthzAuthzRolesGetHandler = authz.AuthzRolesGetHandlerFunc(h.getRole)
+	api.AuthzAuthzRolesPutHandler = authz.AuthzRolesPutHandlerFunc(h.putRole)
+	api.AuthzAuthzRolesDeleteHandler = authz.AuthzRolesDeleteHandlerFunc(h.deleteRole)
+	api.AuthzAuthzUsersRolesGetHandler = authz.AuthzUsersRolesGetHandlerFunc(h.getUserRoles)
+	api.AuthzAuthzUsersRolesPutHandler = authz.AuthzUsersRolesPutHandlerFunc(h.putUserRoles)
+}
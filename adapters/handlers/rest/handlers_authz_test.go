@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authzops "github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/adapters/repos/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+func TestAuthzEndpoints(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo, err := authz.NewRepo(context.Background(), t.TempDir(), logger)
+	require.Nil(t, err)
+	defer repo.Shutdown(context.Background())
+
+	authorizer, err := rbac.New(rbac.Config{Enabled: true, RootUsers: []string{"root"}}, repo)
+	require.Nil(t, err)
+	h := &authzHandlers{authorizer: authorizer}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/authz/roles", nil)
+	root := &models.Principal{Username: "root"}
+	reader := &models.Principal{Username: "reader"}
+	putRole := func(name, action string) *httptest.ResponseRecorder {
+		return writeResponse(h.putRole(authzops.AuthzRolesPutParams{
+			HTTPRequest: req, RoleName: name, Body: &models.Role{
+				Permissions: []*models.Permission{{Action: action, Resource: "classes/Article"}},
+			},
+		}, root))
+	}
+	assign := func(user string, roles ...string) *httptest.ResponseRecorder {
+		return writeResponse(h.putUserRoles(authzops.AuthzUsersRolesPutParams{
+			HTTPRequest: req, UserName: user, Body: &models.UserRoles{Roles: roles},
+		}, root))
+	}
+	userRoles := func(principal *models.Principal, user string) *httptest.ResponseRecorder {
+		return writeResponse(h.getUserRoles(authzops.AuthzUsersRolesGetParams{
+			HTTPRequest: req, UserName: user,
+		}, principal))
+	}
+
+	t.Run("create role", func(t *testing.T) {
+		rec := putRole("articles", "read")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = putRole("broken", "fly")
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	})
+
+	t.Run("list and get roles", func(t *testing.T) {
+		rec := writeResponse(h.listRoles(authzops.AuthzRolesListParams{HTTPRequest: req}, root))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var roles []rbac.Role
+		require.Nil(t, json.NewDecoder(rec.Body).Decode(&roles))
+		require.Len(t, roles, 1)
+		assert.Equal(t, "articles", roles[0].Name)
+
+		rec = writeResponse(h.getRole(authzops.AuthzRolesGetParams{
+			HTTPRequest: req, RoleName: "missing",
+		}, root))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = writeResponse(h.listRoles(authzops.AuthzRolesListParams{HTTPRequest: req}, reader))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("assign roles", func(t *testing.T) {
+		rec := assign("reader", "articles")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = userRoles(reader, "reader")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"roles": ["articles"]}`, rec.Body.String())
+
+		assert.Nil(t, authorizer.Authorize(reader, "get", "classes/Article"))
+		assert.NotNil(t, authorizer.Authorize(reader, "create", "classes/Article"))
+
+		rec = assign("reader", "missing")
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+		// anonymous users may not read the roles of others
+		rec = userRoles(nil, "reader")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("delete role", func(t *testing.T) {
+		rec := writeResponse(h.deleteRole(authzops.AuthzRolesDeleteParams{
+			HTTPRequest: req, RoleName: "articles",
+		}, root))
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		assert.NotNil(t, authorizer.Authorize(reader, "get", "classes/Article"))
+	})
+}
+
+func TestAuthzEndpointsDisabled(t *testing.T) {
+	h := &authzHandlers{}
+	rec := writeResponse(h.listRoles(authzops.AuthzRolesListParams{
+		HTTPRequest: httptest.NewRequest(http.MethodGet, "/v1/authz/roles", nil),
+	}, nil))
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
@@ -36,6 +36,11 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 		Backend: params.Backend,
 		Include: params.Body.Include,
 		Exclude: params.Body.Exclude,
+		BaseID:  params.Body.BaseID,
+		Tenants: ubak.TenantSelection{
+			Include: params.Body.IncludeTenants,
+			Exclude: params.Body.ExcludeTenants,
+		},
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -99,6 +104,11 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		Backend: params.Backend,
 		Include: params.Body.Include,
 		Exclude: params.Body.Exclude,
+		Tenants: ubak.TenantSelection{
+			Include: params.Body.IncludeTenants,
+			Exclude: params.Body.ExcludeTenants,
+			Rename:  params.Body.TenantNames,
+		},
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -12,6 +12,7 @@
 package rest
 
 import (
+	"context"
 	"errors"
 
 	middleware "github.com/go-openapi/runtime/middleware"
@@ -40,7 +41,9 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
+	ctx := withIDProperties(params.HTTPRequest.Context(), params.IDProperties)
+	ctx = withDeduplication(ctx, params)
+	objs, err := h.manager.AddObjects(ctx, principal,
 		params.Body.Objects, params.Body.Fields, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
@@ -65,6 +68,57 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		WithPayload(h.objectsResponse(objs))
 }
 
+func (h *batchObjectHandlers) mergeObjects(params batch.BatchObjectsMergeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchObjectsMergeBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	objs, err := h.manager.MergeObjects(params.HTTPRequest.Context(), principal,
+		params.Body, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return batch.NewBatchObjectsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchObjectsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewBatchObjectsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchObjectsMergeOK().
+		WithPayload(h.objectsResponse(objs))
+}
+
+// withDeduplication passes the dedup parameters on to the import, objects
+// are compared by their content unless dedupBy says otherwise
+func withDeduplication(ctx context.Context, params batch.BatchObjectsCreateParams) context.Context {
+	if params.Dedup == nil {
+		return ctx
+	}
+	d := objects.Deduplication{
+		Mode: objects.DedupMode(*params.Dedup),
+		By:   objects.DedupByContent,
+	}
+	if params.DedupBy != nil {
+		d.By = objects.DedupKey(*params.DedupBy)
+	}
+	if params.DedupLinkProperty != nil {
+		d.LinkProperty = *params.DedupLinkProperty
+	}
+	return objects.WithDeduplication(ctx, d)
+}
+
 func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
 	response := make([]*models.ObjectsGetResponse, len(input))
 	for i, object := range input {
@@ -79,8 +133,9 @@ func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*mod
 		response[i] = &models.ObjectsGetResponse{
 			Object: *object.Object,
 			Result: &models.ObjectsGetResponseAO2Result{
-				Errors: errorResponse,
-				Status: &status,
+				DuplicateOf: object.DuplicateOf,
+				Errors:      errorResponse,
+				Status:      &status,
 			},
 		}
 	}
@@ -98,7 +153,8 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body, repl)
+	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body,
+		params.Validation, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -235,6 +291,92 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
+func (h *batchObjectHandlers) updateObjects(params batch.BatchObjectsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchObjectsUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	res, err := h.manager.UpdateObjects(params.HTTPRequest.Context(), principal,
+		params.Body, repl, tenant)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &objects.ErrInvalidUserInput{}) {
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &objects.ErrMultiTenancy{}) {
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &autherrs.Forbidden{}) {
+			return batch.NewBatchObjectsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else {
+			return batch.NewBatchObjectsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchObjectsUpdateOK().
+		WithPayload(h.objectsUpdateResponse(res))
+}
+
+func (h *batchObjectHandlers) objectsUpdateResponse(input *objects.BatchUpdateResponse) *models.BatchUpdateResponse {
+	var successful, failed int64
+	output := input.Output
+	var objects []*models.BatchUpdateResponseResultsObjectsItems0
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS
+		if input.DryRun {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN
+		} else if obj.Err != nil {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusFAILED
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			failed += 1
+		} else {
+			successful += 1
+		}
+
+		if output == "minimal" &&
+			(status == models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS ||
+				status == models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN) {
+			// only add SUCCESS and DRYRUN results if output is "verbose"
+			continue
+		}
+
+		objects = append(objects, &models.BatchUpdateResponseResultsObjectsItems0{
+			ID:     obj.UUID,
+			Status: &status,
+			Errors: errorResponse,
+		})
+	}
+
+	response := &models.BatchUpdateResponse{
+		Match: &models.BatchUpdateResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		},
+		DryRun: &input.DryRun,
+		Output: &output,
+		Results: &models.BatchUpdateResponseResults{
+			Matches:    input.Result.Matches,
+			Limit:      input.Result.Limit,
+			Successful: successful,
+			Failed:     failed,
+			Objects:    objects,
+		},
+	}
+	return response
+}
+
 func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger)}
 
@@ -244,6 +386,10 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchObjectsMergeHandler = batch.
+		BatchObjectsMergeHandlerFunc(h.mergeObjects)
+	api.BatchBatchObjectsUpdateHandler = batch.
+		BatchObjectsUpdateHandlerFunc(h.updateObjects)
 }
 
 type batchRequestsTotal struct {
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestWithDeduplication(t *testing.T) {
+	ctx := context.Background()
+	vector := "vector"
+	assert.Equal(t, ctx, withDeduplication(ctx, batch.BatchObjectsCreateParams{
+		DedupBy: &vector,
+	}), "dedupBy alone does not enable deduplication")
+}
+
+func TestBatchObjectsResponseDuplicateOf(t *testing.T) {
+	h := &batchObjectHandlers{}
+	res := h.objectsResponse(objects.BatchObjects{
+		{UUID: "8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5", Object: &models.Object{Class: "Foo"}},
+		{
+			UUID:        "0c8e6d2b-3e3c-4a0e-9a5f-d7a8b95dc1c6",
+			Object:      &models.Object{Class: "Foo"},
+			DuplicateOf: "8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5",
+		},
+	})
+	assert.Empty(t, res[0].Result.DuplicateOf)
+	assert.Equal(t, res[0].ID, res[1].Result.DuplicateOf)
+	assert.Equal(t, models.ObjectsGetResponseAO2ResultStatusSUCCESS, *res[1].Result.Status)
+}
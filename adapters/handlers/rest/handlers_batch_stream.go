@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// defaultBatchChunkSize is the number of objects imported at once if the
+	// request does not set chunkSize
+	defaultBatchChunkSize = 100
+	// maxBatchStreamLine limits the size of a single object in the stream
+	maxBatchStreamLine = 64 * 1024 * 1024
+)
+
+type batchChunkAdder interface {
+	AddObjectsChunk(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, repl *additional.ReplicationProperties,
+	) (objects.BatchObjects, objects.Backpressure, error)
+}
+
+type batchStreamHandlers struct {
+	manager batchChunkAdder
+}
+
+// batchChunkAck acknowledges a chunk of a streaming import
+type batchChunkAck struct {
+	Chunk        int                   `json:"chunk"`
+	Imported     int                   `json:"imported"`
+	Errors       []batchChunkError     `json:"errors,omitempty"`
+	Backpressure *batchBackpressure    `json:"backpressure,omitempty"`
+	Error        *models.ErrorResponse `json:"error,omitempty"`
+}
+
+type batchChunkError struct {
+	// Index is the position of the object in the chunk
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+type batchBackpressure struct {
+	QueueDepth   int    `json:"queueDepth"`
+	ShardBusy    string `json:"shardBusy,omitempty"`
+	RetryAfterMs int64  `json:"retryAfterMs,omitempty"`
+}
+
+// stream imports newline-delimited JSON objects in chunks of chunkSize. Each
+// chunk is acknowledged with a line of the response containing the errors of
+// its objects and the current backpressure. Over HTTP/2 acknowledgements are
+// sent as soon as a chunk is imported, HTTP/1.x does not allow responding
+// while the request is still being read, so they are sent at the end.
+func (h *batchStreamHandlers) stream(params batch.BatchObjectsStreamParams,
+	principal *models.Principal,
+) middleware.Responder {
+	chunkSize := defaultBatchChunkSize
+	if params.ChunkSize != nil {
+		chunkSize = int(*params.ChunkSize)
+	}
+
+	var repl *additional.ReplicationProperties
+	if params.ConsistencyLevel != nil && *params.ConsistencyLevel != "" {
+		repl = &additional.ReplicationProperties{ConsistencyLevel: *params.ConsistencyLevel}
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		h.importStream(w, params.HTTPRequest, params.Body, principal, chunkSize, repl)
+	})
+}
+
+func (h *batchStreamHandlers) importStream(w http.ResponseWriter, r *http.Request,
+	body io.Reader, principal *models.Principal, chunkSize int,
+	repl *additional.ReplicationProperties,
+) {
+	acks := newBatchAckWriter(w, r.ProtoMajor >= 2)
+	defer acks.close()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBatchStreamLine)
+
+	chunk := make([]*models.Object, 0, chunkSize)
+	chunkIndex := 0
+	flush := func() bool {
+		if len(chunk) == 0 {
+			return true
+		}
+		ack, ok := h.importChunk(r.Context(), principal, chunkIndex, chunk, repl)
+		acks.write(ack)
+		chunk = chunk[:0]
+		chunkIndex++
+		return ok
+	}
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		obj := &models.Object{}
+		if err := json.Unmarshal(scanner.Bytes(), obj); err != nil {
+			acks.write(batchChunkAck{Chunk: chunkIndex, Error: errPayloadFromSingleErr(
+				fmt.Errorf("line %d: %w", line, err))})
+			return
+		}
+
+		chunk = append(chunk, obj)
+		if len(chunk) == chunkSize && !flush() {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		acks.write(batchChunkAck{Chunk: chunkIndex, Error: errPayloadFromSingleErr(err)})
+		return
+	}
+
+	flush()
+}
+
+// importChunk returns false if the import failed as a whole, e.g. because
+// the principal is not authorized
+func (h *batchStreamHandlers) importChunk(ctx context.Context,
+	principal *models.Principal, chunkIndex int, chunk []*models.Object,
+	repl *additional.ReplicationProperties,
+) (batchChunkAck, bool) {
+	ack := batchChunkAck{Chunk: chunkIndex}
+
+	res, backpressure, err := h.manager.AddObjectsChunk(ctx, principal, chunk, repl)
+	if err != nil {
+		ack.Error = errPayloadFromSingleErr(err)
+		return ack, false
+	}
+
+	for _, obj := range res {
+		if obj.Err != nil {
+			ack.Errors = append(ack.Errors, batchChunkError{
+				Index: obj.OriginalIndex,
+				Error: obj.Err.Error(),
+			})
+			continue
+		}
+		ack.Imported++
+	}
+
+	ack.Backpressure = &batchBackpressure{
+		QueueDepth:   backpressure.QueueDepth,
+		ShardBusy:    backpressure.ShardBusy,
+		RetryAfterMs: backpressure.RetryAfter.Milliseconds(),
+	}
+	return ack, true
+}
+
+// batchAckWriter writes acknowledgements as newline-delimited JSON, either
+// right away or once the request is read completely
+type batchAckWriter struct {
+	w         http.ResponseWriter
+	immediate bool
+	buffered  []batchChunkAck
+	started   bool
+}
+
+func newBatchAckWriter(w http.ResponseWriter, immediate bool) *batchAckWriter {
+	return &batchAckWriter{w: w, immediate: immediate}
+}
+
+func (a *batchAckWriter) write(ack batchChunkAck) {
+	if !a.immediate {
+		a.buffered = append(a.buffered, ack)
+		return
+	}
+
+	a.send(ack)
+	if flusher, ok := a.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (a *batchAckWriter) close() {
+	for _, ack := range a.buffered {
+		a.send(ack)
+	}
+	if !a.started {
+		a.start()
+	}
+}
+
+func (a *batchAckWriter) send(ack batchChunkAck) {
+	if !a.started {
+		a.start()
+	}
+	json.NewEncoder(a.w).Encode(ack)
+}
+
+func (a *batchAckWriter) start() {
+	a.w.Header().Set("Content-Type", "application/x-ndjson")
+	a.w.WriteHeader(http.StatusOK)
+	a.started = true
+}
+
+func setupBatchStream(api *operations.WeaviateAPI, appState *state.State) {
+	appState.BatchManager.SetWriteStallFn(func() (string, time.Duration) {
+		stall := appState.DB.WriteStall()
+		return stall.Reason, stall.RetryAfter
+	})
+
+	h := &batchStreamHandlers{manager: appState.BatchManager}
+	api.BatchBatchObjectsStreamHandler = batch.BatchObjectsStreamHandlerFunc(h.stream)
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeBatchChunkAdder struct {
+	chunks [][]*models.Object
+	err    error
+}
+
+func (f *fakeBatchChunkAdder) AddObjectsChunk(ctx context.Context,
+	principal *models.Principal, objs []*models.Object,
+	repl *additional.ReplicationProperties,
+) (objects.BatchObjects, objects.Backpressure, error) {
+	if f.err != nil {
+		return nil, objects.Backpressure{}, f.err
+	}
+
+	chunk := append([]*models.Object(nil), objs...)
+	f.chunks = append(f.chunks, chunk)
+
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj}
+		if obj.Class == "" {
+			res[i].Err = errors.New("class is required")
+		}
+	}
+	return res, objects.Backpressure{
+		QueueDepth: 2,
+		ShardBusy:  "memtable_flush",
+		RetryAfter: 250 * time.Millisecond,
+	}, nil
+}
+
+func batchStreamBody(classes ...string) string {
+	var b strings.Builder
+	for _, class := range classes {
+		fmt.Fprintf(&b, "{\"class\":%q}\n", class)
+	}
+	return b.String()
+}
+
+func readBatchAcks(t *testing.T, body string) []batchChunkAck {
+	var acks []batchChunkAck
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		var ack batchChunkAck
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &ack))
+		acks = append(acks, ack)
+	}
+	return acks
+}
+
+func bindBatchStreamParams(query, body string) (batch.BatchObjectsStreamParams, error) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/batch/objects/stream"+query,
+		strings.NewReader(body))
+	params := batch.NewBatchObjectsStreamParams()
+	err := bindRequest(&params, req)
+	return params, err
+}
+
+func TestBatchStream(t *testing.T) {
+	stream := func(t *testing.T, h *batchStreamHandlers, query, body string) *httptest.ResponseRecorder {
+		params, err := bindBatchStreamParams(query, body)
+		require.Nil(t, err)
+		return writeResponse(h.stream(params, nil))
+	}
+
+	t.Run("acknowledges every chunk", func(t *testing.T) {
+		adder := &fakeBatchChunkAdder{}
+		h := &batchStreamHandlers{manager: adder}
+
+		body := batchStreamBody("Article", "", "Article", "Article", "Article")
+		rec := stream(t, h, "?chunkSize=2", body)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		require.Len(t, adder.chunks, 3)
+		assert.Len(t, adder.chunks[2], 1)
+
+		acks := readBatchAcks(t, rec.Body.String())
+		require.Len(t, acks, 3)
+		assert.Equal(t, 0, acks[0].Chunk)
+		assert.Equal(t, 1, acks[0].Imported)
+		assert.Equal(t, []batchChunkError{{Index: 1, Error: "class is required"}}, acks[0].Errors)
+		assert.Equal(t, &batchBackpressure{
+			QueueDepth:   2,
+			ShardBusy:    "memtable_flush",
+			RetryAfterMs: 250,
+		}, acks[0].Backpressure)
+		assert.Equal(t, 1, acks[1].Chunk)
+		assert.Equal(t, 2, acks[1].Imported)
+		assert.Equal(t, 1, acks[2].Imported)
+	})
+
+	t.Run("stops at an invalid line", func(t *testing.T) {
+		adder := &fakeBatchChunkAdder{}
+		h := &batchStreamHandlers{manager: adder}
+
+		body := batchStreamBody("Article") + "not json\n" + batchStreamBody("Article")
+		rec := stream(t, h, "", body)
+
+		assert.Empty(t, adder.chunks)
+		acks := readBatchAcks(t, rec.Body.String())
+		require.Len(t, acks, 1)
+		require.NotNil(t, acks[0].Error)
+		assert.Contains(t, acks[0].Error.Error[0].Message, "line 2")
+	})
+
+	t.Run("stops if a chunk fails", func(t *testing.T) {
+		adder := &fakeBatchChunkAdder{err: errors.New("forbidden")}
+		h := &batchStreamHandlers{manager: adder}
+
+		rec := stream(t, h, "?chunkSize=1", batchStreamBody("Article", "Article"))
+
+		acks := readBatchAcks(t, rec.Body.String())
+		require.Len(t, acks, 1)
+		require.NotNil(t, acks[0].Error)
+		assert.Equal(t, "forbidden", acks[0].Error.Error[0].Message)
+	})
+
+	t.Run("invalid chunk size", func(t *testing.T) {
+		_, err := bindBatchStreamParams("?chunkSize=0", batchStreamBody("Article"))
+		assert.NotNil(t, err)
+		_, err = bindBatchStreamParams("?chunkSize=10001", batchStreamBody("Article"))
+		assert.NotNil(t, err)
+	})
+}
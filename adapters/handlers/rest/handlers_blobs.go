@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/tiering"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type blobManager interface {
+	GetBlob(ctx context.Context, principal *models.Principal, className string,
+		id strfmt.UUID, propName, tenant string) (io.ReadCloser, int64, error)
+}
+
+type blobHandlers struct {
+	manager blobManager
+}
+
+// get streams the raw bytes of a blob property at
+// /v1/blobs/{class}/{id}/{property}, so large blobs don't have to be
+// returned base64 encoded as part of the object
+func (h *blobHandlers) get(params objects.ObjectsBlobsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	blob, size, err := h.manager.GetBlob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.PropertyName, getTenant(params.Tenant))
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return objects.NewObjectsBlobsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrNotFound{}):
+			return objects.NewObjectsBlobsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}),
+			errors.As(err, &uco.ErrMultiTenancy{}):
+			return objects.NewObjectsBlobsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsBlobsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer blob.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		// the status is already sent, a failing copy can only abort the response
+		io.Copy(w, blob)
+	})
+}
+
+// setupBlobs connects the external blob store if configured and registers
+// the endpoint which streams blob properties
+func setupBlobs(api *operations.WeaviateAPI, appState *state.State,
+	objectsManager *uco.Manager, batchManager *uco.BatchManager,
+) error {
+	if cfg := appState.ServerConfig.Config.BlobStorage; cfg.Enabled {
+		store, err := tiering.NewBlobs(cfg)
+		if err != nil {
+			return fmt.Errorf("init blob storage: %w", err)
+		}
+		objectsManager.SetBlobStore(store)
+		batchManager.SetBlobStore(store)
+	}
+
+	h := &blobHandlers{manager: objectsManager}
+	api.ObjectsObjectsBlobsGetHandler = objects.ObjectsBlobsGetHandlerFunc(h.get)
+	return nil
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/models"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeBlobManager struct {
+	className string
+	id        strfmt.UUID
+	propName  string
+	tenant    string
+	data      []byte
+	err       error
+}
+
+func (f *fakeBlobManager) GetBlob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, propName, tenant string,
+) (io.ReadCloser, int64, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	f.className, f.id, f.propName, f.tenant = className, id, propName, tenant
+	return io.NopCloser(bytes.NewReader(f.data)), int64(len(f.data)), nil
+}
+
+func TestGetBlob(t *testing.T) {
+	serve := func(m *fakeBlobManager, tenant *string) *httptest.ResponseRecorder {
+		h := &blobHandlers{manager: m}
+		return writeResponse(h.get(objects.ObjectsBlobsGetParams{
+			HTTPRequest:  httptest.NewRequest(http.MethodGet, "/v1/blobs", nil),
+			ClassName:    "Image",
+			ID:           "8c29da7a-600a-43dc-85fb-83ab2b08c294",
+			PropertyName: "raw",
+			Tenant:       tenant,
+		}, nil))
+	}
+
+	t.Run("streams the blob", func(t *testing.T) {
+		m := &fakeBlobManager{data: []byte("some image bytes")}
+		tenant := "t1"
+		rec := serve(m, &tenant)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "16", rec.Header().Get("Content-Length"))
+		assert.Equal(t, []byte("some image bytes"), rec.Body.Bytes())
+		assert.Equal(t, "Image", m.className)
+		assert.Equal(t, strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294"), m.id)
+		assert.Equal(t, "raw", m.propName)
+		assert.Equal(t, "t1", m.tenant)
+	})
+
+	t.Run("errors of the manager", func(t *testing.T) {
+		for _, tc := range []struct {
+			err    error
+			status int
+		}{
+			{uco.NewErrNotFound("no object"), http.StatusNotFound},
+			{uco.NewErrInvalidUserInput("not a blob"), http.StatusUnprocessableEntity},
+			{uco.NewErrInternal("store down"), http.StatusInternalServerError},
+		} {
+			rec := serve(&fakeBlobManager{err: tc.err}, nil)
+			assert.Equal(t, tc.status, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/bulk_imports"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/bulkimport"
+)
+
+// bulkImportsPath is checked by the standby guard, imports write objects
+const bulkImportsPath = "/v1/bulk-imports"
+
+type bulkImportHandlers struct {
+	manager *bulkimport.Manager
+}
+
+// list returns the reports of all imports of this node
+func (h *bulkImportHandlers) list(params bulk_imports.BulkImportsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	reports, err := h.manager.List(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return bulk_imports.NewBulkImportsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return bulk_imports.NewBulkImportsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return bulk_imports.NewBulkImportsListOK().WithPayload(jsonObjects(reports))
+}
+
+// start imports a Parquet or Arrow file in the background
+func (h *bulkImportHandlers) start(params bulk_imports.BulkImportsStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	body := params.Body
+	report, err := h.manager.Start(principal, bulkimport.Options{
+		Class:            body.Class,
+		Source:           body.Source,
+		Format:           body.Format,
+		Tenant:           body.Tenant,
+		Columns:          body.Columns,
+		IDColumn:         body.IDColumn,
+		VectorColumn:     body.VectorColumn,
+		BatchSize:        int(body.BatchSize),
+		ConsistencyLevel: body.ConsistencyLevel,
+	})
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return bulk_imports.NewBulkImportsStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return bulk_imports.NewBulkImportsStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return bulk_imports.NewBulkImportsStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return bulk_imports.NewBulkImportsStartAccepted().WithPayload(report)
+}
+
+// get returns the report of a single import
+func (h *bulkImportHandlers) get(params bulk_imports.BulkImportsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := h.manager.Status(principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return bulk_imports.NewBulkImportsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return bulk_imports.NewBulkImportsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return bulk_imports.NewBulkImportsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return bulk_imports.NewBulkImportsGetOK().WithPayload(report)
+}
+
+// cancel stops an import which is still running
+func (h *bulkImportHandlers) cancel(params bulk_imports.BulkImportsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.manager.Cancel(principal, params.ID); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return bulk_imports.NewBulkImportsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return bulk_imports.NewBulkImportsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return bulk_imports.NewBulkImportsCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return bulk_imports.NewBulkImportsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return bulk_imports.NewBulkImportsCancelNoContent()
+}
+
+func setupBulkImport(api *operations.WeaviateAPI, appState *state.State) {
+	manager := bulkimport.NewManager(appState.BatchManager, appState.SchemaManager,
+		appState.Authorizer, appState.ServerConfig.Config.BulkImport, appState.Logger)
+	manager.SetJobs(appState.Jobs)
+
+	h := &bulkImportHandlers{manager: manager}
+	api.BulkImportsBulkImportsListHandler = bulk_imports.BulkImportsListHandlerFunc(h.list)
+	api.BulkImportsBulkImportsStartHandler = bulk_imports.BulkImportsStartHandlerFunc(h.start)
+	api.BulkImportsBulkImportsGetHandler = bulk_imports.BulkImportsGetHandlerFunc(h.get)
+	api.BulkImportsBulkImportsCancelHandler = bulk_imports.BulkImportsCancelHandlerFunc(h.cancel)
+}
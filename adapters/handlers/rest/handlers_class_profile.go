@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/dataprofile"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const defaultProfileSampleSize = 1000
+
+type classProfileRepo interface {
+	ProfileClass(ctx context.Context, className string, sampleSize int) (*dataprofile.ClassProfile, error)
+}
+
+type classProfileHandlers struct {
+	repo       classProfileRepo
+	authorizer authorization.Authorizer
+}
+
+// profile returns data quality statistics of a sample of the objects in the
+// local shards of a class. The sampleSize query parameter is capped, so
+// profiling never reads more than a bounded number of objects.
+func (h *classProfileHandlers) profile(params schema.SchemaObjectsProfileParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.authorizer.Authorize(principal, "list", "schema/*")
+	if err == nil {
+		err = h.authorizer.Authorize(principal, "list",
+			authorization.ClassResource(params.ClassName, ""))
+	}
+	if err != nil {
+		return schema.NewSchemaObjectsProfileForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	sampleSize := defaultProfileSampleSize
+	if params.SampleSize != nil {
+		sampleSize = int(*params.SampleSize)
+	}
+
+	profile, err := h.repo.ProfileClass(params.HTTPRequest.Context(), params.ClassName, sampleSize)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsProfileForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsProfileNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsProfileUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsProfileInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsProfileOK().WithPayload(profile)
+}
+
+func setupClassProfile(api *operations.WeaviateAPI, appState *state.State,
+	repo classProfileRepo,
+) {
+	h := &classProfileHandlers{
+		repo:       repo,
+		authorizer: appState.Authorizer,
+	}
+	api.SchemaSchemaObjectsProfileHandler = schema.SchemaObjectsProfileHandlerFunc(h.profile)
+}
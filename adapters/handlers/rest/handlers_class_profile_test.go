@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/dataprofile"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+type fakeClassProfileRepo struct {
+	sampleSize int
+}
+
+func (f *fakeClassProfileRepo) ProfileClass(ctx context.Context, className string,
+	sampleSize int,
+) (*dataprofile.ClassProfile, error) {
+	if className != "Article" {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+	f.sampleSize = sampleSize
+	return &dataprofile.ClassProfile{Class: className, SampleSize: sampleSize}, nil
+}
+
+func TestClassProfile(t *testing.T) {
+	newHandlers := func(allowed string) (*classProfileHandlers, *fakeClassProfileRepo) {
+		repo := &fakeClassProfileRepo{}
+		return &classProfileHandlers{
+			repo:       repo,
+			authorizer: fakeTenantAuthorizer{allowed: allowed},
+		}, repo
+	}
+	bind := func(className, query string) (schema.SchemaObjectsProfileParams, error) {
+		params := schema.NewSchemaObjectsProfileParams()
+		req := httptest.NewRequest(http.MethodGet, "/v1/schema/"+className+"/profile"+query, nil)
+		err := bindRequest(&params, req, "className", className)
+		return params, err
+	}
+	serve := func(h *classProfileHandlers, className, query string) *httptest.ResponseRecorder {
+		params, err := bind(className, query)
+		require.Nil(t, err)
+		return writeResponse(h.profile(params, nil))
+	}
+
+	t.Run("default sample size", func(t *testing.T) {
+		h, repo := newHandlers("list")
+		rec := serve(h, "Article", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var profile dataprofile.ClassProfile
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &profile))
+		assert.Equal(t, "Article", profile.Class)
+		assert.Equal(t, defaultProfileSampleSize, repo.sampleSize)
+	})
+
+	t.Run("custom sample size", func(t *testing.T) {
+		h, repo := newHandlers("list")
+		rec := serve(h, "Article", "?sampleSize=50")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 50, repo.sampleSize)
+	})
+
+	t.Run("invalid sample size", func(t *testing.T) {
+		for _, size := range []string{"0", "abc", "10001"} {
+			_, err := bind("Article", "?sampleSize="+size)
+			assert.NotNil(t, err, size)
+		}
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		h, _ := newHandlers("list")
+		rec := serve(h, "Unknown", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		h, _ := newHandlers("update")
+		rec := serve(h, "Article", "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
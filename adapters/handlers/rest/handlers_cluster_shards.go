@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type shardMover interface {
+	MoveShard(ctx context.Context, principal *models.Principal,
+		className, shard, source, target string) error
+	StartShardMove(ctx context.Context, principal *models.Principal,
+		className, shard, source, target string) (string, error)
+	DrainNode(ctx context.Context, principal *models.Principal,
+		node string) (schemaUC.DrainStatus, error)
+	DrainStatus(ctx context.Context, principal *models.Principal,
+		node string) (schemaUC.DrainStatus, error)
+	SplitShards(ctx context.Context, principal *models.Principal,
+		className string, count int) (schemaUC.SplitStatus, error)
+	SplitStatus(ctx context.Context, principal *models.Principal,
+		className string) (schemaUC.SplitStatus, error)
+}
+
+type shardMoveHandlers struct {
+	mover shardMover
+}
+
+// move moves a shard to another node. It returns once the target node owns
+// the shard, or right away with 202 and the id of the job which moves the
+// shard if ?async=true is set.
+func (h *shardMoveHandlers) move(params cluster.ClusterShardsMoveParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := params.Body
+	if req.Class == "" || req.Shard == "" || req.SourceNode == "" || req.TargetNode == "" {
+		return cluster.NewClusterShardsMoveBadRequest().WithPayload(errPayloadFromSingleErr(
+			fmt.Errorf("class, shard, sourceNode and targetNode are required")))
+	}
+
+	var (
+		jobID string
+		err   error
+	)
+	ctx := params.HTTPRequest.Context()
+	async := params.Async != nil && *params.Async
+	if async {
+		jobID, err = h.mover.StartShardMove(ctx, principal, req.Class, req.Shard,
+			req.SourceNode, req.TargetNode)
+	} else {
+		err = h.mover.MoveShard(ctx, principal, req.Class, req.Shard,
+			req.SourceNode, req.TargetNode)
+	}
+	switch {
+	case err == nil && async:
+		return cluster.NewClusterShardsMoveAccepted().WithPayload(&models.JobStarted{JobID: jobID})
+	case err == nil:
+		return cluster.NewClusterShardsMoveOK().WithPayload(req)
+	case errors.Is(err, schemaUC.ErrNotFound):
+		return cluster.NewClusterShardsMoveNotFound().WithPayload(
+			errPayloadFromSingleErr(fmt.Errorf("class %q not found", req.Class)))
+	case errors.As(err, &autherrs.Forbidden{}):
+		return cluster.NewClusterShardsMoveForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return cluster.NewClusterShardsMoveUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterShardsMoveInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+// drain moves all shards of a node to the remaining nodes, it returns right
+// away with 202
+func (h *shardMoveHandlers) drain(params cluster.ClusterNodesDrainParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.mover.DrainNode(params.HTTPRequest.Context(), principal, params.NodeName)
+	switch {
+	case err == nil:
+		return cluster.NewClusterNodesDrainAccepted().WithPayload(status)
+	case errors.Is(err, schemaUC.ErrNotFound):
+		return cluster.NewClusterNodesDrainNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &autherrs.Forbidden{}):
+		return cluster.NewClusterNodesDrainForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return cluster.NewClusterNodesDrainUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterNodesDrainInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+// drainStatus returns the progress of draining a node
+func (h *shardMoveHandlers) drainStatus(params cluster.ClusterNodesDrainStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.mover.DrainStatus(params.HTTPRequest.Context(), principal, params.NodeName)
+	switch {
+	case err == nil:
+		return cluster.NewClusterNodesDrainStatusOK().WithPayload(status)
+	case errors.Is(err, schemaUC.ErrNotFound):
+		return cluster.NewClusterNodesDrainStatusNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &autherrs.Forbidden{}):
+		return cluster.NewClusterNodesDrainStatusForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return cluster.NewClusterNodesDrainStatusUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterNodesDrainStatusInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+// split increases the shard count of a class, it returns right away with 202
+func (h *shardMoveHandlers) split(params cluster.ClusterShardsSplitParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if params.Body.Class == "" {
+		return cluster.NewClusterShardsSplitBadRequest().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("class is required")))
+	}
+
+	status, err := h.mover.SplitShards(params.HTTPRequest.Context(), principal,
+		params.Body.Class, int(params.Body.Count))
+	switch {
+	case err == nil:
+		return cluster.NewClusterShardsSplitAccepted().WithPayload(status)
+	case errors.Is(err, schemaUC.ErrNotFound):
+		return cluster.NewClusterShardsSplitNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &autherrs.Forbidden{}):
+		return cluster.NewClusterShardsSplitForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return cluster.NewClusterShardsSplitUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterShardsSplitInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+// splitStatus returns the progress of splitting the shards of a class
+func (h *shardMoveHandlers) splitStatus(params cluster.ClusterShardsSplitStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.mover.SplitStatus(params.HTTPRequest.Context(), principal, params.Class)
+	switch {
+	case err == nil:
+		return cluster.NewClusterShardsSplitStatusOK().WithPayload(status)
+	case errors.Is(err, schemaUC.ErrNotFound):
+		return cluster.NewClusterShardsSplitStatusNotFound().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &autherrs.Forbidden{}):
+		return cluster.NewClusterShardsSplitStatusForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case errors.As(err, &enterrors.ErrUnprocessable{}):
+		return cluster.NewClusterShardsSplitStatusUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterShardsSplitStatusInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func setupClusterShards(api *operations.WeaviateAPI, mover shardMover) {
+	h := &shardMoveHandlers{mover: mover}
+	api.ClusterClusterShardsMoveHandler = cluster.ClusterShardsMoveHandlerFunc(h.move)
+	api.ClusterClusterShardsSplitHandler = cluster.ClusterShardsSplitHandlerFunc(h.split)
+	api.ClusterClusterShardsSplitStatusHandler = cluster.ClusterShardsSplitStatusHandlerFunc(h.splitStatus)
+	api.ClusterClusterNodesDrainHandler = cluster.ClusterNodesDrainHandlerFunc(h.drain)
+	api.ClusterClusterNodesDrainStatusHandler = cluster.ClusterNodesDrainStatusHandlerFunc(h.drainStatus)
+}
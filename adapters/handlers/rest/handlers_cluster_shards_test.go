@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// shardMove is a move recorded by fakeShardMover
+type shardMove struct {
+	Class, Shard, SourceNode, TargetNode string
+}
+
+type fakeShardMover struct {
+	moved []shardMove
+}
+
+func (f *fakeShardMover) MoveShard(ctx context.Context, principal *models.Principal,
+	className, shard, source, target string,
+) error {
+	switch {
+	case className != "Article":
+		return schemaUC.ErrNotFound
+	case source == target:
+		return enterrors.NewErrUnprocessable(fmt.Errorf("source and target node are the same"))
+	}
+	f.moved = append(f.moved, shardMove{className, shard, source, target})
+	return nil
+}
+
+func (f *fakeShardMover) StartShardMove(ctx context.Context, principal *models.Principal,
+	className, shard, source, target string,
+) (string, error) {
+	if className != "Article" {
+		return "", schemaUC.ErrNotFound
+	}
+	f.moved = append(f.moved, shardMove{className, shard, source, target})
+	return "job1", nil
+}
+
+func (f *fakeShardMover) DrainNode(ctx context.Context, principal *models.Principal,
+	node string,
+) (schemaUC.DrainStatus, error) {
+	if node != "node2" {
+		return schemaUC.DrainStatus{}, fmt.Errorf("node %q: %w", node, schemaUC.ErrNotFound)
+	}
+	return schemaUC.DrainStatus{Node: node, Status: schemaUC.DrainStatusStarted, TotalShards: 3}, nil
+}
+
+func (f *fakeShardMover) DrainStatus(ctx context.Context, principal *models.Principal,
+	node string,
+) (schemaUC.DrainStatus, error) {
+	return schemaUC.DrainStatus{
+		Node: node, Status: schemaUC.DrainStatusSuccess,
+		TotalShards: 3, MovedShards: 3, SafeToTerminate: true,
+	}, nil
+}
+
+func (f *fakeShardMover) SplitShards(ctx context.Context, principal *models.Principal,
+	className string, count int,
+) (schemaUC.SplitStatus, error) {
+	switch {
+	case className != "Article":
+		return schemaUC.SplitStatus{}, schemaUC.ErrNotFound
+	case count <= 2:
+		return schemaUC.SplitStatus{}, enterrors.NewErrUnprocessable(
+			fmt.Errorf("shard count must be greater than 2"))
+	}
+	return schemaUC.SplitStatus{Class: className, Status: schemaUC.SplitStatusStarted, ShardCount: count}, nil
+}
+
+func (f *fakeShardMover) SplitStatus(ctx context.Context, principal *models.Principal,
+	className string,
+) (schemaUC.SplitStatus, error) {
+	if className != "Article" {
+		return schemaUC.SplitStatus{}, schemaUC.ErrNotFound
+	}
+	return schemaUC.SplitStatus{Class: className, Status: schemaUC.SplitStatusSuccess, ShardCount: 4}, nil
+}
+
+func TestShardMove(t *testing.T) {
+	serve := func(h *shardMoveHandlers, query, body string) *httptest.ResponseRecorder {
+		params := cluster.NewClusterShardsMoveParams()
+		req := httptest.NewRequest(http.MethodPost, "/v1/cluster/shards/move"+query,
+			strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		require.Nil(t, bindRequest(&params, req))
+		return writeResponse(h.move(params, nil))
+	}
+
+	mover := &fakeShardMover{}
+	h := &shardMoveHandlers{mover: mover}
+	assert.Equal(t, http.StatusOK, serve(h, "",
+		`{"class": "Article", "shard": "S1", "sourceNode": "node1", "targetNode": "node2"}`).Code)
+	assert.Equal(t, []shardMove{{"Article", "S1", "node1", "node2"}}, mover.moved)
+
+	assert.Equal(t, http.StatusNotFound, serve(h, "",
+		`{"class": "Other", "shard": "S1", "sourceNode": "node1", "targetNode": "node2"}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, serve(h, "",
+		`{"class": "Article", "shard": "S1", "sourceNode": "node1", "targetNode": "node1"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(h, "", `{"class": "Article"}`).Code)
+
+	t.Run("async", func(t *testing.T) {
+		mover := &fakeShardMover{}
+		h := &shardMoveHandlers{mover: mover}
+
+		rec := serve(h, "?async=true",
+			`{"class": "Article", "shard": "S1", "sourceNode": "node1", "targetNode": "node2"}`)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.JSONEq(t, `{"jobId": "job1"}`, rec.Body.String())
+		assert.Equal(t, []shardMove{{"Article", "S1", "node1", "node2"}}, mover.moved)
+
+		rec = serve(h, "?async=true",
+			`{"class": "Other", "shard": "S1", "sourceNode": "node1", "targetNode": "node2"}`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestNodeDrain(t *testing.T) {
+	h := &shardMoveHandlers{mover: &fakeShardMover{}}
+	req := httptest.NewRequest(http.MethodPost, "/v1/cluster/nodes/node2/drain", nil)
+	drain := func(node string) *httptest.ResponseRecorder {
+		return writeResponse(h.drain(cluster.ClusterNodesDrainParams{
+			HTTPRequest: req, NodeName: node,
+		}, nil))
+	}
+
+	rec := drain("node2")
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	var status schemaUC.DrainStatus
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, schemaUC.DrainStatusStarted, status.Status)
+
+	rec = writeResponse(h.drainStatus(cluster.ClusterNodesDrainStatusParams{
+		HTTPRequest: req, NodeName: "node2",
+	}, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.SafeToTerminate)
+
+	assert.Equal(t, http.StatusNotFound, drain("node5").Code)
+}
+
+func TestShardSplit(t *testing.T) {
+	h := &shardMoveHandlers{mover: &fakeShardMover{}}
+	req := httptest.NewRequest(http.MethodPost, "/v1/cluster/shards/split", nil)
+	split := func(class string, count int64) *httptest.ResponseRecorder {
+		return writeResponse(h.split(cluster.ClusterShardsSplitParams{
+			HTTPRequest: req, Body: &models.ShardSplit{Class: class, Count: count},
+		}, nil))
+	}
+	splitStatus := func(class string) *httptest.ResponseRecorder {
+		return writeResponse(h.splitStatus(cluster.ClusterShardsSplitStatusParams{
+			HTTPRequest: req, Class: class,
+		}, nil))
+	}
+
+	rec := split("Article", 4)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	var status schemaUC.SplitStatus
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, schemaUC.SplitStatus{
+		Class: "Article", Status: schemaUC.SplitStatusStarted, ShardCount: 4,
+	}, status)
+
+	rec = splitStatus("Article")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, schemaUC.SplitStatusSuccess, status.Status)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, split("Article", 2).Code)
+	assert.Equal(t, http.StatusNotFound, split("Other", 4).Code)
+	assert.Equal(t, http.StatusNotFound, splitStatus("Other").Code)
+	assert.Equal(t, http.StatusBadRequest, split("", 4).Code)
+}
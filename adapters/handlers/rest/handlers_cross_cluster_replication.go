@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/clients"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	crossreplrepo "github.com/weaviate/weaviate/adapters/repos/crossrepl"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+)
+
+type crossClusterReplicationHandlers struct {
+	manager *crossrepl.Manager
+}
+
+func (h *crossClusterReplicationHandlers) status(params replication.ReplicationCrossClusterStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Status(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return replication.NewReplicationCrossClusterStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return replication.NewReplicationCrossClusterStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationCrossClusterStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return replication.NewReplicationCrossClusterStatusOK().WithPayload(status)
+}
+
+func (h *crossClusterReplicationHandlers) promote(params replication.ReplicationCrossClusterPromoteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Promote(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return replication.NewReplicationCrossClusterPromoteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return replication.NewReplicationCrossClusterPromoteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationCrossClusterPromoteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return replication.NewReplicationCrossClusterPromoteOK().WithPayload(status)
+}
+
+func (h *crossClusterReplicationHandlers) apply(params replication.ReplicationCrossClusterApplyParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ops := make([]crossrepl.Op, 0, len(params.Body))
+	for _, op := range params.Body {
+		if op == nil {
+			continue
+		}
+		ops = append(ops, crossrepl.Op{
+			Type:   crossrepl.OpType(op.Type),
+			Class:  op.Class,
+			Tenant: op.Tenant,
+			ID:     op.ID,
+			Object: op.Object,
+			After:  op.After,
+			IDs:    op.Ids,
+			Final:  op.Final,
+		})
+	}
+
+	if err := h.manager.Apply(params.HTTPRequest.Context(), principal, ops); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return replication.NewReplicationCrossClusterApplyForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return replication.NewReplicationCrossClusterApplyUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationCrossClusterApplyInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return replication.NewReplicationCrossClusterApplyNoContent()
+}
+
+// makeAddStandbyGuard rejects object writes while the cluster is a standby.
+// Schema changes are still allowed, as the standby needs the same classes as
+// the primary before changes can be applied. The objects and batch managers
+// reject writes from all other APIs, the guard only answers REST requests
+// before they are decoded.
+func makeAddStandbyGuard(manager *crossrepl.Manager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if manager == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isStandbyWriteRequest(r) {
+				if err := manager.Writable(); err != nil {
+					writeErrorResponse(w, http.StatusServiceUnavailable, err)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isStandbyWriteRequest is true for requests which write objects, including
+// bulk imports, reads sent as POST are excluded
+func isStandbyWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	path := r.URL.Path
+	if path == "/v1/objects/validate" || path == multiGetObjectsPath {
+		return false
+	}
+	return strings.HasPrefix(path, "/v1/objects") || strings.HasPrefix(path, "/v1/batch") ||
+		strings.HasPrefix(path, bulkImportsPath)
+}
+
+// setupCrossClusterReplication records changes on the primary and ships
+// them to the standby. It is a no-op unless a role is configured.
+func setupCrossClusterReplication(api *operations.WeaviateAPI, appState *state.State,
+	reader crossrepl.ObjectReader, repo crossrepl.Repo, httpClient *http.Client,
+) *crossrepl.Manager {
+	cfg := appState.ServerConfig.Config.CrossClusterReplication
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	store, err := crossreplrepo.NewRepo(appState.ServerConfig.Config.Persistence.DataPath,
+		appState.DB.TenantEncryption, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize cross-cluster replication repo")
+		os.Exit(1)
+	}
+	log, err := crossrepl.NewChangeLog(cfg.MaxPendingChanges, store, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not load cross-cluster replication change log")
+		os.Exit(1)
+	}
+	var shipper *crossrepl.Shipper
+	if cfg.StandbyURL != "" {
+		shipper = crossrepl.NewShipper(log, reader,
+			clients.NewStandbyCluster(httpClient, cfg.StandbyURL, cfg.StandbyAPIKey),
+			time.Duration(cfg.ShipIntervalSeconds)*time.Second, cfg.BatchSize,
+			appState.Logger, crossrepl.NewMetrics(appState.Metrics))
+	}
+
+	manager := crossrepl.NewManager(cfg.Role, log, shipper, repo, appState.SchemaManager,
+		appState.ServerConfig, appState.Authorizer, appState.Logger)
+
+	appState.CrossClusterReplication = manager
+
+	h := &crossClusterReplicationHandlers{manager: manager}
+	api.ReplicationReplicationCrossClusterStatusHandler = replication.
+		ReplicationCrossClusterStatusHandlerFunc(h.status)
+	api.ReplicationReplicationCrossClusterPromoteHandler = replication.
+		ReplicationCrossClusterPromoteHandlerFunc(h.promote)
+	api.ReplicationReplicationCrossClusterApplyHandler = replication.
+		ReplicationCrossClusterApplyHandlerFunc(h.apply)
+
+	if cfg.Role == config.CrossClusterReplicationRoleStandby {
+		appState.Logger.WithField("action", "startup").
+			Info("cluster is a cross-cluster replication standby, object writes are rejected")
+	}
+
+	return manager
+}
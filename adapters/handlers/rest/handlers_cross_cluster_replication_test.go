@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	crossreplrepo "github.com/weaviate/weaviate/adapters/repos/crossrepl"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+)
+
+func TestStandbyGuard(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	store, err := crossreplrepo.NewRepo(t.TempDir(), nil, logger)
+	require.Nil(t, err)
+	defer store.Close()
+	log, err := crossrepl.NewChangeLog(1, store, logger)
+	require.Nil(t, err)
+	manager := crossrepl.NewManager(config.CrossClusterReplicationRoleStandby,
+		log, nil, nil, nil, nil, nil, logger)
+
+	handler := makeAddStandbyGuard(manager)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	tests := []struct {
+		method   string
+		path     string
+		expected int
+	}{
+		{http.MethodGet, "/v1/objects", http.StatusTeapot},
+		{http.MethodOptions, "/v1/objects", http.StatusTeapot},
+		{http.MethodPost, "/v1/objects/validate", http.StatusTeapot},
+		{http.MethodPost, multiGetObjectsPath, http.StatusTeapot},
+		{http.MethodPost, "/v1/schema", http.StatusTeapot},
+		{http.MethodPost, "/v1/graphql", http.StatusTeapot},
+		{http.MethodPost, "/v1/objects", http.StatusServiceUnavailable},
+		{http.MethodPatch, "/v1/objects/Article/8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01", http.StatusServiceUnavailable},
+		{http.MethodDelete, "/v1/batch/objects", http.StatusServiceUnavailable},
+		{http.MethodPost, "/v1/bulk-imports", http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+			assert.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/export"
+)
+
+// exportErrorTrailer is set if the export failed after the response status
+// was already sent
+const exportErrorTrailer = "X-Weaviate-Export-Error"
+
+type exportHandlers struct {
+	manager *export.Manager
+	logger  logrus.FieldLogger
+}
+
+// export streams all objects of the class including their vectors. The
+// format (arrow, ndjson), tenant and a JSON encoded where filter are passed
+// as query parameters.
+func (h *exportHandlers) export(params objects.ObjectsClassExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	opts := export.Options{Tenant: getTenant(params.Tenant)}
+	if params.Format != nil {
+		opts.Format = *params.Format
+	}
+	if params.Where != nil && *params.Where != "" {
+		where := &models.WhereFilter{}
+		if err := json.Unmarshal([]byte(*params.Where), where); err != nil {
+			return objects.NewObjectsClassExportBadRequest().WithPayload(
+				errPayloadFromSingleErr(fmt.Errorf("where is not a valid filter: %w", err)))
+		}
+		opts.Where = where
+	}
+
+	exp, err := h.manager.Export(principal, params.ClassName, opts)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return objects.NewObjectsClassExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return objects.NewObjectsClassExportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return objects.NewObjectsClassExportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", exp.ContentType)
+		w.Header().Set("Trailer", exportErrorTrailer)
+		w.WriteHeader(http.StatusOK)
+
+		count, err := exp.WriteTo(params.HTTPRequest.Context(), w)
+		if err != nil {
+			w.Header().Set(exportErrorTrailer, err.Error())
+			h.logger.WithField("action", "export_objects").
+				WithField("class", params.ClassName).
+				WithField("tenant", opts.Tenant).
+				WithField("exported", count).
+				WithError(err).
+				Error("export aborted")
+		}
+	})
+}
+
+func setupExport(api *operations.WeaviateAPI, appState *state.State, repo export.Repo) {
+	h := &exportHandlers{
+		manager: export.NewManager(repo, appState.SchemaManager,
+			appState.Authorizer, appState.Logger),
+		logger: appState.Logger,
+	}
+	api.ObjectsObjectsClassExportHandler = objects.ObjectsClassExportHandlerFunc(h.export)
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/clients"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/federation"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	federationUC "github.com/weaviate/weaviate/usecases/federation"
+)
+
+type federationHandlers struct {
+	manager *federationUC.Manager
+}
+
+func (h *federationHandlers) list(params federation.FederationPeersListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	peers, err := h.manager.ListPeers(principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return federation.NewFederationPeersListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return federation.NewFederationPeersListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.FederationPeer, len(peers))
+	for i := range peers {
+		payload[i] = federationPeerPayload(peers[i])
+	}
+	return federation.NewFederationPeersListOK().WithPayload(payload)
+}
+
+func (h *federationHandlers) register(params federation.FederationPeersRegisterParams,
+	principal *models.Principal,
+) middleware.Responder {
+	peer := federationUC.Peer{
+		Name:           params.Body.Name,
+		URL:            params.Body.URL,
+		GRPCAddress:    params.Body.GrpcAddress,
+		TimeoutSeconds: int(params.Body.TimeoutSeconds),
+		APIKey:         params.Body.APIKey,
+	}
+	if err := h.manager.RegisterPeer(principal, peer); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return federation.NewFederationPeersRegisterForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return federation.NewFederationPeersRegisterUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return federation.NewFederationPeersRegisterInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return federation.NewFederationPeersRegisterCreated().WithPayload(federationPeerPayload(peer))
+}
+
+func (h *federationHandlers) deregister(params federation.FederationPeersDeregisterParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.manager.DeregisterPeer(principal, params.PeerName); err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return federation.NewFederationPeersDeregisterForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return federation.NewFederationPeersDeregisterNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return federation.NewFederationPeersDeregisterUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return federation.NewFederationPeersDeregisterInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return federation.NewFederationPeersDeregisterNoContent()
+}
+
+// federationPeerPayload never includes the api key of the peer
+func federationPeerPayload(peer federationUC.Peer) *models.FederationPeer {
+	return &models.FederationPeer{
+		Name:           peer.Name,
+		URL:            peer.URL,
+		GrpcAddress:    peer.GRPCAddress,
+		TimeoutSeconds: int64(peer.TimeoutSeconds),
+	}
+}
+
+// setupFederation builds the peer registry from the config and the persisted
+// peers and enables cross-cluster references in the schema and objects
+// managers
+func setupFederation(api *operations.WeaviateAPI, appState *state.State,
+	httpClient *http.Client,
+) (*federationUC.Resolver, error) {
+	cfg := appState.ServerConfig.Config.Federation
+	peers := make([]federationUC.Peer, len(cfg.Peers))
+	for i, p := range cfg.Peers {
+		peers[i] = federationUC.Peer{
+			Name:           p.Name,
+			URL:            p.URL,
+			GRPCAddress:    p.GRPCAddress,
+			TimeoutSeconds: p.TimeoutSeconds,
+			APIKey:         p.APIKey,
+		}
+		if peers[i].TimeoutSeconds == 0 {
+			peers[i].TimeoutSeconds = cfg.TimeoutSeconds
+		}
+	}
+
+	registry, err := federationUC.NewRegistry(peers...)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := federationUC.NewResolver(registry, clients.NewFederationPeers(httpClient),
+		time.Duration(cfg.CacheTTLSeconds)*time.Second)
+	resolver.SetBatchClient(clients.NewFederationGRPC())
+
+	manager, err := federationUC.NewManager(registry, resolver, appState.FederationRepo,
+		appState.Authorizer, appState.Logger)
+	if err != nil {
+		return nil, err
+	}
+	appState.FederationRepo.SetPeers(manager)
+
+	h := &federationHandlers{manager: manager}
+	api.FederationFederationPeersListHandler = federation.FederationPeersListHandlerFunc(h.list)
+	api.FederationFederationPeersRegisterHandler = federation.FederationPeersRegisterHandlerFunc(h.register)
+	api.FederationFederationPeersDeregisterHandler = federation.FederationPeersDeregisterHandlerFunc(h.deregister)
+
+	return resolver, nil
+}
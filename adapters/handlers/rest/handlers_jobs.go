@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/jobs"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	jobsUC "github.com/weaviate/weaviate/usecases/jobs"
+)
+
+type jobHandlers struct {
+	manager *jobsUC.Manager
+}
+
+// list returns the jobs of this node, the most recent one first. They can be
+// filtered with ?type=.
+func (h *jobHandlers) list(params jobs.JobsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var jobType string
+	if params.Type != nil {
+		jobType = *params.Type
+	}
+
+	list, err := h.manager.List(principal, jobType)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return jobs.NewJobsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return jobs.NewJobsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return jobs.NewJobsListOK().WithPayload(jsonObjects(list))
+}
+
+func (h *jobHandlers) get(params jobs.JobsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.Get(principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return jobs.NewJobsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return jobs.NewJobsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return jobs.NewJobsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return jobs.NewJobsGetOK().WithPayload(job)
+}
+
+func (h *jobHandlers) cancel(params jobs.JobsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.Cancel(principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return jobs.NewJobsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return jobs.NewJobsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return jobs.NewJobsCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return jobs.NewJobsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return jobs.NewJobsCancelOK().WithPayload(job)
+}
+
+func setupJobs(api *operations.WeaviateAPI, appState *state.State) {
+	h := &jobHandlers{manager: appState.Jobs}
+	api.JobsJobsListHandler = jobs.JobsListHandlerFunc(h.list)
+	api.JobsJobsGetHandler = jobs.JobsGetHandlerFunc(h.get)
+	api.JobsJobsCancelHandler = jobs.JobsCancelHandlerFunc(h.cancel)
+}
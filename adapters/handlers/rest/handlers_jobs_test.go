@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	jobsops "github.com/weaviate/weaviate/adapters/handlers/rest/operations/jobs"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+type fakeJobsAuthorizer struct {
+	allowed []string
+}
+
+func (f fakeJobsAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	for _, allowed := range f.allowed {
+		if verb == allowed {
+			return nil
+		}
+	}
+	return autherrs.NewForbidden(principal, verb, resource)
+}
+
+// newTestJobs creates a jobs manager which only allows the given verbs
+func newTestJobs(t *testing.T, allowed ...string) *jobs.Manager {
+	logger, _ := test.NewNullLogger()
+	m, err := jobs.NewManager(&fakeJobsRepo{}, fakeJobsAuthorizer{allowed: allowed},
+		config.Jobs{}, logger)
+	require.Nil(t, err)
+	return m
+}
+
+type fakeJobsRepo struct{}
+
+func (r *fakeJobsRepo) Put(ctx context.Context, job *jobs.Job) error    { return nil }
+func (r *fakeJobsRepo) List(ctx context.Context) ([]*jobs.Job, error)   { return nil, nil }
+func (r *fakeJobsRepo) Delete(ctx context.Context, ids ...string) error { return nil }
+
+func TestJobs(t *testing.T) {
+	manager := newTestJobs(t, "get", "list")
+	h := &jobHandlers{manager: manager}
+
+	running := manager.Track(jobs.TypeBulkImport, "Article", func() {})
+	done := manager.Track(jobs.TypeBackup, "s3/backup", nil)
+	done.Done(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/jobs", nil)
+	list := func(jobType *string) *httptest.ResponseRecorder {
+		return writeResponse(h.list(jobsops.JobsListParams{HTTPRequest: req, Type: jobType}, nil))
+	}
+	get := func(id string) *httptest.ResponseRecorder {
+		return writeResponse(h.get(jobsops.JobsGetParams{HTTPRequest: req, ID: id}, nil))
+	}
+	cancel := func(h *jobHandlers, id string) *httptest.ResponseRecorder {
+		return writeResponse(h.cancel(jobsops.JobsCancelParams{HTTPRequest: req, ID: id}, nil))
+	}
+
+	t.Run("list", func(t *testing.T) {
+		rec := list(nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res []*jobs.Job
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Len(t, res, 2)
+
+		jobType := jobs.TypeBackup
+		rec = list(&jobType)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 1)
+		assert.Equal(t, done.ID(), res[0].ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		rec := get(done.ID())
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var job jobs.Job
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &job))
+		assert.Equal(t, jobs.StatusSuccess, job.Status)
+
+		assert.Equal(t, http.StatusNotFound, get("unknown").Code)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, cancel(h, running.ID()).Code)
+
+		h := &jobHandlers{manager: newTestJobs(t, "delete")}
+		cancelled := false
+		job := h.manager.Track(jobs.TypeBulkImport, "Article", func() { cancelled = true })
+
+		require.Equal(t, http.StatusOK, cancel(h, job.ID()).Code)
+		assert.True(t, cancelled)
+		assert.Equal(t, http.StatusUnprocessableEntity,
+			cancel(h, job.ID()).Code, "not running anymore")
+	})
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+// multiGetObjectsPath reads objects even though it is a POST
+const multiGetObjectsPath = "/v1/objects/_get"
+
+type multiGetManager interface {
+	MultiGetObjects(ctx context.Context, principal *models.Principal,
+		items []uco.MultiGetItem, properties []string, addl additional.Properties,
+		tenant string) ([]*models.Object, error)
+}
+
+type multiGetHandlers struct {
+	manager multiGetManager
+}
+
+// multiGet fetches many objects in one round trip, e.g. to hydrate the
+// references of a result instead of getting each object on its own. The
+// objects are returned in the order of the request, objects which don't
+// exist are null.
+func (h *multiGetHandlers) multiGet(params objects.ObjectsMultiGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := params.Body
+	if len(req.Objects) == 0 {
+		return objects.NewObjectsMultiGetBadRequest().
+			WithPayload(errPayloadFromSingleErr(errors.New("objects are required")))
+	}
+	items := make([]uco.MultiGetItem, len(req.Objects))
+	for i, item := range req.Objects {
+		if item == nil {
+			return objects.NewObjectsMultiGetBadRequest().WithPayload(
+				errPayloadFromSingleErr(fmt.Errorf("object %d is null", i)))
+		}
+		items[i] = uco.MultiGetItem{Class: item.Class, ID: item.ID}
+		if items[i].Class == "" {
+			items[i].Class = req.Class
+		}
+	}
+
+	var addl additional.Properties
+	if req.Include != "" {
+		var err error
+		include := strings.ReplaceAll(req.Include, " ", "")
+		if addl, err = parseIncludeParam(&include, nil, false, nil); err != nil {
+			return objects.NewObjectsMultiGetBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	objs, err := h.manager.MultiGetObjects(params.HTTPRequest.Context(), principal, items,
+		req.Properties, addl, req.Tenant)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return objects.NewObjectsMultiGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}),
+			errors.As(err, &uco.ErrMultiTenancy{}):
+			return objects.NewObjectsMultiGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsMultiGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return objects.NewObjectsMultiGetOK().WithPayload(&models.MultiGetResponse{Objects: objs})
+}
+
+func setupMultiGetObjects(api *operations.WeaviateAPI, manager multiGetManager) {
+	h := &multiGetHandlers{manager: manager}
+	api.ObjectsObjectsMultiGetHandler = objects.ObjectsMultiGetHandlerFunc(h.multiGet)
+}
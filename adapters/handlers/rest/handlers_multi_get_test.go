@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeMultiGetManager struct {
+	items      []uco.MultiGetItem
+	properties []string
+	addl       additional.Properties
+	tenant     string
+	err        error
+}
+
+func (f *fakeMultiGetManager) MultiGetObjects(ctx context.Context, principal *models.Principal,
+	items []uco.MultiGetItem, properties []string, addl additional.Properties, tenant string,
+) ([]*models.Object, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.items, f.properties, f.addl, f.tenant = items, properties, addl, tenant
+	out := make([]*models.Object, len(items))
+	for i, item := range items {
+		if item.Class != "" {
+			out[i] = &models.Object{Class: item.Class, ID: item.ID}
+		}
+	}
+	return out, nil
+}
+
+func TestMultiGetObjects(t *testing.T) {
+	bind := func(body string) (objects.ObjectsMultiGetParams, error) {
+		params := objects.NewObjectsMultiGetParams()
+		req := httptest.NewRequest(http.MethodPost, multiGetObjectsPath, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		err := bindRequest(&params, req)
+		return params, err
+	}
+	serve := func(m *fakeMultiGetManager, body string) *httptest.ResponseRecorder {
+		params, err := bind(body)
+		require.Nil(t, err)
+		h := &multiGetHandlers{manager: m}
+		return writeResponse(h.multiGet(params, nil))
+	}
+
+	t.Run("objects with projection", func(t *testing.T) {
+		m := &fakeMultiGetManager{}
+		rec := serve(m, `{
+			"class": "Article",
+			"objects": [
+				{"id": "8c29da7a-600a-43dc-85fb-83ab2b08c294"},
+				{"class": "Author", "id": "4d2ae3b8-2a4a-4f4a-b5b6-0a1c7fd9e2a1"}
+			],
+			"properties": ["title"],
+			"include": "vector, classification",
+			"tenant": "t1"
+		}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res models.MultiGetResponse
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Objects, 2)
+		assert.Equal(t, "Article", res.Objects[0].Class)
+		assert.Equal(t, "Author", res.Objects[1].Class)
+		assert.Equal(t, []uco.MultiGetItem{
+			{Class: "Article", ID: strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")},
+			{Class: "Author", ID: strfmt.UUID("4d2ae3b8-2a4a-4f4a-b5b6-0a1c7fd9e2a1")},
+		}, m.items)
+		assert.Equal(t, []string{"title"}, m.properties)
+		assert.True(t, m.addl.Vector)
+		assert.True(t, m.addl.Classification)
+		assert.Equal(t, "t1", m.tenant)
+	})
+
+	t.Run("missing objects are null", func(t *testing.T) {
+		rec := serve(&fakeMultiGetManager{},
+			`{"objects": [{"id": "8c29da7a-600a-43dc-85fb-83ab2b08c294"}]}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"objects": [null]}`, rec.Body.String())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := bind(`{`)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, serve(&fakeMultiGetManager{}, `{"objects": []}`).Code)
+		assert.Equal(t, http.StatusBadRequest, serve(&fakeMultiGetManager{},
+			`{"objects": [{"id": "8c29da7a-600a-43dc-85fb-83ab2b08c294"}], "include": "unknown"}`).Code)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		body := `{"objects": [{"id": "8c29da7a-600a-43dc-85fb-83ab2b08c294"}]}`
+		assert.Equal(t, http.StatusForbidden, serve(&fakeMultiGetManager{
+			err: autherrs.NewForbidden(nil, "get", "objects"),
+		}, body).Code)
+		assert.Equal(t, http.StatusUnprocessableEntity, serve(&fakeMultiGetManager{
+			err: uco.NewErrInvalidUserInput("too many"),
+		}, body).Code)
+	})
+}
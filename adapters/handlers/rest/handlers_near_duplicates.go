@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/neardup"
+)
+
+type nearDuplicatesHandlers struct {
+	manager *neardup.Manager
+}
+
+// detect starts a near-duplicate detection job. The distance threshold is
+// required, the mode (report, merge), the amount of neighbors compared with
+// each object and the tenant are optional.
+func (h *nearDuplicatesHandlers) detect(params schema.SchemaObjectsDuplicatesDetectParams,
+	principal *models.Principal,
+) middleware.Responder {
+	opts := neardup.Options{
+		Tenant:   getTenant(params.Tenant),
+		Distance: params.Distance,
+	}
+	if params.Mode != nil {
+		opts.Mode = *params.Mode
+	}
+	if params.Neighbors != nil {
+		opts.Neighbors = int(*params.Neighbors)
+	}
+
+	report, err := h.manager.Detect(principal, params.ClassName, opts)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsDuplicatesDetectForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsDuplicatesDetectNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsDuplicatesDetectUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsDuplicatesDetectInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsDuplicatesDetectAccepted().WithPayload(report)
+}
+
+// status returns the report of the current or last detection job
+func (h *nearDuplicatesHandlers) status(params schema.SchemaObjectsDuplicatesStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := h.manager.Status(principal, params.ClassName, getTenant(params.Tenant))
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsDuplicatesStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsDuplicatesStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsDuplicatesStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsDuplicatesStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsDuplicatesStatusOK().WithPayload(report)
+}
+
+func setupNearDuplicates(api *operations.WeaviateAPI, appState *state.State,
+	repo neardup.Repo, writer neardup.Writer,
+) {
+	manager := neardup.NewManager(repo, writer, appState.SchemaManager,
+		appState.Authorizer, appState.Logger)
+	manager.SetJobs(appState.Jobs)
+	h := &nearDuplicatesHandlers{manager: manager}
+	api.SchemaSchemaObjectsDuplicatesDetectHandler = schema.
+		SchemaObjectsDuplicatesDetectHandlerFunc(h.detect)
+	api.SchemaSchemaObjectsDuplicatesStatusHandler = schema.
+		SchemaObjectsDuplicatesStatusHandlerFunc(h.status)
+}
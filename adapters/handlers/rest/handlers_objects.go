@@ -15,7 +15,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
@@ -38,6 +40,12 @@ type objectHandlers struct {
 	config              config.Config
 	modulesProvider     ModulesProvider
 	metricRequestsTotal restApiRequestsTotal
+	aliases             aliasResolver
+}
+
+// aliasResolver maps a class alias to the class it points to
+type aliasResolver interface {
+	ResolveAlias(name string) string
 }
 
 type ModulesProvider interface {
@@ -77,6 +85,9 @@ type objectsManager interface {
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	if params.Body != nil {
+		params.Body.Class = h.resolveAlias(params.Body.Class)
+	}
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
@@ -85,8 +96,8 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	}
 	className := getClassName(params.Body)
 
-	object, err := h.manager.AddObject(params.HTTPRequest.Context(),
-		principal, params.Body, repl)
+	ctx := withIDProperties(params.HTTPRequest.Context(), params.IDProperties)
+	object, err := h.manager.AddObject(ctx, principal, params.Body, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
@@ -116,6 +127,9 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	if params.Body != nil {
+		params.Body.Class = h.resolveAlias(params.Body.Class)
+	}
 	className := getClassName(params.Body)
 	err := h.manager.ValidateObject(params.HTTPRequest.Context(), principal, params.Body, nil)
 	if err != nil {
@@ -144,6 +158,7 @@ func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	var additional additional.Properties
 
 	// The process to extract additional params depends on knowing the schema
@@ -202,7 +217,8 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(object))
-	return objects.NewObjectsClassGetOK().WithPayload(object)
+	return objects.NewObjectsClassGetOK().WithPayload(object).
+		WithETag(formatETag(object.LastUpdateTimeUnix))
 }
 
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
@@ -218,9 +234,17 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	ctx, cancel, err := queryContext(params.HTTPRequest)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return objects.NewObjectsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	defer cancel()
+
 	var deprecationsRes []*models.Deprecation
 
-	list, err := h.manager.GetObjects(params.HTTPRequest.Context(), principal,
+	list, err := h.manager.GetObjects(ctx, principal,
 		params.Offset, params.Limit, params.Sort, params.Order, params.After, additional,
 		getTenant(params.Tenant))
 	if err != nil {
@@ -257,12 +281,21 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 func (h *objectHandlers) query(params objects.ObjectsListParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	*params.Class = h.resolveAlias(*params.Class)
 	additional, err := parseIncludeParam(params.Include, h.modulesProvider, h.shouldIncludeGetObjectsModuleParams(), nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(*params.Class, err)
 		return objects.NewObjectsListBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	ctx, cancel, err := queryContext(params.HTTPRequest)
+	if err != nil {
+		h.metricRequestsTotal.logError(*params.Class, err)
+		return objects.NewObjectsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	defer cancel()
+
 	req := uco.QueryParams{
 		Class:      *params.Class,
 		Offset:     params.Offset,
@@ -273,7 +306,7 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		Tenant:     params.Tenant,
 		Additional: additional,
 	}
-	resultSet, rerr := h.manager.Query(params.HTTPRequest.Context(), principal, &req)
+	resultSet, rerr := h.manager.Query(ctx, principal, &req)
 	if rerr != nil {
 		h.metricRequestsTotal.logError(req.Class, rerr)
 		switch rerr.Code {
@@ -314,6 +347,7 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
@@ -333,7 +367,10 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassDeleteNotFound()
-		case uco.ErrMultiTenancy:
+		case uco.ErrConflict:
+			return objects.NewObjectsClassDeleteConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrMultiTenancy, uco.ErrInvalidUserInput:
 			return objects.NewObjectsClassDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -349,6 +386,10 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
+	if params.Body != nil {
+		params.Body.Class = h.resolveAlias(params.Body.Class)
+	}
 	className := getClassName(params.Body)
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
@@ -370,6 +411,9 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrConflict{}) {
+			return objects.NewObjectsClassPutConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else {
 			return objects.NewObjectsClassPutInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -382,12 +426,14 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	}
 
 	h.metricRequestsTotal.logOk(className)
-	return objects.NewObjectsClassPutOK().WithPayload(object)
+	return objects.NewObjectsClassPutOK().WithPayload(object).
+		WithETag(formatETag(object.LastUpdateTimeUnix))
 }
 
 func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
@@ -422,6 +468,7 @@ func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 }
 
 func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, principal *models.Principal) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	updates := params.Body
 	updates.ID = params.ID
 	updates.Class = params.ClassName
@@ -442,6 +489,9 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 		case objErr.Forbidden():
 			return objects.NewObjectsClassPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Conflict():
+			return objects.NewObjectsClassPatchConflict().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		case objErr.BadRequest():
 			return objects.NewObjectsClassPatchUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -462,6 +512,7 @@ func (h *objectHandlers) addObjectReference(
 	params objects.ObjectsClassReferencesCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	input := uco.AddReferenceInput{
 		Class:    params.ClassName,
 		ID:       params.ID,
@@ -505,6 +556,7 @@ func (h *objectHandlers) addObjectReference(
 func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferencesPutParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	input := uco.PutReferenceInput{
 		Class:    params.ClassName,
 		ID:       params.ID,
@@ -549,6 +601,7 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassReferencesDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	params.ClassName = h.resolveAlias(params.ClassName)
 	input := uco.DeleteReferenceInput{
 		Class:     params.ClassName,
 		ID:        params.ID,
@@ -592,8 +645,9 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 func setupObjectHandlers(api *operations.WeaviateAPI,
 	manager *uco.Manager, config config.Config, logger logrus.FieldLogger,
 	modulesProvider ModulesProvider, metrics *monitoring.PrometheusMetrics,
+	aliases aliasResolver,
 ) {
-	h := &objectHandlers{manager, logger, config, modulesProvider, newObjectsRequestsTotal(metrics, logger)}
+	h := &objectHandlers{manager, logger, config, modulesProvider, newObjectsRequestsTotal(metrics, logger), aliases}
 	api.ObjectsObjectsCreateHandler = objects.
 		ObjectsCreateHandlerFunc(h.addObject)
 	api.ObjectsObjectsValidateHandler = objects.
@@ -897,6 +951,27 @@ func getConsistencyLevel(lvl *string) (string, error) {
 	return "", nil
 }
 
+// queryContext derives the context of a list request. If the optional
+// "timeout" query parameter is set, the context is cancelled once the
+// timeout is exceeded, so the query stops consuming resources server-side.
+func queryContext(r *http.Request) (context.Context, context.CancelFunc, error) {
+	raw := r.URL.Query().Get("timeout")
+	if raw == "" {
+		return r.Context(), func() {}, nil
+	}
+
+	timeout, err := time.ParseDuration(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid 'timeout' parameter: %w", err)
+	}
+	if timeout <= 0 {
+		return nil, nil, fmt.Errorf("invalid 'timeout' parameter: must be positive, got %s", timeout)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	return ctx, cancel, nil
+}
+
 func getTenant(maybeKey *string) string {
 	if maybeKey != nil {
 		return *maybeKey
@@ -904,6 +979,29 @@ func getTenant(maybeKey *string) string {
 	return ""
 }
 
+// withIDProperties passes the comma-separated idProperties parameter on to
+// the id derivation of new objects
+func withIDProperties(ctx context.Context, param *string) context.Context {
+	if param == nil {
+		return ctx
+	}
+	var props []string
+	for _, prop := range strings.Split(*param, ",") {
+		if prop = strings.TrimSpace(prop); prop != "" {
+			props = append(props, prop)
+		}
+	}
+	return uco.WithIDProperties(ctx, props)
+}
+
+// resolveAlias returns the class className points to if it is an alias
+func (h *objectHandlers) resolveAlias(className string) string {
+	if h.aliases == nil {
+		return className
+	}
+	return h.aliases.ResolveAlias(className)
+}
+
 func getClassName(obj *models.Object) string {
 	if obj != nil {
 		return obj.Class
@@ -16,6 +16,7 @@ import (
 	stderrors "errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -1039,6 +1040,37 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 	})
 }
 
+func TestQueryContext(t *testing.T) {
+	t.Run("without timeout", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/v1/objects", nil)
+		ctx, cancel, err := queryContext(req)
+		require.Nil(t, err)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("with timeout", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/v1/objects?timeout=2s", nil)
+		ctx, cancel, err := queryContext(req)
+		require.Nil(t, err)
+		defer cancel()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(2*time.Second), deadline, time.Second)
+	})
+
+	t.Run("with invalid timeout", func(t *testing.T) {
+		for _, timeout := range []string{"soon", "0s", "-1s"} {
+			req := httptest.NewRequest("GET", "/v1/objects?timeout="+timeout, nil)
+			_, _, err := queryContext(req)
+			assert.NotNil(t, err, timeout)
+		}
+	})
+}
+
 type fakeManager struct {
 	getObjectReturn *models.Object
 	getObjectErr    error
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type propertyDeleter interface {
+	DeleteClassProperty(ctx context.Context, principal *models.Principal, class, property string) error
+}
+
+type propertyDeleteHandlers struct {
+	manager propertyDeleter
+}
+
+// deleteProperty removes a property from a class. The data of the property
+// is removed in the background, which can be followed in the jobs API.
+func (h *propertyDeleteHandlers) deleteProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if params.Body.Name == "" {
+		return schema.NewSchemaObjectsPropertiesDeleteBadRequest().
+			WithPayload(errPayloadFromSingleErr(errors.New("name is required")))
+	}
+
+	err := h.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPropertiesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsPropertiesDeleteConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsPropertiesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsPropertiesDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsPropertiesDeleteAccepted()
+}
+
+func setupPropertyDelete(api *operations.WeaviateAPI, manager propertyDeleter) {
+	h := &propertyDeleteHandlers{manager: manager}
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteProperty)
+}
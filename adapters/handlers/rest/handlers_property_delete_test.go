@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakePropertyDeleter struct {
+	props map[string][]string
+}
+
+func (f *fakePropertyDeleter) DeleteClassProperty(ctx context.Context, principal *models.Principal,
+	class, property string,
+) error {
+	props, ok := f.props[class]
+	if !ok {
+		return fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	for i, prop := range props {
+		if prop == property {
+			f.props[class] = append(props[:i], props[i+1:]...)
+			return nil
+		}
+	}
+	return enterrors.NewErrUnprocessable(fmt.Errorf("property %q not found", property))
+}
+
+func TestDeleteProperty(t *testing.T) {
+	manager := &fakePropertyDeleter{props: map[string][]string{"Article": {"title", "body"}}}
+	h := &propertyDeleteHandlers{manager: manager}
+	serve := func(class, body string) int {
+		params := schema.NewSchemaObjectsPropertiesDeleteParams()
+		req := httptest.NewRequest(http.MethodPost, "/v1/schema/"+class+"/properties/delete",
+			strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		require.Nil(t, bindRequest(&params, req, "className", class))
+		return writeResponse(h.deleteProperty(params, nil)).Code
+	}
+
+	assert.Equal(t, http.StatusAccepted, serve("Article", `{"name": "title"}`))
+	assert.Equal(t, []string{"body"}, manager.props["Article"])
+
+	assert.Equal(t, http.StatusUnprocessableEntity, serve("Article", `{"name": "title"}`))
+	assert.Equal(t, http.StatusNotFound, serve("Missing", `{"name": "title"}`))
+	assert.Equal(t, http.StatusBadRequest, serve("Article", `{}`))
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type propertyTokenizationUpdater interface {
+	UpdatePropertyTokenization(ctx context.Context, principal *models.Principal,
+		class, property, tokenization string) error
+}
+
+type propertyTokenizationHandlers struct {
+	manager propertyTokenizationUpdater
+}
+
+// updateTokenization changes the tokenization of a text property. The
+// property is reindexed in the background, which can be followed in the
+// jobs API.
+func (h *propertyTokenizationHandlers) updateTokenization(
+	params schema.SchemaObjectsPropertiesTokenizationParams, principal *models.Principal,
+) middleware.Responder {
+	if params.Body.Name == "" || params.Body.Tokenization == "" {
+		return schema.NewSchemaObjectsPropertiesTokenizationBadRequest().WithPayload(
+			errPayloadFromSingleErr(errors.New("name and tokenization are required")))
+	}
+
+	err := h.manager.UpdatePropertyTokenization(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Name, params.Body.Tokenization)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPropertiesTokenizationNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsPropertiesTokenizationConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesTokenizationForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsPropertiesTokenizationNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsPropertiesTokenizationUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesTokenizationInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsPropertiesTokenizationAccepted()
+}
+
+func setupPropertyTokenization(api *operations.WeaviateAPI, manager propertyTokenizationUpdater) {
+	h := &propertyTokenizationHandlers{manager: manager}
+	api.SchemaSchemaObjectsPropertiesTokenizationHandler = schema.
+		SchemaObjectsPropertiesTokenizationHandlerFunc(h.updateTokenization)
+}
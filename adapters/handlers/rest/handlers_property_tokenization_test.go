@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakePropertyTokenizationUpdater struct {
+	props map[string]map[string]string
+}
+
+func (f *fakePropertyTokenizationUpdater) UpdatePropertyTokenization(ctx context.Context,
+	principal *models.Principal, class, property, tokenization string,
+) error {
+	props, ok := f.props[class]
+	if !ok {
+		return fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	current, ok := props[property]
+	if !ok || current == tokenization {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("cannot change tokenization of %q", property))
+	}
+	props[property] = tokenization
+	return nil
+}
+
+func TestUpdatePropertyTokenization(t *testing.T) {
+	manager := &fakePropertyTokenizationUpdater{props: map[string]map[string]string{
+		"Article": {"title": "word"},
+	}}
+	h := &propertyTokenizationHandlers{manager: manager}
+	serve := func(class, body string) int {
+		params := schema.NewSchemaObjectsPropertiesTokenizationParams()
+		req := httptest.NewRequest(http.MethodPost, "/v1/schema/"+class+"/properties/tokenization",
+			strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		require.Nil(t, bindRequest(&params, req, "className", class))
+		return writeResponse(h.updateTokenization(params, nil)).Code
+	}
+
+	body := `{"name": "title", "tokenization": "field"}`
+	assert.Equal(t, http.StatusAccepted, serve("Article", body))
+	assert.Equal(t, "field", manager.props["Article"]["title"])
+
+	assert.Equal(t, http.StatusUnprocessableEntity, serve("Article", body))
+	assert.Equal(t, http.StatusNotFound, serve("Missing", body))
+	assert.Equal(t, http.StatusBadRequest, serve("Article", `{"name": "title"}`))
+}
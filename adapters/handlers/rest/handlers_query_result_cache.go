@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+var errQueryResultCacheDisabled = errors.New("query result cache is disabled, " +
+	"set QUERY_RESULT_CACHE_ENABLED to enable it")
+
+type queryResultCacheHandlers struct {
+	cache      *traverser.ResultCache // nil if the cache is disabled
+	authorizer authorization.Authorizer
+}
+
+// get returns the hit rate of the query result cache of this node
+func (h *queryResultCacheHandlers) get(params admin.QueryResultCacheGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", "query-result-cache"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewQueryResultCacheGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewQueryResultCacheGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if h.cache == nil {
+		return admin.NewQueryResultCacheGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errQueryResultCacheDisabled))
+	}
+	return admin.NewQueryResultCacheGetOK().WithPayload(h.cache.Stats())
+}
+
+// flush empties the query result cache of this node
+func (h *queryResultCacheHandlers) flush(params admin.QueryResultCacheFlushParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", "query-result-cache"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewQueryResultCacheFlushForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewQueryResultCacheFlushInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if h.cache == nil {
+		return admin.NewQueryResultCacheFlushUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errQueryResultCacheDisabled))
+	}
+	h.cache.Flush()
+	return admin.NewQueryResultCacheFlushOK().WithPayload(h.cache.Stats())
+}
+
+func setupQueryResultCache(api *operations.WeaviateAPI, appState *state.State) {
+	h := &queryResultCacheHandlers{
+		cache:      appState.QueryResultCache,
+		authorizer: appState.Authorizer,
+	}
+	api.AdminQueryResultCacheGetHandler = admin.QueryResultCacheGetHandlerFunc(h.get)
+	api.AdminQueryResultCacheFlushHandler = admin.QueryResultCacheFlushHandlerFunc(h.flush)
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+var errQueryVectorCacheDisabled = errors.New("query vector cache is disabled, " +
+	"set QUERY_VECTOR_CACHE_ENABLED to enable it")
+
+type queryVectorCacheHandlers struct {
+	cache      *modules.QueryVectorCache // nil if the cache is disabled
+	authorizer authorization.Authorizer
+}
+
+// get returns the hit rate of the query vector cache of this node
+func (h *queryVectorCacheHandlers) get(params admin.QueryVectorCacheGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", "modules/query-vector-cache"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewQueryVectorCacheGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewQueryVectorCacheGetInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if h.cache == nil {
+		return admin.NewQueryVectorCacheGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errQueryVectorCacheDisabled))
+	}
+	return admin.NewQueryVectorCacheGetOK().WithPayload(h.cache.Stats())
+}
+
+// flush empties the query vector cache of this node
+func (h *queryVectorCacheHandlers) flush(params admin.QueryVectorCacheFlushParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "delete", "modules/query-vector-cache"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewQueryVectorCacheFlushForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewQueryVectorCacheFlushInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if h.cache == nil {
+		return admin.NewQueryVectorCacheFlushUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errQueryVectorCacheDisabled))
+	}
+	h.cache.Flush()
+	return admin.NewQueryVectorCacheFlushOK().WithPayload(h.cache.Stats())
+}
+
+func setupQueryVectorCache(api *operations.WeaviateAPI, appState *state.State) {
+	h := &queryVectorCacheHandlers{
+		cache:      appState.QueryVectorCache,
+		authorizer: appState.Authorizer,
+	}
+	api.AdminQueryVectorCacheGetHandler = admin.QueryVectorCacheGetHandlerFunc(h.get)
+	api.AdminQueryVectorCacheFlushHandler = admin.QueryVectorCacheFlushHandlerFunc(h.flush)
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/refintegrity"
+)
+
+type referenceIntegrityHandlers struct {
+	manager *refintegrity.Manager
+}
+
+// verify starts a verification job. The mode (report, nullify, repair) and
+// tenant are optional.
+func (h *referenceIntegrityHandlers) verify(params schema.SchemaObjectsReferencesVerifyParams,
+	principal *models.Principal,
+) middleware.Responder {
+	opts := refintegrity.Options{Tenant: getTenant(params.Tenant)}
+	if params.Mode != nil {
+		opts.Mode = *params.Mode
+	}
+
+	report, err := h.manager.Verify(principal, params.ClassName, opts)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsReferencesVerifyForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsReferencesVerifyNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsReferencesVerifyUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReferencesVerifyInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsReferencesVerifyAccepted().WithPayload(report)
+}
+
+// status returns the report of the current or last verification job
+func (h *referenceIntegrityHandlers) status(params schema.SchemaObjectsReferencesVerifyStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := h.manager.Status(principal, params.ClassName, getTenant(params.Tenant))
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsReferencesVerifyStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsReferencesVerifyStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsReferencesVerifyStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReferencesVerifyStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsReferencesVerifyStatusOK().WithPayload(report)
+}
+
+func setupReferenceIntegrity(api *operations.WeaviateAPI, appState *state.State,
+	repo refintegrity.Repo, writer refintegrity.Writer,
+) {
+	h := &referenceIntegrityHandlers{
+		manager: refintegrity.NewManager(repo, writer, appState.SchemaManager,
+			appState.Authorizer, appState.Logger),
+	}
+	api.SchemaSchemaObjectsReferencesVerifyHandler = schema.
+		SchemaObjectsReferencesVerifyHandlerFunc(h.verify)
+	api.SchemaSchemaObjectsReferencesVerifyStatusHandler = schema.
+		SchemaObjectsReferencesVerifyStatusHandlerFunc(h.status)
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type renameManager interface {
+	RenameClass(ctx context.Context, principal *models.Principal, from, to string) error
+	RenameProperty(ctx context.Context, principal *models.Principal, class, from, to string) error
+}
+
+type renameHandlers struct {
+	manager renameManager
+}
+
+// renameClass renames a class, the previous name stays usable as an alias
+// of the class
+func (h *renameHandlers) renameClass(params schema.SchemaObjectsRenameParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if params.Body.Name == "" {
+		return schema.NewSchemaObjectsRenameBadRequest().
+			WithPayload(errPayloadFromSingleErr(errors.New("name is required")))
+	}
+
+	err := h.manager.RenameClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsRenameConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsRenameForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsRenameUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsRenameInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsRenameNoContent()
+}
+
+// renameProperty renames a property of a class
+func (h *renameHandlers) renameProperty(params schema.SchemaObjectsPropertiesRenameParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if params.Body.From == "" || params.Body.To == "" {
+		return schema.NewSchemaObjectsPropertiesRenameBadRequest().
+			WithPayload(errPayloadFromSingleErr(errors.New("from and to are required")))
+	}
+
+	err := h.manager.RenameProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.From, params.Body.To)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPropertiesRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsPropertiesRenameConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesRenameForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsPropertiesRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsPropertiesRenameUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesRenameInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsPropertiesRenameNoContent()
+}
+
+func setupRename(api *operations.WeaviateAPI, manager renameManager) {
+	h := &renameHandlers{manager: manager}
+	api.SchemaSchemaObjectsRenameHandler = schema.SchemaObjectsRenameHandlerFunc(h.renameClass)
+	api.SchemaSchemaObjectsPropertiesRenameHandler = schema.
+		SchemaObjectsPropertiesRenameHandlerFunc(h.renameProperty)
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakeRenameManager struct {
+	classes map[string][]string
+}
+
+func (f *fakeRenameManager) RenameClass(ctx context.Context, principal *models.Principal,
+	from, to string,
+) error {
+	props, ok := f.classes[from]
+	if !ok {
+		return fmt.Errorf("class %q: %w", from, schemaUC.ErrNotFound)
+	}
+	if _, ok := f.classes[to]; ok {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("class name %q already exists", to))
+	}
+	delete(f.classes, from)
+	f.classes[to] = props
+	return nil
+}
+
+func (f *fakeRenameManager) RenameProperty(ctx context.Context, principal *models.Principal,
+	class, from, to string,
+) error {
+	props, ok := f.classes[class]
+	if !ok {
+		return fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	for i, prop := range props {
+		if prop == from {
+			props[i] = to
+			return nil
+		}
+	}
+	return enterrors.NewErrUnprocessable(fmt.Errorf("property %q not found", from))
+}
+
+func TestRename(t *testing.T) {
+	manager := &fakeRenameManager{classes: map[string][]string{
+		"Article": {"title"},
+		"Author":  {"name"},
+	}}
+	h := &renameHandlers{manager: manager}
+	req := httptest.NewRequest(http.MethodPost, "/v1/schema", nil)
+	renameClass := func(class, name string) int {
+		return writeResponse(h.renameClass(schema.SchemaObjectsRenameParams{
+			HTTPRequest: req, ClassName: class, Body: &models.ClassRename{Name: name},
+		}, nil)).Code
+	}
+	renameProperty := func(class, from, to string) int {
+		return writeResponse(h.renameProperty(schema.SchemaObjectsPropertiesRenameParams{
+			HTTPRequest: req, ClassName: class, Body: &models.PropertyRename{From: from, To: to},
+		}, nil)).Code
+	}
+
+	t.Run("class", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, renameClass("Article", "Post"))
+		assert.Equal(t, []string{"title"}, manager.classes["Post"])
+
+		assert.Equal(t, http.StatusNotFound, renameClass("Article", "Post"))
+		assert.Equal(t, http.StatusUnprocessableEntity, renameClass("Post", "Author"))
+		assert.Equal(t, http.StatusBadRequest, renameClass("Post", ""))
+	})
+
+	t.Run("property", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, renameProperty("Author", "name", "fullName"))
+		assert.Equal(t, []string{"fullName"}, manager.classes["Author"])
+
+		assert.Equal(t, http.StatusUnprocessableEntity, renameProperty("Author", "name", "other"))
+		assert.Equal(t, http.StatusNotFound, renameProperty("Missing", "name", "other"))
+		assert.Equal(t, http.StatusBadRequest, renameProperty("Author", "name", ""))
+	})
+}
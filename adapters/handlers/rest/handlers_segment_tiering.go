@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/tiering"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type segmentTieringRepo interface {
+	SegmentTieringStatus(className string) (map[string]map[string]lsmkv.SegmentTieringStatus, error)
+	SetSegmentsPinned(ctx context.Context, className string, pinned bool) error
+}
+
+type segmentTieringHandlers struct {
+	repo       segmentTieringRepo
+	authorizer authorization.Authorizer
+}
+
+// get returns the tiering status of the local shards of a class
+func (h *segmentTieringHandlers) get(params schema.SchemaObjectsTieringGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.authorizer.Authorize(principal, "list", "schema/*")
+	if err == nil {
+		err = h.authorizer.Authorize(principal, "get",
+			authorization.SchemaResource(params.ClassName, ""))
+	}
+	if err != nil {
+		return schema.NewSchemaObjectsTieringGetForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	status, err := h.status(params.ClassName)
+	if err != nil {
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsTieringGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsTieringGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsTieringGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsTieringGetOK().WithPayload(status)
+}
+
+// update restores all tiered segments of the class and keeps them local if
+// pinned is true, false allows tiering again
+func (h *segmentTieringHandlers) update(params schema.SchemaObjectsTieringUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.authorizer.Authorize(principal, "update", "schema/objects")
+	if err == nil {
+		err = h.authorizer.Authorize(principal, "update",
+			authorization.SchemaResource(params.ClassName, ""))
+	}
+	if err != nil {
+		return schema.NewSchemaObjectsTieringUpdateForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	err = h.repo.SetSegmentsPinned(params.HTTPRequest.Context(), params.ClassName,
+		*params.Body.Pinned)
+	var status *models.SegmentTieringStatus
+	if err == nil {
+		status, err = h.status(params.ClassName)
+	}
+	if err != nil {
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsTieringUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsTieringUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsTieringUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsTieringUpdateOK().WithPayload(status)
+}
+
+func (h *segmentTieringHandlers) status(className string) (*models.SegmentTieringStatus, error) {
+	shards, err := h.repo.SegmentTieringStatus(className)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SegmentTieringStatus{Class: className, Shards: shards}, nil
+}
+
+// newSegmentTiering creates the remote store and block cache shared by all
+// tiered buckets, it returns nil if tiering is disabled
+func newSegmentTiering(cfg config.SegmentTiering,
+	promMetrics *monitoring.PrometheusMetrics,
+) (*lsmkv.Tiering, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	store, err := tiering.NewS3(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return lsmkv.NewTiering(store, cfg.Prefix,
+		time.Duration(cfg.MinSegmentAgeSeconds)*time.Second,
+		cfg.BlockSizeKB*1024, cfg.CacheSizeMB*1024*1024, promMetrics), nil
+}
+
+func setupSegmentTiering(api *operations.WeaviateAPI, appState *state.State,
+	repo segmentTieringRepo,
+) {
+	h := &segmentTieringHandlers{
+		repo:       repo,
+		authorizer: appState.Authorizer,
+	}
+	api.SchemaSchemaObjectsTieringGetHandler = schema.SchemaObjectsTieringGetHandlerFunc(h.get)
+	api.SchemaSchemaObjectsTieringUpdateHandler = schema.SchemaObjectsTieringUpdateHandlerFunc(h.update)
+}
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+)
+
+var errSlowQueryLogDisabled = errors.New("slow query log is disabled, " +
+	"set SLOW_QUERY_LOG_ENABLED to enable it")
+
+type slowQueryHandlers struct {
+	log        *slowquery.Log // nil if the slow query log is disabled
+	authorizer authorization.Authorizer
+}
+
+// list returns the slow queries of this node which are kept in memory, the
+// most recent one first
+func (h *slowQueryHandlers) list(params admin.DebugSlowQueriesParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, "get", "debug/slow-queries"); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return admin.NewDebugSlowQueriesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return admin.NewDebugSlowQueriesInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if h.log == nil {
+		return admin.NewDebugSlowQueriesUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(errSlowQueryLogDisabled))
+	}
+
+	return admin.NewDebugSlowQueriesOK().WithPayload(jsonObjects(h.log.Entries()))
+}
+
+func setupSlowQueries(api *operations.WeaviateAPI, appState *state.State) {
+	h := &slowQueryHandlers{
+		log:        appState.SlowQueryLog,
+		authorizer: appState.Authorizer,
+	}
+	api.AdminDebugSlowQueriesHandler = admin.DebugSlowQueriesHandlerFunc(h.list)
+}
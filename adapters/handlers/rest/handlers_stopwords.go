@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type stopwordsManager interface {
+	GetStopwords(ctx context.Context, principal *models.Principal,
+		class string) (*models.StopwordConfig, error)
+	ReplaceStopwords(ctx context.Context, principal *models.Principal,
+		class string, version int64, conf *models.StopwordConfig) (*models.StopwordConfig, error)
+	UpdateStopwords(ctx context.Context, principal *models.Principal,
+		class string, version int64, add, remove []string) (*models.StopwordConfig, error)
+}
+
+type stopwordsHandlers struct {
+	manager stopwordsManager
+}
+
+// get returns the stopword config of a class and the presets available to
+// choose from
+func (h *stopwordsHandlers) get(params schema.SchemaObjectsStopwordsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	conf, err := h.manager.GetStopwords(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsStopwordsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsStopwordsGetConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsStopwordsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsStopwordsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsStopwordsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsStopwordsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsStopwordsGetOK().
+		WithPayload(classStopwordsPayload(params.ClassName, conf))
+}
+
+// replace replaces the stopword config of a class. The version the change is
+// based on fails the change with a conflict if the config was changed in the
+// meantime.
+func (h *stopwordsHandlers) replace(params schema.SchemaObjectsStopwordsReplaceParams,
+	principal *models.Principal,
+) middleware.Responder {
+	conf, err := h.manager.ReplaceStopwords(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Version, params.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsStopwordsReplaceNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsStopwordsReplaceConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsStopwordsReplaceForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsStopwordsReplaceNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsStopwordsReplaceUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsStopwordsReplaceInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsStopwordsReplaceOK().
+		WithPayload(classStopwordsPayload(params.ClassName, conf))
+}
+
+// update adds and removes stopwords, the version works like on replace
+func (h *stopwordsHandlers) update(params schema.SchemaObjectsStopwordsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if len(params.Body.Add)+len(params.Body.Remove) == 0 {
+		return schema.NewSchemaObjectsStopwordsUpdateBadRequest().WithPayload(
+			errPayloadFromSingleErr(errors.New("add or remove at least one stopword")))
+	}
+
+	conf, err := h.manager.UpdateStopwords(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Version, params.Body.Add, params.Body.Remove)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsStopwordsUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsStopwordsUpdateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsStopwordsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsStopwordsUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsStopwordsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsStopwordsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsStopwordsUpdateOK().
+		WithPayload(classStopwordsPayload(params.ClassName, conf))
+}
+
+func classStopwordsPayload(className string, conf *models.StopwordConfig) *models.ClassStopwords {
+	presets := make([]string, 0, len(stopwords.Presets))
+	for preset := range stopwords.Presets {
+		presets = append(presets, preset)
+	}
+	sort.Strings(presets)
+
+	payload := &models.ClassStopwords{Class: className, Presets: presets}
+	if conf != nil {
+		payload.StopwordConfig = *conf
+	}
+	return payload
+}
+
+func setupStopwords(api *operations.WeaviateAPI, manager stopwordsManager) {
+	h := &stopwordsHandlers{manager: manager}
+	api.SchemaSchemaObjectsStopwordsGetHandler = schema.SchemaObjectsStopwordsGetHandlerFunc(h.get)
+	api.SchemaSchemaObjectsStopwordsReplaceHandler = schema.
+		SchemaObjectsStopwordsReplaceHandlerFunc(h.replace)
+	api.SchemaSchemaObjectsStopwordsUpdateHandler = schema.
+		SchemaObjectsStopwordsUpdateHandlerFunc(h.update)
+}
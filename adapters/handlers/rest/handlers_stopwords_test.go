@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakeStopwordsManager struct {
+	confs       map[string]*models.StopwordConfig
+	add, remove []string
+}
+
+func (f *fakeStopwordsManager) current(class string, version int64) (*models.StopwordConfig, error) {
+	conf, ok := f.confs[class]
+	if !ok {
+		return nil, fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	if version != 0 && version != conf.Version {
+		return nil, schemaUC.ErrVersionConflict
+	}
+	return conf, nil
+}
+
+func (f *fakeStopwordsManager) GetStopwords(ctx context.Context, principal *models.Principal,
+	class string,
+) (*models.StopwordConfig, error) {
+	return f.current(class, 0)
+}
+
+func (f *fakeStopwordsManager) ReplaceStopwords(ctx context.Context, principal *models.Principal,
+	class string, version int64, conf *models.StopwordConfig,
+) (*models.StopwordConfig, error) {
+	current, err := f.current(class, version)
+	if err != nil {
+		return nil, err
+	}
+	conf.Version = current.Version + 1
+	f.confs[class] = conf
+	return conf, nil
+}
+
+func (f *fakeStopwordsManager) UpdateStopwords(ctx context.Context, principal *models.Principal,
+	class string, version int64, add, remove []string,
+) (*models.StopwordConfig, error) {
+	current, err := f.current(class, version)
+	if err != nil {
+		return nil, err
+	}
+	f.add, f.remove = add, remove
+	current.Version++
+	return current, nil
+}
+
+func TestStopwords(t *testing.T) {
+	manager := &fakeStopwordsManager{confs: map[string]*models.StopwordConfig{
+		"Article": {Preset: "en", Version: 1},
+	}}
+	h := &stopwordsHandlers{manager: manager}
+	serve := func(method, class, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/v1/schema/"+class+"/stopwords", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		var res middleware.Responder
+		switch method {
+		case http.MethodGet:
+			params := schema.NewSchemaObjectsStopwordsGetParams()
+			require.Nil(t, bindRequest(&params, req, "className", class))
+			res = h.get(params, nil)
+		case http.MethodPut:
+			params := schema.NewSchemaObjectsStopwordsReplaceParams()
+			require.Nil(t, bindRequest(&params, req, "className", class))
+			res = h.replace(params, nil)
+		case http.MethodPatch:
+			params := schema.NewSchemaObjectsStopwordsUpdateParams()
+			require.Nil(t, bindRequest(&params, req, "className", class))
+			res = h.update(params, nil)
+		}
+		return writeResponse(res)
+	}
+
+	rec := serve(http.MethodGet, "Article", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var res map[string]interface{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, "Article", res["class"])
+	assert.Equal(t, "en", res["preset"])
+	assert.Equal(t, float64(1), res["version"])
+	assert.Equal(t, []interface{}{"en", "none"}, res["presets"])
+
+	rec = serve(http.MethodPut, "Article", `{"preset": "none", "additions": ["star"], "version": 1}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, &models.StopwordConfig{Preset: "none", Additions: []string{"star"}, Version: 2},
+		manager.confs["Article"])
+
+	rec = serve(http.MethodPatch, "Article", `{"add": ["moon"], "remove": ["star"]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"moon"}, manager.add)
+	assert.Equal(t, []string{"star"}, manager.remove)
+
+	assert.Equal(t, http.StatusConflict,
+		serve(http.MethodPatch, "Article", `{"add": ["moon"], "version": 1}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPatch, "Article", `{}`).Code)
+	params := schema.NewSchemaObjectsStopwordsReplaceParams()
+	req := httptest.NewRequest(http.MethodPut, "/v1/schema/Article/stopwords", strings.NewReader(`[`))
+	req.Header.Set("Content-Type", "application/json")
+	assert.NotNil(t, bindRequest(&params, req, "className", "Article"))
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodGet, "Missing", "").Code)
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/suggest"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const defaultSuggestLimit = 10
+
+type suggestRepo interface {
+	Suggest(ctx context.Context, className, propName, prefix string,
+		limit int, tenant string) (*suggest.Result, error)
+}
+
+type suggestHandlers struct {
+	repo       suggestRepo
+	authorizer authorization.Authorizer
+}
+
+// suggest returns the most frequent terms of a property starting with the
+// prefix query parameter, for autocompletion of search input
+func (h *suggestHandlers) suggest(params schema.SchemaObjectsSuggestParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.authorizer.Authorize(principal, "get", "traversal/*")
+	if err == nil {
+		err = h.authorizer.Authorize(principal, "get",
+			authorization.ClassResource(params.ClassName, ""))
+	}
+	if err != nil {
+		return schema.NewSchemaObjectsSuggestForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	limit := defaultSuggestLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	res, err := h.repo.Suggest(params.HTTPRequest.Context(), params.ClassName,
+		params.Property, params.Prefix, limit, getTenant(params.Tenant))
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsSuggestForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsSuggestNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsSuggestUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSuggestInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsSuggestOK().WithPayload(res)
+}
+
+func setupSuggest(api *operations.WeaviateAPI, appState *state.State,
+	repo suggestRepo,
+) {
+	h := &suggestHandlers{
+		repo:       repo,
+		authorizer: appState.Authorizer,
+	}
+	api.SchemaSchemaObjectsSuggestHandler = schema.SchemaObjectsSuggestHandlerFunc(h.suggest)
+}
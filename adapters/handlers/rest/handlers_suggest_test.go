@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/suggest"
+)
+
+type fakeSuggestRepo struct {
+	property, prefix, tenant string
+	limit                    int
+}
+
+func (f *fakeSuggestRepo) Suggest(ctx context.Context, className, propName,
+	prefix string, limit int, tenant string,
+) (*suggest.Result, error) {
+	if className != "Article" {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+	f.property, f.prefix, f.limit, f.tenant = propName, prefix, limit, tenant
+	return &suggest.Result{
+		Class:       className,
+		Property:    propName,
+		Prefix:      prefix,
+		Suggestions: []*suggest.Suggestion{{Term: "apple", Frequency: 3}},
+	}, nil
+}
+
+func TestSuggest(t *testing.T) {
+	newHandlers := func(allowed string) (*suggestHandlers, *fakeSuggestRepo) {
+		repo := &fakeSuggestRepo{}
+		return &suggestHandlers{
+			repo:       repo,
+			authorizer: fakeTenantAuthorizer{allowed: allowed},
+		}, repo
+	}
+	bind := func(className, query string) (schema.SchemaObjectsSuggestParams, error) {
+		params := schema.NewSchemaObjectsSuggestParams()
+		req := httptest.NewRequest(http.MethodGet, "/v1/schema/"+className+"/suggest?"+query, nil)
+		err := bindRequest(&params, req, "className", className)
+		return params, err
+	}
+	serve := func(h *suggestHandlers, className, query string) *httptest.ResponseRecorder {
+		params, err := bind(className, query)
+		require.Nil(t, err)
+		return writeResponse(h.suggest(params, nil))
+	}
+
+	t.Run("default limit", func(t *testing.T) {
+		h, repo := newHandlers("get")
+		rec := serve(h, "Article", "property=title&prefix=ap")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res suggest.Result
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, []*suggest.Suggestion{{Term: "apple", Frequency: 3}}, res.Suggestions)
+		assert.Equal(t, "title", repo.property)
+		assert.Equal(t, "ap", repo.prefix)
+		assert.Equal(t, defaultSuggestLimit, repo.limit)
+		assert.Equal(t, "", repo.tenant)
+	})
+
+	t.Run("limit and tenant", func(t *testing.T) {
+		h, repo := newHandlers("get")
+		rec := serve(h, "Article", "property=title&prefix=ap&limit=5&tenant=t1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 5, repo.limit)
+		assert.Equal(t, "t1", repo.tenant)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		for _, limit := range []string{"0", "abc", "101"} {
+			_, err := bind("Article", "property=title&prefix=ap&limit="+limit)
+			assert.NotNil(t, err, limit)
+		}
+	})
+
+	t.Run("missing parameters", func(t *testing.T) {
+		for _, query := range []string{"property=title", "prefix=ap", ""} {
+			_, err := bind("Article", query)
+			assert.NotNil(t, err, query)
+		}
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		h, _ := newHandlers("get")
+		rec := serve(h, "Unknown", "property=title&prefix=ap")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		h, _ := newHandlers("list")
+		rec := serve(h, "Article", "property=title&prefix=ap")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type synonymsManager interface {
+	GetSynonyms(ctx context.Context, principal *models.Principal,
+		class string) ([]*models.SynonymRule, error)
+	UpdateSynonyms(ctx context.Context, principal *models.Principal,
+		class string, rules []*models.SynonymRule) error
+}
+
+type synonymsHandlers struct {
+	manager synonymsManager
+}
+
+// get returns the synonym rules of a class
+func (h *synonymsHandlers) get(params schema.SchemaObjectsSynonymsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	rules, err := h.manager.GetSynonyms(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsSynonymsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsSynonymsGetConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsSynonymsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsSynonymsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsSynonymsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSynonymsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsSynonymsGetOK().
+		WithPayload(&models.ClassSynonyms{Class: params.ClassName, Synonyms: rules})
+}
+
+// update replaces all synonym rules of a class
+func (h *synonymsHandlers) update(params schema.SchemaObjectsSynonymsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	err := h.manager.UpdateSynonyms(ctx, principal, params.ClassName, params.Body.Synonyms)
+	var rules []*models.SynonymRule
+	if err == nil {
+		rules, err = h.manager.GetSynonyms(ctx, principal, params.ClassName)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsSynonymsUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrVersionConflict):
+			return schema.NewSchemaObjectsSynonymsUpdateConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsSynonymsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewSchemaObjectsSynonymsUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewSchemaObjectsSynonymsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSynonymsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return schema.NewSchemaObjectsSynonymsUpdateOK().
+		WithPayload(&models.ClassSynonyms{Class: params.ClassName, Synonyms: rules})
+}
+
+func setupSynonyms(api *operations.WeaviateAPI, manager synonymsManager) {
+	h := &synonymsHandlers{manager: manager}
+	api.SchemaSchemaObjectsSynonymsGetHandler = schema.SchemaObjectsSynonymsGetHandlerFunc(h.get)
+	api.SchemaSchemaObjectsSynonymsUpdateHandler = schema.SchemaObjectsSynonymsUpdateHandlerFunc(h.update)
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakeSynonymsManager struct {
+	rules map[string][]*models.SynonymRule
+}
+
+func (f *fakeSynonymsManager) GetSynonyms(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]*models.SynonymRule, error) {
+	rules, ok := f.rules[class]
+	if !ok {
+		return nil, fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	return rules, nil
+}
+
+func (f *fakeSynonymsManager) UpdateSynonyms(ctx context.Context, principal *models.Principal,
+	class string, rules []*models.SynonymRule,
+) error {
+	if _, ok := f.rules[class]; !ok {
+		return fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	for _, rule := range rules {
+		if len(rule.Terms) < 2 {
+			return enterrors.NewErrUnprocessable(fmt.Errorf("a rule needs at least two terms"))
+		}
+	}
+	f.rules[class] = rules
+	return nil
+}
+
+func TestSynonyms(t *testing.T) {
+	manager := &fakeSynonymsManager{rules: map[string][]*models.SynonymRule{
+		"Article": {},
+	}}
+	h := &synonymsHandlers{manager: manager}
+	bindUpdate := func(class, body string) (schema.SchemaObjectsSynonymsUpdateParams, error) {
+		params := schema.NewSchemaObjectsSynonymsUpdateParams()
+		req := httptest.NewRequest(http.MethodPut, "/v1/schema/"+class+"/synonyms",
+			strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		err := bindRequest(&params, req, "className", class)
+		return params, err
+	}
+	serve := func(method, class, body string) *httptest.ResponseRecorder {
+		if method == http.MethodGet {
+			return writeResponse(h.get(schema.SchemaObjectsSynonymsGetParams{
+				HTTPRequest: httptest.NewRequest(method, "/v1/schema/"+class+"/synonyms", nil),
+				ClassName:   class,
+			}, nil))
+		}
+		params, err := bindUpdate(class, body)
+		require.Nil(t, err)
+		return writeResponse(h.update(params, nil))
+	}
+
+	rec := serve(http.MethodPut, "Article", `{"synonyms": [{"terms": ["tv", "television"]}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	expected := []*models.SynonymRule{{Terms: []string{"tv", "television"}}}
+	assert.Equal(t, expected, manager.rules["Article"])
+
+	rec = serve(http.MethodGet, "Article", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var res models.ClassSynonyms
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, models.ClassSynonyms{Class: "Article", Synonyms: expected}, res)
+
+	assert.Equal(t, http.StatusUnprocessableEntity,
+		serve(http.MethodPut, "Article", `{"synonyms": [{"terms": ["tv"]}]}`).Code)
+	_, err := bindUpdate("Article", `[`)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodGet, "Missing", "").Code)
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodPut, "Missing", `{"synonyms": []}`).Code)
+}
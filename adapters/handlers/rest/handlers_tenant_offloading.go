@@ -0,0 +1,285 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/tiering"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
+	"github.com/weaviate/weaviate/usecases/offload"
+)
+
+type tenantActivityRepo interface {
+	TenantActivity(className string) ([]db.TenantActivity, error)
+	SetTenantStatus(ctx context.Context, className, tenant, status string) error
+	RestoreTenant(ctx context.Context, className, tenant, source string) error
+}
+
+type tenantActivityHandlers struct {
+	repo       tenantActivityRepo
+	authorizer authorization.Authorizer
+	jobs       *jobs.Manager
+}
+
+// get returns the offloading status of the tenants of a class on this node
+func (h *tenantActivityHandlers) get(params schema.TenantsActivityGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.authorizer.Authorize(principal, "get", "schema/tenants")
+	if err == nil {
+		err = h.authorizer.Authorize(principal, "get",
+			authorization.SchemaResource(params.ClassName, ""))
+	}
+	if err != nil {
+		return schema.NewTenantsActivityGetForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	status, err := h.status(params.ClassName)
+	if err != nil {
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewTenantsActivityGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewTenantsActivityGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsActivityGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsActivityGetOK().WithPayload(status)
+}
+
+// update moves the given tenants to the given status. With ?async=true the
+// tenants are moved in the background and the id of the job is returned
+// with 202.
+func (h *tenantActivityHandlers) update(params schema.TenantsActivityUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	className, updates := params.ClassName, params.Body
+	if err := h.authorizer.Authorize(principal, "update", "schema/tenants"); err != nil {
+		return schema.NewTenantsActivityUpdateForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if len(updates) == 0 {
+		return schema.NewTenantsActivityUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("at least one tenant is required")))
+	}
+	for _, update := range updates {
+		err := h.authorizer.Authorize(principal, "update",
+			authorization.SchemaResource(className, update.Name))
+		if err != nil {
+			return schema.NewTenantsActivityUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	if params.Async != nil && *params.Async {
+		jobID := h.runAsync(jobs.TypeTenantActivity, className, len(updates),
+			func(ctx context.Context, i int) error {
+				return h.repo.SetTenantStatus(ctx, className,
+					updates[i].Name, updates[i].Status)
+			})
+		return schema.NewTenantsActivityUpdateAccepted().
+			WithPayload(&models.JobStarted{JobID: jobID})
+	}
+
+	var err error
+	ctx := params.HTTPRequest.Context()
+	for _, update := range updates {
+		if err = h.repo.SetTenantStatus(ctx, className, update.Name, update.Status); err != nil {
+			break
+		}
+	}
+	var status *models.TenantActivityStatus
+	if err == nil {
+		status, err = h.status(className)
+	}
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewTenantsActivityUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewTenantsActivityUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewTenantsActivityUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsActivityUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsActivityUpdateOK().WithPayload(status)
+}
+
+// restore replaces the empty local shards of tenants with the copies which
+// other nodes offloaded. The restored tenants are cold until they are used.
+// With ?async=true the tenants are restored in the background and the id of
+// the job is returned with 202.
+func (h *tenantActivityHandlers) restore(params schema.TenantsRestoreParams,
+	principal *models.Principal,
+) middleware.Responder {
+	className, restores := params.ClassName, params.Body
+	if err := h.authorizer.Authorize(principal, "update", "schema/tenants"); err != nil {
+		return schema.NewTenantsRestoreForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	if len(restores) == 0 {
+		return schema.NewTenantsRestoreBadRequest().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("at least one tenant is required")))
+	}
+	for _, restore := range restores {
+		err := h.authorizer.Authorize(principal, "update",
+			authorization.SchemaResource(className, restore.Name))
+		if err != nil {
+			return schema.NewTenantsRestoreForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	if params.Async != nil && *params.Async {
+		jobID := h.runAsync(jobs.TypeTenantRestore, className, len(restores),
+			func(ctx context.Context, i int) error {
+				return h.repo.RestoreTenant(ctx, className,
+					restores[i].Name, restores[i].Node)
+			})
+		return schema.NewTenantsRestoreAccepted().
+			WithPayload(&models.JobStarted{JobID: jobID})
+	}
+
+	var err error
+	ctx := params.HTTPRequest.Context()
+	for _, restore := range restores {
+		if err = h.repo.RestoreTenant(ctx, className, restore.Name, restore.Node); err != nil {
+			break
+		}
+	}
+	var status *models.TenantActivityStatus
+	if err == nil {
+		status, err = h.status(className)
+	}
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewTenantsRestoreForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return schema.NewTenantsRestoreNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return schema.NewTenantsRestoreUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsRestoreInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsRestoreOK().WithPayload(status)
+}
+
+func (h *tenantActivityHandlers) status(className string) (*models.TenantActivityStatus, error) {
+	tenants, err := h.repo.TenantActivity(className)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TenantActivityStatus{Class: className, Tenants: jsonObjects(tenants)}, nil
+}
+
+// runAsync applies n changes to tenants in the background and tracks them
+// as a job. A cancelled job stops before the next tenant is changed.
+func (h *tenantActivityHandlers) runAsync(jobType, className string, n int,
+	apply func(ctx context.Context, i int) error,
+) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := h.jobs.Track(jobType, className, cancel)
+
+	go func() {
+		defer cancel()
+		for i := 0; i < n; i++ {
+			if err := ctx.Err(); err != nil {
+				job.Done(err)
+				return
+			}
+			if err := apply(ctx, i); err != nil {
+				job.Done(err)
+				return
+			}
+			job.Progress(int64(i+1), int64(n))
+		}
+		job.Done(nil)
+	}()
+
+	return job.ID()
+}
+
+// newTenantOffloading creates the offloading config of the db, tenants are
+// only offloaded on request if automatic offloading is disabled
+func newTenantOffloading(cfg config.TenantOffloading,
+	backends offload.BackendProvider,
+) (db.TenantOffloading, error) {
+	if !cfg.Enabled {
+		return db.TenantOffloading{}, nil
+	}
+
+	offloading := db.TenantOffloading{
+		WarmAfter: time.Duration(cfg.WarmAfterSeconds) * time.Second,
+		ColdAfter: time.Duration(cfg.ColdAfterSeconds) * time.Second,
+		Prefix:    cfg.Prefix,
+	}
+
+	if cfg.Bucket != "" {
+		store, err := tiering.NewS3Bucket(cfg.Endpoint, cfg.Bucket, cfg.UseSSL)
+		if err != nil {
+			return offloading, err
+		}
+		offloading.Store = store
+	}
+	if cfg.Backend != "" {
+		offloading.Offloader = offload.New(backends, cfg.Backend)
+	}
+
+	return offloading, nil
+}
+
+func setupTenantOffloading(api *operations.WeaviateAPI, appState *state.State,
+	repo tenantActivityRepo,
+) {
+	h := &tenantActivityHandlers{
+		repo:       repo,
+		authorizer: appState.Authorizer,
+		jobs:       appState.Jobs,
+	}
+	api.SchemaTenantsActivityGetHandler = schema.TenantsActivityGetHandlerFunc(h.get)
+	api.SchemaTenantsActivityUpdateHandler = schema.TenantsActivityUpdateHandlerFunc(h.update)
+	api.SchemaTenantsRestoreHandler = schema.TenantsRestoreHandlerFunc(h.restore)
+}
@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+type fakeTenantActivityRepo struct {
+	statuses map[string]string
+}
+
+func (f *fakeTenantActivityRepo) TenantActivity(className string) ([]db.TenantActivity, error) {
+	if className != "Article" {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	var out []db.TenantActivity
+	for _, name := range []string{"tenant1", "tenant2"} {
+		out = append(out, db.TenantActivity{Name: name, Status: f.statuses[name]})
+	}
+	return out, nil
+}
+
+func (f *fakeTenantActivityRepo) SetTenantStatus(ctx context.Context,
+	className, tenant, status string,
+) error {
+	if _, ok := f.statuses[tenant]; !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", tenant))
+	}
+	f.statuses[tenant] = status
+	return nil
+}
+
+func (f *fakeTenantActivityRepo) RestoreTenant(ctx context.Context,
+	className, tenant, source string,
+) error {
+	if source != "node1" {
+		return enterrors.NewErrNotFound(
+			fmt.Errorf("node %q has not offloaded tenant %q", source, tenant))
+	}
+	if f.statuses[tenant] != db.TenantHot {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("tenant %q has local objects", tenant))
+	}
+	f.statuses[tenant] = db.TenantCold
+	return nil
+}
+
+type fakeTenantAuthorizer struct {
+	allowed string
+}
+
+func (f fakeTenantAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if verb != f.allowed {
+		return autherrs.NewForbidden(principal, verb, resource)
+	}
+	return nil
+}
+
+func TestTenantActivity(t *testing.T) {
+	newHandlers := func(allowed string) (*tenantActivityHandlers, *fakeTenantActivityRepo) {
+		repo := &fakeTenantActivityRepo{statuses: map[string]string{
+			"tenant1": db.TenantHot, "tenant2": db.TenantWarm,
+		}}
+		return &tenantActivityHandlers{
+			repo:       repo,
+			authorizer: fakeTenantAuthorizer{allowed: allowed},
+		}, repo
+	}
+
+	get := func(h *tenantActivityHandlers, className string) *httptest.ResponseRecorder {
+		return writeResponse(h.get(schema.TenantsActivityGetParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet,
+				"/v1/schema/"+className+"/tenants/activity", nil),
+			ClassName: className,
+		}, nil))
+	}
+	bind := func(params requestBinder, method, path, body string) error {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		return bindRequest(params, req, "className", "Article")
+	}
+	update := func(h *tenantActivityHandlers, query, body string) *httptest.ResponseRecorder {
+		params := schema.NewTenantsActivityUpdateParams()
+		require.Nil(t, bind(&params, http.MethodPut,
+			"/v1/schema/Article/tenants/activity"+query, body))
+		return writeResponse(h.update(params, nil))
+	}
+
+	t.Run("get", func(t *testing.T) {
+		h, _ := newHandlers("get")
+		rec := get(h, "Article")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var status struct {
+			Class   string              `json:"class"`
+			Tenants []db.TenantActivity `json:"tenants"`
+		}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		assert.Equal(t, "Article", status.Class)
+		require.Len(t, status.Tenants, 2)
+		assert.Equal(t, db.TenantWarm, status.Tenants[1].Status)
+	})
+
+	t.Run("get unknown class", func(t *testing.T) {
+		h, _ := newHandlers("get")
+		assert.Equal(t, http.StatusNotFound, get(h, "Unknown").Code)
+	})
+
+	t.Run("put", func(t *testing.T) {
+		h, repo := newHandlers("update")
+		rec := update(h, "", `[{"name": "tenant1", "status": "COLD"}]`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, db.TenantCold, repo.statuses["tenant1"])
+	})
+
+	t.Run("put async", func(t *testing.T) {
+		h, repo := newHandlers("update")
+		h.jobs = newTestJobs(t, "get")
+		rec := update(h, "?async=true",
+			`[{"name": "tenant1", "status": "COLD"}, {"name": "tenant3", "status": "COLD"}]`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		var started models.JobStarted
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &started))
+		job := waitForJob(t, h.jobs, started.JobID)
+		assert.Equal(t, jobs.TypeTenantActivity, job.Type)
+		assert.Equal(t, jobs.StatusFailed, job.Status, "tenant3 does not exist")
+		assert.Equal(t, int64(1), job.Processed)
+		assert.Equal(t, db.TenantCold, repo.statuses["tenant1"])
+	})
+
+	t.Run("put with invalid body", func(t *testing.T) {
+		h, _ := newHandlers("update")
+		params := schema.NewTenantsActivityUpdateParams()
+		assert.NotNil(t, bind(&params, http.MethodPut, "/v1/schema/Article/tenants/activity", `{}`))
+		assert.NotNil(t, bind(&params, http.MethodPut, "/v1/schema/Article/tenants/activity",
+			`[{"name": "tenant1", "status": "FROZEN"}]`))
+		assert.Equal(t, http.StatusBadRequest, update(h, "", `[]`).Code)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		h, _ := newHandlers("update")
+		rec := update(h, "", `[{"name": "tenant3", "status": "WARM"}]`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		h, _ := newHandlers("get")
+		rec := update(h, "", `[{"name": "tenant1", "status": "WARM"}]`)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	restore := func(h *tenantActivityHandlers, query, body string) *httptest.ResponseRecorder {
+		params := schema.NewTenantsRestoreParams()
+		require.Nil(t, bind(&params, http.MethodPost,
+			"/v1/schema/Article/tenants/restore"+query, body))
+		return writeResponse(h.restore(params, nil))
+	}
+
+	t.Run("restore", func(t *testing.T) {
+		h, repo := newHandlers("update")
+		rec := restore(h, "", `[{"name": "tenant1", "node": "node1"}]`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, db.TenantCold, repo.statuses["tenant1"])
+	})
+
+	t.Run("restore async", func(t *testing.T) {
+		h, repo := newHandlers("update")
+		h.jobs = newTestJobs(t, "get")
+		rec := restore(h, "", `[{"name": "tenant1", "node": "node1"}]`)
+		require.Equal(t, http.StatusOK, rec.Code, "synchronous without async")
+
+		repo.statuses["tenant1"] = db.TenantHot
+		rec = restore(h, "?async=true", `[{"name": "tenant1", "node": "node1"}]`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		var started models.JobStarted
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &started))
+		job := waitForJob(t, h.jobs, started.JobID)
+		assert.Equal(t, jobs.TypeTenantRestore, job.Type)
+		assert.Equal(t, "Article", job.Target)
+		assert.Equal(t, jobs.StatusSuccess, job.Status)
+		assert.Equal(t, db.TenantCold, repo.statuses["tenant1"])
+	})
+
+	t.Run("restore errors", func(t *testing.T) {
+		h, _ := newHandlers("update")
+		assert.Equal(t, http.StatusNotFound, restore(h, "",
+			`[{"name": "tenant1", "node": "node2"}]`).Code)
+		assert.Equal(t, http.StatusUnprocessableEntity, restore(h, "",
+			`[{"name": "tenant2", "node": "node1"}]`).Code)
+		assert.Equal(t, http.StatusBadRequest, restore(h, "", `[]`).Code)
+
+		h, _ = newHandlers("get")
+		assert.Equal(t, http.StatusForbidden, restore(h, "",
+			`[{"name": "tenant1", "node": "node1"}]`).Code)
+	})
+}
+
+func waitForJob(t *testing.T, m *jobs.Manager, id string) *jobs.Job {
+	var job *jobs.Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = m.Get(nil, id)
+		require.Nil(t, err)
+		return job.CompletedAt != nil
+	}, time.Second, time.Millisecond)
+	return job
+}
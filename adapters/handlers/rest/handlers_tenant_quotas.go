@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type tenantQuotaManager interface {
+	UpdateTenantQuotas(ctx context.Context, principal *models.Principal,
+		class string, tenants []*models.Tenant) error
+	GetTenants(ctx context.Context, principal *models.Principal,
+		class string) ([]*models.Tenant, error)
+}
+
+type tenantQuotaHandlers struct {
+	manager tenantQuotaManager
+}
+
+// updateQuotas replaces the quotas of existing tenants and responds with the
+// tenants of the class including their current usage. Quotas for new
+// tenants are set when they are created. Like in the tenants API, every
+// error which is neither about a missing class or tenant nor about
+// permissions is a problem with the request.
+func (h *tenantQuotaHandlers) updateQuotas(params schema.TenantsQuotasUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if len(params.Body) == 0 {
+		return schema.NewTenantsQuotasUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("at least one tenant is required")))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	err := h.manager.UpdateTenantQuotas(ctx, principal, params.ClassName, params.Body)
+	var updated []*models.Tenant
+	if err == nil {
+		updated, err = h.manager.GetTenants(ctx, principal, params.ClassName)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewTenantsQuotasUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewTenantsQuotasUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsQuotasUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsQuotasUpdateOK().WithPayload(updated)
+}
+
+func setupTenantQuotas(api *operations.WeaviateAPI, manager tenantQuotaManager) {
+	h := &tenantQuotaHandlers{manager: manager}
+	api.SchemaTenantsQuotasUpdateHandler = schema.TenantsQuotasUpdateHandlerFunc(h.updateQuotas)
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type fakeTenantQuotaManager struct {
+	tenants map[string]map[string]*models.TenantQuota
+}
+
+func (f *fakeTenantQuotaManager) UpdateTenantQuotas(ctx context.Context,
+	principal *models.Principal, class string, tenants []*models.Tenant,
+) error {
+	quotas, ok := f.tenants[class]
+	if !ok {
+		return fmt.Errorf("class %q: %w", class, schemaUC.ErrNotFound)
+	}
+	for _, tenant := range tenants {
+		if _, ok := quotas[tenant.Name]; !ok {
+			return fmt.Errorf("tenant %q: %w", tenant.Name, schemaUC.ErrNotFound)
+		}
+		if q := tenant.Quota; q != nil && q.MaxObjects < 0 {
+			return objects.NewErrInvalidUserInput("quota of tenant %q must not be negative", tenant.Name)
+		}
+	}
+	for _, tenant := range tenants {
+		quotas[tenant.Name] = tenant.Quota
+	}
+	return nil
+}
+
+func (f *fakeTenantQuotaManager) GetTenants(ctx context.Context,
+	principal *models.Principal, class string,
+) ([]*models.Tenant, error) {
+	var out []*models.Tenant
+	for name, quota := range f.tenants[class] {
+		out = append(out, &models.Tenant{Name: name, Quota: quota})
+	}
+	return out, nil
+}
+
+func TestUpdateTenantQuotas(t *testing.T) {
+	manager := &fakeTenantQuotaManager{tenants: map[string]map[string]*models.TenantQuota{
+		"Article": {"tenant1": nil},
+	}}
+	h := &tenantQuotaHandlers{manager: manager}
+	serve := func(class, body string) *httptest.ResponseRecorder {
+		params := schema.NewTenantsQuotasUpdateParams()
+		req := httptest.NewRequest(http.MethodPut, "/v1/schema/"+class+"/tenants/quotas",
+			strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		require.Nil(t, bindRequest(&params, req, "className", class))
+		return writeResponse(h.updateQuotas(params, nil))
+	}
+
+	rec := serve("Article", `[{"name": "tenant1", "quota": {"maxObjects": 10}}]`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var tenants []*models.Tenant
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &tenants))
+	require.Len(t, tenants, 1)
+	assert.Equal(t, int64(10), tenants[0].Quota.MaxObjects)
+
+	body := `[{"name": "tenant1", "quota": {"maxObjects": -1}}]`
+	assert.Equal(t, http.StatusUnprocessableEntity, serve("Article", body).Code)
+	body = `[{"name": "tenant2", "quota": {"maxObjects": 10}}]`
+	assert.Equal(t, http.StatusNotFound, serve("Article", body).Code)
+	assert.Equal(t, http.StatusNotFound, serve("Missing", body).Code)
+	assert.Equal(t, http.StatusBadRequest, serve("Article", `[]`).Code)
+}
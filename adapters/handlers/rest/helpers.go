@@ -12,7 +12,9 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -37,3 +39,21 @@ func errPayloadFromSingleErr(err error) *models.ErrorResponse {
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// writeErrorResponse responds with an error from a middleware, before the
+// request reaches the generated api
+func writeErrorResponse(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+}
+
+// jsonObjects converts a list for a response whose items are free-form
+// objects in the spec
+func jsonObjects[T any](items []T) []models.JSONObject {
+	out := make([]models.JSONObject, len(items))
+	for i := range items {
+		out[i] = items[i]
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// requestBinder is implemented by all generated parameters
+type requestBinder interface {
+	BindRequest(r *http.Request, route *middleware.MatchedRoute) error
+}
+
+// bindRequest binds and validates the request like the generated api does,
+// pathParams are pairs of names and values
+func bindRequest(params requestBinder, req *http.Request, pathParams ...string) error {
+	route := &middleware.MatchedRoute{Consumer: runtime.JSONConsumer()}
+	route.Formats = strfmt.Default
+	for i := 0; i+1 < len(pathParams); i += 2 {
+		route.Params = append(route.Params, middleware.RouteParam{
+			Name: pathParams[i], Value: pathParams[i+1],
+		})
+	}
+	return params.BindRequest(req, route)
+}
+
+// writeResponse writes the response of a handler like the generated api does
+func writeResponse(res middleware.Responder) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	res.WriteResponse(rec, runtime.JSONProducer())
+	return rec
+}
@@ -13,8 +13,11 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,8 +26,15 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -89,17 +99,24 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 			OptionsPassthrough: true,
 			AllowedMethods:     []string{"POST", "PUT", "DELETE", "GET", "PATCH"},
 		}).Handler
+		handler = makeAddStandbyGuard(appState.CrossClusterReplication)(handler)
 		handler = handleCORS(handler)
 		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON), handler)
 		handler = makeAddLogging(appState.Logger)(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = makeAddMonitoring(appState.Metrics)(handler)
 		}
+		handler = makeAddWriteBackpressure(appState.DB.WriteStall)(handler)
+		handler = makeAddAdmissionControl(appState.AdmissionControl)(handler)
 		handler = addPreflight(handler)
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 		handler = addInjectHeadersIntoContext(handler)
+		handler = addObjectPreconditions(handler)
+		handler = addConsistencyTokens(handler)
+		handler = addTracing(handler)
+		handler = addRequestID(handler)
 		handler = makeCatchPanics(appState.Logger,
 			newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
 
@@ -140,13 +157,154 @@ func makeAddMonitoring(metrics *monitoring.PrometheusMetrics) func(http.Handler)
 	}
 }
 
+// makeAddWriteBackpressure adds a Retry-After hint to the responses of write
+// requests while writes on this node are stalled. The request itself is still
+// served, it is up to the client to slow down.
+func makeAddWriteBackpressure(writeStall func() lsmkv.WriteStall) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isWriteRequest(r) {
+				if stall := writeStall(); stall.Stalled() {
+					seconds := int(math.Ceil(stall.RetryAfter.Seconds()))
+					w.Header().Set("Retry-After", strconv.Itoa(seconds))
+					w.Header().Set("X-Weaviate-Write-Stall", stall.Reason)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+	if r.URL.Path == multiGetObjectsPath {
+		return false
+	}
+
+	return strings.HasPrefix(r.URL.Path, "/v1/objects") ||
+		strings.HasPrefix(r.URL.Path, "/v1/batch")
+}
+
+// addObjectPreconditions makes writes to a single object conditional on the
+// version in the If-Match header, as returned in the ETag of a read. A
+// mismatch is rejected with a 409 by the handlers.
+func addObjectPreconditions(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch := r.Header.Get("If-Match")
+		if ifMatch == "" || ifMatch == "*" || !isObjectWriteRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		version, err := parseETag(ifMatch)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(objects.WithExpectedVersion(r.Context(), version)))
+	})
+}
+
+// isObjectWriteRequest is true for updates and deletes of the object
+// itself, references of an object are not versioned
+func isObjectWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+
+	return strings.HasPrefix(r.URL.Path, "/v1/objects/") &&
+		!strings.Contains(r.URL.Path, "/references/")
+}
+
+const consistencyTokenHeader = "X-Weaviate-Consistency-Token"
+
+// addConsistencyTokens returns a consistency token for the replicated writes
+// of a request and makes queries carrying one of those tokens read from
+// replicas which have applied these writes. Several tokens can be passed
+// comma-separated, they are merged.
+func addConsistencyTokens(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if values := r.Header.Values(consistencyTokenHeader); len(values) > 0 {
+			var token replica.Token
+			for _, value := range values {
+				for _, part := range strings.Split(value, ",") {
+					if part = strings.TrimSpace(part); part == "" {
+						continue
+					}
+					t, err := replica.ParseToken(part)
+					if err != nil {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+						return
+					}
+					token = token.Merge(t)
+				}
+			}
+			ctx = replica.WithToken(ctx, token)
+		}
+
+		if !isWriteRequest(r) {
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		ctx, recorder := replica.WithTokenRecorder(ctx)
+		tw := &tokenWriter{ResponseWriter: w, recorder: recorder}
+		next.ServeHTTP(tw, r.WithContext(ctx))
+	})
+}
+
+// tokenWriter sets the consistency token header before the response is
+// written, by then all writes of the request have been recorded
+type tokenWriter struct {
+	http.ResponseWriter
+	recorder    *replica.TokenRecorder
+	wroteHeader bool
+}
+
+func (w *tokenWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if token, ok := w.recorder.Token(); ok {
+			w.Header().Set(consistencyTokenHeader, token.Encode())
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *tokenWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps streaming responses working through the writer
+func (w *tokenWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func addPreflight(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "OPTIONS" {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "*")
 			w.Header().Set("Access-Control-Allow-Headers",
-				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key")
+				"Content-Type, Authorization, Batch, If-Match, X-Weaviate-Consistency-Token, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key")
 			return
 		}
 
@@ -154,6 +312,60 @@ func addPreflight(next http.Handler) http.Handler {
 	})
 }
 
+// addRequestID attaches the id of the request, taken from the X-Request-Id
+// header or generated, to the context and echoes it in the response, so
+// that mutations can be traced in the audit log
+func addRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if id == "" {
+			id = audit.NewRequestID()
+		}
+		w.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(w, r.WithContext(audit.WithRequestID(r.Context(), id)))
+	})
+}
+
+// addTracing starts the root span of a request, continuing the trace of the
+// client if it was propagated in the headers. Without a configured exporter
+// the span is a no-op.
+func addTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracing.StartServer(r.Context(), r.Header, "HTTP "+r.Method,
+			attribute.String("http.method", r.Method),
+			attribute.String("http.target", r.URL.Path),
+			attribute.String("weaviate.request_id", audit.RequestID(r.Context())),
+		)
+		defer span.End()
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rec.status))
+		}
+	})
+}
+
+// statusRecorder remembers the status code written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush keeps streaming responses working through the recorder
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func addInjectHeadersIntoContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestWriteBackpressure(t *testing.T) {
+	stalled := lsmkv.WriteStall{
+		Reason:     lsmkv.WriteStallMemtableFlush,
+		RetryAfter: 2500 * time.Millisecond,
+	}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		stall      lsmkv.WriteStall
+		method     string
+		path       string
+		retryAfter string
+		reason     string
+	}{
+		{
+			name:       "stalled object write",
+			stall:      stalled,
+			method:     http.MethodPost,
+			path:       "/v1/objects",
+			retryAfter: "3",
+			reason:     lsmkv.WriteStallMemtableFlush,
+		},
+		{
+			name:       "stalled batch write",
+			stall:      stalled,
+			method:     http.MethodPost,
+			path:       "/v1/batch/objects",
+			retryAfter: "3",
+			reason:     lsmkv.WriteStallMemtableFlush,
+		},
+		{
+			name:   "stalled read",
+			stall:  stalled,
+			method: http.MethodGet,
+			path:   "/v1/objects",
+		},
+		{
+			name:   "stalled multi-get",
+			stall:  stalled,
+			method: http.MethodPost,
+			path:   multiGetObjectsPath,
+		},
+		{
+			name:   "stalled schema write",
+			stall:  stalled,
+			method: http.MethodPost,
+			path:   "/v1/schema",
+		},
+		{
+			name:   "not stalled",
+			method: http.MethodPut,
+			path:   "/v1/objects/Foo/a4de1d5e-ef7b-4d0c-8e47-e58a6d4e4b1a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := makeAddWriteBackpressure(func() lsmkv.WriteStall {
+				return test.stall
+			})(ok)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(test.method, test.path, nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, test.retryAfter, rec.Header().Get("Retry-After"))
+			assert.Equal(t, test.reason, rec.Header().Get("X-Weaviate-Write-Stall"))
+		})
+	}
+}
+
+func TestAddRequestID(t *testing.T) {
+	var seen string
+	handler := addRequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = audit.RequestID(r.Context())
+	}))
+
+	t.Run("propagates client supplied id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		req.Header.Set("X-Request-Id", "abc-123")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, "abc-123", seen)
+		assert.Equal(t, "abc-123", rec.Header().Get("X-Request-Id"))
+	})
+
+	t.Run("generates id if missing", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/objects", nil))
+
+		assert.NotEmpty(t, seen)
+		assert.Equal(t, seen, rec.Header().Get("X-Request-Id"))
+	})
+}
+
+func TestAddObjectPreconditions(t *testing.T) {
+	const path = "/v1/objects/Foo/a4de1d5e-ef7b-4d0c-8e47-e58a6d4e4b1a"
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		ifMatch     string
+		code        int
+		version     int64
+		conditional bool
+	}{
+		{name: "update", method: http.MethodPut, path: path, ifMatch: formatETag(1700000000001),
+			code: http.StatusOK, version: 1700000000001, conditional: true},
+		{name: "weak etag", method: http.MethodPatch, path: path, ifMatch: `W/"17"`,
+			code: http.StatusOK, version: 17, conditional: true},
+		{name: "delete", method: http.MethodDelete, path: path, ifMatch: "42",
+			code: http.StatusOK, version: 42, conditional: true},
+		{name: "without header", method: http.MethodPut, path: path, code: http.StatusOK},
+		{name: "any version", method: http.MethodPut, path: path, ifMatch: "*", code: http.StatusOK},
+		{name: "read", method: http.MethodGet, path: path, ifMatch: `"17"`, code: http.StatusOK},
+		{name: "reference", method: http.MethodPut, path: path + "/references/friend",
+			ifMatch: `"17"`, code: http.StatusOK},
+		{name: "invalid etag", method: http.MethodPut, path: path, ifMatch: `"abc"`,
+			code: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				version     int64
+				conditional bool
+			)
+			handler := addObjectPreconditions(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				version, conditional = objects.ExpectedVersion(r.Context())
+			}))
+
+			req := httptest.NewRequest(test.method, test.path, nil)
+			if test.ifMatch != "" {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.code, rec.Code)
+			assert.Equal(t, test.conditional, conditional)
+			assert.Equal(t, test.version, version)
+		})
+	}
+}
+
+func TestAddConsistencyTokens(t *testing.T) {
+	write := replica.TokenWrite{Class: "Foo", Shard: "S1", ID: "a4de1d5e-ef7b-4d0c-8e47-e58a6d4e4b1a", UpdateTime: 17}
+	other := replica.TokenWrite{Class: "Foo", Shard: "S2", ID: "6b8a1f7c-2d1e-4e3a-9f0b-1c2d3e4f5a6b", UpdateTime: 18}
+
+	t.Run("writes return a token", func(t *testing.T) {
+		handler := addConsistencyTokens(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			replica.RecordWrite(r.Context(), write)
+			w.Write([]byte("{}"))
+		}))
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/objects", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		token, err := replica.ParseToken(rec.Header().Get(consistencyTokenHeader))
+		require.Nil(t, err)
+		assert.Equal(t, []replica.TokenWrite{write}, token.Writes)
+	})
+
+	t.Run("writes without replication return no token", func(t *testing.T) {
+		handler := addConsistencyTokens(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		req := httptest.NewRequest(http.MethodDelete, "/v1/objects/Foo/"+write.ID.String(), nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get(consistencyTokenHeader))
+	})
+
+	t.Run("queries pass the merged tokens on", func(t *testing.T) {
+		var (
+			token replica.Token
+			ok    bool
+		)
+		handler := addConsistencyTokens(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok = replica.TokenFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/graphql", nil)
+		req.Header.Set(consistencyTokenHeader,
+			replica.Token{Writes: []replica.TokenWrite{write}}.Encode()+", "+
+				replica.Token{Writes: []replica.TokenWrite{other}}.Encode())
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.True(t, ok)
+		assert.ElementsMatch(t, []replica.TokenWrite{write, other}, token.Writes)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		handler := addConsistencyTokens(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("request with invalid token must not be served")
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		req.Header.Set(consistencyTokenHeader, "%%%")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
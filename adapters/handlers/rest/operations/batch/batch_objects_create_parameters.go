@@ -53,6 +53,22 @@ type BatchObjectsCreateParams struct {
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Comma-separated list of properties the ids of objects without an id are derived from, as UUIDv5 of the class name and the property values. Overrides the idProperties of the class.
+	  In: query
+	*/
+	IDProperties *string
+	/*Detect duplicates of existing objects and of earlier objects of the batch, and skip them, merge them into the original or import them with a reference to the original. Possible values: skip, merge, link.
+	  In: query
+	*/
+	Dedup *string
+	/*What makes two objects duplicates, either equal properties or an equal vector. Defaults to content. Possible values: content, vector.
+	  In: query
+	*/
+	DedupBy *string
+	/*Reference property through which duplicates point to their original, required if dedup is link.
+	  In: query
+	*/
+	DedupLinkProperty *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -98,6 +114,26 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qDedup, qhkDedup, _ := qs.GetOK("dedup")
+	if err := o.bindDedup(qDedup, qhkDedup, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDedupBy, qhkDedupBy, _ := qs.GetOK("dedupBy")
+	if err := o.bindDedupBy(qDedupBy, qhkDedupBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDedupLinkProperty, qhkDedupLinkProperty, _ := qs.GetOK("dedupLinkProperty")
+	if err := o.bindDedupLinkProperty(qDedupLinkProperty, qhkDedupLinkProperty, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIDProperties, qhkIDProperties, _ := qs.GetOK("idProperties")
+	if err := o.bindIDProperties(qIDProperties, qhkIDProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -121,3 +157,75 @@ func (o *BatchObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey
 
 	return nil
 }
+
+// bindIDProperties binds and validates parameter IDProperties from query.
+func (o *BatchObjectsCreateParams) bindIDProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IDProperties = &raw
+
+	return nil
+}
+
+// bindDedup binds and validates parameter Dedup from query.
+func (o *BatchObjectsCreateParams) bindDedup(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Dedup = &raw
+
+	return nil
+}
+
+// bindDedupBy binds and validates parameter DedupBy from query.
+func (o *BatchObjectsCreateParams) bindDedupBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.DedupBy = &raw
+
+	return nil
+}
+
+// bindDedupLinkProperty binds and validates parameter DedupLinkProperty from query.
+func (o *BatchObjectsCreateParams) bindDedupLinkProperty(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.DedupLinkProperty = &raw
+
+	return nil
+}
@@ -24,7 +24,11 @@ import (
 
 // BatchObjectsCreateURL generates an URL for the batch objects create operation
 type BatchObjectsCreateURL struct {
-	ConsistencyLevel *string
+	ConsistencyLevel  *string
+	Dedup             *string
+	DedupBy           *string
+	DedupLinkProperty *string
+	IDProperties      *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -68,6 +72,38 @@ func (o *BatchObjectsCreateURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var dedupQ string
+	if o.Dedup != nil {
+		dedupQ = *o.Dedup
+	}
+	if dedupQ != "" {
+		qs.Set("dedup", dedupQ)
+	}
+
+	var dedupByQ string
+	if o.DedupBy != nil {
+		dedupByQ = *o.DedupBy
+	}
+	if dedupByQ != "" {
+		qs.Set("dedupBy", dedupByQ)
+	}
+
+	var dedupLinkPropertyQ string
+	if o.DedupLinkProperty != nil {
+		dedupLinkPropertyQ = *o.DedupLinkProperty
+	}
+	if dedupLinkPropertyQ != "" {
+		qs.Set("dedupLinkProperty", dedupLinkPropertyQ)
+	}
+
+	var idPropertiesQ string
+	if o.IDProperties != nil {
+		idPropertiesQ = *o.IDProperties
+	}
+	if idPropertiesQ != "" {
+		qs.Set("idProperties", idPropertiesQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -54,6 +54,10 @@ type BatchReferencesCreateParams struct {
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Controls how references are validated before they are imported. 'skipValidation' only parses the beacons, 'validateSchemaOnly' checks the source property and target class against the schema, 'validateExistence' additionally checks that the source and target objects exist. Without this parameter only references involving multi-tenant classes are checked.
+	  In: query
+	*/
+	Validation *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -101,6 +105,11 @@ func (o *BatchReferencesCreateParams) BindRequest(r *http.Request, route *middle
 	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qValidation, qhkValidation, _ := qs.GetOK("validation")
+	if err := o.bindValidation(qValidation, qhkValidation, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -124,3 +133,21 @@ func (o *BatchReferencesCreateParams) bindConsistencyLevel(rawData []string, has
 
 	return nil
 }
+
+// bindValidation binds and validates parameter Validation from query.
+func (o *BatchReferencesCreateParams) bindValidation(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Validation = &raw
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 // BatchReferencesCreateURL generates an URL for the batch references create operation
 type BatchReferencesCreateURL struct {
 	ConsistencyLevel *string
+	Validation       *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -68,6 +69,14 @@ func (o *BatchReferencesCreateURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var validationQ string
+	if o.Validation != nil {
+		validationQ = *o.Validation
+	}
+	if validationQ != "" {
+		qs.Set("validation", validationQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
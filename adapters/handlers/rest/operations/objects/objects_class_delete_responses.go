@@ -189,6 +189,51 @@ func (o *ObjectsClassDeleteNotFound) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassDeleteConflictCode is the HTTP code returned for type ObjectsClassDeleteConflict
+const ObjectsClassDeleteConflictCode int = 409
+
+/*
+ObjectsClassDeleteConflict The object has been changed since the version given in the If-Match header.
+
+swagger:response objectsClassDeleteConflict
+*/
+type ObjectsClassDeleteConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassDeleteConflict creates ObjectsClassDeleteConflict with default headers values
+func NewObjectsClassDeleteConflict() *ObjectsClassDeleteConflict {
+
+	return &ObjectsClassDeleteConflict{}
+}
+
+// WithPayload adds the payload to the objects class delete conflict response
+func (o *ObjectsClassDeleteConflict) WithPayload(payload *models.ErrorResponse) *ObjectsClassDeleteConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class delete conflict response
+func (o *ObjectsClassDeleteConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassDeleteConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassDeleteUnprocessableEntityCode is the HTTP code returned for type ObjectsClassDeleteUnprocessableEntity
 const ObjectsClassDeleteUnprocessableEntityCode int = 422
 
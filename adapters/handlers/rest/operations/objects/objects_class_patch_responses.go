@@ -189,6 +189,51 @@ func (o *ObjectsClassPatchNotFound) WriteResponse(rw http.ResponseWriter, produc
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPatchConflictCode is the HTTP code returned for type ObjectsClassPatchConflict
+const ObjectsClassPatchConflictCode int = 409
+
+/*
+ObjectsClassPatchConflict The object has been changed since the version given in the If-Match header.
+
+swagger:response objectsClassPatchConflict
+*/
+type ObjectsClassPatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPatchConflict creates ObjectsClassPatchConflict with default headers values
+func NewObjectsClassPatchConflict() *ObjectsClassPatchConflict {
+
+	return &ObjectsClassPatchConflict{}
+}
+
+// WithPayload adds the payload to the objects class patch conflict response
+func (o *ObjectsClassPatchConflict) WithPayload(payload *models.ErrorResponse) *ObjectsClassPatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class patch conflict response
+func (o *ObjectsClassPatchConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPatchUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPatchUnprocessableEntity
 const ObjectsClassPatchUnprocessableEntityCode int = 422
 
@@ -33,6 +33,10 @@ ObjectsClassPutOK Successfully received.
 swagger:response objectsClassPutOK
 */
 type ObjectsClassPutOK struct {
+	/*The version of the object, to be passed in the If-Match header of a conditional update or delete.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsClassPutOK() *ObjectsClassPutOK {
 	return &ObjectsClassPutOK{}
 }
 
+// WithETag adds the eTag to the objects class put o k response
+func (o *ObjectsClassPutOK) WithETag(eTag string) *ObjectsClassPutOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the objects class put o k response
+func (o *ObjectsClassPutOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the objects class put o k response
 func (o *ObjectsClassPutOK) WithPayload(payload *models.Object) *ObjectsClassPutOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsClassPutOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsClassPutOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -164,6 +186,51 @@ func (o *ObjectsClassPutNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPutConflictCode is the HTTP code returned for type ObjectsClassPutConflict
+const ObjectsClassPutConflictCode int = 409
+
+/*
+ObjectsClassPutConflict The object has been changed since the version given in the If-Match header.
+
+swagger:response objectsClassPutConflict
+*/
+type ObjectsClassPutConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPutConflict creates ObjectsClassPutConflict with default headers values
+func NewObjectsClassPutConflict() *ObjectsClassPutConflict {
+
+	return &ObjectsClassPutConflict{}
+}
+
+// WithPayload adds the payload to the objects class put conflict response
+func (o *ObjectsClassPutConflict) WithPayload(payload *models.ErrorResponse) *ObjectsClassPutConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class put conflict response
+func (o *ObjectsClassPutConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPutConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPutUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPutUnprocessableEntity
 const ObjectsClassPutUnprocessableEntityCode int = 422
 
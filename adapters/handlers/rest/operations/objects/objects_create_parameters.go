@@ -55,6 +55,10 @@ type ObjectsCreateParams struct {
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Comma-separated list of properties the ids of objects without an id are derived from, as UUIDv5 of the class name and the property values. Overrides the idProperties of the class.
+	  In: query
+	*/
+	IDProperties *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -100,6 +104,11 @@ func (o *ObjectsCreateParams) BindRequest(r *http.Request, route *middleware.Mat
 	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qIDProperties, qhkIDProperties, _ := qs.GetOK("idProperties")
+	if err := o.bindIDProperties(qIDProperties, qhkIDProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -123,3 +132,21 @@ func (o *ObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey bool
 
 	return nil
 }
+
+// bindIDProperties binds and validates parameter IDProperties from query.
+func (o *ObjectsCreateParams) bindIDProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IDProperties = &raw
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 // ObjectsCreateURL generates an URL for the objects create operation
 type ObjectsCreateURL struct {
 	ConsistencyLevel *string
+	IDProperties     *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -68,6 +69,14 @@ func (o *ObjectsCreateURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var idPropertiesQ string
+	if o.IDProperties != nil {
+		idPropertiesQ = *o.IDProperties
+	}
+	if idPropertiesQ != "" {
+		qs.Set("idProperties", idPropertiesQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
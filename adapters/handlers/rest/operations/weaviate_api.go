@@ -18,6 +18,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -31,13 +32,22 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/admin"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/aliases"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/bulk_imports"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/federation"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/jobs"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/well_known"
 	"github.com/weaviate/weaviate/entities/models"
@@ -64,11 +74,63 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		JSONConsumer: runtime.JSONConsumer(),
 		YamlConsumer: yamlpc.YAMLConsumer(),
 
+		ApplicationVndApacheArrowStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("applicationVndApacheArrowStream producer has not yet been implemented")
+		}),
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		AdminAdmissionControlGetHandler: admin.AdmissionControlGetHandlerFunc(func(params admin.AdmissionControlGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.AdmissionControlGet has not yet been implemented")
+		}),
+		AdminAdmissionControlUpdateHandler: admin.AdmissionControlUpdateHandlerFunc(func(params admin.AdmissionControlUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.AdmissionControlUpdate has not yet been implemented")
+		}),
+		AliasesAliasesDeleteHandler: aliases.AliasesDeleteHandlerFunc(func(params aliases.AliasesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation aliases.AliasesDelete has not yet been implemented")
+		}),
+		AliasesAliasesGetHandler: aliases.AliasesGetHandlerFunc(func(params aliases.AliasesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation aliases.AliasesGet has not yet been implemented")
+		}),
+		AliasesAliasesListHandler: aliases.AliasesListHandlerFunc(func(params aliases.AliasesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation aliases.AliasesList has not yet been implemented")
+		}),
+		AliasesAliasesPutHandler: aliases.AliasesPutHandlerFunc(func(params aliases.AliasesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation aliases.AliasesPut has not yet been implemented")
+		}),
+		ApikeysApikeysCreateHandler: apikeys.ApikeysCreateHandlerFunc(func(params apikeys.ApikeysCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysCreate has not yet been implemented")
+		}),
+		ApikeysApikeysListHandler: apikeys.ApikeysListHandlerFunc(func(params apikeys.ApikeysListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysList has not yet been implemented")
+		}),
+		ApikeysApikeysRevokeHandler: apikeys.ApikeysRevokeHandlerFunc(func(params apikeys.ApikeysRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRevoke has not yet been implemented")
+		}),
+		ApikeysApikeysRotateHandler: apikeys.ApikeysRotateHandlerFunc(func(params apikeys.ApikeysRotateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRotate has not yet been implemented")
+		}),
+		AuthzAuthzRolesDeleteHandler: authz.AuthzRolesDeleteHandlerFunc(func(params authz.AuthzRolesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesDelete has not yet been implemented")
+		}),
+		AuthzAuthzRolesGetHandler: authz.AuthzRolesGetHandlerFunc(func(params authz.AuthzRolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesGet has not yet been implemented")
+		}),
+		AuthzAuthzRolesListHandler: authz.AuthzRolesListHandlerFunc(func(params authz.AuthzRolesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesList has not yet been implemented")
+		}),
+		AuthzAuthzRolesPutHandler: authz.AuthzRolesPutHandlerFunc(func(params authz.AuthzRolesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesPut has not yet been implemented")
+		}),
+		AuthzAuthzUsersRolesGetHandler: authz.AuthzUsersRolesGetHandlerFunc(func(params authz.AuthzUsersRolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUsersRolesGet has not yet been implemented")
+		}),
+		AuthzAuthzUsersRolesPutHandler: authz.AuthzUsersRolesPutHandlerFunc(func(params authz.AuthzUsersRolesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUsersRolesPut has not yet been implemented")
+		}),
 		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
 		}),
@@ -87,21 +149,78 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchObjectsMergeHandler: batch.BatchObjectsMergeHandlerFunc(func(params batch.BatchObjectsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsMerge has not yet been implemented")
+		}),
+		BatchBatchObjectsStreamHandler: batch.BatchObjectsStreamHandlerFunc(func(params batch.BatchObjectsStreamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsStream has not yet been implemented")
+		}),
+		BatchBatchObjectsUpdateHandler: batch.BatchObjectsUpdateHandlerFunc(func(params batch.BatchObjectsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsUpdate has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
+		BulkImportsBulkImportsCancelHandler: bulk_imports.BulkImportsCancelHandlerFunc(func(params bulk_imports.BulkImportsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_imports.BulkImportsCancel has not yet been implemented")
+		}),
+		BulkImportsBulkImportsGetHandler: bulk_imports.BulkImportsGetHandlerFunc(func(params bulk_imports.BulkImportsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_imports.BulkImportsGet has not yet been implemented")
+		}),
+		BulkImportsBulkImportsListHandler: bulk_imports.BulkImportsListHandlerFunc(func(params bulk_imports.BulkImportsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_imports.BulkImportsList has not yet been implemented")
+		}),
+		BulkImportsBulkImportsStartHandler: bulk_imports.BulkImportsStartHandlerFunc(func(params bulk_imports.BulkImportsStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_imports.BulkImportsStart has not yet been implemented")
+		}),
 		ClassificationsClassificationsGetHandler: classifications.ClassificationsGetHandlerFunc(func(params classifications.ClassificationsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsGet has not yet been implemented")
 		}),
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterNodesDrainHandler: cluster.ClusterNodesDrainHandlerFunc(func(params cluster.ClusterNodesDrainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterNodesDrain has not yet been implemented")
+		}),
+		ClusterClusterNodesDrainStatusHandler: cluster.ClusterNodesDrainStatusHandlerFunc(func(params cluster.ClusterNodesDrainStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterNodesDrainStatus has not yet been implemented")
+		}),
+		ClusterClusterShardsMoveHandler: cluster.ClusterShardsMoveHandlerFunc(func(params cluster.ClusterShardsMoveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsMove has not yet been implemented")
+		}),
+		ClusterClusterShardsSplitHandler: cluster.ClusterShardsSplitHandlerFunc(func(params cluster.ClusterShardsSplitParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsSplit has not yet been implemented")
+		}),
+		ClusterClusterShardsSplitStatusHandler: cluster.ClusterShardsSplitStatusHandlerFunc(func(params cluster.ClusterShardsSplitStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardsSplitStatus has not yet been implemented")
+		}),
+		AdminDebugSlowQueriesHandler: admin.DebugSlowQueriesHandlerFunc(func(params admin.DebugSlowQueriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.DebugSlowQueries has not yet been implemented")
+		}),
+		FederationFederationPeersDeregisterHandler: federation.FederationPeersDeregisterHandlerFunc(func(params federation.FederationPeersDeregisterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation federation.FederationPeersDeregister has not yet been implemented")
+		}),
+		FederationFederationPeersListHandler: federation.FederationPeersListHandlerFunc(func(params federation.FederationPeersListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation federation.FederationPeersList has not yet been implemented")
+		}),
+		FederationFederationPeersRegisterHandler: federation.FederationPeersRegisterHandlerFunc(func(params federation.FederationPeersRegisterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation federation.FederationPeersRegister has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		JobsJobsCancelHandler: jobs.JobsCancelHandlerFunc(func(params jobs.JobsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.JobsCancel has not yet been implemented")
+		}),
+		JobsJobsGetHandler: jobs.JobsGetHandlerFunc(func(params jobs.JobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.JobsGet has not yet been implemented")
+		}),
+		JobsJobsListHandler: jobs.JobsListHandlerFunc(func(params jobs.JobsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation jobs.JobsList has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
@@ -111,9 +230,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		ObjectsObjectsBlobsGetHandler: objects.ObjectsBlobsGetHandlerFunc(func(params objects.ObjectsBlobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsBlobsGet has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
+		ObjectsObjectsClassExportHandler: objects.ObjectsClassExportHandlerFunc(func(params objects.ObjectsClassExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassExport has not yet been implemented")
+		}),
 		ObjectsObjectsClassGetHandler: objects.ObjectsClassGetHandlerFunc(func(params objects.ObjectsClassGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassGet has not yet been implemented")
 		}),
@@ -150,6 +275,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsListHandler: objects.ObjectsListHandlerFunc(func(params objects.ObjectsListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsList has not yet been implemented")
 		}),
+		ObjectsObjectsMultiGetHandler: objects.ObjectsMultiGetHandlerFunc(func(params objects.ObjectsMultiGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsMultiGet has not yet been implemented")
+		}),
 		ObjectsObjectsPatchHandler: objects.ObjectsPatchHandlerFunc(func(params objects.ObjectsPatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsPatch has not yet been implemented")
 		}),
@@ -168,6 +296,27 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		AdminQueryResultCacheFlushHandler: admin.QueryResultCacheFlushHandlerFunc(func(params admin.QueryResultCacheFlushParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.QueryResultCacheFlush has not yet been implemented")
+		}),
+		AdminQueryResultCacheGetHandler: admin.QueryResultCacheGetHandlerFunc(func(params admin.QueryResultCacheGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.QueryResultCacheGet has not yet been implemented")
+		}),
+		AdminQueryVectorCacheFlushHandler: admin.QueryVectorCacheFlushHandlerFunc(func(params admin.QueryVectorCacheFlushParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.QueryVectorCacheFlush has not yet been implemented")
+		}),
+		AdminQueryVectorCacheGetHandler: admin.QueryVectorCacheGetHandlerFunc(func(params admin.QueryVectorCacheGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin.QueryVectorCacheGet has not yet been implemented")
+		}),
+		ReplicationReplicationCrossClusterApplyHandler: replication.ReplicationCrossClusterApplyHandlerFunc(func(params replication.ReplicationCrossClusterApplyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationCrossClusterApply has not yet been implemented")
+		}),
+		ReplicationReplicationCrossClusterPromoteHandler: replication.ReplicationCrossClusterPromoteHandlerFunc(func(params replication.ReplicationCrossClusterPromoteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationCrossClusterPromote has not yet been implemented")
+		}),
+		ReplicationReplicationCrossClusterStatusHandler: replication.ReplicationCrossClusterStatusHandlerFunc(func(params replication.ReplicationCrossClusterStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationCrossClusterStatus has not yet been implemented")
+		}),
 		SchemaSchemaClusterStatusHandler: schema.SchemaClusterStatusHandlerFunc(func(params schema.SchemaClusterStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaClusterStatus has not yet been implemented")
 		}),
@@ -180,21 +329,78 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsDeleteHandler: schema.SchemaObjectsDeleteHandlerFunc(func(params schema.SchemaObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsDelete has not yet been implemented")
 		}),
+		SchemaSchemaObjectsDuplicatesDetectHandler: schema.SchemaObjectsDuplicatesDetectHandlerFunc(func(params schema.SchemaObjectsDuplicatesDetectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsDuplicatesDetect has not yet been implemented")
+		}),
+		SchemaSchemaObjectsDuplicatesStatusHandler: schema.SchemaObjectsDuplicatesStatusHandlerFunc(func(params schema.SchemaObjectsDuplicatesStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsDuplicatesStatus has not yet been implemented")
+		}),
 		SchemaSchemaObjectsGetHandler: schema.SchemaObjectsGetHandlerFunc(func(params schema.SchemaObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsGet has not yet been implemented")
 		}),
+		SchemaSchemaObjectsProfileHandler: schema.SchemaObjectsProfileHandlerFunc(func(params schema.SchemaObjectsProfileParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsProfile has not yet been implemented")
+		}),
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesRenameHandler: schema.SchemaObjectsPropertiesRenameHandlerFunc(func(params schema.SchemaObjectsPropertiesRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesRename has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesTokenizationHandler: schema.SchemaObjectsPropertiesTokenizationHandlerFunc(func(params schema.SchemaObjectsPropertiesTokenizationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesTokenization has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReferencesVerifyHandler: schema.SchemaObjectsReferencesVerifyHandlerFunc(func(params schema.SchemaObjectsReferencesVerifyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReferencesVerify has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReferencesVerifyStatusHandler: schema.SchemaObjectsReferencesVerifyStatusHandlerFunc(func(params schema.SchemaObjectsReferencesVerifyStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReferencesVerifyStatus has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRenameHandler: schema.SchemaObjectsRenameHandlerFunc(func(params schema.SchemaObjectsRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRename has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
 		SchemaSchemaObjectsShardsUpdateHandler: schema.SchemaObjectsShardsUpdateHandlerFunc(func(params schema.SchemaObjectsShardsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsStopwordsGetHandler: schema.SchemaObjectsStopwordsGetHandlerFunc(func(params schema.SchemaObjectsStopwordsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStopwordsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsStopwordsReplaceHandler: schema.SchemaObjectsStopwordsReplaceHandlerFunc(func(params schema.SchemaObjectsStopwordsReplaceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStopwordsReplace has not yet been implemented")
+		}),
+		SchemaSchemaObjectsStopwordsUpdateHandler: schema.SchemaObjectsStopwordsUpdateHandlerFunc(func(params schema.SchemaObjectsStopwordsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStopwordsUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsSuggestHandler: schema.SchemaObjectsSuggestHandlerFunc(func(params schema.SchemaObjectsSuggestParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSuggest has not yet been implemented")
+		}),
+		SchemaSchemaObjectsSynonymsGetHandler: schema.SchemaObjectsSynonymsGetHandlerFunc(func(params schema.SchemaObjectsSynonymsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSynonymsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsSynonymsUpdateHandler: schema.SchemaObjectsSynonymsUpdateHandlerFunc(func(params schema.SchemaObjectsSynonymsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSynonymsUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsTieringGetHandler: schema.SchemaObjectsTieringGetHandlerFunc(func(params schema.SchemaObjectsTieringGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsTieringGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsTieringUpdateHandler: schema.SchemaObjectsTieringUpdateHandlerFunc(func(params schema.SchemaObjectsTieringUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsTieringUpdate has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaTenantsActivityGetHandler: schema.TenantsActivityGetHandlerFunc(func(params schema.TenantsActivityGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsActivityGet has not yet been implemented")
+		}),
+		SchemaTenantsActivityUpdateHandler: schema.TenantsActivityUpdateHandlerFunc(func(params schema.TenantsActivityUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsActivityUpdate has not yet been implemented")
+		}),
 		SchemaTenantsCreateHandler: schema.TenantsCreateHandlerFunc(func(params schema.TenantsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsCreate has not yet been implemented")
 		}),
@@ -204,6 +410,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaTenantsGetHandler: schema.TenantsGetHandlerFunc(func(params schema.TenantsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsGet has not yet been implemented")
 		}),
+		SchemaTenantsQuotasUpdateHandler: schema.TenantsQuotasUpdateHandlerFunc(func(params schema.TenantsQuotasUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsQuotasUpdate has not yet been implemented")
+		}),
+		SchemaTenantsRestoreHandler: schema.TenantsRestoreHandlerFunc(func(params schema.TenantsRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsRestore has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -249,13 +461,21 @@ type WeaviateAPI struct {
 
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONConsumer runtime.Consumer
 	// YamlConsumer registers a consumer for the following mime types:
 	//   - application/yaml
 	YamlConsumer runtime.Consumer
 
+	// ApplicationVndApacheArrowStreamProducer registers a producer for the following mime types:
+	//   - application/vnd.apache.arrow.stream
+	ApplicationVndApacheArrowStreamProducer runtime.Producer
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONProducer runtime.Producer
 
 	// OidcAuth registers a function that takes an access token and a collection of required scopes and returns a principal
@@ -267,6 +487,38 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// AdminAdmissionControlGetHandler sets the operation handler for the admission control get operation
+	AdminAdmissionControlGetHandler admin.AdmissionControlGetHandler
+	// AdminAdmissionControlUpdateHandler sets the operation handler for the admission control update operation
+	AdminAdmissionControlUpdateHandler admin.AdmissionControlUpdateHandler
+	// AliasesAliasesDeleteHandler sets the operation handler for the aliases delete operation
+	AliasesAliasesDeleteHandler aliases.AliasesDeleteHandler
+	// AliasesAliasesGetHandler sets the operation handler for the aliases get operation
+	AliasesAliasesGetHandler aliases.AliasesGetHandler
+	// AliasesAliasesListHandler sets the operation handler for the aliases list operation
+	AliasesAliasesListHandler aliases.AliasesListHandler
+	// AliasesAliasesPutHandler sets the operation handler for the aliases put operation
+	AliasesAliasesPutHandler aliases.AliasesPutHandler
+	// ApikeysApikeysCreateHandler sets the operation handler for the apikeys create operation
+	ApikeysApikeysCreateHandler apikeys.ApikeysCreateHandler
+	// ApikeysApikeysListHandler sets the operation handler for the apikeys list operation
+	ApikeysApikeysListHandler apikeys.ApikeysListHandler
+	// ApikeysApikeysRevokeHandler sets the operation handler for the apikeys revoke operation
+	ApikeysApikeysRevokeHandler apikeys.ApikeysRevokeHandler
+	// ApikeysApikeysRotateHandler sets the operation handler for the apikeys rotate operation
+	ApikeysApikeysRotateHandler apikeys.ApikeysRotateHandler
+	// AuthzAuthzRolesDeleteHandler sets the operation handler for the authz roles delete operation
+	AuthzAuthzRolesDeleteHandler authz.AuthzRolesDeleteHandler
+	// AuthzAuthzRolesGetHandler sets the operation handler for the authz roles get operation
+	AuthzAuthzRolesGetHandler authz.AuthzRolesGetHandler
+	// AuthzAuthzRolesListHandler sets the operation handler for the authz roles list operation
+	AuthzAuthzRolesListHandler authz.AuthzRolesListHandler
+	// AuthzAuthzRolesPutHandler sets the operation handler for the authz roles put operation
+	AuthzAuthzRolesPutHandler authz.AuthzRolesPutHandler
+	// AuthzAuthzUsersRolesGetHandler sets the operation handler for the authz users roles get operation
+	AuthzAuthzUsersRolesGetHandler authz.AuthzUsersRolesGetHandler
+	// AuthzAuthzUsersRolesPutHandler sets the operation handler for the authz users roles put operation
+	AuthzAuthzUsersRolesPutHandler authz.AuthzUsersRolesPutHandler
 	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
@@ -279,24 +531,66 @@ type WeaviateAPI struct {
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchObjectsMergeHandler sets the operation handler for the batch objects merge operation
+	BatchBatchObjectsMergeHandler batch.BatchObjectsMergeHandler
+	// BatchBatchObjectsStreamHandler sets the operation handler for the batch objects stream operation
+	BatchBatchObjectsStreamHandler batch.BatchObjectsStreamHandler
+	// BatchBatchObjectsUpdateHandler sets the operation handler for the batch objects update operation
+	BatchBatchObjectsUpdateHandler batch.BatchObjectsUpdateHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
+	// BulkImportsBulkImportsCancelHandler sets the operation handler for the bulk imports cancel operation
+	BulkImportsBulkImportsCancelHandler bulk_imports.BulkImportsCancelHandler
+	// BulkImportsBulkImportsGetHandler sets the operation handler for the bulk imports get operation
+	BulkImportsBulkImportsGetHandler bulk_imports.BulkImportsGetHandler
+	// BulkImportsBulkImportsListHandler sets the operation handler for the bulk imports list operation
+	BulkImportsBulkImportsListHandler bulk_imports.BulkImportsListHandler
+	// BulkImportsBulkImportsStartHandler sets the operation handler for the bulk imports start operation
+	BulkImportsBulkImportsStartHandler bulk_imports.BulkImportsStartHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterNodesDrainHandler sets the operation handler for the cluster nodes drain operation
+	ClusterClusterNodesDrainHandler cluster.ClusterNodesDrainHandler
+	// ClusterClusterNodesDrainStatusHandler sets the operation handler for the cluster nodes drain status operation
+	ClusterClusterNodesDrainStatusHandler cluster.ClusterNodesDrainStatusHandler
+	// ClusterClusterShardsMoveHandler sets the operation handler for the cluster shards move operation
+	ClusterClusterShardsMoveHandler cluster.ClusterShardsMoveHandler
+	// ClusterClusterShardsSplitHandler sets the operation handler for the cluster shards split operation
+	ClusterClusterShardsSplitHandler cluster.ClusterShardsSplitHandler
+	// ClusterClusterShardsSplitStatusHandler sets the operation handler for the cluster shards split status operation
+	ClusterClusterShardsSplitStatusHandler cluster.ClusterShardsSplitStatusHandler
+	// AdminDebugSlowQueriesHandler sets the operation handler for the debug slow queries operation
+	AdminDebugSlowQueriesHandler admin.DebugSlowQueriesHandler
+	// FederationFederationPeersDeregisterHandler sets the operation handler for the federation peers deregister operation
+	FederationFederationPeersDeregisterHandler federation.FederationPeersDeregisterHandler
+	// FederationFederationPeersListHandler sets the operation handler for the federation peers list operation
+	FederationFederationPeersListHandler federation.FederationPeersListHandler
+	// FederationFederationPeersRegisterHandler sets the operation handler for the federation peers register operation
+	FederationFederationPeersRegisterHandler federation.FederationPeersRegisterHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// JobsJobsCancelHandler sets the operation handler for the jobs cancel operation
+	JobsJobsCancelHandler jobs.JobsCancelHandler
+	// JobsJobsGetHandler sets the operation handler for the jobs get operation
+	JobsJobsGetHandler jobs.JobsGetHandler
+	// JobsJobsListHandler sets the operation handler for the jobs list operation
+	JobsJobsListHandler jobs.JobsListHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// ObjectsObjectsBlobsGetHandler sets the operation handler for the objects blobs get operation
+	ObjectsObjectsBlobsGetHandler objects.ObjectsBlobsGetHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
+	// ObjectsObjectsClassExportHandler sets the operation handler for the objects class export operation
+	ObjectsObjectsClassExportHandler objects.ObjectsClassExportHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
 	ObjectsObjectsClassGetHandler objects.ObjectsClassGetHandler
 	// ObjectsObjectsClassHeadHandler sets the operation handler for the objects class head operation
@@ -321,6 +615,8 @@ type WeaviateAPI struct {
 	ObjectsObjectsHeadHandler objects.ObjectsHeadHandler
 	// ObjectsObjectsListHandler sets the operation handler for the objects list operation
 	ObjectsObjectsListHandler objects.ObjectsListHandler
+	// ObjectsObjectsMultiGetHandler sets the operation handler for the objects multi get operation
+	ObjectsObjectsMultiGetHandler objects.ObjectsMultiGetHandler
 	// ObjectsObjectsPatchHandler sets the operation handler for the objects patch operation
 	ObjectsObjectsPatchHandler objects.ObjectsPatchHandler
 	// ObjectsObjectsReferencesCreateHandler sets the operation handler for the objects references create operation
@@ -333,6 +629,20 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// AdminQueryResultCacheFlushHandler sets the operation handler for the query result cache flush operation
+	AdminQueryResultCacheFlushHandler admin.QueryResultCacheFlushHandler
+	// AdminQueryResultCacheGetHandler sets the operation handler for the query result cache get operation
+	AdminQueryResultCacheGetHandler admin.QueryResultCacheGetHandler
+	// AdminQueryVectorCacheFlushHandler sets the operation handler for the query vector cache flush operation
+	AdminQueryVectorCacheFlushHandler admin.QueryVectorCacheFlushHandler
+	// AdminQueryVectorCacheGetHandler sets the operation handler for the query vector cache get operation
+	AdminQueryVectorCacheGetHandler admin.QueryVectorCacheGetHandler
+	// ReplicationReplicationCrossClusterApplyHandler sets the operation handler for the replication cross cluster apply operation
+	ReplicationReplicationCrossClusterApplyHandler replication.ReplicationCrossClusterApplyHandler
+	// ReplicationReplicationCrossClusterPromoteHandler sets the operation handler for the replication cross cluster promote operation
+	ReplicationReplicationCrossClusterPromoteHandler replication.ReplicationCrossClusterPromoteHandler
+	// ReplicationReplicationCrossClusterStatusHandler sets the operation handler for the replication cross cluster status operation
+	ReplicationReplicationCrossClusterStatusHandler replication.ReplicationCrossClusterStatusHandler
 	// SchemaSchemaClusterStatusHandler sets the operation handler for the schema cluster status operation
 	SchemaSchemaClusterStatusHandler schema.SchemaClusterStatusHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
@@ -341,22 +651,64 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
 	SchemaSchemaObjectsDeleteHandler schema.SchemaObjectsDeleteHandler
+	// SchemaSchemaObjectsDuplicatesDetectHandler sets the operation handler for the schema objects duplicates detect operation
+	SchemaSchemaObjectsDuplicatesDetectHandler schema.SchemaObjectsDuplicatesDetectHandler
+	// SchemaSchemaObjectsDuplicatesStatusHandler sets the operation handler for the schema objects duplicates status operation
+	SchemaSchemaObjectsDuplicatesStatusHandler schema.SchemaObjectsDuplicatesStatusHandler
 	// SchemaSchemaObjectsGetHandler sets the operation handler for the schema objects get operation
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
+	// SchemaSchemaObjectsProfileHandler sets the operation handler for the schema objects profile operation
+	SchemaSchemaObjectsProfileHandler schema.SchemaObjectsProfileHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
+	// SchemaSchemaObjectsPropertiesRenameHandler sets the operation handler for the schema objects properties rename operation
+	SchemaSchemaObjectsPropertiesRenameHandler schema.SchemaObjectsPropertiesRenameHandler
+	// SchemaSchemaObjectsPropertiesTokenizationHandler sets the operation handler for the schema objects properties tokenization operation
+	SchemaSchemaObjectsPropertiesTokenizationHandler schema.SchemaObjectsPropertiesTokenizationHandler
+	// SchemaSchemaObjectsReferencesVerifyHandler sets the operation handler for the schema objects references verify operation
+	SchemaSchemaObjectsReferencesVerifyHandler schema.SchemaObjectsReferencesVerifyHandler
+	// SchemaSchemaObjectsReferencesVerifyStatusHandler sets the operation handler for the schema objects references verify status operation
+	SchemaSchemaObjectsReferencesVerifyStatusHandler schema.SchemaObjectsReferencesVerifyStatusHandler
+	// SchemaSchemaObjectsRenameHandler sets the operation handler for the schema objects rename operation
+	SchemaSchemaObjectsRenameHandler schema.SchemaObjectsRenameHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
+	// SchemaSchemaObjectsStopwordsGetHandler sets the operation handler for the schema objects stopwords get operation
+	SchemaSchemaObjectsStopwordsGetHandler schema.SchemaObjectsStopwordsGetHandler
+	// SchemaSchemaObjectsStopwordsReplaceHandler sets the operation handler for the schema objects stopwords replace operation
+	SchemaSchemaObjectsStopwordsReplaceHandler schema.SchemaObjectsStopwordsReplaceHandler
+	// SchemaSchemaObjectsStopwordsUpdateHandler sets the operation handler for the schema objects stopwords update operation
+	SchemaSchemaObjectsStopwordsUpdateHandler schema.SchemaObjectsStopwordsUpdateHandler
+	// SchemaSchemaObjectsSuggestHandler sets the operation handler for the schema objects suggest operation
+	SchemaSchemaObjectsSuggestHandler schema.SchemaObjectsSuggestHandler
+	// SchemaSchemaObjectsSynonymsGetHandler sets the operation handler for the schema objects synonyms get operation
+	SchemaSchemaObjectsSynonymsGetHandler schema.SchemaObjectsSynonymsGetHandler
+	// SchemaSchemaObjectsSynonymsUpdateHandler sets the operation handler for the schema objects synonyms update operation
+	SchemaSchemaObjectsSynonymsUpdateHandler schema.SchemaObjectsSynonymsUpdateHandler
+	// SchemaSchemaObjectsTieringGetHandler sets the operation handler for the schema objects tiering get operation
+	SchemaSchemaObjectsTieringGetHandler schema.SchemaObjectsTieringGetHandler
+	// SchemaSchemaObjectsTieringUpdateHandler sets the operation handler for the schema objects tiering update operation
+	SchemaSchemaObjectsTieringUpdateHandler schema.SchemaObjectsTieringUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaTenantsActivityGetHandler sets the operation handler for the tenants activity get operation
+	SchemaTenantsActivityGetHandler schema.TenantsActivityGetHandler
+	// SchemaTenantsActivityUpdateHandler sets the operation handler for the tenants activity update operation
+	SchemaTenantsActivityUpdateHandler schema.TenantsActivityUpdateHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
 	SchemaTenantsCreateHandler schema.TenantsCreateHandler
 	// SchemaTenantsDeleteHandler sets the operation handler for the tenants delete operation
 	SchemaTenantsDeleteHandler schema.TenantsDeleteHandler
 	// SchemaTenantsGetHandler sets the operation handler for the tenants get operation
 	SchemaTenantsGetHandler schema.TenantsGetHandler
+	// SchemaTenantsQuotasUpdateHandler sets the operation handler for the tenants quotas update operation
+	SchemaTenantsQuotasUpdateHandler schema.TenantsQuotasUpdateHandler
+	// SchemaTenantsRestoreHandler sets the operation handler for the tenants restore operation
+	SchemaTenantsRestoreHandler schema.TenantsRestoreHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -439,6 +791,12 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "YamlConsumer")
 	}
 
+	if o.ApplicationVndApacheArrowStreamProducer == nil {
+		unregistered = append(unregistered, "ApplicationVndApacheArrowStreamProducer")
+	}
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -450,6 +808,54 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.AdminAdmissionControlGetHandler == nil {
+		unregistered = append(unregistered, "admin.AdmissionControlGetHandler")
+	}
+	if o.AdminAdmissionControlUpdateHandler == nil {
+		unregistered = append(unregistered, "admin.AdmissionControlUpdateHandler")
+	}
+	if o.AliasesAliasesDeleteHandler == nil {
+		unregistered = append(unregistered, "aliases.AliasesDeleteHandler")
+	}
+	if o.AliasesAliasesGetHandler == nil {
+		unregistered = append(unregistered, "aliases.AliasesGetHandler")
+	}
+	if o.AliasesAliasesListHandler == nil {
+		unregistered = append(unregistered, "aliases.AliasesListHandler")
+	}
+	if o.AliasesAliasesPutHandler == nil {
+		unregistered = append(unregistered, "aliases.AliasesPutHandler")
+	}
+	if o.ApikeysApikeysCreateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysCreateHandler")
+	}
+	if o.ApikeysApikeysListHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysListHandler")
+	}
+	if o.ApikeysApikeysRevokeHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRevokeHandler")
+	}
+	if o.ApikeysApikeysRotateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRotateHandler")
+	}
+	if o.AuthzAuthzRolesDeleteHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesDeleteHandler")
+	}
+	if o.AuthzAuthzRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesGetHandler")
+	}
+	if o.AuthzAuthzRolesListHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesListHandler")
+	}
+	if o.AuthzAuthzRolesPutHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesPutHandler")
+	}
+	if o.AuthzAuthzUsersRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUsersRolesGetHandler")
+	}
+	if o.AuthzAuthzUsersRolesPutHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUsersRolesPutHandler")
+	}
 	if o.BackupsBackupsCreateHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateHandler")
 	}
@@ -468,21 +874,78 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchObjectsMergeHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsMergeHandler")
+	}
+	if o.BatchBatchObjectsStreamHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsStreamHandler")
+	}
+	if o.BatchBatchObjectsUpdateHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsUpdateHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
+	if o.BulkImportsBulkImportsCancelHandler == nil {
+		unregistered = append(unregistered, "bulk_imports.BulkImportsCancelHandler")
+	}
+	if o.BulkImportsBulkImportsGetHandler == nil {
+		unregistered = append(unregistered, "bulk_imports.BulkImportsGetHandler")
+	}
+	if o.BulkImportsBulkImportsListHandler == nil {
+		unregistered = append(unregistered, "bulk_imports.BulkImportsListHandler")
+	}
+	if o.BulkImportsBulkImportsStartHandler == nil {
+		unregistered = append(unregistered, "bulk_imports.BulkImportsStartHandler")
+	}
 	if o.ClassificationsClassificationsGetHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsGetHandler")
 	}
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterNodesDrainHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterNodesDrainHandler")
+	}
+	if o.ClusterClusterNodesDrainStatusHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterNodesDrainStatusHandler")
+	}
+	if o.ClusterClusterShardsMoveHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsMoveHandler")
+	}
+	if o.ClusterClusterShardsSplitHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsSplitHandler")
+	}
+	if o.ClusterClusterShardsSplitStatusHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardsSplitStatusHandler")
+	}
+	if o.AdminDebugSlowQueriesHandler == nil {
+		unregistered = append(unregistered, "admin.DebugSlowQueriesHandler")
+	}
+	if o.FederationFederationPeersDeregisterHandler == nil {
+		unregistered = append(unregistered, "federation.FederationPeersDeregisterHandler")
+	}
+	if o.FederationFederationPeersListHandler == nil {
+		unregistered = append(unregistered, "federation.FederationPeersListHandler")
+	}
+	if o.FederationFederationPeersRegisterHandler == nil {
+		unregistered = append(unregistered, "federation.FederationPeersRegisterHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.JobsJobsCancelHandler == nil {
+		unregistered = append(unregistered, "jobs.JobsCancelHandler")
+	}
+	if o.JobsJobsGetHandler == nil {
+		unregistered = append(unregistered, "jobs.JobsGetHandler")
+	}
+	if o.JobsJobsListHandler == nil {
+		unregistered = append(unregistered, "jobs.JobsListHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
@@ -492,9 +955,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.ObjectsObjectsBlobsGetHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsBlobsGetHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
+	if o.ObjectsObjectsClassExportHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassExportHandler")
+	}
 	if o.ObjectsObjectsClassGetHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassGetHandler")
 	}
@@ -531,6 +1000,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsListHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsListHandler")
 	}
+	if o.ObjectsObjectsMultiGetHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsMultiGetHandler")
+	}
 	if o.ObjectsObjectsPatchHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsPatchHandler")
 	}
@@ -549,6 +1021,27 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.AdminQueryResultCacheFlushHandler == nil {
+		unregistered = append(unregistered, "admin.QueryResultCacheFlushHandler")
+	}
+	if o.AdminQueryResultCacheGetHandler == nil {
+		unregistered = append(unregistered, "admin.QueryResultCacheGetHandler")
+	}
+	if o.AdminQueryVectorCacheFlushHandler == nil {
+		unregistered = append(unregistered, "admin.QueryVectorCacheFlushHandler")
+	}
+	if o.AdminQueryVectorCacheGetHandler == nil {
+		unregistered = append(unregistered, "admin.QueryVectorCacheGetHandler")
+	}
+	if o.ReplicationReplicationCrossClusterApplyHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationCrossClusterApplyHandler")
+	}
+	if o.ReplicationReplicationCrossClusterPromoteHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationCrossClusterPromoteHandler")
+	}
+	if o.ReplicationReplicationCrossClusterStatusHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationCrossClusterStatusHandler")
+	}
 	if o.SchemaSchemaClusterStatusHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaClusterStatusHandler")
 	}
@@ -561,21 +1054,78 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsDeleteHandler")
 	}
+	if o.SchemaSchemaObjectsDuplicatesDetectHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsDuplicatesDetectHandler")
+	}
+	if o.SchemaSchemaObjectsDuplicatesStatusHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsDuplicatesStatusHandler")
+	}
 	if o.SchemaSchemaObjectsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsGetHandler")
 	}
+	if o.SchemaSchemaObjectsProfileHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsProfileHandler")
+	}
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesRenameHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesRenameHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesTokenizationHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesTokenizationHandler")
+	}
+	if o.SchemaSchemaObjectsReferencesVerifyHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReferencesVerifyHandler")
+	}
+	if o.SchemaSchemaObjectsReferencesVerifyStatusHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReferencesVerifyStatusHandler")
+	}
+	if o.SchemaSchemaObjectsRenameHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRenameHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
 	if o.SchemaSchemaObjectsShardsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsStopwordsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStopwordsGetHandler")
+	}
+	if o.SchemaSchemaObjectsStopwordsReplaceHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStopwordsReplaceHandler")
+	}
+	if o.SchemaSchemaObjectsStopwordsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStopwordsUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsSuggestHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSuggestHandler")
+	}
+	if o.SchemaSchemaObjectsSynonymsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSynonymsGetHandler")
+	}
+	if o.SchemaSchemaObjectsSynonymsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSynonymsUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsTieringGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsTieringGetHandler")
+	}
+	if o.SchemaSchemaObjectsTieringUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsTieringUpdateHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaTenantsActivityGetHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsActivityGetHandler")
+	}
+	if o.SchemaTenantsActivityUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsActivityUpdateHandler")
+	}
 	if o.SchemaTenantsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsCreateHandler")
 	}
@@ -585,6 +1135,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaTenantsGetHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsGetHandler")
 	}
+	if o.SchemaTenantsQuotasUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsQuotasUpdateHandler")
+	}
+	if o.SchemaTenantsRestoreHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsRestoreHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -635,6 +1191,8 @@ func (o *WeaviateAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consu
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONConsumer
 		case "application/yaml":
 			result["application/yaml"] = o.YamlConsumer
 		}
@@ -652,8 +1210,14 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/vnd.apache.arrow.stream":
+			result["application/vnd.apache.arrow.stream"] = o.ApplicationVndApacheArrowStreamProducer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -698,6 +1262,70 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/.well-known/openid-configuration"] = well_known.NewGetWellKnownOpenidConfiguration(o.context, o.WellKnownGetWellKnownOpenidConfigurationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admission-control"] = admin.NewAdmissionControlGet(o.context, o.AdminAdmissionControlGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admission-control"] = admin.NewAdmissionControlUpdate(o.context, o.AdminAdmissionControlUpdateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/aliases/{aliasName}"] = aliases.NewAliasesDelete(o.context, o.AliasesAliasesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/aliases/{aliasName}"] = aliases.NewAliasesGet(o.context, o.AliasesAliasesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/aliases"] = aliases.NewAliasesList(o.context, o.AliasesAliasesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/aliases/{aliasName}"] = aliases.NewAliasesPut(o.context, o.AliasesAliasesPutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys"] = apikeys.NewApikeysCreate(o.context, o.ApikeysApikeysCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apikeys"] = apikeys.NewApikeysList(o.context, o.ApikeysApikeysListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apikeys/{id}"] = apikeys.NewApikeysRevoke(o.context, o.ApikeysApikeysRevokeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys/{id}/rotate"] = apikeys.NewApikeysRotate(o.context, o.ApikeysApikeysRotateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/authz/roles/{roleName}"] = authz.NewAuthzRolesDelete(o.context, o.AuthzAuthzRolesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles/{roleName}"] = authz.NewAuthzRolesGet(o.context, o.AuthzAuthzRolesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles"] = authz.NewAuthzRolesList(o.context, o.AuthzAuthzRolesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/authz/roles/{roleName}"] = authz.NewAuthzRolesPut(o.context, o.AuthzAuthzRolesPutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/users/{userName}/roles"] = authz.NewAuthzUsersRolesGet(o.context, o.AuthzAuthzUsersRolesGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/authz/users/{userName}/roles"] = authz.NewAuthzUsersRolesPut(o.context, o.AuthzAuthzUsersRolesPutHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -722,10 +1350,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/batch/objects"] = batch.NewBatchObjectsDelete(o.context, o.BatchBatchObjectsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batch/objects"] = batch.NewBatchObjectsMerge(o.context, o.BatchBatchObjectsMergeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batch/objects/stream"] = batch.NewBatchObjectsStream(o.context, o.BatchBatchObjectsStreamHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/batch/objects"] = batch.NewBatchObjectsUpdate(o.context, o.BatchBatchObjectsUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/batch/references"] = batch.NewBatchReferencesCreate(o.context, o.BatchBatchReferencesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/bulk-imports/{id}"] = bulk_imports.NewBulkImportsCancel(o.context, o.BulkImportsBulkImportsCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bulk-imports/{id}"] = bulk_imports.NewBulkImportsGet(o.context, o.BulkImportsBulkImportsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bulk-imports"] = bulk_imports.NewBulkImportsList(o.context, o.BulkImportsBulkImportsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/bulk-imports"] = bulk_imports.NewBulkImportsStart(o.context, o.BulkImportsBulkImportsStartHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -737,11 +1393,59 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterNodesDrain(o.context, o.ClusterClusterNodesDrainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterNodesDrainStatus(o.context, o.ClusterClusterNodesDrainStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/shards/move"] = cluster.NewClusterShardsMove(o.context, o.ClusterClusterShardsMoveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/shards/split"] = cluster.NewClusterShardsSplit(o.context, o.ClusterClusterShardsSplitHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/shards/split"] = cluster.NewClusterShardsSplitStatus(o.context, o.ClusterClusterShardsSplitStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/debug/slow-queries"] = admin.NewDebugSlowQueries(o.context, o.AdminDebugSlowQueriesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/federation/peers/{peerName}"] = federation.NewFederationPeersDeregister(o.context, o.FederationFederationPeersDeregisterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/federation/peers"] = federation.NewFederationPeersList(o.context, o.FederationFederationPeersListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/federation/peers"] = federation.NewFederationPeersRegister(o.context, o.FederationFederationPeersRegisterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql/batch"] = graphql.NewGraphqlBatch(o.context, o.GraphqlGraphqlBatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/jobs/{id}"] = jobs.NewJobsCancel(o.context, o.JobsJobsCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/jobs/{id}"] = jobs.NewJobsGet(o.context, o.JobsJobsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/jobs"] = jobs.NewJobsList(o.context, o.JobsJobsListHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -754,6 +1458,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobs/{className}/{id}/{propertyName}"] = objects.NewObjectsBlobsGet(o.context, o.ObjectsObjectsBlobsGetHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -761,6 +1469,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/objects/{className}/export"] = objects.NewObjectsClassExport(o.context, o.ObjectsObjectsClassExportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/objects/{className}/{id}"] = objects.NewObjectsClassGet(o.context, o.ObjectsObjectsClassGetHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
@@ -806,6 +1518,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/objects"] = objects.NewObjectsList(o.context, o.ObjectsObjectsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/_get"] = objects.NewObjectsMultiGet(o.context, o.ObjectsObjectsMultiGetHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -830,6 +1546,34 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/query-result-cache"] = admin.NewQueryResultCacheFlush(o.context, o.AdminQueryResultCacheFlushHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/query-result-cache"] = admin.NewQueryResultCacheGet(o.context, o.AdminQueryResultCacheGetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/modules/query-vector-cache"] = admin.NewQueryVectorCacheFlush(o.context, o.AdminQueryVectorCacheFlushHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/modules/query-vector-cache"] = admin.NewQueryVectorCacheGet(o.context, o.AdminQueryVectorCacheGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/cross-cluster/apply"] = replication.NewReplicationCrossClusterApply(o.context, o.ReplicationReplicationCrossClusterApplyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/cross-cluster/promote"] = replication.NewReplicationCrossClusterPromote(o.context, o.ReplicationReplicationCrossClusterPromoteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/cross-cluster/status"] = replication.NewReplicationCrossClusterStatus(o.context, o.ReplicationReplicationCrossClusterStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -846,14 +1590,50 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/schema/{className}"] = schema.NewSchemaObjectsDelete(o.context, o.SchemaSchemaObjectsDeleteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/duplicates"] = schema.NewSchemaObjectsDuplicatesDetect(o.context, o.SchemaSchemaObjectsDuplicatesDetectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/duplicates"] = schema.NewSchemaObjectsDuplicatesStatus(o.context, o.SchemaSchemaObjectsDuplicatesStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema/{className}"] = schema.NewSchemaObjectsGet(o.context, o.SchemaSchemaObjectsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/profile"] = schema.NewSchemaObjectsProfile(o.context, o.SchemaSchemaObjectsProfileHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/delete"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/rename"] = schema.NewSchemaObjectsPropertiesRename(o.context, o.SchemaSchemaObjectsPropertiesRenameHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/tokenization"] = schema.NewSchemaObjectsPropertiesTokenization(o.context, o.SchemaSchemaObjectsPropertiesTokenizationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/references/verify"] = schema.NewSchemaObjectsReferencesVerify(o.context, o.SchemaSchemaObjectsReferencesVerifyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/references/verify"] = schema.NewSchemaObjectsReferencesVerifyStatus(o.context, o.SchemaSchemaObjectsReferencesVerifyStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/rename"] = schema.NewSchemaObjectsRename(o.context, o.SchemaSchemaObjectsRenameHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -862,10 +1642,50 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}/shards/{shardName}"] = schema.NewSchemaObjectsShardsUpdate(o.context, o.SchemaSchemaObjectsShardsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/stopwords"] = schema.NewSchemaObjectsStopwordsGet(o.context, o.SchemaSchemaObjectsStopwordsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/stopwords"] = schema.NewSchemaObjectsStopwordsReplace(o.context, o.SchemaSchemaObjectsStopwordsReplaceHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/schema/{className}/stopwords"] = schema.NewSchemaObjectsStopwordsUpdate(o.context, o.SchemaSchemaObjectsStopwordsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/suggest"] = schema.NewSchemaObjectsSuggest(o.context, o.SchemaSchemaObjectsSuggestHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/synonyms"] = schema.NewSchemaObjectsSynonymsGet(o.context, o.SchemaSchemaObjectsSynonymsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/synonyms"] = schema.NewSchemaObjectsSynonymsUpdate(o.context, o.SchemaSchemaObjectsSynonymsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tiering"] = schema.NewSchemaObjectsTieringGet(o.context, o.SchemaSchemaObjectsTieringGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/tiering"] = schema.NewSchemaObjectsTieringUpdate(o.context, o.SchemaSchemaObjectsTieringUpdateHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants/activity"] = schema.NewTenantsActivityGet(o.context, o.SchemaTenantsActivityGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/tenants/activity"] = schema.NewTenantsActivityUpdate(o.context, o.SchemaTenantsActivityUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -878,6 +1698,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema/{className}/tenants"] = schema.NewTenantsGet(o.context, o.SchemaTenantsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/tenants/quotas"] = schema.NewTenantsQuotasUpdate(o.context, o.SchemaTenantsQuotasUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants/restore"] = schema.NewTenantsRestore(o.context, o.SchemaTenantsRestoreHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -12,24 +12,37 @@
 package state
 
 import (
+	"sync"
+
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/adapters/repos/authz"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/embeddingcache"
+	federationrepo "github.com/weaviate/weaviate/adapters/repos/federation"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+	"github.com/weaviate/weaviate/usecases/federation"
+	"github.com/weaviate/weaviate/usecases/jobs"
 	"github.com/weaviate/weaviate/usecases/locks"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -41,6 +54,15 @@ type State struct {
 	AnonymousAccess       *anonymous.Client
 	APIKey                *apikey.Client
 	Authorizer            authorization.Authorizer
+	RBAC                  *rbac.Authorizer          // nil unless role based access control is enabled
+	APIKeys               *apikey.Keys              // nil unless dynamic api keys are enabled
+	AuthzRepo             *authz.DistributedRepo    // nil unless RBAC or APIKeys is set
+	EmbeddingCache        *embeddingcache.Repo      // nil unless the embedding cache is enabled
+	QueryVectorCache      *modules.QueryVectorCache // nil unless the query vector cache is enabled
+	QueryResultCache      *traverser.ResultCache    // nil unless the query result cache is enabled
+	AuditLog              *audit.Logger             // nil unless the audit log is enabled
+	SlowQueryLog          *slowquery.Log            // nil unless the slow query log is enabled
+	Jobs                  *jobs.Manager
 	ServerConfig          *config.WeaviateConfig
 	Locks                 locks.ConnectorSchemaLock
 	Logger                *logrus.Logger
@@ -49,15 +71,26 @@ type State struct {
 	SchemaManager         *schema.Manager
 	Scaler                *scaler.Scaler
 	Cluster               *cluster.State
+	ClusterTLS            *cluster.TLS  // nil unless cluster TLS is enabled
+	SchemaConsensus       *cluster.Raft // nil unless raft is enabled
 	RemoteIndexIncoming   *sharding.RemoteIndexIncoming
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 	Traverser             *traverser.Traverser
+	ObjectsManager        *objects.Manager
+	BatchManager          *objects.BatchManager
+	FederationResolver    *federation.Resolver
+	FederationRepo        *federationrepo.DistributedRepo
+	AdmissionControl      *admission.Controller
+	// nil unless a cross-cluster replication role is configured
+	CrossClusterReplication *crossrepl.Manager
 
 	ClassificationRepo *classifications.DistributedRepo
 	Metrics            *monitoring.PrometheusMetrics
 	BackupManager      *backup.Manager
 	DB                 *db.DB
+
+	graphQLLock sync.RWMutex
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
@@ -66,5 +99,15 @@ type State struct {
 //
 // type gqlProvider interface { GetGraphQL graphql.GraphQL }
 func (s *State) GetGraphQL() graphql.GraphQL {
+	s.graphQLLock.RLock()
+	defer s.graphQLLock.RUnlock()
 	return s.GraphQL
 }
+
+// SetGraphQL replaces the GraphQL provider. Requests which already
+// retrieved the previous provider keep using it.
+func (s *State) SetGraphQL(gql graphql.GraphQL) {
+	s.graphQLLock.Lock()
+	defer s.graphQLLock.Unlock()
+	s.GraphQL = gql
+}
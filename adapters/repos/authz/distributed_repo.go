@@ -0,0 +1,418 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	DefaultTxTTL = 60 * time.Second
+
+	// nodes which start at the same time may run into each other's
+	// transactions when they read the state of the cluster
+	readAttempts = 3
+	readBackoff  = time.Second
+)
+
+// Roles is updated with the changes made on other nodes, see
+// rbac.Authorizer
+type Roles interface {
+	ApplyRole(role rbac.Role) error
+	ApplyDeleteRole(name string)
+	ApplyUserRoles(user string, roles []string)
+}
+
+// DistributedRepo stores the roles and the api keys on all nodes of the
+// cluster. A change is only made if every node accepts it, so a revoked key
+// or role can no longer be used on any node once the change returns and a
+// node cannot miss a change while it is down. Nodes which join the cluster
+// merge the keys of the other nodes into their own and take over their roles
+// if they have none, when they are loaded.
+type DistributedRepo struct {
+	*Repo
+
+	sync.Mutex
+	txRemote *cluster.TxManager
+	members  cluster.MemberLister
+	apiKeyFn func(apikey.Key)
+	roles    Roles
+	logger   logrus.FieldLogger
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	members cluster.MemberLister, localRepo *Repo, logger logrus.FieldLogger,
+) *DistributedRepo {
+	broadcaster := cluster.NewTxBroadcaster(members, remoteClient)
+	repo := &DistributedRepo{
+		Repo:     localRepo,
+		txRemote: cluster.NewTxManager(broadcaster, logger),
+		members:  members,
+		logger:   logger,
+	}
+
+	broadcaster.SetConsensusFunction(repo.mergeRead)
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+	repo.txRemote.SetResponseFn(repo.incomingTxResponse)
+
+	return repo
+}
+
+// SetAPIKeyFn sets the function which is called with every key changed on
+// another node, see apikey.Keys.Apply
+func (r *DistributedRepo) SetAPIKeyFn(fn func(apikey.Key)) {
+	r.Lock()
+	defer r.Unlock()
+	r.apiKeyFn = fn
+}
+
+// SetRoles sets the roles which are updated with the changes made on other
+// nodes
+func (r *DistributedRepo) SetRoles(roles Roles) {
+	r.Lock()
+	defer r.Unlock()
+	r.roles = roles
+}
+
+// Load returns the local roles. A node without any roles takes over the
+// roles of the other nodes, as it is new to the cluster. If they cannot be
+// read it starts without roles, which grants nothing.
+func (r *DistributedRepo) Load() ([]rbac.Role, map[string][]string, error) {
+	roles, users, err := r.Repo.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(roles) > 0 || len(users) > 0 || len(r.members.AllNames()) <= 1 {
+		return roles, users, nil
+	}
+
+	payload, err := r.readRemote(context.Background(), rbac.TransactionReadRoles)
+	if err != nil {
+		r.logger.WithField("action", "load_roles").WithError(err).
+			Warn("could not read the roles of the other nodes, starting without roles")
+		return roles, users, nil
+	}
+	pl := payload.(rbac.TransactionReadRolesPayload)
+	for _, role := range pl.Roles {
+		if err := r.Repo.PutRole(role); err != nil {
+			return nil, nil, fmt.Errorf("store role %q: %w", role.Name, err)
+		}
+	}
+	for user, userRoles := range pl.Users {
+		if err := r.Repo.PutUserRoles(user, userRoles); err != nil {
+			return nil, nil, fmt.Errorf("store roles of user %q: %w", user, err)
+		}
+	}
+	return pl.Roles, pl.Users, nil
+}
+
+// PutRole stores the role on all nodes, it fails if any node is unreachable
+func (r *DistributedRepo) PutRole(role rbac.Role) error {
+	return r.replicate(rbac.TransactionPutRole,
+		rbac.TransactionPutRolePayload{Role: role},
+		func() error { return r.Repo.PutRole(role) })
+}
+
+// DeleteRole deletes the role on all nodes, it fails if any node is
+// unreachable
+func (r *DistributedRepo) DeleteRole(name string) error {
+	return r.replicate(rbac.TransactionDeleteRole,
+		rbac.TransactionDeleteRolePayload{Name: name},
+		func() error { return r.Repo.DeleteRole(name) })
+}
+
+// PutUserRoles stores the roles of the user on all nodes, it fails if any
+// node is unreachable
+func (r *DistributedRepo) PutUserRoles(user string, roles []string) error {
+	return r.replicate(rbac.TransactionPutUserRoles,
+		rbac.TransactionPutUserRolesPayload{User: user, Roles: roles},
+		func() error { return r.Repo.PutUserRoles(user, roles) })
+}
+
+// LoadAPIKeys returns the local keys merged with the keys of the other
+// nodes, keys which changed elsewhere are stored locally. If the other nodes
+// cannot be read the local keys are used, a key which is missing cannot be
+// used.
+func (r *DistributedRepo) LoadAPIKeys() ([]apikey.Key, error) {
+	local, err := r.Repo.LoadAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(r.members.AllNames()) <= 1 {
+		return local, nil
+	}
+
+	payload, err := r.readRemote(context.Background(), apikey.TransactionReadKeys)
+	if err != nil {
+		r.logger.WithField("action", "load_api_keys").WithError(err).
+			Warn("could not read the api keys of the other nodes, using the local keys")
+		return local, nil
+	}
+
+	byID := make(map[string]int, len(local))
+	for i, key := range local {
+		byID[key.ID] = i
+	}
+	for _, key := range payload.(apikey.TransactionReadKeysPayload).Keys {
+		i, ok := byID[key.ID]
+		if ok && !newer(key, local[i]) {
+			continue
+		}
+		if err := r.Repo.PutAPIKey(key); err != nil {
+			return nil, fmt.Errorf("store api key %q: %w", key.ID, err)
+		}
+		if ok {
+			local[i] = key
+			continue
+		}
+		byID[key.ID] = len(local)
+		local = append(local, key)
+	}
+	return local, nil
+}
+
+// PutAPIKey stores the key on all nodes, it fails if any node is
+// unreachable
+func (r *DistributedRepo) PutAPIKey(key apikey.Key) error {
+	return r.replicate(apikey.TransactionPutKey,
+		apikey.TransactionPutKeyPayload{Key: key},
+		func() error { return r.Repo.PutAPIKey(key) })
+}
+
+// replicate commits a cluster-wide transaction before the change is stored
+// locally
+func (r *DistributedRepo) replicate(txType cluster.TransactionType,
+	payload interface{}, store func() error,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	ctx := context.Background()
+	tx, err := r.txRemote.BeginTransaction(ctx, txType, payload, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := r.txRemote.CommitWriteTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	return store()
+}
+
+// readRemote returns the merged payload of a read transaction
+func (r *DistributedRepo) readRemote(ctx context.Context,
+	txType cluster.TransactionType,
+) (interface{}, error) {
+	var err error
+	for attempt := 1; attempt <= readAttempts; attempt++ {
+		var tx *cluster.Transaction
+		tx, err = r.txRemote.BeginTransactionTolerateNodeFailures(ctx, txType,
+			nil, DefaultTxTTL)
+		if errors.Is(err, cluster.ErrConcurrentTransaction) && attempt < readAttempts {
+			time.Sleep(readBackoff)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("open transaction: %w", err)
+		}
+
+		// the transaction only reads, closing it is the same on every path
+		defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+		switch tx.Payload.(type) {
+		case apikey.TransactionReadKeysPayload, rbac.TransactionReadRolesPayload:
+			return tx.Payload, nil
+		default:
+			return nil, fmt.Errorf("unrecognized tx response payload: %T", tx.Payload)
+		}
+	}
+	return nil, err
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	r.Lock()
+	apiKeyFn, roles := r.apiKeyFn, r.roles
+	r.Unlock()
+
+	switch tx.Type {
+	case apikey.TransactionReadKeys, rbac.TransactionReadRoles:
+		return nil
+
+	case apikey.TransactionPutKey:
+		key := tx.Payload.(apikey.TransactionPutKeyPayload).Key
+		if err := r.Repo.PutAPIKey(key); err != nil {
+			return err
+		}
+		if apiKeyFn != nil {
+			apiKeyFn(key)
+		}
+		return nil
+
+	case rbac.TransactionPutRole:
+		role := tx.Payload.(rbac.TransactionPutRolePayload).Role
+		if err := r.Repo.PutRole(role); err != nil {
+			return err
+		}
+		if roles != nil {
+			return roles.ApplyRole(role)
+		}
+		return nil
+
+	case rbac.TransactionDeleteRole:
+		name := tx.Payload.(rbac.TransactionDeleteRolePayload).Name
+		if err := r.Repo.DeleteRole(name); err != nil {
+			return err
+		}
+		if roles != nil {
+			roles.ApplyDeleteRole(name)
+		}
+		return nil
+
+	case rbac.TransactionPutUserRoles:
+		pl := tx.Payload.(rbac.TransactionPutUserRolesPayload)
+		if err := r.Repo.PutUserRoles(pl.User, pl.Roles); err != nil {
+			return err
+		}
+		if roles != nil {
+			roles.ApplyUserRoles(pl.User, pl.Roles)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+// incomingTxResponse answers read transactions with the local state
+func (r *DistributedRepo) incomingTxResponse(ctx context.Context,
+	tx *cluster.Transaction,
+) ([]byte, error) {
+	res := *tx
+	switch tx.Type {
+	case apikey.TransactionReadKeys:
+		keys, err := r.Repo.LoadAPIKeys()
+		if err != nil {
+			return nil, err
+		}
+		res.Payload = apikey.TransactionReadKeysPayload{Keys: keys}
+
+	case rbac.TransactionReadRoles:
+		roles, users, err := r.Repo.Load()
+		if err != nil {
+			return nil, err
+		}
+		res.Payload = rbac.TransactionReadRolesPayload{Roles: roles, Users: users}
+
+	default:
+		return nil, nil
+	}
+	return json.Marshal(res)
+}
+
+// mergeRead merges the responses of the other nodes to a read transaction
+func (r *DistributedRepo) mergeRead(ctx context.Context,
+	in []*cluster.Transaction,
+) (*cluster.Transaction, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	switch in[0].Type {
+	case apikey.TransactionReadKeys:
+		return mergeReadKeys(in)
+	case rbac.TransactionReadRoles:
+		return mergeReadRoles(in)
+	default:
+		return nil, nil
+	}
+}
+
+// mergeReadKeys keeps the latest version of every key
+func mergeReadKeys(in []*cluster.Transaction) (*cluster.Transaction, error) {
+	byID := map[string]apikey.Key{}
+	for _, tx := range in {
+		raw, ok := tx.Payload.(json.RawMessage)
+		if !ok {
+			continue
+		}
+		var pl apikey.TransactionReadKeysPayload
+		if err := json.Unmarshal(raw, &pl); err != nil {
+			return nil, fmt.Errorf("unmarshal api keys: %w", err)
+		}
+		for _, key := range pl.Keys {
+			if prev, ok := byID[key.ID]; !ok || newer(key, prev) {
+				byID[key.ID] = key
+			}
+		}
+	}
+
+	keys := make([]apikey.Key, 0, len(byID))
+	for _, key := range byID {
+		keys = append(keys, key)
+	}
+	return &cluster.Transaction{
+		Payload: apikey.TransactionReadKeysPayload{Keys: keys},
+	}, nil
+}
+
+// mergeReadRoles takes the roles of the first node which has any, all nodes
+// have the same roles as every change is made on all of them
+func mergeReadRoles(in []*cluster.Transaction) (*cluster.Transaction, error) {
+	merged := rbac.TransactionReadRolesPayload{Users: map[string][]string{}}
+	for _, tx := range in {
+		raw, ok := tx.Payload.(json.RawMessage)
+		if !ok {
+			continue
+		}
+		var pl rbac.TransactionReadRolesPayload
+		if err := json.Unmarshal(raw, &pl); err != nil {
+			return nil, fmt.Errorf("unmarshal roles: %w", err)
+		}
+		if len(pl.Roles) > 0 || len(pl.Users) > 0 {
+			merged = pl
+			break
+		}
+	}
+	return &cluster.Transaction{Payload: merged}, nil
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
+
+// newer reports whether a is a later version of the same key than b. A
+// revocation is final, otherwise the latest rotation wins.
+func newer(a, b apikey.Key) bool {
+	if (a.RevokedAt != nil) != (b.RevokedAt != nil) {
+		return a.RevokedAt != nil
+	}
+	return changedAt(a).After(changedAt(b))
+}
+
+func changedAt(key apikey.Key) time.Time {
+	if key.RotatedAt != nil {
+		return *key.RotatedAt
+	}
+	return key.CreatedAt
+}
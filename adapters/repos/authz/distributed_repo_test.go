@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type fakeMembers struct {
+	names []string
+}
+
+func (m *fakeMembers) AllNames() []string { return m.names }
+
+func (m *fakeMembers) Hostnames() []string { return m.names[1:] }
+
+// fakeTxClient passes transactions to the tx manager of another node, the
+// payloads are encoded like on the wire
+type fakeTxClient struct {
+	remote *cluster.TxManager
+	err    error
+}
+
+func (c *fakeTxClient) OpenTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	if c.err != nil {
+		return c.err
+	}
+	raw, err := json.Marshal(tx.Payload)
+	if err != nil {
+		return err
+	}
+	unmarshal := rbac.UnmarshalTransaction
+	if tx.Type == apikey.TransactionPutKey || tx.Type == apikey.TransactionReadKeys {
+		unmarshal = apikey.UnmarshalTransaction
+	}
+	pl, err := unmarshal(tx.Type, raw)
+	if err != nil {
+		return err
+	}
+	data, err := c.remote.IncomingBeginTransaction(ctx,
+		&cluster.Transaction{ID: tx.ID, Type: tx.Type, Payload: pl, Deadline: tx.Deadline})
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	var res struct{ Payload json.RawMessage }
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	tx.Payload = res.Payload
+	return nil
+}
+
+func (c *fakeTxClient) AbortTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	c.remote.IncomingAbortTransaction(ctx, tx)
+	return nil
+}
+
+func (c *fakeTxClient) CommitTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	return c.remote.IncomingCommitTransaction(ctx, tx)
+}
+
+func newTestDistributedRepos(t *testing.T) (*DistributedRepo, *DistributedRepo, *fakeTxClient) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	local, err := NewRepo(ctx, t.TempDir(), logger)
+	require.Nil(t, err)
+	t.Cleanup(func() { local.Shutdown(ctx) })
+	remoteLocal, err := NewRepo(ctx, t.TempDir(), logger)
+	require.Nil(t, err)
+	t.Cleanup(func() { remoteLocal.Shutdown(ctx) })
+
+	client := &fakeTxClient{}
+	remoteClient := &fakeTxClient{}
+	repo := NewDistributedRepo(client, &fakeMembers{names: []string{"node1", "node2"}},
+		local, logger)
+	remote := NewDistributedRepo(remoteClient, &fakeMembers{names: []string{"node2", "node1"}},
+		remoteLocal, logger)
+	client.remote, remoteClient.remote = remote.TxManager(), repo.TxManager()
+	return repo, remote, client
+}
+
+func TestDistributedRepoAPIKeys(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := apikey.Key{ID: "0a1b", User: "alice", Hash: "ff00", CreatedAt: created}
+
+	t.Run("keys are stored on all nodes", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		var applied []apikey.Key
+		remote.SetAPIKeyFn(func(key apikey.Key) { applied = append(applied, key) })
+
+		require.Nil(t, repo.PutAPIKey(key))
+		revoked := key
+		revoked.RevokedAt = &created
+		require.Nil(t, repo.PutAPIKey(revoked))
+
+		assert.Equal(t, []apikey.Key{key, revoked}, applied)
+		keys, err := remote.Repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.Equal(t, []apikey.Key{revoked}, keys)
+		keys, err = repo.Repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.Equal(t, []apikey.Key{revoked}, keys)
+	})
+
+	t.Run("keys are not stored if a node fails", func(t *testing.T) {
+		repo, remote, client := newTestDistributedRepos(t)
+		client.err = errors.New("node2 is down")
+
+		assert.NotNil(t, repo.PutAPIKey(key))
+		keys, err := repo.Repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.Empty(t, keys)
+		keys, err = remote.Repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("loading merges the keys of the other nodes", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		rotatedAt := created.Add(time.Hour)
+		rotated := key
+		rotated.Hash, rotated.RotatedAt = "ff01", &rotatedAt
+		other := apikey.Key{ID: "2c3d", User: "bob", Hash: "ff02", CreatedAt: created}
+		stale := apikey.Key{ID: "4e5f", User: "carol", Hash: "ff03", CreatedAt: created}
+		revoked := stale
+		revoked.RevokedAt = &rotatedAt
+		require.Nil(t, repo.Repo.PutAPIKey(rotated))
+		require.Nil(t, repo.Repo.PutAPIKey(stale))
+		require.Nil(t, remote.Repo.PutAPIKey(key))
+		require.Nil(t, remote.Repo.PutAPIKey(other))
+		require.Nil(t, remote.Repo.PutAPIKey(revoked))
+
+		keys, err := repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []apikey.Key{rotated, other, revoked}, keys)
+		keys, err = repo.Repo.LoadAPIKeys()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []apikey.Key{rotated, other, revoked}, keys)
+	})
+}
+
+type fakeRoles struct {
+	roles map[string]rbac.Role
+	users map[string][]string
+}
+
+func (f *fakeRoles) ApplyRole(role rbac.Role) error {
+	f.roles[role.Name] = role
+	return nil
+}
+
+func (f *fakeRoles) ApplyDeleteRole(name string) { delete(f.roles, name) }
+
+func (f *fakeRoles) ApplyUserRoles(user string, roles []string) { f.users[user] = roles }
+
+func TestDistributedRepoRoles(t *testing.T) {
+	reader := rbac.Role{Name: "reader", Permissions: []rbac.Permission{
+		{Action: rbac.ActionRead, Resource: "classes/*"},
+	}}
+	writer := rbac.Role{Name: "writer", Permissions: []rbac.Permission{
+		{Action: rbac.ActionWrite, Resource: "classes/Article"},
+	}}
+
+	t.Run("roles are stored on all nodes", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		applied := &fakeRoles{roles: map[string]rbac.Role{}, users: map[string][]string{}}
+		remote.SetRoles(applied)
+
+		require.Nil(t, repo.PutRole(reader))
+		require.Nil(t, repo.PutRole(writer))
+		require.Nil(t, repo.PutUserRoles("alice", []string{"reader", "writer"}))
+		require.Nil(t, repo.DeleteRole("writer"))
+
+		assert.Equal(t, map[string]rbac.Role{"reader": reader}, applied.roles)
+		assert.Equal(t, map[string][]string{"alice": {"reader", "writer"}}, applied.users)
+		for _, r := range []*Repo{repo.Repo, remote.Repo} {
+			roles, users, err := r.Load()
+			require.Nil(t, err)
+			assert.Equal(t, []rbac.Role{reader}, roles)
+			assert.Equal(t, map[string][]string{"alice": {"reader", "writer"}}, users)
+		}
+	})
+
+	t.Run("roles are not stored if a node fails", func(t *testing.T) {
+		repo, remote, client := newTestDistributedRepos(t)
+		client.err = errors.New("node2 is down")
+
+		assert.NotNil(t, repo.PutRole(reader))
+		assert.NotNil(t, repo.PutUserRoles("alice", []string{"reader"}))
+		for _, r := range []*Repo{repo.Repo, remote.Repo} {
+			roles, users, err := r.Load()
+			require.Nil(t, err)
+			assert.Empty(t, roles)
+			assert.Empty(t, users)
+		}
+	})
+
+	t.Run("a node without roles takes over the roles of the others", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		require.Nil(t, remote.Repo.PutRole(reader))
+		require.Nil(t, remote.Repo.PutUserRoles("alice", []string{"reader"}))
+
+		roles, users, err := repo.Load()
+		require.Nil(t, err)
+		assert.Equal(t, []rbac.Role{reader}, roles)
+		assert.Equal(t, map[string][]string{"alice": {"reader"}}, users)
+		roles, users, err = repo.Repo.Load()
+		require.Nil(t, err)
+		assert.Equal(t, []rbac.Role{reader}, roles)
+		assert.Equal(t, map[string][]string{"alice": {"reader"}}, users)
+	})
+
+	t.Run("a node with roles keeps them", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		require.Nil(t, repo.Repo.PutRole(writer))
+		require.Nil(t, remote.Repo.PutRole(reader))
+
+		roles, _, err := repo.Load()
+		require.Nil(t, err)
+		assert.Equal(t, []rbac.Role{writer}, roles)
+	})
+}
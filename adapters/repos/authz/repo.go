@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+const (
+	authzDir    = "authz"
+	authzBucket = "authz"
+)
+
+var (
+	rolePrefix   = []byte("role/")
+	userPrefix   = []byte("user/")
+	apiKeyPrefix = []byte("apikey/")
+)
+
+// Repo stores roles and the roles of users in a dedicated bucket, see
+// rbac.Repo, as well as the api keys created through the api, see
+// apikey.KeyRepo. Roles are kept under "role/<name>", the roles of a user
+// under "user/<name>" and keys under "apikey/<id>", all as JSON.
+type Repo struct {
+	store  *lsmkv.Store
+	bucket *lsmkv.Bucket
+}
+
+func NewRepo(ctx context.Context, rootPath string, logger logrus.FieldLogger) (*Repo, error) {
+	store, err := lsmkv.New(path.Join(rootPath, authzDir), rootPath, logger, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init authz store: %w", err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, authzBucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("create authz bucket: %w", err)
+	}
+
+	return &Repo{store: store, bucket: store.Bucket(authzBucket)}, nil
+}
+
+func (r *Repo) Load() ([]rbac.Role, map[string][]string, error) {
+	cursor := r.bucket.Cursor()
+	defer cursor.Close()
+
+	var roles []rbac.Role
+	users := map[string][]string{}
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		switch {
+		case bytes.HasPrefix(k, rolePrefix):
+			var role rbac.Role
+			if err := json.Unmarshal(v, &role); err != nil {
+				return nil, nil, fmt.Errorf("unmarshal role %q: %w", k, err)
+			}
+			roles = append(roles, role)
+		case bytes.HasPrefix(k, userPrefix):
+			var userRoles []string
+			if err := json.Unmarshal(v, &userRoles); err != nil {
+				return nil, nil, fmt.Errorf("unmarshal roles of %q: %w", k, err)
+			}
+			users[string(k[len(userPrefix):])] = userRoles
+		}
+	}
+	return roles, users, nil
+}
+
+func (r *Repo) PutRole(role rbac.Role) error {
+	return r.put(key(rolePrefix, role.Name), role)
+}
+
+func (r *Repo) DeleteRole(name string) error {
+	return r.delete(key(rolePrefix, name))
+}
+
+func (r *Repo) PutUserRoles(user string, roles []string) error {
+	if len(roles) == 0 {
+		return r.delete(key(userPrefix, user))
+	}
+	return r.put(key(userPrefix, user), roles)
+}
+
+func (r *Repo) LoadAPIKeys() ([]apikey.Key, error) {
+	cursor := r.bucket.Cursor()
+	defer cursor.Close()
+
+	var keys []apikey.Key
+	for k, v := cursor.Seek(apiKeyPrefix); k != nil && bytes.HasPrefix(k, apiKeyPrefix); k, v = cursor.Next() {
+		var apiKey apikey.Key
+		if err := json.Unmarshal(v, &apiKey); err != nil {
+			return nil, fmt.Errorf("unmarshal api key %q: %w", k, err)
+		}
+		keys = append(keys, apiKey)
+	}
+	return keys, nil
+}
+
+func (r *Repo) PutAPIKey(apiKey apikey.Key) error {
+	return r.put(key(apiKeyPrefix, apiKey.ID), apiKey)
+}
+
+func key(prefix []byte, name string) []byte {
+	return append(append([]byte{}, prefix...), name...)
+}
+
+func (r *Repo) put(key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := r.bucket.Put(key, data); err != nil {
+		return err
+	}
+	// changes are rare, don't keep them only in the memtable and its log
+	return r.bucket.FlushAndSwitch()
+}
+
+func (r *Repo) delete(key []byte) error {
+	if err := r.bucket.Delete(key); err != nil {
+		return err
+	}
+	return r.bucket.FlushAndSwitch()
+}
+
+func (r *Repo) Shutdown(ctx context.Context) error {
+	return r.store.Shutdown(ctx)
+}
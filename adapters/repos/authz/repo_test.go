@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+func TestRepo(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	repo, err := NewRepo(ctx, dir, logger)
+	require.Nil(t, err)
+
+	reader := rbac.Role{Name: "reader", Permissions: []rbac.Permission{
+		{Action: rbac.ActionRead, Resource: "classes/*"},
+	}}
+	writer := rbac.Role{Name: "writer", Permissions: []rbac.Permission{
+		{Action: rbac.ActionWrite, Resource: "classes/Article"},
+	}}
+	require.Nil(t, repo.PutRole(reader))
+	require.Nil(t, repo.PutRole(writer))
+	require.Nil(t, repo.PutUserRoles("alice", []string{"reader", "writer"}))
+	require.Nil(t, repo.PutUserRoles("bob", []string{"reader"}))
+	require.Nil(t, repo.DeleteRole("writer"))
+	require.Nil(t, repo.PutUserRoles("alice", []string{"reader"}))
+	require.Nil(t, repo.PutUserRoles("bob", nil))
+	apiKey := apikey.Key{ID: "0a1b", User: "alice", Hash: "ff00",
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	require.Nil(t, repo.PutAPIKey(apiKey))
+	require.Nil(t, repo.Shutdown(ctx))
+
+	repo, err = NewRepo(ctx, dir, logger)
+	require.Nil(t, err)
+	defer repo.Shutdown(ctx)
+
+	roles, users, err := repo.Load()
+	require.Nil(t, err)
+	assert.Equal(t, []rbac.Role{reader}, roles)
+	assert.Equal(t, map[string][]string{"alice": {"reader"}}, users)
+
+	keys, err := repo.LoadAPIKeys()
+	require.Nil(t, err)
+	assert.Equal(t, []apikey.Key{apiKey}, keys)
+}
@@ -0,0 +1,328 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	changesBucket = []byte("changes")
+	resyncsBucket = []byte("resyncs")
+	parkedBucket  = []byte("parked")
+)
+
+// With encryption at rest the object IDs and the reasons changes were parked
+// for are sealed with the key of their tenant. Changes stored before it was
+// enabled keep their plain ID.
+type storedChange struct {
+	Class    string      `json:"class"`
+	Tenant   string      `json:"tenant,omitempty"`
+	ID       strfmt.UUID `json:"id,omitempty"`
+	SealedID []byte      `json:"sealedId,omitempty"`
+	Since    time.Time   `json:"since"`
+}
+
+type parkedChange struct {
+	storedChange
+	ParkedAt     time.Time `json:"parkedAt"`
+	Reason       string    `json:"reason,omitempty"`
+	SealedReason []byte    `json:"sealedReason,omitempty"`
+}
+
+type storedResync struct {
+	crossrepl.Resync
+	SealedAfter []byte `json:"sealedAfter,omitempty"`
+}
+
+// Keys returns the encryption of a tenant, or of the class if tenant is
+// empty. It returns nil if encryption at rest is disabled.
+type Keys func(ctx context.Context, class, tenant string) (*diskio.Encryption, error)
+
+// Repo persists the cross-cluster replication change log of this node in a
+// bolt db in the data path
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	keys    Keys // nil if encryption at rest is disabled
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, keys Keys, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		keys:    keys,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/cross_cluster_replication.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{changesBucket, resyncsBucket, parkedBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return errors.Wrapf(err, "create bucket '%s'", string(b))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+func (r *Repo) Changes() ([]crossrepl.StoredChange, error) {
+	var out []crossrepl.StoredChange
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(changesBucket).ForEach(func(k, v []byte) error {
+			var c storedChange
+			if err := json.Unmarshal(v, &c); err != nil {
+				return errors.Wrapf(err, "parse change %d from JSON", binary.BigEndian.Uint64(k))
+			}
+			if c.SealedID != nil {
+				id, err := r.unseal(c.Class, c.Tenant, c.SealedID)
+				if err != nil {
+					// e.g. the key of a deleted tenant, the change is kept for
+					// the whole tenant
+					r.logger.WithField("action", "cross_cluster_replication_load").
+						WithField("class", c.Class).WithField("tenant", c.Tenant).
+						WithError(err).Warn("could not decrypt object id of change")
+				}
+				c.ID = strfmt.UUID(id)
+			}
+			out = append(out, crossrepl.StoredChange{
+				Change: crossrepl.Change{Class: c.Class, Tenant: c.Tenant, ID: c.ID},
+				Seq:    binary.BigEndian.Uint64(k),
+				Since:  c.Since,
+			})
+			return nil
+		})
+	})
+	return out, err
+}
+
+// PutChange is called for every object write on the primary. Concurrent
+// calls are committed together, so that the writes don't queue up behind
+// one sync of the file each.
+func (r *Repo) PutChange(c crossrepl.StoredChange) error {
+	stored, err := r.fromChange(c)
+	if err != nil {
+		return err
+	}
+	changeJSON, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrap(err, "marshal change to JSON")
+	}
+
+	return r.db.Batch(func(tx *bolt.Tx) error {
+		return tx.Bucket(changesBucket).Put(seqKey(c.Seq), changeJSON)
+	})
+}
+
+func (r *Repo) DeleteChanges(seqs []uint64) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(changesBucket)
+		for _, seq := range seqs {
+			if err := b.Delete(seqKey(seq)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repo) Resyncs() ([]crossrepl.Resync, error) {
+	var out []crossrepl.Resync
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(resyncsBucket).ForEach(func(k, v []byte) error {
+			var resync storedResync
+			if err := json.Unmarshal(v, &resync); err != nil {
+				return errors.Wrapf(err, "parse resync %q from JSON", string(k))
+			}
+			if resync.SealedAfter != nil {
+				after, err := r.unseal(resync.Class, resync.Tenant, resync.SealedAfter)
+				if err != nil {
+					// the pass is started over
+					r.logger.WithField("action", "cross_cluster_replication_load").
+						WithField("class", resync.Class).WithField("tenant", resync.Tenant).
+						WithError(err).Warn("could not decrypt progress of resync")
+				}
+				resync.After = strfmt.UUID(after)
+			}
+			out = append(out, resync.Resync)
+			return nil
+		})
+	})
+	return out, err
+}
+
+func (r *Repo) PutResync(resync crossrepl.Resync) error {
+	stored := storedResync{Resync: resync}
+	if resync.After != "" {
+		sealed, err := r.seal(resync.Class, resync.Tenant, []byte(resync.After))
+		if err != nil {
+			return err
+		}
+		if sealed != nil {
+			stored.After, stored.SealedAfter = "", sealed
+		}
+	}
+	resyncJSON, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrap(err, "marshal resync to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(resyncsBucket).Put(scopeKey(resync.Scope), resyncJSON)
+	})
+}
+
+func (r *Repo) DeleteResync(scope crossrepl.Scope) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(resyncsBucket).Delete(scopeKey(scope))
+	})
+}
+
+func (r *Repo) ParkChange(c crossrepl.StoredChange, reason string) error {
+	stored, err := r.fromChange(c)
+	if err != nil {
+		return err
+	}
+	parked := parkedChange{storedChange: stored, ParkedAt: time.Now(), Reason: reason}
+	sealed, err := r.seal(c.Class, c.Tenant, []byte(reason))
+	if err != nil {
+		return err
+	}
+	if sealed != nil {
+		parked.Reason, parked.SealedReason = "", sealed
+	}
+	parkedJSON, err := json.Marshal(parked)
+	if err != nil {
+		return errors.Wrap(err, "marshal parked change to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		parked := tx.Bucket(parkedBucket)
+		seq, err := parked.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := parked.Put(seqKey(seq), parkedJSON); err != nil {
+			return err
+		}
+		if c.Seq == 0 {
+			return nil
+		}
+		return tx.Bucket(changesBucket).Delete(seqKey(c.Seq))
+	})
+}
+
+func (r *Repo) ParkedChanges() (int, error) {
+	var n int
+	err := r.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(parkedBucket).Stats().KeyN
+		return nil
+	})
+	return n, err
+}
+
+func (r *Repo) Close() error {
+	return r.db.Close()
+}
+
+func (r *Repo) fromChange(c crossrepl.StoredChange) (storedChange, error) {
+	stored := storedChange{Class: c.Class, Tenant: c.Tenant, ID: c.ID, Since: c.Since}
+	if c.ID == "" {
+		return stored, nil
+	}
+	sealed, err := r.seal(c.Class, c.Tenant, []byte(c.ID))
+	if err != nil {
+		return stored, err
+	}
+	if sealed != nil {
+		stored.ID, stored.SealedID = "", sealed
+	}
+	return stored, nil
+}
+
+// seal encrypts value with the key of the tenant, it returns nil if
+// encryption at rest is disabled
+func (r *Repo) seal(class, tenant string, value []byte) ([]byte, error) {
+	if r.keys == nil {
+		return nil, nil
+	}
+	enc, err := r.keys(context.Background(), class, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "get encryption key")
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	return enc.Seal(value), nil
+}
+
+func (r *Repo) unseal(class, tenant string, sealed []byte) ([]byte, error) {
+	if r.keys == nil {
+		return nil, fmt.Errorf("value is encrypted, but encryption at rest is disabled")
+	}
+	enc, err := r.keys(context.Background(), class, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "get encryption key")
+	}
+	if enc == nil {
+		return nil, fmt.Errorf("value is encrypted, but encryption at rest is disabled")
+	}
+	return enc.Unseal(sealed)
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func scopeKey(s crossrepl.Scope) []byte {
+	return []byte(s.Class + "\x00" + s.Tenant)
+}
+
+var _ = crossrepl.ChangeStore(&Repo{})
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package crossrepl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/crossrepl"
+)
+
+func Test_CrossReplRepo(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, nil, logger)
+	require.Nil(t, err)
+
+	since := time.Now().UTC().Truncate(time.Second)
+	a := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01"},
+		Seq:    1, Since: since,
+	}
+	b := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", Tenant: "t1", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"},
+		Seq:    2, Since: since,
+	}
+	c := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03"},
+		Seq:    256, Since: since,
+	}
+	resync := crossrepl.Resync{
+		Scope: crossrepl.Scope{Class: "Article", Tenant: "t1"},
+		After: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02",
+	}
+
+	t.Run("storing changes", func(t *testing.T) {
+		require.Nil(t, r.PutChange(c))
+		require.Nil(t, r.PutChange(a))
+		require.Nil(t, r.PutChange(b))
+		require.Nil(t, r.PutResync(resync))
+		require.Nil(t, r.DeleteChanges([]uint64{2}))
+		require.Nil(t, r.ParkChange(c, "rejected"))
+	})
+
+	t.Run("changes survive a restart", func(t *testing.T) {
+		require.Nil(t, r.Close())
+		r, err = NewRepo(dirName, nil, logger)
+		require.Nil(t, err)
+
+		changes, err := r.Changes()
+		require.Nil(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, a.Change, changes[0].Change)
+		assert.Equal(t, a.Seq, changes[0].Seq)
+		assert.True(t, a.Since.Equal(changes[0].Since))
+
+		resyncs, err := r.Resyncs()
+		require.Nil(t, err)
+		assert.Equal(t, []crossrepl.Resync{resync}, resyncs)
+
+		parked, err := r.ParkedChanges()
+		require.Nil(t, err)
+		assert.Equal(t, 1, parked)
+	})
+
+	t.Run("finished resyncs are removed", func(t *testing.T) {
+		require.Nil(t, r.DeleteResync(resync.Scope))
+
+		resyncs, err := r.Resyncs()
+		require.Nil(t, err)
+		assert.Empty(t, resyncs)
+	})
+}
+
+func Test_CrossReplRepo_Encrypted(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	enc, err := diskio.NewEncryption(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	deleted := map[string]bool{}
+	keys := func(ctx context.Context, class, tenant string) (*diskio.Encryption, error) {
+		if deleted[tenant] {
+			return nil, fmt.Errorf("tenant %q not found", tenant)
+		}
+		return enc, nil
+	}
+
+	since := time.Now().UTC().Truncate(time.Second)
+	legacy := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", Tenant: "t1", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01"},
+		Seq:    1, Since: since,
+	}
+	a := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", Tenant: "t1", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"},
+		Seq:    2, Since: since,
+	}
+	b := crossrepl.StoredChange{
+		Change: crossrepl.Change{Class: "Article", Tenant: "t2", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03"},
+		Seq:    3, Since: since,
+	}
+	resync := crossrepl.Resync{
+		Scope: crossrepl.Scope{Class: "Article", Tenant: "t1"},
+		After: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d04",
+	}
+
+	// a change stored before encryption at rest was enabled
+	r, err := NewRepo(dirName, nil, logger)
+	require.Nil(t, err)
+	require.Nil(t, r.PutChange(legacy))
+	require.Nil(t, r.Close())
+
+	r, err = NewRepo(dirName, keys, logger)
+	require.Nil(t, err)
+	defer r.Close()
+	require.Nil(t, r.PutChange(a))
+	require.Nil(t, r.PutChange(b))
+	require.Nil(t, r.PutResync(resync))
+	parked := a
+	parked.Seq = 0
+	require.Nil(t, r.ParkChange(parked, "rejected 8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"))
+
+	t.Run("object ids are not stored in plain text", func(t *testing.T) {
+		data, err := os.ReadFile(r.DBPath())
+		require.Nil(t, err)
+		for _, id := range []strfmt.UUID{a.ID, b.ID, resync.After} {
+			assert.False(t, bytes.Contains(data, []byte(id)), id)
+		}
+	})
+
+	t.Run("plain and encrypted changes are read", func(t *testing.T) {
+		changes, err := r.Changes()
+		require.Nil(t, err)
+		require.Len(t, changes, 3)
+		assert.Equal(t, legacy.Change, changes[0].Change)
+		assert.Equal(t, a.Change, changes[1].Change)
+		assert.Equal(t, b.Change, changes[2].Change)
+
+		resyncs, err := r.Resyncs()
+		require.Nil(t, err)
+		assert.Equal(t, []crossrepl.Resync{resync}, resyncs)
+	})
+
+	t.Run("changes of deleted tenants are kept for the whole tenant", func(t *testing.T) {
+		deleted["t2"] = true
+		changes, err := r.Changes()
+		require.Nil(t, err)
+		require.Len(t, changes, 3)
+		assert.Equal(t, crossrepl.Change{Class: "Article", Tenant: "t2"}, changes[2].Change)
+	})
+}
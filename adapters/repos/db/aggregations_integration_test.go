@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func Test_Aggregations(t *testing.T) {
@@ -2258,3 +2259,71 @@ func mustStringToTime(s string) time.Time {
 	}
 	return asTime
 }
+
+// A distance cutoff on a vector search must be applied as is, certainties are
+// only meaningful for cosine distances
+func Test_Aggregations_VectorDistanceCutoff(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "AggregateByDistanceClass",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{Name: "position", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	for i := 0; i < 10; i++ {
+		obj := &models.Object{
+			ID:         strfmt.UUID(uuid.New().String()),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"position": int64(i)},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{float32(i), 0, 0}, nil))
+	}
+
+	// squared distances of the positions 0-4 are within the cutoff
+	for _, objectLimit := range []*int{nil, ptInt(8)} {
+		t.Run(fmt.Sprintf("object limit %v", objectLimit != nil), func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName:        schema.ClassName(class.Class),
+				SearchVector:     []float32{0, 0, 0},
+				Distance:         16.5,
+				WithDistance:     true,
+				ObjectLimit:      objectLimit,
+				IncludeMetaCount: true,
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        "position",
+						Aggregators: []aggregation.Aggregator{aggregation.SumAggregator},
+					},
+				},
+			})
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 5, res.Groups[0].Count)
+			assert.InDelta(t, 10, res.Groups[0].Properties["position"].NumericalAggregations["sum"], 0.001)
+		})
+	}
+}
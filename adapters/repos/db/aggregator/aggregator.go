@@ -27,9 +27,10 @@ import (
 )
 
 type vectorIndex interface {
-	SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+	SearchByVectorDistance(ctx context.Context, vector []float32, targetDistance float32, maxLimit int64,
+		allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVector(ctx context.Context, vector []float32, k int,
 		allowList helpers.AllowList) ([]uint64, []float32, error)
-	SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
 }
 
 type Aggregator struct {
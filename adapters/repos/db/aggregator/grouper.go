@@ -58,6 +58,9 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 	err := ScanAllLSM(g.store, func(prop *models.PropertySchema, docID uint64) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
 		return true, g.addElementById(prop, docID)
 	})
 	if err != nil {
@@ -90,7 +93,7 @@ func (g *grouper) fetchDocIDs(ctx context.Context) (ids []uint64, err error) {
 	}
 
 	if len(g.params.SearchVector) > 0 {
-		ids, _, err = g.vectorSearch(allowList, g.params.SearchVector)
+		ids, _, err = g.vectorSearch(ctx, allowList, g.params.SearchVector)
 		if err != nil {
 			return nil, fmt.Errorf("failed to perform vector search: %w", err)
 		}
@@ -127,7 +130,7 @@ func (g *grouper) hybrid(ctx context.Context, allowList helpers.AllowList) ([]ui
 	}
 
 	denseSearch := func(vec []float32) ([]*storobj.Object, []float32, error) {
-		res, dists, err := g.objectVectorSearch(vec, allowList)
+		res, dists, err := g.objectVectorSearch(ctx, vec, allowList)
 		if err != nil {
 			return nil, nil, fmt.Errorf("aggregate grouped dense search: %w", err)
 		}
@@ -163,6 +163,32 @@ func (a *numericalAggregator) AddNumberRow(number float64, count uint64) error {
 	return nil
 }
 
+// AddStats adds pre-aggregated values. Their distribution is unknown, so mode
+// and median can't be calculated from them.
+func (a *numericalAggregator) AddStats(count uint64, sum, min, max float64) {
+	if count == 0 {
+		// skip
+		return
+	}
+
+	a.count += count
+	a.sum += sum
+	if min < a.min {
+		a.min = min
+	}
+	if max > a.max {
+		a.max = max
+	}
+}
+
+// Merge adds all values of the other aggregator, e.g. from another shard
+func (a *numericalAggregator) Merge(other *numericalAggregator) {
+	a.AddStats(other.count, other.sum, other.min, other.max)
+	for value, count := range other.valueCounter {
+		a.valueCounter[value] += count
+	}
+}
+
 func (a *numericalAggregator) Mean() float64 {
 	if a.count == 0 {
 		return 0
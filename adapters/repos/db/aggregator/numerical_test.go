@@ -202,3 +202,38 @@ func TestNumericalAggregator_MinMaxCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericalAggregator_Stats(t *testing.T) {
+	t.Run("stats match the individual values", func(t *testing.T) {
+		fromValues := newNumericalAggregator()
+		for _, num := range []float64{4, -2, 7, 7} {
+			fromValues.AddFloat64(num)
+		}
+
+		fromStats := newNumericalAggregator()
+		fromStats.AddStats(4, 16, -2, 7)
+		fromStats.AddStats(0, 0, 0, 0)
+
+		assert.Equal(t, fromValues.Count(), fromStats.Count())
+		assert.Equal(t, fromValues.Sum(), fromStats.Sum())
+		assert.Equal(t, fromValues.Mean(), fromStats.Mean())
+		assert.Equal(t, fromValues.Min(), fromStats.Min())
+		assert.Equal(t, fromValues.Max(), fromStats.Max())
+	})
+
+	t.Run("merge stats into values", func(t *testing.T) {
+		agg := newNumericalAggregator()
+		agg.AddFloat64(1)
+		agg.AddFloat64(3)
+
+		other := newNumericalAggregator()
+		other.AddStats(2, 20, 8, 12)
+		agg.Merge(other)
+
+		assert.Equal(t, float64(4), agg.Count())
+		assert.Equal(t, float64(24), agg.Sum())
+		assert.Equal(t, float64(6), agg.Mean())
+		assert.Equal(t, float64(1), agg.Min())
+		assert.Equal(t, float64(12), agg.Max())
+	})
+}
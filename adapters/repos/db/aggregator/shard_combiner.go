@@ -212,11 +212,7 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			numAggSecondTyped := second[propType].(*numericalAggregator)
 			if numAggFirst, ok := first[propType]; ok {
 				numAggFirstTyped := numAggFirst.(*numericalAggregator)
-				for _, pair := range numAggSecondTyped.pairs {
-					for i := uint64(0); i < pair.count; i++ {
-						numAggFirstTyped.AddFloat64(pair.value)
-					}
-				}
+				numAggFirstTyped.Merge(numAggSecondTyped)
 				numAggFirstTyped.buildPairsFromCounts()
 				first[propType] = numAggFirstTyped
 			} else {
@@ -56,7 +56,7 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 
 	// bool never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c := b.CursorRoaringSet().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -65,8 +65,11 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	} else {
-		c := b.SetCursor() // bool never has a frequency, so it's always a Set
+		c := b.SetCursor().WithContext(ctx) // bool never has a frequency, so it's always a Set
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -75,6 +78,9 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	}
 
 	out.BooleanAggregation = agg.Res()
@@ -153,11 +159,18 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
 	}
 
+	if agg, ok, err := ua.numericalAggregatorFromStats(b, prop.Aggregators); err != nil {
+		return nil, err
+	} else if ok {
+		addNumericalAggregations(&out, prop.Aggregators, agg)
+		return &out, nil
+	}
+
 	agg := newNumericalAggregator()
 
 	// flat never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c := b.CursorRoaringSet().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -165,8 +178,11 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	} else {
-		c := b.SetCursor()
+		c := b.SetCursor().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -174,6 +190,9 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	}
 
 	addNumericalAggregations(&out, prop.Aggregators, agg)
@@ -194,11 +213,18 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
 	}
 
+	if agg, ok, err := ua.numericalAggregatorFromStats(b, prop.Aggregators); err != nil {
+		return nil, err
+	} else if ok {
+		addNumericalAggregations(&out, prop.Aggregators, agg)
+		return &out, nil
+	}
+
 	agg := newNumericalAggregator()
 
 	// int never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c := b.CursorRoaringSet().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -206,9 +232,12 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	} else {
 
-		c := b.SetCursor()
+		c := b.SetCursor().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -216,6 +245,9 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	}
 
 	addNumericalAggregations(&out, prop.Aggregators, agg)
@@ -223,6 +255,34 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 	return &out, nil
 }
 
+// numericalAggregatorFromStats serves the aggregation from the pre-aggregated
+// stats of the bucket's segments, which is much cheaper than iterating the
+// bucket. This is only possible if no aggregator requires the distribution of
+// the values, ok is false in this case or if no exact stats are available.
+func (ua unfilteredAggregator) numericalAggregatorFromStats(b *lsmkv.Bucket,
+	aggs []aggregation.Aggregator,
+) (*numericalAggregator, bool, error) {
+	if b.Strategy() != lsmkv.StrategyRoaringSet {
+		return nil, false, nil
+	}
+
+	for _, a := range aggs {
+		switch a {
+		case aggregation.ModeAggregator, aggregation.MedianAggregator:
+			return nil, false, nil
+		}
+	}
+
+	stats, ok, err := b.NumericStats()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	agg := newNumericalAggregator()
+	agg.AddStats(stats.Count, stats.Sum, stats.Min, stats.Max)
+	return agg, true, nil
+}
+
 func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 	prop aggregation.ParamProperty,
 ) (*aggregation.Property, error) {
@@ -240,7 +300,7 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 
 	// dates don't have frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c := b.CursorRoaringSet().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -248,8 +308,11 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	} else {
-		c := b.SetCursor()
+		c := b.SetCursor().WithContext(ctx)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -257,6 +320,9 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 				return nil, err
 			}
 		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
 	}
 
 	addDateAggregations(&out, prop.Aggregators, agg)
@@ -311,7 +377,7 @@ func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
 
 	agg := newDateAggregator()
 
-	c := b.Cursor()
+	c := b.Cursor().WithContext(ctx)
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -319,6 +385,9 @@ func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
 			return nil, err
 		}
 	}
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
 
 	addDateAggregations(&out, prop.Aggregators, agg)
 
@@ -455,7 +524,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 
 	// we're looking at the whole object, so this is neither a Set, nor a Map, but
 	// a Replace strategy
-	c := b.Cursor()
+	c := b.Cursor().WithContext(ctx)
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -463,6 +532,9 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 			return nil, err
 		}
 	}
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
 
 	out.TextAggregation = agg.Res()
 
@@ -484,7 +556,7 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 
 	agg := newNumericalAggregator()
 
-	c := b.Cursor()
+	c := b.Cursor().WithContext(ctx)
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -492,6 +564,9 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 			return nil, err
 		}
 	}
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
 
 	addNumericalAggregations(&out, prop.Aggregators, agg)
 
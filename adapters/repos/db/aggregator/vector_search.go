@@ -21,23 +21,23 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-func (a *Aggregator) vectorSearch(allow helpers.AllowList, vec []float32) ([]uint64, []float32, error) {
+func (a *Aggregator) vectorSearch(ctx context.Context, allow helpers.AllowList, vec []float32) ([]uint64, []float32, error) {
 	if a.params.ObjectLimit != nil {
-		return a.searchByVector(vec, a.params.ObjectLimit, allow)
+		return a.searchByVector(ctx, vec, a.params.ObjectLimit, allow)
 	}
 
-	return a.searchByVectorDistance(vec, allow)
+	return a.searchByVectorDistance(ctx, vec, allow)
 }
 
-func (a *Aggregator) searchByVector(searchVector []float32, limit *int, ids helpers.AllowList) ([]uint64, []float32, error) {
-	idsFound, dists, err := a.vectorIndex.SearchByVector(searchVector, *limit, ids)
+func (a *Aggregator) searchByVector(ctx context.Context, searchVector []float32, limit *int,
+	ids helpers.AllowList,
+) ([]uint64, []float32, error) {
+	idsFound, dists, err := a.vectorIndex.SearchByVector(ctx, searchVector, *limit, ids)
 	if err != nil {
 		return idsFound, nil, err
 	}
 
-	if a.params.Certainty > 0 {
-		targetDist := float32(1-a.params.Certainty) * 2
-
+	if targetDist, ok := a.targetDistance(); ok {
 		i := 0
 		for _, dist := range dists {
 			if dist > targetDist {
@@ -46,19 +46,19 @@ func (a *Aggregator) searchByVector(searchVector []float32, limit *int, ids help
 			i++
 		}
 
-		return idsFound[:i], dists, nil
+		return idsFound[:i], dists[:i], nil
 
 	}
 	return idsFound, dists, nil
 }
 
-func (a *Aggregator) searchByVectorDistance(searchVector []float32, ids helpers.AllowList) ([]uint64, []float32, error) {
-	if a.params.Certainty <= 0 {
-		return nil, nil, fmt.Errorf("must provide certainty or objectLimit with vector search")
+func (a *Aggregator) searchByVectorDistance(ctx context.Context, searchVector []float32, ids helpers.AllowList) ([]uint64, []float32, error) {
+	targetDist, ok := a.targetDistance()
+	if !ok {
+		return nil, nil, fmt.Errorf("must provide certainty, distance or objectLimit with vector search")
 	}
 
-	targetDist := float32(1-a.params.Certainty) * 2
-	idsFound, dists, err := a.vectorIndex.SearchByVectorDistance(searchVector, targetDist, -1, ids)
+	idsFound, dists, err := a.vectorIndex.SearchByVectorDistance(ctx, searchVector, targetDist, -1, ids)
 	if err != nil {
 		return nil, nil, fmt.Errorf("aggregate search by vector: %w", err)
 	}
@@ -66,10 +66,23 @@ func (a *Aggregator) searchByVectorDistance(searchVector []float32, ids helpers.
 	return idsFound, dists, nil
 }
 
-func (a *Aggregator) objectVectorSearch(searchVector []float32,
+// targetDistance is the maximum distance of objects to aggregate over. An
+// explicit distance takes precedence over a certainty, which is only
+// meaningful for cosine distances.
+func (a *Aggregator) targetDistance() (float32, bool) {
+	if a.params.WithDistance {
+		return float32(a.params.Distance), true
+	}
+	if a.params.Certainty > 0 {
+		return float32(1-a.params.Certainty) * 2, true
+	}
+	return 0, false
+}
+
+func (a *Aggregator) objectVectorSearch(ctx context.Context, searchVector []float32,
 	allowList helpers.AllowList,
 ) ([]*storobj.Object, []float32, error) {
-	ids, dists, err := a.vectorSearch(allowList, searchVector)
+	ids, dists, err := a.vectorSearch(ctx, allowList, searchVector)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,7 +98,11 @@ func (db *DB) ShardsBackup(
 	}()
 	sm := make(map[string]*Shard, len(shards))
 	for _, shardName := range shards {
-		shard := idx.shards.Load(shardName)
+		shard, release, err := idx.getShard(ctx, shardName)
+		if err != nil {
+			return cd, fmt.Errorf("class %q: shard %q: %w", class, shardName, err)
+		}
+		release()
 		if shard == nil {
 			return cd, fmt.Errorf("no shard %q for class %q", shardName, class)
 		}
@@ -192,6 +196,11 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 		}
 	}()
 
+	// offloaded tenants are not offloaded again until the backup is released
+	if err = i.activateAllTenants(ctx); err != nil {
+		return fmt.Errorf("activate offloaded tenants: %w", err)
+	}
+
 	if err = i.ForEachShard(func(name string, s *Shard) error {
 		if err = s.beginBackup(ctx); err != nil {
 			return fmt.Errorf("pause compaction and flush: %w", err)
@@ -30,6 +30,10 @@ type batchQueue struct {
 func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchObjects, error) {
+	if err := db.admitBatch(); err != nil {
+		return nil, err
+	}
+
 	objectByClass := make(map[string]batchQueue)
 	indexByClass := make(map[string]*Index)
 
@@ -88,7 +92,9 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 		for i, err := range errs {
 			if err != nil {
 				objs[queue.originalIndex[i]].Err = err
+				continue
 			}
+			db.recordChange(class, queue.objects[i].Object.Tenant, queue.objects[i].ID())
 		}
 	}
 
@@ -145,7 +151,9 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 		for i, err := range errs {
 			if err != nil {
 				references[queue[i].OriginalIndex].Err = err
+				continue
 			}
+			db.recordChange(class.String(), queue[i].Tenant, queue[i].From.TargetID)
 		}
 	}
 
@@ -168,27 +176,21 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find objects")
 	}
 	// prepare to be deleted list of DocIDs from all shards
-	toDelete := map[string][]uint64{}
-	limit := db.config.QueryMaximumResults
-
-	matches := int64(0)
-	for shardName, docIDs := range shardDocIDs {
-		docIDsLength := int64(len(docIDs))
-		if matches <= limit {
-			if matches+docIDsLength <= limit {
-				toDelete[shardName] = docIDs
-			} else {
-				toDelete[shardName] = docIDs[:limit-matches]
-			}
-		}
-		matches += docIDsLength
-	}
+	toDelete, matches := limitDocIDs(shardDocIDs, db.config.QueryMaximumResults)
 	// delete the DocIDs in given shards
 	deletedObjects, err := idx.batchDeleteObjects(ctx, toDelete, params.DryRun, repl)
 	if err != nil {
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot delete objects")
 	}
 
+	if !params.DryRun {
+		for _, obj := range deletedObjects {
+			if obj.Err == nil {
+				db.recordChange(className.String(), tenant, obj.UUID)
+			}
+		}
+	}
+
 	result := objects.BatchDeleteResult{
 		Matches: matches,
 		Limit:   db.config.QueryMaximumResults,
@@ -197,3 +199,60 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	}
 	return result, nil
 }
+
+func (db *DB) BatchUpdateObjects(ctx context.Context, params objects.BatchUpdateParams,
+	repl *additional.ReplicationProperties, tenant string,
+) (objects.BatchUpdateResult, error) {
+	className := params.ClassName
+	idx := db.GetIndex(className)
+	if idx == nil {
+		return objects.BatchUpdateResult{}, errors.Errorf("cannot find index for class %v", className)
+	}
+
+	shardDocIDs, err := idx.findDocIDs(ctx, params.Filters, tenant)
+	if err != nil {
+		return objects.BatchUpdateResult{}, errors.Wrapf(err, "cannot find objects")
+	}
+	toUpdate, matches := limitDocIDs(shardDocIDs, db.config.QueryMaximumResults)
+
+	updatedObjects, err := idx.batchUpdateObjects(ctx, toUpdate, params.Update,
+		params.DryRun, repl)
+	if err != nil {
+		return objects.BatchUpdateResult{}, errors.Wrapf(err, "cannot update objects")
+	}
+
+	if !params.DryRun {
+		for _, obj := range updatedObjects {
+			if obj.Err == nil {
+				db.recordChange(className.String(), tenant, obj.UUID)
+			}
+		}
+	}
+
+	result := objects.BatchUpdateResult{
+		Matches: matches,
+		Limit:   db.config.QueryMaximumResults,
+		DryRun:  params.DryRun,
+		Objects: updatedObjects,
+	}
+	return result, nil
+}
+
+// limitDocIDs caps the doc ids found across all shards at limit, it returns
+// the capped doc ids and the number of matches before capping
+func limitDocIDs(shardDocIDs map[string][]uint64, limit int64) (map[string][]uint64, int64) {
+	limited := map[string][]uint64{}
+	matches := int64(0)
+	for shardName, docIDs := range shardDocIDs {
+		docIDsLength := int64(len(docIDs))
+		if matches <= limit {
+			if matches+docIDsLength <= limit {
+				limited[shardName] = docIDs
+			} else {
+				limited[shardName] = docIDs[:limit-matches]
+			}
+		}
+		matches += docIDsLength
+	}
+	return limited, matches
+}
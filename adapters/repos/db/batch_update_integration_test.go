@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestBatchUpdateObjects(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the thing class", testAddBatchObjectClass(repo, migrator, schemaGetter))
+
+	t.Run("batch import things", testBatchImportObjects(repo))
+
+	allThings := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLike,
+			Value:    &filters.Value{Value: "*", Type: schema.DataTypeText},
+			On:       &filters.Path{Property: schema.PropertyName("id")},
+		},
+	}
+	withStringProp := func(value string) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+				On:       &filters.Path{Class: "ThingForBatching", Property: "stringProp"},
+			},
+		}
+	}
+	count := func(t *testing.T, filter *filters.LocalFilter) int {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  "ThingForBatching",
+			Pagination: &filters.Pagination{Limit: 10000},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+	params := func(dryRun bool) objects.BatchUpdateParams {
+		return objects.BatchUpdateParams{
+			ClassName: "ThingForBatching",
+			Filters:   withStringProp("element"),
+			Update: objects.MergeDocument{
+				Class:              "ThingForBatching",
+				PrimitiveSchema:    map[string]interface{}{"stringProp": "updated"},
+				PropertiesToDelete: []string{"location"},
+				UpdateTime:         1700000000000,
+			},
+			DryRun: dryRun,
+			Output: objects.OutputVerbose,
+		}
+	}
+
+	total := count(t, allThings)
+	matched := count(t, withStringProp("element"))
+	require.True(t, matched > 0)
+
+	t.Run("with dryRun nothing is changed", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params(true), nil, "")
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, int64(matched), res.Matches)
+		require.Len(t, res.Objects, matched)
+		for _, obj := range res.Objects {
+			assert.Nil(t, obj.Err)
+			assert.NotEmpty(t, obj.UUID)
+		}
+		assert.Equal(t, 0, count(t, withStringProp("updated")))
+	})
+
+	t.Run("matched objects are updated", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params(false), nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(matched), res.Matches)
+		require.Len(t, res.Objects, matched)
+		for _, obj := range res.Objects {
+			require.Nil(t, obj.Err)
+
+			updated, err := repo.ObjectByID(context.Background(), obj.UUID, nil,
+				additional.Properties{Vector: true}, "")
+			require.Nil(t, err)
+			require.NotNil(t, updated)
+			props := updated.Schema.(map[string]interface{})
+			assert.Equal(t, "updated", props["stringProp"])
+			assert.NotContains(t, props, "location")
+			assert.Equal(t, int64(1700000000000), updated.Updated)
+			assert.NotEmpty(t, updated.Vector, "vector is kept")
+		}
+
+		assert.Equal(t, matched, count(t, withStringProp("updated")))
+		assert.Equal(t, 0, count(t, withStringProp("element")))
+		assert.Equal(t, total, count(t, allThings))
+	})
+
+	t.Run("nothing matches anymore", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params(false), nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), res.Matches)
+		assert.Empty(t, res.Objects)
+	})
+}
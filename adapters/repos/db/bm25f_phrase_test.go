@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func setupPhraseClass(t *testing.T, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger) {
+	vTrue := true
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               "PhraseClass",
+		Properties: []*models.Property{
+			{
+				Name:           "title",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "tags",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "description",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "the quick brown fox jumps", "tags": []string{"quick", "fox"}},
+		{"title": "the brown fox is quick", "tags": []string{"quick fox"}},
+		{"title": "a quick and very brown fox"},
+		{"title": "foxes are not quick", "description": "quick brown fox"},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+}
+
+func TestBM25FPhraseQueries(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	setupPhraseClass(t, repo, schemaGetter, logger)
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, props ...string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: props, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 100, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		props    []string
+		expected []uint64
+	}{
+		{
+			name:     "exact phrase",
+			query:    `"quick brown fox"`,
+			props:    []string{"title"},
+			expected: []uint64{0},
+		},
+		{
+			name:     "phrase including stopwords",
+			query:    `"the brown fox"`,
+			props:    []string{"title"},
+			expected: []uint64{1},
+		},
+		{
+			name:     "phrase with slop",
+			query:    `"quick brown fox"~3`,
+			props:    []string{"title"},
+			expected: []uint64{0, 2},
+		},
+		{
+			name:     "proximity in any order",
+			query:    "fox NEAR/2 quick",
+			props:    []string{"title"},
+			expected: []uint64{0, 1},
+		},
+		{
+			name:     "phrase does not span array values",
+			query:    `"quick fox"`,
+			props:    []string{"tags"},
+			expected: []uint64{1},
+		},
+		{
+			name:     "phrase in any of the properties",
+			query:    `"quick fox"`,
+			props:    []string{"title", "tags"},
+			expected: []uint64{1},
+		},
+		{
+			name:     "phrase combined with terms",
+			query:    `"brown fox" jumps`,
+			props:    []string{"title"},
+			expected: []uint64{0, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, search(t, test.query, test.props...))
+		})
+	}
+
+	t.Run("phrase on property without positions", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"description"}, Query: `"quick brown fox"`,
+		}
+		_, _, err := idx.objectSearch(context.TODO(), 100, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		var missing inverted.MissingIndexError
+		assert.ErrorAs(t, err, &missing)
+	})
+}
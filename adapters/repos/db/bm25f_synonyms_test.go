@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FSynonyms(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               "SynonymClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "code",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "a television with remote", "code": "TV-1"},
+		{"title": "cheap notebook", "code": "NB-1"},
+		{"title": "hotels in new york", "code": "NY-1"},
+		{"title": "gaming laptop", "code": "TELEVISION-1"},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("SynonymClass")
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, props ...string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: props, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 100, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		return ids
+	}
+
+	t.Run("without synonyms", func(t *testing.T) {
+		assert.Empty(t, search(t, "tv", "title"))
+	})
+
+	class.InvertedIndexConfig.Synonyms = []*models.SynonymRule{
+		{Terms: []string{"TV", "television"}},
+		{Terms: []string{"laptop", "notebook"}, Expansion: "oneWay"},
+		{Terms: []string{"nyc", "new york"}},
+		{Terms: []string{"TV-1", "TELEVISION-1"}},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		props    []string
+		expected []uint64
+	}{
+		{
+			name:     "equivalent",
+			query:    "tv",
+			props:    []string{"title"},
+			expected: []uint64{0},
+		},
+		{
+			name:     "one way",
+			query:    "laptop",
+			props:    []string{"title"},
+			expected: []uint64{3, 1},
+		},
+		{
+			name:     "one way is not reversed",
+			query:    "notebook",
+			props:    []string{"title"},
+			expected: []uint64{1},
+		},
+		{
+			name:     "multi-word synonym",
+			query:    "nyc",
+			props:    []string{"title"},
+			expected: []uint64{2},
+		},
+		{
+			name:     "synonyms are analyzed like the property",
+			query:    "TV-1",
+			props:    []string{"code"},
+			expected: []uint64{0, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, search(t, test.query, test.props...))
+		})
+	}
+}
@@ -158,6 +158,10 @@ func (f *fakeSchemaManager) TenantShard(class, tenant string) string {
 	return tenant
 }
 
+func (f *fakeSchemaManager) TenantQuota(class, tenant string) *models.TenantQuota {
+	return nil
+}
+
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
 	ss := f.shardState
 	return ss.Shard("", string(uuid))
@@ -42,6 +42,7 @@ func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 		return fmt.Errorf("import into index %s: %w", idx.ID(), err)
 	}
 
+	db.recordChange(obj.Class, obj.Tenant, obj.ID)
 	return nil
 }
 
@@ -59,6 +60,7 @@ func (db *DB) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
 		return fmt.Errorf("delete from index %q: %w", idx.ID(), err)
 	}
 
+	db.recordChange(class, tenant, id)
 	return nil
 }
 
@@ -86,7 +88,7 @@ func (db *DB) MultiGet(ctx context.Context, query []multi.Identifier,
 
 	out := make(search.Results, len(query))
 	for indexID, queries := range byIndex {
-		indexRes, err := db.indices[indexID].multiObjectByID(ctx, queries, tenant)
+		indexRes, err := db.indices[indexID].multiObjectByID(ctx, queries, additional, tenant)
 		if err != nil {
 			return nil, errors.Wrapf(err, "index %q", indexID)
 		}
@@ -267,5 +269,6 @@ func (db *DB) Merge(ctx context.Context, merge objects.MergeDocument,
 		return errors.Wrapf(err, "merge into index %s", idx.ID())
 	}
 
+	db.recordChange(merge.Class, tenant, merge.ID)
 	return nil
 }
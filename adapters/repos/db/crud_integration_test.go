@@ -732,6 +732,26 @@ func TestCRUD(t *testing.T) {
 		assert.Equal(t, []float32{3, 1, 0.3, 12}, item.Vector, "it should include the object meta as it was explicitly specified")
 	})
 
+	t.Run("getting an action with skipped vector", func(t *testing.T) {
+		addl := additional.Properties{SkipVector: true}
+		item, err := repo.Object(context.Background(), "TheBestActionClass", actionID,
+			search.SelectProperties{}, addl, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, item, "must have a result")
+
+		assert.Equal(t, "some act-citing value", item.Schema.(map[string]interface{})["stringProp"])
+		assert.Nil(t, item.Vector)
+		assert.Equal(t, 4, item.Dims)
+
+		res, err := repo.MultiGet(context.Background(), []multi.Identifier{
+			{ID: actionID.String(), ClassName: "TheBestActionClass"},
+		}, addl, "")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, actionID, res[0].ID)
+		assert.Nil(t, res[0].Vector)
+	})
+
 	t.Run("searching all actions", func(t *testing.T) {
 		res, err := repo.ObjectSearch(context.Background(), 0, 10, nil, nil, additional.Properties{}, "")
 		require.Nil(t, err)
@@ -1437,15 +1457,6 @@ func TestCRUD_Query(t *testing.T) {
 				query:              toParams("", 0, 7, &filters.Cursor{After: "", Limit: 7}, nil, nil),
 				constainsErrorMsgs: []string{"class not found"},
 			},
-			{
-				name: "error on sort parameter",
-				query: toParams(className, 0, 7,
-					&filters.Cursor{After: "", Limit: 7}, nil,
-					[]filters.Sort{{Path: []string{"stringProp"}, Order: "asc"}},
-				),
-				cursor:             &filters.Cursor{After: "", Limit: 7},
-				constainsErrorMsgs: []string{"sort cannot be set with after and limit parameters"},
-			},
 			{
 				name: "error on offset parameter",
 				query: toParams(className, 10, 7,
@@ -1462,7 +1473,7 @@ func TestCRUD_Query(t *testing.T) {
 					[]filters.Sort{{Path: []string{"stringProp"}, Order: "asc"}},
 				),
 				cursor:             &filters.Cursor{After: "", Limit: 7},
-				constainsErrorMsgs: []string{"offset,sort cannot be set with after and limit parameters"},
+				constainsErrorMsgs: []string{"offset cannot be set with after and limit parameters"},
 			},
 		}
 		for _, tt := range tests {
@@ -1504,6 +1515,60 @@ func TestCRUD_Query(t *testing.T) {
 				}
 			})
 		}
+		t.Run("scroll through filtered and sorted objects", func(t *testing.T) {
+			filter := buildFilter("stringProp", "*a*", filters.OperatorLike, schema.DataTypeText)
+			scroll := func(t *testing.T, filter *filters.LocalFilter, sort []filters.Sort, limit int) []strfmt.UUID {
+				var ids []strfmt.UUID
+				cursor := &filters.Cursor{After: "", Limit: limit}
+				for {
+					query := toParams(className, 0, limit, cursor, filter, sort)
+					query.Additional = additional.Properties{Cursor: true}
+					res, err := repo.Query(context.Background(), query)
+					require.Nil(t, err)
+					if len(res) == 0 {
+						return ids
+					}
+					for i := range res {
+						ids = append(ids, res[i].ID)
+					}
+					after, ok := res[len(res)-1].AdditionalProperties["cursor"].(string)
+					require.True(t, ok)
+					cursor = &filters.Cursor{After: after, Limit: limit}
+				}
+			}
+
+			t.Run("filtered", func(t *testing.T) {
+				ids := scroll(t, filter, nil, 2)
+				assert.Equal(t, []strfmt.UUID{thingID1, thingID2, thingID3, thingID4, thingID5, thingID6}, ids)
+			})
+
+			t.Run("sorted", func(t *testing.T) {
+				sort := []filters.Sort{buildSortFilter([]string{"stringProp"}, "desc")}
+				ids := scroll(t, nil, sort, 3)
+				assert.Equal(t, []strfmt.UUID{thingID2, thingID6, thingID3, thingID7, thingID4, thingID1, thingID5}, ids)
+			})
+
+			t.Run("filtered and sorted", func(t *testing.T) {
+				sort := []filters.Sort{buildSortFilter([]string{"stringProp"}, "asc")}
+				ids := scroll(t, filter, sort, 2)
+				assert.Equal(t, []strfmt.UUID{thingID1, thingID5, thingID4, thingID3, thingID2, thingID6}, ids)
+			})
+
+			t.Run("error on a sorted cursor without sort", func(t *testing.T) {
+				sort := []filters.Sort{buildSortFilter([]string{"stringProp"}, "asc")}
+				query := toParams(className, 0, 1, &filters.Cursor{Limit: 1}, nil, sort)
+				query.Additional = additional.Properties{Cursor: true}
+				res, err := repo.Query(context.Background(), query)
+				require.Nil(t, err)
+				require.Len(t, res, 1)
+
+				after := res[0].AdditionalProperties["cursor"].(string)
+				_, err = repo.Query(context.Background(),
+					toParams(className, 0, 1, &filters.Cursor{After: after, Limit: 1}, nil, nil))
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), "sort is not set")
+			})
+		})
 		// clean up
 		for _, td := range testData {
 			err := repo.DeleteObject(context.Background(), td.className, td.id, nil, "")
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_NestedProperties(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "PersonWithNestedProps",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{{
+				Name:         "city",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			}, {
+				Name:     "zip",
+				DataType: schema.DataTypeInt.PropString(),
+			}},
+		}, {
+			Name:     "pets",
+			DataType: schema.DataTypeObjectArray.PropString(),
+			NestedProperties: []*models.NestedProperty{{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			}},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	amsterdamID := strfmt.UUID("4e5f2b7a-36a4-4fa3-9c4e-2f3a0d6d4b01")
+	berlinID := strfmt.UUID("4e5f2b7a-36a4-4fa3-9c4e-2f3a0d6d4b02")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    amsterdamID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{"city": "Amsterdam", "zip": float64(1011)},
+				"pets": []interface{}{
+					map[string]interface{}{"name": "Rex"},
+					map[string]interface{}{"name": "Tom"},
+				},
+			},
+		}, {
+			ID:    berlinID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{"city": "Berlin", "zip": float64(10115)},
+				"pets": []interface{}{
+					map[string]interface{}{"name": "Tom"},
+				},
+			},
+		}}
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("nested values are returned as is", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), amsterdamID, search.SelectProperties{},
+			additional.Properties{}, "")
+		require.Nil(t, err)
+
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"city": "Amsterdam", "zip": float64(1011)},
+			props["address"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Rex"},
+			map[string]interface{}{"name": "Tom"},
+		}, props["pets"])
+	})
+
+	nestedFilter := func(path string, value interface{}, operator filters.Operator,
+		dataType schema.DataType,
+	) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: operator,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: schema.PropertyName(path),
+				},
+				Value: &filters.Value{Value: value, Type: dataType},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+	}{
+		{
+			name:        "filtering on a nested text prop",
+			filter:      nestedFilter("address.city", "Amsterdam", eq, schema.DataTypeText),
+			expectedIDs: []strfmt.UUID{amsterdamID},
+		},
+		{
+			name:        "filtering on a nested int prop",
+			filter:      nestedFilter("address.zip", 10000, gt, schema.DataTypeInt),
+			expectedIDs: []strfmt.UUID{berlinID},
+		},
+		{
+			name:        "filtering on a nested prop of an object array",
+			filter:      nestedFilter("pets.name", "Tom", eq, schema.DataTypeText),
+			expectedIDs: []strfmt.UUID{amsterdamID, berlinID},
+		},
+		{
+			name:        "filtering on a nested prop of an object array matching one element",
+			filter:      nestedFilter("pets.name", "Rex", eq, schema.DataTypeText),
+			expectedIDs: []strfmt.UUID{amsterdamID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := repo.Search(context.Background(), dto.GetParams{
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 10},
+				Filters:    tt.filter,
+			})
+			require.Nil(t, err)
+
+			ids := make([]strfmt.UUID, len(res))
+			for i := range res {
+				ids[i] = res[i].ID
+			}
+			assert.ElementsMatch(t, tt.expectedIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// LookupObjectHashes returns the objects recorded for the given hashes by
+// imports with deduplication. Only local shards are searched, so duplicates
+// of objects held by other nodes are not detected.
+func (db *DB) LookupObjectHashes(ctx context.Context, className, tenant string,
+	hashes [][]byte,
+) ([]objects.ObjectHash, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	shardNames, err := idx.targetShardNames(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]objects.ObjectHash, len(hashes))
+	for i, hash := range hashes {
+		out[i].Hash = hash
+	}
+	for _, name := range shardNames {
+		if err := idx.lookupObjectHashes(ctx, name, tenant, out); err != nil {
+			return nil, fmt.Errorf("shard %s: %w", name, err)
+		}
+	}
+	return out, nil
+}
+
+func (i *Index) lookupObjectHashes(ctx context.Context, shardName, tenant string,
+	entries []objects.ObjectHash,
+) error {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if shard == nil {
+		return nil
+	}
+
+	bucket, err := shard.dedupBucket(ctx)
+	if err != nil {
+		return err
+	}
+	for j := range entries {
+		if entries[j].ID != "" {
+			continue
+		}
+		v, err := bucket.Get(dedupKey(tenant, entries[j].Hash))
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		id, lastUpdate, err := decodeDedupValue(v)
+		if err != nil {
+			return err
+		}
+		entries[j].ID, entries[j].LastUpdateTimeUnix = id, lastUpdate
+	}
+	return nil
+}
+
+// PutObjectHashes records the hashes of imported objects in the shards of
+// the objects. Hashes of objects on other nodes are dropped.
+func (db *DB) PutObjectHashes(ctx context.Context, className, tenant string,
+	entries []objects.ObjectHash,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	byShard := map[string][]objects.ObjectHash{}
+	for _, entry := range entries {
+		name, err := idx.determineObjectShard(entry.ID, tenant)
+		if err != nil {
+			return err
+		}
+		byShard[name] = append(byShard[name], entry)
+	}
+
+	for name, entries := range byShard {
+		if err := idx.putObjectHashes(ctx, name, tenant, entries); err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (i *Index) putObjectHashes(ctx context.Context, shardName, tenant string,
+	entries []objects.ObjectHash,
+) error {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if shard == nil {
+		return nil
+	}
+
+	bucket, err := shard.dedupBucket(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		v, err := encodeDedupValue(entry.ID, entry.LastUpdateTimeUnix)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(dedupKey(tenant, entry.Hash), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dedupBucket loads the bucket of object hashes, it is only created once a
+// shard is imported into with deduplication
+func (s *Shard) dedupBucket(ctx context.Context) (*lsmkv.Bucket, error) {
+	s.dedupLock.Lock()
+	defer s.dedupLock.Unlock()
+
+	if b := s.store.Bucket(helpers.DedupHashesBucketLSM); b != nil {
+		return b, nil
+	}
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.DedupHashesBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return nil, errors.Wrap(err, "create dedup hashes bucket")
+	}
+	return s.store.Bucket(helpers.DedupHashesBucketLSM), nil
+}
+
+// dedupKey scopes a hash to its tenant, as tenants may share a shard
+func dedupKey(tenant string, hash []byte) []byte {
+	key := make([]byte, 0, len(tenant)+1+len(hash))
+	key = append(key, tenant...)
+	key = append(key, 0)
+	return append(key, hash...)
+}
+
+func encodeDedupValue(id strfmt.UUID, lastUpdate int64) ([]byte, error) {
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse uuid: %q", id.String())
+	}
+	v := make([]byte, 24)
+	copy(v, parsed[:])
+	binary.LittleEndian.PutUint64(v[16:], uint64(lastUpdate))
+	return v, nil
+}
+
+func decodeDedupValue(v []byte) (strfmt.UUID, int64, error) {
+	if len(v) != 24 {
+		return "", 0, fmt.Errorf("invalid dedup hash entry of length %d", len(v))
+	}
+	id, err := uuid.FromBytes(v[:16])
+	if err != nil {
+		return "", 0, err
+	}
+	return strfmt.UUID(id.String()), int64(binary.LittleEndian.Uint64(v[16:])), nil
+}
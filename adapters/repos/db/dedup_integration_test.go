@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestObjectHashes(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "ObjectHashesClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dir,
+			QueryMaximumResults:       10,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	const id = strfmt.UUID("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5")
+	hashes := [][]byte{[]byte("hash-a"), []byte("hash-b")}
+
+	t.Run("unknown hashes", func(t *testing.T) {
+		res, err := repo.LookupObjectHashes(ctx, class.Class, "", hashes)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, strfmt.UUID(""), res[0].ID)
+		assert.Equal(t, hashes[1], res[1].Hash)
+	})
+
+	require.Nil(t, repo.PutObjectHashes(ctx, class.Class, "", []objects.ObjectHash{
+		{Hash: hashes[0], ID: id, LastUpdateTimeUnix: 42},
+	}))
+
+	assertRecorded := func(t *testing.T, repo *DB) {
+		res, err := repo.LookupObjectHashes(ctx, class.Class, "", hashes)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, id, res[0].ID)
+		assert.Equal(t, int64(42), res[0].LastUpdateTimeUnix)
+		assert.Equal(t, strfmt.UUID(""), res[1].ID)
+	}
+
+	t.Run("recorded hashes", func(t *testing.T) {
+		assertRecorded(t, repo)
+	})
+
+	t.Run("after restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo()
+		assertRecorded(t, repo)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.LookupObjectHashes(ctx, "Unknown", "", hashes)
+		assert.NotNil(t, err)
+	})
+
+	require.Nil(t, repo.Shutdown(ctx))
+}
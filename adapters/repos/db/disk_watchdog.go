@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+)
+
+// fsUsage is the usage of the filesystem a shard is stored on
+type fsUsage struct {
+	total uint64
+	avail uint64
+}
+
+func (u fsUsage) String() string {
+	MB := uint64(1024 * 1024)
+	return fmt.Sprintf("%dMB of %dMB free", u.avail/MB, u.total/MB)
+}
+
+// diskWatchdogThresholds returns the free space below which the writes to
+// a filesystem of the given size are fenced, and the free space it needs
+// to have again for the fence to be lifted
+func (d *DB) diskWatchdogThresholds(total uint64) (fence, lift uint64) {
+	cfg := d.config.ResourceUsage.DiskWatchdog
+
+	fence = cfg.MinFreeMB * 1024 * 1024
+	if pct := total / 100 * cfg.MinFreePercentage; pct > fence {
+		fence = pct
+	}
+	return fence, fence + total/100*cfg.RecoveryMarginPercentage
+}
+
+// watchDiskSpace fences the writes to all shards on a filesystem which is
+// about to run out of space, before a full disk leaves truncated WALs and
+// segments behind, and lifts the fence once enough space was freed. Shards
+// which are READONLY for any other reason are left alone.
+func (d *DB) watchDiskSpace() {
+	d.indexLock.RLock()
+	indices := make([]*Index, 0, len(d.indices))
+	for _, index := range d.indices {
+		if index != nil {
+			indices = append(indices, index)
+		}
+	}
+	d.indexLock.RUnlock()
+
+	// the shards are usually on the same filesystem, it is only read once
+	// per device
+	usages := map[uint64]fsUsage{}
+	for _, index := range indices {
+		index.ForEachShard(func(name string, shard *Shard) error {
+			path := shard.DBPathLSM()
+			usage, err := pathUsage(path, usages)
+			if err != nil {
+				d.logger.WithField("action", "disk_watchdog").
+					WithField("path", path).
+					WithError(err).
+					Warn("failed to read disk usage")
+				return nil
+			}
+			d.fenceShardWrites(shard, path, usage)
+			return nil
+		})
+	}
+}
+
+func (d *DB) fenceShardWrites(shard *Shard, path string, usage fsUsage) {
+	fence, lift := d.diskWatchdogThresholds(usage.total)
+
+	if usage.avail < fence {
+		reason := fmt.Sprintf("disk of %s is almost full (%s, minimum %dMB)",
+			path, usage, fence/1024/1024)
+		if shard.fenceWrites(reason) {
+			d.logger.WithField("action", "disk_watchdog").
+				WithField("shard", shard.ID()).
+				WithField("path", path).
+				Warnf("set READONLY, %s", reason)
+		}
+		return
+	}
+
+	if usage.avail >= lift && shard.unfenceWrites() {
+		d.logger.WithField("action", "disk_watchdog").
+			WithField("shard", shard.ID()).
+			WithField("path", path).
+			Infof("set READY, disk space was freed (%s)", usage)
+	}
+}
+
+func pathUsage(path string, usages map[uint64]fsUsage) (fsUsage, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fsUsage{}, err
+	}
+	var device uint64
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		device = uint64(st.Dev)
+		if usage, ok := usages[device]; ok {
+			return usage, nil
+		}
+	}
+
+	fs := syscall.Statfs_t{}
+	if err := syscall.Statfs(path, &fs); err != nil {
+		return fsUsage{}, err
+	}
+	usage := fsUsage{
+		total: fs.Blocks * uint64(fs.Bsize),
+		avail: fs.Bavail * uint64(fs.Bsize),
+	}
+	usages[device] = usage
+	return usage, nil
+}
+
+func (d *DB) diskWatchdogInterval() time.Duration {
+	return time.Duration(d.config.ResourceUsage.DiskWatchdog.IntervalSeconds) * time.Second
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestDiskWatchdog(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "DiskWatchdogClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: schema.DataTypeText.PropString(),
+		}},
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	put := func(id strfmt.UUID) error {
+		return repo.PutObject(ctx, &models.Object{
+			Class: class.Class, ID: id,
+			Properties: map[string]interface{}{"name": "watched"},
+		}, []float32{1, 2, 3}, nil)
+	}
+	shardStatus := func() storagestate.Status {
+		var status storagestate.Status
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(_ string, shard *Shard) error {
+			status = shard.getStatus()
+			return nil
+		})
+		return status
+	}
+
+	require.Nil(t, put("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5"))
+
+	t.Run("fences writes below the threshold", func(t *testing.T) {
+		// no disk has all of its space free
+		repo.config.ResourceUsage.DiskWatchdog = config.DiskWatchdog{
+			Enabled: true, MinFreePercentage: 100, IntervalSeconds: 1,
+		}
+		repo.watchDiskSpace()
+
+		assert.Equal(t, storagestate.StatusReadOnly, shardStatus())
+		err := put("f1d4ac9b-4d4c-4a35-9c2b-4a9a1a7c1b0e")
+		require.NotNil(t, err)
+		assert.ErrorIs(t, err, storagestate.ErrStatusReadOnly)
+		assert.Contains(t, err.Error(), "is almost full")
+	})
+
+	t.Run("lifts the fence once space is freed", func(t *testing.T) {
+		repo.config.ResourceUsage.DiskWatchdog.MinFreePercentage = 0
+		repo.watchDiskSpace()
+
+		assert.Equal(t, storagestate.StatusReady, shardStatus())
+		assert.Nil(t, put("f1d4ac9b-4d4c-4a35-9c2b-4a9a1a7c1b0e"))
+	})
+
+	t.Run("leaves shards set to READONLY by the user alone", func(t *testing.T) {
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(_ string, shard *Shard) error {
+			return shard.updateStatus(storagestate.StatusReadOnly.String())
+		})
+		repo.watchDiskSpace()
+
+		assert.Equal(t, storagestate.StatusReadOnly, shardStatus())
+		err := put("3e4f4b6c-1f0c-4b55-8e0a-0c6c4c7b3e21")
+		assert.ErrorIs(t, err, storagestate.ErrStatusReadOnly)
+		assert.NotContains(t, err.Error(), "is almost full")
+	})
+}
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/encryption"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestEncryptionAtRest(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	className := "EncryptionAtRest"
+	tenants := []string{"tenant1", "tenant2"}
+	secret := "a xylophonist secret which must not be stored in plain text"
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	for _, tenant := range tenants {
+		shardState.AddPartition(tenant, []string{"node1"})
+	}
+
+	keys, err := encryption.NewLocalKeyManager(t.TempDir(), bytes.Repeat([]byte{1}, encryption.KeySize))
+	require.Nil(t, err)
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{{
+			Name:     "secret",
+			DataType: schema.DataTypeText.PropString(),
+		}},
+	}
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			KeyManager:                keys,
+			PerTenantKeys:             true,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, shardState))
+
+	ids := map[string]strfmt.UUID{}
+	for _, tenant := range tenants {
+		ids[tenant] = strfmt.UUID(uuid.NewString())
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      className,
+			ID:         ids[tenant],
+			Tenant:     tenant,
+			Properties: map[string]interface{}{"secret": secret},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	objectsBucketDir := func(tenant string) string {
+		shard := repo.GetIndex(schema.ClassName(className)).shards.Load(tenant)
+		require.NotNil(t, shard)
+		require.Nil(t, shard.store.Bucket(helpers.ObjectsBucketLSM).FlushMemtable())
+		return filepath.Join(shard.DBPathLSM(), helpers.ObjectsBucketLSM)
+	}
+
+	t.Run("no file of the shards is stored in plain text", func(t *testing.T) {
+		// shutting down flushes all buckets, the vector index and the
+		// property lengths
+		require.Nil(t, repo.Shutdown(ctx))
+
+		files := 0
+		err := filepath.WalkDir(dirName, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			require.Nil(t, err)
+			// the object, the tokens of the inverted index and the property
+			// names in the property lengths
+			for _, needle := range []string{secret, "xylophonist", `"secret"`} {
+				assert.False(t, bytes.Contains(data, []byte(needle)), "%q in %s", needle, path)
+			}
+			files++
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Greater(t, files, 0)
+	})
+
+	t.Run("objects can be read after a restart", func(t *testing.T) {
+		repo = newRepo()
+		for _, tenant := range tenants {
+			res, err := repo.ObjectByID(ctx, ids[tenant], nil, additional.Properties{}, tenant)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, secret, res.Schema.(map[string]interface{})["secret"])
+		}
+	})
+
+	t.Run("deleting a tenant deletes its key", func(t *testing.T) {
+		copyDir := t.TempDir()
+		files, err := filepath.Glob(filepath.Join(objectsBucketDir("tenant1"), "*"))
+		require.Nil(t, err)
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			require.Nil(t, err)
+			require.Nil(t, os.WriteFile(filepath.Join(copyDir, filepath.Base(file)), data, 0o600))
+		}
+
+		commit, err := NewMigrator(repo, logger).DeleteTenants(ctx, class, []string{"tenant1"})
+		require.Nil(t, err)
+		commit(true)
+
+		// a copy of the data, e.g. from a backup, cannot be read with the key
+		// the tenant would get now
+		key, err := keys.DataKey(ctx, className+"/tenant1")
+		require.Nil(t, err)
+		enc, err := diskio.NewEncryption(key)
+		require.Nil(t, err)
+		_, err = lsmkv.NewBucket(ctx, copyDir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace), lsmkv.WithEncryption(enc))
+		assert.ErrorContains(t, err, "different key")
+
+		res, err := repo.ObjectByID(ctx, ids["tenant2"], nil, additional.Properties{}, "tenant2")
+		require.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+
+	require.Nil(t, repo.Shutdown(ctx))
+}
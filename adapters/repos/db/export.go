@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// exportPageSize is the number of objects requested at once from shards
+// which are not held by this node
+const exportPageSize = 1000
+
+// ExportObjects passes all objects of the class to fn, including their
+// vectors. If a filter is set, only matching objects are passed. Local
+// shards are read from a snapshot of their objects bucket, so writes during
+// the export do not show up in it, remote shards are paged through.
+func (db *DB) ExportObjects(ctx context.Context, className string,
+	filter *filters.LocalFilter, tenant string, fn func(obj *models.Object) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot find index for class %v", className)
+	}
+
+	return idx.exportObjects(ctx, filter, tenant, fn)
+}
+
+func (i *Index) exportObjects(ctx context.Context, filter *filters.LocalFilter,
+	tenant string, fn func(obj *models.Object) error,
+) error {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return err
+	}
+
+	for _, shardName := range shardNames {
+		if err := i.exportShard(ctx, shardName, filter, fn); err != nil {
+			return fmt.Errorf("export shard %s: %w", shardName, err)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) exportShard(ctx context.Context, shardName string,
+	filter *filters.LocalFilter, fn func(obj *models.Object) error,
+) error {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if shard != nil {
+		return shard.exportObjects(ctx, filter, fn)
+	}
+
+	var sort []filters.Sort
+	if filter != nil {
+		sort = cursorSort(nil)
+	}
+
+	cursor := &filters.Cursor{Limit: exportPageSize}
+	for {
+		objs, _, err := i.remote.SearchShard(ctx, shardName, nil, exportPageSize,
+			filter, nil, sort, cursor, nil, additional.Properties{Vector: true}, false)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objs {
+			if err := fn(exportedObject(obj)); err != nil {
+				return err
+			}
+		}
+
+		if len(objs) < exportPageSize {
+			return nil
+		}
+		cursor = &filters.Cursor{
+			After: objs[len(objs)-1].ID().String(),
+			Limit: exportPageSize,
+		}
+	}
+}
+
+// exportObjects iterates a snapshot of the objects bucket. The filter is
+// resolved before the snapshot is taken, objects which only match due to
+// writes in between are therefore not part of the export.
+func (s *Shard) exportObjects(ctx context.Context, filter *filters.LocalFilter,
+	fn func(obj *models.Object) error,
+) error {
+	var allowList helpers.AllowList
+	if filter != nil {
+		list, err := s.buildAllowList(ctx, filter, additional.Properties{})
+		if err != nil {
+			return err
+		}
+		allowList = list
+	}
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).SnapshotCursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if allowList != nil {
+			docID, err := storobj.DocIDFromBinary(v)
+			if err != nil {
+				return errors.Wrap(err, "read doc id")
+			}
+			if !allowList.Contains(docID) {
+				continue
+			}
+		}
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal object")
+		}
+
+		if err := fn(exportedObject(obj)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportedObject(obj *storobj.Object) *models.Object {
+	out := obj.Object
+	out.Vector = obj.Vector
+	out.Additional = nil
+	return &out
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestExportObjects(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the thing class", testAddBatchObjectClass(repo, migrator, schemaGetter))
+
+	t.Run("batch import things", testBatchImportObjects(repo))
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  "ThingForBatching",
+			Pagination: &filters.Pagination{Limit: 10000},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+	export := func(t *testing.T, filter *filters.LocalFilter) []*models.Object {
+		var out []*models.Object
+		err := repo.ExportObjects(context.Background(), "ThingForBatching", filter, "",
+			func(obj *models.Object) error {
+				out = append(out, obj)
+				return nil
+			})
+		require.Nil(t, err)
+		return out
+	}
+	ids := func(objs []*models.Object) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(objs))
+		for i := range objs {
+			out[i] = objs[i].ID
+		}
+		return out
+	}
+
+	t.Run("all objects are exported with their vectors", func(t *testing.T) {
+		exported := export(t, nil)
+		assert.ElementsMatch(t, search(t, nil), ids(exported))
+		for _, obj := range exported {
+			assert.Equal(t, "ThingForBatching", obj.Class)
+			assert.NotEmpty(t, obj.Vector)
+			assert.NotNil(t, obj.Properties)
+		}
+	})
+
+	t.Run("only objects matching the filter are exported", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				Value:    &filters.Value{Value: "element", Type: schema.DataTypeText},
+				On:       &filters.Path{Class: "ThingForBatching", Property: "stringProp"},
+			},
+		}
+
+		expected := search(t, filter)
+		require.NotEmpty(t, expected)
+		assert.ElementsMatch(t, expected, ids(export(t, filter)))
+	})
+
+	t.Run("an error of the callback stops the export", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := repo.ExportObjects(context.Background(), "ThingForBatching", nil, "",
+			func(obj *models.Object) error {
+				calls++
+				return stop
+			})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("tenant on class without multi-tenancy", func(t *testing.T) {
+		err := repo.ExportObjects(context.Background(), "ThingForBatching", nil, "t1",
+			func(obj *models.Object) error { return nil })
+		assert.NotNil(t, err)
+	})
+}
@@ -60,9 +60,21 @@ func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 }
 
 func (f *fakeSchemaGetter) TenantShard(class, tenant string) string {
+	if f.shardState != nil {
+		if p, ok := f.shardState.Physical[tenant]; ok && p.SharedShard != "" {
+			return p.SharedShard
+		}
+	}
 	return tenant
 }
 
+func (f *fakeSchemaGetter) TenantQuota(class, tenant string) *models.TenantQuota {
+	if f.shardState == nil {
+		return nil
+	}
+	return f.shardState.Physical[tenant].Quota
+}
+
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	ss := f.shardState
 	return ss.Shard("", string(uuid))
@@ -218,12 +230,24 @@ func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) UpdateObjectBatch(ctx context.Context, hostName, indexName, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
 	return "", nil
 }
 
+func (f *fakeRemoteClient) GetShardUsage(ctx context.Context,
+	hostName, indexName, shardName string,
+) (map[string]models.TenantUsage, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string,
 ) error {
@@ -319,6 +343,18 @@ func (*fakeReplicationClient) FetchObjects(ctx context.Context, host,
 	return nil, nil
 }
 
+func (*fakeReplicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, req replica.HashTreeRequest,
+) ([]replica.Digest, error) {
+	return nil, nil
+}
+
+func (*fakeReplicationClient) DigestObjectsInLeaves(ctx context.Context,
+	host, index, shard string, req replica.HashTreeRequest,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (*fakeReplicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, objects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
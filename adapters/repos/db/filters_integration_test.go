@@ -76,6 +76,10 @@ var (
 	wgr  = filters.OperatorWithinGeoRange
 	and  = filters.OperatorAnd
 	null = filters.OperatorIsNull
+	pfx  = filters.OperatorPrefix
+	fzy  = filters.OperatorFuzzy
+	cany = filters.OperatorContainsAny
+	call = filters.OperatorContainsAll
 
 	// datatypes
 	dtInt            = schema.DataTypeInt
@@ -84,6 +88,8 @@ var (
 	dtText           = schema.DataTypeText
 	dtDate           = schema.DataTypeDate
 	dtGeoCoordinates = schema.DataTypeGeoCoordinates
+	dtIntArray       = schema.DataTypeIntArray
+	dtTextArray      = schema.DataTypeTextArray
 )
 
 func prepareCarTestSchemaAndData(repo *DB,
@@ -120,6 +126,11 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 		}
 
 		tests := []test{
+			{
+				name:        "horsepower is any of 100, 130",
+				filter:      buildFilter("horsepower", []int{100, 130}, cany, dtIntArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
 			{
 				name:        "horsepower == 130",
 				filter:      buildFilter("horsepower", 130, eq, dtInt),
@@ -170,6 +181,26 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("modelName", "*rinte?", like, dtText),
 				expectedIDs: []strfmt.UUID{carSprinterID},
 			},
+			{
+				name:        "modelName prefix spr dtText",
+				filter:      buildFilter("modelName", "spr", pfx, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "modelName fuzzy sprimter dtText",
+				filter:      buildFilter("modelName", "sprimter", fzy, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name:        "modelName fuzzy spirnter~1 dtText",
+				filter:      buildFilter("modelName", "spirnter~1", fzy, dtText),
+				expectedIDs: []strfmt.UUID{},
+			},
+			{
+				name:        "modelName fuzzy spirnter~2 dtText",
+				filter:      buildFilter("modelName", "spirnter~2", fzy, dtText),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
 			{
 				name:        "weight == 3499.90",
 				filter:      buildFilter("weight", 3499.90, eq, dtNumber),
@@ -376,6 +407,21 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("colorArrayField", "dark grey", eq, dtText),
 				expectedIDs: []strfmt.UUID{},
 			},
+			{
+				name:        "by color array containing any of the colors",
+				filter:      buildFilter("colorArrayField", []string{"light grey", "dark"}, cany, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
+			{
+				name:        "by color array containing all of the colors",
+				filter:      buildFilter("colorArrayField", []string{"dark", "grey"}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "by color array containing all of the colors, none matching",
+				filter:      buildFilter("colorArrayField", []string{"light grey", "grey"}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{},
+			},
 			{
 				name:        "by null value",
 				filter:      buildFilter("colorArrayField", true, null, dtBool),
@@ -479,6 +525,18 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("availableAtDealerships", dealershipSouth.String(), eq, dtText),
 				expectedIDs: []strfmt.UUID{carPoloID, carSprinterID},
 			},
+			{
+				name: "available at all of the dealerships",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "available at any of the dealerships",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, cany, dtTextArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID, carSprinterID},
+			},
 		}
 
 		for _, test := range tests {
@@ -961,6 +1019,49 @@ func TestGeoPropUpdateJourney(t *testing.T) {
 		// notice the opposite order
 		assert.Equal(t, ids[1], res[0].ID)
 	})
+
+	t.Run("verify 2nd object found by bounding box", func(t *testing.T) {
+		box := filters.GeoBoundingBox{
+			TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(7), Longitude: ptFloat32(-2)},
+			BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(6), Longitude: ptFloat32(0)},
+		}
+		res, err := repo.Search(context.Background(),
+			getParamsWithFilter("GeoUpdateTestClass", buildFilter(
+				"location", box, filters.OperatorWithinGeoBoundingBox, schema.DataTypeGeoCoordinates,
+			)))
+
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[1], res[0].ID)
+	})
+
+	t.Run("verify 1st object found by polygon", func(t *testing.T) {
+		polygon := filters.GeoPolygon{Vertices: []*models.GeoCoordinates{
+			{Latitude: ptFloat32(20), Longitude: ptFloat32(10)},
+			{Latitude: ptFloat32(25), Longitude: ptFloat32(15)},
+			{Latitude: ptFloat32(20), Longitude: ptFloat32(20)},
+		}}
+		res, err := repo.Search(context.Background(),
+			getParamsWithFilter("GeoUpdateTestClass", buildFilter(
+				"location", polygon, filters.OperatorWithinGeoPolygon, schema.DataTypeGeoCoordinates,
+			)))
+
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+
+	t.Run("verify deleted object is not found", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), "GeoUpdateTestClass", ids[1], nil, ""))
+
+		res, err := repo.Search(context.Background(),
+			getParamsWithFilter("GeoUpdateTestClass", buildFilter(
+				"location", searchQuery, wgr, schema.DataTypeGeoCoordinates,
+			)))
+
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
 }
 
 // This test prevents a regression on
@@ -22,6 +22,8 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	UsageBucketLSM             = "usage"
+	DedupHashesBucketLSM       = "dedup_hashes"
 	DocIDBucket                = []byte("doc_ids")
 )
 
@@ -71,3 +73,15 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+// BucketGeoCellsFromPropNameLSM is the bucket mapping the cells of the geo
+// index of a property to the doc ids located within them
+func BucketGeoCellsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_geo_cells")
+}
+
+// BucketGeoCoordinatesFromPropNameLSM is the bucket holding the exact
+// coordinates of a doc id in the geo index of a property
+func BucketGeoCoordinatesFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_geo_coordinates")
+}
@@ -98,9 +98,79 @@ func lowercase(terms []string) []string {
 	return terms
 }
 
+// Token is a term along with its position in the tokenized text. Start and
+// End are offsets in runes, End is exclusive.
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// TokenizeWithOffsets splits the text into the same terms as Tokenize, but
+// keeps the position of every term, e.g. to highlight matches
+func TokenizeWithOffsets(tokenization string, in string) []Token {
+	var isSeparator func(r rune) bool
+	lower := true
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		isSeparator = func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}
+	case models.PropertyTokenizationLowercase:
+		isSeparator = unicode.IsSpace
+	case models.PropertyTokenizationWhitespace:
+		isSeparator = unicode.IsSpace
+		lower = false
+	case models.PropertyTokenizationField:
+		// the whole trimmed value is a single term
+		isSeparator = func(r rune) bool { return false }
+		lower = false
+	default:
+		return []Token{}
+	}
+
+	runes := []rune(in)
+	tokens := []Token{}
+	if tokenization == models.PropertyTokenizationField {
+		start, end := 0, len(runes)
+		for start < end && unicode.IsSpace(runes[start]) {
+			start++
+		}
+		for end > start && unicode.IsSpace(runes[end-1]) {
+			end--
+		}
+		return append(tokens, Token{Term: string(runes[start:end]), Start: start, End: end})
+	}
+
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && !isSeparator(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			term := string(runes[start:i])
+			if lower {
+				term = strings.ToLower(term)
+			}
+			tokens = append(tokens, Token{Term: term, Start: start, End: i})
+			start = -1
+		}
+	}
+	return tokens
+}
+
 func TokenizeAndCountDuplicates(tokenization string, in string) ([]string, []int) {
+	return CountDuplicates(Tokenize(tokenization, in))
+}
+
+// CountDuplicates returns the unique terms along with the number of times
+// each of them occurred
+func CountDuplicates(terms []string) ([]string, []int) {
 	counts := map[string]int{}
-	for _, term := range Tokenize(tokenization, in) {
+	for _, term := range terms {
 		counts[term]++
 	}
 
@@ -79,6 +79,34 @@ func TestTokenize(t *testing.T) {
 	})
 }
 
+func TestTokenizeWithOffsets(t *testing.T) {
+	input := " Hello You*-beautiful_world?! "
+
+	t.Run("same terms as tokenize", func(t *testing.T) {
+		for _, tokenization := range Tokenizations {
+			tokens := TokenizeWithOffsets(tokenization, input)
+			terms := make([]string, len(tokens))
+			for i := range tokens {
+				terms[i] = tokens[i].Term
+			}
+			assert.Equal(t, Tokenize(tokenization, input), terms, tokenization)
+		}
+	})
+
+	t.Run("offsets are in runes", func(t *testing.T) {
+		tokens := TokenizeWithOffsets(models.PropertyTokenizationWord, "Grüße, Welt")
+		assert.Equal(t, []Token{
+			{Term: "grüße", Start: 0, End: 5},
+			{Term: "welt", Start: 7, End: 11},
+		}, tokens)
+	})
+
+	t.Run("field is trimmed", func(t *testing.T) {
+		tokens := TokenizeWithOffsets(models.PropertyTokenizationField, input)
+		assert.Equal(t, []Token{{Term: "Hello You*-beautiful_world?!", Start: 1, End: 29}}, tokens)
+	})
+}
+
 func TestTokenizeAndCountDuplicates(t *testing.T) {
 	input := "Hello You Beautiful World! hello you beautiful world!"
 
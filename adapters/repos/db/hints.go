@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const (
+	hintsDir    = "replica_hints"
+	hintsBucket = "hints"
+	// number of hints read per cursor, the cursor is not held while the
+	// hints are sent
+	hintsReplayBatchSize = 100
+)
+
+// HintedHandoff keeps writes which replicas missed while they were
+// unreachable and replays them once they are back, see replica.Hint. A
+// zero ReplayInterval disables it.
+type HintedHandoff struct {
+	ReplayInterval time.Duration
+	// MaxAge after which hints are dropped instead of replayed
+	MaxAge time.Duration
+}
+
+// hintStore keeps the hints of a node in a dedicated bucket. Keys start with
+// the name of the node which missed the write followed by the time the hint
+// was added, so the hints of a node are replayed in the order of the writes.
+//
+// Hints contain the objects of the writes, so with encryption at rest they
+// are sealed with the key of the shard they belong to. Deleting the key of a
+// tenant makes its hints unreadable, they are dropped on replay.
+type hintStore struct {
+	store   *lsmkv.Store
+	bucket  *lsmkv.Bucket
+	keys    hintKeys // nil if encryption at rest is disabled
+	counter atomic.Uint64
+	metrics *prometheus.CounterVec // nil without monitoring
+}
+
+// hintKeys returns the encryption of a shard
+type hintKeys func(ctx context.Context, class, shard string) (*diskio.Encryption, error)
+
+// storedHint is the value hints are stored as if they are encrypted. Class
+// and shard are needed to look up the key.
+type storedHint struct {
+	Class  string `json:"class"`
+	Shard  string `json:"shard"`
+	Sealed []byte `json:"sealed"`
+}
+
+func newHintStore(ctx context.Context, rootPath string, keys hintKeys,
+	logger logrus.FieldLogger, promMetrics *monitoring.PrometheusMetrics,
+) (*hintStore, error) {
+	store, err := lsmkv.New(path.Join(rootPath, hintsDir), rootPath, logger, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init hints store: %w", err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, hintsBucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("create hints bucket: %w", err)
+	}
+
+	h := &hintStore{store: store, bucket: store.Bucket(hintsBucket), keys: keys}
+	if promMetrics != nil {
+		h.metrics = promMetrics.ReplicaHints
+	}
+	return h, nil
+}
+
+// AddHints implements replica.Hinter
+func (h *hintStore) AddHints(hints []replica.Hint) error {
+	ctx := context.Background()
+	now := time.Now().UnixNano()
+	for _, hint := range hints {
+		data, err := h.marshal(ctx, hint)
+		if err != nil {
+			return err
+		}
+		if err := h.bucket.Put(h.key(hint.Node, now), data); err != nil {
+			return fmt.Errorf("store hint: %w", err)
+		}
+		h.count(hint.Class, "stored")
+	}
+	return nil
+}
+
+func (h *hintStore) marshal(ctx context.Context, hint replica.Hint) ([]byte, error) {
+	data, err := json.Marshal(hint)
+	if err != nil {
+		return nil, fmt.Errorf("marshal hint: %w", err)
+	}
+	if h.keys == nil {
+		return data, nil
+	}
+
+	enc, err := h.keys(ctx, hint.Class, hint.Shard)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt hint: %w", err)
+	}
+	data, err = json.Marshal(storedHint{
+		Class:  hint.Class,
+		Shard:  hint.Shard,
+		Sealed: enc.Seal(data),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal hint: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshal decodes encrypted hints as well as plain ones, which were stored
+// before encryption at rest was enabled
+func (h *hintStore) unmarshal(ctx context.Context, data []byte, hint *replica.Hint) error {
+	var stored storedHint
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	if stored.Sealed == nil {
+		return json.Unmarshal(data, hint)
+	}
+
+	hint.Class, hint.Shard = stored.Class, stored.Shard
+	if h.keys == nil {
+		return fmt.Errorf("hint is encrypted, but encryption at rest is disabled")
+	}
+	enc, err := h.keys(ctx, stored.Class, stored.Shard)
+	if err != nil {
+		return fmt.Errorf("decrypt hint: %w", err)
+	}
+	data, err = enc.Unseal(stored.Sealed)
+	if err != nil {
+		return fmt.Errorf("decrypt hint: %w", err)
+	}
+	return json.Unmarshal(data, hint)
+}
+
+func (h *hintStore) key(node string, now int64) []byte {
+	key := make([]byte, len(node)+17)
+	copy(key, node)
+	binary.BigEndian.PutUint64(key[len(node)+1:], uint64(now))
+	binary.BigEndian.PutUint64(key[len(node)+9:], h.counter.Add(1))
+	return key
+}
+
+func (h *hintStore) count(class, result string) {
+	if h.metrics != nil {
+		h.metrics.With(prometheus.Labels{"class_name": class, "result": result}).Inc()
+	}
+}
+
+// nodes returns the names of all nodes which have hints
+func (h *hintStore) nodes() []string {
+	cursor := h.bucket.Cursor()
+	defer cursor.Close()
+
+	var nodes []string
+	for k, _ := cursor.First(); k != nil; {
+		end := bytes.IndexByte(k, 0)
+		if end < 0 {
+			k, _ = cursor.Next()
+			continue
+		}
+		nodes = append(nodes, string(k[:end]))
+		// skip the remaining hints of the node
+		next := append([]byte{}, k[:end]...)
+		k, _ = cursor.Seek(append(next, 1))
+	}
+	return nodes
+}
+
+type hintEntry struct {
+	key  []byte
+	hint replica.Hint
+	err  error // the hint could not be decoded
+}
+
+// next returns the oldest hints of the node
+func (h *hintStore) next(ctx context.Context, node string, limit int) []hintEntry {
+	cursor := h.bucket.Cursor()
+	defer cursor.Close()
+
+	prefix := append([]byte(node), 0)
+	var entries []hintEntry
+	for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) &&
+		len(entries) < limit; k, v = cursor.Next() {
+		e := hintEntry{key: append([]byte{}, k...)}
+		e.err = h.unmarshal(ctx, v, &e.hint)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func (h *hintStore) delete(key []byte) error {
+	return h.bucket.Delete(key)
+}
+
+func (h *hintStore) shutdown(ctx context.Context) error {
+	return h.store.Shutdown(ctx)
+}
+
+// hinter returns the store of hints for replicators, nil if hinted handoff
+// is disabled
+func (db *DB) hinter() replica.Hinter {
+	if db.hints == nil {
+		return nil
+	}
+	return db.hints
+}
+
+// hintEncryption returns the encryption of the shard a hint belongs to
+func (db *DB) hintEncryption(ctx context.Context, class, shard string) (*diskio.Encryption, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+	return index.encryption(ctx, shard)
+}
+
+// replayHints sends the hints of all nodes to them, until a node turns out
+// to be still unreachable
+func (db *DB) replayHints() {
+	ctx := context.Background()
+	for _, node := range db.hints.nodes() {
+		if err := db.replayNodeHints(ctx, node); err != nil {
+			db.logger.WithField("action", "replay_hints").
+				WithField("node", node).WithError(err).
+				Debug("node still unreachable")
+		}
+	}
+}
+
+func (db *DB) replayNodeHints(ctx context.Context, node string) error {
+	maxAge := db.config.HintedHandoff.MaxAge
+	for {
+		entries := db.hints.next(ctx, node, hintsReplayBatchSize)
+		if len(entries) == 0 {
+			return nil
+		}
+
+		for _, e := range entries {
+			if err := db.replayHint(ctx, e, maxAge); err != nil {
+				return err
+			}
+			if err := db.hints.delete(e.key); err != nil {
+				return fmt.Errorf("delete hint: %w", err)
+			}
+		}
+	}
+}
+
+// replayHint returns an error if the hint must be kept, because its node
+// could not be reached
+func (db *DB) replayHint(ctx context.Context, e hintEntry, maxAge time.Duration) error {
+	h := e.hint
+	l := db.logger.WithField("action", "replay_hints").
+		WithField("node", h.Node).
+		WithField("class", h.Class).
+		WithField("shard", h.Shard)
+
+	if e.err != nil {
+		l.WithError(e.err).Warn("drop malformed hint")
+		return nil
+	}
+	if age := time.Since(time.UnixMilli(h.Created)); age > maxAge {
+		l.WithField("age", age).Warn("drop expired hint")
+		db.hints.count(h.Class, "expired")
+		return nil
+	}
+	index := db.GetIndex(schema.ClassName(h.Class))
+	if index == nil { // the class was deleted in the meantime
+		return nil
+	}
+
+	err := index.replicator.ReplayHint(ctx, h)
+	var rerr *replica.Error
+	switch {
+	case err == nil:
+		db.hints.count(h.Class, "replayed")
+		return nil
+	case errors.As(err, &rerr):
+		l.WithError(err).Warn("hint rejected by replica")
+		db.hints.count(h.Class, "rejected")
+		return nil
+	default:
+		return err
+	}
+}
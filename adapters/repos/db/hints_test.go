@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestHintStore(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	store, err := newHintStore(ctx, t.TempDir(), nil, logger, nil)
+	require.Nil(t, err)
+	defer store.shutdown(ctx)
+
+	now := time.Now().UnixMilli()
+	hint := func(node, id string) replica.Hint {
+		return replica.Hint{
+			Node: node, Class: "C1", Shard: "S1", Created: now,
+			Op: replica.HintDeleteObject, ID: strfmt.UUID("00000000-0000-0000-0000-00000000000" + id),
+		}
+	}
+	require.Nil(t, store.AddHints([]replica.Hint{hint("node-2", "1"), hint("node-1", "1")}))
+	require.Nil(t, store.AddHints([]replica.Hint{hint("node-1", "2")}))
+	require.Nil(t, store.AddHints([]replica.Hint{hint("node-1", "3"), hint("node-10", "1")}))
+
+	t.Run("nodes", func(t *testing.T) {
+		assert.Equal(t, []string{"node-1", "node-10", "node-2"}, store.nodes())
+	})
+
+	t.Run("hints of a node in order", func(t *testing.T) {
+		entries := store.next(ctx, "node-1", 2)
+		require.Len(t, entries, 2)
+		assert.Nil(t, entries[0].err)
+		assert.Equal(t, hint("node-1", "1"), entries[0].hint)
+		assert.Equal(t, hint("node-1", "2"), entries[1].hint)
+
+		require.Nil(t, store.delete(entries[0].key))
+		entries = store.next(ctx, "node-1", 10)
+		require.Len(t, entries, 2)
+		assert.Equal(t, hint("node-1", "2"), entries[0].hint)
+		assert.Equal(t, hint("node-1", "3"), entries[1].hint)
+	})
+
+	t.Run("hints of unknown classes and expired hints are dropped", func(t *testing.T) {
+		db := &DB{
+			logger:  logger,
+			indices: map[string]*Index{},
+			hints:   store,
+			config:  Config{HintedHandoff: HintedHandoff{MaxAge: time.Hour}},
+		}
+		expired := hint("node-3", "1")
+		expired.Created = time.Now().Add(-2 * time.Hour).UnixMilli()
+		require.Nil(t, store.AddHints([]replica.Hint{expired}))
+
+		db.replayHints()
+		assert.Empty(t, store.nodes())
+	})
+}
+
+func TestHintStore_Encrypted(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	enc, err := diskio.NewEncryption(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	keys := func(ctx context.Context, class, shard string) (*diskio.Encryption, error) {
+		if shard != "S1" {
+			return nil, fmt.Errorf("no key for shard %q", shard)
+		}
+		return enc, nil
+	}
+
+	// a hint stored before encryption at rest was enabled
+	plain, err := newHintStore(ctx, dir, nil, logger, nil)
+	require.Nil(t, err)
+	legacy := replica.Hint{
+		Node: "node-1", Class: "C1", Shard: "S1", Created: time.Now().UnixMilli(),
+		Op: replica.HintDeleteObject, ID: "00000000-0000-0000-0000-000000000001",
+	}
+	require.Nil(t, plain.AddHints([]replica.Hint{legacy}))
+	require.Nil(t, plain.shutdown(ctx))
+
+	store, err := newHintStore(ctx, dir, keys, logger, nil)
+	require.Nil(t, err)
+	defer store.shutdown(ctx)
+
+	hint := replica.Hint{
+		Node: "node-1", Class: "C1", Shard: "S1", Created: time.Now().UnixMilli(),
+		Op: replica.HintPutObjects, Objects: [][]byte{[]byte("a xylophonist object")},
+	}
+	require.Nil(t, store.AddHints([]replica.Hint{hint}))
+
+	t.Run("hints are not stored in plain text", func(t *testing.T) {
+		cursor := store.bucket.Cursor()
+		defer cursor.Close()
+		n := 0
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if n == 1 {
+				assert.NotContains(t, string(v), "xylophonist")
+			}
+			n++
+		}
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("plain and encrypted hints are read", func(t *testing.T) {
+		entries := store.next(ctx, "node-1", 10)
+		require.Len(t, entries, 2)
+		assert.Nil(t, entries[0].err)
+		assert.Equal(t, legacy, entries[0].hint)
+		require.Nil(t, entries[1].err)
+		assert.Equal(t, hint, entries[1].hint)
+	})
+
+	t.Run("hints without a key cannot be read", func(t *testing.T) {
+		other := hint
+		other.Node, other.Shard = "node-2", "S2"
+		require.NotNil(t, store.AddHints([]replica.Hint{other}))
+
+		require.Nil(t, store.bucket.Put(store.key("node-2", 1), mustMarshal(t, storedHint{
+			Class: "C1", Shard: "S2", Sealed: enc.Seal([]byte("{}")),
+		})))
+		entries := store.next(ctx, "node-2", 10)
+		require.Len(t, entries, 1)
+		assert.ErrorContains(t, entries[0].err, "no key")
+	})
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	data, err := json.Marshal(v)
+	require.Nil(t, err)
+	return data
+}
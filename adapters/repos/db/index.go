@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -35,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/autocut"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -46,6 +48,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/traverser"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -96,6 +99,11 @@ func (m *shardMap) CompareAndSwap(name string, old, new *Shard) bool {
 	return (*sync.Map)(m).CompareAndSwap(name, old, new)
 }
 
+// CompareAndDelete deletes the shard for a key if it is equal to old.
+func (m *shardMap) CompareAndDelete(name string, old *Shard) bool {
+	return (*sync.Map)(m).CompareAndDelete(name, old)
+}
+
 // LoadAndDelete deletes the value for a key, returning the previous value if any.
 // The loaded result reports whether the key was present.
 func (m *shardMap) LoadAndDelete(name string) (*Shard, bool) {
@@ -141,15 +149,57 @@ type Index struct {
 	dropIndex sync.RWMutex
 
 	metrics         *Metrics
+	promMetrics     *monitoring.PrometheusMetrics
 	centralJobQueue chan job
 
 	partitioningEnabled bool
+	// storageName is the class name the files of the index were created
+	// under, it only differs from the class name once the class was renamed
+	storageName string
+	// tenants tracks the activity of local tenants, so idle tenants can be
+	// offloaded
+	tenants *tenantLifecycle
+	// propertyMigrations are the renamed and dropped properties whose
+	// objects are not migrated yet
+	propertyMigrations *propertyMigrations
+
+	schemaReads *schemaUC.ReadCache[*classReads]
 }
 
 func (i *Index) ID() string {
 	return indexID(i.Config.ClassName)
 }
 
+// storageID is the prefix of all files of the index. Renaming a class keeps
+// the files where they are, so it can differ from ID.
+func (i *Index) storageID() string {
+	if i.storageName == "" {
+		return i.ID()
+	}
+	return indexID(schema.ClassName(i.storageName))
+}
+
+// renamed reports whether the objects of the index may still be stored
+// under a previous class name
+func (i *Index) renamed() bool {
+	return i.storageID() != i.ID()
+}
+
+// withCurrentNames sets the class and the property names of objects which
+// were stored before the class or one of its properties was renamed. They
+// are rewritten with the current names on their next update.
+func (i *Index) withCurrentNames(objs ...*storobj.Object) {
+	i.withCurrentProperties(objs...)
+	if !i.renamed() {
+		return
+	}
+	for _, obj := range objs {
+		if obj != nil {
+			obj.Object.Class = i.Config.ClassName.String()
+		}
+	}
+}
+
 type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 }
@@ -170,7 +220,7 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	}
 
 	repl := replica.NewReplicator(config.ClassName.String(),
-		sg, nodeResolver, replicaClient, logger)
+		sg, nodeResolver, replicaClient, config.Hints, logger)
 
 	index := &Index{
 		Config:                config,
@@ -184,8 +234,21 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
+		promMetrics:         promMetrics,
 		centralJobQueue:     jobQueueCh,
 		partitioningEnabled: shardState.PartitioningEnabled,
+		storageName:         shardState.IndexID,
+		schemaReads:         schemaUC.NewReadCache[*classReads](config.CacheSchemaReads),
+	}
+
+	index.tenants, err = newTenantLifecycle(config.RootPath, index.storageID(), promMetrics)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create new index")
+	}
+
+	index.propertyMigrations, err = loadPropertyMigrations(config.RootPath, index.storageID(), class)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create new index")
 	}
 
 	if err := index.checkSingleShardMigration(shardState); err != nil {
@@ -199,12 +262,20 @@ func NewIndex(ctx context.Context, config IndexConfig,
 			continue
 		}
 
+		if index.partitioningEnabled && index.tenants.offloaded(shardName) {
+			// offloaded tenants are loaded on first use
+			continue
+		}
+
 		shard, err := NewShard(ctx, promMetrics, shardName, index, class, jobQueueCh)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %s of index %s", shardName, index.ID())
 		}
 
 		index.shards.Store(shardName, shard)
+		if index.partitioningEnabled {
+			index.tenants.get(shardName, true)
+		}
 	}
 
 	return index, nil
@@ -214,6 +285,7 @@ func NewIndex(ctx context.Context, config IndexConfig,
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard *Shard, object *storobj.Object) error) (err error) {
 	return i.ForEachShard(func(_ string, shard *Shard) error {
 		wrapper := func(object *storobj.Object) error {
+			i.withCurrentNames(object)
 			return cb(i, shard, object)
 		}
 		bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
@@ -233,6 +305,17 @@ func (i *Index) IterateShards(ctx context.Context, cb func(index *Index, shard *
 }
 
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
+	if i.propertyMigrations.conflicting(prop.Name) {
+		// a property of the same name was dropped or renamed, the objects of
+		// all tenants are migrated first so they do not get its values
+		if err := i.activateAllTenants(ctx); err != nil {
+			return errors.Wrap(err, "activate tenants")
+		}
+		if err := i.migrateProperties(ctx); err != nil {
+			return errors.Wrapf(err, "extend idx '%s' with property '%s", i.ID(), prop.Name)
+		}
+	}
+
 	eg := &errgroup.Group{}
 	eg.SetLimit(_NUMCPU)
 
@@ -246,6 +329,22 @@ func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
 	return nil
 }
 
+func (i *Index) reindexProperty(ctx context.Context, propName string) error {
+	// offloaded tenants would otherwise keep the buckets of the old tokenization
+	if err := i.activateAllTenants(ctx); err != nil {
+		return errors.Wrap(err, "activate tenants")
+	}
+
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		reindexer := NewShardInvertedReindexer(shard, i.logger)
+		reindexer.AddTask(newShardInvertedReindexTaskPropertyTokenization(propName))
+		if err := reindexer.Do(ctx); err != nil {
+			return errors.Wrapf(err, "reindex property %q of shard %q", propName, name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) addUUIDProperty(ctx context.Context) error {
 	return i.ForEachShard(func(name string, shard *Shard) error {
 		err := shard.addIDProperty(ctx)
@@ -303,6 +402,9 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
 
+	if err := i.stopwords.Reload(updated.Stopwords); err != nil {
+		return errors.Wrap(err, "reload stopwords")
+	}
 	i.invertedIndexConfig = updated
 
 	return nil
@@ -319,6 +421,26 @@ type IndexConfig struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	ReplicationFactor         int64
+	SegmentTiering            *lsmkv.Tiering
+	FilterCacheMaxEntries     int
+	DistanceMetrics           DistanceMetricProvider
+	AsyncIndexing             bool
+	AsyncIndexingWorkers      int
+	TenantOffloading          TenantOffloading
+	Hints                     replica.Hinter // nil if hinted handoff is disabled
+	KeyManager                modulecapabilities.KeyManager
+	PerTenantKeys             bool
+	// CacheSchemaReads is set if the DB receives schema change notifications
+	CacheSchemaReads bool
+	QueryPlanner     *traverser.QueryPlanner // nil if the query planner is disabled
+	PropertyStats    bool
+	// VectorOnly is set for classes which only store the vectors of their
+	// objects, the shards don't build any inverted index
+	VectorOnly bool
+	// ShardCircuitBreaker configures the health tracking of the shards
+	ShardCircuitBreaker config.ShardCircuitBreaker
+	// ShardChangeRecorders are notified about every write to the shards
+	ShardChangeRecorders []ChangeRecorder
 
 	TrackVectorDimensions bool
 }
@@ -327,6 +449,17 @@ func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
+// recordChange notifies the shard change recorders about a write to the
+// object id of tenant, or about the whole tenant if id is empty
+func (i *Index) recordChange(tenant string, id strfmt.UUID) {
+	if !i.partitioningEnabled {
+		tenant = ""
+	}
+	for _, r := range i.Config.ShardChangeRecorders {
+		r.RecordChange(i.Config.ClassName.String(), tenant, id)
+	}
+}
+
 func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
 	className := i.Config.ClassName.String()
 	if tenant != "" {
@@ -346,6 +479,9 @@ func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, err
 		return "", fmt.Errorf("marshal uuid: %q", id.String())
 	}
 
+	if ss := i.classReads().shardingState; ss != nil {
+		return ss.PhysicalShard(uuidBytes), nil
+	}
 	return i.getSchema.ShardFromUUID(className, uuidBytes), nil
 }
 
@@ -367,6 +503,11 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
+	if sharding.IsSharedShardName(shardName) {
+		if err := i.claimSharedObjects(ctx, shardName, []*storobj.Object{object})[0]; err != nil {
+			return err
+		}
+	}
 
 	if i.replicationEnabled() {
 		if replProps == nil {
@@ -376,7 +517,20 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 		if err := i.replicator.PutObject(ctx, shardName, object, cl); err != nil {
 			return fmt.Errorf("replicate insertion: shard=%q: %w", shardName, err)
 		}
-	} else if shard := i.localShard(shardName); shard != nil {
+		replica.RecordWrite(ctx, replica.TokenWrite{
+			Class: i.Config.ClassName.String(), Shard: shardName,
+			ID: object.ID(), UpdateTime: object.LastUpdateTimeUnix(),
+		})
+		return nil
+	}
+
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if shard != nil {
 		if err := shard.putObject(ctx, object); err != nil {
 			return fmt.Errorf("put local object: shard=%q: %w", shardName, err)
 		}
@@ -394,7 +548,11 @@ func (i *Index) IncomingPutObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard := i.localShard(shardName)
+	localShard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
 	if localShard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -533,6 +691,21 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 		group.pos = append(group.pos, pos)
 		byShard[shardName] = group
 	}
+	for shardName, group := range byShard {
+		if !sharding.IsSharedShardName(shardName) {
+			continue
+		}
+		var claimed objsAndPos
+		for j, err := range i.claimSharedObjects(ctx, shardName, group.objects) {
+			if err != nil {
+				out[group.pos[j]] = err
+				continue
+			}
+			claimed.objects = append(claimed.objects, group.objects[j])
+			claimed.pos = append(claimed.pos, group.pos[j])
+		}
+		byShard[shardName] = claimed
+	}
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -550,8 +723,12 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 			if replProps != nil {
 				errs = i.replicator.PutObjects(ctx, shardName, group.objects,
 					replica.ConsistencyLevel(replProps.ConsistencyLevel))
-			} else if shard := i.localShard(shardName); shard != nil {
+				i.recordBatchWrite(ctx, shardName, group.objects, errs)
+			} else if shard, release, err := i.getShard(ctx, shardName); err != nil {
+				errs = duplicateErr(err, len(group.objects))
+			} else if shard != nil {
 				errs = shard.putObjectBatch(ctx, group.objects)
+				release()
 			} else {
 				errs = i.remote.BatchPutObjects(ctx, shardName, group.objects)
 			}
@@ -567,6 +744,47 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 	return out
 }
 
+// recordBatchWrite adds the most recent successfully replicated object of a
+// batch to the consistency token of the request, if one is being recorded
+func (i *Index) recordBatchWrite(ctx context.Context, shardName string,
+	objs []*storobj.Object, errs []error,
+) {
+	var latest *storobj.Object
+	for pos, obj := range objs {
+		if pos < len(errs) && errs[pos] != nil {
+			continue
+		}
+		if latest == nil || obj.LastUpdateTimeUnix() > latest.LastUpdateTimeUnix() {
+			latest = obj
+		}
+	}
+	if latest == nil {
+		return
+	}
+	replica.RecordWrite(ctx, replica.TokenWrite{
+		Class: i.Config.ClassName.String(), Shard: shardName,
+		ID: latest.ID(), UpdateTime: latest.LastUpdateTimeUnix(),
+	})
+}
+
+// tokenReplica returns the node whose replica of the shard has applied all
+// writes of the request's consistency token. An empty name means no token
+// applies and the shard can be served as usual.
+func (i *Index) tokenReplica(ctx context.Context, shardName string) (string, error) {
+	if !i.replicationEnabled() {
+		return "", nil
+	}
+	token, ok := replica.TokenFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	writes := token.ForShard(i.Config.ClassName.String(), shardName)
+	if len(writes) == 0 {
+		return "", nil
+	}
+	return i.replicator.ReplicaWithWrites(ctx, shardName, writes)
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -581,7 +799,11 @@ func (i *Index) IncomingBatchPutObjects(ctx context.Context, shardName string,
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard := i.shards.Load(shardName)
+	localShard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return duplicateErr(err, len(objects))
+	}
+	defer release()
 	if localShard == nil {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(objects))
@@ -643,8 +865,11 @@ func (i *Index) addReferencesBatch(ctx context.Context, refs objects.BatchRefere
 			}
 			errs = i.replicator.AddReferences(ctx, shardName, group.refs,
 				replica.ConsistencyLevel(replProps.ConsistencyLevel))
-		} else if shard := i.localShard(shardName); shard != nil {
+		} else if shard, release, err := i.getShard(ctx, shardName); err != nil {
+			errs = duplicateErr(err, len(group.refs))
+		} else if shard != nil {
 			errs = shard.addReferencesBatch(ctx, group.refs)
+			release()
 		} else {
 			errs = i.remote.BatchAddReferences(ctx, shardName, group.refs)
 		}
@@ -662,7 +887,11 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard := i.shards.Load(shardName)
+	localShard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return duplicateErr(err, len(refs))
+	}
+	defer release()
 	if localShard == nil {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(refs))
@@ -701,10 +930,20 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 			obj, err = i.replicator.GetOne(ctx,
 				replica.ConsistencyLevel(replProps.ConsistencyLevel), shardName, id, props, addl)
 		}
+		if i.foreignObject(obj, tenant) {
+			return nil, err
+		}
+		i.withCurrentNames(obj)
 		return obj, err
 	}
 
-	if shard := i.localShard(shardName); shard != nil {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if shard != nil {
 		obj, err = shard.objectByID(ctx, id, props, addl)
 		if err != nil {
 			return obj, fmt.Errorf("get local object: shard=%s: %w", shardName, err)
@@ -716,6 +955,10 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
+	if i.foreignObject(obj, tenant) {
+		return nil, nil
+	}
+	i.withCurrentNames(obj)
 	return obj, nil
 }
 
@@ -723,7 +966,11 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
 ) (*storobj.Object, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -739,12 +986,16 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID,
 ) ([]*storobj.Object, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
-	objs, err := shard.multiObjectByID(ctx, wrapIDsInMulti(ids))
+	objs, err := shard.multiObjectByID(ctx, wrapIDsInMulti(ids), additional.Properties{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -753,7 +1004,7 @@ func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 }
 
 func (i *Index) multiObjectByID(ctx context.Context,
-	query []multi.Identifier, tenant string,
+	query []multi.Identifier, addl additional.Properties, tenant string,
 ) ([]*storobj.Object, error) {
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, err
@@ -782,10 +1033,15 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	for shardName, group := range byShard {
 
 		var objects []*storobj.Object
-		var err error
 
-		if shard := i.localShard(shardName); shard != nil {
-			objects, err = shard.multiObjectByID(ctx, group.ids)
+		shard, release, err := i.getShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+
+		if shard != nil {
+			objects, err = shard.multiObjectByID(ctx, group.ids, addl)
+			release()
 			if err != nil {
 				return nil, errors.Wrapf(err, "shard %s", shard.ID())
 			}
@@ -796,12 +1052,15 @@ func (i *Index) multiObjectByID(ctx context.Context,
 			}
 		}
 
-		for i, obj := range objects {
-			desiredPos := group.pos[i]
-			out[desiredPos] = obj
+		for j, obj := range objects {
+			if i.foreignObject(obj, tenant) {
+				continue
+			}
+			out[group.pos[j]] = obj
 		}
 	}
 
+	i.withCurrentNames(out...)
 	return out, nil
 }
 
@@ -841,6 +1100,11 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 			return false, objects.NewErrInvalidUserInput("determine shard: %v", err)
 		}
 	}
+	if sharding.IsSharedShardName(shardName) {
+		// only the object tells which tenant of the shard it belongs to
+		obj, err := i.objectByID(ctx, id, nil, additional.Properties{}, replProps, tenant)
+		return obj != nil, err
+	}
 
 	var exists bool
 	if i.replicationEnabled() {
@@ -851,7 +1115,13 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		return i.replicator.Exists(ctx, cl, shardName, id)
 
 	}
-	if shard := i.localShard(shardName); shard != nil {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	if shard != nil {
 		exists, err = shard.exists(ctx, id)
 		if err != nil {
 			err = fmt.Errorf("exists locally: shard=%q: %w", shardName, err)
@@ -868,7 +1138,11 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	id strfmt.UUID,
 ) (bool, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return false, err
+	}
+	defer release()
 	if shard == nil {
 		return false, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -884,10 +1158,13 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, replProps *additional.ReplicationProperties, tenant string, autoCut int,
-) ([]*storobj.Object, []float32, error) {
+) (objs []*storobj.Object, scores []float32, err error) {
+	defer func() { i.withCurrentNames(objs...) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
+	filters = i.tenantFilter(filters, tenant)
 
 	shardNames, err := i.targetShardNames(tenant)
 	if err != nil || len(shardNames) == 0 {
@@ -897,22 +1174,8 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	// If the request is a BM25F with no properties selected, use all possible properties
 	if keywordRanking != nil && keywordRanking.Type == "bm25" && len(keywordRanking.Properties) == 0 {
 
-		cl, err := schema.GetClassByName(
-			i.getSchema.GetSchemaSkipAuth().Objects,
-			i.Config.ClassName.String())
-		if err != nil {
-			return nil, nil, err
-		}
-
-		propHash := cl.Properties
-		// Get keys of hash
-		for _, v := range propHash {
-			if inverted.PropertyHasSearchableIndex(i.getSchema.GetSchemaSkipAuth().Objects,
-				i.Config.ClassName.String(), v.Name) {
-
-				keywordRanking.Properties = append(keywordRanking.Properties, v.Name)
-			}
-		}
+		keywordRanking.Properties = append(keywordRanking.Properties,
+			i.classReads().searchableProps...)
 
 		// WEAVIATE-471 - error if we can't find a property to search
 		if len(keywordRanking.Properties) == 0 {
@@ -921,6 +1184,12 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		}
 	}
 
+	// paginating filtered or sorted results requires every object to have a
+	// unique position, the ID breaks ties
+	if cursor != nil && (filters != nil || len(sort) > 0) {
+		sort = cursorSort(sort)
+	}
+
 	outObjects, outScores, err := i.objectSearchByShard(ctx, limit,
 		filters, keywordRanking, sort, cursor, addlProps, shardNames)
 	if err != nil {
@@ -992,6 +1261,12 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		outObjects = outObjects[:limit]
 	}
 
+	if cursor != nil && !addlProps.ReferenceQuery {
+		if err := i.addCursors(outObjects, sort); err != nil {
+			return nil, nil, errors.Wrap(err, "cursor")
+		}
+	}
+
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency(replica.One)
@@ -1022,10 +1297,41 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 		eg.Go(func() error {
 			var objs []*storobj.Object
 			var scores []float32
-			var err error
 
-			if shard := i.localShard(shardName); shard != nil {
+			node, err := i.tokenReplica(ctx, shardName)
+			if err != nil {
+				return fmt.Errorf("shard %s: %w", shardName, err)
+			}
+			if node == "" {
+				if node, err = i.degradedShardReplica(shardName); err != nil {
+					return fmt.Errorf("shard %s: %w", shardName, err)
+				}
+			}
+			if node != "" && node != i.getSchema.NodeName() {
+				objs, scores, err = i.remote.SearchShardOnNode(
+					ctx, node, shardName, nil, limit, filters, keywordRanking,
+					sort, cursor, nil, addlProps, true)
+				if err != nil {
+					return fmt.Errorf(
+						"remote shard object search %s: %w", shardName, err)
+				}
+				shardResultLock.Lock()
+				resultObjects = append(resultObjects, objs...)
+				resultScores = append(resultScores, scores...)
+				shardResultLock.Unlock()
+				return nil
+			}
+
+			shard, release, err := i.getShard(ctx, shardName)
+			if err != nil {
+				return err
+			}
+			defer release()
+
+			if shard != nil {
+				start := time.Now()
 				objs, scores, err = shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, addlProps)
+				shard.health.observeRead(start, err)
 				if err != nil {
 					return fmt.Errorf(
 						"local shard object search %s: %w", shard.ID(), err)
@@ -1113,6 +1419,38 @@ func (i *Index) sort(objects []*storobj.Object, scores []float32,
 		Sort(objects, scores, limit, sort)
 }
 
+// addCursors stores the position of every object in its additional
+// properties, so clients can continue paginating after any of them.
+// Unsorted results are ordered by ID, which is their position.
+func (i *Index) addCursors(objects []*storobj.Object, sort []filters.Sort) error {
+	var cursors []string
+	if len(sort) > 0 {
+		var err error
+		cursors, err = sorter.NewObjectsSorter(i.getSchema.GetSchemaSkipAuth()).
+			Cursors(objects, sort)
+		if err != nil {
+			return err
+		}
+	}
+
+	for pos, obj := range objects {
+		if obj.AdditionalProperties() == nil {
+			obj.Object.Additional = make(map[string]interface{})
+		}
+		if cursors != nil {
+			obj.Object.Additional["cursor"] = cursors[pos]
+		} else {
+			obj.Object.Additional["cursor"] = obj.ID().String()
+		}
+	}
+	return nil
+}
+
+// cursorSort is used where a filters parameter shadows the package
+func cursorSort(sort []filters.Sort) []filters.Sort {
+	return filters.CursorSort(sort)
+}
+
 func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 	groupBy *searchparams.GroupBy, limit, shardCount int,
 ) ([]*storobj.Object, []float32, error) {
@@ -1122,11 +1460,12 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
 	dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
-	shardName string,
+	shard *Shard,
 ) ([]*storobj.Object, []float32, error) {
-	shard := i.shards.Load(shardName)
+	start := time.Now()
 	res, resDists, err := shard.objectVectorSearch(
 		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+	shard.health.observeRead(start, err)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1138,8 +1477,7 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 func (i *Index) targetShardNames(tenant string) ([]string, error) {
 	className := i.Config.ClassName.String()
 	if !i.partitioningEnabled {
-		shardingState := i.getSchema.CopyShardingState(className)
-		return shardingState.AllPhysicalShards(), nil
+		return i.classReads().physicalShards, nil
 	}
 	if tenant != "" {
 		if shard := i.getSchema.TenantShard(className, tenant); shard != "" {
@@ -1153,19 +1491,33 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
-) ([]*storobj.Object, []float32, error) {
+) (objs []*storobj.Object, scores []float32, err error) {
+	defer func() { i.withCurrentNames(objs...) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
+	filters = i.tenantFilter(filters, tenant)
 	shardNames, err := i.targetShardNames(tenant)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
 	}
 
 	if len(shardNames) == 1 {
-		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
-				sort, groupBy, additional, shardNames[0])
+		node, err := i.degradedShardReplica(shardNames[0])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shardNames[0])
+		}
+		if node == "" {
+			shard, release, err := i.getShard(ctx, shardNames[0])
+			if err != nil {
+				return nil, nil, err
+			}
+			if shard != nil {
+				defer release()
+				return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
+					sort, groupBy, additional, shard)
+			}
 		}
 	}
 
@@ -1189,11 +1541,41 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 		eg.Go(func() error {
 			var res []*storobj.Object
 			var resDists []float32
-			var err error
 
-			if shard := i.localShard(shardName); shard != nil {
+			node, err := i.tokenReplica(ctx, shardName)
+			if err != nil {
+				return errors.Wrapf(err, "shard %s", shardName)
+			}
+			if node == "" {
+				if node, err = i.degradedShardReplica(shardName); err != nil {
+					return errors.Wrapf(err, "shard %s", shardName)
+				}
+			}
+			if node != "" && node != i.getSchema.NodeName() {
+				res, resDists, err = i.remote.SearchShardOnNode(ctx, node,
+					shardName, searchVector, limit, filters,
+					nil, sort, nil, groupBy, additional, true)
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+				m.Lock()
+				out = append(out, res...)
+				dists = append(dists, resDists...)
+				m.Unlock()
+				return nil
+			}
+
+			shard, release, err := i.getShard(ctx, shardName)
+			if err != nil {
+				return err
+			}
+			defer release()
+
+			if shard != nil {
+				start := time.Now()
 				res, resDists, err = shard.objectVectorSearch(
 					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+				shard.health.observeRead(start, err)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1261,7 +1643,11 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -1307,7 +1693,20 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		if err := i.replicator.DeleteObject(ctx, shardName, id, cl); err != nil {
 			return fmt.Errorf("replicate deletion: shard=%q %w", shardName, err)
 		}
-	} else if shard := i.localShard(shardName); shard != nil {
+		replica.RecordWrite(ctx, replica.TokenWrite{
+			Class: i.Config.ClassName.String(), Shard: shardName,
+			ID: id, UpdateTime: time.Now().UnixMilli(), Deleted: true,
+		})
+		return nil
+	}
+
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if shard != nil {
 		if err := shard.deleteObject(ctx, id); err != nil {
 			return fmt.Errorf("delete local object: shard=%q: %w", shardName, err)
 		}
@@ -1322,20 +1721,20 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard := i.localShard(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
 	if shard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
 	if err := shard.deleteObject(ctx, id); err != nil {
-		return fmt.Errorf("shard %s", shard.ID())
+		return fmt.Errorf("shard %s: %w", shard.ID(), err)
 	}
 	return nil
 }
 
-func (i *Index) localShard(name string) *Shard {
-	return i.shards.Load(name)
-}
-
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	replProps *additional.ReplicationProperties, tenant string,
 ) error {
@@ -1359,10 +1758,20 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		if err := i.replicator.MergeObject(ctx, shardName, &merge, cl); err != nil {
 			return fmt.Errorf("replicate single update: %w", err)
 		}
+		replica.RecordWrite(ctx, replica.TokenWrite{
+			Class: i.Config.ClassName.String(), Shard: shardName,
+			ID: merge.ID, UpdateTime: merge.UpdateTime,
+		})
 		return nil
 	}
 
-	if shard := i.localShard(shardName); shard != nil {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if shard != nil {
 		if err := shard.mergeObject(ctx, merge); err != nil {
 			return fmt.Errorf("update local object: shard=%q: %w", shardName, err)
 		}
@@ -1381,12 +1790,16 @@ func (i *Index) IncomingMergeObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
 	if shard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
-	err := shard.mergeObject(ctx, mergeDoc)
+	err = shard.mergeObject(ctx, mergeDoc)
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1400,6 +1813,7 @@ func (i *Index) aggregate(ctx context.Context,
 	if err := i.validateMultiTenancy(params.Tenant); err != nil {
 		return nil, err
 	}
+	params.Filters = i.tenantFilter(params.Filters, params.Tenant)
 
 	shardNames, err := i.targetShardNames(params.Tenant)
 	if err != nil || len(shardNames) == 0 {
@@ -1408,10 +1822,14 @@ func (i *Index) aggregate(ctx context.Context,
 
 	results := make([]*aggregation.Result, len(shardNames))
 	for j, shardName := range shardNames {
-		var err error
 		var res *aggregation.Result
-		if shard := i.localShard(shardName); shard != nil {
+		shard, release, err := i.getShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		if shard != nil {
 			res, err = shard.aggregate(ctx, params)
+			release()
 		} else {
 			res, err = i.remote.Aggregate(ctx, shardName, params)
 		}
@@ -1428,7 +1846,11 @@ func (i *Index) aggregate(ctx context.Context,
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -1461,7 +1883,22 @@ func (i *Index) drop() error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
 
-	i.shards.Range(dropShard)
+	var dropped []string
+	i.shards.Range(func(name string, shard *Shard) error {
+		dropped = append(dropped, name)
+		return dropShard(name, shard)
+	})
+	names, _ := i.tenants.snapshot()
+	for _, name := range names {
+		i.dropOffloadedTenant(context.Background(), name)
+	}
+	i.deleteEncryptionKeys(context.Background(), append(dropped, names...), true)
+	if err := i.tenants.drop(); err != nil {
+		logrus.WithFields(fields).Error(err)
+	}
+	if err := i.propertyMigrations.drop(); err != nil {
+		logrus.WithFields(fields).Error(err)
+	}
 	return eg.Wait()
 }
 
@@ -1474,10 +1911,14 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 	defer i.backupStateLock.RUnlock()
 
 	// mark deleted shards
+	var offloaded []string
 	for _, name := range names {
 		prev, ok := i.shards.Swap(name, nil) // mark
 		if !ok {                             // shard doesn't exit
 			i.shards.LoadAndDelete(name) // rollback nil value created by swap()
+			if i.tenants.offloaded(name) {
+				offloaded = append(offloaded, name)
+			}
 			continue
 		}
 		if prev != nil {
@@ -1501,7 +1942,16 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 		// detach shards
 		for name := range shards {
 			i.shards.LoadAndDelete(name)
+			i.recordChange(name, "")
+			if err := i.tenants.remove(name); err != nil {
+				i.logger.WithField("action", "drop_shard").
+					WithField("shard", name).Error(err)
+			}
 		}
+		for _, name := range offloaded {
+			i.dropOffloadedTenant(context.Background(), name)
+		}
+		i.deleteEncryptionKeys(context.Background(), names, false)
 
 		// drop shards
 		for _, shard := range shards {
@@ -1543,10 +1993,10 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 			status, err = i.remote.GetShardStatus(ctx, shardName)
 		} else {
 			shard := i.shards.Load(shardName)
-			if shard == nil {
-				err = errors.Errorf("shard %s does not exist", shardName)
-			} else {
+			if shard != nil {
 				status = shard.getStatus().String()
+			} else if status = i.offloadedTenantStatus(shardName); status == "" {
+				err = errors.Errorf("shard %s does not exist", shardName)
 			}
 		}
 		if err != nil {
@@ -1562,20 +2012,33 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (string, error) {
 	shard := i.shards.Load(shardName)
 	if shard == nil {
+		if status := i.offloadedTenantStatus(shardName); status != "" {
+			return status, nil
+		}
 		return "", errors.Errorf("shard %q does not exist", shardName)
 	}
 	return shard.getStatus().String(), nil
 }
 
 func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	if shard := i.localShard(shardName); shard != nil {
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if shard != nil {
 		return shard.updateStatus(targetStatus)
 	}
 	return i.remote.UpdateShardStatus(ctx, shardName, targetStatus)
 }
 
 func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
 	if shard == nil {
 		return errors.Errorf("shard %s does not exist", shardName)
 	}
@@ -1598,6 +2061,7 @@ func (i *Index) findDocIDs(ctx context.Context,
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, err
 	}
+	filters = i.tenantFilter(filters, tenant)
 
 	shardNames, err := i.targetShardNames(tenant)
 	if err != nil {
@@ -1606,11 +2070,14 @@ func (i *Index) findDocIDs(ctx context.Context,
 
 	results := make(map[string][]uint64)
 	for _, shardName := range shardNames {
-		var err error
 		var res []uint64
-		if shard := i.localShard(shardName); shard != nil {
-			shard := i.shards.Load(shardName)
+		shard, release, err := i.getShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		if shard != nil {
 			res, err = shard.findDocIDs(ctx, filters)
+			release()
 		} else {
 			res, err = i.remote.FindDocIDs(ctx, shardName, filters)
 		}
@@ -1627,7 +2094,11 @@ func (i *Index) findDocIDs(ctx context.Context,
 func (i *Index) IncomingFindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -1666,8 +2137,11 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardDocIDs map[string][
 				}
 				objs = i.replicator.DeleteObjects(ctx, shardName, docIDs,
 					dryRun, replica.ConsistencyLevel(replProps.ConsistencyLevel))
-			} else if shard := i.localShard(shardName); shard != nil {
+			} else if shard, release, err := i.getShard(ctx, shardName); err != nil {
+				objs = objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+			} else if shard != nil {
 				objs = shard.deleteObjectBatch(ctx, docIDs, dryRun)
+				release()
 			} else {
 				objs = i.remote.DeleteObjectBatch(ctx, shardName, docIDs, dryRun)
 			}
@@ -1691,7 +2165,11 @@ func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 ) objects.BatchSimpleObjects {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+	defer release()
 	if shard == nil {
 		return objects.BatchSimpleObjects{
 			objects.BatchSimpleObject{Err: errors.Errorf("shard %q does not exist locally", shardName)},
@@ -1701,6 +2179,90 @@ func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	return shard.deleteObjectBatch(ctx, docIDs, dryRun)
 }
 
+func (i *Index) batchUpdateObjects(ctx context.Context, shardDocIDs map[string][]uint64,
+	update objects.MergeDocument, dryRun bool, replProps *additional.ReplicationProperties,
+) (objects.BatchSimpleObjects, error) {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	type result struct {
+		objs objects.BatchSimpleObjects
+	}
+
+	wg := &sync.WaitGroup{}
+	ch := make(chan result, len(shardDocIDs))
+	for shardName, docIDs := range shardDocIDs {
+		wg.Add(1)
+		go func(shardName string, docIDs []uint64) {
+			defer wg.Done()
+
+			var objs objects.BatchSimpleObjects
+			if shard, release, err := i.getShard(ctx, shardName); err != nil {
+				objs = objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+			} else if shard != nil {
+				objs = i.updateObjectBatch(ctx, shard, docIDs, update, dryRun, replProps)
+				release()
+			} else {
+				objs = i.remote.UpdateObjectBatch(ctx, shardName, docIDs, update, dryRun)
+			}
+			ch <- result{objs}
+		}(shardName, docIDs)
+	}
+
+	wg.Wait()
+	close(ch)
+
+	var out objects.BatchSimpleObjects
+	for res := range ch {
+		out = append(out, res.objs...)
+	}
+
+	return out, nil
+}
+
+// updateObjectBatch applies update to the objects of a shard held by this
+// node. The doc ids are only valid on the replica they were found on, so the
+// node holding that replica coordinates the replicated merges.
+func (i *Index) updateObjectBatch(ctx context.Context, shard *Shard, docIDs []uint64,
+	update objects.MergeDocument, dryRun bool, replProps *additional.ReplicationProperties,
+) objects.BatchSimpleObjects {
+	if !i.replicationEnabled() {
+		objs := shard.updateObjectBatch(ctx, docIDs, update, dryRun, shard.mergeObjectOfBatch)
+		if !dryRun {
+			shard.flushObjectBatch(objs)
+		}
+		return objs
+	}
+
+	if replProps == nil {
+		replProps = defaultConsistency()
+	}
+	l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
+	return shard.updateObjectBatch(ctx, docIDs, update, dryRun,
+		func(ctx context.Context, doc objects.MergeDocument) error {
+			return i.replicator.MergeObject(ctx, shard.name, &doc, l)
+		})
+}
+
+func (i *Index) IncomingUpdateObjectBatch(ctx context.Context, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+	defer release()
+	if shard == nil {
+		return objects.BatchSimpleObjects{
+			objects.BatchSimpleObject{Err: errors.Errorf("shard %q does not exist locally", shardName)},
+		}
+	}
+
+	return i.updateObjectBatch(ctx, shard, docIDs, update, dryRun, nil)
+}
+
 func defaultConsistency(l ...replica.ConsistencyLevel) *additional.ReplicationProperties {
 	rp := &additional.ReplicationProperties{}
 	if len(l) != 0 {
@@ -1726,7 +2288,7 @@ func objectSearchPreallocate(limit int, shards []string) ([]*storobj.Object, []f
 func (i *Index) addNewShard(ctx context.Context,
 	class *models.Class, shardName string,
 ) error {
-	if shard := i.shards.Load(shardName); shard != nil {
+	if shard := i.shards.Load(shardName); shard != nil || i.tenants.offloaded(shardName) {
 		return fmt.Errorf("shard %q exists already", shardName)
 	}
 
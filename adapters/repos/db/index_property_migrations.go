@@ -0,0 +1,424 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// propertyMigration is a property which was renamed or dropped. The buckets
+// of a shard are migrated when the migration is committed or, for shards
+// which are not loaded, when the shard is loaded. Its objects are migrated
+// in the background afterwards. Until then, objects which still have the
+// property under its previous name are read and updated with the current
+// name, and dropped properties are left out.
+type propertyMigration struct {
+	ID   uint64 `json:"id"`
+	From string `json:"from"`
+	// To is the new name, it is empty if the property was dropped
+	To string `json:"to,omitempty"`
+	// Committed is set once the schema was changed. Migrations are recorded
+	// before the schema change, so only the last one can be uncommitted. It
+	// is rolled back if the schema change did not happen.
+	Committed bool `json:"committed,omitempty"`
+	// Done holds the local shards which completed the migration
+	Done map[string]bool `json:"done,omitempty"`
+}
+
+func (m *propertyMigration) dropped() bool {
+	return m.To == ""
+}
+
+// applied reports whether the schema change of the migration happened,
+// given the current properties of the class
+func (m *propertyMigration) applied(props map[string]bool) bool {
+	if props[m.From] {
+		return false
+	}
+	return m.dropped() || props[m.To]
+}
+
+// propertyMigrations are persisted next to the shards of an index, so the
+// migration of a shard is continued when it is loaded again, e.g. after a
+// crash or once an offloaded tenant is activated. A nil *propertyMigrations,
+// e.g. of an index which was not created through NewIndex, has none.
+type propertyMigrations struct {
+	sync.Mutex
+	path       string
+	migrations []*propertyMigration
+}
+
+// loadPropertyMigrations loads the migrations of an index and rolls back the
+// last one if the node crashed before the schema was changed
+func loadPropertyMigrations(rootPath, indexID string,
+	class *models.Class,
+) (*propertyMigrations, error) {
+	m := &propertyMigrations{
+		path: filepath.Join(rootPath, indexID+".property_migrations.json"),
+	}
+
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m, nil
+		}
+		return nil, fmt.Errorf("read property migrations %s: %w", m.path, err)
+	}
+	if err := json.Unmarshal(data, &m.migrations); err != nil {
+		return nil, fmt.Errorf("parse property migrations %s: %w", m.path, err)
+	}
+
+	if m.resolveLast(classProperties(class)) {
+		if err := m.persist(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func classProperties(class *models.Class) map[string]bool {
+	props := map[string]bool{}
+	if class != nil {
+		for _, prop := range class.Properties {
+			props[prop.Name] = true
+		}
+	}
+	return props
+}
+
+// prepare records a migration before the schema is changed
+func (m *propertyMigrations) prepare(migration *propertyMigration,
+	props map[string]bool,
+) error {
+	if m == nil {
+		return fmt.Errorf("property migrations are not loaded")
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	m.resolveLast(props)
+	for _, other := range m.migrations {
+		if other.ID >= migration.ID {
+			migration.ID = other.ID + 1
+		}
+	}
+	m.migrations = append(m.migrations, migration)
+	if err := m.persist(); err != nil {
+		m.migrations = m.migrations[:len(m.migrations)-1]
+		return err
+	}
+	return nil
+}
+
+// resolveLast commits the last migration if its schema change happened and
+// removes it otherwise. It must only be called when no schema change is in
+// progress. It reports whether the migrations changed.
+func (m *propertyMigrations) resolveLast(props map[string]bool) bool {
+	if len(m.migrations) == 0 {
+		return false
+	}
+	last := m.migrations[len(m.migrations)-1]
+	if last.Committed {
+		return false
+	}
+	if last.applied(props) {
+		last.Committed = true
+	} else {
+		m.migrations = m.migrations[:len(m.migrations)-1]
+	}
+	return true
+}
+
+// commitLast commits the last migration once its schema change happened and
+// reports whether it did so
+func (m *propertyMigrations) commitLast(props map[string]bool) bool {
+	if len(m.migrations) == 0 {
+		return false
+	}
+	last := m.migrations[len(m.migrations)-1]
+	if last.Committed || !last.applied(props) {
+		return false
+	}
+	last.Committed = true
+	return true
+}
+
+// pending returns the committed migrations the shard has not completed yet
+func (m *propertyMigrations) pending(shard string,
+	props func() map[string]bool,
+) ([]propertyMigration, error) {
+	if m == nil {
+		return nil, nil
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.migrations) == 0 {
+		return nil, nil
+	}
+	if m.commitLast(props()) {
+		if err := m.persist(); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []propertyMigration
+	for _, migration := range m.migrations {
+		if migration.Committed && !migration.Done[shard] {
+			out = append(out, *migration)
+		}
+	}
+	return out, nil
+}
+
+// uncommittedRename returns the rename whose schema change is in progress,
+// if any
+func (m *propertyMigrations) uncommittedRename() *propertyMigration {
+	if m == nil {
+		return nil
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.migrations) == 0 {
+		return nil
+	}
+	last := *m.migrations[len(m.migrations)-1]
+	if last.Committed || last.dropped() {
+		return nil
+	}
+	return &last
+}
+
+// effective returns the migrations which apply to the stored objects, that
+// is all whose schema change happened, unless a property with the previous
+// name was added again
+func (m *propertyMigrations) effective(props func() map[string]bool) []propertyMigration {
+	if m == nil {
+		return nil
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.migrations) == 0 {
+		return nil
+	}
+
+	current := props()
+	var out []propertyMigration
+	for _, migration := range m.migrations {
+		if current[migration.From] {
+			continue
+		}
+		if migration.Committed || migration.applied(current) {
+			out = append(out, *migration)
+		}
+	}
+	return out
+}
+
+// conflicting reports whether a migration of a property of the given name
+// is pending
+func (m *propertyMigrations) conflicting(name string) bool {
+	if m == nil {
+		return false
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	for _, migration := range m.migrations {
+		if migration.From == name || migration.To == name {
+			return true
+		}
+	}
+	return false
+}
+
+// complete marks the migrations as done for the shard. Migrations which all
+// local shards completed are removed.
+func (m *propertyMigrations) complete(shard string, done []propertyMigration,
+	localShards []string,
+) error {
+	if m == nil {
+		return nil
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	ids := make(map[uint64]bool, len(done))
+	for _, migration := range done {
+		ids[migration.ID] = true
+	}
+
+	remaining := m.migrations[:0]
+	for _, migration := range m.migrations {
+		if ids[migration.ID] {
+			if migration.Done == nil {
+				migration.Done = map[string]bool{}
+			}
+			migration.Done[shard] = true
+		}
+		if !migration.completedBy(localShards) {
+			remaining = append(remaining, migration)
+		}
+	}
+	m.migrations = remaining
+	return m.persist()
+}
+
+func (m *propertyMigration) completedBy(shards []string) bool {
+	if !m.Committed {
+		return false
+	}
+	for _, shard := range shards {
+		if !m.Done[shard] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *propertyMigrations) persist() error {
+	if len(m.migrations) == 0 {
+		if err := os.Remove(m.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove property migrations: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(m.migrations)
+	if err != nil {
+		return err
+	}
+
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return fmt.Errorf("write property migrations: %w", err)
+	}
+	if err := os.Rename(tmp, m.path); err != nil {
+		return fmt.Errorf("write property migrations: %w", err)
+	}
+	return nil
+}
+
+// drop removes the migrations of a dropped index
+func (m *propertyMigrations) drop() error {
+	if m == nil {
+		return nil
+	}
+	m.Lock()
+	defer m.Unlock()
+
+	m.migrations = nil
+	return m.persist()
+}
+
+// migrateProperties renames and drops the properties of an object which
+// was stored before the migrations and reports whether it changed
+func migrateProperties(props map[string]interface{}, migrations []propertyMigration) bool {
+	changed := false
+	for _, migration := range migrations {
+		value, ok := props[migration.From]
+		if !ok {
+			continue
+		}
+		delete(props, migration.From)
+		if _, ok := props[migration.To]; !ok && !migration.dropped() {
+			props[migration.To] = value
+		}
+		changed = true
+	}
+	return changed
+}
+
+func (i *Index) currentProperties() map[string]bool {
+	return classProperties(i.class())
+}
+
+// withCurrentProperties migrates the properties of objects which were
+// stored before a property was renamed or dropped, see propertyMigration
+func (i *Index) withCurrentProperties(objs ...*storobj.Object) {
+	migrations := i.propertyMigrations.effective(i.currentProperties)
+	if len(migrations) == 0 {
+		return
+	}
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if props, ok := obj.Object.Properties.(map[string]interface{}); ok {
+			migrateProperties(props, migrations)
+		}
+	}
+}
+
+// prepareRenameProperty records the rename of a property before the schema
+// is changed. Until they are renamed, the buckets of the property can be
+// found under both names.
+func (i *Index) prepareRenameProperty(propName, newPropName string) error {
+	if i.propertyMigrations.conflicting(newPropName) {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("property %q is still being migrated", newPropName))
+	}
+	err := i.propertyMigrations.prepare(&propertyMigration{From: propName, To: newPropName},
+		i.currentProperties())
+	if err != nil {
+		return errors.Wrap(err, "record property rename")
+	}
+
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.aliasPropertyBuckets(propName, newPropName); err != nil {
+			return errors.Wrapf(err, "rename property %q of shard %q", propName, name)
+		}
+		return nil
+	})
+}
+
+// prepareDropProperty records the drop of a property before the schema is
+// changed
+func (i *Index) prepareDropProperty(propName string) error {
+	err := i.propertyMigrations.prepare(&propertyMigration{From: propName},
+		i.currentProperties())
+	if err != nil {
+		return errors.Wrap(err, "record property drop")
+	}
+	return nil
+}
+
+// migrateProperties completes the pending property migrations of the loaded
+// shards. Shards which are not loaded complete them once they are loaded.
+func (i *Index) migrateProperties(ctx context.Context) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.migrateProperties(ctx); err != nil {
+			return errors.Wrapf(err, "migrate properties of shard %q", name)
+		}
+		return nil
+	})
+}
+
+func (i *Index) completePropertyMigrations(shard string, done []propertyMigration) error {
+	var localShards []string
+	if ss := i.getSchema.CopyShardingState(i.Config.ClassName.String()); ss != nil {
+		localShards = ss.AllLocalPhysicalShards()
+	}
+	return i.propertyMigrations.complete(shard, done, localShards)
+}
@@ -0,0 +1,702 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// indexQueueBatchSize is the maximum number of vectors a worker inserts
+	// at once. Deletes wait for the current batches, so it is kept small.
+	indexQueueBatchSize = 100
+
+	indexQueueRecordAdd    byte = 1
+	indexQueueRecordDelete byte = 2
+)
+
+// indexQueue decouples imports from the vector index. Vectors are appended
+// to a log on disk and inserted into the wrapped index by a bounded number
+// of workers, all other operations are passed through to the wrapped index.
+//
+// The log contains add and delete records. The number of adds which have
+// been indexed in order is stored as a checkpoint, so only the remaining
+// adds are replayed on startup. Doc IDs are never reused, so an add which is
+// followed by a delete of the same doc ID is not replayed at all. The log is
+// truncated whenever the queue is drained.
+type indexQueue struct {
+	VectorIndex
+
+	logger         logrus.FieldLogger
+	rootPath       string
+	path           string
+	checkpointPath string
+	workerCount    int
+	encryption     *diskio.Encryption // nil unless the log is encrypted
+
+	// addLock is held for reading while workers insert vectors and for
+	// writing by Delete, so a vector is never inserted after its deletion
+	addLock sync.RWMutex
+
+	sync.Mutex
+	work       *sync.Cond
+	file       *diskio.File
+	writer     *bufio.Writer
+	pending    []queuedVector
+	pendingIDs map[uint64]struct{}
+	inFlight   int
+	// seq is the number of adds in the log, checkpoint the number of adds
+	// which have been indexed in order and done contains the adds after the
+	// checkpoint which are already indexed
+	seq                 uint64
+	checkpoint          uint64
+	persistedCheckpoint uint64
+	done                map[uint64]struct{}
+	drained             chan struct{}
+	started             bool
+	closed              bool
+	workers             sync.WaitGroup
+}
+
+// initIndexQueue wraps the vector index with a queue if async indexing is
+// enabled. A queue left over from a previous run is also restored if async
+// indexing has been disabled since, otherwise its vectors would be lost.
+func (s *Shard) initIndexQueue() error {
+	if !s.index.Config.AsyncIndexing {
+		path := filepath.Join(s.index.Config.RootPath, s.ID()+".indexqueue")
+		if st, err := os.Stat(path); err != nil || st.Size() <= s.encryption.HeaderSize() {
+			return nil
+		}
+	}
+
+	q, err := newIndexQueue(s.vectorIndex, s.index.Config.RootPath, s.ID(),
+		s.index.Config.AsyncIndexingWorkers, s.encryption, s.index.logger)
+	if err != nil {
+		return err
+	}
+	s.vectorIndex = q
+
+	return nil
+}
+
+// vectorQueueStatus returns the number of vectors waiting to be indexed and
+// for how long, both are zero if async indexing is disabled
+func (s *Shard) vectorQueueStatus() (int, time.Duration) {
+	if q, ok := s.vectorIndex.(*indexQueue); ok {
+		return q.status()
+	}
+	return 0, 0
+}
+
+// queuedVectorIndex is the vector index behind the queue, extensions of the
+// index which the queue doesn't forward have to be asserted on it
+func (s *Shard) queuedVectorIndex() VectorIndex {
+	if q, ok := s.vectorIndex.(*indexQueue); ok {
+		return q.VectorIndex
+	}
+	return s.vectorIndex
+}
+
+// waitForIndexing blocks until all vectors queued so far are indexed
+func (s *Shard) waitForIndexing(ctx context.Context) error {
+	if q, ok := s.vectorIndex.(*indexQueue); ok {
+		return q.wait(ctx)
+	}
+	return nil
+}
+
+type queuedVector struct {
+	seq      uint64
+	id       uint64
+	vector   []float32
+	queuedAt time.Time
+}
+
+// newIndexQueue restores the queue of the shard with the given ID. Workers
+// are started by PostStartup, as the vector index can't be used before the
+// shard is initialized completely.
+func newIndexQueue(index VectorIndex, rootPath, shardID string, workers int,
+	enc *diskio.Encryption, logger logrus.FieldLogger,
+) (*indexQueue, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	path := filepath.Join(rootPath, shardID+".indexqueue")
+	q := &indexQueue{
+		VectorIndex:    index,
+		logger:         logger.WithField("action", "async_indexing"),
+		rootPath:       rootPath,
+		path:           path,
+		checkpointPath: path + ".checkpoint",
+		workerCount:    workers,
+		pendingIDs:     map[uint64]struct{}{},
+		done:           map[uint64]struct{}{},
+		drained:        make(chan struct{}),
+		encryption:     enc,
+	}
+	q.work = sync.NewCond(&q.Mutex)
+
+	if err := q.restore(); err != nil {
+		return nil, errors.Wrapf(err, "restore index queue %s", path)
+	}
+	if q.isDrained() {
+		close(q.drained)
+	}
+
+	file, err := enc.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open index queue %s", path)
+	}
+	q.file = file
+	q.writer = bufio.NewWriter(file)
+
+	return q, nil
+}
+
+func (q *indexQueue) PostStartup() {
+	q.VectorIndex.PostStartup()
+
+	q.Lock()
+	defer q.Unlock()
+	if q.started || q.closed {
+		return
+	}
+	q.started = true
+	for i := 0; i < q.workerCount; i++ {
+		q.workers.Add(1)
+		go q.worker()
+	}
+}
+
+// Add queues the vector. It is validated right away, so invalid vectors are
+// still rejected synchronously.
+func (q *indexQueue) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+	if err := q.VectorIndex.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	if q.closed {
+		return errors.New("index queue is shut down")
+	}
+
+	if err := q.writeAdd(id, vector); err != nil {
+		return errors.Wrap(err, "write to index queue")
+	}
+
+	if q.isDrained() {
+		q.drained = make(chan struct{})
+	}
+	q.pending = append(q.pending, queuedVector{
+		seq:      q.seq,
+		id:       id,
+		vector:   vector,
+		queuedAt: time.Now(),
+	})
+	q.pendingIDs[id] = struct{}{}
+	q.seq++
+	q.work.Signal()
+
+	return nil
+}
+
+// Delete removes the vectors from the queue before they are deleted from
+// the index
+func (q *indexQueue) Delete(ids ...uint64) error {
+	q.addLock.Lock()
+	q.Lock()
+	err := q.removeQueued(ids)
+	q.Unlock()
+	q.addLock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "delete from index queue")
+	}
+
+	return q.VectorIndex.Delete(ids...)
+}
+
+func (q *indexQueue) removeQueued(ids []uint64) error {
+	if q.seq == q.checkpoint {
+		// nothing would be replayed, no need to record the delete
+		return nil
+	}
+
+	if err := q.writeDelete(ids); err != nil {
+		return err
+	}
+
+	found := false
+	for _, id := range ids {
+		if _, ok := q.pendingIDs[id]; ok {
+			delete(q.pendingIDs, id)
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	deleted := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		deleted[id] = struct{}{}
+	}
+	remaining := q.pending[:0]
+	for _, v := range q.pending {
+		if _, ok := deleted[v.id]; ok {
+			q.markDone(v.seq)
+			continue
+		}
+		remaining = append(remaining, v)
+	}
+	q.pending = remaining
+	q.notifyIfDrained()
+
+	return nil
+}
+
+// Flush persists the queue and the wrapped index. Afterwards the checkpoint
+// is updated, as only now the indexed vectors are persisted.
+func (q *indexQueue) Flush() error {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.closed {
+		return q.VectorIndex.Flush()
+	}
+
+	if err := q.writer.Flush(); err != nil {
+		return errors.Wrap(err, "flush index queue")
+	}
+
+	if err := q.VectorIndex.Flush(); err != nil {
+		return err
+	}
+
+	return q.persistCheckpoint()
+}
+
+func (q *indexQueue) persistCheckpoint() error {
+	if q.isDrained() && q.seq > 0 {
+		// everything is indexed, start over with an empty log. The checkpoint
+		// is reset first, a crash in between leads to replaying the log
+		// instead of skipping vectors.
+		if err := q.writeCheckpoint(0); err != nil {
+			return err
+		}
+		if err := q.file.Truncate(0); err != nil {
+			return errors.Wrap(err, "truncate index queue")
+		}
+		q.seq, q.checkpoint = 0, 0
+		return nil
+	}
+
+	if q.checkpoint == q.persistedCheckpoint {
+		return nil
+	}
+	return q.writeCheckpoint(q.checkpoint)
+}
+
+func (q *indexQueue) writeCheckpoint(checkpoint uint64) error {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, checkpoint)
+
+	tmp := q.checkpointPath + ".tmp"
+	if err := os.WriteFile(tmp, buf, 0o666); err != nil {
+		return errors.Wrap(err, "write index queue checkpoint")
+	}
+	if err := os.Rename(tmp, q.checkpointPath); err != nil {
+		return errors.Wrap(err, "write index queue checkpoint")
+	}
+
+	q.persistedCheckpoint = checkpoint
+	return nil
+}
+
+// Shutdown stops the workers, vectors which are not indexed yet are indexed
+// after the next startup
+func (q *indexQueue) Shutdown(ctx context.Context) error {
+	if err := q.stop(); err != nil {
+		return err
+	}
+
+	return q.VectorIndex.Shutdown(ctx)
+}
+
+func (q *indexQueue) Drop(ctx context.Context) error {
+	if err := q.stop(); err != nil {
+		return err
+	}
+
+	for _, path := range []string{q.path, q.checkpointPath} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "remove %s", path)
+		}
+	}
+
+	return q.VectorIndex.Drop(ctx)
+}
+
+func (q *indexQueue) stop() error {
+	q.Lock()
+	if q.closed {
+		q.Unlock()
+		return nil
+	}
+	q.closed = true
+	q.work.Broadcast()
+	q.Unlock()
+
+	q.workers.Wait()
+
+	q.Lock()
+	defer q.Unlock()
+
+	// nobody waits for vectors which are indexed after a restart
+	if !q.isDrained() {
+		close(q.drained)
+	}
+
+	if err := q.writer.Flush(); err != nil {
+		return errors.Wrap(err, "flush index queue")
+	}
+	if err := q.VectorIndex.Flush(); err != nil {
+		return err
+	}
+	if err := q.persistCheckpoint(); err != nil {
+		return err
+	}
+
+	return q.file.Close()
+}
+
+func (q *indexQueue) ListFiles(ctx context.Context) ([]string, error) {
+	files, err := q.VectorIndex.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range []string{q.path, q.checkpointPath} {
+		if st, err := os.Stat(path); err != nil || st.Size() == 0 {
+			continue
+		}
+		rel, err := filepath.Rel(q.rootPath, path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, rel)
+	}
+
+	return files, nil
+}
+
+// status returns the number of vectors which are not indexed yet and how
+// long the oldest one which wasn't picked up by a worker has been waiting
+func (q *indexQueue) status() (length int, lag time.Duration) {
+	q.Lock()
+	defer q.Unlock()
+
+	if len(q.pending) > 0 {
+		lag = time.Since(q.pending[0].queuedAt)
+	}
+	return len(q.pending) + q.inFlight, lag
+}
+
+// wait blocks until all queued vectors are indexed
+func (q *indexQueue) wait(ctx context.Context) error {
+	q.Lock()
+	drained := q.drained
+	q.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *indexQueue) worker() {
+	defer q.workers.Done()
+
+	for {
+		q.Lock()
+		for len(q.pending) == 0 && !q.closed {
+			q.work.Wait()
+		}
+		closed := q.closed
+		q.Unlock()
+		if closed {
+			return
+		}
+
+		q.addLock.RLock()
+		q.Lock()
+		batch := q.take()
+		q.Unlock()
+
+		for _, v := range batch {
+			if err := q.VectorIndex.Add(v.id, v.vector); err != nil {
+				q.logger.WithError(err).WithField("id", v.id).
+					Error("insert queued vector into index")
+			}
+		}
+		q.addLock.RUnlock()
+
+		q.Lock()
+		q.inFlight -= len(batch)
+		for _, v := range batch {
+			q.markDone(v.seq)
+		}
+		q.notifyIfDrained()
+		q.Unlock()
+	}
+}
+
+// take removes the next batch from the queue, vectors may have been deleted
+// since the worker was woken up
+func (q *indexQueue) take() []queuedVector {
+	n := len(q.pending)
+	if n > indexQueueBatchSize {
+		n = indexQueueBatchSize
+	}
+
+	batch := make([]queuedVector, n)
+	copy(batch, q.pending)
+	q.pending = q.pending[n:]
+	for _, v := range batch {
+		delete(q.pendingIDs, v.id)
+	}
+	q.inFlight += n
+
+	return batch
+}
+
+func (q *indexQueue) markDone(seq uint64) {
+	if seq != q.checkpoint {
+		q.done[seq] = struct{}{}
+		return
+	}
+
+	q.checkpoint++
+	for {
+		if _, ok := q.done[q.checkpoint]; !ok {
+			return
+		}
+		delete(q.done, q.checkpoint)
+		q.checkpoint++
+	}
+}
+
+func (q *indexQueue) isDrained() bool {
+	return len(q.pending) == 0 && q.inFlight == 0
+}
+
+func (q *indexQueue) notifyIfDrained() {
+	if !q.isDrained() {
+		return
+	}
+
+	select {
+	case <-q.drained:
+	default:
+		close(q.drained)
+	}
+}
+
+func (q *indexQueue) writeAdd(id uint64, vector []float32) error {
+	buf := make([]byte, 13+4*len(vector))
+	buf[0] = indexQueueRecordAdd
+	binary.LittleEndian.PutUint64(buf[1:9], id)
+	binary.LittleEndian.PutUint32(buf[9:13], uint32(len(vector)))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[13+4*i:], math.Float32bits(v))
+	}
+
+	_, err := q.writer.Write(buf)
+	return err
+}
+
+func (q *indexQueue) writeDelete(ids []uint64) error {
+	buf := make([]byte, 5+8*len(ids))
+	buf[0] = indexQueueRecordDelete
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(len(ids)))
+	for i, id := range ids {
+		binary.LittleEndian.PutUint64(buf[5+8*i:], id)
+	}
+
+	_, err := q.writer.Write(buf)
+	return err
+}
+
+// restore reads the log and queues all adds after the checkpoint which were
+// not deleted afterwards. A record which was only written partially before
+// a crash is cut off.
+func (q *indexQueue) restore() error {
+	checkpoint, err := os.ReadFile(q.checkpointPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(checkpoint) == 8 {
+		q.checkpoint = binary.LittleEndian.Uint64(checkpoint)
+		q.persistedCheckpoint = q.checkpoint
+	}
+
+	content, err := q.encryption.ReadFile(q.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			q.checkpoint = 0
+			return nil
+		}
+		return err
+	}
+
+	var adds []queuedVector
+	deleted := map[uint64]struct{}{}
+	r := bytes.NewReader(content)
+	valid := int64(0)
+	for {
+		add, ids, err := readIndexQueueRecord(r)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			if err == io.ErrUnexpectedEOF {
+				q.logger.WithField("path", q.path).
+					Warn("index queue ends with a partial record, truncating")
+				if err := os.Truncate(q.path, valid+q.encryption.HeaderSize()); err != nil {
+					return err
+				}
+				break
+			}
+			return err
+		}
+		valid = int64(len(content)) - int64(r.Len())
+
+		for _, id := range ids {
+			deleted[id] = struct{}{}
+		}
+		if add != nil {
+			add.seq = q.seq
+			add.queuedAt = time.Now()
+			adds = append(adds, *add)
+			q.seq++
+		}
+	}
+
+	if q.checkpoint > q.seq {
+		// the log was truncated, but the reset checkpoint is missing
+		q.checkpoint = 0
+	}
+
+	for _, add := range adds {
+		if add.seq < q.checkpoint {
+			continue
+		}
+		if _, ok := deleted[add.id]; ok {
+			q.markDone(add.seq)
+			continue
+		}
+		q.pending = append(q.pending, add)
+		q.pendingIDs[add.id] = struct{}{}
+	}
+
+	return nil
+}
+
+func readIndexQueueRecord(r io.Reader) (*queuedVector, []uint64, error) {
+	var kind [1]byte
+	if _, err := io.ReadFull(r, kind[:]); err != nil {
+		return nil, nil, err
+	}
+
+	switch kind[0] {
+	case indexQueueRecordAdd:
+		var header [12]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		buf := make([]byte, 4*binary.LittleEndian.Uint32(header[8:]))
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		vector := make([]float32, len(buf)/4)
+		for i := range vector {
+			vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+		}
+		return &queuedVector{
+			id:     binary.LittleEndian.Uint64(header[:8]),
+			vector: vector,
+		}, nil, nil
+
+	case indexQueueRecordDelete:
+		var header [4]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		buf := make([]byte, 8*binary.LittleEndian.Uint32(header[:]))
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		ids := make([]uint64, len(buf)/8)
+		for i := range ids {
+			ids[i] = binary.LittleEndian.Uint64(buf[8*i:])
+		}
+		return nil, ids, nil
+
+	default:
+		return nil, nil, errors.Errorf("unknown index queue record type %d", kind[0])
+	}
+}
+
+// unexpectedEOF turns an EOF within a record into io.ErrUnexpectedEOF
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// WaitForIndexing blocks until all vectors which have been queued for the
+// local shards of the class are indexed
+func (db *DB) WaitForIndexing(ctx context.Context, className, tenant string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("tried to browse non-existing index for %s", className)
+	}
+
+	return idx.ForEachShard(func(name string, shard *Shard) error {
+		if tenant != "" && name != tenant {
+			return nil
+		}
+		return shard.waitForIndexing(ctx)
+	})
+}
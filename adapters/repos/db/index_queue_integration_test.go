@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestAsyncIndexing(t *testing.T) {
+	dirName := t.TempDir()
+	className := "AsyncIndexing"
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			AsyncIndexing:             true,
+			AsyncIndexingWorkers:      2,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	batch := make(objects.BatchObjects, 100)
+	for i := range batch {
+		id := strfmt.UUID(uuid.NewString())
+		batch[i] = objects.BatchObject{
+			OriginalIndex: i,
+			UUID:          id,
+			Object:        &models.Object{Class: className, ID: id},
+			Vector:        []float32{float32(i), 1, 2},
+		}
+	}
+	res, err := repo.BatchPutObjects(context.Background(), batch, nil)
+	require.Nil(t, err)
+	for _, obj := range res {
+		require.Nil(t, obj.Err)
+	}
+
+	search := func(repo *DB) []strfmt.UUID {
+		found, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: []float32{1, 1, 2},
+			Pagination:   &filters.Pagination{Limit: 200},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(found))
+		for i, res := range found {
+			ids[i] = res.ID
+		}
+		return ids
+	}
+
+	t.Run("wait until all vectors are indexed", func(t *testing.T) {
+		require.Nil(t, repo.WaitForIndexing(context.Background(), className, ""))
+		assert.Len(t, search(repo), 100)
+
+		status, err := repo.GetNodeStatus(context.Background(), className)
+		require.Nil(t, err)
+		require.Len(t, status[0].Shards, 1)
+		assert.Equal(t, int64(0), status[0].Shards[0].VectorQueueLength)
+	})
+
+	t.Run("index survives a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo()
+		defer repo.Shutdown(context.Background())
+
+		require.Nil(t, repo.WaitForIndexing(context.Background(), className, ""))
+		assert.Len(t, search(repo), 100)
+	})
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+)
+
+type recordingVectorIndex struct {
+	*noop.Index
+	sync.Mutex
+	vectors map[uint64][]float32
+	deleted []uint64
+}
+
+func newRecordingVectorIndex() *recordingVectorIndex {
+	return &recordingVectorIndex{
+		Index:   noop.NewIndex(),
+		vectors: map[uint64][]float32{},
+	}
+}
+
+func (r *recordingVectorIndex) Add(id uint64, vector []float32) error {
+	r.Lock()
+	defer r.Unlock()
+	r.vectors[id] = vector
+	return nil
+}
+
+func (r *recordingVectorIndex) Delete(ids ...uint64) error {
+	r.Lock()
+	defer r.Unlock()
+	for _, id := range ids {
+		delete(r.vectors, id)
+	}
+	r.deleted = append(r.deleted, ids...)
+	return nil
+}
+
+func (r *recordingVectorIndex) ids() []uint64 {
+	r.Lock()
+	defer r.Unlock()
+	ids := make([]uint64, 0, len(r.vectors))
+	for id := range r.vectors {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestIndexQueue(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	t.Run("vectors are indexed by the workers", func(t *testing.T) {
+		index := newRecordingVectorIndex()
+		q, err := newIndexQueue(index, t.TempDir(), "shard", 2, nil, logger)
+		require.Nil(t, err)
+
+		for id := uint64(0); id < 250; id++ {
+			require.Nil(t, q.Add(id, []float32{float32(id), 1}))
+		}
+		length, _ := q.status()
+		assert.Equal(t, 250, length, "workers are only started by PostStartup")
+
+		q.PostStartup()
+		require.Nil(t, q.wait(ctx))
+
+		assert.Len(t, index.ids(), 250)
+		assert.Equal(t, []float32{7, 1}, index.vectors[7])
+		length, lag := q.status()
+		assert.Equal(t, 0, length)
+		assert.Equal(t, time.Duration(0), lag)
+
+		require.Nil(t, q.Flush())
+		st, err := os.Stat(q.path)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), st.Size(), "drained queue is truncated")
+
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("nil vectors are rejected", func(t *testing.T) {
+		q, err := newIndexQueue(newRecordingVectorIndex(), t.TempDir(), "shard", 1, nil, logger)
+		require.Nil(t, err)
+
+		assert.NotNil(t, q.Add(1, nil))
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("queued vectors are removed on delete", func(t *testing.T) {
+		index := newRecordingVectorIndex()
+		q, err := newIndexQueue(index, t.TempDir(), "shard", 1, nil, logger)
+		require.Nil(t, err)
+
+		require.Nil(t, q.Add(1, []float32{1}))
+		require.Nil(t, q.Add(2, []float32{2}))
+		require.Nil(t, q.Delete(1))
+
+		q.PostStartup()
+		require.Nil(t, q.wait(ctx))
+
+		assert.ElementsMatch(t, []uint64{2}, index.ids())
+		assert.Equal(t, []uint64{1}, index.deleted)
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("queued vectors are restored after a restart", func(t *testing.T) {
+		dir := t.TempDir()
+
+		q, err := newIndexQueue(newRecordingVectorIndex(), dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		for id := uint64(0); id < 5; id++ {
+			require.Nil(t, q.Add(id, []float32{float32(id)}))
+		}
+		require.Nil(t, q.Delete(3))
+		require.Nil(t, q.Shutdown(ctx))
+
+		index := newRecordingVectorIndex()
+		q, err = newIndexQueue(index, dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		q.PostStartup()
+		require.Nil(t, q.wait(ctx))
+
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 4}, index.ids())
+		assert.Equal(t, []float32{4}, index.vectors[4])
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("indexed vectors are not replayed", func(t *testing.T) {
+		dir := t.TempDir()
+
+		q, err := newIndexQueue(newRecordingVectorIndex(), dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		for id := uint64(0); id < 3; id++ {
+			require.Nil(t, q.Add(id, []float32{float32(id)}))
+		}
+		// the first two vectors are indexed, the third one is not
+		q.Lock()
+		q.pending = q.pending[2:]
+		q.markDone(0)
+		q.markDone(1)
+		q.Unlock()
+		require.Nil(t, q.Shutdown(ctx))
+
+		index := newRecordingVectorIndex()
+		q, err = newIndexQueue(index, dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		q.PostStartup()
+		require.Nil(t, q.wait(ctx))
+
+		assert.ElementsMatch(t, []uint64{2}, index.ids())
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("partially written record is cut off", func(t *testing.T) {
+		dir := t.TempDir()
+
+		q, err := newIndexQueue(newRecordingVectorIndex(), dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		require.Nil(t, q.Add(1, []float32{1, 2}))
+		require.Nil(t, q.Add(2, []float32{3, 4}))
+		require.Nil(t, q.Shutdown(ctx))
+
+		st, err := os.Stat(q.path)
+		require.Nil(t, err)
+		require.Nil(t, os.Truncate(q.path, st.Size()-3))
+
+		index := newRecordingVectorIndex()
+		q, err = newIndexQueue(index, dir, "shard", 1, nil, logger)
+		require.Nil(t, err)
+		q.PostStartup()
+		require.Nil(t, q.wait(ctx))
+
+		assert.ElementsMatch(t, []uint64{1}, index.ids())
+		require.Nil(t, q.Shutdown(ctx))
+	})
+
+	t.Run("drop removes the queue", func(t *testing.T) {
+		q, err := newIndexQueue(newRecordingVectorIndex(), t.TempDir(), "shard", 1, nil, logger)
+		require.Nil(t, err)
+		require.Nil(t, q.Add(1, []float32{1}))
+		require.Nil(t, q.Drop(ctx))
+
+		_, err = os.Stat(q.path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
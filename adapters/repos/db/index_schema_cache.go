@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// schemaNotifier delivers schema changes, the schema manager implements it
+type schemaNotifier interface {
+	Subscribe(subscriber schemaUC.ChangeSubscriber)
+}
+
+// classReads are the parts of the schema of a class which are read on every
+// query. They are never modified, a change replaces them.
+type classReads struct {
+	// shardingState is nil for multi-tenant classes, copying it on every
+	// tenant change would be more expensive than reading single tenants
+	shardingState  *sharding.State
+	physicalShards []string
+	// searchableProps are the properties a BM25 query without properties
+	// searches
+	searchableProps []string
+}
+
+// classReads are cached if the DB receives schema change notifications, so
+// queries don't contend on the locks of the schema manager
+func (i *Index) classReads() *classReads {
+	return i.schemaReads.Get(i.loadClassReads)
+}
+
+func (i *Index) loadClassReads() *classReads {
+	className := i.Config.ClassName.String()
+	reads := &classReads{}
+	if !i.partitioningEnabled {
+		reads.shardingState = i.getSchema.CopyShardingState(className)
+		if reads.shardingState != nil {
+			reads.physicalShards = reads.shardingState.AllPhysicalShards()
+		}
+	}
+
+	sch := i.getSchema.GetSchemaSkipAuth().Objects
+	if class, err := schema.GetClassByName(sch, className); err == nil {
+		for _, prop := range class.Properties {
+			if inverted.PropertyHasSearchableIndex(sch, className, prop.Name) {
+				reads.searchableProps = append(reads.searchableProps, prop.Name)
+			}
+		}
+	}
+	return reads
+}
+
+// OnSchemaChange invalidates the cached schema reads of affected indices
+func (db *DB) OnSchemaChange(change schemaUC.Change) {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	switch change.Type {
+	case schemaUC.ClassRenamed, schemaUC.SchemaReplaced:
+		for _, index := range db.indices {
+			index.schemaReads.Invalidate()
+		}
+	default:
+		if index, ok := db.indices[indexID(schema.ClassName(change.Class))]; ok {
+			index.schemaReads.Invalidate()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestIndexSchemaReadCache(t *testing.T) {
+	sg := &countingSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+			Class: "C1",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			},
+		}}}},
+		state: &sharding.State{Physical: map[string]sharding.Physical{
+			"S2": {Name: "S2"}, "S1": {Name: "S1"},
+		}},
+	}
+	index := &Index{
+		Config:      IndexConfig{ClassName: "C1"},
+		getSchema:   sg,
+		schemaReads: schemaUC.NewReadCache[*classReads](true),
+	}
+	db := &DB{indices: map[string]*Index{index.ID(): index}}
+
+	shards, err := index.targetShardNames("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"S1", "S2"}, shards)
+	assert.Equal(t, []string{"title"}, index.classReads().searchableProps)
+	assert.Equal(t, 1, sg.copies, "reads are cached")
+
+	db.OnSchemaChange(schemaUC.Change{Type: schemaUC.ClassUpdated, Class: "Other"})
+	index.classReads()
+	assert.Equal(t, 1, sg.copies, "other classes don't invalidate the cache")
+
+	sg.state.Physical["S3"] = sharding.Physical{Name: "S3"}
+	db.OnSchemaChange(schemaUC.Change{Type: schemaUC.ClassUpdated, Class: "C1"})
+	shards, err = index.targetShardNames("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"S1", "S2", "S3"}, shards)
+	assert.Equal(t, 2, sg.copies)
+}
+
+type countingSchemaGetter struct {
+	schemaUC.SchemaGetter
+	schema schema.Schema
+	state  *sharding.State
+	copies int
+}
+
+func (f *countingSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func (f *countingSchemaGetter) CopyShardingState(class string) *sharding.State {
+	f.copies++
+	st := f.state.DeepCopy()
+	return &st
+}
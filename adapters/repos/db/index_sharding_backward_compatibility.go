@@ -27,7 +27,7 @@ func (i *Index) checkSingleShardMigration(shardState *sharding.State) error {
 	}
 
 	for _, entry := range res {
-		if !strings.HasPrefix(entry.Name(), i.ID()+"_single") {
+		if !strings.HasPrefix(entry.Name(), i.storageID()+"_single") {
 			// either not part of this index, or not a "_single" shard
 			continue
 		}
@@ -40,7 +40,7 @@ func (i *Index) checkSingleShardMigration(shardState *sharding.State) error {
 		}
 
 		shardName := shards[0]
-		newName := i.ID() + "_" + shardName + strings.TrimPrefix(entry.Name(), i.ID()+"_single")
+		newName := i.storageID() + "_" + shardName + strings.TrimPrefix(entry.Name(), i.storageID()+"_single")
 		oldPath := filepath.Join(i.Config.RootPath, entry.Name())
 		newPath := filepath.Join(i.Config.RootPath, newName)
 
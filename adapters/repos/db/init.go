@@ -32,6 +32,18 @@ func (db *DB) init(ctx context.Context) error {
 		return errors.Wrapf(err, "create root path directory at %s", db.config.RootPath)
 	}
 
+	if db.config.HintedHandoff.ReplayInterval > 0 {
+		var keys hintKeys
+		if db.config.KeyManager != nil {
+			keys = db.hintEncryption
+		}
+		hints, err := newHintStore(ctx, db.config.RootPath, keys, db.logger, db.promMetrics)
+		if err != nil {
+			return err
+		}
+		db.hints = hints
+	}
+
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
 		for _, class := range objects.Classes {
@@ -54,19 +66,8 @@ func (db *DB) init(ctx context.Context) error {
 				return fmt.Errorf("replication config: %w", err)
 			}
 
-			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName:                 schema.ClassName(class.Class),
-				RootPath:                  db.config.RootPath,
-				ResourceUsage:             db.config.ResourceUsage,
-				QueryMaximumResults:       db.config.QueryMaximumResults,
-				MemtablesFlushIdleAfter:   db.config.MemtablesFlushIdleAfter,
-				MemtablesInitialSizeMB:    db.config.MemtablesInitialSizeMB,
-				MemtablesMaxSizeMB:        db.config.MemtablesMaxSizeMB,
-				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
-				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
-				TrackVectorDimensions:     db.config.TrackVectorDimensions,
-				ReplicationFactor:         class.ReplicationConfig.Factor,
-			}, db.schemaGetter.CopyShardingState(class.Class),
+			idx, err := NewIndex(ctx, db.indexConfig(class),
+				db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
 				db.schemaGetter, db, db.logger, db.nodeResolver, db.remoteIndex,
@@ -84,3 +85,35 @@ func (db *DB) init(ctx context.Context) error {
 
 	return nil
 }
+
+// indexConfig returns the config of the index of class
+func (db *DB) indexConfig(class *models.Class) IndexConfig {
+	return IndexConfig{
+		ClassName:                 schema.ClassName(class.Class),
+		RootPath:                  db.config.RootPath,
+		ResourceUsage:             db.config.ResourceUsage,
+		QueryMaximumResults:       db.config.QueryMaximumResults,
+		MemtablesFlushIdleAfter:   db.config.MemtablesFlushIdleAfter,
+		MemtablesInitialSizeMB:    db.config.MemtablesInitialSizeMB,
+		MemtablesMaxSizeMB:        db.config.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
+		MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
+		TrackVectorDimensions:     db.config.TrackVectorDimensions && !class.VectorOnly,
+		ReplicationFactor:         class.ReplicationConfig.Factor,
+		SegmentTiering:            db.config.segmentTieringFor(class.Class),
+		FilterCacheMaxEntries:     db.config.FilterCacheMaxEntries,
+		DistanceMetrics:           db.config.DistanceMetrics,
+		AsyncIndexing:             db.config.AsyncIndexing,
+		AsyncIndexingWorkers:      db.config.AsyncIndexingWorkers,
+		TenantOffloading:          db.config.TenantOffloading,
+		Hints:                     db.hinter(),
+		KeyManager:                db.config.KeyManager,
+		PerTenantKeys:             db.config.PerTenantKeys,
+		CacheSchemaReads:          db.schemaNotifications,
+		QueryPlanner:              db.config.QueryPlanner,
+		PropertyStats:             db.config.PropertyStats,
+		VectorOnly:                class.VectorOnly,
+		ShardCircuitBreaker:       db.config.ShardCircuitBreaker,
+		ShardChangeRecorders:      db.shardChangeRecorders,
+	}
+}
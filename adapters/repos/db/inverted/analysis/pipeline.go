@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package analysis builds the configurable text analysis pipelines which
+// text properties can select instead of one of the fixed tokenizations. A
+// pipeline runs char filters, a tokenizer, lowercasing, stopword removal and
+// stemming, in that order. The same pipeline is used at index and at query
+// time, so that both sides produce identical terms.
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	StemmerNone    = "none"
+	StemmerEnglish = "english"
+)
+
+// Stemmers contains the stemmers available by language
+var Stemmers = map[string]func(string) string{
+	StemmerEnglish: porterStem,
+}
+
+type charFilter struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+type Pipeline struct {
+	tokenization string
+	charFilters  []charFilter
+	lowercase    bool
+	stopwords    *stopwords.Detector
+	stem         func(string) string
+}
+
+// New compiles the given analyzer config into a pipeline. It fails for
+// configs which do not pass Validate.
+func New(conf models.AnalyzerConfig) (*Pipeline, error) {
+	p := &Pipeline{
+		tokenization: conf.Tokenization,
+		lowercase:    conf.Lowercase,
+	}
+
+	if p.tokenization == "" {
+		p.tokenization = models.PropertyTokenizationWord
+	}
+	if !isTokenization(p.tokenization) {
+		return nil, fmt.Errorf("tokenization %q does not exist", p.tokenization)
+	}
+
+	for i, cf := range conf.CharFilters {
+		if cf == nil {
+			continue
+		}
+		re, err := regexp.Compile(cf.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("charFilters.%d: invalid pattern: %w", i, err)
+		}
+		p.charFilters = append(p.charFilters, charFilter{
+			pattern:     re,
+			replacement: cf.Replacement,
+		})
+	}
+
+	if conf.Stopwords != nil {
+		detector, err := stopwords.NewDetectorFromConfig(*conf.Stopwords)
+		if err != nil {
+			return nil, fmt.Errorf("stopwords: %w", err)
+		}
+		p.stopwords = detector
+	}
+
+	if conf.Stemmer != "" && conf.Stemmer != StemmerNone {
+		stem, ok := Stemmers[conf.Stemmer]
+		if !ok {
+			return nil, fmt.Errorf("stemmer %q does not exist, available stemmers are %v",
+				conf.Stemmer, availableStemmers())
+		}
+		p.stem = stem
+	}
+
+	return p, nil
+}
+
+// Validate checks that the analyzer config with the given name can be
+// compiled into a pipeline
+func Validate(name string, conf models.AnalyzerConfig) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("analyzer name must not be empty")
+	}
+	if _, err := New(conf); err != nil {
+		return fmt.Errorf("analyzer %q: %w", name, err)
+	}
+	return nil
+}
+
+// Tokenization returns the tokenization the pipeline splits its input with
+func (p *Pipeline) Tokenization() string {
+	return p.tokenization
+}
+
+// Analyze turns the input into the list of terms to be indexed or searched
+// for. Duplicates are kept.
+func (p *Pipeline) Analyze(in string) []string {
+	return p.analyze(in, false)
+}
+
+// AnalyzeWithWildcards behaves like Analyze but keeps the wildcard symbols
+// '?' and '*' used by the like operator. Terms containing wildcards are not
+// stemmed, as the stemmer would not be able to tell where the word ends.
+func (p *Pipeline) AnalyzeWithWildcards(in string) []string {
+	return p.analyze(in, true)
+}
+
+func (p *Pipeline) analyze(in string, wildcards bool) []string {
+	for _, cf := range p.charFilters {
+		in = cf.pattern.ReplaceAllString(in, cf.replacement)
+	}
+
+	var terms []string
+	if wildcards {
+		terms = helpers.TokenizeWithWildcards(p.tokenization, in)
+	} else {
+		terms = helpers.Tokenize(p.tokenization, in)
+	}
+
+	out := terms[:0]
+	for _, term := range terms {
+		if p.lowercase {
+			term = strings.ToLower(term)
+		}
+		if p.stopwords != nil && p.stopwords.IsStopword(term) {
+			continue
+		}
+		if p.stem != nil && !(wildcards && strings.ContainsAny(term, "?*")) {
+			term = p.stem(term)
+		}
+		if term == "" {
+			continue
+		}
+		out = append(out, term)
+	}
+
+	return out
+}
+
+var cache sync.Map
+
+// ForProperty returns the pipeline selected by the analyzer setting of the
+// given property, or nil if the property uses a plain tokenization.
+// Compiled pipelines are cached by their config, so that schema updates
+// changing an analyzer are picked up on the next call.
+func ForProperty(class *models.Class, prop *models.Property) (*Pipeline, error) {
+	if prop == nil || prop.Analyzer == "" {
+		return nil, nil
+	}
+
+	var conf models.AnalyzerConfig
+	var ok bool
+	if class != nil && class.InvertedIndexConfig != nil {
+		conf, ok = class.InvertedIndexConfig.Analyzers[prop.Analyzer]
+	}
+	if !ok {
+		return nil, fmt.Errorf("analyzer %q of property %q is not defined", prop.Analyzer, prop.Name)
+	}
+
+	key, err := json.Marshal(conf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal analyzer %q: %w", prop.Analyzer, err)
+	}
+	if p, ok := cache.Load(string(key)); ok {
+		return p.(*Pipeline), nil
+	}
+
+	p, err := New(conf)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer %q: %w", prop.Analyzer, err)
+	}
+	cache.Store(string(key), p)
+	return p, nil
+}
+
+func isTokenization(tokenization string) bool {
+	for _, t := range helpers.Tokenizations {
+		if t == tokenization {
+			return true
+		}
+	}
+	return false
+}
+
+func availableStemmers() []string {
+	names := make([]string, 0, len(Stemmers))
+	for name := range Stemmers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestPipeline(t *testing.T) {
+	type testCase struct {
+		name     string
+		conf     models.AnalyzerConfig
+		input    string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "defaults to word tokenization",
+			conf:     models.AnalyzerConfig{},
+			input:    "Hello, my name is John-Doe",
+			expected: []string{"hello", "my", "name", "is", "john", "doe"},
+		},
+		{
+			name: "whitespace tokenization with lowercasing",
+			conf: models.AnalyzerConfig{
+				Tokenization: models.PropertyTokenizationWhitespace,
+				Lowercase:    true,
+			},
+			input:    "Hello, John-Doe",
+			expected: []string{"hello,", "john-doe"},
+		},
+		{
+			name: "char filters run in order before tokenization",
+			conf: models.AnalyzerConfig{
+				Tokenization: models.PropertyTokenizationWhitespace,
+				CharFilters: []*models.AnalyzerCharFilter{
+					{Pattern: `<[^>]+>`, Replacement: " "},
+					{Pattern: `(\w+)-(\w+)`, Replacement: "$1$2"},
+				},
+			},
+			input:    "<b>e-mail</b> me",
+			expected: []string{"email", "me"},
+		},
+		{
+			name: "stopwords are removed before stemming",
+			conf: models.AnalyzerConfig{
+				Stopwords: &models.StopwordConfig{
+					Preset:    "en",
+					Additions: []string{"running"},
+				},
+				Stemmer: StemmerEnglish,
+			},
+			input:    "The dogs are running and jumping",
+			expected: []string{"dog", "jump"},
+		},
+		{
+			name: "stemming without stopwords",
+			conf: models.AnalyzerConfig{
+				Stemmer: StemmerEnglish,
+			},
+			input:    "connected connections",
+			expected: []string{"connect", "connect"},
+		},
+		{
+			name: "stemmer none",
+			conf: models.AnalyzerConfig{
+				Stemmer: StemmerNone,
+			},
+			input:    "connections",
+			expected: []string{"connections"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(tc.conf)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, p.Analyze(tc.input))
+		})
+	}
+
+	t.Run("wildcard terms are not stemmed", func(t *testing.T) {
+		p, err := New(models.AnalyzerConfig{Stemmer: StemmerEnglish})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"connect*", "connect"}, p.AnalyzeWithWildcards("connect* connections"))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		analyzer    string
+		conf        models.AnalyzerConfig
+		expectedErr string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "valid config",
+			analyzer: "english",
+			conf: models.AnalyzerConfig{
+				Tokenization: models.PropertyTokenizationLowercase,
+				CharFilters:  []*models.AnalyzerCharFilter{{Pattern: `\d+`}},
+				Stemmer:      StemmerEnglish,
+				Stopwords:    &models.StopwordConfig{Preset: "en"},
+			},
+		},
+		{
+			name:        "empty name",
+			analyzer:    " ",
+			expectedErr: "analyzer name must not be empty",
+		},
+		{
+			name:        "unknown tokenization",
+			analyzer:    "a",
+			conf:        models.AnalyzerConfig{Tokenization: "trigram"},
+			expectedErr: `analyzer "a": tokenization "trigram" does not exist`,
+		},
+		{
+			name:     "invalid char filter",
+			analyzer: "a",
+			conf: models.AnalyzerConfig{
+				CharFilters: []*models.AnalyzerCharFilter{{Pattern: `(`}},
+			},
+			expectedErr: `analyzer "a": charFilters.0: invalid pattern: error parsing regexp: missing closing ): ` + "`(`",
+		},
+		{
+			name:        "unknown stemmer",
+			analyzer:    "a",
+			conf:        models.AnalyzerConfig{Stemmer: "klingon"},
+			expectedErr: `analyzer "a": stemmer "klingon" does not exist, available stemmers are [english]`,
+		},
+		{
+			name:     "unknown stopword preset",
+			analyzer: "a",
+			conf: models.AnalyzerConfig{
+				Stopwords: &models.StopwordConfig{Preset: "klingon"},
+			},
+			expectedErr: `analyzer "a": stopwords: failed to create new detector from config: preset "klingon" not known to stopword detector`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.analyzer, tc.conf)
+			if tc.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestForProperty(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {Stemmer: StemmerEnglish},
+			},
+		},
+	}
+
+	t.Run("property without analyzer", func(t *testing.T) {
+		p, err := ForProperty(class, &models.Property{Name: "title"})
+		require.Nil(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("property with analyzer", func(t *testing.T) {
+		p, err := ForProperty(class, &models.Property{Name: "title", Analyzer: "english"})
+		require.Nil(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, []string{"run"}, p.Analyze("Running"))
+
+		cached, err := ForProperty(class, &models.Property{Name: "body", Analyzer: "english"})
+		require.Nil(t, err)
+		assert.True(t, p == cached)
+	})
+
+	t.Run("property with undefined analyzer", func(t *testing.T) {
+		_, err := ForProperty(class, &models.Property{Name: "title", Analyzer: "german"})
+		assert.EqualError(t, err, `analyzer "german" of property "title" is not defined`)
+	})
+}
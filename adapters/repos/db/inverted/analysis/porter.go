@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+// porterStem implements the original Porter (1980) stemming algorithm for
+// English. Words containing anything but lowercase ASCII letters are
+// returned unchanged, so callers should lowercase before stemming.
+func porterStem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	p := &porter{b: []byte(word)}
+	p.step1ab()
+	p.step1c()
+	p.step2()
+	p.step3()
+	p.step4()
+	p.step5()
+	return string(p.b)
+}
+
+// porter holds the word being stemmed in b, the last index of b is the
+// current end of the word. j marks the end of the stem once a suffix has
+// been matched with ends.
+type porter struct {
+	b []byte
+	j int
+}
+
+func (p *porter) k() int {
+	return len(p.b) - 1
+}
+
+func (p *porter) truncate(k int) {
+	p.b = p.b[:k+1]
+}
+
+// cons reports whether b[i] is a consonant
+func (p *porter) cons(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !p.cons(i - 1)
+	default:
+		return true
+	}
+}
+
+// m measures the number of vowel-consonant sequences in b[0:j+1]
+func (p *porter) m() int {
+	n, i := 0, 0
+	for {
+		if i > p.j {
+			return n
+		}
+		if !p.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > p.j {
+				return n
+			}
+			if p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > p.j {
+				return n
+			}
+			if !p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem reports whether b[0:j+1] contains a vowel
+func (p *porter) vowelInStem() bool {
+	for i := 0; i <= p.j; i++ {
+		if !p.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC reports whether b[i-1:i+1] is a double consonant
+func (p *porter) doubleC(i int) bool {
+	if i < 1 || p.b[i] != p.b[i-1] {
+		return false
+	}
+	return p.cons(i)
+}
+
+// cvc reports whether b[i-2:i+1] is consonant-vowel-consonant and the last
+// consonant is not w, x or y. This restores an e in words like hop(e).
+func (p *porter) cvc(i int) bool {
+	if i < 2 || !p.cons(i) || p.cons(i-1) || !p.cons(i-2) {
+		return false
+	}
+	switch p.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	default:
+		return true
+	}
+}
+
+// ends reports whether the word ends with s and sets j to the end of the
+// remaining stem if it does
+func (p *porter) ends(s string) bool {
+	k := p.k()
+	if len(s) > k+1 || string(p.b[k-len(s)+1:]) != s {
+		return false
+	}
+	p.j = k - len(s)
+	return true
+}
+
+// setTo replaces b[j+1:] with s
+func (p *porter) setTo(s string) {
+	p.b = append(p.b[:p.j+1], s...)
+}
+
+// replace replaces the matched suffix with s if the stem has a measure > 0
+func (p *porter) replace(s string) {
+	if p.m() > 0 {
+		p.setTo(s)
+	}
+}
+
+// replaceFirst replaces the first matching suffix in rules. Only the first
+// match is considered, even if its stem is too short to be replaced.
+func (p *porter) replaceFirst(rules [][2]string) {
+	for _, rule := range rules {
+		if p.ends(rule[0]) {
+			p.replace(rule[1])
+			return
+		}
+	}
+}
+
+// step1ab removes plurals and -ed or -ing
+func (p *porter) step1ab() {
+	if p.b[p.k()] == 's' {
+		if p.ends("sses") {
+			p.truncate(p.k() - 2)
+		} else if p.ends("ies") {
+			p.setTo("i")
+		} else if p.k() > 0 && p.b[p.k()-1] != 's' {
+			p.truncate(p.k() - 1)
+		}
+	}
+
+	if p.ends("eed") {
+		if p.m() > 0 {
+			p.truncate(p.k() - 1)
+		}
+	} else if (p.ends("ed") || p.ends("ing")) && p.vowelInStem() {
+		p.truncate(p.j)
+		if p.ends("at") {
+			p.setTo("ate")
+		} else if p.ends("bl") {
+			p.setTo("ble")
+		} else if p.ends("iz") {
+			p.setTo("ize")
+		} else if p.doubleC(p.k()) {
+			if c := p.b[p.k()]; c != 'l' && c != 's' && c != 'z' {
+				p.truncate(p.k() - 1)
+			}
+		} else {
+			p.j = p.k()
+			if p.m() == 1 && p.cvc(p.k()) {
+				p.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns a terminal y into i when there is another vowel in the stem
+func (p *porter) step1c() {
+	if p.ends("y") && p.vowelInStem() {
+		p.b[p.k()] = 'i'
+	}
+}
+
+var porterStep2 = map[byte][][2]string{
+	'a': {{"ational", "ate"}, {"tional", "tion"}},
+	'c': {{"enci", "ence"}, {"anci", "ance"}},
+	'e': {{"izer", "ize"}},
+	'l': {{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}},
+	'o': {{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}},
+	's': {{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}},
+	't': {{"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"}},
+	'g': {{"logi", "log"}},
+}
+
+// step2 maps double suffixes to single ones, e.g. -ization to -ize
+func (p *porter) step2() {
+	if p.k() < 1 {
+		return
+	}
+	p.replaceFirst(porterStep2[p.b[p.k()-1]])
+}
+
+var porterStep3 = map[byte][][2]string{
+	'e': {{"icate", "ic"}, {"ative", ""}, {"alize", "al"}},
+	'i': {{"iciti", "ic"}},
+	'l': {{"ical", "ic"}, {"ful", ""}},
+	's': {{"ness", ""}},
+}
+
+// step3 handles -ic-, -full, -ness etc.
+func (p *porter) step3() {
+	p.replaceFirst(porterStep3[p.b[p.k()]])
+}
+
+var porterStep4 = map[byte][]string{
+	'a': {"al"},
+	'c': {"ance", "ence"},
+	'e': {"er"},
+	'i': {"ic"},
+	'l': {"able", "ible"},
+	'n': {"ant", "ement", "ment", "ent"},
+	'o': {"ion", "ou"},
+	's': {"ism"},
+	't': {"ate", "iti"},
+	'u': {"ous"},
+	'v': {"ive"},
+	'z': {"ize"},
+}
+
+// step4 removes -ant, -ence etc. in context <c>vcvc<v>
+func (p *porter) step4() {
+	if p.k() < 1 {
+		return
+	}
+
+	matched := false
+	for _, suffix := range porterStep4[p.b[p.k()-1]] {
+		if !p.ends(suffix) {
+			continue
+		}
+		if suffix == "ion" && (p.j < 0 || (p.b[p.j] != 's' && p.b[p.j] != 't')) {
+			continue
+		}
+		matched = true
+		break
+	}
+
+	if matched && p.m() > 1 {
+		p.truncate(p.j)
+	}
+}
+
+// step5 removes a final -e and turns -ll into -l if the measure is > 1
+func (p *porter) step5() {
+	p.j = p.k()
+	if p.b[p.k()] == 'e' {
+		if a := p.m(); a > 1 || (a == 1 && !p.cvc(p.k()-1)) {
+			p.truncate(p.k() - 1)
+		}
+	}
+	if p.b[p.k()] == 'l' && p.doubleC(p.k()) && p.m() > 1 {
+		p.truncate(p.k() - 1)
+	}
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPorterStem(t *testing.T) {
+	testCases := map[string]string{
+		// step 1ab
+		"caresses":  "caress",
+		"ponies":    "poni",
+		"ties":      "ti",
+		"caress":    "caress",
+		"cats":      "cat",
+		"feed":      "feed",
+		"agreed":    "agre",
+		"plastered": "plaster",
+		"bled":      "bled",
+		"motoring":  "motor",
+		"sing":      "sing",
+		"conflated": "conflat",
+		"troubled":  "troubl",
+		"sized":     "size",
+		"hopping":   "hop",
+		"tanned":    "tan",
+		"falling":   "fall",
+		"hissing":   "hiss",
+		"fizzed":    "fizz",
+		"failing":   "fail",
+		"filing":    "file",
+		// step 1c
+		"happy": "happi",
+		"sky":   "sky",
+		// steps 2 to 5
+		"relational":     "relat",
+		"conditional":    "condit",
+		"generalization": "gener",
+		"oscillators":    "oscil",
+		"connections":    "connect",
+		"hopefulness":    "hope",
+		"electricity":    "electr",
+		"adjustment":     "adjust",
+		"adoption":       "adopt",
+		"controll":       "control",
+		"rate":           "rate",
+		// left alone
+		"at":     "at",
+		"naïve":  "naïve",
+		"abc123": "abc123",
+	}
+
+	for word, expected := range testCases {
+		assert.Equal(t, expected, porterStem(word), word)
+	}
+}
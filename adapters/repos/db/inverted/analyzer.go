@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,6 +26,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term within the property, only set for properties
+	// which index positions
+	Positions []uint32
 }
 
 type Property struct {
@@ -37,6 +41,7 @@ type Property struct {
 
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	class                  *models.Class
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -53,6 +58,40 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 		terms = append(terms, helpers.Tokenize(tokenization, in)...)
 	}
 
+	return countTerms(terms)
+}
+
+// TextProp analyzes given input using the analyzer pipeline selected by the
+// property, falling back to the property's tokenization if it does not
+// select one, then aggregates duplicates. For properties which index
+// positions the positions of each term are recorded as well.
+func (a *Analyzer) TextProp(prop *models.Property, inArr []string) ([]Countable, error) {
+	pipeline, err := analysis.ForProperty(a.class, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	termsPerValue := make([][]string, len(inArr))
+	for i, in := range inArr {
+		if pipeline == nil {
+			termsPerValue[i] = helpers.Tokenize(prop.Tokenization, in)
+		} else {
+			termsPerValue[i] = pipeline.Analyze(in)
+		}
+	}
+
+	if HasPositionsIndex(prop) {
+		return countTermsWithPositions(termsPerValue), nil
+	}
+
+	var terms []string
+	for _, valueTerms := range termsPerValue {
+		terms = append(terms, valueTerms...)
+	}
+	return countTerms(terms), nil
+}
+
+func countTerms(terms []string) []Countable {
 	counts := map[string]uint64{}
 	for _, term := range terms {
 		counts[term]++
@@ -70,6 +109,36 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+// positionGap separates the positions of consecutive values of array
+// properties, so that phrases can't match across values
+const positionGap = 100
+
+func countTermsWithPositions(termsPerValue [][]string) []Countable {
+	var countable []Countable
+	indices := map[string]int{}
+
+	offset := uint32(0)
+	for _, terms := range termsPerValue {
+		for i, term := range terms {
+			pos := offset + uint32(i)
+			if idx, ok := indices[term]; ok {
+				countable[idx].TermFrequency++
+				countable[idx].Positions = append(countable[idx].Positions, pos)
+				continue
+			}
+			indices[term] = len(countable)
+			countable = append(countable, Countable{
+				Data:          []byte(term),
+				TermFrequency: 1,
+				Positions:     []uint32{pos},
+			})
+		}
+		offset += uint32(len(terms)) + positionGap
+	}
+
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -219,3 +288,10 @@ func NewAnalyzer(isFallbackToSearchable IsFallbackToSearchable) *Analyzer {
 	}
 	return &Analyzer{isFallbackToSearchable: isFallbackToSearchable}
 }
+
+// WithClass sets the class whose analyzer definitions are used for text
+// properties selecting an analyzer
+func (a *Analyzer) WithClass(class *models.Class) *Analyzer {
+	a.class = class
+	return a
+}
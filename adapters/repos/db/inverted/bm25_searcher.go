@@ -20,7 +20,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/weaviate/sroar"
@@ -87,6 +89,11 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 		return nil, nil, err
 	}
 
+	filterDocIds, keywordRanking, err = b.withPhrases(class, filterDocIds, keywordRanking)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	objs, scores, err := b.wand(ctx, filterDocIds, class, keywordRanking, limit)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "wand")
@@ -112,7 +119,8 @@ func (b *BM25Searcher) wand(
 	// There are currently cases, for different tokenization:
 	// word, lowercase, whitespace and field.
 	// Query is tokenized and respective properties are then searched for the search terms,
-	// results at the end are combined using WAND
+	// results at the end are combined using WAND.
+	// Properties with an analyzer form an additional group per analyzer.
 	tokenizationsOrdered := []string{
 		models.PropertyTokenizationWord,
 		models.PropertyTokenizationLowercase,
@@ -126,7 +134,8 @@ func (b *BM25Searcher) wand(
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		tokenize := func(in string) []string { return helpers.Tokenize(tokenization, in) }
+		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.CountDuplicates(expandSynonyms(class, tokenize(params.Query), tokenize))
 
 		// stopword filtering for word tokenization
 		if tokenization == models.PropertyTokenizationWord {
@@ -160,6 +169,22 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
+			if prop.Analyzer != "" {
+				// properties selecting an analyzer are grouped by analyzer, the
+				// query is run through the same pipeline as the indexed text
+				key := analyzerGroupKey(prop.Analyzer)
+				if _, exists := propNamesByTokenization[key]; !exists {
+					pipeline, err := analysis.ForProperty(class, prop)
+					if err != nil {
+						return nil, nil, err
+					}
+					queryTermsByTokenization[key], duplicateBoostsByTokenization[key] = helpers.CountDuplicates(expandSynonyms(class, pipeline.Analyze(params.Query), pipeline.Analyze))
+					propNamesByTokenization[key] = make([]string, 0)
+					tokenizationsOrdered = append(tokenizationsOrdered, key)
+				}
+				propNamesByTokenization[key] = append(propNamesByTokenization[key], property)
+				continue
+			}
 			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
@@ -197,7 +222,7 @@ func (b *BM25Searcher) wand(
 				k := i + offset
 
 				eg.Go(func() error {
-					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
+					termResult, docIndices, err := b.createTerm(ctx, N, filterDocIds, queryTerms[j], propNames,
 						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
 					if err != nil {
 						return err
@@ -225,8 +250,26 @@ func (b *BM25Searcher) wand(
 	resultsOriginalOrder := make(terms, len(results))
 	copy(resultsOriginalOrder, results)
 
-	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	topKHeap, err := b.getTopKHeap(ctx, limit, results, averagePropLength)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.getTopKObjects(ctx, topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+}
+
+// analyzerGroupKey cannot collide with a tokenization, none of them
+// contains a colon
+func analyzerGroupKey(analyzer string) string {
+	return "analyzer:" + analyzer
+}
+
+// expandSynonyms adds the synonyms configured for the class to the query
+// terms, which are produced by analyze
+func expandSynonyms(class *models.Class, queryTerms []string, analyze func(string) []string) []string {
+	if class.InvertedIndexConfig == nil {
+		return queryTerms
+	}
+	return synonyms.NewExpander(class.InvertedIndexConfig.Synonyms, analyze).Expand(queryTerms)
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
@@ -254,7 +297,7 @@ WordLoop:
 	}
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(ctx context.Context, topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -265,6 +308,10 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 
 	buf := make([]byte, 8)
 	for topKHeap.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		res := topKHeap.Pop()
 		binary.LittleEndian.PutUint64(buf, res.ID)
 		objectByte, err := objectsBucket.GetBySecondary(0, buf)
@@ -302,13 +349,17 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 	return objects, scores, nil
 }
 
-func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength float64) *priorityqueue.Queue {
+func (b *BM25Searcher) getTopKHeap(ctx context.Context, limit int, results terms, averagePropLength float64) (*priorityqueue.Queue, error) {
 	topKHeap := priorityqueue.NewMin(limit)
 	worstDist := float64(-10000) // tf score can be negative
 	sort.Sort(results)
 	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		if results.completelyExhausted() || results.pivot(worstDist) {
-			return topKHeap
+			return topKHeap, nil
 		}
 
 		id, score := results.scoreNext(averagePropLength, b.config)
@@ -327,12 +378,15 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 	}
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
+func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
 	allMsAndProps := make(AllMapPairsAndPropName, 0, len(propertyNames))
 	for _, propName := range propertyNames {
+		if err := ctx.Err(); err != nil {
+			return termResult, nil, err
+		}
 
 		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
 		if bucket == nil {
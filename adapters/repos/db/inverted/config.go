@@ -16,7 +16,9 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -39,6 +41,16 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = validateAnalyzersConfig(conf.Analyzers)
+	if err != nil {
+		return err
+	}
+
+	err = synonyms.Validate(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,6 +77,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 		conf.Stopwords.Preset = iicm.Stopwords.Preset
 		conf.Stopwords.Additions = iicm.Stopwords.Additions
 		conf.Stopwords.Removals = iicm.Stopwords.Removals
+		conf.Stopwords.Version = iicm.Stopwords.Version
 	}
 
 	return conf
@@ -85,6 +98,22 @@ func validateBM25Config(conf *models.BM25Config) error {
 	return nil
 }
 
+func validateAnalyzersConfig(analyzers map[string]models.AnalyzerConfig) error {
+	for name, conf := range analyzers {
+		if conf.Stopwords != nil {
+			if err := validateStopwordAdditionsRemovals(conf.Stopwords); err != nil {
+				return errors.Wrapf(err, "analyzer %q", name)
+			}
+		}
+
+		if err := analysis.Validate(name, conf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateStopwordConfig(conf *models.StopwordConfig) error {
 	if conf == nil {
 		conf = &models.StopwordConfig{}
@@ -163,6 +163,50 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, test.expectedLength, len(in.Stopwords.Additions))
 		}
 	})
+
+	t.Run("with valid analyzers", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {
+					CharFilters: []*models.AnalyzerCharFilter{{Pattern: `<[^>]+>`}},
+					Stemmer:     "english",
+					Stopwords:   &models.StopwordConfig{Preset: "en"},
+				},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid analyzer", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {Stemmer: "klingon"},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err,
+			`analyzer "english": stemmer "klingon" does not exist, available stemmers are [english]`)
+	})
+
+	t.Run("with invalid analyzer stopwords", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {
+					Stopwords: &models.StopwordConfig{
+						Additions: []string{"some"},
+						Removals:  []string{"some"},
+					},
+				},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, `analyzer "english": `+
+			"found 'some' in both stopwords.additions and stopwords.removals")
+	})
 }
 
 func TestConfigFromModel(t *testing.T) {
@@ -13,6 +13,8 @@ package inverted
 
 import (
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -36,6 +38,17 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateAnalyzersConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
+	// synonyms are only applied at query time, so they can be changed freely
+	err = synonyms.Validate(updated.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,6 +87,7 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 			Preset:    initial.Stopwords.Preset,
 			Additions: initial.Stopwords.Additions,
 			Removals:  initial.Stopwords.Removals,
+			Version:   initial.Stopwords.Version,
 		}
 		return nil
 	}
@@ -83,5 +97,105 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 		return err
 	}
 
+	// the version is maintained here rather than by the client, so that it
+	// changes exactly when the stopwords do
+	updated.Stopwords.Version = 0
+	if initial.Stopwords != nil {
+		updated.Stopwords.Version = initial.Stopwords.Version
+		if initial.Stopwords.Preset == updated.Stopwords.Preset &&
+			stringsEqual(initial.Stopwords.Additions, updated.Stopwords.Additions) &&
+			stringsEqual(initial.Stopwords.Removals, updated.Stopwords.Removals) {
+			return nil
+		}
+	}
+	updated.Stopwords.Version++
+
+	return nil
+}
+
+// validateAnalyzersConfigUpdate only allows adding analyzers. Changing or
+// removing an existing one would alter the terms produced at query time
+// without reindexing the terms already stored.
+func validateAnalyzersConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Analyzers == nil {
+		updated.Analyzers = initial.Analyzers
+		return nil
+	}
+
+	if err := validateAnalyzersConfig(updated.Analyzers); err != nil {
+		return err
+	}
+
+	for name, conf := range initial.Analyzers {
+		updatedConf, ok := updated.Analyzers[name]
+		if !ok {
+			return errors.Errorf("analyzer %q cannot be removed when updating a schema", name)
+		}
+		if !analyzerConfigsEqual(conf, updatedConf) {
+			return errors.Errorf("analyzer %q cannot be changed when updating a schema", name)
+		}
+	}
+
 	return nil
 }
+
+// analyzerConfigsEqual compares the effective settings, so that defaults
+// and empty lists lost in a JSON roundtrip are not treated as a change
+func analyzerConfigsEqual(a, b models.AnalyzerConfig) bool {
+	tokenization := func(t string) string {
+		if t == "" {
+			return models.PropertyTokenizationWord
+		}
+		return t
+	}
+	stemmer := func(s string) string {
+		if s == "" {
+			return analysis.StemmerNone
+		}
+		return s
+	}
+
+	if tokenization(a.Tokenization) != tokenization(b.Tokenization) ||
+		a.Lowercase != b.Lowercase || stemmer(a.Stemmer) != stemmer(b.Stemmer) {
+		return false
+	}
+
+	if len(a.CharFilters) != len(b.CharFilters) {
+		return false
+	}
+	for i := range a.CharFilters {
+		var fa, fb models.AnalyzerCharFilter
+		if a.CharFilters[i] != nil {
+			fa = *a.CharFilters[i]
+		}
+		if b.CharFilters[i] != nil {
+			fb = *b.CharFilters[i]
+		}
+		if fa != fb {
+			return false
+		}
+	}
+
+	if (a.Stopwords == nil) != (b.Stopwords == nil) {
+		return false
+	}
+	if a.Stopwords != nil {
+		return a.Stopwords.Preset == b.Stopwords.Preset &&
+			stringsEqual(a.Stopwords.Additions, b.Stopwords.Additions) &&
+			stringsEqual(a.Stopwords.Removals, b.Stopwords.Removals)
+	}
+
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -138,4 +138,87 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("stopwords version", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: &models.StopwordConfig{Preset: "en", Additions: []string{"star"}, Version: 3},
+		}
+
+		unchanged := &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en", Additions: []string{"star"}, Version: 10},
+		}
+		require.Nil(t, ValidateUserConfigUpdate(initial, unchanged))
+		assert.Equal(t, int64(3), unchanged.Stopwords.Version)
+
+		changed := &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en", Additions: []string{"star", "nebula"}},
+		}
+		require.Nil(t, ValidateUserConfigUpdate(initial, changed))
+		assert.Equal(t, int64(4), changed.Stopwords.Version)
+
+		missing := &models.InvertedIndexConfig{}
+		require.Nil(t, ValidateUserConfigUpdate(initial, missing))
+		assert.Equal(t, int64(3), missing.Stopwords.Version)
+	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {
+					Stemmer:   "english",
+					Stopwords: &models.StopwordConfig{Preset: "en"},
+				},
+			},
+		}
+		unchanged := models.AnalyzerConfig{
+			Tokenization: models.PropertyTokenizationWord,
+			Stemmer:      "english",
+			Stopwords:    &models.StopwordConfig{Preset: "en", Additions: []string{}},
+		}
+
+		t.Run("missing analyzers are kept", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, initial.Analyzers, updated.Analyzers)
+		})
+
+		t.Run("adding an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"english": unchanged,
+					"plain":   {Tokenization: models.PropertyTokenizationWhitespace},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("removing an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"plain": {},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "english" cannot be removed when updating a schema`)
+		})
+
+		t.Run("changing an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"english": {Stemmer: "english"},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "english" cannot be changed when updating a schema`)
+		})
+	})
 }
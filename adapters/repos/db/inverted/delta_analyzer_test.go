@@ -276,4 +276,31 @@ func TestDeltaAnalyzer(t *testing.T) {
 		assert.Equal(t, expectedAdd, res.ToAdd)
 		assert.Equal(t, expectedDelete, res.ToDelete)
 	})
+
+	t.Run("with previous indexing - changed positions", func(t *testing.T) {
+		previous := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("value1"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("value2"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+			},
+		}
+		next := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("value2"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("value1"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+			},
+		}
+
+		res := Delta(previous, next)
+		assert.ElementsMatch(t, next[0].Items, res.ToAdd[0].Items)
+		assert.ElementsMatch(t, previous[0].Items, res.ToDelete[0].Items)
+	})
 }
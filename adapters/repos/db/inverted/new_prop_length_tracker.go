@@ -15,10 +15,13 @@ import (
 	"encoding/json"
 	"math"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 var MAX_BUCKETS = 64
@@ -35,6 +38,9 @@ type JsonPropertyLengthTracker struct {
 	sync.Mutex
 	UnlimitedBuckets bool
 	logger           logrus.FieldLogger
+
+	// nil unless the tracker file is encrypted
+	encryption *diskio.Encryption
 }
 
 // This class replaces the old PropertyLengthTracker.  It fixes a bug and provides a
@@ -61,8 +67,8 @@ type JsonPropertyLengthTracker struct {
 //
 // Note that some of the code in this file is forced by the need to be backwards-compatible with the old format.  Once we are confident that all users have migrated to the new format, we can remove the old format code and simplify this file.
 
-// NewJsonPropertyLengthTracker creates a new tracker and loads the data from the given path.  If the file is in the old format, it will be converted to the new format.
-func NewJsonPropertyLengthTracker(path string, logger logrus.FieldLogger) (t *JsonPropertyLengthTracker, err error) {
+// NewJsonPropertyLengthTracker creates a new tracker and loads the data from the given path.  If the file is in the old format, it will be converted to the new format.  The file is encrypted with enc unless it is nil, encrypted files are always in the new format.
+func NewJsonPropertyLengthTracker(path string, enc *diskio.Encryption, logger logrus.FieldLogger) (t *JsonPropertyLengthTracker, err error) {
 	// Recover and return empty tracker on panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -71,6 +77,7 @@ func NewJsonPropertyLengthTracker(path string, logger logrus.FieldLogger) (t *Js
 				data:             &PropLenData{make(map[string]map[int]int), make(map[string]int), make(map[string]int)},
 				path:             path,
 				UnlimitedBuckets: false,
+				encryption:       enc,
 			}
 			err = errors.Errorf("Recovered from panic in NewJsonPropertyLengthTracker, original error: %v", r)
 		}
@@ -81,10 +88,11 @@ func NewJsonPropertyLengthTracker(path string, logger logrus.FieldLogger) (t *Js
 		path:             path,
 		UnlimitedBuckets: false,
 		logger:           logger,
+		encryption:       enc,
 	}
 
 	// read the file into memory
-	bytes, err := os.ReadFile(path)
+	bytes, err := enc.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) { // File doesn't exist, probably a new class(or a recount), return empty tracker
 			t.Flush(false)
@@ -208,6 +216,61 @@ func (t *JsonPropertyLengthTracker) UnTrackProperty(propName string, value float
 	return nil
 }
 
+// Moves the tracked values of a property to a new name. The values of the
+// leaves of a nested property, tracked by their dotted paths, are moved along.
+func (t *JsonPropertyLengthTracker) RenameProperty(propName, newPropName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		return
+	}
+	for _, name := range t.trackedNames(propName) {
+		newName := newPropName + strings.TrimPrefix(name, propName)
+		if bucketed, ok := t.data.BucketedData[name]; ok {
+			t.data.BucketedData[newName] = bucketed
+			delete(t.data.BucketedData, name)
+		}
+		if sum, ok := t.data.SumData[name]; ok {
+			t.data.SumData[newName] = sum
+			delete(t.data.SumData, name)
+		}
+		if count, ok := t.data.CountData[name]; ok {
+			t.data.CountData[newName] = count
+			delete(t.data.CountData, name)
+		}
+	}
+}
+
+// Removes all tracked values of a property, including those of the leaves of
+// a nested property
+func (t *JsonPropertyLengthTracker) RemoveProperty(propName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		return
+	}
+	for _, name := range t.trackedNames(propName) {
+		delete(t.data.BucketedData, name)
+		delete(t.data.SumData, name)
+		delete(t.data.CountData, name)
+	}
+}
+
+// trackedNames returns propName and the dotted paths of the leaves of propName
+// which have tracked values
+func (t *JsonPropertyLengthTracker) trackedNames(propName string) []string {
+	names := []string{propName}
+	prefix := propName + schema.NestedPathSeparator
+	for name := range t.data.CountData {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // Returns the bucket that the given value belongs to
 func (t *JsonPropertyLengthTracker) bucketFromValue(value float32) int {
 	if t.UnlimitedBuckets {
@@ -296,7 +359,7 @@ func (t *JsonPropertyLengthTracker) Flush(flushBackup bool) error {
 	// Do a write+rename to avoid corrupting the file if we crash while writing
 	tempfile := filename + ".tmp"
 
-	err = os.WriteFile(tempfile, bytes, 0o666)
+	err = t.encryption.WriteFile(tempfile, bytes, 0o666)
 	if err != nil {
 		return err
 	}
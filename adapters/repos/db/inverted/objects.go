@@ -215,7 +215,10 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		items, err = a.TextProp(prop, in)
+		if err != nil {
+			return nil, err
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -355,7 +358,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		var err error
+		items, err = a.TextProp(prop, []string{asString})
+		if err != nil {
+			return nil, err
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -582,6 +589,12 @@ func HasSearchableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether the searchable index of the property stores the
+// positions of each term, which phrase and proximity queries rely on
+func HasPositionsIndex(prop *models.Property) bool {
+	return HasSearchableIndex(prop) && prop.IndexPositions != nil && *prop.IndexPositions
+}
+
 // Indicates whether property should be indexed
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
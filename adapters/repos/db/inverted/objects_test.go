@@ -713,6 +713,120 @@ func TestAnalyzeObject(t *testing.T) {
 			assert.ElementsMatch(t, expected[i].Items, res[i].Items)
 		}
 	})
+
+	t.Run("with analyzer properties", func(t *testing.T) {
+		class := &models.Class{
+			Class: "Article",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"english": {
+						Stemmer:   "english",
+						Stopwords: &models.StopwordConfig{Preset: "en"},
+					},
+				},
+			},
+			Properties: []*models.Property{
+				{
+					Name:         "title",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationWord,
+					Analyzer:     "english",
+				},
+				{
+					Name:         "tags",
+					DataType:     schema.DataTypeTextArray.PropString(),
+					Tokenization: models.PropertyTokenizationWord,
+					Analyzer:     "english",
+				},
+			},
+		}
+		sch := map[string]interface{}{
+			"title": "The runners are running",
+			"tags":  []interface{}{"connected", "connections"},
+		}
+
+		res, err := NewAnalyzer(nil).WithClass(class).
+			Object(sch, class.Properties, strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+		require.Nil(t, err)
+
+		byName := map[string]Property{}
+		for _, prop := range res {
+			byName[prop.Name] = prop
+		}
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("runner"), TermFrequency: 1},
+			{Data: []byte("run"), TermFrequency: 1},
+		}, byName["title"].Items)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("connect"), TermFrequency: 2},
+		}, byName["tags"].Items)
+	})
+
+	t.Run("with positions", func(t *testing.T) {
+		vTrue := true
+		props := []*models.Property{
+			{
+				Name:           "title",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "tags",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "description",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		}
+		sch := map[string]interface{}{
+			"title":       "to be or not to be",
+			"tags":        []interface{}{"quick fox", "fox"},
+			"description": "to be",
+		}
+
+		res, err := a.Object(sch, props, strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+		require.Nil(t, err)
+
+		byName := map[string]Property{}
+		for _, prop := range res {
+			byName[prop.Name] = prop
+		}
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("to"), TermFrequency: 2, Positions: []uint32{0, 4}},
+			{Data: []byte("be"), TermFrequency: 2, Positions: []uint32{1, 5}},
+			{Data: []byte("or"), TermFrequency: 1, Positions: []uint32{2}},
+			{Data: []byte("not"), TermFrequency: 1, Positions: []uint32{3}},
+		}, byName["title"].Items)
+		// values of arrays are separated by a gap
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("quick"), TermFrequency: 1, Positions: []uint32{0}},
+			{Data: []byte("fox"), TermFrequency: 2, Positions: []uint32{1, 2 + positionGap}},
+		}, byName["tags"].Items)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("to"), TermFrequency: 1},
+			{Data: []byte("be"), TermFrequency: 1},
+		}, byName["description"].Items)
+	})
+
+	t.Run("with undefined analyzer", func(t *testing.T) {
+		props := []*models.Property{
+			{
+				Name:     "title",
+				DataType: schema.DataTypeText.PropString(),
+				Analyzer: "english",
+			},
+		}
+
+		_, err := a.Object(map[string]interface{}{"title": "hello"}, props,
+			strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `analyzer "english" of property "title" is not defined`)
+	})
 }
 
 func TestConvertSliceToUntyped(t *testing.T) {
@@ -0,0 +1,329 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// phraseQuery is a sequence of words which needs to appear in a property
+// within slop positions. Ordered phrases need to appear in the given order,
+// unordered phrases (the near operator) in any order.
+type phraseQuery struct {
+	text    string
+	slop    int
+	ordered bool
+}
+
+var (
+	phraseSlopRegexp = regexp.MustCompile(`^~(\d+)`)
+	nearRegexp       = regexp.MustCompile(`^NEAR/(\d+)$`)
+)
+
+// parseQuery extracts phrase and proximity operators from a keyword query.
+// Phrases are written in double quotes with an optional slop, e.g.
+// "quick fox"~2, proximity as two words joined by NEAR/n, e.g.
+// quick NEAR/3 fox. The returned query has all operators removed but keeps
+// the words of phrases, so that they still contribute to the score. Quotes
+// without a closing quote are ignored.
+func parseQuery(query string) (string, []phraseQuery) {
+	if !strings.Contains(query, `"`) && !strings.Contains(query, "NEAR/") {
+		return query, nil
+	}
+
+	var (
+		phrases []phraseQuery
+		rest    []string
+	)
+
+	remaining := query
+	for {
+		start := strings.Index(remaining, `"`)
+		if start < 0 {
+			break
+		}
+		end := strings.Index(remaining[start+1:], `"`)
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		rest = append(rest, remaining[:start])
+		text := remaining[start+1 : end]
+		remaining = remaining[end+1:]
+
+		phrase := phraseQuery{text: text, ordered: true}
+		if m := phraseSlopRegexp.FindStringSubmatch(remaining); m != nil {
+			phrase.slop, _ = strconv.Atoi(m[1])
+			remaining = remaining[len(m[0]):]
+		}
+		if strings.TrimSpace(text) != "" {
+			phrases = append(phrases, phrase)
+			rest = append(rest, text)
+		}
+	}
+	rest = append(rest, strings.ReplaceAll(remaining, `"`, " "))
+
+	// proximity operators are only recognized outside of phrases
+	var words []string
+	for _, part := range rest {
+		words = append(words, strings.Fields(part)...)
+	}
+	out := make([]string, 0, len(words))
+	for i, word := range words {
+		m := nearRegexp.FindStringSubmatch(word)
+		if m == nil {
+			out = append(out, word)
+			continue
+		}
+		if i == 0 || i == len(words)-1 {
+			continue
+		}
+		slop, _ := strconv.Atoi(m[1])
+		phrases = append(phrases, phraseQuery{
+			text: words[i-1] + " " + words[i+1],
+			slop: slop,
+		})
+	}
+
+	return strings.Join(out, " "), phrases
+}
+
+// withPhrases restricts the allow list to documents matching all phrases of
+// the query and strips the phrase and proximity operators from the query
+func (b *BM25Searcher) withPhrases(class *models.Class, filterDocIds helpers.AllowList,
+	params searchparams.KeywordRanking,
+) (helpers.AllowList, searchparams.KeywordRanking, error) {
+	query, phrases := parseQuery(params.Query)
+	params.Query = query
+	if len(phrases) == 0 {
+		return filterDocIds, params, nil
+	}
+
+	propNames := make([]string, len(params.Properties))
+	for i, prop := range params.Properties {
+		propNames[i] = strings.Split(prop, "^")[0]
+	}
+
+	allow, err := b.phraseAllowList(class, propNames, phrases)
+	if err != nil {
+		return nil, params, err
+	}
+
+	if filterDocIds == nil {
+		return allow, params, nil
+	}
+
+	combined := helpers.NewAllowList()
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if filterDocIds.Contains(id) {
+			combined.Insert(id)
+		}
+	}
+	return combined, params, nil
+}
+
+// phraseAllowList returns the documents which match all phrases in at least
+// one of the given properties. All properties need to index positions.
+func (b *BM25Searcher) phraseAllowList(class *models.Class, propNames []string,
+	phrases []phraseQuery,
+) (helpers.AllowList, error) {
+	var out *sroar.Bitmap
+	for _, phrase := range phrases {
+		matches := sroar.NewBitmap()
+		for _, propName := range propNames {
+			if err := b.matchPhrase(class, propName, phrase, matches); err != nil {
+				return nil, err
+			}
+		}
+
+		if out == nil {
+			out = matches
+		} else {
+			out.And(matches)
+		}
+	}
+
+	return helpers.NewAllowListFromBitmap(out), nil
+}
+
+func (b *BM25Searcher) matchPhrase(class *models.Class, propName string,
+	phrase phraseQuery, matches *sroar.Bitmap,
+) error {
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return err
+	}
+	if !HasPositionsIndex(prop) {
+		return inverted.NewMissingPositionsIndexError(propName)
+	}
+
+	terms, err := phraseTerms(class, prop, phrase.text)
+	if err != nil {
+		return err
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return fmt.Errorf("could not find bucket for property %v", propName)
+	}
+
+	// postings of each distinct term by doc id
+	postings := make(map[string]map[uint64][]uint32, len(terms))
+	for _, term := range terms {
+		if _, ok := postings[term]; ok {
+			continue
+		}
+		pairs, err := bucket.MapList([]byte(term))
+		if err != nil {
+			return err
+		}
+		if len(pairs) == 0 {
+			return nil
+		}
+
+		byDoc := make(map[uint64][]uint32, len(pairs))
+		for _, pair := range pairs {
+			byDoc[binary.BigEndian.Uint64(pair.Key)] = decodePositions(pair.Value)
+		}
+		postings[term] = byDoc
+	}
+
+	positions := make([][]uint32, len(terms))
+Docs:
+	for docID, first := range postings[terms[0]] {
+		positions[0] = first
+		for i := 1; i < len(terms); i++ {
+			pos, ok := postings[terms[i]][docID]
+			if !ok {
+				continue Docs
+			}
+			positions[i] = pos
+		}
+
+		if phrase.ordered && matchOrdered(positions, phrase.slop) ||
+			!phrase.ordered && matchUnordered(positions, phrase.slop) {
+			matches.Set(docID)
+		}
+	}
+
+	return nil
+}
+
+// phraseTerms analyzes the phrase the same way the property was indexed.
+// Stopwords are kept for the fixed tokenizations, as they are indexed as
+// well and are part of the phrase.
+func phraseTerms(class *models.Class, prop *models.Property, text string) ([]string, error) {
+	pipeline, err := analysis.ForProperty(class, prop)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline != nil {
+		return pipeline.Analyze(text), nil
+	}
+	return helpers.Tokenize(prop.Tokenization, text), nil
+}
+
+// decodePositions reads the positions following frequency and property
+// length of a searchable index value
+func decodePositions(value []byte) []uint32 {
+	if len(value) <= 8 {
+		return nil
+	}
+
+	out := make([]uint32, (len(value)-8)/4)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(value[8+4*i : 12+4*i])
+	}
+	return out
+}
+
+// matchOrdered checks if the terms appear in order with at most slop other
+// positions between them in total. Positions are sorted ascending, so for
+// each start the earliest following position of every term is the best
+// candidate.
+func matchOrdered(positions [][]uint32, slop int) bool {
+	for _, start := range positions[0] {
+		prev := start
+		ok := true
+		for i := 1; i < len(positions); i++ {
+			next, found := firstAfter(positions[i], prev)
+			if !found {
+				ok = false
+				break
+			}
+			prev = next
+		}
+		if ok && int(prev-start)-(len(positions)-1) <= slop {
+			return true
+		}
+		if !ok {
+			// later starts can't find the remaining terms either
+			return false
+		}
+	}
+	return false
+}
+
+// matchUnordered checks if each consecutive pair of terms appears within
+// slop positions of each other in any order
+func matchUnordered(positions [][]uint32, slop int) bool {
+	for i := 1; i < len(positions); i++ {
+		if !withinDistance(positions[i-1], positions[i], slop) {
+			return false
+		}
+	}
+	return true
+}
+
+func withinDistance(a, b []uint32, slop int) bool {
+	for _, pa := range a {
+		for _, pb := range b {
+			if pa == pb {
+				continue
+			}
+			dist := int(pa) - int(pb)
+			if dist < 0 {
+				dist = -dist
+			}
+			if dist-1 <= slop {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func firstAfter(positions []uint32, after uint32) (uint32, bool) {
+	for _, pos := range positions {
+		if pos > after {
+			return pos, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name            string
+		query           string
+		expectedQuery   string
+		expectedPhrases []phraseQuery
+	}{
+		{
+			name:          "no operators",
+			query:         "quick brown fox",
+			expectedQuery: "quick brown fox",
+		},
+		{
+			name:            "phrase",
+			query:           `jumps "quick brown fox" high`,
+			expectedQuery:   "jumps quick brown fox high",
+			expectedPhrases: []phraseQuery{{text: "quick brown fox", ordered: true}},
+		},
+		{
+			name:            "phrase with slop",
+			query:           `"quick fox"~2 jumps`,
+			expectedQuery:   "quick fox jumps",
+			expectedPhrases: []phraseQuery{{text: "quick fox", slop: 2, ordered: true}},
+		},
+		{
+			name:          "multiple phrases",
+			query:         `"quick fox" "lazy dog"~1`,
+			expectedQuery: "quick fox lazy dog",
+			expectedPhrases: []phraseQuery{
+				{text: "quick fox", ordered: true},
+				{text: "lazy dog", slop: 1, ordered: true},
+			},
+		},
+		{
+			name:            "proximity",
+			query:           "quick NEAR/3 fox",
+			expectedQuery:   "quick fox",
+			expectedPhrases: []phraseQuery{{text: "quick fox", slop: 3}},
+		},
+		{
+			name:          "proximity without operand",
+			query:         "NEAR/3 fox",
+			expectedQuery: "fox",
+		},
+		{
+			name:          "unterminated quote",
+			query:         `quick "fox`,
+			expectedQuery: "quick fox",
+		},
+		{
+			name:          "empty phrase",
+			query:         `quick "" fox`,
+			expectedQuery: "quick fox",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, phrases := parseQuery(test.query)
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedPhrases, phrases)
+		})
+	}
+}
+
+func TestMatchPositions(t *testing.T) {
+	// positions of "quick", "brown" and "fox"
+	adjacent := [][]uint32{{1}, {2}, {3}}
+	gap := [][]uint32{{1}, {4}, {5}}
+	reversed := [][]uint32{{5}, {3}, {1}}
+
+	t.Run("ordered", func(t *testing.T) {
+		assert.True(t, matchOrdered(adjacent, 0))
+		assert.False(t, matchOrdered(gap, 1))
+		assert.True(t, matchOrdered(gap, 2))
+		assert.False(t, matchOrdered(reversed, 10))
+		assert.True(t, matchOrdered([][]uint32{{1, 10}, {7, 11}}, 0))
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		assert.True(t, matchUnordered(adjacent, 0))
+		assert.True(t, matchUnordered(reversed, 1))
+		assert.False(t, matchUnordered(reversed, 0))
+		// the same position never matches, e.g. for repeated terms
+		assert.False(t, matchUnordered([][]uint32{{4}, {4}}, 5))
+	})
+}
+
+func TestDecodePositions(t *testing.T) {
+	value := make([]byte, 16)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(2))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(7))
+	binary.LittleEndian.PutUint32(value[8:12], 3)
+	binary.LittleEndian.PutUint32(value[12:16], 105)
+
+	assert.Equal(t, []uint32{3, 105}, decodePositions(value))
+	assert.Nil(t, decodePositions(value[:8]))
+}
@@ -72,7 +72,7 @@ func Test_PropertyLengthTracker(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
-				tracker, err := NewJsonPropertyLengthTracker(trackerPath, l)
+				tracker, err := NewJsonPropertyLengthTracker(trackerPath, nil, l)
 				require.Nil(t, err)
 
 				actualMean := float32(0)
@@ -95,7 +95,7 @@ func Test_PropertyLengthTracker(t *testing.T) {
 	})
 
 	t.Run("test untrack", func(t *testing.T) {
-		tracker, err := NewJsonPropertyLengthTracker(trackerPath, l)
+		tracker, err := NewJsonPropertyLengthTracker(trackerPath, nil, l)
 		require.Nil(t, err)
 
 		tracker.TrackProperty("test-prop", 1)
@@ -149,7 +149,7 @@ func Test_PropertyLengthTracker(t *testing.T) {
 		}
 
 		// This time we use a single tracker
-		tracker, err := NewJsonPropertyLengthTracker(trackerPath, l)
+		tracker, err := NewJsonPropertyLengthTracker(trackerPath, nil, l)
 		require.Nil(t, err)
 
 		for _, prop := range props {
@@ -174,7 +174,7 @@ func Test_PropertyLengthTracker(t *testing.T) {
 
 	t.Run("with more properties that can fit on one page", func(t *testing.T) {
 		// This time we use a single tracker
-		tracker, err := NewJsonPropertyLengthTracker(trackerPath, l)
+		tracker, err := NewJsonPropertyLengthTracker(trackerPath, nil, l)
 		require.Nil(t, err)
 
 		create20PropsAndVerify(t, tracker)
@@ -237,7 +237,7 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 	l := logrus.New()
 
 	t.Run("initializing an empty tracker, no file present", func(t *testing.T) {
-		tr, err := NewJsonPropertyLengthTracker(path, l)
+		tr, err := NewJsonPropertyLengthTracker(path, nil, l)
 		require.Nil(t, err)
 		tracker = tr
 	})
@@ -256,7 +256,7 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 
 	var secondTracker *JsonPropertyLengthTracker
 	t.Run("initializing a new tracker from the same file", func(t *testing.T) {
-		tr, err := NewJsonPropertyLengthTracker(path, l)
+		tr, err := NewJsonPropertyLengthTracker(path, nil, l)
 		require.Nil(t, err)
 		secondTracker = tr
 	})
@@ -306,7 +306,7 @@ func TestFormatConversion(t *testing.T) {
 	l := logrus.New()
 
 	t.Run("initializing a new tracker from the same file", func(t *testing.T) {
-		tr, err := NewJsonPropertyLengthTracker(path, l)
+		tr, err := NewJsonPropertyLengthTracker(path, nil, l)
 		require.Nil(t, err)
 		newTracker = tr
 	})
@@ -618,3 +618,72 @@ func Test_PropertyLengthTracker_Overflow(t *testing.T) {
 	err = tracker.TrackProperty("OVERFLOW", float32(123))
 	require.NotNil(t, err)
 }
+
+func Test_PropertyLengthTracker_RenameProperty(t *testing.T) {
+	dirName := t.TempDir()
+	path := path.Join(dirName, "my_test_shard")
+
+	tracker, err := NewJsonPropertyLengthTracker(path, nil, logrus.New())
+	require.Nil(t, err)
+
+	require.Nil(t, tracker.TrackProperty("title", 4))
+	require.Nil(t, tracker.TrackProperty("title", 8))
+
+	tracker.RenameProperty("title", "headline")
+
+	res, err := tracker.PropertyMean("headline")
+	require.Nil(t, err)
+	assert.InEpsilon(t, float32(6), res, 0.1)
+
+	res, err = tracker.PropertyMean("title")
+	require.Nil(t, err)
+	assert.Equal(t, float32(0), res)
+}
+
+func Test_PropertyLengthTracker_RemoveProperty(t *testing.T) {
+	dirName := t.TempDir()
+	path := path.Join(dirName, "my_test_shard")
+
+	tracker, err := NewJsonPropertyLengthTracker(path, nil, logrus.New())
+	require.Nil(t, err)
+
+	require.Nil(t, tracker.TrackProperty("title", 4))
+	require.Nil(t, tracker.TrackProperty("body", 8))
+
+	tracker.RemoveProperty("title")
+
+	_, count, _, err := tracker.PropertyTally("title")
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	res, err := tracker.PropertyMean("body")
+	require.Nil(t, err)
+	assert.InEpsilon(t, float32(8), res, 0.1)
+}
+
+func Test_PropertyLengthTracker_NestedProperty(t *testing.T) {
+	dirName := t.TempDir()
+	path := path.Join(dirName, "my_test_shard")
+
+	tracker, err := NewJsonPropertyLengthTracker(path, nil, logrus.New())
+	require.Nil(t, err)
+
+	require.Nil(t, tracker.TrackProperty("address.city", 4))
+	require.Nil(t, tracker.TrackProperty("addressBook", 8))
+
+	tracker.RenameProperty("address", "home")
+
+	res, err := tracker.PropertyMean("home.city")
+	require.Nil(t, err)
+	assert.InEpsilon(t, float32(4), res, 0.1)
+
+	tracker.RemoveProperty("home")
+
+	_, count, _, err := tracker.PropertyTally("home.city")
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	res, err = tracker.PropertyMean("addressBook")
+	require.Nil(t, err)
+	assert.InEpsilon(t, float32(8), res, 0.1)
+}
@@ -28,12 +28,13 @@ type propValuePair struct {
 	operator filters.Operator
 
 	// set for all values that can be served by an inverted index, i.e. anything
-	// that's not a geoRange
+	// that's not a geo filter
 	value []byte
 
-	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
-	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
+	// only set if operator.IsGeo(), as that cannot be served by a byte value
+	// from an inverted index. Holds a filters.GeoRange, filters.GeoBoundingBox
+	// or filters.GeoPolygon
+	valueGeo           interface{}
 	docIDs             docBitmap
 	children           []*propValuePair
 	hasFilterableIndex bool
@@ -44,7 +45,7 @@ func newPropValuePair() propValuePair {
 	return propValuePair{docIDs: newDocBitmap()}
 }
 
-func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
+func (pv *propValuePair) fetchDocIDs(ctx context.Context, s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
 		var bucketName string
 		if pv.hasFilterableIndex {
@@ -75,14 +76,13 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 				"add `indexTimestamps: true` to the invertedIndexConfig")
 		}
 
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
+		if b == nil && !pv.operator.IsGeo() {
+			// a nil bucket is ok for a geo filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
-		ctx := context.TODO() // TODO: pass through instead of spawning new
 		dbm, err := s.docBitmap(ctx, b, limit, pv)
 		if err != nil {
 			return err
@@ -100,7 +100,7 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 				// otherwise we run into situations where each subfilter on their own
 				// runs into the limit, possibly yielding in "less than limit" results
 				// after merging.
-				err := child.fetchDocIDs(s, 0)
+				err := child.fetchDocIDs(ctx, s, 0)
 				if err != nil {
 					return errors.Wrapf(err, "nested child %d", i)
 				}
@@ -79,6 +79,10 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorPrefix:
+		return rr.prefix(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
 	default:
@@ -246,6 +250,24 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	return nil
 }
 
+// prefix reads all rows whose key starts with the value. As keys are sorted,
+// this is a single range read.
+func (rr *RowReader) prefix(ctx context.Context, readFn ReadFn) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	return readPrefix[[][]byte](ctx, c, rr.value, readFn)
+}
+
+// fuzzy reads all rows whose key is within the maximum edit distance of the
+// value
+func (rr *RowReader) fuzzy(ctx context.Context, readFn ReadFn) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	return readFuzzy[[][]byte](ctx, c, rr.value, readFn)
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReader) newCursor() *lsmkv.CursorSet {
@@ -74,6 +74,10 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFnFrequency)
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorPrefix:
+		return rr.prefix(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
 	}
@@ -253,6 +257,24 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 	return nil
 }
 
+// prefix reads all rows whose key starts with the value. As keys are sorted,
+// this is a single range read.
+func (rr *RowReaderFrequency) prefix(ctx context.Context, readFn ReadFnFrequency) error {
+	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
+	defer c.Close()
+
+	return readPrefix[[]lsmkv.MapPair](ctx, c, rr.value, readFn)
+}
+
+// fuzzy reads all rows whose key is within the maximum edit distance of the
+// value
+func (rr *RowReaderFrequency) fuzzy(ctx context.Context, readFn ReadFnFrequency) error {
+	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
+	defer c.Close()
+
+	return readFuzzy[[]lsmkv.MapPair](ctx, c, rr.value, readFn)
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReaderFrequency) newCursor(
@@ -85,6 +85,10 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn RoaringSetReadFn
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorPrefix:
+		return rr.prefix(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
 	}
@@ -244,3 +248,21 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 
 	return nil
 }
+
+// prefix reads all rows whose key starts with the value. As keys are sorted,
+// this is a single range read.
+func (rr *RowReaderRoaringSet) prefix(ctx context.Context, readFn RoaringSetReadFn) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	return readPrefix[*sroar.Bitmap](ctx, c, rr.value, readFn)
+}
+
+// fuzzy reads all rows whose key is within the maximum edit distance of the
+// value
+func (rr *RowReaderRoaringSet) fuzzy(ctx context.Context, readFn RoaringSetReadFn) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	return readFuzzy[*sroar.Bitmap](ctx, c, rr.value, readFn)
+}
@@ -186,6 +186,43 @@ func TestRowReaderRoaringSet(t *testing.T) {
 				{"hhh", []uint64{11111111, 2222222, 33333333}},
 			},
 		},
+		{
+			name:     "prefix 'ee' value",
+			value:    "ee",
+			operator: filters.OperatorPrefix,
+			expected: []kvData{
+				{"eee", []uint64{11111, 22222, 33333}},
+			},
+		},
+		{
+			name:     "prefix non-matching value",
+			value:    "ef",
+			operator: filters.OperatorPrefix,
+			expected: []kvData{},
+		},
+		{
+			name:     "fuzzy 'cdc' value",
+			value:    "cdc",
+			operator: filters.OperatorFuzzy,
+			expected: []kvData{
+				{"ccc", []uint64{111, 222, 333}},
+			},
+		},
+		{
+			name:     "fuzzy 'cdc~0' value",
+			value:    "cdc~0",
+			operator: filters.OperatorFuzzy,
+			expected: []kvData{},
+		},
+		{
+			name:     "fuzzy 'fg~2' value",
+			value:    "fg~2",
+			operator: filters.OperatorFuzzy,
+			expected: []kvData{
+				{"fff", []uint64{111111, 222222, 333333}},
+				{"ggg", []uint64{1111111, 2222222, 3333333}},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -283,6 +320,14 @@ func (c *dummyCursorRoaringSet) Seek(key []byte) ([]byte, *sroar.Bitmap) {
 	return c.Next()
 }
 
+func (c *dummyCursorRoaringSet) WithContext(ctx context.Context) lsmkv.CursorRoaringSet {
+	return c
+}
+
+func (c *dummyCursorRoaringSet) Err() error {
+	return nil
+}
+
 func (c *dummyCursorRoaringSet) Close() {
 	c.closed = true
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
@@ -92,7 +93,7 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(ctx, it, additional)
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -105,7 +106,7 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 	return lsmSorter.SortDocIDs(ctx, limit, sort, docIDs)
 }
 
-func (s *Searcher) objectsByDocID(it docIDsIterator,
+func (s *Searcher) objectsByDocID(ctx context.Context, it docIDsIterator,
 	additional additional.Properties,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
@@ -118,6 +119,10 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 
 	i := 0
 	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		res, err := bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -165,12 +170,12 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	additional additional.Properties, className schema.ClassName,
 	limit int,
 ) (helpers.AllowList, error) {
-	pv, err := s.extractPropValuePair(filter.Root, className)
+	pv, err := s.extractPropValuePair(ctx, filter.Root, className)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := pv.fetchDocIDs(s, limit); err != nil {
+	if err := pv.fetchDocIDs(ctx, s, limit); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
@@ -182,7 +187,7 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	return helpers.NewAllowListFromBitmap(dbm.docIDs), nil
 }
 
-func (s *Searcher) extractPropValuePair(filter *filters.Clause,
+func (s *Searcher) extractPropValuePair(ctx context.Context, filter *filters.Clause,
 	className schema.ClassName,
 ) (*propValuePair, error) {
 	out := newPropValuePair()
@@ -198,7 +203,7 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		for i, clause := range filter.Operands {
 			i, clause := i, clause
 			eg.Go(func() error {
-				child, err := s.extractPropValuePair(&clause, className)
+				child, err := s.extractPropValuePair(ctx, &clause, className)
 				if err != nil {
 					return errors.Wrapf(err, "nested clause at pos %d", i)
 				}
@@ -236,7 +241,7 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 	}
 
 	if s.onRefProp(property) && len(props) != 1 {
-		return s.extractReferenceFilter(property, filter)
+		return s.extractReferenceFilter(ctx, property, filter)
 	}
 
 	if s.onRefProp(property) && filter.Value.Type == schema.DataTypeInt {
@@ -245,6 +250,10 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return s.extractReferenceCount(property, filter.Value.Value, filter.Operator)
 	}
 
+	if filter.Operator.IsContains() {
+		return s.extractContainsFilter(ctx, filter, className)
+	}
+
 	if filter.Operator == filters.OperatorIsNull {
 		return s.extractPropertyNull(property, filter.Value.Type, filter.Value.Value, filter.Operator)
 	}
@@ -259,19 +268,57 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 			filter.Operator)
 	}
 
+	if filter.Operator == filters.OperatorPrefix || filter.Operator == filters.OperatorFuzzy {
+		if !s.onTokenizableProp(property) {
+			return nil, fmt.Errorf("operator %q can only be used on text/text[] props",
+				filter.Operator.Name())
+		}
+	}
+
 	if s.onTokenizableProp(property) {
-		return s.extractTokenizableProp(property, filter.Value.Type, filter.Value.Value,
-			filter.Operator)
+		return s.extractTokenizableProp(s.schema.GetClass(className), property,
+			filter.Value.Type, filter.Value.Value, filter.Operator)
 	}
 
 	return s.extractPrimitiveProp(property, filter.Value.Type, filter.Value.Value,
 		filter.Operator)
 }
 
-func (s *Searcher) extractReferenceFilter(prop *models.Property,
+// extractContainsFilter matches each element of the array value like an
+// Equal clause. The doc id bitmaps of the elements are merged by the
+// inverted index, a union for ContainsAny and an intersection for
+// ContainsAll, so no objects need to be retrieved to check the arrays.
+func (s *Searcher) extractContainsFilter(ctx context.Context, filter *filters.Clause,
+	className schema.ClassName,
+) (*propValuePair, error) {
+	values, err := filters.ContainsValues(filter.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := filters.OperatorOr
+	if filter.Operator == filters.OperatorContainsAll {
+		operator = filters.OperatorAnd
+	}
+
+	operands := make([]filters.Clause, len(values))
+	for i, value := range values {
+		operands[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       filter.On,
+			Value:    value,
+		}
+	}
+
+	return s.extractPropValuePair(ctx, &filters.Clause{
+		Operator: operator,
+		Operands: operands,
+	}, className)
+}
+
+func (s *Searcher) extractReferenceFilter(ctx context.Context, prop *models.Property,
 	filter *filters.Clause,
 ) (*propValuePair, error) {
-	ctx := context.TODO()
 	return newRefFilterExtractor(s.logger, s.classSearcher, filter, prop).
 		Do(ctx)
 }
@@ -343,16 +390,20 @@ func (s *Searcher) extractReferenceCount(prop *models.Property, value interface{
 func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator,
 ) (*propValuePair, error) {
-	if valueType != schema.DataTypeGeoCoordinates {
-		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", prop.Name)
+	if valueType != schema.DataTypeGeoCoordinates || !operator.IsGeo() {
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only "+
+			"be used with geoRange, geoBoundingBox or geoPolygon filters", prop.Name)
 	}
 
-	parsed := value.(filters.GeoRange)
+	switch value.(type) {
+	case filters.GeoRange, filters.GeoBoundingBox, filters.GeoPolygon:
+	default:
+		return nil, fmt.Errorf("prop %q: unsupported geo filter value %T", prop.Name, value)
+	}
 
 	return &propValuePair{
 		value:              nil, // not going to be served by an inverted index
-		valueGeoRange:      &parsed,
+		valueGeo:           value,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndex(prop),
@@ -405,6 +456,8 @@ func (s *Searcher) extractInternalProp(propName string, propType schema.DataType
 		return s.extractIDProp(propName, propType, value, operator)
 	case filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix:
 		return s.extractTimestampProp(propName, propType, value, operator)
+	case filters.InternalPropTenant:
+		return s.extractTenantProp(value, operator)
 	default:
 		return nil, fmt.Errorf(
 			"failed to extract internal prop, unsupported internal prop '%s'", propName)
@@ -437,6 +490,21 @@ func (s *Searcher) extractIDProp(propName string, propType schema.DataType,
 	}, nil
 }
 
+func (s *Searcher) extractTenantProp(value interface{}, operator filters.Operator,
+) (*propValuePair, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected tenant to be string, got '%T'", value)
+	}
+
+	return &propValuePair{
+		value:              []byte(v),
+		prop:               filters.InternalPropTenant,
+		operator:           operator,
+		hasFilterableIndex: true,
+	}, nil
+}
+
 func (s *Searcher) extractTimestampProp(propName string, propType schema.DataType, value interface{},
 	operator filters.Operator,
 ) (*propValuePair, error) {
@@ -481,19 +549,44 @@ func (s *Searcher) extractTimestampProp(propName string, propType schema.DataTyp
 	}, nil
 }
 
-func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema.DataType,
-	value interface{}, operator filters.Operator,
+func (s *Searcher) extractTokenizableProp(class *models.Class, prop *models.Property,
+	propType schema.DataType, value interface{}, operator filters.Operator,
 ) (*propValuePair, error) {
 	var terms []string
+	var pipeline *analysis.Pipeline
+	fuzzyDist := 0
 
 	switch propType {
 	case schema.DataTypeText:
+		// the value has to pass through the same analyzer pipeline as the
+		// indexed text, otherwise stemmed or filtered terms would not match
+		var err error
+		pipeline, err = analysis.ForProperty(class, prop)
+		if err != nil {
+			return nil, err
+		}
+
+		text := value.(string)
+		if operator == filters.OperatorFuzzy {
+			// the edit distance suffix would be removed by the tokenizer, it is
+			// added to every single term again below
+			text, fuzzyDist, err = filters.ParseFuzzyValue(text)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
-			terms = helpers.TokenizeWithWildcards(prop.Tokenization, value.(string))
-		} else {
-			terms = helpers.Tokenize(prop.Tokenization, value.(string))
+		switch {
+		case pipeline != nil && operator == filters.OperatorLike:
+			terms = pipeline.AnalyzeWithWildcards(text)
+		case pipeline != nil:
+			terms = pipeline.Analyze(text)
+		case operator == filters.OperatorLike:
+			terms = helpers.TokenizeWithWildcards(prop.Tokenization, text)
+		default:
+			terms = helpers.Tokenize(prop.Tokenization, text)
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
@@ -508,9 +601,13 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	propValuePairs := make([]*propValuePair, 0, len(terms))
 	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
+		// analyzer pipelines remove their own stopwords
+		if pipeline == nil && s.stopwords.IsStopword(term) {
 			continue
 		}
+		if operator == filters.OperatorFuzzy {
+			term = filters.FormatFuzzyValue(term, fuzzyDist)
+		}
 		propValuePairs = append(propValuePairs, &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
@@ -615,7 +712,8 @@ func (s *Searcher) onUUIDProp(prop *models.Property) bool {
 }
 
 func (s *Searcher) onInternalProp(propName string) bool {
-	return filters.IsInternalProperty(schema.PropertyName(propName))
+	return filters.IsInternalProperty(schema.PropertyName(propName)) ||
+		propName == filters.InternalPropTenant
 }
 
 func (s *Searcher) onTokenizableProp(prop *models.Property) bool {
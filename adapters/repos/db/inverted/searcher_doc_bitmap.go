@@ -28,7 +28,7 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// geo props cannot be served by the inverted index and they require an
 	// external index. So, instead of trying to serve this chunk of the filter
 	// request internally, we can pass it to an external geo index
-	if pv.operator == filters.OperatorWithinGeoRange {
+	if pv.operator.IsGeo() {
 		return s.docBitmapGeo(ctx, pv)
 	}
 	// all other operators perform operations on the inverted index which we
@@ -143,11 +143,22 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 		return out, nil
 	}
 
-	res, err := propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	var res *sroar.Bitmap
+	var err error
+	switch value := pv.valueGeo.(type) {
+	case filters.GeoRange:
+		res, err = propIndex.GeoIndex.WithinRange(ctx, value)
+	case filters.GeoBoundingBox:
+		res, err = propIndex.GeoIndex.WithinBoundingBox(ctx, value)
+	case filters.GeoPolygon:
+		res, err = propIndex.GeoIndex.WithinPolygon(ctx, value)
+	default:
+		return out, fmt.Errorf("unsupported geo filter value %T on prop %q", pv.valueGeo, pv.prop)
+	}
 	if err != nil {
-		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
+		return out, errors.Wrapf(err, "geo index search on prop %q", pv.prop)
 	}
 
-	out.docIDs.SetMany(res)
+	out.docIDs = res
 	return out, nil
 }
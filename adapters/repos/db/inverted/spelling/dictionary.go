@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package spelling corrects misspelled query terms using the terms of the
+// inverted index. Dictionaries follow the symmetric delete approach of
+// SymSpell: every term is stored under all variants of it with up to the
+// maximum edit distance of characters deleted. A lookup generates the
+// deletes of the misspelled term and only needs to compute the exact edit
+// distance to the few terms sharing one of them.
+package spelling
+
+import (
+	"sort"
+)
+
+const (
+	DefaultMaxEditDistance = 2
+	// MaxDictionaryTerms bounds the memory of a dictionary. Terms beyond it
+	// are not added.
+	MaxDictionaryTerms = 250_000
+	// MinTermLength is the length in runes below which terms are neither
+	// added nor corrected, as short terms have too many close neighbours
+	MinTermLength = 3
+	// deletes are only generated for a prefix of each term, which keeps
+	// their number independent of the term length at the cost of
+	// verifying some more candidates
+	prefixLength = 7
+)
+
+type Suggestion struct {
+	Term      string
+	Distance  int
+	Frequency int64
+}
+
+type Dictionary struct {
+	maxDistance int
+	frequencies map[string]int64
+	deletes     map[string][]string
+}
+
+func NewDictionary(maxDistance int) *Dictionary {
+	return &Dictionary{
+		maxDistance: maxDistance,
+		frequencies: map[string]int64{},
+		deletes:     map[string][]string{},
+	}
+}
+
+// Add adds a term with the number of documents containing it. Adding a term
+// again adds to its frequency.
+func (d *Dictionary) Add(term string, frequency int64) {
+	if frequency <= 0 || len([]rune(term)) < MinTermLength {
+		return
+	}
+	if _, ok := d.frequencies[term]; ok {
+		d.frequencies[term] += frequency
+		return
+	}
+	if len(d.frequencies) >= MaxDictionaryTerms {
+		return
+	}
+
+	d.frequencies[term] = frequency
+	for del := range deletes(prefix(term), d.maxDistance) {
+		d.deletes[del] = append(d.deletes[del], term)
+	}
+}
+
+func (d *Dictionary) Len() int {
+	return len(d.frequencies)
+}
+
+func (d *Dictionary) Frequency(term string) int64 {
+	return d.frequencies[term]
+}
+
+// Lookup returns the terms within maxDistance edits of the given term,
+// closest and then most frequent first. maxDistance is capped to the one
+// the dictionary was built for.
+func (d *Dictionary) Lookup(term string, maxDistance int) []Suggestion {
+	if maxDistance > d.maxDistance {
+		maxDistance = d.maxDistance
+	}
+
+	seen := map[string]struct{}{}
+	var out []Suggestion
+	for del := range deletes(prefix(term), maxDistance) {
+		for _, candidate := range d.deletes[del] {
+			if _, ok := seen[candidate]; ok {
+				continue
+			}
+			seen[candidate] = struct{}{}
+
+			if dist := distance(term, candidate, maxDistance); dist <= maxDistance {
+				out = append(out, Suggestion{
+					Term:      candidate,
+					Distance:  dist,
+					Frequency: d.frequencies[candidate],
+				})
+			}
+		}
+	}
+
+	sortSuggestions(out)
+	return out
+}
+
+// Dictionaries combines the dictionaries of several shards
+type Dictionaries []*Dictionary
+
+func (ds Dictionaries) Frequency(term string) int64 {
+	var sum int64
+	for _, d := range ds {
+		sum += d.Frequency(term)
+	}
+	return sum
+}
+
+func (ds Dictionaries) Lookup(term string, maxDistance int) []Suggestion {
+	merged := map[string]Suggestion{}
+	for _, d := range ds {
+		for _, s := range d.Lookup(term, maxDistance) {
+			if prev, ok := merged[s.Term]; ok {
+				s.Frequency += prev.Frequency
+			}
+			merged[s.Term] = s
+		}
+	}
+
+	out := make([]Suggestion, 0, len(merged))
+	for _, s := range merged {
+		out = append(out, s)
+	}
+	sortSuggestions(out)
+	return out
+}
+
+// Alternatives returns up to limit corrections of a query given as its
+// terms. Terms found in the dictionaries are kept. The first alternative
+// replaces every unknown term with its best suggestion, the following ones
+// each use a worse suggestion for one of the terms. It returns nil if no
+// term could be corrected.
+func (ds Dictionaries) Alternatives(terms []string, maxDistance, limit int) [][]string {
+	suggestions := make([][]Suggestion, len(terms))
+	corrected := false
+	for i, term := range terms {
+		if len([]rune(term)) < MinTermLength || ds.Frequency(term) > 0 {
+			continue
+		}
+		suggestions[i] = ds.Lookup(term, maxDistance)
+		corrected = corrected || len(suggestions[i]) > 0
+	}
+	if !corrected || limit <= 0 {
+		return nil
+	}
+
+	best := make([]string, len(terms))
+	for i, term := range terms {
+		best[i] = term
+		if len(suggestions[i]) > 0 {
+			best[i] = suggestions[i][0].Term
+		}
+	}
+
+	out := [][]string{best}
+	for i := range terms {
+		for k := 1; k < len(suggestions[i]) && len(out) < limit; k++ {
+			alternative := append([]string{}, best...)
+			alternative[i] = suggestions[i][k].Term
+			out = append(out, alternative)
+		}
+	}
+	return out
+}
+
+func sortSuggestions(s []Suggestion) {
+	sort.Slice(s, func(a, b int) bool {
+		if s[a].Distance != s[b].Distance {
+			return s[a].Distance < s[b].Distance
+		}
+		if s[a].Frequency != s[b].Frequency {
+			return s[a].Frequency > s[b].Frequency
+		}
+		return s[a].Term < s[b].Term
+	})
+}
+
+func prefix(term string) string {
+	runes := []rune(term)
+	if len(runes) > prefixLength {
+		return string(runes[:prefixLength])
+	}
+	return term
+}
+
+// deletes returns the term and all variants of it with up to maxDistance
+// runes deleted
+func deletes(term string, maxDistance int) map[string]struct{} {
+	out := map[string]struct{}{term: {}}
+	current := []string{term}
+	for d := 0; d < maxDistance; d++ {
+		var next []string
+		for _, t := range current {
+			runes := []rune(t)
+			if len(runes) <= 1 {
+				continue
+			}
+			for i := range runes {
+				del := string(runes[:i]) + string(runes[i+1:])
+				if _, ok := out[del]; !ok {
+					out[del] = struct{}{}
+					next = append(next, del)
+				}
+			}
+		}
+		current = next
+	}
+	return out
+}
+
+// distance is the optimal string alignment distance of a and b, which
+// counts the transposition of adjacent runes as a single edit. Distances
+// above max are returned as max+1.
+func distance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > max || -diff > max {
+		return max + 1
+	}
+
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		rowMin := rows[i][0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d := minInt(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d = minInt(d, rows[i-2][j-2]+1)
+			}
+			rows[i][j] = d
+			rowMin = minInt(rowMin, d)
+		}
+		if rowMin > max {
+			return max + 1
+		}
+	}
+
+	if d := rows[len(ra)][len(rb)]; d <= max {
+		return d
+	}
+	return max + 1
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package spelling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"weaviate", "weaviate", 0},
+		{"weaviate", "weavate", 1},
+		{"weaviate", "waeviate", 1},
+		{"weaviate", "weaviatee", 1},
+		{"vector", "vectro", 1},
+		{"vector", "vcetro", 2},
+		{"vector", "hector", 1},
+		{"vector", "doctors", 3},
+		{"café", "cafe", 1},
+	}
+	for _, test := range tests {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			assert.Equal(t, test.want, distance(test.a, test.b, 2))
+		})
+	}
+}
+
+func TestDictionaryLookup(t *testing.T) {
+	d := NewDictionary(DefaultMaxEditDistance)
+	d.Add("journey", 10)
+	d.Add("journal", 4)
+	d.Add("journalism", 3)
+	d.Add("attorney", 5)
+	d.Add("at", 100)
+	d.Add("journal", 2)
+
+	assert.Equal(t, 4, d.Len())
+	assert.Equal(t, int64(6), d.Frequency("journal"))
+	assert.Equal(t, int64(0), d.Frequency("at"), "short terms are not added")
+
+	t.Run("closest term first", func(t *testing.T) {
+		res := d.Lookup("jorney", 2)
+		require.NotEmpty(t, res)
+		assert.Equal(t, Suggestion{Term: "journey", Distance: 1, Frequency: 10}, res[0])
+	})
+
+	t.Run("equal distance by frequency", func(t *testing.T) {
+		res := d.Lookup("journex", 2)
+		require.Len(t, res, 2)
+		assert.Equal(t, "journey", res[0].Term)
+		assert.Equal(t, "journal", res[1].Term)
+	})
+
+	t.Run("long terms beyond the prefix", func(t *testing.T) {
+		res := d.Lookup("journalizm", 2)
+		require.NotEmpty(t, res)
+		assert.Equal(t, "journalism", res[0].Term)
+	})
+
+	t.Run("no term within distance", func(t *testing.T) {
+		assert.Empty(t, d.Lookup("quantum", 2))
+	})
+
+	t.Run("distance is capped", func(t *testing.T) {
+		small := NewDictionary(1)
+		small.Add("journey", 1)
+		assert.Empty(t, small.Lookup("jorny", 2))
+		assert.Len(t, small.Lookup("jorney", 2), 1)
+	})
+}
+
+func TestDictionariesAlternatives(t *testing.T) {
+	a := NewDictionary(DefaultMaxEditDistance)
+	a.Add("quick", 3)
+	a.Add("brown", 5)
+	a.Add("fox", 2)
+	b := NewDictionary(DefaultMaxEditDistance)
+	b.Add("brawn", 1)
+	b.Add("brown", 1)
+	ds := Dictionaries{a, b}
+
+	assert.Equal(t, int64(6), ds.Frequency("brown"))
+
+	t.Run("correct terms", func(t *testing.T) {
+		res := ds.Alternatives([]string{"quick", "brwn", "fox"}, 2, 3)
+		assert.Equal(t, [][]string{
+			{"quick", "brown", "fox"},
+			{"quick", "brawn", "fox"},
+		}, res)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res := ds.Alternatives([]string{"quikc", "brwn"}, 2, 1)
+		assert.Equal(t, [][]string{{"quick", "brown"}}, res)
+	})
+
+	t.Run("nothing to correct", func(t *testing.T) {
+		assert.Nil(t, ds.Alternatives([]string{"quick", "brown"}, 2, 3))
+		assert.Nil(t, ds.Alternatives([]string{"zzzzzz"}, 2, 3))
+	})
+}
@@ -62,6 +62,22 @@ func NewDetectorFromPreset(preset string) (*Detector, error) {
 	return d, nil
 }
 
+// Reload replaces the stopwords of the detector with the ones of the given
+// config, so that searches holding on to the detector pick up a changed
+// config without being recreated
+func (d *Detector) Reload(config models.StopwordConfig) error {
+	updated, err := NewDetectorFromConfig(config)
+	if err != nil {
+		return err
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.stopwords = updated.stopwords
+	return nil
+}
+
 func (d *Detector) SetAdditions(additions []string) {
 	d.Lock()
 	defer d.Unlock()
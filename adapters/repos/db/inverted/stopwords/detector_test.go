@@ -157,3 +157,17 @@ func TestStopwordDetector(t *testing.T) {
 		runTest(t, tests)
 	})
 }
+
+func TestStopwordDetectorReload(t *testing.T) {
+	sd, err := NewDetectorFromConfig(models.StopwordConfig{Preset: "en"})
+	require.Nil(t, err)
+	require.True(t, sd.IsStopword("the"))
+	require.False(t, sd.IsStopword("dog"))
+
+	require.Nil(t, sd.Reload(models.StopwordConfig{Preset: "none", Additions: []string{"dog"}}))
+	require.False(t, sd.IsStopword("the"))
+	require.True(t, sd.IsStopword("dog"))
+
+	require.NotNil(t, sd.Reload(models.StopwordConfig{Preset: "unknown"}))
+	require.True(t, sd.IsStopword("dog"), "a failed reload keeps the stopwords")
+}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package synonyms expands the terms of keyword queries with the synonyms
+// configured for a class. Expansion happens at query time only, so synonym
+// rules can be changed without reindexing.
+package synonyms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// ExpansionEquivalent expands every term of a rule to all other terms
+	ExpansionEquivalent = "equivalent"
+	// ExpansionOneWay expands the first term of a rule to the other terms,
+	// but not vice versa
+	ExpansionOneWay = "oneWay"
+)
+
+// Validate checks that every rule has at least two terms and a known
+// expansion
+func Validate(rules []*models.SynonymRule) error {
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("synonyms.%d: rule must not be empty", i)
+		}
+		switch rule.Expansion {
+		case "", ExpansionEquivalent, ExpansionOneWay:
+		default:
+			return fmt.Errorf("synonyms.%d: expansion %q does not exist, use %q or %q",
+				i, rule.Expansion, ExpansionEquivalent, ExpansionOneWay)
+		}
+		if len(rule.Terms) < 2 {
+			return fmt.Errorf("synonyms.%d: a rule needs at least two terms", i)
+		}
+		for _, term := range rule.Terms {
+			if strings.TrimSpace(term) == "" {
+				return fmt.Errorf("synonyms.%d: terms must not be empty", i)
+			}
+		}
+	}
+	return nil
+}
+
+type mapping struct {
+	match      []string
+	expansions [][]string
+}
+
+// Expander adds the synonyms of the terms of a query to it. The zero value
+// and nil do not expand anything.
+type Expander struct {
+	// mappings are indexed by the first term they match
+	mappings map[string][]mapping
+}
+
+// NewExpander prepares the rules for queries whose terms are produced by
+// analyze. The terms of the rules are run through analyze as well, so that
+// they match regardless of case, punctuation or stemming.
+func NewExpander(rules []*models.SynonymRule, analyze func(string) []string) *Expander {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	e := &Expander{mappings: map[string][]mapping{}}
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		analyzed := analyzeRule(rule, analyze)
+		if len(analyzed) < 2 {
+			continue
+		}
+
+		sources := len(analyzed)
+		if rule.Expansion == ExpansionOneWay {
+			sources = 1
+		}
+
+		for i, match := range analyzed[:sources] {
+			m := mapping{match: match}
+			for j, expansion := range analyzed {
+				if j != i {
+					m.expansions = append(m.expansions, expansion)
+				}
+			}
+			e.mappings[match[0]] = append(e.mappings[match[0]], m)
+		}
+	}
+
+	return e
+}
+
+// analyzeRule returns the distinct analyzed terms of a rule. Terms which
+// are removed by the analyzer entirely are dropped, unless it is the first
+// term of a one way rule, which then has nothing left to expand.
+func analyzeRule(rule *models.SynonymRule, analyze func(string) []string) [][]string {
+	out := make([][]string, 0, len(rule.Terms))
+	for i, term := range rule.Terms {
+		terms := analyze(term)
+		if len(terms) == 0 {
+			if i == 0 && rule.Expansion == ExpansionOneWay {
+				return nil
+			}
+			continue
+		}
+
+		duplicate := false
+		for _, prev := range out {
+			duplicate = duplicate || equal(prev, terms)
+		}
+		if !duplicate {
+			out = append(out, terms)
+		}
+	}
+	return out
+}
+
+// Expand returns the terms followed by the synonyms of every sequence of
+// terms matching a rule. Synonyms are not expanded any further.
+func (e *Expander) Expand(terms []string) []string {
+	if e == nil || len(e.mappings) == 0 {
+		return terms
+	}
+
+	out := terms
+	for i := range terms {
+		for _, m := range e.mappings[terms[i]] {
+			if len(terms)-i < len(m.match) || !equal(terms[i:i+len(m.match)], m.match) {
+				continue
+			}
+			if len(out) == len(terms) {
+				// copy before appending, so the input is not modified
+				out = append(make([]string, 0, 2*len(terms)), terms...)
+			}
+			for _, expansion := range m.expansions {
+				out = append(out, expansion...)
+			}
+		}
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
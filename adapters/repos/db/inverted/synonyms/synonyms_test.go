@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []*models.SynonymRule{
+		{Terms: []string{"tv", "television"}},
+		{Terms: []string{"laptop", "notebook"}, Expansion: ExpansionOneWay},
+		{Terms: []string{"nyc", "new york"}, Expansion: ExpansionEquivalent},
+	}
+	assert.Nil(t, Validate(valid))
+	assert.Nil(t, Validate(nil))
+
+	for name, rules := range map[string][]*models.SynonymRule{
+		"nil rule":          {nil},
+		"single term":       {{Terms: []string{"tv"}}},
+		"empty term":        {{Terms: []string{"tv", " "}}},
+		"unknown expansion": {{Terms: []string{"tv", "television"}, Expansion: "both"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.NotNil(t, Validate(rules))
+		})
+	}
+}
+
+func TestExpander(t *testing.T) {
+	word := func(in string) []string {
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+	rules := []*models.SynonymRule{
+		{Terms: []string{"TV", "television"}},
+		{Terms: []string{"laptop", "notebook"}, Expansion: ExpansionOneWay},
+		{Terms: []string{"NYC", "New York"}},
+		{Terms: []string{"colour", "Colour", "color"}},
+	}
+	e := NewExpander(rules, word)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "equivalent",
+			query:    "television stand",
+			expected: []string{"television", "stand", "tv"},
+		},
+		{
+			name:     "terms are analyzed",
+			query:    "tv",
+			expected: []string{"tv", "television"},
+		},
+		{
+			name:     "one way from the first term",
+			query:    "cheap laptop",
+			expected: []string{"cheap", "laptop", "notebook"},
+		},
+		{
+			name:     "one way is not reversed",
+			query:    "notebook",
+			expected: []string{"notebook"},
+		},
+		{
+			name:     "multi-word expansion",
+			query:    "nyc hotels",
+			expected: []string{"nyc", "hotels", "new", "york"},
+		},
+		{
+			name:     "multi-word match",
+			query:    "hotels in new york",
+			expected: []string{"hotels", "in", "new", "york", "nyc"},
+		},
+		{
+			name:     "partial multi-word match",
+			query:    "new hotels",
+			expected: []string{"new", "hotels"},
+		},
+		{
+			name:     "terms identical after analysis are skipped",
+			query:    "colour",
+			expected: []string{"colour", "color"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			terms := word(test.query)
+			original := append([]string{}, terms...)
+			assert.Equal(t, test.expected, e.Expand(terms))
+			assert.Equal(t, original, terms, "input is not modified")
+		})
+	}
+
+	t.Run("no rules", func(t *testing.T) {
+		assert.Equal(t, []string{"tv"}, NewExpander(nil, word).Expand([]string{"tv"}))
+	})
+}
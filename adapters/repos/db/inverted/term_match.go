@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// fuzzyMatcher matches row keys within a maximum (Levenshtein) edit distance
+// of a term. As row keys are sorted, a key prefix which is already too far
+// away from the term rules out all keys starting with it, so those don't
+// have to be read at all.
+type fuzzyMatcher struct {
+	term    []rune
+	maxDist int
+}
+
+func parseFuzzy(in []byte) (*fuzzyMatcher, error) {
+	term, dist, err := filters.ParseFuzzyValue(string(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &fuzzyMatcher{term: []rune(term), maxDist: dist}, nil
+}
+
+// match returns whether the key is within the edit distance of the term. If
+// it is not, skip is the length of the shortest prefix of the key which
+// can't be extended into a match or 0 if there is no such prefix.
+func (f *fuzzyMatcher) match(key []byte) (ok bool, skip int) {
+	prev := make([]int, len(f.term)+1)
+	curr := make([]int, len(f.term)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i, pos := 1, 0; pos < len(key); i++ {
+		r, size := utf8.DecodeRune(key[pos:])
+		pos += size
+
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(f.term); j++ {
+			cost := 1
+			if f.term[j-1] == r {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+
+		if rowMin > f.maxDist {
+			return false, pos
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(f.term)] <= f.maxDist, 0
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// prefixSuccessor returns the smallest key which is larger than all keys
+// starting with prefix, nil if there is none
+func prefixSuccessor(prefix []byte) []byte {
+	out := make([]byte, len(prefix))
+	copy(out, prefix)
+	for i := len(out) - 1; i >= 0; i-- {
+		if out[i] < 0xff {
+			out[i]++
+			return out[:i+1]
+		}
+	}
+	return nil
+}
+
+// termMatchCursor is the subset of the bucket cursors needed to find the
+// rows matched by the Prefix and Fuzzy operators
+type termMatchCursor[V any] interface {
+	First() ([]byte, V)
+	Seek([]byte) ([]byte, V)
+	Next() ([]byte, V)
+}
+
+// readPrefix reads all rows whose key starts with prefix
+func readPrefix[V any](ctx context.Context, c termMatchCursor[V], prefix []byte,
+	readFn func(k []byte, v V) (bool, error),
+) error {
+	for k, v := c.Seek(prefix); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !bytes.HasPrefix(k, prefix) {
+			break
+		}
+
+		if continueReading, err := readFn(k, v); err != nil {
+			return err
+		} else if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
+
+// readFuzzy reads all rows whose key is within the edit distance of the
+// fuzzy value
+func readFuzzy[V any](ctx context.Context, c termMatchCursor[V], value []byte,
+	readFn func(k []byte, v V) (bool, error),
+) error {
+	fuzzy, err := parseFuzzy(value)
+	if err != nil {
+		return errors.Wrap(err, "parse fuzzy value")
+	}
+
+	k, v := c.First()
+	for k != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ok, skip := fuzzy.match(k)
+		if ok {
+			if continueReading, err := readFn(k, v); err != nil {
+				return err
+			} else if !continueReading {
+				break
+			}
+		}
+
+		if skip == 0 {
+			k, v = c.Next()
+			continue
+		}
+
+		// skip all keys starting with the prefix that can't match anymore
+		next := prefixSuccessor(k[:skip])
+		if next == nil {
+			break
+		}
+		k, v = c.Seek(next)
+	}
+
+	return nil
+}
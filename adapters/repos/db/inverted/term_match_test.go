@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+)
+
+func TestFuzzyMatcher(t *testing.T) {
+	tests := []struct {
+		value        string
+		key          string
+		expectedOk   bool
+		expectedSkip int
+	}{
+		{value: "weaviate", key: "weaviate", expectedOk: true},
+		{value: "weaviate", key: "weavaite", expectedOk: false, expectedSkip: 7},
+		{value: "weaviate~2", key: "weavaite", expectedOk: true},
+		{value: "weaviate", key: "weaviat", expectedOk: true},
+		{value: "weaviate", key: "weaviates", expectedOk: true},
+		{value: "weaviate~0", key: "weaviates", expectedOk: false, expectedSkip: 9},
+		{value: "weaviate", key: "wxxviate", expectedOk: false, expectedSkip: 3},
+		{value: "weaviate", key: "xyz", expectedOk: false, expectedSkip: 2},
+		// edit distance is measured in characters, not bytes
+		{value: "schön", key: "schon", expectedOk: true},
+		{value: "schön~0", key: "schön", expectedOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.key, func(t *testing.T) {
+			fuzzy, err := parseFuzzy([]byte(tt.value))
+			require.Nil(t, err)
+
+			ok, skip := fuzzy.match([]byte(tt.key))
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedSkip, skip)
+		})
+	}
+}
+
+func TestPrefixSuccessor(t *testing.T) {
+	assert.Equal(t, []byte("ab"), prefixSuccessor([]byte("aa")))
+	assert.Equal(t, []byte("b"), prefixSuccessor([]byte{'a', 0xff}))
+	assert.Nil(t, prefixSuccessor([]byte{0xff, 0xff}))
+}
+
+func TestReadFuzzySkipsRuledOutPrefixes(t *testing.T) {
+	data := []kvData{
+		{"apple", []uint64{1}},
+		{"applf", []uint64{2}},
+		{"xaaaa", []uint64{3}},
+		{"xaaab", []uint64{4}},
+		{"xbbbb", []uint64{5}},
+		{"zapple", []uint64{6}},
+	}
+	c := &countingCursor{dummyCursorRoaringSet: &dummyCursorRoaringSet{data: data}}
+
+	var keys []string
+	err := readFuzzy[*sroar.Bitmap](context.Background(), c, []byte("apple"),
+		func(k []byte, v *sroar.Bitmap) (bool, error) {
+			keys = append(keys, string(k))
+			return true, nil
+		})
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"apple", "applf", "zapple"}, keys)
+	// "xaaaa" rules out "xaaab" as well, so there is one seek past each of
+	// the "xa" and "xb" keys
+	assert.Equal(t, 2, c.seeks)
+}
+
+type countingCursor struct {
+	*dummyCursorRoaringSet
+	seeks int
+}
+
+func (c *countingCursor) Seek(key []byte) ([]byte, *sroar.Bitmap) {
+	c.seeks++
+	return c.dummyCursorRoaringSet.Seek(key)
+}
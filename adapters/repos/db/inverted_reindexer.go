@@ -312,7 +312,7 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 		for _, item := range property.Items {
 			key := item.Data
 			if reindexablePropSearchableValue && inverted.HasSearchableIndex(schemaProp) {
-				pair := r.shard.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+				pair := r.shard.pairPropertyWithFrequency(docID, item.TermFrequency, propLen, item.Positions)
 				if err := r.shard.addToPropertyMapBucket(bucketSearchableValue, pair, key); err != nil {
 					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 				}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// shardInvertedReindexTaskPropertyTokenization rebuilds the filterable and
+// searchable buckets of a single text property, after its tokenization was
+// changed in the schema. The tracked property lengths are recounted as well,
+// as the number of tokens depends on the tokenization.
+type shardInvertedReindexTaskPropertyTokenization struct {
+	propName string
+}
+
+func newShardInvertedReindexTaskPropertyTokenization(propName string,
+) *shardInvertedReindexTaskPropertyTokenization {
+	return &shardInvertedReindexTaskPropertyTokenization{propName: propName}
+}
+
+func (t *shardInvertedReindexTaskPropertyTokenization) GetPropertiesToReindex(ctx context.Context,
+	shard *Shard,
+) ([]ReindexableProperty, error) {
+	reindexableProperties := []ReindexableProperty{}
+
+	bucketOptions := []lsmkv.BucketOption{
+		shard.memtableIdleConfig(),
+		shard.dynamicMemtableSizing(),
+		shard.segmentTiering(),
+	}
+
+	if bucket := shard.store.Bucket(helpers.BucketFromPropNameLSM(t.propName)); bucket != nil &&
+		bucket.Strategy() == lsmkv.StrategyRoaringSet {
+		reindexableProperties = append(reindexableProperties, ReindexableProperty{
+			PropertyName:    t.propName,
+			IndexType:       IndexTypePropValue,
+			DesiredStrategy: lsmkv.StrategyRoaringSet,
+			BucketOptions: append(bucketOptions[:len(bucketOptions):len(bucketOptions)],
+				lsmkv.WithFilterCache(shard.index.Config.FilterCacheMaxEntries)),
+		})
+	}
+
+	if bucket := shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM(t.propName)); bucket != nil &&
+		bucket.Strategy() == lsmkv.StrategyMapCollection {
+		searchableBucketOptions := bucketOptions[:len(bucketOptions):len(bucketOptions)]
+		if shard.versioner.Version() < 2 {
+			searchableBucketOptions = append(searchableBucketOptions, lsmkv.WithLegacyMapSorting())
+		}
+		reindexableProperties = append(reindexableProperties, ReindexableProperty{
+			PropertyName:    t.propName,
+			IndexType:       IndexTypePropSearchableValue,
+			DesiredStrategy: lsmkv.StrategyMapCollection,
+			BucketOptions:   searchableBucketOptions,
+		})
+	}
+
+	return reindexableProperties, nil
+}
+
+func (t *shardInvertedReindexTaskPropertyTokenization) OnPostResumeStore(ctx context.Context, shard *Shard) error {
+	return shard.recountPropLengths(ctx, t.propName)
+}
+
+// recountPropLengths replaces the tracked lengths of a property with the
+// ones of its currently stored values
+func (s *Shard) recountPropLengths(ctx context.Context, propName string) error {
+	s.propLengths.RemoveProperty(propName)
+
+	objectsBucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if err := objectsBucket.IterateObjects(ctx, func(object *storobj.Object) error {
+		props, _, err := s.analyzeObject(object)
+		if err != nil {
+			return errors.Wrap(err, "analyze object")
+		}
+		for i := range props {
+			if props[i].Name == propName {
+				return s.addPropLengths(props[i : i+1])
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "recount lengths of prop %q", propName)
+	}
+
+	return s.propLengths.Flush(false)
+}
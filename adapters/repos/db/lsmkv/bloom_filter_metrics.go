@@ -11,6 +11,8 @@
 
 package lsmkv
 
+import "path/filepath"
+
 type bloomFilterMetrics struct {
 	trueNegative  TimeObserver
 	falsePositive TimeObserver
@@ -19,10 +21,11 @@ type bloomFilterMetrics struct {
 
 // newBloomFilterMetrics curries the prometheus metrics just once at
 // initialization to prevent further allocs on the hot path
-func newBloomFilterMetrics(metrics *Metrics) *bloomFilterMetrics {
+func newBloomFilterMetrics(metrics *Metrics, segmentPath string) *bloomFilterMetrics {
+	dir := filepath.Dir(segmentPath)
 	return &bloomFilterMetrics{
-		trueNegative:  metrics.BloomFilterObserver("replace", "get_true_negative"),
-		falsePositive: metrics.BloomFilterObserver("replace", "get_false_positive"),
-		truePositive:  metrics.BloomFilterObserver("replace", "get_true_positive"),
+		trueNegative:  metrics.BloomFilterObserver(dir, "replace", "get_true_negative", "true_negative"),
+		falsePositive: metrics.BloomFilterObserver(dir, "replace", "get_false_positive", "false_positive"),
+		truePositive:  metrics.BloomFilterObserver(dir, "replace", "get_true_positive", "true_positive"),
 	}
 }
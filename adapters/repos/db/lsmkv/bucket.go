@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -26,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -65,7 +67,8 @@ type Bucket struct {
 	status     storagestate.Status
 	statusLock sync.RWMutex
 
-	metrics *Metrics
+	metrics       *Metrics
+	bucketMetrics *bucketMetrics
 
 	// all "replace" buckets support counting through net additions, but not all
 	// produce a meaningful count. Typically, the only count we're interested in
@@ -73,6 +76,30 @@ type Bucket struct {
 	monitorCount bool
 
 	pauseTimer *prometheus.Timer // Times the pause
+
+	// moves cold segments to a remote store, nil if disabled
+	tiering *Tiering
+
+	// nil unless segments keep pre-aggregated numeric stats
+	numericKeyDecoder NumericKeyDecoder
+
+	// whether segments keep statistics of their keys, see [WithKeyStats]
+	keyStats bool
+
+	// 0 unless bitmaps of frequently read keys are cached
+	filterCacheMaxEntries int
+
+	// nil unless the files are encrypted, see [WithEncryption]
+	encryption *diskio.Encryption
+
+	// start of the flush in progress and duration of the last completed one,
+	// both protected by the flushLock
+	flushStartedAt    time.Time
+	lastFlushDuration time.Duration
+
+	// set to flush the active memtable with the next flush cycle regardless
+	// of the thresholds, see [Bucket.RequestFlush]
+	flushRequested atomic.Bool
 }
 
 // NewBucket initializes a new bucket. It either loads the state from disk if
@@ -116,8 +143,15 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 		b.memtableThreshold = uint64(b.memtableResizer.Initial())
 	}
 
+	b.bucketMetrics = newBucketMetrics(metrics, dir, b.strategy)
+
+	if err := b.initEncryption(); err != nil {
+		return nil, err
+	}
+
 	sg, err := newSegmentGroup(dir, logger, b.legacyMapSortingBeforeCompaction,
-		metrics, b.strategy, b.monitorCount, compactionCycle)
+		metrics, b.bucketMetrics, b.strategy, b.monitorCount, compactionCycle, b.tiering, rootDir,
+		b.numericKeyDecoder, b.keyStats, b.filterCacheMaxEntries, b.encryption)
 	if err != nil {
 		return nil, errors.Wrap(err, "init disk segments")
 	}
@@ -197,6 +231,8 @@ func (b *Bucket) SetMemtableThreshold(size uint64) {
 // secondary indexes, use [Bucket.GetBySecondary] to retrieve an object using
 // its secondary key
 func (b *Bucket) Get(key []byte) ([]byte, error) {
+	defer b.bucketMetrics.observeRead(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -249,6 +285,8 @@ func (b *Bucket) Get(key []byte) ([]byte, error) {
 // equivalent exists for Set and Map, as those do not support secondary
 // indexes.
 func (b *Bucket) GetBySecondary(pos int, key []byte) ([]byte, error) {
+	defer b.bucketMetrics.observeRead(time.Now())
+
 	bytes, _, err := b.GetBySecondaryIntoMemory(pos, key, nil)
 	return bytes, err
 }
@@ -265,6 +303,14 @@ func (b *Bucket) GetBySecondary(pos int, key []byte) ([]byte, error) {
 // Similar to [Bucket.Get], GetBySecondary is limited to ReplaceStrategy. No
 // equivalent exists for Set and Map, as those do not support secondary
 // indexes.
+//
+// Values read from a disk segment are always copied into the buffer, which
+// avoids an allocation but not the copy itself. They are placed so that they
+// start at an 8-byte aligned address. This allows callers to reinterpret
+// aligned fixed-width fields of the copied value, such as a vector, without
+// decoding them a second time.
+// Values served from a memtable are not copied and have no such guarantee.
+// The returned buffer should be passed to the next call to reuse it.
 func (b *Bucket) GetBySecondaryIntoMemory(pos int, key []byte, buffer []byte) ([]byte, []byte, error) {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
@@ -311,6 +357,8 @@ func (b *Bucket) GetBySecondaryIntoMemory(pos int, key []byte, buffer []byte) ([
 // SetList is specific to the Set Strategy, for Map use [Bucket.MapList], and
 // for Replace use [Bucket.Get].
 func (b *Bucket) SetList(key []byte) ([][]byte, error) {
+	defer b.bucketMetrics.observeRead(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -369,6 +417,8 @@ func (b *Bucket) SetList(key []byte) ([][]byte, error) {
 // Put is limited to ReplaceStrategy, use [Bucket.SetAdd] for Set or
 // [Bucket.MapSet] and [Bucket.MapSetMulti].
 func (b *Bucket) Put(key, value []byte, opts ...SecondaryKeyOption) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -391,6 +441,8 @@ func (b *Bucket) Put(key, value []byte, opts ...SecondaryKeyOption) error {
 // SetAdd is specific to the Set strategy. For Replace, use [Bucket.Put], for
 // Map use either [Bucket.MapSet] or [Bucket.MapSetMulti].
 func (b *Bucket) SetAdd(key []byte, values [][]byte) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -410,6 +462,8 @@ func (b *Bucket) SetAdd(key []byte, values [][]byte) error {
 // [Bucket.Delete] to delete the entire row, for Maps use [Bucket.MapDeleteKey]
 // to delete a single map entry.
 func (b *Bucket) SetDeleteSingle(key []byte, valueToDelete []byte) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -494,6 +548,8 @@ func MapListLegacySortingRequired() MapListOption {
 // MapList is specific to the Map strategy, for Sets use [Bucket.SetList], for
 // Replace use [Bucket.Get].
 func (b *Bucket) MapList(key []byte, cfgs ...MapListOption) ([]MapPair, error) {
+	defer b.bucketMetrics.observeRead(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -580,6 +636,8 @@ func (b *Bucket) MapList(key []byte, cfgs ...MapListOption) ([]MapPair, error) {
 //
 // MapSet is specific to the Map Strategy, for Replace use [Bucket.Put], and for Set use [Bucket.SetAdd] instead.
 func (b *Bucket) MapSet(rowKey []byte, kv MapPair) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -589,6 +647,8 @@ func (b *Bucket) MapSet(rowKey []byte, kv MapPair) error {
 // MapSetMulti is the same as [Bucket.MapSet], except that it takes in multiple
 // [MapPair] objects at the same time.
 func (b *Bucket) MapSetMulti(rowKey []byte, kvs []MapPair) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -613,6 +673,8 @@ func (b *Bucket) MapSetMulti(rowKey []byte, kvs []MapPair) error {
 // MapDeleteKey is specific to the Map Strategy. For Replace, you can use
 // [Bucket.Delete] to delete the entire row, for Sets use [Bucket.SetDeleteSingle] to delete a single set element.
 func (b *Bucket) MapDeleteKey(rowKey, mapKey []byte) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -636,6 +698,8 @@ func (b *Bucket) MapDeleteKey(rowKey, mapKey []byte) error {
 // [Bucket.MapDeleteKey] to delete a single key-value pair, for Sets use
 // [Bucket.SetDeleteSingle] to delete a single set element.
 func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -646,7 +710,7 @@ func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
 // lock on its own
 func (b *Bucket) setNewActiveMemtable() error {
 	mt, err := newMemtable(filepath.Join(b.dir, fmt.Sprintf("segment-%d",
-		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.metrics)
+		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.metrics, b.encryption)
 	if err != nil {
 		return err
 	}
@@ -717,6 +781,8 @@ func (b *Bucket) existsOnDiskAndPreviousMemtable(previous *countStats, key []byt
 }
 
 func (b *Bucket) Shutdown(ctx context.Context) error {
+	defer b.bucketMetrics.close()
+
 	if err := b.disk.shutdown(ctx); err != nil {
 		return err
 	}
@@ -755,11 +821,13 @@ func (b *Bucket) Shutdown(ctx context.Context) error {
 func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldBreak cyclemanager.ShouldBreakFunc) bool {
 	b.flushLock.RLock()
 	commitLogSize := b.active.commitlog.Size()
+	b.bucketMetrics.setMemtableSize(b.active.Size())
 	memtableTooLarge := b.active.Size() >= b.memtableThreshold
 	walTooLarge := uint64(commitLogSize) >= b.walThreshold
 	dirtyButIdle := (b.active.Size() > 0 || commitLogSize > 0) &&
 		b.active.IdleDuration() >= b.flushAfterIdle
-	shouldSwitch := memtableTooLarge || walTooLarge || dirtyButIdle
+	requested := b.flushRequested.Swap(false) && b.active.Size() > 0
+	shouldSwitch := memtableTooLarge || walTooLarge || dirtyButIdle || requested
 
 	// If true, the parent shard has indicated that it has
 	// entered an immutable state. During this time, the
@@ -818,8 +886,11 @@ func (b *Bucket) isReadOnly() bool {
 // FlushAndSwitch is typically called periodically and does not require manual
 // calling, but there are some situations where this might be intended, such as
 // in test scenarios or when a force flush is desired.
-func (b *Bucket) FlushAndSwitch() error {
+func (b *Bucket) FlushAndSwitch() (err error) {
 	before := time.Now()
+	defer func() {
+		b.bucketMetrics.flushed(err)
+	}()
 
 	b.logger.WithField("action", "lsm_memtable_flush_start").
 		WithField("path", b.dir).
@@ -858,6 +929,7 @@ func (b *Bucket) atomicallyAddDiskSegmentAndRemoveFlushing() error {
 		return err
 	}
 	b.flushing = nil
+	b.lastFlushDuration = time.Since(b.flushStartedAt)
 
 	if b.strategy == StrategyReplace && b.monitorCount {
 		// having just flushed the memtable we now have the most up2date count which
@@ -873,6 +945,7 @@ func (b *Bucket) atomicallySwitchMemtable() error {
 	defer b.flushLock.Unlock()
 
 	b.flushing = b.active
+	b.flushStartedAt = time.Now()
 	return b.setNewActiveMemtable()
 }
 
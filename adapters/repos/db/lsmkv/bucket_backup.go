@@ -47,7 +47,7 @@ func (b *Bucket) FlushMemtable() error {
 	}
 	b.flushLock.Unlock()
 
-	stat, err := b.active.commitlog.file.Stat()
+	walSize, err := b.active.commitlog.file.Size()
 	if err != nil {
 		b.logger.WithField("action", "lsm_wal_stat").
 			WithField("path", b.dir).
@@ -57,7 +57,7 @@ func (b *Bucket) FlushMemtable() error {
 
 	// attempting a flush&switch on when the active memtable
 	// or WAL is empty results in a corrupted backup attempt
-	if b.active.Size() > 0 || stat.Size() > 0 {
+	if b.active.Size() > 0 || walSize > 0 {
 		if err := b.FlushAndSwitch(); err != nil {
 			return err
 		}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// bucketMetrics are labelled with the name of the bucket rather than its
+// path, so the series of all shards of a class can be aggregated. Gauges are
+// therefore only ever changed by the delta to the last value reported by
+// this bucket. A nil bucketMetrics reports nothing.
+type bucketMetrics struct {
+	read         prometheus.Observer
+	write        prometheus.Observer
+	compactions  prometheus.Observer
+	flushes      prometheus.Counter
+	flushErrors  prometheus.Counter
+	segments     *deltaGauge
+	memtableSize *deltaGauge
+	filterCache  *prometheus.CounterVec
+}
+
+func newBucketMetrics(metrics *Metrics, dir, strategy string) *bucketMetrics {
+	if metrics == nil {
+		return nil
+	}
+
+	labels := prometheus.Labels{
+		"strategy": strategy,
+		"bucket":   filepath.Base(dir),
+	}
+	with := func(extra string, value string) prometheus.Labels {
+		out := prometheus.Labels{extra: value}
+		for k, v := range labels {
+			out[k] = v
+		}
+		return out
+	}
+
+	return &bucketMetrics{
+		read:         metrics.bucketOperations.With(with("operation", "read")),
+		write:        metrics.bucketOperations.With(with("operation", "write")),
+		compactions:  metrics.bucketCompactions.With(labels),
+		flushes:      metrics.bucketFlushes.With(with("result", "success")),
+		flushErrors:  metrics.bucketFlushes.With(with("result", "failure")),
+		segments:     &deltaGauge{gauge: metrics.bucketSegments.With(labels)},
+		memtableSize: &deltaGauge{gauge: metrics.bucketMemtableSize.With(labels)},
+		filterCache:  metrics.bucketFilterCache.MustCurryWith(labels),
+	}
+}
+
+func (m *bucketMetrics) observeRead(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.read.Observe(float64(time.Since(start)) / float64(time.Millisecond))
+}
+
+func (m *bucketMetrics) observeWrite(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.write.Observe(float64(time.Since(start)) / float64(time.Millisecond))
+}
+
+func (m *bucketMetrics) observeCompaction(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.compactions.Observe(float64(time.Since(start)) / float64(time.Millisecond))
+}
+
+func (m *bucketMetrics) flushed(err error) {
+	if m == nil {
+		return
+	}
+	if err != nil {
+		m.flushErrors.Inc()
+		return
+	}
+	m.flushes.Inc()
+}
+
+func (m *bucketMetrics) setSegments(count int) {
+	if m == nil {
+		return
+	}
+	m.segments.set(float64(count))
+}
+
+func (m *bucketMetrics) setMemtableSize(size uint64) {
+	if m == nil {
+		return
+	}
+	m.memtableSize.set(float64(size))
+}
+
+// filterCacheLookup counts a lookup of the filter cache by its result: hit,
+// partial if only segments added since need to be read, or miss
+func (m *bucketMetrics) filterCacheLookup(result string) {
+	if m == nil {
+		return
+	}
+	m.filterCache.With(prometheus.Labels{"result": result}).Inc()
+}
+
+// close removes the contribution of the bucket from the gauges, so a shut
+// down or dropped bucket does not keep inflating the aggregate
+func (m *bucketMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.segments.set(0)
+	m.memtableSize.set(0)
+}
+
+type deltaGauge struct {
+	sync.Mutex
+	gauge prometheus.Gauge
+	last  float64
+}
+
+func (g *deltaGauge) set(val float64) {
+	g.Lock()
+	defer g.Unlock()
+
+	g.gauge.Add(val - g.last)
+	g.last = val
+}
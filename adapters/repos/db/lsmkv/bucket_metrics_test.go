@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+func TestBucketMetricsGroupedByClass(t *testing.T) {
+	prom := *monitoring.GetMetrics()
+	prom.GroupShards = true
+
+	dir := func(shard string) string {
+		return filepath.Join(t.TempDir(), shard, "lsm", "property_title")
+	}
+	shard1 := newBucketMetrics(NewMetrics(&prom, "BucketMetricsClass", "shard1"),
+		dir("shard1"), StrategyMapCollection)
+	shard2 := newBucketMetrics(NewMetrics(&prom, "BucketMetricsClass", "shard2"),
+		dir("shard2"), StrategyMapCollection)
+
+	labels := prometheus.Labels{
+		"strategy":   StrategyMapCollection,
+		"class_name": "BucketMetricsClass",
+		"shard_name": "n/a",
+		"bucket":     "property_title",
+	}
+	segments := prom.LSMBucketSegments.With(labels)
+	memtableSize := prom.LSMBucketMemtableSize.With(labels)
+
+	shard1.setSegments(3)
+	shard2.setSegments(2)
+	shard1.setMemtableSize(100)
+	shard2.setMemtableSize(50)
+	assert.Equal(t, float64(5), testutil.ToFloat64(segments))
+	assert.Equal(t, float64(150), testutil.ToFloat64(memtableSize))
+
+	// a compaction reduces the count of a single shard
+	shard1.setSegments(1)
+	assert.Equal(t, float64(3), testutil.ToFloat64(segments))
+
+	shard1.flushed(nil)
+	shard2.flushed(nil)
+	shard2.flushed(errors.New("disk full"))
+	assert.Equal(t, float64(2), testutil.ToFloat64(prom.LSMBucketFlushes.With(
+		withLabel(labels, "result", "success"))))
+	assert.Equal(t, float64(1), testutil.ToFloat64(prom.LSMBucketFlushes.With(
+		withLabel(labels, "result", "failure"))))
+
+	// a shut down shard no longer contributes to the gauges
+	shard2.close()
+	assert.Equal(t, float64(1), testutil.ToFloat64(segments))
+	assert.Equal(t, float64(100), testutil.ToFloat64(memtableSize))
+}
+
+func TestBucketMetricsNil(t *testing.T) {
+	var m *bucketMetrics
+	assert.Nil(t, newBucketMetrics(nil, "dir", StrategyReplace))
+	m.setSegments(1)
+	m.setMemtableSize(1)
+	m.flushed(nil)
+	m.close()
+}
+
+func withLabel(labels prometheus.Labels, key, value string) prometheus.Labels {
+	out := prometheus.Labels{key: value}
+	for k, v := range labels {
+		out[k] = v
+	}
+	return out
+}
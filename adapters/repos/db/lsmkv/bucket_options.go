@@ -85,6 +85,29 @@ func WithDynamicMemtableSizing(
 	}
 }
 
+// WithSegmentTiering moves cold, fully compacted segments to the remote store
+// of the tiering. A nil tiering leaves all segments local.
+func WithSegmentTiering(tiering *Tiering) BucketOption {
+	return func(b *Bucket) error {
+		b.tiering = tiering
+		return nil
+	}
+}
+
+// WithFilterCache caches the combined bitmaps of the disk segments for up to
+// maxEntries keys of a roaring set bucket, so repeated reads of the same key
+// only need to combine segments created since and the memtables. It has no
+// effect on other strategies or if maxEntries is 0.
+func WithFilterCache(maxEntries int) BucketOption {
+	return func(b *Bucket) error {
+		if maxEntries < 0 {
+			return errors.Errorf("filter cache max entries must not be negative")
+		}
+		b.filterCacheMaxEntries = maxEntries
+		return nil
+	}
+}
+
 type secondaryIndexKeys [][]byte
 
 type SecondaryKeyOption func(s secondaryIndexKeys) error
@@ -111,3 +134,27 @@ func WithMonitorCount() BucketOption {
 		return nil
 	}
 }
+
+// WithNumericStats keeps pre-aggregated statistics for each segment of a
+// roaring set bucket with numeric keys, see [Bucket.NumericStats]
+func WithNumericStats(decode NumericKeyDecoder) BucketOption {
+	return func(b *Bucket) error {
+		if b.strategy != StrategyRoaringSet {
+			return errors.Errorf("numeric stats only supported on 'roaringset' buckets")
+		}
+		b.numericKeyDecoder = decode
+		return nil
+	}
+}
+
+// WithKeyStats keeps statistics of the distribution of the keys of each
+// segment of a roaring set bucket, see [Bucket.KeyStats]
+func WithKeyStats() BucketOption {
+	return func(b *Bucket) error {
+		if b.strategy != StrategyRoaringSet {
+			return errors.Errorf("key stats only supported on 'roaringset' buckets")
+		}
+		b.keyStats = true
+		return nil
+	}
+}
@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/entities/lsmkv"
@@ -23,6 +24,8 @@ func (b *Bucket) RoaringSetAddOne(key []byte, value uint64) error {
 		return err
 	}
 
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -34,6 +37,8 @@ func (b *Bucket) RoaringSetRemoveOne(key []byte, value uint64) error {
 		return err
 	}
 
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -45,6 +50,8 @@ func (b *Bucket) RoaringSetAddList(key []byte, values []uint64) error {
 		return err
 	}
 
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -56,6 +63,8 @@ func (b *Bucket) RoaringSetAddBitmap(key []byte, bm *sroar.Bitmap) error {
 		return err
 	}
 
+	defer b.bucketMetrics.observeWrite(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -67,6 +76,8 @@ func (b *Bucket) RoaringSetGet(key []byte) (*sroar.Bitmap, error) {
 		return nil, err
 	}
 
+	defer b.bucketMetrics.observeRead(time.Now())
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -98,6 +109,72 @@ func (b *Bucket) RoaringSetGet(key []byte) (*sroar.Bitmap, error) {
 	return segments.Flatten(), nil
 }
 
+// NumericStats combines the pre-aggregated stats of all segments and
+// memtables of a bucket created with [WithNumericStats]. This is much cheaper
+// than iterating the bucket, but only exact if no layer deletes ids of the
+// previous ones. Until a compaction resolves such deletions, ok is false and
+// callers need to fall back to reading the bucket.
+//
+// The stats assume that an id is only ever re-added to a key after it was
+// deleted from it, which is how the inverted index writes to the bucket.
+func (b *Bucket) NumericStats() (stats NumericStats, ok bool, err error) {
+	if err := checkStrategyRoaringSet(b.strategy); err != nil {
+		return NumericStats{}, false, err
+	}
+
+	if b.numericKeyDecoder == nil {
+		return NumericStats{}, false, nil
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	layers, ok := b.disk.numericStats()
+	if !ok {
+		return NumericStats{}, false, nil
+	}
+
+	memtables := []*Memtable{b.active}
+	if b.flushing != nil {
+		memtables = []*Memtable{b.flushing, b.active}
+	}
+
+	for _, m := range memtables {
+		layer, err := numericStatsFromCursor(m.newRoaringSetCursor(),
+			b.numericKeyDecoder)
+		if err != nil {
+			return NumericStats{}, false, fmt.Errorf("memtable: %w", err)
+		}
+		layers = append(layers, layer)
+	}
+
+	stats, ok = combineNumericStats(layers)
+	return stats, ok, nil
+}
+
+// KeyStats combines the stats of all segments of a bucket created with
+// [WithKeyStats]. They are maintained when segments are flushed or
+// compacted, so writes still in a memtable are not included. ok is false if
+// the stats of any segment are unavailable, for example for tiered segments
+// without locally persisted stats. Unlike [Bucket.NumericStats] the result is
+// always an estimate, see [combineKeyStats].
+func (b *Bucket) KeyStats() (stats KeyStats, ok bool, err error) {
+	if err := checkStrategyRoaringSet(b.strategy); err != nil {
+		return KeyStats{}, false, err
+	}
+
+	if !b.keyStats {
+		return KeyStats{}, false, nil
+	}
+
+	layers, ok := b.disk.keyStatsLayers()
+	if !ok {
+		return KeyStats{}, false, nil
+	}
+
+	return combineKeyStats(layers), true, nil
+}
+
 func checkStrategyRoaringSet(bucketStrat string) error {
 	if bucketStrat == StrategyRoaringSet {
 		return nil
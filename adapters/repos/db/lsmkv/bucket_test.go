@@ -15,6 +15,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"unsafe"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
@@ -163,3 +164,39 @@ func TestBucketReadsIntoMemory(t *testing.T) {
 	assert.Equal(t, []byte("world"), valuePrimary)
 	assert.Equal(t, []byte("world"), valueSecondary)
 }
+
+func TestBucketReadsIntoMemoryAligned(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyReplace), WithSecondaryIndices(1))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world"),
+		WithSecondaryKey(0, []byte("bonjour"))))
+	require.Nil(t, b.FlushMemtable())
+
+	backing := make([]byte, 64)
+	for offset := 0; offset < valueAlignment; offset++ {
+		// start the buffer at every possible misalignment
+		buffer := backing[offset:offset]
+		value, buffer, err := b.GetBySecondaryIntoMemory(0, []byte("bonjour"), buffer)
+		require.Nil(t, err)
+
+		assert.Equal(t, []byte("world"), value)
+		assert.Zero(t, uintptr(unsafe.Pointer(&value[0]))%valueAlignment)
+		assert.Same(t, &backing[offset], &buffer[0], "buffer is reused")
+	}
+
+	t.Run("buffer too small", func(t *testing.T) {
+		value, buffer, err := b.GetBySecondaryIntoMemory(0, []byte("bonjour"), make([]byte, 2))
+		require.Nil(t, err)
+
+		assert.Equal(t, []byte("world"), value)
+		assert.Zero(t, uintptr(unsafe.Pointer(&value[0]))%valueAlignment)
+		assert.GreaterOrEqual(t, cap(buffer), len(value))
+	})
+}
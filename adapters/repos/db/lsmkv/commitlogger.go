@@ -16,13 +16,15 @@ import (
 	"encoding/binary"
 	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type commitLogger struct {
-	file   *os.File
+	file   *diskio.File
 	writer *bufio.Writer
 	n      atomic.Int64
 	path   string
@@ -30,6 +32,9 @@ type commitLogger struct {
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
 	paused bool
+
+	// duration of the most recent write of the buffer to disk in nanoseconds
+	lastFlushNs atomic.Int64
 }
 
 type CommitType uint16
@@ -60,12 +65,12 @@ func (ct CommitType) Is(checkedCommitType CommitType) bool {
 	return ct == checkedCommitType
 }
 
-func newCommitLogger(path string) (*commitLogger, error) {
+func newCommitLogger(path string, enc *diskio.Encryption) (*commitLogger, error) {
 	out := &commitLogger{
 		path: path + ".wal",
 	}
 
-	f, err := os.Create(out.path)
+	f, err := enc.Create(out.path)
 	if err != nil {
 		return nil, err
 	}
@@ -173,5 +178,14 @@ func (cl *commitLogger) delete() error {
 }
 
 func (cl *commitLogger) flushBuffers() error {
+	before := time.Now()
+	defer func() { cl.lastFlushNs.Store(int64(time.Since(before))) }()
+
 	return cl.writer.Flush()
 }
+
+// lastFlushDuration is the time the most recent write of the buffer to disk
+// took
+func (cl *commitLogger) lastFlushDuration() time.Duration {
+	return time.Duration(cl.lastFlushNs.Load())
+}
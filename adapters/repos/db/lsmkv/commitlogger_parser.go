@@ -15,7 +15,6 @@ import (
 	"bufio"
 	"encoding/binary"
 	"io"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/diskio"
@@ -28,10 +27,11 @@ type commitloggerParser struct {
 	reader       io.Reader
 	metrics      *Metrics
 	replaceCache map[string]segmentReplaceNode
+	encryption   *diskio.Encryption
 }
 
 func newCommitLoggerParser(path string, activeMemtable *Memtable,
-	strategy string, metrics *Metrics,
+	strategy string, metrics *Metrics, enc *diskio.Encryption,
 ) *commitloggerParser {
 	return &commitloggerParser{
 		path:         path,
@@ -39,6 +39,7 @@ func newCommitLoggerParser(path string, activeMemtable *Memtable,
 		strategy:     strategy,
 		metrics:      metrics,
 		replaceCache: map[string]segmentReplaceNode{},
+		encryption:   enc,
 	}
 }
 
@@ -58,7 +59,7 @@ func (p *commitloggerParser) Do() error {
 // doReplace parsers all entries into a cache for deduplication first and only
 // imports unique entries into the actual memtable as a final step.
 func (p *commitloggerParser) doReplace() error {
-	f, err := os.Open(p.path)
+	f, err := p.encryption.Open(p.path)
 	if err != nil {
 		return err
 	}
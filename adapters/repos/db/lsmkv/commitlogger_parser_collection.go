@@ -15,14 +15,13 @@ import (
 	"bufio"
 	"encoding/binary"
 	"io"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 func (p *commitloggerParser) doCollection() error {
-	f, err := os.Open(p.path)
+	f, err := p.encryption.Open(p.path)
 	if err != nil {
 		return err
 	}
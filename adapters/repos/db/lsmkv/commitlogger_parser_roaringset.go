@@ -15,7 +15,6 @@ import (
 	"bufio"
 	"encoding/binary"
 	"io"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
@@ -23,7 +22,7 @@ import (
 )
 
 func (p *commitloggerParser) doRoaringSet() error {
-	f, err := os.Open(p.path)
+	f, err := p.encryption.Open(p.path)
 	if err != nil {
 		return err
 	}
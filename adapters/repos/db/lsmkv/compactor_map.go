@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type compactorMap struct {
@@ -35,6 +36,7 @@ type compactorMap struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+	encryption       *diskio.Encryption
 
 	// for backward-compatibility with states where the disk state for maps was
 	// not guaranteed to be sorted yet
@@ -43,7 +45,7 @@ type compactorMap struct {
 
 func newCompactorMapCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
-	scratchSpacePath string, requiresSorting bool,
+	scratchSpacePath string, requiresSorting bool, encryption *diskio.Encryption,
 ) *compactorMap {
 	return &compactorMap{
 		c1:                  c1,
@@ -54,6 +56,7 @@ func newCompactorMapCollection(w io.WriteSeeker,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
 		requiresSorting:     requiresSorting,
+		encryption:          encryption,
 	}
 }
 
@@ -209,6 +212,7 @@ func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
 		Keys:                keys,
 		SecondaryIndexCount: c.secondaryIndexCount,
 		ScratchSpacePath:    c.scratchSpacePath,
+		Encryption:          c.encryption,
 	}
 
 	_, err := indices.WriteTo(c.bufw)
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -35,11 +36,12 @@ type compactorReplace struct {
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
+	encryption       *diskio.Encryption
 }
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, encryption *diskio.Encryption,
 ) *compactorReplace {
 	return &compactorReplace{
 		c1:                  c1,
@@ -49,6 +51,7 @@ func newCompactorReplace(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		encryption:          encryption,
 	}
 }
 
@@ -170,6 +173,7 @@ func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
 		Keys:                keys,
 		SecondaryIndexCount: c.secondaryIndexCount,
 		ScratchSpacePath:    c.scratchSpacePath,
+		Encryption:          c.encryption,
 	}
 
 	_, err := indices.WriteTo(c.bufw)
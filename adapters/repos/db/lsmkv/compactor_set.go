@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type compactorSet struct {
@@ -34,11 +35,12 @@ type compactorSet struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+	encryption       *diskio.Encryption
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, encryption *diskio.Encryption,
 ) *compactorSet {
 	return &compactorSet{
 		c1:                  c1,
@@ -48,6 +50,7 @@ func newCompactorSetCollection(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		encryption:          encryption,
 	}
 }
 
@@ -165,6 +168,7 @@ func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
 		Keys:                keys,
 		SecondaryIndexCount: c.secondaryIndexCount,
 		ScratchSpacePath:    c.scratchSpacePath,
+		Encryption:          c.encryption,
 	}
 
 	_, err := indices.WriteTo(c.bufw)
@@ -13,12 +13,15 @@ package lsmkv
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 type CursorReplace struct {
+	cursorContext
+
 	innerCursors []innerCursorReplace
 	state        []cursorStateReplace
 	unlock       func()
@@ -68,6 +71,38 @@ func (b *Bucket) Cursor() *CursorReplace {
 	}
 }
 
+// SnapshotCursor returns a cursor on the state of the bucket at the time of
+// the call. Contrary to Cursor it holds no lock while it is open, so flushes
+// and compactions are not held up by long-running reads such as exports.
+// Segments which are compacted away in the meantime are kept mapped until
+// the cursor is closed.
+func (b *Bucket) SnapshotCursor() *CursorReplace {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	if b.strategy != StrategyReplace {
+		panic("SnapshotCursor() called on strategy other than 'replace'")
+	}
+
+	innerCursors, release := b.disk.newSnapshotCursors()
+	if b.flushing != nil {
+		innerCursors = append(innerCursors, b.flushing.newSnapshotCursor())
+	}
+	innerCursors = append(innerCursors, b.active.newSnapshotCursor())
+
+	return &CursorReplace{
+		innerCursors: innerCursors,
+		unlock:       release,
+	}
+}
+
+// WithContext makes the cursor stop serving once ctx is cancelled, see
+// cursorContext for details.
+func (c *CursorReplace) WithContext(ctx context.Context) *CursorReplace {
+	c.ctx = ctx
+	return c
+}
+
 func (c *CursorReplace) Close() {
 	c.unlock()
 }
@@ -99,6 +134,10 @@ func (c *CursorReplace) seekAll(target []byte) {
 }
 
 func (c *CursorReplace) serveCurrentStateAndAdvance() ([]byte, []byte) {
+	if c.cancelled() {
+		return nil, nil
+	}
+
 	id, err := c.cursorWithLowestKey()
 	if err != nil {
 		if err == lsmkv.NotFound {
@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"bytes"
+	"context"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -20,6 +21,8 @@ import (
 )
 
 type CursorMap struct {
+	cursorContext
+
 	innerCursors []innerCursorMap
 	state        []cursorStateMap
 	unlock       func()
@@ -94,6 +97,13 @@ func (c *CursorMap) First() ([]byte, []MapPair) {
 	return c.serveCurrentStateAndAdvance()
 }
 
+// WithContext makes the cursor stop serving once ctx is cancelled, see
+// cursorContext for details.
+func (c *CursorMap) WithContext(ctx context.Context) *CursorMap {
+	c.ctx = ctx
+	return c
+}
+
 func (c *CursorMap) Close() {
 	c.unlock()
 }
@@ -143,6 +153,10 @@ func (c *CursorMap) firstAll() {
 }
 
 func (c *CursorMap) serveCurrentStateAndAdvance() ([]byte, []MapPair) {
+	if c.cancelled() {
+		return nil, nil
+	}
+
 	id, err := c.cursorWithLowestKey()
 	if err != nil {
 		if err == lsmkv.NotFound {
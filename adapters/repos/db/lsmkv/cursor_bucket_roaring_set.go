@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/weaviate/sroar"
@@ -23,25 +24,46 @@ type CursorRoaringSet interface {
 	Next() ([]byte, *sroar.Bitmap)
 	Seek([]byte) ([]byte, *sroar.Bitmap)
 	Close()
+	// WithContext makes the cursor stop serving once ctx is cancelled. Callers
+	// need to check Err() after iterating to tell a cancelled cursor apart from
+	// an exhausted one.
+	WithContext(ctx context.Context) CursorRoaringSet
+	Err() error
 }
 
 type cursorRoaringSet struct {
+	cursorContext
+
 	combinedCursor *roaringset.CombinedCursor
 	unlock         func()
 }
 
 func (c *cursorRoaringSet) First() ([]byte, *sroar.Bitmap) {
+	if c.cancelled() {
+		return nil, nil
+	}
 	return c.combinedCursor.First()
 }
 
 func (c *cursorRoaringSet) Next() ([]byte, *sroar.Bitmap) {
+	if c.cancelled() {
+		return nil, nil
+	}
 	return c.combinedCursor.Next()
 }
 
 func (c *cursorRoaringSet) Seek(key []byte) ([]byte, *sroar.Bitmap) {
+	if c.cancelled() {
+		return nil, nil
+	}
 	return c.combinedCursor.Seek(key)
 }
 
+func (c *cursorRoaringSet) WithContext(ctx context.Context) CursorRoaringSet {
+	c.ctx = ctx
+	return c
+}
+
 func (c *cursorRoaringSet) Close() {
 	c.unlock()
 }
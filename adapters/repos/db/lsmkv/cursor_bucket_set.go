@@ -13,12 +13,15 @@ package lsmkv
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 type CursorSet struct {
+	cursorContext
+
 	innerCursors []innerCursorCollection
 	state        []cursorStateCollection
 	unlock       func()
@@ -94,6 +97,13 @@ func (c *CursorSet) First() ([]byte, [][]byte) {
 	return c.serveCurrentStateAndAdvance()
 }
 
+// WithContext makes the cursor stop serving once ctx is cancelled, see
+// cursorContext for details.
+func (c *CursorSet) WithContext(ctx context.Context) *CursorSet {
+	c.ctx = ctx
+	return c
+}
+
 func (c *CursorSet) Close() {
 	c.unlock()
 }
@@ -143,6 +153,10 @@ func (c *CursorSet) firstAll() {
 }
 
 func (c *CursorSet) serveCurrentStateAndAdvance() ([]byte, [][]byte) {
+	if c.cancelled() {
+		return nil, nil
+	}
+
 	id, err := c.cursorWithLowestKey()
 	if err != nil {
 		if err == lsmkv.NotFound {
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import "context"
+
+// cursorContext lets a cursor stop serving once the context of the query it
+// belongs to is cancelled. A cursor without context is never cancelled.
+//
+// Once cancelled, a cursor serves a nil key just as if it was exhausted, so
+// callers need to check Err() after their loop to tell both cases apart.
+type cursorContext struct {
+	ctx context.Context
+}
+
+func (c *cursorContext) cancelled() bool {
+	return c.ctx != nil && c.ctx.Err() != nil
+}
+
+// Err returns the error of the cursor's context, nil if the cursor has no
+// context or it is not cancelled.
+func (c *cursorContext) Err() error {
+	if c.ctx == nil {
+		return nil
+	}
+	return c.ctx.Err()
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCursorContext(t *testing.T) {
+	newBucket := func(t *testing.T, strategy string) *Bucket {
+		logger, _ := test.NewNullLogger()
+		b, err := NewBucket(context.Background(), t.TempDir(), "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(), WithStrategy(strategy))
+		require.Nil(t, err)
+		t.Cleanup(func() { b.Shutdown(context.Background()) })
+		return b
+	}
+
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("key-%03d", i))
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		b := newBucket(t, StrategyReplace)
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.Put(key(i), []byte("value")))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := b.Cursor().WithContext(ctx)
+		defer c.Close()
+
+		count := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			count++
+			if count == 3 {
+				cancel()
+			}
+		}
+		assert.Equal(t, 3, count)
+		assert.ErrorIs(t, c.Err(), context.Canceled)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		b := newBucket(t, StrategySetCollection)
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.SetAdd(key(i), [][]byte{[]byte("value")}))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c := b.SetCursor().WithContext(ctx)
+		defer c.Close()
+
+		k, _ := c.First()
+		assert.Nil(t, k)
+		assert.ErrorIs(t, c.Err(), context.Canceled)
+	})
+
+	t.Run("roaring set", func(t *testing.T) {
+		b := newBucket(t, StrategyRoaringSet)
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.RoaringSetAddOne(key(i), uint64(i)))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := b.CursorRoaringSet().WithContext(ctx)
+		defer c.Close()
+
+		k, _ := c.Seek(key(5))
+		assert.Equal(t, key(5), k)
+
+		cancel()
+		k, _ = c.Next()
+		assert.Nil(t, k)
+		assert.ErrorIs(t, c.Err(), context.Canceled)
+	})
+
+	t.Run("without context", func(t *testing.T) {
+		b := newBucket(t, StrategyMapCollection)
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.MapSet(key(i), MapPair{Key: []byte("k"), Value: []byte("v")}))
+		}
+
+		c := b.MapCursor()
+		defer c.Close()
+
+		count := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			count++
+		}
+		assert.Equal(t, 10, count)
+		assert.Nil(t, c.Err())
+	})
+}
@@ -44,6 +44,30 @@ func (m *Memtable) newCursor() innerCursorReplace {
 	}
 }
 
+// newSnapshotCursor copies the nodes of the memtable, as updates of existing
+// keys modify their node in place. The cursor is therefore not affected by
+// writes after its creation and needs no lock.
+func (m *Memtable) newSnapshotCursor() innerCursorReplace {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := m.key.flattenInOrder()
+	data := make([]*binarySearchNode, len(nodes))
+	for i, node := range nodes {
+		data[i] = &binarySearchNode{
+			key:       node.key,
+			value:     node.value,
+			tombstone: node.tombstone,
+		}
+	}
+
+	return &memtableCursor{
+		data:   data,
+		lock:   func() {},
+		unlock: func() {},
+	}
+}
+
 func (c *memtableCursor) first() ([]byte, []byte, error) {
 	c.lock()
 	defer c.unlock()
@@ -17,12 +17,14 @@ import (
 
 type segmentCursorCollection struct {
 	segment    *segment
+	reader     *cursorReader
 	nextOffset uint64
 }
 
 func (s *segment) newCollectionCursor() *segmentCursorCollection {
 	return &segmentCursorCollection{
 		segment: s,
+		reader:  s.newCursorReader(),
 	}
 }
 
@@ -43,8 +45,12 @@ func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
 		return nil, nil, err
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[node.Start:node.End])
+	contents, err := s.reader.node(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'entities.Deleted' which would require that the offset is still advanced
@@ -62,8 +68,12 @@ func (s *segmentCursorCollection) next() ([]byte, []value, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'entities.Deleted' which would require that the offset is still advanced
@@ -78,8 +88,12 @@ func (s *segmentCursorCollection) next() ([]byte, []value, error) {
 
 func (s *segmentCursorCollection) first() ([]byte, []value, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'entities.Deleted' which would require that the offset is still advanced
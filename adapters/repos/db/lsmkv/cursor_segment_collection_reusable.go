@@ -17,6 +17,7 @@ import (
 
 type segmentCursorCollectionReusable struct {
 	segment    *segment
+	reader     *cursorReader
 	nextOffset uint64
 	nodeBuf    segmentCollectionNode
 }
@@ -24,6 +25,7 @@ type segmentCursorCollectionReusable struct {
 func (s *segment) newCollectionCursorReusable() *segmentCursorCollectionReusable {
 	return &segmentCursorCollectionReusable{
 		segment: s,
+		reader:  s.newCursorReader(),
 	}
 }
 
@@ -33,8 +35,12 @@ func (s *segmentCursorCollectionReusable) seek(key []byte) ([]byte, []value, err
 		return nil, nil, err
 	}
 
-	err = s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], &s.nodeBuf)
+	contents, err := s.reader.node(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 	if err != nil {
 		return s.nodeBuf.primaryKey, nil, err
 	}
@@ -49,8 +55,12 @@ func (s *segmentCursorCollectionReusable) next() ([]byte, []value, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	err := s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], &s.nodeBuf)
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'entities.Deleted' which would require that the offset is still advanced
@@ -65,8 +75,12 @@ func (s *segmentCursorCollectionReusable) next() ([]byte, []value, error) {
 
 func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], &s.nodeBuf)
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 	if err != nil {
 		return s.nodeBuf.primaryKey, nil, err
 	}
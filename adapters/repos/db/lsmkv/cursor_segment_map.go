@@ -15,12 +15,14 @@ import "github.com/weaviate/weaviate/entities/lsmkv"
 
 type segmentCursorMap struct {
 	segment    *segment
+	reader     *cursorReader
 	nextOffset uint64
 }
 
 func (s *segment) newMapCursor() *segmentCursorMap {
 	return &segmentCursorMap{
 		segment: s,
+		reader:  s.newCursorReader(),
 	}
 }
 
@@ -41,8 +43,12 @@ func (s *segmentCursorMap) seek(key []byte) ([]byte, []MapPair, error) {
 		return nil, nil, err
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[node.Start:node.End])
+	contents, err := s.reader.node(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -68,8 +74,12 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -92,8 +102,12 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 
 func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
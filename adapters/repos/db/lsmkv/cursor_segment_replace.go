@@ -17,6 +17,7 @@ import (
 
 type segmentCursorReplace struct {
 	segment      *segment
+	reader       *cursorReader
 	nextOffset   uint64
 	reusableNode *segmentReplaceNode
 }
@@ -24,6 +25,7 @@ type segmentCursorReplace struct {
 func (s *segment) newCursor() *segmentCursorReplace {
 	return &segmentCursorReplace{
 		segment:      s,
+		reader:       s.newCursorReader(),
 		reusableNode: &segmentReplaceNode{},
 	}
 }
@@ -45,8 +47,12 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
-	err = s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], s.reusableNode)
+	contents, err := s.reader.node(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -65,8 +71,12 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -81,8 +91,12 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -101,8 +115,12 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 		return out, lsmkv.NotFound
 	}
 
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -117,8 +135,12 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 
 func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.reader.from(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -21,12 +21,73 @@ func (s *segment) newRoaringSetCursor() *roaringset.SegmentCursor {
 		&roaringSetSeeker{s.index})
 }
 
+// newRoaringSetReadCursor is like newRoaringSetCursor, but also supports
+// tiered segments
+func (s *segment) newRoaringSetReadCursor() roaringset.InnerCursor {
+	if s.remote == nil {
+		return s.newRoaringSetCursor()
+	}
+
+	return &tieredRoaringSetCursor{segment: s, reader: s.newCursorReader()}
+}
+
+// tieredRoaringSetCursor reads the nodes of a tiered segment one at a time,
+// it behaves like [roaringset.SegmentCursor] otherwise
+type tieredRoaringSetCursor struct {
+	segment    *segment
+	reader     *cursorReader
+	nextOffset uint64
+}
+
+func (c *tieredRoaringSetCursor) First() ([]byte, roaringset.BitmapLayer, error) {
+	c.nextOffset = c.segment.dataStartPos
+	return c.Next()
+}
+
+func (c *tieredRoaringSetCursor) Next() ([]byte, roaringset.BitmapLayer, error) {
+	if c.nextOffset >= c.segment.dataEndPos {
+		return nil, roaringset.BitmapLayer{}, nil
+	}
+
+	contents, err := c.reader.from(c.nextOffset)
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	return c.parse(contents)
+}
+
+func (c *tieredRoaringSetCursor) Seek(key []byte) ([]byte, roaringset.BitmapLayer, error) {
+	node, err := c.segment.index.Seek(key)
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	c.nextOffset = node.Start
+	contents, err := c.reader.node(node)
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	return c.parse(contents)
+}
+
+func (c *tieredRoaringSetCursor) parse(contents []byte) ([]byte, roaringset.BitmapLayer, error) {
+	sn := roaringset.NewSegmentNodeFromBuffer(contents)
+	c.nextOffset += sn.Len()
+	layer := roaringset.BitmapLayer{
+		Additions: sn.Additions(),
+		Deletions: sn.Deletions(),
+	}
+	return sn.PrimaryKey(), layer, nil
+}
+
 func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset.InnerCursor, func()) {
 	sg.maintenanceLock.RLock()
 	out := make([]roaringset.InnerCursor, len(sg.segments))
 
 	for i, segment := range sg.segments {
-		out[i] = segment.newRoaringSetCursor()
+		out[i] = segment.newRoaringSetReadCursor()
 	}
 
 	return out, sg.maintenanceLock.RUnlock
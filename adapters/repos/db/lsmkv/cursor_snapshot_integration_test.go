@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestReplaceStrategy_SnapshotCursor(t *testing.T) {
+	bucket, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	defer bucket.Shutdown(testCtx())
+
+	// so big it effectively never triggers as part of this test
+	bucket.SetMemtableThreshold(1e9)
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	expected := map[string]string{}
+
+	// two flushed segments and a memtable
+	for segment := 0; segment < 3; segment++ {
+		for i := segment * 10; i < segment*10+10; i++ {
+			value := fmt.Sprintf("original-%d", i)
+			require.Nil(t, bucket.Put(key(i), []byte(value)))
+			expected[string(key(i))] = value
+		}
+		if segment < 2 {
+			require.Nil(t, bucket.FlushAndSwitch())
+		}
+	}
+
+	c := bucket.SnapshotCursor()
+	defer c.Close()
+
+	t.Run("modify the bucket after the snapshot was taken", func(t *testing.T) {
+		// an update of a key in the memtable, a deletion and an insertion
+		require.Nil(t, bucket.Put(key(25), []byte("updated")))
+		require.Nil(t, bucket.Delete(key(3)))
+		require.Nil(t, bucket.Put(key(99), []byte("inserted")))
+
+		require.Nil(t, bucket.FlushAndSwitch())
+		for bucket.disk.eligibleForCompaction() {
+			require.Nil(t, bucket.disk.compactOnce())
+		}
+	})
+
+	t.Run("snapshot still returns the original state", func(t *testing.T) {
+		retrieved := map[string]string{}
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			retrieved[string(k)] = string(v)
+		}
+
+		assert.Equal(t, expected, retrieved)
+	})
+
+	t.Run("a new snapshot sees the modifications", func(t *testing.T) {
+		c2 := bucket.SnapshotCursor()
+		defer c2.Close()
+
+		k, v := c2.Seek(key(25))
+		assert.Equal(t, key(25), k)
+		assert.Equal(t, []byte("updated"), v)
+
+		k, _ = c2.Seek(key(3))
+		assert.Equal(t, key(4), k)
+	})
+}
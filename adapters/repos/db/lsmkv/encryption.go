@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// encryptionMarkerFile is written into the directory of an encrypted bucket,
+// it holds the fingerprint of the key
+const encryptionMarkerFile = "encryption.key-fingerprint"
+
+// WithEncryption encrypts all files of a bucket: the WAL, the segments and
+// the files derived from them, such as bloom filters, and the scratch files
+// used while writing segments. Keys and values are covered, copies of the
+// files, such as backups and tiered segments, therefore remain encrypted.
+// Segments are decrypted into memory when they are loaded instead of being
+// mapped from disk.
+//
+// Only a new bucket can be encrypted, a bucket which already contains
+// unencrypted data cannot be opened with encryption. A bucket which was
+// created with encryption cannot be opened without it or with a different
+// key either.
+func WithEncryption(enc *diskio.Encryption) BucketOption {
+	return func(b *Bucket) error {
+		b.encryption = enc
+		return nil
+	}
+}
+
+// initEncryption checks that the bucket is opened with the key it was
+// created with. It must be called before any data is loaded.
+func (b *Bucket) initEncryption() error {
+	markerPath := filepath.Join(b.dir, encryptionMarkerFile)
+	fingerprint, err := os.ReadFile(markerPath)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "read encryption marker")
+	}
+
+	if err == nil {
+		if b.encryption == nil {
+			return errors.Errorf("bucket %s is encrypted, but no key was provided", b.dir)
+		}
+		if !bytes.Equal(fingerprint, b.encryption.Fingerprint()) {
+			return errors.Errorf("bucket %s was encrypted with a different key", b.dir)
+		}
+		return nil
+	}
+
+	if b.encryption == nil {
+		return nil
+	}
+
+	hasData, err := bucketHasData(b.dir)
+	if err != nil {
+		return err
+	}
+	if hasData {
+		return errors.Errorf("bucket %s contains unencrypted data, encryption can only "+
+			"be enabled for new buckets, export and import the data to encrypt it", b.dir)
+	}
+
+	if err := os.WriteFile(markerPath, b.encryption.Fingerprint(), 0o600); err != nil {
+		return errors.Wrap(err, "write encryption marker")
+	}
+	return nil
+}
+
+func bucketHasData(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, errors.Wrap(err, "read bucket dir")
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".wal") ||
+			strings.HasSuffix(name, tieredSegmentExt) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+func TestBucketEncryption(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	key := bytes.Repeat([]byte{7}, 32)
+
+	open := func(key []byte) (*Bucket, error) {
+		opts := []BucketOption{WithStrategy(StrategyReplace), WithSecondaryIndices(1)}
+		if key != nil {
+			enc, err := diskio.NewEncryption(key)
+			require.Nil(t, err)
+			opts = append(opts, WithEncryption(enc))
+		}
+		return NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(), opts...)
+	}
+
+	b, err := open(key)
+	require.Nil(t, err)
+	value := []byte("a value which must not be stored in plain text")
+	require.Nil(t, b.Put([]byte("key-1"), value, WithSecondaryKey(0, []byte("sec-1"))))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.Put([]byte("key-2"), value, WithSecondaryKey(0, []byte("sec-2"))))
+
+	t.Run("values are readable through the bucket", func(t *testing.T) {
+		res, err := b.Get([]byte("key-1"))
+		require.Nil(t, err)
+		assert.Equal(t, value, res)
+		res, err = b.GetBySecondary(0, []byte("sec-2"))
+		require.Nil(t, err)
+		assert.Equal(t, value, res)
+
+		c := b.Cursor()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, value, v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("the commit log is not stored in plain text", func(t *testing.T) {
+		assertNotOnDisk(t, dir, value, []byte("key-2"), []byte("sec-2"))
+	})
+
+	t.Run("segments are not stored in plain text", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+		assertNotOnDisk(t, dir, value, []byte("key-1"), []byte("sec-1"),
+			[]byte("key-2"), []byte("sec-2"))
+	})
+
+	require.Nil(t, b.Shutdown(ctx))
+
+	t.Run("the bucket cannot be opened without the key", func(t *testing.T) {
+		_, err := open(nil)
+		assert.ErrorContains(t, err, "no key")
+		_, err = open(bytes.Repeat([]byte{8}, 32))
+		assert.ErrorContains(t, err, "different key")
+	})
+
+	t.Run("the bucket can be opened with the key", func(t *testing.T) {
+		b, err := open(key)
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+		res, err := b.Get([]byte("key-2"))
+		require.Nil(t, err)
+		assert.Equal(t, value, res)
+	})
+
+	t.Run("unencrypted buckets cannot be opened with a key", func(t *testing.T) {
+		dir = t.TempDir()
+		b, err := open(nil)
+		require.Nil(t, err)
+		require.Nil(t, b.Put([]byte("key-1"), value))
+		require.Nil(t, b.Shutdown(ctx))
+
+		_, err = open(key)
+		assert.NotNil(t, err)
+
+		b, err = open(nil)
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+		res, err := b.Get([]byte("key-1"))
+		require.Nil(t, err)
+		assert.Equal(t, value, res)
+	})
+
+	t.Run("compacted segments of a roaring set bucket", func(t *testing.T) {
+		dir := t.TempDir()
+		enc, err := diskio.NewEncryption(key)
+		require.Nil(t, err)
+		b, err := NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyRoaringSet), WithEncryption(enc))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		token := []byte("a-token-which-must-not-be-stored")
+		require.Nil(t, b.RoaringSetAddOne(token, 1))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.RoaringSetAddOne(token, 2))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Nil(t, b.disk.compactOnce())
+		require.Len(t, b.disk.segments, 1)
+		assertNotOnDisk(t, dir, token)
+
+		bm, err := b.RoaringSetGet(token)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2}, bm.ToArray())
+	})
+
+	t.Run("map buckets", func(t *testing.T) {
+		dir := t.TempDir()
+		enc, err := diskio.NewEncryption(key)
+		require.Nil(t, err)
+		b, err := NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyMapCollection), WithEncryption(enc))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		token := []byte("a-token-which-must-not-be-stored")
+		require.Nil(t, b.MapSet(token, MapPair{Key: []byte("doc-1"), Value: value}))
+		require.Nil(t, b.FlushAndSwitch())
+		assertNotOnDisk(t, dir, token, value)
+
+		pairs, err := b.MapList(token)
+		require.Nil(t, err)
+		require.Len(t, pairs, 1)
+		assert.Equal(t, value, pairs[0].Value)
+	})
+}
+
+func assertNotOnDisk(t *testing.T, dir string, needles ...[]byte) {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		require.Nil(t, err)
+		for _, needle := range needles {
+			assert.False(t, bytes.Contains(data, needle), "%q found in %s", needle, path)
+		}
+		return nil
+	})
+	require.Nil(t, err)
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/weaviate/sroar"
+)
+
+// filterCache keeps the flattened bitmaps of the most recently read keys of
+// a roaring set bucket. Repeated filters on the same value, such as the
+// tenant of a multi-tenant pattern, then skip combining the layers of all
+// disk segments.
+//
+// An entry is valid up to the segment it was calculated with. If segments
+// are added by a flush, only the layers of the new segments need to be
+// applied to the cached bitmap. A compaction invalidates the entries of the
+// first of the two compacted segments, entries of the second one still hold
+// for the compacted segment. Memtables are never cached.
+type filterCache struct {
+	sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+type filterCacheEntry struct {
+	key     string
+	segment *segment
+	bitmap  *sroar.Bitmap
+}
+
+func newFilterCache(maxEntries int) *filterCache {
+	return &filterCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// get returns a copy of the cached bitmap of the key and the last segment
+// contained in it. It returns nil if the key is not cached.
+func (c *filterCache) get(key []byte) (*sroar.Bitmap, *segment) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[string(key)]
+	if !ok {
+		return nil, nil
+	}
+
+	c.lru.MoveToFront(elem)
+	entry := elem.Value.(*filterCacheEntry)
+	return entry.bitmap.Clone(), entry.segment
+}
+
+// put caches the bitmap of the key, which contains all segments up to and
+// including the specified one. The bitmap must not be changed afterwards.
+func (c *filterCache) put(key []byte, seg *segment, bitmap *sroar.Bitmap) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[string(key)]; ok {
+		entry := elem.Value.(*filterCacheEntry)
+		entry.segment = seg
+		entry.bitmap = bitmap
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	entry := &filterCacheEntry{key: string(key), segment: seg, bitmap: bitmap}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// invalidate removes all entries which were calculated up to the segment
+func (c *filterCache) invalidate(seg *segment) {
+	c.Lock()
+	defer c.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*filterCacheEntry).segment == seg {
+			c.remove(elem)
+		}
+		elem = next
+	}
+}
+
+// replace points all entries calculated up to the old segment to the updated
+// one. This is only valid if the updated segment contains the old one and
+// all previous ones it is combined with, e.g. the result of a compaction of
+// the old segment with its predecessor.
+func (c *filterCache) replace(old, updated *segment) {
+	c.Lock()
+	defer c.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		if entry := elem.Value.(*filterCacheEntry); entry.segment == old {
+			entry.segment = updated
+		}
+	}
+}
+
+func (c *filterCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*filterCacheEntry).key)
+}
+
+// drop removes all entries
+func (c *filterCache) drop() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+}
+
+// sizeInBytes is the size of all cached bitmaps
+func (c *filterCache) sizeInBytes() uint64 {
+	c.Lock()
+	defer c.Unlock()
+
+	var size uint64
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		size += uint64(len(elem.Value.(*filterCacheEntry).bitmap.ToBuffer()))
+	}
+	return size
+}
+
+func (c *filterCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.lru.Len()
+}
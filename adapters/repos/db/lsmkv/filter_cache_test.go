@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestFilterCache(t *testing.T) {
+	seg1, seg2, seg3 := &segment{}, &segment{}, &segment{}
+	bitmap := func(ids ...uint64) *sroar.Bitmap {
+		bm := sroar.NewBitmap()
+		bm.SetMany(ids)
+		return bm
+	}
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		c := newFilterCache(2)
+		c.put([]byte("a"), seg1, bitmap(1))
+		c.put([]byte("b"), seg1, bitmap(2))
+		c.get([]byte("a"))
+		c.put([]byte("c"), seg1, bitmap(3))
+
+		assert.Equal(t, 2, c.len())
+		bm, _ := c.get([]byte("b"))
+		assert.Nil(t, bm)
+
+		bm, seg := c.get([]byte("a"))
+		require.NotNil(t, bm)
+		assert.Equal(t, []uint64{1}, bm.ToArray())
+		assert.Same(t, seg1, seg)
+	})
+
+	t.Run("returned bitmaps are copies", func(t *testing.T) {
+		c := newFilterCache(2)
+		c.put([]byte("a"), seg1, bitmap(1))
+
+		bm, _ := c.get([]byte("a"))
+		bm.Set(2)
+
+		bm, _ = c.get([]byte("a"))
+		assert.Equal(t, []uint64{1}, bm.ToArray())
+	})
+
+	t.Run("invalidate and replace segments", func(t *testing.T) {
+		c := newFilterCache(10)
+		c.put([]byte("a"), seg1, bitmap(1))
+		c.put([]byte("b"), seg2, bitmap(2))
+
+		c.invalidate(seg1)
+		c.replace(seg2, seg3)
+
+		bm, _ := c.get([]byte("a"))
+		assert.Nil(t, bm)
+
+		bm, seg := c.get([]byte("b"))
+		require.NotNil(t, bm)
+		assert.Same(t, seg3, seg)
+	})
+}
+
+func TestBucket_FilterCache(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyRoaringSet), WithFilterCache(10))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	key := []byte("tenant-a")
+	expectIDs := func(t *testing.T, expected ...uint64) {
+		bm, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Equal(t, expected, bm.ToArray())
+	}
+
+	t.Run("memtables are not cached", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetAddList(key, []uint64{1, 2, 3}))
+		expectIDs(t, 1, 2, 3)
+		assert.Equal(t, 0, b.disk.filterCache.len())
+	})
+
+	t.Run("segments are cached", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+		expectIDs(t, 1, 2, 3)
+		assert.Equal(t, 1, b.disk.filterCache.len())
+
+		// a cached read must not be affected by changes to a previous result
+		bm, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		bm.Set(17)
+		expectIDs(t, 1, 2, 3)
+	})
+
+	t.Run("cache is combined with memtable", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetRemoveOne(key, 2))
+		require.Nil(t, b.RoaringSetAddOne(key, 4))
+		expectIDs(t, 1, 3, 4)
+	})
+
+	t.Run("cache is extended by new segments", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+		expectIDs(t, 1, 3, 4)
+
+		_, seg := b.disk.filterCache.get(key)
+		assert.Same(t, b.disk.segments[len(b.disk.segments)-1], seg)
+	})
+
+	t.Run("cache is valid after compaction", func(t *testing.T) {
+		require.Len(t, b.disk.segments, 2)
+
+		// the cached entry is up to the second segment, which the compacted
+		// segment replaces
+		require.Nil(t, b.disk.compactOnce())
+		require.Len(t, b.disk.segments, 1)
+		_, seg := b.disk.filterCache.get(key)
+		assert.Same(t, b.disk.segments[0], seg)
+		expectIDs(t, 1, 3, 4)
+	})
+}
+
+func TestBucket_FilterCacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyRoaringSet), WithFilterCache(10))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	key := []byte("tenant-a")
+
+	require.Nil(t, b.RoaringSetAddList(key, []uint64{1, 2}))
+	require.Nil(t, b.FlushAndSwitch())
+	_, err = b.RoaringSetGet(key)
+	require.Nil(t, err)
+
+	require.Nil(t, b.RoaringSetRemoveOne(key, 1))
+	require.Nil(t, b.FlushAndSwitch())
+
+	// the cached entry is up to the first segment, which no longer exists on
+	// its own after it is compacted with the second one
+	require.Nil(t, b.disk.compactOnce())
+	require.Len(t, b.disk.segments, 1)
+	assert.Equal(t, 0, b.disk.filterCache.len())
+
+	bm, err := b.RoaringSetGet(key)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{2}, bm.ToArray())
+}
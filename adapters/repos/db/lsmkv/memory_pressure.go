@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+// MemoryUsage is the memory held by the memtables and the filter caches of
+// a bucket or store, in bytes
+type MemoryUsage struct {
+	Memtables   uint64
+	FilterCache uint64
+}
+
+func (u MemoryUsage) Add(other MemoryUsage) MemoryUsage {
+	return MemoryUsage{
+		Memtables:   u.Memtables + other.Memtables,
+		FilterCache: u.FilterCache + other.FilterCache,
+	}
+}
+
+// MemoryUsage returns the size of the active and the flushing memtable as
+// well as the bitmaps in the filter cache
+func (b *Bucket) MemoryUsage() MemoryUsage {
+	var out MemoryUsage
+
+	b.flushLock.RLock()
+	if b.active != nil {
+		out.Memtables += b.active.Size()
+	}
+	if b.flushing != nil {
+		out.Memtables += b.flushing.Size()
+	}
+	b.flushLock.RUnlock()
+
+	if b.disk != nil && b.disk.filterCache != nil {
+		out.FilterCache = b.disk.filterCache.sizeInBytes()
+	}
+	return out
+}
+
+// RequestFlush makes the next run of the flush cycle flush the active
+// memtable, even if it is below the thresholds. It is used to free memory
+// under memory pressure.
+func (b *Bucket) RequestFlush() {
+	b.flushRequested.Store(true)
+}
+
+// DropFilterCache removes all entries from the filter cache
+func (b *Bucket) DropFilterCache() {
+	if b.disk != nil && b.disk.filterCache != nil {
+		b.disk.filterCache.drop()
+	}
+}
+
+// MemoryUsage sums up the memory usage of all buckets
+func (s *Store) MemoryUsage() MemoryUsage {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	var out MemoryUsage
+	for _, b := range s.bucketsByName {
+		out = out.Add(b.MemoryUsage())
+	}
+	return out
+}
+
+// RequestFlush requests a flush of the memtables of all buckets, see
+// [Bucket.RequestFlush]
+func (s *Store) RequestFlush() {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	for _, b := range s.bucketsByName {
+		b.RequestFlush()
+	}
+}
+
+// DropFilterCaches empties the filter caches of all buckets
+func (s *Store) DropFilterCaches() {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	for _, b := range s.bucketsByName {
+		b.DropFilterCache()
+	}
+}
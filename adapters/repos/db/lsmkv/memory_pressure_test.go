@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucket_MemoryPressure(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyRoaringSet), WithFilterCache(10))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	key := []byte("tenant-a")
+	require.Nil(t, b.RoaringSetAddList(key, []uint64{1, 2, 3}))
+
+	t.Run("memtable is counted", func(t *testing.T) {
+		usage := b.MemoryUsage()
+		assert.Greater(t, usage.Memtables, uint64(0))
+		assert.Zero(t, usage.FilterCache)
+	})
+
+	t.Run("requested flush", func(t *testing.T) {
+		neverBreak := func() bool { return false }
+		assert.False(t, b.flushAndSwitchIfThresholdsMet(neverBreak), "below thresholds")
+
+		b.RequestFlush()
+		assert.True(t, b.flushAndSwitchIfThresholdsMet(neverBreak))
+		assert.Zero(t, b.MemoryUsage().Memtables)
+		assert.Len(t, b.disk.segments, 1)
+
+		b.RequestFlush()
+		assert.False(t, b.flushAndSwitchIfThresholdsMet(neverBreak), "empty memtable")
+	})
+
+	t.Run("filter cache is counted and dropped", func(t *testing.T) {
+		_, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Greater(t, b.MemoryUsage().FilterCache, uint64(0))
+
+		b.DropFilterCache()
+		assert.Zero(t, b.MemoryUsage().FilterCache)
+		assert.Equal(t, 0, b.disk.filterCache.len())
+
+		bm, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, bm.ToArray())
+	})
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -37,12 +38,15 @@ type Memtable struct {
 	lastWrite          time.Time
 	createdAt          time.Time
 	metrics            *memtableMetrics
+
+	// nil unless the files of the memtable are encrypted
+	encryption *diskio.Encryption
 }
 
 func newMemtable(path string, strategy string,
-	secondaryIndices uint16, metrics *Metrics,
+	secondaryIndices uint16, metrics *Metrics, enc *diskio.Encryption,
 ) (*Memtable, error) {
-	cl, err := newCommitLogger(path)
+	cl, err := newCommitLogger(path, enc)
 	if err != nil {
 		return nil, errors.Wrap(err, "init commit logger")
 	}
@@ -54,6 +58,7 @@ func newMemtable(path string, strategy string,
 		primaryIndex:     &binarySearchTree{}, // todo, sort upfront
 		roaringSet:       &roaringset.BinarySearchTree{},
 		commitlog:        cl,
+		encryption:       enc,
 		path:             path,
 		strategy:         strategy,
 		secondaryIndices: secondaryIndices,
@@ -15,7 +15,6 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
@@ -42,7 +41,7 @@ func (m *Memtable) flush() error {
 		return nil
 	}
 
-	f, err := os.Create(m.path + ".db")
+	f, err := m.encryption.Create(m.path + ".db")
 	if err != nil {
 		return err
 	}
@@ -79,6 +78,7 @@ func (m *Memtable) flush() error {
 		Keys:                keys,
 		SecondaryIndexCount: m.secondaryIndices,
 		ScratchSpacePath:    m.path + ".scratch.d",
+		Encryption:          m.encryption,
 	}
 
 	if _, err := indices.WriteTo(w); err != nil {
@@ -26,7 +26,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	}
 
 	t.Run("inserting individual entries", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -53,7 +53,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting lists", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -78,7 +78,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -105,7 +105,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing individual entries", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -126,7 +126,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing lists", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -151,7 +151,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -176,7 +176,7 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("adding/removing bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
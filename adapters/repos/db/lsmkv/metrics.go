@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -41,6 +42,16 @@ type Metrics struct {
 	memtableSize         *prometheus.GaugeVec
 	DimensionSum         *prometheus.GaugeVec
 
+	bucketSegments          *prometheus.GaugeVec
+	bucketMemtableSize      *prometheus.GaugeVec
+	bucketCompactions       prometheus.ObserverVec
+	bucketFlushes           *prometheus.CounterVec
+	bucketOperations        prometheus.ObserverVec
+	bucketBloomFilterChecks *prometheus.CounterVec
+	bucketFilterCache       *prometheus.CounterVec
+
+	// groupClasses is set if the metrics of several shards end up in the same
+	// series, metrics which can't be aggregated are not reported then
 	groupClasses bool
 }
 
@@ -50,6 +61,12 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 	if promMetrics.Group {
 		className = "n/a"
 		shardName = "n/a"
+	} else if promMetrics.GroupShards {
+		shardName = "n/a"
+	}
+	shardLabels := prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
 	}
 
 	replace := promMetrics.AsyncOperations.MustCurryWith(prometheus.Labels{
@@ -77,7 +94,7 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 	})
 
 	return &Metrics{
-		groupClasses:         promMetrics.Group,
+		groupClasses:         promMetrics.Group || promMetrics.GroupShards,
 		CompactionReplace:    replace,
 		CompactionSet:        set,
 		CompactionMap:        stratMap,
@@ -126,6 +143,13 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		bucketSegments:          promMetrics.LSMBucketSegments.MustCurryWith(shardLabels),
+		bucketMemtableSize:      promMetrics.LSMBucketMemtableSize.MustCurryWith(shardLabels),
+		bucketCompactions:       promMetrics.LSMBucketCompactionDurations.MustCurryWith(shardLabels),
+		bucketFlushes:           promMetrics.LSMBucketFlushes.MustCurryWith(shardLabels),
+		bucketOperations:        promMetrics.LSMBucketOperationDurations.MustCurryWith(shardLabels),
+		bucketBloomFilterChecks: promMetrics.LSMBucketBloomFilterChecks.MustCurryWith(shardLabels),
+		bucketFilterCache:       promMetrics.LSMBucketFilterCacheLookups.MustCurryWith(shardLabels),
 	}
 }
 
@@ -179,7 +203,9 @@ func (m *Metrics) MemtableSizeSetter(path, strategy string) Setter {
 	}
 }
 
-func (m *Metrics) BloomFilterObserver(strategy, operation string) TimeObserver {
+// BloomFilterObserver observes the duration of a bloom filter check and
+// counts the result per bucket. The bucket is identified by its dir.
+func (m *Metrics) BloomFilterObserver(dir, strategy, operation, result string) TimeObserver {
 	if m == nil {
 		return noOpTimeObserver
 	}
@@ -188,9 +214,15 @@ func (m *Metrics) BloomFilterObserver(strategy, operation string) TimeObserver {
 		"strategy":  strategy,
 		"operation": operation,
 	})
+	checks := m.bucketBloomFilterChecks.With(prometheus.Labels{
+		"strategy": strategy,
+		"bucket":   filepath.Base(dir),
+		"result":   result,
+	})
 
 	return func(before time.Time) {
 		curried.Observe(float64(time.Since(before)) / float64(time.Millisecond))
+		checks.Inc()
 	}
 }
 
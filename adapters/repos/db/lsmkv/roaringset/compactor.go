@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 // Compactor takes in a left and a right segment and merges them into a single
@@ -67,6 +68,7 @@ type Compactor struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+	encryption       *diskio.Encryption
 }
 
 // NewCompactor from left (older) and right (newer) seeker. See [Compactor] for
@@ -74,7 +76,7 @@ type Compactor struct {
 // requirements are the way they are.
 func NewCompactor(w io.WriteSeeker,
 	left, right *SegmentCursor, level uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, encryption *diskio.Encryption,
 ) *Compactor {
 	return &Compactor{
 		left:             left,
@@ -83,6 +85,7 @@ func NewCompactor(w io.WriteSeeker,
 		bufw:             bufio.NewWriterSize(w, 256*1024),
 		currentLevel:     level,
 		scratchSpacePath: scratchSpacePath,
+		encryption:       encryption,
 	}
 }
 
@@ -261,6 +264,7 @@ func (c *Compactor) writeIndexes(keys []segmentindex.Key) error {
 		Keys:                keys,
 		SecondaryIndexCount: 0,
 		ScratchSpacePath:    c.scratchSpacePath,
+		Encryption:          c.encryption,
 	}
 
 	_, err := indexes.WriteTo(c.bufw)
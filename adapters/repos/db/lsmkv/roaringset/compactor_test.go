@@ -270,7 +270,7 @@ func Test_Compactor(t *testing.T) {
 			f, err := os.Create(segmentFile)
 			require.Nil(t, err)
 
-			c := NewCompactor(f, leftCursor, rightCursor, 5, t.TempDir())
+			c := NewCompactor(f, leftCursor, rightCursor, 5, t.TempDir(), nil)
 			require.Nil(t, c.Do())
 
 			require.Nil(t, f.Close())
@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"syscall"
@@ -20,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/willf/bloom"
 )
 
@@ -42,8 +44,19 @@ type segment struct {
 	metrics               *Metrics
 	bloomFilterMetrics    *bloomFilterMetrics
 
+	// set if the data section of the segment lives in a remote store, contents
+	// is nil in this case
+	remote *remoteSegment
+
+	// nil unless the files of the segment are encrypted
+	encryption *diskio.Encryption
+
 	// the net addition this segment adds with respect to all previous segments
 	countNetAdditions int
+
+	// pre-aggregated stats of numeric roaring set segments, nil if not kept
+	numericStats *NumericStats
+	keyStats     *KeyStats
 }
 
 type diskIndex interface {
@@ -63,88 +76,177 @@ type diskIndex interface {
 }
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
-	existsLower existsOnLowerSegmentsFn,
+	existsLower existsOnLowerSegmentsFn, enc *diskio.Encryption,
 ) (*segment, error) {
-	file, err := os.Open(path)
+	content, err := mapSegment(path, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := segmentindex.ParseHeader(bytes.NewReader(content[:segmentindex.HeaderSize]))
+	if err != nil {
+		syscall.Munmap(content)
+		return nil, errors.Wrap(err, "parse header")
+	}
+
+	ind := &segment{
+		path:               path,
+		contents:           content,
+		segmentEndPos:      uint64(len(content)),
+		logger:             logger,
+		metrics:            metrics,
+		bloomFilterMetrics: newBloomFilterMetrics(metrics, path),
+		encryption:         enc,
+	}
+
+	if err := ind.init(header, content, 0, existsLower); err != nil {
+		return nil, err
+	}
+
+	return ind, nil
+}
+
+// mapSegment maps the segment at path into memory. An encrypted segment is
+// decrypted into anonymous memory instead, so both are unmapped alike.
+func mapSegment(path string, enc *diskio.Encryption) ([]byte, error) {
+	file, err := enc.Open(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "open file")
 	}
 	defer file.Close()
 
-	fileInfo, err := file.Stat()
+	size, err := file.Size()
 	if err != nil {
 		return nil, errors.Wrap(err, "stat file")
 	}
+	if size < segmentindex.HeaderSize {
+		return nil, errors.Errorf("segment of %d bytes is too small", size)
+	}
+
+	if enc == nil {
+		content, err := syscall.Mmap(int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+		if err != nil {
+			return nil, errors.Wrap(err, "mmap file")
+		}
+		return content, nil
+	}
 
-	content, err := syscall.Mmap(int(file.Fd()), 0, int(fileInfo.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	content, err := syscall.Mmap(-1, 0, int(size), syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_PRIVATE|syscall.MAP_ANON)
 	if err != nil {
-		return nil, errors.Wrap(err, "mmap file")
+		return nil, errors.Wrap(err, "allocate memory for decrypted segment")
+	}
+	if _, err := file.ReadAt(content, 0); err != nil {
+		syscall.Munmap(content)
+		return nil, errors.Wrap(err, "read encrypted segment")
 	}
+	return content, nil
+}
 
-	header, err := segmentindex.ParseHeader(bytes.NewReader(content[:segmentindex.HeaderSize]))
+// newTieredSegment loads a segment from the local stub written when it was
+// tiered. Only the indexes are held locally, values are read from the remote
+// store.
+func newTieredSegment(tieredPath string, tiering *Tiering, logger logrus.FieldLogger,
+	metrics *Metrics, existsLower existsOnLowerSegmentsFn, enc *diskio.Encryption,
+) (*segment, error) {
+	stub, err := readTieredStub(enc, tieredPath)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := segmentindex.ParseHeader(bytes.NewReader(stub.header))
 	if err != nil {
 		return nil, errors.Wrap(err, "parse header")
 	}
 
+	decrypter, err := enc.NewDecrypter(stub.fileHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "init decryption of remote segment")
+	}
+
+	size := header.IndexStart + uint64(len(stub.index))
+	ind := &segment{
+		path:               segmentPathFromTieredPath(tieredPath),
+		segmentEndPos:      size,
+		logger:             logger,
+		metrics:            metrics,
+		bloomFilterMetrics: newBloomFilterMetrics(metrics, tieredPath),
+		encryption:         enc,
+		remote: &remoteSegment{
+			tiering:    tiering,
+			key:        stub.key,
+			size:       size,
+			headerSize: uint64(enc.HeaderSize()),
+			decrypter:  decrypter,
+		},
+	}
+
+	if err := ind.init(header, stub.index, header.IndexStart, existsLower); err != nil {
+		return nil, err
+	}
+
+	return ind, nil
+}
+
+// init sets up the indexes, bloom filters and net additions. The index source
+// is either the full segment or only its index section, offset is the
+// position of the source within the segment.
+func (s *segment) init(header *segmentindex.Header, indexSource []byte,
+	offset uint64, existsLower existsOnLowerSegmentsFn,
+) error {
 	switch header.Strategy {
 	case segmentindex.StrategyReplace, segmentindex.StrategySetCollection,
 		segmentindex.StrategyMapCollection, segmentindex.StrategyRoaringSet:
 	default:
-		return nil, errors.Errorf("unsupported strategy in segment")
+		return errors.Errorf("unsupported strategy in segment")
 	}
 
-	primaryIndex, err := header.PrimaryIndex(content)
+	primaryIndex, err := header.PrimaryIndexAt(indexSource, offset)
 	if err != nil {
-		return nil, errors.Wrap(err, "extract primary index position")
+		return errors.Wrap(err, "extract primary index position")
 	}
 
-	primaryDiskIndex := segmentindex.NewDiskTree(primaryIndex)
+	s.level = header.Level
+	s.version = header.Version
+	s.secondaryIndexCount = header.SecondaryIndices
+	s.segmentStartPos = header.IndexStart
+	s.strategy = header.Strategy
+	s.dataStartPos = segmentindex.HeaderSize // fixed value that's the same for all strategies
+	s.dataEndPos = header.IndexStart
+	s.index = segmentindex.NewDiskTree(primaryIndex)
 
-	ind := &segment{
-		level:               header.Level,
-		path:                path,
-		contents:            content,
-		version:             header.Version,
-		secondaryIndexCount: header.SecondaryIndices,
-		segmentStartPos:     header.IndexStart,
-		segmentEndPos:       uint64(len(content)),
-		strategy:            header.Strategy,
-		dataStartPos:        segmentindex.HeaderSize, // fixed value that's the same for all strategies
-		dataEndPos:          header.IndexStart,
-		index:               primaryDiskIndex,
-		logger:              logger,
-		metrics:             metrics,
-		bloomFilterMetrics:  newBloomFilterMetrics(metrics),
-	}
-
-	if ind.secondaryIndexCount > 0 {
-		ind.secondaryIndices = make([]diskIndex, ind.secondaryIndexCount)
-		ind.secondaryBloomFilters = make([]*bloom.BloomFilter, ind.secondaryIndexCount)
-		for i := range ind.secondaryIndices {
-			secondary, err := header.SecondaryIndex(content, uint16(i))
+	if s.secondaryIndexCount > 0 {
+		s.secondaryIndices = make([]diskIndex, s.secondaryIndexCount)
+		s.secondaryBloomFilters = make([]*bloom.BloomFilter, s.secondaryIndexCount)
+		for i := range s.secondaryIndices {
+			secondary, err := header.SecondaryIndexAt(indexSource, offset, uint16(i))
 			if err != nil {
-				return nil, errors.Wrapf(err, "get position for secondary index at %d", i)
+				return errors.Wrapf(err, "get position for secondary index at %d", i)
 			}
 
-			ind.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
-			if err := ind.initSecondaryBloomFilter(i); err != nil {
-				return nil, errors.Wrapf(err, "init bloom filter for secondary index at %d", i)
+			s.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
+			if err := s.initSecondaryBloomFilter(i); err != nil {
+				return errors.Wrapf(err, "init bloom filter for secondary index at %d", i)
 			}
 		}
 	}
 
-	if err := ind.initBloomFilter(); err != nil {
-		return nil, err
+	if err := s.initBloomFilter(); err != nil {
+		return err
 	}
 
-	if err := ind.initCountNetAdditions(existsLower); err != nil {
-		return nil, err
+	if err := s.initCountNetAdditions(existsLower); err != nil {
+		return err
 	}
 
-	return ind, nil
+	return nil
 }
 
 func (s *segment) close() error {
+	if s.remote != nil {
+		return nil
+	}
+
 	return syscall.Munmap(s.contents)
 }
 
@@ -167,6 +269,18 @@ func (s *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.numericStatsPath()); err != nil {
+		return fmt.Errorf("drop numeric stats file: %w", err)
+	}
+
+	if err := os.RemoveAll(s.keyStatsPath()); err != nil {
+		return fmt.Errorf("drop key stats file: %w", err)
+	}
+
+	if s.remote != nil {
+		return s.dropRemote()
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong and we
 	// don't want to ignore it.
@@ -177,10 +291,104 @@ func (s *segment) drop() error {
 	return nil
 }
 
+func (s *segment) dropRemote() error {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteReadTimeout)
+	defer cancel()
+
+	if err := s.remote.tiering.store.DeleteSegment(ctx, s.remote.key); err != nil {
+		return fmt.Errorf("drop tiered segment: %w", err)
+	}
+	s.remote.tiering.cache.evict(s.remote.key)
+
+	if err := os.Remove(tieredPathFromSegmentPath(s.path)); err != nil {
+		return fmt.Errorf("drop tiered segment stub: %w", err)
+	}
+
+	return nil
+}
+
+// copyData fills p with the contents of the segment starting at off
+func (s *segment) copyData(p []byte, off uint64) error {
+	if s.remote != nil {
+		return s.remote.readAt(context.Background(), p, off)
+	}
+
+	copy(p, s.contents[off:])
+	return nil
+}
+
+// cursorReader serves the data section of a segment to cursors. Local
+// segments are served from their contents directly. Tiered segments are read
+// one node at a time with ranged reads through the block cache, so a scan
+// never holds more than the current node in memory. The bounds of each node
+// are taken from the primary index. Cursors are not bound to a request, so
+// every read is only bounded by remoteReadTimeout.
+type cursorReader struct {
+	segment *segment
+
+	// lastKey and lastEnd identify the node read last. The node following it
+	// is the first node in the index with a key greater than lastKey.
+	lastKey []byte
+	lastEnd uint64
+}
+
+func (s *segment) newCursorReader() *cursorReader {
+	return &cursorReader{segment: s}
+}
+
+// node returns the contents of a node found through the index
+func (r *cursorReader) node(node segmentindex.Node) ([]byte, error) {
+	if r.segment.remote == nil {
+		return r.segment.contents[node.Start:node.End], nil
+	}
+
+	// the contents are not reused, as cursors hand out values which point
+	// into them
+	contents := make([]byte, node.End-node.Start)
+	if err := r.segment.remote.readAt(context.Background(), contents, node.Start); err != nil {
+		return nil, err
+	}
+
+	r.lastKey = append(r.lastKey[:0], node.Key...)
+	r.lastEnd = node.End
+	return contents, nil
+}
+
+// from returns the contents starting at off, which must either be the start
+// of the data section or the end of the node read last. For a tiered segment
+// only the node at off is returned.
+func (r *cursorReader) from(off uint64) ([]byte, error) {
+	if r.segment.remote == nil {
+		return r.segment.contents[off:], nil
+	}
+
+	var seek []byte
+	switch off {
+	case r.segment.dataStartPos:
+		// an empty key sorts before all keys of the segment
+	case r.lastEnd:
+		seek = append(append(make([]byte, 0, len(r.lastKey)+1), r.lastKey...), 0)
+	default:
+		return nil, fmt.Errorf("%w %s: no node ends at offset %d",
+			ErrRemoteSegmentRead, r.segment.remote.key, off)
+	}
+
+	node, err := r.segment.index.Seek(seek)
+	if err != nil {
+		return nil, err
+	}
+	if node.Start != off {
+		return nil, fmt.Errorf("%w %s: expected node at offset %d, index points to %d",
+			ErrRemoteSegmentRead, r.segment.remote.key, off, node.Start)
+	}
+
+	return r.node(node)
+}
+
 // Size returns the total size of the segment in bytes, including the header
 // and index
 func (s *segment) Size() int {
-	return len(s.contents)
+	return int(s.segmentEndPos)
 }
 
 // Payload Size is only the payload of the index, excluding the index
@@ -16,11 +16,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"hash/crc32"
-	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/willf/bloom"
 )
 
@@ -120,11 +120,11 @@ func (s *segment) storeBloomFilterOnDisk(path string) error {
 		return fmt.Errorf("write bloom filter: %w", err)
 	}
 
-	return writeWithChecksum(buf.Bytes(), path)
+	return writeWithChecksum(s.encryption, buf.Bytes(), path)
 }
 
 func (s *segment) loadBloomFilterFromDisk() error {
-	data, err := loadWithChecksum(s.bloomFilterPath(), -1)
+	data, err := loadWithChecksum(s.encryption, s.bloomFilterPath(), -1)
 	if err != nil {
 		return err
 	}
@@ -226,11 +226,11 @@ func (s *segment) storeBloomFilterSecondaryOnDisk(path string, pos int) error {
 		return fmt.Errorf("write bloom filter: %w", err)
 	}
 
-	return writeWithChecksum(buf.Bytes(), path)
+	return writeWithChecksum(s.encryption, buf.Bytes(), path)
 }
 
 func (s *segment) loadBloomFilterSecondaryFromDisk(pos int) error {
-	data, err := loadWithChecksum(s.bloomFilterSecondaryPath(pos), -1)
+	data, err := loadWithChecksum(s.encryption, s.bloomFilterSecondaryPath(pos), -1)
 	if err != nil {
 		return err
 	}
@@ -244,10 +244,10 @@ func (s *segment) loadBloomFilterSecondaryFromDisk(pos int) error {
 	return nil
 }
 
-func writeWithChecksum(data []byte, path string) error {
+func writeWithChecksum(enc *diskio.Encryption, data []byte, path string) error {
 	chksm := crc32.ChecksumIEEE(data)
 
-	f, err := os.Create(path)
+	f, err := enc.Create(path)
 	if err != nil {
 		return fmt.Errorf("open file for writing: %w", err)
 	}
@@ -277,8 +277,8 @@ func writeWithChecksum(data []byte, path string) error {
 
 // use negative length check to indicate that no length check should be
 // performed
-func loadWithChecksum(path string, lengthCheck int) ([]byte, error) {
-	f, err := os.Open(path)
+func loadWithChecksum(enc *diskio.Encryption, path string, lengthCheck int) ([]byte, error) {
+	f, err := enc.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("open file for reading: %w", err)
 	}
@@ -301,7 +301,7 @@ func TestRepairTooShortBloomSecondaryOnInit(t *testing.T) {
 func TestLoadWithChecksumErrorCases(t *testing.T) {
 	t.Run("file does not exist", func(t *testing.T) {
 		dirName := t.TempDir()
-		_, err := loadWithChecksum(path.Join(dirName, "my-file"), -1)
+		_, err := loadWithChecksum(nil, path.Join(dirName, "my-file"), -1)
 		assert.NotNil(t, err)
 	})
 
@@ -316,7 +316,7 @@ func TestLoadWithChecksumErrorCases(t *testing.T) {
 
 		require.Nil(t, f.Close())
 
-		_, err = loadWithChecksum(path.Join(dirName, "my-file"), 17)
+		_, err = loadWithChecksum(nil, path.Join(dirName, "my-file"), 17)
 		assert.NotNil(t, err)
 	})
 }
@@ -50,7 +50,9 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 	// compaction completes and the old segment is removed, we would be accessing
 	// invalid memory without the copy, thus leading to a SEGFAULT.
 	contentsCopy := make([]byte, node.End-node.Start)
-	copy(contentsCopy, s.contents[node.Start:node.End])
+	if err := s.copyData(contentsCopy, node.Start); err != nil {
+		return nil, err
+	}
 
 	return s.collectionStratParseData(contentsCopy)
 }
@@ -19,11 +19,13 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
@@ -46,19 +48,46 @@ type SegmentGroup struct {
 	// not guaranteed to be sorted yet
 	mapRequiresSorting bool
 
-	status     storagestate.Status
-	statusLock sync.Mutex
-	metrics    *Metrics
+	status        storagestate.Status
+	statusLock    sync.Mutex
+	metrics       *Metrics
+	bucketMetrics *bucketMetrics
 
 	// all "replace" buckets support counting through net additions, but not all
 	// produce a meaningful count. Typically, the only count we're interested in
 	// is that of the bucket that holds objects
 	monitorCount bool
+
+	// nil if segments of this group are never tiered
+	tiering     *Tiering
+	tieringLock sync.Mutex
+	rootDir     string
+	pinned      atomic.Bool
+
+	// nil unless segments keep pre-aggregated numeric stats
+	numericKeyDecoder NumericKeyDecoder
+
+	// whether segments keep statistics of their keys
+	keyStats bool
+
+	// nil unless the files of the segments are encrypted
+	encryption *diskio.Encryption
+
+	// nil unless bitmaps of frequently read keys are cached
+	filterCache *filterCache
+
+	// segments read by open snapshot cursors must not be unmapped, closing
+	// them is deferred until the last of those cursors is closed
+	snapshotLock  sync.Mutex
+	snapshotRefs  map[*segment]int
+	pendingCloses map[*segment]struct{}
 }
 
 func newSegmentGroup(dir string, logger logrus.FieldLogger,
-	mapRequiresSorting bool, metrics *Metrics, strategy string,
-	monitorCount bool, compactionCycleManager cyclemanager.CycleManager,
+	mapRequiresSorting bool, metrics *Metrics, bucketMetrics *bucketMetrics,
+	strategy string, monitorCount bool, compactionCycleManager cyclemanager.CycleManager,
+	tiering *Tiering, rootDir string, numericKeyDecoder NumericKeyDecoder,
+	keyStats bool, filterCacheMaxEntries int, encryption *diskio.Encryption,
 ) (*SegmentGroup, error) {
 	list, err := os.ReadDir(dir)
 	if err != nil {
@@ -70,13 +99,42 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		dir:                dir,
 		logger:             logger,
 		metrics:            metrics,
+		bucketMetrics:      bucketMetrics,
 		monitorCount:       monitorCount,
 		mapRequiresSorting: mapRequiresSorting,
 		strategy:           strategy,
+		tiering:            tiering,
+		rootDir:            rootDir,
+		numericKeyDecoder:  numericKeyDecoder,
+		keyStats:           keyStats,
+		encryption:         encryption,
 	}
 
+	if filterCacheMaxEntries > 0 {
+		out.filterCache = newFilterCache(filterCacheMaxEntries)
+	}
+
+	pinned, err := fileExists(filepath.Join(dir, pinnedMarker))
+	if err != nil {
+		return nil, errors.Wrap(err, "check for pinned segments")
+	}
+	out.pinned.Store(pinned)
+
 	segmentIndex := 0
 	for _, entry := range list {
+		if filepath.Ext(entry.Name()) == tieredSegmentExt {
+			segment, err := out.loadTieredSegment(filepath.Join(dir, entry.Name()),
+				segmentIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "init tiered segment %s", entry.Name())
+			}
+			if segment != nil {
+				out.segments[segmentIndex] = segment
+				segmentIndex++
+			}
+			continue
+		}
+
 		if filepath.Ext(entry.Name()) != ".db" {
 			// skip, this could be commit log, etc.
 			continue
@@ -111,16 +169,24 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 			return nil, errors.Wrapf(err, "obtain file info")
 		}
 
-		if info.Size() == 0 {
+		if info.Size() <= encryption.HeaderSize() {
 			continue
 		}
 
 		segment, err := newSegment(filepath.Join(dir, entry.Name()), logger,
-			metrics, out.makeExistsOnLower(segmentIndex))
+			metrics, out.makeExistsOnLower(segmentIndex), encryption)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init segment %s", entry.Name())
 		}
 
+		if err := out.initNumericStats(segment); err != nil {
+			return nil, errors.Wrapf(err, "init numeric stats of segment %s", entry.Name())
+		}
+
+		if err := out.initKeyStats(segment); err != nil {
+			return nil, errors.Wrapf(err, "init key stats of segment %s", entry.Name())
+		}
+
 		out.segments[segmentIndex] = segment
 		segmentIndex++
 	}
@@ -130,6 +196,7 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 	if out.monitorCount {
 		out.metrics.ObjectCount(out.count())
 	}
+	out.bucketMetrics.setSegments(len(out.segments))
 
 	out.unregisterCompaction = compactionCycleManager.Register(out.compactIfLevelsMatch)
 
@@ -160,11 +227,19 @@ func (sg *SegmentGroup) add(path string) error {
 
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger, sg.metrics,
-		sg.makeExistsOnLower(newSegmentIndex))
+		sg.makeExistsOnLower(newSegmentIndex), sg.encryption)
 	if err != nil {
 		return errors.Wrapf(err, "init segment %s", path)
 	}
 
+	if err := sg.initNumericStats(segment); err != nil {
+		return errors.Wrapf(err, "init numeric stats of segment %s", path)
+	}
+
+	if err := sg.initKeyStats(segment); err != nil {
+		return errors.Wrapf(err, "init key stats of segment %s", path)
+	}
+
 	sg.segments = append(sg.segments, segment)
 	return nil
 }
@@ -194,6 +269,10 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 				return nil, nil
 			}
 
+			if errors.Is(err, ErrRemoteSegmentRead) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -222,6 +301,10 @@ func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []b
 				return nil, nil, nil
 			}
 
+			if errors.Is(err, ErrRemoteSegmentRead) {
+				return nil, nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -287,10 +370,66 @@ func (sg *SegmentGroup) roaringSetGet(key []byte) (roaringset.BitmapLayers, erro
 	sg.maintenanceLock.RLock()
 	defer sg.maintenanceLock.RUnlock()
 
+	if sg.filterCache != nil {
+		return sg.roaringSetGetCached(key)
+	}
+
+	return roaringSetGetLayers(sg.segments, key, nil)
+}
+
+// roaringSetGetCached flattens all segments into a single layer, starting
+// from the cached bitmap of the key if there is one. Must be called while
+// holding the maintenanceLock.
+func (sg *SegmentGroup) roaringSetGetCached(key []byte) (roaringset.BitmapLayers, error) {
+	if len(sg.segments) == 0 {
+		return nil, nil
+	}
+
+	last := sg.segments[len(sg.segments)-1]
+	cached, cachedUpTo := sg.filterCache.get(key)
+	if cached != nil && cachedUpTo == last {
+		sg.bucketMetrics.filterCacheLookup("hit")
+		return roaringset.BitmapLayers{{Additions: cached}}, nil
+	}
+
+	remaining := sg.segments
+	var root *roaringset.BitmapLayer
+	if cached != nil {
+		for i, seg := range sg.segments {
+			if seg == cachedUpTo {
+				remaining = sg.segments[i+1:]
+				root = &roaringset.BitmapLayer{Additions: cached}
+				break
+			}
+		}
+	}
+	if root != nil {
+		sg.bucketMetrics.filterCacheLookup("partial")
+	} else {
+		sg.bucketMetrics.filterCacheLookup("miss")
+	}
+
+	layers, err := roaringSetGetLayers(remaining, key, root)
+	if err != nil {
+		return nil, err
+	}
+
+	flattened := layers.Flatten()
+	sg.filterCache.put(key, last, flattened.Clone())
+
+	return roaringset.BitmapLayers{{Additions: flattened}}, nil
+}
+
+func roaringSetGetLayers(segments []*segment, key []byte,
+	root *roaringset.BitmapLayer,
+) (roaringset.BitmapLayers, error) {
 	var out roaringset.BitmapLayers
+	if root != nil {
+		out = append(out, *root)
+	}
 
 	// start with first and do not exit
-	for _, segment := range sg.segments {
+	for _, segment := range segments {
 		rs, err := segment.roaringSetGet(key)
 		if err != nil {
 			if err == lsmkv.NotFound {
@@ -334,7 +473,7 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	defer sg.maintenanceLock.Unlock()
 
 	for i, seg := range sg.segments {
-		if err := seg.close(); err != nil {
+		if err := sg.closeSegment(seg); err != nil {
 			return err
 		}
 
@@ -16,6 +16,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -42,6 +43,11 @@ func (sg *SegmentGroup) eligibleForCompaction() bool {
 	levels := map[uint16]int{}
 
 	for _, segment := range sg.segments {
+		if segment.remote != nil {
+			// tiered segments are fully compacted
+			continue
+		}
+
 		levels[segment.level]++
 		if levels[segment.level] > 1 {
 			return true
@@ -59,6 +65,9 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 	levels := map[uint16]int{}
 
 	for _, segment := range sg.segments {
+		if segment.remote != nil {
+			continue
+		}
 		levels[segment.level]++
 	}
 
@@ -87,7 +96,7 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 			break
 		}
 
-		if segment.level == currLowestLevel {
+		if segment.remote == nil && segment.level == currLowestLevel {
 			res = append(res, i)
 		}
 	}
@@ -115,9 +124,10 @@ func (sg *SegmentGroup) compactOnce() error {
 		// nothing to do
 		return nil
 	}
+	defer sg.bucketMetrics.observeCompaction(time.Now())
 
 	path := fmt.Sprintf("%s.tmp", sg.segmentAtPos(pair[1]).path)
-	f, err := os.Create(path)
+	f, err := sg.encryption.Create(path)
 	if err != nil {
 		return err
 	}
@@ -142,7 +152,8 @@ func (sg *SegmentGroup) compactOnce() error {
 
 	case segmentindex.StrategyReplace:
 		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
-			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath)
+			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath,
+			sg.encryption)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -155,7 +166,7 @@ func (sg *SegmentGroup) compactOnce() error {
 	case segmentindex.StrategySetCollection:
 		c := newCompactorSetCollection(f, sg.segmentAtPos(pair[0]).newCollectionCursor(),
 			sg.segmentAtPos(pair[1]).newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath)
+			scratchSpacePath, sg.encryption)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -169,7 +180,8 @@ func (sg *SegmentGroup) compactOnce() error {
 		c := newCompactorMapCollection(f,
 			sg.segmentAtPos(pair[0]).newCollectionCursorReusable(),
 			sg.segmentAtPos(pair[1]).newCollectionCursorReusable(),
-			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting)
+			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting,
+			sg.encryption)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -187,7 +199,7 @@ func (sg *SegmentGroup) compactOnce() error {
 		rightCursor := rightSegment.newRoaringSetCursor()
 
 		c := roaringset.NewCompactor(f, leftCursor, rightCursor,
-			level, scratchSpacePath)
+			level, scratchSpacePath, sg.encryption)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionRoaringSet.With(prometheus.Labels{"path": pathLabel}).Set(1)
@@ -222,19 +234,35 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.RUnlock()
 
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
-		updatedCountNetAdditions, sg.logger)
+		updatedCountNetAdditions, sg.logger, sg.encryption)
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
 
+	numericStatsPath, err := sg.preComputeNumericStats(newPathTmp, old1, old2)
+	if err != nil {
+		return fmt.Errorf("precompute numeric stats: %w", err)
+	}
+	if numericStatsPath != "" {
+		precomputedFiles = append(precomputedFiles, numericStatsPath)
+	}
+
+	keyStatsPath, err := sg.preComputeKeyStats(newPathTmp)
+	if err != nil {
+		return fmt.Errorf("precompute key stats: %w", err)
+	}
+	if keyStatsPath != "" {
+		precomputedFiles = append(precomputedFiles, keyStatsPath)
+	}
+
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
-	if err := sg.segments[old1].close(); err != nil {
+	if err := sg.closeSegment(sg.segments[old1]); err != nil {
 		return errors.Wrap(err, "close disk segment")
 	}
 
-	if err := sg.segments[old2].close(); err != nil {
+	if err := sg.closeSegment(sg.segments[old2]); err != nil {
 		return errors.Wrap(err, "close disk segment")
 	}
 
@@ -246,6 +274,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		return errors.Wrap(err, "drop disk segment")
 	}
 
+	replaced1, replaced2 := sg.segments[old1], sg.segments[old2]
 	sg.segments[old1] = nil
 	sg.segments[old2] = nil
 
@@ -265,11 +294,24 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		}
 	}
 
-	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil)
+	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil, sg.encryption)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
 
+	if err := sg.initNumericStats(seg); err != nil {
+		return errors.Wrap(err, "init numeric stats of new segment")
+	}
+
+	if err := sg.initKeyStats(seg); err != nil {
+		return errors.Wrap(err, "init key stats of new segment")
+	}
+
+	if sg.filterCache != nil {
+		sg.filterCache.invalidate(replaced1)
+		sg.filterCache.replace(replaced2, seg)
+	}
+
 	sg.segments[old2] = seg
 
 	sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
@@ -307,7 +349,7 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldBreak cyclemanager.ShouldBrea
 	sg.logger.WithField("action", "lsm_compaction").
 		WithField("path", sg.dir).
 		Trace("no segment eligible for compaction")
-	return false
+	return sg.tierColdSegments()
 }
 
 func (sg *SegmentGroup) Len() int {
@@ -318,6 +360,8 @@ func (sg *SegmentGroup) Len() int {
 }
 
 func (sg *SegmentGroup) monitorSegments() {
+	sg.bucketMetrics.setSegments(sg.Len())
+
 	if sg.metrics == nil || sg.metrics.groupClasses {
 		return
 	}
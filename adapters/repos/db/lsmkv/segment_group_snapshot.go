@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+// newSnapshotCursors creates cursors on the current segments which remain
+// valid after the maintenanceLock is released. The returned func must be
+// called once the cursors are no longer used.
+func (sg *SegmentGroup) newSnapshotCursors() ([]innerCursorReplace, func()) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	segments := make([]*segment, len(sg.segments))
+	copy(segments, sg.segments)
+	sg.pinSegments(segments)
+
+	out := make([]innerCursorReplace, len(segments))
+	for i, segment := range segments {
+		out[i] = segment.newCursor()
+	}
+
+	return out, func() { sg.unpinSegments(segments) }
+}
+
+func (sg *SegmentGroup) pinSegments(segments []*segment) {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	if sg.snapshotRefs == nil {
+		sg.snapshotRefs = map[*segment]int{}
+	}
+	for _, seg := range segments {
+		sg.snapshotRefs[seg]++
+	}
+}
+
+func (sg *SegmentGroup) unpinSegments(segments []*segment) {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	for _, seg := range segments {
+		sg.snapshotRefs[seg]--
+		if sg.snapshotRefs[seg] > 0 {
+			continue
+		}
+		delete(sg.snapshotRefs, seg)
+
+		if _, ok := sg.pendingCloses[seg]; !ok {
+			continue
+		}
+		delete(sg.pendingCloses, seg)
+		if err := seg.close(); err != nil {
+			sg.logger.WithField("action", "lsm_close_pinned_segment").
+				WithField("path", seg.path).
+				WithError(err).
+				Error("close segment after snapshot was released")
+		}
+	}
+}
+
+// closeSegment unmaps a segment which is no longer part of the group. If a
+// snapshot cursor still reads from it, it is closed once the last of them is
+// released instead. Its files can be removed right away, as the mapping
+// stays intact until it is unmapped.
+func (sg *SegmentGroup) closeSegment(seg *segment) error {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	if sg.snapshotRefs[seg] > 0 {
+		if sg.pendingCloses == nil {
+			sg.pendingCloses = map[*segment]struct{}{}
+		}
+		sg.pendingCloses[seg] = struct{}{}
+		return nil
+	}
+
+	return seg.close()
+}
@@ -0,0 +1,356 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// restoreChunkSize is the size of the ranges read when a tiered segment is
+// downloaded again
+const restoreChunkSize = 16 * 1024 * 1024
+
+// loadTieredSegment mounts a tiered segment from its stub. It returns nil if
+// the segment was still present locally, which means the process stopped
+// before tiering completed and the local copy is authoritative.
+func (sg *SegmentGroup) loadTieredSegment(path string, segmentIndex int) (*segment, error) {
+	ok, err := fileExists(segmentPathFromTieredPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "delete stub of incompletely tiered segment")
+		}
+		return nil, nil
+	}
+
+	if sg.tiering == nil {
+		return nil, errors.Errorf("segment is tiered, but segment tiering is not configured")
+	}
+
+	seg, err := newTieredSegment(path, sg.tiering, sg.logger, sg.metrics,
+		sg.makeExistsOnLower(segmentIndex), sg.encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sg.initNumericStats(seg); err != nil {
+		return nil, errors.Wrap(err, "init numeric stats")
+	}
+
+	if err := sg.initKeyStats(seg); err != nil {
+		return nil, errors.Wrap(err, "init key stats")
+	}
+
+	return seg, nil
+}
+
+// tierColdSegments moves the oldest local segment to the remote store once it
+// is old enough and no longer a compaction candidate. Tiered segments always
+// form a prefix of the segment list, compactions only consider the local
+// segments after it, so segments are never merged across a tiered one.
+func (sg *SegmentGroup) tierColdSegments() bool {
+	if sg.tiering == nil || sg.pinned.Load() || sg.isReadyOnly() {
+		return false
+	}
+
+	sg.tieringLock.Lock()
+	defer sg.tieringLock.Unlock()
+
+	if sg.pinned.Load() {
+		return false
+	}
+
+	pos, ok := sg.nextTieringCandidate()
+	if !ok {
+		return false
+	}
+
+	if err := sg.tierSegment(pos); err != nil {
+		sg.logger.WithField("action", "lsm_segment_tiering").
+			WithField("path", sg.dir).
+			WithError(err).
+			Errorf("tiering segment failed")
+		return false
+	}
+
+	return true
+}
+
+func (sg *SegmentGroup) nextTieringCandidate() (int, bool) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	// the latest segment is never tiered, as it is the most likely to be
+	// compacted with the next flush
+	for i := 0; i < len(sg.segments)-1; i++ {
+		seg := sg.segments[i]
+		if seg.remote != nil {
+			continue
+		}
+
+		// only the oldest local segment is considered, so it still needs to be
+		// fully compacted, i.e. no other local segment may share its level
+		for _, other := range sg.segments[i+1:] {
+			if other.remote == nil && other.level == seg.level {
+				return 0, false
+			}
+		}
+
+		info, err := os.Stat(seg.path)
+		if err != nil || time.Since(info.ModTime()) < sg.tiering.minAge {
+			return 0, false
+		}
+
+		return i, true
+	}
+
+	return 0, false
+}
+
+func (sg *SegmentGroup) tierSegment(pos int) error {
+	seg := sg.segmentAtPos(pos)
+	key := sg.tiering.remoteKey(sg.rootDir, seg.path)
+
+	// an encrypted segment is uploaded as it is stored, so the remote copy
+	// remains encrypted
+	stub := tieredStub{
+		key:        key,
+		fileHeader: make([]byte, sg.encryption.HeaderSize()),
+		header:     seg.contents[:segmentindex.HeaderSize],
+		index:      seg.contents[seg.segmentStartPos:],
+	}
+	f, err := os.Open(seg.path)
+	if err != nil {
+		return fmt.Errorf("open segment: %w", err)
+	}
+	if _, err := io.ReadFull(f, stub.fileHeader); err != nil {
+		f.Close()
+		return fmt.Errorf("read header of encrypted segment: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return fmt.Errorf("open segment: %w", err)
+	}
+	size := int64(seg.Size()) + sg.encryption.HeaderSize()
+	err = sg.tiering.store.PutSegment(context.Background(), key, f, size)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("upload segment %s: %w", key, err)
+	}
+
+	stubPath := tieredPathFromSegmentPath(seg.path)
+	if err := writeTieredStub(sg.encryption, stubPath, stub); err != nil {
+		sg.deleteRemote(key)
+		return err
+	}
+
+	tiered, err := newTieredSegment(stubPath, sg.tiering, sg.logger, sg.metrics,
+		sg.makeExistsOnLower(pos), sg.encryption)
+	if err != nil {
+		os.Remove(stubPath)
+		sg.deleteRemote(key)
+		return fmt.Errorf("init tiered segment: %w", err)
+	}
+	// moving the segment does not change its contents
+	tiered.numericStats = seg.numericStats
+	tiered.keyStats = seg.keyStats
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	if err := sg.swapSegment(seg, tiered); err != nil {
+		return err
+	}
+	if err := sg.closeSegment(seg); err != nil {
+		return errors.Wrap(err, "close disk segment")
+	}
+
+	if err := os.Remove(seg.path); err != nil {
+		return fmt.Errorf("remove local copy of tiered segment: %w", err)
+	}
+
+	sg.tiering.observe("tier")
+	return nil
+}
+
+// swapSegment replaces a segment by its tiered or restored equivalent. The
+// position is looked up again, as compactions may have shifted the segments
+// in the meantime. Must be called while holding the maintenanceLock.
+func (sg *SegmentGroup) swapSegment(old, updated *segment) error {
+	for i, seg := range sg.segments {
+		if seg == old {
+			sg.segments[i] = updated
+			if sg.filterCache != nil {
+				sg.filterCache.replace(old, updated)
+			}
+			return nil
+		}
+	}
+
+	return errors.Errorf("segment %s no longer exists", old.path)
+}
+
+// setPinned controls whether segments may be tiered. Pinning a group
+// restores all of its tiered segments to local disk.
+func (sg *SegmentGroup) setPinned(ctx context.Context, pinned bool) error {
+	sg.tieringLock.Lock()
+	defer sg.tieringLock.Unlock()
+
+	marker := filepath.Join(sg.dir, pinnedMarker)
+	if !pinned {
+		sg.pinned.Store(false)
+		return os.RemoveAll(marker)
+	}
+
+	if err := os.WriteFile(marker, nil, 0o600); err != nil {
+		return fmt.Errorf("write pinned marker: %w", err)
+	}
+	sg.pinned.Store(true)
+
+	for i := 0; i < sg.Len(); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := sg.restoreSegment(ctx, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sg *SegmentGroup) restoreSegment(ctx context.Context, pos int) error {
+	seg := sg.segmentAtPos(pos)
+	if seg.remote == nil {
+		return nil
+	}
+
+	tmp := seg.path + ".restore"
+	if err := sg.download(ctx, seg.remote, tmp); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("download segment %s: %w", seg.remote.key, err)
+	}
+
+	if err := os.Rename(tmp, seg.path); err != nil {
+		return fmt.Errorf("rename restored segment: %w", err)
+	}
+
+	local, err := newSegment(seg.path, sg.logger, sg.metrics, sg.makeExistsOnLower(pos),
+		sg.encryption)
+	if err != nil {
+		return fmt.Errorf("init restored segment: %w", err)
+	}
+	local.numericStats = seg.numericStats
+	local.keyStats = seg.keyStats
+
+	sg.maintenanceLock.Lock()
+	err = sg.swapSegment(seg, local)
+	sg.maintenanceLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(tieredPathFromSegmentPath(seg.path)); err != nil {
+		return fmt.Errorf("remove tiered segment stub: %w", err)
+	}
+
+	sg.deleteRemote(seg.remote.key)
+	sg.tiering.observe("restore")
+	return nil
+}
+
+func (sg *SegmentGroup) download(ctx context.Context, remote *remoteSegment, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// the remote copy is stored as it is, encrypted segments included
+	size := remote.fileSize()
+	buf := make([]byte, restoreChunkSize)
+	for off := uint64(0); off < size; off += restoreChunkSize {
+		chunk := buf
+		if off+restoreChunkSize > size {
+			chunk = buf[:size-off]
+		}
+
+		if err := remote.tiering.store.ReadSegmentAt(ctx, remote.key, chunk, int64(off)); err != nil {
+			return err
+		}
+
+		if _, err := f.Write(chunk); err != nil {
+			return err
+		}
+	}
+
+	return f.Sync()
+}
+
+// deleteRemote removes a segment from the remote store, failures only leave
+// an orphaned object behind, so they are logged rather than returned
+func (sg *SegmentGroup) deleteRemote(key string) {
+	sg.tiering.cache.evict(key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteReadTimeout)
+	defer cancel()
+
+	if err := sg.tiering.store.DeleteSegment(ctx, key); err != nil {
+		sg.logger.WithField("action", "lsm_segment_tiering").
+			WithField("key", key).
+			WithError(err).
+			Warn("could not delete segment from remote store")
+	}
+}
+
+func (sg *SegmentGroup) tieringStatus() SegmentTieringStatus {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	status := SegmentTieringStatus{Pinned: sg.pinned.Load()}
+	for _, seg := range sg.segments {
+		if seg.remote != nil {
+			status.TieredSegments++
+			status.TieredBytes += int64(seg.Size())
+		} else {
+			status.LocalSegments++
+			status.LocalBytes += int64(seg.Size())
+		}
+	}
+
+	return status
+}
+
+// dropRemoteSegments removes the remote copies of all tiered segments, the
+// local stubs are removed together with the bucket
+func (sg *SegmentGroup) dropRemoteSegments() {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for _, seg := range sg.segments {
+		if seg.remote != nil {
+			sg.deleteRemote(seg.remote.key)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+type fakeRemoteSegmentStore struct {
+	sync.Mutex
+	objects map[string][]byte
+	maxRead int
+}
+
+func newFakeRemoteSegmentStore() *fakeRemoteSegmentStore {
+	return &fakeRemoteSegmentStore{objects: map[string][]byte{}}
+}
+
+func (f *fakeRemoteSegmentStore) PutSegment(ctx context.Context, key string,
+	r io.Reader, size int64,
+) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeRemoteSegmentStore) ReadSegmentAt(ctx context.Context, key string,
+	p []byte, off int64,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(p) > f.maxRead {
+		f.maxRead = len(p)
+	}
+
+	data, ok := f.objects[key]
+	if !ok || off+int64(len(p)) > int64(len(data)) {
+		return fmt.Errorf("no range %d-%d in %q", off, off+int64(len(p)), key)
+	}
+	copy(p, data[off:])
+	return nil
+}
+
+func (f *fakeRemoteSegmentStore) DeleteSegment(ctx context.Context, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, key)
+	return nil
+}
+
+func (f *fakeRemoteSegmentStore) len() int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.objects)
+}
+
+func TestSegmentGroup_Tiering(t *testing.T) {
+	t.Run("unencrypted", func(t *testing.T) {
+		testSegmentGroupTiering(t, nil)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		enc, err := diskio.NewEncryption(bytes.Repeat([]byte{7}, 32))
+		require.Nil(t, err)
+		testSegmentGroupTiering(t, enc)
+	})
+}
+
+func testSegmentGroupTiering(t *testing.T, enc *diskio.Encryption) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	dir := rootDir + "/bucket"
+	logger, _ := test.NewNullLogger()
+	store := newFakeRemoteSegmentStore()
+	// a tiny block size and cache make sure reads span and evict blocks
+	tiering := NewTiering(store, "prefix", 0, 64, 256, nil)
+
+	openBucket := func(t *testing.T) *Bucket {
+		b, err := NewBucket(ctx, dir, rootDir, logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1),
+			WithSegmentTiering(tiering), WithEncryption(enc))
+		require.Nil(t, err)
+		return b
+	}
+
+	put := func(t *testing.T, b *Bucket, from, to int) {
+		for i := from; i < to; i++ {
+			err := b.Put([]byte(fmt.Sprintf("key-%03d", i)),
+				[]byte(fmt.Sprintf("value-%03d", i)),
+				WithSecondaryKey(0, []byte(fmt.Sprintf("secondary-%03d", i))))
+			require.Nil(t, err)
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	assertAll := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 30; i++ {
+			val, err := b.Get([]byte(fmt.Sprintf("key-%03d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%03d", i), string(val))
+
+			val, err = b.GetBySecondary(0, []byte(fmt.Sprintf("secondary-%03d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%03d", i), string(val))
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, fmt.Sprintf("key-%03d", count), string(k))
+			assert.Equal(t, fmt.Sprintf("value-%03d", count), string(v))
+			count++
+		}
+		assert.Equal(t, 30, count)
+
+		k, v := c.Seek([]byte("key-005"))
+		assert.Equal(t, "key-005", string(k))
+		assert.Equal(t, "value-005", string(v))
+		k, _ = c.Next()
+		assert.Equal(t, "key-006", string(k))
+	}
+
+	b := openBucket(t)
+
+	t.Run("create a fully compacted segment and a fresh one", func(t *testing.T) {
+		put(t, b, 0, 10)
+		put(t, b, 10, 20)
+		require.Nil(t, b.disk.compactOnce())
+		put(t, b, 20, 30)
+		require.Equal(t, 2, b.disk.Len())
+	})
+
+	t.Run("only the compacted segment is tiered", func(t *testing.T) {
+		assert.True(t, b.disk.tierColdSegments())
+		assert.False(t, b.disk.tierColdSegments())
+
+		status := b.SegmentTieringStatus()
+		assert.Equal(t, 1, status.TieredSegments)
+		assert.Equal(t, 1, status.LocalSegments)
+		assert.Equal(t, 1, store.len())
+	})
+
+	if enc != nil {
+		t.Run("the remote copy is encrypted", func(t *testing.T) {
+			store.Lock()
+			defer store.Unlock()
+			for key, data := range store.objects {
+				assert.False(t, bytes.Contains(data, []byte("value-")), key)
+			}
+			assertNotOnDisk(t, dir, []byte("value-"), []byte("key-"))
+		})
+	}
+
+	t.Run("reads are served from the remote store", func(t *testing.T) {
+		assertAll(t, b)
+
+		store.Lock()
+		defer store.Unlock()
+		assert.LessOrEqual(t, store.maxRead, 64,
+			"reads must go through the block cache, not download the segment")
+	})
+
+	t.Run("tiered segments are loaded after a restart", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+		b = openBucket(t)
+
+		assert.Equal(t, 1, b.SegmentTieringStatus().TieredSegments)
+		assertAll(t, b)
+	})
+
+	t.Run("pinning restores the segments", func(t *testing.T) {
+		require.Nil(t, b.PinSegments(ctx))
+
+		status := b.SegmentTieringStatus()
+		assert.True(t, status.Pinned)
+		assert.Equal(t, 0, status.TieredSegments)
+		assert.Equal(t, 0, store.len())
+		assert.False(t, b.disk.tierColdSegments())
+		assertAll(t, b)
+	})
+
+	t.Run("pinning survives restarts, unpinning allows tiering again", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+		b = openBucket(t)
+		assert.True(t, b.SegmentTieringStatus().Pinned)
+
+		require.Nil(t, b.UnpinSegments(ctx))
+		assert.True(t, b.disk.tierColdSegments())
+		assertAll(t, b)
+		require.Nil(t, b.Shutdown(ctx))
+	})
+}
+
+func TestSegmentGroup_TieringRoaringSetCursor(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	store := newFakeRemoteSegmentStore()
+	tiering := NewTiering(store, "prefix", 0, 64, 256, nil)
+
+	b, err := NewBucket(ctx, rootDir+"/bucket", rootDir, logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyRoaringSet), WithSegmentTiering(tiering))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	add := func(t *testing.T, from, to int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, b.RoaringSetAddOne([]byte(fmt.Sprintf("key-%03d", i)), uint64(i)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	add(t, 0, 10)
+	add(t, 10, 20)
+	require.Nil(t, b.disk.compactOnce())
+	add(t, 20, 30)
+	require.True(t, b.disk.tierColdSegments())
+
+	c := b.CursorRoaringSet()
+	defer c.Close()
+
+	count := 0
+	for k, bm := c.First(); k != nil; k, bm = c.Next() {
+		assert.Equal(t, fmt.Sprintf("key-%03d", count), string(k))
+		assert.Equal(t, []uint64{uint64(count)}, bm.ToArray())
+		count++
+	}
+	assert.Equal(t, 30, count)
+
+	k, bm := c.Seek([]byte("key-005"))
+	assert.Equal(t, "key-005", string(k))
+	assert.Equal(t, []uint64{5}, bm.ToArray())
+	k, _ = c.Next()
+	assert.Equal(t, "key-006", string(k))
+
+	store.Lock()
+	defer store.Unlock()
+	assert.LessOrEqual(t, store.maxRead, 64)
+}
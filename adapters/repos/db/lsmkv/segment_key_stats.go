@@ -0,0 +1,390 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/propertystats"
+	"github.com/weaviate/weaviate/entities/sketch"
+)
+
+// KeyStats describe the distribution of the keys of a single segment of a
+// roaring set bucket, such as the filterable index of a property. Every key
+// is weighted by the number of ids it holds. Keys are compared bytewise, which
+// matches the order of values for the lexicographically sortable encodings of
+// the inverted index.
+type KeyStats struct {
+	Count uint64
+	Keys  uint64
+	// Deletions is the number of deleted ids in the layer. Just like for
+	// [NumericStats] they make the stats of any layers they are combined with
+	// an upper bound.
+	Deletions uint64
+
+	MinKey []byte
+	MaxKey []byte
+
+	// Distinct estimates the number of distinct keys, it is mergeable across
+	// segments and shards unlike Keys
+	Distinct *sketch.HyperLogLog
+
+	// Histogram is equi-depth, every bucket holds about the same number of ids
+	Histogram []propertystats.HistogramBucket
+}
+
+// combineKeyStats combines the stats of all layers, ordered from oldest to
+// newest. Histograms of different layers overlap, they are merged with
+// [propertystats.MergeHistograms]. Deletions of newer layers are subtracted
+// from the count, but can't be attributed to a key, so the histogram and the
+// number of keys are an upper bound.
+func combineKeyStats(layers []KeyStats) KeyStats {
+	out := KeyStats{Distinct: sketch.NewDefaultHyperLogLog()}
+
+	var buckets []propertystats.HistogramBucket
+	var deletions uint64
+	for i, layer := range layers {
+		if i > 0 {
+			deletions += layer.Deletions
+		}
+
+		if layer.Count > 0 {
+			if out.MinKey == nil || bytes.Compare(layer.MinKey, out.MinKey) < 0 {
+				out.MinKey = layer.MinKey
+			}
+			if out.MaxKey == nil || bytes.Compare(layer.MaxKey, out.MaxKey) > 0 {
+				out.MaxKey = layer.MaxKey
+			}
+		}
+
+		out.Count += layer.Count
+		out.Keys += layer.Keys
+		// sketches of segments are always created with the same precision
+		out.Distinct.Merge(layer.Distinct)
+		buckets = append(buckets, layer.Histogram...)
+	}
+
+	if deletions >= out.Count {
+		return KeyStats{Distinct: sketch.NewDefaultHyperLogLog()}
+	}
+	out.Count -= deletions
+
+	out.Histogram = propertystats.MergeHistograms(buckets, out.Count)
+	return out
+}
+
+// keyStatsFromCursor iterates the cursor twice, first to collect the totals
+// and then to split the keys into equally deep histogram buckets
+func keyStatsFromCursor(c roaringset.InnerCursor) (KeyStats, error) {
+	out := KeyStats{Distinct: sketch.NewDefaultHyperLogLog()}
+
+	k, layer, err := c.First()
+	for ; k != nil && err == nil; k, layer, err = c.Next() {
+		if layer.Deletions != nil {
+			out.Deletions += uint64(layer.Deletions.GetCardinality())
+		}
+
+		count := additionsCount(layer)
+		if count == 0 {
+			continue
+		}
+
+		if out.MinKey == nil {
+			out.MinKey = copyKey(k)
+		}
+		out.MaxKey = k
+		out.Count += count
+		out.Keys++
+		out.Distinct.Add(k)
+	}
+	if err != nil {
+		return KeyStats{}, err
+	}
+
+	if out.Count == 0 {
+		return out, nil
+	}
+	out.MaxKey = copyKey(out.MaxKey)
+
+	depth := propertystats.HistogramDepth(out.Count)
+	var current propertystats.HistogramBucket
+	k, layer, err = c.First()
+	for ; k != nil && err == nil; k, layer, err = c.Next() {
+		count := additionsCount(layer)
+		if count == 0 {
+			continue
+		}
+
+		current.Count += count
+		current.Keys++
+		if current.Count >= depth {
+			current.UpperKey = copyKey(k)
+			out.Histogram = append(out.Histogram, current)
+			current = propertystats.HistogramBucket{}
+		}
+	}
+	if err != nil {
+		return KeyStats{}, err
+	}
+
+	if current.Count > 0 {
+		current.UpperKey = out.MaxKey
+		out.Histogram = append(out.Histogram, current)
+	}
+
+	return out, nil
+}
+
+func additionsCount(layer roaringset.BitmapLayer) uint64 {
+	if layer.Additions == nil {
+		return 0
+	}
+	return uint64(layer.Additions.GetCardinality())
+}
+
+func copyKey(k []byte) []byte {
+	out := make([]byte, len(k))
+	copy(out, k)
+	return out
+}
+
+func (s *segment) keyStatsPath() string {
+	return keyStatsPathFromSegmentPath(s.path)
+}
+
+func keyStatsPathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.kst", extless)
+}
+
+// initKeyStats loads the stats of the segment from disk or calculates them
+// if they are missing. Like numeric stats, they are unavailable for tiered
+// segments unless persisted locally.
+func (s *segment) initKeyStats() error {
+	if s.strategy != segmentindex.StrategyRoaringSet {
+		return nil
+	}
+
+	ok, err := fileExists(s.keyStatsPath())
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = s.loadKeyStatsFromDisk()
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrInvalidChecksum {
+			// not a recoverable error
+			return err
+		}
+
+		// now continue re-calculating
+	}
+
+	if s.remote != nil {
+		return nil
+	}
+
+	stats, err := keyStatsFromCursor(s.newRoaringSetCursor())
+	if err != nil {
+		return fmt.Errorf("calculate key stats: %w", err)
+	}
+
+	s.keyStats = &stats
+
+	if err := storeKeyStatsOnDisk(s.encryption, s.keyStatsPath(), stats); err != nil {
+		return fmt.Errorf("store key stats on disk: %w", err)
+	}
+
+	return nil
+}
+
+// preComputeKeyStats writes the stats of a compacted segment. Unlike
+// [SegmentGroup.preComputeNumericStats] they are always calculated from the
+// compacted segment, as the histograms of the two old segments can't be
+// combined exactly. The path of the .tmp file is returned, empty if the group
+// does not keep key stats.
+func (sg *SegmentGroup) preComputeKeyStats(segPathTmp string) (string, error) {
+	if !sg.keyStats || sg.strategy != StrategyRoaringSet {
+		return "", nil
+	}
+
+	contents, err := sg.encryption.ReadFile(segPathTmp)
+	if err != nil {
+		return "", fmt.Errorf("read segment: %w", err)
+	}
+
+	header, err := segmentindex.ParseHeader(
+		bytes.NewReader(contents[:segmentindex.HeaderSize]))
+	if err != nil {
+		return "", fmt.Errorf("parse header: %w", err)
+	}
+
+	// iterating does not require the index, only seeking does
+	stats, err := keyStatsFromCursor(roaringset.NewSegmentCursor(
+		contents[segmentindex.HeaderSize:header.IndexStart], nil))
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("%s.tmp",
+		keyStatsPathFromSegmentPath(strings.TrimSuffix(segPathTmp, ".tmp")))
+	if err := storeKeyStatsOnDisk(sg.encryption, path, stats); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func storeKeyStatsOnDisk(enc *diskio.Encryption, path string, stats KeyStats) error {
+	distinct, err := stats.Distinct.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal distinct sketch: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	writeUint64 := func(v uint64) {
+		binary.Write(buf, binary.LittleEndian, v)
+	}
+	writeBytes := func(b []byte) {
+		binary.Write(buf, binary.LittleEndian, uint32(len(b)))
+		buf.Write(b)
+	}
+
+	writeUint64(stats.Count)
+	writeUint64(stats.Keys)
+	writeUint64(stats.Deletions)
+	writeBytes(stats.MinKey)
+	writeBytes(stats.MaxKey)
+	writeBytes(distinct)
+	binary.Write(buf, binary.LittleEndian, uint32(len(stats.Histogram)))
+	for _, bucket := range stats.Histogram {
+		writeBytes(bucket.UpperKey)
+		writeUint64(bucket.Count)
+		writeUint64(bucket.Keys)
+	}
+
+	return writeWithChecksum(enc, buf.Bytes(), path)
+}
+
+func (s *segment) loadKeyStatsFromDisk() error {
+	data, err := loadWithChecksum(s.encryption, s.keyStatsPath(), 0)
+	if err != nil {
+		return err
+	}
+
+	stats, err := parseKeyStats(data)
+	if err != nil {
+		return fmt.Errorf("parse key stats: %w", err)
+	}
+
+	s.keyStats = &stats
+	return nil
+}
+
+func parseKeyStats(data []byte) (KeyStats, error) {
+	r := bytes.NewReader(data)
+	var err error
+	readUint64 := func() uint64 {
+		var v uint64
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, &v)
+		}
+		return v
+	}
+	readBytes := func() []byte {
+		var l uint32
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, &l)
+		}
+		if err != nil || l == 0 {
+			return nil
+		}
+		if int(l) > r.Len() {
+			err = fmt.Errorf("length %d exceeds remaining %d bytes", l, r.Len())
+			return nil
+		}
+		out := make([]byte, l)
+		_, err = r.Read(out)
+		return out
+	}
+
+	stats := KeyStats{
+		Count:     readUint64(),
+		Keys:      readUint64(),
+		Deletions: readUint64(),
+		MinKey:    readBytes(),
+		MaxKey:    readBytes(),
+	}
+	distinct := readBytes()
+	var buckets uint32
+	if err == nil {
+		err = binary.Read(r, binary.LittleEndian, &buckets)
+	}
+	if err != nil {
+		return KeyStats{}, err
+	}
+
+	stats.Distinct = &sketch.HyperLogLog{}
+	if err := stats.Distinct.UnmarshalBinary(distinct); err != nil {
+		return KeyStats{}, fmt.Errorf("distinct sketch: %w", err)
+	}
+
+	for i := uint32(0); i < buckets && err == nil; i++ {
+		stats.Histogram = append(stats.Histogram, propertystats.HistogramBucket{
+			UpperKey: readBytes(),
+			Count:    readUint64(),
+			Keys:     readUint64(),
+		})
+	}
+	if err != nil {
+		return KeyStats{}, err
+	}
+
+	return stats, nil
+}
+
+// initKeyStats is a no-op unless the bucket was created with [WithKeyStats]
+func (sg *SegmentGroup) initKeyStats(seg *segment) error {
+	if !sg.keyStats {
+		return nil
+	}
+
+	return seg.initKeyStats()
+}
+
+// keyStatsLayers returns the stats of all segments, ordered from oldest to
+// newest. ok is false if the stats of any segment are unavailable.
+func (sg *SegmentGroup) keyStatsLayers() (out []KeyStats, ok bool) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	out = make([]KeyStats, len(sg.segments))
+	for i, seg := range sg.segments {
+		if seg.keyStats == nil {
+			return nil, false
+		}
+		out[i] = *seg.keyStats
+	}
+
+	return out, true
+}
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/propertystats"
+)
+
+func TestBucket_KeyStats(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	key := func(v uint64) []byte {
+		out := make([]byte, 8)
+		binary.BigEndian.PutUint64(out, v)
+		return out
+	}
+	open := func(t *testing.T) *Bucket {
+		b, err := NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyRoaringSet), WithKeyStats())
+		require.Nil(t, err)
+		return b
+	}
+	histogramCount := func(stats KeyStats) (count, keys uint64) {
+		for _, bucket := range stats.Histogram {
+			count += bucket.Count
+			keys += bucket.Keys
+		}
+		return
+	}
+
+	b := open(t)
+
+	t.Run("memtables are not included", func(t *testing.T) {
+		// 10 ids for each of the keys 0-99
+		for id := uint64(0); id < 1000; id++ {
+			require.Nil(t, b.RoaringSetAddOne(key(id%100), id))
+		}
+
+		stats, ok, err := b.KeyStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(0), stats.Count)
+		assert.Empty(t, stats.Histogram)
+	})
+
+	t.Run("single segment", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+
+		stats, ok, err := b.KeyStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(1000), stats.Count)
+		assert.Equal(t, uint64(100), stats.Keys)
+		assert.InDelta(t, 100, stats.Distinct.Estimate(), 2)
+		assert.Equal(t, key(0), stats.MinKey)
+		assert.Equal(t, key(99), stats.MaxKey)
+
+		count, keys := histogramCount(stats)
+		assert.Equal(t, uint64(1000), count)
+		assert.Equal(t, uint64(100), keys)
+		assert.LessOrEqual(t, len(stats.Histogram), propertystats.MaxHistogramBuckets)
+		assert.Equal(t, key(99), stats.Histogram[len(stats.Histogram)-1].UpperKey)
+		for i := 1; i < len(stats.Histogram); i++ {
+			assert.Equal(t, 1, bytes.Compare(stats.Histogram[i].UpperKey,
+				stats.Histogram[i-1].UpperKey), "upper keys are increasing")
+		}
+	})
+
+	t.Run("multiple segments with deletions", func(t *testing.T) {
+		// moves ids 0 and 100 from key 0 to the new key 200
+		require.Nil(t, b.RoaringSetRemoveOne(key(0), 0))
+		require.Nil(t, b.RoaringSetRemoveOne(key(0), 100))
+		require.Nil(t, b.RoaringSetAddList(key(200), []uint64{0, 100}))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Equal(t, 2, b.disk.Len())
+
+		stats, ok, err := b.KeyStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(1000), stats.Count)
+		assert.InDelta(t, 101, stats.Distinct.Estimate(), 2)
+		assert.Equal(t, key(0), stats.MinKey)
+		assert.Equal(t, key(200), stats.MaxKey)
+
+		// the histogram can't attribute the deletions to a key
+		count, _ := histogramCount(stats)
+		assert.Equal(t, uint64(1002), count)
+	})
+
+	t.Run("compaction", func(t *testing.T) {
+		require.Nil(t, b.disk.compactOnce())
+		require.Equal(t, 1, b.disk.Len())
+
+		stats, ok, err := b.KeyStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(1000), stats.Count)
+		assert.Equal(t, uint64(101), stats.Keys)
+		assert.Equal(t, uint64(0), stats.Deletions)
+
+		count, _ := histogramCount(stats)
+		assert.Equal(t, uint64(1000), count)
+	})
+
+	t.Run("persisted with the segment", func(t *testing.T) {
+		before, _, err := b.KeyStats()
+		require.Nil(t, err)
+
+		path := b.disk.segments[0].keyStatsPath()
+		require.Nil(t, b.Shutdown(ctx))
+		assert.FileExists(t, path)
+
+		b = open(t)
+		stats, ok, err := b.KeyStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, before, stats)
+
+		t.Run("recalculated if corrupt", func(t *testing.T) {
+			require.Nil(t, b.Shutdown(ctx))
+			require.Nil(t, os.WriteFile(path, []byte("corrupt"), 0o666))
+
+			b = open(t)
+			defer b.Shutdown(ctx)
+
+			stats, ok, err := b.KeyStats()
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.Equal(t, before, stats)
+		})
+	})
+}
+
+func TestBucket_KeyStats_NotEnabled(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyRoaringSet))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	_, ok, err := b.KeyStats()
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	_, err = NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		WithStrategy(StrategyReplace), WithKeyStats())
+	assert.NotNil(t, err)
+}
@@ -20,6 +20,8 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 // ErrInvalidChecksum indicates the the read file should not be trusted. For
@@ -84,10 +86,16 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error {
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.remote != nil {
+		if err := s.scanKeysAndTombstones(cb); err != nil {
+			return err
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	s.countNetAdditions = countNet
 
@@ -102,8 +110,22 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error {
 	return nil
 }
 
+// scanKeysAndTombstones calls cb for every node of a tiered segment. Contrary
+// to the buffered extractor it does not need the whole data section at once.
+func (s *segment) scanKeysAndTombstones(cb func(key []byte, tombstone bool)) error {
+	c := s.newCursor()
+	for node, err := c.firstWithAllKeys(); !errors.Is(err, lsmkv.NotFound); node, err = c.nextWithAllKeys() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		cb(node.primaryKey, node.tombstone)
+	}
+
+	return nil
+}
+
 func (s *segment) storeCountNetOnDisk() error {
-	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions)
+	return storeCountNetOnDisk(s.encryption, s.countNetPath(), s.countNetAdditions)
 }
 
 // prefillCountNetAdditions is a helper function that can be used in
@@ -114,23 +136,25 @@ func (s *segment) storeCountNetOnDisk() error {
 // by "prefilling" which means creating the file on disk, the subsequent
 // newSegment() call can skip re-calculating the count net additions which
 // would have a high cost on large segment groups.
-func prefillCountNetAdditions(segPath string, updatedCountNetAdditions int) error {
-	return storeCountNetOnDisk(countNetPathFromSegmentPath(segPath),
+func prefillCountNetAdditions(enc *diskio.Encryption, segPath string,
+	updatedCountNetAdditions int,
+) error {
+	return storeCountNetOnDisk(enc, countNetPathFromSegmentPath(segPath),
 		updatedCountNetAdditions)
 }
 
-func storeCountNetOnDisk(path string, value int) error {
+func storeCountNetOnDisk(enc *diskio.Encryption, path string, value int) error {
 	buf := new(bytes.Buffer)
 
 	if err := binary.Write(buf, binary.LittleEndian, uint64(value)); err != nil {
 		return fmt.Errorf("write cna to buf: %w", err)
 	}
 
-	return writeWithChecksum(buf.Bytes(), path)
+	return writeWithChecksum(enc, buf.Bytes(), path)
 }
 
 func (s *segment) loadCountNetFromDisk() error {
-	data, err := loadWithChecksum(s.countNetPath(), 12)
+	data, err := loadWithChecksum(s.encryption, s.countNetPath(), 12)
 	if err != nil {
 		return err
 	}
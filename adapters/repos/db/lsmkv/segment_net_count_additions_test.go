@@ -134,10 +134,10 @@ func TestPrefillCountNetAdditions(t *testing.T) {
 	segmentName := path.Join(dirName, "foo.db")
 	expectedFileName := path.Join(dirName, "foo.cna")
 
-	err := prefillCountNetAdditions(segmentName, 20)
+	err := prefillCountNetAdditions(nil, segmentName, 20)
 	require.Nil(t, err)
 
-	data, err := loadWithChecksum(expectedFileName, 12)
+	data, err := loadWithChecksum(nil, expectedFileName, 12)
 	require.Nil(t, err)
 	count := binary.LittleEndian.Uint64(data)
 	assert.Equal(t, 20, int(count))
@@ -0,0 +1,295 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// NumericKeyDecoder turns the key of a numeric roaring set bucket, such as
+// the filterable index of an int or number property, back into its value
+type NumericKeyDecoder func(key []byte) (float64, error)
+
+// NumericStats are pre-aggregated statistics of a single layer (segment or
+// memtable) of a numeric roaring set bucket. Every key is weighted by the
+// number of ids it holds.
+type NumericStats struct {
+	Count uint64
+	Sum   float64
+	Min   float64
+	Max   float64
+
+	// Deletions is the number of deleted ids in the layer. A layer with
+	// deletions removes ids from previous layers, so its stats can't simply be
+	// added on top of them.
+	Deletions uint64
+}
+
+// Combine adds the stats of a newer layer which does not delete from the
+// current one. The deletions of the result are an upper bound.
+func (s NumericStats) Combine(newer NumericStats) NumericStats {
+	out := NumericStats{
+		Count:     s.Count + newer.Count,
+		Sum:       s.Sum + newer.Sum,
+		Min:       s.Min,
+		Max:       s.Max,
+		Deletions: s.Deletions + newer.Deletions,
+	}
+
+	if s.Count == 0 {
+		out.Min, out.Max = newer.Min, newer.Max
+	} else if newer.Count > 0 {
+		out.Min = math.Min(s.Min, newer.Min)
+		out.Max = math.Max(s.Max, newer.Max)
+	}
+
+	return out
+}
+
+// combineNumericStats combines the stats of all layers, ordered from oldest
+// to newest. Deletions in the first layer are irrelevant, as there is nothing
+// to delete. Deletions in any later layer make the result inexact, ok is
+// false in this case.
+func combineNumericStats(layers []NumericStats) (out NumericStats, ok bool) {
+	for i, layer := range layers {
+		if i > 0 && layer.Deletions > 0 {
+			return NumericStats{}, false
+		}
+		out = out.Combine(layer)
+	}
+
+	out.Deletions = 0
+	return out, true
+}
+
+func numericStatsFromCursor(c roaringset.InnerCursor,
+	decode NumericKeyDecoder,
+) (NumericStats, error) {
+	var out NumericStats
+
+	k, layer, err := c.First()
+	for ; k != nil && err == nil; k, layer, err = c.Next() {
+		if layer.Deletions != nil {
+			out.Deletions += uint64(layer.Deletions.GetCardinality())
+		}
+
+		if layer.Additions == nil {
+			continue
+		}
+
+		count := uint64(layer.Additions.GetCardinality())
+		if count == 0 {
+			continue
+		}
+
+		value, err := decode(k)
+		if err != nil {
+			return NumericStats{}, fmt.Errorf("decode key: %w", err)
+		}
+
+		out = out.Combine(NumericStats{
+			Count: count,
+			Sum:   value * float64(count),
+			Min:   value,
+			Max:   value,
+		})
+	}
+	if err != nil {
+		return NumericStats{}, err
+	}
+
+	return out, nil
+}
+
+func (s *segment) numericStatsPath() string {
+	return numericStatsPathFromSegmentPath(s.path)
+}
+
+func numericStatsPathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.nst", extless)
+}
+
+// initNumericStats loads the stats of the segment from disk or calculates
+// them if they are missing. Tiered segments are never read from the remote
+// store for this, their stats stay unavailable unless persisted locally.
+func (s *segment) initNumericStats(decode NumericKeyDecoder) error {
+	if s.strategy != segmentindex.StrategyRoaringSet {
+		return nil
+	}
+
+	ok, err := fileExists(s.numericStatsPath())
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = s.loadNumericStatsFromDisk()
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrInvalidChecksum {
+			// not a recoverable error
+			return err
+		}
+
+		// now continue re-calculating
+	}
+
+	if s.remote != nil {
+		return nil
+	}
+
+	stats, err := numericStatsFromCursor(s.newRoaringSetCursor(), decode)
+	if err != nil {
+		return fmt.Errorf("calculate numeric stats: %w", err)
+	}
+
+	s.numericStats = &stats
+
+	if err := storeNumericStatsOnDisk(s.encryption, s.numericStatsPath(), stats); err != nil {
+		return fmt.Errorf("store numeric stats on disk: %w", err)
+	}
+
+	return nil
+}
+
+// preComputeNumericStats writes the stats of a compacted segment, so they
+// don't need to be calculated once the segment is initialized. Similar to
+// [prefillCountNetAdditions] the compacted segment behaves exactly as the two
+// segments it replaces, so its stats are rolled up from theirs, as long as
+// the newer one does not delete from the older one. Otherwise they are
+// calculated from the compacted segment. The path of the .tmp file is
+// returned, empty if the group does not keep numeric stats.
+func (sg *SegmentGroup) preComputeNumericStats(segPathTmp string,
+	old1, old2 int,
+) (string, error) {
+	if sg.numericKeyDecoder == nil || sg.strategy != StrategyRoaringSet {
+		return "", nil
+	}
+
+	sg.maintenanceLock.RLock()
+	older, newer := sg.segments[old1].numericStats, sg.segments[old2].numericStats
+	sg.maintenanceLock.RUnlock()
+
+	var stats NumericStats
+	if older != nil && newer != nil && newer.Deletions == 0 {
+		stats = older.Combine(*newer)
+	} else {
+		calculated, err := numericStatsFromSegmentFile(sg.encryption, segPathTmp,
+			sg.numericKeyDecoder)
+		if err != nil {
+			return "", err
+		}
+		stats = calculated
+	}
+
+	path := fmt.Sprintf("%s.tmp",
+		numericStatsPathFromSegmentPath(strings.TrimSuffix(segPathTmp, ".tmp")))
+	if err := storeNumericStatsOnDisk(sg.encryption, path, stats); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func numericStatsFromSegmentFile(enc *diskio.Encryption, path string,
+	decode NumericKeyDecoder,
+) (NumericStats, error) {
+	contents, err := enc.ReadFile(path)
+	if err != nil {
+		return NumericStats{}, fmt.Errorf("read segment: %w", err)
+	}
+
+	header, err := segmentindex.ParseHeader(
+		bytes.NewReader(contents[:segmentindex.HeaderSize]))
+	if err != nil {
+		return NumericStats{}, fmt.Errorf("parse header: %w", err)
+	}
+
+	// iterating does not require the index, only seeking does
+	c := roaringset.NewSegmentCursor(
+		contents[segmentindex.HeaderSize:header.IndexStart], nil)
+	return numericStatsFromCursor(c, decode)
+}
+
+func storeNumericStatsOnDisk(enc *diskio.Encryption, path string, stats NumericStats) error {
+	buf := new(bytes.Buffer)
+
+	for _, v := range []uint64{
+		stats.Count,
+		math.Float64bits(stats.Sum),
+		math.Float64bits(stats.Min),
+		math.Float64bits(stats.Max),
+		stats.Deletions,
+	} {
+		if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+			return fmt.Errorf("write numeric stats to buf: %w", err)
+		}
+	}
+
+	return writeWithChecksum(enc, buf.Bytes(), path)
+}
+
+func (s *segment) loadNumericStatsFromDisk() error {
+	data, err := loadWithChecksum(s.encryption, s.numericStatsPath(), 44)
+	if err != nil {
+		return err
+	}
+
+	s.numericStats = &NumericStats{
+		Count:     binary.LittleEndian.Uint64(data[0:8]),
+		Sum:       math.Float64frombits(binary.LittleEndian.Uint64(data[8:16])),
+		Min:       math.Float64frombits(binary.LittleEndian.Uint64(data[16:24])),
+		Max:       math.Float64frombits(binary.LittleEndian.Uint64(data[24:32])),
+		Deletions: binary.LittleEndian.Uint64(data[32:40]),
+	}
+
+	return nil
+}
+
+// initNumericStats is a no-op unless the bucket was created with
+// [WithNumericStats]
+func (sg *SegmentGroup) initNumericStats(seg *segment) error {
+	if sg.numericKeyDecoder == nil {
+		return nil
+	}
+
+	return seg.initNumericStats(sg.numericKeyDecoder)
+}
+
+// numericStats returns the stats of all segments, ordered from oldest to
+// newest. ok is false if the stats of any segment are unavailable.
+func (sg *SegmentGroup) numericStats() (out []NumericStats, ok bool) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	out = make([]NumericStats, len(sg.segments))
+	for i, seg := range sg.segments {
+		if seg.numericStats == nil {
+			return nil, false
+		}
+		out[i] = *seg.numericStats
+	}
+
+	return out, true
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestNumericStats_Combine(t *testing.T) {
+	a := NumericStats{Count: 2, Sum: 6, Min: 1, Max: 5}
+	b := NumericStats{Count: 1, Sum: -2, Min: -2, Max: -2, Deletions: 1}
+
+	assert.Equal(t, NumericStats{Count: 3, Sum: 4, Min: -2, Max: 5, Deletions: 1},
+		a.Combine(b))
+	assert.Equal(t, a, NumericStats{}.Combine(a))
+	assert.Equal(t, a, a.Combine(NumericStats{}))
+
+	t.Run("deletions in the first layer are ignored", func(t *testing.T) {
+		stats, ok := combineNumericStats([]NumericStats{b, a})
+		require.True(t, ok)
+		assert.Equal(t, NumericStats{Count: 3, Sum: 4, Min: -2, Max: 5}, stats)
+	})
+
+	t.Run("deletions in a later layer can't be combined", func(t *testing.T) {
+		_, ok := combineNumericStats([]NumericStats{a, b})
+		assert.False(t, ok)
+	})
+}
+
+func TestBucket_NumericStats(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	key := func(v uint64) []byte {
+		out := make([]byte, 8)
+		binary.BigEndian.PutUint64(out, v)
+		return out
+	}
+	decode := func(k []byte) (float64, error) {
+		return float64(binary.BigEndian.Uint64(k)), nil
+	}
+	open := func(t *testing.T) *Bucket {
+		b, err := NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyRoaringSet), WithNumericStats(decode))
+		require.Nil(t, err)
+		return b
+	}
+
+	b := open(t)
+
+	t.Run("memtable only", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetAddList(key(3), []uint64{1, 2}))
+		require.Nil(t, b.RoaringSetAddOne(key(7), 3))
+
+		stats, ok, err := b.NumericStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, NumericStats{Count: 3, Sum: 13, Min: 3, Max: 7}, stats)
+	})
+
+	t.Run("segment and memtable", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.RoaringSetAddOne(key(1), 4))
+
+		stats, ok, err := b.NumericStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, NumericStats{Count: 4, Sum: 14, Min: 1, Max: 7}, stats)
+	})
+
+	t.Run("deletion of a previous layer", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetRemoveOne(key(7), 3))
+		require.Nil(t, b.RoaringSetAddOne(key(5), 3))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Equal(t, 2, b.disk.Len())
+
+		_, ok, err := b.NumericStats()
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("resolved by compaction", func(t *testing.T) {
+		require.Nil(t, b.disk.compactOnce())
+		require.Equal(t, 1, b.disk.Len())
+
+		stats, ok, err := b.NumericStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, NumericStats{Count: 4, Sum: 12, Min: 1, Max: 5}, stats)
+	})
+
+	t.Run("persisted with the segment", func(t *testing.T) {
+		path := b.disk.segments[0].numericStatsPath()
+		require.Nil(t, b.Shutdown(ctx))
+		assert.FileExists(t, path)
+
+		b = open(t)
+		defer b.Shutdown(ctx)
+
+		stats, ok, err := b.NumericStats()
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, NumericStats{Count: 4, Sum: 12, Min: 1, Max: 5}, stats)
+	})
+}
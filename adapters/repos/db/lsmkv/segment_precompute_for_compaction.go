@@ -14,13 +14,13 @@ package lsmkv
 import (
 	"bytes"
 	"fmt"
-	"os"
 	"strings"
 	"syscall"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/willf/bloom"
 )
 
@@ -29,7 +29,7 @@ import (
 // created will have a .tmp suffix so they don't interfere with existing
 // segments that might have a similar name.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
-	logger logrus.FieldLogger,
+	logger logrus.FieldLogger, enc *diskio.Encryption,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -40,21 +40,9 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		return nil, fmt.Errorf("pre computing a segment expects a .tmp segment path")
 	}
 
-	file, err := os.Open(path)
+	content, err := mapSegment(path, enc)
 	if err != nil {
-		return nil, fmt.Errorf("open file: %w", err)
-	}
-
-	defer file.Close()
-
-	fileInfo, err := file.Stat()
-	if err != nil {
-		return nil, fmt.Errorf("stat file: %w", err)
-	}
-
-	content, err := syscall.Mmap(int(file.Fd()), 0, int(fileInfo.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
-	if err != nil {
-		return nil, fmt.Errorf("mmap file: %w", err)
+		return nil, err
 	}
 
 	defer syscall.Munmap(content)
@@ -96,6 +84,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		dataEndPos:          header.IndexStart,
 		index:               primaryDiskIndex,
 		logger:              logger,
+		encryption:          enc,
 	}
 
 	if ind.secondaryIndexCount > 0 {
@@ -128,7 +117,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	}
 
 	cnaPath := fmt.Sprintf("%s.tmp", ind.countNetPath())
-	if err := storeCountNetOnDisk(cnaPath, updatedCountNetAdditions); err != nil {
+	if err := storeCountNetOnDisk(enc, cnaPath, updatedCountNetAdditions); err != nil {
 		return nil, err
 	}
 
@@ -72,7 +72,7 @@ func TestPrecomputeSegmentMeta_Replace(t *testing.T) {
 	err = os.Rename(path.Join(dirName, fname), segmentTmp)
 	require.Nil(t, err)
 
-	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger)
+	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger, nil)
 	require.Nil(t, err)
 
 	// there should be 4 files and they should all have a .tmp suffix:
@@ -132,7 +132,7 @@ func TestPrecomputeSegmentMeta_Set(t *testing.T) {
 	err = os.Rename(path.Join(dirName, fname), segmentTmp)
 	require.Nil(t, err)
 
-	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger)
+	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger, nil)
 	require.Nil(t, err)
 
 	// there should be 2 files and they should all have a .tmp suffix:
@@ -147,14 +147,14 @@ func TestPrecomputeSegmentMeta_Set(t *testing.T) {
 func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 	t.Run("file without .tmp suffix", func(t *testing.T) {
 		logger, _ := test.NewNullLogger()
-		_, err := preComputeSegmentMeta("a-path-without-the-required-suffix", 7, logger)
+		_, err := preComputeSegmentMeta("a-path-without-the-required-suffix", 7, logger, nil)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "expects a .tmp segment")
 	})
 
 	t.Run("file does not exist", func(t *testing.T) {
 		logger, _ := test.NewNullLogger()
-		_, err := preComputeSegmentMeta("i-dont-exist.tmp", 7, logger)
+		_, err := preComputeSegmentMeta("i-dont-exist.tmp", 7, logger, nil)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "no such file or directory")
 	})
@@ -177,7 +177,7 @@ func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 		err = f.Close()
 		require.Nil(t, err)
 
-		_, err = preComputeSegmentMeta(segmentName, 7, logger)
+		_, err = preComputeSegmentMeta(segmentName, 7, logger, nil)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "parse header")
 	})
@@ -201,7 +201,7 @@ func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 		err = f.Close()
 		require.Nil(t, err)
 
-		_, err = preComputeSegmentMeta(segmentName, 7, logger)
+		_, err = preComputeSegmentMeta(segmentName, 7, logger, nil)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unsupported strategy")
 	})
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"time"
+	"unsafe"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
@@ -57,7 +58,9 @@ func (s *segment) get(key []byte) ([]byte, error) {
 	// Similar approach was used to fix SEGFAULT in collection strategy
 	// https://github.com/weaviate/weaviate/issues/1837
 	contentsCopy := make([]byte, node.End-node.Start)
-	copy(contentsCopy, s.contents[node.Start:node.End])
+	if err := s.copyData(contentsCopy, node.Start); err != nil {
+		return nil, err
+	}
 
 	return s.replaceStratParseData(contentsCopy)
 }
@@ -91,15 +94,36 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 	// invalid memory without the copy, thus leading to a SEGFAULT.
 	// Similar approach was used to fix SEGFAULT in collection strategy
 	// https://github.com/weaviate/weaviate/issues/1837
-	var contentsCopy []byte
-	if uint64(cap(buffer)) >= node.End-node.Start {
-		contentsCopy = buffer[:node.End-node.Start]
-	} else {
-		contentsCopy = make([]byte, node.End-node.Start)
+	//
+	// The node is placed in the buffer so that its value starts at an aligned
+	// address, see [Bucket.GetBySecondaryIntoMemory].
+	size := node.End - node.Start
+	if uint64(cap(buffer)) < size+valueAlignment-1 {
+		buffer = make([]byte, size+valueAlignment-1)
+	}
+	buffer = buffer[:cap(buffer)]
+	pad := uint64(alignmentPadding(buffer, replaceValueOffset))
+	contentsCopy := buffer[pad : pad+size]
+	if err := s.copyData(contentsCopy, node.Start); err != nil {
+		return nil, err, nil
 	}
-	copy(contentsCopy, s.contents[node.Start:node.End])
 	currContent, err := s.replaceStratParseData(contentsCopy)
-	return currContent, err, contentsCopy
+	return currContent, err, buffer
+}
+
+const (
+	// values copied into memory start at a multiple of valueAlignment
+	valueAlignment = 8
+	// position of the value within a replace node, after the tombstone and
+	// the value length
+	replaceValueOffset = 9
+)
+
+// alignmentPadding returns the number of bytes to skip at the start of buf,
+// so that buf[pad+offset] is aligned to valueAlignment
+func alignmentPadding(buf []byte, offset int) int {
+	addr := uintptr(unsafe.Pointer(unsafe.SliceData(buf))) + uintptr(offset)
+	return int((valueAlignment - addr%valueAlignment) % valueAlignment)
 }
 
 func (s *segment) replaceStratParseData(in []byte) ([]byte, error) {
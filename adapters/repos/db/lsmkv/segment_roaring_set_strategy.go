@@ -35,7 +35,17 @@ func (s *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
 		return out, err
 	}
 
-	sn := roaringset.NewSegmentNodeFromBuffer(s.contents[node.Start:node.End])
+	var data []byte
+	if s.remote == nil {
+		data = s.contents[node.Start:node.End]
+	} else {
+		data = make([]byte, node.End-node.Start)
+		if err := s.copyData(data, node.Start); err != nil {
+			return out, err
+		}
+	}
+
+	sn := roaringset.NewSegmentNodeFromBuffer(data)
 
 	// make sure that any data is copied before exiting this method, otherwise we
 	// risk a SEGFAULT as described in
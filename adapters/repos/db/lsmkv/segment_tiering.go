@@ -0,0 +1,338 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// ErrRemoteSegmentRead indicates that the contents of a tiered segment could
+// not be read from the remote store. Contrary to other errors on the read
+// path this is not a sign of corruption, so it is returned to the caller.
+var ErrRemoteSegmentRead = errors.New("read tiered segment")
+
+const (
+	// tieredSegmentExt is the extension of the local stub of a tiered
+	// segment. It contains the header and the indexes of the segment, so that
+	// lookups only need to go to the remote store for the actual values.
+	tieredSegmentExt = ".tiered"
+
+	// pinnedMarker prevents the segments of a bucket from being tiered
+	pinnedMarker = "segments.pinned"
+
+	remoteReadTimeout = 30 * time.Second
+)
+
+// RemoteSegmentStore is an S3-compatible object storage holding the contents
+// of tiered segments
+type RemoteSegmentStore interface {
+	PutSegment(ctx context.Context, key string, r io.Reader, size int64) error
+	// ReadSegmentAt fills p with the contents of the segment starting at off
+	ReadSegmentAt(ctx context.Context, key string, p []byte, off int64) error
+	DeleteSegment(ctx context.Context, key string) error
+}
+
+// Tiering moves cold, fully compacted segments to a [RemoteSegmentStore].
+// Reads of tiered segments are served through a block cache, which is shared
+// by all buckets using the same Tiering.
+type Tiering struct {
+	store     RemoteSegmentStore
+	prefix    string
+	minAge    time.Duration
+	blockSize uint64
+	cache     *blockCache
+	segments  *prometheus.CounterVec
+}
+
+func NewTiering(store RemoteSegmentStore, prefix string, minAge time.Duration,
+	blockSize, cacheSize int, promMetrics *monitoring.PrometheusMetrics,
+) *Tiering {
+	blocks := cacheSize / blockSize
+	if blocks < 1 {
+		blocks = 1
+	}
+
+	var requests, segments *prometheus.CounterVec
+	if promMetrics != nil {
+		requests = promMetrics.LSMTieringBlockCacheRequests
+		segments = promMetrics.LSMTieredSegments
+	}
+
+	return &Tiering{
+		store:     store,
+		prefix:    strings.Trim(prefix, "/"),
+		minAge:    minAge,
+		blockSize: uint64(blockSize),
+		cache:     newBlockCache(blocks, requests),
+		segments:  segments,
+	}
+}
+
+func (t *Tiering) observe(operation string) {
+	if t.segments == nil {
+		return
+	}
+
+	t.segments.With(prometheus.Labels{"operation": operation}).Inc()
+}
+
+// remoteKey builds the object key from the path of the segment relative to
+// the data root, so it is unique across all classes, shards and buckets
+func (t *Tiering) remoteKey(rootDir, segmentPath string) string {
+	rel, err := filepath.Rel(rootDir, segmentPath)
+	if err != nil {
+		rel = filepath.Base(segmentPath)
+	}
+
+	rel = filepath.ToSlash(rel)
+	if t.prefix == "" {
+		return rel
+	}
+	return t.prefix + "/" + rel
+}
+
+// remoteSegment is the part of a segment which lives in the remote store
+type remoteSegment struct {
+	tiering *Tiering
+	key     string
+	size    uint64
+
+	// the remote copy of an encrypted segment is the encrypted file, its data
+	// starts after the header of the file
+	headerSize uint64
+	decrypter  *diskio.Decrypter
+}
+
+// fileSize is the size of the remote copy
+func (r *remoteSegment) fileSize() uint64 {
+	return r.headerSize + r.size
+}
+
+// readAt fills p with the segment contents starting at off, fetching blocks
+// which are not cached yet. Every fetch is bounded by ctx and by
+// remoteReadTimeout.
+func (r *remoteSegment) readAt(ctx context.Context, p []byte, off uint64) error {
+	end := off + uint64(len(p))
+	if end > r.size {
+		return fmt.Errorf("%w: range %d-%d exceeds segment size %d",
+			ErrRemoteSegmentRead, off, end, r.size)
+	}
+
+	bs := r.tiering.blockSize
+	fileOff, fileEnd := off+r.headerSize, end+r.headerSize
+	for pos := fileOff; pos < fileEnd; {
+		block, err := r.block(ctx, pos/bs)
+		if err != nil {
+			return err
+		}
+
+		blockStart := (pos / bs) * bs
+		n := copy(p[pos-fileOff:], block[pos-blockStart:])
+		pos += uint64(n)
+	}
+
+	r.decrypter.DecryptAt(p, int64(off))
+	return nil
+}
+
+func (r *remoteSegment) block(ctx context.Context, idx uint64) ([]byte, error) {
+	key := blockKey{segment: r.key, block: idx}
+	if block, ok := r.tiering.cache.get(key); ok {
+		return block, nil
+	}
+
+	start := idx * r.tiering.blockSize
+	length := r.tiering.blockSize
+	if start+length > r.fileSize() {
+		length = r.fileSize() - start
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, remoteReadTimeout)
+	defer cancel()
+
+	block := make([]byte, length)
+	if err := r.tiering.store.ReadSegmentAt(ctx, r.key, block, int64(start)); err != nil {
+		return nil, fmt.Errorf("%w %s: %v", ErrRemoteSegmentRead, r.key, err)
+	}
+
+	r.tiering.cache.put(key, block)
+	return block, nil
+}
+
+type blockKey struct {
+	segment string
+	block   uint64
+}
+
+type blockCacheEntry struct {
+	key  blockKey
+	data []byte
+}
+
+// blockCache keeps the most recently used blocks of tiered segments
+type blockCache struct {
+	sync.Mutex
+	maxBlocks int
+	entries   map[blockKey]*list.Element
+	lru       *list.List
+	requests  *prometheus.CounterVec
+}
+
+func newBlockCache(maxBlocks int, requests *prometheus.CounterVec) *blockCache {
+	return &blockCache{
+		maxBlocks: maxBlocks,
+		entries:   map[blockKey]*list.Element{},
+		lru:       list.New(),
+		requests:  requests,
+	}
+}
+
+func (c *blockCache) get(key blockKey) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.observe("miss")
+		return nil, false
+	}
+
+	c.observe("hit")
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*blockCacheEntry).data, true
+}
+
+func (c *blockCache) put(key blockKey, data []byte) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&blockCacheEntry{key: key, data: data})
+	for c.lru.Len() > c.maxBlocks {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*blockCacheEntry).key)
+	}
+}
+
+// evict removes all blocks of a segment, e.g. once it is no longer tiered
+func (c *blockCache) evict(segment string) {
+	c.Lock()
+	defer c.Unlock()
+
+	for key, elem := range c.entries {
+		if key.segment == segment {
+			c.lru.Remove(elem)
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *blockCache) observe(result string) {
+	if c.requests == nil {
+		return
+	}
+
+	c.requests.With(prometheus.Labels{"result": result}).Inc()
+}
+
+func tieredPathFromSegmentPath(segPath string) string {
+	return strings.TrimSuffix(segPath, filepath.Ext(segPath)) + tieredSegmentExt
+}
+
+func segmentPathFromTieredPath(tieredPath string) string {
+	return strings.TrimSuffix(tieredPath, tieredSegmentExt) + ".db"
+}
+
+// tieredStub is everything but the data section of a tiered segment
+type tieredStub struct {
+	key string
+	// fileHeader is the header of an encrypted segment file, which is
+	// needed to decrypt the remote copy, empty otherwise
+	fileHeader []byte
+	header     []byte
+	index      []byte
+}
+
+// writeTieredStub persists everything but the data section of a segment.
+// The layout is the length of the remote key (uint16), the key itself, the
+// header of the encrypted file if the segment is encrypted, the segment
+// header and finally the index section of the segment.
+func writeTieredStub(enc *diskio.Encryption, path string, stub tieredStub) error {
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.LittleEndian, uint16(len(stub.key))); err != nil {
+		return err
+	}
+	buf.WriteString(stub.key)
+	buf.Write(stub.fileHeader)
+	buf.Write(stub.header)
+	buf.Write(stub.index)
+
+	tmp := path + ".tmp"
+	if err := enc.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("write tiered stub: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func readTieredStub(enc *diskio.Encryption, path string) (tieredStub, error) {
+	data, err := enc.ReadFile(path)
+	if err != nil {
+		return tieredStub{}, fmt.Errorf("read tiered stub: %w", err)
+	}
+
+	if len(data) < 2 {
+		return tieredStub{}, fmt.Errorf("tiered stub %s is truncated", path)
+	}
+	keyLen := int(binary.LittleEndian.Uint16(data[:2]))
+	headerStart := 2 + keyLen + int(enc.HeaderSize())
+	if len(data) < headerStart+segmentindex.HeaderSize {
+		return tieredStub{}, fmt.Errorf("tiered stub %s is truncated", path)
+	}
+
+	return tieredStub{
+		key:        string(data[2 : 2+keyLen]),
+		fileHeader: data[2+keyLen : headerStart],
+		header:     data[headerStart : headerStart+segmentindex.HeaderSize],
+		index:      data[headerStart+segmentindex.HeaderSize:],
+	}, nil
+}
+
+// SegmentTieringStatus describes how many segments of a bucket are tiered
+type SegmentTieringStatus struct {
+	Pinned         bool  `json:"pinned"`
+	LocalSegments  int   `json:"localSegments"`
+	LocalBytes     int64 `json:"localBytes"`
+	TieredSegments int   `json:"tieredSegments"`
+	TieredBytes    int64 `json:"tieredBytes"`
+}
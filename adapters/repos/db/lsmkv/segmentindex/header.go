@@ -54,19 +54,26 @@ func (h *Header) WriteTo(w io.Writer) (int64, error) {
 }
 
 func (h *Header) PrimaryIndex(source []byte) ([]byte, error) {
+	return h.PrimaryIndexAt(source, 0)
+}
+
+// PrimaryIndexAt is like PrimaryIndex, but the source does not start at the
+// beginning of the segment, but at the given offset. This allows extracting
+// the indexes from a copy of the index section only.
+func (h *Header) PrimaryIndexAt(source []byte, offset uint64) ([]byte, error) {
 	if h.SecondaryIndices == 0 {
-		return source[h.IndexStart:], nil
+		return source[h.IndexStart-offset:], nil
 	}
 
 	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.IndexStart:h.secondaryIndexOffsetsEnd()])
+		source[h.IndexStart-offset : h.secondaryIndexOffsetsEnd()-offset])
 	if err != nil {
 		return nil, err
 	}
 
 	// the beginning of the first secondary is also the end of the primary
 	end := offsets[0]
-	return source[h.secondaryIndexOffsetsEnd():end], nil
+	return source[h.secondaryIndexOffsetsEnd()-offset : end-offset], nil
 }
 
 func (h *Header) secondaryIndexOffsetsEnd() uint64 {
@@ -85,24 +92,29 @@ func (h *Header) parseSecondaryIndexOffsets(source []byte) ([]uint64, error) {
 }
 
 func (h *Header) SecondaryIndex(source []byte, indexID uint16) ([]byte, error) {
+	return h.SecondaryIndexAt(source, 0, indexID)
+}
+
+// SecondaryIndexAt is the equivalent of PrimaryIndexAt for secondary indexes
+func (h *Header) SecondaryIndexAt(source []byte, offset uint64, indexID uint16) ([]byte, error) {
 	if indexID >= h.SecondaryIndices {
 		return nil, errors.Errorf("retrieve index %d with len %d",
 			indexID, h.SecondaryIndices)
 	}
 
 	offsets, err := h.parseSecondaryIndexOffsets(
-		source[h.IndexStart:h.secondaryIndexOffsetsEnd()])
+		source[h.IndexStart-offset : h.secondaryIndexOffsetsEnd()-offset])
 	if err != nil {
 		return nil, err
 	}
 
-	start := offsets[indexID]
+	start := offsets[indexID] - offset
 	if indexID == h.SecondaryIndices-1 {
 		// this is the last index, return until EOF
 		return source[start:], nil
 	}
 
-	end := offsets[indexID+1]
+	end := offsets[indexID+1] - offset
 	return source[start:end], nil
 }
 
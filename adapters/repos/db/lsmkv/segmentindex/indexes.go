@@ -21,12 +21,16 @@ import (
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type Indexes struct {
 	Keys                []Key
 	SecondaryIndexCount uint16
 	ScratchSpacePath    string
+
+	// nil unless the segment is encrypted, the scratch files then are too
+	Encryption *diskio.Encryption
 }
 
 func (s Indexes) WriteTo(w io.Writer) (int64, error) {
@@ -53,7 +57,7 @@ func (s Indexes) WriteTo(w io.Writer) (int64, error) {
 	}
 
 	primaryFileName := filepath.Join(s.ScratchSpacePath, "primary")
-	primaryFD, err := os.Create(primaryFileName)
+	primaryFD, err := s.Encryption.Create(primaryFileName)
 	if err != nil {
 		return written, err
 	}
@@ -78,7 +82,7 @@ func (s Indexes) WriteTo(w io.Writer) (int64, error) {
 
 	// secondaryIndicesBytes := bytes.NewBuffer(nil)
 	secondaryFileName := filepath.Join(s.ScratchSpacePath, "secondary")
-	secondaryFD, err := os.Create(secondaryFileName)
+	secondaryFD, err := s.Encryption.Create(secondaryFileName)
 	if err != nil {
 		return written, err
 	}
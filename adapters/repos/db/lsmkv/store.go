@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
@@ -37,9 +38,16 @@ type Store struct {
 	compactionCycle cyclemanager.CycleManager
 	flushCycle      cyclemanager.CycleManager
 
+	// nil unless all buckets are encrypted, see [Store.SetEncryption]
+	encryption *diskio.Encryption
+
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
 	bucketAccessLock sync.RWMutex
+
+	// bucketAliases resolve the names of renamed buckets, see
+	// [Store.AliasBucket]
+	bucketAliases map[string]string
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -52,6 +60,7 @@ func New(dir, rootDir string, logger logrus.FieldLogger,
 		dir:             dir,
 		rootDir:         rootDir,
 		bucketsByName:   map[string]*Bucket{},
+		bucketAliases:   map[string]string{},
 		logger:          logger,
 		metrics:         metrics,
 		compactionCycle: cyclemanager.NewMulti(cyclemanager.CompactionCycleTicker()),
@@ -61,13 +70,58 @@ func New(dir, rootDir string, logger logrus.FieldLogger,
 	return s, s.init()
 }
 
+// SetEncryption encrypts every bucket which is created or loaded afterwards,
+// see [WithEncryption]
+func (s *Store) SetEncryption(enc *diskio.Encryption) {
+	s.encryption = enc
+}
+
+// bucketOptions prepends the options which apply to every bucket of the store
+func (s *Store) bucketOptions(opts []BucketOption) []BucketOption {
+	if s.encryption == nil {
+		return opts
+	}
+	return append([]BucketOption{WithEncryption(s.encryption)}, opts...)
+}
+
+// Bucket returns the bucket with the given name or alias, nil if there is
+// none
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
 
+	if b, ok := s.bucketsByName[name]; ok {
+		return b
+	}
+	return s.bucketsByName[s.bucketAliases[name]]
+}
+
+func (s *Store) loadedBucket(name string) *Bucket {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
 	return s.bucketsByName[name]
 }
 
+// AliasBucket makes the bucket name available as alias as well, until a
+// bucket named alias is created or name is dropped. It lets readers and
+// writers which use the new name of a bucket find it before it is renamed.
+// After a rename, the old name is an alias of the new one.
+func (s *Store) AliasBucket(alias, name string) {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	if _, ok := s.bucketsByName[alias]; ok {
+		return
+	}
+	if _, ok := s.bucketsByName[name]; !ok {
+		if target, ok := s.bucketAliases[name]; ok {
+			name = target
+		}
+	}
+	s.bucketAliases[alias] = name
+}
+
 func (s *Store) UpdateBucketsStatus(targetStatus storagestate.Status) {
 	// UpdateBucketsStatus is a write operation on the bucket itself, but from
 	// the perspective of our bucket access map this is a read-only operation,
@@ -117,12 +171,12 @@ func (s *Store) bucketDir(bucketName string) string {
 func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	opts ...BucketOption,
 ) error {
-	if b := s.Bucket(bucketName); b != nil {
+	if b := s.loadedBucket(bucketName); b != nil {
 		return nil
 	}
 
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		s.compactionCycle, s.flushCycle, opts...)
+		s.compactionCycle, s.flushCycle, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -136,6 +190,7 @@ func (s *Store) setBucket(name string, b *Bucket) {
 	defer s.bucketAccessLock.Unlock()
 
 	s.bucketsByName[name] = b
+	delete(s.bucketAliases, name)
 }
 
 func (s *Store) Shutdown(ctx context.Context) error {
@@ -275,7 +330,7 @@ func (s *Store) GetBucketsByName() map[string]*Bucket {
 func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	opts ...BucketOption,
 ) error {
-	if b := s.Bucket(bucketName); b != nil {
+	if b := s.loadedBucket(bucketName); b != nil {
 		return fmt.Errorf("bucket %s exists and is already in use", bucketName)
 	}
 
@@ -285,7 +340,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		s.compactionCycle, s.flushCycle, opts...)
+		s.compactionCycle, s.flushCycle, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -341,20 +396,30 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	return nil
 }
 
+// RenameBucket moves the bucket to the new name. The files of a bucket which
+// was never loaded are moved, unless there are none or the new name is
+// already taken, so the rename can be repeated after a crash.
 func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
 
-	currBucket := s.bucketsByName[bucketName]
-	if currBucket == nil {
-		return fmt.Errorf("bucket '%s' not found", bucketName)
-	}
 	newBucket := s.bucketsByName[newBucketName]
 	if newBucket != nil {
 		return fmt.Errorf("bucket '%s' already exists", newBucketName)
 	}
+	currBucket := s.bucketsByName[bucketName]
+	if currBucket == nil {
+		return s.renameBucketDir(bucketName, newBucketName)
+	}
 	s.bucketsByName[newBucketName] = currBucket
 	delete(s.bucketsByName, bucketName)
+	delete(s.bucketAliases, newBucketName)
+	for alias, name := range s.bucketAliases {
+		if name == bucketName {
+			s.bucketAliases[alias] = newBucketName
+		}
+	}
+	s.bucketAliases[bucketName] = newBucketName
 
 	currBucketDir := currBucket.dir
 	newBucketDir := s.bucketDir(newBucketName)
@@ -367,6 +432,53 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+func (s *Store) renameBucketDir(bucketName, newBucketName string) error {
+	currBucketDir := s.bucketDir(bucketName)
+	newBucketDir := s.bucketDir(newBucketName)
+
+	if _, err := os.Stat(currBucketDir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "stat bucket dir '%s'", currBucketDir)
+	}
+	if _, err := os.Stat(newBucketDir); err == nil {
+		return nil
+	}
+
+	if err := os.Rename(currBucketDir, newBucketDir); err != nil {
+		return errors.Wrapf(err, "failed renaming bucket dir '%s' to '%s'", currBucketDir, newBucketDir)
+	}
+	return nil
+}
+
+// DropBucket shuts down the bucket and removes its files. The files are
+// removed even if the bucket was never loaded.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	for alias, name := range s.bucketAliases {
+		if alias == bucketName || name == bucketName {
+			delete(s.bucketAliases, alias)
+		}
+	}
+
+	bucketDir := s.bucketDir(bucketName)
+	if bucket := s.bucketsByName[bucketName]; bucket != nil {
+		delete(s.bucketsByName, bucketName)
+		bucketDir = bucket.dir
+		if err := bucket.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+		}
+	}
+
+	if err := os.RemoveAll(bucketDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucketDir)
+	}
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
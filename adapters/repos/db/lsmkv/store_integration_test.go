@@ -79,3 +79,65 @@ func TestStoreLifecycle(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreBucketAliases(t *testing.T) {
+	ctx := testCtx()
+	store, err := New(t.TempDir(), "", nullLogger(), nil)
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "old", WithStrategy(StrategyReplace)))
+	bucket := store.Bucket("old")
+	require.Nil(t, bucket.Put([]byte("key"), []byte("value")))
+
+	t.Run("alias before the rename", func(t *testing.T) {
+		store.AliasBucket("new", "old")
+		assert.Same(t, bucket, store.Bucket("new"))
+	})
+
+	t.Run("old name after the rename", func(t *testing.T) {
+		require.Nil(t, store.RenameBucket(ctx, "old", "new"))
+		assert.Same(t, bucket, store.Bucket("new"))
+		assert.Same(t, bucket, store.Bucket("old"))
+	})
+
+	t.Run("a new bucket replaces the alias", func(t *testing.T) {
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "old", WithStrategy(StrategyReplace)))
+		assert.NotSame(t, bucket, store.Bucket("old"))
+
+		value, err := store.Bucket("old").Get([]byte("key"))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("dropping a bucket removes its aliases", func(t *testing.T) {
+		store.AliasBucket("other", "new")
+		require.Nil(t, store.DropBucket(ctx, "new"))
+		assert.Nil(t, store.Bucket("new"))
+		assert.Nil(t, store.Bucket("other"))
+	})
+}
+
+func TestStoreRenameUnloadedBucket(t *testing.T) {
+	ctx := testCtx()
+	dir := t.TempDir()
+
+	store, err := New(dir, "", nullLogger(), nil)
+	require.Nil(t, err)
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "old", WithStrategy(StrategyReplace)))
+	require.Nil(t, store.Bucket("old").Put([]byte("key"), []byte("value")))
+	require.Nil(t, store.Shutdown(ctx))
+
+	store, err = New(dir, "", nullLogger(), nil)
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.RenameBucket(ctx, "old", "new"))
+	// repeating the rename is a no-op
+	require.Nil(t, store.RenameBucket(ctx, "old", "new"))
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "new", WithStrategy(StrategyReplace)))
+	value, err := store.Bucket("new").Get([]byte("key"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+)
+
+// PinSegments restores all tiered segments of the bucket to local disk and
+// prevents further tiering until UnpinSegments is called. The setting is
+// persisted and survives restarts.
+func (b *Bucket) PinSegments(ctx context.Context) error {
+	return b.disk.setPinned(ctx, true)
+}
+
+// UnpinSegments allows cold segments of the bucket to be tiered again
+func (b *Bucket) UnpinSegments(ctx context.Context) error {
+	return b.disk.setPinned(ctx, false)
+}
+
+func (b *Bucket) SegmentTieringStatus() SegmentTieringStatus {
+	return b.disk.tieringStatus()
+}
+
+// SetSegmentsPinned pins or unpins the segments of all buckets which are
+// configured for tiering
+func (s *Store) SetSegmentsPinned(ctx context.Context, pinned bool) error {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for name, b := range s.bucketsByName {
+		if b.tiering == nil {
+			continue
+		}
+
+		var err error
+		if pinned {
+			err = b.PinSegments(ctx)
+		} else {
+			err = b.UnpinSegments(ctx)
+		}
+		if err != nil {
+			ec.Add(fmt.Errorf("bucket %s: %w", name, err))
+		}
+	}
+
+	return ec.ToError()
+}
+
+// SegmentTieringStatus returns the status of all buckets which are
+// configured for tiering, keyed by bucket name
+func (s *Store) SegmentTieringStatus() map[string]SegmentTieringStatus {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	out := map[string]SegmentTieringStatus{}
+	for name, b := range s.bucketsByName {
+		if b.tiering == nil {
+			continue
+		}
+		out[name] = b.SegmentTieringStatus()
+	}
+
+	return out
+}
+
+// DropRemoteSegments deletes the remote copies of all tiered segments. It is
+// meant to be called right before the store is shut down and removed from
+// disk.
+func (s *Store) DropRemoteSegments() {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	for _, b := range s.bucketsByName {
+		if b.tiering != nil {
+			b.disk.dropRemoteSegments()
+		}
+	}
+}
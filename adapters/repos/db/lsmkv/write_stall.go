@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"time"
+)
+
+const (
+	// WriteStallMemtableFlush indicates that memtables fill up faster than
+	// they can be flushed to disk
+	WriteStallMemtableFlush = "memtable_flush"
+	// WriteStallCommitLog indicates that writing the commit log to disk is
+	// slow
+	WriteStallCommitLog = "commit_log"
+)
+
+const (
+	// commit log writes slower than this mark the bucket as stalled
+	commitLogStallThreshold = 500 * time.Millisecond
+	// lower bound of the retry hint, so clients don't retry immediately
+	minWriteStallRetryAfter = time.Second
+)
+
+// WriteStall describes whether writes to a bucket or store currently
+// accumulate faster than they can be persisted. The zero value means writes
+// are not stalled.
+type WriteStall struct {
+	// Reason is one of the WriteStall* constants, empty if not stalled
+	Reason string
+	// RetryAfter estimates how long it takes until the stall is resolved
+	RetryAfter time.Duration
+}
+
+func (w WriteStall) Stalled() bool {
+	return w.Reason != ""
+}
+
+// Worse returns the stall with the longer expected duration
+func (w WriteStall) Worse(other WriteStall) WriteStall {
+	if !other.Stalled() {
+		return w
+	}
+	if !w.Stalled() || other.RetryAfter > w.RetryAfter {
+		return other
+	}
+	return w
+}
+
+// WriteStall reports whether writes to the bucket are stalled. The memtable
+// is considered stalled if the active memtable is already full while the
+// previous one is still being flushed, the commit log if its last write to
+// disk took longer than the threshold.
+func (b *Bucket) WriteStall() WriteStall {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	var out WriteStall
+	if b.flushing != nil && b.active.Size() >= b.memtableThreshold {
+		// the remaining time of the flush in progress, assuming it takes as long
+		// as the last one
+		remaining := b.lastFlushDuration - time.Since(b.flushStartedAt)
+		out = WriteStall{Reason: WriteStallMemtableFlush, RetryAfter: remaining}
+	}
+
+	if took := b.active.commitlog.lastFlushDuration(); took >= commitLogStallThreshold {
+		out = out.Worse(WriteStall{Reason: WriteStallCommitLog, RetryAfter: took})
+	}
+
+	if out.Stalled() && out.RetryAfter < minWriteStallRetryAfter {
+		out.RetryAfter = minWriteStallRetryAfter
+	}
+	return out
+}
+
+// WriteStall returns the worst write stall of all buckets of the store
+func (s *Store) WriteStall() WriteStall {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	var out WriteStall
+	for _, b := range s.bucketsByName {
+		out = out.Worse(b.WriteStall())
+	}
+	return out
+}
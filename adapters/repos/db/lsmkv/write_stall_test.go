@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucket_WriteStall(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newBucket := func(t *testing.T) *Bucket {
+		b, err := NewBucket(context.Background(), t.TempDir(), "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(), WithMemtableThreshold(100))
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			// shutdown waits for pending flushes, complete them first
+			if b.flushing != nil {
+				require.Nil(t, b.flushing.flush())
+				require.Nil(t, b.atomicallyAddDiskSegmentAndRemoveFlushing())
+			}
+			require.Nil(t, b.Shutdown(context.Background()))
+		})
+		return b
+	}
+	fill := func(t *testing.T, b *Bucket, prefix string) {
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("%s-%d", prefix, i)), make([]byte, 20)))
+		}
+	}
+
+	t.Run("not stalled while the memtable fills up", func(t *testing.T) {
+		b := newBucket(t)
+		fill(t, b, "key")
+
+		assert.False(t, b.WriteStall().Stalled())
+	})
+
+	t.Run("stalled while a flush is in progress and the next memtable is full", func(t *testing.T) {
+		b := newBucket(t)
+		fill(t, b, "first")
+		b.lastFlushDuration = 5 * time.Second
+		require.Nil(t, b.atomicallySwitchMemtable())
+		assert.False(t, b.WriteStall().Stalled())
+
+		fill(t, b, "second")
+		stall := b.WriteStall()
+		assert.Equal(t, WriteStallMemtableFlush, stall.Reason)
+		assert.Greater(t, stall.RetryAfter, 4*time.Second)
+		assert.LessOrEqual(t, stall.RetryAfter, 5*time.Second)
+	})
+
+	t.Run("retry hint is at least a second", func(t *testing.T) {
+		b := newBucket(t)
+		fill(t, b, "first")
+		require.Nil(t, b.atomicallySwitchMemtable())
+		fill(t, b, "second")
+
+		stall := b.WriteStall()
+		assert.Equal(t, WriteStallMemtableFlush, stall.Reason)
+		assert.Equal(t, minWriteStallRetryAfter, stall.RetryAfter)
+	})
+
+	t.Run("stalled by slow commit log writes", func(t *testing.T) {
+		b := newBucket(t)
+		b.active.commitlog.lastFlushNs.Store(int64(2 * time.Second))
+
+		stall := b.WriteStall()
+		assert.Equal(t, WriteStallCommitLog, stall.Reason)
+		assert.Equal(t, 2*time.Second, stall.RetryAfter)
+	})
+}
+
+func TestWriteStall_Worse(t *testing.T) {
+	none := WriteStall{}
+	short := WriteStall{Reason: WriteStallCommitLog, RetryAfter: time.Second}
+	long := WriteStall{Reason: WriteStallMemtableFlush, RetryAfter: 3 * time.Second}
+
+	assert.Equal(t, none, none.Worse(none))
+	assert.Equal(t, short, none.Worse(short))
+	assert.Equal(t, short, short.Worse(none))
+	assert.Equal(t, long, short.Worse(long))
+	assert.Equal(t, long, long.Worse(short))
+}
@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// writeStallMemoryPressure is reported as the write stall reason while the
+// memory governor rejects new batches
+const writeStallMemoryPressure = "memory_pressure"
+
+// memoryStage is the measure the memory governor takes, each stage includes
+// the measures of the previous ones
+type memoryStage int
+
+const (
+	memoryStageNormal memoryStage = iota
+	memoryStageShrinkCaches
+	memoryStageFlushMemtables
+	memoryStageRejectBatches
+)
+
+func (s memoryStage) String() string {
+	switch s {
+	case memoryStageShrinkCaches:
+		return "shrink_caches"
+	case memoryStageFlushMemtables:
+		return "flush_memtables"
+	case memoryStageRejectBatches:
+		return "reject_batches"
+	default:
+		return "normal"
+	}
+}
+
+// MemoryUsage is the memory held by the caches, memtables and queries of the
+// local shards as of the last scan of the memory governor
+type MemoryUsage struct {
+	// Percentage of GOMEMLIMIT in use
+	Percentage     float64 `json:"percentage"`
+	Stage          string  `json:"stage"`
+	VectorCache    int64   `json:"vectorCacheBytes"`
+	FilterCache    uint64  `json:"filterCacheBytes"`
+	Memtables      uint64  `json:"memtablesBytes"`
+	RunningQueries int64   `json:"runningQueries"`
+
+	// VectorCacheBudget is shared by the vector caches of all shards, 0 if
+	// the caches are only limited by their vectorCacheMaxObjects
+	VectorCacheBudget int64 `json:"vectorCacheBudgetBytes"`
+}
+
+// vectorCacheShrinker is implemented by the vector indexes which cache
+// vectors in memory
+type vectorCacheShrinker interface {
+	VectorCacheBytes() int64
+	ShrinkVectorCache()
+	SetVectorCacheBudget(bytes int64)
+}
+
+// memoryGovernor tracks the memory of the components which grow with the
+// load against GOMEMLIMIT, and sheds load in a defined order before the node
+// is killed for running out of memory
+type memoryGovernor struct {
+	sync.RWMutex
+	stage memoryStage
+	usage MemoryUsage
+
+	// percentage of GOMEMLIMIT in use and GOMEMLIMIT itself, replaced in
+	// tests
+	percentage     func() float64
+	limit          func() int64
+	runningQueries atomic.Int64
+}
+
+func newMemoryGovernor() *memoryGovernor {
+	mon := memwatch.NewMonitor(
+		runtime.MemProfile, debug.SetMemoryLimit, runtime.MemProfileRate)
+	return &memoryGovernor{
+		percentage: func() float64 { return mon.Ratio() * 100 },
+		limit:      func() int64 { return debug.SetMemoryLimit(-1) },
+	}
+}
+
+// trackQuery counts a running query until the returned func is called
+func (g *memoryGovernor) trackQuery() func() {
+	g.runningQueries.Add(1)
+	return func() { g.runningQueries.Add(-1) }
+}
+
+// MemoryUsage returns the memory usage as of the last scan of the memory
+// governor
+func (db *DB) MemoryUsage() MemoryUsage {
+	db.memoryGovernor.RLock()
+	defer db.memoryGovernor.RUnlock()
+
+	return db.memoryGovernor.usage
+}
+
+// admitBatch rejects new batches while the memory governor is at its last
+// stage
+func (db *DB) admitBatch() error {
+	db.memoryGovernor.RLock()
+	defer db.memoryGovernor.RUnlock()
+
+	if db.memoryGovernor.stage < memoryStageRejectBatches {
+		return nil
+	}
+	return fmt.Errorf("memory pressure: %.2f%% of GOMEMLIMIT in use, new batches are "+
+		"rejected until memory is freed, retry later", db.memoryGovernor.usage.Percentage)
+}
+
+// memoryPressureStall is the write stall signaled to streaming imports while
+// batches are rejected
+func (db *DB) memoryPressureStall() lsmkv.WriteStall {
+	db.memoryGovernor.RLock()
+	defer db.memoryGovernor.RUnlock()
+
+	if db.memoryGovernor.stage < memoryStageRejectBatches {
+		return lsmkv.WriteStall{}
+	}
+	return lsmkv.WriteStall{
+		Reason:     writeStallMemoryPressure,
+		RetryAfter: time.Duration(db.config.ResourceUsage.MemoryGovernor.IntervalSeconds) * time.Second,
+	}
+}
+
+func (db *DB) memoryStageFor(percentage float64) memoryStage {
+	cfg := db.config.ResourceUsage.MemoryGovernor
+	switch {
+	case percentage >= float64(cfg.RejectBatchesPercentage):
+		return memoryStageRejectBatches
+	case percentage >= float64(cfg.FlushMemtablesPercentage):
+		return memoryStageFlushMemtables
+	case percentage >= float64(cfg.ShrinkCachesPercentage):
+		return memoryStageShrinkCaches
+	default:
+		return memoryStageNormal
+	}
+}
+
+// vectorCacheBudget is the memory the vector caches of all shards may hold
+// together, 0 if they are not limited
+func (db *DB) vectorCacheBudget(stage memoryStage) int64 {
+	pct := db.config.ResourceUsage.MemoryGovernor.VectorCachePercentage
+	limit := db.memoryGovernor.limit()
+	if pct == 0 || limit <= 0 || limit == math.MaxInt64 {
+		return 0
+	}
+
+	budget := int64(float64(limit) * float64(pct) / 100)
+	if stage >= memoryStageShrinkCaches {
+		// the shrunk caches are refilled as the shards are searched, they
+		// should not grow back to where they were
+		budget /= 2
+	}
+	return budget
+}
+
+// governMemory compares the memory in use to GOMEMLIMIT and takes the
+// measures of the stage it is in. Caches are shrunk first as they are
+// refilled from disk, then the memtables are flushed, and only if that
+// doesn't help either new batches are rejected.
+func (db *DB) governMemory() {
+	percentage := db.memoryGovernor.percentage()
+	stage := db.memoryStageFor(percentage)
+
+	usage := MemoryUsage{
+		Percentage:        percentage,
+		Stage:             stage.String(),
+		RunningQueries:    db.memoryGovernor.runningQueries.Load(),
+		VectorCacheBudget: db.vectorCacheBudget(stage),
+	}
+
+	// the vector cache budget is split by the number of objects per shard
+	type cachedShard struct {
+		vc      vectorCacheShrinker
+		objects int64
+	}
+	var cached []cachedShard
+	var objects int64
+
+	db.indexLock.RLock()
+	for _, index := range db.indices {
+		index.ForEachShard(func(name string, shard *Shard) error {
+			if vc, ok := shard.queuedVectorIndex().(vectorCacheShrinker); ok {
+				usage.VectorCache += vc.VectorCacheBytes()
+				if stage >= memoryStageShrinkCaches {
+					vc.ShrinkVectorCache()
+				}
+				if usage.VectorCacheBudget > 0 && shard.store != nil {
+					count := int64(shard.objectCount())
+					if count < 1 {
+						count = 1
+					}
+					cached = append(cached, cachedShard{vc: vc, objects: count})
+					objects += count
+				}
+			}
+			if shard.store == nil {
+				return nil
+			}
+			storeUsage := shard.store.MemoryUsage()
+			usage.FilterCache += storeUsage.FilterCache
+			usage.Memtables += storeUsage.Memtables
+			if stage >= memoryStageShrinkCaches {
+				shard.store.DropFilterCaches()
+			}
+			if stage >= memoryStageFlushMemtables {
+				shard.store.RequestFlush()
+			}
+			return nil
+		})
+	}
+	for _, c := range cached {
+		c.vc.SetVectorCacheBudget(int64(
+			float64(usage.VectorCacheBudget) * float64(c.objects) / float64(objects)))
+	}
+	db.indexLock.RUnlock()
+
+	db.memoryGovernor.Lock()
+	previous := db.memoryGovernor.stage
+	db.memoryGovernor.stage = stage
+	db.memoryGovernor.usage = usage
+	db.memoryGovernor.Unlock()
+
+	db.memoryUsageMetrics(usage, stage)
+
+	logger := db.logger.WithField("action", "memory_governor").
+		WithField("percentage", percentage).
+		WithField("vector_cache_bytes", usage.VectorCache).
+		WithField("vector_cache_budget_bytes", usage.VectorCacheBudget).
+		WithField("filter_cache_bytes", usage.FilterCache).
+		WithField("memtables_bytes", usage.Memtables).
+		WithField("running_queries", usage.RunningQueries)
+	switch {
+	case stage > previous:
+		logger.Warnf("memory pressure: %.2f%% of GOMEMLIMIT in use, entering stage %s",
+			percentage, stage)
+	case stage < previous && stage == memoryStageNormal:
+		logger.Infof("memory pressure resolved: %.2f%% of GOMEMLIMIT in use", percentage)
+	}
+}
+
+func (db *DB) memoryUsageMetrics(usage MemoryUsage, stage memoryStage) {
+	if db.promMetrics == nil {
+		return
+	}
+	db.promMetrics.MemoryGovernorStage.Set(float64(stage))
+	db.promMetrics.MemoryGovernorBytes.WithLabelValues("vector_cache").Set(float64(usage.VectorCache))
+	db.promMetrics.MemoryGovernorBytes.WithLabelValues("vector_cache_budget").Set(float64(usage.VectorCacheBudget))
+	db.promMetrics.MemoryGovernorBytes.WithLabelValues("filter_cache").Set(float64(usage.FilterCache))
+	db.promMetrics.MemoryGovernorBytes.WithLabelValues("memtables").Set(float64(usage.Memtables))
+}
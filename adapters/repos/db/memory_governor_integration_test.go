@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestMemoryGovernor(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "MemoryGovernorClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWord,
+		}},
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+		FilterCacheMaxEntries:     10,
+		ResourceUsage: config.ResourceUsage{
+			MemoryGovernor: config.MemoryGovernor{
+				Enabled:                  true,
+				ShrinkCachesPercentage:   80,
+				FlushMemtablesPercentage: 85,
+				RejectBatchesPercentage:  90,
+				VectorCachePercentage:    10,
+				IntervalSeconds:          1,
+			},
+		},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	percentage := 0.0
+	repo.memoryGovernor.percentage = func() float64 { return percentage }
+	limit := int64(1 << 30)
+	repo.memoryGovernor.limit = func() int64 { return limit }
+	govern := func(pct float64) MemoryUsage {
+		percentage = pct
+		repo.governMemory()
+		return repo.MemoryUsage()
+	}
+	batch := func(id strfmt.UUID) error {
+		_, err := repo.BatchPutObjects(ctx, objects.BatchObjects{{
+			Object: &models.Object{
+				Class: class.Class, ID: id,
+				Properties: map[string]interface{}{"name": "governed"},
+			},
+			Vector: []float32{1, 2, 3},
+			UUID:   id,
+		}}, nil)
+		return err
+	}
+	// the filtered search fills the filter cache, the unfiltered one the
+	// vector cache, as a filter this selective is searched without the index
+	search := func() {
+		_, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+
+		_, err = repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 1},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: "name",
+				},
+				Value: &filters.Value{Value: "governed", Type: schema.DataTypeText},
+			}},
+		})
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, batch("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5"))
+
+	t.Run("normal usage", func(t *testing.T) {
+		usage := govern(50)
+		assert.Equal(t, "normal", usage.Stage)
+		assert.Greater(t, usage.Memtables, uint64(0))
+	})
+
+	t.Run("memtables are flushed", func(t *testing.T) {
+		govern(87)
+		assert.Eventually(t, func() bool {
+			return govern(50).Memtables == 0
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("caches are shrunk", func(t *testing.T) {
+		search()
+		usage := govern(50)
+		assert.Greater(t, usage.VectorCache, int64(0))
+		assert.Greater(t, usage.FilterCache, uint64(0))
+
+		assert.Equal(t, "shrink_caches", govern(82).Stage)
+		usage = govern(50)
+		assert.Zero(t, usage.VectorCache)
+		assert.Zero(t, usage.FilterCache)
+	})
+
+	t.Run("batches are rejected", func(t *testing.T) {
+		assert.Equal(t, "reject_batches", govern(95).Stage)
+		err := batch("f1d4ac9b-4d4c-4a35-9c2b-4a9a1a7c1b0e")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "memory pressure")
+
+		repo.scanWriteStalls()
+		assert.Equal(t, writeStallMemoryPressure, repo.WriteStall().Reason)
+
+		// queries are still served
+		search()
+	})
+
+	t.Run("batches are accepted again", func(t *testing.T) {
+		assert.Equal(t, "normal", govern(40).Stage)
+		assert.Nil(t, batch("f1d4ac9b-4d4c-4a35-9c2b-4a9a1a7c1b0e"))
+		repo.scanWriteStalls()
+		assert.False(t, repo.WriteStall().Stalled())
+	})
+
+	t.Run("vector cache budget", func(t *testing.T) {
+		assert.Equal(t, int64(107374182), govern(50).VectorCacheBudget)
+		assert.Equal(t, int64(53687091), govern(82).VectorCacheBudget,
+			"the budget is halved under memory pressure")
+
+		// a budget too small for a single vector, the vectors are read from
+		// disk on every search
+		limit = 100
+		assert.Equal(t, int64(10), govern(50).VectorCacheBudget)
+		search()
+
+		limit = math.MaxInt64
+		assert.Zero(t, govern(50).VectorCacheBudget, "without GOMEMLIMIT there is no budget")
+	})
+}
+
+func TestMemoryGovernorAsyncIndexing(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "MemoryGovernorAsyncClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+		AsyncIndexing:             true,
+		AsyncIndexingWorkers:      1,
+		ResourceUsage: config.ResourceUsage{
+			MemoryGovernor: config.MemoryGovernor{
+				Enabled:                  true,
+				ShrinkCachesPercentage:   80,
+				FlushMemtablesPercentage: 85,
+				RejectBatchesPercentage:  90,
+				IntervalSeconds:          1,
+			},
+		},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	repo.memoryGovernor.percentage = func() float64 { return 50 }
+
+	id := strfmt.UUID("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5")
+	require.Nil(t, repo.PutObject(ctx, &models.Object{Class: class.Class, ID: id},
+		[]float32{1, 2, 3}, nil))
+
+	// the vector cache is behind the indexing queue, it is only filled once
+	// the vector is indexed and searched
+	assert.Eventually(t, func() bool {
+		_, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		repo.governMemory()
+		return repo.MemoryUsage().VectorCache > 0
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -16,7 +16,10 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 type Metrics struct {
@@ -30,6 +33,11 @@ type Metrics struct {
 	filteredVectorVector  prometheus.Observer
 	filteredVectorObjects prometheus.Observer
 	filteredVectorSort    prometheus.Observer
+	writeStall            *prometheus.GaugeVec
+	replicaRepairObjects  *prometheus.CounterVec
+	replicaRepairBytes    prometheus.Counter
+	tenantUsage           *prometheus.GaugeVec
+	quotaRejections       *prometheus.CounterVec
 }
 
 func NewMetrics(
@@ -91,9 +99,97 @@ func NewMetrics(
 		"operation":  "sort",
 	})
 
+	m.replicaRepairObjects = prom.ReplicaRepairObjects.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+	m.replicaRepairBytes = prom.ReplicaRepairBytes.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	m.quotaRejections = prom.TenantQuotaRejections.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+	})
+
+	if !prom.Group {
+		// a stall is a per-shard state, it can't be aggregated meaningfully
+		m.writeStall = prom.ShardWriteStall.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		})
+		m.tenantUsage = prom.TenantUsage.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+		})
+	}
+
 	return m
 }
 
+// ReplicaRepair counts the objects compared, repaired and skipped by a
+// repair of the replicas of the shard
+func (m *Metrics) ReplicaRepair(stats replica.RepairStats) {
+	if !m.monitoring {
+		return
+	}
+
+	m.replicaRepairObjects.With(prometheus.Labels{"result": "compared"}).Add(float64(stats.Compared))
+	m.replicaRepairObjects.With(prometheus.Labels{"result": "repaired"}).Add(float64(stats.Repaired))
+	m.replicaRepairObjects.With(prometheus.Labels{"result": "skipped"}).Add(float64(stats.Skipped))
+	m.replicaRepairBytes.Add(float64(stats.Bytes))
+}
+
+// WriteStall sets the stall state of the shard, every reason other than the
+// current one is reset
+func (m *Metrics) WriteStall(stall lsmkv.WriteStall) {
+	if !m.monitoring || m.writeStall == nil {
+		return
+	}
+
+	for _, reason := range []string{lsmkv.WriteStallMemtableFlush, lsmkv.WriteStallCommitLog} {
+		value := 0.0
+		if stall.Reason == reason {
+			value = 1
+		}
+		m.writeStall.With(prometheus.Labels{"reason": reason}).Set(value)
+	}
+}
+
+// TenantUsage sets the usage of a tenant of the shard
+func (m *Metrics) TenantUsage(tenant string, usage models.TenantUsage) {
+	if m == nil || !m.monitoring || m.tenantUsage == nil {
+		return
+	}
+
+	m.tenantUsage.With(prometheus.Labels{"tenant": tenant, "resource": "objects"}).
+		Set(float64(usage.Objects))
+	m.tenantUsage.With(prometheus.Labels{"tenant": tenant, "resource": "vectors"}).
+		Set(float64(usage.Vectors))
+	m.tenantUsage.With(prometheus.Labels{"tenant": tenant, "resource": "storage_bytes"}).
+		Set(float64(usage.StorageBytes))
+}
+
+// DeleteTenantUsage removes the usage of a tenant which is no longer
+// counted by the shard
+func (m *Metrics) DeleteTenantUsage(tenant string) {
+	if m == nil || !m.monitoring || m.tenantUsage == nil {
+		return
+	}
+
+	for _, resource := range []string{"objects", "vectors", "storage_bytes"} {
+		m.tenantUsage.Delete(prometheus.Labels{"tenant": tenant, "resource": resource})
+	}
+}
+
+// TenantQuotaRejected counts a write rejected by the quota of a tenant
+func (m *Metrics) TenantQuotaRejected(resource string) {
+	if m == nil || !m.monitoring {
+		return
+	}
+
+	m.quotaRejections.With(prometheus.Labels{"resource": resource}).Inc()
+}
+
 func (m *Metrics) BatchObject(start time.Time, size int) {
 	took := time.Since(start)
 	m.logger.WithField("action", "batch_objects").
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
@@ -41,20 +42,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		return fmt.Errorf("replication config: %w", err)
 	}
 
-	idx, err := NewIndex(ctx,
-		IndexConfig{
-			ClassName:                 schema.ClassName(class.Class),
-			RootPath:                  m.db.config.RootPath,
-			ResourceUsage:             m.db.config.ResourceUsage,
-			QueryMaximumResults:       m.db.config.QueryMaximumResults,
-			MemtablesFlushIdleAfter:   m.db.config.MemtablesFlushIdleAfter,
-			MemtablesInitialSizeMB:    m.db.config.MemtablesInitialSizeMB,
-			MemtablesMaxSizeMB:        m.db.config.MemtablesMaxSizeMB,
-			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
-			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
-			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
-			ReplicationFactor:         class.ReplicationConfig.Factor,
-		},
+	idx, err := NewIndex(ctx, m.db.indexConfig(class),
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
 		// always have the field set
@@ -66,21 +54,24 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		return errors.Wrap(err, "create index")
 	}
 
-	err = idx.addUUIDProperty(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "extend idx '%s' with uuid property", idx.ID())
-	}
-
-	if class.InvertedIndexConfig.IndexTimestamps {
-		err = idx.addTimestampProperties(ctx)
+	// vector-only classes don't have any inverted index
+	if !class.VectorOnly {
+		err = idx.addUUIDProperty(ctx)
 		if err != nil {
-			return errors.Wrapf(err, "extend idx '%s' with timestamp properties", idx.ID())
+			return errors.Wrapf(err, "extend idx '%s' with uuid property", idx.ID())
+		}
+
+		if class.InvertedIndexConfig.IndexTimestamps {
+			err = idx.addTimestampProperties(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "extend idx '%s' with timestamp properties", idx.ID())
+			}
 		}
-	}
 
-	if m.db.config.TrackVectorDimensions {
-		if err := idx.addDimensionsProperty(context.TODO()); err != nil {
-			return errors.Wrap(err, "init id property")
+		if m.db.config.TrackVectorDimensions {
+			if err := idx.addDimensionsProperty(context.TODO()); err != nil {
+				return errors.Wrap(err, "init id property")
+			}
 		}
 	}
 
@@ -96,11 +87,50 @@ func (m *Migrator) DropClass(ctx context.Context, className string) error {
 	return m.db.DeleteIndex(schema.ClassName(className))
 }
 
+// UpdateClass renames the index of className to newClassName. The files on
+// disk keep the original name, the index is reopened under the new name and
+// objects are labelled with the current class name on read.
 func (m *Migrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
-	if newClassName != nil {
-		return errors.New("weaviate does not support renaming of classes")
+	if newClassName == nil || *newClassName == className {
+		return nil
+	}
+
+	sch := m.db.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(*newClassName))
+	if class == nil {
+		return errors.Errorf("class %q not found in schema", *newClassName)
+	}
+	shardState := m.db.schemaGetter.CopyShardingState(*newClassName)
+	if shardState == nil {
+		return errors.Errorf("sharding state of class %q not found", *newClassName)
+	}
+
+	m.db.indexLock.Lock()
+	defer m.db.indexLock.Unlock()
+
+	id := indexID(schema.ClassName(className))
+	if old := m.db.indices[id]; old != nil {
+		old.dropIndex.Lock()
+		err := old.Shutdown(ctx)
+		delete(m.db.indices, id)
+		old.dropIndex.Unlock()
+		if err != nil {
+			return errors.Wrapf(err, "shutdown index %q", id)
+		}
+	}
+
+	idx, err := NewIndex(ctx, m.db.indexConfig(class),
+		shardState,
+		inverted.ConfigFromModel(class.InvertedIndexConfig),
+		class.VectorIndexConfig.(schema.VectorIndexConfig),
+		m.db.schemaGetter, m.db, m.logger, m.db.nodeResolver, m.db.remoteIndex,
+		m.db.replicaClient, m.db.promMetrics, class, m.db.jobQueueCh)
+	if err != nil {
+		return errors.Wrapf(err, "reopen index of %q as %q", className, *newClassName)
 	}
 
+	m.db.indices[idx.ID()] = idx
+	idx.notifyReady()
 	return nil
 }
 
@@ -113,18 +143,58 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 	return idx.addProperty(ctx, prop)
 }
 
-// DropProperty is ignored, API compliant change
+// DropProperty records that the property is dropped. It is called before the
+// property is removed from the schema. Its buckets are dropped and it is
+// stripped from the stored objects by MigrateProperties, objects which are
+// read before no longer have it once the schema changed.
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property of a non-existing index for %s", className)
+	}
+
+	return idx.prepareDropProperty(propertyName)
+}
+
+// MigrateProperties renames and drops the buckets of the renamed and dropped
+// properties and rewrites the stored objects of the loaded shards. It is
+// expected to run in the background, once the schema changed. Shards which
+// are not loaded are migrated once they are loaded.
+func (m *Migrator) MigrateProperties(ctx context.Context, className string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot migrate properties of a non-existing index for %s", className)
+	}
+
+	return idx.migrateProperties(ctx)
+}
+
+// ReindexProperty rebuilds the inverted buckets of the property from the
+// stored objects of all local shards, e.g. after its tokenization was
+// changed. The shards do not accept writes while they are reindexed.
+func (m *Migrator) ReindexProperty(ctx context.Context, className string, propertyName string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot reindex property of a non-existing index for %s", className)
+	}
+
+	return idx.reindexProperty(ctx, propertyName)
 }
 
+// UpdateProperty records that the property is renamed. It is called before
+// the property is renamed in the schema, its buckets can be found under both
+// names until they are renamed by MigrateProperties.
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
-	if newName != nil {
-		return errors.New("weaviate does not support renaming of properties")
+	if newName == nil || *newName == propName {
+		return nil
 	}
 
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot rename property of a non-existing index for %s", className)
+	}
+
+	return idx.prepareRenameProperty(propName, *newName)
 }
 
 func (m *Migrator) GetShardsStatus(ctx context.Context, className string) (map[string]string, error) {
@@ -145,6 +215,18 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	return idx.updateShardStatus(ctx, shardName, targetStatus)
 }
 
+// TenantUsage returns the objects, vectors and storage bytes the tenants use
+func (m *Migrator) TenantUsage(ctx context.Context, className string,
+	tenants []string,
+) (map[string]*models.TenantUsage, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot get tenant usage of a non-existing index for %s", className)
+	}
+
+	return idx.tenantUsage(ctx, tenants), nil
+}
+
 // NewTenants creates new partitions and returns a commit func
 // that can be used to either commit or rollback the partitions
 func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error) {
@@ -179,7 +261,7 @@ func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, tenants
 	}()
 
 	for _, name := range tenants {
-		if shard := idx.shards.Load(name); shard != nil {
+		if shard := idx.shards.Load(name); shard != nil || idx.tenants.offloaded(name) {
 			continue
 		}
 		shard, err := NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue)
@@ -221,9 +303,18 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
+	// the dynamic index eventually becomes an hnsw index, so the same
+	// restrictions apply to the hnsw settings it carries
+	oldDynamic, ok := old.(dynamicent.UserConfig)
+	if ok {
+		updatedDynamic, ok := updated.(dynamicent.UserConfig)
+		if !ok {
+			return errors.Errorf("vector index type is immutable: cannot update %q to %q",
+				old.IndexType(), updated.IndexType())
+		}
+		return hnsw.ValidateUserConfigUpdate(oldDynamic.Hnsw, updatedDynamic.Hnsw)
+	}
+
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
 
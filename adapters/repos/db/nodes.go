@@ -128,10 +128,16 @@ func (db *DB) localNodeStatusForClass(status *[]*models.NodeShardStatus,
 func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCount int64) {
 	i.ForEachShard(func(name string, shard *Shard) error {
 		objectCount := int64(shard.objectCount())
+		queueLength, queueLag := shard.vectorQueueStatus()
+		health, healthReason := shard.health.status()
 		shardStatus := &models.NodeShardStatus{
-			Name:        name,
-			Class:       shard.index.Config.ClassName.String(),
-			ObjectCount: objectCount,
+			Name:                  name,
+			Class:                 shard.index.Config.ClassName.String(),
+			ObjectCount:           objectCount,
+			VectorQueueLength:     int64(queueLength),
+			VectorQueueLagSeconds: queueLag.Seconds(),
+			Health:                health,
+			HealthReason:          healthReason,
 		}
 		totalCount += objectCount
 		*status = append(*status, shardStatus)
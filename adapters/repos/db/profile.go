@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/dataprofile"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ProfileClass profiles a sample of at most sampleSize objects of the local
+// shards of a class. Every shard contributes in proportion to its number of
+// objects.
+func (db *DB) ProfileClass(ctx context.Context, className string,
+	sampleSize int,
+) (*dataprofile.ClassProfile, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	class, err := schema.GetClassByName(idx.getSchema.GetSchemaSkipAuth().Objects, className)
+	if err != nil {
+		return nil, enterrors.NewErrNotFound(err)
+	}
+
+	counts := map[string]int{}
+	var total int64
+	idx.ForEachShard(func(name string, shard *Shard) error {
+		counts[name] = shard.objectCount()
+		total += int64(counts[name])
+		return nil
+	})
+
+	profiler := dataprofile.NewProfiler(class)
+	err = idx.ForEachShard(func(name string, shard *Shard) error {
+		if total == 0 {
+			return nil
+		}
+
+		// rounding up guarantees a sample of every non-empty shard
+		n := int((int64(counts[name])*int64(sampleSize) + total - 1) / total)
+		if err := shard.sampleObjects(ctx, n, func(obj *storobj.Object) {
+			props, _ := obj.Properties().(map[string]interface{})
+			profiler.Add(props, obj.Vector)
+		}); err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profiler.Profile(className, total), nil
+}
+
+// sampleObjects passes up to n objects to fn, read from a random position of
+// the objects bucket onwards, wrapping around at its end. The bucket is keyed
+// by id, so for random ids this is a uniform sample, while the cost of
+// reading it is bounded by n.
+func (s *Shard) sampleObjects(ctx context.Context, n int,
+	fn func(obj *storobj.Object),
+) error {
+	if n <= 0 {
+		return nil
+	}
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).SnapshotCursor()
+	defer cursor.Close()
+
+	start := make([]byte, 16)
+	rand.Read(start)
+
+	read := 0
+	sample := func(k, v []byte, stop func(k []byte) bool) error {
+		for ; k != nil && read < n && !stop(k); k, v = cursor.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				return errors.Wrap(err, "unmarshal object")
+			}
+			fn(obj)
+			read++
+		}
+		return nil
+	}
+
+	k, v := cursor.Seek(start)
+	if err := sample(k, v, func([]byte) bool { return false }); err != nil {
+		return err
+	}
+
+	k, v = cursor.First()
+	return sample(k, v, func(k []byte) bool { return bytes.Compare(k, start) >= 0 })
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestProfileClass(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "ProfiledClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(ctx)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	for i := 0; i < 50; i++ {
+		props := map[string]interface{}{}
+		if i%5 != 0 {
+			props["title"] = "abcd"
+		}
+		vector := []float32{float32(i), 1}
+		if i < 10 {
+			vector = []float32{1, 1}
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         strfmt.UUID(uuid.NewString()),
+			Class:      class.Class,
+			Properties: props,
+		}, vector, nil))
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		profile, err := repo.ProfileClass(ctx, class.Class, 1000)
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(50), profile.ObjectCount)
+		assert.Equal(t, 50, profile.SampleSize, "every object is read once")
+		require.Len(t, profile.Properties, 1)
+		assert.Equal(t, 0.2, profile.Properties[0].NullRatio)
+		assert.Equal(t, 4.0, *profile.Properties[0].AverageTextLength)
+		require.NotNil(t, profile.Vectors)
+		assert.Equal(t, 9, profile.Vectors.DuplicatesInSample)
+		assert.Equal(t, int64(9), profile.Vectors.EstimatedDuplicates)
+	})
+
+	for _, size := range []int{1, 20} {
+		t.Run(fmt.Sprintf("sample of %d", size), func(t *testing.T) {
+			profile, err := repo.ProfileClass(ctx, class.Class, size)
+			require.Nil(t, err)
+			assert.Equal(t, size, profile.SampleSize)
+			assert.Equal(t, size, profile.Vectors.Count)
+		})
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.ProfileClass(ctx, "Unknown", 10)
+		assert.NotNil(t, err)
+	})
+}
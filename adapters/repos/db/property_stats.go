@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/propertystats"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// PropertyStats returns the statistics of a property of a class, merged
+// across its local shards. ok is false if the stats of any shard are
+// unavailable, for example because some of its segments are tiered.
+func (db *DB) PropertyStats(className, propName string) (stats propertystats.Stats, ok bool, err error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return propertystats.Stats{}, false,
+			enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	if !idx.Config.PropertyStats {
+		return propertystats.Stats{}, false, enterrors.NewErrUnprocessable(
+			fmt.Errorf("property stats are not enabled"))
+	}
+
+	class, err := schema.GetClassByName(idx.getSchema.GetSchemaSkipAuth().Objects, className)
+	if err != nil {
+		return propertystats.Stats{}, false, enterrors.NewErrNotFound(err)
+	}
+
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return propertystats.Stats{}, false, enterrors.NewErrNotFound(err)
+	}
+
+	if !inverted.HasFilterableIndex(prop) {
+		return propertystats.Stats{}, false, enterrors.NewErrUnprocessable(
+			fmt.Errorf("property %q has no filterable index", propName))
+	}
+
+	var shards []propertystats.Stats
+	ok = true
+	err = idx.ForEachShard(func(name string, shard *Shard) error {
+		s, shardOK, err := shard.propertyStats(prop)
+		if err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		ok = ok && shardOK
+		shards = append(shards, s)
+		return nil
+	})
+	if err != nil || !ok {
+		return propertystats.Stats{}, false, err
+	}
+
+	return propertystats.Merge(shards...), true, nil
+}
+
+// propertyStats builds the stats of a property from the key stats of its
+// filterable index and the exact count of its null state index
+func (s *Shard) propertyStats(prop *models.Property) (propertystats.Stats, bool, error) {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+	if bucket == nil {
+		return propertystats.Stats{}, false, nil
+	}
+
+	keyStats, ok, err := bucket.KeyStats()
+	if err != nil || !ok {
+		return propertystats.Stats{}, false, err
+	}
+
+	out := propertystats.Stats{
+		Count:     keyStats.Count,
+		Distinct:  keyStats.Distinct,
+		MinKey:    keyStats.MinKey,
+		MaxKey:    keyStats.MaxKey,
+		Histogram: keyStats.Histogram,
+	}
+
+	if decode := numericKeyDecoder(prop); decode != nil && out.Count > 0 {
+		min, err := decode(out.MinKey)
+		if err != nil {
+			return propertystats.Stats{}, false, fmt.Errorf("decode min: %w", err)
+		}
+		max, err := decode(out.MaxKey)
+		if err != nil {
+			return propertystats.Stats{}, false, fmt.Errorf("decode max: %w", err)
+		}
+		out.Min, out.Max = &min, &max
+	}
+
+	if bucketNull := s.store.Bucket(helpers.BucketFromPropNameNullLSM(prop.Name)); bucketNull != nil {
+		nulls, err := bucketNull.RoaringSetGet([]byte{uint8(filters.InternalNullState)})
+		if err != nil {
+			return propertystats.Stats{}, false, fmt.Errorf("null state: %w", err)
+		}
+		out.NullCount = uint64(nulls.GetCardinality())
+		out.HasNullCount = true
+	}
+
+	return out, true, nil
+}
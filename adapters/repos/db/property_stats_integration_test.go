@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPropertyStats(t *testing.T) {
+	ctx := context.Background()
+	vTrue := true
+	class := &models.Class{
+		Class:             "PropertyStatsClass",
+		VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			IndexNullState:         true,
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "age",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:            "unfiltered",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: new(bool),
+				IndexSearchable: &vTrue,
+			},
+		},
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+		PropertyStats:             true,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	for i := 0; i < 100; i++ {
+		props := map[string]interface{}{"age": int64(i % 10)}
+		if i%10 != 0 {
+			props["name"] = fmt.Sprintf("name-%d", i%20)
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         strfmt.UUID(uuid.NewString()),
+			Class:      class.Class,
+			Properties: props,
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	require.Nil(t, repo.GetIndex(schema.ClassName(class.Class)).
+		ForEachShard(func(_ string, shard *Shard) error {
+			return shard.store.FlushMemtables(ctx)
+		}))
+
+	t.Run("int property", func(t *testing.T) {
+		stats, ok, err := repo.PropertyStats(class.Class, "age")
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		assert.Equal(t, uint64(100), stats.Count)
+		assert.Equal(t, uint64(10), stats.DistinctCount())
+		require.NotNil(t, stats.Min)
+		require.NotNil(t, stats.Max)
+		assert.Equal(t, 0.0, *stats.Min)
+		assert.Equal(t, 9.0, *stats.Max)
+		assert.True(t, stats.HasNullCount)
+		assert.Equal(t, uint64(0), stats.NullCount)
+
+		key, err := inverted.LexicographicallySortableInt64(5)
+		require.Nil(t, err)
+		assert.InDelta(t, 0.1, stats.EqualFraction(key), 0.05)
+		assert.InDelta(t, 0.5, stats.LessThanFraction(key), 0.1)
+	})
+
+	t.Run("text property", func(t *testing.T) {
+		stats, ok, err := repo.PropertyStats(class.Class, "name")
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		assert.Equal(t, uint64(90), stats.Count)
+		assert.Equal(t, uint64(18), stats.DistinctCount())
+		assert.Nil(t, stats.Min)
+		assert.Equal(t, []byte("name-1"), stats.MinKey)
+		assert.Equal(t, []byte("name-9"), stats.MaxKey)
+		assert.Equal(t, uint64(10), stats.NullCount)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var notFound enterrors.ErrNotFound
+		var unprocessable enterrors.ErrUnprocessable
+
+		_, _, err := repo.PropertyStats("Unknown", "age")
+		assert.ErrorAs(t, err, &notFound)
+
+		_, _, err = repo.PropertyStats(class.Class, "unknown")
+		assert.ErrorAs(t, err, &notFound)
+
+		_, _, err = repo.PropertyStats(class.Class, "unfiltered")
+		assert.ErrorAs(t, err, &unprocessable)
+	})
+}
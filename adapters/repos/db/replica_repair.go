@@ -0,0 +1,300 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const (
+	// how long a hash tree is reused, it must outlive the comparison of the
+	// trees of two replicas
+	hashTreeTTL = time.Minute
+	// height of the hash trees used to sync shards which are moved, if the
+	// replica repair is not configured
+	defaultHashTreeHeight = 12
+	// number of objects read per cursor, cursors are not held for long to
+	// not block flushing
+	hashTreeScanBatchSize = 1000
+)
+
+// ReplicaRepair compares the replicas of shards in the background and
+// repairs objects which are missing or outdated on some of them, see
+// replica.Replicator.RepairShard. A zero Interval disables the repair.
+type ReplicaRepair struct {
+	Interval       time.Duration
+	HashTreeHeight int
+	// MaxBytesPerSecond limits the bandwidth of a node used for repairs,
+	// zero means unlimited
+	MaxBytesPerSecond int
+}
+
+// hashTreeCache keeps the last hash tree of a shard, so the levels of a tree
+// which are requested one after the other are consistent. Requesting the
+// root starts a new comparison and rebuilds the tree.
+type hashTreeCache struct {
+	sync.Mutex
+	tree  *replica.HashTree
+	built time.Time
+}
+
+func (db *DB) HashTreeLevel(ctx context.Context,
+	class, shardName string, req replica.HashTreeRequest,
+) ([]replica.Digest, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found locally", class)
+	}
+	return index.hashTreeLevel(ctx, shardName, req)
+}
+
+func (i *Index) hashTreeLevel(ctx context.Context,
+	shardName string, req replica.HashTreeRequest,
+) ([]replica.Digest, error) {
+	s, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+
+	tree, err := s.hashTree(ctx, req.Height, req.Level == 0)
+	if err != nil {
+		return nil, fmt.Errorf("shard %q hash tree: %w", shardName, err)
+	}
+	return tree.Level(req.Level, req.Nodes)
+}
+
+func (db *DB) DigestObjectsInLeaves(ctx context.Context,
+	class, shardName string, req replica.HashTreeRequest,
+) ([]replica.RepairResponse, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found locally", class)
+	}
+	return index.digestObjectsInLeaves(ctx, shardName, req)
+}
+
+func (i *Index) digestObjectsInLeaves(ctx context.Context,
+	shardName string, req replica.HashTreeRequest,
+) ([]replica.RepairResponse, error) {
+	if req.Height < 0 || req.Height > replica.MaxHashTreeHeight {
+		return nil, fmt.Errorf("invalid hash tree height %d", req.Height)
+	}
+	s, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+
+	leaves := make([]int, len(req.Nodes))
+	copy(leaves, req.Nodes)
+	sort.Ints(leaves)
+
+	var result []replica.RepairResponse
+	for _, leaf := range leaves {
+		if leaf < 0 || leaf >= 1<<req.Height {
+			return nil, fmt.Errorf("leaf %d out of range", leaf)
+		}
+		err := s.scanObjectDigests(ctx, replica.HashTreeLeafStart(req.Height, leaf),
+			func(id []byte, updateTime int64) (bool, error) {
+				if replica.HashTreeLeaf(req.Height, id) != leaf {
+					return false, nil
+				}
+				parsed, err := uuid.FromBytes(id)
+				if err != nil {
+					return false, fmt.Errorf("parse uuid: %w", err)
+				}
+				result = append(result, replica.RepairResponse{
+					ID:         parsed.String(),
+					UpdateTime: updateTime,
+				})
+				return true, nil
+			})
+		if err != nil {
+			return nil, fmt.Errorf("shard %q digest leaf %d: %w", shardName, leaf, err)
+		}
+	}
+	return result, nil
+}
+
+// hashTree returns the hash tree of the shard with the given height. It is
+// rebuilt from the objects bucket if rebuild is set or the last one is too
+// old.
+func (s *Shard) hashTree(ctx context.Context, height int, rebuild bool) (*replica.HashTree, error) {
+	s.hashTrees.Lock()
+	defer s.hashTrees.Unlock()
+
+	if t := s.hashTrees.tree; !rebuild && t != nil && t.Height() == height &&
+		time.Since(s.hashTrees.built) < hashTreeTTL {
+		return t, nil
+	}
+
+	tree, err := replica.NewHashTree(height)
+	if err != nil {
+		return nil, err
+	}
+	err = s.scanObjectDigests(ctx, nil, func(id []byte, updateTime int64) (bool, error) {
+		tree.AddObject(id, updateTime)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	tree.Build()
+
+	s.hashTrees.tree = tree
+	s.hashTrees.built = time.Now()
+	return tree, nil
+}
+
+// scanObjectDigests calls fn with the id and last update time of every object
+// starting at from, in the order of their ids, until fn returns false
+func (s *Shard) scanObjectDigests(ctx context.Context, from []byte,
+	fn func(id []byte, updateTime int64) (bool, error),
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	var last []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, done, err := func() (int, bool, error) {
+			cursor := bucket.Cursor()
+			defer cursor.Close()
+
+			var k, v []byte
+			switch {
+			case last != nil:
+				k, v = cursor.Seek(last)
+				if bytes.Equal(k, last) {
+					k, v = cursor.Next()
+				}
+			case from != nil:
+				k, v = cursor.Seek(from)
+			default:
+				k, v = cursor.First()
+			}
+
+			n := 0
+			for ; k != nil && n < hashTreeScanBatchSize; k, v = cursor.Next() {
+				updateTime, err := storobj.LastUpdateTimeFromBinary(v)
+				if err != nil {
+					return n, true, fmt.Errorf("object %x: %w", k, err)
+				}
+				ok, err := fn(k, updateTime)
+				if err != nil || !ok {
+					return n, true, err
+				}
+				last = append(last[:0], k...)
+				n++
+			}
+			return n, k == nil, nil
+		}()
+		if err != nil || done || n == 0 {
+			return err
+		}
+	}
+}
+
+// SyncShard brings the copy of a shard on the target node up to date with
+// the copy on the source node while the shard is moved, see
+// replica.Replicator.SyncShard. It returns the number of objects which were
+// changed on the target.
+func (db *DB) SyncShard(ctx context.Context, class, shard, source, target string) (int, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return 0, fmt.Errorf("class %q not found locally", class)
+	}
+
+	height := db.config.ReplicaRepair.HashTreeHeight
+	if height == 0 {
+		height = defaultHashTreeHeight
+	}
+	stats, err := index.replicator.SyncShard(ctx, shard, source, target, height)
+	return stats.Repaired + stats.Deleted, err
+}
+
+// repairReplicas compares the local replicas of all shards of replicated
+// classes with the other replicas and repairs them
+func (db *DB) repairReplicas() {
+	cfg := db.config.ReplicaRepair
+	th := replica.NewThrottle(cfg.MaxBytesPerSecond)
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		if index.replicationEnabled() {
+			indices = append(indices, index)
+		}
+	}
+	db.indexLock.RUnlock()
+
+	for _, index := range indices {
+		index.repairReplicas(context.Background(), cfg.HashTreeHeight, th)
+	}
+}
+
+func (i *Index) repairReplicas(ctx context.Context, height int, th *replica.Throttle) {
+	shards := map[string]*Shard{}
+	i.ForEachShard(func(name string, shard *Shard) error {
+		shards[name] = shard
+		return nil
+	})
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		started := time.Now()
+		stats, err := i.replicator.RepairShard(ctx, name, height, th)
+		shards[name].metrics.ReplicaRepair(stats)
+
+		l := i.logger.WithField("action", "replica_repair").
+			WithField("class", i.Config.ClassName).
+			WithField("shard", name).
+			WithField("took", time.Since(started))
+		if err != nil {
+			l.WithError(err).Warn("repair shard replicas")
+		} else if stats.Repaired > 0 || stats.Skipped > 0 {
+			l.WithField("differing_leaves", stats.Leaves).
+				WithField("compared", stats.Compared).
+				WithField("repaired", stats.Repaired).
+				WithField("skipped", stats.Skipped).
+				WithField("bytes", stats.Bytes).
+				Info("repaired shard replicas")
+		}
+	}
+}
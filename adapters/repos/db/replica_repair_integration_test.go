@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestIndexHashTree(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "SomeClass",
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{},
+		&fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	// more objects than are read per cursor
+	now := time.Now().UnixMilli()
+	objs := make([]*models.Object, 2*hashTreeScanBatchSize+10)
+	for i := range objs {
+		objs[i] = &models.Object{
+			ID:                 strfmt.UUID(uuid.NewString()),
+			Class:              class.Class,
+			CreationTimeUnix:   now,
+			LastUpdateTimeUnix: now + int64(i),
+			Vector:             []float32{1, 2, 3},
+		}
+	}
+	batch := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		batch[i] = objects.BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID, Vector: obj.Vector}
+	}
+	_, err = repo.BatchPutObjects(ctx, batch, nil)
+	require.Nil(t, err)
+
+	height := 4
+	expected, err := replica.NewHashTree(height)
+	require.Nil(t, err)
+	for _, obj := range objs {
+		id, _ := uuid.MustParse(obj.ID.String()).MarshalBinary()
+		expected.AddObject(id, obj.LastUpdateTimeUnix)
+	}
+	expected.Build()
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	shard, err := idx.determineObjectShard(objs[0].ID, "")
+	require.Nil(t, err)
+
+	t.Run("hash tree", func(t *testing.T) {
+		root, err := idx.hashTreeLevel(ctx, shard, replica.HashTreeRequest{
+			Height: height, Level: 0, Nodes: []int{0},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []replica.Digest{expected.Root()}, root)
+
+		leaves := []int{0, 3, 15}
+		want, err := expected.Level(height, leaves)
+		require.Nil(t, err)
+		got, err := idx.hashTreeLevel(ctx, shard, replica.HashTreeRequest{
+			Height: height, Level: height, Nodes: leaves,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("digests of all leaves", func(t *testing.T) {
+		leaves := make([]int, 1<<height)
+		for i := range leaves {
+			leaves[i] = len(leaves) - 1 - i
+		}
+		res, err := idx.digestObjectsInLeaves(ctx, shard, replica.HashTreeRequest{
+			Height: height, Level: height, Nodes: leaves,
+		})
+		require.Nil(t, err)
+		require.Len(t, res, len(objs))
+
+		updateTimes := make(map[string]int64, len(objs))
+		for _, obj := range objs {
+			updateTimes[obj.ID.String()] = obj.LastUpdateTimeUnix
+		}
+		for _, x := range res {
+			assert.Equal(t, updateTimes[x.ID], x.UpdateTime)
+		}
+		assert.True(t, sort.SliceIsSorted(res, func(i, j int) bool { return res[i].ID < res[j].ID }))
+	})
+
+	t.Run("digests of one leaf", func(t *testing.T) {
+		id, _ := uuid.MustParse(objs[0].ID.String()).MarshalBinary()
+		leaf := replica.HashTreeLeaf(height, id)
+		res, err := idx.digestObjectsInLeaves(ctx, shard, replica.HashTreeRequest{
+			Height: height, Level: height, Nodes: []int{leaf},
+		})
+		require.Nil(t, err)
+		assert.NotEmpty(t, res)
+		for _, x := range res {
+			id, _ := uuid.MustParse(x.ID).MarshalBinary()
+			assert.Equal(t, leaf, replica.HashTreeLeaf(height, id))
+		}
+	})
+}
@@ -25,7 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
-	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -152,8 +152,17 @@ func (db *DB) replicatedIndex(name string) (idx *Index, resp *replica.SimpleResp
 	return
 }
 
-func (i *Index) writableShard(name string) (*Shard, *replica.SimpleResponse) {
-	localShard := i.shards.Load(name)
+// writableShard returns the local shard for a replication request. The shard
+// is released right away, it stays loaded until the request is committed as
+// it is only offloaded after being idle.
+func (i *Index) writableShard(ctx context.Context, name string) (*Shard, *replica.SimpleResponse) {
+	localShard, release, err := i.getShard(ctx, name)
+	if err != nil {
+		return nil, &replica.SimpleResponse{Errors: []replica.Error{
+			{Code: replica.StatusShardNotFound, Msg: name, Err: err},
+		}}
+	}
+	release()
 	if localShard == nil {
 		return nil, &replica.SimpleResponse{Errors: []replica.Error{
 			{Code: replica.StatusShardNotFound, Msg: name},
@@ -168,7 +177,7 @@ func (i *Index) writableShard(name string) (*Shard, *replica.SimpleResponse) {
 }
 
 func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, object *storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -176,7 +185,7 @@ func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, ob
 }
 
 func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, doc *objects.MergeDocument) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -184,7 +193,7 @@ func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, do
 }
 
 func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -192,7 +201,7 @@ func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, objects []*storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -200,7 +209,7 @@ func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, o
 }
 
 func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string, docIDs []uint64, dryRun bool) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -208,7 +217,7 @@ func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -216,27 +225,33 @@ func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string
 }
 
 func (i *Index) CommitReplication(shard, requestID string) interface{} {
-	localShard := i.shards.Load(shard)
-	if localShard == nil {
+	localShard, release, err := i.getShard(context.Background(), shard)
+	if err != nil || localShard == nil {
 		return nil
 	}
+	defer release()
 	return localShard.commit(context.Background(), requestID, &i.backupStateLock)
 }
 
 func (i *Index) AbortReplication(shard, requestID string) interface{} {
-	localShard := i.shards.Load(shard)
-	if localShard == nil {
+	localShard, release, err := i.getShard(context.Background(), shard)
+	if err != nil || localShard == nil {
 		return replica.SimpleResponse{Errors: []replica.Error{
 			{Code: replica.StatusShardNotFound, Msg: shard},
 		}}
 	}
+	defer release()
 	return localShard.abort(context.Background(), requestID)
 }
 
 func (i *Index) IncomingFilePutter(ctx context.Context, shardName,
 	filePath string,
 ) (io.WriteCloser, error) {
-	localShard := i.shards.Load(shardName)
+	localShard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if localShard == nil {
 		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -256,7 +271,11 @@ func (i *Index) IncomingCreateShard(ctx context.Context,
 func (i *Index) IncomingReinitShard(ctx context.Context,
 	shardName string,
 ) error {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
 	if shard == nil {
 		return fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -288,16 +307,16 @@ func (s *Shard) reinit(ctx context.Context) error {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
 
-	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
+	hnswUserConfig, ok := vectorindex.HnswConfig(s.index.vectorIndexUserConfig)
 	if !ok {
-		return fmt.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
+		return fmt.Errorf("vector index: unsupported config %T",
 			s.index.vectorIndexUserConfig)
 	}
 
 	if hnswUserConfig.Skip {
 		s.vectorIndex = noop.NewIndex()
 	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+		if err := s.initVectorIndex(ctx, s.index.vectorIndexUserConfig); err != nil {
 			return fmt.Errorf("init vector index: %w", err)
 		}
 
@@ -325,7 +344,11 @@ func (i *Index) overwriteObjects(ctx context.Context,
 	shard string, updates []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
 	result := make([]replica.RepairResponse, 0, len(updates)/2)
-	s := i.shards.Load(shard)
+	s, release, err := i.getShard(ctx, shard)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if s == nil {
 		return nil, fmt.Errorf("shard %q not found locally", shard)
 	}
@@ -386,7 +409,11 @@ func (i *Index) digestObjects(ctx context.Context,
 	shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
 	result = make([]replica.RepairResponse, len(ids))
-	s := i.shards.Load(shardName)
+	s, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if s == nil {
 		return nil, fmt.Errorf("shard %q not found locally", shardName)
 	}
@@ -396,7 +423,7 @@ func (i *Index) digestObjects(ctx context.Context,
 		multiIDs[j] = multi.Identifier{ID: ids[j].String()}
 	}
 
-	objs, err := s.multiObjectByID(ctx, multiIDs)
+	objs, err := s.multiObjectByID(ctx, multiIDs, additional.Properties{})
 	if err != nil {
 		return nil, fmt.Errorf("shard objects digest: %w", err)
 	}
@@ -442,7 +469,11 @@ func (db *DB) FetchObject(ctx context.Context,
 func (i *Index) readRepairGetObject(ctx context.Context,
 	shardName string, id strfmt.UUID,
 ) (objects.Replica, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return objects.Replica{}, err
+	}
+	defer release()
 	if shard == nil {
 		return objects.Replica{}, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -479,12 +510,16 @@ func (db *DB) FetchObjects(ctx context.Context,
 func (i *Index) fetchObjects(ctx context.Context,
 	shardName string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
-	shard := i.shards.Load(shardName)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if shard == nil {
 		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
 
-	objs, err := shard.multiObjectByID(ctx, wrapIDsInMulti(ids))
+	objs, err := shard.multiObjectByID(ctx, wrapIDsInMulti(ids), additional.Properties{})
 	if err != nil {
 		return nil, fmt.Errorf("shard %q replication multi get objects: %w", shard.ID(), err)
 	}
@@ -18,6 +18,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/storobj"
 
 	"github.com/pkg/errors"
@@ -28,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
 type DB struct {
@@ -43,6 +47,13 @@ type DB struct {
 	shutdown          chan struct{}
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
+	writeStallState   writeStallState
+	memoryGovernor    *memoryGovernor
+	offloadingTenants atomic.Bool
+	repairingReplicas atomic.Bool
+	replayingHints    atomic.Bool
+	promotingTenants  atomic.Bool
+	hints             *hintStore // nil if hinted handoff is disabled
 
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
@@ -71,10 +82,70 @@ type DB struct {
 	jobQueueCh          chan job
 	shutDownWg          sync.WaitGroup
 	maxNumberGoroutines int
+
+	changeRecorders      []ChangeRecorder
+	shardChangeRecorders []ChangeRecorder
+	tenantPromoter       TenantPromoter
+
+	// schemaNotifications is set if the schema getter reports changes, indices
+	// cache their schema reads then
+	schemaNotifications bool
+}
+
+// ChangeRecorder is notified about every object written or deleted through
+// this node, e.g. to ship the changes to another cluster
+type ChangeRecorder interface {
+	RecordChange(class, tenant string, id strfmt.UUID)
 }
 
 func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	db.schemaGetter = sg
+	if notifier, ok := sg.(schemaNotifier); ok {
+		notifier.Subscribe(db.OnSchemaChange)
+		db.schemaNotifications = true
+	}
+}
+
+// AddChangeRecorder registers a recorder. Recorders are added at startup,
+// before the API serves writes.
+func (db *DB) AddChangeRecorder(r ChangeRecorder) {
+	db.changeRecorders = append(db.changeRecorders, r)
+}
+
+func (db *DB) recordChange(class, tenant string, id strfmt.UUID) {
+	for _, r := range db.changeRecorders {
+		r.RecordChange(class, tenant, id)
+	}
+}
+
+// AddShardChangeRecorder registers a recorder which is notified about every
+// object written to or deleted from a local shard, whichever node
+// coordinated the write, e.g. by replication, hinted handoff or repairs.
+// Tenants whose shard was activated or dropped are recorded with an empty
+// id. Recorders are added at startup, before the indices are loaded.
+func (db *DB) AddShardChangeRecorder(r ChangeRecorder) {
+	db.shardChangeRecorders = append(db.shardChangeRecorders, r)
+}
+
+// ReadsLocally reports whether queries for the tenant of a class only read
+// shards of this node. Writes to the shards of other nodes are not recorded
+// by the shard change recorders of this node.
+func (db *DB) ReadsLocally(class, tenant string) bool {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil || index.validateMultiTenancy(tenant) != nil {
+		return false
+	}
+	shardNames, err := index.targetShardNames(tenant)
+	if err != nil || len(shardNames) == 0 {
+		return false
+	}
+	for _, name := range shardNames {
+		shard := index.shards.Load(name)
+		if shard == nil || !shard.health.allowRead() {
+			return false
+		}
+	}
+	return true
 }
 
 func (db *DB) WaitForStartup(ctx context.Context) error {
@@ -109,6 +180,7 @@ func New(logger logrus.FieldLogger, config Config,
 		jobQueueCh:          make(chan job, 100000),
 		maxNumberGoroutines: int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:   newResourceScanState(),
+		memoryGovernor:      newMemoryGovernor(),
 	}
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
@@ -135,6 +207,74 @@ type Config struct {
 	TrackVectorDimensions     bool
 	ServerVersion             string
 	GitHash                   string
+
+	// FilterCacheMaxEntries is the number of filter bitmaps cached per
+	// filterable property of a shard, 0 disables the cache
+	FilterCacheMaxEntries int
+
+	// SegmentTiering is nil if cold segments are never moved to a remote
+	// store. If SegmentTieringClasses is set, only those classes are tiered.
+	SegmentTiering        *lsmkv.Tiering
+	SegmentTieringClasses []string
+
+	// DistanceMetrics resolves custom distance metrics provided by modules,
+	// nil if there are none
+	DistanceMetrics DistanceMetricProvider
+
+	// AsyncIndexing queues vectors on disk, so imports don't wait for the
+	// vector index. AsyncIndexingWorkers insert them per shard.
+	AsyncIndexing        bool
+	AsyncIndexingWorkers int
+
+	// TenantOffloading unloads idle tenants of multi-tenant classes
+	TenantOffloading TenantOffloading
+
+	// ReplicaRepair repairs diverged replicas in the background
+	ReplicaRepair ReplicaRepair
+
+	// HintedHandoff replays writes missed by unreachable replicas
+	HintedHandoff HintedHandoff
+
+	// KeyManager provides the keys the files of the shards and the hints
+	// of their writes are encrypted with, nil if they are stored
+	// unencrypted. With PerTenantKeys each tenant is encrypted with its own
+	// key.
+	KeyManager    modulecapabilities.KeyManager
+	PerTenantKeys bool
+
+	// QueryPlanner chooses the strategy of filtered vector searches, nil
+	// leaves it to the flat search cutoff of the vector index
+	QueryPlanner *traverser.QueryPlanner
+
+	// PropertyStats keeps statistics of the keys of every filterable
+	// property index, see [DB.PropertyStats]
+	PropertyStats bool
+
+	// ShardCircuitBreaker marks shards with failing or slow reads and writes
+	// as degraded, see [shardHealth]
+	ShardCircuitBreaker config.ShardCircuitBreaker
+}
+
+// DistanceMetricProvider returns the custom distance metric with the given
+// name, typically provided by a module
+type DistanceMetricProvider interface {
+	DistanceMetric(name string) (modulecapabilities.DistanceMetric, bool)
+}
+
+// segmentTieringFor returns the tiering to use for the class, nil if its
+// segments should stay local
+func (c Config) segmentTieringFor(className string) *lsmkv.Tiering {
+	if c.SegmentTiering == nil || len(c.SegmentTieringClasses) == 0 {
+		return c.SegmentTiering
+	}
+
+	for _, class := range c.SegmentTieringClasses {
+		if class == className {
+			return c.SegmentTiering
+		}
+	}
+
+	return nil
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -145,6 +285,13 @@ func (db *DB) GetIndex(className schema.ClassName) *Index {
 	id := indexID(className)
 	index, ok := db.indices[id]
 	if !ok {
+		// references stored before a class was renamed still carry the
+		// original class name
+		for _, index := range db.indices {
+			if index.renamed() && index.storageID() == id {
+				return index
+			}
+		}
 		return nil
 	}
 
@@ -217,6 +364,12 @@ func (db *DB) Shutdown(ctx context.Context) error {
 
 	db.shutDownWg.Wait() // wait until job queue shutdown is completed
 
+	if db.hints != nil {
+		if err := db.hints.shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown hints store")
+		}
+	}
+
 	return nil
 }
 
@@ -50,10 +50,74 @@ func (d *DB) scanResourceUsage() {
 	go func() {
 		t := time.NewTicker(time.Second * 30)
 		defer t.Stop()
+		stallTicker := time.NewTicker(writeStallScanInterval)
+		defer stallTicker.Stop()
+		offloadTicker := time.NewTicker(tenantOffloadScanInterval)
+		defer offloadTicker.Stop()
+		// a nil channel never fires if the replica repair is disabled
+		var repairC <-chan time.Time
+		if d.config.ReplicaRepair.Interval > 0 {
+			repairTicker := time.NewTicker(d.config.ReplicaRepair.Interval)
+			defer repairTicker.Stop()
+			repairC = repairTicker.C
+		}
+		var diskWatchdogC <-chan time.Time
+		if d.config.ResourceUsage.DiskWatchdog.Enabled {
+			diskWatchdogTicker := time.NewTicker(d.diskWatchdogInterval())
+			defer diskWatchdogTicker.Stop()
+			diskWatchdogC = diskWatchdogTicker.C
+		}
+		var memoryGovernorC <-chan time.Time
+		if d.config.ResourceUsage.MemoryGovernor.Enabled {
+			memoryGovernorTicker := time.NewTicker(time.Duration(
+				d.config.ResourceUsage.MemoryGovernor.IntervalSeconds) * time.Second)
+			defer memoryGovernorTicker.Stop()
+			memoryGovernorC = memoryGovernorTicker.C
+		}
+		var hintsC <-chan time.Time
+		if d.hints != nil {
+			hintsTicker := time.NewTicker(d.config.HintedHandoff.ReplayInterval)
+			defer hintsTicker.Stop()
+			hintsC = hintsTicker.C
+		}
 		for {
 			select {
 			case <-d.shutdown:
 				return
+			case <-stallTicker.C:
+				d.scanWriteStalls()
+			case <-diskWatchdogC:
+				d.watchDiskSpace()
+			case <-memoryGovernorC:
+				d.governMemory()
+			case <-offloadTicker.C:
+				// offloading can take long, it must not delay the other scans
+				if d.offloadingTenants.CompareAndSwap(false, true) {
+					go func() {
+						defer d.offloadingTenants.Store(false)
+						d.offloadIdleTenants()
+					}()
+				}
+				if d.promotingTenants.CompareAndSwap(false, true) {
+					go func() {
+						defer d.promotingTenants.Store(false)
+						d.promoteLargeTenants()
+					}()
+				}
+			case <-repairC:
+				if d.repairingReplicas.CompareAndSwap(false, true) {
+					go func() {
+						defer d.repairingReplicas.Store(false)
+						d.repairReplicas()
+					}()
+				}
+			case <-hintsC:
+				if d.replayingHints.CompareAndSwap(false, true) {
+					go func() {
+						defer d.replayingHints.Store(false)
+						d.replayHints()
+					}()
+				}
 			case <-t.C:
 				if !d.resourceScanState.isReadOnly {
 					du := d.getDiskUse(d.config.RootPath)
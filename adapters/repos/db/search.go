@@ -82,6 +82,7 @@ func (db *DB) SparseObjectSearch(ctx context.Context,
 func (db *DB) Search(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
+	defer db.memoryGovernor.trackQuery()()
 	res, _, err := db.SparseObjectSearch(ctx, params)
 	if err != nil {
 		return nil, err
@@ -98,6 +99,7 @@ func (db *DB) VectorSearch(ctx context.Context,
 	if params.SearchVector == nil {
 		return db.Search(ctx, params)
 	}
+	defer db.memoryGovernor.trackQuery()()
 
 	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
 	if err != nil {
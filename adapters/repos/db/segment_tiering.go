@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// SegmentTieringStatus returns the tiering status of every bucket of the
+// local shards of a class, keyed by shard and bucket name
+func (db *DB) SegmentTieringStatus(className string) (map[string]map[string]lsmkv.SegmentTieringStatus, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	out := map[string]map[string]lsmkv.SegmentTieringStatus{}
+	idx.ForEachShard(func(name string, shard *Shard) error {
+		out[name] = shard.store.SegmentTieringStatus()
+		return nil
+	})
+
+	return out, nil
+}
+
+// SetSegmentsPinned pins or unpins the segments of the local shards of a
+// class. Pinned segments are restored from the remote store and never tiered
+// until they are unpinned again.
+func (db *DB) SetSegmentsPinned(ctx context.Context, className string, pinned bool) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	if idx.Config.SegmentTiering == nil {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("segment tiering is not enabled for class %q", className))
+	}
+
+	return idx.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.store.SetSegmentsPinned(ctx, pinned); err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		return nil
+	})
+}
@@ -31,10 +31,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
@@ -57,10 +61,13 @@ type Shard struct {
 	deletedDocIDs   *docid.InMemDeletedTracker
 	propLengths     *inverted.JsonPropertyLengthTracker
 	versioner       *shardVersioner
+	usage           *shardUsage
 
 	status              storagestate.Status
 	statusLock          sync.Mutex
+	writeFence          string // why the disk watchdog set the shard READONLY
 	propertyIndicesLock sync.RWMutex
+	dedupLock           sync.Mutex
 	stopMetrics         chan struct{}
 
 	centralJobQueue chan job // reference to queue used by all shards
@@ -68,6 +75,7 @@ type Shard struct {
 	docIdLock []sync.Mutex
 	// replication
 	replicationMap pendingReplicaTasks
+	hashTrees      hashTreeCache
 
 	// Indicates whether searchable buckets should be used
 	// when filterable buckets are missing for text/text[] properties
@@ -80,8 +88,23 @@ type Shard struct {
 	// being enabled, only searchable bucket exists
 	fallbackToSearchable bool
 
-	vectorCycles   *hnsw.MaintenanceCycles
-	geoPropsCycles *hnsw.MaintenanceCycles
+	vectorCycles *hnsw.MaintenanceCycles
+
+	// spelling dictionaries of the searchable properties, built on first
+	// use, see spellingDictionary
+	spellingDictionaries sync.Map
+
+	// health tracks the error rate and latency of the reads and writes, nil
+	// if the circuit breakers are disabled
+	health *shardHealth
+
+	// nil unless the files of the shard are encrypted, see initEncryption
+	encryption *diskio.Encryption
+
+	// held while renamed or dropped properties are migrated, see
+	// migrateProperties
+	propertyMigrationLock   sync.Mutex
+	cancelPropertyMigration context.CancelFunc
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -100,26 +123,34 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		replicationMap:  pendingReplicaTasks{Tasks: make(map[string]replicaTask, 32)},
 		centralJobQueue: jobQueueCh,
 		vectorCycles:    &hnsw.MaintenanceCycles{},
-		geoPropsCycles:  &hnsw.MaintenanceCycles{},
+		health:          newShardHealth(index.Config.ShardCircuitBreaker),
 	}
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
+	if err := s.initEncryption(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
+	hnswUserConfig, ok := vectorindex.HnswConfig(index.vectorIndexUserConfig)
 	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
+		return nil, errors.Errorf("vector index: unsupported config %T",
 			index.vectorIndexUserConfig)
 	}
 
 	if hnswUserConfig.Skip {
 		s.vectorIndex = noop.NewIndex()
 	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+		if err := s.initVectorIndex(ctx, index.vectorIndexUserConfig); err != nil {
 			return nil, fmt.Errorf("init vector index: %w", err)
 		}
 
+		if err := s.initIndexQueue(); err != nil {
+			return nil, fmt.Errorf("init index queue: %w", err)
+		}
+
 		defer s.vectorIndex.PostStartup()
 	}
 
@@ -127,12 +158,16 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	s.startPropertyMigration()
+
 	return s, nil
 }
 
 func (s *Shard) initVectorIndex(
-	ctx context.Context, hnswUserConfig hnswent.UserConfig,
+	ctx context.Context, cfg schema.VectorIndexConfig,
 ) error {
+	hnswUserConfig, _ := vectorindex.HnswConfig(cfg)
+
 	var distProv distancer.Provider
 
 	switch hnswUserConfig.Distance {
@@ -147,8 +182,11 @@ func (s *Shard) initVectorIndex(
 	case hnswent.DistanceHamming:
 		distProv = distancer.NewHammingProvider()
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+		custom, err := s.customDistanceProvider(hnswUserConfig)
+		if err != nil {
+			return err
+		}
+		distProv = custom
 	}
 
 	s.vectorCycles.Init(
@@ -171,6 +209,29 @@ func (s *Shard) initVectorIndex(
 		cyclemanager.HnswCommitLoggerCycleTicker(),
 		cyclemanager.NewFixedIntervalTicker(time.Duration(hnswUserConfig.CleanupIntervalSeconds)*time.Second))
 
+	if dynamicUserConfig, ok := cfg.(dynamicent.UserConfig); ok {
+		vi, err := newDynamicIndex(s, dynamicUserConfig, distProv, func() (VectorIndex, error) {
+			return s.newHnswIndex(hnswUserConfig, distProv)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
+		}
+		s.vectorIndex = vi
+		return nil
+	}
+
+	vi, err := s.newHnswIndex(hnswUserConfig, distProv)
+	if err != nil {
+		return err
+	}
+	s.vectorIndex = vi
+
+	return nil
+}
+
+func (s *Shard) newHnswIndex(hnswUserConfig hnswent.UserConfig,
+	distProv distancer.Provider,
+) (VectorIndex, error) {
 	vi, err := hnsw.New(hnsw.Config{
 		Logger:               s.index.logger,
 		RootPath:             s.index.Config.RootPath,
@@ -181,16 +242,40 @@ func (s *Shard) initVectorIndex(
 		VectorForIDThunk:     s.vectorByIndexID,
 		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
 		DistanceProvider:     distProv,
+		Encryption:           s.encryption,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger, s.vectorCycles.CommitLogMaintenance())
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger, s.vectorCycles.CommitLogMaintenance(),
+				hnsw.WithCommitlogEncryption(s.encryption))
 		},
 	}, hnswUserConfig, s.vectorCycles.TombstoneCleanup())
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 	}
-	s.vectorIndex = vi
 
-	return nil
+	return vi, nil
+}
+
+// customDistanceProvider resolves a distance metric which is not builtin
+// using the modules
+func (s *Shard) customDistanceProvider(
+	hnswUserConfig hnswent.UserConfig,
+) (distancer.Provider, error) {
+	var metric modulecapabilities.DistanceMetric
+	if s.index.Config.DistanceMetrics != nil {
+		metric, _ = s.index.Config.DistanceMetrics.DistanceMetric(hnswUserConfig.Distance)
+	}
+	if metric == nil {
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"] "+
+			"or a metric provided by a module", hnswUserConfig.Distance)
+	}
+
+	fn, err := metric.DistanceFn(hnswUserConfig.DistanceParams)
+	if err != nil {
+		return nil, errors.Wrapf(err, "distance metric %q", hnswUserConfig.Distance)
+	}
+
+	return distancer.NewCustomProvider(hnswUserConfig.Distance, fn), nil
 }
 
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
@@ -214,12 +299,20 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	s.versioner = versioner
 
 	plPath := path.Join(s.index.Config.RootPath, s.ID()+".proplengths")
-	propLengths, err := inverted.NewJsonPropertyLengthTracker(plPath, s.index.logger)
+	propLengths, err := inverted.NewJsonPropertyLengthTracker(plPath, s.encryption, s.index.logger)
 	if err != nil {
 		return errors.Wrapf(err, "init shard %q: prop length tracker", s.ID())
 	}
 	s.propLengths = propLengths
 
+	if err := s.loadPropertyMigrations(ctx); err != nil {
+		return errors.Wrapf(err, "init shard %q: migrate properties", s.ID())
+	}
+
+	if err := s.initUsage(ctx); err != nil {
+		return errors.Wrapf(err, "init shard %q: usage", s.ID())
+	}
+
 	if err := s.initProperties(class); err != nil {
 		return errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
@@ -230,7 +323,7 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 }
 
 func (s *Shard) ID() string {
-	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
+	return fmt.Sprintf("%s_%s", s.index.storageID(), s.name)
 }
 
 func (s *Shard) DBPathLSM() string {
@@ -258,6 +351,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
+	store.SetEncryption(s.encryption)
 
 	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
@@ -265,6 +359,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 		lsmkv.WithMonitorCount(),
 		s.dynamicMemtableSizing(),
 		s.memtableIdleConfig(),
+		s.segmentTiering(),
 	)
 	if err != nil {
 		return errors.Wrap(err, "create objects bucket")
@@ -276,6 +371,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 }
 
 func (s *Shard) drop() error {
+	s.stopPropertyMigration()
 	s.replicationMap.clear()
 
 	if s.index.Config.TrackVectorDimensions {
@@ -294,10 +390,8 @@ func (s *Shard) drop() error {
 	if err := s.vectorCycles.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shutdown vector cycles")
 	}
-	if err := s.geoPropsCycles.Shutdown(ctx); err != nil {
-		return errors.Wrap(err, "shutdown geo props cycles")
-	}
 
+	s.store.DropRemoteSegments()
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -325,6 +419,8 @@ func (s *Shard) drop() error {
 		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
 	}
 
+	s.usage.dropMetrics()
+
 	// delete indexcount
 	err = s.propLengths.Drop()
 	if err != nil {
@@ -414,7 +510,19 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 	)
 }
 
+func (s *Shard) segmentTiering() lsmkv.BucketOption {
+	return lsmkv.WithSegmentTiering(s.index.Config.SegmentTiering)
+}
+
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		// nested properties are indexed by the dotted paths of their leaves
+		for _, leaf := range schema.FlattenNestedProperties([]*models.Property{prop}) {
+			s.createPropertyIndex(ctx, leaf, eg)
+		}
+		return
+	}
+
 	if !inverted.HasInvertedIndex(prop) {
 		return
 	}
@@ -454,11 +562,12 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 	bucketOpts := []lsmkv.BucketOption{
 		s.memtableIdleConfig(),
 		s.dynamicMemtableSizing(),
+		s.segmentTiering(),
 	}
 
 	if inverted.HasFilterableIndex(prop) {
 		if dt, _ := schema.AsPrimitive(prop.DataType); dt == schema.DataTypeGeoCoordinates {
-			return s.initGeoProp(prop)
+			return s.initGeoProp(ctx, prop, bucketOpts)
 		}
 
 		if schema.IsRefDataType(prop.DataType) {
@@ -470,9 +579,19 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 			}
 		}
 
+		filterableBucketOpts := append(bucketOpts,
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+			lsmkv.WithFilterCache(s.index.Config.FilterCacheMaxEntries))
+		if decode := numericKeyDecoder(prop); decode != nil {
+			filterableBucketOpts = append(filterableBucketOpts, lsmkv.WithNumericStats(decode))
+		}
+		if s.index.Config.PropertyStats {
+			filterableBucketOpts = append(filterableBucketOpts, lsmkv.WithKeyStats())
+		}
+
 		if err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketFromPropNameLSM(prop.Name),
-			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))...,
+			filterableBucketOpts...,
 		); err != nil {
 			return err
 		}
@@ -495,6 +614,23 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 	return nil
 }
 
+// numericKeyDecoder returns the decoder for the filterable index of scalar
+// numeric props, so their segments keep stats for unfiltered aggregations.
+// It returns nil for any other prop.
+func numericKeyDecoder(prop *models.Property) lsmkv.NumericKeyDecoder {
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeInt:
+		return func(key []byte) (float64, error) {
+			v, err := inverted.ParseLexicographicallySortableInt64(key)
+			return float64(v), err
+		}
+	case schema.DataTypeNumber:
+		return inverted.ParseLexicographicallySortableFloat64
+	default:
+		return nil
+	}
+}
+
 func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Property) error {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -540,6 +676,8 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 }
 
 func (s *Shard) shutdown(ctx context.Context) error {
+	s.stopPropertyMigration()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -566,9 +704,6 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	if err := s.vectorCycles.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shutdown vector cycles")
 	}
-	if err := s.geoPropsCycles.Shutdown(ctx); err != nil {
-		return errors.Wrap(err, "shutdown geo props cycles")
-	}
 
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
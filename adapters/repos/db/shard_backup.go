@@ -13,7 +13,10 @@ package db
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
 	"path"
 
@@ -61,9 +64,37 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 	ret.Files = append(ret.Files, files2...)
+	return s.checksumBackupFiles(ret)
+}
+
+// checksumBackupFiles records the checksums of the files of a backup, so
+// that incremental backups can skip unchanged files
+func (s *Shard) checksumBackupFiles(ret *backup.ShardDescriptor) error {
+	ret.Checksums = make(map[string]string, len(ret.Files))
+	for _, fpath := range ret.Files {
+		sum, err := fileChecksum(path.Join(s.index.Config.RootPath, fpath))
+		if err != nil {
+			return fmt.Errorf("checksum %s: %w", fpath, err)
+		}
+		ret.Checksums[fpath] = sum
+	}
 	return nil
 }
 
+func fileChecksum(fpath string) (string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	var g errgroup.Group
 
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// encryptionKeyID returns the id of the key the objects of a shard are
+// encrypted with. The class name the files were created under is used, so
+// renaming a class keeps its keys. With per-tenant keys each tenant has its
+// own key, except for the tenants of shared shards which use the class key.
+func (i *Index) encryptionKeyID(shardName string) string {
+	if i.hasPerTenantKeys(shardName) {
+		return i.classKeyID() + "/" + shardName
+	}
+	return i.classKeyID()
+}
+
+func (i *Index) classKeyID() string {
+	if i.storageName == "" {
+		return i.Config.ClassName.String()
+	}
+	return i.storageName
+}
+
+func (i *Index) hasPerTenantKeys(shardName string) bool {
+	return i.Config.PerTenantKeys && i.partitioningEnabled &&
+		!sharding.IsSharedShardName(shardName)
+}
+
+// encryption returns the encryption of the shard, nil if encryption at rest
+// is disabled
+func (i *Index) encryption(ctx context.Context, shardName string) (*diskio.Encryption, error) {
+	keys := i.Config.KeyManager
+	if keys == nil {
+		return nil, nil
+	}
+
+	key, err := keys.DataKey(ctx, i.encryptionKeyID(shardName))
+	if err != nil {
+		return nil, errors.Wrap(err, "get encryption key")
+	}
+	enc, err := diskio.NewEncryption(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "init encryption")
+	}
+	return enc, nil
+}
+
+// TenantEncryption returns the encryption of the shard of tenant, or of the
+// class if tenant is empty. It is nil if encryption at rest is disabled.
+func (db *DB) TenantEncryption(ctx context.Context, class, tenant string) (*diskio.Encryption, error) {
+	if db.config.KeyManager == nil {
+		return nil, nil
+	}
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found", class)
+	}
+
+	shard := ""
+	if tenant != "" {
+		if shard = index.getSchema.TenantShard(class, tenant); shard == "" {
+			return nil, fmt.Errorf("%w: %q", errTenantNotFound, tenant)
+		}
+	}
+	return index.encryption(ctx, shard)
+}
+
+// initEncryption loads the key of the shard. All files of the shard are
+// encrypted with it: the buckets, including those of the inverted index, the
+// vector index with its commit logs and queue, and the property lengths.
+func (s *Shard) initEncryption(ctx context.Context) error {
+	enc, err := s.index.encryption(ctx, s.name)
+	if err != nil {
+		return err
+	}
+	s.encryption = enc
+	return nil
+}
+
+// deleteEncryptionKeys deletes the keys of dropped tenants. Copies of their
+// shards, such as backups, can no longer be read afterwards. If the class is
+// dropped, its own key is deleted as well.
+func (i *Index) deleteEncryptionKeys(ctx context.Context, shardNames []string, dropClass bool) {
+	keys := i.Config.KeyManager
+	if keys == nil {
+		return
+	}
+
+	var ids []string
+	for _, name := range shardNames {
+		if i.hasPerTenantKeys(name) {
+			ids = append(ids, i.encryptionKeyID(name))
+		}
+	}
+	if dropClass {
+		ids = append(ids, i.classKeyID())
+	}
+
+	for _, id := range ids {
+		if err := keys.DeleteKey(ctx, id); err != nil {
+			i.logger.WithField("action", "delete_encryption_key").
+				WithField("key", id).WithError(err).
+				Error("could not delete encryption key, the data it encrypted can still be read")
+		}
+	}
+}
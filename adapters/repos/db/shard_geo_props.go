@@ -14,35 +14,49 @@ package db
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/geo"
-	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
-	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
-func (s *Shard) initGeoProp(prop *models.Property) error {
-	s.geoPropsCycles.Init(
-		cyclemanager.GeoCommitLoggerCycleTicker(),
-		cyclemanager.NewFixedIntervalTicker(hnsw.DefaultCleanupIntervalSeconds*time.Second))
+func (s *Shard) initGeoProp(ctx context.Context, prop *models.Property,
+	bucketOpts []lsmkv.BucketOption,
+) error {
+	if err := s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketGeoCellsFromPropNameLSM(prop.Name),
+		append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))...,
+	); err != nil {
+		return errors.Wrapf(err, "create geo cells bucket for prop %q", prop.Name)
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketGeoCoordinatesFromPropNameLSM(prop.Name),
+		append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyReplace))...,
+	); err != nil {
+		return errors.Wrapf(err, "create geo coordinates bucket for prop %q", prop.Name)
+	}
 
 	idx, err := geo.NewIndex(geo.Config{
-		ID:                 geoPropID(s.ID(), prop.Name),
-		RootPath:           s.index.Config.RootPath,
-		CoordinatesForID:   s.makeCoordinatesForID(prop.Name),
-		DisablePersistence: false,
-		Logger:             s.index.logger,
-	}, s.geoPropsCycles.TombstoneCleanup(), s.geoPropsCycles.CommitLogMaintenance())
+		Cells:       s.store.Bucket(helpers.BucketGeoCellsFromPropNameLSM(prop.Name)),
+		Coordinates: s.store.Bucket(helpers.BucketGeoCoordinatesFromPropNameLSM(prop.Name)),
+	})
 	if err != nil {
 		return errors.Wrapf(err, "create geo index for prop %q", prop.Name)
 	}
 
+	if err := s.migrateLegacyGeoIndex(prop.Name, idx); err != nil {
+		return errors.Wrapf(err, "migrate geo index for prop %q", prop.Name)
+	}
+
 	s.propertyIndicesLock.Lock()
 	s.propertyIndices[prop.Name] = propertyspecific.Index{
 		Type:     schema.DataTypeGeoCoordinates,
@@ -51,37 +65,59 @@ func (s *Shard) initGeoProp(prop *models.Property) error {
 	}
 	s.propertyIndicesLock.Unlock()
 
-	idx.PostStartup()
-
 	return nil
 }
 
-func (s *Shard) makeCoordinatesForID(propName string) geo.CoordinatesForID {
-	return func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
-		obj, err := s.objectByIndexID(ctx, id, true)
+// migrateLegacyGeoIndex rebuilds the index from the objects bucket if the
+// shard still contains the files of the previous hnsw-based geo index. The
+// files are removed once the index is complete, so an interrupted migration
+// is restarted on the next startup.
+func (s *Shard) migrateLegacyGeoIndex(propName string, idx *geo.Index) error {
+	legacyFiles, err := filepath.Glob(filepath.Join(s.index.Config.RootPath,
+		geoPropID(s.ID(), propName)+".*"))
+	if err != nil {
+		return err
+	}
+	if len(legacyFiles) == 0 {
+		return nil
+	}
+
+	s.index.logger.WithField("action", "geo_index_migration").
+		WithField("shard", s.ID()).
+		WithField("property", propName).
+		Info("rebuilding geo index from objects")
+
+	c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		obj, err := storobj.FromBinary(v)
 		if err != nil {
-			return nil, storobj.NewErrNotFoundf(id, "retrieve object")
+			return errors.Wrapf(err, "unmarshal object %x", k)
 		}
 
-		if obj.Properties() == nil {
-			return nil, storobj.NewErrNotFoundf(id,
-				"object has no properties")
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok {
+			continue
 		}
 
-		prop, ok := obj.Properties().(map[string]interface{})[propName]
+		coordinates, ok := props[propName].(*models.GeoCoordinates)
 		if !ok {
-			return nil, storobj.NewErrNotFoundf(id,
-				"object has no property %q", propName)
+			continue
 		}
 
-		geoProp, ok := prop.(*models.GeoCoordinates)
-		if !ok {
-			return nil, fmt.Errorf("expected property to be of type %T, got: %T",
-				&models.GeoCoordinates{}, prop)
+		if err := idx.Add(obj.DocID(), coordinates); err != nil {
+			return errors.Wrapf(err, "add doc id %d", obj.DocID())
 		}
+	}
 
-		return geoProp, nil
+	for _, file := range legacyFiles {
+		if err := os.RemoveAll(file); err != nil {
+			return errors.Wrap(err, "remove legacy geo index")
+		}
 	}
+
+	return nil
 }
 
 func geoPropID(shardID string, propName string) string {
@@ -107,6 +143,19 @@ func (s *Shard) updatePropertySpecificIndices(object *storobj.Object,
 	return nil
 }
 
+func (s *Shard) deleteFromPropertySpecificIndices(docID uint64) error {
+	s.propertyIndicesLock.RLock()
+	defer s.propertyIndicesLock.RUnlock()
+
+	for propName, propIndex := range s.propertyIndices {
+		if err := s.deleteFromGeoIndex(propIndex, docID); err != nil {
+			return errors.Wrapf(err, "property %q", propName)
+		}
+	}
+
+	return nil
+}
+
 func (s *Shard) updatePropertySpecificIndex(propName string,
 	index propertyspecific.Index, obj *storobj.Object,
 	status objectInsertStatus,
@@ -142,13 +191,13 @@ func (s *Shard) addToGeoIndex(propName string, index propertyspecific.Index,
 		return storagestate.ErrStatusReadOnly
 	}
 
-	if obj.Properties() == nil {
-		return nil
-	}
-
-	asMap := obj.Properties().(map[string]interface{})
+	asMap, _ := obj.Properties().(map[string]interface{})
 	propValue, ok := asMap[propName]
 	if !ok {
+		if !status.docIDChanged {
+			// an update in place could have removed the property
+			return s.deleteFromGeoIndex(index, status.docID)
+		}
 		return nil
 	}
 
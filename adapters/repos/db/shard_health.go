@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// ShardHealthHealthy is reported for shards whose circuit breakers are
+	// closed
+	ShardHealthHealthy = models.NodeShardStatusHealthHEALTHY
+	// ShardHealthDegraded is reported for shards with an open read or write
+	// circuit breaker
+	ShardHealthDegraded = models.NodeShardStatusHealthDEGRADED
+)
+
+var errShardDegraded = errors.New("shard is degraded")
+
+// circuitBreaker tracks the outcome of the requests to a shard within a
+// sliding window. It opens once the error rate or the mean latency of the
+// window exceed their thresholds, and lets a single probe request pass after
+// the cooldown to find out whether the shard recovered.
+type circuitBreaker struct {
+	sync.Mutex
+	cfg config.ShardCircuitBreaker
+	now func() time.Time
+
+	windowStart time.Time
+	requests    int
+	failures    int
+	latency     time.Duration
+
+	open     bool
+	openedAt time.Time
+	probedAt time.Time // zero unless a probe is in flight
+	reason   string
+}
+
+func newCircuitBreaker(cfg config.ShardCircuitBreaker) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg, now: time.Now}
+}
+
+// allow reports whether a request may be sent to the shard
+func (b *circuitBreaker) allow() bool {
+	b.Lock()
+	defer b.Unlock()
+
+	if !b.open {
+		return true
+	}
+	// a probe which never reported back doesn't keep the breaker open forever
+	if b.now().Sub(b.openedAt) < b.cooldown() ||
+		(!b.probedAt.IsZero() && b.now().Sub(b.probedAt) < b.cooldown()) {
+		return false
+	}
+	b.probedAt = b.now()
+	return true
+}
+
+// observe records the outcome of a request which started at start
+func (b *circuitBreaker) observe(start time.Time, err error) {
+	if err != nil && isDiskFull(err) {
+		b.trip("no space left on device")
+		return
+	}
+	failed := err != nil && !ignoredShardError(err)
+	took := b.now().Sub(start)
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.open {
+		if b.probedAt.IsZero() {
+			return
+		}
+		b.probedAt = time.Time{}
+		if failed || b.slow(took) {
+			b.openedAt = b.now()
+			return
+		}
+		b.open = false
+		b.reason = ""
+		b.reset()
+		return
+	}
+
+	if b.now().Sub(b.windowStart) > b.window() {
+		b.reset()
+	}
+	b.requests++
+	b.latency += took
+	if failed {
+		b.failures++
+	}
+	if b.requests < b.cfg.MinRequests {
+		return
+	}
+
+	if rate := float64(b.failures) / float64(b.requests); rate >= b.cfg.ErrorRateThreshold {
+		b.openLocked(fmt.Sprintf("error rate %.2f of the last %d requests", rate, b.requests))
+	} else if mean := b.latency / time.Duration(b.requests); b.slow(mean) {
+		b.openLocked(fmt.Sprintf("mean latency %s of the last %d requests", mean, b.requests))
+	}
+}
+
+// trip opens the breaker regardless of the window
+func (b *circuitBreaker) trip(reason string) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.probedAt = time.Time{}
+	b.openLocked(reason)
+}
+
+func (b *circuitBreaker) openLocked(reason string) {
+	b.open = true
+	b.openedAt = b.now()
+	b.reason = reason
+	b.reset()
+}
+
+func (b *circuitBreaker) state() (open bool, reason string) {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.open, b.reason
+}
+
+func (b *circuitBreaker) reset() {
+	b.windowStart = b.now()
+	b.requests = 0
+	b.failures = 0
+	b.latency = 0
+}
+
+func (b *circuitBreaker) slow(took time.Duration) bool {
+	return b.cfg.LatencyThresholdMilliseconds > 0 &&
+		took > time.Duration(b.cfg.LatencyThresholdMilliseconds)*time.Millisecond
+}
+
+func (b *circuitBreaker) window() time.Duration {
+	return time.Duration(b.cfg.WindowSeconds) * time.Second
+}
+
+func (b *circuitBreaker) cooldown() time.Duration {
+	return time.Duration(b.cfg.CooldownSeconds) * time.Second
+}
+
+// shardHealth holds separate circuit breakers for the reads and the writes
+// of a shard, so that a full disk doesn't stop the shard from being queried.
+// A nil shardHealth is valid and always healthy, it is used if the circuit
+// breakers are disabled.
+type shardHealth struct {
+	reads  *circuitBreaker
+	writes *circuitBreaker
+}
+
+func newShardHealth(cfg config.ShardCircuitBreaker) *shardHealth {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &shardHealth{
+		reads:  newCircuitBreaker(cfg),
+		writes: newCircuitBreaker(cfg),
+	}
+}
+
+func (h *shardHealth) allowRead() bool {
+	if h == nil {
+		return true
+	}
+	return h.reads.allow()
+}
+
+func (h *shardHealth) observeRead(start time.Time, err error) {
+	if h == nil {
+		return
+	}
+	h.reads.observe(start, err)
+}
+
+// beginWrite returns an error if writes to the shard are currently rejected
+func (h *shardHealth) beginWrite() error {
+	if h == nil || h.writes.allow() {
+		return nil
+	}
+	_, reason := h.writes.state()
+	return fmt.Errorf("%w: writes rejected: %s", errShardDegraded, reason)
+}
+
+// endWrite records the outcome of a write, it is meant to be deferred with
+// a pointer to the named error return of the write
+func (h *shardHealth) endWrite(start time.Time, err *error) {
+	if h == nil {
+		return
+	}
+	h.writes.observe(start, *err)
+}
+
+// endWriteBatch records a batch as a single write. Errors of the
+// individual objects are mostly caused by the objects themselves, so only a
+// full disk fails the batch.
+func (h *shardHealth) endWriteBatch(start time.Time, errs []error) {
+	if h == nil {
+		return
+	}
+	var err error
+	for _, e := range errs {
+		if e != nil && isDiskFull(e) {
+			err = e
+			break
+		}
+	}
+	h.writes.observe(start, err)
+}
+
+// status returns the health state of the shard and why it is degraded
+func (h *shardHealth) status() (string, string) {
+	if h == nil {
+		return ShardHealthHealthy, ""
+	}
+	var reasons []string
+	if open, reason := h.reads.state(); open {
+		reasons = append(reasons, "reads: "+reason)
+	}
+	if open, reason := h.writes.state(); open {
+		reasons = append(reasons, "writes: "+reason)
+	}
+	if len(reasons) == 0 {
+		return ShardHealthHealthy, ""
+	}
+	return ShardHealthDegraded, strings.Join(reasons, "; ")
+}
+
+func isDiskFull(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) ||
+		strings.Contains(err.Error(), "no space left on device")
+}
+
+// ignoredShardError reports errors which don't say anything about the
+// health of the shard
+func ignoredShardError(err error) bool {
+	return errors.Is(err, context.Canceled) ||
+		errors.Is(err, storagestate.ErrStatusReadOnly) ||
+		errors.Is(err, errShardDegraded)
+}
+
+// degradedShardReplica is consulted before the local replica of a shard is
+// read. If its read circuit breaker is open it returns another node holding
+// a replica of the shard, or fails fast if there is none, rather than letting
+// the query wait for the sick shard. An empty name means the shard can be
+// served as usual.
+func (i *Index) degradedShardReplica(shardName string) (string, error) {
+	shard := i.shards.Load(shardName)
+	if shard == nil || shard.health.allowRead() {
+		return "", nil
+	}
+	_, reason := shard.health.reads.state()
+
+	if i.replicationEnabled() {
+		local := i.getSchema.NodeName()
+		if state := i.getSchema.CopyShardingState(i.Config.ClassName.String()); state != nil {
+			for _, node := range state.Physical[shardName].BelongsToNodes {
+				if node != local {
+					return node, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %s", errShardDegraded, reason)
+}
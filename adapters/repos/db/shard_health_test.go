@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestShardHealth(t *testing.T) {
+	cfg := config.ShardCircuitBreaker{
+		Enabled:                      true,
+		ErrorRateThreshold:           0.5,
+		LatencyThresholdMilliseconds: 100,
+		MinRequests:                  4,
+		WindowSeconds:                10,
+		CooldownSeconds:              5,
+	}
+	now := time.Now()
+	newHealth := func() *shardHealth {
+		h := newShardHealth(cfg)
+		h.reads.now = func() time.Time { return now }
+		h.writes.now = func() time.Time { return now }
+		return h
+	}
+	failure := errors.New("read failed")
+
+	t.Run("disabled", func(t *testing.T) {
+		h := newShardHealth(config.ShardCircuitBreaker{})
+		require.Nil(t, h)
+
+		h.observeRead(now, failure)
+		assert.True(t, h.allowRead())
+		assert.Nil(t, h.beginWrite())
+		state, _ := h.status()
+		assert.Equal(t, ShardHealthHealthy, state)
+	})
+
+	t.Run("trips on error rate", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 3; i++ {
+			h.observeRead(now, failure)
+			assert.True(t, h.allowRead(), "below min requests")
+		}
+		h.observeRead(now, nil)
+		assert.False(t, h.allowRead())
+
+		state, reason := h.status()
+		assert.Equal(t, ShardHealthDegraded, state)
+		assert.Contains(t, reason, "reads: error rate")
+		assert.Nil(t, h.beginWrite(), "writes have their own breaker")
+	})
+
+	t.Run("ignores canceled requests", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 4; i++ {
+			h.observeRead(now, fmt.Errorf("search: %w", context.Canceled))
+		}
+		assert.True(t, h.allowRead())
+	})
+
+	t.Run("trips on latency", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 4; i++ {
+			h.observeRead(now.Add(-time.Second), nil)
+		}
+		assert.False(t, h.allowRead())
+		_, reason := h.status()
+		assert.Contains(t, reason, "mean latency")
+	})
+
+	t.Run("trips writes on full disk", func(t *testing.T) {
+		h := newHealth()
+		err := fmt.Errorf("flush memtable: %w", syscall.ENOSPC)
+		h.endWrite(now, &err)
+
+		err = h.beginWrite()
+		assert.ErrorIs(t, err, errShardDegraded)
+		assert.Contains(t, err.Error(), "no space left on device")
+		assert.True(t, h.allowRead())
+	})
+
+	t.Run("failed batch objects don't trip writes", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 4; i++ {
+			h.endWriteBatch(now, []error{nil, errors.New("invalid vector")})
+		}
+		assert.Nil(t, h.beginWrite())
+
+		h.endWriteBatch(now, []error{errors.New("write: no space left on device")})
+		assert.NotNil(t, h.beginWrite())
+	})
+
+	t.Run("window is reset", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 3; i++ {
+			h.observeRead(now, failure)
+		}
+		now = now.Add(11 * time.Second)
+		h.observeRead(now, failure)
+		assert.True(t, h.allowRead())
+	})
+
+	t.Run("recovers after successful probe", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < 4; i++ {
+			h.observeRead(now, failure)
+		}
+		require.False(t, h.allowRead())
+
+		now = now.Add(6 * time.Second)
+		require.True(t, h.allowRead(), "probe after cooldown")
+		assert.False(t, h.allowRead(), "single probe at a time")
+		h.observeRead(now, failure)
+		assert.False(t, h.allowRead(), "failed probe restarts cooldown")
+
+		now = now.Add(6 * time.Second)
+		require.True(t, h.allowRead())
+		h.observeRead(now, nil)
+		assert.True(t, h.allowRead())
+		state, reason := h.status()
+		assert.Equal(t, ShardHealthHealthy, state)
+		assert.Empty(t, reason)
+	})
+}
@@ -17,12 +17,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
 
 func (s *Shard) initProperties(class *models.Class) error {
 	s.propertyIndices = propertyspecific.Indices{}
-	if class == nil {
+	if class == nil || s.index.Config.VectorOnly {
+		// vector-only classes neither have property nor internal indexes, the
+		// objects bucket is the mapping of ids to vectors
 		return nil
 	}
 
@@ -47,6 +50,15 @@ func (s *Shard) initProperties(class *models.Class) error {
 		})
 	}
 
+	if sharding.IsSharedShardName(s.name) {
+		eg.Go(func() error {
+			if err := s.addSharedTenantProperty(context.TODO()); err != nil {
+				return errors.Wrap(err, "create shared tenant index")
+			}
+			return nil
+		})
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		eg.Go(func() error {
 			if err := s.addDimensionsProperty(context.TODO()); err != nil {
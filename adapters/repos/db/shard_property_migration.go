@@ -0,0 +1,300 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// propertyBuckets returns the names of all buckets which may exist for a
+// property, depending on its data type and index settings
+func propertyBuckets(propName string) []string {
+	return []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+		helpers.BucketFromPropNameNullLSM(propName),
+		helpers.BucketFromPropNameLengthLSM(propName),
+		helpers.BucketFromPropNameMetaCountLSM(propName),
+		helpers.BucketGeoCellsFromPropNameLSM(propName),
+		helpers.BucketGeoCoordinatesFromPropNameLSM(propName),
+	}
+}
+
+// nestedPropertyBuckets returns the names of the buckets of the leaves of a
+// nested property. Leaves are indexed by their dotted path, so the names of
+// their buckets share the prefix of the property name and the separator.
+func (s *Shard) nestedPropertyBuckets(propName string) ([]string, error) {
+	prefix := helpers.BucketFromPropNameLSM(propName + schema.NestedPathSeparator)
+	entries, err := os.ReadDir(s.DBPathLSM())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), prefix) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// renamedPropertyBuckets returns the names of the buckets of a property
+// and of its nested properties, and their names after a rename
+func (s *Shard) renamedPropertyBuckets(propName, newPropName string) ([]string, []string, error) {
+	oldBuckets, newBuckets := propertyBuckets(propName), propertyBuckets(newPropName)
+	nestedBuckets, err := s.nestedPropertyBuckets(propName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "list nested property buckets")
+	}
+	oldPrefix := helpers.BucketFromPropNameLSM(propName + schema.NestedPathSeparator)
+	newPrefix := helpers.BucketFromPropNameLSM(newPropName + schema.NestedPathSeparator)
+	for _, name := range nestedBuckets {
+		oldBuckets = append(oldBuckets, name)
+		newBuckets = append(newBuckets, newPrefix+strings.TrimPrefix(name, oldPrefix))
+	}
+	return oldBuckets, newBuckets, nil
+}
+
+// aliasPropertyBuckets makes the buckets of a property which is about to be
+// renamed available under the new name, so they are found once the schema
+// changed and before they are renamed
+func (s *Shard) aliasPropertyBuckets(propName, newPropName string) error {
+	oldBuckets, newBuckets, err := s.renamedPropertyBuckets(propName, newPropName)
+	if err != nil {
+		return err
+	}
+	for i, name := range oldBuckets {
+		s.store.AliasBucket(newBuckets[i], name)
+	}
+	return nil
+}
+
+// migratePropertyBuckets renames and drops the buckets and the tracked
+// property lengths of the migrated properties. It is called before the
+// property buckets are loaded and again before the objects are migrated, so
+// it skips what was migrated already.
+func (s *Shard) migratePropertyBuckets(ctx context.Context, migrations []propertyMigration) error {
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	for _, migration := range migrations {
+		if migration.dropped() {
+			if err := s.dropPropertyBuckets(ctx, migration.From); err != nil {
+				return errors.Wrapf(err, "drop property %q", migration.From)
+			}
+			s.propLengths.RemoveProperty(migration.From)
+			continue
+		}
+
+		if err := s.renamePropertyBuckets(ctx, migration.From, migration.To); err != nil {
+			return errors.Wrapf(err, "rename property %q", migration.From)
+		}
+		s.propLengths.RenameProperty(migration.From, migration.To)
+	}
+
+	if err := s.propLengths.Flush(false); err != nil {
+		return errors.Wrap(err, "flush prop lengths")
+	}
+	return nil
+}
+
+func (s *Shard) renamePropertyBuckets(ctx context.Context, propName, newPropName string) error {
+	oldBuckets, newBuckets, err := s.renamedPropertyBuckets(propName, newPropName)
+	if err != nil {
+		return err
+	}
+
+	loaded := s.store.GetBucketsByName()
+	for i, name := range oldBuckets {
+		if loaded[name] == nil && loaded[newBuckets[i]] != nil {
+			// renamed already
+			continue
+		}
+		if err := s.store.RenameBucket(ctx, name, newBuckets[i]); err != nil {
+			return errors.Wrapf(err, "rename bucket %q", name)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) dropPropertyBuckets(ctx context.Context, propName string) error {
+	s.propertyIndicesLock.Lock()
+	delete(s.propertyIndices, propName)
+	s.propertyIndicesLock.Unlock()
+
+	nestedBuckets, err := s.nestedPropertyBuckets(propName)
+	if err != nil {
+		return errors.Wrap(err, "list nested property buckets")
+	}
+	for _, name := range append(propertyBuckets(propName), nestedBuckets...) {
+		if err := s.store.DropBucket(ctx, name); err != nil {
+			return errors.Wrapf(err, "drop bucket %q", name)
+		}
+	}
+	return nil
+}
+
+// loadPropertyMigrations migrates the buckets of the properties which were
+// renamed or dropped while the shard was not loaded, before the buckets of
+// the current properties are created
+func (s *Shard) loadPropertyMigrations(ctx context.Context) error {
+	pending, err := s.index.propertyMigrations.pending(s.name, s.index.currentProperties)
+	if err != nil {
+		return err
+	}
+	if err := s.migratePropertyBuckets(ctx, pending); err != nil {
+		return err
+	}
+
+	if rename := s.index.propertyMigrations.uncommittedRename(); rename != nil {
+		return s.aliasPropertyBuckets(rename.From, rename.To)
+	}
+	return nil
+}
+
+// startPropertyMigration migrates the objects of the shard in the background
+// if a property was renamed or dropped, see migrateProperties
+func (s *Shard) startPropertyMigration() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelPropertyMigration = cancel
+
+	go func() {
+		defer cancel()
+		if err := s.migrateProperties(ctx); err != nil && ctx.Err() == nil {
+			s.index.logger.WithField("action", "migrate_properties").
+				WithField("shard", s.name).WithError(err).
+				Error("migrate renamed and dropped properties")
+		}
+	}()
+}
+
+// stopPropertyMigration cancels a running migration and waits until it
+// stopped. It is continued when the shard is loaded again.
+func (s *Shard) stopPropertyMigration() {
+	if s.cancelPropertyMigration != nil {
+		s.cancelPropertyMigration()
+	}
+	s.propertyMigrationLock.Lock()
+	s.propertyMigrationLock.Unlock()
+}
+
+// migrateProperties completes the pending property migrations of the shard.
+// Its buckets are renamed or dropped and the properties of the stored objects
+// are rewritten. Objects which are read or written in the meantime already
+// get the current property names, see Index.withCurrentProperties, so the
+// migration does not block reads or writes.
+func (s *Shard) migrateProperties(ctx context.Context) error {
+	s.propertyMigrationLock.Lock()
+	defer s.propertyMigrationLock.Unlock()
+
+	pending, err := s.index.propertyMigrations.pending(s.name, s.index.currentProperties)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	if err := s.migratePropertyBuckets(ctx, pending); err != nil {
+		return err
+	}
+	err = s.updateStoredProperties(ctx, func(props map[string]interface{}) bool {
+		return migrateProperties(props, pending)
+	})
+	if err != nil {
+		return err
+	}
+	return s.index.completePropertyMigrations(s.name, pending)
+}
+
+// updateStoredProperties calls update with the properties of every stored
+// object and writes back the objects for which it returns true. The doc ids
+// are kept, so no index needs to be updated.
+func (s *Shard) updateStoredProperties(ctx context.Context,
+	update func(props map[string]interface{}) bool,
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	// collect the ids first, the objects are rewritten outside of the cursor
+	var ids [][]byte
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		ids = append(ids, bytes.Clone(k))
+	}
+	cursor.Close()
+
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.updateStoredObjectProperties(bucket, id, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) updateStoredObjectProperties(bucket *lsmkv.Bucket, id []byte,
+	update func(props map[string]interface{}) bool,
+) error {
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	previous, err := bucket.Get(id)
+	if err != nil {
+		return errors.Wrap(err, "get bucket")
+	}
+	if previous == nil {
+		// deleted in the meantime
+		return nil
+	}
+
+	obj, err := storobj.FromBinary(previous)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+	props, ok := obj.Object.Properties.(map[string]interface{})
+	if !ok || !update(props) {
+		return nil
+	}
+
+	next, err := obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
+	}
+	if err := s.upsertObjectDataLSM(bucket, id, next, obj.DocID()); err != nil {
+		return errors.Wrap(err, "upsert object data")
+	}
+	s.trackUsage(s.usageKey(obj), objectUsageDelta(previous, next))
+	s.index.recordChange(s.usageKey(obj), obj.ID())
+	return nil
+}
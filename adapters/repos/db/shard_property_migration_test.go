@@ -0,0 +1,304 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// withPropertyMigrations loads the property migrations of an index created
+// by testShard
+func withPropertyMigrations(t *testing.T) func(*Index) {
+	return func(idx *Index) {
+		var err error
+		idx.propertyMigrations, err = loadPropertyMigrations(idx.Config.RootPath,
+			idx.storageID(), idx.class())
+		require.Nil(t, err)
+	}
+}
+
+// setProperties replaces the properties of the class of a test index, they
+// are not indexed
+func setProperties(idx *Index, names ...string) {
+	vFalse := false
+	class := idx.getSchema.GetSchemaSkipAuth().Objects.Classes[0]
+	class.Properties = nil
+	for _, name := range names {
+		class.Properties = append(class.Properties, &models.Property{
+			Name:            name,
+			DataType:        schema.DataTypeText.PropString(),
+			IndexFilterable: &vFalse,
+			IndexSearchable: &vFalse,
+		})
+	}
+}
+
+func storedProperties(t *testing.T, shd *Shard, obj *storobj.Object) interface{} {
+	id, err := parseBytesUUID(obj.ID())
+	require.Nil(t, err)
+	data, err := shd.store.Bucket(helpers.ObjectsBucketLSM).Get(id)
+	require.Nil(t, err)
+	stored, err := storobj.FromBinary(data)
+	require.Nil(t, err)
+	return stored.Object.Properties
+}
+
+func readProperties(t *testing.T, idx *Index, obj *storobj.Object) interface{} {
+	res, err := idx.objectByID(testCtx(), obj.ID(), nil, additional.Properties{}, nil, "")
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	return res.Object.Properties
+}
+
+func TestShard_RenameProperty(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+	setProperties(idx, "title", "body")
+
+	require.Nil(t, shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("title"),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+	bucket := shd.store.Bucket(helpers.BucketFromPropNameLSM("title"))
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"title": "hello", "body": "world"}
+	require.Nil(t, shd.putObject(ctx, obj))
+
+	t.Run("the buckets are found under both names before the schema changes", func(t *testing.T) {
+		require.Nil(t, idx.prepareRenameProperty("title", "headline"))
+
+		assert.Same(t, bucket, shd.store.Bucket(helpers.BucketFromPropNameLSM("headline")))
+		assert.Equal(t, map[string]interface{}{"title": "hello", "body": "world"},
+			readProperties(t, idx, obj))
+	})
+
+	t.Run("objects are read with the new name once the schema changed", func(t *testing.T) {
+		setProperties(idx, "headline", "body")
+
+		assert.Equal(t, map[string]interface{}{"headline": "hello", "body": "world"},
+			readProperties(t, idx, obj))
+		assert.Equal(t, map[string]interface{}{"title": "hello", "body": "world"},
+			storedProperties(t, shd, obj))
+	})
+
+	t.Run("the migration renames the buckets and rewrites the objects", func(t *testing.T) {
+		require.Nil(t, idx.migrateProperties(ctx))
+
+		assert.NoDirExists(t, filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("title")))
+		assert.DirExists(t, filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("headline")))
+		assert.Same(t, bucket, shd.store.Bucket(helpers.BucketFromPropNameLSM("headline")))
+		assert.Equal(t, map[string]interface{}{"headline": "hello", "body": "world"},
+			storedProperties(t, shd, obj))
+		assert.Empty(t, idx.propertyMigrations.effective(idx.currentProperties))
+		assert.NoFileExists(t, idx.propertyMigrations.path)
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_DropProperty(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+	setProperties(idx, "title", "body")
+
+	require.Nil(t, shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("title"),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+	bucketDir := filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("title"))
+	require.DirExists(t, bucketDir)
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"title": "hello", "body": "world"}
+	require.Nil(t, shd.putObject(ctx, obj))
+
+	require.Nil(t, idx.prepareDropProperty("title"))
+	setProperties(idx, "body")
+
+	assert.Equal(t, map[string]interface{}{"body": "world"}, readProperties(t, idx, obj))
+
+	require.Nil(t, idx.migrateProperties(ctx))
+
+	assert.Nil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("title")))
+	assert.NoDirExists(t, bucketDir)
+	assert.Equal(t, map[string]interface{}{"body": "world"}, storedProperties(t, shd, obj))
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_DropNestedProperty(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+	setProperties(idx, "address", "addressBook")
+
+	for _, name := range []string{"address.city", "address.zip", "addressBook"} {
+		require.Nil(t, shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(name),
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+	}
+
+	require.Nil(t, idx.prepareDropProperty("address"))
+	setProperties(idx, "addressBook")
+	require.Nil(t, idx.migrateProperties(ctx))
+
+	assert.Nil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("address.city")))
+	assert.Nil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("address.zip")))
+	assert.NotNil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("addressBook")))
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_RenameNestedProperty(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+	setProperties(idx, "address")
+
+	require.Nil(t, shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("address.city"),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+	bucket := shd.store.Bucket(helpers.BucketFromPropNameLSM("address.city"))
+
+	require.Nil(t, idx.prepareRenameProperty("address", "home"))
+	assert.Same(t, bucket, shd.store.Bucket(helpers.BucketFromPropNameLSM("home.city")))
+
+	setProperties(idx, "home")
+	require.Nil(t, idx.migrateProperties(ctx))
+
+	assert.NoDirExists(t, filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("address.city")))
+	assert.Same(t, bucket, shd.store.Bucket(helpers.BucketFromPropNameLSM("home.city")))
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_PropertyMigrationRecovery(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+
+	t.Run("a rename without a schema change is rolled back", func(t *testing.T) {
+		_, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+		setProperties(idx, "title")
+
+		require.Nil(t, idx.prepareRenameProperty("title", "headline"))
+
+		// the node crashed before the schema changed
+		migrations, err := loadPropertyMigrations(idx.Config.RootPath, idx.storageID(), idx.class())
+		require.Nil(t, err)
+		assert.Empty(t, migrations.migrations)
+		assert.NoFileExists(t, migrations.path)
+
+		require.Nil(t, idx.drop())
+	})
+
+	t.Run("a shard completes the migration when it is loaded", func(t *testing.T) {
+		shd, idx := testShard(t, ctx, className, withPropertyMigrations(t))
+		setProperties(idx, "title", "body")
+
+		require.Nil(t, shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("title"),
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+		obj := testObject(className)
+		obj.Object.Properties = map[string]interface{}{"title": "hello", "body": "world"}
+		require.Nil(t, shd.putObject(ctx, obj))
+
+		require.Nil(t, idx.prepareRenameProperty("title", "headline"))
+		setProperties(idx, "headline", "body")
+
+		// the node crashed before the objects were migrated
+		require.Nil(t, shd.shutdown(ctx))
+		withPropertyMigrations(t)(idx)
+		class := idx.class()
+		shd, err := NewShard(ctx, nil, shd.name, idx, class, idx.centralJobQueue)
+		require.Nil(t, err)
+		idx.shards.Store(shd.name, shd)
+
+		assert.NoDirExists(t, filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("title")))
+		assert.DirExists(t, filepath.Join(shd.DBPathLSM(), helpers.BucketFromPropNameLSM("headline")))
+		assert.Equal(t, map[string]interface{}{"headline": "hello", "body": "world"},
+			readProperties(t, idx, obj))
+		assert.Eventually(t, func() bool {
+			return assert.ObjectsAreEqual(map[string]interface{}{"headline": "hello", "body": "world"},
+				storedProperties(t, shd, obj))
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.Nil(t, idx.drop())
+	})
+}
+
+func TestShard_ReindexPropertyTokenization(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+
+	vTrue := true
+	prop := &models.Property{
+		Name:            "title",
+		DataType:        schema.DataTypeText.PropString(),
+		Tokenization:    models.PropertyTokenizationWord,
+		IndexFilterable: &vTrue,
+		IndexSearchable: &vTrue,
+	}
+	class := idx.getSchema.GetSchemaSkipAuth().Objects.Classes[0]
+	class.Properties = []*models.Property{prop}
+	require.Nil(t, shd.createPropertyValueIndex(ctx, prop))
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"title": "Hello World"}
+	require.Nil(t, shd.putObject(ctx, obj))
+
+	filterable := func() *lsmkv.Bucket {
+		return shd.store.Bucket(helpers.BucketFromPropNameLSM("title"))
+	}
+	searchable := func() *lsmkv.Bucket {
+		return shd.store.Bucket(helpers.BucketSearchableFromPropNameLSM("title"))
+	}
+	containsDoc := func(t *testing.T, key string) bool {
+		bm, err := filterable().RoaringSetGet([]byte(key))
+		require.Nil(t, err)
+		return bm.Contains(obj.DocID())
+	}
+	mean := func(t *testing.T) float32 {
+		mean, err := shd.propLengths.PropertyMean("title")
+		require.Nil(t, err)
+		return mean
+	}
+
+	assert.True(t, containsDoc(t, "hello"))
+	assert.False(t, containsDoc(t, "Hello World"))
+	assert.Equal(t, float32(2), mean(t))
+
+	prop.Tokenization = models.PropertyTokenizationField
+	require.Nil(t, idx.reindexProperty(ctx, "title"))
+
+	assert.False(t, containsDoc(t, "hello"))
+	assert.True(t, containsDoc(t, "Hello World"))
+	pairs, err := searchable().MapList([]byte("Hello World"))
+	require.Nil(t, err)
+	assert.Len(t, pairs, 1)
+	pairs, err = searchable().MapList([]byte("hello"))
+	require.Nil(t, err)
+	assert.Len(t, pairs, 0)
+	assert.Equal(t, float32(1), mean(t))
+
+	require.Nil(t, idx.drop())
+}
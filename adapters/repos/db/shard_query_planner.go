@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// plannedVectorIndex is implemented by vector indexes which can execute the
+// strategies chosen by the query planner
+type plannedVectorIndex interface {
+	SearchByVectorFlat(ctx context.Context, vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorGraph(ctx context.Context, vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// plannedVectorSearch searches the vector index for limit results. If the
+// query planner is enabled, it chooses how a filtered search is executed and
+// the chosen plan is returned, otherwise the plan is nil.
+func (s *Shard) plannedVectorSearch(ctx context.Context, searchVector []float32, limit int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, *traverser.VectorSearchPlan, error) {
+	planner := s.index.Config.QueryPlanner
+	index, ok := s.queuedVectorIndex().(plannedVectorIndex)
+	if planner == nil || !ok {
+		ids, dists, err := s.vectorIndex.SearchByVector(ctx, searchVector, limit, allowList)
+		return ids, dists, nil, err
+	}
+
+	if allowList == nil {
+		ids, dists, err := s.vectorIndex.SearchByVector(ctx, searchVector, limit, nil)
+		return ids, dists, &traverser.VectorSearchPlan{Strategy: traverser.StrategyUnfiltered}, err
+	}
+
+	plan := planner.PlanVectorSearch(traverser.VectorSearchStats{
+		ObjectCount:   s.objectCount(),
+		FilteredCount: allowList.Len(),
+		Limit:         limit,
+	})
+
+	var (
+		ids   []uint64
+		dists []float32
+		err   error
+	)
+	switch plan.Strategy {
+	case traverser.StrategyFlat:
+		ids, dists, err = index.SearchByVectorFlat(ctx, searchVector, limit, allowList)
+	case traverser.StrategyPostFilter:
+		ids, dists, err = postFilteredVectorSearch(ctx, index, searchVector, limit, allowList, &plan)
+	default:
+		ids, dists, err = index.SearchByVectorGraph(ctx, searchVector, limit, allowList)
+	}
+	return ids, dists, &plan, err
+}
+
+// postFilteredVectorSearch searches for the number of candidates set in the
+// plan without a filter and keeps the ones on the allow list. If they do not
+// contain enough matches, the search is repeated with pre-filtering.
+func postFilteredVectorSearch(ctx context.Context, index plannedVectorIndex, searchVector []float32,
+	limit int, allowList helpers.AllowList, plan *traverser.VectorSearchPlan,
+) ([]uint64, []float32, error) {
+	candidates, candidateDists, err := index.SearchByVectorGraph(ctx, searchVector, plan.Candidates, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]uint64, 0, limit)
+	dists := make([]float32, 0, limit)
+	for i, id := range candidates {
+		if !allowList.Contains(id) {
+			continue
+		}
+		ids = append(ids, id)
+		dists = append(dists, candidateDists[i])
+		if len(ids) == limit {
+			return ids, dists, nil
+		}
+	}
+
+	if len(ids) == allowList.Len() || len(candidates) < plan.Candidates {
+		// all matching objects were found
+		return ids, dists, nil
+	}
+
+	plan.Fallback = true
+	return index.SearchByVectorGraph(ctx, searchVector, limit, allowList)
+}
+
+// addQueryPlan adds the explanation of the plan to the additional
+// properties of the results
+func addQueryPlan(objs []*storobj.Object, plan *traverser.VectorSearchPlan) {
+	explanation := plan.String()
+	for _, obj := range objs {
+		if obj.Object.Additional == nil {
+			obj.Object.Additional = map[string]interface{}{}
+		}
+		obj.Object.Additional["queryPlan"] = explanation
+	}
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// sortedIDsIndex returns its ids in ascending order, their distance is the
+// id itself
+type sortedIDsIndex struct {
+	ids          []uint64
+	filteredRuns int
+}
+
+func (f *sortedIDsIndex) SearchByVectorFlat(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return f.SearchByVectorGraph(ctx, vector, k, allow)
+}
+
+func (f *sortedIDsIndex) SearchByVectorGraph(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if allow != nil {
+		f.filteredRuns++
+	}
+	var ids []uint64
+	var dists []float32
+	for _, id := range f.ids {
+		if len(ids) == k {
+			break
+		}
+		if allow == nil || allow.Contains(id) {
+			ids = append(ids, id)
+			dists = append(dists, float32(id))
+		}
+	}
+	return ids, dists, nil
+}
+
+func TestPostFilteredVectorSearch(t *testing.T) {
+	index := &sortedIDsIndex{}
+	for id := uint64(0); id < 100; id++ {
+		index.ids = append(index.ids, id)
+	}
+
+	t.Run("enough matching candidates", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for id := uint64(0); id < 100; id += 2 {
+			allow.Insert(id)
+		}
+		plan := &traverser.VectorSearchPlan{Candidates: 10}
+
+		ids, dists, err := postFilteredVectorSearch(context.Background(), index, nil, 3, allow, plan)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 2, 4}, ids)
+		assert.Equal(t, []float32{0, 2, 4}, dists)
+		assert.False(t, plan.Fallback)
+		assert.Equal(t, 0, index.filteredRuns)
+	})
+
+	t.Run("too few matching candidates", func(t *testing.T) {
+		allow := helpers.NewAllowList(5, 50, 60, 70)
+		plan := &traverser.VectorSearchPlan{Candidates: 10}
+
+		ids, _, err := postFilteredVectorSearch(context.Background(), index, nil, 3, allow, plan)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{5, 50, 60}, ids)
+		assert.True(t, plan.Fallback)
+		assert.Equal(t, 1, index.filteredRuns)
+	})
+
+	t.Run("all matching objects among the candidates", func(t *testing.T) {
+		allow := helpers.NewAllowList(1, 3)
+		plan := &traverser.VectorSearchPlan{Candidates: 10}
+
+		ids, _, err := postFilteredVectorSearch(context.Background(), index, nil, 3, allow, plan)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 3}, ids)
+		assert.False(t, plan.Fallback)
+	})
+}
+
+func TestQueuedVectorIndex(t *testing.T) {
+	index := noop.NewIndex()
+
+	s := &Shard{vectorIndex: index}
+	assert.Equal(t, VectorIndex(index), s.queuedVectorIndex())
+
+	// with async indexing the planner and the memory governor must see the
+	// index behind the queue
+	s = &Shard{vectorIndex: &indexQueue{VectorIndex: index}}
+	assert.Equal(t, VectorIndex(index), s.queuedVectorIndex())
+}
@@ -31,6 +31,10 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"github.com/weaviate/weaviate/usecases/traverser"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
@@ -42,7 +46,9 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, err
 	}
 
+	_, span := tracing.Start(ctx, "lsmkv.Get", s.spanAttributes()...)
 	bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +57,7 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := unmarshalObject(bytes, additional)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -59,8 +65,17 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 	return obj, nil
 }
 
+// unmarshalObject decodes the full object unless the vector is skipped, in
+// which case only what was asked for is decoded
+func unmarshalObject(data []byte, addl additional.Properties) (*storobj.Object, error) {
+	if addl.SkipVector {
+		return storobj.FromBinaryOptional(data, addl)
+	}
+	return storobj.FromBinary(data)
+}
+
 func (s *Shard) multiObjectByID(ctx context.Context,
-	query []multi.Identifier,
+	query []multi.Identifier, addl additional.Properties,
 ) ([]*storobj.Object, error) {
 	objects := make([]*storobj.Object, len(query))
 
@@ -85,7 +100,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := unmarshalObject(bytes, addl)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
@@ -144,11 +159,31 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 }
 
 func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32, error) {
-	keyBuf := make([]byte, 8)
-	return s.readVectorByIndexIDIntoSlice(ctx, indexID, &hnsw.VectorSlice{Buff8: keyBuf})
+	container := &hnsw.VectorSlice{Buff8: make([]byte, 8)}
+	bytes, err := s.readObjectByIndexIDIntoSlice(ctx, indexID, container)
+	if err != nil {
+		return nil, err
+	}
+
+	// the vector is retained by the vector cache, so it is decoded rather
+	// than referencing the object's bytes
+	return storobj.VectorFromBinary(bytes, nil)
 }
 
+// readVectorByIndexIDIntoSlice reads the vector without allocating. The
+// object is still copied from the segment into the container's buffer, but the
+// vector is not decoded again, the result is a view on that buffer whenever
+// possible. It is only valid until the container is reused.
 func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+	bytes, err := s.readObjectByIndexIDIntoSlice(ctx, indexID, container)
+	if err != nil {
+		return nil, err
+	}
+
+	return storobj.VectorViewFromBinary(bytes, container.Slice)
+}
+
+func (s *Shard) readObjectByIndexIDIntoSlice(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]byte, error) {
 	binary.LittleEndian.PutUint64(container.Buff8, indexID)
 
 	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
@@ -163,10 +198,13 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	}
 
 	container.Buff = newBuff
-	return storobj.VectorFromBinary(bytes, container.Slice)
+	return bytes, nil
 }
 
 func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	ctx, span := tracing.Start(ctx, "shard.objectSearch", s.spanAttributes()...)
+	defer span.End()
+
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -181,39 +219,56 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		var filterDocIds helpers.AllowList
 
 		if filters != nil {
+			beforeFilter := time.Now()
+			filterCtx, filterSpan := tracing.Start(ctx, "inverted.DocIDs")
 			objs, err = inverted.NewSearcher(s.index.logger, s.store,
 				s.index.getSchema.GetSchemaSkipAuth(),
 				s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 				s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable).
-				DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+				DocIDs(filterCtx, filters, additional, s.index.Config.ClassName)
+			tracing.End(filterSpan, err)
 			if err != nil {
 				return nil, nil, err
 			}
 
 			filterDocIds = objs
+			slowquery.Observe(ctx, s.name, slowquery.PhaseFilter, time.Since(beforeFilter))
 		}
 
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
-		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
+		beforeKeyword := time.Now()
+		keywordCtx, keywordSpan := tracing.Start(ctx, "inverted.BM25F")
+		bm25objs, bm25count, err = bm25searcher.BM25F(keywordCtx, filterDocIds, className, limit, *keywordRanking)
+		tracing.End(keywordSpan, err)
 		if err != nil {
 			return nil, nil, err
 		}
+		slowquery.Observe(ctx, s.name, slowquery.PhaseKeyword, time.Since(beforeKeyword))
 
 		return bm25objs, bm25count, nil
 	}
 
+	if cursor != nil && (filters != nil || len(sort) > 0) {
+		objs, err := s.cursorObjectSearch(ctx, limit, filters, sort, cursor, additional)
+		return objs, nil, err
+	}
+
 	if filters == nil {
 		objs, err := s.objectList(ctx, limit, sort,
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
+	beforeFilter := time.Now()
+	filterCtx, filterSpan := tracing.Start(ctx, "inverted.Objects")
 	objs, err := inverted.NewSearcher(s.index.logger, s.store,
 		s.index.getSchema.GetSchemaSkipAuth(),
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable).
-		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
+		Objects(filterCtx, limit, filters, sort, additional, s.index.Config.ClassName)
+	tracing.End(filterSpan, err)
+	slowquery.Observe(ctx, s.name, slowquery.PhaseFilter, time.Since(beforeFilter))
 	return objs, nil, err
 }
 
@@ -226,31 +281,53 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		dists     []float32
 		err       error
 		allowList helpers.AllowList
+		plan      *traverser.VectorSearchPlan
 	)
 
+	ctx, span := tracing.Start(ctx, "shard.objectVectorSearch", s.spanAttributes()...)
+	defer span.End()
+
 	if filters != nil {
 		beforeFilter := time.Now()
-		list, err := s.buildAllowList(ctx, filters, additional)
+		filterCtx, filterSpan := tracing.Start(ctx, "inverted.DocIDs")
+		list, err := s.buildAllowList(filterCtx, filters, additional)
+		tracing.End(filterSpan, err)
 		if err != nil {
 			return nil, nil, err
 		}
 		allowList = list
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
+		slowquery.Observe(ctx, s.name, slowquery.PhaseFilter, time.Since(beforeFilter))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
 	}
 
 	beforeVector := time.Now()
+	vectorCtx, vectorSpan := tracing.Start(ctx, "vectorIndex.Search",
+		attribute.Int("weaviate.limit", limit),
+		attribute.Bool("weaviate.filtered", allowList != nil))
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
+		ids, dists, err = s.vectorIndex.SearchByVectorDistance(vectorCtx,
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
+			tracing.End(vectorSpan, err)
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, plan, err = s.plannedVectorSearch(vectorCtx, searchVector, limit, allowList)
 		if err != nil {
+			tracing.End(vectorSpan, err)
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
+		if plan != nil {
+			vectorSpan.SetAttributes(attribute.String("weaviate.plan", string(plan.Strategy)))
+		}
 	}
+	vectorSpan.SetAttributes(attribute.Int("weaviate.results", len(ids)))
+	vectorSpan.End()
+	slowquery.Observe(ctx, s.name, slowquery.PhaseVector, time.Since(beforeVector))
 	if len(ids) == 0 {
 		return nil, nil, nil
 	}
@@ -273,12 +350,16 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		if filters != nil {
 			s.metrics.FilteredVectorSort(time.Since(beforeSort))
 		}
+		slowquery.Observe(ctx, s.name, slowquery.PhaseSort, time.Since(beforeSort))
 	}
 
 	beforeObjects := time.Now()
 
+	_, objectsSpan := tracing.Start(ctx, "lsmkv.ObjectsByDocID",
+		attribute.Int("weaviate.objects", len(ids)))
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	tracing.End(objectsSpan, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -286,10 +367,23 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	if filters != nil {
 		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
 	}
+	slowquery.Observe(ctx, s.name, slowquery.PhaseObjects, time.Since(beforeObjects))
+
+	if plan != nil && additional.QueryPlan {
+		addQueryPlan(objs, plan)
+	}
 
 	return objs, dists, nil
 }
 
+// spanAttributes identify the shard in the spans of its reads
+func (s *Shard) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("weaviate.class", s.index.Config.ClassName.String()),
+		attribute.String("weaviate.shard", s.name),
+	}
+}
+
 func (s *Shard) objectList(ctx context.Context, limit int,
 	sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties,
 	className schema.ClassName,
@@ -335,7 +429,11 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		obj, err := unmarshalObject(val, additional)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -347,6 +445,54 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	return out[:i], nil
 }
 
+// cursorObjectSearch returns the objects matching the filter which are
+// positioned after the cursor in the order defined by sort. The last sort
+// level is expected to be the object ID (see filters.CursorSort), so the
+// position is unique.
+func (s *Shard) cursorObjectSearch(ctx context.Context, limit int,
+	filter *filters.LocalFilter, sort []filters.Sort, cursor *filters.Cursor,
+	additional additional.Properties,
+) ([]*storobj.Object, error) {
+	var after *filters.CursorPosition
+	if cursor.After != "" {
+		pos, err := filters.DecodeCursor(cursor.After)
+		if err != nil {
+			return nil, err
+		}
+		after = pos
+	}
+
+	className := s.index.Config.ClassName
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className)
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor object search")
+	}
+
+	var docIDs []uint64
+	if filter == nil {
+		docIDs, err = lsmSorter.SortAfter(ctx, limit, sort, after)
+	} else {
+		// the filter must not be limited, objects before the cursor are only
+		// skipped while sorting
+		var allowList helpers.AllowList
+		allowList, err = inverted.NewSearcher(s.index.logger, s.store,
+			s.index.getSchema.GetSchemaSkipAuth(),
+			s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
+			s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable).
+			DocIDs(ctx, filter, additional, className)
+		if err != nil {
+			return nil, err
+		}
+		docIDs, err = lsmSorter.SortDocIDsAfter(ctx, limit, sort, allowList, after)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor object search")
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	return storobj.ObjectsByDocID(bucket, docIDs, additional)
+}
+
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName,
 ) ([]uint64, error) {
@@ -441,6 +587,9 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	tenant := s.usageKeyFromBinary(existing)
+	s.trackUsage(tenant, objectUsageDelta(existing, nil))
+	defer s.index.recordChange(tenant, id)
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -13,6 +13,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -80,12 +81,12 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	preconditions := objects.PreconditionsFrom(ctx)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
+		ctx = objects.WithPreconditions(ctx, preconditions)
 		if err := s.putOne(ctx, uuid, object); err != nil {
-			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
-			}
+			resp.Errors = []replica.Error{replicaWriteError(err)}
 		}
 		return resp
 	}
@@ -100,12 +101,12 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	preconditions := objects.PreconditionsFrom(ctx)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
+		ctx = objects.WithPreconditions(ctx, preconditions)
 		if err := s.merge(ctx, uuid, *doc); err != nil {
-			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
-			}
+			resp.Errors = []replica.Error{replicaWriteError(err)}
 		}
 		return resp
 	}
@@ -114,7 +115,7 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 }
 
 func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
-	bucket, obj, idBytes, docID, err := s.canDeleteOne(ctx, uuid)
+	idBytes, err := parseBytesUUID(uuid)
 	if err != nil {
 		return replica.SimpleResponse{
 			Errors: []replica.Error{
@@ -122,12 +123,12 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 			},
 		}
 	}
+	preconditions := objects.PreconditionsFrom(ctx)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.deleteOne(ctx, bucket, obj, idBytes, docID); err != nil {
-			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
-			}
+		ctx = objects.WithPreconditions(ctx, preconditions)
+		if err := s.deleteOne(ctx, uuid, idBytes); err != nil {
+			resp.Errors = []replica.Error{replicaWriteError(err)}
 		}
 		return resp
 	}
@@ -135,13 +136,15 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 	return replica.SimpleResponse{}
 }
 
-func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects []*storobj.Object) replica.SimpleResponse {
+func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objs []*storobj.Object) replica.SimpleResponse {
+	preconditions := objects.PreconditionsFrom(ctx)
 	task := func(ctx context.Context) interface{} {
-		rawErrs := s.putBatch(ctx, objects)
+		ctx = objects.WithPreconditions(ctx, preconditions)
+		rawErrs := s.putBatch(ctx, objs)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
 			if err != nil {
-				resp.Errors[i] = replica.Error{Code: replica.StatusConflict, Msg: err.Error()}
+				resp.Errors[i] = replicaWriteError(err)
 			}
 		}
 		return resp
@@ -185,6 +188,15 @@ func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs
 	return replica.SimpleResponse{}
 }
 
+// replicaWriteError is the error a replica reports for a write which failed
+// when it was committed
+func replicaWriteError(err error) replica.Error {
+	if errors.As(err, &objects.ErrConflict{}) {
+		return replica.Error{Code: replica.StatusVersionConflict, Msg: err.Error()}
+	}
+	return replica.Error{Code: replica.StatusConflict, Msg: err.Error()}
+}
+
 func parseBytesUUID(id strfmt.UUID) ([]byte, error) {
 	uuid, err := uuid.Parse(string(id))
 	if err != nil {
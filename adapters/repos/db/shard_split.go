@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// number of objects copied to a split shard at once
+const shardSplitBatchSize = 100
+
+// SplitShards splits the local shards as described by split, see
+// sharding.State.SplitShards. While split.Since is zero, the objects which
+// belong to a new shard are copied to it, the indexes of the new shard are
+// built as the objects are added. Once the new shards receive writes, the
+// split is completed:
+//
+//   - objects which were deleted from the existing shard during the copy are
+//     deleted from the new shard
+//   - objects which were updated during the copy are copied again, unless
+//     the new shard already has a more recent version
+//   - the moved objects are deleted from the existing shard
+func (db *DB) SplitShards(ctx context.Context, class string, split sharding.Split) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %q not found locally", class)
+	}
+
+	for name, sourceName := range split.Shards {
+		if err := index.splitShard(ctx, &split.State, name, sourceName,
+			split.Since); err != nil {
+			return fmt.Errorf("split shard %q from %q: %w", name, sourceName, err)
+		}
+	}
+	return nil
+}
+
+func (i *Index) splitShard(ctx context.Context, state *sharding.State,
+	name, sourceName string, since int64,
+) error {
+	source := i.shards.Load(sourceName)
+	if source == nil { // the shard is not on this node
+		return nil
+	}
+
+	target := i.shards.Load(name)
+	if target == nil {
+		if since != 0 {
+			return fmt.Errorf("shard %q not found locally", name)
+		}
+		if err := i.addNewShard(ctx, nil, name); err != nil {
+			return err
+		}
+		target = i.shards.Load(name)
+	}
+
+	l := i.logger.WithField("action", "split_shard").
+		WithField("class", i.Config.ClassName).
+		WithField("shard", name).
+		WithField("source", sourceName)
+
+	if since == 0 {
+		n, err := i.copySplitObjects(ctx, state, source, target, 0)
+		l.WithField("objects", n).Info("objects copied to split shard")
+		return err
+	}
+
+	deleted, err := i.deleteRemovedSplitObjects(ctx, source, target, since)
+	if err != nil {
+		return fmt.Errorf("delete removed objects: %w", err)
+	}
+	copied, err := i.copySplitObjects(ctx, state, source, target, since)
+	if err != nil {
+		return fmt.Errorf("copy updated objects: %w", err)
+	}
+	ids, err := splitObjectIDs(ctx, state, source, name, 0)
+	if err != nil {
+		return err
+	}
+	if err := deleteObjectsByKey(ctx, source, ids); err != nil {
+		return fmt.Errorf("delete moved objects: %w", err)
+	}
+
+	l.WithField("deleted", deleted).WithField("updated", copied).
+		WithField("moved", len(ids)).Info("shard split completed")
+	return nil
+}
+
+// splitObjectIDs returns the keys of the objects of source which belong to
+// the shard after the split and were updated at or after since
+func splitObjectIDs(ctx context.Context, state *sharding.State, source *Shard,
+	shard string, since int64,
+) ([][]byte, error) {
+	var ids [][]byte
+	err := source.scanObjectDigests(ctx, nil, func(id []byte, updateTime int64) (bool, error) {
+		if updateTime >= since && state.PhysicalShard(id) == shard {
+			ids = append(ids, append([]byte{}, id...))
+		}
+		return true, nil
+	})
+	return ids, err
+}
+
+// copySplitObjects copies the objects which belong to target after the split
+// and were updated at or after since from source to target
+func (i *Index) copySplitObjects(ctx context.Context, state *sharding.State,
+	source, target *Shard, since int64,
+) (int, error) {
+	ids, err := splitObjectIDs(ctx, state, source, target.name, since)
+	if err != nil {
+		return 0, err
+	}
+
+	bucket := source.store.Bucket(helpers.ObjectsBucketLSM)
+	targetBucket := target.store.Bucket(helpers.ObjectsBucketLSM)
+	n := 0
+	for start := 0; start < len(ids); start += shardSplitBatchSize {
+		end := start + shardSplitBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch := make([]*storobj.Object, 0, end-start)
+		for _, id := range ids[start:end] {
+			data, err := bucket.Get(id)
+			if err != nil {
+				return n, err
+			}
+			if data == nil { // deleted in the meantime
+				continue
+			}
+			obj, err := storobj.FromBinary(data)
+			if err != nil {
+				return n, fmt.Errorf("unmarshal object %x: %w", id, err)
+			}
+			if since != 0 {
+				// the target receives writes already, keep its newer versions
+				current, err := targetBucket.Get(id)
+				if err != nil {
+					return n, err
+				}
+				if current != nil {
+					updateTime, err := storobj.LastUpdateTimeFromBinary(current)
+					if err != nil {
+						return n, err
+					}
+					if updateTime >= obj.LastUpdateTimeUnix() {
+						continue
+					}
+				}
+			}
+			batch = append(batch, obj)
+		}
+
+		for _, err := range target.putObjectBatch(ctx, batch) {
+			if err != nil {
+				return n, err
+			}
+		}
+		n += len(batch)
+	}
+	return n, nil
+}
+
+// deleteRemovedSplitObjects deletes the objects from target which were
+// copied before since and have been deleted from source in the meantime
+func (i *Index) deleteRemovedSplitObjects(ctx context.Context,
+	source, target *Shard, since int64,
+) (int, error) {
+	bucket := source.store.Bucket(helpers.ObjectsBucketLSM)
+	var ids [][]byte
+	err := target.scanObjectDigests(ctx, nil, func(id []byte, updateTime int64) (bool, error) {
+		if updateTime >= since {
+			return true, nil
+		}
+		data, err := bucket.Get(id)
+		if err != nil {
+			return false, err
+		}
+		if data == nil {
+			ids = append(ids, append([]byte{}, id...))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), deleteObjectsByKey(ctx, target, ids)
+}
+
+func deleteObjectsByKey(ctx context.Context, s *Shard, ids [][]byte) error {
+	for _, id := range ids {
+		u, err := uuid.FromBytes(id)
+		if err != nil {
+			return fmt.Errorf("object %x: %w", id, err)
+		}
+		if err := s.deleteObject(ctx, strfmt.UUID(u.String())); err != nil {
+			return fmt.Errorf("object %s: %w", u, err)
+		}
+	}
+	return nil
+}
@@ -12,6 +12,7 @@
 package db
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -46,11 +47,60 @@ func (s *Shard) updateStatus(in string) error {
 	}
 
 	s.status = targetStatus
+	s.writeFence = ""
 	s.updateStoreStatus(targetStatus)
 
 	return nil
 }
 
+// readOnlyErr is returned by the writes to a READONLY shard, it names the
+// reason if the disk watchdog fenced the shard
+func (s *Shard) readOnlyErr() error {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.writeFence == "" {
+		return storagestate.ErrStatusReadOnly
+	}
+	return fmt.Errorf("%w: %s", storagestate.ErrStatusReadOnly, s.writeFence)
+}
+
+// fenceWrites switches the shard to READONLY on behalf of the disk watchdog.
+// A shard which is READONLY already is left alone, so the watchdog never
+// lifts a status it didn't set. It reports whether the shard was fenced.
+func (s *Shard) fenceWrites(reason string) bool {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.status == storagestate.StatusReadOnly {
+		if s.writeFence != "" {
+			s.writeFence = reason
+		}
+		return false
+	}
+
+	s.status = storagestate.StatusReadOnly
+	s.writeFence = reason
+	s.updateStoreStatus(storagestate.StatusReadOnly)
+	return true
+}
+
+// unfenceWrites switches a shard fenced by the disk watchdog back to READY.
+// It reports whether the shard was fenced.
+func (s *Shard) unfenceWrites() bool {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.writeFence == "" {
+		return false
+	}
+
+	s.status = storagestate.StatusReady
+	s.writeFence = ""
+	s.updateStoreStatus(storagestate.StatusReady)
+	return true
+}
+
 func (s *Shard) updateStoreStatus(targetStatus storagestate.Status) {
 	s.store.UpdateBucketsStatus(targetStatus)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -25,12 +26,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 func TestShard_UpdateStatus(t *testing.T) {
@@ -80,6 +84,100 @@ func TestShard_UpdateStatus(t *testing.T) {
 	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
 }
 
+func TestShard_Preconditions(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+
+	obj := testObject(className)
+	obj.Object.LastUpdateTimeUnix = 100
+	id := obj.ID()
+	require.Nil(t, shd.putObject(ctx, obj))
+
+	conditional := func(version int64) context.Context {
+		p := objects.Preconditions{}
+		p.Add(id, version)
+		return objects.WithPreconditions(ctx, p)
+	}
+
+	t.Run("put with outdated version", func(t *testing.T) {
+		next := testObject(className)
+		next.Object.ID = id
+		next.Object.LastUpdateTimeUnix = 101
+		err := shd.putObject(conditional(99), next)
+		assert.True(t, errors.As(err, &objects.ErrConflict{}), "got %v", err)
+	})
+
+	t.Run("put with current version", func(t *testing.T) {
+		next := testObject(className)
+		next.Object.ID = id
+		next.Object.LastUpdateTimeUnix = 101
+		require.Nil(t, shd.putObject(conditional(100), next))
+	})
+
+	t.Run("merge with outdated version", func(t *testing.T) {
+		doc := objects.MergeDocument{Class: className, ID: id, UpdateTime: 102}
+		err := shd.mergeObject(conditional(100), doc)
+		assert.True(t, errors.As(err, &objects.ErrConflict{}), "got %v", err)
+	})
+
+	t.Run("delete with outdated version", func(t *testing.T) {
+		err := shd.deleteObject(conditional(100), id)
+		assert.True(t, errors.As(err, &objects.ErrConflict{}), "got %v", err)
+
+		found, err := shd.exists(ctx, id)
+		require.Nil(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("delete with current version", func(t *testing.T) {
+		require.Nil(t, shd.deleteObject(conditional(101), id))
+	})
+
+	t.Run("put of deleted object", func(t *testing.T) {
+		err := shd.putObject(conditional(101), obj)
+		assert.True(t, errors.As(err, &objects.ErrConflict{}), "got %v", err)
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+type fakeChangeRecorder struct {
+	sync.Mutex
+	ids []strfmt.UUID
+}
+
+func (r *fakeChangeRecorder) RecordChange(class, tenant string, id strfmt.UUID) {
+	r.Lock()
+	defer r.Unlock()
+	r.ids = append(r.ids, id)
+}
+
+func TestShard_RecordsChanges(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	recorder := &fakeChangeRecorder{}
+	idx.Config.ShardChangeRecorders = []ChangeRecorder{recorder}
+
+	obj := testObject(className)
+	id := obj.ID()
+	require.Nil(t, shd.putObject(ctx, obj))
+	require.Nil(t, shd.mergeObject(ctx, objects.MergeDocument{Class: className, ID: id, UpdateTime: 1}))
+	// a write coordinated by another node
+	resp := shd.prepareDeleteObject(ctx, "req", id)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, replica.SimpleResponse{}, shd.commit(ctx, "req", &sync.RWMutex{}))
+
+	batch := []*storobj.Object{testObject(className), testObject(className)}
+	for _, err := range shd.putObjectBatch(ctx, batch) {
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, []strfmt.UUID{id, id, id, batch[0].ID(), batch[1].ID()}, recorder.ids)
+	require.Nil(t, idx.drop())
+}
+
 func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	amount := 10000
 	sizePerValue := 8
@@ -19,18 +19,21 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object,
-) []error {
+) (errs []error) {
 	if s.isReadOnly() {
-		return []error{storagestate.ErrStatusReadOnly}
+		return []error{s.readOnlyErr()}
+	}
+	if err := s.health.beginWrite(); err != nil {
+		return duplicateErr(err, len(objects))
 	}
 
+	defer func(start time.Time) { s.health.endWriteBatch(start, errs) }(time.Now())
 	return s.putBatch(ctx, objects)
 }
 
@@ -50,6 +53,10 @@ func (s *Shard) putBatch(ctx context.Context,
 	batcher.wg.Wait()
 	s.metrics.VectorIndex(batcher.batchStartTime)
 
+	for _, object := range objects {
+		s.index.recordChange(s.usageKey(object), object.ID())
+	}
+
 	return err
 }
 
@@ -157,7 +164,7 @@ func (ob *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 		return err
 	}
 
-	status, err := ob.shard.putObjectLSM(object, idBytes)
+	status, err := ob.shard.putObjectLSM(ctx, object, idBytes)
 	if err != nil {
 		return err
 	}
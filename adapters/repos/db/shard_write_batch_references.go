@@ -32,7 +32,7 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 	refs objects.BatchReferences,
 ) []error {
 	if s.isReadOnly() {
-		return []error{errors.Errorf("shard is read-only")}
+		return []error{s.readOnlyErr()}
 	}
 
 	return newReferencesBatcher(s).References(ctx, refs)
@@ -60,6 +60,9 @@ func (b *referencesBatcher) References(ctx context.Context,
 	b.init(refs)
 	b.storeInObjectStore(ctx)
 	b.flushWALs(ctx)
+	for _, ref := range refs {
+		b.shard.index.recordChange(ref.Tenant, ref.From.TargetID)
+	}
 	return b.errs
 }
 
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"golang.org/x/sync/errgroup"
+)
+
+// batchUpdateProgressInterval controls how often the progress of a batch
+// update is logged
+const batchUpdateProgressInterval = 10 * time.Second
+
+// mergeFn merges a single document into the object it identifies
+type mergeFn func(ctx context.Context, doc objects.MergeDocument) error
+
+// updateObjectBatch merges update into every object identified by docIDs.
+// The doc ids are resolved on this shard, the merge itself is carried out by
+// merge. With dryRun set the matching objects are only listed.
+func (s *Shard) updateObjectBatch(ctx context.Context, docIDs []uint64,
+	update objects.MergeDocument, dryRun bool, merge mergeFn,
+) objects.BatchSimpleObjects {
+	if s.isReadOnly() && !dryRun {
+		return objects.BatchSimpleObjects{
+			objects.BatchSimpleObject{Err: s.readOnlyErr()},
+		}
+	}
+
+	progress := newBatchUpdateProgress(s, len(docIDs), dryRun)
+	defer progress.done()
+
+	result := make(objects.BatchSimpleObjects, len(docIDs))
+	eg := new(errgroup.Group)
+	eg.SetLimit(2 * runtime.GOMAXPROCS(0))
+	for i, docID := range docIDs {
+		i, docID := i, docID
+		eg.Go(func() error {
+			result[i] = s.updateObjectOfBatch(ctx, docID, update, dryRun, merge)
+			progress.add(result[i].Err)
+			return nil
+		})
+	}
+	eg.Wait()
+
+	return result
+}
+
+func (s *Shard) updateObjectOfBatch(ctx context.Context, docID uint64,
+	update objects.MergeDocument, dryRun bool, merge mergeFn,
+) objects.BatchSimpleObject {
+	if err := ctx.Err(); err != nil {
+		return objects.BatchSimpleObject{Err: errors.Wrap(err, "batch update")}
+	}
+
+	id, err := s.uuidFromDocID(docID)
+	if err != nil || dryRun {
+		return objects.BatchSimpleObject{UUID: id, Err: err}
+	}
+
+	doc := update
+	doc.ID = id
+	return objects.BatchSimpleObject{UUID: id, Err: merge(ctx, doc)}
+}
+
+// mergeObjectOfBatch is the mergeFn for updates which are applied to this
+// shard only. The WALs are flushed once the whole batch is done.
+func (s *Shard) mergeObjectOfBatch(ctx context.Context, doc objects.MergeDocument) error {
+	idBytes, err := uuid.MustParse(doc.ID.String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return s.mergeUnflushed(ctx, idBytes, doc)
+}
+
+// flushObjectBatch writes the WALs of a batch of merges applied through
+// mergeObjectOfBatch, a failing flush fails all objects of the batch
+func (s *Shard) flushObjectBatch(objs objects.BatchSimpleObjects) {
+	err := s.store.WriteWALs()
+	if err == nil {
+		err = s.vectorIndex.Flush()
+	}
+	if err == nil {
+		err = s.propLengths.Flush(false)
+	}
+	if err == nil {
+		return
+	}
+	for i := range objs {
+		objs[i].Err = errors.Wrap(err, "flush WALs")
+	}
+}
+
+// batchUpdateProgress periodically logs how far a batch update on a shard
+// got, as such an update can touch a large part of the shard
+type batchUpdateProgress struct {
+	logger    logrus.FieldLogger
+	total     int
+	processed atomic.Int64
+	failed    atomic.Int64
+	started   time.Time
+	lastLog   atomic.Int64
+}
+
+func newBatchUpdateProgress(s *Shard, total int, dryRun bool) *batchUpdateProgress {
+	now := time.Now()
+	p := &batchUpdateProgress{
+		logger: s.index.logger.WithFields(logrus.Fields{
+			"action":  "batch_update",
+			"class":   s.index.Config.ClassName,
+			"shard":   s.name,
+			"dry_run": dryRun,
+		}),
+		total:   total,
+		started: now,
+	}
+	p.lastLog.Store(now.UnixNano())
+	return p
+}
+
+func (p *batchUpdateProgress) add(err error) {
+	p.processed.Add(1)
+	if err != nil {
+		p.failed.Add(1)
+	}
+
+	now := time.Now().UnixNano()
+	last := p.lastLog.Load()
+	if time.Duration(now-last) < batchUpdateProgressInterval ||
+		!p.lastLog.CompareAndSwap(last, now) {
+		return
+	}
+	p.log().Info("batch update in progress")
+}
+
+func (p *batchUpdateProgress) done() {
+	p.log().WithField("took", time.Since(p.started)).Debug("batch update finished")
+}
+
+func (p *batchUpdateProgress) log() logrus.FieldLogger {
+	return p.logger.WithFields(logrus.Fields{
+		"processed": p.processed.Load(),
+		"failed":    p.failed.Load(),
+		"total":     p.total,
+	})
+}
@@ -14,109 +14,72 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
-	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-//nolint:all
-func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
+func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) (err error) {
 	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+		return s.readOnlyErr()
 	}
+	if err := s.health.beginWrite(); err != nil {
+		return err
+	}
+	defer s.health.endWrite(time.Now(), &err)
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
+	return s.deleteOne(ctx, id, idBytes)
+}
 
-	var docID uint64
+func (s *Shard) deleteOne(ctx context.Context, id strfmt.UUID, idBytes []byte) error {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	existing, err := bucket.Get([]byte(idBytes))
-	if err != nil {
-		return errors.Wrap(err, "unexpected error on previous lookup")
-	}
-
-	if existing == nil {
-		// nothing to do
-		return nil
-	}
-
-	// we need the doc ID so we can clean up inverted indices currently
-	// pointing to this object
-	docID, err = storobj.DocIDFromBinary(existing)
-	if err != nil {
-		return errors.Wrap(err, "get existing doc id from object binary")
-	}
 
-	err = bucket.Delete(idBytes)
-	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
-	}
-
-	err = s.cleanupInvertedIndexOnDelete(existing, docID)
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	existing, err := bucket.Get(idBytes)
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
-	}
-
-	// in-mem
-	// TODO: do we still need this?
-	s.deletedDocIDs.Add(docID)
-
-	if err := s.vectorIndex.Delete(docID); err != nil {
-		return errors.Wrap(err, "delete from vector index")
-	}
-
-	if err := s.store.WriteWALs(); err != nil {
-		return errors.Wrap(err, "flush all buffered WALs")
-	}
-
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush all vector index buffered WALs")
+		lock.Unlock()
+		return fmt.Errorf("get previous object: %w", err)
 	}
 
-	return nil
-}
-
-func (s *Shard) canDeleteOne(ctx context.Context, id strfmt.UUID) (bucket *lsmkv.Bucket, obj, uid []byte, docID uint64, err error) {
-	if uid, err = parseBytesUUID(id); err != nil {
-		return nil, nil, uid, 0, err
-	}
-
-	bucket = s.store.Bucket(helpers.ObjectsBucketLSM)
-	existing, err := bucket.Get(uid)
-	if err != nil {
-		return nil, nil, uid, 0, fmt.Errorf("get previous object: %w", err)
+	if err := checkPrecondition(ctx, id, existing); err != nil {
+		lock.Unlock()
+		return err
 	}
 
 	if existing == nil {
-		return bucket, nil, uid, 0, nil
+		// nothing to do
+		lock.Unlock()
+		return nil
 	}
 
 	// we need the doc ID so we can clean up inverted indices currently
 	// pointing to this object
-	docID, err = storobj.DocIDFromBinary(existing)
+	docID, err := storobj.DocIDFromBinary(existing)
 	if err != nil {
-		return bucket, nil, uid, 0, fmt.Errorf("get existing doc id from object binary: %w", err)
+		lock.Unlock()
+		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
-	return bucket, existing, uid, docID, nil
-}
 
-func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idBytes []byte, docID uint64) error {
-	if obj == nil || bucket == nil {
-		return nil
-	}
-	err := bucket.Delete(idBytes)
+	err = bucket.Delete(idBytes)
+	lock.Unlock()
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	tenant := s.usageKeyFromBinary(existing)
+	s.trackUsage(tenant, objectUsageDelta(existing, nil))
+	defer s.index.recordChange(tenant, id)
 
-	err = s.cleanupInvertedIndexOnDelete(obj, docID)
+	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -141,10 +104,15 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 }
 
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
+	if s.index.Config.VectorOnly {
+		return nil
+	}
+
 	previousObject, err := storobj.FromBinary(previous)
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
+	s.index.withCurrentProperties(previousObject)
 
 	// TODO text_rbm_inverted_index null props cleanup?
 	previousInvertProps, _, err := s.analyzeObject(previousObject)
@@ -159,6 +127,10 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return errors.Wrap(err, "put inverted indices props")
 	}
 
+	if err := s.deleteFromPropertySpecificIndices(docID); err != nil {
+		return errors.Wrap(err, "delete from property specific indices")
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		err = s.removeDimensionsLSM(len(previousObject.Vector), docID)
 		if err != nil {
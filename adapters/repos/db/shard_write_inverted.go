@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -53,11 +54,18 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		schemaMap = maybeSchemaMap
 	}
 
+	// nested properties are indexed by the dotted paths of their leaves
+	props := c.Properties
+	if hasNestedProperty(props) {
+		props = schema.FlattenNestedProperties(c.Properties)
+		schemaMap = schema.FlattenNestedValues(c.Properties, schemaMap)
+	}
+
 	// add nil for all properties that are not part of the object so that they can be added to the inverted index for
 	// the null state (if enabled)
 	var nilProps []nilProp
 	if s.index.invertedIndexConfig.IndexNullState {
-		for _, prop := range c.Properties {
+		for _, prop := range props {
 			dt := schema.DataType(prop.DataType[0])
 			// some datatypes are not added to the inverted index, so we can skip them here
 			if dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypePhoneNumber || dt == schema.DataTypeBlob {
@@ -85,6 +93,18 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
-	return props, nilProps, err
+	analyzed, err := inverted.NewAnalyzer(s.isFallbackToSearchable).WithClass(c).Object(schemaMap, props, object.ID())
+	if prop, ok := sharedTenantProperty(object); ok && err == nil {
+		analyzed = append(analyzed, prop)
+	}
+	return analyzed, nilProps, err
+}
+
+func hasNestedProperty(props []*models.Property) bool {
+	for _, prop := range props {
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			return true
+		}
+	}
+	return false
 }
@@ -92,7 +92,7 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		propLen := float32(len(property.Items))
 		for _, item := range property.Items {
 			key := item.Data
-			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen, item.Positions)
 			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
@@ -134,9 +134,12 @@ func (s *Shard) addToPropertyNullIndex(propName string, docID uint64, isNull boo
 	return nil
 }
 
-func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) lsmkv.MapPair {
-	// 8 bytes for doc id, 4 bytes for frequency, 4 bytes for prop term length
-	buf := make([]byte, 16)
+func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32,
+	positions []uint32,
+) lsmkv.MapPair {
+	// 8 bytes for doc id, 4 bytes for frequency, 4 bytes for prop term length,
+	// followed by 4 bytes per term position if the property indexes positions
+	buf := make([]byte, 16+4*len(positions))
 
 	// Shard Index version 2 requires BigEndian for sorting, if the shard was
 	// built prior assume it uses LittleEndian
@@ -147,6 +150,9 @@ func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) l
 	}
 	binary.LittleEndian.PutUint32(buf[8:12], math.Float32bits(freq))
 	binary.LittleEndian.PutUint32(buf[12:16], math.Float32bits(propLen))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(buf[16+4*i:20+4*i], pos)
+	}
 
 	return lsmkv.MapPair{
 		Key:   buf[:8],
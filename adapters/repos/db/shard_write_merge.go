@@ -13,19 +13,22 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/models"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
-func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
+func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) (err error) {
 	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+		return s.readOnlyErr()
+	}
+	if err := s.health.beginWrite(); err != nil {
+		return err
 	}
 
 	if merge.Vector != nil {
@@ -41,14 +44,34 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return err
 	}
 
+	defer s.health.endWrite(time.Now(), &err)
 	return s.merge(ctx, idBytes, merge)
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
-	next, status, err := s.mergeObjectInStorage(doc, idBytes)
+	if err := s.mergeUnflushed(ctx, idBytes, doc); err != nil {
+		return err
+	}
+
+	if err := s.store.WriteWALs(); err != nil {
+		return errors.Wrap(err, "flush all buffered WALs")
+	}
+
+	if err := s.vectorIndex.Flush(); err != nil {
+		return errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
+	return nil
+}
+
+// mergeUnflushed merges doc into the stored object and updates all indices,
+// but leaves flushing the WALs to the caller
+func (s *Shard) mergeUnflushed(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
+	next, status, err := s.mergeObjectInStorage(ctx, doc, idBytes)
 	if err != nil {
 		return err
 	}
+	defer s.index.recordChange(s.usageKey(next), doc.ID)
 
 	if err := s.updateVectorIndex(next.Vector, status); err != nil {
 		return errors.Wrap(err, "update vector index")
@@ -58,18 +81,10 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "update property-specific indices")
 	}
 
-	if err := s.store.WriteWALs(); err != nil {
-		return errors.Wrap(err, "flush all buffered WALs")
-	}
-
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush all vector index buffered WALs")
-	}
-
 	return nil
 }
 
-func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
+func (s *Shard) mergeObjectInStorage(ctx context.Context, merge objects.MergeDocument,
 	idBytes []byte,
 ) (*storobj.Object, objectInsertStatus, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
@@ -83,6 +98,11 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, objectInsertStatus{}, errors.Wrap(err, "get bucket")
 	}
 
+	if err := checkPrecondition(ctx, merge.ID, previous); err != nil {
+		lock.Unlock()
+		return nil, objectInsertStatus{}, err
+	}
+
 	nextObj, _, err := s.mergeObjectData(previous, merge)
 	if err != nil {
 		lock.Unlock()
@@ -102,7 +122,15 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	tenant := s.usageKey(nextObj)
+	delta := objectUsageDelta(previous, nextBytes)
+	if err := s.reserveUsage(tenant, delta); err != nil {
+		lock.Unlock()
+		return nil, status, err
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+		s.trackUsage(tenant, delta.negate())
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "upsert object data")
 	}
@@ -170,7 +198,14 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	tenant := s.usageKey(nextObj)
+	delta := objectUsageDelta(previous, nextBytes)
+	if err := s.reserveUsage(tenant, delta); err != nil {
+		return out, err
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+		s.trackUsage(tenant, delta.negate())
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
@@ -203,9 +238,12 @@ func (s *Shard) mergeObjectData(previous []byte,
 		}
 
 		previousObj = p
+		s.index.withCurrentProperties(previousObj)
 	}
 
-	return mergeProps(previousObj, merge), previousObj, nil
+	next := mergeProps(previousObj, merge)
+	s.index.withCurrentProperties(next)
+	return next, previousObj, nil
 }
 
 func mergeProps(previous *storobj.Object,
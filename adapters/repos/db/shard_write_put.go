@@ -18,19 +18,23 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+		return s.readOnlyErr()
+	}
+	if err := s.health.beginWrite(); err != nil {
+		return err
 	}
 	uuid, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
@@ -39,7 +43,7 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 	return s.putOne(ctx, uuid, object)
 }
 
-func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
+func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) (err error) {
 	if object.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
 		err := s.vectorIndex.ValidateBeforeInsert(object.Vector)
@@ -47,11 +51,15 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	defer s.health.endWrite(time.Now(), &err)
 
-	status, err := s.putObjectLSM(object, uuid)
+	status, err := s.putObjectLSM(ctx, object, uuid)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
+	// recorded once all indices are updated, so queries started before
+	// can't be cached without the object
+	defer s.index.recordChange(s.usageKey(object), object.ID())
 
 	if err := s.updateVectorIndex(object.Vector, status); err != nil {
 		return errors.Wrap(err, "update vector index")
@@ -121,7 +129,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	return nil
 }
 
-func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
+func (s *Shard) putObjectLSM(ctx context.Context, object *storobj.Object, idBytes []byte,
 ) (objectInsertStatus, error) {
 	before := time.Now()
 	defer s.metrics.PutObject(before)
@@ -138,6 +146,15 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 		return objectInsertStatus{}, err
 	}
 
+	// the object may have been validated before a property was renamed or
+	// dropped, it is stored with the current properties
+	s.index.withCurrentProperties(object)
+
+	if err := checkPrecondition(ctx, object.ID(), previous_object_bytes); err != nil {
+		lock.Unlock()
+		return objectInsertStatus{}, err
+	}
+
 	status, err := s.determineInsertStatus(previous_object_bytes, object)
 	if err != nil {
 		lock.Unlock()
@@ -152,8 +169,16 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 		return status, errors.Wrapf(err, "marshal object %s to binary", object.ID())
 	}
 
+	tenant := s.usageKey(object)
+	delta := objectUsageDelta(previous_object_bytes, data)
+	if err := s.reserveUsage(tenant, delta); err != nil {
+		lock.Unlock()
+		return status, err
+	}
+
 	before = time.Now()
 	if err := s.upsertObjectDataLSM(bucket, idBytes, data, status.docID); err != nil {
+		s.trackUsage(tenant, delta.negate())
 		lock.Unlock()
 		return status, errors.Wrap(err, "upsert object data")
 	}
@@ -170,6 +195,25 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	return status, nil
 }
 
+// checkPrecondition checks the precondition ctx carries for the object id, if
+// any, against previous, the object as it is currently stored. It must be
+// called under the doc id lock of the object, so that no other write can
+// change the object between the check and the write.
+func checkPrecondition(ctx context.Context, id strfmt.UUID, previous []byte) error {
+	if objects.PreconditionsFrom(ctx) == nil {
+		return nil
+	}
+	if previous == nil {
+		return objects.CheckPrecondition(ctx, id, false, 0)
+	}
+
+	version, err := storobj.LastUpdateTimeFromBinary(previous)
+	if err != nil {
+		return errors.Wrap(err, "get previous version from object binary")
+	}
+	return objects.CheckPrecondition(ctx, id, true, version)
+}
+
 type objectInsertStatus struct {
 	docID        uint64
 	docIDChanged bool
@@ -254,6 +298,10 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	status objectInsertStatus, previous []byte,
 ) error {
+	if s.index.Config.VectorOnly {
+		return nil
+	}
+
 	props, nilprops, err := s.analyzeObject(object)
 	if err != nil {
 		return errors.Wrap(err, "analyze next object")
@@ -262,6 +310,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	if status.docIDChanged {
 		oldObject, err := storobj.FromBinary(previous)
 		if err == nil {
+			s.index.withCurrentProperties(oldObject)
 
 			oldProps, _, err := s.analyzeObject(oldObject)
 			if err != nil {
@@ -356,6 +405,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
+	s.index.withCurrentProperties(previousObject)
 
 	// TODO text_rbm_inverted_index null props cleanup?
 	previousInvertProps, _, err := s.analyzeObject(previousObject)
@@ -0,0 +1,309 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// sharedTenantKey is the additional property the tenant of an object in a
+// shared shard is stored under. It is part of the binary object, so the
+// tenant is known on every replica and when the object is deleted.
+const sharedTenantKey = "sharedTenant"
+
+// sharedTenantMoveBatchSize is the number of objects which are moved at once
+// when a tenant leaves its shared shard
+const sharedTenantMoveBatchSize = 100
+
+// TenantPromoter moves tenants which have outgrown their shared shard into a
+// dedicated shard
+type TenantPromoter interface {
+	PromoteTenant(ctx context.Context, class, tenant string) error
+}
+
+func (db *DB) SetTenantPromoter(p TenantPromoter) {
+	db.tenantPromoter = p
+}
+
+// sharedTenant returns the tenant of an object in a shared shard and an
+// empty string for all other objects
+func sharedTenant(obj *storobj.Object) string {
+	tenant, _ := obj.Object.Additional[sharedTenantKey].(string)
+	return tenant
+}
+
+// sharedTenantProperty indexes the tenant of an object in a shared shard.
+// The bitmap of a tenant is the allow list of all its searches.
+func sharedTenantProperty(obj *storobj.Object) (inverted.Property, bool) {
+	tenant := sharedTenant(obj)
+	if tenant == "" {
+		return inverted.Property{}, false
+	}
+
+	return inverted.Property{
+		Name:               filters.InternalPropTenant,
+		Items:              []inverted.Countable{{Data: []byte(tenant)}},
+		HasFilterableIndex: true,
+	}, true
+}
+
+func (s *Shard) addSharedTenantProperty(ctx context.Context) error {
+	return s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(filters.InternalPropTenant),
+		s.memtableIdleConfig(),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+}
+
+// largeSharedTenants returns the tenants of a shared shard which have more
+// than threshold objects
+func (s *Shard) largeSharedTenants(threshold int) []string {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropTenant))
+	if bucket == nil {
+		return nil
+	}
+
+	var tenants []string
+	cursor := bucket.CursorRoaringSet()
+	defer cursor.Close()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if v.GetCardinality() > threshold {
+			tenants = append(tenants, string(k))
+		}
+	}
+	return tenants
+}
+
+// isSharedTenant reports whether the objects of a tenant are in a shared
+// shard
+func (i *Index) isSharedTenant(tenant string) bool {
+	return tenant != "" && sharding.IsSharedShardName(
+		i.getSchema.TenantShard(i.Config.ClassName.String(), tenant))
+}
+
+// tenantFilter restricts a filter to the objects of a tenant of a shared
+// shard. The filters of all other tenants are returned unchanged.
+func (i *Index) tenantFilter(filter *filters.LocalFilter, tenant string) *filters.LocalFilter {
+	if !i.isSharedTenant(tenant) {
+		return filter
+	}
+
+	clause := filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: i.Config.ClassName, Property: filters.InternalPropTenant},
+		Value:    &filters.Value{Value: tenant, Type: schema.DataTypeText},
+	}
+	if filter == nil || filter.Root == nil {
+		return &filters.LocalFilter{Root: &clause}
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{*filter.Root, clause},
+	}}
+}
+
+// foreignObject reports whether obj belongs to another tenant of the shared
+// shard of tenant. Such objects are treated as if they did not exist.
+func (i *Index) foreignObject(obj *storobj.Object, tenant string) bool {
+	return obj != nil && i.isSharedTenant(tenant) && sharedTenant(obj) != tenant
+}
+
+// claimSharedObjects stamps objects which are written to a shared shard with
+// their tenant. Ids are unique per shard rather than per tenant, so the
+// objects of other tenants must not be overwritten.
+func (i *Index) claimSharedObjects(ctx context.Context, shardName string,
+	objs []*storobj.Object,
+) []error {
+	ids := make([]strfmt.UUID, len(objs))
+	for j, obj := range objs {
+		ids[j] = obj.ID()
+	}
+
+	var (
+		existing []*storobj.Object
+		errs     = make([]error, len(objs))
+	)
+	shard, release, err := i.getShard(ctx, shardName)
+	if err == nil && shard != nil {
+		existing, err = shard.multiObjectByID(ctx, wrapIDsInMulti(ids), additional.Properties{})
+		release()
+	} else if err == nil {
+		existing, err = i.remote.MultiGetObjects(ctx, shardName, ids)
+	}
+	if err != nil {
+		return duplicateErr(fmt.Errorf("check ids in shared shard %q: %w", shardName, err), len(objs))
+	}
+
+	for j, obj := range objs {
+		tenant := obj.Object.Tenant
+		if j < len(existing) && existing[j] != nil && sharedTenant(existing[j]) != tenant {
+			errs[j] = objects.NewErrInvalidUserInput(
+				"id %s is already used by another tenant in shared shard %s", obj.ID(), shardName)
+			continue
+		}
+		if obj.Object.Additional == nil {
+			obj.Object.Additional = models.AdditionalProperties{}
+		}
+		obj.Object.Additional[sharedTenantKey] = tenant
+	}
+	return errs
+}
+
+// moveSharedTenant moves the objects of a tenant out of the local shared
+// shard from into the local shard to, which is created if needed. If to is
+// empty the objects are deleted. Objects are copied before they are
+// deleted, so an interrupted move can be repeated.
+func (i *Index) moveSharedTenant(ctx context.Context, tenant, from, to string) error {
+	src, release, err := i.getShard(ctx, from)
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		return nil // the shared shard is hosted by other nodes
+	}
+	defer release()
+
+	var dst *Shard
+	if to != "" {
+		if i.shards.Load(to) == nil && !i.tenants.offloaded(to) {
+			if err := i.addNewShard(ctx, i.class(), to); err != nil {
+				return fmt.Errorf("create shard %q: %w", to, err)
+			}
+		}
+		shard, release, err := i.getShard(ctx, to)
+		if err != nil {
+			return err
+		}
+		defer release()
+		dst = shard
+	}
+
+	bucket := src.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropTenant))
+	if bucket == nil {
+		return nil
+	}
+	docIDs, err := bucket.RoaringSetGet([]byte(tenant))
+	if err != nil {
+		return fmt.Errorf("get objects of tenant %q: %w", tenant, err)
+	}
+
+	ids := docIDs.ToArray()
+	for start := 0; start < len(ids); start += sharedTenantMoveBatchSize {
+		end := start + sharedTenantMoveBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		// the classification flag reads the additional properties as well
+		objs, err := storobj.ObjectsByDocID(src.store.Bucket(helpers.ObjectsBucketLSM),
+			ids[start:end], additional.Properties{Vector: true, Classification: true})
+		if err != nil {
+			return fmt.Errorf("read objects of tenant %q: %w", tenant, err)
+		}
+
+		if dst != nil {
+			for _, obj := range objs {
+				delete(obj.Object.Additional, sharedTenantKey)
+				obj.Object.Tenant = tenant
+			}
+			for _, err := range dst.putObjectBatch(ctx, objs) {
+				if err != nil {
+					return fmt.Errorf("copy objects of tenant %q: %w", tenant, err)
+				}
+			}
+		}
+		for _, obj := range objs {
+			if err := src.deleteObject(ctx, obj.ID()); err != nil {
+				return fmt.Errorf("delete object of tenant %q from shard %q: %w", tenant, from, err)
+			}
+		}
+	}
+	return nil
+}
+
+// promoteLargeTenants asks the promoter to move the tenants of the local
+// shared shards which have outgrown the promotion threshold of the class.
+// Only the first replica of a shared shard asks, so a tenant is promoted
+// once.
+func (i *Index) promoteLargeTenants(ctx context.Context, promoter TenantPromoter) {
+	if !i.partitioningEnabled {
+		return
+	}
+	class := i.class()
+	if class == nil || class.MultiTenancyConfig == nil ||
+		class.MultiTenancyConfig.PromotionThreshold <= 0 {
+		return
+	}
+	threshold := int(class.MultiTenancyConfig.PromotionThreshold)
+
+	var tenants []string
+	i.ForEachShard(func(name string, shard *Shard) error {
+		if !sharding.IsSharedShardName(name) {
+			return nil
+		}
+		if owner, err := i.getSchema.ShardOwner(class.Class, name); err != nil ||
+			owner != i.getSchema.NodeName() {
+			return nil
+		}
+		tenants = append(tenants, shard.largeSharedTenants(threshold)...)
+		return nil
+	})
+
+	for _, tenant := range tenants {
+		if err := promoter.PromoteTenant(ctx, class.Class, tenant); err != nil {
+			i.logger.WithField("action", "promote_tenant").
+				WithField("class", class.Class).WithField("tenant", tenant).
+				WithError(err).Warn("could not move tenant into a dedicated shard")
+		}
+	}
+}
+
+func (db *DB) promoteLargeTenants() {
+	if db.tenantPromoter == nil {
+		return
+	}
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indices = append(indices, index)
+	}
+	db.indexLock.RUnlock()
+
+	for _, index := range indices {
+		index.promoteLargeTenants(context.Background(), db.tenantPromoter)
+	}
+}
+
+// MoveSharedTenant moves the objects of a tenant out of a shared shard into
+// the shard to or deletes them if to is empty
+func (m *Migrator) MoveSharedTenant(ctx context.Context, class *models.Class,
+	tenant, from, to string,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+	return idx.moveSharedTenant(ctx, tenant, from, to)
+}
@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeTenantPromoter struct {
+	sync.Mutex
+	tenants []string
+}
+
+func (p *fakeTenantPromoter) PromoteTenant(ctx context.Context, class, tenant string) error {
+	p.Lock()
+	defer p.Unlock()
+	p.tenants = append(p.tenants, tenant)
+	return nil
+}
+
+func TestSharedTenants(t *testing.T) {
+	dirName := t.TempDir()
+	className := "SharedTenants"
+	nodes := []string{"node1"}
+	sharedShard := sharding.SharedShardName(0)
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{nodes}, 1, true)
+	require.Nil(t, err)
+	p := shardState.AddPartition(sharedShard, nodes)
+	p.Shared = true
+	shardState.Physical[sharedShard] = p
+	shardState.AddSharedPartition("small", sharedShard, nodes)
+	shardState.AddSharedPartition("large", sharedShard, nodes)
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled:            true,
+			SharedShards:       1,
+			PromotionThreshold: 15,
+		},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	ids := map[string][]strfmt.UUID{}
+	for tenant, count := range map[string]int{"small": 10, "large": 20} {
+		batch := make(objects.BatchObjects, count)
+		for i := range batch {
+			id := strfmt.UUID(uuid.NewString())
+			ids[tenant] = append(ids[tenant], id)
+			batch[i] = objects.BatchObject{
+				OriginalIndex: i,
+				UUID:          id,
+				Object:        &models.Object{Class: className, ID: id, Tenant: tenant},
+				Vector:        []float32{float32(i), 1, 2},
+			}
+		}
+		res, err := repo.BatchPutObjects(context.Background(), batch, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			require.Nil(t, obj.Err)
+		}
+	}
+
+	search := func(tenant string) int {
+		found, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: []float32{1, 1, 2},
+			Pagination:   &filters.Pagination{Limit: 100},
+			Tenant:       tenant,
+		})
+		require.Nil(t, err)
+		return len(found)
+	}
+
+	list := func(tenant string) int {
+		found, err := repo.ObjectSearch(context.Background(), 0, 100, nil, nil,
+			additional.Properties{}, tenant)
+		require.Nil(t, err)
+		return len(found)
+	}
+
+	t.Run("tenants only see their own objects", func(t *testing.T) {
+		assert.Equal(t, 10, search("small"))
+		assert.Equal(t, 20, search("large"))
+		assert.Equal(t, 10, list("small"))
+		assert.Equal(t, 20, list("large"))
+	})
+
+	t.Run("objects of other tenants cannot be read by id", func(t *testing.T) {
+		id := ids["large"][0]
+		res, err := repo.ObjectByID(context.Background(), id, nil, additional.Properties{}, "large")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		res, err = repo.ObjectByID(context.Background(), id, nil, additional.Properties{}, "small")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		exists, err := repo.Exists(context.Background(), className, id, nil, "small")
+		require.Nil(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("ids of other tenants cannot be taken over", func(t *testing.T) {
+		id := ids["large"][0]
+		err := repo.PutObject(context.Background(),
+			&models.Object{Class: className, ID: id, Tenant: "small"}, []float32{1, 2, 3}, nil)
+		assert.NotNil(t, err)
+		assert.Equal(t, 10, search("small"))
+		assert.Equal(t, 20, search("large"))
+	})
+
+	t.Run("large tenants are promoted", func(t *testing.T) {
+		promoter := &fakeTenantPromoter{}
+		repo.SetTenantPromoter(promoter)
+		repo.promoteLargeTenants()
+		sort.Strings(promoter.tenants)
+		assert.Equal(t, []string{"large"}, promoter.tenants)
+	})
+
+	t.Run("moving a tenant into a dedicated shard", func(t *testing.T) {
+		require.Nil(t, migrator.MoveSharedTenant(context.Background(), class, "large", sharedShard, "large"))
+		shardState.AddPartition("large", nodes)
+
+		assert.Equal(t, 20, search("large"))
+		assert.Equal(t, 10, search("small"))
+
+		promoter := &fakeTenantPromoter{}
+		repo.SetTenantPromoter(promoter)
+		repo.promoteLargeTenants()
+		assert.Empty(t, promoter.tenants)
+	})
+
+	t.Run("deleting a shared tenant", func(t *testing.T) {
+		require.Nil(t, migrator.MoveSharedTenant(context.Background(), class, "small", sharedShard, ""))
+		assert.Equal(t, 0, search("small"))
+		assert.Equal(t, 20, search("large"))
+	})
+}
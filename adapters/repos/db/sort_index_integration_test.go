@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// The sort by a single int or number property is served by the filterable
+// index, the results must not differ from sorting every object in memory:
+// objects without a value come first (asc) or last (desc), ties are ordered
+// by id.
+func TestSortByFilterableIndex(t *testing.T) {
+	for _, indexNullState := range []bool{false, true} {
+		t.Run(fmt.Sprintf("indexNullState=%v", indexNullState), func(t *testing.T) {
+			testSortByFilterableIndex(t, indexNullState)
+		})
+	}
+}
+
+func testSortByFilterableIndex(t *testing.T, indexNullState bool) {
+	ctx := context.Background()
+	className := "SortByIndexClass"
+	class := &models.Class{
+		Class:             className,
+		VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			IndexNullState:         indexNullState,
+		},
+		Properties: []*models.Property{
+			{Name: "int", DataType: schema.DataTypeInt.PropString()},
+			{Name: "number", DataType: schema.DataTypeNumber.PropString()},
+		},
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       1000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(ctx)
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	type entry struct {
+		id     strfmt.UUID
+		int    *float64
+		number *float64
+	}
+	r := rand.New(rand.NewSource(7))
+	randomValue := func() *float64 {
+		if r.Intn(5) == 0 {
+			return nil
+		}
+		v := float64(r.Intn(10) - 3)
+		return &v
+	}
+
+	entries := make([]*entry, 60)
+	put := func(e *entry) {
+		props := map[string]interface{}{}
+		if e.int != nil {
+			props["int"] = *e.int
+		}
+		if e.number != nil {
+			props["number"] = *e.number / 4
+		}
+		obj := &models.Object{ID: e.id, Class: className, Properties: props}
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil))
+	}
+	for i := range entries {
+		entries[i] = &entry{
+			id:     strfmt.UUID(uuid.New().String()),
+			int:    randomValue(),
+			number: randomValue(),
+		}
+		put(entries[i])
+	}
+	// updates must not leave stale entries behind in the index
+	for _, e := range entries[:20] {
+		e.int, e.number = randomValue(), randomValue()
+		put(e)
+	}
+
+	expected := func(prop, order string, limit int) []strfmt.UUID {
+		value := func(e *entry) *float64 {
+			if prop == "int" {
+				return e.int
+			}
+			return e.number
+		}
+		sorted := make([]*entry, len(entries))
+		copy(sorted, entries)
+		sort.Slice(sorted, func(i, j int) bool {
+			a, b := value(sorted[i]), value(sorted[j])
+			switch {
+			case a == nil && b == nil:
+			case a == nil:
+				return order == "asc"
+			case b == nil:
+				return order == "desc"
+			case *a != *b:
+				return (*a < *b) == (order == "asc")
+			}
+			return sorted[i].id < sorted[j].id
+		})
+		ids := make([]strfmt.UUID, 0, limit)
+		for _, e := range sorted[:limit] {
+			ids = append(ids, e.id)
+		}
+		return ids
+	}
+
+	for _, prop := range []string{"int", "number"} {
+		for _, order := range []string{"asc", "desc"} {
+			for _, limit := range []int{1, 7, 30, 60} {
+				t.Run(fmt.Sprintf("%s %s limit %d", prop, order, limit), func(t *testing.T) {
+					res, err := repo.ObjectSearch(ctx, 0, limit, nil,
+						[]filters.Sort{{Path: []string{prop}, Order: order}},
+						additional.Properties{}, "")
+					require.Nil(t, err)
+
+					ids := make([]strfmt.UUID, len(res))
+					for i := range res {
+						ids[i] = res[i].ID
+					}
+					assert.Equal(t, expected(prop, order, limit), ids)
+				})
+			}
+		}
+	}
+}
@@ -11,7 +11,14 @@
 
 package sorter
 
-import "github.com/weaviate/weaviate/entities/storobj"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
 
 type comparable struct {
 	docID uint64
@@ -55,6 +62,41 @@ func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object,
 	return &comparable{object.DocID(), values, payload}
 }
 
+// createFromCursor creates the comparable of the object at the cursor
+// position. The last sort level is expected to be the object ID.
+func (c *comparableCreator) createFromCursor(pos *filters.CursorPosition) (*comparable, error) {
+	if len(pos.Values) != len(c.propNames)-1 {
+		return nil, fmt.Errorf("cursor does not match the sort of the query")
+	}
+	values := make([]interface{}, len(c.propNames))
+	for level, raw := range pos.Values {
+		value, err := c.extractor.extractFromJSON(raw, c.propNames[level])
+		if err != nil {
+			return nil, errors.Wrapf(err, "cursor value of %s", c.propNames[level])
+		}
+		values[level] = value
+	}
+	id := pos.ID.String()
+	values[len(values)-1] = &id
+	return &comparable{values: values}, nil
+}
+
+// cursorFromObject is the counterpart of createFromCursor
+func (c *comparableCreator) cursorFromObject(object *storobj.Object) (filters.CursorPosition, error) {
+	pos := filters.CursorPosition{
+		Values: make([]json.RawMessage, len(c.propNames)-1),
+		ID:     object.ID(),
+	}
+	for level := range pos.Values {
+		raw, err := json.Marshal(c.extractor.extractFromObject(object, c.propNames[level]))
+		if err != nil {
+			return pos, errors.Wrapf(err, "cursor value of %s", c.propNames[level])
+		}
+		pos.Values[level] = raw
+	}
+	return pos, nil
+}
+
 func (c *comparableCreator) extractDocIDs(comparables []*comparable) []uint64 {
 	docIDs := make([]uint64, len(comparables))
 	for i, comparable := range comparables {
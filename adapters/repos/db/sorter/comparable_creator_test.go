@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestComparableCreator_Cursor(t *testing.T) {
+	obj := createMyFavoriteClassObject()
+	objData, err := obj.MarshalBinary()
+	require.Nil(t, err)
+
+	sch := getMyFavoriteClassSchemaForTests()
+	class := sch.GetClass(testClassName)
+	dataTypesHelper := newDataTypesHelper(class)
+	extractor := newComparableValueExtractor(dataTypesHelper)
+
+	propNames := []string{
+		"textProp", "textPropArray", "intProp", "numberProp", "intPropArray",
+		"numberPropArray", "boolProp", "boolPropArray", "dateProp", "datePropArray",
+		"phoneProp", "geoProp", "emptyStringProp", "_creationTimeUnix",
+	}
+	for _, propName := range propNames {
+		t.Run(propName, func(t *testing.T) {
+			sort := filters.CursorSort(sort1(propName, "asc"))
+			names, orders, err := extractPropNamesAndOrders(sort)
+			require.Nil(t, err)
+			creator := newComparableCreator(extractor, names)
+			comparator := newComparator(dataTypesHelper, names, orders)
+
+			pos, err := creator.cursorFromObject(obj)
+			require.Nil(t, err)
+			token, err := filters.EncodeCursor(pos)
+			require.Nil(t, err)
+			decoded, err := filters.DecodeCursor(token)
+			require.Nil(t, err)
+			fromCursor, err := creator.createFromCursor(decoded)
+			require.Nil(t, err)
+
+			// the cursor position equals the object, no matter if read from
+			// the object or the stored bytes
+			assert.Equal(t, 0, comparator.compare(creator.createFromObjectWithPayload(obj, nil), fromCursor))
+			assert.Equal(t, 0, comparator.compare(creator.createFromBytes(obj.DocID(), objData), fromCursor))
+		})
+	}
+
+	t.Run("cursor of a different sort", func(t *testing.T) {
+		creator := newComparableCreator(extractor, []string{"textProp", "intProp", filters.InternalPropID})
+		pos, err := newComparableCreator(extractor, []string{"textProp", filters.InternalPropID}).
+			cursorFromObject(obj)
+		require.Nil(t, err)
+
+		_, err = creator.createFromCursor(&pos)
+		assert.NotNil(t, err)
+	})
+}
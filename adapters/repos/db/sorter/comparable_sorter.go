@@ -78,7 +78,8 @@ func (is *insertSorter) getSorted() []*comparable {
 }
 
 // implementation of sort.Interface
-// sorting is performed in getSorted() method
+// sorting is performed in getSorted() method, it is stable so elements
+// considered equal by all sort levels keep the order they were added in
 type defaultSorter struct {
 	comparator  *comparator
 	comparables []*comparable
@@ -94,7 +95,7 @@ func (ds *defaultSorter) addComparable(el *comparable) bool {
 }
 
 func (ds *defaultSorter) getSorted() []*comparable {
-	sort.Sort(ds)
+	sort.Stable(ds)
 	return ds.comparables
 }
 
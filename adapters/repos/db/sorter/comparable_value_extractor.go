@@ -137,6 +137,41 @@ func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, pro
 	}
 }
 
+// extractFromJSON decodes a value previously encoded from the result of
+// extractFromObject, e.g. as part of a cursor
+func (e *comparableValueExtractor) extractFromJSON(raw json.RawMessage, propName string) (interface{}, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var value interface{}
+	switch e.dataTypesHelper.getType(propName) {
+	case schema.DataTypeBlob, schema.DataTypeText:
+		value = new(string)
+	case schema.DataTypeTextArray:
+		value = new([]string)
+	case schema.DataTypeDate:
+		value = new(time.Time)
+	case schema.DataTypeDateArray:
+		value = new([]time.Time)
+	case schema.DataTypeNumber, schema.DataTypeInt:
+		value = new(float64)
+	case schema.DataTypeNumberArray, schema.DataTypeIntArray,
+		schema.DataTypePhoneNumber, schema.DataTypeGeoCoordinates:
+		value = new([]float64)
+	case schema.DataTypeBoolean:
+		value = new(bool)
+	case schema.DataTypeBooleanArray:
+		value = new([]bool)
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
 func (e *comparableValueExtractor) mustExtractNumbers(value []string) []float64 {
 	numbers := make([]float64, len(value))
 	for i := range value {
@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// lsmIndexSorter orders objects by the keys of a property's filterable
+// index instead of loading and comparing every object of the shard. Keys of
+// int, number and date properties are stored lexicographically sortable, so
+// iterating the bucket yields the values in ascending order. Only the
+// objects making it into the result are read.
+//
+// Objects without a value are ordered first (asc) or last (desc), just like
+// the comparators do. They can only be found using the null state index, so
+// whenever they are needed but not indexed, the sorter reports that it can
+// not serve the request and the caller falls back to sorting in memory.
+type lsmIndexSorter struct {
+	objects *lsmkv.Bucket
+	values  *lsmkv.Bucket
+	nulls   *lsmkv.Bucket // nil if the null state is not indexed
+	desc    bool
+	limit   int
+}
+
+// newLSMIndexSorter returns nil if the sort can not be served by the index,
+// which is the case for multiple sort levels, properties other than
+// non-array int, number and date properties and properties without a
+// filterable index.
+func newLSMIndexSorter(store *lsmkv.Store, objects *lsmkv.Bucket,
+	dataTypesHelper *dataTypesHelper, sort []filters.Sort, limit int,
+) *lsmIndexSorter {
+	if limit <= 0 || len(sort) != 1 || len(sort[0].Path) != 1 {
+		return nil
+	}
+
+	propName := sort[0].Path[0]
+	if propName == filters.InternalPropID || propName == filters.InternalPropBackwardsCompatID {
+		return nil
+	}
+	switch dataTypesHelper.getType(propName) {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+	default:
+		return nil
+	}
+
+	values := store.Bucket(helpers.BucketFromPropNameLSM(propName))
+	if values == nil || values.Strategy() != lsmkv.StrategyRoaringSet {
+		return nil
+	}
+	nulls := store.Bucket(helpers.BucketFromPropNameNullLSM(propName))
+	if nulls != nil && nulls.Strategy() != lsmkv.StrategyRoaringSet {
+		nulls = nil
+	}
+
+	return &lsmIndexSorter{
+		objects: objects,
+		values:  values,
+		nulls:   nulls,
+		desc:    sort[0].Order == "desc",
+		limit:   limit,
+	}
+}
+
+// getSorted returns false if objects without a value would be part of the
+// result, but the null state of the property is not indexed.
+func (s *lsmIndexSorter) getSorted(ctx context.Context) ([]uint64, bool, error) {
+	docIDs := make([]uint64, 0, s.limit)
+	var err error
+
+	if !s.desc {
+		if s.nulls == nil {
+			return nil, false, nil
+		}
+		if docIDs, err = s.appendNulls(ctx, docIDs); err != nil {
+			return nil, false, err
+		}
+		if docIDs, err = s.appendAscending(ctx, docIDs); err != nil {
+			return nil, false, err
+		}
+		return docIDs, true, nil
+	}
+
+	if docIDs, err = s.appendDescending(ctx, docIDs); err != nil {
+		return nil, false, err
+	}
+	if len(docIDs) < s.limit {
+		if s.nulls == nil {
+			return nil, false, nil
+		}
+		if docIDs, err = s.appendNulls(ctx, docIDs); err != nil {
+			return nil, false, err
+		}
+	}
+	return docIDs, true, nil
+}
+
+func (s *lsmIndexSorter) appendNulls(ctx context.Context, docIDs []uint64) ([]uint64, error) {
+	bm, err := s.nulls.RoaringSetGet([]byte{uint8(filters.InternalNullState)})
+	if err != nil {
+		return nil, errors.Wrap(err, "lsm index sorter - read null state")
+	}
+	return s.appendGroup(ctx, docIDs, bm)
+}
+
+func (s *lsmIndexSorter) appendAscending(ctx context.Context, docIDs []uint64) ([]uint64, error) {
+	cursor := s.values.CursorRoaringSet().WithContext(ctx)
+	defer cursor.Close()
+
+	var err error
+	for k, bm := cursor.First(); k != nil && len(docIDs) < s.limit; k, bm = cursor.Next() {
+		if docIDs, err = s.appendGroup(ctx, docIDs, bm); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docIDs, nil
+}
+
+func (s *lsmIndexSorter) appendDescending(ctx context.Context, docIDs []uint64) ([]uint64, error) {
+	// roaring set cursors only move forward, so collect the keys first and
+	// read the bitmaps of the largest values afterwards
+	var keys [][]byte
+	cursor := s.values.CursorRoaringSetKeyOnly().WithContext(ctx)
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	cursor.Close()
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := len(keys) - 1; i >= 0 && len(docIDs) < s.limit; i-- {
+		bm, err := s.values.RoaringSetGet(keys[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "lsm index sorter - read value")
+		}
+		if docIDs, err = s.appendGroup(ctx, docIDs, bm); err != nil {
+			return nil, err
+		}
+	}
+	return docIDs, nil
+}
+
+// appendGroup appends objects sharing the same value. They are ordered by
+// their uuid, which matches the order in which the in-memory sorter
+// encounters them when iterating the objects bucket.
+func (s *lsmIndexSorter) appendGroup(ctx context.Context, docIDs []uint64,
+	bm *sroar.Bitmap,
+) ([]uint64, error) {
+	if bm == nil || bm.IsEmpty() {
+		return docIDs, nil
+	}
+
+	type member struct {
+		id    []byte
+		docID uint64
+	}
+	members := make([]member, 0, bm.GetCardinality())
+	docIDBytes := make([]byte, 8)
+
+	for _, docID := range bm.ToArray() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		binary.LittleEndian.PutUint64(docIDBytes, docID)
+		objData, err := s.objects.GetBySecondary(0, docIDBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "lsm index sorter - could not get obj by doc id %d", docID)
+		}
+		if objData == nil {
+			continue
+		}
+		id, err := idFromBinary(objData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "lsm index sorter - could not get id of doc id %d", docID)
+		}
+		members = append(members, member{id: id, docID: docID})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return bytes.Compare(members[i].id, members[j].id) < 0
+	})
+	for i := 0; i < len(members) && len(docIDs) < s.limit; i++ {
+		docIDs = append(docIDs, members[i].docID)
+	}
+	return docIDs, nil
+}
+
+// idFromBinary reads the uuid of a marshalled object without parsing the
+// rest of it
+func idFromBinary(in []byte) ([]byte, error) {
+	// version, docID and kind precede the uuid
+	const offset = 1 + 8 + 1
+	if len(in) < offset+16 {
+		return nil, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+	return in[offset : offset+16], nil
+}
@@ -29,9 +29,17 @@ type LSMSorter interface {
 	SortDocIDs(ctx context.Context, limit int, sort []filters.Sort, ids helpers.AllowList) ([]uint64, error)
 	SortDocIDsAndDists(ctx context.Context, limit int, sort []filters.Sort,
 		ids []uint64, dists []float32) ([]uint64, []float32, error)
+	// SortAfter and SortDocIDsAfter only return objects positioned after the
+	// cursor. The last sort level must be the object ID (see
+	// filters.CursorSort), a nil cursor starts at the beginning.
+	SortAfter(ctx context.Context, limit int, sort []filters.Sort,
+		after *filters.CursorPosition) ([]uint64, error)
+	SortDocIDsAfter(ctx context.Context, limit int, sort []filters.Sort,
+		ids helpers.AllowList, after *filters.CursorPosition) ([]uint64, error)
 }
 
 type lsmSorter struct {
+	store           *lsmkv.Store
 	bucket          *lsmkv.Bucket
 	dataTypesHelper *dataTypesHelper
 	valueExtractor  *comparableValueExtractor
@@ -49,11 +57,25 @@ func NewLSMSorter(store *lsmkv.Store, sch schema.Schema, className schema.ClassN
 	dataTypesHelper := newDataTypesHelper(class)
 	comparableValuesExtractor := newComparableValueExtractor(dataTypesHelper)
 
-	return &lsmSorter{bucket, dataTypesHelper, comparableValuesExtractor}, nil
+	return &lsmSorter{store, bucket, dataTypesHelper, comparableValuesExtractor}, nil
 }
 
 func (s *lsmSorter) Sort(ctx context.Context, limit int, sort []filters.Sort) ([]uint64, error) {
-	helper, err := s.createHelper(sort, validateLimit(limit, s.bucket.Count()))
+	limit = validateLimit(limit, s.bucket.Count())
+
+	// a single sort level can be read in order from the property's
+	// filterable index, avoiding to load every object of the shard
+	if indexSorter := newLSMIndexSorter(s.store, s.bucket, s.dataTypesHelper, sort, limit); indexSorter != nil {
+		docIDs, ok, err := indexSorter.getSorted(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return docIDs, nil
+		}
+	}
+
+	helper, err := s.createHelper(sort, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +100,32 @@ func (s *lsmSorter) SortDocIDsAndDists(ctx context.Context, limit int, sort []fi
 	return helper.getSortedDocIDsAndDistances(ctx, ids, dists)
 }
 
+func (s *lsmSorter) SortAfter(ctx context.Context, limit int, sort []filters.Sort,
+	after *filters.CursorPosition,
+) ([]uint64, error) {
+	helper, err := s.createHelper(sort, validateLimit(limit, s.bucket.Count()))
+	if err != nil {
+		return nil, err
+	}
+	if err := helper.setAfter(after); err != nil {
+		return nil, err
+	}
+	return helper.getSorted(ctx)
+}
+
+func (s *lsmSorter) SortDocIDsAfter(ctx context.Context, limit int, sort []filters.Sort,
+	ids helpers.AllowList, after *filters.CursorPosition,
+) ([]uint64, error) {
+	helper, err := s.createHelper(sort, validateLimit(limit, ids.Len()))
+	if err != nil {
+		return nil, err
+	}
+	if err := helper.setAfter(after); err != nil {
+		return nil, err
+	}
+	return helper.getSortedDocIDs(ctx, ids)
+}
+
 func (s *lsmSorter) createHelper(sort []filters.Sort, limit int) (*lsmSorterHelper, error) {
 	propNames, orders, err := extractPropNamesAndOrders(sort)
 	if err != nil {
@@ -94,12 +142,30 @@ type lsmSorterHelper struct {
 	comparator *comparator
 	creator    *comparableCreator
 	limit      int
+	// objects positioned at or before after are skipped, nil if not paginating
+	after *comparable
 }
 
 func newLsmSorterHelper(bucket *lsmkv.Bucket, comparator *comparator,
 	creator *comparableCreator, limit int,
 ) *lsmSorterHelper {
-	return &lsmSorterHelper{bucket, comparator, creator, limit}
+	return &lsmSorterHelper{bucket: bucket, comparator: comparator, creator: creator, limit: limit}
+}
+
+func (h *lsmSorterHelper) setAfter(after *filters.CursorPosition) error {
+	if after == nil {
+		return nil
+	}
+	comparable, err := h.creator.createFromCursor(after)
+	if err != nil {
+		return errors.Wrap(err, "lsm sorter")
+	}
+	h.after = comparable
+	return nil
+}
+
+func (h *lsmSorterHelper) isAfterCursor(comparable *comparable) bool {
+	return h.after == nil || h.comparator.compare(comparable, h.after) == 1
 }
 
 func (h *lsmSorterHelper) getSorted(ctx context.Context) ([]uint64, error) {
@@ -109,12 +175,18 @@ func (h *lsmSorterHelper) getSorted(ctx context.Context) ([]uint64, error) {
 	sorter := newInsertSorter(h.comparator, h.limit)
 
 	for k, objData := cursor.First(); k != nil; k, objData = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		docID, err := storobj.DocIDFromBinary(objData)
 		if err != nil {
 			return nil, errors.Wrapf(err, "lsm sorter - could not get doc id")
 		}
 		comparable := h.creator.createFromBytes(docID, objData)
-		sorter.addComparable(comparable)
+		if h.isAfterCursor(comparable) {
+			sorter.addComparable(comparable)
+		}
 	}
 
 	return h.creator.extractDocIDs(sorter.getSorted()), nil
@@ -126,6 +198,10 @@ func (h *lsmSorterHelper) getSortedDocIDs(ctx context.Context, docIDs helpers.Al
 	it := docIDs.Iterator()
 
 	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := h.bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -136,7 +212,9 @@ func (h *lsmSorterHelper) getSortedDocIDs(ctx context.Context, docIDs helpers.Al
 		}
 
 		comparable := h.creator.createFromBytes(docID, objData)
-		sorter.addComparable(comparable)
+		if h.isAfterCursor(comparable) {
+			sorter.addComparable(comparable)
+		}
 	}
 
 	return h.creator.extractDocIDs(sorter.getSorted()), nil
@@ -149,6 +227,10 @@ func (h *lsmSorterHelper) getSortedDocIDsAndDistances(ctx context.Context, docID
 	docIDBytes := make([]byte, 8)
 
 	for i, docID := range docIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := h.bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
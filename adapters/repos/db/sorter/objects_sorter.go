@@ -12,6 +12,7 @@
 package sorter
 
 import (
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -54,6 +55,39 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 		sort(objects, scores)
 }
 
+// Cursors returns the opaque cursor of every object, so clients can continue
+// paginating after any of them. The last sort level must be the object ID
+// (see filters.CursorSort).
+func (s objectsSorter) Cursors(objects []*storobj.Object, sort []filters.Sort) ([]string, error) {
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	propNames, _, err := extractPropNamesAndOrders(sort)
+	if err != nil {
+		return nil, err
+	}
+	if len(propNames) == 0 {
+		return nil, errors.New("cursor requires at least the id as sort level")
+	}
+
+	class := s.schema.GetClass(objects[0].Class())
+	dataTypesHelper := newDataTypesHelper(class)
+	creator := newComparableCreator(newComparableValueExtractor(dataTypesHelper), propNames)
+
+	cursors := make([]string, len(objects))
+	for i := range objects {
+		pos, err := creator.cursorFromObject(objects[i])
+		if err != nil {
+			return nil, err
+		}
+		if cursors[i], err = filters.EncodeCursor(pos); err != nil {
+			return nil, err
+		}
+	}
+	return cursors, nil
+}
+
 type objectsSorterHelper struct {
 	comparator *comparator
 	creator    *comparableCreator
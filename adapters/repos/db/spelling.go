@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/spelling"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// spellingDictionaryTTL is how long a dictionary is used before it is built
+// again from the searchable bucket, so that new terms are picked up
+const spellingDictionaryTTL = time.Minute
+
+type cachedSpellingDictionary struct {
+	dictionary *spelling.Dictionary
+	builtAt    time.Time
+}
+
+// SpellingAlternatives returns up to limit spelling corrections of a keyword
+// query, the most likely first. Query terms are looked up in the terms of
+// the searchable index of the given properties, or of all suitable
+// properties if none are given. Only properties with word or lowercase
+// tokenization and without an analyzer are used, as the corrected query is
+// joined from the corrected terms. It returns no alternatives if every
+// term is known or no close term exists. Only local shards are considered.
+func (db *DB) SpellingAlternatives(ctx context.Context, className string,
+	properties []string, query string, limit int, tenant string,
+) ([]string, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	class, err := schema.GetClassByName(idx.getSchema.GetSchemaSkipAuth().Objects, className)
+	if err != nil {
+		return nil, enterrors.NewErrNotFound(err)
+	}
+	propNames := spellingProperties(class, properties)
+	if len(propNames) == 0 {
+		return nil, nil
+	}
+
+	terms := helpers.Tokenize(models.PropertyTokenizationWord, query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	if err := idx.validateMultiTenancy(tenant); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	shardNames, err := idx.targetShardNames(tenant)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	var dictionaries spelling.Dictionaries
+	for _, name := range shardNames {
+		shard := idx.shards.Load(name)
+		if shard == nil {
+			continue
+		}
+		for _, propName := range propNames {
+			d, err := shard.spellingDictionary(ctx, propName)
+			if err != nil {
+				return nil, fmt.Errorf("shard %s: %w", name, err)
+			}
+			dictionaries = append(dictionaries, d)
+		}
+	}
+
+	alternatives := dictionaries.Alternatives(terms, spelling.DefaultMaxEditDistance, limit)
+	out := make([]string, len(alternatives))
+	for i, alternative := range alternatives {
+		out[i] = strings.Join(alternative, " ")
+	}
+	return out, nil
+}
+
+// spellingProperties returns the names of the properties whose terms are
+// used for spelling corrections. Requested properties may carry a boost
+// such as "title^2".
+func spellingProperties(class *models.Class, requested []string) []string {
+	suitable := func(prop *models.Property) bool {
+		return inverted.HasSearchableIndex(prop) && prop.Analyzer == "" &&
+			(prop.Tokenization == models.PropertyTokenizationWord ||
+				prop.Tokenization == models.PropertyTokenizationLowercase)
+	}
+
+	var out []string
+	if len(requested) == 0 {
+		for _, prop := range class.Properties {
+			if suitable(prop) {
+				out = append(out, prop.Name)
+			}
+		}
+		return out
+	}
+
+	for _, name := range requested {
+		name = strings.Split(name, "^")[0]
+		prop, err := schema.GetPropertyByName(class, name)
+		if err == nil && suitable(prop) {
+			out = append(out, prop.Name)
+		}
+	}
+	return out
+}
+
+// spellingDictionary returns the dictionary of the terms of the searchable
+// index of a property, building it if it does not exist or has expired
+func (s *Shard) spellingDictionary(ctx context.Context, propName string) (*spelling.Dictionary, error) {
+	if cached, ok := s.spellingDictionaries.Load(propName); ok {
+		c := cached.(*cachedSpellingDictionary)
+		if time.Since(c.builtAt) < spellingDictionaryTTL {
+			return c.dictionary, nil
+		}
+	}
+
+	bucket := s.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, fmt.Errorf("no searchable bucket for property %q", propName)
+	}
+
+	d := spelling.NewDictionary(spelling.DefaultMaxEditDistance)
+	cursor := bucket.MapCursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		d.Add(string(k), int64(len(v)))
+	}
+
+	s.spellingDictionaries.Store(propName, &cachedSpellingDictionary{
+		dictionary: d,
+		builtAt:    time.Now(),
+	})
+	return d, nil
+}
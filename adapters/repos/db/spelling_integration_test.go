@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSpellingAlternatives(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "SpellingClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "code",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(ctx)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	titles := []string{
+		"Apple pie", "apple juice", "Apple crumble", "banana bread", "angle grinder",
+	}
+	for _, title := range titles {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         strfmt.UUID(uuid.NewString()),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"title": title, "code": "appel"},
+		}, []float32{1, 2}, nil))
+	}
+
+	t.Run("misspelled terms are corrected", func(t *testing.T) {
+		res, err := repo.SpellingAlternatives(ctx, class.Class, nil, "Appel pei", 3, "")
+		require.Nil(t, err)
+		require.NotEmpty(t, res)
+		assert.Equal(t, "apple pie", res[0])
+	})
+
+	t.Run("boosted properties", func(t *testing.T) {
+		res, err := repo.SpellingAlternatives(ctx, class.Class, []string{"title^2"}, "angel", 3, "")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"angle"}, res)
+	})
+
+	t.Run("known terms are not corrected", func(t *testing.T) {
+		res, err := repo.SpellingAlternatives(ctx, class.Class, nil, "banana bread", 3, "")
+		require.Nil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("properties with field tokenization are not used", func(t *testing.T) {
+		res, err := repo.SpellingAlternatives(ctx, class.Class, []string{"code"}, "apple", 3, "")
+		require.Nil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.SpellingAlternatives(ctx, "Unknown", nil, "apple", 3, "")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestUpdateInvertedIndexConfigReloadsStopwords(t *testing.T) {
+	ctx := context.Background()
+	initial := invertedConfig()
+	initial.Stopwords = &models.StopwordConfig{Preset: "en"}
+	class := &models.Class{
+		Class:               "StopwordsClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: initial,
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(ctx)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	assert.True(t, idx.stopwords.IsStopword("the"))
+	assert.False(t, idx.stopwords.IsStopword("star"))
+
+	updated := invertedConfig()
+	updated.Stopwords = &models.StopwordConfig{Preset: "none", Additions: []string{"star"}}
+	require.Nil(t, migrator.UpdateInvertedIndexConfig(ctx, class.Class, updated))
+
+	assert.False(t, idx.stopwords.IsStopword("the"))
+	assert.True(t, idx.stopwords.IsStopword("star"))
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/suggest"
+)
+
+// Suggest returns the limit most frequent terms of the searchable inverted
+// index of a property which start with prefix. The prefix is tokenized like
+// the property, so only its last token is completed. As the index is updated
+// on every write, so are the suggestions. Only local shards are considered.
+func (db *DB) Suggest(ctx context.Context, className, propName, prefix string,
+	limit int, tenant string,
+) (*suggest.Result, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	class, err := schema.GetClassByName(idx.getSchema.GetSchemaSkipAuth().Objects, className)
+	if err != nil {
+		return nil, enterrors.NewErrNotFound(err)
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, enterrors.NewErrNotFound(err)
+	}
+	if !inverted.HasSearchableIndex(prop) {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf(
+			"property %q has no searchable index", propName))
+	}
+
+	if err := idx.validateMultiTenancy(tenant); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	shardNames, err := idx.targetShardNames(tenant)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	res := &suggest.Result{
+		Class:       className,
+		Property:    propName,
+		Prefix:      prefix,
+		Tenant:      tenant,
+		Suggestions: []*suggest.Suggestion{},
+	}
+
+	tokens := helpers.Tokenize(prop.Tokenization, prefix)
+	if len(tokens) == 0 {
+		return res, nil
+	}
+	term := []byte(tokens[len(tokens)-1])
+
+	collector := suggest.NewCollector()
+	for _, name := range shardNames {
+		// shards of other nodes and unloaded tenants are not part of the
+		// suggestions
+		shard := idx.shards.Load(name)
+		if shard == nil {
+			continue
+		}
+		if err := shard.collectTermFrequencies(ctx, propName, term, collector); err != nil {
+			return nil, fmt.Errorf("shard %s: %w", name, err)
+		}
+	}
+
+	res.Suggestions = collector.Top(limit)
+	return res, nil
+}
+
+// collectTermFrequencies adds the document frequency of every term of the
+// searchable index of a property starting with prefix to the collector
+func (s *Shard) collectTermFrequencies(ctx context.Context, propName string,
+	prefix []byte, collector *suggest.Collector,
+) error {
+	bucket := s.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return fmt.Errorf("no searchable bucket for property %q", propName)
+	}
+
+	cursor := bucket.MapCursor()
+	defer cursor.Close()
+
+	for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		collector.Add(string(k), int64(len(v)))
+	}
+	return nil
+}
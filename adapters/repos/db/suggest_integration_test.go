@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/suggest"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSuggest(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+	class := &models.Class{
+		Class:               "SuggestClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:            "code",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationField,
+				IndexSearchable: &vFalse,
+			},
+		},
+	}
+
+	migrator, repo, schemaGetter := createRepo(t)
+	defer repo.Shutdown(ctx)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	titles := []string{
+		"Apple pie", "apple crumble", "Applesauce", "apricot jam",
+		"Apple juice", "banana bread",
+	}
+	ids := make([]strfmt.UUID, len(titles))
+	for i, title := range titles {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"title": title, "code": title},
+		}, []float32{1, 2}, nil))
+	}
+
+	t.Run("ranked by frequency", func(t *testing.T) {
+		res, err := repo.Suggest(ctx, class.Class, "title", "AP", 10, "")
+		require.Nil(t, err)
+		assert.Equal(t, []*suggest.Suggestion{
+			{Term: "apple", Frequency: 3},
+			{Term: "applesauce", Frequency: 1},
+			{Term: "apricot", Frequency: 1},
+		}, res.Suggestions)
+	})
+
+	t.Run("last token of the prefix is completed", func(t *testing.T) {
+		res, err := repo.Suggest(ctx, class.Class, "title", "apple cr", 10, "")
+		require.Nil(t, err)
+		assert.Equal(t, []*suggest.Suggestion{
+			{Term: "crumble", Frequency: 1},
+		}, res.Suggestions)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res, err := repo.Suggest(ctx, class.Class, "title", "ap", 1, "")
+		require.Nil(t, err)
+		require.Len(t, res.Suggestions, 1)
+		assert.Equal(t, "apple", res.Suggestions[0].Term)
+	})
+
+	t.Run("deleted objects are no longer suggested", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[3], nil, ""))
+
+		res, err := repo.Suggest(ctx, class.Class, "title", "apr", 10, "")
+		require.Nil(t, err)
+		assert.Empty(t, res.Suggestions)
+	})
+
+	t.Run("property without searchable index", func(t *testing.T) {
+		_, err := repo.Suggest(ctx, class.Class, "code", "ap", 10, "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("tenant on a class without multi-tenancy", func(t *testing.T) {
+		_, err := repo.Suggest(ctx, class.Class, "title", "ap", 10, "tenant1")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.Suggest(ctx, "Unknown", "title", "ap", 10, "")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,1022 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/offload"
+)
+
+// Tenants are HOT while their shard is loaded, WARM while it is unloaded
+// from memory but its files are on local disk and COLD while its files are
+// only present in object storage
+const (
+	TenantHot  = "HOT"
+	TenantWarm = "WARM"
+	TenantCold = "COLD"
+)
+
+const (
+	// how often idle tenants are looked for
+	tenantOffloadScanInterval = 10 * time.Second
+	// how long a tenant may be busy before it is unloaded anyway
+	tenantDeactivateTimeout = 30 * time.Second
+	// size of the ranges in which cold files are downloaded
+	tenantDownloadChunkSize = 4 * 1024 * 1024
+)
+
+// TenantOffloading unloads the shards of idle tenants of multi-tenant
+// classes. A zero WarmAfter disables automatic offloading, tenants can then
+// still be offloaded explicitly. Tenants only become cold if a Store or an
+// Offloader is set, the Offloader takes precedence.
+type TenantOffloading struct {
+	WarmAfter time.Duration
+	ColdAfter time.Duration
+	Store     lsmkv.RemoteSegmentStore
+	Prefix    string
+	Offloader ShardOffloader
+}
+
+func (o TenantOffloading) coldStorage() bool {
+	return o.Store != nil || o.Offloader != nil
+}
+
+// ShardOffloader keeps copies of inactive shards in external storage, which
+// can be restored on any node, see usecases/offload
+type ShardOffloader interface {
+	Upload(ctx context.Context, class, shard, node string,
+		files []offload.File) (*offload.Manifest, error)
+	Manifest(ctx context.Context, class, shard, node string) (*offload.Manifest, error)
+	Download(ctx context.Context, class, shard, node string) (*offload.Manifest, error)
+}
+
+// TenantActivity is the offloading status of a local tenant. The source is
+// the node whose offloaded copy a cold tenant is restored from.
+type TenantActivity struct {
+	Name     string    `json:"name"`
+	Status   string    `json:"status"`
+	LastUsed time.Time `json:"lastUsed"`
+	Source   string    `json:"source,omitempty"`
+}
+
+// tenantFile is a file or directory of an offloaded shard, the path is
+// relative to the data root
+type tenantFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	Dir  bool   `json:"dir,omitempty"`
+}
+
+// tenantActivity tracks the status and usage of a single local tenant. Its
+// lock serializes all status changes of the tenant.
+type tenantActivity struct {
+	sync.Mutex
+	status string
+	// the files of a cold tenant in the Store, or the node whose copy of the
+	// tenant the Offloader holds
+	files  []tenantFile
+	source string
+	// lastUsed is in unix nanoseconds, inUse the number of requests which
+	// currently hold the shard
+	lastUsed atomic.Int64
+	inUse    atomic.Int64
+}
+
+func (t *tenantActivity) touch() {
+	t.lastUsed.Store(time.Now().UnixNano())
+}
+
+func (t *tenantActivity) release() {
+	t.inUse.Add(-1)
+	t.touch()
+}
+
+func (t *tenantActivity) idle(now time.Time) time.Duration {
+	return now.Sub(time.Unix(0, t.lastUsed.Load()))
+}
+
+type persistedTenant struct {
+	Status   string       `json:"status"`
+	LastUsed time.Time    `json:"lastUsed"`
+	Files    []tenantFile `json:"files,omitempty"`
+	Source   string       `json:"source,omitempty"`
+}
+
+// tenantLifecycle holds the activity of the local tenants of an index. The
+// status of offloaded tenants is persisted, so their shards are not loaded
+// on startup. A nil lifecycle, e.g. of an index which was not created
+// through NewIndex, has no offloaded tenants.
+type tenantLifecycle struct {
+	sync.Mutex
+	path    string
+	tenants map[string]*tenantActivity
+
+	transitions *prometheus.CounterVec
+	statuses    *prometheus.GaugeVec
+	activations prometheus.Observer
+}
+
+func newTenantLifecycle(rootPath, indexID string,
+	promMetrics *monitoring.PrometheusMetrics,
+) (*tenantLifecycle, error) {
+	l := &tenantLifecycle{
+		path:    filepath.Join(rootPath, indexID+".tenants.json"),
+		tenants: map[string]*tenantActivity{},
+	}
+
+	if promMetrics != nil {
+		l.transitions = promMetrics.TenantTransitions.MustCurryWith(
+			prometheus.Labels{"class_name": indexID})
+		l.statuses = promMetrics.TenantStatus.MustCurryWith(
+			prometheus.Labels{"class_name": indexID})
+		l.activations = promMetrics.TenantActivationDurations.With(
+			prometheus.Labels{"class_name": indexID})
+	}
+
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return l, nil
+		}
+		return nil, fmt.Errorf("read tenant status %s: %w", l.path, err)
+	}
+
+	var persisted map[string]persistedTenant
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		return nil, fmt.Errorf("parse tenant status %s: %w", l.path, err)
+	}
+
+	for name, p := range persisted {
+		t := &tenantActivity{status: p.Status, files: p.Files, source: p.Source}
+		t.lastUsed.Store(p.LastUsed.UnixNano())
+		l.tenants[name] = t
+	}
+
+	return l, nil
+}
+
+// offloaded returns whether the tenant's shard must not be loaded on startup
+func (l *tenantLifecycle) offloaded(name string) bool {
+	if l == nil {
+		return false
+	}
+	l.Lock()
+	defer l.Unlock()
+
+	t, ok := l.tenants[name]
+	return ok && t.status != TenantHot
+}
+
+// get returns the activity of a local tenant. It is created for a loaded
+// shard, nil is returned for tenants which are not local.
+func (l *tenantLifecycle) get(name string, loaded bool) *tenantActivity {
+	l.Lock()
+	defer l.Unlock()
+
+	if t, ok := l.tenants[name]; ok {
+		return t
+	}
+	if !loaded {
+		return nil
+	}
+
+	t := &tenantActivity{status: TenantHot}
+	t.touch()
+	l.tenants[name] = t
+	return t
+}
+
+// snapshot returns all tenants sorted by name
+func (l *tenantLifecycle) snapshot() ([]string, []*tenantActivity) {
+	if l == nil {
+		return nil, nil
+	}
+	l.Lock()
+	defer l.Unlock()
+
+	names := make([]string, 0, len(l.tenants))
+	for name := range l.tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tenants := make([]*tenantActivity, len(names))
+	for j, name := range names {
+		tenants[j] = l.tenants[name]
+	}
+	return names, tenants
+}
+
+// setStatus persists and sets the new status of the tenant, the caller must
+// hold the tenant's lock. The status is only changed while holding both
+// locks, so it can be read with either of them.
+func (l *tenantLifecycle) setStatus(name string, t *tenantActivity,
+	status string, files []tenantFile, source string,
+) error {
+	l.Lock()
+	defer l.Unlock()
+
+	prevStatus, prevFiles, prevSource := t.status, t.files, t.source
+	t.status, t.files, t.source = status, files, source
+	if err := l.persist(); err != nil {
+		t.status, t.files, t.source = prevStatus, prevFiles, prevSource
+		return err
+	}
+
+	if l.transitions != nil {
+		l.transitions.With(prometheus.Labels{"status": status}).Inc()
+	}
+	return nil
+}
+
+// remove forgets a tenant whose shard was dropped. A pending activation of
+// the tenant fails, as it is hot without a shard.
+func (l *tenantLifecycle) remove(name string) error {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+
+	t, ok := l.tenants[name]
+	if !ok {
+		return nil
+	}
+
+	delete(l.tenants, name)
+	offloaded := t.status != TenantHot
+	t.status, t.files, t.source = TenantHot, nil, ""
+	if offloaded {
+		return l.persist()
+	}
+	return nil
+}
+
+// persist writes the status of all offloaded tenants, the caller must hold
+// the lock
+func (l *tenantLifecycle) persist() error {
+	persisted := map[string]persistedTenant{}
+	for name, t := range l.tenants {
+		if t.status == TenantHot {
+			continue
+		}
+		persisted[name] = persistedTenant{
+			Status:   t.status,
+			LastUsed: time.Unix(0, t.lastUsed.Load()),
+			Files:    t.files,
+			Source:   t.source,
+		}
+	}
+
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return fmt.Errorf("write tenant status: %w", err)
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return fmt.Errorf("write tenant status: %w", err)
+	}
+	return nil
+}
+
+// activity returns the status of all tenants sorted by name
+func (l *tenantLifecycle) activity() []TenantActivity {
+	l.Lock()
+	defer l.Unlock()
+
+	out := make([]TenantActivity, 0, len(l.tenants))
+	for name, t := range l.tenants {
+		out = append(out, TenantActivity{
+			Name:     name,
+			Status:   t.status,
+			LastUsed: time.Unix(0, t.lastUsed.Load()),
+			Source:   t.source,
+		})
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Name < out[b].Name })
+	return out
+}
+
+func (l *tenantLifecycle) observeStatuses() {
+	if l.statuses == nil {
+		return
+	}
+
+	counts := map[string]float64{TenantHot: 0, TenantWarm: 0, TenantCold: 0}
+	for _, t := range l.activity() {
+		counts[t.Status]++
+	}
+	for status, count := range counts {
+		l.statuses.With(prometheus.Labels{"status": status}).Set(count)
+	}
+}
+
+func (l *tenantLifecycle) drop() error {
+	if l == nil {
+		return nil
+	}
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func noopRelease() {}
+
+func (i *Index) class() *models.Class {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	return sch.GetClass(i.Config.ClassName)
+}
+
+// getShard returns the local shard with the given name and a func which must
+// be called once the shard is no longer used. Offloaded tenants are
+// activated first. The shard is nil if it is not local to this node.
+func (i *Index) getShard(ctx context.Context, name string) (*Shard, func(), error) {
+	if !i.partitioningEnabled || i.tenants == nil {
+		return i.shards.Load(name), noopRelease, nil
+	}
+
+	t := i.tenants.get(name, i.shards.Load(name) != nil)
+	if t == nil {
+		return nil, noopRelease, nil
+	}
+
+	for {
+		// inUse is incremented before the shard is loaded, so deactivation
+		// either sees the request or the request sees the missing shard
+		t.inUse.Add(1)
+		if shard := i.shards.Load(name); shard != nil {
+			t.touch()
+			return shard, t.release, nil
+		}
+		t.inUse.Add(-1)
+
+		if err := i.activateTenant(ctx, name, t); err != nil {
+			return nil, noopRelease, err
+		}
+	}
+}
+
+// activateTenant loads the shard of an offloaded tenant, cold tenants are
+// downloaded first
+func (i *Index) activateTenant(ctx context.Context, name string, t *tenantActivity) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if i.shards.Load(name) != nil {
+		return nil
+	}
+
+	before := time.Now()
+	switch t.status {
+	case TenantHot:
+		// the shard was dropped in the meantime
+		return enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", name))
+	case TenantCold:
+		if err := i.downloadTenant(ctx, name, t); err != nil {
+			return fmt.Errorf("activate tenant %q: %w", name, err)
+		}
+	}
+
+	class := i.class()
+	if class == nil {
+		return enterrors.NewErrNotFound(
+			fmt.Errorf("class %q not found", i.Config.ClassName))
+	}
+
+	shard, err := NewShard(ctx, i.promMetrics, name, i, class, i.centralJobQueue)
+	if err != nil {
+		return fmt.Errorf("activate tenant %q: %w", name, err)
+	}
+	shard.notifyReady()
+
+	files := t.files
+	if err := i.tenants.setStatus(name, t, TenantHot, nil, ""); err != nil {
+		shard.shutdown(ctx)
+		return fmt.Errorf("activate tenant %q: %w", name, err)
+	}
+	i.shards.Store(name, shard)
+	t.touch()
+	// the objects may have been restored from another node
+	i.recordChange(name, "")
+
+	i.deleteRemoteTenant(ctx, files)
+	if i.tenants.activations != nil {
+		i.tenants.activations.Observe(float64(time.Since(before).Milliseconds()))
+	}
+	i.logger.WithField("action", "activate_tenant").
+		WithField("class", i.Config.ClassName).WithField("tenant", name).
+		WithField("took", time.Since(before)).Debug("tenant activated")
+	return nil
+}
+
+// deactivateTenant unloads the shard of a hot tenant once no request uses it
+// anymore. The caller must hold the tenant's lock.
+func (i *Index) deactivateTenant(ctx context.Context, name string, t *tenantActivity) error {
+	shard := i.shards.Load(name)
+	if shard == nil {
+		return nil
+	}
+
+	if !i.shards.CompareAndDelete(name, shard) {
+		// the shard is being dropped
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tenantDeactivateTimeout)
+	defer cancel()
+
+	for t.inUse.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			i.shards.Store(name, shard)
+			return fmt.Errorf("deactivate tenant %q: still in use: %w", name, ctx.Err())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if err := shard.shutdown(ctx); err != nil {
+		return fmt.Errorf("deactivate tenant %q: %w", name, err)
+	}
+
+	return i.tenants.setStatus(name, t, TenantWarm, nil, "")
+}
+
+// offloadTenant uploads the files of a warm tenant to object storage and
+// removes them locally. The caller must hold the tenant's lock.
+func (i *Index) offloadTenant(ctx context.Context, name string, t *tenantActivity) error {
+	cfg := i.Config.TenantOffloading
+	if !cfg.coldStorage() {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("no object storage is configured for cold tenants"))
+	}
+
+	files, err := i.tenantFiles(name)
+	if err != nil {
+		return fmt.Errorf("offload tenant %q: %w", name, err)
+	}
+
+	var source string
+	if cfg.Offloader != nil {
+		source = i.getSchema.NodeName()
+		copied := make([]offload.File, len(files))
+		for j, file := range files {
+			copied[j] = offload.File{Path: file.Path, Size: file.Size, Dir: file.Dir}
+		}
+		if _, err := cfg.Offloader.Upload(ctx, i.Config.ClassName.String(),
+			name, source, copied); err != nil {
+			return fmt.Errorf("offload tenant %q: %w", name, err)
+		}
+		// the manifest lists the files of the copy
+		files = nil
+	} else {
+		for _, file := range files {
+			if file.Dir {
+				continue
+			}
+			if err := i.uploadTenantFile(ctx, cfg.Store, file); err != nil {
+				return fmt.Errorf("offload tenant %q: %w", name, err)
+			}
+		}
+	}
+
+	// the files are only removed once the cold status is persisted, a crash
+	// in between leaves the tenant warm
+	if err := i.tenants.setStatus(name, t, TenantCold, files, source); err != nil {
+		return fmt.Errorf("offload tenant %q: %w", name, err)
+	}
+
+	return i.removeTenantFiles(name)
+}
+
+func (i *Index) uploadTenantFile(ctx context.Context,
+	store lsmkv.RemoteSegmentStore, file tenantFile,
+) error {
+	f, err := os.Open(filepath.Join(i.Config.RootPath, file.Path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return store.PutSegment(ctx, i.tenantKey(file.Path), f, file.Size)
+}
+
+// downloadTenant restores the files of a cold tenant, the caller must hold
+// the tenant's lock
+func (i *Index) downloadTenant(ctx context.Context, name string, t *tenantActivity) error {
+	if t.source != "" {
+		offloader := i.Config.TenantOffloading.Offloader
+		if offloader == nil {
+			return fmt.Errorf("no offload backend is configured for cold tenants")
+		}
+		_, err := offloader.Download(ctx, i.Config.ClassName.String(), name, t.source)
+		return err
+	}
+
+	store := i.Config.TenantOffloading.Store
+	if store == nil {
+		return fmt.Errorf("no object storage is configured for cold tenants")
+	}
+
+	for _, file := range t.files {
+		target := filepath.Join(i.Config.RootPath, file.Path)
+		if file.Dir {
+			if err := os.MkdirAll(target, 0o777); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o777); err != nil {
+			return err
+		}
+		if err := downloadTenantFile(ctx, store, i.tenantKey(file.Path),
+			target, file.Size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func downloadTenantFile(ctx context.Context, store lsmkv.RemoteSegmentStore,
+	key, target string, size int64,
+) error {
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, tenantDownloadChunkSize)
+	for off := int64(0); off < size; off += int64(len(buf)) {
+		if rest := size - off; rest < int64(len(buf)) {
+			buf = buf[:rest]
+		}
+		if err := store.ReadSegmentAt(ctx, key, buf, off); err != nil {
+			return err
+		}
+		if _, err := f.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	return f.Sync()
+}
+
+// deleteRemoteTenant removes the objects of a tenant which is local again,
+// leftovers only cost storage, so errors are logged only
+func (i *Index) deleteRemoteTenant(ctx context.Context, files []tenantFile) {
+	store := i.Config.TenantOffloading.Store
+	if store == nil {
+		return
+	}
+
+	for _, file := range files {
+		if file.Dir {
+			continue
+		}
+		if err := store.DeleteSegment(ctx, i.tenantKey(file.Path)); err != nil {
+			i.logger.WithField("action", "delete_remote_tenant").
+				WithField("class", i.Config.ClassName).
+				WithError(err).Warn("could not delete offloaded tenant file")
+		}
+	}
+}
+
+func (i *Index) tenantKey(file string) string {
+	return path.Join(strings.Trim(i.Config.TenantOffloading.Prefix, "/"),
+		"tenants", filepath.ToSlash(file))
+}
+
+// tenantRoots returns the top level files and directories of a shard below
+// the data root
+func (i *Index) tenantRoots(name string) ([]string, error) {
+	shardID := fmt.Sprintf("%s_%s", i.storageID(), name)
+
+	// shards created before geo props were indexed in the lsm store still
+	// contain the files of the previous geo index until they are migrated
+	var geoProps []string
+	if class := i.class(); class != nil {
+		for _, prop := range class.Properties {
+			if dt, _ := schema.AsPrimitive(prop.DataType); dt == schema.DataTypeGeoCoordinates {
+				geoProps = append(geoProps, geoPropID(shardID, prop.Name)+".")
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(i.Config.RootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var roots []string
+	for _, entry := range entries {
+		n := entry.Name()
+		if n == shardID+"_lsm" || strings.HasPrefix(n, shardID+".") {
+			roots = append(roots, n)
+			continue
+		}
+		for _, prefix := range geoProps {
+			if strings.HasPrefix(n, prefix) {
+				roots = append(roots, n)
+			}
+		}
+	}
+
+	return roots, nil
+}
+
+// tenantFiles lists all files and directories of a shard
+func (i *Index) tenantFiles(name string) ([]tenantFile, error) {
+	roots, err := i.tenantRoots(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []tenantFile
+	for _, root := range roots {
+		err := filepath.Walk(filepath.Join(i.Config.RootPath, root),
+			func(p string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				rel, err := filepath.Rel(i.Config.RootPath, p)
+				if err != nil {
+					return err
+				}
+				if info.IsDir() {
+					files = append(files, tenantFile{Path: rel, Dir: true})
+				} else if info.Mode().IsRegular() {
+					files = append(files, tenantFile{Path: rel, Size: info.Size()})
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, errors.Wrapf(err, "list files of tenant %q", name)
+		}
+	}
+
+	return files, nil
+}
+
+func (i *Index) removeTenantFiles(name string) error {
+	roots, err := i.tenantRoots(name)
+	if err != nil {
+		return err
+	}
+
+	for _, root := range roots {
+		if err := os.RemoveAll(filepath.Join(i.Config.RootPath, root)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropOffloadedTenant removes the files of a tenant which is not loaded.
+// It returns false if the tenant is not offloaded.
+func (i *Index) dropOffloadedTenant(ctx context.Context, name string) bool {
+	t := i.tenants.get(name, false)
+	if t == nil {
+		return false
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	status, files := t.status, t.files
+	if err := i.tenants.remove(name); err != nil {
+		i.logger.WithField("action", "drop_shard").
+			WithField("shard", name).Error(err)
+	}
+	if status == TenantHot {
+		return false
+	}
+
+	if status == TenantCold {
+		i.deleteRemoteTenant(ctx, files)
+	}
+	if err := i.removeTenantFiles(name); err != nil {
+		i.logger.WithField("action", "drop_shard").
+			WithField("shard", name).Error(err)
+	}
+	return true
+}
+
+// offloadedTenantStatus returns the status of a local tenant whose shard is
+// not loaded, it is empty for any other shard
+func (i *Index) offloadedTenantStatus(name string) string {
+	if !i.partitioningEnabled || i.tenants == nil {
+		return ""
+	}
+
+	i.tenants.Lock()
+	defer i.tenants.Unlock()
+
+	if t, ok := i.tenants.tenants[name]; ok && t.status != TenantHot {
+		return t.status
+	}
+	return ""
+}
+
+func (i *Index) backupInProgress() bool {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	return i.backupState.InProgress
+}
+
+// setTenantStatus moves a local tenant to the given status
+func (i *Index) setTenantStatus(ctx context.Context, name, status string) error {
+	if !i.partitioningEnabled {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %s has multi-tenancy disabled", i.Config.ClassName))
+	}
+
+	t := i.tenants.get(name, i.shards.Load(name) != nil)
+	if t == nil {
+		return enterrors.NewErrNotFound(
+			fmt.Errorf("tenant %q not found on this node", name))
+	}
+
+	switch status {
+	case TenantHot:
+		shard, release, err := i.getShard(ctx, name)
+		if err != nil {
+			return err
+		}
+		release()
+		if shard == nil {
+			return enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", name))
+		}
+		return nil
+	case TenantWarm, TenantCold:
+		if i.backupInProgress() {
+			return enterrors.NewErrUnprocessable(fmt.Errorf(
+				"cannot offload tenant %q while a backup is in progress", name))
+		}
+	default:
+		return enterrors.NewErrUnprocessable(fmt.Errorf(
+			"invalid tenant status %q, must be one of %s, %s, %s",
+			status, TenantHot, TenantWarm, TenantCold))
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if status == TenantCold && !i.Config.TenantOffloading.coldStorage() {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("no object storage is configured for cold tenants"))
+	}
+
+	switch t.status {
+	case TenantHot:
+		if err := i.deactivateTenant(ctx, name, t); err != nil {
+			return err
+		}
+	case TenantCold:
+		if status == TenantWarm {
+			if err := i.downloadTenant(ctx, name, t); err != nil {
+				return fmt.Errorf("restore tenant %q: %w", name, err)
+			}
+			files := t.files
+			if err := i.tenants.setStatus(name, t, TenantWarm, nil, ""); err != nil {
+				return err
+			}
+			i.deleteRemoteTenant(ctx, files)
+		}
+		return nil
+	}
+
+	if status == TenantCold && t.status == TenantWarm {
+		return i.offloadTenant(ctx, name, t)
+	}
+	return nil
+}
+
+// restoreTenant replaces the empty local shard of a tenant with the copy
+// which the given node offloaded, e.g. to move a cold tenant to a new node.
+// The copy is downloaded on first use. Writes which reach the shard while it
+// is replaced are lost.
+func (i *Index) restoreTenant(ctx context.Context, name, source string) error {
+	if !i.partitioningEnabled {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %s has multi-tenancy disabled", i.Config.ClassName))
+	}
+
+	offloader := i.Config.TenantOffloading.Offloader
+	if offloader == nil {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("no offload backend is configured for cold tenants"))
+	}
+	if i.backupInProgress() {
+		return enterrors.NewErrUnprocessable(fmt.Errorf(
+			"cannot restore tenant %q while a backup is in progress", name))
+	}
+
+	if _, err := offloader.Manifest(ctx, i.Config.ClassName.String(), name, source); err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return enterrors.NewErrNotFound(fmt.Errorf(
+				"node %q has not offloaded tenant %q", source, name))
+		}
+		return fmt.Errorf("restore tenant %q: %w", name, err)
+	}
+
+	t := i.tenants.get(name, i.shards.Load(name) != nil)
+	if t == nil {
+		return enterrors.NewErrNotFound(
+			fmt.Errorf("tenant %q not found on this node", name))
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.status == TenantCold && t.source == source {
+		return nil
+	}
+	if t.status != TenantHot {
+		return enterrors.NewErrUnprocessable(fmt.Errorf(
+			"tenant %q is %s, only tenants without local objects can be restored",
+			name, t.status))
+	}
+
+	shard := i.shards.Load(name)
+	if shard == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", name))
+	}
+	if shard.objectCount() > 0 {
+		return enterrors.NewErrUnprocessable(fmt.Errorf(
+			"tenant %q has local objects, only empty tenants can be restored", name))
+	}
+
+	if err := i.deactivateTenant(ctx, name, t); err != nil {
+		return err
+	}
+	if err := i.tenants.setStatus(name, t, TenantCold, nil, source); err != nil {
+		return fmt.Errorf("restore tenant %q: %w", name, err)
+	}
+
+	return i.removeTenantFiles(name)
+}
+
+// offloadIdleTenants moves tenants which have been idle for long enough to
+// the warm or cold tier
+func (i *Index) offloadIdleTenants(ctx context.Context, now time.Time) {
+	if !i.partitioningEnabled {
+		return
+	}
+	defer i.tenants.observeStatuses()
+
+	cfg := i.Config.TenantOffloading
+	if cfg.WarmAfter <= 0 || i.backupInProgress() {
+		return
+	}
+
+	// register tenants which have been created since the last scan
+	i.ForEachShard(func(name string, shard *Shard) error {
+		i.tenants.get(name, true)
+		return nil
+	})
+
+	names, tenants := i.tenants.snapshot()
+	for j, t := range tenants {
+		name := names[j]
+		idle := t.idle(now)
+		if idle < cfg.WarmAfter || t.inUse.Load() > 0 {
+			continue
+		}
+
+		err := func() error {
+			t.Lock()
+			defer t.Unlock()
+
+			if t.status == TenantHot {
+				if err := i.deactivateTenant(ctx, name, t); err != nil {
+					return err
+				}
+			}
+			if t.status == TenantWarm && cfg.coldStorage() &&
+				cfg.ColdAfter > 0 && idle >= cfg.ColdAfter {
+				return i.offloadTenant(ctx, name, t)
+			}
+			return nil
+		}()
+		if err != nil {
+			i.logger.WithField("action", "offload_idle_tenant").
+				WithField("class", i.Config.ClassName).WithField("tenant", name).
+				WithError(err).Warn("could not offload idle tenant")
+		}
+	}
+}
+
+// tenantActivity returns the status of all local tenants
+func (i *Index) tenantActivity() []TenantActivity {
+	i.ForEachShard(func(name string, shard *Shard) error {
+		i.tenants.get(name, true)
+		return nil
+	})
+
+	return i.tenants.activity()
+}
+
+// activateAllTenants loads all offloaded tenants, e.g. before a backup
+func (i *Index) activateAllTenants(ctx context.Context) error {
+	if !i.partitioningEnabled {
+		return nil
+	}
+
+	names, _ := i.tenants.snapshot()
+	for _, name := range names {
+		_, release, err := i.getShard(ctx, name)
+		if err != nil {
+			return err
+		}
+		release()
+	}
+	return nil
+}
+
+func (db *DB) offloadIdleTenants() {
+	now := time.Now()
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indices = append(indices, index)
+	}
+	db.indexLock.RUnlock()
+
+	for _, index := range indices {
+		index.offloadIdleTenants(context.Background(), now)
+	}
+}
+
+// TenantActivity returns the offloading status of the local tenants of a
+// class
+func (db *DB) TenantActivity(className string) ([]TenantActivity, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	if !idx.partitioningEnabled {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %s has multi-tenancy disabled", className))
+	}
+
+	return idx.tenantActivity(), nil
+}
+
+// SetTenantStatus forces a local tenant of a class into the given status
+func (db *DB) SetTenantStatus(ctx context.Context, className, tenant, status string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	return idx.setTenantStatus(ctx, tenant, status)
+}
+
+// RestoreTenant restores the copy of a tenant which the source node
+// offloaded on this node
+func (db *DB) RestoreTenant(ctx context.Context, className, tenant, source string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	return idx.restoreTenant(ctx, tenant, source)
+}
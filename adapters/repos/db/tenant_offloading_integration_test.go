@@ -0,0 +1,409 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/offload"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeTenantStore struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeTenantStore) PutSegment(ctx context.Context, key string, r io.Reader, size int64) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return fmt.Errorf("expected %d bytes, got %d", size, len(data))
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.objects[key] = data
+	return nil
+}
+
+func (s *fakeTenantStore) ReadSegmentAt(ctx context.Context, key string, p []byte, off int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	data, ok := s.objects[key]
+	if !ok || off+int64(len(p)) > int64(len(data)) {
+		return fmt.Errorf("read %q at %d: out of range", key, off)
+	}
+	copy(p, data[off:])
+	return nil
+}
+
+func (s *fakeTenantStore) DeleteSegment(ctx context.Context, key string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *fakeTenantStore) len() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return len(s.objects)
+}
+
+func TestTenantOffloading(t *testing.T) {
+	dirName := t.TempDir()
+	className := "TenantOffloading"
+	tenants := []string{"tenant1", "tenant2"}
+	store := &fakeTenantStore{objects: map[string][]byte{}}
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	for _, tenant := range tenants {
+		shardState.AddPartition(tenant, []string{"node1"})
+	}
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	newRepo := func(warmAfter time.Duration) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			TenantOffloading: TenantOffloading{
+				WarmAfter: warmAfter,
+				Store:     store,
+				Prefix:    "offloaded",
+			},
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo(0)
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	for _, tenant := range tenants {
+		batch := make(objects.BatchObjects, 20)
+		for i := range batch {
+			id := strfmt.UUID(uuid.NewString())
+			batch[i] = objects.BatchObject{
+				OriginalIndex: i,
+				UUID:          id,
+				Object:        &models.Object{Class: className, ID: id, Tenant: tenant},
+				Vector:        []float32{float32(i), 1, 2},
+			}
+		}
+		res, err := repo.BatchPutObjects(context.Background(), batch, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			require.Nil(t, obj.Err)
+		}
+	}
+
+	search := func(repo *DB, tenant string) int {
+		found, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: []float32{1, 1, 2},
+			Pagination:   &filters.Pagination{Limit: 100},
+			Tenant:       tenant,
+		})
+		require.Nil(t, err)
+		return len(found)
+	}
+
+	status := func(repo *DB) map[string]string {
+		activity, err := repo.TenantActivity(className)
+		require.Nil(t, err)
+		out := map[string]string{}
+		for _, tenant := range activity {
+			out[tenant.Name] = tenant.Status
+		}
+		return out
+	}
+
+	lsmPath := func(tenant string) string {
+		return filepath.Join(dirName, fmt.Sprintf("%s_%s_lsm", indexID(schema.ClassName(className)), tenant))
+	}
+
+	t.Run("warm tenants are unloaded and activated on first use", func(t *testing.T) {
+		require.Nil(t, repo.SetTenantStatus(context.Background(), className, "tenant1", TenantWarm))
+		assert.Equal(t, map[string]string{"tenant1": TenantWarm, "tenant2": TenantHot}, status(repo))
+		assert.Nil(t, repo.GetIndex(schema.ClassName(className)).shards.Load("tenant1"))
+		assert.DirExists(t, lsmPath("tenant1"))
+
+		assert.Equal(t, 20, search(repo, "tenant1"))
+		assert.Equal(t, TenantHot, status(repo)["tenant1"])
+	})
+
+	t.Run("cold tenants are moved to object storage", func(t *testing.T) {
+		require.Nil(t, repo.SetTenantStatus(context.Background(), className, "tenant1", TenantCold))
+		assert.Equal(t, TenantCold, status(repo)["tenant1"])
+		assert.NoDirExists(t, lsmPath("tenant1"))
+		assert.Greater(t, store.len(), 0)
+
+		assert.Equal(t, 20, search(repo, "tenant1"))
+		assert.Equal(t, TenantHot, status(repo)["tenant1"])
+		assert.DirExists(t, lsmPath("tenant1"))
+		assert.Equal(t, 0, store.len())
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		assert.NotNil(t, repo.SetTenantStatus(context.Background(), className, "tenant1", "LUKEWARM"))
+		assert.NotNil(t, repo.SetTenantStatus(context.Background(), className, "unknown", TenantWarm))
+	})
+
+	t.Run("offloaded tenants stay offloaded after a restart", func(t *testing.T) {
+		require.Nil(t, repo.SetTenantStatus(context.Background(), className, "tenant2", TenantCold))
+		require.Nil(t, repo.Shutdown(context.Background()))
+
+		repo = newRepo(0)
+		assert.Equal(t, map[string]string{"tenant1": TenantHot, "tenant2": TenantCold}, status(repo))
+		assert.Nil(t, repo.GetIndex(schema.ClassName(className)).shards.Load("tenant2"))
+
+		assert.Equal(t, 20, search(repo, "tenant2"))
+		assert.Equal(t, TenantHot, status(repo)["tenant2"])
+	})
+
+	t.Run("idle tenants are offloaded automatically", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo(time.Millisecond)
+
+		time.Sleep(10 * time.Millisecond)
+		repo.offloadIdleTenants()
+		assert.Equal(t, map[string]string{"tenant1": TenantWarm, "tenant2": TenantWarm}, status(repo))
+
+		assert.Equal(t, 20, search(repo, "tenant2"))
+		assert.Equal(t, TenantHot, status(repo)["tenant2"])
+	})
+
+	t.Run("dropping an offloaded tenant removes its files", func(t *testing.T) {
+		require.Nil(t, repo.SetTenantStatus(context.Background(), className, "tenant1", TenantCold))
+		require.Greater(t, store.len(), 0)
+
+		commit, err := NewMigrator(repo, logger).DeleteTenants(context.Background(), class, []string{"tenant1"})
+		require.Nil(t, err)
+		commit(true)
+
+		assert.NotContains(t, status(repo), "tenant1")
+		assert.Equal(t, 0, store.len())
+		entries, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		for _, entry := range entries {
+			assert.NotContains(t, entry.Name(), "tenant1")
+		}
+	})
+
+	require.Nil(t, repo.Shutdown(context.Background()))
+}
+
+// fakeBackupBackend shares its objects with the backends of other nodes
+type fakeBackupBackend struct {
+	*fakeTenantStore
+	dataPath string
+}
+
+func (f *fakeBackupBackend) BackupBackend(string) (modulecapabilities.BackupBackend, error) {
+	return f, nil
+}
+
+func (f *fakeBackupBackend) IsExternal() bool                         { return true }
+func (f *fakeBackupBackend) Name() string                             { return "fake" }
+func (f *fakeBackupBackend) HomeDir(backupID string) string           { return backupID }
+func (f *fakeBackupBackend) SourceDataPath() string                   { return f.dataPath }
+func (f *fakeBackupBackend) Initialize(context.Context, string) error { return nil }
+
+func (f *fakeBackupBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	data, ok := f.objects[path.Join(backupID, key)]
+	if !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("no object %s/%s", backupID, key))
+	}
+	return data, nil
+}
+
+func (f *fakeBackupBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	data, err := f.GetObject(ctx, backupID, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, 0o666)
+}
+
+func (f *fakeBackupBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	data, err := os.ReadFile(filepath.Join(f.dataPath, srcPath))
+	if err != nil {
+		return err
+	}
+	return f.PutObject(ctx, backupID, key, data)
+}
+
+func (f *fakeBackupBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.objects[path.Join(backupID, key)] = data
+	return nil
+}
+
+func TestTenantOffloadingToBackupBackend(t *testing.T) {
+	className := "TenantOffloadingBackend"
+	store := &fakeTenantStore{objects: map[string][]byte{}}
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("tenant1", []string{"node1"})
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+
+	logger := logrus.New()
+	// every repo has its own data path, like a separate node
+	newRepo := func(dirName string) *DB {
+		backend := &fakeBackupBackend{fakeTenantStore: store, dataPath: dirName}
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			TenantOffloading: TenantOffloading{
+				Offloader: offload.New(backend, "fake"),
+			},
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		schemaGetter := &fakeSchemaGetter{shardState: shardState}
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, shardState))
+		schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+		return repo
+	}
+
+	search := func(repo *DB) int {
+		found, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: []float32{1, 1, 2},
+			Pagination:   &filters.Pagination{Limit: 100},
+			Tenant:       "tenant1",
+		})
+		require.Nil(t, err)
+		return len(found)
+	}
+
+	status := func(repo *DB) TenantActivity {
+		activity, err := repo.TenantActivity(className)
+		require.Nil(t, err)
+		require.Len(t, activity, 1)
+		return activity[0]
+	}
+
+	repo1 := newRepo(t.TempDir())
+	defer repo1.Shutdown(context.Background())
+
+	batch := make(objects.BatchObjects, 20)
+	for i := range batch {
+		id := strfmt.UUID(uuid.NewString())
+		batch[i] = objects.BatchObject{
+			OriginalIndex: i,
+			UUID:          id,
+			Object:        &models.Object{Class: className, ID: id, Tenant: "tenant1"},
+			Vector:        []float32{float32(i), 1, 2},
+		}
+	}
+	res, err := repo1.BatchPutObjects(context.Background(), batch, nil)
+	require.Nil(t, err)
+	for _, obj := range res {
+		require.Nil(t, obj.Err)
+	}
+
+	t.Run("cold tenants are copied with a manifest", func(t *testing.T) {
+		require.Nil(t, repo1.SetTenantStatus(context.Background(), className, "tenant1", TenantCold))
+		assert.Equal(t, TenantCold, status(repo1).Status)
+		assert.Equal(t, "node1", status(repo1).Source)
+
+		m, err := repo1.config.TenantOffloading.Offloader.Manifest(context.Background(),
+			className, "tenant1", "node1")
+		require.Nil(t, err)
+		assert.Greater(t, m.Size(), int64(0))
+
+		assert.Equal(t, 20, search(repo1))
+		assert.Equal(t, TenantHot, status(repo1).Status)
+		assert.Empty(t, status(repo1).Source)
+	})
+
+	t.Run("copies are restored on another node", func(t *testing.T) {
+		repo2 := newRepo(t.TempDir())
+		defer repo2.Shutdown(context.Background())
+		assert.Equal(t, 0, search(repo2))
+
+		err := repo2.RestoreTenant(context.Background(), className, "tenant1", "node3")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+
+		require.Nil(t, repo2.RestoreTenant(context.Background(), className, "tenant1", "node1"))
+		assert.Equal(t, TenantCold, status(repo2).Status)
+		assert.Equal(t, 20, search(repo2))
+		assert.Equal(t, TenantHot, status(repo2).Status)
+
+		// tenants with local objects are never overwritten
+		err = repo2.RestoreTenant(context.Background(), className, "tenant1", "node1")
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+}
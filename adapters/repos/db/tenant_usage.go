@@ -0,0 +1,319 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// shardUsage counts the objects, the objects with a vector and the size of
+// the stored objects per tenant of a shard. A shared shard counts each of
+// its tenants, every other shard has a single entry named after the shard.
+// The counters are kept in a bucket of the shard, so they move along with
+// the shard when it is backed up, offloaded or restored.
+type shardUsage struct {
+	sync.Mutex
+	bucket  *lsmkv.Bucket
+	tenants map[string]models.TenantUsage
+	metrics *Metrics
+}
+
+// usageDelta is the change of the usage caused by a single write
+type usageDelta struct {
+	objects, vectors, bytes int64
+}
+
+// objectUsageDelta returns the change of the usage if the stored object
+// previous is replaced with next, either can be nil
+func objectUsageDelta(previous, next []byte) usageDelta {
+	var d usageDelta
+	if previous != nil {
+		d.objects--
+		d.bytes -= int64(len(previous))
+		if hasVector(previous) {
+			d.vectors--
+		}
+	}
+	if next != nil {
+		d.objects++
+		d.bytes += int64(len(next))
+		if hasVector(next) {
+			d.vectors++
+		}
+	}
+	return d
+}
+
+func (d usageDelta) negate() usageDelta {
+	return usageDelta{objects: -d.objects, vectors: -d.vectors, bytes: -d.bytes}
+}
+
+// hasVector reads the length of the vector of a stored object without
+// decoding it, see storobj.VectorFromBinary for the layout
+func hasVector(data []byte) bool {
+	return len(data) >= 44 && data[0] == 1 &&
+		binary.LittleEndian.Uint16(data[42:44]) > 0
+}
+
+func (s *Shard) initUsage(ctx context.Context) error {
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.UsageBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return errors.Wrap(err, "create usage bucket")
+	}
+
+	u := &shardUsage{
+		bucket:  s.store.Bucket(helpers.UsageBucketLSM),
+		tenants: map[string]models.TenantUsage{},
+		metrics: s.metrics,
+	}
+	c := u.bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		u.tenants[string(k)] = decodeUsage(v)
+	}
+	c.Close()
+
+	// shards which were written before their usage was tracked are counted
+	// once on startup
+	if len(u.tenants) == 0 && s.counter.PreviewNext() != 0 {
+		c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := u.add(s.usageKeyFromBinary(v), objectUsageDelta(nil, v), nil); err != nil {
+				c.Close()
+				return errors.Wrap(err, "count usage")
+			}
+		}
+		c.Close()
+	}
+
+	for tenant, usage := range u.tenants {
+		u.metrics.TenantUsage(tenant, usage)
+	}
+	s.usage = u
+	return nil
+}
+
+// usageKey returns the tenant the usage of an object is counted for
+func (s *Shard) usageKey(obj *storobj.Object) string {
+	if tenant := sharedTenant(obj); tenant != "" {
+		return tenant
+	}
+	return s.name
+}
+
+func (s *Shard) usageKeyFromBinary(data []byte) string {
+	if !sharding.IsSharedShardName(s.name) {
+		return s.name
+	}
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return s.name
+	}
+	return s.usageKey(obj)
+}
+
+// reserveUsage adds the change of a write to the usage of the tenant,
+// unless the write would exceed the quota of the tenant
+func (s *Shard) reserveUsage(tenant string, d usageDelta) error {
+	var quota *models.TenantQuota
+	if s.index.partitioningEnabled {
+		quota = s.index.getSchema.TenantQuota(s.index.Config.ClassName.String(), tenant)
+	}
+	return s.usage.add(tenant, d, quota)
+}
+
+// trackUsage adds the change of a write which already happened
+func (s *Shard) trackUsage(tenant string, d usageDelta) {
+	if err := s.usage.add(tenant, d, nil); err != nil {
+		s.index.logger.WithField("action", "track_usage").WithField("shard", s.name).
+			WithError(err).Warn("could not update the usage of the tenant")
+	}
+}
+
+// tenantUsage returns the usage of the tenants of the shard
+func (s *Shard) tenantUsage() map[string]models.TenantUsage {
+	u := s.usage
+	if u == nil {
+		return nil
+	}
+
+	u.Lock()
+	defer u.Unlock()
+	out := make(map[string]models.TenantUsage, len(u.tenants))
+	for tenant, usage := range u.tenants {
+		out[tenant] = usage
+	}
+	return out
+}
+
+func (u *shardUsage) add(tenant string, d usageDelta, quota *models.TenantQuota) error {
+	if u == nil || d == (usageDelta{}) {
+		return nil
+	}
+
+	u.Lock()
+	defer u.Unlock()
+
+	current := u.tenants[tenant]
+	next := models.TenantUsage{
+		Objects:      current.Objects + d.objects,
+		Vectors:      current.Vectors + d.vectors,
+		StorageBytes: current.StorageBytes + d.bytes,
+	}
+	if err := u.checkQuota(tenant, quota, d, next); err != nil {
+		return err
+	}
+
+	if next.Objects <= 0 {
+		if err := u.bucket.Delete([]byte(tenant)); err != nil {
+			return errors.Wrap(err, "delete usage")
+		}
+		delete(u.tenants, tenant)
+		u.metrics.DeleteTenantUsage(tenant)
+		return nil
+	}
+
+	if err := u.bucket.Put([]byte(tenant), encodeUsage(next)); err != nil {
+		return errors.Wrap(err, "store usage")
+	}
+	u.tenants[tenant] = next
+	u.metrics.TenantUsage(tenant, next)
+	return nil
+}
+
+// checkQuota rejects writes which increase the usage of a tenant above one
+// of its limits. Writes which reduce the usage are always allowed, so a
+// tenant above its limits, e.g. after the quota was lowered, can still
+// delete objects.
+func (u *shardUsage) checkQuota(tenant string, quota *models.TenantQuota,
+	d usageDelta, next models.TenantUsage,
+) error {
+	if quota == nil {
+		return nil
+	}
+
+	var resource string
+	var limit int64
+	switch {
+	case quota.MaxObjects > 0 && d.objects > 0 && next.Objects > quota.MaxObjects:
+		resource, limit = "objects", quota.MaxObjects
+	case quota.MaxVectors > 0 && d.vectors > 0 && next.Vectors > quota.MaxVectors:
+		resource, limit = "vectors", quota.MaxVectors
+	case quota.MaxStorageBytes > 0 && d.bytes > 0 && next.StorageBytes > quota.MaxStorageBytes:
+		resource, limit = "storage bytes", quota.MaxStorageBytes
+	default:
+		return nil
+	}
+
+	u.metrics.TenantQuotaRejected(resource)
+	return objects.NewErrMultiTenancy(fmt.Errorf(
+		"tenant %q has reached its quota of %d %s", tenant, limit, resource))
+}
+
+// dropMetrics removes the usage of all tenants of a dropped shard from the
+// metrics
+func (u *shardUsage) dropMetrics() {
+	if u == nil {
+		return
+	}
+
+	u.Lock()
+	defer u.Unlock()
+	for tenant := range u.tenants {
+		u.metrics.DeleteTenantUsage(tenant)
+	}
+}
+
+func encodeUsage(u models.TenantUsage) []byte {
+	data := make([]byte, 24)
+	binary.LittleEndian.PutUint64(data[0:8], uint64(u.Objects))
+	binary.LittleEndian.PutUint64(data[8:16], uint64(u.Vectors))
+	binary.LittleEndian.PutUint64(data[16:24], uint64(u.StorageBytes))
+	return data
+}
+
+func decodeUsage(data []byte) models.TenantUsage {
+	if len(data) < 24 {
+		return models.TenantUsage{}
+	}
+	return models.TenantUsage{
+		Objects:      int64(binary.LittleEndian.Uint64(data[0:8])),
+		Vectors:      int64(binary.LittleEndian.Uint64(data[8:16])),
+		StorageBytes: int64(binary.LittleEndian.Uint64(data[16:24])),
+	}
+}
+
+// tenantUsage returns the usage of the given tenants. The shards are asked
+// once each, local shards directly and remote shards over the cluster api.
+// Tenants whose shard is offloaded or could not be reached are left out.
+func (i *Index) tenantUsage(ctx context.Context, tenants []string) map[string]*models.TenantUsage {
+	shardState := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	if shardState == nil {
+		return nil
+	}
+
+	shardTenants := map[string][]string{}
+	for _, tenant := range tenants {
+		if shard := shardState.Shard(tenant, ""); shard != "" {
+			shardTenants[shard] = append(shardTenants[shard], tenant)
+		}
+	}
+
+	out := make(map[string]*models.TenantUsage, len(tenants))
+	for shardName, names := range shardTenants {
+		var usage map[string]models.TenantUsage
+		if shardState.IsLocalShard(shardName) {
+			shard := i.shards.Load(shardName)
+			if shard == nil {
+				continue
+			}
+			usage = shard.tenantUsage()
+		} else {
+			var err error
+			usage, err = i.remote.GetShardUsage(ctx, shardName)
+			if err != nil {
+				i.logger.WithField("action", "tenant_usage").WithField("shard", shardName).
+					WithError(err).Warn("could not get the usage of a remote shard")
+				continue
+			}
+		}
+
+		for _, tenant := range names {
+			u := usage[tenant]
+			out[tenant] = &u
+		}
+	}
+	return out
+}
+
+func (i *Index) IncomingGetShardUsage(ctx context.Context, shardName string) (map[string]models.TenantUsage, error) {
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		if i.offloadedTenantStatus(shardName) != "" {
+			return nil, nil
+		}
+		return nil, errors.Errorf("shard %q does not exist", shardName)
+	}
+	return shard.tenantUsage(), nil
+}
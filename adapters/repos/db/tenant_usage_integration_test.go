@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestTenantQuotas(t *testing.T) {
+	dirName := t.TempDir()
+	className := "TenantQuotas"
+	nodes := []string{"node1"}
+
+	shardState, err := sharding.InitState(className, sharding.Config{},
+		fakeNodes{nodes}, 1, true)
+	require.Nil(t, err)
+	for tenant, quota := range map[string]*models.TenantQuota{
+		"objects": {MaxObjects: 3},
+		"vectors": {MaxVectors: 1},
+		"bytes":   {MaxStorageBytes: 1},
+	} {
+		p := shardState.AddPartition(tenant, nodes)
+		p.Quota = quota
+		shardState.Physical[tenant] = p
+	}
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	startRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := startRepo()
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, shardState))
+
+	put := func(tenant string, vector []float32) (strfmt.UUID, error) {
+		id := strfmt.UUID(uuid.NewString())
+		return id, repo.PutObject(context.Background(), &models.Object{
+			Class:  className,
+			ID:     id,
+			Tenant: tenant,
+		}, vector, nil)
+	}
+
+	usage := func(tenant string) models.TenantUsage {
+		res, err := NewMigrator(repo, logger).TenantUsage(context.Background(),
+			className, []string{tenant})
+		require.Nil(t, err)
+		require.NotNil(t, res[tenant])
+		return *res[tenant]
+	}
+
+	assertQuotaErr := func(t *testing.T, err error) {
+		var mtErr objects.ErrMultiTenancy
+		require.True(t, errors.As(err, &mtErr), err)
+		assert.Contains(t, err.Error(), "quota")
+	}
+
+	var ids []strfmt.UUID
+	t.Run("objects quota", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			id, err := put("objects", nil)
+			require.Nil(t, err)
+			ids = append(ids, id)
+		}
+		_, err := put("objects", nil)
+		assertQuotaErr(t, err)
+		assert.Equal(t, int64(3), usage("objects").Objects)
+		assert.Greater(t, usage("objects").StorageBytes, int64(0))
+	})
+
+	t.Run("overwriting an object does not count twice", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:  className,
+			ID:     ids[0],
+			Tenant: "objects",
+		}, nil, nil))
+		assert.Equal(t, int64(3), usage("objects").Objects)
+	})
+
+	t.Run("vectors quota", func(t *testing.T) {
+		_, err := put("vectors", []float32{1, 2, 3})
+		require.Nil(t, err)
+		_, err = put("vectors", []float32{1, 2, 3})
+		assertQuotaErr(t, err)
+		_, err = put("vectors", nil)
+		require.Nil(t, err)
+		u := usage("vectors")
+		assert.Equal(t, int64(2), u.Objects)
+		assert.Equal(t, int64(1), u.Vectors)
+	})
+
+	t.Run("storage bytes quota", func(t *testing.T) {
+		_, err := put("bytes", nil)
+		assertQuotaErr(t, err)
+		assert.Equal(t, models.TenantUsage{}, usage("bytes"))
+	})
+
+	t.Run("usage is persisted", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = startRepo()
+		assert.Equal(t, int64(3), usage("objects").Objects)
+		assert.Equal(t, int64(1), usage("vectors").Vectors)
+	})
+
+	t.Run("deletes free the quota", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), className, ids[0], nil, "objects"))
+		assert.Equal(t, int64(2), usage("objects").Objects)
+		_, err := put("objects", nil)
+		require.Nil(t, err)
+	})
+
+	require.Nil(t, repo.Shutdown(context.Background()))
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// Cells divide the surface into a grid in the latitude/longitude plane. At
+// level l there are 2^l rows and 2^l columns, every cell is split into four
+// cells at the next level. The id of a cell interleaves the bits of its row
+// and column, so all leaf cells of a cell form a contiguous range of ids.
+// Points are stored by the id of their leaf cell, a cell of about 30cm at
+// the equator.
+const maxLevel = 26
+
+// maxCoverCells limits the number of cells used to cover the area of a
+// search. More cells follow the outline more closely, but each of them
+// requires a seek in the bucket.
+const maxCoverCells = 64
+
+type rect struct {
+	minLat, maxLat, minLon, maxLon float64
+}
+
+func (r rect) intersects(other rect) bool {
+	return r.minLat <= other.maxLat && other.minLat <= r.maxLat &&
+		r.minLon <= other.maxLon && other.minLon <= r.maxLon
+}
+
+func (r rect) containsRect(other rect) bool {
+	return r.minLat <= other.minLat && other.maxLat <= r.maxLat &&
+		r.minLon <= other.minLon && other.maxLon <= r.maxLon
+}
+
+func (r rect) containsPoint(lat, lon float64) bool {
+	return r.minLat <= lat && lat <= r.maxLat && r.minLon <= lon && lon <= r.maxLon
+}
+
+func (r rect) corners() [4][2]float64 {
+	return [4][2]float64{
+		{r.minLat, r.minLon}, {r.minLat, r.maxLon},
+		{r.maxLat, r.minLon}, {r.maxLat, r.maxLon},
+	}
+}
+
+// gridIndex returns the row or column of a latitude or longitude, the value
+// is expected to be normalized to [0, 1]
+func gridIndex(normalized float64, level int) uint32 {
+	size := uint32(1) << level
+	if normalized <= 0 {
+		return 0
+	}
+	if normalized >= 1 {
+		return size - 1
+	}
+	return uint32(normalized * float64(size))
+}
+
+func rowOf(lat float64, level int) uint32 {
+	return gridIndex((lat+90)/180, level)
+}
+
+func columnOf(lon float64, level int) uint32 {
+	return gridIndex((lon+180)/360, level)
+}
+
+func interleave(row, column uint32, level int) uint64 {
+	var id uint64
+	for bit := level - 1; bit >= 0; bit-- {
+		id = id<<2 | uint64(row>>bit&1)<<1 | uint64(column>>bit&1)
+	}
+	return id
+}
+
+func deinterleave(id uint64, level int) (row, column uint32) {
+	for bit := level - 1; bit >= 0; bit-- {
+		row = row<<1 | uint32(id>>(2*bit+1)&1)
+		column = column<<1 | uint32(id>>(2*bit)&1)
+	}
+	return row, column
+}
+
+// leafCell returns the id of the leaf cell containing the point
+func leafCell(lat, lon float64) uint64 {
+	return interleave(rowOf(lat, maxLevel), columnOf(lon, maxLevel), maxLevel)
+}
+
+func cellRect(row, column uint32, level int) rect {
+	latStep := 180 / float64(uint64(1)<<level)
+	lonStep := 360 / float64(uint64(1)<<level)
+	return rect{
+		minLat: -90 + float64(row)*latStep,
+		maxLat: -90 + float64(row+1)*latStep,
+		minLon: -180 + float64(column)*lonStep,
+		maxLon: -180 + float64(column+1)*lonStep,
+	}
+}
+
+func leafCellRect(id uint64) rect {
+	row, column := deinterleave(id, maxLevel)
+	return cellRect(row, column, maxLevel)
+}
+
+func cellKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func cellFromKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
+
+// cellRange is a range of leaf cell ids, the end is exclusive. If inside is
+// set, the range lies entirely within the searched region.
+type cellRange struct {
+	start, end uint64
+	inside     bool
+}
+
+// cover returns the ranges of leaf cells which intersect with the region,
+// sorted by their start
+func cover(r region) []cellRange {
+	var ranges []cellRange
+	for _, bounds := range r.bounds() {
+		level := coverLevel(bounds)
+		shift := 2 * (maxLevel - level)
+
+		for row := rowOf(bounds.minLat, level); row <= rowOf(bounds.maxLat, level); row++ {
+			for column := columnOf(bounds.minLon, level); column <= columnOf(bounds.maxLon, level); column++ {
+				rel := r.relate(cellRect(row, column, level))
+				if rel == disjoint {
+					continue
+				}
+
+				id := interleave(row, column, level)
+				ranges = append(ranges, cellRange{
+					start:  id << shift,
+					end:    (id + 1) << shift,
+					inside: rel == within,
+				})
+			}
+		}
+	}
+
+	sort.Slice(ranges, func(a, b int) bool { return ranges[a].start < ranges[b].start })
+
+	// merge adjacent ranges, so they are read with a single seek
+	merged := ranges[:0]
+	for _, cr := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].end >= cr.start && merged[n-1].inside == cr.inside {
+			if cr.end > merged[n-1].end {
+				merged[n-1].end = cr.end
+			}
+			continue
+		}
+		merged = append(merged, cr)
+	}
+
+	return merged
+}
+
+// coverLevel returns the finest level at which the bounds are covered by at
+// most maxCoverCells cells
+func coverLevel(bounds rect) int {
+	for level := maxLevel; level > 0; level-- {
+		rows := uint64(rowOf(bounds.maxLat, level)-rowOf(bounds.minLat, level)) + 1
+		columns := uint64(columnOf(bounds.maxLon, level)-columnOf(bounds.minLon, level)) + 1
+		if rows*columns <= maxCoverCells {
+			return level
+		}
+	}
+	return 0
+}
+
+func degreesToRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func radiansToDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
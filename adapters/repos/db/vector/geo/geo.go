@@ -13,128 +13,221 @@ package geo
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
-	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
-	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
-	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
-// Index wraps another index to provide geo searches. This allows us to reuse
-// the hnsw vector index, without making geo searches dependent on
-// hnsw-specific features.
-//
-// In the future we could use this level of abstraction to provide a better
-// suited geo-index if we deem it necessary
+// Index provides geo searches on a single property. Every doc id is stored
+// in the cell containing its coordinates, a search reads all cells covering
+// the searched area and only checks the exact coordinates of doc ids in
+// cells on the boundary of the area.
 type Index struct {
-	config      Config
-	vectorIndex vectorIndex
-}
-
-// vectorIndex represents the underlying vector index, typically hnsw
-type vectorIndex interface {
-	Add(id uint64, vector []float32) error
-	KnnSearchByVectorMaxDist(query []float32, dist float32, ef int,
-		allowList helpers.AllowList) ([]uint64, error)
-	Delete(id ...uint64) error
-	Dump(...string)
-	Drop(ctx context.Context) error
-	PostStartup()
+	config Config
 }
 
 // Config is passed to the GeoIndex when its created
 type Config struct {
-	ID                 string
-	CoordinatesForID   CoordinatesForID
-	DisablePersistence bool
-	RootPath           string
-	Logger             logrus.FieldLogger
-}
-
-func NewIndex(config Config, tombstoneCleanupCycle cyclemanager.CycleManager,
-	commitLogMaintenanceCycle cyclemanager.CycleManager,
-) (*Index, error) {
-	vi, err := hnsw.New(hnsw.Config{
-		VectorForIDThunk:      config.CoordinatesForID.VectorForID,
-		ID:                    config.ID,
-		RootPath:              config.RootPath,
-		MakeCommitLoggerThunk: makeCommitLoggerFromConfig(config, commitLogMaintenanceCycle),
-		DistanceProvider:      distancer.NewGeoProvider(),
-	}, hnswent.UserConfig{
-		MaxConnections:         64,
-		EFConstruction:         128,
-		CleanupIntervalSeconds: hnswent.DefaultCleanupIntervalSeconds,
-	}, tombstoneCleanupCycle)
-	if err != nil {
-		return nil, errors.Wrap(err, "underlying hnsw index")
-	}
+	// Cells is a roaring set bucket, mapping a leaf cell to the doc ids
+	// within
+	Cells *lsmkv.Bucket
+	// Coordinates is a replace bucket, mapping a doc id to its coordinates
+	Coordinates *lsmkv.Bucket
+}
 
-	i := &Index{
-		config:      config,
-		vectorIndex: vi,
+func NewIndex(config Config) (*Index, error) {
+	if config.Cells == nil || config.Coordinates == nil {
+		return nil, fmt.Errorf("cells and coordinates bucket must be set")
 	}
 
-	return i, nil
+	return &Index{config: config}, nil
 }
 
+// Drop is a no-op, the buckets are owned and removed by the shard
 func (i *Index) Drop(ctx context.Context) error {
-	if err := i.vectorIndex.Drop(ctx); err != nil {
-		return err
+	return nil
+}
+
+// Add extends the index with the specified GeoCoordinates. If the id is
+// already present, its coordinates are replaced. It is thread-safe and can
+// be called concurrently for different ids.
+func (i *Index) Add(id uint64, coordinates *models.GeoCoordinates) error {
+	lat, lon, err := latLon(coordinates)
+	if err != nil {
+		return errors.Wrap(err, "invalid arguments")
+	}
+
+	if err := i.Delete(id); err != nil {
+		return errors.Wrap(err, "remove previous coordinates")
+	}
+
+	key := docIDKey(id)
+	if err := i.config.Coordinates.Put(key, encodeCoordinates(lat, lon)); err != nil {
+		return errors.Wrap(err, "store coordinates")
+	}
+
+	if err := i.config.Cells.RoaringSetAddOne(cellKey(leafCell(lat, lon)), id); err != nil {
+		return errors.Wrap(err, "add to cell")
 	}
 
-	i.vectorIndex = nil
 	return nil
 }
 
-func (i *Index) PostStartup() {
-	i.vectorIndex.PostStartup()
-}
+// Delete removes the id from the index, it is a no-op if it is not present
+func (i *Index) Delete(id uint64) error {
+	key := docIDKey(id)
+	lat, lon, ok, err := i.coordinates(key)
+	if err != nil || !ok {
+		return err
+	}
 
-func makeCommitLoggerFromConfig(config Config, maintenanceCycle cyclemanager.CycleManager,
-) hnsw.MakeCommitLogger {
-	makeCL := hnsw.MakeNoopCommitLogger
-	if !config.DisablePersistence {
-		makeCL = func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger, maintenanceCycle)
-		}
+	if err := i.config.Cells.RoaringSetRemoveOne(cellKey(leafCell(lat, lon)), id); err != nil {
+		return errors.Wrap(err, "remove from cell")
 	}
-	return makeCL
-}
 
-// Add extends the index with the specified GeoCoordinates. It is thread-safe
-// and can be called concurrently.
-func (i *Index) Add(id uint64, coordinates *models.GeoCoordinates) error {
-	v, err := geoCoordiantesToVector(coordinates)
-	if err != nil {
-		return errors.Wrap(err, "invalid arguments")
+	if err := i.config.Coordinates.Delete(key); err != nil {
+		return errors.Wrap(err, "delete coordinates")
 	}
 
-	return i.vectorIndex.Add(id, v)
+	return nil
 }
 
-// WithinGeoRange searches the index by the specified range. It is thread-safe
-// and can be called concurrently.
+// WithinRange returns all ids within the distance of the coordinates. It is
+// thread-safe and can be called concurrently.
 func (i *Index) WithinRange(ctx context.Context,
 	geoRange filters.GeoRange,
-) ([]uint64, error) {
+) (*sroar.Bitmap, error) {
 	if geoRange.GeoCoordinates == nil {
 		return nil, fmt.Errorf("invalid arguments: GeoCoordinates in range must be set")
 	}
 
-	query, err := geoCoordiantesToVector(geoRange.GeoCoordinates)
+	lat, lon, err := latLon(geoRange.GeoCoordinates)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid arguments")
 	}
 
-	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
+	return i.search(ctx, newCircle(lat, lon, geoRange.Distance))
 }
 
-func (i *Index) Delete(id uint64) error {
-	return i.vectorIndex.Delete(id)
+// WithinBoundingBox returns all ids within the box. A box whose top left
+// longitude is larger than its bottom right longitude crosses the
+// antimeridian. It is thread-safe and can be called concurrently.
+func (i *Index) WithinBoundingBox(ctx context.Context,
+	box filters.GeoBoundingBox,
+) (*sroar.Bitmap, error) {
+	if err := box.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.search(ctx, newBoundingBox(box))
+}
+
+// WithinPolygon returns all ids within the polygon. It is thread-safe and
+// can be called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon filters.GeoPolygon,
+) (*sroar.Bitmap, error) {
+	if err := polygon.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.search(ctx, newPolygon(polygon))
+}
+
+func (i *Index) search(ctx context.Context, r region) (*sroar.Bitmap, error) {
+	out := sroar.NewBitmap()
+
+	c := i.config.Cells.CursorRoaringSet().WithContext(ctx)
+	defer c.Close()
+
+	for _, cr := range cover(r) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for k, ids := c.Seek(cellKey(cr.start)); k != nil && cellFromKey(k) < cr.end; k, ids = c.Next() {
+			if cr.inside {
+				out.Or(ids)
+				continue
+			}
+
+			switch r.relate(leafCellRect(cellFromKey(k))) {
+			case within:
+				out.Or(ids)
+			case intersects:
+				if err := i.addContained(r, ids, out); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := c.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// addContained adds the ids whose exact coordinates are within the region
+func (i *Index) addContained(r region, ids, out *sroar.Bitmap) error {
+	for _, id := range ids.ToArray() {
+		lat, lon, ok, err := i.coordinates(docIDKey(id))
+		if err != nil {
+			return err
+		}
+		if ok && r.contains(lat, lon) {
+			out.Set(id)
+		}
+	}
+	return nil
+}
+
+func (i *Index) coordinates(key []byte) (float64, float64, bool, error) {
+	v, err := i.config.Coordinates.Get(key)
+	if err != nil {
+		return 0, 0, false, errors.Wrap(err, "get coordinates")
+	}
+	if len(v) != 8 {
+		return 0, 0, false, nil
+	}
+
+	lat, lon := decodeCoordinates(v)
+	return lat, lon, true, nil
+}
+
+func latLon(in *models.GeoCoordinates) (float64, float64, error) {
+	if in.Latitude == nil {
+		return 0, 0, fmt.Errorf("latitude must be set")
+	}
+
+	if in.Longitude == nil {
+		return 0, 0, fmt.Errorf("longitude must be set")
+	}
+
+	return float64(*in.Latitude), float64(*in.Longitude), nil
+}
+
+func docIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, id)
+	return key
+}
+
+// coordinates are stored with the precision of the user input
+func encodeCoordinates(lat, lon float64) []byte {
+	out := make([]byte, 8)
+	binary.LittleEndian.PutUint32(out[0:4], math.Float32bits(float32(lat)))
+	binary.LittleEndian.PutUint32(out[4:8], math.Float32bits(float32(lon)))
+	return out
+}
+
+func decodeCoordinates(in []byte) (float64, float64) {
+	return float64(math.Float32frombits(binary.LittleEndian.Uint32(in[0:4]))),
+		float64(math.Float32frombits(binary.LittleEndian.Uint32(in[4:8])))
 }
@@ -13,10 +13,14 @@ package geo
 
 import (
 	"context"
+	"math/rand"
+	"path/filepath"
 	"testing"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -34,17 +38,7 @@ func TestGeoJourney(t *testing.T) {
 		},
 	}
 
-	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
-		return &elements[id], nil
-	}
-
-	geoIndex, err := NewIndex(Config{
-		ID:                 "unit-test",
-		CoordinatesForID:   getCoordinates,
-		DisablePersistence: true,
-		RootPath:           "doesnt-matter-persistence-is-off",
-	}, cyclemanager.NewNoop(), cyclemanager.NewNoop())
-	require.Nil(t, err)
+	geoIndex := testIndex(t)
 
 	t.Run("importing all", func(t *testing.T) {
 		for id, coordinates := range elements {
@@ -80,7 +74,6 @@ func TestGeoJourney(t *testing.T) {
 	})
 
 	t.Run("searching within 500km of munich", func(t *testing.T) {
-		// should return both cities, with munich first and stuttgart second
 		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
 			GeoCoordinates: &models.GeoCoordinates{
 				Latitude:  ptFloat32(48.13743),
@@ -91,11 +84,10 @@ func TestGeoJourney(t *testing.T) {
 		require.Nil(t, err)
 
 		expectedResults := []uint64{0, 1}
-		assert.Equal(t, expectedResults, results)
+		assert.Equal(t, expectedResults, results.ToArray())
 	})
 
 	t.Run("searching within 10km of munich", func(t *testing.T) {
-		// should return both cities, with munich first and stuttgart second
 		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
 			GeoCoordinates: &models.GeoCoordinates{
 				Latitude:  ptFloat32(48.13743),
@@ -106,10 +98,224 @@ func TestGeoJourney(t *testing.T) {
 		require.Nil(t, err)
 
 		expectedResults := []uint64{0}
-		assert.Equal(t, expectedResults, results)
+		assert.Equal(t, expectedResults, results.ToArray())
+	})
+
+	t.Run("searching within a bounding box around stuttgart", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     geo(49, 9),
+			BottomRight: geo(48.5, 9.5),
+		})
+		require.Nil(t, err)
+
+		expectedResults := []uint64{1}
+		assert.Equal(t, expectedResults, results.ToArray())
+	})
+
+	t.Run("searching within a polygon around munich", func(t *testing.T) {
+		results, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Vertices: []*models.GeoCoordinates{geo(48, 11), geo(48.5, 11.5), geo(48, 12)},
+		})
+		require.Nil(t, err)
+
+		expectedResults := []uint64{0}
+		assert.Equal(t, expectedResults, results.ToArray())
+	})
+
+	t.Run("searching with an invalid polygon", func(t *testing.T) {
+		_, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Vertices: []*models.GeoCoordinates{geo(48, 11), geo(48.5, 11.5)},
+		})
+		assert.Equal(t, "invalid arguments: geo polygon: requires at least 3 vertices, got 2",
+			err.Error())
+	})
+
+	t.Run("moving munich to stuttgart", func(t *testing.T) {
+		require.Nil(t, geoIndex.Add(0, &elements[1]))
+
+		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
+			GeoCoordinates: &elements[0],
+			Distance:       10 * km,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, results.ToArray())
+	})
+
+	t.Run("deleting stuttgart", func(t *testing.T) {
+		require.Nil(t, geoIndex.Delete(1))
+		require.Nil(t, geoIndex.Delete(1))
+
+		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
+			GeoCoordinates: &elements[1],
+			Distance:       10 * km,
+		})
+		require.Nil(t, err)
+
+		expectedResults := []uint64{0}
+		assert.Equal(t, expectedResults, results.ToArray())
+	})
+}
+
+func TestGeoAntimeridian(t *testing.T) {
+	geoIndex := testIndex(t)
+
+	require.Nil(t, geoIndex.Add(0, geo(-17, 179.9)))
+	require.Nil(t, geoIndex.Add(1, geo(-17, -179.9)))
+	require.Nil(t, geoIndex.Add(2, geo(-17, 0)))
+
+	t.Run("range", func(t *testing.T) {
+		results, err := geoIndex.WithinRange(context.Background(), filters.GeoRange{
+			GeoCoordinates: geo(-17, 180),
+			Distance:       50000,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, results.ToArray())
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     geo(-16, 179),
+			BottomRight: geo(-18, -179),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, results.ToArray())
+	})
+}
+
+// TestGeoCompareWithBruteForce makes sure the cell cover does not miss any
+// points, by comparing the index results with a check of every point
+func TestGeoCompareWithBruteForce(t *testing.T) {
+	geoIndex := testIndex(t)
+	r := rand.New(rand.NewSource(7))
+
+	points := make([]*models.GeoCoordinates, 5000)
+	for i := range points {
+		// cluster the points around europe to get dense cells
+		points[i] = geo(float32(35+r.Float64()*30), float32(-10+r.Float64()*40))
+		require.Nil(t, geoIndex.Add(uint64(i), points[i]))
+	}
+
+	type searchCase struct {
+		name   string
+		search func() ([]uint64, error)
+		region region
+	}
+	var regions []searchCase
+
+	for i := 0; i < 20; i++ {
+		center := geo(float32(35+r.Float64()*30), float32(-10+r.Float64()*40))
+		geoRange := filters.GeoRange{
+			GeoCoordinates: center,
+			Distance:       float32(1000 + r.Float64()*1000e3),
+		}
+		regions = append(regions, searchCase{
+			name: "range",
+			search: func() ([]uint64, error) {
+				res, err := geoIndex.WithinRange(context.Background(), geoRange)
+				return res.ToArray(), err
+			},
+			region: newCircle(float64(*center.Latitude), float64(*center.Longitude), geoRange.Distance),
+		})
+
+		lat, lon := float32(35+r.Float64()*30), float32(-10+r.Float64()*40)
+		box := filters.GeoBoundingBox{
+			TopLeft:     geo(lat+float32(r.Float64()*10), lon),
+			BottomRight: geo(lat, lon+float32(r.Float64()*10)),
+		}
+		regions = append(regions, searchCase{
+			name: "bounding box",
+			search: func() ([]uint64, error) {
+				res, err := geoIndex.WithinBoundingBox(context.Background(), box)
+				return res.ToArray(), err
+			},
+			region: newBoundingBox(box),
+		})
+
+		polygon := filters.GeoPolygon{Vertices: []*models.GeoCoordinates{
+			geo(lat, lon), geo(lat+5, lon+float32(r.Float64()*5)),
+			geo(lat+float32(r.Float64()*10), lon+10), geo(lat-float32(r.Float64()*5), lon+5),
+		}}
+		regions = append(regions, searchCase{
+			name: "polygon",
+			search: func() ([]uint64, error) {
+				res, err := geoIndex.WithinPolygon(context.Background(), polygon)
+				return res.ToArray(), err
+			},
+			region: newPolygon(polygon),
+		})
+	}
+
+	for _, test := range regions {
+		results, err := test.search()
+		require.Nil(t, err)
+
+		var expected []uint64
+		for i, p := range points {
+			if test.region.contains(float64(*p.Latitude), float64(*p.Longitude)) {
+				expected = append(expected, uint64(i))
+			}
+		}
+
+		if len(expected) == 0 {
+			assert.Empty(t, results, test.name)
+		} else {
+			assert.Equal(t, expected, results, test.name)
+		}
+	}
+}
+
+func TestCells(t *testing.T) {
+	t.Run("interleaving is reversible", func(t *testing.T) {
+		row, column := deinterleave(interleave(12345, 67890, 20), 20)
+		assert.Equal(t, uint32(12345), row)
+		assert.Equal(t, uint32(67890), column)
+	})
+
+	t.Run("leaf cell contains its point", func(t *testing.T) {
+		for _, p := range [][2]float64{{48.13743, 11.57549}, {-90, -180}, {90, 180}, {0, 0}} {
+			assert.True(t, leafCellRect(leafCell(p[0], p[1])).containsPoint(p[0], p[1]))
+		}
+	})
+
+	t.Run("leaf cells of a cell are contiguous", func(t *testing.T) {
+		level := 10
+		row, column := rowOf(48.13743, level), columnOf(11.57549, level)
+		id := interleave(row, column, level)
+		shift := 2 * (maxLevel - level)
+
+		leaf := leafCell(48.13743, 11.57549)
+		assert.GreaterOrEqual(t, leaf, id<<shift)
+		assert.Less(t, leaf, (id+1)<<shift)
 	})
 }
 
+func testIndex(t *testing.T) *Index {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	cells, err := lsmkv.NewBucket(ctx, filepath.Join(dir, "cells"), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+	require.Nil(t, err)
+	t.Cleanup(func() { cells.Shutdown(ctx) })
+
+	coordinates, err := lsmkv.NewBucket(ctx, filepath.Join(dir, "coordinates"), "", logger, nil,
+		cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	require.Nil(t, err)
+	t.Cleanup(func() { coordinates.Shutdown(ctx) })
+
+	geoIndex, err := NewIndex(Config{Cells: cells, Coordinates: coordinates})
+	require.Nil(t, err)
+
+	return geoIndex
+}
+
+func geo(lat, lon float32) *models.GeoCoordinates {
+	return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// earthRadius in meters, matches the one used by the geo distancer
+const earthRadius = float64(6371e3)
+
+type relation int
+
+const (
+	disjoint relation = iota
+	intersects
+	within
+)
+
+// region is an area searched in the index
+type region interface {
+	// bounds returns rectangles enclosing the region. A region crossing the
+	// antimeridian is enclosed by two rectangles, one on either side.
+	bounds() []rect
+	// relate returns whether the rectangle lies entirely outside or inside
+	// of the region or intersects with its boundary
+	relate(r rect) relation
+	contains(lat, lon float64) bool
+}
+
+func distance(latA, lonA, latB, lonB float64) float64 {
+	latARadian := degreesToRadians(latA)
+	latBRadian := degreesToRadians(latB)
+	deltaLatRadian := degreesToRadians(latB - latA)
+	deltaLonRadian := degreesToRadians(lonB - lonA)
+
+	a := math.Sin(deltaLatRadian/2)*math.Sin(deltaLatRadian/2) +
+		math.Cos(latARadian)*math.Cos(latBRadian)*math.Sin(deltaLonRadian/2)*math.Sin(deltaLonRadian/2)
+
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+type circle struct {
+	lat, lon float64
+	radius   float64
+}
+
+func newCircle(lat, lon float64, radius float32) *circle {
+	return &circle{lat: lat, lon: lon, radius: float64(radius)}
+}
+
+func (c *circle) bounds() []rect {
+	angular := c.radius / earthRadius
+	if angular >= math.Pi {
+		return []rect{{minLat: -90, maxLat: 90, minLon: -180, maxLon: 180}}
+	}
+
+	deltaLat := radiansToDegrees(angular)
+	minLat, maxLat := c.lat-deltaLat, c.lat+deltaLat
+	if minLat <= -90 || maxLat >= 90 {
+		// the circle contains a pole, so it spans all longitudes
+		return []rect{{
+			minLat: math.Max(minLat, -90), maxLat: math.Min(maxLat, 90),
+			minLon: -180, maxLon: 180,
+		}}
+	}
+
+	sinDeltaLon := math.Sin(angular) / math.Cos(degreesToRadians(c.lat))
+	if sinDeltaLon >= 1 {
+		return []rect{{minLat: minLat, maxLat: maxLat, minLon: -180, maxLon: 180}}
+	}
+
+	deltaLon := radiansToDegrees(math.Asin(sinDeltaLon))
+	return splitAtAntimeridian(minLat, maxLat, c.lon-deltaLon, c.lon+deltaLon)
+}
+
+func (c *circle) relate(r rect) relation {
+	if r.maxLon-r.minLon >= 180 {
+		// too large to reason about by its corners
+		return intersects
+	}
+
+	// The distance to the center grows monotonically along the edges of
+	// the rectangle, as long as they do not cross the meridian opposite of
+	// the center. The corners are then the points furthest away.
+	antipode := c.lon + 180
+	if antipode > 180 {
+		antipode -= 360
+	}
+	if r.minLon > antipode || antipode > r.maxLon {
+		inside := true
+		for _, corner := range r.corners() {
+			if !c.contains(corner[0], corner[1]) {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			return within
+		}
+	}
+
+	centerLat, centerLon := (r.minLat+r.maxLat)/2, (r.minLon+r.maxLon)/2
+	var cellRadius float64
+	for _, corner := range r.corners() {
+		cellRadius = math.Max(cellRadius, distance(centerLat, centerLon, corner[0], corner[1]))
+	}
+	if distance(c.lat, c.lon, centerLat, centerLon)-cellRadius > c.radius {
+		return disjoint
+	}
+
+	return intersects
+}
+
+func (c *circle) contains(lat, lon float64) bool {
+	return distance(c.lat, c.lon, lat, lon) <= c.radius
+}
+
+type boundingBox struct {
+	rects []rect
+}
+
+func newBoundingBox(box filters.GeoBoundingBox) *boundingBox {
+	return &boundingBox{rects: splitAtAntimeridian(
+		float64(*box.BottomRight.Latitude), float64(*box.TopLeft.Latitude),
+		float64(*box.TopLeft.Longitude), float64(*box.BottomRight.Longitude),
+	)}
+}
+
+func (b *boundingBox) bounds() []rect {
+	return b.rects
+}
+
+func (b *boundingBox) relate(r rect) relation {
+	rel := disjoint
+	for _, bounds := range b.rects {
+		if bounds.containsRect(r) {
+			return within
+		}
+		if bounds.intersects(r) {
+			rel = intersects
+		}
+	}
+	return rel
+}
+
+func (b *boundingBox) contains(lat, lon float64) bool {
+	for _, bounds := range b.rects {
+		if bounds.containsPoint(lat, lon) {
+			return true
+		}
+	}
+	return false
+}
+
+// polygon is evaluated in the plane of latitude and longitude, i.e. its
+// edges follow the grid rather than great circles. Polygons crossing the
+// antimeridian are not supported.
+type polygon struct {
+	lats, lons []float64
+	rect       rect
+}
+
+func newPolygon(in filters.GeoPolygon) *polygon {
+	p := &polygon{
+		lats: make([]float64, len(in.Vertices)),
+		lons: make([]float64, len(in.Vertices)),
+		rect: rect{minLat: 90, maxLat: -90, minLon: 180, maxLon: -180},
+	}
+
+	for i, vertex := range in.Vertices {
+		p.lats[i], p.lons[i] = float64(*vertex.Latitude), float64(*vertex.Longitude)
+		p.rect.minLat = math.Min(p.rect.minLat, p.lats[i])
+		p.rect.maxLat = math.Max(p.rect.maxLat, p.lats[i])
+		p.rect.minLon = math.Min(p.rect.minLon, p.lons[i])
+		p.rect.maxLon = math.Max(p.rect.maxLon, p.lons[i])
+	}
+
+	return p
+}
+
+func (p *polygon) bounds() []rect {
+	return []rect{p.rect}
+}
+
+func (p *polygon) relate(r rect) relation {
+	if !p.rect.intersects(r) {
+		return disjoint
+	}
+
+	for i, j := 0, len(p.lats)-1; i < len(p.lats); j, i = i, i+1 {
+		if segmentIntersectsRect(p.lats[j], p.lons[j], p.lats[i], p.lons[i], r) {
+			return intersects
+		}
+	}
+
+	// no edge passes through the rectangle, so it is either entirely inside
+	// or entirely outside
+	if p.contains((r.minLat+r.maxLat)/2, (r.minLon+r.maxLon)/2) {
+		return within
+	}
+	return disjoint
+}
+
+// contains uses the even-odd rule, casting a ray along the latitude
+func (p *polygon) contains(lat, lon float64) bool {
+	inside := false
+	for i, j := 0, len(p.lats)-1; i < len(p.lats); j, i = i, i+1 {
+		if (p.lats[i] > lat) != (p.lats[j] > lat) &&
+			lon < (p.lons[j]-p.lons[i])*(lat-p.lats[i])/(p.lats[j]-p.lats[i])+p.lons[i] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func splitAtAntimeridian(minLat, maxLat, minLon, maxLon float64) []rect {
+	switch {
+	case minLon > maxLon:
+		return []rect{
+			{minLat: minLat, maxLat: maxLat, minLon: minLon, maxLon: 180},
+			{minLat: minLat, maxLat: maxLat, minLon: -180, maxLon: maxLon},
+		}
+	case minLon < -180:
+		return []rect{
+			{minLat: minLat, maxLat: maxLat, minLon: minLon + 360, maxLon: 180},
+			{minLat: minLat, maxLat: maxLat, minLon: -180, maxLon: maxLon},
+		}
+	case maxLon > 180:
+		return []rect{
+			{minLat: minLat, maxLat: maxLat, minLon: minLon, maxLon: 180},
+			{minLat: minLat, maxLat: maxLat, minLon: -180, maxLon: maxLon - 360},
+		}
+	default:
+		return []rect{{minLat: minLat, maxLat: maxLat, minLon: minLon, maxLon: maxLon}}
+	}
+}
+
+func segmentIntersectsRect(latA, lonA, latB, lonB float64, r rect) bool {
+	if r.containsPoint(latA, lonA) || r.containsPoint(latB, lonB) {
+		return true
+	}
+
+	edges := [4][4]float64{
+		{r.minLat, r.minLon, r.minLat, r.maxLon},
+		{r.maxLat, r.minLon, r.maxLat, r.maxLon},
+		{r.minLat, r.minLon, r.maxLat, r.minLon},
+		{r.minLat, r.maxLon, r.maxLat, r.maxLon},
+	}
+	for _, edge := range edges {
+		if segmentsIntersect(latA, lonA, latB, lonB, edge[0], edge[1], edge[2], edge[3]) {
+			return true
+		}
+	}
+	return false
+}
+
+func segmentsIntersect(ax, ay, bx, by, cx, cy, dx, dy float64) bool {
+	orientation := func(px, py, qx, qy, rx, ry float64) float64 {
+		return (qx-px)*(ry-py) - (qy-py)*(rx-px)
+	}
+
+	d1 := orientation(cx, cy, dx, dy, ax, ay)
+	d2 := orientation(cx, cy, dx, dy, bx, by)
+	d3 := orientation(ax, ay, bx, by, cx, cy)
+	d4 := orientation(ax, ay, bx, by, dx, dy)
+
+	return ((d1 > 0) != (d2 > 0) || d1 == 0 || d2 == 0) &&
+		((d3 > 0) != (d4 > 0) || d3 == 0 || d4 == 0)
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// weight of the most recent sample in the smoothed recall and latency
+	autoTuneSmoothing = 0.2
+	// number of samples before the ef is adjusted for the first time
+	autoTuneWarmup = 5
+	// relative steps by which the ef is raised or lowered
+	autoTuneStepUp   = 1.25
+	autoTuneStepDown = 0.9
+	// the reference search of a sample uses this multiple of the ef
+	autoTuneReferenceFactor = 2
+)
+
+// efTuner adjusts the search time ef based on a sample of the queries. A
+// sampled query is repeated in the background with a higher ef, the overlap
+// of both results is a proxy for the recall of the current ef. The ef is
+// raised while the recall proxy is below the target and lowered while the
+// latency exceeds its target, or if the recall has a lot of headroom.
+type efTuner struct {
+	// read on every search, so it is not guarded by the lock
+	ef       atomic.Int64
+	sampling atomic.Bool // at most one sample is in flight
+
+	sync.Mutex
+	cfg      ent.AutoTuneConfig
+	min, max int
+	recall   float64
+	latency  time.Duration
+	samples  int
+
+	randFunc func() float64
+}
+
+func newEFTuner(uc ent.UserConfig) *efTuner {
+	t := &efTuner{randFunc: rand.Float64}
+	t.configure(uc)
+
+	start := uc.EF
+	if start < 1 {
+		start = uc.DynamicEFMin
+	}
+	t.ef.Store(int64(t.clamp(start)))
+	return t
+}
+
+// configure applies an updated config, the tuned ef and the collected
+// samples are kept
+func (t *efTuner) configure(uc ent.UserConfig) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.cfg = uc.AutoTune
+	t.min = uc.DynamicEFMin
+	t.max = uc.DynamicEFMax
+	t.ef.Store(int64(t.clamp(int(t.ef.Load()))))
+}
+
+func (t *efTuner) searchEF(k int) int {
+	ef := int(t.ef.Load())
+	if ef < k {
+		ef = k // otherwise results will get cut off early
+	}
+	return ef
+}
+
+// sample decides whether the current query should be sampled. If it
+// returns true, observe or skip must be called once the sample is done.
+func (t *efTuner) sample() bool {
+	t.Lock()
+	rate := t.cfg.SampleRate
+	t.Unlock()
+
+	if t.randFunc() >= rate {
+		return false
+	}
+	return t.sampling.CompareAndSwap(false, true)
+}
+
+func (t *efTuner) skip() {
+	t.sampling.Store(false)
+}
+
+// observe records the recall proxy and latency of a sample and adjusts the
+// ef. It returns the new ef and the smoothed recall proxy.
+func (t *efTuner) observe(recall float64, latency time.Duration) (int, float64) {
+	defer t.sampling.Store(false)
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.samples == 0 {
+		t.recall, t.latency = recall, latency
+	} else {
+		t.recall += autoTuneSmoothing * (recall - t.recall)
+		t.latency += time.Duration(autoTuneSmoothing * float64(latency-t.latency))
+	}
+	t.samples++
+
+	ef := int(t.ef.Load())
+	if t.samples < autoTuneWarmup {
+		return ef, t.recall
+	}
+
+	target := t.cfg.TargetRecall
+	latencyTarget := time.Duration(t.cfg.TargetLatencyMs) * time.Millisecond
+	switch {
+	case latencyTarget > 0 && t.latency > latencyTarget:
+		// the latency target is a hard limit, recall is traded in for it
+		ef = stepDown(ef)
+	case t.recall < target:
+		ef = stepUp(ef)
+	case t.recall > target && t.recall >= target+(1-target)/2:
+		// more recall than needed, save some work
+		ef = stepDown(ef)
+	}
+
+	ef = t.clamp(ef)
+	t.ef.Store(int64(ef))
+	return ef, t.recall
+}
+
+func (t *efTuner) clamp(ef int) int {
+	if ef > t.max {
+		ef = t.max
+	}
+	if ef < t.min {
+		ef = t.min
+	}
+	return ef
+}
+
+func stepUp(ef int) int {
+	next := int(math.Ceil(float64(ef) * autoTuneStepUp))
+	if next <= ef {
+		next = ef + 1
+	}
+	return next
+}
+
+func stepDown(ef int) int {
+	next := int(math.Floor(float64(ef) * autoTuneStepDown))
+	if next >= ef {
+		next = ef - 1
+	}
+	return next
+}
+
+// recallProxy is the share of the reference results which are also
+// contained in the results of the sampled search
+func recallProxy(ids, reference []uint64, k int) float64 {
+	if len(reference) > k {
+		reference = reference[:k]
+	}
+	if len(reference) == 0 {
+		return 1
+	}
+
+	found := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		found[id] = struct{}{}
+	}
+
+	hits := 0
+	for _, id := range reference {
+		if _, ok := found[id]; ok {
+			hits++
+		}
+	}
+
+	return float64(hits) / float64(len(reference))
+}
+
+// updateEFTuner starts, reconfigures or stops the ef auto tuning
+func (h *hnsw) updateEFTuner(uc ent.UserConfig) {
+	if !uc.AutoTune.Enabled {
+		h.efTuner.Store(nil)
+		return
+	}
+
+	if tuner := h.efTuner.Load(); tuner != nil {
+		tuner.configure(uc)
+		return
+	}
+	h.efTuner.Store(newEFTuner(uc))
+}
+
+// knnSearchAndSample runs the search and, if the query is sampled, repeats
+// it with a higher ef to feed the tuner. The reference search runs inline,
+// so it can't outlive the index, which only costs latency on the small
+// fraction of sampled queries.
+func (h *hnsw) knnSearchAndSample(ctx context.Context, tuner *efTuner, vector []float32, k, ef int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if !tuner.sample() {
+		return h.knnSearchByVector(ctx, vector, k, ef, allowList)
+	}
+
+	before := time.Now()
+	ids, dists, err := h.knnSearchByVector(ctx, vector, k, ef, allowList)
+	took := time.Since(before)
+	if err != nil {
+		tuner.skip()
+		return ids, dists, err
+	}
+
+	reference, _, err := h.knnSearchByVector(ctx, vector, k,
+		ef*autoTuneReferenceFactor, allowList)
+	if err != nil {
+		// the sampled search itself succeeded, so the query doesn't fail
+		tuner.skip()
+		h.logger.WithField("action", "hnsw_ef_auto_tuning").WithError(err).
+			Warn("reference search for ef auto tuning failed")
+		return ids, dists, nil
+	}
+
+	prev := int(tuner.ef.Load())
+	next, recall := tuner.observe(recallProxy(ids, reference, k), took)
+	h.metrics.TunedEF(next, recall)
+	if next != prev {
+		h.logger.WithField("action", "hnsw_ef_auto_tuning").
+			WithField("ef", next).
+			WithField("previous_ef", prev).
+			WithField("recall_proxy", recall).
+			Debug("adjusted search time ef")
+	}
+
+	return ids, dists, nil
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func autoTuneConfig(targetRecall float64, targetLatencyMs int) ent.UserConfig {
+	return ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		VectorCacheMaxObjects: 100000,
+		EF:                    -1,
+		DynamicEFMin:          10,
+		DynamicEFMax:          100,
+		DynamicEFFactor:       8,
+		AutoTune: ent.AutoTuneConfig{
+			Enabled:         true,
+			TargetRecall:    targetRecall,
+			TargetLatencyMs: targetLatencyMs,
+			SampleRate:      1,
+		},
+	}
+}
+
+func Test_EFTuner(t *testing.T) {
+	observe := func(tuner *efTuner, n int, recall float64, latency time.Duration) int {
+		var ef int
+		for i := 0; i < n; i++ {
+			require.True(t, tuner.sample())
+			ef, _ = tuner.observe(recall, latency)
+		}
+		return ef
+	}
+
+	t.Run("starts at the min without an explicit ef", func(t *testing.T) {
+		tuner := newEFTuner(autoTuneConfig(0.9, 0))
+		assert.Equal(t, 10, tuner.searchEF(5))
+		assert.Equal(t, 20, tuner.searchEF(20), "ef is never lower than the limit")
+	})
+
+	t.Run("no adjustment during warmup", func(t *testing.T) {
+		tuner := newEFTuner(autoTuneConfig(0.9, 0))
+		assert.Equal(t, 10, observe(tuner, autoTuneWarmup-1, 0.5, time.Millisecond))
+	})
+
+	t.Run("raises ef while recall is below target", func(t *testing.T) {
+		tuner := newEFTuner(autoTuneConfig(0.9, 0))
+		ef := observe(tuner, autoTuneWarmup, 0.5, time.Millisecond)
+		assert.Equal(t, 13, ef)
+
+		ef = observe(tuner, 50, 0.5, time.Millisecond)
+		assert.Equal(t, 100, ef, "capped at dynamicEfMax")
+	})
+
+	t.Run("lowers ef while the latency exceeds its target", func(t *testing.T) {
+		cfg := autoTuneConfig(0.9, 5)
+		cfg.EF = 80
+		tuner := newEFTuner(cfg)
+		ef := observe(tuner, autoTuneWarmup, 0.5, 10*time.Millisecond)
+		assert.Equal(t, 72, ef, "latency wins over recall")
+
+		ef = observe(tuner, 50, 0.5, 10*time.Millisecond)
+		assert.Equal(t, 10, ef, "capped at dynamicEfMin")
+	})
+
+	t.Run("lowers ef if recall has a lot of headroom", func(t *testing.T) {
+		cfg := autoTuneConfig(0.9, 0)
+		cfg.EF = 80
+		tuner := newEFTuner(cfg)
+		assert.Equal(t, 72, observe(tuner, autoTuneWarmup, 0.99, time.Millisecond))
+	})
+
+	t.Run("keeps ef if recall is slightly above target", func(t *testing.T) {
+		cfg := autoTuneConfig(0.9, 0)
+		cfg.EF = 80
+		tuner := newEFTuner(cfg)
+		assert.Equal(t, 80, observe(tuner, autoTuneWarmup, 0.92, time.Millisecond))
+	})
+
+	t.Run("only one sample at a time", func(t *testing.T) {
+		tuner := newEFTuner(autoTuneConfig(0.9, 0))
+		require.True(t, tuner.sample())
+		assert.False(t, tuner.sample())
+		tuner.skip()
+		assert.True(t, tuner.sample())
+	})
+
+	t.Run("reconfiguring keeps the tuned ef within the new bounds", func(t *testing.T) {
+		tuner := newEFTuner(autoTuneConfig(0.9, 0))
+		observe(tuner, 50, 0.5, time.Millisecond)
+		require.Equal(t, 100, tuner.searchEF(1))
+
+		cfg := autoTuneConfig(0.9, 0)
+		cfg.DynamicEFMax = 60
+		tuner.configure(cfg)
+		assert.Equal(t, 60, tuner.searchEF(1))
+	})
+}
+
+func Test_RecallProxy(t *testing.T) {
+	assert.Equal(t, 1.0, recallProxy([]uint64{1, 2, 3}, []uint64{3, 2, 1, 4}, 3))
+	assert.Equal(t, 2.0/3, recallProxy([]uint64{1, 2, 5}, []uint64{1, 2, 3}, 3))
+	assert.Equal(t, 1.0, recallProxy(nil, nil, 3))
+}
+
+func Test_EFAutoTuningSearch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(500, 20, 16)
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "ef-auto-tuning-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, autoTuneConfig(0.99, 0), cyclemanager.NewNoop())
+	require.Nil(t, err)
+	defer index.Drop(context.Background())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	tuner := index.efTuner.Load()
+	require.NotNil(t, tuner)
+	for _, query := range queries {
+		res, _, err := index.SearchByVector(context.Background(), query, 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 10)
+	}
+
+	tuner.Lock()
+	samples := tuner.samples
+	tuner.Unlock()
+	assert.Equal(t, len(queries), samples)
+	ef := tuner.searchEF(1)
+	assert.True(t, ef >= 10 && ef <= 100, "ef %d within bounds", ef)
+
+	t.Run("disabling auto tuning falls back to the dynamic ef", func(t *testing.T) {
+		cfg := autoTuneConfig(0.99, 0)
+		cfg.AutoTune.Enabled = false
+		require.Nil(t, index.UpdateUserConfig(cfg, func() {}))
+		assert.Nil(t, index.efTuner.Load())
+		assert.Equal(t, 80, index.searchTimeEF(10))
+	})
+}
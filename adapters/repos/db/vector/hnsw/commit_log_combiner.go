@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type CommitLogCombiner struct {
@@ -25,16 +26,21 @@ type CommitLogCombiner struct {
 	id        string
 	threshold int64
 	logger    logrus.FieldLogger
+
+	// encrypted logs are decrypted and encrypted again when merged, as every
+	// file has its own header
+	encryption *diskio.Encryption
 }
 
 func NewCommitLogCombiner(rootPath, id string, threshold int64,
-	logger logrus.FieldLogger,
+	logger logrus.FieldLogger, enc *diskio.Encryption,
 ) *CommitLogCombiner {
 	return &CommitLogCombiner{
-		rootPath:  rootPath,
-		id:        id,
-		threshold: threshold,
-		logger:    logger,
+		rootPath:   rootPath,
+		id:         id,
+		threshold:  threshold,
+		logger:     logger,
+		encryption: enc,
 	}
 }
 
@@ -143,18 +149,18 @@ func (c *CommitLogCombiner) combine(first, second string) error {
 }
 
 func (c *CommitLogCombiner) mergeFiles(outName, first, second string) error {
-	out, err := os.Create(outName)
+	out, err := c.encryption.Create(outName)
 	if err != nil {
 		return errors.Wrapf(err, "open target file %q", outName)
 	}
 
-	source1, err := os.Open(first)
+	source1, err := c.encryption.Open(first)
 	if err != nil {
 		return errors.Wrapf(err, "open first source file %q", first)
 	}
 	defer source1.Close()
 
-	source2, err := os.Open(second)
+	source2, err := c.encryption.Open(second)
 	if err != nil {
 		return errors.Wrapf(err, "open second source file %q", second)
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
 
@@ -44,10 +45,9 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 	maintenanceCycle cyclemanager.CycleManager, opts ...CommitlogOption,
 ) (*hnswCommitLogger, error) {
 	l := &hnswCommitLogger{
-		rootPath:  rootPath,
-		id:        name,
-		condensor: NewMemoryCondensor(logger),
-		logger:    logger,
+		rootPath: rootPath,
+		id:       name,
+		logger:   logger,
 
 		// both can be overwritten using functional options
 		maxSizeIndividual: defaultCommitLogSize / 5,
@@ -60,7 +60,9 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		}
 	}
 
-	fd, err := getLatestCommitFileOrCreate(rootPath, name)
+	l.condensor = NewMemoryCondensor(logger, l.encryption)
+
+	fd, err := getLatestCommitFileOrCreate(rootPath, name, l.encryption)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +74,9 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 	return l, nil
 }
 
-func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
+func getLatestCommitFileOrCreate(rootPath, name string,
+	enc *diskio.Encryption,
+) (*diskio.File, error) {
 	dir := commitLogDirectory(rootPath, name)
 	err := os.MkdirAll(dir, os.ModePerm)
 	if err != nil {
@@ -89,7 +93,7 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 		fileName = fmt.Sprintf("%d", time.Now().Unix())
 	}
 
-	fd, err := os.OpenFile(commitLogFileName(rootPath, name, fileName),
+	fd, err := enc.OpenFile(commitLogFileName(rootPath, name, fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return nil, errors.Wrap(err, "create commit log file")
@@ -250,6 +254,9 @@ type hnswCommitLogger struct {
 	maxSizeCombining  int64
 	commitLogger      *commitlog.Logger
 
+	// nil unless the commit logs are encrypted
+	encryption *diskio.Encryption
+
 	unregisterSwitchLogs   cyclemanager.UnregisterFunc
 	unregisterCondenseLogs cyclemanager.UnregisterFunc
 }
@@ -475,7 +482,7 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 			Info("commit log size crossed threshold, switching to new file")
 	}
 
-	fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
+	fd, err := l.encryption.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return true, errors.Wrap(err, "create commit log file")
@@ -520,7 +527,8 @@ func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	// assumption that the combined file will be considerably smaller than the
 	// sum of both input files
 	threshold := int64(float64(l.maxSizeCombining) * 1.75)
-	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger).Do()
+	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger,
+		l.encryption).Do()
 }
 
 func (l *hnswCommitLogger) Drop(ctx context.Context) error {
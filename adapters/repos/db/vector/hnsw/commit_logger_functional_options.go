@@ -11,6 +11,8 @@
 
 package hnsw
 
+import "github.com/weaviate/weaviate/entities/diskio"
+
 type CommitlogOption func(l *hnswCommitLogger) error
 
 func WithCommitlogThreshold(size int64) CommitlogOption {
@@ -26,3 +28,12 @@ func WithCommitlogThresholdForCombining(size int64) CommitlogOption {
 		return nil
 	}
 }
+
+// WithCommitlogEncryption encrypts the commit logs, the index must be
+// configured with the same encryption to read them
+func WithCommitlogEncryption(enc *diskio.Encryption) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.encryption = enc
+		return nil
+	}
+}
@@ -13,14 +13,15 @@ package commitlog
 
 import (
 	"encoding/binary"
-	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type Logger struct {
-	file *os.File
+	file *diskio.File
 	bufw *bufWriter
 }
 
@@ -44,7 +45,7 @@ const (
 )
 
 func NewLogger(fileName string) *Logger {
-	file, err := os.Create(fileName)
+	file, err := (*diskio.Encryption)(nil).Create(fileName)
 	if err != nil {
 		panic(err)
 	}
@@ -52,7 +53,7 @@ func NewLogger(fileName string) *Logger {
 	return &Logger{file: file, bufw: NewWriter(file)}
 }
 
-func NewLoggerWithFile(file *os.File) *Logger {
+func NewLoggerWithFile(file *diskio.File) *Logger {
 	return &Logger{file: file, bufw: NewWriterSize(file, 32*1024)}
 }
 
@@ -216,21 +217,16 @@ func (l *Logger) Reset() error {
 }
 
 func (l *Logger) FileSize() (int64, error) {
-	i, err := l.file.Stat()
+	size, err := l.file.Size()
 	if err != nil {
 		return -1, err
 	}
 
-	return i.Size(), nil
+	return size, nil
 }
 
 func (l *Logger) FileName() (string, error) {
-	i, err := l.file.Stat()
-	if err != nil {
-		return "", err
-	}
-
-	return i.Name(), nil
+	return filepath.Base(l.file.Name()), nil
 }
 
 func (l *Logger) Flush() error {
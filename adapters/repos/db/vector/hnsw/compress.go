@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -29,6 +30,7 @@ func (h *hnsw) initCompressedStore() error {
 	if err != nil {
 		return errors.Wrap(err, "Init lsmkv (compressed vectors store)")
 	}
+	store.SetEncryption(h.encryption)
 	err = store.CreateOrLoadBucket(context.Background(), helpers.CompressedObjectsBucketLSM)
 	if err != nil {
 		return errors.Wrapf(err, "Create or load bucket (compressed vectors store)")
@@ -37,6 +39,15 @@ func (h *hnsw) initCompressedStore() error {
 	return nil
 }
 
+// newCompressedVectorsCache creates the cache of the pq codes, codes which
+// are not cached are read from the compressed store
+func (h *hnsw) newCompressedVectorsCache(maxSize int) *compressedShardedLockCache {
+	c := newCompressedShardedLockCache(maxSize, h.logger)
+	c.metrics = h.metrics
+	c.vectorForID = h.compressedVectorFromStore
+	return c
+}
+
 func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	if h.nodes[0] == nil {
 		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
@@ -100,3 +111,21 @@ func (h *hnsw) storeCompressedVector(index uint64, vector []byte) {
 	binary.LittleEndian.PutUint64(Id, index)
 	h.compressedStore.Bucket(helpers.CompressedObjectsBucketLSM).Put(Id, vector)
 }
+
+func (h *hnsw) compressedVectorFromStore(ctx context.Context, id uint64) ([]byte, error) {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, id)
+	vec, err := h.compressedStore.Bucket(helpers.CompressedObjectsBucketLSM).Get(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get compressed vector of docID %d", id)
+	}
+	if vec == nil {
+		return nil, storobj.NewErrNotFoundf(id, "no compressed vector for docID %d", id)
+	}
+
+	// the value may be a view on a segment which is removed on compaction,
+	// but the cache keeps it around
+	out := make([]byte, len(vec))
+	copy(out, vec)
+	return out, nil
+}
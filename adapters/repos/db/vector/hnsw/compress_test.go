@@ -115,7 +115,7 @@ func TestRecall(t *testing.T) {
 		for i := 0; i < len(queries); i++ {
 			truth := testinghelpers.BruteForce(vectors, queries[i], k, distanceWrapper(distancer))
 			before = time.Now()
-			results, _, _ := index.SearchByVector(queries[i], k, nil)
+			results, _, _ := index.SearchByVector(context.Background(), queries[i], k, nil)
 			querying += time.Since(before)
 			retrieved += k
 			relevant += testinghelpers.MatchesInLists(truth, results)
@@ -219,7 +219,7 @@ func TestHnswPqGist(t *testing.T) {
 			var querying time.Duration = 0
 			ssdhelpers.Concurrently(uint64(len(queries)), func(_, i uint64, _ *sync.Mutex) {
 				before = time.Now()
-				results, _, _ := index.SearchByVector(queries[i], k, nil)
+				results, _, _ := index.SearchByVector(context.Background(), queries[i], k, nil)
 				querying += time.Since(before)
 				retrieved += k
 				relevant += testinghelpers.MatchesInLists(truths[i], results)
@@ -397,7 +397,7 @@ func TestHnswPqSift(t *testing.T) {
 		var querying time.Duration = 0
 		ssdhelpers.Concurrently(uint64(len(queries)), func(_, i uint64, _ *sync.Mutex) {
 			before = time.Now()
-			results, _, _ := index.SearchByVector(queries[i], k, nil)
+			results, _, _ := index.SearchByVector(context.Background(), queries[i], k, nil)
 			querying += time.Since(before)
 			retrieved += k
 			relevant += testinghelpers.MatchesInLists(truths[i], results)
@@ -485,7 +485,7 @@ func TestHnswPqSiftDeletes(t *testing.T) {
 			var querying time.Duration = 0
 			ssdhelpers.Concurrently(uint64(len(queries)), func(_, i uint64, _ *sync.Mutex) {
 				before = time.Now()
-				results, _, _ := index.SearchByVector(queries[i], k, nil)
+				results, _, _ := index.SearchByVector(context.Background(), queries[i], k, nil)
 				querying += time.Since(before)
 				retrieved += k
 				relevant += testinghelpers.MatchesInLists(truths[i], results)
@@ -580,7 +580,7 @@ func TestHnswPqDeepImage(t *testing.T) {
 			var querying time.Duration = 0
 			ssdhelpers.Concurrently(uint64(len(queries)), func(_, i uint64, _ *sync.Mutex) {
 				before = time.Now()
-				results, _, _ := index.SearchByVector(queries[i], k, nil)
+				results, _, _ := index.SearchByVector(context.Background(), queries[i], k, nil)
 				querying += time.Since(before)
 				retrieved += k
 				relevant += testinghelpers.MatchesInLists(truths[i], results)
@@ -29,6 +29,11 @@ type compressedShardedLockCache struct {
 	count        int64
 	cancel       chan bool
 	logger       logrus.FieldLogger
+	metrics      *Metrics
+
+	// vectorForID reads the code of a vector which is not in the cache, e.g.
+	// because the cache was cleared to free memory
+	vectorForID func(ctx context.Context, id uint64) ([]byte, error)
 	//nolint:unused
 	dims int32
 	//nolint:unused
@@ -48,6 +53,7 @@ func newCompressedShardedLockCache(maxSize int, logger logrus.FieldLogger) *comp
 		logger:          logger,
 		shardedLocks:    make([]sync.RWMutex, shardFactor),
 		maintenanceLock: sync.Mutex{},
+		metrics:         &Metrics{},
 	}
 
 	for i := uint64(0); i < shardFactor; i++ {
@@ -64,6 +70,7 @@ func (c *compressedShardedLockCache) get(ctx context.Context, id uint64) ([]byte
 	c.shardedLocks[id%shardFactor].RUnlock()
 
 	if vec != nil {
+		c.metrics.VectorCacheHit()
 		return vec, nil
 	}
 
@@ -90,7 +97,22 @@ func (c *compressedShardedLockCache) delete(ctx context.Context, id uint64) {
 
 //nolint:unused
 func (c *compressedShardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]byte, error) {
-	return nil, errors.New("Not implemented")
+	if c.vectorForID == nil {
+		return nil, errors.New("Not implemented")
+	}
+
+	c.metrics.VectorCacheMiss()
+	vec, err := c.vectorForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	atomic.AddInt64(&c.count, 1)
+	c.shardedLocks[id%shardFactor].Lock()
+	c.cache[id] = vec
+	c.shardedLocks[id%shardFactor].Unlock()
+
+	return vec, nil
 }
 
 //nolint:unused
@@ -107,6 +129,8 @@ func (c *compressedShardedLockCache) multiGet(ctx context.Context, ids []uint64)
 			vecFromDisk, err := c.handleCacheMiss(ctx, id)
 			errs[i] = err
 			vec = vecFromDisk
+		} else {
+			c.metrics.VectorCacheHit()
 		}
 
 		out[i] = vec
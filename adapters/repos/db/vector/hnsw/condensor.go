@@ -21,17 +21,19 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
 
 type MemoryCondensor struct {
-	newLogFile *os.File
+	newLogFile *diskio.File
 	newLog     *bufWriter
 	logger     logrus.FieldLogger
+	encryption *diskio.Encryption
 }
 
 func (c *MemoryCondensor) Do(fileName string) error {
-	fd, err := os.Open(fileName)
+	fd, err := c.encryption.Open(fileName)
 	if err != nil {
 		return errors.Wrap(err, "open commit log to be condensed")
 	}
@@ -43,7 +45,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		return errors.Wrap(err, "read commit log to be condensed")
 	}
 
-	newLogFile, err := os.OpenFile(fmt.Sprintf("%s.condensed", fileName),
+	newLogFile, err := c.encryption.OpenFile(fmt.Sprintf("%s.condensed", fileName),
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return errors.Wrap(err, "open new commit log file for writing")
@@ -256,6 +258,8 @@ func (c *MemoryCondensor) AddPQ(data ssdhelpers.PQData) error {
 	return err
 }
 
-func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
-	return &MemoryCondensor{logger: logger}
+func NewMemoryCondensor(logger logrus.FieldLogger,
+	enc *diskio.Encryption,
+) *MemoryCondensor {
+	return &MemoryCondensor{logger: logger, encryption: enc}
 }
@@ -114,7 +114,7 @@ func TestCondensor(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed", input))
 		require.Nil(t, err)
 
 		control, ok, err := getCurrentCommitLogFileName(
@@ -191,14 +191,14 @@ func TestCondensorAppendNodeLinks(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed1", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed1", input))
 		require.Nil(t, err)
 
 		input, ok, err = getCurrentCommitLogFileName(commitLogDirectory(rootPath, "uncondensed2"))
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed2", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed2", input))
 		require.Nil(t, err)
 
 		control, ok, err := getCurrentCommitLogFileName(
@@ -290,14 +290,14 @@ func TestCondensorReplaceNodeLinks(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed1", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed1", input))
 		require.Nil(t, err)
 
 		input, ok, err = getCurrentCommitLogFileName(commitLogDirectory(rootPath, "uncondensed2"))
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed2", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed2", input))
 		require.Nil(t, err)
 
 		control, ok, err := getCurrentCommitLogFileName(
@@ -393,14 +393,14 @@ func TestCondensorClearLinksAtLevel(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed1", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed1", input))
 		require.Nil(t, err)
 
 		input, ok, err = getCurrentCommitLogFileName(commitLogDirectory(rootPath, "uncondensed2"))
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed2", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed2", input))
 		require.Nil(t, err)
 
 		control, ok, err := getCurrentCommitLogFileName(
@@ -452,7 +452,7 @@ func TestCondensorWithoutEntrypoint(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed", input))
 		require.Nil(t, err)
 
 		actual, ok, err := getCurrentCommitLogFileName(
@@ -531,7 +531,7 @@ func TestCondensorWithPQInformation(t *testing.T) {
 		require.Nil(t, err)
 		require.True(t, ok)
 
-		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed", input))
+		err = NewMemoryCondensor(logger, nil).Do(commitLogFileName(rootPath, "uncondensed", input))
 		require.Nil(t, err)
 
 		actual, ok, err := getCurrentCommitLogFileName(
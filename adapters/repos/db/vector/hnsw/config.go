@@ -14,6 +14,7 @@ package hnsw
 import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -32,6 +33,11 @@ type Config struct {
 	DistanceProvider      distancer.Provider
 	PrometheusMetrics     *monitoring.PrometheusMetrics
 
+	// Encryption encrypts the commit logs and the compressed vectors, it
+	// must match the one passed to the commit logger. nil if the index is not
+	// encrypted.
+	Encryption *diskio.Encryption
+
 	// metadata for monitoring
 	ShardName string
 	ClassName string
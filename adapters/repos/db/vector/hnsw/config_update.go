@@ -12,6 +12,8 @@
 package hnsw
 
 import (
+	"bytes"
+	"encoding/json"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
@@ -55,6 +57,32 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// dimensions can be enforced on an existing class, but once enforced,
+	// changing them would leave vectors of two lengths in the index
+	if initialParsed.Dimensions != 0 &&
+		initialParsed.Dimensions != updatedParsed.Dimensions {
+		return errors.Errorf("dimensions is immutable once set: attempted change from \"%d\" to \"%d\"",
+			initialParsed.Dimensions, updatedParsed.Dimensions)
+	}
+
+	// the vectors in the cache are encoded when they are read, switching the
+	// encoding would require to rebuild the cache
+	if initialParsed.VectorCacheCompression != updatedParsed.VectorCacheCompression {
+		return errors.Errorf("vectorCacheCompression is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.VectorCacheCompression, updatedParsed.VectorCacheCompression)
+	}
+
+	// the distance function of a custom metric is built once when the index
+	// is initialized, changed params would only apply to new shards. Params
+	// are compared in their serialized form, as numbers may be represented
+	// differently depending on whether they were read from disk or the API.
+	initialParams, _ := json.Marshal(initialParsed.DistanceParams)
+	updatedParams, _ := json.Marshal(updatedParsed.DistanceParams)
+	if !bytes.Equal(initialParams, updatedParams) {
+		return errors.Errorf("distanceParams is immutable: attempted change from %v to %v",
+			initialParsed.DistanceParams, updatedParsed.DistanceParams)
+	}
+
 	return nil
 }
 
@@ -89,22 +117,22 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMin, int64(parsed.DynamicEFMin))
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
+	h.efScale.Store(parsed.DynamicEFPolicy == ent.DynamicEFPolicyScale)
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.updateEFTuner(parsed)
+	h.cacheMaxObjects.Store(int64(parsed.VectorCacheMaxObjects))
 
 	if !parsed.PQ.Enabled {
+		h.resizeVectorCache()
 		callback()
 		return nil
 	}
 
 	// compression got enabled in this update
 	if h.compressedVectorsCache == (*compressedShardedLockCache)(nil) {
-		h.compressedVectorsCache = newCompressedShardedLockCache(parsed.VectorCacheMaxObjects, h.logger)
+		h.compressedVectorsCache = h.newCompressedVectorsCache(parsed.VectorCacheMaxObjects)
 	} else {
-		if h.compressed.Load() {
-			h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
-		} else {
-			h.cache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
-		}
+		h.resizeVectorCache()
 	}
 
 	// ToDo: check atomic operation
@@ -55,6 +55,48 @@ func TestUserConfigUpdates(t *testing.T) {
 					"cleanupIntervalSeconds is immutable: " +
 						"attempted change from \"60\" to \"90\""),
 			},
+			{
+				name: "attempting to change distance params",
+				initial: ent.UserConfig{
+					Distance:       "weighted-l2",
+					DistanceParams: map[string]interface{}{"weights": []interface{}{1.0}},
+				},
+				update: ent.UserConfig{
+					Distance:       "weighted-l2",
+					DistanceParams: map[string]interface{}{"weights": []interface{}{2.0}},
+				},
+				expectedError: errors.Errorf(
+					"distanceParams is immutable: " +
+						"attempted change from map[weights:[1]] to map[weights:[2]]"),
+			},
+			{
+				name:    "attempting to change enforced dimensions",
+				initial: ent.UserConfig{Dimensions: 384},
+				update:  ent.UserConfig{Dimensions: 768},
+				expectedError: errors.Errorf(
+					"dimensions is immutable once set: " +
+						"attempted change from \"384\" to \"768\""),
+			},
+			{
+				name:          "starting to enforce dimensions",
+				initial:       ent.UserConfig{},
+				update:        ent.UserConfig{Dimensions: 384},
+				expectedError: nil,
+			},
+			{
+				name:          "changing the dimension mismatch policy",
+				initial:       ent.UserConfig{DimensionMismatch: ent.DimensionMismatchReject},
+				update:        ent.UserConfig{DimensionMismatch: ent.DimensionMismatchTruncate},
+				expectedError: nil,
+			},
+			{
+				name:    "attempting to change the vector cache compression",
+				initial: ent.UserConfig{VectorCacheCompression: ent.VectorCacheCompressionNone},
+				update:  ent.UserConfig{VectorCacheCompression: ent.VectorCacheCompressionSQ},
+				expectedError: errors.Errorf(
+					"vectorCacheCompression is immutable: " +
+						"attempted change from \"none\" to \"sq\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
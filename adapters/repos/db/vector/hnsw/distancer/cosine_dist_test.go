@@ -100,3 +100,31 @@ func TestCosineDistancerStepbyStep(t *testing.T) {
 		assert.Equal(t, control, expectedDistance)
 	})
 }
+
+func TestNormalizeInto(t *testing.T) {
+	vec := []float32{3, 0, 4}
+	expected := []float32{0.6, 0, 0.8}
+
+	t.Run("into a separate slice", func(t *testing.T) {
+		dst := make([]float32, 0, 3)
+		out := NormalizeInto(dst, vec)
+		assert.Equal(t, expected, out)
+		assert.Same(t, &dst[:1][0], &out[0])
+		assert.Equal(t, []float32{3, 0, 4}, vec)
+	})
+
+	t.Run("in place", func(t *testing.T) {
+		inPlace := []float32{3, 0, 4}
+		assert.Equal(t, expected, NormalizeInto(inPlace, inPlace))
+		assert.Equal(t, expected, inPlace)
+	})
+
+	t.Run("dst too small", func(t *testing.T) {
+		assert.Equal(t, expected, NormalizeInto(nil, vec))
+	})
+
+	t.Run("zero vector", func(t *testing.T) {
+		dst := []float32{1, 1, 1}
+		assert.Equal(t, []float32{0, 0, 0}, NormalizeInto(dst, []float32{0, 0, 0}))
+	})
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Custom calculates distances using a distance function supplied by a
+// module
+type Custom struct {
+	a  []float32
+	fn func(a, b []float32) float32
+}
+
+func (c Custom) Distance(b []float32) (float32, bool, error) {
+	if len(c.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(c.a), len(b))
+	}
+
+	return c.fn(c.a, b), true, nil
+}
+
+type CustomProvider struct {
+	name string
+	fn   func(a, b []float32) float32
+}
+
+func NewCustomProvider(name string, fn func(a, b []float32) float32) CustomProvider {
+	return CustomProvider{name: name, fn: fn}
+}
+
+func (c CustomProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return c.fn(a, b), true, nil
+}
+
+func (c CustomProvider) Type() string {
+	return c.name
+}
+
+func (c CustomProvider) New(a []float32) Distancer {
+	return &Custom{a: a, fn: c.fn}
+}
+
+// Step is not supported, a custom distance can't be assumed to be the sum of
+// the distances of a vector's segments. PQ is therefore disabled for custom
+// distance metrics.
+func (c CustomProvider) Step(x, y []float32) float32 {
+	panic("Not implemented")
+}
+
+func (c CustomProvider) Wrap(x float32) float32 {
+	panic("Not implemented")
+}
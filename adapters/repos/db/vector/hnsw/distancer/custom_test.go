@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomDistancer(t *testing.T) {
+	weights := []float32{1, 0.5, 0}
+	weightedL2 := func(a, b []float32) float32 {
+		var sum float32
+		for i := range a {
+			diff := a[i] - b[i]
+			sum += weights[i] * diff * diff
+		}
+		return sum
+	}
+	provider := NewCustomProvider("weighted-l2", weightedL2)
+
+	t.Run("distance", func(t *testing.T) {
+		vec1 := []float32{3, 4, 5}
+		vec2 := []float32{1, 2, 0}
+		// 1*(3-1)^2 + 0.5*(4-2)^2 + 0*(5-0)^2 = 4 + 2 + 0 = 6
+		expectedDistance := float32(6)
+
+		dist, ok, err := provider.New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		control, ok, err := provider.SingleDist(vec1, vec2)
+		require.True(t, ok)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+		assert.Equal(t, "weighted-l2", provider.Type())
+	})
+
+	t.Run("vector lengths don't match", func(t *testing.T) {
+		_, _, err := provider.New([]float32{1, 2, 3}).Distance([]float32{1, 2})
+		assert.NotNil(t, err)
+		_, _, err = provider.SingleDist([]float32{1, 2, 3}, []float32{1, 2})
+		assert.NotNil(t, err)
+	})
+}
@@ -14,12 +14,24 @@ package distancer
 import "math"
 
 func Normalize(v []float32) []float32 {
+	return NormalizeInto(make([]float32, len(v)), v)
+}
+
+// NormalizeInto is like Normalize, but writes the result into dst, which is
+// grown if its capacity does not suffice. dst and v may be the same slice.
+func NormalizeInto(dst, v []float32) []float32 {
 	var norm float32
-	out := make([]float32, len(v))
+	if cap(dst) < len(v) {
+		dst = make([]float32, len(v))
+	}
+	out := dst[:len(v)]
 	for i := range v {
 		norm += v[i] * v[i]
 	}
 	if norm == 0 {
+		for i := range out {
+			out[i] = 0
+		}
 		return out
 	}
 
@@ -79,6 +79,83 @@ func Test_DynamicEF(t *testing.T) {
 			limit:      5,
 			expectedEf: 78,
 		},
+		{
+			name: "explicit ef, limit higher than ef",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    64,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+			},
+			limit:      200,
+			expectedEf: 200,
+		},
+		{
+			name: "scale policy, explicit ef acts as floor",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    64,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+				DynamicEFPolicy:       ent.DynamicEFPolicyScale,
+			},
+			limit:      5,
+			expectedEf: 64,
+		},
+		{
+			name: "scale policy, limit within the dynamic range",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    64,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+				DynamicEFPolicy:       ent.DynamicEFPolicyScale,
+			},
+			limit:      23,
+			expectedEf: 184,
+		},
+		{
+			name: "scale policy, capped at max",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    64,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+				DynamicEFPolicy:       ent.DynamicEFPolicyScale,
+			},
+			limit:      200,
+			expectedEf: 500,
+		},
+		{
+			name: "scale policy, explicit ef higher than max",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    800,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+				DynamicEFPolicy:       ent.DynamicEFPolicyScale,
+			},
+			limit:      200,
+			expectedEf: 800,
+		},
+		{
+			name: "scale policy, limit higher than max",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    64,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+				DynamicEFPolicy:       ent.DynamicEFPolicyScale,
+			},
+			limit:      1000,
+			expectedEf: 1000,
+		},
 	}
 
 	for _, test := range tests {
@@ -101,3 +178,32 @@ func Test_DynamicEF(t *testing.T) {
 		})
 	}
 }
+
+func Test_DynamicEF_PolicyUpdate(t *testing.T) {
+	cfg := ent.UserConfig{
+		VectorCacheMaxObjects: 10,
+		EF:                    64,
+		DynamicEFMin:          100,
+		DynamicEFMax:          500,
+		DynamicEFFactor:       8,
+		DynamicEFPolicy:       ent.DynamicEFPolicyAuto,
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "dynaimc-ef-policy-update-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return nil, errors.Errorf("not implemented")
+		},
+	}, cfg, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	defer index.Drop(context.Background())
+
+	assert.Equal(t, 64, index.searchTimeEF(50))
+
+	cfg.DynamicEFPolicy = ent.DynamicEFPolicyScale
+	require.Nil(t, index.UpdateUserConfig(cfg, func() {}))
+	assert.Equal(t, 400, index.searchTimeEF(50))
+}
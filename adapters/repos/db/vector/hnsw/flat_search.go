@@ -12,17 +12,35 @@
 package hnsw
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-func (h *hnsw) flatSearch(queryVector []float32, limit int,
+func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, limit int,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	results := priorityqueue.NewMax(limit)
 
+	// every candidate is visited only once, so uncompressed vectors are read
+	// into a pooled container rather than allocated and added to the cache,
+	// if the index was configured to support it
+	var container *VectorSlice
+	if !h.compressed.Load() && h.TempVectorForIDThunk != nil {
+		container = h.pools.tempVectors.Get(int(h.dims))
+		defer h.pools.tempVectors.Put(container)
+	}
+
 	it := allowList.Iterator()
 	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		h.RLock()
 		// Hot fix for https://github.com/weaviate/weaviate/issues/1937
 		// this if statement mitigates the problem but it doesn't resolve the issue
@@ -39,7 +57,14 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 		h.RUnlock()
-		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
+		var dist float32
+		var ok bool
+		var err error
+		if container != nil {
+			dist, ok, err = h.distBetweenTempNodeAndVec(candidate, queryVector, container)
+		} else {
+			dist, ok, err = h.distBetweenNodeAndVec(candidate, queryVector)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -72,3 +97,27 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 
 	return ids, dists, nil
 }
+
+func (h *hnsw) distBetweenTempNodeAndVec(node uint64, vecB []float32,
+	container *VectorSlice,
+) (float32, bool, error) {
+	vecA, err := h.tempVectorForID(context.Background(), node, container)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID)
+			return 0, false, nil
+		} else {
+			// not a typed error, we can recover from, return with err
+			return 0, false, errors.Wrapf(err,
+				"could not get vector of object at docID %d", node)
+		}
+	}
+
+	if len(vecA) == 0 {
+		return 0, false, fmt.Errorf(
+			"got a nil or zero-length vector at docID %d", node)
+	}
+
+	return h.distancerProvider.SingleDist(vecA, vecB)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -77,11 +78,19 @@ type hnsw struct {
 	// ef at search time
 	ef int64
 
-	// only used if ef=-1
+	// only used if ef=-1 or if efScale is set
 	efMin    int64
 	efMax    int64
 	efFactor int64
 
+	// derive ef from k even if ef is set explicitly, see
+	// ent.DynamicEFPolicyScale
+	efScale atomic.Bool
+
+	// nil unless ef auto tuning is enabled, in which case it replaces ef and
+	// the dynamic ef policy, see ent.AutoTuneConfig
+	efTuner atomic.Pointer[efTuner]
+
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
@@ -114,6 +123,9 @@ type hnsw struct {
 	id       string
 	rootPath string
 
+	// nil unless the commit logs and compressed vectors are encrypted
+	encryption *diskio.Encryption
+
 	logger            logrus.FieldLogger
 	distancerProvider distancer.Provider
 
@@ -156,6 +168,15 @@ type hnsw struct {
 	className              string
 	shardName              string
 	VectorForIDThunk       VectorForID
+
+	// cacheCompressed is set if the vector cache holds scalar quantized
+	// vectors, see ent.VectorCacheCompressionSQ
+	cacheCompressed bool
+	// cacheMaxObjects is the vectorCacheMaxObjects of the user config, the
+	// cache is only made smaller than that if the memory governor assigns it a
+	// budget in bytes, see SetVectorCacheBudget
+	cacheMaxObjects atomic.Int64
+	cacheBudget     atomic.Int64
 }
 
 type CommitLogger interface {
@@ -215,13 +236,17 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		normalizeOnRead = true
 	}
 
-	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	var vectorCache floatVectorCache
+	if uc.VectorCacheCompression == ent.VectorCacheCompressionSQ {
+		vectorCache = newScalarQuantizedCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	} else {
+		vectorCache = newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	}
 
+	// stays a typed nil until compression is turned on
 	var compressedVectorsCache *compressedShardedLockCache
-	if uc.PQ.Enabled {
-		compressedVectorsCache = newCompressedShardedLockCache(uc.VectorCacheMaxObjects, cfg.Logger)
-	}
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
 	index := &hnsw{
@@ -241,6 +266,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		compressedVectorsCache: compressedVectorsCache,
 		id:                     cfg.ID,
 		rootPath:               cfg.RootPath,
+		encryption:             cfg.Encryption,
 		tombstones:             map[uint64]struct{}{},
 		logger:                 cfg.Logger,
 		distancerProvider:      cfg.DistanceProvider,
@@ -266,6 +292,17 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		cacheCompressed:      uc.VectorCacheCompression == ent.VectorCacheCompressionSQ,
+	}
+	index.cacheMaxObjects.Store(int64(uc.VectorCacheMaxObjects))
+	vectorCache.setMetrics(index.metrics)
+	if uc.PQ.Enabled {
+		index.compressedVectorsCache = index.newCompressedVectorsCache(uc.VectorCacheMaxObjects)
+	}
+
+	index.efScale.Store(uc.DynamicEFPolicy == ent.DynamicEFPolicyScale)
+	if uc.AutoTune.Enabled {
+		index.efTuner.Store(newEFTuner(uc))
 	}
 
 	// TODO common_cycle_manager move to poststartup?
@@ -395,7 +432,7 @@ func (h *hnsw) findBestEntrypointForNode(currentMaxLevel, targetLevel int,
 		}
 
 		eps.Insert(entryPointID, dist)
-		res, err := h.searchLayerByVector(nodeVec, eps, 1, level, nil)
+		res, err := h.searchLayerByVector(context.Background(), nodeVec, eps, 1, level, nil)
 		if err != nil {
 			return 0,
 				errors.Wrapf(err, "update candidate: search layer at level %d", level)
@@ -29,6 +29,12 @@ type Metrics struct {
 	cleaned          prometheus.Counter
 	size             prometheus.Gauge
 	grow             prometheus.Observer
+	searchEF         prometheus.Observer
+	tunedEF          prometheus.Gauge
+	recallProxy      prometheus.Gauge
+	cacheHits        prometheus.Counter
+	cacheMisses      prometheus.Counter
+	rescored         prometheus.Counter
 	startupProgress  prometheus.Gauge
 	startupDurations prometheus.ObserverVec
 	startupDiskIO    prometheus.ObserverVec
@@ -96,6 +102,38 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"operation":  "grow",
 	})
 
+	searchEF := prom.VectorIndexSearchEF.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	tunedEF := prom.VectorIndexTunedEF.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	recallProxy := prom.VectorIndexRecallProxy.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	cacheHits := prom.VectorIndexCacheRequests.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+		"result":     "hit",
+	})
+
+	cacheMisses := prom.VectorIndexCacheRequests.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+		"result":     "miss",
+	})
+
+	rescored := prom.VectorIndexRescored.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	startupProgress := prom.StartupProgress.With(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -123,6 +161,12 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		deleteTime:       deleteTime,
 		size:             size,
 		grow:             grow,
+		searchEF:         searchEF,
+		tunedEF:          tunedEF,
+		recallProxy:      recallProxy,
+		cacheHits:        cacheHits,
+		cacheMisses:      cacheMisses,
+		rescored:         rescored,
 		startupProgress:  startupProgress,
 		startupDurations: startupDurations,
 		startupDiskIO:    startupDiskIO,
@@ -202,6 +246,56 @@ func (m *Metrics) GrowDuration(start time.Time) {
 	m.grow.Observe(took)
 }
 
+// SearchEF records the ef picked for a search, so that the effect of the
+// dynamic ef policy can be observed
+func (m *Metrics) SearchEF(ef int) {
+	if !m.enabled {
+		return
+	}
+
+	m.searchEF.Observe(float64(ef))
+}
+
+// TunedEF records the ef picked by the ef auto tuning together with the
+// recall proxy it was based on
+func (m *Metrics) TunedEF(ef int, recallProxy float64) {
+	if !m.enabled {
+		return
+	}
+
+	m.tunedEF.Set(float64(ef))
+	m.recallProxy.Set(recallProxy)
+}
+
+// VectorCacheHit counts a vector served from the vector cache
+func (m *Metrics) VectorCacheHit() {
+	if !m.enabled {
+		return
+	}
+
+	m.cacheHits.Inc()
+}
+
+// VectorCacheMiss counts a vector which had to be read from disk as it was
+// not in the vector cache
+func (m *Metrics) VectorCacheMiss() {
+	if !m.enabled {
+		return
+	}
+
+	m.cacheMisses.Inc()
+}
+
+// Rescored counts the search candidates whose approximate distance was
+// replaced by the distance to the uncompressed vector
+func (m *Metrics) Rescored(candidates int) {
+	if !m.enabled {
+		return
+	}
+
+	m.rescored.Add(float64(candidates))
+}
+
 type Observer func(start time.Time)
 
 func noOpObserver(start time.Time) {
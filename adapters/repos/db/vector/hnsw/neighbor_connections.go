@@ -77,7 +77,7 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 	eps := priorityqueue.NewMin(1)
 	eps.Insert(n.entryPointID, n.entryPointDist)
 
-	results, err := n.graph.searchLayerByVector(n.nodeVec, eps, n.graph.efConstruction,
+	results, err := n.graph.searchLayerByVector(context.Background(), n.nodeVec, eps, n.graph.efConstruction,
 		level, nil)
 	if err != nil {
 		return errors.Wrapf(err, "search layer at level %d", level)
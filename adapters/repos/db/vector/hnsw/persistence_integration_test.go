@@ -15,6 +15,8 @@
 package hnsw
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -27,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -68,7 +71,7 @@ func TestHnswPersistence(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -92,7 +95,7 @@ func TestHnswPersistence(t *testing.T) {
 	t.Run("verify that the results match after rebuiling from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -136,7 +139,7 @@ func TestHnswPersistence_CorruptWAL(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -197,7 +200,7 @@ func TestHnswPersistence_CorruptWAL(t *testing.T) {
 	t.Run("verify that the results match after rebuiling from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -247,7 +250,7 @@ func TestHnswPersistence_WithDeletion_WithoutTombstoneCleanup(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -274,7 +277,7 @@ func TestHnswPersistence_WithDeletion_WithoutTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuiling from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -332,7 +335,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 
 	t.Run("verify that the results match originally", func(t *testing.T) {
 		position := 3
-		res, _, err := index.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := index.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -357,7 +360,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuiling from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := secondIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, expectedResults, res)
 		})
@@ -400,7 +403,7 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 	t.Run("verify that the results match after rebuiling from disk",
 		func(t *testing.T) {
 			position := 3
-			res, _, err := thirdIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+			res, _, err := thirdIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
 			require.Nil(t, err)
 			assert.Equal(t, []uint64{3}, res)
 		})
@@ -447,7 +450,80 @@ func TestHnswPersistence_WithDeletion_WithTombstoneCleanup(t *testing.T) {
 			2, 1, 0, // cluster 1
 		}
 		position := 3
-		res, _, err := fourthIndex.knnSearchByVector(testVectors[position], 50, 36, nil)
+		res, _, err := fourthIndex.knnSearchByVector(context.Background(), testVectors[position], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+	})
+}
+
+func TestHnswPersistence_Encrypted(t *testing.T) {
+	dirName := t.TempDir()
+	indexID := "integrationtest_encrypted"
+	enc, err := diskio.NewEncryption(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	cl, clErr := NewCommitLogger(dirName, indexID, logger,
+		cyclemanager.NewNoop(), WithCommitlogEncryption(enc))
+	makeCL := func() (CommitLogger, error) {
+		return cl, clErr
+	}
+	newIndex := func() (*hnsw, error) {
+		return New(Config{
+			RootPath:              dirName,
+			ID:                    indexID,
+			MakeCommitLoggerThunk: makeCL,
+			DistanceProvider:      distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk:      testVectorForID,
+			Encryption:            enc,
+		}, ent.UserConfig{
+			MaxConnections: 30,
+			EFConstruction: 60,
+		}, cyclemanager.NewNoop())
+	}
+
+	index, err := newIndex()
+	require.Nil(t, err)
+	for i, vec := range testVectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	require.Nil(t, index.Flush())
+
+	expectedResults := []uint64{
+		3, 5, 4, // cluster 2
+		7, 8, 6, // cluster 3
+		2, 1, 0, // cluster 1
+	}
+
+	t.Run("condense the encrypted commit log", func(t *testing.T) {
+		// commit logs are named after the second they were created in
+		time.Sleep(time.Second)
+		require.Nil(t, cl.SwitchCommitLogs(true))
+		ok, err := cl.condenseOldLogs()
+		require.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("commit logs can only be read with the key", func(t *testing.T) {
+		other, err := diskio.NewEncryption(bytes.Repeat([]byte{2}, 32))
+		require.Nil(t, err)
+
+		files, err := getCommitFileNames(dirName, indexID)
+		require.Nil(t, err)
+		require.NotEmpty(t, files)
+		for _, file := range files {
+			_, err := enc.ReadFile(file)
+			assert.Nil(t, err)
+			_, err = other.ReadFile(file)
+			assert.ErrorContains(t, err, "different key")
+		}
+	})
+
+	t.Run("the index is rebuilt from the encrypted commit logs", func(t *testing.T) {
+		secondIndex, err := newIndex()
+		require.Nil(t, err)
+
+		res, _, err := secondIndex.knnSearchByVector(context.Background(), testVectors[3], 50, 36, nil)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResults, res)
 	})
@@ -112,7 +112,7 @@ func TestRecallGeo(t *testing.T) {
 		for i := 0; i < queries; i++ {
 			controlList := bruteForce(vectors, queryVectors[i], k)
 			before := time.Now()
-			results, _, err := vectorIndex.knnSearchByVector(queryVectors[i], k, 800, nil)
+			results, _, err := vectorIndex.knnSearchByVector(context.Background(), queryVectors[i], k, 800, nil)
 			times += time.Since(before)
 
 			require.Nil(t, err)
@@ -36,6 +36,10 @@ func (h *hnsw) searchTimeEF(k int) int {
 		return h.autoEfFromK(k)
 	}
 
+	if h.efScale.Load() {
+		return h.scaledEfFromK(k, ef)
+	}
+
 	if ef < k {
 		ef = k
 	}
@@ -61,21 +65,86 @@ func (h *hnsw) autoEfFromK(k int) int {
 	return ef
 }
 
-func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+// scaledEfFromK uses the explicitly set ef as the floor instead of efMin. The
+// upper bound is efMax, unless the floor itself is higher
+func (h *hnsw) scaledEfFromK(k, floor int) int {
+	factor := int(atomic.LoadInt64(&h.efFactor))
+	max := int(atomic.LoadInt64(&h.efMax))
+
+	ef := k * factor
+	if ef > max {
+		ef = max
+	}
+	if ef < floor {
+		ef = floor
+	}
+	if k > ef {
+		ef = k // otherwise results will get cut off early
+	}
+
+	return ef
+}
+
+func (h *hnsw) SearchByVector(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	vector = h.normalizeQueryVector(vector)
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		return h.flatSearch(ctx, vector, k, allowList)
+	}
+
+	return h.graphSearch(ctx, vector, k, allowList)
+}
+
+// SearchByVectorFlat compares the query vector with every vector on the allow
+// list, regardless of the flat search cutoff. It is used when the query
+// planner has chosen the strategy instead of the index.
+func (h *hnsw) SearchByVectorFlat(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
+	return h.flatSearch(ctx, h.normalizeQueryVector(vector), k, allowList)
+}
+
+// SearchByVectorGraph always searches the graph, even if the allow list is
+// shorter than the flat search cutoff. A nil allow list searches all vectors.
+func (h *hnsw) SearchByVectorGraph(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	return h.graphSearch(ctx, h.normalizeQueryVector(vector), k, allowList)
+}
+
+func (h *hnsw) normalizeQueryVector(vector []float32) []float32 {
 	if h.distancerProvider.Type() == "cosine-dot" {
 		// cosine-dot requires normalized vectors, as the dot product and cosine
 		// similarity are only identical if the vector is normalized
-		vector = distancer.Normalize(vector)
+		return distancer.Normalize(vector)
 	}
+	return vector
+}
 
-	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		return h.flatSearch(vector, k, allowList)
+func (h *hnsw) graphSearch(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if tuner := h.efTuner.Load(); tuner != nil {
+		ef := tuner.searchEF(k)
+		h.metrics.SearchEF(ef)
+		return h.knnSearchAndSample(ctx, tuner, vector, k, ef, allowList)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	ef := h.searchTimeEF(k)
+	h.metrics.SearchEF(ef)
+	return h.knnSearchByVector(ctx, vector, k, ef, allowList)
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -87,7 +156,7 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 // eventually turned into objects, for example, a Get query. If the caller just
 // needs ids for sake of something like aggregation, a maxLimit of -1 can be
 // passed in to truly obtain all results from the vector index.
-func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+func (h *hnsw) SearchByVectorDistance(ctx context.Context, vector []float32, targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	var (
@@ -100,7 +169,7 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	recursiveSearch := func() (bool, error) {
 		shouldContinue := false
 
-		ids, dist, err := h.SearchByVector(vector, searchParams.totalLimit, allowList)
+		ids, dist, err := h.SearchByVector(ctx, vector, searchParams.totalLimit, allowList)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -139,6 +208,10 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	}
 
 	for shouldContinue {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		searchParams.iterate()
 		if searchParams.maxLimitReached() {
 			h.logger.
@@ -157,11 +230,14 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	return resultIDs, resultDist, nil
 }
 
+// shouldRescore is true if the distances of the search are based on
+// compressed vectors, either the pq codes of a compressed index or the scalar
+// quantized vectors of the vector cache
 func (h *hnsw) shouldRescore() bool {
-	return h.compressed.Load() && !h.doNotRescore
+	return (h.compressed.Load() || h.cacheCompressed) && !h.doNotRescore
 }
 
-func (h *hnsw) searchLayerByVector(queryVector []float32,
+func (h *hnsw) searchLayerByVector(ctx context.Context, queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
@@ -170,10 +246,13 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		byteDistancer = h.pq.NewDistancer(queryVector)
 		defer h.pq.ReturnDistancer(byteDistancer)
 	}
-	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
+	return h.searchLayerByVectorWithDistancer(ctx, queryVector, entrypoints, ef, level,
+		allowList, byteDistancer)
 }
 
-func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
+// searchLayerByVectorWithDistancer stops with the error of the context once
+// it is cancelled, searches which are part of an insert are never cancelled
+func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context, queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
 ) {
@@ -207,6 +286,15 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
 
 	for candidates.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			h.pools.pqCandidates.Put(candidates)
+			h.pools.pqResults.Put(results)
+			h.pools.visitedListsLock.Lock()
+			h.pools.visitedLists.Return(visited)
+			h.pools.visitedListsLock.Unlock()
+			return nil, err
+		}
+
 		var dist float32
 		candidate := candidates.Pop()
 		dist = candidate.Dist
@@ -423,7 +511,26 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
-	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
+	vec, err := h.tempVectorForID(context.Background(), nodeID, slice)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID)
+			return 0, false, nil
+		} else {
+			// not a typed error, we can recover from, return with err
+			return 0, false, errors.Wrapf(err, "get vector of docID %d", nodeID)
+		}
+	}
+	return concreteDistancer.DistanceToFloat(vec)
+}
+
+// exactDistanceToNode is the distance between the query and the vector of a
+// node read from disk, bypassing the scalar quantized vector cache
+func (h *hnsw) exactDistanceToNode(searchVec []float32, nodeID uint64) (float32, bool, error) {
+	slice := h.pools.tempVectors.Get(int(h.dims))
+	defer h.pools.tempVectors.Put(slice)
+	vec, err := h.tempVectorForID(context.Background(), nodeID, slice)
 	if err != nil {
 		var e storobj.ErrNotFound
 		if errors.As(err, &e) {
@@ -434,10 +541,31 @@ func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDist
 			return 0, false, errors.Wrapf(err, "get vector of docID %d", nodeID)
 		}
 	}
+	dist, _, err := h.distancerProvider.SingleDist(searchVec, vec)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "calculate distance between candidate and query")
+	}
+	return dist, true, nil
+}
+
+// tempVectorForID reads the vector of a node into a pooled container, without
+// going through the vector cache and without allocating. The result is only
+// valid until the container is put back and must not be modified, as it may
+// be a view on the copy of the stored object held by the container.
+func (h *hnsw) tempVectorForID(ctx context.Context, nodeID uint64,
+	container *VectorSlice,
+) ([]float32, error) {
+	vec, err := h.TempVectorForIDThunk(ctx, nodeID, container)
+	if err != nil {
+		return nil, err
+	}
+
 	if h.distancerProvider.Type() == "cosine-dot" {
-		vec = distancer.Normalize(vec)
+		// the vector may be shared, so it is normalized into the container
+		vec = distancer.NormalizeInto(container.Slice, vec)
 	}
-	return concreteDistancer.DistanceToFloat(vec)
+
+	return vec, nil
 }
 
 func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
@@ -480,7 +608,7 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 			"tombstone was added", docID)
 }
 
-func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
+func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
@@ -508,7 +636,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		eps := priorityqueue.NewMin(10)
 		eps.Insert(entryPointID, entryPointDistance)
 
-		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, byteDistancer)
+		res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, 1, level, nil, byteDistancer)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
@@ -551,7 +679,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin(10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, byteDistancer)
+	res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, ef, 0, allowList, byteDistancer)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
@@ -566,13 +694,23 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		}
 		res.Reset()
 		for _, id := range ids {
-			dist, _, _ := h.distanceFromBytesToFloatNode(byteDistancer, id)
+			var dist float32
+			var ok bool
+			if byteDistancer != nil {
+				dist, ok, _ = h.distanceFromBytesToFloatNode(byteDistancer, id)
+			} else {
+				dist, ok, _ = h.exactDistanceToNode(searchVec, id)
+			}
+			if !ok {
+				// deleted in the meantime
+				continue
+			}
 			res.Insert(id, dist)
 			if res.Len() > ef {
 				res.Pop()
 			}
 		}
-
+		h.metrics.Rescored(len(ids))
 	}
 
 	for res.Len() > k {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -78,7 +79,7 @@ func TestNilCheckOnPartiallyCleanedNode(t *testing.T) {
 	})
 
 	t.Run("run a search that would typically find the new ep", func(t *testing.T) {
-		res, _, err := vectorIndex.SearchByVector([]float32{1.7, 1.7}, 20, nil)
+		res, _, err := vectorIndex.SearchByVector(context.Background(), []float32{1.7, 1.7}, 20, nil)
 		require.Nil(t, err)
 		assert.Equal(t, []uint64{2, 0}, res, "right results are found")
 	})
@@ -88,3 +89,101 @@ func TestNilCheckOnPartiallyCleanedNode(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestFlatSearchReadsIntoPooledContainer(t *testing.T) {
+	vectors := [][]float32{
+		{1, 1},
+		{2, 2},
+		{3, 4},
+		{100, -100},
+	}
+
+	for _, provider := range []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+	} {
+		t.Run(provider.Type(), func(t *testing.T) {
+			var cached, temp int
+			index, err := New(Config{
+				RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+				ID:                    "flat-search-pooled",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      provider,
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					cached++
+					return vectors[int(id)], nil
+				},
+				TempVectorForIDThunk: func(ctx context.Context, id uint64,
+					container *VectorSlice,
+				) ([]float32, error) {
+					temp++
+					// return the stored vector itself, like a zero-copy read would
+					return vectors[int(id)], nil
+				},
+			}, ent.UserConfig{
+				MaxConnections:        30,
+				EFConstruction:        128,
+				VectorCacheMaxObjects: 100000,
+				FlatSearchCutoff:      100,
+			}, cyclemanager.NewNoop())
+			require.Nil(t, err)
+
+			for i, vec := range vectors {
+				require.Nil(t, index.Add(uint64(i), vec))
+			}
+
+			cached, temp = 0, 0
+			res, _, err := index.SearchByVector(context.Background(), []float32{1, 1}, 2,
+				helpers.NewAllowList(0, 2, 3))
+			require.Nil(t, err)
+
+			assert.Equal(t, []uint64{0, 2}, res)
+			assert.Equal(t, 0, cached)
+			assert.Equal(t, 3, temp)
+			assert.Equal(t, []float32{3, 4}, vectors[2], "stored vector is unchanged")
+		})
+	}
+}
+
+func TestSearchByVectorCancelled(t *testing.T) {
+	vectors := [][]float32{
+		{1, 1},
+		{2, 2},
+		{3, 4},
+		{100, -100},
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "search-cancelled",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+		FlatSearchCutoff:      2,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("graph search", func(t *testing.T) {
+		_, _, err := index.SearchByVector(ctx, []float32{1, 1}, 2, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("flat search", func(t *testing.T) {
+		_, _, err := index.SearchByVector(ctx, []float32{1, 1}, 2,
+			helpers.NewAllowList(0, 2))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -76,7 +76,7 @@ func (h *hnsw) restoreFromDisk() error {
 	for i, fileName := range fileNames {
 		beforeIndividual := time.Now()
 
-		fd, err := os.Open(fileName)
+		fd, err := h.encryption.Open(fileName)
 		if err != nil {
 			return errors.Wrapf(err, "open commit log %q for reading", fileName)
 		}
@@ -102,7 +102,8 @@ func (h *hnsw) restoreFromDisk() error {
 					Error("write-ahead-log ended abruptly, some elements may not have been recovered")
 
 				// we need to truncate the file to its valid length!
-				if err := os.Truncate(fileName, int64(valid)); err != nil {
+				if err := os.Truncate(fileName,
+					int64(valid)+h.encryption.HeaderSize()); err != nil {
 					return errors.Wrapf(err, "truncate corrupt commit log %q", fileName)
 				}
 			} else {
@@ -34,12 +34,21 @@ type shardedLockCache struct {
 	dims                int32
 	trackDimensionsOnce sync.Once
 	deletionInterval    time.Duration
+	metrics             *Metrics
 
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
 	maintenanceLock sync.Mutex
 }
 
+// floatVectorCache is the cache of the uncompressed vectors, it holds them
+// either as they are or scalar quantized
+type floatVectorCache interface {
+	cache[float32]
+	multiGet(ctx context.Context, ids []uint64) ([][]float32, []error)
+	setMetrics(metrics *Metrics)
+}
+
 var shardFactor = uint64(512)
 
 const defaultDeletionInterval = 3 * time.Second
@@ -58,6 +67,7 @@ func newShardedLockCache(vecForID VectorForID, maxSize int,
 		shardedLocks:     make([]sync.RWMutex, shardFactor),
 		maintenanceLock:  sync.Mutex{},
 		deletionInterval: deletionInterval,
+		metrics:          &Metrics{},
 	}
 
 	for i := uint64(0); i < shardFactor; i++ {
@@ -78,6 +88,7 @@ func (s *shardedLockCache) get(ctx context.Context, id uint64) ([]float32, error
 	s.shardedLocks[id%shardFactor].RUnlock()
 
 	if vec != nil {
+		s.metrics.VectorCacheHit()
 		return vec, nil
 	}
 
@@ -98,6 +109,7 @@ func (s *shardedLockCache) delete(ctx context.Context, id uint64) {
 }
 
 func (s *shardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]float32, error) {
+	s.metrics.VectorCacheMiss()
 	vec, err := s.vectorForID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -132,6 +144,8 @@ func (s *shardedLockCache) multiGet(ctx context.Context, ids []uint64) ([][]floa
 			vecFromDisk, err := s.handleCacheMiss(ctx, id)
 			errs[i] = err
 			vec = vecFromDisk
+		} else {
+			s.metrics.VectorCacheHit()
 		}
 
 		out[i] = vec
@@ -146,6 +160,10 @@ var prefetchFunc func(in uintptr) = func(in uintptr) {
 	// this function will be overridden for amd64
 }
 
+func (s *shardedLockCache) setMetrics(metrics *Metrics) {
+	s.metrics = metrics
+}
+
 //nolint:unused
 func (s *shardedLockCache) prefetch(id uint64) {
 	s.shardedLocks[id%shardFactor].RLock()
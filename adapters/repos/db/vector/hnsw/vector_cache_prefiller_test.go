@@ -122,6 +122,11 @@ func (f *fakeCache) drop() {
 	panic("not implemented")
 }
 
+//nolint:unused
+func (f *fakeCache) deleteAllVectors() {
+	f.reset()
+}
+
 //nolint:unused
 func (f *fakeCache) copyMaxSize() int64 {
 	return 1e6
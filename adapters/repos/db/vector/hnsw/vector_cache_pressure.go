@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import "sync/atomic"
+
+// VectorCacheBytes estimates the memory held by the vectors in the cache
+func (h *hnsw) VectorCacheBytes() int64 {
+	if h.compressed.Load() {
+		return h.compressedVectorsCache.countVectors() * h.vectorCacheEntryBytes()
+	}
+	return h.cache.countVectors() * h.vectorCacheEntryBytes()
+}
+
+// ShrinkVectorCache removes all vectors from the cache to free memory, they
+// are read from disk again as the index is searched
+func (h *hnsw) ShrinkVectorCache() {
+	if h.compressed.Load() {
+		h.compressedVectorsCache.deleteAllVectors()
+		return
+	}
+	h.cache.deleteAllVectors()
+}
+
+// SetVectorCacheBudget limits the cache to the number of vectors which fit
+// into the given bytes, but never beyond the vectorCacheMaxObjects of the
+// user config. A budget of 0 removes the limit again.
+func (h *hnsw) SetVectorCacheBudget(bytes int64) {
+	h.cacheBudget.Store(bytes)
+	h.resizeVectorCache()
+}
+
+// resizeVectorCache sets the max size of the active cache from the user
+// config and the budget
+func (h *hnsw) resizeVectorCache() {
+	maxObjects := h.cacheMaxObjects.Load()
+	if budget := h.cacheBudget.Load(); budget > 0 {
+		// as long as no vector was read the size of an entry is unknown, the
+		// budget is applied as soon as the next budget is set
+		if entry := h.vectorCacheEntryBytes(); entry > 0 && budget/entry < maxObjects {
+			maxObjects = budget / entry
+		}
+	}
+
+	if h.compressed.Load() {
+		h.compressedVectorsCache.updateMaxSize(maxObjects)
+		return
+	}
+	h.cache.updateMaxSize(maxObjects)
+}
+
+// vectorCacheEntryBytes is the size of a single vector in the active cache
+func (h *hnsw) vectorCacheEntryBytes() int64 {
+	if h.compressed.Load() {
+		return int64(h.pq.ExposeFields().M)
+	}
+	dims := int64(atomic.LoadInt32(&h.dims))
+	if h.cacheCompressed {
+		if dims == 0 {
+			return 0
+		}
+		return dims + sqHeaderSize
+	}
+	return dims * 4
+}
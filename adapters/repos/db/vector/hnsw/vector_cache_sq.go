@@ -0,0 +1,316 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// sqHeaderSize is the size of the offset and step which are stored in front
+// of the codes of a scalar quantized vector
+const sqHeaderSize = 8
+
+// scalarQuantizedCache holds the vectors scalar quantized to a byte per
+// dimension instead of the full float32 vectors. Every vector is quantized
+// with its own offset and step, so the cache doesn't need to be trained and
+// works from the first insert. The vectors are decoded on every read, which
+// trades some cpu for a quarter of the memory. The decoded vectors are only
+// approximations, the search rescores its final candidates with the vectors
+// read from disk.
+type scalarQuantizedCache struct {
+	shardedLocks        []sync.RWMutex
+	cache               [][]byte
+	vectorForID         VectorForID
+	normalizeOnRead     bool
+	maxSize             int64
+	count               int64
+	cancel              chan bool
+	logger              logrus.FieldLogger
+	dims                int32
+	trackDimensionsOnce sync.Once
+	deletionInterval    time.Duration
+	metrics             *Metrics
+
+	// The maintenanceLock makes sure that only one maintenance operation, such
+	// as growing the cache or clearing the cache happens at the same time.
+	maintenanceLock sync.Mutex
+}
+
+func newScalarQuantizedCache(vecForID VectorForID, maxSize int,
+	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
+) *scalarQuantizedCache {
+	vc := &scalarQuantizedCache{
+		vectorForID:      vecForID,
+		cache:            make([][]byte, initialSize),
+		normalizeOnRead:  normalizeOnRead,
+		maxSize:          int64(maxSize),
+		cancel:           make(chan bool),
+		logger:           logger,
+		shardedLocks:     make([]sync.RWMutex, shardFactor),
+		deletionInterval: deletionInterval,
+		metrics:          &Metrics{},
+	}
+
+	vc.watchForDeletion()
+	return vc
+}
+
+// sqEncode quantizes every dimension to one of 256 steps between the
+// smallest and the largest value of the vector
+func sqEncode(vec []float32) []byte {
+	out := make([]byte, sqHeaderSize+len(vec))
+	if len(vec) == 0 {
+		return out
+	}
+
+	min, max := vec[0], vec[0]
+	for _, v := range vec {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	step := (max - min) / math.MaxUint8
+
+	binary.LittleEndian.PutUint32(out[0:4], math.Float32bits(min))
+	binary.LittleEndian.PutUint32(out[4:8], math.Float32bits(step))
+	if step == 0 {
+		return out
+	}
+
+	for i, v := range vec {
+		code := math.Round(float64((v - min) / step))
+		if code > math.MaxUint8 {
+			code = math.MaxUint8
+		}
+		out[sqHeaderSize+i] = byte(code)
+	}
+	return out
+}
+
+func sqDecode(encoded []byte) []float32 {
+	min := math.Float32frombits(binary.LittleEndian.Uint32(encoded[0:4]))
+	step := math.Float32frombits(binary.LittleEndian.Uint32(encoded[4:8]))
+
+	codes := encoded[sqHeaderSize:]
+	out := make([]float32, len(codes))
+	for i, code := range codes {
+		out[i] = min + float32(code)*step
+	}
+	return out
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) all() [][]float32 {
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	out := make([][]float32, len(s.cache))
+	for i, encoded := range s.cache {
+		if encoded != nil {
+			out[i] = sqDecode(encoded)
+		}
+	}
+	return out
+}
+
+func (s *scalarQuantizedCache) get(ctx context.Context, id uint64) ([]float32, error) {
+	s.shardedLocks[id%shardFactor].RLock()
+	encoded := s.cache[id]
+	s.shardedLocks[id%shardFactor].RUnlock()
+
+	if encoded != nil {
+		s.metrics.VectorCacheHit()
+		return sqDecode(encoded), nil
+	}
+
+	return s.handleCacheMiss(ctx, id)
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) delete(ctx context.Context, id uint64) {
+	s.shardedLocks[id%shardFactor].Lock()
+	defer s.shardedLocks[id%shardFactor].Unlock()
+
+	if int(id) >= len(s.cache) || s.cache[id] == nil {
+		return
+	}
+
+	s.cache[id] = nil
+	atomic.AddInt64(&s.count, -1)
+}
+
+func (s *scalarQuantizedCache) handleCacheMiss(ctx context.Context, id uint64) ([]float32, error) {
+	s.metrics.VectorCacheMiss()
+	vec, err := s.vectorForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.trackDimensionsOnce.Do(func() {
+		atomic.StoreInt32(&s.dims, int32(len(vec)))
+	})
+
+	if s.normalizeOnRead {
+		vec = distancer.Normalize(vec)
+	}
+
+	atomic.AddInt64(&s.count, 1)
+	s.shardedLocks[id%shardFactor].Lock()
+	s.cache[id] = sqEncode(vec)
+	s.shardedLocks[id%shardFactor].Unlock()
+
+	// the full vector was read anyway, there is no point in returning the
+	// approximation of it
+	return vec, nil
+}
+
+func (s *scalarQuantizedCache) multiGet(ctx context.Context, ids []uint64) ([][]float32, []error) {
+	out := make([][]float32, len(ids))
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		out[i], errs[i] = s.get(ctx, id)
+	}
+
+	return out, errs
+}
+
+func (s *scalarQuantizedCache) setMetrics(metrics *Metrics) {
+	s.metrics = metrics
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) prefetch(id uint64) {
+	s.shardedLocks[id%shardFactor].RLock()
+	defer s.shardedLocks[id%shardFactor].RUnlock()
+
+	prefetchFunc(uintptr(unsafe.Pointer(&s.cache[id])))
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) preload(id uint64, vec []float32) {
+	s.shardedLocks[id%shardFactor].Lock()
+	defer s.shardedLocks[id%shardFactor].Unlock()
+
+	s.trackDimensionsOnce.Do(func() {
+		atomic.StoreInt32(&s.dims, int32(len(vec)))
+	})
+
+	if s.cache[id] == nil {
+		atomic.AddInt64(&s.count, 1)
+	}
+	s.cache[id] = sqEncode(vec)
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) grow(node uint64) {
+	if node < uint64(len(s.cache)) {
+		return
+	}
+	s.maintenanceLock.Lock()
+	defer s.maintenanceLock.Unlock()
+
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	newSize := node + minimumIndexGrowthDelta
+	newCache := make([][]byte, newSize)
+	copy(newCache, s.cache)
+	s.cache = newCache
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) len() int32 {
+	return int32(len(s.cache))
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) countVectors() int64 {
+	return atomic.LoadInt64(&s.count)
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) drop() {
+	s.deleteAllVectors()
+	s.cancel <- true
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) deleteAllVectors() {
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	s.logger.WithField("action", "hnsw_delete_vector_cache").
+		Debug("deleting full vector cache")
+	for i := range s.cache {
+		s.cache[i] = nil
+	}
+
+	atomic.StoreInt64(&s.count, 0)
+}
+
+func (s *scalarQuantizedCache) watchForDeletion() {
+	go func() {
+		t := time.NewTicker(s.deletionInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-s.cancel:
+				return
+			case <-t.C:
+				s.replaceIfFull()
+			}
+		}
+	}()
+}
+
+func (s *scalarQuantizedCache) replaceIfFull() {
+	if atomic.LoadInt64(&s.count) >= atomic.LoadInt64(&s.maxSize) {
+		s.maintenanceLock.Lock()
+		s.deleteAllVectors()
+		s.maintenanceLock.Unlock()
+	}
+}
+
+func (s *scalarQuantizedCache) obtainAllLocks() {
+	for i := range s.shardedLocks {
+		s.shardedLocks[i].Lock()
+	}
+}
+
+func (s *scalarQuantizedCache) releaseAllLocks() {
+	for i := range s.shardedLocks {
+		s.shardedLocks[i].Unlock()
+	}
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) updateMaxSize(size int64) {
+	atomic.StoreInt64(&s.maxSize, size)
+}
+
+//nolint:unused
+func (s *scalarQuantizedCache) copyMaxSize() int64 {
+	return atomic.LoadInt64(&s.maxSize)
+}
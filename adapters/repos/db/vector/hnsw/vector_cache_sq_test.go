@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestScalarQuantization(t *testing.T) {
+	t.Run("values are within half a step", func(t *testing.T) {
+		vec := []float32{-1.5, 0, 0.25, 3.75, 2}
+		step := float32(3.75+1.5) / 255
+
+		decoded := sqDecode(sqEncode(vec))
+		require.Len(t, decoded, len(vec))
+		for i := range vec {
+			assert.InDelta(t, vec[i], decoded[i], float64(step/2)+1e-6)
+		}
+	})
+
+	t.Run("constant vector", func(t *testing.T) {
+		decoded := sqDecode(sqEncode([]float32{0.5, 0.5, 0.5}))
+		assert.Equal(t, []float32{0.5, 0.5, 0.5}, decoded)
+	})
+
+	t.Run("empty vector", func(t *testing.T) {
+		assert.Empty(t, sqDecode(sqEncode([]float32{})))
+	})
+}
+
+func TestScalarQuantizedCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors := map[uint64][]float32{
+		1: {0.1, 0.2, 0.3},
+		2: {-1, 0, 1},
+	}
+	reads := 0
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		reads++
+		return vectors[id], nil
+	}
+
+	c := newScalarQuantizedCache(vecForID, 1000, logger, false, defaultDeletionInterval)
+	defer c.drop()
+	ctx := context.Background()
+
+	vec, err := c.get(ctx, 1)
+	require.Nil(t, err)
+	assert.Equal(t, vectors[1], vec, "a miss returns the vector as read")
+	assert.Equal(t, int64(1), c.countVectors())
+
+	vec, err = c.get(ctx, 1)
+	require.Nil(t, err)
+	assert.InDeltaSlice(t, vectors[1], vec, 0.001)
+	assert.Equal(t, 1, reads, "a hit doesn't read the vector")
+
+	c.preload(2, vectors[2])
+	vec, err = c.get(ctx, 2)
+	require.Nil(t, err)
+	assert.InDeltaSlice(t, vectors[2], vec, 0.01)
+	assert.Equal(t, int64(2), c.countVectors())
+
+	c.delete(ctx, 2)
+	assert.Equal(t, int64(1), c.countVectors())
+
+	c.deleteAllVectors()
+	assert.Equal(t, int64(0), c.countVectors())
+	_, err = c.get(ctx, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 2, reads)
+}
+
+func newVectorCacheTestIndex(t *testing.T, vectors [][]float32,
+	compression string,
+) *hnsw {
+	uc := ent.NewDefaultUserConfig()
+	uc.VectorCacheCompression = compression
+	uc.VectorCacheMaxObjects = 1000
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "vector-cache",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	return index
+}
+
+func TestScalarQuantizedCacheSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	vectors := make([][]float32, 300)
+	for i := range vectors {
+		vectors[i] = make([]float32, 32)
+		for j := range vectors[i] {
+			vectors[i][j] = r.Float32()
+		}
+	}
+	index := newVectorCacheTestIndex(t, vectors, ent.VectorCacheCompressionSQ)
+	assert.True(t, index.shouldRescore())
+
+	query := vectors[42]
+	ids, dists, err := index.SearchByVector(context.Background(), query, 10, nil)
+	require.Nil(t, err)
+	require.Len(t, ids, 10)
+	assert.Equal(t, uint64(42), ids[0])
+
+	l2 := distancer.NewL2SquaredProvider()
+	for i, id := range ids {
+		exact, _, err := l2.SingleDist(query, vectors[id])
+		require.Nil(t, err)
+		assert.Equal(t, exact, dists[i], "distances are rescored with the stored vectors")
+	}
+	assert.True(t, sort.SliceIsSorted(dists, func(a, b int) bool { return dists[a] < dists[b] }))
+
+	assert.Equal(t, index.cache.countVectors()*(32+sqHeaderSize), index.VectorCacheBytes())
+}
+
+func TestVectorCacheBudget(t *testing.T) {
+	vectors := [][]float32{{1, 2, 3, 4}, {4, 3, 2, 1}}
+
+	t.Run("uncompressed", func(t *testing.T) {
+		index := newVectorCacheTestIndex(t, vectors, ent.VectorCacheCompressionNone)
+
+		index.SetVectorCacheBudget(10 * 4 * 4)
+		assert.Equal(t, int64(10), index.cache.copyMaxSize())
+
+		index.SetVectorCacheBudget(1e9)
+		assert.Equal(t, int64(1000), index.cache.copyMaxSize(),
+			"the budget doesn't grow the cache beyond vectorCacheMaxObjects")
+
+		index.SetVectorCacheBudget(10 * 4 * 4)
+		index.SetVectorCacheBudget(0)
+		assert.Equal(t, int64(1000), index.cache.copyMaxSize())
+	})
+
+	t.Run("scalar quantized", func(t *testing.T) {
+		index := newVectorCacheTestIndex(t, vectors, ent.VectorCacheCompressionSQ)
+
+		index.SetVectorCacheBudget(10 * (4 + sqHeaderSize))
+		assert.Equal(t, int64(10), index.cache.copyMaxSize())
+	})
+
+	t.Run("user config update keeps the budget", func(t *testing.T) {
+		index := newVectorCacheTestIndex(t, vectors, ent.VectorCacheCompressionNone)
+		index.SetVectorCacheBudget(10 * 4 * 4)
+
+		uc := ent.NewDefaultUserConfig()
+		uc.VectorCacheMaxObjects = 5
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.Equal(t, int64(5), index.cache.copyMaxSize())
+
+		uc.VectorCacheMaxObjects = 2000
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.Equal(t, int64(10), index.cache.copyMaxSize())
+	})
+}
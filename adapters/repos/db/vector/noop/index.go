@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -37,11 +38,11 @@ func (i *Index) Delete(id ...uint64) error {
 	return nil
 }
 
-func (i *Index) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (i *Index) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
 }
 
-func (i *Index) SearchByVectorDistance(vector []float32, dist float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (i *Index) SearchByVectorDistance(ctx context.Context, vector []float32, dist float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
 	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
 }
 
@@ -57,6 +58,12 @@ func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func
 		}
 		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
 
+	case dynamic.UserConfig:
+		if t.Hnsw.Skip {
+			return nil
+		}
+		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
+
 	default:
 		return fmt.Errorf("unrecognized vector index config: %T", updated)
 
@@ -0,0 +1,576 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// dynamicIndex answers vector searches by brute force over the objects of
+// the shard until the shard holds more than the configured threshold of
+// objects. It then builds an hnsw index in the background and swaps it in
+// once it is complete, so shards which stay small, such as those of tiny
+// tenants, never pay for a graph.
+//
+// Vectors added or deleted while the hnsw index is built are recorded and
+// applied before the swap. The switch is persisted in a marker file, so the
+// shard starts with the hnsw index after a restart.
+type dynamicIndex struct {
+	shard      *Shard
+	distancer  distancer.Provider
+	newHnsw    func() (VectorIndex, error)
+	markerPath string
+	rootPath   string
+	logger     logrus.FieldLogger
+
+	threshold atomic.Int64
+	// approximate number of vectors, initialized from the objects bucket on
+	// first use
+	count     atomic.Int64
+	countOnce sync.Once
+
+	// held for writing to swap in the hnsw index or to start and finish a
+	// build, for reading by everything else
+	sync.RWMutex
+	hnsw     VectorIndex
+	building *dynamicBuild
+	cancel   context.CancelFunc
+	done     chan struct{}
+	// latest hnsw config, applied when the build is swapped in
+	hnswConfig schema.VectorIndexConfig
+}
+
+// dynamicBuild tracks the writes which happen while the hnsw index is built
+type dynamicBuild struct {
+	index VectorIndex
+
+	sync.Mutex
+	added   helpers.AllowList
+	pending []queuedVector
+	deleted []uint64
+}
+
+func newDynamicIndex(shard *Shard, cfg dynamicent.UserConfig,
+	distProv distancer.Provider, newHnsw func() (VectorIndex, error),
+) (*dynamicIndex, error) {
+	d := &dynamicIndex{
+		shard:      shard,
+		distancer:  distProv,
+		newHnsw:    newHnsw,
+		rootPath:   shard.index.Config.RootPath,
+		markerPath: filepath.Join(shard.index.Config.RootPath, shard.ID()+".dynamic"),
+		logger: shard.index.logger.WithFields(logrus.Fields{
+			"action": "dynamic_vector_index",
+			"shard":  shard.name,
+		}),
+		hnswConfig: cfg.Hnsw,
+	}
+	d.threshold.Store(int64(cfg.Threshold))
+
+	if _, err := os.Stat(d.markerPath); err == nil {
+		index, err := newHnsw()
+		if err != nil {
+			return nil, err
+		}
+		d.hnsw = index
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "check dynamic index marker")
+	}
+
+	return d, nil
+}
+
+// upgraded returns true once the hnsw index is in use
+func (d *dynamicIndex) upgraded() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.hnsw != nil
+}
+
+func (d *dynamicIndex) Add(id uint64, vector []float32) error {
+	d.RLock()
+	if d.hnsw != nil {
+		defer d.RUnlock()
+		return d.hnsw.Add(id, vector)
+	}
+
+	if b := d.building; b != nil {
+		b.Lock()
+		b.pending = append(b.pending, queuedVector{id: id, vector: vector})
+		b.Unlock()
+	}
+	building := d.building != nil
+	d.RUnlock()
+
+	// the object is already stored, so a freshly initialized count includes it
+	var count int64
+	if d.initCount() {
+		count = d.count.Load()
+	} else {
+		count = d.count.Add(1)
+	}
+	if count > d.threshold.Load() && !building {
+		d.startUpgrade()
+	}
+
+	return nil
+}
+
+func (d *dynamicIndex) Delete(ids ...uint64) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		return d.hnsw.Delete(ids...)
+	}
+
+	if b := d.building; b != nil {
+		b.Lock()
+		b.deleted = append(b.deleted, ids...)
+		b.Unlock()
+	}
+
+	if !d.initCount() {
+		d.count.Add(-int64(len(ids)))
+	}
+	return nil
+}
+
+// initCount counts the objects of the shard on first use, it returns true if
+// it did so in this call
+func (d *dynamicIndex) initCount() bool {
+	initialized := false
+	d.countOnce.Do(func() {
+		d.count.Store(int64(d.shard.store.Bucket(helpers.ObjectsBucketLSM).Count()))
+		initialized = true
+	})
+	return initialized
+}
+
+// startUpgrade builds the hnsw index in the background, unless a build is
+// already running
+func (d *dynamicIndex) startUpgrade() {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.hnsw != nil || d.building != nil {
+		return
+	}
+
+	index, err := d.newHnsw()
+	if err != nil {
+		d.logger.WithError(err).Error("could not create hnsw index, staying on brute force search")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.building = &dynamicBuild{index: index, added: helpers.NewAllowList()}
+	d.cancel = cancel
+	d.done = make(chan struct{})
+
+	d.logger.WithField("threshold", d.threshold.Load()).
+		Info("shard exceeded threshold, building hnsw index")
+
+	go d.upgrade(ctx, d.building, d.done)
+}
+
+func (d *dynamicIndex) upgrade(ctx context.Context, b *dynamicBuild, done chan struct{}) {
+	defer close(done)
+
+	if err := d.build(ctx, b); err != nil {
+		d.abortBuild(b, err)
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	// the writes which happened while the existing objects were indexed
+	b.Lock()
+	defer b.Unlock()
+	deleted := make(map[uint64]struct{}, len(b.deleted))
+	for _, id := range b.deleted {
+		deleted[id] = struct{}{}
+	}
+	for _, v := range b.pending {
+		if _, ok := deleted[v.id]; ok || b.added.Contains(v.id) {
+			continue
+		}
+		if err := b.index.Add(v.id, v.vector); err != nil {
+			d.building = nil
+			go d.abortBuild(b, errors.Wrapf(err, "add vector %d", v.id))
+			return
+		}
+	}
+	if len(b.deleted) > 0 {
+		if err := b.index.Delete(b.deleted...); err != nil {
+			d.building = nil
+			go d.abortBuild(b, errors.Wrap(err, "delete vectors"))
+			return
+		}
+	}
+
+	if err := b.index.UpdateUserConfig(d.hnswConfig, func() {}); err != nil {
+		d.logger.WithError(err).Warn("could not apply latest hnsw config")
+	}
+	if err := b.index.Flush(); err != nil {
+		d.building = nil
+		go d.abortBuild(b, errors.Wrap(err, "flush hnsw index"))
+		return
+	}
+	if err := os.WriteFile(d.markerPath, []byte("hnsw\n"), 0o644); err != nil {
+		d.building = nil
+		go d.abortBuild(b, errors.Wrap(err, "write marker"))
+		return
+	}
+
+	b.index.PostStartup()
+	d.hnsw = b.index
+	d.building = nil
+	d.logger.Info("switched to hnsw index")
+}
+
+// build inserts the vectors of all objects which existed when the build
+// started
+func (d *dynamicIndex) build(ctx context.Context, b *dynamicBuild) error {
+	cursor := d.shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	for _, val := cursor.First(); val != nil; _, val = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		docID, vector, err := docIDAndVectorFromBinary(val)
+		if err != nil {
+			return err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+
+		if err := b.index.Add(docID, vector); err != nil {
+			return errors.Wrapf(err, "add vector %d", docID)
+		}
+		b.Lock()
+		b.added.Insert(docID)
+		b.Unlock()
+	}
+
+	return nil
+}
+
+// abortBuild drops the partially built index, so a later attempt starts
+// from scratch
+func (d *dynamicIndex) abortBuild(b *dynamicBuild, err error) {
+	if !errors.Is(err, context.Canceled) {
+		d.logger.WithError(err).Error("building hnsw index failed, staying on brute force search")
+	}
+
+	if dropErr := b.index.Drop(context.Background()); dropErr != nil {
+		d.logger.WithError(dropErr).Error("could not drop partially built hnsw index")
+	}
+
+	d.Lock()
+	if d.building == b {
+		d.building = nil
+	}
+	d.Unlock()
+}
+
+// stopBuild cancels a running build and waits for it to finish
+func (d *dynamicIndex) stopBuild() {
+	d.RLock()
+	cancel, done := d.cancel, d.done
+	d.RUnlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func docIDAndVectorFromBinary(data []byte) (uint64, []float32, error) {
+	docID, err := storobj.DocIDFromBinary(data)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "read doc id")
+	}
+
+	vector, err := storobj.VectorFromBinary(data, nil)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "read vector")
+	}
+
+	return docID, vector, nil
+}
+
+func (d *dynamicIndex) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	index := d.hnsw
+	d.RUnlock()
+
+	if index != nil {
+		return index.SearchByVector(ctx, vector, k, allow)
+	}
+
+	return d.flatSearch(ctx, vector, allow, func(ids []uint64, dists []float32) ([]uint64, []float32) {
+		if len(ids) > k {
+			ids, dists = ids[:k], dists[:k]
+		}
+		return ids, dists
+	}, k)
+}
+
+func (d *dynamicIndex) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	index := d.hnsw
+	d.RUnlock()
+
+	if index != nil {
+		return index.SearchByVectorDistance(ctx, vector, targetDistance, maxLimit, allow)
+	}
+
+	return d.flatSearch(ctx, vector, allow, func(ids []uint64, dists []float32) ([]uint64, []float32) {
+		cut := sort.Search(len(dists), func(i int) bool { return dists[i] > targetDistance })
+		if maxLimit >= 0 && int64(cut) > maxLimit {
+			cut = int(maxLimit)
+		}
+		return ids[:cut], dists[:cut]
+	}, -1)
+}
+
+// flatSearch compares the query with the vector of every object of the
+// shard, or only of those in the allow list. If k is positive, only the k
+// nearest are kept. The results are sorted by distance before they are cut
+// down by trim.
+func (d *dynamicIndex) flatSearch(ctx context.Context, vector []float32,
+	allow helpers.AllowList,
+	trim func(ids []uint64, dists []float32) ([]uint64, []float32), k int,
+) ([]uint64, []float32, error) {
+	normalize := d.distancer.Type() == "cosine-dot"
+	if normalize {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	capacity := k
+	if capacity < 1 {
+		capacity = 100
+	}
+	heap := priorityqueue.NewMax(capacity)
+
+	consider := func(id uint64, candidate []float32) error {
+		if len(candidate) == 0 {
+			return nil
+		}
+		if normalize {
+			candidate = distancer.Normalize(candidate)
+		}
+		dist, _, err := d.distancer.SingleDist(vector, candidate)
+		if err != nil {
+			return errors.Wrapf(err, "distance to vector %d", id)
+		}
+		heap.Insert(id, dist)
+		if k > 0 && heap.Len() > k {
+			heap.Pop()
+		}
+		return nil
+	}
+
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+			candidate, err := d.shard.vectorByIndexID(ctx, id)
+			if err != nil {
+				// the object might have been deleted in the meantime
+				continue
+			}
+			if err := consider(id, candidate); err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		cursor := d.shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+		for _, val := cursor.First(); val != nil; _, val = cursor.Next() {
+			if err := ctx.Err(); err != nil {
+				cursor.Close()
+				return nil, nil, err
+			}
+			docID, candidate, err := docIDAndVectorFromBinary(val)
+			if err == nil {
+				err = consider(docID, candidate)
+			}
+			if err != nil {
+				cursor.Close()
+				return nil, nil, err
+			}
+		}
+		cursor.Close()
+	}
+
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i], dists[i] = item.ID, item.Dist
+	}
+
+	ids, dists = trim(ids, dists)
+	return ids, dists, nil
+}
+
+func (d *dynamicIndex) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(dynamicent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not dynamic.UserConfig, but %T", updated)
+	}
+
+	d.threshold.Store(int64(parsed.Threshold))
+
+	d.Lock()
+	d.hnswConfig = parsed.Hnsw
+	index := d.hnsw
+	d.Unlock()
+
+	if index != nil {
+		return index.UpdateUserConfig(parsed.Hnsw, callback)
+	}
+
+	callback()
+	return nil
+}
+
+func (d *dynamicIndex) Drop(ctx context.Context) error {
+	d.stopBuild()
+
+	d.RLock()
+	index := d.hnsw
+	d.RUnlock()
+
+	if index != nil {
+		if err := index.Drop(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(d.markerPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove dynamic index marker")
+	}
+	return nil
+}
+
+func (d *dynamicIndex) Shutdown(ctx context.Context) error {
+	// an unfinished build is dropped, it starts over after the restart
+	d.stopBuild()
+
+	d.RLock()
+	index := d.hnsw
+	d.RUnlock()
+
+	if index != nil {
+		return index.Shutdown(ctx)
+	}
+	return nil
+}
+
+func (d *dynamicIndex) Flush() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		return d.hnsw.Flush()
+	}
+	return nil
+}
+
+func (d *dynamicIndex) SwitchCommitLogs(ctx context.Context) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		return d.hnsw.SwitchCommitLogs(ctx)
+	}
+	return nil
+}
+
+func (d *dynamicIndex) ListFiles(ctx context.Context) ([]string, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw == nil {
+		// the brute force search has no files of its own
+		return nil, nil
+	}
+
+	files, err := d.hnsw.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(d.rootPath, d.markerPath)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, rel), nil
+}
+
+func (d *dynamicIndex) PostStartup() {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		d.hnsw.PostStartup()
+	}
+}
+
+func (d *dynamicIndex) ValidateBeforeInsert(vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		return d.hnsw.ValidateBeforeInsert(vector)
+	}
+	return nil
+}
+
+func (d *dynamicIndex) Dump(labels ...string) {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.hnsw != nil {
+		d.hnsw.Dump(labels...)
+	}
+}
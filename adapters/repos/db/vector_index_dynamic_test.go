@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDynamicIndex(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	cfg := dynamicent.NewDefaultUserConfig()
+	cfg.Threshold = 200
+	cfg.Distance = enthnsw.DistanceL2Squared
+	cfg.Hnsw.Distance = enthnsw.DistanceL2Squared
+
+	shd, idx := testShard(t, ctx, "TestClass", func(idx *Index) {
+		idx.vectorIndexUserConfig = cfg
+	})
+	defer idx.drop()
+
+	dynamic, ok := shd.vectorIndex.(*dynamicIndex)
+	require.True(t, ok)
+
+	objects := createRandomObjects(r, "TestClass", 150)
+	for _, err := range shd.putObjectBatch(ctx, objects) {
+		require.Nil(t, err)
+	}
+
+	query := []float32{r.Float32(), r.Float32(), r.Float32(), r.Float32()}
+
+	t.Run("brute force search below the threshold", func(t *testing.T) {
+		require.False(t, dynamic.upgraded())
+
+		expected := bruteForceDocIDs(t, objects, query)
+
+		ids, dists, err := shd.vectorIndex.SearchByVector(context.Background(), query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected[:10], ids)
+		assert.True(t, sort.SliceIsSorted(dists, func(i, j int) bool {
+			return dists[i] < dists[j]
+		}))
+	})
+
+	t.Run("brute force search with an allow list", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for _, obj := range objects[:20] {
+			allow.Insert(obj.DocID())
+		}
+
+		expected := bruteForceDocIDs(t, objects[:20], query)
+
+		ids, _, err := shd.vectorIndex.SearchByVector(context.Background(), query, 5, allow)
+		require.Nil(t, err)
+		assert.Equal(t, expected[:5], ids)
+	})
+
+	t.Run("brute force search by distance", func(t *testing.T) {
+		ids, dists, err := shd.vectorIndex.SearchByVectorDistance(ctx, query, 0.1, -1, nil)
+		require.Nil(t, err)
+		require.Equal(t, len(ids), len(dists))
+		for _, dist := range dists {
+			assert.LessOrEqual(t, dist, float32(0.1))
+		}
+	})
+
+	t.Run("switch to hnsw above the threshold", func(t *testing.T) {
+		more := createRandomObjects(r, "TestClass", 100)
+		for _, err := range shd.putObjectBatch(ctx, more) {
+			require.Nil(t, err)
+		}
+		objects = append(objects, more...)
+
+		assert.Eventually(t, dynamic.upgraded, 10*time.Second, 10*time.Millisecond)
+
+		_, err := os.Stat(dynamic.markerPath)
+		require.Nil(t, err)
+
+		expected := bruteForceDocIDs(t, objects, query)
+		ids, _, err := shd.vectorIndex.SearchByVector(context.Background(), query, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected[:1], ids)
+	})
+}
+
+// bruteForceDocIDs returns the doc ids of the objects ordered by their
+// l2-squared distance to the query
+func bruteForceDocIDs(t *testing.T, objects []*storobj.Object, query []float32) []uint64 {
+	distProv := distancer.NewL2SquaredProvider()
+
+	type result struct {
+		id   uint64
+		dist float32
+	}
+	results := make([]result, len(objects))
+	for i, obj := range objects {
+		dist, _, err := distProv.SingleDist(query, obj.Vector)
+		require.Nil(t, err)
+		results[i] = result{id: obj.DocID(), dist: dist}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].dist < results[j].dist })
+
+	ids := make([]uint64, len(results))
+	for i := range results {
+		ids[i] = results[i].id
+	}
+	return ids
+}
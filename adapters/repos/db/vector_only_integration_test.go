@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorOnlyClass(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "VectorOnlyClass",
+		VectorOnly:          true,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dir,
+			QueryMaximumResults:       10,
+			MaxImportGoroutinesFactor: 1,
+			TrackVectorDimensions:     true,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	ids := []strfmt.UUID{
+		"8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5",
+		"f1d4ac9b-4d4c-4a35-9c2b-4a9a1a7c1b0e",
+		"3e4f4b6c-1f0c-4b55-8e0a-0c6c4c7b3e21",
+	}
+	vectors := [][]float32{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	for i, id := range ids {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class: class.Class, ID: id,
+		}, vectors[i], nil))
+	}
+
+	nearest := func(t *testing.T, repo *DB, vector []float32) []search.Result {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("no inverted buckets", func(t *testing.T) {
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(_ string, shard *Shard) error {
+			assert.NotNil(t, shard.store.Bucket(helpers.ObjectsBucketLSM))
+			assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID)))
+			assert.Nil(t, shard.store.Bucket(helpers.DimensionsBucketLSM))
+			return nil
+		})
+	})
+
+	t.Run("vector search", func(t *testing.T) {
+		res := nearest(t, repo, []float32{0.1, 0.9, 0})
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[1], res[0].ID)
+	})
+
+	t.Run("get by id", func(t *testing.T) {
+		res, err := repo.Object(ctx, class.Class, ids[2], nil,
+			additional.Properties{Vector: true}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, vectors[2], res.Vector)
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class: class.Class, ID: ids[0],
+		}, []float32{0, 0.2, 0.9}, nil))
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[2], nil, ""))
+
+		res := nearest(t, repo, []float32{0, 0, 1})
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+
+	t.Run("after restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo()
+
+		res := nearest(t, repo, []float32{0.1, 0.9, 0})
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[1], res[0].ID)
+	})
+
+	require.Nil(t, repo.Shutdown(ctx))
+}
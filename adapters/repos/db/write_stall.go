@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// how often the shards are checked for stalled writes
+const writeStallScanInterval = time.Second
+
+type writeStallState struct {
+	sync.RWMutex
+	worst lsmkv.WriteStall
+}
+
+// WriteStall returns the worst write stall across all local shards as of the
+// last scan. It is used to signal backpressure to clients.
+func (db *DB) WriteStall() lsmkv.WriteStall {
+	db.writeStallState.RLock()
+	defer db.writeStallState.RUnlock()
+
+	return db.writeStallState.worst
+}
+
+func (db *DB) scanWriteStalls() {
+	var worst lsmkv.WriteStall
+
+	db.indexLock.RLock()
+	for _, index := range db.indices {
+		index.ForEachShard(func(name string, shard *Shard) error {
+			if shard.store == nil {
+				return nil
+			}
+			stall := shard.store.WriteStall()
+			shard.metrics.WriteStall(stall)
+			worst = worst.Worse(stall)
+			return nil
+		})
+	}
+	db.indexLock.RUnlock()
+	worst = worst.Worse(db.memoryPressureStall())
+
+	db.writeStallState.Lock()
+	db.writeStallState.worst = worst
+	db.writeStallState.Unlock()
+}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package embeddingcache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	embeddingCacheDir    = "embedding_cache"
+	embeddingCacheBucket = "embeddings"
+)
+
+// Repo implements modules.EmbeddingCache with a dedicated bucket. Values
+// start with the time the entry expires followed by the vector, see
+// encode. Once there are more than maxEntries entries, the expired and the
+// oldest entries are removed until a tenth of the space is free again.
+type Repo struct {
+	store      *lsmkv.Store
+	bucket     *lsmkv.Bucket
+	ttl        time.Duration
+	maxEntries int
+	logger     logrus.FieldLogger
+	metrics    *prometheus.CounterVec // nil without monitoring
+
+	// count is approximate, overwritten keys are counted twice until the
+	// next eviction counts the entries again
+	mu    sync.Mutex
+	count int
+}
+
+func NewRepo(ctx context.Context, rootPath string, ttl time.Duration,
+	maxEntries int, logger logrus.FieldLogger, promMetrics *monitoring.PrometheusMetrics,
+) (*Repo, error) {
+	store, err := lsmkv.New(path.Join(rootPath, embeddingCacheDir), rootPath, logger, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init embedding cache store: %w", err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, embeddingCacheBucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("create embedding cache bucket: %w", err)
+	}
+
+	r := &Repo{
+		store:      store,
+		bucket:     store.Bucket(embeddingCacheBucket),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		logger:     logger,
+	}
+	if promMetrics != nil {
+		r.metrics = promMetrics.EmbeddingCacheLookups
+	}
+	r.count = r.bucket.Count()
+	return r, nil
+}
+
+// Get implements modules.EmbeddingCache
+func (r *Repo) Get(module string, key []byte) ([]float32, bool, error) {
+	data, err := r.bucket.Get(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("get embedding: %w", err)
+	}
+
+	vector, expires, err := decode(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("get embedding: %w", err)
+	}
+	if vector == nil || time.Now().After(expires) {
+		r.countLookup(module, "miss")
+		return nil, false, nil
+	}
+
+	r.countLookup(module, "hit")
+	return vector, true, nil
+}
+
+// Put implements modules.EmbeddingCache
+func (r *Repo) Put(module string, key []byte, vector []float32) error {
+	if err := r.bucket.Put(key, encode(vector, time.Now().Add(r.ttl))); err != nil {
+		return fmt.Errorf("put embedding: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.count++
+	if r.count > r.maxEntries {
+		r.evict()
+	}
+	return nil
+}
+
+type cacheEntry struct {
+	key     []byte
+	expires time.Time
+}
+
+// evict removes the expired entries and, if that is not enough, the entries
+// which expire next. It is only called once the cache is full, so the cost
+// of reading all entries is spread over a tenth of maxEntries writes.
+func (r *Repo) evict() {
+	target := r.maxEntries - r.maxEntries/10
+	now := time.Now()
+
+	var entries []cacheEntry
+	cursor := r.bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		_, expires, err := decode(v)
+		if err != nil {
+			expires = time.Time{}
+		}
+		entries = append(entries, cacheEntry{
+			key:     append([]byte{}, k...),
+			expires: expires,
+		})
+	}
+	cursor.Close()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].expires.Before(entries[j].expires)
+	})
+
+	remaining := len(entries)
+	for _, entry := range entries {
+		if remaining <= target && entry.expires.After(now) {
+			break
+		}
+		if err := r.bucket.Delete(entry.key); err != nil {
+			r.logger.WithField("action", "embedding_cache_evict").WithError(err).
+				Warn("could not evict embedding")
+			continue
+		}
+		remaining--
+	}
+	r.count = remaining
+}
+
+func (r *Repo) countLookup(module, result string) {
+	if r.metrics != nil {
+		r.metrics.With(prometheus.Labels{"module": module, "result": result}).Inc()
+	}
+}
+
+func (r *Repo) Shutdown(ctx context.Context) error {
+	return r.store.Shutdown(ctx)
+}
+
+func encode(vector []float32, expires time.Time) []byte {
+	data := make([]byte, 8+4*len(vector))
+	binary.LittleEndian.PutUint64(data, uint64(expires.UnixNano()))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(data[8+4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+// decode returns a nil vector if data is nil, e.g. because the key was not
+// found
+func decode(data []byte) ([]float32, time.Time, error) {
+	if data == nil {
+		return nil, time.Time{}, nil
+	}
+	if len(data) < 8 || (len(data)-8)%4 != 0 {
+		return nil, time.Time{}, errors.New("invalid entry length")
+	}
+
+	expires := time.Unix(0, int64(binary.LittleEndian.Uint64(data)))
+	vector := make([]float32, (len(data)-8)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[8+4*i:]))
+	}
+	return vector, expires, nil
+}
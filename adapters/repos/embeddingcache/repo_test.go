@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package embeddingcache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepo(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	t.Run("get and put", func(t *testing.T) {
+		repo, err := NewRepo(ctx, t.TempDir(), time.Hour, 100, logger, nil)
+		require.Nil(t, err)
+		defer repo.Shutdown(ctx)
+
+		_, ok, err := repo.Get("mod", []byte("key"))
+		require.Nil(t, err)
+		assert.False(t, ok)
+
+		require.Nil(t, repo.Put("mod", []byte("key"), []float32{1, 2.5, -3}))
+		vector, ok, err := repo.Get("mod", []byte("key"))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{1, 2.5, -3}, vector)
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		repo, err := NewRepo(ctx, t.TempDir(), time.Millisecond, 100, logger, nil)
+		require.Nil(t, err)
+		defer repo.Shutdown(ctx)
+
+		require.Nil(t, repo.Put("mod", []byte("key"), []float32{1}))
+		time.Sleep(5 * time.Millisecond)
+
+		_, ok, err := repo.Get("mod", []byte("key"))
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("oldest entries are evicted once full", func(t *testing.T) {
+		repo, err := NewRepo(ctx, t.TempDir(), time.Hour, 10, logger, nil)
+		require.Nil(t, err)
+		defer repo.Shutdown(ctx)
+
+		for i := 0; i < 11; i++ {
+			require.Nil(t, repo.Put("mod", []byte(fmt.Sprintf("key%02d", i)), []float32{float32(i)}))
+		}
+
+		assert.Equal(t, 9, repo.bucket.Count())
+		_, ok, err := repo.Get("mod", []byte("key00"))
+		require.Nil(t, err)
+		assert.False(t, ok)
+		vector, ok, err := repo.Get("mod", []byte("key10"))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{10}, vector)
+	})
+
+	t.Run("entries survive a restart", func(t *testing.T) {
+		dir := t.TempDir()
+		repo, err := NewRepo(ctx, dir, time.Hour, 100, logger, nil)
+		require.Nil(t, err)
+		require.Nil(t, repo.Put("mod", []byte("key"), []float32{1, 2}))
+		require.Nil(t, repo.Shutdown(ctx))
+
+		repo, err = NewRepo(ctx, dir, time.Hour, 100, logger, nil)
+		require.Nil(t, err)
+		defer repo.Shutdown(ctx)
+
+		assert.Equal(t, 1, repo.count)
+		vector, ok, err := repo.Get("mod", []byte("key"))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{1, 2}, vector)
+	})
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+const (
+	DefaultTxTTL = 60 * time.Second
+
+	// nodes which start at the same time may run into each other's
+	// transactions when they read the peers of the cluster
+	readAttempts = 3
+	readBackoff  = time.Second
+)
+
+// Peers is updated with the changes made on other nodes, see
+// federation.Manager
+type Peers interface {
+	ApplyPeer(peer federation.Peer) error
+	ApplyDeletePeer(name string)
+}
+
+// DistributedRepo stores the federation peers on all nodes of the cluster,
+// so classes which reference a peer can be used on every node. A change is
+// only made if every node accepts it, so a node cannot miss a change while
+// it is down. Nodes without any peers take over the peers of the other nodes
+// when they are loaded, as they are new to the cluster.
+type DistributedRepo struct {
+	*Repo
+
+	sync.Mutex
+	txRemote *cluster.TxManager
+	members  cluster.MemberLister
+	peers    Peers
+	logger   logrus.FieldLogger
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	members cluster.MemberLister, localRepo *Repo, logger logrus.FieldLogger,
+) *DistributedRepo {
+	broadcaster := cluster.NewTxBroadcaster(members, remoteClient)
+	repo := &DistributedRepo{
+		Repo:     localRepo,
+		txRemote: cluster.NewTxManager(broadcaster, logger),
+		members:  members,
+		logger:   logger,
+	}
+
+	broadcaster.SetConsensusFunction(repo.mergeReadPeers)
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+	repo.txRemote.SetResponseFn(repo.incomingTxResponse)
+
+	return repo
+}
+
+// SetPeers sets the peers which are updated with the changes made on other
+// nodes
+func (r *DistributedRepo) SetPeers(peers Peers) {
+	r.Lock()
+	defer r.Unlock()
+	r.peers = peers
+}
+
+// LoadPeers returns the local peers. A node without any peers takes over the
+// peers of the other nodes. If they cannot be read it starts with the peers
+// of its configuration only.
+func (r *DistributedRepo) LoadPeers() ([]federation.Peer, error) {
+	local, err := r.Repo.LoadPeers()
+	if err != nil {
+		return nil, err
+	}
+	if len(local) > 0 || len(r.members.AllNames()) <= 1 {
+		return local, nil
+	}
+
+	remote, err := r.readRemotePeers(context.Background())
+	if err != nil {
+		r.logger.WithField("action", "load_federation_peers").WithError(err).
+			Warn("could not read the federation peers of the other nodes, " +
+				"starting with the configured peers only")
+		return local, nil
+	}
+
+	peers := make([]federation.Peer, len(remote))
+	for i, stored := range remote {
+		peers[i] = stored.ToPeer()
+		if err := r.Repo.PutPeer(peers[i]); err != nil {
+			return nil, fmt.Errorf("store federation peer %q: %w", stored.Name, err)
+		}
+	}
+	return peers, nil
+}
+
+// PutPeer stores the peer on all nodes, it fails if any node is unreachable
+func (r *DistributedRepo) PutPeer(peer federation.Peer) error {
+	return r.replicate(federation.TransactionPutPeer,
+		federation.TransactionPutPeerPayload{Peer: peer.Stored()},
+		func() error { return r.Repo.PutPeer(peer) })
+}
+
+// DeletePeer deletes the peer on all nodes, it fails if any node is
+// unreachable
+func (r *DistributedRepo) DeletePeer(name string) error {
+	return r.replicate(federation.TransactionDeletePeer,
+		federation.TransactionDeletePeerPayload{Name: name},
+		func() error { return r.Repo.DeletePeer(name) })
+}
+
+// replicate commits a cluster-wide transaction before the change is stored
+// locally
+func (r *DistributedRepo) replicate(txType cluster.TransactionType,
+	payload interface{}, store func() error,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	ctx := context.Background()
+	tx, err := r.txRemote.BeginTransaction(ctx, txType, payload, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := r.txRemote.CommitWriteTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	return store()
+}
+
+func (r *DistributedRepo) readRemotePeers(ctx context.Context) ([]federation.StoredPeer, error) {
+	var err error
+	for attempt := 1; attempt <= readAttempts; attempt++ {
+		var tx *cluster.Transaction
+		tx, err = r.txRemote.BeginTransactionTolerateNodeFailures(ctx,
+			federation.TransactionReadPeers, nil, DefaultTxTTL)
+		if errors.Is(err, cluster.ErrConcurrentTransaction) && attempt < readAttempts {
+			time.Sleep(readBackoff)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("open transaction: %w", err)
+		}
+
+		// the transaction only reads, closing it is the same on every path
+		defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+		pl, ok := tx.Payload.(federation.TransactionReadPeersPayload)
+		if !ok {
+			return nil, fmt.Errorf("unrecognized tx response payload: %T", tx.Payload)
+		}
+		return pl.Peers, nil
+	}
+	return nil, err
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	r.Lock()
+	peers := r.peers
+	r.Unlock()
+
+	switch tx.Type {
+	case federation.TransactionReadPeers:
+		return nil
+
+	case federation.TransactionPutPeer:
+		peer := tx.Payload.(federation.TransactionPutPeerPayload).Peer.ToPeer()
+		if err := r.Repo.PutPeer(peer); err != nil {
+			return err
+		}
+		if peers != nil {
+			return peers.ApplyPeer(peer)
+		}
+		return nil
+
+	case federation.TransactionDeletePeer:
+		name := tx.Payload.(federation.TransactionDeletePeerPayload).Name
+		if err := r.Repo.DeletePeer(name); err != nil {
+			return err
+		}
+		if peers != nil {
+			peers.ApplyDeletePeer(name)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+// incomingTxResponse answers read transactions with the local peers
+func (r *DistributedRepo) incomingTxResponse(ctx context.Context,
+	tx *cluster.Transaction,
+) ([]byte, error) {
+	if tx.Type != federation.TransactionReadPeers {
+		return nil, nil
+	}
+
+	local, err := r.Repo.LoadPeers()
+	if err != nil {
+		return nil, err
+	}
+	stored := make([]federation.StoredPeer, len(local))
+	for i, peer := range local {
+		stored[i] = peer.Stored()
+	}
+
+	res := *tx
+	res.Payload = federation.TransactionReadPeersPayload{Peers: stored}
+	return json.Marshal(res)
+}
+
+// mergeReadPeers takes the peers of the first node which has any, all nodes
+// have the same peers as every change is made on all of them
+func (r *DistributedRepo) mergeReadPeers(ctx context.Context,
+	in []*cluster.Transaction,
+) (*cluster.Transaction, error) {
+	var merged federation.TransactionReadPeersPayload
+	for _, tx := range in {
+		raw, ok := tx.Payload.(json.RawMessage)
+		if !ok {
+			continue
+		}
+		var pl federation.TransactionReadPeersPayload
+		if err := json.Unmarshal(raw, &pl); err != nil {
+			return nil, fmt.Errorf("unmarshal federation peers: %w", err)
+		}
+		if len(pl.Peers) > 0 {
+			merged = pl
+			break
+		}
+	}
+	return &cluster.Transaction{Payload: merged}, nil
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+type fakeMembers struct {
+	names []string
+}
+
+func (m *fakeMembers) AllNames() []string { return m.names }
+
+func (m *fakeMembers) Hostnames() []string { return m.names[1:] }
+
+// fakeTxClient passes transactions to the tx manager of another node, the
+// payloads are encoded like on the wire
+type fakeTxClient struct {
+	remote *cluster.TxManager
+	err    error
+}
+
+func (c *fakeTxClient) OpenTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	if c.err != nil {
+		return c.err
+	}
+	raw, err := json.Marshal(tx.Payload)
+	if err != nil {
+		return err
+	}
+	pl, err := federation.UnmarshalTransaction(tx.Type, raw)
+	if err != nil {
+		return err
+	}
+	data, err := c.remote.IncomingBeginTransaction(ctx,
+		&cluster.Transaction{ID: tx.ID, Type: tx.Type, Payload: pl, Deadline: tx.Deadline})
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	var res struct{ Payload json.RawMessage }
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	tx.Payload = res.Payload
+	return nil
+}
+
+func (c *fakeTxClient) AbortTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	c.remote.IncomingAbortTransaction(ctx, tx)
+	return nil
+}
+
+func (c *fakeTxClient) CommitTransaction(ctx context.Context, host string, tx *cluster.Transaction) error {
+	return c.remote.IncomingCommitTransaction(ctx, tx)
+}
+
+type fakePeers struct {
+	peers map[string]federation.Peer
+}
+
+func (f *fakePeers) ApplyPeer(peer federation.Peer) error {
+	f.peers[peer.Name] = peer
+	return nil
+}
+
+func (f *fakePeers) ApplyDeletePeer(name string) { delete(f.peers, name) }
+
+func newTestDistributedRepos(t *testing.T) (*DistributedRepo, *DistributedRepo, *fakeTxClient) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	local, err := NewRepo(ctx, t.TempDir(), logger)
+	require.Nil(t, err)
+	t.Cleanup(func() { local.Shutdown(ctx) })
+	remoteLocal, err := NewRepo(ctx, t.TempDir(), logger)
+	require.Nil(t, err)
+	t.Cleanup(func() { remoteLocal.Shutdown(ctx) })
+
+	client := &fakeTxClient{}
+	remoteClient := &fakeTxClient{}
+	repo := NewDistributedRepo(client, &fakeMembers{names: []string{"node1", "node2"}},
+		local, logger)
+	remote := NewDistributedRepo(remoteClient, &fakeMembers{names: []string{"node2", "node1"}},
+		remoteLocal, logger)
+	client.remote, remoteClient.remote = remote.TxManager(), repo.TxManager()
+	return repo, remote, client
+}
+
+func TestDistributedRepo(t *testing.T) {
+	eu := federation.Peer{Name: "eu", URL: "https://eu.example.com", APIKey: "secret"}
+	us := federation.Peer{Name: "us", URL: "https://us.example.com"}
+
+	t.Run("peers are stored on all nodes", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		applied := &fakePeers{peers: map[string]federation.Peer{}}
+		remote.SetPeers(applied)
+
+		require.Nil(t, repo.PutPeer(eu))
+		require.Nil(t, repo.PutPeer(us))
+		require.Nil(t, repo.DeletePeer("us"))
+
+		assert.Equal(t, map[string]federation.Peer{"eu": eu}, applied.peers)
+		for _, r := range []*Repo{repo.Repo, remote.Repo} {
+			peers, err := r.LoadPeers()
+			require.Nil(t, err)
+			assert.Equal(t, []federation.Peer{eu}, peers)
+		}
+	})
+
+	t.Run("peers are not stored if a node fails", func(t *testing.T) {
+		repo, remote, client := newTestDistributedRepos(t)
+		client.err = errors.New("node2 is down")
+
+		assert.NotNil(t, repo.PutPeer(eu))
+		for _, r := range []*Repo{repo.Repo, remote.Repo} {
+			peers, err := r.LoadPeers()
+			require.Nil(t, err)
+			assert.Empty(t, peers)
+		}
+	})
+
+	t.Run("a node without peers takes over the peers of the others", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		require.Nil(t, remote.Repo.PutPeer(eu))
+
+		peers, err := repo.LoadPeers()
+		require.Nil(t, err)
+		assert.Equal(t, []federation.Peer{eu}, peers)
+		peers, err = repo.Repo.LoadPeers()
+		require.Nil(t, err)
+		assert.Equal(t, []federation.Peer{eu}, peers)
+	})
+
+	t.Run("a node with peers keeps them", func(t *testing.T) {
+		repo, remote, _ := newTestDistributedRepos(t)
+		require.Nil(t, repo.Repo.PutPeer(us))
+		require.Nil(t, remote.Repo.PutPeer(eu))
+
+		peers, err := repo.LoadPeers()
+		require.Nil(t, err)
+		assert.Equal(t, []federation.Peer{us}, peers)
+	})
+}
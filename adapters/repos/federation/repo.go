@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+const (
+	federationDir = "federation"
+	peersBucket   = "peers"
+)
+
+// Repo stores the federation peers registered through the api in a
+// dedicated bucket, see federation.Repo. Peers are kept under their name as
+// JSON, including their api key.
+type Repo struct {
+	store  *lsmkv.Store
+	bucket *lsmkv.Bucket
+}
+
+func NewRepo(ctx context.Context, rootPath string, logger logrus.FieldLogger) (*Repo, error) {
+	store, err := lsmkv.New(path.Join(rootPath, federationDir), rootPath, logger, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init federation store: %w", err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, peersBucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("create federation peers bucket: %w", err)
+	}
+
+	return &Repo{store: store, bucket: store.Bucket(peersBucket)}, nil
+}
+
+func (r *Repo) LoadPeers() ([]federation.Peer, error) {
+	cursor := r.bucket.Cursor()
+	defer cursor.Close()
+
+	var peers []federation.Peer
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		var peer federation.StoredPeer
+		if err := json.Unmarshal(v, &peer); err != nil {
+			return nil, fmt.Errorf("unmarshal federation peer %q: %w", k, err)
+		}
+		peers = append(peers, peer.ToPeer())
+	}
+	return peers, nil
+}
+
+func (r *Repo) PutPeer(peer federation.Peer) error {
+	data, err := json.Marshal(peer.Stored())
+	if err != nil {
+		return err
+	}
+	if err := r.bucket.Put([]byte(peer.Name), data); err != nil {
+		return err
+	}
+	// changes are rare, don't keep them only in the memtable and its log
+	return r.bucket.FlushAndSwitch()
+}
+
+func (r *Repo) DeletePeer(name string) error {
+	if err := r.bucket.Delete([]byte(name)); err != nil {
+		return err
+	}
+	return r.bucket.FlushAndSwitch()
+}
+
+func (r *Repo) Shutdown(ctx context.Context) error {
+	return r.store.Shutdown(ctx)
+}
@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/federation"
+)
+
+func TestRepo(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	repo, err := NewRepo(ctx, dir, logger)
+	require.Nil(t, err)
+
+	eu := federation.Peer{Name: "eu", URL: "https://eu.example.com", APIKey: "secret"}
+	us := federation.Peer{Name: "us", URL: "https://us.example.com",
+		GRPCAddress: "us.example.com:50051", TimeoutSeconds: 5}
+	require.Nil(t, repo.PutPeer(eu))
+	require.Nil(t, repo.PutPeer(us))
+	require.Nil(t, repo.DeletePeer("us"))
+	require.Nil(t, repo.Shutdown(ctx))
+
+	repo, err = NewRepo(ctx, dir, logger)
+	require.Nil(t, err)
+	defer repo.Shutdown(ctx)
+
+	peers, err := repo.LoadPeers()
+	require.Nil(t, err)
+	assert.Equal(t, []federation.Peer{eu}, peers)
+}
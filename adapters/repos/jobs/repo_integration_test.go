@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+func Test_JobsRepo(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+
+	started := time.Now().UTC().Truncate(time.Second)
+	completed := started.Add(time.Minute)
+	one := &jobs.Job{
+		ID: "one", Type: jobs.TypeBulkImport, Target: "Article",
+		Status: jobs.StatusStarted, StartedAt: started, UpdatedAt: started,
+	}
+	two := &jobs.Job{
+		ID: "two", Type: jobs.TypeBackup, Target: "s3/backup",
+		Status: jobs.StatusSuccess, Progress: 100, StartedAt: started,
+		UpdatedAt: completed, CompletedAt: &completed,
+	}
+
+	t.Run("storing jobs", func(t *testing.T) {
+		require.Nil(t, r.Put(ctx, one))
+		require.Nil(t, r.Put(ctx, two))
+	})
+
+	t.Run("jobs survive a restart", func(t *testing.T) {
+		require.Nil(t, r.Close())
+		r, err = NewRepo(dirName, logger)
+		require.Nil(t, err)
+
+		res, err := r.List(ctx)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []*jobs.Job{one, two}, res)
+	})
+
+	t.Run("deleting jobs", func(t *testing.T) {
+		require.Nil(t, r.Delete(ctx, "one", "unknown"))
+
+		res, err := r.List(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, []*jobs.Job{two}, res)
+	})
+}
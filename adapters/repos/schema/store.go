@@ -35,6 +35,7 @@ var (
 	keyMetaClass         = []byte{eTypeMeta, 0}
 	keyShardingState     = []byte{eTypeSharingState, 0}
 	keyConfig            = []byte{eTypeConfig, 0}
+	keyAliases           = []byte{eTypeAliases, 0}
 	_Version         int = 2
 )
 
@@ -44,6 +45,7 @@ const (
 	eTypeClass        byte = 2
 	eTypeShard        byte = 4
 	eTypeMeta         byte = 5
+	eTypeAliases      byte = 6
 	eTypeSharingState byte = 15
 )
 
@@ -59,6 +61,7 @@ It ensures that each class has a dedicated bucket, which includes metadata, and
 
 Schema Structure:
   - Config: contains metadata related to parsing the schema
+  - Aliases: maps class aliases to the classes they point to
   - Nested buckets for each class
 
 Schema Structure for a class Bucket:
@@ -314,6 +317,42 @@ func (r *store) DeleteShards(_ context.Context, class string, shards []string) e
 	return r.db.Update(f)
 }
 
+// SaveAliases replaces all class aliases
+func (r *store) SaveAliases(_ context.Context, aliases map[string]string) error {
+	f := func(tx *bolt.Tx) error {
+		return saveAliases(tx.Bucket(schemaBucket), aliases)
+	}
+	return r.db.Update(f)
+}
+
+func saveAliases(root *bolt.Bucket, aliases map[string]string) error {
+	if len(aliases) == 0 {
+		return root.Delete(keyAliases)
+	}
+	data, err := json.Marshal(aliases)
+	if err != nil {
+		return fmt.Errorf("marshal aliases: %w", err)
+	}
+	if err := root.Put(keyAliases, data); err != nil {
+		return fmt.Errorf("write aliases: %w", err)
+	}
+	return nil
+}
+
+func (r *store) loadAliases() (aliases map[string]string, err error) {
+	err = r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(schemaBucket).Get(keyAliases)
+		if len(data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(data, &aliases)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal aliases: %w", err)
+	}
+	return aliases, nil
+}
+
 // Load loads the complete schema from the persistent storage
 func (r *store) Load(ctx context.Context) (ucs.State, error) {
 	state := ucs.NewState(32)
@@ -345,6 +384,11 @@ func (r *store) Load(ctx context.Context) (ucs.State, error) {
 		state.ObjectSchema.Classes = append(state.ObjectSchema.Classes, &cls)
 		state.ShardingState[cls.Class] = &ss
 	}
+	aliases, err := r.loadAliases()
+	if err != nil {
+		return state, err
+	}
+	state.Aliases = aliases
 	return state, nil
 }
 
@@ -445,7 +489,7 @@ func (r *store) saveAllTx(ctx context.Context, root *bolt.Bucket, ss ucs.State)
 			}
 		}
 
-		return nil
+		return saveAliases(root, ss.Aliases)
 	}
 }
 
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tiering
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Blobs keeps the bytes of externally stored blob properties in an
+// S3-compatible bucket, all keys are placed below the configured prefix
+type Blobs struct {
+	s3     *S3
+	prefix string
+}
+
+func NewBlobs(cfg config.BlobStorage) (*Blobs, error) {
+	s3, err := NewS3Bucket(cfg.Endpoint, cfg.Bucket, cfg.UseSSL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Blobs{s3: s3, prefix: cfg.Prefix}, nil
+}
+
+func (b *Blobs) PutBlob(ctx context.Context, key string, data []byte) error {
+	return b.s3.PutSegment(ctx, b.prefix+key, bytes.NewReader(data), int64(len(data)))
+}
+
+// OpenBlob returns a reader on the blob and its size in bytes
+func (b *Blobs) OpenBlob(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	obj, err := b.s3.OpenObject(ctx, b.prefix+key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, 0, fmt.Errorf("stat object %q: %w", key, err)
+	}
+
+	return obj, info.Size, nil
+}
+
+// DeleteBlobs removes all blobs whose key starts with prefix
+func (b *Blobs) DeleteBlobs(ctx context.Context, prefix string) error {
+	objects := b.s3.client.ListObjects(ctx, b.s3.bucket, minio.ListObjectsOptions{
+		Prefix:    b.prefix + prefix,
+		Recursive: true,
+	})
+	for obj := range objects {
+		if obj.Err != nil {
+			return fmt.Errorf("list objects %q: %w", prefix, obj.Err)
+		}
+		if err := b.s3.DeleteSegment(ctx, obj.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
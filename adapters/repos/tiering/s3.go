@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tiering contains the remote stores which cold LSM segments and
+// offloaded tenants can be moved to, and bulk imports can be read from
+package tiering
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+// S3 stores tiered segments in an S3-compatible bucket. Credentials are
+// taken from the standard AWS environment variables or the instance role.
+type S3 struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3(cfg config.SegmentTiering) (*S3, error) {
+	return NewS3Bucket(cfg.Endpoint, cfg.Bucket, cfg.UseSSL)
+}
+
+// NewS3Bucket creates a store for the given bucket, the default AWS endpoint
+// is used if endpoint is empty
+func NewS3Bucket(endpoint, bucket string, useSSL bool) (*S3, error) {
+	region := os.Getenv("AWS_REGION")
+	if len(region) == 0 {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	var creds *credentials.Credentials
+	if (os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "") &&
+		(os.Getenv("AWS_SECRET_ACCESS_KEY") != "" || os.Getenv("AWS_SECRET_KEY") != "") {
+		creds = credentials.NewEnvAWS()
+	} else {
+		creds = credentials.NewIAM("")
+		if _, err := creds.Get(); err != nil {
+			// can be anonymous access
+			creds = credentials.NewEnvAWS()
+		}
+	}
+
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Region: region,
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+
+	return &S3{client: client, bucket: bucket}, nil
+}
+
+func (s *S3) PutSegment(ctx context.Context, key string, r io.Reader, size int64) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size,
+		minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return fmt.Errorf("put object %q: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *S3) ReadSegmentAt(ctx context.Context, key string, p []byte, off int64) error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(off, off+int64(len(p))-1); err != nil {
+		return fmt.Errorf("set range: %w", err)
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, key, opts)
+	if err != nil {
+		return fmt.Errorf("get object %q: %w", key, err)
+	}
+	defer obj.Close()
+
+	if _, err := io.ReadFull(obj, p); err != nil {
+		return fmt.Errorf("read object %q at %d: %w", key, off, err)
+	}
+
+	return nil
+}
+
+func (s *S3) DeleteSegment(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key,
+		minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// OpenObject returns a reader on the object, it fails if the object does not
+// exist
+func (s *S3) OpenObject(ctx context.Context, key string) (*minio.Object, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get object %q: %w", key, err)
+	}
+
+	// the object is only requested once it is read from
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, fmt.Errorf("stat object %q: %w", key, err)
+	}
+
+	return obj, nil
+}
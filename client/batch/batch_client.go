@@ -18,6 +18,7 @@ package batch
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -45,6 +46,12 @@ type ClientService interface {
 
 	BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsDeleteOK, error)
 
+	BatchObjectsMerge(params *BatchObjectsMergeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsMergeOK, error)
+
+	BatchObjectsStream(params *BatchObjectsStreamParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*BatchObjectsStreamOK, error)
+
+	BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error)
+
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -132,6 +139,129 @@ func (a *Client) BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+BatchObjectsMerge patches existing objects as a batch
+
+Merge the provided properties into existing objects in bulk. Properties which are not provided are left untouched, the existing vector is kept unless a new one is supplied. Objects which carry a lastUpdateTimeUnix are only patched if it still matches the version of the stored object.
+*/
+func (a *Client) BatchObjectsMerge(params *BatchObjectsMergeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsMergeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsMergeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.merge",
+		Method:             "PATCH",
+		PathPattern:        "/batch/objects",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsMergeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsMergeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.merge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchObjectsStream imports objects as a stream
+
+Imports newline-delimited JSON objects in chunks of chunkSize. Each chunk is acknowledged with a line of the response containing the errors of its objects and the current backpressure. Over HTTP/2 acknowledgements are sent as soon as a chunk is imported, over HTTP/1.x they are sent at the end.
+*/
+func (a *Client) BatchObjectsStream(params *BatchObjectsStreamParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*BatchObjectsStreamOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsStreamParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.stream",
+		Method:             "POST",
+		PathPattern:        "/batch/objects/stream",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/x-ndjson"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsStreamReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsStreamOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.stream: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchObjectsUpdate updates objects based on a match filter as a batch
+
+Update Objects in bulk that match a certain filter. The given properties are set on every matched object and the listed properties are removed from it. The update is carried out on the shards holding the objects, their vectors are kept as they are.
+*/
+func (a *Client) BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.update",
+		Method:             "PUT",
+		PathPattern:        "/batch/objects",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchReferencesCreate creates new cross references between arbitrary classes in bulk
 
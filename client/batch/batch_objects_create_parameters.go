@@ -81,6 +81,30 @@ type BatchObjectsCreateParams struct {
 	*/
 	ConsistencyLevel *string
 
+	/* Dedup.
+
+	   Detect duplicates of existing objects and of earlier objects of the batch, and skip them, merge them into the original or import them with a reference to the original. Possible values: skip, merge, link.
+	*/
+	Dedup *string
+
+	/* DedupBy.
+
+	   What makes two objects duplicates, either equal properties or an equal vector. Defaults to content. Possible values: content, vector.
+	*/
+	DedupBy *string
+
+	/* DedupLinkProperty.
+
+	   Reference property through which duplicates point to their original, required if dedup is link.
+	*/
+	DedupLinkProperty *string
+
+	/* IDProperties.
+
+	   Comma-separated list of properties the ids of objects without an id are derived from, as UUIDv5 of the class name and the property values. Overrides the idProperties of the class.
+	*/
+	IDProperties *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -156,6 +180,50 @@ func (o *BatchObjectsCreateParams) SetConsistencyLevel(consistencyLevel *string)
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithDedup adds the dedup to the batch objects create params
+func (o *BatchObjectsCreateParams) WithDedup(dedup *string) *BatchObjectsCreateParams {
+	o.SetDedup(dedup)
+	return o
+}
+
+// SetDedup adds the dedup to the batch objects create params
+func (o *BatchObjectsCreateParams) SetDedup(dedup *string) {
+	o.Dedup = dedup
+}
+
+// WithDedupBy adds the dedupBy to the batch objects create params
+func (o *BatchObjectsCreateParams) WithDedupBy(dedupBy *string) *BatchObjectsCreateParams {
+	o.SetDedupBy(dedupBy)
+	return o
+}
+
+// SetDedupBy adds the dedupBy to the batch objects create params
+func (o *BatchObjectsCreateParams) SetDedupBy(dedupBy *string) {
+	o.DedupBy = dedupBy
+}
+
+// WithDedupLinkProperty adds the dedupLinkProperty to the batch objects create params
+func (o *BatchObjectsCreateParams) WithDedupLinkProperty(dedupLinkProperty *string) *BatchObjectsCreateParams {
+	o.SetDedupLinkProperty(dedupLinkProperty)
+	return o
+}
+
+// SetDedupLinkProperty adds the dedupLinkProperty to the batch objects create params
+func (o *BatchObjectsCreateParams) SetDedupLinkProperty(dedupLinkProperty *string) {
+	o.DedupLinkProperty = dedupLinkProperty
+}
+
+// WithIDProperties adds the idProperties to the batch objects create params
+func (o *BatchObjectsCreateParams) WithIDProperties(idProperties *string) *BatchObjectsCreateParams {
+	o.SetIDProperties(idProperties)
+	return o
+}
+
+// SetIDProperties adds the idProperties to the batch objects create params
+func (o *BatchObjectsCreateParams) SetIDProperties(idProperties *string) {
+	o.IDProperties = idProperties
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -184,6 +252,74 @@ func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg s
 		}
 	}
 
+	if o.Dedup != nil {
+
+		// query param dedup
+		var qrDedup string
+
+		if o.Dedup != nil {
+			qrDedup = *o.Dedup
+		}
+		qDedup := qrDedup
+		if qDedup != "" {
+
+			if err := r.SetQueryParam("dedup", qDedup); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.DedupBy != nil {
+
+		// query param dedupBy
+		var qrDedupBy string
+
+		if o.DedupBy != nil {
+			qrDedupBy = *o.DedupBy
+		}
+		qDedupBy := qrDedupBy
+		if qDedupBy != "" {
+
+			if err := r.SetQueryParam("dedupBy", qDedupBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.DedupLinkProperty != nil {
+
+		// query param dedupLinkProperty
+		var qrDedupLinkProperty string
+
+		if o.DedupLinkProperty != nil {
+			qrDedupLinkProperty = *o.DedupLinkProperty
+		}
+		qDedupLinkProperty := qrDedupLinkProperty
+		if qDedupLinkProperty != "" {
+
+			if err := r.SetQueryParam("dedupLinkProperty", qDedupLinkProperty); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.IDProperties != nil {
+
+		// query param idProperties
+		var qrIDProperties string
+
+		if o.IDProperties != nil {
+			qrIDProperties = *o.IDProperties
+		}
+		qIDProperties := qrIDProperties
+		if qIDProperties != "" {
+
+			if err := r.SetQueryParam("idProperties", qIDProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
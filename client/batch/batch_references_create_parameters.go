@@ -86,6 +86,12 @@ type BatchReferencesCreateParams struct {
 	*/
 	ConsistencyLevel *string
 
+	/* Validation.
+
+	   Controls how references are validated before they are imported. 'skipValidation' only parses the beacons, 'validateSchemaOnly' checks the source property and target class against the schema, 'validateExistence' additionally checks that the source and target objects exist. Without this parameter only references involving multi-tenant classes are checked.
+	*/
+	Validation *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -161,6 +167,17 @@ func (o *BatchReferencesCreateParams) SetConsistencyLevel(consistencyLevel *stri
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithValidation adds the validation to the batch references create params
+func (o *BatchReferencesCreateParams) WithValidation(validation *string) *BatchReferencesCreateParams {
+	o.SetValidation(validation)
+	return o
+}
+
+// SetValidation adds the validation to the batch references create params
+func (o *BatchReferencesCreateParams) SetValidation(validation *string) {
+	o.Validation = validation
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -191,6 +208,23 @@ func (o *BatchReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, re
 		}
 	}
 
+	if o.Validation != nil {
+
+		// query param validation
+		var qrValidation string
+
+		if o.Validation != nil {
+			qrValidation = *o.Validation
+		}
+		qValidation := qrValidation
+		if qValidation != "" {
+
+			if err := r.SetQueryParam("validation", qValidation); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -64,6 +64,12 @@ func (o *ObjectsClassDeleteReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewObjectsClassDeleteConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassDeleteUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -385,6 +391,74 @@ func (o *ObjectsClassDeleteNotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewObjectsClassDeleteConflict creates a ObjectsClassDeleteConflict with default headers values
+func NewObjectsClassDeleteConflict() *ObjectsClassDeleteConflict {
+	return &ObjectsClassDeleteConflict{}
+}
+
+/*
+ObjectsClassDeleteConflict describes a response with status code 409, with default header values.
+
+The object has been changed since the version given in the If-Match header.
+*/
+type ObjectsClassDeleteConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class delete conflict response has a 2xx status code
+func (o *ObjectsClassDeleteConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class delete conflict response has a 3xx status code
+func (o *ObjectsClassDeleteConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class delete conflict response has a 4xx status code
+func (o *ObjectsClassDeleteConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class delete conflict response has a 5xx status code
+func (o *ObjectsClassDeleteConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class delete conflict response a status code equal to that given
+func (o *ObjectsClassDeleteConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the objects class delete conflict response
+func (o *ObjectsClassDeleteConflict) Code() int {
+	return 409
+}
+
+func (o *ObjectsClassDeleteConflict) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassDeleteConflict) String() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassDeleteConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassDeleteConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassDeleteUnprocessableEntity creates a ObjectsClassDeleteUnprocessableEntity with default headers values
 func NewObjectsClassDeleteUnprocessableEntity() *ObjectsClassDeleteUnprocessableEntity {
 	return &ObjectsClassDeleteUnprocessableEntity{}
@@ -64,6 +64,12 @@ func (o *ObjectsClassPatchReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewObjectsClassPatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -385,6 +391,74 @@ func (o *ObjectsClassPatchNotFound) readResponse(response runtime.ClientResponse
 	return nil
 }
 
+// NewObjectsClassPatchConflict creates a ObjectsClassPatchConflict with default headers values
+func NewObjectsClassPatchConflict() *ObjectsClassPatchConflict {
+	return &ObjectsClassPatchConflict{}
+}
+
+/*
+ObjectsClassPatchConflict describes a response with status code 409, with default header values.
+
+The object has been changed since the version given in the If-Match header.
+*/
+type ObjectsClassPatchConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class patch conflict response has a 2xx status code
+func (o *ObjectsClassPatchConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class patch conflict response has a 3xx status code
+func (o *ObjectsClassPatchConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class patch conflict response has a 4xx status code
+func (o *ObjectsClassPatchConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class patch conflict response has a 5xx status code
+func (o *ObjectsClassPatchConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class patch conflict response a status code equal to that given
+func (o *ObjectsClassPatchConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the objects class patch conflict response
+func (o *ObjectsClassPatchConflict) Code() int {
+	return 409
+}
+
+func (o *ObjectsClassPatchConflict) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassPatchConflict) String() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassPatchConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPatchUnprocessableEntity creates a ObjectsClassPatchUnprocessableEntity with default headers values
 func NewObjectsClassPatchUnprocessableEntity() *ObjectsClassPatchUnprocessableEntity {
 	return &ObjectsClassPatchUnprocessableEntity{}
@@ -58,6 +58,12 @@ func (o *ObjectsClassPutReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewObjectsClassPutConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPutUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -86,6 +92,11 @@ ObjectsClassPutOK describes a response with status code 200, with default header
 Successfully received.
 */
 type ObjectsClassPutOK struct {
+
+	/* The version of the object, to be passed in the If-Match header of a conditional update or delete.
+	 */
+	ETag string
+
 	Payload *models.Object
 }
 
@@ -133,6 +144,13 @@ func (o *ObjectsClassPutOK) GetPayload() *models.Object {
 
 func (o *ObjectsClassPutOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
@@ -323,6 +341,74 @@ func (o *ObjectsClassPutNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewObjectsClassPutConflict creates a ObjectsClassPutConflict with default headers values
+func NewObjectsClassPutConflict() *ObjectsClassPutConflict {
+	return &ObjectsClassPutConflict{}
+}
+
+/*
+ObjectsClassPutConflict describes a response with status code 409, with default header values.
+
+The object has been changed since the version given in the If-Match header.
+*/
+type ObjectsClassPutConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class put conflict response has a 2xx status code
+func (o *ObjectsClassPutConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class put conflict response has a 3xx status code
+func (o *ObjectsClassPutConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class put conflict response has a 4xx status code
+func (o *ObjectsClassPutConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class put conflict response has a 5xx status code
+func (o *ObjectsClassPutConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class put conflict response a status code equal to that given
+func (o *ObjectsClassPutConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the objects class put conflict response
+func (o *ObjectsClassPutConflict) Code() int {
+	return 409
+}
+
+func (o *ObjectsClassPutConflict) Error() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassPutConflict) String() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutConflict  %+v", 409, o.Payload)
+}
+
+func (o *ObjectsClassPutConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPutConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPutUnprocessableEntity creates a ObjectsClassPutUnprocessableEntity with default headers values
 func NewObjectsClassPutUnprocessableEntity() *ObjectsClassPutUnprocessableEntity {
 	return &ObjectsClassPutUnprocessableEntity{}
@@ -18,6 +18,7 @@ package objects
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -41,8 +42,12 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ObjectsBlobsGet(params *ObjectsBlobsGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsBlobsGetOK, error)
+
 	ObjectsClassDelete(params *ObjectsClassDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassDeleteNoContent, error)
 
+	ObjectsClassExport(params *ObjectsClassExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassExportOK, error)
+
 	ObjectsClassGet(params *ObjectsClassGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassGetOK, error)
 
 	ObjectsClassHead(params *ObjectsClassHeadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassHeadNoContent, error)
@@ -67,6 +72,8 @@ type ClientService interface {
 
 	ObjectsList(params *ObjectsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsListOK, error)
 
+	ObjectsMultiGet(params *ObjectsMultiGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsMultiGetOK, error)
+
 	ObjectsPatch(params *ObjectsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsPatchNoContent, error)
 
 	ObjectsReferencesCreate(params *ObjectsReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsReferencesCreateOK, error)
@@ -82,6 +89,47 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ObjectsBlobsGet gets the raw bytes of a blob property
+
+Streams the raw bytes of a blob property, so large blobs don't have to be returned base64 encoded as part of the object.
+*/
+func (a *Client) ObjectsBlobsGet(params *ObjectsBlobsGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsBlobsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsBlobsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.blobs.get",
+		Method:             "GET",
+		PathPattern:        "/blobs/{className}/{id}/{propertyName}",
+		ProducesMediaTypes: []string{"application/json", "application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsBlobsGetReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsBlobsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.blobs.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsClassDelete deletes object based on its class and UUID
 
@@ -123,6 +171,47 @@ func (a *Client) ObjectsClassDelete(params *ObjectsClassDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+ObjectsClassExport exports all objects of a class
+
+Streams all objects of the class including their vectors. If the export fails after the response started, the error is sent in the X-Weaviate-Export-Error trailer.
+*/
+func (a *Client) ObjectsClassExport(params *ObjectsClassExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.export",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/export",
+		ProducesMediaTypes: []string{"application/json", "application/vnd.apache.arrow.stream", "application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsClassGet gets a specific object based on its class and UUID also available as websocket bus
 
@@ -615,6 +704,47 @@ func (a *Client) ObjectsList(params *ObjectsListParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+ObjectsMultiGet gets many objects at once
+
+Fetches many objects in one round trip, e.g. to hydrate the references of a result instead of getting each object on its own.
+*/
+func (a *Client) ObjectsMultiGet(params *ObjectsMultiGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsMultiGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsMultiGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.multi.get",
+		Method:             "POST",
+		PathPattern:        "/objects/_get",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsMultiGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsMultiGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.multi.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsPatch updates an object based on its UUID using patch semantics
 
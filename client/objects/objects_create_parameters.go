@@ -83,6 +83,12 @@ type ObjectsCreateParams struct {
 	*/
 	ConsistencyLevel *string
 
+	/* IDProperties.
+
+	   Comma-separated list of properties the ids of objects without an id are derived from, as UUIDv5 of the class name and the property values. Overrides the idProperties of the class.
+	*/
+	IDProperties *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -158,6 +164,17 @@ func (o *ObjectsCreateParams) SetConsistencyLevel(consistencyLevel *string) {
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithIDProperties adds the idProperties to the objects create params
+func (o *ObjectsCreateParams) WithIDProperties(idProperties *string) *ObjectsCreateParams {
+	o.SetIDProperties(idProperties)
+	return o
+}
+
+// SetIDProperties adds the idProperties to the objects create params
+func (o *ObjectsCreateParams) SetIDProperties(idProperties *string) {
+	o.IDProperties = idProperties
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -188,6 +205,23 @@ func (o *ObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.IDProperties != nil {
+
+		// query param idProperties
+		var qrIDProperties string
+
+		if o.IDProperties != nil {
+			qrIDProperties = *o.IDProperties
+		}
+		qIDProperties := qrIDProperties
+		if qIDProperties != "" {
+
+			if err := r.SetQueryParam("idProperties", qIDProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
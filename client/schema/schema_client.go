@@ -49,22 +49,64 @@ type ClientService interface {
 
 	SchemaObjectsDelete(params *SchemaObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDeleteOK, error)
 
+	SchemaObjectsDuplicatesDetect(params *SchemaObjectsDuplicatesDetectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDuplicatesDetectAccepted, error)
+
+	SchemaObjectsDuplicatesStatus(params *SchemaObjectsDuplicatesStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDuplicatesStatusOK, error)
+
 	SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGetOK, error)
 
+	SchemaObjectsProfile(params *SchemaObjectsProfileParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsProfileOK, error)
+
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteAccepted, error)
+
+	SchemaObjectsPropertiesRename(params *SchemaObjectsPropertiesRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesRenameNoContent, error)
+
+	SchemaObjectsPropertiesTokenization(params *SchemaObjectsPropertiesTokenizationParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesTokenizationAccepted, error)
+
+	SchemaObjectsReferencesVerify(params *SchemaObjectsReferencesVerifyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReferencesVerifyAccepted, error)
+
+	SchemaObjectsReferencesVerifyStatus(params *SchemaObjectsReferencesVerifyStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReferencesVerifyStatusOK, error)
+
+	SchemaObjectsRename(params *SchemaObjectsRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRenameNoContent, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
+	SchemaObjectsStopwordsGet(params *SchemaObjectsStopwordsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsGetOK, error)
+
+	SchemaObjectsStopwordsReplace(params *SchemaObjectsStopwordsReplaceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsReplaceOK, error)
+
+	SchemaObjectsStopwordsUpdate(params *SchemaObjectsStopwordsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsUpdateOK, error)
+
+	SchemaObjectsSuggest(params *SchemaObjectsSuggestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSuggestOK, error)
+
+	SchemaObjectsSynonymsGet(params *SchemaObjectsSynonymsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsGetOK, error)
+
+	SchemaObjectsSynonymsUpdate(params *SchemaObjectsSynonymsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsUpdateOK, error)
+
+	SchemaObjectsTieringGet(params *SchemaObjectsTieringGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsTieringGetOK, error)
+
+	SchemaObjectsTieringUpdate(params *SchemaObjectsTieringUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsTieringUpdateOK, error)
+
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	TenantsActivityGet(params *TenantsActivityGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsActivityGetOK, error)
+
+	TenantsActivityUpdate(params *TenantsActivityUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsActivityUpdateOK, *TenantsActivityUpdateAccepted, error)
+
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
 
 	TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error)
 
 	TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error)
 
+	TenantsQuotasUpdate(params *TenantsQuotasUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsQuotasUpdateOK, error)
+
+	TenantsRestore(params *TenantsRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsRestoreOK, *TenantsRestoreAccepted, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -224,6 +266,88 @@ func (a *Client) SchemaObjectsDelete(params *SchemaObjectsDeleteParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaObjectsDuplicatesDetect detects near duplicate objects
+
+Starts a job which finds objects of a class whose vectors are within the distance threshold of each other.
+*/
+func (a *Client) SchemaObjectsDuplicatesDetect(params *SchemaObjectsDuplicatesDetectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDuplicatesDetectAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsDuplicatesDetectParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.duplicates.detect",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/duplicates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsDuplicatesDetectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsDuplicatesDetectAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.duplicates.detect: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsDuplicatesStatus gets the near duplicate detection report
+
+Returns the report of the current or last near-duplicate detection of a class.
+*/
+func (a *Client) SchemaObjectsDuplicatesStatus(params *SchemaObjectsDuplicatesStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDuplicatesStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsDuplicatesStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.duplicates.status",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/duplicates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsDuplicatesStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsDuplicatesStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.duplicates.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsGet gets a single class from the schema
 */
@@ -263,6 +387,47 @@ func (a *Client) SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runti
 	panic(msg)
 }
 
+/*
+SchemaObjectsProfile profiles the data of a class
+
+Returns data quality statistics of a sample of the objects in the local shards of a class.
+*/
+func (a *Client) SchemaObjectsProfile(params *SchemaObjectsProfileParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsProfileOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsProfileParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.profile",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/profile",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsProfileReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsProfileOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.profile: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsPropertiesAdd adds a property to an object class
 */
@@ -303,22 +468,24 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 }
 
 /*
-SchemaObjectsShardsGet gets the shards status of an object class
+SchemaObjectsPropertiesDelete deletes a property
+
+Removes a property from a class. The data of the property is removed in the background, which can be followed in the jobs API.
 */
-func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
+func (a *Client) SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesDeleteAccepted, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsGetParams()
+		params = NewSchemaObjectsPropertiesDeleteParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.get",
-		Method:             "GET",
-		PathPattern:        "/schema/{className}/shards",
+		ID:                 "schema.objects.properties.delete",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/delete",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
+		Reader:             &SchemaObjectsPropertiesDeleteReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -331,33 +498,35 @@ func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, au
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsGetOK)
+	success, ok := result.(*SchemaObjectsPropertiesDeleteAccepted)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsShardsUpdate Update shard status of an Object Class
+SchemaObjectsPropertiesRename renames a property
+
+Renames a property of a class. Stored objects are migrated in the background.
 */
-func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
+func (a *Client) SchemaObjectsPropertiesRename(params *SchemaObjectsPropertiesRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesRenameNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsUpdateParams()
+		params = NewSchemaObjectsPropertiesRenameParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.update",
-		Method:             "PUT",
-		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ID:                 "schema.objects.properties.rename",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/rename",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsPropertiesRenameReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -370,35 +539,35 @@ func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdatePara
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	success, ok := result.(*SchemaObjectsPropertiesRenameNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.rename: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsUpdate updates settings of an existing schema class
+SchemaObjectsPropertiesTokenization changes the tokenization of a property
 
-Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.
+Changes the tokenization of a text property. The property is reindexed in the background, which can be followed in the jobs API.
 */
-func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+func (a *Client) SchemaObjectsPropertiesTokenization(params *SchemaObjectsPropertiesTokenizationParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesTokenizationAccepted, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsUpdateParams()
+		params = NewSchemaObjectsPropertiesTokenizationParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.update",
-		Method:             "PUT",
-		PathPattern:        "/schema/{className}",
+		ID:                 "schema.objects.properties.tokenization",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/tokenization",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsPropertiesTokenizationReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -411,33 +580,35 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsUpdateOK)
+	success, ok := result.(*SchemaObjectsPropertiesTokenizationAccepted)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.tokenization: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsCreate Create a new tenant for a specific class
+SchemaObjectsReferencesVerify verifies the references of a class
+
+Starts a job which verifies that the references of a class point to existing objects.
 */
-func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error) {
+func (a *Client) SchemaObjectsReferencesVerify(params *SchemaObjectsReferencesVerifyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReferencesVerifyAccepted, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsCreateParams()
+		params = NewSchemaObjectsReferencesVerifyParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.create",
+		ID:                 "schema.objects.references.verify",
 		Method:             "POST",
-		PathPattern:        "/schema/{className}/tenants",
+		PathPattern:        "/schema/{className}/references/verify",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsCreateReader{formats: a.formats},
+		Reader:             &SchemaObjectsReferencesVerifyReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -450,33 +621,35 @@ func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsCreateOK)
+	success, ok := result.(*SchemaObjectsReferencesVerifyAccepted)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.references.verify: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsDelete delete tenants from a specific class
+SchemaObjectsReferencesVerifyStatus gets the reference verification report
+
+Returns the report of the current or last verification of the references of a class.
 */
-func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error) {
+func (a *Client) SchemaObjectsReferencesVerifyStatus(params *SchemaObjectsReferencesVerifyStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReferencesVerifyStatusOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsDeleteParams()
+		params = NewSchemaObjectsReferencesVerifyStatusParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.delete",
-		Method:             "DELETE",
-		PathPattern:        "/schema/{className}/tenants",
+		ID:                 "schema.objects.references.verify.status",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/references/verify",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsDeleteReader{formats: a.formats},
+		Reader:             &SchemaObjectsReferencesVerifyStatusReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -489,33 +662,74 @@ func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsDeleteOK)
+	success, ok := result.(*SchemaObjectsReferencesVerifyStatusOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.references.verify.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsGet get all tenants from a specific class
+SchemaObjectsRename renames a class
+
+Renames a class, the previous name stays usable as an alias of the class.
 */
-func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error) {
+func (a *Client) SchemaObjectsRename(params *SchemaObjectsRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRenameNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsGetParams()
+		params = NewSchemaObjectsRenameParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.get",
+		ID:                 "schema.objects.rename",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/rename",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRenameReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRenameNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.rename: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShardsGet gets the shards status of an object class
+*/
+func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShardsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shards.get",
 		Method:             "GET",
-		PathPattern:        "/schema/{className}/tenants",
+		PathPattern:        "/schema/{className}/shards",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsGetReader{formats: a.formats},
+		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -528,13 +742,704 @@ func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAut
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsGetOK)
+	success, ok := result.(*SchemaObjectsShardsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShardsUpdate Update shard status of an Object Class
+*/
+func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShardsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shards.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsStopwordsGet gets the stopwords of a class
+
+Returns the stopword config of a class.
+*/
+func (a *Client) SchemaObjectsStopwordsGet(params *SchemaObjectsStopwordsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStopwordsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.stopwords.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/stopwords",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStopwordsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStopwordsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.stopwords.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsStopwordsReplace replaces the stopwords of a class
+
+Replaces the stopword config of a class. If the version is set, the change fails with a conflict if the config was changed in the meantime.
+*/
+func (a *Client) SchemaObjectsStopwordsReplace(params *SchemaObjectsStopwordsReplaceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsReplaceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStopwordsReplaceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.stopwords.replace",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/stopwords",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStopwordsReplaceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStopwordsReplaceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.stopwords.replace: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsStopwordsUpdate changes the stopwords of a class
+
+Adds and removes stopwords of a class. If the version is set, the change fails with a conflict if the config was changed in the meantime.
+*/
+func (a *Client) SchemaObjectsStopwordsUpdate(params *SchemaObjectsStopwordsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStopwordsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStopwordsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.stopwords.update",
+		Method:             "PATCH",
+		PathPattern:        "/schema/{className}/stopwords",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStopwordsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStopwordsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.stopwords.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsSuggest suggests terms of a property
+
+Returns the most frequent terms of a property starting with the prefix, for autocompletion of search input.
+*/
+func (a *Client) SchemaObjectsSuggest(params *SchemaObjectsSuggestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSuggestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsSuggestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.suggest",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/suggest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsSuggestReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsSuggestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.suggest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsSynonymsGet gets the synonyms of a class
+
+Returns the synonym rules of a class.
+*/
+func (a *Client) SchemaObjectsSynonymsGet(params *SchemaObjectsSynonymsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsSynonymsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.synonyms.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/synonyms",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsSynonymsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsSynonymsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.synonyms.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsSynonymsUpdate replaces the synonyms of a class
+
+Replaces all synonym rules of a class.
+*/
+func (a *Client) SchemaObjectsSynonymsUpdate(params *SchemaObjectsSynonymsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsSynonymsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsSynonymsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.synonyms.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/synonyms",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsSynonymsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsSynonymsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.synonyms.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsTieringGet gets the tiering status of a class
+
+Returns the tiering status of the local shards of a class.
+*/
+func (a *Client) SchemaObjectsTieringGet(params *SchemaObjectsTieringGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsTieringGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsTieringGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.tiering.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tiering",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsTieringGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsTieringGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.tiering.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsTieringUpdate pins the segments of a class
+
+Restores all tiered segments of the class and keeps them local, or allows tiering again.
+*/
+func (a *Client) SchemaObjectsTieringUpdate(params *SchemaObjectsTieringUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsTieringUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsTieringUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.tiering.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/tiering",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsTieringUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsTieringUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.tiering.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsUpdate updates settings of an existing schema class
+
+Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.
+*/
+func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsActivityGet gets the offloading status of tenants
+
+Returns the offloading status of the tenants of a class on this node.
+*/
+func (a *Client) TenantsActivityGet(params *TenantsActivityGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsActivityGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsActivityGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.activity.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/activity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsActivityGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsActivityGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.activity.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsActivityUpdate changes the offloading status of tenants
+
+Moves the given tenants to the given status.
+*/
+func (a *Client) TenantsActivityUpdate(params *TenantsActivityUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsActivityUpdateOK, *TenantsActivityUpdateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsActivityUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.activity.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/tenants/activity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsActivityUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *TenantsActivityUpdateOK:
+		return value, nil, nil
+	case *TenantsActivityUpdateAccepted:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsCreate Create a new tenant for a specific class
+*/
+func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsDelete delete tenants from a specific class
+*/
+func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsGet get all tenants from a specific class
+*/
+func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsQuotasUpdate updates the quotas of tenants
+
+Replaces the quotas of existing tenants and responds with the tenants of the class including their current usage. Quotas for new tenants are set when they are created.
+*/
+func (a *Client) TenantsQuotasUpdate(params *TenantsQuotasUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsQuotasUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsQuotasUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.quotas.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/tenants/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsQuotasUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsQuotasUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.quotas.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsRestore restores offloaded tenants
+
+Replaces the empty local shards of tenants with the copies which other nodes offloaded. The restored tenants are cold until they are used.
+*/
+func (a *Client) TenantsRestore(params *TenantsRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsRestoreOK, *TenantsRestoreAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.restore",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *TenantsRestoreOK:
+		return value, nil, nil
+	case *TenantsRestoreAccepted:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
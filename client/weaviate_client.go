@@ -21,14 +21,23 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/weaviate/weaviate/client/admin"
+	"github.com/weaviate/weaviate/client/aliases"
+	"github.com/weaviate/weaviate/client/apikeys"
+	"github.com/weaviate/weaviate/client/authz"
 	"github.com/weaviate/weaviate/client/backups"
 	"github.com/weaviate/weaviate/client/batch"
+	"github.com/weaviate/weaviate/client/bulk_imports"
 	"github.com/weaviate/weaviate/client/classifications"
+	"github.com/weaviate/weaviate/client/cluster"
+	"github.com/weaviate/weaviate/client/federation"
 	"github.com/weaviate/weaviate/client/graphql"
+	"github.com/weaviate/weaviate/client/jobs"
 	"github.com/weaviate/weaviate/client/meta"
 	"github.com/weaviate/weaviate/client/nodes"
 	"github.com/weaviate/weaviate/client/objects"
 	"github.com/weaviate/weaviate/client/operations"
+	"github.com/weaviate/weaviate/client/replication"
 	"github.com/weaviate/weaviate/client/schema"
 	"github.com/weaviate/weaviate/client/well_known"
 )
@@ -75,14 +84,23 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli := new(Weaviate)
 	cli.Transport = transport
+	cli.Admin = admin.New(transport, formats)
+	cli.Aliases = aliases.New(transport, formats)
+	cli.Apikeys = apikeys.New(transport, formats)
+	cli.Authz = authz.New(transport, formats)
 	cli.Backups = backups.New(transport, formats)
 	cli.Batch = batch.New(transport, formats)
+	cli.BulkImports = bulk_imports.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
+	cli.Cluster = cluster.New(transport, formats)
+	cli.Federation = federation.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
+	cli.Jobs = jobs.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
+	cli.Replication = replication.New(transport, formats)
 	cli.Schema = schema.New(transport, formats)
 	cli.WellKnown = well_known.New(transport, formats)
 	return cli
@@ -129,14 +147,30 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Weaviate is a client for weaviate
 type Weaviate struct {
+	Admin admin.ClientService
+
+	Aliases aliases.ClientService
+
+	Apikeys apikeys.ClientService
+
+	Authz authz.ClientService
+
 	Backups backups.ClientService
 
 	Batch batch.ClientService
 
+	BulkImports bulk_imports.ClientService
+
 	Classifications classifications.ClientService
 
+	Cluster cluster.ClientService
+
+	Federation federation.ClientService
+
 	Graphql graphql.ClientService
 
+	Jobs jobs.ClientService
+
 	Meta meta.ClientService
 
 	Nodes nodes.ClientService
@@ -145,6 +179,8 @@ type Weaviate struct {
 
 	Operations operations.ClientService
 
+	Replication replication.ClientService
+
 	Schema schema.ClientService
 
 	WellKnown well_known.ClientService
@@ -155,14 +191,23 @@ type Weaviate struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Admin.SetTransport(transport)
+	c.Aliases.SetTransport(transport)
+	c.Apikeys.SetTransport(transport)
+	c.Authz.SetTransport(transport)
 	c.Backups.SetTransport(transport)
 	c.Batch.SetTransport(transport)
+	c.BulkImports.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
+	c.Cluster.SetTransport(transport)
+	c.Federation.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
+	c.Jobs.SetTransport(transport)
 	c.Meta.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
 	c.Operations.SetTransport(transport)
+	c.Replication.SetTransport(transport)
 	c.Schema.SetTransport(transport)
 	c.WellKnown.SetTransport(transport)
 }
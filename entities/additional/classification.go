@@ -35,11 +35,20 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Cursor             bool                   `json:"cursor"`
+	QueryPlan          bool                   `json:"queryPlan"`
+	Highlights         bool                   `json:"highlights"`
+	DidYouMean         bool                   `json:"didYouMean"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
 	NoProps bool `json:"noProps"`
 
+	// SkipVector avoids decoding the vector of the objects altogether. Parsing
+	// large vectors dominates the read path of queries which only return
+	// properties or metadata.
+	SkipVector bool `json:"skipVector"`
+
 	// ReferenceQuery is used to indicate that a search
 	// is being conducted on behalf of a referenced
 	// property. for example: this is relevant when a
@@ -0,0 +1,21 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// DidYouMean holds spelling corrections of the query of a bm25 or hybrid
+// search. Applied is set if the search found nothing for the original
+// query and was run again with the first alternative.
+type DidYouMean struct {
+	Original     string   `json:"original"`
+	Alternatives []string `json:"alternatives"`
+	Applied      bool     `json:"applied"`
+}
@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// Highlight holds the query terms found in one text value of an object.
+// Offsets are in runes of the value, End is exclusive.
+type Highlight struct {
+	Property string `json:"property"`
+	// Index is the position of the value in a text array property
+	Index   int              `json:"index"`
+	Snippet string           `json:"snippet"`
+	Matches []HighlightMatch `json:"matches"`
+}
+
+type HighlightMatch struct {
+	Term  string `json:"term"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
@@ -13,6 +13,7 @@ package aggregation
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -29,11 +30,15 @@ type Params struct {
 	ObjectLimit      *int                       `json:"objectLimit"`
 	SearchVector     []float32                  `json:"searchVector"`
 	Certainty        float64                    `json:"certainty"`
+	Distance         float64                    `json:"distance"`
+	WithDistance     bool                       `json:"withDistance"`
 	Tenant           string                     `json:"tenant"`
 	ModuleParams     map[string]interface{}     `json:"moduleParams"`
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	// Timeout bounds the execution time of the query, zero means no timeout
+	Timeout time.Duration `json:"timeout"`
 }
 
 type ParamProperty struct {
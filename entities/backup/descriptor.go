@@ -13,6 +13,7 @@ package backup
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -33,6 +34,8 @@ type DistributedBackupDescriptor struct {
 	Version       string                     `json:"version"` //
 	ServerVersion string                     `json:"serverVersion"`
 	Error         string                     `json:"error"`
+	// BaseID is the backup which an incremental backup is based on
+	BaseID string `json:"baseId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -186,6 +189,19 @@ type ShardDescriptor struct {
 	PropLengthTracker     []byte `json:"propLengthTracker"`
 	ShardVersionPath      string `json:"shardVersionPath"`
 	Version               []byte `json:"version"`
+
+	// Inherited are the files of an incremental backup which have not changed
+	// since its base backup. They are not uploaded again but mapped to the
+	// backup which holds them.
+	Inherited map[string]string `json:"inherited,omitempty"`
+	// Checksums are the hex encoded sha256 checksums of the files, they are
+	// used to detect unchanged files and to verify restored files.
+	Checksums map[string]string `json:"checksums,omitempty"`
+}
+
+// FileCount returns the number of files of the shard, including inherited ones
+func (s *ShardDescriptor) FileCount() int {
+	return len(s.Files) + len(s.Inherited)
 }
 
 // ClassDescriptor contains everything needed to completely restore a class
@@ -207,6 +223,27 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	// BaseID is the backup which an incremental backup is based on
+	BaseID string `json:"baseId,omitempty"`
+}
+
+// Chain returns the IDs of the earlier backups which hold inherited files
+// of d, they are needed to restore d
+func (d *BackupDescriptor) Chain() []string {
+	set := make(map[string]struct{})
+	for _, c := range d.Classes {
+		for _, s := range c.Shards {
+			for _, id := range s.Inherited {
+				set[id] = struct{}{}
+			}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
 }
 
 // List all existing classes in d
@@ -293,7 +330,7 @@ func (d *BackupDescriptor) Validate() error {
 			return fmt.Errorf("invalid class %q: [name schema sharding]", c.Name)
 		}
 		for _, s := range c.Shards {
-			n := len(s.Files)
+			n := s.FileCount()
 			if s.Name == "" || s.Node == "" || s.DocIDCounterPath == "" ||
 				s.ShardVersionPath == "" || s.PropLengthTrackerPath == "" ||
 				(n > 0 && (len(s.DocIDCounter) == 0 ||
@@ -306,6 +343,11 @@ func (d *BackupDescriptor) Validate() error {
 					return fmt.Errorf("invalid shard %q.%q: file number %d", c.Name, s.Name, i)
 				}
 			}
+			for fpath, id := range s.Inherited {
+				if fpath == "" || id == "" || id == d.ID {
+					return fmt.Errorf("invalid shard %q.%q: inherited file %q", c.Name, s.Name, fpath)
+				}
+			}
 		}
 	}
 	return nil
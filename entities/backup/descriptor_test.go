@@ -178,6 +178,42 @@ func TestValidateBackup(t *testing.T) {
 				}},
 			}},
 		}, success: true},
+		// incremental backups
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept, BaseID: "0",
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					Inherited: map[string]string{"file": "0"},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept, BaseID: "0",
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes,
+					Inherited: map[string]string{"file": "1"},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept, BaseID: "0",
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes,
+					Inherited: map[string]string{"file": "0"},
+				}},
+			}},
+		}, success: true},
 	}
 	for i, tc := range tests {
 		err := tc.desc.Validate()
@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dataprofile
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ClassProfile describes the quality of the data of a class, based on a
+// sample of its objects
+type ClassProfile struct {
+	Class string `json:"class"`
+	// ObjectCount is the number of objects the sample was taken from
+	ObjectCount int64              `json:"objectCount"`
+	SampleSize  int                `json:"sampleSize"`
+	Properties  []*PropertyProfile `json:"properties"`
+	Vectors     *VectorProfile     `json:"vectors,omitempty"`
+}
+
+type PropertyProfile struct {
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	// NullRatio is the share of sampled objects without a value
+	NullRatio float64 `json:"nullRatio"`
+	// AverageTextLength is the average number of characters of the text
+	// values, set for text and text[] properties
+	AverageTextLength *float64 `json:"averageTextLength,omitempty"`
+	// AverageArrayLength is the average number of elements of the non-null
+	// values, set for array properties
+	AverageArrayLength *float64 `json:"averageArrayLength,omitempty"`
+}
+
+type VectorProfile struct {
+	// Count is the number of sampled objects with a vector
+	Count       int          `json:"count"`
+	Dimensions  int          `json:"dimensions"`
+	ZeroVectors int          `json:"zeroVectors"`
+	Norm        Distribution `json:"norm"`
+	// DuplicatesInSample is the number of sampled vectors which are equal to
+	// another sampled vector
+	DuplicatesInSample int `json:"duplicatesInSample"`
+	// EstimatedDuplicates extrapolates the share of duplicates in the sample
+	// to all objects. Duplicates are much more likely to be missed by a small
+	// sample than unique vectors, so this is a lower bound.
+	EstimatedDuplicates int64 `json:"estimatedDuplicates"`
+}
+
+type Distribution struct {
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stdDev"`
+	P5     float64 `json:"p5"`
+	P50    float64 `json:"p50"`
+	P95    float64 `json:"p95"`
+}
+
+// Profiler collects the profile of a class from the sampled objects passed
+// to Add. It keeps the norm and a hash of every sampled vector, so the
+// sample size must be bounded. It is not safe for concurrent use.
+type Profiler struct {
+	props   []*models.Property
+	sampled int
+
+	nulls      []int
+	textValues []int
+	textChars  []int
+	arrays     []int
+	arrayElems []int
+
+	norms        []float64
+	dimensions   int
+	zeroVectors  int
+	vectorHashes map[uint64]struct{}
+	duplicates   int
+}
+
+func NewProfiler(class *models.Class) *Profiler {
+	n := len(class.Properties)
+	return &Profiler{
+		props:        class.Properties,
+		nulls:        make([]int, n),
+		textValues:   make([]int, n),
+		textChars:    make([]int, n),
+		arrays:       make([]int, n),
+		arrayElems:   make([]int, n),
+		vectorHashes: map[uint64]struct{}{},
+	}
+}
+
+func (p *Profiler) Add(props map[string]interface{}, vector []float32) {
+	p.sampled++
+
+	for i, prop := range p.props {
+		value, ok := props[prop.Name]
+		if !ok || value == nil {
+			p.nulls[i]++
+			continue
+		}
+
+		if text, ok := value.(string); ok {
+			p.addText(i, text)
+			continue
+		}
+
+		// the element type of arrays depends on how the object was decoded
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice {
+			p.addArray(i, rv.Len())
+			for j := 0; j < rv.Len(); j++ {
+				if text, ok := rv.Index(j).Interface().(string); ok {
+					p.addText(i, text)
+				}
+			}
+		}
+	}
+
+	if len(vector) > 0 {
+		p.addVector(vector)
+	}
+}
+
+func (p *Profiler) addText(i int, text string) {
+	p.textValues[i]++
+	p.textChars[i] += utf8.RuneCountInString(text)
+}
+
+func (p *Profiler) addArray(i, length int) {
+	if length == 0 {
+		// an empty array holds no value, just like null
+		p.nulls[i]++
+		return
+	}
+	p.arrays[i]++
+	p.arrayElems[i] += length
+}
+
+func (p *Profiler) addVector(vector []float32) {
+	p.dimensions = len(vector)
+
+	sum := 0.0
+	buf := make([]byte, 4*len(vector))
+	for i, x := range vector {
+		sum += float64(x) * float64(x)
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(x))
+	}
+
+	norm := math.Sqrt(sum)
+	p.norms = append(p.norms, norm)
+	if norm == 0 {
+		p.zeroVectors++
+	}
+
+	hash := murmur3.Sum64(buf)
+	if _, ok := p.vectorHashes[hash]; ok {
+		p.duplicates++
+	} else {
+		p.vectorHashes[hash] = struct{}{}
+	}
+}
+
+// Profile returns the profile of the sample, objectCount is the number of
+// objects it was taken from
+func (p *Profiler) Profile(className string, objectCount int64) *ClassProfile {
+	out := &ClassProfile{
+		Class:       className,
+		ObjectCount: objectCount,
+		SampleSize:  p.sampled,
+		Properties:  make([]*PropertyProfile, len(p.props)),
+	}
+
+	for i, prop := range p.props {
+		pp := &PropertyProfile{
+			Name:     prop.Name,
+			DataType: prop.DataType[0],
+		}
+		if p.sampled > 0 {
+			pp.NullRatio = float64(p.nulls[i]) / float64(p.sampled)
+		}
+
+		dt := schema.DataType(prop.DataType[0])
+		if dt == schema.DataTypeText || dt == schema.DataTypeTextArray {
+			pp.AverageTextLength = average(p.textChars[i], p.textValues[i])
+		}
+		if _, isArray := schema.IsArrayType(dt); isArray {
+			pp.AverageArrayLength = average(p.arrayElems[i], p.arrays[i])
+		}
+		out.Properties[i] = pp
+	}
+
+	if len(p.norms) > 0 {
+		out.Vectors = &VectorProfile{
+			Count:              len(p.norms),
+			Dimensions:         p.dimensions,
+			ZeroVectors:        p.zeroVectors,
+			Norm:               distribution(p.norms),
+			DuplicatesInSample: p.duplicates,
+			EstimatedDuplicates: int64(math.Round(
+				float64(p.duplicates) / float64(len(p.norms)) * float64(objectCount))),
+		}
+	}
+
+	return out
+}
+
+func average(sum, count int) *float64 {
+	avg := 0.0
+	if count > 0 {
+		avg = float64(sum) / float64(count)
+	}
+	return &avg
+}
+
+func distribution(values []float64) Distribution {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(len(sorted))
+
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(sorted))
+
+	percentile := func(p float64) float64 {
+		return sorted[int(math.Round(p*float64(len(sorted)-1)))]
+	}
+
+	return Distribution{
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   mean,
+		StdDev: math.Sqrt(variance),
+		P5:     percentile(0.05),
+		P50:    percentile(0.5),
+		P95:    percentile(0.95),
+	}
+}
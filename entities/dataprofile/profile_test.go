@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dataprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestProfiler(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+			{Name: "scores", DataType: schema.DataTypeNumberArray.PropString()},
+		},
+	}
+
+	p := NewProfiler(class)
+	p.Add(map[string]interface{}{
+		"title":     "héllo",
+		"tags":      []string{"a", "bcd"},
+		"wordCount": float64(10),
+		"scores":    []float64{1, 2, 3},
+	}, []float32{3, 4})
+	p.Add(map[string]interface{}{
+		"title":  "abc",
+		"tags":   []interface{}{"ef"},
+		"scores": []float64{},
+	}, []float32{3, 4})
+	p.Add(map[string]interface{}{"title": nil}, []float32{0, 0})
+	p.Add(nil, []float32{6, 8})
+
+	profile := p.Profile("Article", 400)
+	assert.Equal(t, "Article", profile.Class)
+	assert.Equal(t, int64(400), profile.ObjectCount)
+	assert.Equal(t, 4, profile.SampleSize)
+
+	require.Len(t, profile.Properties, 4)
+	title, tags, wordCount, scores := profile.Properties[0], profile.Properties[1],
+		profile.Properties[2], profile.Properties[3]
+
+	assert.Equal(t, 0.5, title.NullRatio)
+	require.NotNil(t, title.AverageTextLength)
+	assert.Equal(t, 4.0, *title.AverageTextLength, "characters, not bytes")
+	assert.Nil(t, title.AverageArrayLength)
+
+	assert.Equal(t, 0.5, tags.NullRatio)
+	assert.Equal(t, 2.0, *tags.AverageTextLength)
+	assert.Equal(t, 1.5, *tags.AverageArrayLength)
+
+	assert.Equal(t, 0.75, wordCount.NullRatio)
+	assert.Nil(t, wordCount.AverageTextLength)
+
+	assert.Equal(t, 0.75, scores.NullRatio, "empty arrays count as null")
+	assert.Equal(t, 3.0, *scores.AverageArrayLength)
+
+	require.NotNil(t, profile.Vectors)
+	v := profile.Vectors
+	assert.Equal(t, 4, v.Count)
+	assert.Equal(t, 2, v.Dimensions)
+	assert.Equal(t, 1, v.ZeroVectors)
+	assert.Equal(t, 1, v.DuplicatesInSample)
+	assert.Equal(t, int64(100), v.EstimatedDuplicates)
+	assert.Equal(t, 0.0, v.Norm.Min)
+	assert.Equal(t, 10.0, v.Norm.Max)
+	assert.Equal(t, 5.0, v.Norm.Mean)
+	assert.InDelta(t, 3.536, v.Norm.StdDev, 0.001)
+	assert.Equal(t, 5.0, v.Norm.P50)
+}
+
+func TestProfiler_Empty(t *testing.T) {
+	class := &models.Class{
+		Class:      "Article",
+		Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+	}
+
+	profile := NewProfiler(class).Profile("Article", 0)
+	assert.Equal(t, 0, profile.SampleSize)
+	assert.Equal(t, 0.0, profile.Properties[0].NullRatio)
+	assert.Nil(t, profile.Vectors)
+}
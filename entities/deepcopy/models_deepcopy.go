@@ -55,13 +55,16 @@ func Class(c *models.Class) *models.Class {
 
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
+		Analyzer:        p.Analyzer,
 		DataType:        p.DataType,
 		Description:     p.Description,
 		ModuleConfig:    p.ModuleConfig,
 		Name:            p.Name,
+		OnDelete:        p.OnDelete,
 		Tokenization:    p.Tokenization,
 		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		IndexPositions:  ptrBoolCopy(p.IndexPositions),
 	}
 }
 
@@ -85,15 +88,60 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 
 	var stopwords *models.StopwordConfig = nil
 	if i.Stopwords != nil {
-		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
+		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals, Version: i.Stopwords.Version}
+	}
+
+	var analyzers map[string]models.AnalyzerConfig = nil
+	if i.Analyzers != nil {
+		analyzers = make(map[string]models.AnalyzerConfig, len(i.Analyzers))
+		for name, analyzer := range i.Analyzers {
+			analyzers[name] = AnalyzerConfig(analyzer)
+		}
+	}
+
+	var synonyms []*models.SynonymRule = nil
+	if i.Synonyms != nil {
+		synonyms = make([]*models.SynonymRule, len(i.Synonyms))
+		for j, rule := range i.Synonyms {
+			if rule != nil {
+				synonyms[j] = &models.SynonymRule{Expansion: rule.Expansion, Terms: rule.Terms}
+			}
+		}
 	}
 
 	return &models.InvertedIndexConfig{
+		Analyzers:              analyzers,
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
+	}
+}
+
+func AnalyzerConfig(a models.AnalyzerConfig) models.AnalyzerConfig {
+	var charFilters []*models.AnalyzerCharFilter = nil
+	if a.CharFilters != nil {
+		charFilters = make([]*models.AnalyzerCharFilter, len(a.CharFilters))
+		for i, cf := range a.CharFilters {
+			if cf != nil {
+				charFilters[i] = &models.AnalyzerCharFilter{Pattern: cf.Pattern, Replacement: cf.Replacement}
+			}
+		}
+	}
+
+	var stopwords *models.StopwordConfig = nil
+	if a.Stopwords != nil {
+		stopwords = &models.StopwordConfig{Additions: a.Stopwords.Additions, Preset: a.Stopwords.Preset, Removals: a.Stopwords.Removals}
+	}
+
+	return models.AnalyzerConfig{
+		CharFilters:  charFilters,
+		Lowercase:    a.Lowercase,
+		Stemmer:      a.Stemmer,
+		Stopwords:    stopwords,
+		Tokenization: a.Tokenization,
 	}
 }
@@ -0,0 +1,424 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// EncryptedHeaderSize is the size of the header of an encrypted file. It
+	// consists of encryptedFileMagic, the first bytes of the fingerprint of the
+	// key and the random IV of the file.
+	EncryptedHeaderSize = 32
+
+	encryptedFileMagic = "WVENC\x00\x00\x01"
+	fingerprintSize    = 8
+
+	// encryptedValueVersion is the first byte of every sealed value, it is
+	// followed by the nonce and the sealed value
+	encryptedValueVersion = byte(1)
+)
+
+// Encryption encrypts files with AES in counter mode. Every file starts with
+// a header holding a random IV, so a key can encrypt any number of files. Any
+// position of a file can be decrypted on its own, which allows reading parts
+// of a file, seeking and appending. Files are not authenticated, a modified
+// file decrypts to different data.
+//
+// Values which are stored on their own, such as the entries of a queue, are
+// sealed with AES-GCM instead, see Seal.
+//
+// A nil *Encryption reads and writes plain files and values, so callers do
+// not need to distinguish between encrypted and plain storage.
+type Encryption struct {
+	block       cipher.Block
+	aead        cipher.AEAD
+	fingerprint []byte
+}
+
+// NewEncryption returns the encryption for a 16, 24 or 32 byte AES key
+func NewEncryption(key []byte) (*Encryption, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init aes cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("init gcm: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("weaviate key fingerprint"))
+	return &Encryption{block: block, aead: aead, fingerprint: mac.Sum(nil)[:16]}, nil
+}
+
+// Fingerprint identifies the key without revealing it
+func (e *Encryption) Fingerprint() []byte {
+	if e == nil {
+		return nil
+	}
+	return e.fingerprint
+}
+
+// Create creates or truncates the file at path like os.Create
+func (e *Encryption) Create(path string) (*File, error) {
+	return e.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+// Open opens the file at path for reading like os.Open
+func (e *Encryption) Open(path string) (*File, error) {
+	return e.OpenFile(path, os.O_RDONLY, 0)
+}
+
+// OpenFile opens the file at path like os.OpenFile. An empty file opened for
+// writing gets a new header, the header of an existing file is verified.
+func (e *Encryption) OpenFile(path string, flag int, perm os.FileMode) (*File, error) {
+	if e == nil {
+		f, err := os.OpenFile(path, flag, perm)
+		if err != nil {
+			return nil, err
+		}
+		return &File{file: f}, nil
+	}
+
+	writable := flag&(os.O_WRONLY|os.O_RDWR) != 0
+	if writable {
+		// the header of an existing file must be read
+		flag = flag&^os.O_WRONLY | os.O_RDWR
+	}
+	f, err := os.OpenFile(path, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	out := &File{file: f, enc: e}
+	if err := out.initHeader(writable, flag&os.O_APPEND != 0); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return out, nil
+}
+
+// ReadFile reads and decrypts the file at path like os.ReadFile
+func (e *Encryption) ReadFile(path string) ([]byte, error) {
+	if e == nil {
+		return os.ReadFile(path)
+	}
+
+	f, err := e.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	size, err := f.Size()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// WriteFile encrypts and writes data to the file at path like os.WriteFile
+func (e *Encryption) WriteFile(path string, data []byte, perm os.FileMode) error {
+	if e == nil {
+		return os.WriteFile(path, data, perm)
+	}
+
+	f, err := e.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Seal encrypts and authenticates a single value with a random nonce
+func (e *Encryption) Seal(value []byte) []byte {
+	if e == nil {
+		return value
+	}
+
+	nonceSize := e.aead.NonceSize()
+	out := make([]byte, 1+nonceSize, 1+nonceSize+len(value)+e.aead.Overhead())
+	out[0] = encryptedValueVersion
+	if _, err := rand.Read(out[1:]); err != nil {
+		// crypto/rand only fails if the OS cannot provide randomness, there is
+		// no way to continue safely
+		panic(fmt.Errorf("generate nonce: %w", err))
+	}
+	return e.aead.Seal(out, out[1:], value, nil)
+}
+
+// Unseal returns the value sealed with Seal
+func (e *Encryption) Unseal(sealed []byte) ([]byte, error) {
+	if e == nil {
+		return sealed, nil
+	}
+
+	nonceSize := e.aead.NonceSize()
+	if len(sealed) < 1+nonceSize || sealed[0] != encryptedValueVersion {
+		return nil, fmt.Errorf("value is not sealed")
+	}
+	out, err := e.aead.Open(nil, sealed[1:1+nonceSize], sealed[1+nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt value: %w", err)
+	}
+	return out, nil
+}
+
+// parseHeader verifies the header of an encrypted file and returns its IV
+func (e *Encryption) parseHeader(header []byte) ([]byte, error) {
+	if len(header) < EncryptedHeaderSize ||
+		!bytes.Equal(header[:len(encryptedFileMagic)], []byte(encryptedFileMagic)) {
+		return nil, fmt.Errorf("file is not encrypted")
+	}
+	if !bytes.Equal(header[len(encryptedFileMagic):len(encryptedFileMagic)+fingerprintSize],
+		e.fingerprint[:fingerprintSize]) {
+		return nil, fmt.Errorf("file was encrypted with a different key")
+	}
+	return header[len(encryptedFileMagic)+fingerprintSize : EncryptedHeaderSize], nil
+}
+
+// HeaderSize is the number of bytes a file starts with before its data,
+// zero for plain files
+func (e *Encryption) HeaderSize() int64 {
+	if e == nil {
+		return 0
+	}
+	return EncryptedHeaderSize
+}
+
+// NewDecrypter decrypts parts of an encrypted file which are read by other
+// means than a File, such as from a remote copy. header is the start of the
+// file. It returns nil if e is nil.
+func (e *Encryption) NewDecrypter(header []byte) (*Decrypter, error) {
+	if e == nil {
+		return nil, nil
+	}
+	iv, err := e.parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	return &Decrypter{enc: e, iv: append([]byte(nil), iv...)}, nil
+}
+
+// Decrypter decrypts parts of an encrypted file, a nil *Decrypter leaves
+// the data unchanged
+type Decrypter struct {
+	enc *Encryption
+	iv  []byte
+}
+
+// DecryptAt decrypts p in place, off is the position of p in the plain file
+func (d *Decrypter) DecryptAt(p []byte, off int64) {
+	if d == nil {
+		return
+	}
+	d.enc.xorAt(p, p, d.iv, off)
+}
+
+// xorAt encrypts or decrypts src into dst, offset is the position of src in
+// the plain file
+func (e *Encryption) xorAt(dst, src, iv []byte, offset int64) {
+	var ctr [aes.BlockSize]byte
+	copy(ctr[:], iv)
+	addToCounter(ctr[:], uint64(offset/aes.BlockSize))
+
+	stream := cipher.NewCTR(e.block, ctr[:])
+	if skip := offset % aes.BlockSize; skip > 0 {
+		var discard [aes.BlockSize]byte
+		stream.XORKeyStream(discard[:skip], discard[:skip])
+	}
+	stream.XORKeyStream(dst, src)
+}
+
+// addToCounter adds n to the big-endian counter ctr, the same way
+// cipher.NewCTR increments it
+func addToCounter(ctr []byte, n uint64) {
+	for i := len(ctr) - 1; i >= 0 && n > 0; i-- {
+		sum := uint64(ctr[i]) + n&0xff
+		ctr[i] = byte(sum)
+		n = n>>8 + sum>>8
+	}
+}
+
+// File is a file which is read and written through an Encryption. Sizes and
+// offsets are the ones of the plain data, the header is not visible.
+type File struct {
+	file *os.File
+	enc  *Encryption // nil for plain files
+	iv   []byte
+
+	// offset is the plain position of Read and Write
+	offset    int64
+	appending bool
+	scratch   []byte
+}
+
+func (f *File) initHeader(writable, appending bool) error {
+	info, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, EncryptedHeaderSize)
+	if info.Size() == 0 && writable {
+		copy(header, encryptedFileMagic)
+		copy(header[len(encryptedFileMagic):], f.enc.fingerprint[:fingerprintSize])
+		if _, err := rand.Read(header[len(encryptedFileMagic)+fingerprintSize:]); err != nil {
+			return fmt.Errorf("generate iv: %w", err)
+		}
+		if _, err := f.file.Write(header); err != nil {
+			return fmt.Errorf("write encryption header: %w", err)
+		}
+		f.iv = header[len(encryptedFileMagic)+fingerprintSize:]
+		f.appending = appending
+		return nil
+	}
+
+	if _, err := f.file.ReadAt(header, 0); err != nil {
+		return fmt.Errorf("file is not encrypted: read header: %w", err)
+	}
+	if f.iv, err = f.enc.parseHeader(header); err != nil {
+		return err
+	}
+
+	if appending {
+		f.appending = true
+		f.offset = info.Size() - EncryptedHeaderSize
+		return nil
+	}
+	_, err = f.file.Seek(EncryptedHeaderSize, io.SeekStart)
+	return err
+}
+
+func (f *File) encrypt(p []byte, offset int64) []byte {
+	if cap(f.scratch) < len(p) {
+		f.scratch = make([]byte, len(p))
+	}
+	out := f.scratch[:len(p)]
+	f.enc.xorAt(out, p, f.iv, offset)
+	return out
+}
+
+func (f *File) Read(p []byte) (int, error) {
+	if f.enc == nil {
+		return f.file.Read(p)
+	}
+	n, err := f.file.Read(p)
+	f.enc.xorAt(p[:n], p[:n], f.iv, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	if f.enc == nil {
+		return f.file.ReadAt(p, off)
+	}
+	n, err := f.file.ReadAt(p, off+EncryptedHeaderSize)
+	f.enc.xorAt(p[:n], p[:n], f.iv, off)
+	return n, err
+}
+
+func (f *File) Write(p []byte) (int, error) {
+	if f.enc == nil {
+		return f.file.Write(p)
+	}
+	n, err := f.file.Write(f.encrypt(p, f.offset))
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *File) WriteAt(p []byte, off int64) (int, error) {
+	if f.enc == nil {
+		return f.file.WriteAt(p, off)
+	}
+	return f.file.WriteAt(f.encrypt(p, off), off+EncryptedHeaderSize)
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	if f.enc == nil {
+		return f.file.Seek(offset, whence)
+	}
+	if whence == io.SeekStart {
+		offset += EncryptedHeaderSize
+	}
+	pos, err := f.file.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+	if pos < EncryptedHeaderSize {
+		return 0, fmt.Errorf("seek before the start of the file")
+	}
+	f.offset = pos - EncryptedHeaderSize
+	return f.offset, nil
+}
+
+// Size returns the size of the plain data
+func (f *File) Size() (int64, error) {
+	info, err := f.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if f.enc == nil {
+		return info.Size(), nil
+	}
+	return info.Size() - EncryptedHeaderSize, nil
+}
+
+// Truncate changes the size of the plain data. Like with a plain file, the
+// next write of a file opened for appending goes to the new end.
+func (f *File) Truncate(size int64) error {
+	if f.enc == nil {
+		return f.file.Truncate(size)
+	}
+	if err := f.file.Truncate(size + EncryptedHeaderSize); err != nil {
+		return err
+	}
+	if f.appending {
+		f.offset = size
+	}
+	return nil
+}
+
+// Fd returns the descriptor of the underlying file, which holds encrypted
+// data unless the file is plain
+func (f *File) Fd() uintptr {
+	return f.file.Fd()
+}
+
+func (f *File) Name() string {
+	return f.file.Name()
+}
+
+func (f *File) Sync() error {
+	return f.file.Sync()
+}
+
+func (f *File) Close() error {
+	return f.file.Close()
+}
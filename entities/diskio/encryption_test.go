@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	dir := t.TempDir()
+	enc, err := NewEncryption(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	other, err := NewEncryption(bytes.Repeat([]byte{2}, 32))
+	require.Nil(t, err)
+
+	plain := make([]byte, 1000)
+	for i := range plain {
+		plain[i] = byte(i % 7)
+	}
+
+	t.Run("write and read a file", func(t *testing.T) {
+		path := filepath.Join(dir, "file")
+		require.Nil(t, enc.WriteFile(path, plain, 0o600))
+
+		raw, err := os.ReadFile(path)
+		require.Nil(t, err)
+		assert.Len(t, raw, len(plain)+EncryptedHeaderSize)
+		assert.False(t, bytes.Contains(raw, plain[:100]))
+
+		read, err := enc.ReadFile(path)
+		require.Nil(t, err)
+		assert.Equal(t, plain, read)
+	})
+
+	t.Run("the same data is encrypted differently in every file", func(t *testing.T) {
+		require.Nil(t, enc.WriteFile(filepath.Join(dir, "a"), plain, 0o600))
+		require.Nil(t, enc.WriteFile(filepath.Join(dir, "b"), plain, 0o600))
+		a, err := os.ReadFile(filepath.Join(dir, "a"))
+		require.Nil(t, err)
+		b, err := os.ReadFile(filepath.Join(dir, "b"))
+		require.Nil(t, err)
+		assert.NotEqual(t, a[EncryptedHeaderSize:], b[EncryptedHeaderSize:])
+	})
+
+	t.Run("read at any offset", func(t *testing.T) {
+		f, err := enc.Open(filepath.Join(dir, "file"))
+		require.Nil(t, err)
+		defer f.Close()
+
+		size, err := f.Size()
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(plain)), size)
+
+		for _, off := range []int64{0, 1, 15, 16, 17, 500, 999} {
+			p := make([]byte, 1000-off)
+			_, err := f.ReadAt(p, off)
+			require.Nil(t, err)
+			assert.Equal(t, plain[off:], p, off)
+		}
+
+		pos, err := f.Seek(333, io.SeekStart)
+		require.Nil(t, err)
+		assert.Equal(t, int64(333), pos)
+		rest, err := io.ReadAll(f)
+		require.Nil(t, err)
+		assert.Equal(t, plain[333:], rest)
+	})
+
+	t.Run("append to a file", func(t *testing.T) {
+		path := filepath.Join(dir, "appended")
+		for i := 0; i < len(plain); i += 99 {
+			end := i + 99
+			if end > len(plain) {
+				end = len(plain)
+			}
+			f, err := enc.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+			require.Nil(t, err)
+			_, err = f.Write(plain[i:end])
+			require.Nil(t, err)
+			require.Nil(t, f.Close())
+		}
+
+		read, err := enc.ReadFile(path)
+		require.Nil(t, err)
+		assert.Equal(t, plain, read)
+	})
+
+	t.Run("overwrite parts of a file", func(t *testing.T) {
+		path := filepath.Join(dir, "overwritten")
+		f, err := enc.Create(path)
+		require.Nil(t, err)
+		_, err = f.Write(plain)
+		require.Nil(t, err)
+		_, err = f.Seek(0, io.SeekStart)
+		require.Nil(t, err)
+		_, err = f.Write([]byte("header"))
+		require.Nil(t, err)
+		_, err = f.WriteAt([]byte("middle"), 500)
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		expected := append([]byte(nil), plain...)
+		copy(expected, "header")
+		copy(expected[500:], "middle")
+		read, err := enc.ReadFile(path)
+		require.Nil(t, err)
+		assert.Equal(t, expected, read)
+	})
+
+	t.Run("decrypt a copy of a file", func(t *testing.T) {
+		raw, err := os.ReadFile(filepath.Join(dir, "file"))
+		require.Nil(t, err)
+		d, err := enc.NewDecrypter(raw[:EncryptedHeaderSize])
+		require.Nil(t, err)
+
+		p := append([]byte(nil), raw[EncryptedHeaderSize+100:EncryptedHeaderSize+250]...)
+		d.DecryptAt(p, 100)
+		assert.Equal(t, plain[100:250], p)
+	})
+
+	t.Run("files cannot be read with another key or without encryption", func(t *testing.T) {
+		_, err := other.ReadFile(filepath.Join(dir, "file"))
+		assert.ErrorContains(t, err, "different key")
+
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "plain"), plain, 0o600))
+		_, err = enc.ReadFile(filepath.Join(dir, "plain"))
+		assert.ErrorContains(t, err, "not encrypted")
+	})
+
+	t.Run("seal values", func(t *testing.T) {
+		sealed := enc.Seal([]byte("value"))
+		assert.False(t, bytes.Contains(sealed, []byte("value")))
+
+		value, err := enc.Unseal(sealed)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		_, err = other.Unseal(sealed)
+		assert.NotNil(t, err)
+		_, err = enc.Unseal([]byte("value"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("a nil encryption reads and writes plain data", func(t *testing.T) {
+		var none *Encryption
+		path := filepath.Join(dir, "nil")
+		require.Nil(t, none.WriteFile(path, plain, 0o600))
+
+		raw, err := os.ReadFile(path)
+		require.Nil(t, err)
+		assert.Equal(t, plain, raw)
+		assert.Equal(t, []byte("value"), none.Seal([]byte("value")))
+		assert.Equal(t, int64(0), none.HeaderSize())
+	})
+}
@@ -12,6 +12,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
@@ -35,10 +37,20 @@ type GetParams struct {
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
+	Rerank                *searchparams.Rerank
+	Diversify             *searchparams.Diversify
+	TimeDecay             *searchparams.TimeDecay
+	CustomScore           *searchparams.CustomScore
 	SearchVector          []float32
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
 	AdditionalProperties  additional.Properties
 	ReplicationProperties *additional.ReplicationProperties
 	Tenant                string
+	// WaitForIndexing blocks the search until all vectors which are queued
+	// for async indexing are indexed
+	WaitForIndexing bool
+	// Timeout bounds the execution time of the query, the query is cancelled
+	// server-side once it is exceeded. Zero means no timeout.
+	Timeout time.Duration
 }
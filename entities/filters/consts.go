@@ -18,6 +18,9 @@ const (
 	InternalPropertyLength         = "_propertyLength"
 	InternalPropCreationTimeUnix   = "_creationTimeUnix"
 	InternalPropLastUpdateTimeUnix = "_lastUpdateTimeUnix"
+	// InternalPropTenant indexes the tenants of the objects in shared tenant
+	// shards. It is added to the filters by the db, never by users.
+	InternalPropTenant = "_tenant"
 )
 
 // NotNullState is encoded as 0, so it can be read with the IsNull operator and value false.
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// IsContains returns true for the operators which match any or all of the
+// elements of an array value
+func (o Operator) IsContains() bool {
+	switch o {
+	case OperatorContainsAny, OperatorContainsAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContainsValues splits the array value of a ContainsAny or ContainsAll
+// clause into one value of the base data type per element. Each of them is
+// matched like the value of an Equal clause.
+func ContainsValues(value *Value) ([]*Value, error) {
+	if value == nil {
+		return nil, fmt.Errorf("expected an array value, got none")
+	}
+	baseType, ok := schema.IsArrayType(value.Type)
+	if !ok {
+		return nil, fmt.Errorf("expected an array value, got %q", valueNameFromDataType(value.Type))
+	}
+
+	var elements []interface{}
+	switch typed := value.Value.(type) {
+	case []interface{}:
+		// array values which went through a json roundtrip
+		elements = typed
+	case []string:
+		elements = make([]interface{}, len(typed))
+		for i := range typed {
+			elements[i] = typed[i]
+		}
+	case []int:
+		elements = make([]interface{}, len(typed))
+		for i := range typed {
+			elements[i] = typed[i]
+		}
+	case []float64:
+		elements = make([]interface{}, len(typed))
+		for i := range typed {
+			elements[i] = typed[i]
+		}
+	case []bool:
+		elements = make([]interface{}, len(typed))
+		for i := range typed {
+			elements[i] = typed[i]
+		}
+	default:
+		return nil, fmt.Errorf("expected an array value, got %T", value.Value)
+	}
+
+	out := make([]*Value, len(elements))
+	for i, element := range elements {
+		if asFloat, ok := element.(float64); ok && baseType == schema.DataTypeInt {
+			element = int(asFloat)
+		}
+		out[i] = &Value{Value: element, Type: baseType}
+	}
+	return out, nil
+}
@@ -11,6 +11,15 @@
 
 package filters
 
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
 type Cursor struct {
 	After string `json:"after"`
 	Limit int    `json:"limit"`
@@ -35,3 +44,63 @@ func ExtractCursorFromArgs(args map[string]interface{}) (*Cursor, error) {
 		Limit: limit.(int),
 	}, nil
 }
+
+// CursorPosition is the decoded form of the opaque cursor used to paginate
+// filtered or sorted queries. It holds the sort values of the last returned
+// object, most important first, followed by the object's ID which breaks
+// ties between objects with equal sort values.
+type CursorPosition struct {
+	Values []json.RawMessage `json:"v,omitempty"`
+	ID     strfmt.UUID       `json:"id"`
+}
+
+// EncodeCursor turns a position into the opaque string clients pass as the
+// after parameter of the next page
+func EncodeCursor(pos CursorPosition) (string, error) {
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor parses the after parameter. A plain UUID, as used to
+// paginate unfiltered queries, is a position without any sort values.
+func DecodeCursor(after string) (*CursorPosition, error) {
+	if _, err := uuid.Parse(after); err == nil {
+		return &CursorPosition{ID: strfmt.UUID(after)}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(after)
+	if err != nil {
+		return nil, errors.Errorf("after parameter '%s' is neither a uuid nor a cursor", after)
+	}
+	var pos CursorPosition
+	if err := json.Unmarshal(data, &pos); err != nil {
+		return nil, errors.Errorf("after parameter '%s' is neither a uuid nor a cursor", after)
+	}
+	if _, err := uuid.Parse(pos.ID.String()); err != nil {
+		return nil, errors.Wrapf(err, "cursor '%s' does not contain a valid uuid", after)
+	}
+	return &pos, nil
+}
+
+// CursorSort returns the order in which cursor pagination returns filtered
+// or sorted results. The ID is appended as the least important sort level,
+// so every object has a unique position even if sort values are equal.
+// Without an explicit sort objects are ordered by their ID.
+func CursorSort(sort []Sort) []Sort {
+	if len(sort) > 0 {
+		last := sort[len(sort)-1]
+		if len(last.Path) == 1 && isIDProp(last.Path[0]) {
+			return sort
+		}
+	}
+	out := make([]Sort, len(sort), len(sort)+1)
+	copy(out, sort)
+	return append(out, Sort{Path: []string{InternalPropID}, Order: "asc"})
+}
+
+func isIDProp(propName string) bool {
+	return propName == InternalPropID || propName == InternalPropBackwardsCompatID
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestCursorEncoding(t *testing.T) {
+	id := strfmt.UUID("7c8183ae-150d-433f-92b6-ed095b000001")
+
+	t.Run("plain uuid", func(t *testing.T) {
+		pos, err := DecodeCursor(id.String())
+		require.Nil(t, err)
+		assert.Equal(t, &CursorPosition{ID: id}, pos)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		in := CursorPosition{
+			Values: []json.RawMessage{json.RawMessage(`"text"`), json.RawMessage(`null`), json.RawMessage(`1.5`)},
+			ID:     id,
+		}
+		token, err := EncodeCursor(in)
+		require.Nil(t, err)
+
+		out, err := DecodeCursor(token)
+		require.Nil(t, err)
+		assert.Equal(t, &in, out)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, after := range []string{"not-a-cursor!", "bm90LWpzb24", "eyJpZCI6ImZvbyJ9"} {
+			_, err := DecodeCursor(after)
+			assert.NotNil(t, err, after)
+		}
+	})
+}
+
+func TestCursorSort(t *testing.T) {
+	idAsc := Sort{Path: []string{InternalPropID}, Order: "asc"}
+	byName := Sort{Path: []string{"name"}, Order: "desc"}
+	byIDDesc := Sort{Path: []string{"id"}, Order: "desc"}
+
+	assert.Equal(t, []Sort{idAsc}, CursorSort(nil))
+	assert.Equal(t, []Sort{byName, idAsc}, CursorSort([]Sort{byName}))
+	assert.Equal(t, []Sort{byName, byIDDesc}, CursorSort([]Sort{byName, byIDDesc}))
+
+	// the id is not appended to the backing array of the input
+	in := make([]Sort, 1, 2)
+	in[0] = byName
+	CursorSort(in)
+	assert.Equal(t, Sort{}, in[:2][1])
+}
+
+func TestValidateCursor(t *testing.T) {
+	className := schema.ClassName("MyClass")
+	sorted, err := EncodeCursor(CursorPosition{
+		Values: []json.RawMessage{json.RawMessage(`"text"`)},
+		ID:     "7c8183ae-150d-433f-92b6-ed095b000001",
+	})
+	require.Nil(t, err)
+	filter := &LocalFilter{Root: &Clause{Operator: OperatorEqual}}
+	sort := []Sort{{Path: []string{"name"}, Order: "asc"}}
+
+	tests := []struct {
+		name    string
+		cursor  *Cursor
+		offset  int
+		filter  *LocalFilter
+		sort    []Sort
+		wantErr bool
+	}{
+		{name: "uuid", cursor: &Cursor{After: "7c8183ae-150d-433f-92b6-ed095b000001", Limit: 1}},
+		{name: "with where", cursor: &Cursor{Limit: 1}, filter: filter},
+		{name: "with sort", cursor: &Cursor{After: sorted, Limit: 1}, sort: sort},
+		{name: "with offset", cursor: &Cursor{Limit: 1}, offset: 1, wantErr: true},
+		{name: "invalid after", cursor: &Cursor{After: "foo", Limit: 1}, wantErr: true},
+		{name: "sorted cursor without sort", cursor: &Cursor{After: sorted, Limit: 1}, wantErr: true},
+		{name: "no limit", cursor: &Cursor{Limit: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCursor(className, tt.cursor, tt.offset, tt.filter, tt.sort)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
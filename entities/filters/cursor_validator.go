@@ -13,10 +13,7 @@ package filters
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/google/uuid"
-	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -24,22 +21,16 @@ func ValidateCursor(className schema.ClassName, cursor *Cursor, offset int, filt
 	if className == "" {
 		return fmt.Errorf("class parameter cannot be empty")
 	}
-	if offset > 0 || filters != nil || sort != nil {
-		var params []string
-		if offset > 0 {
-			params = append(params, "offset")
-		}
-		if filters != nil {
-			params = append(params, "where")
-		}
-		if sort != nil {
-			params = append(params, "sort")
-		}
-		return fmt.Errorf("%s cannot be set with after and limit parameters", strings.Join(params, ","))
+	if offset > 0 {
+		return fmt.Errorf("offset cannot be set with after and limit parameters")
 	}
 	if cursor.After != "" {
-		if _, err := uuid.Parse(cursor.After); err != nil {
-			return errors.Wrapf(err, "after parameter '%s' is not a valid uuid", cursor.After)
+		pos, err := DecodeCursor(cursor.After)
+		if err != nil {
+			return err
+		}
+		if len(pos.Values) > 0 && filters == nil && sort == nil {
+			return fmt.Errorf("after parameter '%s' is a cursor of a sorted query, but sort is not set", cursor.After)
 		}
 	}
 	if cursor.Limit < 0 {
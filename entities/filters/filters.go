@@ -33,6 +33,12 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorPrefix
+	OperatorFuzzy
+	OperatorWithinGeoBoundingBox
+	OperatorWithinGeoPolygon
+	OperatorContainsAny
+	OperatorContainsAll
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +51,13 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorPrefix,
+		OperatorFuzzy,
+		OperatorWithinGeoBoundingBox,
+		OperatorWithinGeoPolygon,
+		OperatorContainsAny,
+		OperatorContainsAll:
 		return true
 	default:
 		return false
@@ -78,6 +90,18 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorPrefix:
+		return "Prefix"
+	case OperatorFuzzy:
+		return "Fuzzy"
+	case OperatorWithinGeoBoundingBox:
+		return "WithinGeoBoundingBox"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
 	default:
 		panic("Unknown operator")
 	}
@@ -110,6 +134,14 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	if v.Type == schema.DataTypeGeoCoordinates {
+		geoValue, err := unmarshalGeoValue(v.Value)
+		if err != nil {
+			return err
+		}
+		v.Value = geoValue
+	}
+
 	return nil
 }
 
@@ -13,10 +13,12 @@ package filters
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -52,4 +54,30 @@ func TestSerializeValue(t *testing.T) {
 
 		assert.Equal(t, before, after)
 	})
+
+	geo := func(lat, lon float32) *models.GeoCoordinates {
+		return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	}
+
+	for _, value := range []interface{}{
+		GeoRange{GeoCoordinates: geo(48.1, 11.5), Distance: 2000},
+		GeoBoundingBox{TopLeft: geo(49, 9), BottomRight: geo(48, 12)},
+		GeoPolygon{Vertices: []*models.GeoCoordinates{geo(48, 11), geo(48.5, 11.5), geo(48, 12)}},
+	} {
+		t.Run(fmt.Sprintf("with a %T value", value), func(t *testing.T) {
+			before := Value{
+				Value: value,
+				Type:  schema.DataTypeGeoCoordinates,
+			}
+
+			bytes, err := json.Marshal(before)
+			require.Nil(t, err)
+
+			var after Value
+			err = json.Unmarshal(bytes, &after)
+			require.Nil(t, err)
+
+			assert.Equal(t, before, after)
+		})
+	}
 }
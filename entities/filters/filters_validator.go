@@ -82,6 +82,12 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		return errors.Errorf("Property %q is a nested prop. Filter on one of its "+
+			"nested properties instead, using a path in the form of \"%s.<nestedPropName>\"",
+			propName, propName)
+	}
+
 	if cw.getOperator() == OperatorIsNull {
 		if !cw.isType(schema.DataTypeBoolean) {
 			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
@@ -109,10 +115,22 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if op := cw.getOperator(); op.IsContains() {
+		return validateContainsOperator(propName, prop.DataType, cw)
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
 
+	if op := cw.getOperator(); op == OperatorPrefix || op == OperatorFuzzy {
+		return validateTermMatchOperator(propName, prop.DataType, cw)
+	}
+
+	if op := cw.getOperator(); op.IsGeo() {
+		return validateGeoOperator(propName, prop.DataType, cw)
+	}
+
 	if schema.IsRefDataType(prop.DataType) {
 		// bit of an edge case, directly on refs (i.e. not on a primitive prop of a
 		// ref) we only allow valueInt which is what's used to count references
@@ -212,6 +230,124 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 	}
 }
 
+// validateTermMatchOperator validates the Prefix and Fuzzy operators, which
+// match the terms of text props
+func validateTermMatchOperator(propName schema.PropertyName, dataType []string,
+	cw *clauseWrapper,
+) error {
+	op := cw.getOperator()
+
+	switch dt, _ := schema.AsPrimitive(dataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray,
+		schema.DataTypeString, schema.DataTypeStringArray:
+	default:
+		return fmt.Errorf("operator %q can only be used on text/text[] props, "+
+			"but property %q is of type %q", op.Name(), propName, dataType[0])
+	}
+
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("operator %q requires a valueText, got %q instead",
+			op.Name(), cw.getValueNameFromType())
+	}
+
+	if op == OperatorFuzzy {
+		value, _ := cw.getValue().(string)
+		if _, _, err := ParseFuzzyValue(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateContainsOperator validates the ContainsAny and ContainsAll
+// operators, which require an array value whose elements could be used in an
+// Equal clause on the prop
+func validateContainsOperator(propName schema.PropertyName, dataType []string,
+	cw *clauseWrapper,
+) error {
+	op := cw.getOperator()
+
+	values, err := ContainsValues(cw.clause.Value)
+	if err != nil {
+		return fmt.Errorf("operator %q requires an array value such as "+
+			"\"valueTextArray\": %w", op.Name(), err)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("operator %q requires at least one value", op.Name())
+	}
+	valueType := values[0].Type
+	if alias, ok := deprecatedDataTypeAliases[valueType]; ok {
+		valueType = alias
+	}
+
+	propType, ok := schema.AsPrimitive(dataType)
+	if !ok {
+		return fmt.Errorf("operator %q cannot be used on reference props, "+
+			"but property %q is of type %q", op.Name(), propName, dataType[0])
+	}
+	if baseType, ok := schema.IsArrayType(propType); ok {
+		propType = baseType
+	}
+	if alias, ok := deprecatedDataTypeAliases[propType]; ok {
+		propType = alias
+	}
+
+	switch propType {
+	case schema.DataTypeText, schema.DataTypeInt, schema.DataTypeNumber,
+		schema.DataTypeBoolean, schema.DataTypeDate:
+	case schema.DataTypeUUID:
+		// uuids are specified as text
+		propType = schema.DataTypeText
+	default:
+		return fmt.Errorf("operator %q cannot be used on props of type %q",
+			op.Name(), dataType[0])
+	}
+
+	if valueType != propType {
+		return fmt.Errorf("data type filter cannot use %q on type %q, use %q instead",
+			valueNameFromDataType(valueType)+"Array", dataType[0],
+			valueNameFromDataType(propType)+"Array")
+	}
+
+	return nil
+}
+
+// validateGeoOperator validates the operators served by the geo index, each
+// of them requires its own kind of value
+func validateGeoOperator(propName schema.PropertyName, dataType []string,
+	cw *clauseWrapper,
+) error {
+	op := cw.getOperator()
+
+	if dt, _ := schema.AsPrimitive(dataType); dt != schema.DataTypeGeoCoordinates {
+		return fmt.Errorf("operator %q can only be used on geoCoordinates props, "+
+			"but property %q is of type %q", op.Name(), propName, dataType[0])
+	}
+
+	switch value := cw.getValue().(type) {
+	case GeoRange:
+		if op != OperatorWithinGeoRange {
+			return fmt.Errorf("operator %q cannot be used with a valueGeoRange", op.Name())
+		}
+	case GeoBoundingBox:
+		if op != OperatorWithinGeoBoundingBox {
+			return fmt.Errorf("operator %q cannot be used with a valueGeoBoundingBox", op.Name())
+		}
+		return value.Validate()
+	case GeoPolygon:
+		if op != OperatorWithinGeoPolygon {
+			return fmt.Errorf("operator %q cannot be used with a valueGeoPolygon", op.Name())
+		}
+		return value.Validate()
+	default:
+		return fmt.Errorf("operator %q requires a geo value, got %q instead",
+			op.Name(), cw.getValueNameFromType())
+	}
+
+	return nil
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
@@ -12,6 +12,7 @@
 package filters
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -306,3 +307,413 @@ func TestClauseWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTermMatchOperators(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+					{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+					{Name: "horsepower", DataType: []string{"int"}},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name        string
+		operator    Operator
+		prop        string
+		value       *Value
+		expectedErr string
+	}{
+		{
+			name:     "prefix on text",
+			operator: OperatorPrefix,
+			prop:     "modelName",
+			value:    &Value{Value: "mod", Type: schema.DataTypeText},
+		},
+		{
+			name:     "prefix on text array",
+			operator: OperatorPrefix,
+			prop:     "tags",
+			value:    &Value{Value: "spo", Type: schema.DataTypeText},
+		},
+		{
+			name:     "fuzzy with edit distance",
+			operator: OperatorFuzzy,
+			prop:     "modelName",
+			value:    &Value{Value: "modle~2", Type: schema.DataTypeText},
+		},
+		{
+			name:     "fuzzy with deprecated string value",
+			operator: OperatorFuzzy,
+			prop:     "modelName",
+			value:    &Value{Value: "modle", Type: schema.DataTypeString},
+		},
+		{
+			name:        "fuzzy with too large edit distance",
+			operator:    OperatorFuzzy,
+			prop:        "modelName",
+			value:       &Value{Value: "modle~3", Type: schema.DataTypeText},
+			expectedErr: "fuzzy edit distance must be between 0 and 2, got 3",
+		},
+		{
+			name:        "prefix on int",
+			operator:    OperatorPrefix,
+			prop:        "horsepower",
+			value:       &Value{Value: 100, Type: schema.DataTypeInt},
+			expectedErr: "operator \"Prefix\" can only be used on text/text[] props",
+		},
+		{
+			name:        "fuzzy with int value",
+			operator:    OperatorFuzzy,
+			prop:        "modelName",
+			value:       &Value{Value: 100, Type: schema.DataTypeInt},
+			expectedErr: "operator \"Fuzzy\" requires a valueText, got \"valueInt\" instead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    tt.value,
+				On:       &Path{Class: "Car", Property: schema.PropertyName(tt.prop)},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.expectedErr == "" {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParseFuzzyValue(t *testing.T) {
+	tests := []struct {
+		in           string
+		expectedTerm string
+		expectedDist int
+		expectErr    bool
+	}{
+		{in: "weaviate", expectedTerm: "weaviate", expectedDist: DefaultFuzzyEditDistance},
+		{in: "weaviate~0", expectedTerm: "weaviate", expectedDist: 0},
+		{in: "weaviate~2", expectedTerm: "weaviate", expectedDist: 2},
+		{in: "weaviate~", expectedTerm: "weaviate~", expectedDist: DefaultFuzzyEditDistance},
+		{in: "wea~viate", expectedTerm: "wea~viate", expectedDist: DefaultFuzzyEditDistance},
+		{in: "weaviate~5", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			term, dist, err := ParseFuzzyValue(tt.in)
+			if tt.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedTerm, term)
+			assert.Equal(t, tt.expectedDist, dist)
+		})
+	}
+}
+
+func TestValidateGeoOperators(t *testing.T) {
+	geo := func(lat, lon float32) *models.GeoCoordinates {
+		return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	}
+
+	tests := []struct {
+		name     string
+		prop     schema.PropertyName
+		operator Operator
+		value    interface{}
+		errMsg   string
+	}{
+		{
+			name:     "valid bounding box",
+			prop:     "location",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: geo(50, 10), BottomRight: geo(40, 20)},
+		},
+		{
+			name:     "bounding box crossing the antimeridian",
+			prop:     "location",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: geo(50, 170), BottomRight: geo(40, -170)},
+		},
+		{
+			name:     "bounding box with corners swapped",
+			prop:     "location",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: geo(40, 10), BottomRight: geo(50, 20)},
+			errMsg: "geo bounding box: latitude of topLeft (40) must not be lower " +
+				"than latitude of bottomRight (50)",
+		},
+		{
+			name:     "bounding box with invalid latitude",
+			prop:     "location",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: geo(91, 10), BottomRight: geo(50, 20)},
+			errMsg:   "geo coordinates topLeft: latitude must be between -90 and 90, got 91",
+		},
+		{
+			name:     "valid polygon",
+			prop:     "location",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoPolygon{Vertices: []*models.GeoCoordinates{geo(0, 0), geo(1, 1), geo(0, 2)}},
+		},
+		{
+			name:     "polygon with missing vertex",
+			prop:     "location",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoPolygon{Vertices: []*models.GeoCoordinates{geo(0, 0), nil, geo(0, 2)}},
+			errMsg:   "geo coordinates vertices[1]: latitude and longitude must be set",
+		},
+		{
+			name:     "operator does not match value",
+			prop:     "location",
+			operator: OperatorWithinGeoPolygon,
+			value:    GeoBoundingBox{TopLeft: geo(50, 10), BottomRight: geo(40, 20)},
+			errMsg:   "operator \"WithinGeoPolygon\" cannot be used with a valueGeoBoundingBox",
+		},
+		{
+			name:     "prop is not of type geoCoordinates",
+			prop:     "name",
+			operator: OperatorWithinGeoBoundingBox,
+			value:    GeoBoundingBox{TopLeft: geo(50, 10), BottomRight: geo(40, 20)},
+			errMsg: "operator \"WithinGeoBoundingBox\" can only be used on geoCoordinates " +
+				"props, but property \"name\" is of type \"text\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "City",
+						Properties: []*models.Property{
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+							{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: schema.DataTypeGeoCoordinates},
+				On:       &Path{Class: "City", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.errMsg == "" {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateNestedPropertyFilter(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Person",
+				Properties: []*models.Property{
+					{
+						Name:     "address",
+						DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "city", DataType: schema.DataTypeText.PropString()},
+							{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+						},
+					},
+					{
+						Name:     "pets",
+						DataType: schema.DataTypeObjectArray.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name      string
+		property  schema.PropertyName
+		valueType schema.DataType
+		valid     bool
+	}{
+		{name: "nested text", property: "address.city", valueType: schema.DataTypeText, valid: true},
+		{name: "nested int", property: "address.zip", valueType: schema.DataTypeInt, valid: true},
+		{name: "nested int with wrong value", property: "address.zip", valueType: schema.DataTypeText, valid: false},
+		{name: "nested in object array", property: "pets.name", valueType: schema.DataTypeText, valid: true},
+		{name: "object prop directly", property: "address", valueType: schema.DataTypeText, valid: false},
+		{name: "unknown nested prop", property: "address.street", valueType: schema.DataTypeText, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorEqual,
+				Value:    &Value{Value: "value", Type: tt.valueType},
+				On:       &Path{Class: "Person", Property: tt.property},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateContainsOperators(t *testing.T) {
+	tests := []struct {
+		name      string
+		prop      schema.PropertyName
+		operator  Operator
+		value     interface{}
+		valueType schema.DataType
+		errMsg    string
+	}{
+		{
+			name:      "any of texts on text[] prop",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			value:     []string{"foo", "bar"},
+			valueType: schema.DataTypeTextArray,
+		},
+		{
+			name:      "all of texts on text prop",
+			prop:      "name",
+			operator:  OperatorContainsAll,
+			value:     []string{"foo", "bar"},
+			valueType: schema.DataTypeTextArray,
+		},
+		{
+			name:      "deprecated string values",
+			prop:      "tags",
+			operator:  OperatorContainsAll,
+			value:     []string{"foo"},
+			valueType: schema.DataTypeStringArray,
+		},
+		{
+			name:      "any of ints on int[] prop",
+			prop:      "scores",
+			operator:  OperatorContainsAny,
+			value:     []int{1, 2},
+			valueType: schema.DataTypeIntArray,
+		},
+		{
+			name:      "any of uuids as texts",
+			prop:      "relatedIds",
+			operator:  OperatorContainsAny,
+			value:     []string{"5b6a08ba-1d46-43aa-89cc-8b070790c6f2"},
+			valueType: schema.DataTypeTextArray,
+		},
+		{
+			name:      "non-array value",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			value:     "foo",
+			valueType: schema.DataTypeText,
+			errMsg:    "operator \"ContainsAny\" requires an array value",
+		},
+		{
+			name:      "no values",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			value:     []string{},
+			valueType: schema.DataTypeTextArray,
+			errMsg:    "operator \"ContainsAny\" requires at least one value",
+		},
+		{
+			name:      "values not matching the prop",
+			prop:      "scores",
+			operator:  OperatorContainsAll,
+			value:     []string{"foo"},
+			valueType: schema.DataTypeTextArray,
+			errMsg:    "data type filter cannot use \"valueTextArray\" on type \"int[]\", use \"valueIntArray\" instead",
+		},
+		{
+			name:      "geo prop",
+			prop:      "location",
+			operator:  OperatorContainsAll,
+			value:     []string{"foo"},
+			valueType: schema.DataTypeTextArray,
+			errMsg:    "operator \"ContainsAll\" cannot be used on props of type \"geoCoordinates\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Article",
+						Properties: []*models.Property{
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+							{Name: "scores", DataType: schema.DataTypeIntArray.PropString()},
+							{Name: "relatedIds", DataType: schema.DataTypeUUIDArray.PropString()},
+							{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.valueType},
+				On:       &Path{Class: "Article", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.errMsg == "" {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestContainsValues(t *testing.T) {
+	t.Run("with typed values", func(t *testing.T) {
+		values, err := ContainsValues(&Value{Value: []string{"foo", "bar"}, Type: schema.DataTypeTextArray})
+		require.Nil(t, err)
+		assert.Equal(t, []*Value{
+			{Value: "foo", Type: schema.DataTypeText},
+			{Value: "bar", Type: schema.DataTypeText},
+		}, values)
+	})
+
+	t.Run("with int values which went through a json roundtrip", func(t *testing.T) {
+		bytes, err := json.Marshal(Value{Value: []int{1, 2}, Type: schema.DataTypeIntArray})
+		require.Nil(t, err)
+		var value Value
+		require.Nil(t, json.Unmarshal(bytes, &value))
+
+		values, err := ContainsValues(&value)
+		require.Nil(t, err)
+		assert.Equal(t, []*Value{
+			{Value: 1, Type: schema.DataTypeInt},
+			{Value: 2, Type: schema.DataTypeInt},
+		}, values)
+	})
+
+	t.Run("with a non-array value", func(t *testing.T) {
+		_, err := ContainsValues(&Value{Value: 1, Type: schema.DataTypeInt})
+		require.NotNil(t, err)
+	})
+}
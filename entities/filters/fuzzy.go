@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultFuzzyEditDistance is used if the value of a Fuzzy filter does not
+	// specify the maximum edit distance
+	DefaultFuzzyEditDistance = 1
+	// MaxFuzzyEditDistance limits the edit distance, as the number of matching
+	// terms and the cost of finding them grow quickly with the distance
+	MaxFuzzyEditDistance = 2
+)
+
+// ParseFuzzyValue splits the value of a Fuzzy filter into the term and the
+// maximum edit distance, which can be set with a "~n" suffix, e.g.
+// "weaviate~2". Without a suffix DefaultFuzzyEditDistance is used.
+func ParseFuzzyValue(in string) (string, int, error) {
+	pos := strings.LastIndexByte(in, '~')
+	if pos < 0 || pos == len(in)-1 {
+		return in, DefaultFuzzyEditDistance, nil
+	}
+
+	dist, err := strconv.Atoi(in[pos+1:])
+	if err != nil {
+		// not a distance suffix, but part of the term
+		return in, DefaultFuzzyEditDistance, nil
+	}
+
+	if dist < 0 || dist > MaxFuzzyEditDistance {
+		return "", 0, fmt.Errorf("fuzzy edit distance must be between 0 and %d, got %d",
+			MaxFuzzyEditDistance, dist)
+	}
+
+	return in[:pos], dist, nil
+}
+
+// FormatFuzzyValue is the inverse of ParseFuzzyValue
+func FormatFuzzyValue(term string, dist int) string {
+	return term + "~" + strconv.Itoa(dist)
+}
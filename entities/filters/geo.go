@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MinGeoPolygonVertices is the number of vertices a polygon needs to enclose
+// an area
+const MinGeoPolygonVertices = 3
+
+// GeoBoundingBox to be used with fields of type GeoCoordinates. Matches all
+// points between the latitudes of both corners and east of the top left and
+// west of the bottom right corner. A box whose top left corner lies east of
+// its bottom right corner crosses the antimeridian.
+type GeoBoundingBox struct {
+	TopLeft     *models.GeoCoordinates `json:"topLeft"`
+	BottomRight *models.GeoCoordinates `json:"bottomRight"`
+}
+
+// GeoPolygon to be used with fields of type GeoCoordinates. Matches all
+// points inside the polygon formed by the vertices, the last vertex is
+// connected to the first one. Edges are straight lines in the
+// latitude/longitude plane and must not cross the antimeridian.
+type GeoPolygon struct {
+	Vertices []*models.GeoCoordinates `json:"vertices"`
+}
+
+// IsGeo returns true for the operators which are served by the geo index
+// of a geoCoordinates property
+func (o Operator) IsGeo() bool {
+	switch o {
+	case OperatorWithinGeoRange, OperatorWithinGeoBoundingBox, OperatorWithinGeoPolygon:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b GeoBoundingBox) Validate() error {
+	if err := validateGeoCoordinates("topLeft", b.TopLeft); err != nil {
+		return err
+	}
+	if err := validateGeoCoordinates("bottomRight", b.BottomRight); err != nil {
+		return err
+	}
+
+	if *b.TopLeft.Latitude < *b.BottomRight.Latitude {
+		return fmt.Errorf("geo bounding box: latitude of topLeft (%v) must not be "+
+			"lower than latitude of bottomRight (%v)",
+			*b.TopLeft.Latitude, *b.BottomRight.Latitude)
+	}
+
+	return nil
+}
+
+func (p GeoPolygon) Validate() error {
+	if len(p.Vertices) < MinGeoPolygonVertices {
+		return fmt.Errorf("geo polygon: requires at least %d vertices, got %d",
+			MinGeoPolygonVertices, len(p.Vertices))
+	}
+
+	for i, vertex := range p.Vertices {
+		if err := validateGeoCoordinates(fmt.Sprintf("vertices[%d]", i), vertex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGeoCoordinates(name string, in *models.GeoCoordinates) error {
+	if in == nil || in.Latitude == nil || in.Longitude == nil {
+		return fmt.Errorf("geo coordinates %s: latitude and longitude must be set", name)
+	}
+
+	if *in.Latitude < -90 || *in.Latitude > 90 {
+		return fmt.Errorf("geo coordinates %s: latitude must be between -90 and 90, got %v",
+			name, *in.Latitude)
+	}
+
+	if *in.Longitude < -180 || *in.Longitude > 180 {
+		return fmt.Errorf("geo coordinates %s: longitude must be between -180 and 180, got %v",
+			name, *in.Longitude)
+	}
+
+	return nil
+}
+
+// unmarshalGeoValue restores the typed value of a geo filter which was
+// decoded as a map, e.g. when a filter is sent to another node
+func unmarshalGeoValue(in interface{}) (interface{}, error) {
+	asMap, ok := in.(map[string]interface{})
+	if !ok {
+		return in, nil
+	}
+
+	raw, err := json.Marshal(asMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	switch {
+	case asMap["vertices"] != nil:
+		out = &GeoPolygon{}
+	case asMap["topLeft"] != nil || asMap["bottomRight"] != nil:
+		out = &GeoBoundingBox{}
+	default:
+		out = &GeoRange{}
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return nil, fmt.Errorf("unmarshal geo filter value: %w", err)
+	}
+
+	switch typed := out.(type) {
+	case *GeoPolygon:
+		return *typed, nil
+	case *GeoBoundingBox:
+		return *typed, nil
+	default:
+		return *typed.(*GeoRange), nil
+	}
+}
@@ -119,13 +119,13 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 		lengthPropName, isPropLengthFilter := schema.IsPropertyLength(rawPropertyName, 0)
 		if isPropLengthFilter {
 			// check if property in len(PROPERTY) is valid
-			_, err = schema.ValidatePropertyName(lengthPropName)
+			_, err = schema.ValidatePropertyPath(lengthPropName)
 			if err != nil {
 				return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", lengthPropName)
 			}
 			propertyName = schema.PropertyName(rawPropertyName)
 		} else {
-			propertyName, err = schema.ValidatePropertyName(rawPropertyName)
+			propertyName, err = schema.ValidatePropertyPath(rawPropertyName)
 			// Invalid property name?
 			// Try to parse it as as a reference or a length.
 			if err != nil {
@@ -47,6 +47,27 @@ func Test_ParsePath(t *testing.T) {
 		assert.Equal(t, expectedPath, path, "should parse the path correctly")
 	})
 
+	t.Run("with a nested prop", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"mayor.address.street"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "mayor.address.street",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with an empty nested path segment", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"mayor..street"}
+		_, err := ParsePath(segments, rootClass)
+		require.NotNil(t, err, "should error")
+	})
+
 	t.Run("with nested refs", func(t *testing.T) {
 		rootClass := "City"
 		segments := []interface{}{"inCountry", "Country", "inContinent", "Continent", "onPlanet", "Planet", "name"}
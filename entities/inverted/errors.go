@@ -26,6 +26,10 @@ func NewMissingSearchableIndexError(propName string) error {
 	return MissingIndexError{missingSearchableFormat, []any{propName, propName}}
 }
 
+func NewMissingPositionsIndexError(propName string) error {
+	return MissingIndexError{missingPositionsFormat, []any{propName, propName}}
+}
+
 func NewMissingFilterableMetaCountIndexError(propName string) error {
 	return MissingIndexError{missingFilterableMetaCountFormat, []any{propName, propName}}
 }
@@ -41,6 +45,9 @@ const (
 	missingSearchableFormat = "Searching by property '%s' requires inverted index. " +
 		"Is `indexSearchable` option of property '%s' enabled? " +
 		"Set it to `true` or leave empty"
+	missingPositionsFormat = "Phrase and proximity queries on property '%s' require term positions. " +
+		"Is `indexPositions` option of property '%s' enabled? " +
+		"Set it to `true`"
 	missingFilterableMetaCountFormat = "Searching by property '%s' count requires inverted index. " +
 		"Is `indexFilterable` option of property '%s' enabled? " +
 		"Set it to `true` or leave empty"
@@ -28,17 +28,26 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
+	// The ID of an earlier backup. Only the files which changed since that backup are uploaded, restoring this backup restores the state at the time it was created.
+	BaseID string `json:"baseId,omitempty"`
+
 	// Custom configuration for the backup creation process
 	Config interface{} `json:"config,omitempty"`
 
 	// List of classes to exclude from the backup creation process
 	Exclude []string `json:"exclude"`
 
+	// List of tenants of multi-tenant classes to exclude from the backup creation process
+	ExcludeTenants []string `json:"excludeTenants"`
+
 	// The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.
 	ID string `json:"id,omitempty"`
 
 	// List of classes to include in the backup creation process
 	Include []string `json:"include"`
+
+	// List of tenants of multi-tenant classes to include in the backup creation process
+	IncludeTenants []string `json:"includeTenants"`
 }
 
 // Validate validates this backup create request
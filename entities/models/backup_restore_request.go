@@ -34,8 +34,17 @@ type BackupRestoreRequest struct {
 	// List of classes to exclude from the backup restoration process
 	Exclude []string `json:"exclude"`
 
+	// List of tenants of multi-tenant classes to exclude from the backup restoration process
+	ExcludeTenants []string `json:"excludeTenants"`
+
 	// List of classes to include in the backup restoration process
 	Include []string `json:"include"`
+
+	// List of tenants of multi-tenant classes to include in the backup restoration process
+	IncludeTenants []string `json:"includeTenants"`
+
+	// Tenants of the backup mapped to the names they are restored as. Tenants can be restored into an existing class if they are included or renamed.
+	TenantNames map[string]string `json:"tenantNames,omitempty"`
 }
 
 // Validate validates this backup restore request
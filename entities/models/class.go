@@ -36,6 +36,9 @@ type Class struct {
 	// Description of the class.
 	Description string `json:"description,omitempty"`
 
+	// Names of primitive properties from which the ids of objects imported without an id are derived, as UUIDv5 of the class name and the property values. Re-importing the same object then yields the same id.
+	IDProperties []string `json:"idProperties,omitempty"`
+
 	// inverted index config
 	InvertedIndexConfig *InvertedIndexConfig `json:"invertedIndexConfig,omitempty"`
 
@@ -60,6 +63,9 @@ type Class struct {
 	// Name of the vector index to use, eg. (HNSW)
 	VectorIndexType string `json:"vectorIndexType,omitempty"`
 
+	// Store only the vectors of the objects of this class. Objects can't have properties and no inverted index is built, so the class can only be searched by vector. Useful for workloads which keep metadata elsewhere and only need ANN search. Immutable.
+	VectorOnly bool `json:"vectorOnly,omitempty"`
+
 	// Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.
 	Vectorizer string `json:"vectorizer,omitempty"`
 }
@@ -30,6 +30,12 @@ type MultiTenancyConfig struct {
 
 	// Whether or not multi-tenancy is enabled for this class
 	Enabled bool `json:"enabled"`
+
+	// Number of objects above which a tenant is moved from its shared shard into a dedicated shard. 0 disables the promotion.
+	PromotionThreshold int64 `json:"promotionThreshold,omitempty"`
+
+	// Number of physical shards new tenants share instead of getting a dedicated shard each. Tenants with a centroid are grouped with the tenants of similar centroids. Queries on a tenant of a shared shard are restricted to its objects. 0 gives every tenant a dedicated shard.
+	SharedShards int64 `json:"sharedShards,omitempty"`
 }
 
 // Validate validates this multi tenancy config
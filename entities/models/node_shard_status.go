@@ -18,9 +18,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NodeShardStatus The definition of a node shard status response body
@@ -31,15 +34,79 @@ type NodeShardStatus struct {
 	// The name of shard's class.
 	Class string `json:"class"`
 
+	// The health of the shard, DEGRADED if its reads or writes are rejected by a circuit breaker.
+	// Enum: [HEALTHY DEGRADED]
+	Health string `json:"health,omitempty"`
+
+	// Why the shard is degraded.
+	HealthReason string `json:"healthReason,omitempty"`
+
 	// The name of the shard.
 	Name string `json:"name"`
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// How long the oldest vector in the async indexing queue has been waiting, in seconds.
+	VectorQueueLagSeconds float64 `json:"vectorQueueLagSeconds,omitempty"`
+
+	// The number of vectors waiting to be indexed if async indexing is enabled.
+	VectorQueueLength int64 `json:"vectorQueueLength,omitempty"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var nodeShardStatusTypeHealthPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["HEALTHY","DEGRADED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		nodeShardStatusTypeHealthPropEnum = append(nodeShardStatusTypeHealthPropEnum, v)
+	}
+}
+
+const (
+
+	// NodeShardStatusHealthHEALTHY captures enum value "HEALTHY"
+	NodeShardStatusHealthHEALTHY string = "HEALTHY"
+
+	// NodeShardStatusHealthDEGRADED captures enum value "DEGRADED"
+	NodeShardStatusHealthDEGRADED string = "DEGRADED"
+)
+
+// prop value enum
+func (m *NodeShardStatus) validateHealthEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, nodeShardStatusTypeHealthPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateHealth(formats strfmt.Registry) error {
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHealthEnum("health", "body", m.Health); err != nil {
+		return err
+	}
+
 	return nil
 }
 
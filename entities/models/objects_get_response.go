@@ -258,6 +258,10 @@ func (m *ObjectsGetResponse) UnmarshalBinary(b []byte) error {
 // swagger:model ObjectsGetResponseAO2Result
 type ObjectsGetResponseAO2Result struct {
 
+	// Id of the object this object duplicates, set by imports with deduplication.
+	// Format: uuid
+	DuplicateOf strfmt.UUID `json:"duplicateOf,omitempty"`
+
 	// errors
 	Errors *ErrorResponse `json:"errors,omitempty"`
 
@@ -270,6 +274,10 @@ type ObjectsGetResponseAO2Result struct {
 func (m *ObjectsGetResponseAO2Result) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDuplicateOf(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateErrors(formats); err != nil {
 		res = append(res, err)
 	}
@@ -284,6 +292,18 @@ func (m *ObjectsGetResponseAO2Result) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ObjectsGetResponseAO2Result) validateDuplicateOf(formats strfmt.Registry) error {
+	if swag.IsZero(m.DuplicateOf) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("result"+"."+"duplicateOf", "body", "uuid", m.DuplicateOf.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ObjectsGetResponseAO2Result) validateErrors(formats strfmt.Registry) error {
 	if swag.IsZero(m.Errors) { // not required
 		return nil
@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -31,9 +32,22 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Optional. Name of an analyzer defined in the class' invertedIndexConfig.analyzers. Applies to text and text[] data types. If set, the analyzer pipeline replaces the tokenization of the property at index and query time.
+	Analyzer string `json:"analyzer,omitempty"`
+
+	// Optional. Only applies to properties of data type blob. Determines where the bytes of the blob are kept. `inline` (default) stores them with the object, `external` stores them in the configured external object store and keeps a reference to them with the object. Blobs can be streamed from /v1/blobs/{className}/{id}/{propertyName} either way.
+	// Enum: [inline external]
+	BlobStorage string `json:"blobStorage,omitempty"`
+
+	// Optional. Expression computing the value of this property at write time when it is absent from the object. Supported expressions are `now()` for date and text properties and `concat(...)` of other primitive properties or double-quoted literals for text properties. Mutually exclusive with defaultValue.
+	ComputedValue string `json:"computedValue,omitempty"`
+
 	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int".
 	DataType []string `json:"dataType"`
 
+	// Optional. Value assigned to this property at write time when it is absent from the object. Must match the data type of the property. Mutually exclusive with computedValue.
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+
 	// Description of the property.
 	Description string `json:"description,omitempty"`
 
@@ -43,6 +57,9 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should term positions be stored in the searchable index of this property. Defaults to false. Applicable only to properties of data type text and text[] which have a searchable index. Positions are required for phrase and proximity queries in bm25 and hybrid search, but increase the size of the index considerably.
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
@@ -52,6 +69,13 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
+	// The sub-properties of a property of data type `object` or `object[]`. Each nested property is individually indexed and can be filtered on by its dotted path, e.g. `address.city`.
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Optional. Only applies to reference properties. Determines what happens to objects of this class when an object they reference is deleted. `cascade` deletes them as well, `setNull` removes the reference, `restrict` rejects the deletion as long as references exist. If not set, references are left dangling.
+	// Enum: [cascade setNull restrict]
+	OnDelete string `json:"onDelete,omitempty"`
+
 	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
 	// Enum: [word lowercase whitespace field]
 	Tokenization string `json:"tokenization,omitempty"`
@@ -61,6 +85,18 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBlobStorage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOnDelete(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,6 +107,119 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeBlobStoragePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["inline","external"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeBlobStoragePropEnum = append(propertyTypeBlobStoragePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyBlobStorageInline captures enum value "inline"
+	PropertyBlobStorageInline string = "inline"
+
+	// PropertyBlobStorageExternal captures enum value "external"
+	PropertyBlobStorageExternal string = "external"
+)
+
+// prop value enum
+func (m *Property) validateBlobStorageEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeBlobStoragePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateBlobStorage(formats strfmt.Registry) error {
+	if swag.IsZero(m.BlobStorage) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateBlobStorageEnum("blobStorage", "body", m.BlobStorage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var propertyTypeOnDeletePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cascade","setNull","restrict"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeOnDeletePropEnum = append(propertyTypeOnDeletePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyOnDeleteCascade captures enum value "cascade"
+	PropertyOnDeleteCascade string = "cascade"
+
+	// PropertyOnDeleteSetNull captures enum value "setNull"
+	PropertyOnDeleteSetNull string = "setNull"
+
+	// PropertyOnDeleteRestrict captures enum value "restrict"
+	PropertyOnDeleteRestrict string = "restrict"
+)
+
+// prop value enum
+func (m *Property) validateOnDeleteEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeOnDeletePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateOnDelete(formats strfmt.Registry) error {
+	if swag.IsZero(m.OnDelete) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOnDeleteEnum("onDelete", "body", m.OnDelete); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
@@ -119,8 +268,37 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
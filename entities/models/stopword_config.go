@@ -36,6 +36,9 @@ type StopwordConfig struct {
 
 	// stopwords to be removed from consideration
 	Removals []string `json:"removals"`
+
+	// Incremented on every change of the stopwords of a class. Values sent by clients on updates are ignored
+	Version int64 `json:"version,omitempty"`
 }
 
 // Validate validates this stopword config
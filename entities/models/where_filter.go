@@ -37,7 +37,7 @@ type WhereFilter struct {
 
 	// operator to use
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull]
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange WithinGeoBoundingBox WithinGeoPolygon IsNull Prefix Fuzzy ContainsAny ContainsAll]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -48,10 +48,24 @@ type WhereFilter struct {
 	// Example: false
 	ValueBoolean *bool `json:"valueBoolean,omitempty"`
 
+	// value as array of booleans, used by the ContainsAny and ContainsAll operators
+	// Example: [true,false]
+	ValueBooleanArray []bool `json:"valueBooleanArray"`
+
 	// value as date (as string)
 	// Example: TODO
 	ValueDate *string `json:"valueDate,omitempty"`
 
+	// value as array of dates (as strings), used by the ContainsAny and ContainsAll operators
+	// Example: ["2023-01-01T00:00:00Z"]
+	ValueDateArray []string `json:"valueDateArray"`
+
+	// value as geo bounding box
+	ValueGeoBoundingBox *WhereFilterGeoBoundingBox `json:"valueGeoBoundingBox,omitempty"`
+
+	// value as geo polygon
+	ValueGeoPolygon *WhereFilterGeoPolygon `json:"valueGeoPolygon,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
@@ -59,10 +73,18 @@ type WhereFilter struct {
 	// Example: 2000
 	ValueInt *int64 `json:"valueInt,omitempty"`
 
+	// value as array of integers, used by the ContainsAny and ContainsAll operators
+	// Example: [100,200]
+	ValueIntArray []int64 `json:"valueIntArray"`
+
 	// value as number/float
 	// Example: 3.14
 	ValueNumber *float64 `json:"valueNumber,omitempty"`
 
+	// value as array of numbers/floats, used by the ContainsAny and ContainsAll operators
+	// Example: [3.14,2.71]
+	ValueNumberArray []float64 `json:"valueNumberArray"`
+
 	// value as text (deprecated as of v1.19; alias for valueText)
 	// Example: my search term
 	ValueString *string `json:"valueString,omitempty"`
@@ -70,6 +92,10 @@ type WhereFilter struct {
 	// value as text
 	// Example: my search term
 	ValueText *string `json:"valueText,omitempty"`
+
+	// value as array of texts, used by the ContainsAny and ContainsAll operators
+	// Example: ["my","search","terms"]
+	ValueTextArray []string `json:"valueTextArray"`
 }
 
 // Validate validates this where filter
@@ -84,6 +110,14 @@ func (m *WhereFilter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueGeoBoundingBox(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValueGeoPolygon(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateValueGeoRange(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,7 +158,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","WithinGeoBoundingBox","WithinGeoPolygon","IsNull","Prefix","Fuzzy","ContainsAny","ContainsAll"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -167,8 +201,26 @@ const (
 	// WhereFilterOperatorWithinGeoRange captures enum value "WithinGeoRange"
 	WhereFilterOperatorWithinGeoRange string = "WithinGeoRange"
 
+	// WhereFilterOperatorWithinGeoBoundingBox captures enum value "WithinGeoBoundingBox"
+	WhereFilterOperatorWithinGeoBoundingBox string = "WithinGeoBoundingBox"
+
+	// WhereFilterOperatorWithinGeoPolygon captures enum value "WithinGeoPolygon"
+	WhereFilterOperatorWithinGeoPolygon string = "WithinGeoPolygon"
+
 	// WhereFilterOperatorIsNull captures enum value "IsNull"
 	WhereFilterOperatorIsNull string = "IsNull"
+
+	// WhereFilterOperatorPrefix captures enum value "Prefix"
+	WhereFilterOperatorPrefix string = "Prefix"
+
+	// WhereFilterOperatorFuzzy captures enum value "Fuzzy"
+	WhereFilterOperatorFuzzy string = "Fuzzy"
+
+	// WhereFilterOperatorContainsAny captures enum value "ContainsAny"
+	WhereFilterOperatorContainsAny string = "ContainsAny"
+
+	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
+	WhereFilterOperatorContainsAll string = "ContainsAll"
 )
 
 // prop value enum
@@ -192,6 +244,44 @@ func (m *WhereFilter) validateOperator(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WhereFilter) validateValueGeoBoundingBox(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoBoundingBox) { // not required
+		return nil
+	}
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) validateValueGeoPolygon(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoPolygon) { // not required
+		return nil
+	}
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) validateValueGeoRange(formats strfmt.Registry) error {
 	if swag.IsZero(m.ValueGeoRange) { // not required
 		return nil
@@ -219,6 +309,14 @@ func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateValueGeoBoundingBox(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateValueGeoPolygon(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateValueGeoRange(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -249,6 +347,38 @@ func (m *WhereFilter) contextValidateOperands(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *WhereFilter) contextValidateValueGeoBoundingBox(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) contextValidateValueGeoPolygon(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) contextValidateValueGeoRange(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ValueGeoRange != nil {
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+// DistanceFn returns the distance between two vectors of the same length,
+// lower values indicate more similar vectors
+type DistanceFn = func(a, b []float32) float32
+
+// DistanceMetric is a custom vector distance metric which can be configured
+// per class using the "distance" and "distanceParams" settings of the vector
+// index config
+type DistanceMetric interface {
+	// ValidateParams validates the user supplied distanceParams, it is called
+	// whenever a class using the metric is created or updated
+	ValidateParams(params map[string]interface{}) error
+	// DistanceFn builds the distance function from validated params
+	DistanceFn(params map[string]interface{}) (DistanceFn, error)
+}
+
+// DistanceMetrics defines the custom distance metrics provided by a module,
+// keyed by the name of the metric
+type DistanceMetrics interface {
+	DistanceMetrics() map[string]DistanceMetric
+}
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+)
+
+// KeyManager provides the keys used to encrypt data at rest, typically by
+// wrapping a key management service. Keys are identified by an id, such as
+// a class or a tenant of a class.
+type KeyManager interface {
+	// Name returns the name of the key manager
+	Name() string
+
+	// DataKey returns the 32 byte key with the given id. The key is created
+	// if it does not exist yet.
+	DataKey(ctx context.Context, id string) ([]byte, error)
+
+	// DeleteKey deletes the key with the given id, the data encrypted with
+	// it can no longer be read. Deleting a key which does not exist is not
+	// an error.
+	DeleteKey(ctx context.Context, id string) error
+}
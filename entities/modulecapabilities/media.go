@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// MediaType is a kind of media a MediaVectorizer can vectorize. Each media
+// type adds a near<Media> search operator, e.g. nearImage for MediaImage
+type MediaType string
+
+const (
+	MediaImage MediaType = "image"
+	MediaAudio MediaType = "audio"
+	MediaVideo MediaType = "video"
+)
+
+// MediaVectorizer is implemented by modules which vectorize media passed in
+// a search. The modules provider adds the GraphQL argument and the searcher
+// of the near<Media> operator for each of the module's media types, so the
+// module does not need to provide them through GraphQLArguments and
+// Searcher. An operator the module does provide itself takes precedence
+type MediaVectorizer interface {
+	MediaTypes() []MediaType
+	// VectorizeMedia returns the vector of the base64 encoded media. cfg
+	// holds no class settings on cross-class searches, such as Explore {}
+	VectorizeMedia(ctx context.Context, mediaType MediaType, media string,
+		cfg moduletools.ClassConfig) ([]float32, error)
+}
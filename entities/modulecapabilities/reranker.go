@@ -0,0 +1,26 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// Reranker scores documents for their relevance to a query, e.g. with a
+// cross-encoder model. One score is returned per document, higher scores
+// mean more relevant.
+type Reranker interface {
+	Rerank(ctx context.Context, query string, documents []string,
+		cfg moduletools.ClassConfig) ([]float64, error)
+}
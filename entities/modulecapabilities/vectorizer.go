@@ -46,3 +46,29 @@ type InputVectorizer interface {
 	VectorizeInput(ctx context.Context, input string,
 		cfg moduletools.ClassConfig) ([]float32, error)
 }
+
+// BatchVectorizer is implemented by vectorizers whose inference API embeds
+// many texts in a single request. Instead of calling VectorizeObject for
+// every object, the modules provider collects the objects' texts across
+// concurrent imports and embeds them in batches of the configured size
+type BatchVectorizer interface {
+	// ObjectText returns the text the object is vectorized from. If no
+	// inference call is needed, e.g. because none of the vectorized
+	// properties changed, it sets the vector on the object itself and
+	// returns false
+	ObjectText(ctx context.Context, obj *models.Object, objDiff *moduletools.ObjectDiff,
+		cfg moduletools.ClassConfig) (string, bool, error)
+	// VectorizeBatch returns one vector per text, in the order of the texts
+	VectorizeBatch(ctx context.Context, texts []string,
+		cfg moduletools.ClassConfig) ([][]float32, error)
+	BatchSettings() BatchSettings
+}
+
+// BatchSettings describe how requests to a vectorizer's inference API can
+// be batched. Zero values fall back to the defaults of the modules provider
+type BatchSettings struct {
+	// MaxBatchSize is the maximum number of texts sent in a single request
+	MaxBatchSize int
+	// MaxConcurrency is the maximum number of requests in flight at once
+	MaxConcurrency int
+}
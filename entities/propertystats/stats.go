@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package propertystats
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/sketch"
+)
+
+// MaxHistogramBuckets is the maximum number of buckets of a histogram built
+// or merged by this package
+const MaxHistogramBuckets = 64
+
+// HistogramBucket covers all keys which are larger than the UpperKey of the
+// previous bucket, up to and including its own UpperKey
+type HistogramBucket struct {
+	UpperKey []byte
+	// Count is the number of values of all keys in the bucket
+	Count uint64
+	// Keys is the number of distinct keys in the bucket
+	Keys uint64
+}
+
+// Stats describe the values of a single property, collected from the keys
+// of its filterable index. Keys are compared bytewise, which matches the
+// order of the values for the lexicographically sortable encodings of the
+// inverted index. Every element of an array counts as a value of its own.
+//
+// All stats are estimates, they are maintained as segments are flushed and
+// compacted, so they lag behind recent writes and may still include values
+// which were deleted or updated since.
+type Stats struct {
+	// Count is the number of non-null values
+	Count uint64
+	// NullCount is the number of objects without a value, only set if
+	// HasNullCount is true, which requires the null state to be indexed
+	NullCount    uint64
+	HasNullCount bool
+
+	// Distinct estimates the number of distinct values
+	Distinct *sketch.HyperLogLog
+
+	MinKey []byte
+	MaxKey []byte
+	// Min and Max are the decoded MinKey and MaxKey of int and number
+	// properties, nil for any other data type
+	Min *float64
+	Max *float64
+
+	// Histogram is equi-depth, every bucket holds about the same number of
+	// values
+	Histogram []HistogramBucket
+}
+
+func (s Stats) DistinctCount() uint64 {
+	if s.Distinct == nil {
+		return 0
+	}
+	return s.Distinct.Estimate()
+}
+
+// EqualFraction estimates the fraction of values equal to key, assuming the
+// values of a histogram bucket are evenly spread across its keys
+func (s Stats) EqualFraction(key []byte) float64 {
+	if s.Count == 0 || bytes.Compare(key, s.MinKey) < 0 ||
+		bytes.Compare(key, s.MaxKey) > 0 {
+		return 0
+	}
+
+	i := s.bucketOf(key)
+	if i == len(s.Histogram) || s.Histogram[i].Keys == 0 {
+		return 0
+	}
+
+	bucket := s.Histogram[i]
+	return float64(bucket.Count) / float64(bucket.Keys) / float64(s.Count)
+}
+
+// LessThanFraction estimates the fraction of values smaller than key. Keys
+// can't be interpolated, so half of the bucket containing key is assumed to
+// be smaller.
+func (s Stats) LessThanFraction(key []byte) float64 {
+	if s.Count == 0 || bytes.Compare(key, s.MinKey) <= 0 {
+		return 0
+	}
+	if bytes.Compare(key, s.MaxKey) > 0 {
+		return 1
+	}
+
+	i := s.bucketOf(key)
+	var less float64
+	for _, bucket := range s.Histogram[:i] {
+		less += float64(bucket.Count)
+	}
+	if i < len(s.Histogram) {
+		less += float64(s.Histogram[i].Count) / 2
+	}
+
+	return math.Min(less/float64(s.Count), 1)
+}
+
+// RangeFraction estimates the fraction of values in [lower, upper)
+func (s Stats) RangeFraction(lower, upper []byte) float64 {
+	return math.Max(s.LessThanFraction(upper)-s.LessThanFraction(lower), 0)
+}
+
+// bucketOf returns the index of the first bucket whose upper key is not
+// smaller than key, len(s.Histogram) if there is none
+func (s Stats) bucketOf(key []byte) int {
+	return sort.Search(len(s.Histogram), func(i int) bool {
+		return bytes.Compare(s.Histogram[i].UpperKey, key) >= 0
+	})
+}
+
+// Merge combines the stats of disjoint sets of objects, such as the shards
+// of a class
+func Merge(stats ...Stats) Stats {
+	out := Stats{Distinct: sketch.NewDefaultHyperLogLog(), HasNullCount: len(stats) > 0}
+
+	var buckets []HistogramBucket
+	for _, s := range stats {
+		out.Count += s.Count
+		out.NullCount += s.NullCount
+		out.HasNullCount = out.HasNullCount && s.HasNullCount
+		// sketches are always created with the default precision
+		out.Distinct.Merge(s.Distinct)
+		buckets = append(buckets, s.Histogram...)
+
+		if s.Count == 0 {
+			continue
+		}
+		if out.MinKey == nil || bytes.Compare(s.MinKey, out.MinKey) < 0 {
+			out.MinKey, out.Min = s.MinKey, s.Min
+		}
+		if out.MaxKey == nil || bytes.Compare(s.MaxKey, out.MaxKey) > 0 {
+			out.MaxKey, out.Max = s.MaxKey, s.Max
+		}
+	}
+
+	if !out.HasNullCount {
+		out.NullCount = 0
+	}
+	out.Histogram = MergeHistograms(buckets, out.Count)
+	return out
+}
+
+// MergeHistograms combines the buckets of overlapping histograms into one of
+// at most [MaxHistogramBuckets] buckets holding about count values each. As
+// the keys within a bucket are unknown, every bucket is treated as if all of
+// its values had its upper key, so the result stays within the bounds of
+// the original buckets.
+func MergeHistograms(buckets []HistogramBucket, count uint64) []HistogramBucket {
+	sorted := make([]HistogramBucket, len(buckets))
+	copy(sorted, buckets)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return bytes.Compare(sorted[a].UpperKey, sorted[b].UpperKey) < 0
+	})
+
+	depth := HistogramDepth(count)
+	var out []HistogramBucket
+	var current HistogramBucket
+	for i, bucket := range sorted {
+		current.Count += bucket.Count
+		current.Keys += bucket.Keys
+		current.UpperKey = bucket.UpperKey
+
+		last := i == len(sorted)-1
+		if !last && bytes.Equal(bucket.UpperKey, sorted[i+1].UpperKey) {
+			// the same key must not end up in two buckets
+			continue
+		}
+
+		if current.Count >= depth || last {
+			out = append(out, current)
+			current = HistogramBucket{}
+		}
+	}
+
+	return out
+}
+
+// HistogramDepth is the number of values per bucket for a histogram of count
+// values with at most [MaxHistogramBuckets] buckets
+func HistogramDepth(count uint64) uint64 {
+	depth := (count + MaxHistogramBuckets - 1) / MaxHistogramBuckets
+	if depth == 0 {
+		return 1
+	}
+	return depth
+}
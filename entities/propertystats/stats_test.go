@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package propertystats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/sketch"
+)
+
+func TestStats_Estimates(t *testing.T) {
+	// keys a-d hold 10 values each, e-h hold 30 values each
+	stats := Stats{
+		Count:  160,
+		MinKey: []byte("a"),
+		MaxKey: []byte("h"),
+		Histogram: []HistogramBucket{
+			{UpperKey: []byte("d"), Count: 40, Keys: 4},
+			{UpperKey: []byte("f"), Count: 60, Keys: 2},
+			{UpperKey: []byte("h"), Count: 60, Keys: 2},
+		},
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		assert.InDelta(t, 10.0/160, stats.EqualFraction([]byte("b")), 1e-9)
+		assert.InDelta(t, 30.0/160, stats.EqualFraction([]byte("g")), 1e-9)
+		assert.Equal(t, 0.0, stats.EqualFraction([]byte("0")))
+		assert.Equal(t, 0.0, stats.EqualFraction([]byte("z")))
+	})
+
+	t.Run("less than", func(t *testing.T) {
+		assert.Equal(t, 0.0, stats.LessThanFraction([]byte("a")))
+		assert.Equal(t, 1.0, stats.LessThanFraction([]byte("z")))
+		assert.InDelta(t, (40+30)/160.0, stats.LessThanFraction([]byte("e")), 1e-9)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		assert.InDelta(t, 1.0, stats.RangeFraction([]byte("0"), []byte("z")), 1e-9)
+		assert.Equal(t, 0.0, stats.RangeFraction([]byte("z"), []byte("a")))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, 0.0, Stats{}.EqualFraction([]byte("a")))
+		assert.Equal(t, 0.0, Stats{}.LessThanFraction([]byte("a")))
+		assert.Equal(t, uint64(0), Stats{}.DistinctCount())
+	})
+}
+
+func TestMerge(t *testing.T) {
+	sketchOf := func(keys ...string) *sketch.HyperLogLog {
+		h := sketch.NewDefaultHyperLogLog()
+		for _, k := range keys {
+			h.Add([]byte(k))
+		}
+		return h
+	}
+	lo, hi := 1.0, 9.0
+
+	a := Stats{
+		Count: 4, NullCount: 1, HasNullCount: true,
+		Distinct: sketchOf("a", "c"),
+		MinKey:   []byte("a"), MaxKey: []byte("c"), Min: &lo,
+		Histogram: []HistogramBucket{{UpperKey: []byte("c"), Count: 4, Keys: 2}},
+	}
+	b := Stats{
+		Count: 2, NullCount: 2, HasNullCount: true,
+		Distinct: sketchOf("c", "z"),
+		MinKey:   []byte("c"), MaxKey: []byte("z"), Max: &hi,
+		Histogram: []HistogramBucket{
+			{UpperKey: []byte("c"), Count: 1, Keys: 1},
+			{UpperKey: []byte("z"), Count: 1, Keys: 1},
+		},
+	}
+
+	merged := Merge(a, b, Stats{HasNullCount: true})
+	assert.Equal(t, uint64(6), merged.Count)
+	assert.Equal(t, uint64(3), merged.NullCount)
+	assert.True(t, merged.HasNullCount)
+	assert.Equal(t, uint64(3), merged.DistinctCount())
+	assert.Equal(t, []byte("a"), merged.MinKey)
+	assert.Equal(t, []byte("z"), merged.MaxKey)
+	assert.Equal(t, &lo, merged.Min)
+	assert.Equal(t, &hi, merged.Max)
+	assert.Equal(t, []HistogramBucket{
+		{UpperKey: []byte("c"), Count: 5, Keys: 3},
+		{UpperKey: []byte("z"), Count: 1, Keys: 1},
+	}, merged.Histogram)
+
+	t.Run("null count unknown for any shard", func(t *testing.T) {
+		merged := Merge(a, Stats{})
+		assert.False(t, merged.HasNullCount)
+		assert.Equal(t, uint64(0), merged.NullCount)
+	})
+}
+
+func TestMergeHistograms(t *testing.T) {
+	var buckets []HistogramBucket
+	for i := 0; i < 1000; i++ {
+		buckets = append(buckets, HistogramBucket{
+			UpperKey: []byte{byte(i / 4)}, Count: 1, Keys: 1,
+		})
+	}
+
+	merged := MergeHistograms(buckets, 1000)
+	assert.LessOrEqual(t, len(merged), MaxHistogramBuckets)
+
+	var count uint64
+	for i, bucket := range merged {
+		count += bucket.Count
+		if i > 0 {
+			assert.NotEqual(t, merged[i-1].UpperKey, bucket.UpperKey)
+		}
+	}
+	assert.Equal(t, uint64(1000), count)
+}
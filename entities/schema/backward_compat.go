@@ -36,11 +36,19 @@ func GetClassByName(s *models.Schema, className string) (*models.Class, error) {
 }
 
 // GetPropertyByName returns the class by its name
+//
+// A dotted path into a property of data type object or object[], such as
+// "address.city", returns the nested property at that path. Its name is the
+// full path, see [FlattenNestedProperties].
 func GetPropertyByName(c *models.Class, propName string) (*models.Property, error) {
+	path := strings.Split(propName, NestedPathSeparator)
 	// For each class-property
 	for _, prop := range c.Properties {
 		// Check if the name of the property is the given name, that's the property we need
-		if prop.Name == strings.Split(propName, ".")[0] {
+		if prop.Name == path[0] {
+			if _, ok := AsNested(prop.DataType); ok && len(path) > 1 {
+				return nestedPropertyByPath(c.Class, prop, path[1:])
+			}
 			return prop, nil
 		}
 	}
@@ -113,7 +121,9 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeIntArray),
 		string(DataTypeNumberArray),
 		string(DataTypeBooleanArray),
-		string(DataTypeDateArray):
+		string(DataTypeDateArray),
+		string(DataTypeObject),
+		string(DataTypeObjectArray):
 		return true
 	}
 	return false
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExternalBlobPrefix starts the value of a blob property which is stored in
+// the external blob store. The colon is not part of the base64 alphabet, so
+// references can't be confused with inline blobs.
+const ExternalBlobPrefix = "external:"
+
+// StoresBlobExternally returns true if the property is a blob which is kept
+// in the external blob store
+func StoresBlobExternally(prop *models.Property) bool {
+	return prop.BlobStorage == models.PropertyBlobStorageExternal &&
+		len(prop.DataType) == 1 && prop.DataType[0] == string(DataTypeBlob)
+}
+
+// BlobPrefix is the key prefix of all externally stored blobs of an object
+func BlobPrefix(className, tenant string, id strfmt.UUID) string {
+	if tenant == "" {
+		return className + "/" + id.String() + "/"
+	}
+	return className + "/tenants/" + tenant + "/" + id.String() + "/"
+}
+
+// BlobKey is the key of an externally stored blob in the blob store
+func BlobKey(className, tenant string, id strfmt.UUID, propName string) string {
+	return BlobPrefix(className, tenant, id) + propName
+}
+
+// ExternalBlobRef returns the value a blob property holds instead of the
+// bytes stored under key
+func ExternalBlobRef(key string) string {
+	return ExternalBlobPrefix + key
+}
+
+// ParseExternalBlobRef returns the key of the blob store the value refers
+// to, ok is false for inline blobs
+func ParseExternalBlobRef(value string) (key string, ok bool) {
+	key, ok = strings.CutPrefix(value, ExternalBlobPrefix)
+	return key, ok && key != ""
+}
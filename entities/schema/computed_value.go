@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ComputedValueFunc is the function of a computed value expression
+type ComputedValueFunc string
+
+const (
+	// ComputedValueNow evaluates to the time of the write
+	ComputedValueNow ComputedValueFunc = "now"
+	// ComputedValueConcat evaluates to the concatenation of its arguments
+	ComputedValueConcat ComputedValueFunc = "concat"
+)
+
+// ComputedValueArg is a single argument of a computed value expression. It
+// is either a reference to another property of the class or a literal.
+type ComputedValueArg struct {
+	Property string
+	Literal  string
+}
+
+// IsLiteral returns true if the argument is a literal rather than a
+// reference to a property
+func (a ComputedValueArg) IsLiteral() bool {
+	return a.Property == ""
+}
+
+// ComputedValue is the parsed form of a property's computedValue
+type ComputedValue struct {
+	Func ComputedValueFunc
+	Args []ComputedValueArg
+}
+
+// ParseComputedValue parses a computed value expression. Supported are
+// `now()` and `concat(arg, ...)` where each arg is either a property name
+// or a double-quoted literal.
+func ParseComputedValue(expr string) (*ComputedValue, error) {
+	expr = strings.TrimSpace(expr)
+	open := strings.IndexByte(expr, '(')
+	if open < 0 || !strings.HasSuffix(expr, ")") {
+		return nil, fmt.Errorf("computed value %q: expected a function call", expr)
+	}
+
+	fn := ComputedValueFunc(strings.TrimSpace(expr[:open]))
+	args, err := parseComputedValueArgs(expr[open+1 : len(expr)-1])
+	if err != nil {
+		return nil, fmt.Errorf("computed value %q: %w", expr, err)
+	}
+
+	switch fn {
+	case ComputedValueNow:
+		if len(args) != 0 {
+			return nil, fmt.Errorf("computed value %q: now() takes no arguments", expr)
+		}
+	case ComputedValueConcat:
+		if len(args) == 0 {
+			return nil, fmt.Errorf("computed value %q: concat() requires at least one argument", expr)
+		}
+	default:
+		return nil, fmt.Errorf("computed value %q: unknown function %q, must be one of %q or %q",
+			expr, fn, ComputedValueNow, ComputedValueConcat)
+	}
+
+	return &ComputedValue{Func: fn, Args: args}, nil
+}
+
+func parseComputedValueArgs(in string) ([]ComputedValueArg, error) {
+	if strings.TrimSpace(in) == "" {
+		return nil, nil
+	}
+
+	var args []ComputedValueArg
+	for pos := 0; ; {
+		for pos < len(in) && in[pos] == ' ' {
+			pos++
+		}
+
+		var arg ComputedValueArg
+		if pos < len(in) && in[pos] == '"' {
+			end := pos + 1
+			for ; end < len(in) && in[end] != '"'; end++ {
+				if in[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(in) {
+				return nil, fmt.Errorf("unterminated literal")
+			}
+			literal, err := strconv.Unquote(in[pos : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid literal %s: %w", in[pos:end+1], err)
+			}
+			arg.Literal = literal
+			pos = end + 1
+		} else {
+			end := strings.IndexByte(in[pos:], ',')
+			if end < 0 {
+				end = len(in) - pos
+			}
+			name := strings.TrimSpace(in[pos : pos+end])
+			if _, err := ValidatePropertyName(name); err != nil {
+				return nil, fmt.Errorf("invalid argument: %w", err)
+			}
+			arg.Property = name
+			pos += end
+		}
+		args = append(args, arg)
+
+		for pos < len(in) && in[pos] == ' ' {
+			pos++
+		}
+		if pos == len(in) {
+			return args, nil
+		}
+		if in[pos] != ',' {
+			return nil, fmt.Errorf("expected ',' after argument %d", len(args))
+		}
+		pos++
+	}
+}
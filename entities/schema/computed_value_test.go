@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComputedValue(t *testing.T) {
+	t.Run("valid expressions", func(t *testing.T) {
+		tests := []struct {
+			expr     string
+			expected *ComputedValue
+		}{
+			{
+				expr:     "now()",
+				expected: &ComputedValue{Func: ComputedValueNow},
+			},
+			{
+				expr: " concat( firstName ) ",
+				expected: &ComputedValue{
+					Func: ComputedValueConcat,
+					Args: []ComputedValueArg{{Property: "firstName"}},
+				},
+			},
+			{
+				expr: `concat(firstName, " ", lastName)`,
+				expected: &ComputedValue{
+					Func: ComputedValueConcat,
+					Args: []ComputedValueArg{
+						{Property: "firstName"},
+						{Literal: " "},
+						{Property: "lastName"},
+					},
+				},
+			},
+			{
+				expr: `concat("a, \"b\"",c)`,
+				expected: &ComputedValue{
+					Func: ComputedValueConcat,
+					Args: []ComputedValueArg{
+						{Literal: `a, "b"`},
+						{Property: "c"},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.expr, func(t *testing.T) {
+				parsed, err := ParseComputedValue(test.expr)
+				require.Nil(t, err)
+				assert.Equal(t, test.expected, parsed)
+			})
+		}
+	})
+
+	t.Run("invalid expressions", func(t *testing.T) {
+		tests := []string{
+			"",
+			"now",
+			"now(foo)",
+			"concat()",
+			"upper(name)",
+			"concat(a,)",
+			"concat(a b)",
+			`concat("unterminated)`,
+			`concat("a" b)`,
+			"concat(1abc)",
+		}
+
+		for _, expr := range tests {
+			t.Run(expr, func(t *testing.T) {
+				_, err := ParseComputedValue(expr)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+}
@@ -76,6 +76,28 @@ func TestNonExistingClassPropertyBelongsTo(t *testing.T) {
 	assert.True(t, pdt.ContainsClass(ClassName(className)))
 }
 
+func TestFederatedClassSingleRef(t *testing.T) {
+	s := Empty()
+
+	pdt, err := s.FindPropertyDataType([]string{"remote-peer/RemoteClass"})
+
+	require.Nil(t, err)
+	assert.True(t, pdt.IsReference())
+	assert.True(t, pdt.ContainsClass(ClassName("remote-peer/RemoteClass")))
+}
+
+func TestSplitFederatedClassName(t *testing.T) {
+	peer, class, ok := SplitFederatedClassName("remote-peer/RemoteClass")
+	assert.True(t, ok)
+	assert.Equal(t, "remote-peer", peer)
+	assert.Equal(t, ClassName("RemoteClass"), class)
+
+	for _, invalid := range []string{"RemoteClass", "localhost/RemoteClass", "peer/remoteClass", "/RemoteClass"} {
+		_, _, ok := SplitFederatedClassName(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
 func TestGetPropertyDataType(t *testing.T) {
 	class := &models.Class{Class: "TestClass"}
 	dataTypes := []string{
@@ -240,6 +262,7 @@ func Test_DataType_AsPrimitive(t *testing.T) {
 		for _, inputDataType := range [][]string{
 			{"SomeClass"},
 			{"SomeOtherClass", "AndAnotherOne"},
+			{"remote-peer/SomeClass"},
 		} {
 			testCases = append(testCases, testCase{
 				name:                fmt.Sprintf("%v", inputDataType),
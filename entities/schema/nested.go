@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NestedPathSeparator separates the segments of the path of a nested
+// property, e.g. "address.city"
+const NestedPathSeparator = "."
+
+// ValidatePropertyPath validates a property name which may be a dotted path
+// into nested properties, e.g. "address.city"
+func ValidatePropertyPath(path string) (PropertyName, error) {
+	for _, segment := range strings.Split(path, NestedPathSeparator) {
+		if _, err := ValidatePropertyName(segment); err != nil {
+			return "", err
+		}
+	}
+	return PropertyName(path), nil
+}
+
+// FlattenNestedProperties returns props with each property of data type
+// object or object[] replaced by the leaves of its nested properties. Leaves
+// are named by their dotted path and are indexed like regular properties of
+// that name. If any object on the path of a leaf is an object[], the leaf
+// holds the values of all array elements and therefore has the array
+// version of its data type.
+func FlattenNestedProperties(props []*models.Property) []*models.Property {
+	var out []*models.Property
+	for _, prop := range props {
+		dt, ok := AsNested(prop.DataType)
+		if !ok {
+			out = append(out, prop)
+			continue
+		}
+		out = appendNestedLeaves(out, prop.Name, dt == DataTypeObjectArray,
+			prop.NestedProperties)
+	}
+	return out
+}
+
+func appendNestedLeaves(out []*models.Property, prefix string, inArray bool,
+	nested []*models.NestedProperty,
+) []*models.Property {
+	for _, np := range nested {
+		path := prefix + NestedPathSeparator + np.Name
+		if dt, ok := AsNested(np.DataType); ok {
+			out = appendNestedLeaves(out, path, inArray || dt == DataTypeObjectArray,
+				np.NestedProperties)
+			continue
+		}
+		out = append(out, nestedAsProperty(path, inArray, np))
+	}
+	return out
+}
+
+// nestedAsProperty describes the nested property np at path as a regular
+// property
+func nestedAsProperty(path string, inArray bool, np *models.NestedProperty) *models.Property {
+	dataType := np.DataType
+	if inArray && len(dataType) == 1 {
+		if arrayType, ok := arrayDataType(DataType(dataType[0])); ok {
+			dataType = arrayType.PropString()
+		}
+	}
+
+	return &models.Property{
+		Name:             path,
+		DataType:         dataType,
+		Description:      np.Description,
+		IndexFilterable:  np.IndexFilterable,
+		IndexSearchable:  np.IndexSearchable,
+		Tokenization:     np.Tokenization,
+		NestedProperties: np.NestedProperties,
+	}
+}
+
+// arrayDataType returns the array version of a primitive data type. Array
+// data types are returned as is.
+func arrayDataType(dt DataType) (DataType, bool) {
+	if _, ok := IsArrayType(dt); ok {
+		return dt, true
+	}
+	switch dt {
+	case DataTypeString:
+		return DataTypeStringArray, true
+	case DataTypeText:
+		return DataTypeTextArray, true
+	case DataTypeInt:
+		return DataTypeIntArray, true
+	case DataTypeNumber:
+		return DataTypeNumberArray, true
+	case DataTypeBoolean:
+		return DataTypeBooleanArray, true
+	case DataTypeDate:
+		return DataTypeDateArray, true
+	case DataTypeUUID:
+		return DataTypeUUIDArray, true
+	default:
+		return "", false
+	}
+}
+
+// nestedPropertyByPath resolves the dotted path below the nested property
+// prop, see [FlattenNestedProperties] for the description of the returned
+// property
+func nestedPropertyByPath(className string, prop *models.Property, path []string) (*models.Property, error) {
+	dt, _ := AsNested(prop.DataType)
+	inArray := dt == DataTypeObjectArray
+	name := prop.Name
+	nested := prop.NestedProperties
+
+	var found *models.NestedProperty
+	for i, segment := range path {
+		found = nil
+		for _, np := range nested {
+			if np.Name == segment {
+				found = np
+				break
+			}
+		}
+		name += NestedPathSeparator + segment
+		if found == nil {
+			return nil, fmt.Errorf(ErrorNoSuchProperty, name, className)
+		}
+
+		dt, ok := AsNested(found.DataType)
+		if !ok && i < len(path)-1 {
+			return nil, fmt.Errorf(ErrorNoSuchProperty,
+				strings.Join(append([]string{prop.Name}, path...), NestedPathSeparator), className)
+		}
+		inArray = inArray || dt == DataTypeObjectArray
+		nested = found.NestedProperties
+	}
+
+	return nestedAsProperty(name, inArray, found), nil
+}
+
+// FlattenNestedValues returns a copy of values in which the values of each
+// property of data type object or object[] are replaced by the values of
+// their leaves, keyed by their dotted path. It complements
+// [FlattenNestedProperties].
+func FlattenNestedValues(props []*models.Property, values map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		out[key] = value
+	}
+
+	for _, prop := range props {
+		dt, ok := AsNested(prop.DataType)
+		if !ok {
+			continue
+		}
+		value, ok := out[prop.Name]
+		if !ok {
+			continue
+		}
+		delete(out, prop.Name)
+		flattenNestedValue(out, prop.Name, dt == DataTypeObjectArray, prop.NestedProperties, value)
+	}
+
+	return out
+}
+
+func flattenNestedValue(out map[string]interface{}, prefix string, inArray bool,
+	nested []*models.NestedProperty, value interface{},
+) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for _, np := range nested {
+			sub, ok := typed[np.Name]
+			if !ok || sub == nil {
+				continue
+			}
+			path := prefix + NestedPathSeparator + np.Name
+			if dt, ok := AsNested(np.DataType); ok {
+				flattenNestedValue(out, path, inArray || dt == DataTypeObjectArray,
+					np.NestedProperties, sub)
+				continue
+			}
+			if !inArray {
+				out[path] = sub
+				continue
+			}
+			values, _ := out[path].([]interface{})
+			out[path] = appendValues(values, sub)
+		}
+	case []interface{}:
+		for _, elem := range typed {
+			flattenNestedValue(out, prefix, true, nested, elem)
+		}
+	}
+}
+
+// appendValues appends value to values. Slices of any type are appended
+// element by element.
+func appendValues(values []interface{}, value interface{}) []interface{} {
+	if typed, ok := value.([]interface{}); ok {
+		return append(values, typed...)
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return append(values, value)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		values = append(values, rv.Index(i).Interface())
+	}
+	return values
+}
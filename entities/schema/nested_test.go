@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func nestedTestClass() *models.Class {
+	return &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{Name: "name", DataType: DataTypeText.PropString()},
+			{
+				Name: "address", DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: DataTypeText.PropString(), Tokenization: "field"},
+					{Name: "zip", DataType: DataTypeInt.PropString()},
+					{
+						Name: "geo", DataType: DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "region", DataType: DataTypeText.PropString()},
+						},
+					},
+				},
+			},
+			{
+				Name: "pets", DataType: DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: DataTypeText.PropString()},
+					{Name: "tags", DataType: DataTypeTextArray.PropString()},
+				},
+			},
+		},
+	}
+}
+
+func TestFlattenNestedProperties(t *testing.T) {
+	props := FlattenNestedProperties(nestedTestClass().Properties)
+
+	names := make([]string, len(props))
+	dataTypes := map[string]string{}
+	for i, prop := range props {
+		names[i] = prop.Name
+		dataTypes[prop.Name] = prop.DataType[0]
+	}
+	assert.Equal(t, []string{
+		"name", "address.city", "address.zip", "address.geo.region",
+		"pets.name", "pets.tags",
+	}, names)
+	assert.Equal(t, "text", dataTypes["address.city"])
+	assert.Equal(t, "text[]", dataTypes["pets.name"], "leaves below object[] hold arrays")
+	assert.Equal(t, "text[]", dataTypes["pets.tags"])
+	assert.Equal(t, "field", props[1].Tokenization)
+}
+
+func TestGetPropertyByName_NestedPath(t *testing.T) {
+	class := nestedTestClass()
+
+	t.Run("leaf", func(t *testing.T) {
+		prop, err := GetPropertyByName(class, "address.geo.region")
+		require.Nil(t, err)
+		assert.Equal(t, "address.geo.region", prop.Name)
+		assert.Equal(t, DataTypeText.PropString(), prop.DataType)
+	})
+
+	t.Run("leaf below array", func(t *testing.T) {
+		prop, err := GetPropertyByName(class, "pets.name")
+		require.Nil(t, err)
+		assert.Equal(t, DataTypeTextArray.PropString(), prop.DataType)
+	})
+
+	t.Run("nested object", func(t *testing.T) {
+		prop, err := GetPropertyByName(class, "address.geo")
+		require.Nil(t, err)
+		assert.Equal(t, DataTypeObject.PropString(), prop.DataType)
+		assert.Len(t, prop.NestedProperties, 1)
+	})
+
+	t.Run("top level", func(t *testing.T) {
+		prop, err := GetPropertyByName(class, "address")
+		require.Nil(t, err)
+		assert.Same(t, class.Properties[1], prop)
+	})
+
+	t.Run("unknown paths", func(t *testing.T) {
+		_, err := GetPropertyByName(class, "address.country")
+		assert.NotNil(t, err)
+		_, err = GetPropertyByName(class, "address.city.name")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFlattenNestedValues(t *testing.T) {
+	class := nestedTestClass()
+	values := map[string]interface{}{
+		"name": "Jane",
+		"address": map[string]interface{}{
+			"city": "Berlin",
+			"zip":  float64(10115),
+			"geo":  map[string]interface{}{"region": "Europe"},
+		},
+		"pets": []interface{}{
+			map[string]interface{}{"name": "Rex", "tags": []interface{}{"dog", "good"}},
+			map[string]interface{}{"name": "Tom", "tags": []string{"cat"}},
+			map[string]interface{}{"tags": nil},
+		},
+	}
+
+	flattened := FlattenNestedValues(class.Properties, values)
+	assert.Equal(t, map[string]interface{}{
+		"name":               "Jane",
+		"address.city":       "Berlin",
+		"address.zip":        float64(10115),
+		"address.geo.region": "Europe",
+		"pets.name":          []interface{}{"Rex", "Tom"},
+		"pets.tags":          []interface{}{"dog", "good", "cat"},
+	}, flattened)
+	assert.Contains(t, values, "address", "input is not modified")
+}
+
+func TestValidatePropertyPath(t *testing.T) {
+	_, err := ValidatePropertyPath("address.city")
+	assert.Nil(t, err)
+	_, err = ValidatePropertyPath("address..city")
+	assert.NotNil(t, err)
+	_, err = ValidatePropertyPath("address.1city")
+	assert.NotNil(t, err)
+}
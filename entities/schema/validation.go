@@ -14,22 +14,26 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
 	validateClassNameRegex    *regexp.Regexp
 	validatePropertyNameRegex *regexp.Regexp
+	validatePeerNameRegex     *regexp.Regexp
 	reservedPropertyNames     []string
 )
 
 const (
 	ClassNameRegexCore = `[A-Z][_0-9A-Za-z]*`
 	ShardNameRegexCore = `[A-Za-z0-9\-\_]{1,64}`
+	PeerNameRegexCore  = `[a-z0-9][a-z0-9\-\_]{0,62}`
 )
 
 func init() {
 	validateClassNameRegex = regexp.MustCompile(`^` + ClassNameRegexCore + `$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	validatePeerNameRegex = regexp.MustCompile(`^` + PeerNameRegexCore + `$`)
 	reservedPropertyNames = []string{"_additional", "_id", "id"}
 }
 
@@ -41,6 +45,47 @@ func ValidateClassName(name string) (ClassName, error) {
 	return "", fmt.Errorf("'%s' is not a valid class name", name)
 }
 
+// ValidatePeerName validates that this string is a valid name for a
+// federation peer. Peer names show up as the host part of beacons, so they
+// are restricted to lowercase hostname-like strings. "localhost" is reserved
+// for the local cluster.
+func ValidatePeerName(name string) error {
+	if name == "localhost" {
+		return fmt.Errorf("'%s' is reserved for the local cluster", name)
+	}
+	if !validatePeerNameRegex.MatchString(name) {
+		return fmt.Errorf("'%s' is not a valid peer name, must match /%s/",
+			name, PeerNameRegexCore)
+	}
+	return nil
+}
+
+// SplitFederatedClassName splits a reference dataType of the form
+// "<peerName>/<ClassName>" into its peer and class part. Such a dataType
+// points to a class hosted on a remote cluster which is registered as a
+// federation peer. ok is false if the dataType is not of this form.
+func SplitFederatedClassName(dataType string) (peerName string, className ClassName, ok bool) {
+	peer, class, found := strings.Cut(dataType, "/")
+	if !found {
+		return "", "", false
+	}
+	if err := ValidatePeerName(peer); err != nil {
+		return "", "", false
+	}
+	cn, err := ValidateClassName(class)
+	if err != nil {
+		return "", "", false
+	}
+	return peer, cn, true
+}
+
+// IsFederatedClassName returns true if the class name refers to a class
+// hosted on a federation peer
+func IsFederatedClassName(name ClassName) bool {
+	_, _, ok := SplitFederatedClassName(string(name))
+	return ok
+}
+
 // ValidatePropertyName validates that this string is a valid property name
 func ValidatePropertyName(name string) (PropertyName, error) {
 	if validatePropertyNameRegex.MatchString(name) {
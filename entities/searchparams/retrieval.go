@@ -12,10 +12,12 @@
 package searchparams
 
 type NearVector struct {
-	Vector       []float32 `json:"vector"`
-	Certainty    float64   `json:"certainty"`
-	Distance     float64   `json:"distance"`
-	WithDistance bool      `json:"-"`
+	Vector       []float32   `json:"vector"`
+	Certainty    float64     `json:"certainty"`
+	Distance     float64     `json:"distance"`
+	WithDistance bool        `json:"-"`
+	MoveTo       *VectorMove `json:"moveTo,omitempty"`
+	MoveAwayFrom *VectorMove `json:"moveAwayFrom,omitempty"`
 }
 
 type KeywordRanking struct {
@@ -23,6 +25,9 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// AutoCorrect runs the search again with the best spelling correction
+	// of the query if the query itself finds nothing
+	AutoCorrect bool `json:"autoCorrect"`
 }
 
 type WeightedSearchResult struct {
@@ -42,11 +47,13 @@ type HybridSearch struct {
 }
 
 type NearObject struct {
-	ID           string  `json:"id"`
-	Beacon       string  `json:"beacon"`
-	Certainty    float64 `json:"certainty"`
-	Distance     float64 `json:"distance"`
-	WithDistance bool    `json:"-"`
+	ID           string      `json:"id"`
+	Beacon       string      `json:"beacon"`
+	Certainty    float64     `json:"certainty"`
+	Distance     float64     `json:"distance"`
+	WithDistance bool        `json:"-"`
+	MoveTo       *VectorMove `json:"moveTo,omitempty"`
+	MoveAwayFrom *VectorMove `json:"moveAwayFrom,omitempty"`
 }
 
 type ObjectMove struct {
@@ -54,6 +61,15 @@ type ObjectMove struct {
 	Beacon string
 }
 
+// VectorMove moves the search vector of nearVector or nearObject closer to
+// (or further away from) the mean of the vectors of existing objects and
+// provided vectors
+type VectorMove struct {
+	Force   float32      `json:"force"`
+	Objects []ObjectMove `json:"objects"`
+	Vectors [][]float32  `json:"vectors"`
+}
+
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
 type ExploreMove struct {
 	Values  []string
@@ -73,8 +89,69 @@ type NearTextParams struct {
 	Autocorrect  bool
 }
 
+// Rerank reorders the top results of a search. With a query the text of
+// the property is scored by a reranker module, without one results are
+// ordered by the numeric value of the property.
+type Rerank struct {
+	Property string `json:"property"`
+	Query    string `json:"query"`
+	// Limit is the number of top results which are reranked
+	Limit int `json:"limit"`
+}
+
+// Diversify reorders the results of a search by Maximal Marginal Relevance,
+// so that results which are similar to higher ranked results move down.
+// Zero values select the defaults of the explorer.
+type Diversify struct {
+	// Lambda weighs the relevance of a result against its similarity to
+	// higher ranked results, 1 only considers the relevance
+	Lambda float64 `json:"lambda"`
+	// CandidateMultiplier is the factor by which the limit of the search is
+	// raised to select the results from
+	CandidateMultiplier int `json:"candidateMultiplier"`
+}
+
+// CustomScore reorders the results of a search by the value of an
+// expression, e.g. "0.7*similarity + 0.3*log(popularity)"
+type CustomScore struct {
+	Expression string `json:"expression"`
+}
+
+// TimeDecay boosts recent results of a hybrid or bm25 search by combining
+// their score with a decay function over a date property. Zero values
+// select the defaults of the hybrid searcher.
+type TimeDecay struct {
+	Property string `json:"property"`
+	// Origin is the RFC3339 date with the highest boost, now if empty
+	Origin string `json:"origin"`
+	// Scale is the distance from origin plus offset at which the boost has
+	// dropped to Decay, e.g. "12h" or "7d"
+	Scale string `json:"scale"`
+	// Offset is the distance from origin within which dates are not decayed
+	Offset string `json:"offset"`
+	// Decay is the boost at scale, between 0 and 1
+	Decay float64 `json:"decay"`
+	// Function is the shape of the decay, exp, gauss or linear
+	Function string `json:"function"`
+	// Weight weighs the boost against the score of a result, 1 only
+	// considers the boost
+	Weight float64 `json:"weight"`
+}
+
+const (
+	// GroupByStrategyProperty groups results by the value of a property, it
+	// is used if no strategy is set
+	GroupByStrategyProperty = "property"
+	// GroupByStrategyVector clusters the top results of a search by the
+	// similarity of their vectors
+	GroupByStrategyVector = "vector"
+)
+
 type GroupBy struct {
 	Property        string
 	Groups          int
 	ObjectsPerGroup int
+	Strategy        string
+	// Candidates is the number of top results the vector strategy clusters
+	Candidates int
 }
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// DefaultPrecision uses 2^12 registers, which estimates with a standard error
+// of about 1.6% in 4KiB
+const DefaultPrecision = 12
+
+const (
+	minPrecision = 4
+	maxPrecision = 16
+)
+
+// HyperLogLog estimates the number of distinct values added to it. Sketches
+// of the same precision can be merged, the result estimates the number of
+// distinct values added to any of them. It is not safe for concurrent use.
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func NewHyperLogLog(precision uint8) (*HyperLogLog, error) {
+	if precision < minPrecision || precision > maxPrecision {
+		return nil, fmt.Errorf("precision must be between %d and %d, got %d",
+			minPrecision, maxPrecision, precision)
+	}
+
+	return &HyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}, nil
+}
+
+// NewDefaultHyperLogLog creates a sketch with the [DefaultPrecision]
+func NewDefaultHyperLogLog() *HyperLogLog {
+	h, _ := NewHyperLogLog(DefaultPrecision)
+	return h
+}
+
+func (h *HyperLogLog) Precision() uint8 {
+	return h.precision
+}
+
+func (h *HyperLogLog) Add(value []byte) {
+	h.AddHash(murmur3.Sum64(value))
+}
+
+// AddHash adds a value which was already hashed to 64 uniformly distributed
+// bits
+func (h *HyperLogLog) AddHash(hash uint64) {
+	index := hash >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Merge adds all values of other to h
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if other == nil {
+		return nil
+	}
+
+	if other.precision != h.precision {
+		return fmt.Errorf("cannot merge sketches of precision %d and %d",
+			h.precision, other.precision)
+	}
+
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Estimate returns the estimated number of distinct values. Small
+// cardinalities are estimated with linear counting, which is much more
+// accurate while many registers are still empty.
+func (h *HyperLogLog) Estimate() uint64 {
+	m := float64(len(h.registers))
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+func (h *HyperLogLog) Clone() *HyperLogLog {
+	registers := make([]uint8, len(h.registers))
+	copy(registers, h.registers)
+	return &HyperLogLog{precision: h.precision, registers: registers}
+}
+
+// MarshalBinary encodes the precision followed by one byte per register
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 1+len(h.registers))
+	out[0] = h.precision
+	copy(out[1:], h.registers)
+	return out, nil
+}
+
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("empty sketch")
+	}
+
+	precision := data[0]
+	if precision < minPrecision || precision > maxPrecision {
+		return fmt.Errorf("invalid precision %d", precision)
+	}
+
+	if len(data) != 1+1<<precision {
+		return fmt.Errorf("sketch of precision %d needs %d registers, got %d",
+			precision, 1<<precision, len(data)-1)
+	}
+
+	h.precision = precision
+	h.registers = make([]uint8, 1<<precision)
+	copy(h.registers, data[1:])
+	return nil
+}
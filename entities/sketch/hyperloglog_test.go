@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		h := NewDefaultHyperLogLog()
+		assert.Equal(t, uint64(0), h.Estimate())
+	})
+
+	t.Run("duplicates are counted once", func(t *testing.T) {
+		h := NewDefaultHyperLogLog()
+		for i := 0; i < 1000; i++ {
+			h.Add([]byte(fmt.Sprintf("value-%d", i%10)))
+		}
+		assert.Equal(t, uint64(10), h.Estimate())
+	})
+
+	for _, n := range []int{100, 10_000, 1_000_000} {
+		t.Run(fmt.Sprintf("%d distinct values", n), func(t *testing.T) {
+			h := NewDefaultHyperLogLog()
+			for i := 0; i < n; i++ {
+				h.Add([]byte(fmt.Sprintf("value-%d", i)))
+			}
+			assert.InEpsilon(t, n, h.Estimate(), 0.05)
+		})
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		a, b := NewDefaultHyperLogLog(), NewDefaultHyperLogLog()
+		for i := 0; i < 20_000; i++ {
+			a.Add([]byte(fmt.Sprintf("value-%d", i)))
+		}
+		// overlaps with a for half of its values
+		for i := 10_000; i < 30_000; i++ {
+			b.Add([]byte(fmt.Sprintf("value-%d", i)))
+		}
+
+		merged := a.Clone()
+		require.Nil(t, merged.Merge(b))
+		assert.InEpsilon(t, 30_000, merged.Estimate(), 0.05)
+		assert.InEpsilon(t, 20_000, a.Estimate(), 0.05, "clone is independent")
+
+		other, err := NewHyperLogLog(10)
+		require.Nil(t, err)
+		assert.NotNil(t, merged.Merge(other))
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		h := NewDefaultHyperLogLog()
+		for i := 0; i < 5000; i++ {
+			h.Add([]byte(fmt.Sprintf("value-%d", i)))
+		}
+
+		data, err := h.MarshalBinary()
+		require.Nil(t, err)
+
+		var decoded HyperLogLog
+		require.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, h.Estimate(), decoded.Estimate())
+
+		assert.NotNil(t, decoded.UnmarshalBinary(data[:100]))
+		assert.NotNil(t, decoded.UnmarshalBinary([]byte{40}))
+	})
+
+	t.Run("invalid precision", func(t *testing.T) {
+		_, err := NewHyperLogLog(2)
+		assert.NotNil(t, err)
+	})
+}
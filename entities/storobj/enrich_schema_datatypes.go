@@ -58,6 +58,10 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 				// property type information alongside the value to avoid
 				// this situation
 				schema[propName] = typed
+			} else if !isCrossRefValue(typed) {
+				// an object[] property, nested values are kept as they were
+				// unmarshalled
+				schema[propName] = typed
 			} else {
 				parsed, err := parseCrossRef(typed)
 				if err != nil {
@@ -86,17 +90,54 @@ func parseMapProp(input map[string]interface{}) (interface{}, error) {
 	lon, lonOK := input["longitude"]
 	_, phoneInputOK := input["input"]
 
-	if latOK && lonOK {
-		// this is a geoCoordinates prop
+	if latOK && lonOK && len(input) == 2 {
+		// this is a geoCoordinates prop. An object prop with exactly these
+		// two keys can't be told apart from it and is returned as one, too
 		return parseGeoProp(lat, lon)
 	}
 
-	if phoneInputOK {
+	if phoneInputOK && isPhoneNumberValue(input) {
 		// this is a phone number
 		return parsePhoneNumber(input)
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	// this is an object prop, nested values are kept as they were
+	// unmarshalled
+	return input, nil
+}
+
+var phoneNumberKeys = map[string]struct{}{
+	"input": {}, "internationalFormatted": {}, "nationalFormatted": {}, "national": {},
+	"countryCode": {}, "defaultCountry": {}, "valid": {},
+}
+
+// isPhoneNumberValue tells a phone number apart from an object prop which
+// happens to have an "input" key
+func isPhoneNumberValue(input map[string]interface{}) bool {
+	if _, ok := input["input"].(string); !ok {
+		return false
+	}
+	for key := range input {
+		if _, ok := phoneNumberKeys[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isCrossRefValue tells references apart from object[] props, both are
+// arrays of maps
+func isCrossRefValue(value []interface{}) bool {
+	for _, elem := range value {
+		asMap, ok := elem.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := asMap["beacon"].(string); !ok {
+			return false
+		}
+	}
+	return true
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"unsafe"
 
 	"github.com/buger/jsonparser"
 
@@ -151,7 +152,7 @@ func FromBinaryOptional(data []byte,
 	ec.AddWrap(binary.Read(r, le, &updateTime), "update time")
 	ec.AddWrap(binary.Read(r, le, &vectorLength), "vector length")
 	ko.VectorLen = int(vectorLength)
-	if addProp.Vector {
+	if addProp.Vector && !addProp.SkipVector {
 		ko.Vector = make([]float32, vectorLength)
 		ec.AddWrap(binary.Read(r, le, &ko.Vector), "read vector")
 	} else {
@@ -369,6 +370,12 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.Cursor {
+			additionalProperties["cursor"] = ko.AdditionalProperties()["cursor"]
+		}
+		if additional.QueryPlan {
+			additionalProperties["queryPlan"] = ko.AdditionalProperties()["queryPlan"]
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
@@ -443,6 +450,22 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// LastUpdateTimeFromBinary reads the last update time of an object without
+// parsing the rest of the object
+func LastUpdateTimeFromBinary(in []byte) (int64, error) {
+	// version, docID, kind, uuid and creation time precede the update time
+	const offset = 1 + 8 + 1 + 16 + 8
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+
+	if version := in[0]; version != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -730,6 +753,44 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// VectorViewFromBinary is like VectorFromBinary, but returns a view on the
+// vector within in instead of decoding it, if the platform is little endian
+// and the vector is aligned to 4 bytes. Otherwise it falls back to decoding
+// into buffer. This only saves decoding the vector, in itself must already be
+// a copy the caller owns, such as a value read from a bucket into a reused
+// buffer. As the result may share memory with in, it is only valid as long as
+// in is neither modified nor reused, and must not be modified itself.
+func VectorViewFromBinary(in []byte, buffer []float32) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	if in[0] != 1 || !littleEndian {
+		return VectorFromBinary(in, buffer)
+	}
+
+	vecLen := int(binary.LittleEndian.Uint16(in[42:44]))
+	if vecLen == 0 {
+		return buffer[:0], nil
+	}
+
+	vecStart := 44
+	if uintptr(unsafe.Pointer(&in[vecStart]))%4 != 0 {
+		return VectorFromBinary(in, buffer)
+	}
+
+	// check bounds before reinterpreting, the same way decoding would
+	_ = in[vecStart+vecLen*4-1]
+	return unsafe.Slice((*float32)(unsafe.Pointer(&in[vecStart])), vecLen), nil
+}
+
+// littleEndian is true if the platform's byte order matches the one vectors
+// are serialized with
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
 ) error {
@@ -14,6 +14,7 @@ package storobj
 import (
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
@@ -69,6 +70,15 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only last update time and compare", func(t *testing.T) {
+		updateTime, err := LastUpdateTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updateTime)
+
+		_, err = LastUpdateTimeFromBinary(asBinary[:20])
+		assert.NotNil(t, err)
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -158,6 +168,16 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 			assert.Equal(t, after.VectorLen, 3)
 		})
 	})
+
+	t.Run("skipped vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary,
+			additional.Properties{Vector: true, SkipVector: true})
+		require.Nil(t, err)
+
+		assert.Nil(t, after.Vector)
+		assert.Equal(t, 3, after.VectorLen)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
 }
 
 func TestNewStorageObject(t *testing.T) {
@@ -332,6 +352,52 @@ func TestStorageArrayObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageNestedObjectMarshalling(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city":  "Berlin",
+					"input": "not a phone number",
+					"geo":   map[string]interface{}{"region": "Europe"},
+				},
+				"pets": []interface{}{
+					map[string]interface{}{"name": "Rex", "tags": []interface{}{"dog"}},
+					map[string]interface{}{"name": "Tom"},
+				},
+				"location": map[string]interface{}{
+					"latitude":  float64(1),
+					"longitude": float64(2),
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+
+	props := after.Properties().(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"city":  "Berlin",
+		"input": "not a phone number",
+		"geo":   map[string]interface{}{"region": "Europe"},
+	}, props["address"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "Rex", "tags": []interface{}{"dog"}},
+		map[string]interface{}{"name": "Tom"},
+	}, props["pets"])
+	assert.IsType(t, &models.GeoCoordinates{}, props["location"])
+}
+
 func TestExtractionOfSingleProperties(t *testing.T) {
 	expected := map[string]interface{}{
 		"numberArray":  []interface{}{1.1, 2.1},
@@ -499,3 +565,42 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 		assert.Equal(t, "value2", group.Hits[1]["property1"])
 	})
 }
+
+func TestVectorViewFromBinary(t *testing.T) {
+	vector := []float32{1, 2, 0.7, -3}
+	obj := FromObject(&models.Object{
+		Class: "MyFavoriteClass",
+		ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+	}, vector)
+	obj.SetDocID(7)
+
+	asBinary, err := obj.MarshalBinary()
+	require.Nil(t, err)
+
+	// place the object at every possible alignment
+	backing := make([]byte, len(asBinary)+4)
+	for offset := 0; offset < 4; offset++ {
+		in := backing[offset : offset+len(asBinary)]
+		copy(in, asBinary)
+
+		out, err := VectorViewFromBinary(in, make([]float32, 0, len(vector)))
+		require.Nil(t, err)
+		assert.Equal(t, vector, out)
+
+		aligned := uintptr(unsafe.Pointer(&in[44]))%4 == 0
+		sharesMemory := unsafe.Pointer(&out[0]) == unsafe.Pointer(&in[44])
+		assert.Equal(t, aligned && littleEndian, sharesMemory)
+	}
+
+	t.Run("without vector", func(t *testing.T) {
+		asBinary, err := FromObject(&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		}, nil).MarshalBinary()
+		require.Nil(t, err)
+
+		out, err := VectorViewFromBinary(asBinary, nil)
+		require.Nil(t, err)
+		assert.Empty(t, out)
+	})
+}
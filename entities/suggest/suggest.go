@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package suggest
+
+import "sort"
+
+// Result lists the completions of a prefix on a property, most frequent
+// first
+type Result struct {
+	Class       string        `json:"class"`
+	Property    string        `json:"property"`
+	Prefix      string        `json:"prefix"`
+	Tenant      string        `json:"tenant,omitempty"`
+	Suggestions []*Suggestion `json:"suggestions"`
+}
+
+type Suggestion struct {
+	Term string `json:"term"`
+	// Frequency is the number of objects containing the term
+	Frequency int64 `json:"frequency"`
+}
+
+// Collector merges the term frequencies of several shards
+type Collector struct {
+	frequencies map[string]int64
+}
+
+func NewCollector() *Collector {
+	return &Collector{frequencies: map[string]int64{}}
+}
+
+func (c *Collector) Add(term string, frequency int64) {
+	if frequency <= 0 {
+		return
+	}
+	c.frequencies[term] += frequency
+}
+
+// Top returns the limit most frequent terms. Terms of equal frequency are
+// ordered alphabetically, so the result is deterministic.
+func (c *Collector) Top(limit int) []*Suggestion {
+	out := make([]*Suggestion, 0, len(c.frequencies))
+	for term, frequency := range c.frequencies {
+		out = append(out, &Suggestion{Term: term, Frequency: frequency})
+	}
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Frequency != out[b].Frequency {
+			return out[a].Frequency > out[b].Frequency
+		}
+		return out[a].Term < out[b].Term
+	})
+	if limit >= 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
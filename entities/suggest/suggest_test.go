@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package suggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	c.Add("apple", 3)
+	c.Add("apricot", 2)
+	c.Add("apple", 1)
+	c.Add("application", 4)
+	c.Add("ape", 0)
+
+	assert.Equal(t, []*Suggestion{
+		{Term: "apple", Frequency: 4},
+		{Term: "application", Frequency: 4},
+		{Term: "apricot", Frequency: 2},
+	}, c.Top(10))
+
+	assert.Equal(t, []*Suggestion{
+		{Term: "apple", Frequency: 4},
+	}, c.Top(1))
+
+	assert.Empty(t, NewCollector().Top(10))
+}
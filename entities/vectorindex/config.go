@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vectorindex contains what is shared between the vector index
+// types
+package vectorindex
+
+import (
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	VectorIndexTypeHNSW    = "hnsw"
+	VectorIndexTypeDynamic = "dynamic"
+)
+
+// HnswConfig returns the hnsw settings of a vector index config. The dynamic
+// index carries them for the hnsw index it eventually switches to, they also
+// hold the distance and skip settings it shares with it.
+func HnswConfig(cfg interface{}) (hnsw.UserConfig, bool) {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		return typed, true
+	case dynamic.UserConfig:
+		return typed.Hnsw, true
+	default:
+		return hnsw.UserConfig{}, false
+	}
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// DefaultThreshold is the number of objects in a shard above which the
+	// brute force search is replaced by an hnsw index
+	DefaultThreshold = 10000
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of the dynamic index. A shard starts out with a brute force search and
+// switches to an hnsw index, configured by Hnsw, once it holds more than
+// Threshold objects. The distance applies to both.
+type UserConfig struct {
+	Distance  string          `json:"distance"`
+	Threshold int             `json:"threshold"`
+	Hnsw      hnsw.UserConfig `json:"hnsw"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "dynamic"
+}
+
+// NewDefaultUserConfig returns the config used if the user leaves it blank
+func NewDefaultUserConfig() UserConfig {
+	return UserConfig{
+		Distance:  hnsw.DefaultDistanceMetric,
+		Threshold: DefaultThreshold,
+		Hnsw:      hnsw.NewDefaultUserConfig(),
+	}
+}
+
+// ParseAndValidateConfig from an unknown input value. The hnsw settings are
+// parsed by parseHnsw, so they are validated exactly like those of an hnsw
+// index. A distance set at the top level takes precedence over one set in
+// the hnsw settings.
+func ParseAndValidateConfig(input interface{},
+	parseHnsw func(in interface{}) (schema.VectorIndexConfig, error),
+) (schema.VectorIndexConfig, error) {
+	uc := NewDefaultUserConfig()
+
+	hnswInput := map[string]interface{}{}
+	if input != nil {
+		asMap, ok := input.(map[string]interface{})
+		if !ok || asMap == nil {
+			return uc, fmt.Errorf("input must be a non-nil map")
+		}
+
+		if threshold, ok := asMap["threshold"]; ok {
+			switch typed := threshold.(type) {
+			case json.Number:
+				asInt, err := typed.Int64()
+				if err != nil {
+					return uc, fmt.Errorf("json.Number to int64 for \"threshold\": %w", err)
+				}
+				uc.Threshold = int(asInt)
+			case float64:
+				uc.Threshold = int(typed)
+			default:
+				return uc, fmt.Errorf("threshold must be a number, got %T", threshold)
+			}
+		}
+
+		if nested, ok := asMap["hnsw"]; ok && nested != nil {
+			nestedMap, ok := nested.(map[string]interface{})
+			if !ok {
+				return uc, fmt.Errorf("hnsw must be an object, got %T", nested)
+			}
+			for k, v := range nestedMap {
+				hnswInput[k] = v
+			}
+		}
+
+		if distance, ok := asMap["distance"]; ok {
+			hnswInput["distance"] = distance
+		}
+	}
+
+	parsed, err := parseHnsw(hnswInput)
+	if err != nil {
+		return uc, fmt.Errorf("hnsw: %w", err)
+	}
+
+	hnswConfig, ok := parsed.(hnsw.UserConfig)
+	if !ok {
+		return uc, fmt.Errorf("hnsw: config is not hnsw.UserConfig, but %T", parsed)
+	}
+	uc.Hnsw = hnswConfig
+	uc.Distance = hnswConfig.Distance
+
+	if uc.Threshold < 1 {
+		return uc, fmt.Errorf("invalid dynamic config: threshold must be a positive integer, got %d",
+			uc.Threshold)
+	}
+
+	return uc, nil
+}
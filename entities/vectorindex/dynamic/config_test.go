@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	t.Run("nothing specified, all defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil, hnsw.ParseAndValidateConfig)
+		require.Nil(t, err)
+
+		expected := NewDefaultUserConfig()
+		assert.Equal(t, expected, cfg)
+		assert.Equal(t, "dynamic", cfg.IndexType())
+	})
+
+	t.Run("threshold and nested hnsw settings", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold": json.Number("500"),
+			"hnsw": map[string]interface{}{
+				"ef":             json.Number("64"),
+				"maxConnections": json.Number("16"),
+			},
+		}, hnsw.ParseAndValidateConfig)
+		require.Nil(t, err)
+
+		parsed := cfg.(UserConfig)
+		assert.Equal(t, 500, parsed.Threshold)
+		assert.Equal(t, 64, parsed.Hnsw.EF)
+		assert.Equal(t, 16, parsed.Hnsw.MaxConnections)
+		assert.Equal(t, hnsw.DefaultDistanceMetric, parsed.Distance)
+	})
+
+	t.Run("top level distance takes precedence", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":  "l2-squared",
+			"threshold": float64(10),
+			"hnsw": map[string]interface{}{
+				"distance": "dot",
+			},
+		}, hnsw.ParseAndValidateConfig)
+		require.Nil(t, err)
+
+		parsed := cfg.(UserConfig)
+		assert.Equal(t, "l2-squared", parsed.Distance)
+		assert.Equal(t, "l2-squared", parsed.Hnsw.Distance)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold": json.Number("0"),
+		}, hnsw.ParseAndValidateConfig)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "threshold must be a positive integer")
+	})
+
+	t.Run("hnsw settings are not an object", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"hnsw": "not an object",
+		}, hnsw.ParseAndValidateConfig)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "hnsw must be an object")
+	})
+}
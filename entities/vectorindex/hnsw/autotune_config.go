@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultAutoTuneEnabled         = false
+	DefaultAutoTuneTargetRecall    = 0.95
+	DefaultAutoTuneTargetLatencyMs = 0 // no latency target
+	DefaultAutoTuneSampleRate      = 0.01
+)
+
+// AutoTuneConfig configures the automatic tuning of the search time ef. A
+// fraction of the queries is repeated with a higher ef, the overlap of both
+// results serves as a proxy for the recall. The ef is then raised until the
+// target recall is met and lowered while the latency exceeds its target,
+// within the bounds of dynamicEfMin and dynamicEfMax.
+type AutoTuneConfig struct {
+	Enabled         bool    `json:"enabled"`
+	TargetRecall    float64 `json:"targetRecall"`
+	TargetLatencyMs int     `json:"targetLatencyMs"`
+	SampleRate      float64 `json:"sampleRate"`
+}
+
+func (a AutoTuneConfig) validate() []string {
+	var errMsgs []string
+	if a.TargetRecall <= 0 || a.TargetRecall > 1 {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"autoTune.targetRecall must be in (0, 1], got %v", a.TargetRecall))
+	}
+
+	if a.TargetLatencyMs < 0 {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"autoTune.targetLatencyMs must not be negative, got %d", a.TargetLatencyMs))
+	}
+
+	if a.SampleRate <= 0 || a.SampleRate > 1 {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"autoTune.sampleRate must be in (0, 1], got %v", a.SampleRate))
+	}
+
+	return errMsgs
+}
+
+func parseAutoTuneMap(in map[string]interface{}, at *AutoTuneConfig) error {
+	value, ok := in["autoTune"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(asMap, "enabled", func(v bool) {
+		at.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalFloatFromMap(asMap, "targetRecall", func(v float64) {
+		at.TargetRecall = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "targetLatencyMs", func(v int) {
+		at.TargetLatencyMs = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalFloatFromMap(asMap, "sampleRate", func(v float64) {
+		at.SampleRate = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func optionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return errors.Wrapf(err, "json.Number to float64 for %q", name)
+		}
+		setFn(asFloat)
+	case float64:
+		setFn(typed)
+	}
+
+	return nil
+}
@@ -30,6 +30,40 @@ const (
 	DistanceHamming   = "hamming"
 )
 
+const (
+	// DynamicEFPolicyAuto only derives ef from the limit if ef is set to -1. An
+	// explicitly set ef is used as is, unless the limit is higher.
+	DynamicEFPolicyAuto = "auto"
+	// DynamicEFPolicyScale always derives ef from the limit as
+	// max(limit*dynamicEfFactor, floor) capped at dynamicEfMax, where the floor
+	// is the explicitly set ef or dynamicEfMin otherwise. This makes sure that
+	// large limits don't end up with recall determined by an ef tuned for
+	// small ones.
+	DynamicEFPolicyScale = "scale"
+)
+
+const (
+	// DimensionMismatchReject rejects objects whose vector doesn't have the
+	// configured number of dimensions
+	DimensionMismatchReject = "reject"
+	// DimensionMismatchTruncate cuts vectors with too many dimensions down to
+	// the configured number, shorter vectors are rejected
+	DimensionMismatchTruncate = "truncate"
+	// DimensionMismatchPadZero appends zeros to vectors with too few
+	// dimensions, longer vectors are rejected
+	DimensionMismatchPadZero = "pad-zero"
+)
+
+const (
+	// VectorCacheCompressionNone caches the vectors as they are stored
+	VectorCacheCompressionNone = "none"
+	// VectorCacheCompressionSQ caches the vectors scalar quantized to a byte
+	// per dimension, which cuts the memory of the cache to about a quarter.
+	// Distances computed from the cache are approximate, so the final
+	// candidates of a search are rescored with the vectors read from disk.
+	VectorCacheCompressionSQ = "sq"
+)
+
 const (
 	// Set these defaults if the user leaves them blank
 	DefaultCleanupIntervalSeconds = 5 * 60
@@ -39,10 +73,14 @@ const (
 	DefaultDynamicEFMin           = 100
 	DefaultDynamicEFMax           = 500
 	DefaultDynamicEFFactor        = 8
+	DefaultDynamicEFPolicy        = DynamicEFPolicyAuto
 	DefaultVectorCacheMaxObjects  = 1e12
+	DefaultVectorCacheCompression = VectorCacheCompressionNone
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
 	DefaultDistanceMetric         = DistanceCosine
+	DefaultDimensions             = 0 // not enforced
+	DefaultDimensionMismatch      = DimensionMismatchReject
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
@@ -59,10 +97,26 @@ type UserConfig struct {
 	DynamicEFMin           int      `json:"dynamicEfMin"`
 	DynamicEFMax           int      `json:"dynamicEfMax"`
 	DynamicEFFactor        int      `json:"dynamicEfFactor"`
+	DynamicEFPolicy        string   `json:"dynamicEfPolicy"`
 	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
+	VectorCacheCompression string   `json:"vectorCacheCompression"`
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
+
+	// Dimensions is the number of dimensions every vector of the class must
+	// have, 0 means it is not enforced. DimensionMismatch decides what happens
+	// to vectors of a different length before they reach the index.
+	Dimensions        int    `json:"dimensions"`
+	DimensionMismatch string `json:"dimensionMismatch"`
+
+	// AutoTune replaces the static ef and dynamic ef policy with an ef that
+	// is tuned at runtime, see AutoTuneConfig
+	AutoTune AutoTuneConfig `json:"autoTune"`
+
+	// DistanceParams are passed to custom distance metrics provided by
+	// modules, e.g. the weights of a weighted l2 distance
+	DistanceParams map[string]interface{} `json:"distanceParams,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -77,13 +131,17 @@ func (u *UserConfig) SetDefaults() {
 	u.EFConstruction = DefaultEFConstruction
 	u.CleanupIntervalSeconds = DefaultCleanupIntervalSeconds
 	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
+	u.VectorCacheCompression = DefaultVectorCacheCompression
 	u.EF = DefaultEF
 	u.DynamicEFFactor = DefaultDynamicEFFactor
 	u.DynamicEFMax = DefaultDynamicEFMax
 	u.DynamicEFMin = DefaultDynamicEFMin
+	u.DynamicEFPolicy = DefaultDynamicEFPolicy
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
 	u.Distance = DefaultDistanceMetric
+	u.Dimensions = DefaultDimensions
+	u.DimensionMismatch = DefaultDimensionMismatch
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
 		BitCompression: DefaultPQBitCompression,
@@ -95,6 +153,12 @@ func (u *UserConfig) SetDefaults() {
 			Distribution: DefaultPQEncoderDistribution,
 		},
 	}
+	u.AutoTune = AutoTuneConfig{
+		Enabled:         DefaultAutoTuneEnabled,
+		TargetRecall:    DefaultAutoTuneTargetRecall,
+		TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+		SampleRate:      DefaultAutoTuneSampleRate,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -154,12 +218,24 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalStringFromMap(asMap, "dynamicEfPolicy", func(v string) {
+		uc.DynamicEFPolicy = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalIntFromMap(asMap, "vectorCacheMaxObjects", func(v int) {
 		uc.VectorCacheMaxObjects = v
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := optionalStringFromMap(asMap, "vectorCacheCompression", func(v string) {
+		uc.VectorCacheCompression = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
 		uc.FlatSearchCutoff = v
 	}); err != nil {
@@ -178,10 +254,32 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalMapFromMap(asMap, "distanceParams", func(v map[string]interface{}) {
+		uc.DistanceParams = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalIntFromMap(asMap, "dimensions", func(v int) {
+		uc.Dimensions = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalStringFromMap(asMap, "dimensionMismatch", func(v string) {
+		uc.DimensionMismatch = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
 
+	if err := parseAutoTuneMap(asMap, &uc.AutoTune); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -201,6 +299,71 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	switch u.DynamicEFPolicy {
+	case DynamicEFPolicyAuto, DynamicEFPolicyScale:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"dynamicEfPolicy must be one of %q or %q, got %q",
+			DynamicEFPolicyAuto, DynamicEFPolicyScale, u.DynamicEFPolicy,
+		))
+	}
+
+	if u.DynamicEFPolicy == DynamicEFPolicyScale && u.DynamicEFFactor < 1 {
+		errMsgs = append(errMsgs,
+			"dynamicEfFactor must be a positive integer when dynamicEfPolicy is \"scale\"")
+	}
+
+	if u.Dimensions < 0 {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"dimensions must not be negative, got %d", u.Dimensions))
+	}
+
+	switch u.DimensionMismatch {
+	case DimensionMismatchReject, DimensionMismatchTruncate, DimensionMismatchPadZero:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"dimensionMismatch must be one of %q, %q or %q, got %q",
+			DimensionMismatchReject, DimensionMismatchTruncate,
+			DimensionMismatchPadZero, u.DimensionMismatch,
+		))
+	}
+
+	switch u.VectorCacheCompression {
+	case VectorCacheCompressionNone:
+	case VectorCacheCompressionSQ:
+		if u.PQ.Enabled {
+			// with pq the cache holds pq codes instead of the vectors already
+			errMsgs = append(errMsgs,
+				"vectorCacheCompression \"sq\" can't be combined with pq")
+		}
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"vectorCacheCompression must be one of %q or %q, got %q",
+			VectorCacheCompressionNone, VectorCacheCompressionSQ,
+			u.VectorCacheCompression,
+		))
+	}
+
+	if u.AutoTune.Enabled {
+		errMsgs = append(errMsgs, u.AutoTune.validate()...)
+		if u.DynamicEFMin < 1 || u.DynamicEFMax < u.DynamicEFMin {
+			errMsgs = append(errMsgs, "autoTune requires 0 < dynamicEfMin <= dynamicEfMax")
+		}
+	}
+
+	if IsBuiltinDistance(u.Distance) {
+		if len(u.DistanceParams) > 0 {
+			errMsgs = append(errMsgs, fmt.Sprintf(
+				"distanceParams are only supported by custom distance metrics, not by %q",
+				u.Distance))
+		}
+	} else if u.PQ.Enabled {
+		// pq splits vectors into segments and sums up the distances of the
+		// segments, which can't be assumed for an arbitrary metric
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"pq is not supported by custom distance metric %q", u.Distance))
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -279,6 +442,35 @@ func optionalStringFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func optionalMapFromMap(in map[string]interface{}, name string,
+	setFn func(v map[string]interface{}),
+) error {
+	value, ok := in[name]
+	if !ok || value == nil {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("%s must be an object, got %T", name, value)
+	}
+
+	setFn(asMap)
+	return nil
+}
+
+// IsBuiltinDistance returns true if the distance metric is implemented by
+// Weaviate itself, any other metric has to be provided by a module
+func IsBuiltinDistance(distance string) bool {
+	switch distance {
+	case "", DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+		DistanceHamming:
+		return true
+	default:
+		return false
+	}
+}
+
 func NewDefaultUserConfig() UserConfig {
 	uc := UserConfig{}
 	uc.SetDefaults()
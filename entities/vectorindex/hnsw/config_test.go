@@ -38,12 +38,14 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         DefaultMaxConnections,
 				EFConstruction:         DefaultEFConstruction,
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
@@ -56,6 +58,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -69,11 +78,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         100,
 				EFConstruction:         DefaultEFConstruction,
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
@@ -86,6 +97,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -109,11 +127,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Skip:                   true,
 				Distance:               "l2-squared",
 				PQ: PQConfig{
@@ -127,6 +147,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -150,11 +177,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Skip:                   true,
 				Distance:               "manhattan",
 				PQ: PQConfig{
@@ -168,6 +197,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -191,11 +227,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Skip:                   true,
 				Distance:               "hamming",
 				PQ: PQConfig{
@@ -209,6 +247,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -231,11 +276,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
@@ -248,6 +295,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -280,11 +334,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:       true,
@@ -296,6 +352,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: "normal",
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -327,11 +390,13 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:       true,
@@ -343,6 +408,13 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
 			},
 		},
 
@@ -393,11 +465,95 @@ func Test_UserConfig(t *testing.T) {
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  math.MaxInt64,
+				VectorCacheCompression: DefaultVectorCacheCompression,
 				EF:                     15,
 				FlatSearchCutoff:       16,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
+			},
+		},
+		{
+			name: "with scaling dynamic ef policy",
+			input: map[string]interface{}{
+				"ef":              json.Number("64"),
+				"dynamicEfPolicy": "scale",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: DefaultVectorCacheCompression,
+				EF:                     64,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DynamicEFPolicyScale,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
+			},
+		},
+		{
+			name: "with auto tuning",
+			input: map[string]interface{}{
+				"autoTune": map[string]interface{}{
+					"enabled":         true,
+					"targetRecall":    json.Number("0.9"),
+					"targetLatencyMs": json.Number("20"),
+					"sampleRate":      float64(0.05),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: DefaultVectorCacheCompression,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
 				Distance:               DefaultDistanceMetric,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
@@ -410,7 +566,178 @@ func Test_UserConfig(t *testing.T) {
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         true,
+					TargetRecall:    0.9,
+					TargetLatencyMs: 20,
+					SampleRate:      0.05,
+				},
+			},
+		},
+		{
+			name: "auto tuning with invalid target recall",
+			input: map[string]interface{}{
+				"autoTune": map[string]interface{}{
+					"enabled":      true,
+					"targetRecall": json.Number("1.5"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "autoTune.targetRecall must be in (0, 1]",
+		},
+		{
+			name: "auto tuning with invalid sample rate",
+			input: map[string]interface{}{
+				"autoTune": map[string]interface{}{
+					"enabled":    true,
+					"sampleRate": json.Number("0"),
+				},
 			},
+			expectErr:    true,
+			expectErrMsg: "autoTune.sampleRate must be in (0, 1]",
+		},
+		{
+			name: "auto tuning without ef bounds",
+			input: map[string]interface{}{
+				"dynamicEfMin": json.Number("200"),
+				"dynamicEfMax": json.Number("100"),
+				"autoTune": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "autoTune requires 0 < dynamicEfMin <= dynamicEfMax",
+		},
+		{
+			name: "with dimension enforcement",
+			input: map[string]interface{}{
+				"dimensions":        json.Number("384"),
+				"dimensionMismatch": "pad-zero",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: DefaultVectorCacheCompression,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				Dimensions:        384,
+				DimensionMismatch: DimensionMismatchPadZero,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
+			},
+		},
+		{
+			name: "negative dimensions",
+			input: map[string]interface{}{
+				"dimensions": json.Number("-1"),
+			},
+			expectErr:    true,
+			expectErrMsg: "dimensions must not be negative",
+		},
+		{
+			name: "invalid dimension mismatch policy",
+			input: map[string]interface{}{
+				"dimensionMismatch": "ignore",
+			},
+			expectErr:    true,
+			expectErrMsg: "dimensionMismatch must be one of",
+		},
+		{
+			name: "with scalar quantized vector cache",
+			input: map[string]interface{}{
+				"vectorCacheCompression": "sq",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheCompression: VectorCacheCompressionSQ,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				DynamicEFPolicy:        DefaultDynamicEFPolicy,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				DimensionMismatch: DefaultDimensionMismatch,
+				AutoTune: AutoTuneConfig{
+					Enabled:         DefaultAutoTuneEnabled,
+					TargetRecall:    DefaultAutoTuneTargetRecall,
+					TargetLatencyMs: DefaultAutoTuneTargetLatencyMs,
+					SampleRate:      DefaultAutoTuneSampleRate,
+				},
+			},
+		},
+		{
+			name: "invalid vector cache compression",
+			input: map[string]interface{}{
+				"vectorCacheCompression": "pq",
+			},
+			expectErr:    true,
+			expectErrMsg: "vectorCacheCompression must be one of",
+		},
+		{
+			name: "scalar quantized vector cache with pq",
+			input: map[string]interface{}{
+				"vectorCacheCompression": "sq",
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "vectorCacheCompression \"sq\" can't be combined with pq",
+		},
+		{
+			name: "invalid dynamic ef policy",
+			input: map[string]interface{}{
+				"dynamicEfPolicy": "always",
+			},
+			expectErr:    true,
+			expectErrMsg: "dynamicEfPolicy must be one of \"auto\" or \"scale\"",
+		},
+		{
+			name: "scaling dynamic ef policy without factor",
+			input: map[string]interface{}{
+				"dynamicEfPolicy": "scale",
+				"dynamicEfFactor": json.Number("0"),
+			},
+			expectErr:    true,
+			expectErrMsg: "dynamicEfFactor must be a positive integer",
 		},
 		{
 			name: "invalid max connections (json)",
@@ -464,3 +791,48 @@ func Test_UserConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserConfigCustomDistance(t *testing.T) {
+	t.Run("custom distance with params", func(t *testing.T) {
+		params := map[string]interface{}{
+			"weights": []interface{}{json.Number("1"), json.Number("0.5")},
+		}
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":       "weighted-l2",
+			"distanceParams": params,
+		})
+		require.Nil(t, err)
+
+		expected := NewDefaultUserConfig()
+		expected.Distance = "weighted-l2"
+		expected.DistanceParams = params
+		assert.Equal(t, expected, cfg)
+	})
+
+	t.Run("params with a builtin distance", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":       DistanceL2Squared,
+			"distanceParams": map[string]interface{}{"weights": []interface{}{}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "distanceParams are only supported by custom distance metrics")
+	})
+
+	t.Run("params which are not an object", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":       "weighted-l2",
+			"distanceParams": "foo",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "distanceParams must be an object")
+	})
+
+	t.Run("custom distance with pq", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance": "weighted-l2",
+			"pq":       map[string]interface{}{"enabled": true},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "pq is not supported by custom distance metric")
+	})
+}
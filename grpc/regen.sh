@@ -1,5 +1,5 @@
 protoc --go_out=. --go_opt=paths=source_relative \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
-    ./weaviate.proto
+    ./weaviate.proto ./federation.proto ./vectorizer.proto
 
 gofumpt -w .
\ No newline at end of file
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// VectorizerClient is the client API for Vectorizer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VectorizerClient interface {
+	Vectorize(ctx context.Context, in *VectorizeRequest, opts ...grpc.CallOption) (*VectorizeReply, error)
+	VectorizerMeta(ctx context.Context, in *VectorizerMetaRequest, opts ...grpc.CallOption) (*VectorizerMetaReply, error)
+}
+
+type vectorizerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVectorizerClient(cc grpc.ClientConnInterface) VectorizerClient {
+	return &vectorizerClient{cc}
+}
+
+func (c *vectorizerClient) Vectorize(ctx context.Context, in *VectorizeRequest, opts ...grpc.CallOption) (*VectorizeReply, error) {
+	out := new(VectorizeReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Vectorizer/Vectorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorizerClient) VectorizerMeta(ctx context.Context, in *VectorizerMetaRequest, opts ...grpc.CallOption) (*VectorizerMetaReply, error) {
+	out := new(VectorizerMetaReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Vectorizer/VectorizerMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VectorizerServer is the server API for Vectorizer service.
+// All implementations must embed UnimplementedVectorizerServer
+// for forward compatibility
+type VectorizerServer interface {
+	Vectorize(context.Context, *VectorizeRequest) (*VectorizeReply, error)
+	VectorizerMeta(context.Context, *VectorizerMetaRequest) (*VectorizerMetaReply, error)
+	mustEmbedUnimplementedVectorizerServer()
+}
+
+// UnimplementedVectorizerServer must be embedded to have forward compatible implementations.
+type UnimplementedVectorizerServer struct{}
+
+func (UnimplementedVectorizerServer) Vectorize(context.Context, *VectorizeRequest) (*VectorizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vectorize not implemented")
+}
+func (UnimplementedVectorizerServer) VectorizerMeta(context.Context, *VectorizerMetaRequest) (*VectorizerMetaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VectorizerMeta not implemented")
+}
+func (UnimplementedVectorizerServer) mustEmbedUnimplementedVectorizerServer() {}
+
+// UnsafeVectorizerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VectorizerServer will
+// result in compilation errors.
+type UnsafeVectorizerServer interface {
+	mustEmbedUnimplementedVectorizerServer()
+}
+
+func RegisterVectorizerServer(s grpc.ServiceRegistrar, srv VectorizerServer) {
+	s.RegisterService(&Vectorizer_ServiceDesc, srv)
+}
+
+func _Vectorizer_Vectorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorizerServer).Vectorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Vectorizer/Vectorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorizerServer).Vectorize(ctx, req.(*VectorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vectorizer_VectorizerMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorizerMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorizerServer).VectorizerMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Vectorizer/VectorizerMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorizerServer).VectorizerMeta(ctx, req.(*VectorizerMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Vectorizer_ServiceDesc is the grpc.ServiceDesc for Vectorizer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Vectorizer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weaviategrpc.Vectorizer",
+	HandlerType: (*VectorizerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Vectorize",
+			Handler:    _Vectorizer_Vectorize_Handler,
+		},
+		{
+			MethodName: "VectorizerMeta",
+			Handler:    _Vectorizer_VectorizerMeta_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vectorizer.proto",
+}
@@ -29,6 +29,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SearchStream(ctx context.Context, in *SearchStreamRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error)
+	BatchInsert(ctx context.Context, in *BatchInsertRequest, opts ...grpc.CallOption) (*BatchInsertReply, error)
+	BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
 }
 
 type weaviateClient struct {
@@ -48,11 +52,96 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) SearchStream(ctx context.Context, in *SearchStreamRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_SearchStreamClient interface {
+	Recv() (*SearchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateSearchStreamClient) Recv() (*SearchStreamReply, error) {
+	m := new(SearchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) BatchInsert(ctx context.Context, in *BatchInsertRequest, opts ...grpc.CallOption) (*BatchInsertReply, error) {
+	out := new(BatchInsertReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchInsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviategrpc.Weaviate/BatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateBatchStreamClient{stream}
+	return x, nil
+}
+
+type Weaviate_BatchStreamClient interface {
+	Send(*BatchStreamRequest) error
+	Recv() (*BatchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateBatchStreamClient) Send(m *BatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamClient) Recv() (*BatchStreamReply, error) {
+	m := new(BatchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error) {
+	out := new(AggregateReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	SearchStream(*SearchStreamRequest, Weaviate_SearchStreamServer) error
+	BatchInsert(context.Context, *BatchInsertRequest) (*BatchInsertReply, error)
+	BatchStream(Weaviate_BatchStreamServer) error
+	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -62,6 +151,18 @@ type UnimplementedWeaviateServer struct{}
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) SearchStream(*SearchStreamRequest, Weaviate_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+func (UnimplementedWeaviateServer) BatchInsert(context.Context, *BatchInsertRequest) (*BatchInsertReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchInsert not implemented")
+}
+func (UnimplementedWeaviateServer) BatchStream(Weaviate_BatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchStream not implemented")
+}
+func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +194,89 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).SearchStream(m, &weaviateSearchStreamServer{stream})
+}
+
+type Weaviate_SearchStreamServer interface {
+	Send(*SearchStreamReply) error
+	grpc.ServerStream
+}
+
+type weaviateSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateSearchStreamServer) Send(m *SearchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_BatchInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchInsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchInsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchInsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchInsert(ctx, req.(*BatchInsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).BatchStream(&weaviateBatchStreamServer{stream})
+}
+
+type Weaviate_BatchStreamServer interface {
+	Send(*BatchStreamReply) error
+	Recv() (*BatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateBatchStreamServer) Send(m *BatchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamServer) Recv() (*BatchStreamRequest, error) {
+	m := new(BatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,7 +288,27 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "BatchInsert",
+			Handler:    _Weaviate_BatchInsert_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Weaviate_Aggregate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Weaviate_SearchStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchStream",
+			Handler:       _Weaviate_BatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weaviate.proto",
 }
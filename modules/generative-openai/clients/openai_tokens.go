@@ -16,6 +16,8 @@ import (
 	"strings"
 
 	"github.com/pkoukk/tiktoken-go"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-openai/config"
 )
 
 func getTokensCount(model string, messages []message) (int, error) {
@@ -46,3 +48,19 @@ func getTokensCount(model string, messages []message) (int, error) {
 	}
 	return tokensCount, nil
 }
+
+// TokenBudget returns the number of tokens available for a prompt. Chat
+// models shrink the completion to fit the prompt, so it may take all but one
+// token of the model, legacy models reserve the configured maxTokens.
+func (v *openai) TokenBudget(cfg moduletools.ClassConfig) int {
+	settings := config.NewClassSettings(cfg)
+	modelTokens := settings.GetMaxTokensForModel(settings.Model())
+	if modelTokens == 0 {
+		// unknown model
+		return 0
+	}
+	if settings.IsLegacy() {
+		return int(modelTokens - settings.MaxTokens())
+	}
+	return int(modelTokens) - 1
+}
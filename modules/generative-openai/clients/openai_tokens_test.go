@@ -130,3 +130,10 @@ func Test_getTokensCount(t *testing.T) {
 		})
 	}
 }
+
+func Test_TokenBudget(t *testing.T) {
+	c := New("openAIApiKey", "", nullLogger())
+
+	// the default model is a chat model with 4097 tokens
+	assert.Equal(t, 4096, c.TokenBudget(nil))
+}
@@ -87,9 +87,26 @@ func (m *ReRankerCohereModule) AdditionalProperties() map[string]modulecapabilit
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+// Rerank scores each document with Cohere's rerank endpoint, it is used for
+// the rerank stage of searches
+func (m *ReRankerCohereModule) Rerank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) ([]float64, error) {
+	result, err := m.reranker.Rank(ctx, query, documents, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "rank documents")
+	}
+	scores := make([]float64, len(result.DocumentScores))
+	for i := range result.DocumentScores {
+		scores[i] = result.DocumentScores[i].Score
+	}
+	return scores, nil
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.Reranker(New())
 )
@@ -94,9 +94,26 @@ func (m *ReRankerModule) AdditionalProperties() map[string]modulecapabilities.Ad
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
 
+// Rerank scores each document with the cross-encoder, it is used for the
+// rerank stage of searches
+func (m *ReRankerModule) Rerank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) ([]float64, error) {
+	scores := make([]float64, len(documents))
+	for i := range documents {
+		result, err := m.reranker.Rank(ctx, documents[i], query)
+		if err != nil {
+			return nil, errors.Wrap(err, "rank document")
+		}
+		scores[i] = result.Score
+	}
+	return scores, nil
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.Reranker(New())
 )
@@ -43,9 +43,10 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 	panic("not implemented")
 }
 
-func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) { return "", nil }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
-func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return "" }
+func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error)       { return "", nil }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) string              { return tenant }
+func (f *fakeSchemaGetter) TenantQuota(class, tenant string) *models.TenantQuota { return nil }
+func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string       { return "" }
 
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
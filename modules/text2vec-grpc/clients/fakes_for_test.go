@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeVectorizerServer struct {
+	pb.UnimplementedVectorizerServer
+	lastRequest *pb.VectorizeRequest
+	err         error
+	// dropLast makes the server reply with one embedding too few
+	dropLast bool
+}
+
+func (s *fakeVectorizerServer) Vectorize(ctx context.Context,
+	req *pb.VectorizeRequest,
+) (*pb.VectorizeReply, error) {
+	s.lastRequest = req
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	embeddings := make([]*pb.Embedding, len(req.Texts))
+	for i := range embeddings {
+		embeddings[i] = &pb.Embedding{Vector: []float32{0.1, 0.2, 0.3}}
+	}
+	if s.dropLast {
+		embeddings = embeddings[:len(embeddings)-1]
+	}
+	return &pb.VectorizeReply{Embeddings: embeddings, Model: req.Model, Dimensions: 3}, nil
+}
+
+func (s *fakeVectorizerServer) VectorizerMeta(ctx context.Context,
+	req *pb.VectorizerMetaRequest,
+) (*pb.VectorizerMetaReply, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	info, err := structpb.NewStruct(map[string]interface{}{"provider": "fake"})
+	if err != nil {
+		return nil, errors.Wrap(err, "info")
+	}
+	return &pb.VectorizerMetaReply{Model: "fake-model", Dimensions: 3, Info: info}, nil
+}
+
+// startFakeServer serves the fake vectorizer on a random local port and
+// returns its address
+func startFakeServer(t *testing.T, fake *fakeVectorizerServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	srv := grpc.NewServer()
+	pb.RegisterVectorizerServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func newTestClient(t *testing.T, addr string) *vectorizer {
+	c, err := New(addr, nullLogger())
+	require.Nil(t, err)
+	return c
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func (v *vectorizer) MetaInfo() (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := v.client.VectorizerMeta(ctx, &pb.VectorizerMetaRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "send meta request")
+	}
+
+	meta := map[string]interface{}{
+		"model":      res.Model,
+		"dimensions": res.Dimensions,
+	}
+	if res.Info != nil {
+		meta["info"] = res.Info.AsMap()
+	}
+	return meta, nil
+}
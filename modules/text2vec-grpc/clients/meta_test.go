@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMeta(t *testing.T) {
+	c := newTestClient(t, startFakeServer(t, &fakeVectorizerServer{}))
+	meta, err := c.MetaInfo()
+
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"model":      "fake-model",
+		"dimensions": int32(3),
+		"info":       map[string]interface{}{"provider": "fake"},
+	}, meta)
+}
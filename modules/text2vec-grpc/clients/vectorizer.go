@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/modules/text2vec-grpc/ent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type vectorizer struct {
+	client pb.VectorizerClient
+	logger logrus.FieldLogger
+}
+
+// New connects to a service which implements the Vectorizer gRPC contract
+func New(uri string, logger logrus.FieldLogger) (*vectorizer, error) {
+	conn, err := grpc.Dial(uri,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*48)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "connect to vectorizer service at %q", uri)
+	}
+
+	return &vectorizer{
+		client: pb.NewVectorizerClient(conn),
+		logger: logger,
+	}, nil
+}
+
+func (v *vectorizer) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	req := &pb.VectorizeRequest{Texts: input, Model: config.Model}
+	if config.Config != nil {
+		cfg, err := structpb.NewStruct(config.Config)
+		if err != nil {
+			return nil, errors.Wrap(err, "convert module config")
+		}
+		req.Config = cfg
+	}
+
+	res, err := v.client.Vectorize(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send vectorize request")
+	}
+
+	if len(res.Embeddings) != len(input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d", len(input),
+			len(res.Embeddings))
+	}
+
+	vectors := make([][]float32, len(res.Embeddings))
+	for i, embedding := range res.Embeddings {
+		vectors[i] = embedding.Vector
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: int(res.Dimensions),
+		Vector:     vectors,
+	}, nil
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-grpc/ent"
+)
+
+func TestClient(t *testing.T) {
+	config := ent.VectorizationConfig{
+		Model:  "my-embedding-model",
+		Config: map[string]interface{}{"normalize": true},
+	}
+
+	t.Run("when all is fine", func(t *testing.T) {
+		fake := &fakeVectorizerServer{}
+		c := newTestClient(t, startFakeServer(t, fake))
+
+		res, err := c.Vectorize(context.Background(), []string{"first", "second"}, config)
+
+		require.Nil(t, err)
+		assert.Equal(t, &ent.VectorizationResult{
+			Text:       []string{"first", "second"},
+			Dimensions: 3,
+			Vector:     [][]float32{{0.1, 0.2, 0.3}, {0.1, 0.2, 0.3}},
+		}, res)
+		assert.Equal(t, []string{"first", "second"}, fake.lastRequest.Texts)
+		assert.Equal(t, "my-embedding-model", fake.lastRequest.Model)
+		assert.Equal(t, map[string]interface{}{"normalize": true}, fake.lastRequest.Config.AsMap())
+	})
+
+	t.Run("without a module config", func(t *testing.T) {
+		fake := &fakeVectorizerServer{}
+		c := newTestClient(t, startFakeServer(t, fake))
+
+		_, err := c.Vectorize(context.Background(), []string{"text"}, ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+		assert.Nil(t, fake.lastRequest.Config)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		c := newTestClient(t, startFakeServer(t, &fakeVectorizerServer{}))
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"text"}, config)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "DeadlineExceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		fake := &fakeVectorizerServer{err: errors.Errorf("nope, not gonna happen")}
+		c := newTestClient(t, startFakeServer(t, fake))
+
+		_, err := c.Vectorize(context.Background(), []string{"text"}, config)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "nope, not gonna happen")
+	})
+
+	t.Run("when the server returns too few embeddings", func(t *testing.T) {
+		fake := &fakeVectorizerServer{dropLast: true}
+		c := newTestClient(t, startFakeServer(t, fake))
+
+		_, err := c.Vectorize(context.Background(), []string{"first", "second"}, config)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "expected 2 embeddings, got 1")
+	})
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	DefaultPropertyIndexed       = true
+	DefaultVectorizeClassName    = true
+	DefaultVectorizePropertyName = false
+	// DefaultModel lets the service choose its default model
+	DefaultModel = ""
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) PropertyIndexed(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyIndexed
+	}
+
+	vcn, ok := ic.cfg.Property(propName)["skip"]
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	return !asBool
+}
+
+func (ic *classSettings) VectorizePropertyName(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizePropertyName
+	}
+	vcn, ok := ic.cfg.Property(propName)["vectorizePropertyName"]
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) VectorizeClassName() bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizeClassName
+	}
+
+	vcn, ok := ic.cfg.Class()["vectorizeClassName"]
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) Model() string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultModel
+	}
+
+	vcn, ok := ic.cfg.Class()["model"]
+	if !ok {
+		return DefaultModel
+	}
+
+	asString, ok := vcn.(string)
+	if !ok {
+		return DefaultModel
+	}
+
+	return asString
+}
+
+// Config returns the module config of the class which is not interpreted by
+// the module itself. It is passed through to the service as is.
+func (ic *classSettings) Config() map[string]interface{} {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return nil
+	}
+
+	config := map[string]interface{}{}
+	for key, value := range ic.cfg.Class() {
+		switch key {
+		case "vectorizeClassName", "model":
+		default:
+			config[key] = normalizeConfigValue(value)
+		}
+	}
+	if len(config) == 0 {
+		return nil
+	}
+	return config
+}
+
+func (ic *classSettings) Validate() error {
+	if ic.cfg == nil {
+		return nil
+	}
+
+	if model, ok := ic.cfg.Class()["model"]; ok {
+		if _, ok := model.(string); !ok {
+			return errors.Errorf("model must be a string, got %T", model)
+		}
+	}
+
+	if _, err := structpb.NewStruct(ic.Config()); err != nil {
+		return errors.Wrap(err, "module config cannot be passed to the vectorizer service")
+	}
+
+	return nil
+}
+
+// normalizeConfigValue converts json numbers, which are not supported by
+// structpb, into floats
+func normalizeConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		asFloat, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+		return asFloat
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			out[key] = normalizeConfigValue(elem)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, elem := range v {
+			out[i] = normalizeConfigValue(elem)
+		}
+		return out
+	default:
+		return v
+	}
+}
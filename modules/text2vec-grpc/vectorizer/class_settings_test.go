@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+func TestClassSettings(t *testing.T) {
+	t.Run("with all defaults", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			Properties: []*models.Property{{
+				Name: "someProp",
+			}},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.True(t, ic.PropertyIndexed("someProp"))
+		assert.False(t, ic.VectorizePropertyName("someProp"))
+		assert.True(t, ic.VectorizeClassName())
+		assert.Equal(t, "", ic.Model())
+		assert.Nil(t, ic.Config())
+	})
+
+	t.Run("with a nil config", func(t *testing.T) {
+		// this is the case if we were runnning in a situation such as a
+		// cross-class vectorization of search time, as is the case with Explore
+		// {}, we then expect all default values
+
+		ic := NewClassSettings(nil)
+
+		assert.True(t, ic.PropertyIndexed("someProp"))
+		assert.False(t, ic.VectorizePropertyName("someProp"))
+		assert.True(t, ic.VectorizeClassName())
+		assert.Equal(t, "", ic.Model())
+		assert.Nil(t, ic.Config())
+		assert.Nil(t, ic.Validate())
+	})
+
+	t.Run("with all explicit config using non-default values", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"vectorizeClassName": false,
+					"model":              "my-embedding-model",
+					"dimensions":         json.Number("256"),
+					"normalize":          true,
+					"prefixes":           []interface{}{"query: ", "passage: "},
+				},
+			},
+			Properties: []*models.Property{{
+				Name: "someProp",
+				ModuleConfig: map[string]interface{}{
+					"my-module": map[string]interface{}{
+						"skip":                  true,
+						"vectorizePropertyName": true,
+					},
+				},
+			}},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.False(t, ic.PropertyIndexed("someProp"))
+		assert.True(t, ic.VectorizePropertyName("someProp"))
+		assert.False(t, ic.VectorizeClassName())
+		assert.Equal(t, "my-embedding-model", ic.Model())
+		assert.Equal(t, map[string]interface{}{
+			"dimensions": float64(256),
+			"normalize":  true,
+			"prefixes":   []interface{}{"query: ", "passage: "},
+		}, ic.Config())
+		assert.Nil(t, ic.Validate())
+	})
+}
+
+func TestClassSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:        "model of the wrong type",
+			config:      map[string]interface{}{"model": float64(1)},
+			expectedErr: "model must be a string",
+		},
+		{
+			name:        "config which cannot be passed to the service",
+			config:      map[string]interface{}{"weights": []float32{0.1}},
+			expectedErr: "module config cannot be passed to the vectorizer service",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:        "MyClass",
+				ModuleConfig: map[string]interface{}{"my-module": test.config},
+			}
+			cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+
+			err := NewClassSettings(cfg).Validate()
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMeta(t *testing.T) {
+	t.Run("when the server is providing meta", func(t *testing.T) {
+		server := httptest.NewServer(&testMetaHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, DefaultBatchSize, nullLogger())
+		meta, err := c.MetaInfo()
+
+		require.Nil(t, err)
+		models, ok := meta["models"].([]interface{})
+		require.True(t, ok)
+		require.Len(t, models, 1)
+		model, ok := models[0].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "sentence-transformers/all-MiniLM-L6-v2", model["name"])
+		assert.Equal(t, float64(384), model["dims"])
+	})
+}
+
+type testMetaHandler struct {
+	t *testing.T
+}
+
+func (f *testMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/meta", r.URL.String())
+	assert.Equal(f.t, http.MethodGet, r.Method)
+
+	w.Write([]byte(`{
+    "runtime": "onnxruntime 1.16.0",
+    "models": [
+        {
+            "name": "sentence-transformers/all-MiniLM-L6-v2",
+            "dims": 384,
+            "max_tokens": 256
+        }
+    ]
+}`))
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-onnx/ent"
+)
+
+// DefaultBatchSize is the number of texts which are sent to the inference
+// container in a single request
+const DefaultBatchSize = 32
+
+type vectorizer struct {
+	origin     string
+	batchSize  int
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(origin string, batchSize int, logger logrus.FieldLogger) *vectorizer {
+	return &vectorizer{
+		origin:     origin,
+		batchSize:  batchSize,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+// Vectorize embeds every input separately. The inputs are sent in batches
+// of at most batchSize texts, the inference container truncates each of
+// them to the configured max tokens.
+func (v *vectorizer) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	result := &ent.VectorizationResult{
+		Text:   make([]string, 0, len(input)),
+		Vector: make([][]float32, 0, len(input)),
+	}
+	for start := 0; start < len(input); start += v.batchSize {
+		end := start + v.batchSize
+		if end > len(input) {
+			end = len(input)
+		}
+
+		res, err := v.vectorizeBatch(ctx, input[start:end], config)
+		if err != nil {
+			return nil, err
+		}
+		result.Text = append(result.Text, res.Texts...)
+		result.Vector = append(result.Vector, res.Vectors...)
+		result.Dimensions = res.Dims
+	}
+
+	return result, nil
+}
+
+func (v *vectorizer) vectorizeBatch(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*vecResponse, error) {
+	body, err := json.Marshal(vecRequest{
+		Texts: input,
+		Config: vecRequestConfig{
+			Model:           config.Model,
+			PoolingStrategy: config.PoolingStrategy,
+			MaxTokens:       config.MaxTokens,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", v.url("/vectors"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody vecResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode,
+			resBody.Error)
+	}
+
+	if len(resBody.Vectors) != len(input) {
+		return nil, errors.Errorf("expected %d vectors, got %d", len(input),
+			len(resBody.Vectors))
+	}
+
+	return &resBody, nil
+}
+
+func (v *vectorizer) url(path string) string {
+	return fmt.Sprintf("%s%s", v.origin, path)
+}
+
+type vecRequest struct {
+	Texts  []string         `json:"texts"`
+	Config vecRequestConfig `json:"config"`
+}
+
+type vecRequestConfig struct {
+	Model           string `json:"model,omitempty"`
+	PoolingStrategy string `json:"pooling_strategy"`
+	MaxTokens       int    `json:"max_tokens,omitempty"`
+}
+
+type vecResponse struct {
+	Texts   []string    `json:"texts"`
+	Dims    int         `json:"dims"`
+	Vectors [][]float32 `json:"vectors"`
+	Error   string      `json:"error"`
+}
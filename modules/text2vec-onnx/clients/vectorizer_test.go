@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-onnx/ent"
+)
+
+func TestClient(t *testing.T) {
+	config := ent.VectorizationConfig{
+		Model:           "sentence-transformers/all-MiniLM-L6-v2",
+		PoolingStrategy: "masked_mean",
+		MaxTokens:       128,
+	}
+
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, DefaultBatchSize, nullLogger())
+		expected := &ent.VectorizationResult{
+			Text:       []string{"This is my text"},
+			Vector:     [][]float32{{0.1, 0.2, 0.3}},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"}, config)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, 1, handler.requests)
+	})
+
+	t.Run("when the input exceeds the batch size", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, 2, nullLogger())
+		input := []string{"one", "two", "three", "four", "five"}
+		res, err := c.Vectorize(context.Background(), input, config)
+
+		require.Nil(t, err)
+		assert.Equal(t, input, res.Text)
+		assert.Len(t, res.Vector, 5)
+		assert.Equal(t, 3, handler.requests)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, DefaultBatchSize, nullLogger())
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"}, config)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := New(server.URL, DefaultBatchSize, nullLogger())
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"}, config)
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "nope, not gonna happen")
+	})
+}
+
+type fakeHandler struct {
+	t           *testing.T
+	serverError error
+	requests    int
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/vectors", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	f.requests++
+
+	if f.serverError != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"error":"%s"}`, f.serverError.Error())))
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var b vecRequest
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	assert.Greater(f.t, len(b.Texts), 0)
+	assert.Equal(f.t, "sentence-transformers/all-MiniLM-L6-v2", b.Config.Model)
+	assert.Equal(f.t, "masked_mean", b.Config.PoolingStrategy)
+	assert.Equal(f.t, 128, b.Config.MaxTokens)
+
+	vectors := make([][]float32, len(b.Texts))
+	for i := range vectors {
+		vectors[i] = []float32{0.1, 0.2, 0.3}
+	}
+	outBytes, err := json.Marshal(vecResponse{Texts: b.Texts, Dims: 3, Vectors: vectors})
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	DefaultPropertyIndexed       = true
+	DefaultVectorizeClassName    = true
+	DefaultVectorizePropertyName = false
+	DefaultModel                 = "sentence-transformers/all-MiniLM-L6-v2"
+	DefaultPoolingStrategy       = "masked_mean"
+	DefaultMaxTokens             = 256
+)
+
+var availablePoolingStrategies = []string{"masked_mean", "cls"}
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) PropertyIndexed(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyIndexed
+	}
+
+	vcn, ok := ic.cfg.Property(propName)["skip"]
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultPropertyIndexed
+	}
+
+	return !asBool
+}
+
+func (ic *classSettings) VectorizePropertyName(propName string) bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizePropertyName
+	}
+	vcn, ok := ic.cfg.Property(propName)["vectorizePropertyName"]
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizePropertyName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) VectorizeClassName() bool {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultVectorizeClassName
+	}
+
+	vcn, ok := ic.cfg.Class()["vectorizeClassName"]
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	asBool, ok := vcn.(bool)
+	if !ok {
+		return DefaultVectorizeClassName
+	}
+
+	return asBool
+}
+
+func (ic *classSettings) PoolingStrategy() string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPoolingStrategy
+	}
+
+	vcn, ok := ic.cfg.Class()["poolingStrategy"]
+	if !ok {
+		return DefaultPoolingStrategy
+	}
+
+	asString, ok := vcn.(string)
+	if !ok {
+		return DefaultPoolingStrategy
+	}
+
+	return asString
+}
+
+func (ic *classSettings) Model() string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultModel
+	}
+
+	vcn, ok := ic.cfg.Class()["model"]
+	if !ok {
+		return DefaultModel
+	}
+
+	asString, ok := vcn.(string)
+	if !ok || asString == "" {
+		return DefaultModel
+	}
+
+	return asString
+}
+
+// MaxTokens is the number of tokens after which the inference container
+// truncates a text before embedding it. It returns -1 if the setting is not
+// a number.
+func (ic *classSettings) MaxTokens() int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultMaxTokens
+	}
+
+	vcn, ok := ic.cfg.Class()["maxTokens"]
+	if !ok {
+		return DefaultMaxTokens
+	}
+
+	switch v := vcn.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case json.Number:
+		asInt, err := v.Int64()
+		if err != nil {
+			return -1
+		}
+		return int(asInt)
+	default:
+		return -1
+	}
+}
+
+func (ic *classSettings) Validate() error {
+	pooling := ic.PoolingStrategy()
+	valid := false
+	for _, available := range availablePoolingStrategies {
+		if pooling == available {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return errors.Errorf("wrong poolingStrategy %q, available strategies are: %v",
+			pooling, availablePoolingStrategies)
+	}
+
+	if ic.MaxTokens() < 1 {
+		return errors.Errorf("maxTokens must be a positive integer")
+	}
+
+	return nil
+}
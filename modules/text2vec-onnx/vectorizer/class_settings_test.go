@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+func TestClassSettings(t *testing.T) {
+	t.Run("with all defaults", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			Properties: []*models.Property{{
+				Name: "someProp",
+			}},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.True(t, ic.PropertyIndexed("someProp"))
+		assert.False(t, ic.VectorizePropertyName("someProp"))
+		assert.True(t, ic.VectorizeClassName())
+		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+		assert.Equal(t, "sentence-transformers/all-MiniLM-L6-v2", ic.Model())
+		assert.Equal(t, 256, ic.MaxTokens())
+	})
+
+	t.Run("with a nil config", func(t *testing.T) {
+		// this is the case if we were runnning in a situation such as a
+		// cross-class vectorization of search time, as is the case with Explore
+		// {}, we then expect all default values
+
+		ic := NewClassSettings(nil)
+
+		assert.True(t, ic.PropertyIndexed("someProp"))
+		assert.False(t, ic.VectorizePropertyName("someProp"))
+		assert.True(t, ic.VectorizeClassName())
+		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+		assert.Equal(t, "sentence-transformers/all-MiniLM-L6-v2", ic.Model())
+		assert.Equal(t, 256, ic.MaxTokens())
+	})
+
+	t.Run("with all explicit config matching the defaults", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"vectorizeClassName": true,
+					"poolingStrategy":    "masked_mean",
+				},
+			},
+			Properties: []*models.Property{{
+				Name: "someProp",
+				ModuleConfig: map[string]interface{}{
+					"my-module": map[string]interface{}{
+						"skip":                  false,
+						"vectorizePropertyName": false,
+					},
+				},
+			}},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.True(t, ic.PropertyIndexed("someProp"))
+		assert.False(t, ic.VectorizePropertyName("someProp"))
+		assert.True(t, ic.VectorizeClassName())
+		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+	})
+
+	t.Run("with all explicit config using non-default values", func(t *testing.T) {
+		class := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"vectorizeClassName": false,
+					"model":              "BAAI/bge-small-en-v1.5",
+					"poolingStrategy":    "cls",
+					"maxTokens":          float64(512),
+				},
+			},
+			Properties: []*models.Property{{
+				Name: "someProp",
+				ModuleConfig: map[string]interface{}{
+					"my-module": map[string]interface{}{
+						"skip":                  true,
+						"vectorizePropertyName": true,
+					},
+				},
+			}},
+		}
+
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+		ic := NewClassSettings(cfg)
+
+		assert.False(t, ic.PropertyIndexed("someProp"))
+		assert.True(t, ic.VectorizePropertyName("someProp"))
+		assert.False(t, ic.VectorizeClassName())
+		assert.Equal(t, ic.PoolingStrategy(), "cls")
+		assert.Equal(t, "BAAI/bge-small-en-v1.5", ic.Model())
+		assert.Equal(t, 512, ic.MaxTokens())
+	})
+}
+
+func TestClassSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:   "defaults",
+			config: map[string]interface{}{},
+		},
+		{
+			name:   "explicit settings",
+			config: map[string]interface{}{"poolingStrategy": "cls", "maxTokens": json.Number("128")},
+		},
+		{
+			name:        "unknown pooling strategy",
+			config:      map[string]interface{}{"poolingStrategy": "max"},
+			expectedErr: "wrong poolingStrategy \"max\"",
+		},
+		{
+			name:        "non-positive max tokens",
+			config:      map[string]interface{}{"maxTokens": float64(0)},
+			expectedErr: "maxTokens must be a positive integer",
+		},
+		{
+			name:        "max tokens of the wrong type",
+			config:      map[string]interface{}{"maxTokens": "many"},
+			expectedErr: "maxTokens must be a positive integer",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:        "MyClass",
+				ModuleConfig: map[string]interface{}{"my-module": test.config},
+			}
+			cfg := modules.NewClassBasedModuleConfig(class, "my-module", "tenant")
+
+			err := NewClassSettings(cfg).Validate()
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
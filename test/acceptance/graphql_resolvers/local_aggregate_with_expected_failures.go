@@ -52,7 +52,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
@@ -84,7 +84,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
@@ -116,7 +116,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
@@ -153,7 +153,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
@@ -209,7 +209,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
@@ -265,7 +265,7 @@ func aggregatesWithExpectedFailures(t *testing.T) {
 		require.NotEmpty(t, result)
 		require.Len(t, result, 1)
 		assert.True(t, strings.Contains(result[0].Message,
-			"must provide certainty or objectLimit with vector search"),
+			"must provide certainty, distance or objectLimit with vector search"),
 			"unexpected error message: %s", result[0].Message)
 	})
 
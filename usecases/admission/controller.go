@@ -0,0 +1,350 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package admission decides whether the node accepts a request before any
+// work is done for it. Requests beyond the configured rates or concurrency
+// are rejected with a hint when to retry, so that overload slows clients
+// down instead of exhausting the memory of the node.
+package admission
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Kind is the kind of work a request causes, queries and batch imports are
+// limited in their concurrency in addition to the rate limits
+type Kind int
+
+const (
+	KindOther Kind = iota
+	KindQuery
+	KindBatch
+)
+
+// buckets of API keys which are refilled completely are dropped once more
+// than this many keys are tracked
+const maxTrackedKeys = 10_000
+
+// retry hint for requests rejected because of the concurrency limits
+const concurrencyRetryAfter = time.Second
+
+// ErrRejected is returned for requests the node does not accept right now
+type ErrRejected struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e ErrRejected) Error() string {
+	return fmt.Sprintf("too many requests: %s, retry after %s", e.Reason, e.RetryAfter)
+}
+
+// Stats is the current load as seen by the admission control
+type Stats struct {
+	RunningQueries int `json:"runningQueries"`
+	RunningBatches int `json:"runningBatches"`
+	QueuedBatches  int `json:"queuedBatches"`
+	TrackedKeys    int `json:"trackedKeys"`
+}
+
+// Controller admits requests according to config.AdmissionControl, the
+// config can be changed at runtime through Update
+type Controller struct {
+	mu      sync.Mutex
+	config  config.AdmissionControl
+	global  bucket
+	keys    map[string]*bucket
+	queries slots
+	batches slots
+	metrics *prometheus.CounterVec
+	now     func() time.Time
+}
+
+func New(cfg config.AdmissionControl, promMetrics *monitoring.PrometheusMetrics) *Controller {
+	c := &Controller{
+		config: cfg,
+		keys:   map[string]*bucket{},
+		now:    time.Now,
+	}
+	if promMetrics != nil {
+		c.metrics = promMetrics.AdmissionRejectedRequests
+	}
+	return c
+}
+
+// Config returns the active config
+func (c *Controller) Config() config.AdmissionControl {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.config
+}
+
+// Update replaces the active config. Requests which are already admitted
+// are not affected, queued batches are admitted right away if the new
+// limits allow it.
+func (c *Controller) Update(cfg config.AdmissionControl) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.config = cfg
+	c.global = bucket{}
+	c.keys = map[string]*bucket{}
+	c.queries.grant(c.limit(cfg.MaxConcurrentQueries))
+	c.batches.grant(c.limit(cfg.MaxConcurrentBatches))
+	return nil
+}
+
+// Stats returns the current load
+func (c *Controller) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		RunningQueries: c.queries.used,
+		RunningBatches: c.batches.used,
+		QueuedBatches:  len(c.batches.waiting),
+		TrackedKeys:    len(c.keys),
+	}
+}
+
+// Admit decides whether a request is accepted. key identifies the client,
+// requests without a key are only subject to the global limits. Batches wait
+// for a free slot up to the configured timeout. If the request is accepted,
+// release must be called once it is done.
+func (c *Controller) Admit(ctx context.Context, key string, kind Kind) (release func(), err error) {
+	c.mu.Lock()
+	if !c.config.Enabled {
+		c.mu.Unlock()
+		return func() {}, nil
+	}
+
+	if err := c.takeTokens(key); err != nil {
+		c.mu.Unlock()
+		return nil, c.rejected(err, "rate")
+	}
+
+	switch kind {
+	case KindQuery:
+		if !c.queries.tryAcquire(c.limit(c.config.MaxConcurrentQueries)) {
+			c.mu.Unlock()
+			return nil, c.rejected(ErrRejected{
+				Reason:     "too many concurrent queries",
+				RetryAfter: concurrencyRetryAfter,
+			}, "concurrent_queries")
+		}
+		c.mu.Unlock()
+		return c.releaseFunc(&c.queries, func(cfg config.AdmissionControl) int {
+			return cfg.MaxConcurrentQueries
+		}), nil
+	case KindBatch:
+		c.mu.Unlock()
+		if err := c.acquireBatch(ctx); err != nil {
+			return nil, err
+		}
+		return c.releaseFunc(&c.batches, func(cfg config.AdmissionControl) int {
+			return cfg.MaxConcurrentBatches
+		}), nil
+	default:
+		c.mu.Unlock()
+		return func() {}, nil
+	}
+}
+
+// acquireBatch waits for a batch slot until the queue timeout passes
+func (c *Controller) acquireBatch(ctx context.Context) error {
+	c.mu.Lock()
+	if c.batches.tryAcquire(c.limit(c.config.MaxConcurrentBatches)) {
+		c.mu.Unlock()
+		return nil
+	}
+	granted := make(chan struct{})
+	c.batches.waiting = append(c.batches.waiting, granted)
+	timeout := time.Duration(c.config.BatchQueueTimeoutSeconds) * time.Second
+	c.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-granted:
+		return nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.batches.remove(granted) {
+		return nil // the slot was granted in the meantime
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return c.rejected(ErrRejected{
+		Reason:     fmt.Sprintf("no batch slot became free within %s", timeout),
+		RetryAfter: concurrencyRetryAfter,
+	}, "batch_queue")
+}
+
+func (c *Controller) releaseFunc(s *slots, limit func(config.AdmissionControl) int) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			s.used--
+			s.grant(c.limit(limit(c.config)))
+		})
+	}
+}
+
+// takeTokens takes a token from the bucket of the key and the global
+// bucket. If either is empty, nothing is taken.
+func (c *Controller) takeTokens(key string) error {
+	now := c.now()
+
+	var kb *bucket
+	if key != "" && c.config.APIKeyRequestsPerSecond > 0 {
+		kb = c.keys[key]
+		if kb == nil {
+			if len(c.keys) >= maxTrackedKeys {
+				c.pruneKeys(now)
+			}
+			kb = &bucket{}
+			c.keys[key] = kb
+		}
+		if wait, ok := kb.take(now, c.config.APIKeyRequestsPerSecond,
+			c.config.APIKeyBurst); !ok {
+			return ErrRejected{Reason: "API key rate limit exceeded", RetryAfter: wait}
+		}
+	}
+
+	if c.config.GlobalRequestsPerSecond > 0 {
+		if wait, ok := c.global.take(now, c.config.GlobalRequestsPerSecond,
+			c.config.GlobalBurst); !ok {
+			if kb != nil {
+				kb.tokens++ // the request isn't served, give the token back
+			}
+			return ErrRejected{Reason: "global rate limit exceeded", RetryAfter: wait}
+		}
+	}
+
+	return nil
+}
+
+// pruneKeys drops the buckets which are refilled completely, those keys
+// start with a full bucket again anyway
+func (c *Controller) pruneKeys(now time.Time) {
+	for key, b := range c.keys {
+		if b.full(now, c.config.APIKeyRequestsPerSecond, c.config.APIKeyBurst) {
+			delete(c.keys, key)
+		}
+	}
+}
+
+// limit returns the effective concurrency limit, 0 means unlimited
+func (c *Controller) limit(max int) int {
+	if !c.config.Enabled {
+		return 0
+	}
+	return max
+}
+
+func (c *Controller) rejected(err error, limit string) error {
+	if c.metrics != nil {
+		c.metrics.With(prometheus.Labels{"limit": limit}).Inc()
+	}
+	return err
+}
+
+// bucket is a token bucket which is refilled at a rate of tokens per
+// second up to burst tokens
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) refill(now time.Time, rate, burst int) {
+	if burst <= 0 {
+		burst = rate
+	}
+	if b.last.IsZero() {
+		b.tokens = float64(burst)
+	} else if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * float64(rate)
+		if b.tokens > float64(burst) {
+			b.tokens = float64(burst)
+		}
+	}
+	b.last = now
+}
+
+// take takes a token if there is one, otherwise it returns how long it
+// takes until the next token is available
+func (b *bucket) take(now time.Time, rate, burst int) (time.Duration, bool) {
+	b.refill(now, rate, burst)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	return time.Duration((1 - b.tokens) / float64(rate) * float64(time.Second)), false
+}
+
+func (b *bucket) full(now time.Time, rate, burst int) bool {
+	if burst <= 0 {
+		burst = rate
+	}
+	return b.tokens+now.Sub(b.last).Seconds()*float64(rate) >= float64(burst)
+}
+
+// slots limits the number of concurrent requests, requests waiting for a
+// slot are granted one in order of arrival. slots is guarded by the lock of
+// the Controller.
+type slots struct {
+	used    int
+	waiting []chan struct{}
+}
+
+func (s *slots) tryAcquire(limit int) bool {
+	if limit > 0 && (s.used >= limit || len(s.waiting) > 0) {
+		return false
+	}
+	s.used++
+	return true
+}
+
+// grant hands free slots to waiting requests
+func (s *slots) grant(limit int) {
+	for len(s.waiting) > 0 && (limit <= 0 || s.used < limit) {
+		s.used++
+		close(s.waiting[0])
+		s.waiting = s.waiting[1:]
+	}
+}
+
+// remove removes a waiting request, it returns false if the request isn't
+// waiting anymore
+func (s *slots) remove(ch chan struct{}) bool {
+	for i, w := range s.waiting {
+		if w == ch {
+			s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package admission
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newTestController(cfg config.AdmissionControl) (*Controller, *time.Time) {
+	cfg.Enabled = true
+	c := New(cfg, nil)
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestAdmitDisabled(t *testing.T) {
+	c := New(config.AdmissionControl{MaxConcurrentQueries: 1}, nil)
+	for i := 0; i < 10; i++ {
+		_, err := c.Admit(context.Background(), "key", KindQuery)
+		require.Nil(t, err)
+	}
+}
+
+func TestAdmitRateLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("per api key", func(t *testing.T) {
+		c, now := newTestController(config.AdmissionControl{
+			APIKeyRequestsPerSecond: 2, APIKeyBurst: 3,
+		})
+		for i := 0; i < 3; i++ {
+			_, err := c.Admit(ctx, "a", KindOther)
+			require.Nil(t, err)
+		}
+		_, err := c.Admit(ctx, "a", KindOther)
+		var rejected ErrRejected
+		require.True(t, errors.As(err, &rejected))
+		assert.Equal(t, 500*time.Millisecond, rejected.RetryAfter)
+
+		// other keys and requests without key are not affected
+		_, err = c.Admit(ctx, "b", KindOther)
+		assert.Nil(t, err)
+		_, err = c.Admit(ctx, "", KindOther)
+		assert.Nil(t, err)
+
+		*now = now.Add(500 * time.Millisecond)
+		_, err = c.Admit(ctx, "a", KindOther)
+		assert.Nil(t, err)
+		_, err = c.Admit(ctx, "a", KindOther)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		c, now := newTestController(config.AdmissionControl{
+			GlobalRequestsPerSecond: 10, APIKeyRequestsPerSecond: 5,
+		})
+		for i := 0; i < 10; i++ {
+			_, err := c.Admit(ctx, "", KindOther)
+			require.Nil(t, err)
+		}
+		_, err := c.Admit(ctx, "a", KindOther)
+		assert.ErrorContains(t, err, "global rate limit")
+
+		// the key did not lose a token for the rejected request
+		*now = now.Add(time.Second)
+		for i := 0; i < 5; i++ {
+			_, err := c.Admit(ctx, "a", KindOther)
+			require.Nil(t, err)
+		}
+	})
+}
+
+func TestAdmitConcurrentQueries(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestController(config.AdmissionControl{MaxConcurrentQueries: 2})
+
+	release1, err := c.Admit(ctx, "", KindQuery)
+	require.Nil(t, err)
+	_, err = c.Admit(ctx, "", KindQuery)
+	require.Nil(t, err)
+	_, err = c.Admit(ctx, "", KindQuery)
+	assert.ErrorContains(t, err, "concurrent queries")
+
+	// other requests are not limited by the queries
+	_, err = c.Admit(ctx, "", KindOther)
+	assert.Nil(t, err)
+
+	release1()
+	release1() // releasing twice must not free another slot
+	_, err = c.Admit(ctx, "", KindQuery)
+	assert.Nil(t, err)
+	_, err = c.Admit(ctx, "", KindQuery)
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, c.Stats().RunningQueries)
+}
+
+func TestAdmitBatchQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("waits for a free slot", func(t *testing.T) {
+		c, _ := newTestController(config.AdmissionControl{
+			MaxConcurrentBatches: 1, BatchQueueTimeoutSeconds: 5,
+		})
+		release, err := c.Admit(ctx, "", KindBatch)
+		require.Nil(t, err)
+
+		admitted := make(chan error)
+		go func() {
+			_, err := c.Admit(ctx, "", KindBatch)
+			admitted <- err
+		}()
+		require.Eventually(t, func() bool { return c.Stats().QueuedBatches == 1 },
+			time.Second, time.Millisecond)
+
+		release()
+		assert.Nil(t, <-admitted)
+		assert.Equal(t, Stats{RunningBatches: 1}, c.Stats())
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		c, _ := newTestController(config.AdmissionControl{
+			MaxConcurrentBatches: 1, BatchQueueTimeoutSeconds: 1,
+		})
+		_, err := c.Admit(ctx, "", KindBatch)
+		require.Nil(t, err)
+
+		_, err = c.Admit(ctx, "", KindBatch)
+		var rejected ErrRejected
+		assert.True(t, errors.As(err, &rejected))
+		assert.Equal(t, 0, c.Stats().QueuedBatches)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		c, _ := newTestController(config.AdmissionControl{
+			MaxConcurrentBatches: 1, BatchQueueTimeoutSeconds: 5,
+		})
+		_, err := c.Admit(ctx, "", KindBatch)
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = c.Admit(ctx, "", KindBatch)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("raising the limit admits queued batches", func(t *testing.T) {
+		c, _ := newTestController(config.AdmissionControl{
+			MaxConcurrentBatches: 1, BatchQueueTimeoutSeconds: 5,
+		})
+		_, err := c.Admit(ctx, "", KindBatch)
+		require.Nil(t, err)
+
+		admitted := make(chan error)
+		go func() {
+			_, err := c.Admit(ctx, "", KindBatch)
+			admitted <- err
+		}()
+		require.Eventually(t, func() bool { return c.Stats().QueuedBatches == 1 },
+			time.Second, time.Millisecond)
+
+		cfg := c.Config()
+		cfg.MaxConcurrentBatches = 2
+		require.Nil(t, c.Update(cfg))
+		assert.Nil(t, <-admitted)
+		assert.Equal(t, 2, c.Stats().RunningBatches)
+	})
+}
+
+func TestUpdateValidates(t *testing.T) {
+	c := New(config.AdmissionControl{}, nil)
+	err := c.Update(config.AdmissionControl{Enabled: true, MaxConcurrentQueries: -1})
+	assert.NotNil(t, err)
+	assert.False(t, c.Config().Enabled)
+}
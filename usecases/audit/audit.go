@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package audit records who performed which mutation. Events are passed to
+// all sinks in the order they are recorded, a failing sink is logged but
+// never fails the mutation itself.
+package audit
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Actions which are recorded, object actions are subject to sampling
+const (
+	ActionObjectCreate = "objects.create"
+	ActionObjectUpdate = "objects.update"
+	ActionObjectDelete = "objects.delete"
+	ActionBatchCreate  = "objects.batch_create"
+	ActionBatchUpdate  = "objects.batch_update"
+	ActionBatchDelete  = "objects.batch_delete"
+
+	ActionClassCreate     = "schema.class_create"
+	ActionClassUpdate     = "schema.class_update"
+	ActionClassDelete     = "schema.class_delete"
+	ActionPropertyCreate  = "schema.property_create"
+	ActionTenantsCreate   = "schema.tenants_create"
+	ActionTenantsDelete   = "schema.tenants_delete"
+	ActionTenantsUpdate   = "schema.tenants_update"
+	ActionPropertyRename  = "schema.property_rename"
+	ActionPropertyDelete  = "schema.property_delete"
+	ActionPropertyReindex = "schema.property_reindex"
+	ActionSynonymsUpdate  = "schema.synonyms_update"
+	ActionStopwordsUpdate = "schema.stopwords_update"
+	ActionClassRename     = "schema.class_rename"
+	ActionAliasSet        = "schema.alias_set"
+	ActionAliasDelete     = "schema.alias_delete"
+
+	ActionBackupCreate  = "backups.create"
+	ActionBackupRestore = "backups.restore"
+)
+
+// Event is a single mutation, Resource identifies the object or backup if
+// the mutation is about a single one
+type Event struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"requestId,omitempty"`
+	User      string    `json:"user"`
+	Action    string    `json:"action"`
+	Class     string    `json:"class,omitempty"`
+	Tenant    string    `json:"tenant,omitempty"`
+	Resource  string    `json:"resource,omitempty"`
+	Count     int       `json:"count,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Sink receives the recorded events, Write is never called concurrently
+type Sink interface {
+	Write(event Event) error
+	Close() error
+}
+
+// Logger records events to its sinks. A nil Logger records nothing, so
+// callers don't need to check whether audit logging is enabled.
+type Logger struct {
+	mu                sync.Mutex
+	sinks             []Sink
+	objectsSampleRate float64
+	logger            logrus.FieldLogger
+	now               func() time.Time
+	random            func() float64
+}
+
+// New creates the sinks configured in cfg, it returns nil if audit logging
+// is disabled
+func New(cfg config.AuditLog, logger logrus.FieldLogger) (*Logger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var sinks []Sink
+	if cfg.File != "" {
+		sink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Stdout {
+		sinks = append(sinks, NewStdoutSink())
+	}
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, logger))
+	}
+
+	return NewLogger(sinks, cfg.ObjectsSampleRate, logger), nil
+}
+
+// NewLogger records events to custom sinks, see New for the sinks which can
+// be configured
+func NewLogger(sinks []Sink, objectsSampleRate float64, logger logrus.FieldLogger) *Logger {
+	return &Logger{
+		sinks:             sinks,
+		objectsSampleRate: objectsSampleRate,
+		logger:            logger,
+		now:               time.Now,
+		random:            rand.Float64,
+	}
+}
+
+// Record completes the event with the time, the user and the request id of
+// ctx and writes it to all sinks. err is the outcome of the mutation.
+func (l *Logger) Record(ctx context.Context, principal *models.Principal,
+	event Event, err error,
+) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if strings.HasPrefix(event.Action, "objects.") && l.random() >= l.objectsSampleRate {
+		return
+	}
+
+	event.Time = l.now().UTC()
+	event.RequestID = RequestID(ctx)
+	event.User = "anonymous"
+	if principal != nil {
+		event.User = principal.Username
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil {
+			l.logger.WithField("action", "audit_log").WithError(err).
+				Warn("could not write audit event")
+		}
+	}
+}
+
+// Close flushes and closes all sinks
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var errs []error
+	for _, sink := range l.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+type requestIDKey struct{}
+
+// WithRequestID attaches the id of the request to ctx
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the id attached to ctx, if any
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a random id for requests which don't bring their own
+func NewRequestID() string {
+	return uuid.NewString()
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeSink struct {
+	events []Event
+	err    error
+}
+
+func (s *fakeSink) Write(event Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *fakeSink) Close() error { return nil }
+
+func TestLogger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	sink, failing := &fakeSink{}, &fakeSink{err: errors.New("disk full")}
+	l := NewLogger([]Sink{sink, failing}, 0.5, logger)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	random := 0.0
+	l.random = func() float64 { return random }
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	alice := &models.Principal{Username: "alice"}
+
+	l.Record(ctx, alice, Event{Action: ActionObjectCreate, Class: "Article",
+		Tenant: "t1", Resource: "8a2c"}, nil)
+	require.Len(t, sink.events, 1)
+	assert.Equal(t, Event{
+		Time:      now,
+		RequestID: "req-1",
+		User:      "alice",
+		Action:    ActionObjectCreate,
+		Class:     "Article",
+		Tenant:    "t1",
+		Resource:  "8a2c",
+	}, sink.events[0])
+	assert.Len(t, failing.events, 1)
+	assert.Len(t, hook.AllEntries(), 1, "failing sink is logged")
+
+	t.Run("object mutations are sampled", func(t *testing.T) {
+		random = 0.7
+		l.Record(ctx, alice, Event{Action: ActionObjectDelete}, nil)
+		assert.Len(t, sink.events, 1)
+
+		l.Record(context.Background(), nil, Event{Action: ActionClassDelete, Class: "Article"},
+			errors.New("not found"))
+		require.Len(t, sink.events, 2)
+		assert.Equal(t, "anonymous", sink.events[1].User)
+		assert.Equal(t, "not found", sink.events[1].Error)
+		assert.Empty(t, sink.events[1].RequestID)
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		var l *Logger
+		l.Record(ctx, alice, Event{Action: ActionObjectCreate}, nil)
+		assert.Nil(t, l.Close())
+	})
+}
+
+func TestNew(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	l, err := New(config.AuditLog{}, logger)
+	require.Nil(t, err)
+	assert.Nil(t, l)
+
+	l, err = New(config.AuditLog{Enabled: true, Stdout: true, ObjectsSampleRate: 1}, logger)
+	require.Nil(t, err)
+	assert.Len(t, l.sinks, 1)
+}
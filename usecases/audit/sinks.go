@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// jsonSink writes one JSON event per line
+type jsonSink struct {
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewStdoutSink writes events as JSON lines to stdout
+func NewStdoutSink() Sink {
+	return &jsonSink{w: os.Stdout}
+}
+
+// NewFileSink appends events as JSON lines to the file at path
+func NewFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log file: %w", err)
+	}
+	return &jsonSink{w: f, closer: f}, nil
+}
+
+func (s *jsonSink) Write(event Event) error {
+	return json.NewEncoder(s.w).Encode(event)
+}
+
+func (s *jsonSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+const (
+	webhookQueueSize     = 4096
+	webhookBatchSize     = 100
+	webhookFlushInterval = time.Second
+	webhookTimeout       = 10 * time.Second
+)
+
+// webhookSink posts events as JSON arrays of up to webhookBatchSize events.
+// Events are queued so that a slow receiver doesn't slow down mutations,
+// they are dropped if the queue is full.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	logger logrus.FieldLogger
+
+	queue chan Event
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewWebhookSink posts batches of events to url
+func NewWebhookSink(url string, logger logrus.FieldLogger) Sink {
+	s := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		logger: logger,
+		queue:  make(chan Event, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *webhookSink) Write(event Event) error {
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("webhook queue is full, dropped %s event", event.Action)
+	}
+}
+
+// Close sends the queued events and stops the sink
+func (s *webhookSink) Close() error {
+	s.once.Do(func() { close(s.queue) })
+	<-s.done
+	return nil
+}
+
+func (s *webhookSink) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(webhookFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, webhookBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.post(batch); err != nil {
+			s.logger.WithField("action", "audit_log_webhook").WithError(err).
+				Warnf("could not send %d audit events", len(batch))
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case event, ok := <-s.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) == webhookBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (s *webhookSink) post(events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for i := 0; i < 2; i++ {
+		sink, err := NewFileSink(path)
+		require.Nil(t, err)
+		require.Nil(t, sink.Write(Event{Action: ActionClassCreate, Class: "Article"}))
+		require.Nil(t, sink.Close())
+	}
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event Event
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &event))
+		assert.Equal(t, "Article", event.Class)
+		lines++
+	}
+	assert.Equal(t, 2, lines, "events are appended")
+}
+
+func TestWebhookSink(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []Event
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var events []Event
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&events))
+		mu.Lock()
+		received = append(received, events...)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	logger, hook := test.NewNullLogger()
+	sink := NewWebhookSink(server.URL, logger)
+	for i := 0; i < webhookBatchSize+1; i++ {
+		require.Nil(t, sink.Write(Event{Action: ActionObjectCreate}))
+	}
+	require.Nil(t, sink.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, received, webhookBatchSize+1)
+	assert.Empty(t, hook.AllEntries())
+}
@@ -24,6 +24,7 @@ import (
 type Client struct {
 	config     config.APIKey
 	keystorage [][sha256.Size]byte
+	keys       *Keys // nil unless dynamic keys are enabled
 }
 
 func New(cfg config.Config) (*Client, error) {
@@ -53,6 +54,11 @@ func (c *Client) validateConfig() error {
 		return nil
 	}
 
+	if c.config.Dynamic && len(c.config.AllowedKeys) == 0 && len(c.config.Users) == 0 {
+		// all keys are created through the api
+		return nil
+	}
+
 	if len(c.config.AllowedKeys) < 1 {
 		return fmt.Errorf("need at least one valid allowed key")
 	}
@@ -87,6 +93,11 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 
 	tokenPos, ok := c.isTokenAllowed(token)
 	if !ok {
+		if c.keys != nil {
+			if principal, ok := c.keys.validate(token); ok {
+				return principal, nil
+			}
+		}
 		return nil, errors.New(401, "invalid api key, please provide a valid api key")
 	}
 
@@ -95,6 +106,12 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 	}, nil
 }
 
+// SetKeys enables the keys created through the api, it must be called
+// before any request is authenticated
+func (c *Client) SetKeys(keys *Keys) {
+	c.keys = keys
+}
+
 func (c *Client) isTokenAllowed(token string) (int, bool) {
 	tokenHash := sha256.Sum256([]byte(token))
 
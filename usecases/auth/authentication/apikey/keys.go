@@ -0,0 +1,346 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scopes"
+)
+
+// usage of a key is logged at most once per interval
+const auditInterval = time.Minute
+
+// Key is an API key created through the API. Only the hash of its secret is
+// stored, the secret is returned once when the key is created or rotated.
+type Key struct {
+	ID         string     `json:"id"`
+	User       string     `json:"user"`
+	Scopes     []string   `json:"scopes,omitempty"`
+	Hash       string     `json:"hash,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	RotatedAt  *time.Time `json:"rotatedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// CreateKeyRequest describes a new key, the key belongs to the requesting
+// user if User is empty and never expires if ExpiresAt is nil. Keys of other
+// users can only be created by root users.
+type CreateKeyRequest struct {
+	User      string     `json:"user"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// KeyRepo persists the keys, revoked keys are kept for auditing. In a
+// cluster it stores a key on all nodes, changes made on other nodes are
+// passed to Apply.
+type KeyRepo interface {
+	LoadAPIKeys() ([]Key, error)
+	PutAPIKey(key Key) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Keys manages the keys created through the API
+type Keys struct {
+	mu     sync.RWMutex
+	keys   map[string]*Key
+	byHash map[string]*Key
+
+	repo       KeyRepo
+	authorizer authorizer
+	logger     logrus.FieldLogger
+	now        func() time.Time
+}
+
+func NewKeys(repo KeyRepo, authorizer authorizer, logger logrus.FieldLogger) (*Keys, error) {
+	keys, err := repo.LoadAPIKeys()
+	if err != nil {
+		return nil, fmt.Errorf("load api keys: %w", err)
+	}
+
+	k := &Keys{
+		keys:       make(map[string]*Key, len(keys)),
+		byHash:     make(map[string]*Key, len(keys)),
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+		now:        time.Now,
+	}
+	for i := range keys {
+		k.set(&keys[i])
+	}
+	return k, nil
+}
+
+// List returns all keys including revoked ones, ordered by creation
+func (k *Keys) List(principal *models.Principal) ([]Key, error) {
+	if err := k.authorizer.Authorize(principal, "list", "apikeys"); err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	keys := make([]Key, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key.public())
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].ID < keys[j].ID
+		}
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+// Create returns the new key and its secret token
+func (k *Keys) Create(principal *models.Principal, req CreateKeyRequest) (Key, string, error) {
+	if req.User == "" && principal != nil {
+		req.User = principal.Username
+	}
+	// a key acts as its user, so the permission is checked on the user and
+	// not just on the api keys in general
+	if err := k.authorizer.Authorize(principal, "create", resource(req.User)); err != nil {
+		return Key{}, "", err
+	}
+	if req.User == "" {
+		return Key{}, "", enterrors.NewErrUnprocessable(fmt.Errorf("user must not be empty"))
+	}
+	if err := scopes.Validate(req.Scopes); err != nil {
+		return Key{}, "", enterrors.NewErrUnprocessable(err)
+	}
+	now := k.now().UTC()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return Key{}, "", enterrors.NewErrUnprocessable(fmt.Errorf("expiresAt must be in the future"))
+	}
+
+	id, err := randomID()
+	if err != nil {
+		return Key{}, "", err
+	}
+	token, hash, err := newToken(id)
+	if err != nil {
+		return Key{}, "", err
+	}
+	key := &Key{
+		ID:        id,
+		User:      req.User,
+		Scopes:    req.Scopes,
+		Hash:      hash,
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.repo.PutAPIKey(*key); err != nil {
+		return Key{}, "", fmt.Errorf("store api key: %w", err)
+	}
+	k.set(key)
+	k.audit(principal, key, "apikey_create").Info("api key created")
+	return key.public(), token, nil
+}
+
+// Rotate replaces the secret of a key, the previous secret stops working
+// immediately
+func (k *Keys) Rotate(principal *models.Principal, id string) (Key, string, error) {
+	if err := k.authorizeOwner(principal, "update", id); err != nil {
+		return Key{}, "", err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	key, err := k.active(id)
+	if err != nil {
+		return Key{}, "", err
+	}
+	token, hash, err := newToken(id)
+	if err != nil {
+		return Key{}, "", err
+	}
+
+	now := k.now().UTC()
+	rotated := *key
+	rotated.Hash, rotated.RotatedAt = hash, &now
+	if err := k.repo.PutAPIKey(rotated); err != nil {
+		return Key{}, "", fmt.Errorf("store api key: %w", err)
+	}
+	delete(k.byHash, key.Hash)
+	k.set(&rotated)
+	k.audit(principal, &rotated, "apikey_rotate").Info("api key rotated")
+	return rotated.public(), token, nil
+}
+
+// Revoke disables a key for good, it is kept to show up in the list
+func (k *Keys) Revoke(principal *models.Principal, id string) error {
+	if err := k.authorizeOwner(principal, "delete", id); err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	key, ok := k.keys[id]
+	if !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("api key %q not found", id))
+	}
+	if key.RevokedAt != nil {
+		return nil
+	}
+
+	now := k.now().UTC()
+	revoked := *key
+	revoked.RevokedAt = &now
+	if err := k.repo.PutAPIKey(revoked); err != nil {
+		return fmt.Errorf("store api key: %w", err)
+	}
+	delete(k.byHash, key.Hash)
+	k.set(&revoked)
+	k.audit(principal, &revoked, "apikey_revoke").Info("api key revoked")
+	return nil
+}
+
+// authorizeOwner checks the permission on the user the key belongs to. The
+// user of a key never changes, so it can be checked before the key is
+// locked for the change.
+func (k *Keys) authorizeOwner(principal *models.Principal, verb, id string) error {
+	k.mu.RLock()
+	key, ok := k.keys[id]
+	k.mu.RUnlock()
+	if !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("api key %q not found", id))
+	}
+	return k.authorizer.Authorize(principal, verb, resource(key.User))
+}
+
+// resource is the resource of the keys of a user, e.g. apikeys/alice
+func resource(user string) string {
+	return "apikeys/" + user
+}
+
+// Apply stores a key which was created, rotated or revoked on another node
+func (k *Keys) Apply(key Key) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if old, ok := k.keys[key.ID]; ok {
+		delete(k.byHash, old.Hash)
+	}
+	k.set(&key)
+}
+
+// validate returns the principal of a token if it belongs to a key which
+// is neither revoked nor expired
+func (k *Keys) validate(token string) (*models.Principal, bool) {
+	sum := sha256.Sum256([]byte(token))
+	hash := hex.EncodeToString(sum[:])
+	now := k.now().UTC()
+
+	k.mu.RLock()
+	key, ok := k.byHash[hash]
+	if !ok || key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		k.mu.RUnlock()
+		return nil, false
+	}
+	principal := &models.Principal{Username: key.User, Groups: scopes.Groups(key.Scopes)}
+	logUsage := key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= auditInterval
+	k.mu.RUnlock()
+
+	if logUsage {
+		k.mu.Lock()
+		// the last use is only kept in memory, it is persisted along with
+		// the next change of the key
+		key.LastUsedAt = &now
+		k.audit(principal, key, "apikey_use").Info("api key used")
+		k.mu.Unlock()
+	}
+	return principal, true
+}
+
+func (k *Keys) active(id string) (*Key, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("api key %q not found", id))
+	}
+	if key.RevokedAt != nil {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf("api key %q is revoked", id))
+	}
+	return key, nil
+}
+
+func (k *Keys) set(key *Key) {
+	k.keys[key.ID] = key
+	if key.RevokedAt == nil {
+		k.byHash[key.Hash] = key
+	}
+}
+
+func (k *Keys) audit(principal *models.Principal, key *Key, action string) *logrus.Entry {
+	by := ""
+	if principal != nil {
+		by = principal.Username
+	}
+	return k.logger.WithFields(logrus.Fields{
+		"action":  action,
+		"audit":   true,
+		"key_id":  key.ID,
+		"user":    key.User,
+		"by_user": by,
+	})
+}
+
+// public strips the hash of the secret
+func (key Key) public() Key {
+	key.Hash = ""
+	return key
+}
+
+// newToken returns a token for the key and the hash to store
+func newToken(id string) (token, hash string, err error) {
+	secret, err := randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	token = fmt.Sprintf("wv-%s-%s", id, secret)
+	sum := sha256.Sum256([]byte(token))
+	return token, hex.EncodeToString(sum[:]), nil
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
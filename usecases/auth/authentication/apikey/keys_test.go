@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scopes"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeKeyRepo struct {
+	keys map[string]Key
+}
+
+func (r *fakeKeyRepo) LoadAPIKeys() ([]Key, error) {
+	keys := make([]Key, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *fakeKeyRepo) PutAPIKey(key Key) error {
+	r.keys[key.ID] = key
+	return nil
+}
+
+type fakeAdminAuthorizer struct{}
+
+func (fakeAdminAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		return autherrs.NewForbidden(&models.Principal{}, verb, resource)
+	}
+	// other users may only manage their own keys, like with rbac
+	if principal.Username != "admin" && resource != "apikeys/"+principal.Username {
+		return autherrs.NewForbidden(principal, verb, resource)
+	}
+	return nil
+}
+
+func TestKeys(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	repo := &fakeKeyRepo{keys: map[string]Key{}}
+	keys, err := NewKeys(repo, fakeAdminAuthorizer{}, logger)
+	require.Nil(t, err)
+	now := time.Now().UTC()
+	keys.now = func() time.Time { return now }
+
+	admin := &models.Principal{Username: "admin"}
+	client, err := New(config.Config{Authentication: config.Authentication{
+		APIKey: config.APIKey{Enabled: true, Dynamic: true},
+	}})
+	require.Nil(t, err)
+	client.SetKeys(keys)
+
+	t.Run("create", func(t *testing.T) {
+		_, _, err := keys.Create(&models.Principal{Username: "alice"}, CreateKeyRequest{User: "admin"})
+		assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+
+		_, _, err = keys.Create(admin, CreateKeyRequest{Scopes: []string{"admin"}})
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+
+		past := now.Add(-time.Second)
+		_, _, err = keys.Create(admin, CreateKeyRequest{ExpiresAt: &past})
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+
+	key, token, err := keys.Create(admin, CreateKeyRequest{
+		User:   "alice",
+		Scopes: []string{scopes.Read},
+	})
+	require.Nil(t, err)
+	assert.Empty(t, key.Hash)
+	assert.NotEmpty(t, repo.keys[key.ID].Hash)
+	assert.NotEqual(t, token, repo.keys[key.ID].Hash)
+
+	t.Run("authenticate", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract(token, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "alice", principal.Username)
+		assert.Nil(t, scopes.Check(principal, "get", "schema/*"))
+		assert.NotNil(t, scopes.Check(principal, "create", "objects"))
+
+		_, err = client.ValidateAndExtract(token+"x", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("usage is audited once per interval", func(t *testing.T) {
+		hook.Reset()
+		_, ok := keys.validate(token)
+		require.True(t, ok)
+		now = now.Add(time.Second)
+		_, ok = keys.validate(token)
+		require.True(t, ok)
+		assert.Len(t, hook.AllEntries(), 0)
+
+		now = now.Add(auditInterval)
+		_, ok = keys.validate(token)
+		require.True(t, ok)
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, "apikey_use", hook.LastEntry().Data["action"])
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		rotated, newToken, err := keys.Rotate(admin, key.ID)
+		require.Nil(t, err)
+		assert.Equal(t, key.ID, rotated.ID)
+		assert.NotNil(t, rotated.RotatedAt)
+
+		_, ok := keys.validate(token)
+		assert.False(t, ok)
+		_, ok = keys.validate(newToken)
+		assert.True(t, ok)
+		token = newToken
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		require.Nil(t, keys.Revoke(admin, key.ID))
+		_, ok := keys.validate(token)
+		assert.False(t, ok)
+
+		_, _, err := keys.Rotate(admin, key.ID)
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+		err = keys.Revoke(admin, "missing")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		expiresAt := now.Add(time.Hour)
+		_, token, err := keys.Create(admin, CreateKeyRequest{ExpiresAt: &expiresAt})
+		require.Nil(t, err)
+		_, ok := keys.validate(token)
+		assert.True(t, ok)
+
+		now = expiresAt
+		_, ok = keys.validate(token)
+		assert.False(t, ok)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		reloaded, err := NewKeys(repo, fakeAdminAuthorizer{}, logger)
+		require.Nil(t, err)
+		list, err := reloaded.List(admin)
+		require.Nil(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, key.ID, list[0].ID)
+		assert.NotNil(t, list[0].RevokedAt)
+		assert.Equal(t, "admin", list[1].User)
+	})
+}
+
+func TestKeysOwner(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	keys, err := NewKeys(&fakeKeyRepo{keys: map[string]Key{}}, fakeAdminAuthorizer{}, logger)
+	require.Nil(t, err)
+
+	admin := &models.Principal{Username: "admin"}
+	bob := &models.Principal{Username: "bob"}
+	key, _, err := keys.Create(admin, CreateKeyRequest{User: "alice"})
+	require.Nil(t, err)
+
+	_, _, err = keys.Create(bob, CreateKeyRequest{User: "admin"})
+	assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+	_, _, err = keys.Rotate(bob, key.ID)
+	assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+	err = keys.Revoke(bob, key.ID)
+	assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+
+	own, _, err := keys.Create(bob, CreateKeyRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, "bob", own.User)
+	_, _, err = keys.Rotate(bob, own.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, keys.Revoke(bob, own.ID))
+}
+
+func TestKeysApply(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	admin := &models.Principal{Username: "admin"}
+	// both nodes share the repo, only the changes of the other node are
+	// passed to Apply
+	repo := &fakeKeyRepo{keys: map[string]Key{}}
+	keys, err := NewKeys(repo, fakeAdminAuthorizer{}, logger)
+	require.Nil(t, err)
+	other, err := NewKeys(repo, fakeAdminAuthorizer{}, logger)
+	require.Nil(t, err)
+
+	key, token, err := other.Create(admin, CreateKeyRequest{User: "alice"})
+	require.Nil(t, err)
+	_, ok := keys.validate(token)
+	require.False(t, ok)
+	keys.Apply(repo.keys[key.ID])
+	_, ok = keys.validate(token)
+	require.True(t, ok)
+
+	_, newToken, err := other.Rotate(admin, key.ID)
+	require.Nil(t, err)
+	keys.Apply(repo.keys[key.ID])
+	_, ok = keys.validate(token)
+	assert.False(t, ok)
+	_, ok = keys.validate(newToken)
+	assert.True(t, ok)
+
+	require.Nil(t, other.Revoke(admin, key.ID))
+	keys.Apply(repo.keys[key.ID])
+	_, ok = keys.validate(newToken)
+	assert.False(t, ok)
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	// TransactionPutKey stores a created, rotated or revoked key on all nodes
+	TransactionPutKey cluster.TransactionType = "put_api_key"
+	// TransactionReadKeys returns the keys of all nodes, it is used by nodes
+	// which join the cluster
+	TransactionReadKeys cluster.TransactionType = "read_api_keys"
+)
+
+type TransactionPutKeyPayload struct {
+	Key Key `json:"key"`
+}
+
+type TransactionReadKeysPayload struct {
+	Keys []Key `json:"keys"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPutKey:
+		var pl TransactionPutKeyPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionReadKeys:
+		// the request carries no payload, the keys are part of the response
+		return TransactionReadKeysPayload{}, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized api key transaction type %q", txType)
+	}
+}
@@ -14,6 +14,7 @@ package adminlist
 import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scopes"
 )
 
 const AnonymousPrincipalUsername = "anonymous"
@@ -35,6 +36,10 @@ func New(cfg Config) *Authorizer {
 // Authorize will give full access (to any resource!) if the user is part of
 // the admin list or no access at all if they are not
 func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if err := scopes.Check(principal, verb, resource); err != nil {
+		return err
+	}
+
 	if principal == nil {
 		principal = newAnonymousPrincipal()
 	}
@@ -14,6 +14,7 @@ package authorization
 import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scopes"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -39,7 +40,7 @@ func New(cfg config.Config) Authorizer {
 type DummyAuthorizer struct{}
 
 // Authorize on the DummyAuthorizer will allow any subject access to any
-// resource
+// resource, unless the subject is restricted by scopes
 func (d *DummyAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
-	return nil
+	return scopes.Check(principal, verb, resource)
 }
@@ -0,0 +1,347 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/scopes"
+)
+
+const AnonymousPrincipalUsername = "anonymous"
+
+// Repo persists the roles and the roles assigned to each user. In a cluster
+// it stores the changes on all nodes, changes made on other nodes are passed
+// to the Apply methods.
+type Repo interface {
+	Load() (roles []Role, users map[string][]string, err error)
+	PutRole(role Role) error
+	DeleteRole(name string) error
+	PutUserRoles(user string, roles []string) error
+}
+
+// Authorizer grants the permissions of the roles assigned to a user. Roles
+// and assignments are managed through the Authorizer as well, which requires
+// the "manage" permission on "endpoints/authz". A permission on
+// "endpoints/apikeys" only covers the keys of the user it is granted to.
+//
+// Roles are stored on the node which receives the change, in a cluster the
+// changes need to be applied to every node.
+type Authorizer struct {
+	repo      Repo
+	rootUsers map[string]struct{}
+
+	mu     sync.RWMutex
+	roles  map[string]Role
+	grants map[string][]grant // by role name
+	users  map[string][]string
+}
+
+func New(cfg Config, repo Repo) (*Authorizer, error) {
+	roles, users, err := repo.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load roles: %w", err)
+	}
+
+	a := &Authorizer{
+		repo:      repo,
+		rootUsers: map[string]struct{}{},
+		roles:     map[string]Role{},
+		grants:    map[string][]grant{},
+		users:     users,
+	}
+	for _, user := range cfg.RootUsers {
+		a.rootUsers[user] = struct{}{}
+	}
+	for _, role := range roles {
+		if err := a.setRole(role); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if err := scopes.Check(principal, verb, resource); err != nil {
+		return err
+	}
+
+	if principal == nil {
+		principal = &models.Principal{Username: AnonymousPrincipalUsername}
+	}
+
+	if _, ok := a.rootUsers[principal.Username]; ok {
+		return nil
+	}
+
+	// a key acts as its user, only root users manage the keys of others
+	if user, ok := apiKeyUser(resource); ok && user != principal.Username {
+		return errors.NewForbidden(principal, verb, resource)
+	}
+
+	req := requestFor(verb, resource)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, role := range a.users[principal.Username] {
+		for _, g := range a.grants[role] {
+			if g.covers(req) {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+// Roles returns all roles ordered by name
+func (a *Authorizer) Roles(principal *models.Principal) ([]Role, error) {
+	if err := a.Authorize(principal, "list", "authz"); err != nil {
+		return nil, err
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	roles := make([]Role, 0, len(a.roles))
+	for _, role := range a.roles {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles, nil
+}
+
+func (a *Authorizer) Role(principal *models.Principal, name string) (Role, error) {
+	if err := a.Authorize(principal, "get", "authz"); err != nil {
+		return Role{}, err
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	role, ok := a.roles[name]
+	if !ok {
+		return Role{}, enterrors.NewErrNotFound(fmt.Errorf("role %q not found", name))
+	}
+	return role, nil
+}
+
+// PutRole creates the role or replaces its permissions
+func (a *Authorizer) PutRole(principal *models.Principal, role Role) error {
+	if err := a.Authorize(principal, "update", "authz"); err != nil {
+		return err
+	}
+	if err := role.Validate(); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.repo.PutRole(role); err != nil {
+		return fmt.Errorf("store role: %w", err)
+	}
+	return a.setRole(role)
+}
+
+// DeleteRole deletes the role and removes it from all users
+func (a *Authorizer) DeleteRole(principal *models.Principal, name string) error {
+	if err := a.Authorize(principal, "delete", "authz"); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.roles[name]; !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("role %q not found", name))
+	}
+
+	for user, roles := range a.users {
+		if remaining := without(roles, name); len(remaining) != len(roles) {
+			if err := a.putUserRoles(user, remaining); err != nil {
+				return err
+			}
+		}
+	}
+	if err := a.repo.DeleteRole(name); err != nil {
+		return fmt.Errorf("delete role: %w", err)
+	}
+	delete(a.roles, name)
+	delete(a.grants, name)
+	return nil
+}
+
+// UserRoles returns the roles assigned to a user, users can always see
+// their own roles
+func (a *Authorizer) UserRoles(principal *models.Principal, user string) ([]string, error) {
+	if principal == nil || principal.Username != user {
+		if err := a.Authorize(principal, "get", "authz"); err != nil {
+			return nil, err
+		}
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return append([]string{}, a.users[user]...), nil
+}
+
+// AssignRoles replaces the roles of a user, all roles must exist
+func (a *Authorizer) AssignRoles(principal *models.Principal, user string, roles []string) error {
+	if err := a.Authorize(principal, "update", "authz"); err != nil {
+		return err
+	}
+	if user == "" {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("user must not be empty"))
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, role := range roles {
+		if _, ok := a.roles[role]; !ok {
+			return enterrors.NewErrUnprocessable(fmt.Errorf("role %q not found", role))
+		}
+	}
+	return a.putUserRoles(user, roles)
+}
+
+func (a *Authorizer) putUserRoles(user string, roles []string) error {
+	if err := a.repo.PutUserRoles(user, roles); err != nil {
+		return fmt.Errorf("store roles of user %q: %w", user, err)
+	}
+	a.setUserRoles(user, roles)
+	return nil
+}
+
+// ApplyRole stores a role which was created or changed on another node
+func (a *Authorizer) ApplyRole(role Role) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.setRole(role)
+}
+
+// ApplyDeleteRole removes a role which was deleted on another node, the
+// roles of its users are changed separately
+func (a *Authorizer) ApplyDeleteRole(name string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.roles, name)
+	delete(a.grants, name)
+}
+
+// ApplyUserRoles stores the roles which were assigned to a user on another
+// node
+func (a *Authorizer) ApplyUserRoles(user string, roles []string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.setUserRoles(user, roles)
+}
+
+func (a *Authorizer) setUserRoles(user string, roles []string) {
+	if len(roles) == 0 {
+		delete(a.users, user)
+	} else {
+		a.users[user] = roles
+	}
+}
+
+func (a *Authorizer) setRole(role Role) error {
+	grants := make([]grant, len(role.Permissions))
+	for i, p := range role.Permissions {
+		g, err := p.parse()
+		if err != nil {
+			return fmt.Errorf("role %q: %w", role.Name, err)
+		}
+		grants[i] = g
+	}
+	a.roles[role.Name] = role
+	a.grants[role.Name] = grants
+	return nil
+}
+
+// requestFor translates the verbs and resources used throughout the
+// usecases into the action and resource a permission must grant
+func requestFor(verb, resource string) request {
+	read := verb == "get" || verb == "list" || verb == "head"
+	rank := actionRanks[ActionWrite]
+	if read {
+		rank = actionRanks[ActionRead]
+	}
+
+	parts := strings.Split(resource, "/")
+	switch parts[0] {
+	case "classes": // classes/<class>[/tenants/<tenant>]
+		return request{rank: rank, kind: "classes", name: at(parts, 1), tenant: at(parts, 3)}
+	case "objects": // objects[/<class>][/<id>]
+		class := at(parts, 1)
+		if !isClass(class) {
+			class = ""
+		}
+		return request{rank: rank, kind: "classes", name: class, entry: true}
+	case "batch", "traversal":
+		return request{rank: rank, kind: "classes", entry: true}
+	case "schema": // schema/*, schema/objects, schema/<class>[/tenants/<tenant>]
+		if !read {
+			rank = actionRanks[ActionManage]
+		}
+		class := at(parts, 1)
+		if !isClass(class) {
+			return request{rank: rank, kind: "classes", entry: true}
+		}
+		r := request{rank: rank, kind: "classes", name: class}
+		if at(parts, 2) == "tenants" {
+			r.tenant = at(parts, 3)
+		}
+		return r
+	default:
+		if !read {
+			rank = actionRanks[ActionManage]
+		}
+		return request{rank: rank, kind: "endpoints", name: parts[0]}
+	}
+}
+
+// apiKeyUser returns the user of an api keys resource, apikeys/<user>
+func apiKeyUser(resource string) (string, bool) {
+	parts := strings.SplitN(resource, "/", 2)
+	if parts[0] != "apikeys" || len(parts) < 2 {
+		return "", false
+	}
+	return parts[1], true
+}
+
+func at(parts []string, i int) string {
+	if i < len(parts) {
+		return parts[i]
+	}
+	return ""
+}
+
+// isClass tells class names apart from ids and wildcards, class names
+// always start with an upper case letter
+func isClass(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
+
+func without(list []string, item string) []string {
+	out := make([]string, 0, len(list))
+	for _, s := range list {
+		if s != item {
+			out = append(out, s)
+		}
+	}
+	return out
+}
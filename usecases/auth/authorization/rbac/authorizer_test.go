@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type fakeRepo struct {
+	roles map[string]Role
+	users map[string][]string
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{roles: map[string]Role{}, users: map[string][]string{}}
+}
+
+func (f *fakeRepo) Load() ([]Role, map[string][]string, error) {
+	var roles []Role
+	for _, role := range f.roles {
+		roles = append(roles, role)
+	}
+	users := map[string][]string{}
+	for user, r := range f.users {
+		users[user] = r
+	}
+	return roles, users, nil
+}
+
+func (f *fakeRepo) PutRole(role Role) error {
+	f.roles[role.Name] = role
+	return nil
+}
+
+func (f *fakeRepo) DeleteRole(name string) error {
+	delete(f.roles, name)
+	return nil
+}
+
+func (f *fakeRepo) PutUserRoles(user string, roles []string) error {
+	if len(roles) == 0 {
+		delete(f.users, user)
+		return nil
+	}
+	f.users[user] = roles
+	return nil
+}
+
+var (
+	root  = &models.Principal{Username: "root"}
+	alice = &models.Principal{Username: "alice"}
+)
+
+func newTestAuthorizer(t *testing.T, roles map[string][]Permission) *Authorizer {
+	a, err := New(Config{Enabled: true, RootUsers: []string{"root"}}, newFakeRepo())
+	require.Nil(t, err)
+	var names []string
+	for name, permissions := range roles {
+		require.Nil(t, a.PutRole(root, Role{Name: name, Permissions: permissions}))
+		names = append(names, name)
+	}
+	require.Nil(t, a.AssignRoles(root, "alice", names))
+	return a
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthorizer(t, map[string][]Permission{
+		"articles": {
+			{Action: ActionWrite, Resource: "classes/Article"},
+			{Action: ActionRead, Resource: "classes/Author"},
+		},
+		"tenant": {
+			{Action: ActionManage, Resource: "classes/Doc*/tenants/t1"},
+		},
+		"nodes": {
+			{Action: ActionRead, Resource: "endpoints/nodes"},
+		},
+	})
+
+	tests := []struct {
+		verb, resource string
+		allowed        bool
+	}{
+		{"create", "objects/Article/123", true},
+		{"get", "objects/Author/123", true},
+		{"update", "objects/Author/123", false},
+		{"get", "objects/Other/123", false},
+		{"get", "objects/8b7a2d7e-5d1d-4e3a-9a8e-0f0e6a1c3c52", true},
+		{"list", "objects", true},
+		{"create", "batch/objects", true},
+		{"get", "traversal/*", true},
+		{"get", "classes/Article/tenants/t5", true},
+		{"create", "classes/Author", false},
+		{"create", "classes/Documents/tenants/t1", true},
+		{"create", "classes/Documents/tenants/t2", false},
+		// requests on all classes or tenants need a permission on all of them
+		{"list", "classes/", false},
+		{"get", "classes/Article", true},
+		{"get", "classes/Documents", false},
+		{"list", "schema/*", true},
+		{"update", "schema/objects", true},
+		{"update", "schema/Article", false},
+		{"update", "schema/Documents/tenants/t1", true},
+		{"update", "schema/Documents/tenants/t2", false},
+		{"delete", "schema/Documents", false},
+		{"update", "schema/Documents/shards/s1", false},
+		{"list", "schema/Author/shards", true},
+		{"get", "nodes", true},
+		{"update", "nodes", false},
+		{"get", "backups/s3/1", false},
+		{"list", "authz", false},
+	}
+	for _, test := range tests {
+		err := a.Authorize(alice, test.verb, test.resource)
+		if test.allowed {
+			assert.Nil(t, err, "%s %s", test.verb, test.resource)
+		} else {
+			assert.Equal(t, autherrs.NewForbidden(alice, test.verb, test.resource), err,
+				"%s %s", test.verb, test.resource)
+		}
+	}
+
+	assert.Nil(t, a.Authorize(root, "delete", "schema/Article"))
+
+	all := newTestAuthorizer(t, map[string][]Permission{
+		"reader": {{Action: ActionRead, Resource: "classes/*"}},
+	})
+	assert.Nil(t, all.Authorize(alice, "list", "classes/"))
+	assert.Nil(t, all.Authorize(alice, "get", "classes/Documents"))
+
+	keys := newTestAuthorizer(t, map[string][]Permission{
+		"keys": {{Action: ActionManage, Resource: "endpoints/apikeys"}},
+	})
+	assert.Nil(t, keys.Authorize(alice, "create", "apikeys/alice"))
+	assert.Nil(t, keys.Authorize(alice, "list", "apikeys"))
+	assert.NotNil(t, keys.Authorize(alice, "create", "apikeys/root"))
+	assert.NotNil(t, keys.Authorize(alice, "delete", "apikeys/bob"))
+	assert.NotNil(t, keys.Authorize(alice, "create", "apikeys/"))
+	assert.Nil(t, keys.Authorize(root, "create", "apikeys/alice"))
+	assert.NotNil(t, a.Authorize(nil, "get", "objects/Article/123"))
+}
+
+func TestManageRoles(t *testing.T) {
+	repo := newFakeRepo()
+	a, err := New(Config{Enabled: true, RootUsers: []string{"root"}}, repo)
+	require.Nil(t, err)
+
+	admin := Role{Name: "authz-admin", Permissions: []Permission{
+		{Action: ActionManage, Resource: "endpoints/authz"},
+	}}
+	reader := Role{Name: "reader", Permissions: []Permission{
+		{Action: ActionRead, Resource: "classes/*"},
+	}}
+	require.Nil(t, a.PutRole(root, admin))
+	require.Nil(t, a.AssignRoles(root, "alice", []string{"authz-admin"}))
+
+	// alice can manage roles now, but only has the permissions of her roles
+	require.Nil(t, a.PutRole(alice, reader))
+	require.Nil(t, a.AssignRoles(alice, "bob", []string{"reader"}))
+	bob := &models.Principal{Username: "bob"}
+	assert.Nil(t, a.Authorize(bob, "get", "objects/Article/123"))
+	assert.NotNil(t, a.Authorize(bob, "create", "objects/Article/123"))
+	assert.NotNil(t, a.PutRole(bob, reader))
+
+	roles, err := a.Roles(alice)
+	require.Nil(t, err)
+	assert.Equal(t, []Role{admin, reader}, roles)
+
+	userRoles, err := a.UserRoles(bob, "bob")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"reader"}, userRoles)
+	_, err = a.UserRoles(bob, "alice")
+	assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, role := range []Role{
+			{Name: ""},
+			{Name: "r", Permissions: []Permission{{Action: "delete", Resource: "*"}}},
+			{Name: "r", Permissions: []Permission{{Action: ActionRead, Resource: "objects/A"}}},
+			{Name: "r", Permissions: []Permission{{Action: ActionRead, Resource: "classes/["}}},
+		} {
+			err := a.PutRole(root, role)
+			assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}), "%v", role)
+		}
+		err := a.AssignRoles(root, "bob", []string{"unknown"})
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+		_, err = a.Role(root, "unknown")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+	})
+
+	t.Run("persisted", func(t *testing.T) {
+		loaded, err := New(Config{Enabled: true, RootUsers: []string{"root"}}, repo)
+		require.Nil(t, err)
+		assert.Nil(t, loaded.Authorize(bob, "get", "objects/Article/123"))
+	})
+
+	t.Run("delete role", func(t *testing.T) {
+		require.Nil(t, a.DeleteRole(root, "reader"))
+		assert.NotNil(t, a.Authorize(bob, "get", "objects/Article/123"))
+		assert.Equal(t, map[string][]string{"alice": {"authz-admin"}}, repo.users)
+		err := a.DeleteRole(root, "reader")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+	})
+
+	t.Run("changes of other nodes", func(t *testing.T) {
+		require.Nil(t, a.ApplyRole(reader))
+		a.ApplyUserRoles("bob", []string{"reader"})
+		assert.Nil(t, a.Authorize(bob, "get", "objects/Article/123"))
+
+		a.ApplyDeleteRole("reader")
+		assert.NotNil(t, a.Authorize(bob, "get", "objects/Article/123"))
+		a.ApplyUserRoles("bob", nil)
+		_, err := a.UserRoles(root, "bob")
+		require.Nil(t, err)
+		// changes of other nodes are already stored by the repo
+		assert.Equal(t, map[string][]string{"alice": {"authz-admin"}}, repo.users)
+	})
+}
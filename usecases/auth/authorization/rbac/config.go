@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import "fmt"
+
+// Config enables role based access control. RootUsers have all permissions,
+// they create the roles and assign them to the other users.
+type Config struct {
+	Enabled   bool     `json:"enabled" yaml:"enabled"`
+	RootUsers []string `json:"root_users" yaml:"root_users"`
+}
+
+func (c Config) Validate() error {
+	if len(c.RootUsers) == 0 {
+		return fmt.Errorf("rbac: at least one root user is required")
+	}
+
+	return nil
+}
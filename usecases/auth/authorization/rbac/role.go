@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Actions a permission grants, each action includes the ones before it
+const (
+	ActionRead   = "read"
+	ActionWrite  = "write"
+	ActionManage = "manage"
+)
+
+var actionRanks = map[string]int{ActionRead: 1, ActionWrite: 2, ActionManage: 3}
+
+// Permission grants an action on the resources matching Resource, which is
+// one of
+//
+//   - "*" for all resources
+//   - "classes/<class>" for a class and all of its tenants
+//   - "classes/<class>/tenants/<tenant>" for a single tenant of a class
+//   - "endpoints/<endpoint>" for the APIs which aren't about classes, such as
+//     nodes, backups or authz
+//
+// Classes, tenants and endpoints are patterns as understood by path.Match,
+// e.g. "classes/Article*/tenants/*".
+//
+// Reading a class allows to query its objects and to see its definition,
+// writing allows to change its objects and managing allows to change its
+// definition and tenants. A request on all classes or on all tenants of a
+// class, e.g. a query without a class, needs a permission whose patterns
+// match all of them. The definitions of all classes can be listed with any
+// permission on classes though, their objects cannot.
+type Permission struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+}
+
+// Role is a named set of permissions which is assigned to users
+type Role struct {
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+}
+
+func (r Role) Validate() error {
+	if r.Name == "" || strings.Contains(r.Name, "/") {
+		return fmt.Errorf("invalid role name %q", r.Name)
+	}
+
+	for _, p := range r.Permissions {
+		if _, err := p.parse(); err != nil {
+			return fmt.Errorf("role %q: %w", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// grant is a parsed permission
+type grant struct {
+	rank     int
+	all      bool
+	kind     string // "classes" or "endpoints"
+	pattern  string // class or endpoint
+	tenants  string // tenant pattern of classes
+	original Permission
+}
+
+func (p Permission) parse() (grant, error) {
+	g := grant{rank: actionRanks[p.Action], original: p}
+	if g.rank == 0 {
+		return g, fmt.Errorf("invalid action %q, must be one of %q, %q or %q",
+			p.Action, ActionRead, ActionWrite, ActionManage)
+	}
+
+	if p.Resource == "*" {
+		g.all = true
+		return g, nil
+	}
+
+	parts := strings.Split(p.Resource, "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "classes":
+		g.kind, g.pattern, g.tenants = "classes", parts[1], "*"
+	case len(parts) == 4 && parts[0] == "classes" && parts[2] == "tenants":
+		g.kind, g.pattern, g.tenants = "classes", parts[1], parts[3]
+	case len(parts) == 2 && parts[0] == "endpoints":
+		g.kind, g.pattern = "endpoints", parts[1]
+	default:
+		return g, fmt.Errorf("invalid resource %q", p.Resource)
+	}
+
+	for _, pattern := range []string{g.pattern, g.tenants} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return g, fmt.Errorf("invalid pattern in resource %q", p.Resource)
+		}
+	}
+	if g.pattern == "" || (g.kind == "classes" && g.tenants == "") {
+		return g, fmt.Errorf("invalid resource %q", p.Resource)
+	}
+	return g, nil
+}
+
+// request is a resource an action is requested on. An empty class or tenant
+// stands for all of them. Entry requests are the checks of the generic
+// objects, batch, traversal and schema paths, any permission on a matching
+// class grants them. They are always followed by a request on the precise
+// class and tenant, see authorization.ClassResource.
+type request struct {
+	rank   int
+	kind   string
+	name   string // class or endpoint
+	tenant string
+	entry  bool
+}
+
+func (g grant) covers(r request) bool {
+	if g.rank < r.rank {
+		return false
+	}
+	if g.all {
+		return true
+	}
+	if g.kind != r.kind {
+		return false
+	}
+	if r.kind == "endpoints" {
+		return match(g.pattern, r.name)
+	}
+	if r.entry {
+		return r.name == "" || match(g.pattern, r.name)
+	}
+	return match(g.pattern, orAll(r.name)) && match(g.tenants, orAll(r.tenant))
+}
+
+// orAll turns an empty class or tenant into a name only the patterns which
+// match every name match
+func orAll(name string) string {
+	if name == "" {
+		return "*"
+	}
+	return name
+}
+
+func match(pattern, name string) bool {
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	// TransactionPutRole creates or replaces a role on all nodes
+	TransactionPutRole cluster.TransactionType = "put_role"
+	// TransactionDeleteRole deletes a role on all nodes
+	TransactionDeleteRole cluster.TransactionType = "delete_role"
+	// TransactionPutUserRoles replaces the roles of a user on all nodes
+	TransactionPutUserRoles cluster.TransactionType = "put_user_roles"
+	// TransactionReadRoles returns the roles of all nodes, it is used by
+	// nodes which join the cluster
+	TransactionReadRoles cluster.TransactionType = "read_roles"
+)
+
+type TransactionPutRolePayload struct {
+	Role Role `json:"role"`
+}
+
+type TransactionDeleteRolePayload struct {
+	Name string `json:"name"`
+}
+
+type TransactionPutUserRolesPayload struct {
+	User  string   `json:"user"`
+	Roles []string `json:"roles"`
+}
+
+type TransactionReadRolesPayload struct {
+	Roles []Role              `json:"roles"`
+	Users map[string][]string `json:"users"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPutRole:
+		var pl TransactionPutRolePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionDeleteRole:
+		var pl TransactionDeleteRolePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionPutUserRoles:
+		var pl TransactionPutUserRolesPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionReadRoles:
+		// the request carries no payload, the roles are part of the response
+		return TransactionReadRolesPayload{}, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized role transaction type %q", txType)
+	}
+}
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import "fmt"
+
+// ClassResource is the resource of the objects of a class, or of the
+// objects of a single tenant if tenant is set. Without a class it is the
+// resource of the objects of all classes.
+func ClassResource(class, tenant string) string {
+	if tenant == "" {
+		return fmt.Sprintf("classes/%s", class)
+	}
+	return fmt.Sprintf("classes/%s/tenants/%s", class, tenant)
+}
+
+// SchemaResource is the resource of the definition of a class, or of a
+// single tenant of the class if tenant is set
+func SchemaResource(class, tenant string) string {
+	if tenant == "" {
+		return fmt.Sprintf("schema/%s", class)
+	}
+	return fmt.Sprintf("schema/%s/tenants/%s", class, tenant)
+}
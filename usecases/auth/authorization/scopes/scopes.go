@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package scopes restricts principals, such as those authenticated through
+// an API key with scopes, to a subset of what their user may do. Scopes are
+// carried as groups of the principal, so they can only restrict access and
+// never grant any. Every authorizer checks them before its own rules.
+package scopes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const (
+	// Read allows reading objects, the schema and any other resource
+	Read = "read"
+	// Write allows everything the user may do
+	Write = "write"
+)
+
+const groupPrefix = "scope:"
+
+// Validate returns an error if any of the scopes is unknown
+func Validate(scopes []string) error {
+	for _, scope := range scopes {
+		if scope != Read && scope != Write {
+			return fmt.Errorf("unknown scope %q, must be one of %q, %q", scope, Read, Write)
+		}
+	}
+	return nil
+}
+
+// Groups returns the groups which restrict a principal to the scopes, no
+// scopes means no restriction
+func Groups(scopes []string) []string {
+	groups := make([]string, len(scopes))
+	for i, scope := range scopes {
+		groups[i] = groupPrefix + scope
+	}
+	return groups
+}
+
+// Check returns a Forbidden error if the principal is restricted to scopes
+// which don't include the verb
+func Check(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		return nil
+	}
+
+	restricted := false
+	for _, group := range principal.Groups {
+		scope, ok := strings.CutPrefix(group, groupPrefix)
+		if !ok {
+			continue
+		}
+		restricted = true
+		if scope == Write || (scope == Read && isRead(verb)) {
+			return nil
+		}
+	}
+	if !restricted {
+		return nil
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func isRead(verb string) bool {
+	return verb == "get" || verb == "list" || verb == "head"
+}
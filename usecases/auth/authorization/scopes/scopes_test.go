@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scopes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestCheck(t *testing.T) {
+	principal := func(groups ...string) *models.Principal {
+		return &models.Principal{Username: "alice", Groups: groups}
+	}
+
+	assert.Nil(t, Check(nil, "delete", "schema/objects"))
+	assert.Nil(t, Check(principal("admins"), "delete", "schema/objects"))
+
+	readOnly := principal(Groups([]string{Read})...)
+	assert.Nil(t, Check(readOnly, "get", "traversal/*"))
+	assert.Nil(t, Check(readOnly, "list", "schema/*"))
+	assert.NotNil(t, Check(readOnly, "create", "objects"))
+	assert.NotNil(t, Check(readOnly, "update", "authz"))
+
+	readWrite := principal(Groups([]string{Read, Write})...)
+	assert.Nil(t, Check(readWrite, "delete", "schema/objects"))
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate(nil))
+	assert.Nil(t, Validate([]string{Read, Write}))
+	assert.NotNil(t, Validate([]string{"admin"}))
+}
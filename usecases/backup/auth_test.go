@@ -73,6 +73,9 @@ func Test_Authorization(t *testing.T) {
 			switch method {
 			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus":
 				continue
+			case "SetAuditLogger", "SetJobs":
+				// not user facing, only called once during startup
+				continue
 			}
 			assert.Contains(t, testedMethods, method)
 		}
@@ -157,12 +157,16 @@ type uploader struct {
 	backupID  string
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	// base holds the files of the base backup of an incremental backup
+	base baseFiles
+	// tenants selects the tenants of multi-tenant classes to upload
+	tenants TenantSelection
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
 	backupID string, setstaus func(st backup.Status), l logrus.FieldLogger,
 ) *uploader {
-	return &uploader{sourcer, backend, backupID, setstaus, l}
+	return &uploader{sourcer: sourcer, backend: backend, backupID: backupID, setStatus: setstaus, log: l}
 }
 
 // all uploads all files in addition to the metadata file
@@ -195,8 +199,9 @@ Loop:
 			if cdesc.Error != nil {
 				return cdesc.Error
 			}
+
 			u.log.WithField("class", cdesc.Name).Info("start uploading files")
-			if err := u.class(ctx, desc.ID, cdesc); err != nil {
+			if err := u.class(ctx, desc.ID, &cdesc); err != nil {
 				return err
 			}
 			desc.Classes = append(desc.Classes, cdesc)
@@ -211,8 +216,9 @@ Loop:
 	return nil
 }
 
-// class uploads one class
-func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor) (err error) {
+// class uploads one class, files which are unchanged since the base backup
+// are skipped
+func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescriptor) (err error) {
 	metric, err := monitoring.GetMetrics().BackupStoreDurations.GetMetricWithLabelValues(getType(u.backend.b), desc.Name)
 	if err == nil {
 		timer := prometheus.NewTimer(metric)
@@ -222,14 +228,18 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 		// backups need to be released anyway
 		go u.sourcer.ReleaseBackup(context.Background(), id, desc.Name)
 	}()
+	if _, err := u.tenants.apply(desc); err != nil {
+		return fmt.Errorf("class %s: %w", desc.Name, err)
+	}
 	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
 	defer cancel()
 
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.SetLimit(2 * _NUMCPU)
 
-	for _, shard := range desc.Shards {
-		shard := shard
+	for i := range desc.Shards {
+		shard := &desc.Shards[i]
+		u.base.split(desc.Name, shard)
 		eg.Go(func() error {
 			if err := ctx.Err(); err != nil {
 				return err
@@ -253,6 +263,8 @@ type fileWriter struct {
 	tempDir    string
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
+	// tenants renames the shards of multi-tenant classes
+	tenants TenantSelection
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
@@ -313,8 +325,9 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 }
 
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd backup.ShardDescriptor, classTempDir string) error {
+	name := fw.tenants.name(sd.Name)
 	for _, key := range sd.Files {
-		destPath := path.Join(classTempDir, key)
+		destPath := path.Join(classTempDir, renameFile(&sd, name, key))
 		destDir := path.Dir(destPath)
 		if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 			return fmt.Errorf("create folder %s: %w", destDir, err)
@@ -322,16 +335,33 @@ func (fw *fileWriter) writeTempShard(ctx context.Context, sd backup.ShardDescrip
 		if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
 			return fmt.Errorf("write file %s: %w", destPath, err)
 		}
+		if err := verifyFile(destPath, sd.Checksums[key]); err != nil {
+			return err
+		}
+	}
+	for key, id := range sd.Inherited {
+		destPath := path.Join(classTempDir, renameFile(&sd, name, key))
+		destDir := path.Dir(destPath)
+		if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
+			return fmt.Errorf("create folder %s: %w", destDir, err)
+		}
+		base := fw.backend.sibling(id, sd.Node)
+		if err := base.WriteToFile(ctx, key, destPath); err != nil {
+			return fmt.Errorf("write file %s of backup %s: %w", destPath, id, err)
+		}
+		if err := verifyFile(destPath, sd.Checksums[key]); err != nil {
+			return err
+		}
 	}
-	destPath := path.Join(classTempDir, sd.DocIDCounterPath)
+	destPath := path.Join(classTempDir, renameFile(&sd, name, sd.DocIDCounterPath))
 	if err := os.WriteFile(destPath, sd.DocIDCounter, os.ModePerm); err != nil {
 		return fmt.Errorf("write counter file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.PropLengthTrackerPath)
+	destPath = path.Join(classTempDir, renameFile(&sd, name, sd.PropLengthTrackerPath))
 	if err := os.WriteFile(destPath, sd.PropLengthTracker, os.ModePerm); err != nil {
 		return fmt.Errorf("write prop file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.ShardVersionPath)
+	destPath = path.Join(classTempDir, renameFile(&sd, name, sd.ShardVersionPath))
 	if err := os.WriteFile(destPath, sd.Version, os.ModePerm); err != nil {
 		return fmt.Errorf("write version file %s: %w", destPath, err)
 	}
@@ -45,15 +45,10 @@ func newBackupper(node string, logger logrus.FieldLogger, sourcer Sourcer, backe
 
 // Backup is called by the User
 func (b *backupper) Backup(ctx context.Context,
-	store nodeStore, id string, classes []string,
+	store nodeStore, req *Request,
 ) (*backup.CreateMeta, error) {
 	// make sure there is no active backup
-	req := Request{
-		Method:  OpCreate,
-		ID:      id,
-		Classes: classes,
-	}
-	if _, err := b.backup(ctx, store, &req); err != nil {
+	if _, err := b.backup(ctx, store, req); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
@@ -135,6 +130,14 @@ func (b *backupper) backup(ctx context.Context,
 		ID:      req.ID,
 		Timeout: expiration,
 	}
+	var base baseFiles
+	if req.BaseID != "" {
+		meta, err := baseMeta(ctx, store, b.node, req.BaseID)
+		if err != nil {
+			return ret, err
+		}
+		base = newBaseFiles(meta)
+	}
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir()); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -151,12 +154,15 @@ func (b *backupper) backup(ctx context.Context,
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger)
+		provider.base = base
+		provider.tenants = req.Tenants
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseID:        req.BaseID,
 		}
 
 		// the coordinator might want to abort the backup
@@ -22,6 +22,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -91,10 +92,12 @@ type coordinator struct {
 	client       client
 	log          logrus.FieldLogger
 	nodeResolver nodeResolver
+	jobs         *jobs.Manager
 
 	// state
 	Participants map[string]participantStatus
 	descriptor   *backup.DistributedBackupDescriptor
+	tenants      TenantSelection
 	shardSyncChan
 
 	// timeouts
@@ -142,7 +145,9 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
+		BaseID:        req.BaseID,
 	}
+	c.tenants = req.Tenants
 
 	for key := range c.Participants {
 		delete(c.Participants, key)
@@ -165,21 +170,25 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		Backend: req.Backend,
 	}
 
+	job := c.jobs.Track(jobs.TypeBackup, req.Backend+"/"+req.ID, nil)
 	go func() {
 		defer c.lastOp.reset()
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, false)
+		c.commit(ctx, &statusReq, nodes, false, job)
 		if err := store.PutMeta(ctx, GlobalBackupFile, c.descriptor); err != nil {
 			c.log.WithField("action", OpCreate).
 				WithField("backup_id", req.ID).Errorf("put_meta: %v", err)
 		}
+		job.Done(c.descriptorError())
 	}()
 
 	return nil
 }
 
 // Restore coordinates a distributed restoration among participants
-func (c *coordinator) Restore(ctx context.Context, store coordStore, backend string, desc *backup.DistributedBackupDescriptor) error {
+func (c *coordinator) Restore(ctx context.Context, store coordStore, backend string,
+	desc *backup.DistributedBackupDescriptor, tenants TenantSelection,
+) error {
 	// make sure there is no active backup
 	if prevID := c.lastOp.renew(desc.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("restoration %s already in progress", prevID)
@@ -189,6 +198,7 @@ func (c *coordinator) Restore(ctx context.Context, store coordStore, backend str
 		delete(c.Participants, key)
 	}
 	c.descriptor = desc.ResetStatus()
+	c.tenants = tenants
 
 	nodes, err := c.canCommit(ctx, OpRestore, backend)
 	if err != nil {
@@ -205,14 +215,16 @@ func (c *coordinator) Restore(ctx context.Context, store coordStore, backend str
 	}
 
 	statusReq := StatusRequest{Method: OpRestore, ID: desc.ID, Backend: backend}
+	job := c.jobs.Track(jobs.TypeBackupRestore, backend+"/"+desc.ID, nil)
 	go func() {
 		defer c.lastOp.reset()
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, true)
+		c.commit(ctx, &statusReq, nodes, true, job)
 		if err := store.PutMeta(ctx, GlobalRestoreFile, c.descriptor); err != nil {
 			c.log.WithField("action", OpRestore).
 				WithField("backup_id", desc.ID).Errorf("put_meta: %v", err)
 		}
+		job.Done(c.descriptorError())
 	}()
 
 	return nil
@@ -261,6 +273,10 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 
 	id := c.descriptor.ID
 	groups := c.descriptor.Nodes
+	baseID := ""
+	if method == OpCreate {
+		baseID = c.descriptor.BaseID
+	}
 
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(_MaxNumberConns)
@@ -287,6 +303,8 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 					Backend:  backend,
 					Classes:  gr.Classes,
 					Duration: _BookingPeriod,
+					BaseID:   baseID,
+					Tenants:  c.tenants,
 				},
 			}
 		}
@@ -325,19 +343,26 @@ func (c *coordinator) commit(ctx context.Context,
 	req *StatusRequest,
 	node2Addr map[string]string,
 	toleratePartialFailure bool,
+	job *jobs.Handle,
 ) {
 	// create a new copy for commitAll and queryAll to mutate
 	node2Host := make(map[string]string, len(node2Addr))
 	for k, v := range node2Addr {
 		node2Host[k] = v
 	}
+	// nodes are removed from node2Host once they are done
+	progress := func() {
+		job.Progress(int64(len(node2Addr)-len(node2Host)), int64(len(node2Addr)))
+	}
 	nFailures := c.commitAll(ctx, req, node2Host)
+	progress()
 	retryAfter := c.timeoutNextRound / 5 // 2s for first time
 	canContinue := len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
 	for canContinue {
 		<-time.After(retryAfter)
 		retryAfter = c.timeoutNextRound
 		nFailures += c.queryAll(ctx, req, node2Host)
+		progress()
 		canContinue = len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
 	}
 	if !toleratePartialFailure && nFailures > 0 {
@@ -361,6 +386,17 @@ func (c *coordinator) commit(ctx context.Context,
 	c.descriptor.Error = reason
 }
 
+// descriptorError returns the outcome of the last operation as an error
+func (c *coordinator) descriptorError() error {
+	if c.descriptor.Status == backup.Success {
+		return nil
+	}
+	if c.descriptor.Error == "" {
+		return fmt.Errorf("%s", c.descriptor.Status)
+	}
+	return errors.New(c.descriptor.Error)
+}
+
 // queryAll queries all participant and store their statuses internally
 //
 // It returns the number of failed node backups
@@ -300,7 +300,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), TenantSelection{})
 		assert.Nil(t, err)
 	})
 
@@ -315,7 +315,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), TenantSelection{})
 		assert.ErrorIs(t, err, errCannotCommit)
 		assert.Contains(t, err.Error(), nodes[1])
 	})
@@ -333,7 +333,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, backendName, genReq(), TenantSelection{})
 		assert.ErrorIs(t, err, ErrAny)
 		assert.Contains(t, err.Error(), "initial")
 	})
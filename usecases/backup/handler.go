@@ -42,6 +42,7 @@ type authorizer interface {
 
 type schemaManger interface {
 	RestoreClass(ctx context.Context, d *backup.ClassDescriptor) error
+	RestoreTenants(ctx context.Context, class string, tenants []string) error
 	NodeName() string
 }
 
@@ -105,6 +106,13 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// BaseID turns the backup into an incremental backup, which only
+	// contains the files which changed since the backup with this ID
+	BaseID string
+
+	// Tenants selects the tenants of multi-tenant classes
+	Tenants TenantSelection
 }
 
 func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
@@ -123,7 +131,14 @@ func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupR
 	if err := store.Initialize(ctx); err != nil {
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
-	if meta, err := m.backupper.Backup(ctx, store, req.ID, classes); err != nil {
+	breq := Request{
+		Method:  OpCreate,
+		ID:      req.ID,
+		Classes: classes,
+		BaseID:  req.BaseID,
+		Tenants: req.Tenants,
+	}
+	if meta, err := m.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, err
 	} else {
 		status := string(meta.Status)
@@ -150,7 +165,8 @@ func (m *Manager) Restore(ctx context.Context, pr *models.Principal,
 		return nil, err
 	}
 	cs := meta.List()
-	if cls := m.restorer.AnyExists(cs); cls != "" {
+	// tenants can be restored into existing classes
+	if cls := m.restorer.AnyExists(cs); cls != "" && req.Tenants.IsEmpty() {
 		err := fmt.Errorf("cannot restore class %q because it already exists", cls)
 		return nil, backup.NewErrUnprocessable(err)
 	}
@@ -159,6 +175,7 @@ func (m *Manager) Restore(ctx context.Context, pr *models.Principal,
 		ID:      meta.ID,
 		Backend: req.Backend,
 		Classes: cs,
+		Tenants: req.Tenants,
 	}
 	data, err := m.restorer.Restore(ctx, &rreq, meta, store)
 	if err != nil {
@@ -285,6 +302,12 @@ func (m *Manager) validateBackupRequest(ctx context.Context, store nodeStore, re
 	if err := validateID(req.ID); err != nil {
 		return nil, err
 	}
+	if err := validateBaseID(req); err != nil {
+		return nil, err
+	}
+	if err := validateBackupTenants(req.Tenants); err != nil {
+		return nil, err
+	}
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, fmt.Errorf("malformed request: 'include' and 'exclude' cannot both contain values")
 	}
@@ -316,6 +339,9 @@ func (m *Manager) validateRestoreRequest(ctx context.Context, store nodeStore, r
 		err := fmt.Errorf("malformed request: 'include' and 'exclude' cannot both contain values")
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	if err := req.Tenants.validate(); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
 	meta, cs, err := m.restorer.validate(ctx, &store, &Request{ID: req.ID, Classes: req.Include})
 	if err != nil {
 		if errors.Is(err, errMetaNotFound) {
@@ -338,6 +364,26 @@ func validateID(backupID string) error {
 	return nil
 }
 
+func validateBaseID(req *BackupRequest) error {
+	if req.BaseID == "" {
+		return nil
+	}
+	if err := validateID(req.BaseID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	if req.BaseID == req.ID {
+		return fmt.Errorf("backup %q cannot be based on itself", req.ID)
+	}
+	return nil
+}
+
+func validateBackupTenants(tenants TenantSelection) error {
+	if len(tenants.Rename) > 0 {
+		return fmt.Errorf("malformed request: tenants can only be renamed on restore")
+	}
+	return tenants.validate()
+}
+
 func nodeBackend(node string, provider BackupBackendProvider, backend, id string) (nodeStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
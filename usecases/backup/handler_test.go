@@ -24,6 +24,7 @@ import (
 type fakeSchemaManger struct {
 	errRestoreClass error
 	nodeName        string
+	tenants         []string
 }
 
 func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor,
@@ -31,6 +32,12 @@ func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor
 	return f.errRestoreClass
 }
 
+func (f *fakeSchemaManger) RestoreTenants(ctx context.Context, class string, tenants []string,
+) error {
+	f.tenants = append(f.tenants, tenants...)
+	return f.errRestoreClass
+}
+
 func (f *fakeSchemaManger) NodeName() string {
 	return f.nodeName
 }
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// An incremental backup only uploads the files which changed since its base
+// backup. Unchanged files are mapped to the backup which holds them, which is
+// resolved when the incremental backup is created. Restoring any backup of a
+// chain therefore restores the state at the time the backup was created,
+// without having to apply every backup of the chain one after another.
+
+var errBaseNotFound = errors.New("base backup not found")
+
+// baseFile is a file of the base backup together with the backup holding it
+type baseFile struct {
+	checksum string
+	backupID string
+}
+
+// baseFiles indexes the files of a base backup by class and shard
+type baseFiles map[string]map[string]baseFile
+
+func newBaseFiles(desc *backup.BackupDescriptor) baseFiles {
+	m := make(baseFiles, len(desc.Classes))
+	for _, c := range desc.Classes {
+		for _, s := range c.Shards {
+			files := make(map[string]baseFile, s.FileCount())
+			for _, fpath := range s.Files {
+				if sum := s.Checksums[fpath]; sum != "" {
+					files[fpath] = baseFile{checksum: sum, backupID: desc.ID}
+				}
+			}
+			for fpath, id := range s.Inherited {
+				if sum := s.Checksums[fpath]; sum != "" {
+					files[fpath] = baseFile{checksum: sum, backupID: id}
+				}
+			}
+			m[shardKey(c.Name, s.Name)] = files
+		}
+	}
+	return m
+}
+
+// split moves the files of a shard which are unchanged since the base backup
+// from the files to upload to the inherited files
+func (b baseFiles) split(class string, s *backup.ShardDescriptor) {
+	files, ok := b[shardKey(class, s.Name)]
+	if !ok {
+		return
+	}
+
+	changed := make([]string, 0, len(s.Files))
+	for _, fpath := range s.Files {
+		f, ok := files[fpath]
+		if !ok || f.checksum != s.Checksums[fpath] {
+			changed = append(changed, fpath)
+			continue
+		}
+		if s.Inherited == nil {
+			s.Inherited = make(map[string]string)
+		}
+		s.Inherited[fpath] = f.backupID
+	}
+	s.Files = changed
+}
+
+func shardKey(class, shard string) string {
+	return class + "/" + shard
+}
+
+// sibling returns the store of another backup of a node on the same backend
+func (s *nodeStore) sibling(backupID, node string) nodeStore {
+	return nodeStore{objStore{b: s.b, BasePath: fmt.Sprintf("%s/%s", backupID, node)}}
+}
+
+// baseMeta returns the metadata of the successful backup an incremental
+// backup of this node is based on
+func baseMeta(ctx context.Context, store nodeStore, node, baseID string,
+) (*backup.BackupDescriptor, error) {
+	base := store.sibling(baseID, node)
+	meta, err := base.Meta(ctx, baseID, false)
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return nil, fmt.Errorf("%w: %q", errBaseNotFound, base.HomeDir())
+		}
+		return nil, fmt.Errorf("find base backup %s: %w", base.HomeDir(), err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("invalid base backup %s status: %s", base.HomeDir(), meta.Status)
+	}
+	return meta, nil
+}
+
+// verifyChain checks that every inherited file of desc is held by the backup
+// it is mapped to, with the same checksum
+func verifyChain(ctx context.Context, store nodeStore, desc *backup.BackupDescriptor) error {
+	bases := make(map[string]baseFiles)
+	for _, c := range desc.Classes {
+		for _, s := range c.Shards {
+			for fpath, id := range s.Inherited {
+				key := id + "/" + s.Node
+				files, ok := bases[key]
+				if !ok {
+					meta, err := baseMeta(ctx, store, s.Node, id)
+					if err != nil {
+						return fmt.Errorf("backup chain: %w", err)
+					}
+					files = newBaseFiles(meta)
+					bases[key] = files
+				}
+				f, ok := files[shardKey(c.Name, s.Name)][fpath]
+				if !ok || f.backupID != id || f.checksum != s.Checksums[fpath] {
+					return fmt.Errorf("backup chain: backup %q does not hold file %q of shard %q.%q",
+						id, fpath, c.Name, s.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// verifyFile checks the checksum of a restored file, if one was recorded
+func verifyFile(fpath, want string) error {
+	if want == "" {
+		return nil
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		return fmt.Errorf("file %s is corrupted: checksum %s, want %s", fpath, got, want)
+	}
+	return nil
+}
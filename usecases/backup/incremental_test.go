@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestIncrementalBackup(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "MyClass"
+	)
+	// full holds a, base holds b and inherits a from full
+	base := backup.BackupDescriptor{
+		ID:     "base",
+		Status: string(backup.Success),
+		Classes: []backup.ClassDescriptor{{
+			Name: cls,
+			Shards: []backup.ShardDescriptor{{
+				Name:      "shard1",
+				Node:      nodeName,
+				Files:     []string{"b"},
+				Inherited: map[string]string{"a": "full"},
+				Checksums: map[string]string{"a": "1", "b": "2"},
+			}},
+		}},
+	}
+
+	t.Run("split", func(t *testing.T) {
+		shard := backup.ShardDescriptor{
+			Name:      "shard1",
+			Files:     []string{"a", "b", "c"},
+			Checksums: map[string]string{"a": "1", "b": "3", "c": "4"},
+		}
+		newBaseFiles(&base).split(cls, &shard)
+		assert.Equal(t, []string{"b", "c"}, shard.Files)
+		assert.Equal(t, map[string]string{"a": "full"}, shard.Inherited)
+
+		// unknown shards are uploaded completely
+		shard = backup.ShardDescriptor{
+			Name:      "shard2",
+			Files:     []string{"a"},
+			Checksums: map[string]string{"a": "1"},
+		}
+		newBaseFiles(&base).split(cls, &shard)
+		assert.Equal(t, []string{"a"}, shard.Files)
+		assert.Empty(t, shard.Inherited)
+	})
+
+	incremental := func(inherited map[string]string) *backup.BackupDescriptor {
+		return &backup.BackupDescriptor{
+			ID:     "incremental",
+			BaseID: "base",
+			Classes: []backup.ClassDescriptor{{
+				Name: cls,
+				Shards: []backup.ShardDescriptor{{
+					Name:      "shard1",
+					Node:      nodeName,
+					Files:     []string{"c"},
+					Inherited: inherited,
+					Checksums: map[string]string{"a": "1", "b": "2", "c": "4"},
+				}},
+			}},
+		}
+	}
+
+	t.Run("verify chain", func(t *testing.T) {
+		backend := newFakeBackend()
+		backend.On("GetObject", ctx, "base/"+nodeName, BackupFile).Return(marshalMeta(base), nil)
+		backend.On("GetObject", ctx, "full/"+nodeName, BackupFile).Return(marshalMeta(backup.BackupDescriptor{
+			ID:     "full",
+			Status: string(backup.Success),
+			Classes: []backup.ClassDescriptor{{
+				Name: cls,
+				Shards: []backup.ShardDescriptor{{
+					Name:      "shard1",
+					Node:      nodeName,
+					Files:     []string{"a", "b"},
+					Checksums: map[string]string{"a": "1", "b": "0"},
+				}},
+			}},
+		}), nil)
+		backend.On("GetObject", ctx, mock.Anything, BackupFile).Return(nil, backup.ErrNotFound{})
+		backend.On("HomeDir", mock.Anything).Return("bucket/backups")
+		store := nodeStore{objStore{b: backend, BasePath: "incremental/" + nodeName}}
+
+		desc := incremental(map[string]string{"a": "full", "b": "base"})
+		assert.Nil(t, verifyChain(ctx, store, desc))
+		assert.Equal(t, []string{"base", "full"}, desc.Chain())
+
+		// b has a different checksum in full
+		err := verifyChain(ctx, store, incremental(map[string]string{"b": "full"}))
+		assert.ErrorContains(t, err, "does not hold file")
+
+		err = verifyChain(ctx, store, incremental(map[string]string{"a": "missing"}))
+		assert.True(t, errors.Is(err, errBaseNotFound))
+	})
+
+	t.Run("verify file", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), "segment.db")
+		require.Nil(t, os.WriteFile(fpath, []byte("hello"), os.ModePerm))
+
+		assert.Nil(t, verifyFile(fpath, ""))
+		assert.Nil(t, verifyFile(fpath,
+			"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"))
+		assert.ErrorContains(t, verifyFile(fpath, "1234"), "corrupted")
+	})
+
+	t.Run("validate request", func(t *testing.T) {
+		assert.Nil(t, validateBaseID(&BackupRequest{ID: "b2"}))
+		assert.Nil(t, validateBaseID(&BackupRequest{ID: "b2", BaseID: "b1"}))
+		assert.NotNil(t, validateBaseID(&BackupRequest{ID: "b2", BaseID: "b2"}))
+		assert.NotNil(t, validateBaseID(&BackupRequest{ID: "b2", BaseID: "B1"}))
+	})
+}
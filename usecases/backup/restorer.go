@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type restorer struct {
@@ -125,7 +126,7 @@ func (r *restorer) restore(ctx context.Context,
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, store)
+		err = r.restoreAll(context.Background(), desc, store, req.Tenants)
 		if err != nil {
 			r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
 		}
@@ -137,10 +138,11 @@ func (r *restorer) restore(ctx context.Context,
 func (r *restorer) restoreAll(ctx context.Context,
 	desc *backup.BackupDescriptor,
 	store nodeStore,
+	tenants TenantSelection,
 ) (err error) {
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, desc.ID, &cdesc, store); err != nil {
+		if err := r.restoreOne(ctx, desc.ID, &cdesc, store, tenants); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -161,6 +163,7 @@ func getType(myvar interface{}) string {
 func (r *restorer) restoreOne(ctx context.Context,
 	backupID string, desc *backup.ClassDescriptor,
 	store nodeStore,
+	tenants TenantSelection,
 ) (err error) {
 	metric, err := monitoring.GetMetrics().BackupRestoreDurations.GetMetricWithLabelValues(getType(store.b), desc.Name)
 	if err != nil {
@@ -168,10 +171,20 @@ func (r *restorer) restoreOne(ctx context.Context,
 		defer timer.ObserveDuration()
 	}
 
+	st, err := tenants.apply(desc)
+	if err != nil {
+		return err
+	}
 	if r.sourcer.ClassExists(desc.Name) {
-		return fmt.Errorf("already exists")
+		if st == nil {
+			return fmt.Errorf("already exists")
+		}
+		return r.restoreTenants(ctx, backupID, desc, store, tenants, st)
 	}
 	fw := newFileWriter(r.sourcer, store, backupID)
+	if st != nil {
+		fw.tenants = tenants
+	}
 	rollback, err := fw.Write(ctx, desc)
 	if err != nil {
 		return fmt.Errorf("write files: %w", err)
@@ -185,6 +198,41 @@ func (r *restorer) restoreOne(ctx context.Context,
 	return nil
 }
 
+// restoreTenants restores the selected tenants of a backup into an existing
+// multi-tenant class. The restored tenants are owned by this node, tenants
+// which were replicated to several nodes cannot be restored this way.
+func (r *restorer) restoreTenants(ctx context.Context,
+	backupID string, desc *backup.ClassDescriptor,
+	store nodeStore,
+	tenants TenantSelection,
+	st *sharding.State,
+) error {
+	if len(desc.Shards) == 0 { // no selected tenant is on this node
+		return nil
+	}
+	names := make([]string, len(desc.Shards))
+	for i, s := range desc.Shards {
+		names[i] = tenants.name(s.Name)
+		if p, ok := st.Physical[names[i]]; ok && len(p.BelongsToNodes) > 1 {
+			return fmt.Errorf("tenant %q is replicated and cannot be restored into an existing class", s.Name)
+		}
+	}
+
+	fw := newFileWriter(r.sourcer, store, backupID)
+	fw.tenants = tenants
+	rollback, err := fw.Write(ctx, desc)
+	if err != nil {
+		return fmt.Errorf("write files: %w", err)
+	}
+	if err := r.schema.RestoreTenants(ctx, desc.Name, names); err != nil {
+		if rerr := rollback(); rerr != nil {
+			r.logger.WithField("className", desc.Name).WithField("action", "rollback").Error(rerr)
+		}
+		return fmt.Errorf("restore tenants: %w", err)
+	}
+	return nil
+}
+
 // AnyExists checks if any classes of cs exists in DB
 func (r *restorer) AnyExists(cs []string) string {
 	for _, cls := range cs {
@@ -240,5 +288,8 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 		}
 		meta.Include(req.Classes)
 	}
+	if err := verifyChain(ctx, *store, meta); err != nil {
+		return nil, cs, err
+	}
 	return meta, cs, nil
 }
@@ -20,6 +20,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/jobs"
 )
 
 var (
@@ -35,6 +37,7 @@ type Scheduler struct {
 	backupper  *coordinator
 	restorer   *coordinator
 	backends   BackupBackendProvider
+	auditLog   *audit.Logger
 }
 
 // NewScheduler creates a new scheduler with two coordinators
@@ -62,10 +65,26 @@ func NewScheduler(
 	return m
 }
 
+// SetAuditLogger records every backup and restore which is started
+func (s *Scheduler) SetAuditLogger(l *audit.Logger) {
+	s.auditLog = l
+}
+
+// SetJobs tracks every backup and restore coordinated by this node in the
+// jobs API
+func (s *Scheduler) SetJobs(j *jobs.Manager) {
+	s.backupper.jobs = j
+	s.restorer.jobs = j
+}
+
 func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
 ) (_ *models.BackupCreateResponse, err error) {
 	defer func(begin time.Time) {
 		logOperation(s.logger, "try_backup", req.ID, req.Backend, begin, err)
+		s.auditLog.Record(ctx, pr, audit.Event{
+			Action:   audit.ActionBackupCreate,
+			Resource: req.Backend + "/" + req.ID,
+		}, err)
 	}(time.Now())
 
 	path := fmt.Sprintf("backups/%s/%s", req.Backend, req.ID)
@@ -91,6 +110,8 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		ID:      req.ID,
 		Backend: req.Backend,
 		Classes: classes,
+		BaseID:  req.BaseID,
+		Tenants: req.Tenants,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -112,6 +133,10 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 ) (_ *models.BackupRestoreResponse, err error) {
 	defer func(begin time.Time) {
 		logOperation(s.logger, "try_restore", req.ID, req.Backend, begin, err)
+		s.auditLog.Record(ctx, pr, audit.Event{
+			Action:   audit.ActionBackupRestore,
+			Resource: req.Backend + "/" + req.ID,
+		}, err)
 	}(time.Now())
 	path := fmt.Sprintf("backups/%s/%s/restore", req.Backend, req.ID)
 	if err := s.authorizer.Authorize(pr, "restore", path); err != nil {
@@ -136,7 +161,7 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		Path:    store.HomeDir(),
 		Classes: meta.Classes(),
 	}
-	err = s.restorer.Restore(ctx, store, req.Backend, meta)
+	err = s.restorer.Restore(ctx, store, req.Backend, meta, req.Tenants)
 	if err != nil {
 		status = string(backup.Failed)
 		data.Error = err.Error()
@@ -209,6 +234,12 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if err := validateID(req.ID); err != nil {
 		return nil, err
 	}
+	if err := validateBaseID(req); err != nil {
+		return nil, err
+	}
+	if err := validateBackupTenants(req.Tenants); err != nil {
+		return nil, err
+	}
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, errIncludeExclude
 	}
@@ -239,6 +270,19 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseID != "" {
+		base := coordStore{objStore{b: store.b, BasePath: req.BaseID}}
+		meta, err := base.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			if _, ok := err.(backup.ErrNotFound); ok {
+				return nil, fmt.Errorf("%w: %q", errBaseNotFound, base.HomeDir())
+			}
+			return nil, fmt.Errorf("find base backup %s: %w", base.HomeDir(), err)
+		}
+		if meta.Status != backup.Success {
+			return nil, fmt.Errorf("invalid base backup %s status: %s", base.HomeDir(), meta.Status)
+		}
+	}
 	return classes, nil
 }
 
@@ -252,6 +296,9 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if dup := findDuplicate(req.Include); dup != "" {
 		return nil, fmt.Errorf("class list 'include' contains duplicate: %s", dup)
 	}
+	if err := req.Tenants.validate(); err != nil {
+		return nil, err
+	}
 	destPath := store.HomeDir()
 	meta, err := store.Meta(ctx, GlobalBackupFile)
 	if err != nil {
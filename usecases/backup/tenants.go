@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// TenantSelection restricts backups and restores of multi-tenant classes to
+// some of their tenants, classes without multi-tenancy are not affected.
+// Restored tenants can be renamed, which also allows to restore them into an
+// existing class.
+type TenantSelection struct {
+	// Include are the only tenants which are backed up or restored
+	Include []string
+	// Exclude are the tenants which are not backed up or restored
+	Exclude []string
+	// Rename maps tenants of the backup to the names they are restored as
+	Rename map[string]string
+}
+
+// IsEmpty returns whether all tenants are selected under their own name
+func (t TenantSelection) IsEmpty() bool {
+	return len(t.Include) == 0 && len(t.Exclude) == 0 && len(t.Rename) == 0
+}
+
+func (t TenantSelection) validate() error {
+	if len(t.Include) > 0 && len(t.Exclude) > 0 {
+		return fmt.Errorf("malformed request: 'include tenants' and 'exclude tenants' cannot both contain values")
+	}
+	if dup := findDuplicate(t.Include); dup != "" {
+		return fmt.Errorf("tenant list 'include' contains duplicate: %s", dup)
+	}
+	names := make(map[string]string, len(t.Rename))
+	for from, to := range t.Rename {
+		if to == "" {
+			return fmt.Errorf("tenant %q cannot be renamed to an empty name", from)
+		}
+		if prev, ok := names[to]; ok {
+			return fmt.Errorf("tenants %q and %q cannot both be renamed to %q", prev, from, to)
+		}
+		names[to] = from
+	}
+	return nil
+}
+
+func (t TenantSelection) selects(tenant string) bool {
+	if len(t.Include) > 0 {
+		return contains(t.Include, tenant)
+	}
+	return !contains(t.Exclude, tenant)
+}
+
+// name returns the name a tenant of the backup is restored as
+func (t TenantSelection) name(tenant string) string {
+	if to, ok := t.Rename[tenant]; ok {
+		return to
+	}
+	return tenant
+}
+
+// apply removes the shards of tenants which are not selected from the
+// descriptor of a multi-tenant class and renames the others in its sharding
+// state. It returns the resulting sharding state, or nil if the class is
+// not multi-tenant or all tenants are selected.
+func (t TenantSelection) apply(desc *backup.ClassDescriptor) (*sharding.State, error) {
+	if t.IsEmpty() || len(desc.ShardingState) == 0 {
+		return nil, nil
+	}
+	var st sharding.State
+	if err := json.Unmarshal(desc.ShardingState, &st); err != nil {
+		return nil, fmt.Errorf("unmarshal sharding state: %w", err)
+	}
+	if !st.PartitioningEnabled {
+		return nil, nil
+	}
+
+	shards := make([]backup.ShardDescriptor, 0, len(desc.Shards))
+	for _, s := range desc.Shards {
+		if t.selects(s.Name) {
+			shards = append(shards, s)
+		}
+	}
+	desc.Shards = shards
+
+	physical := make(map[string]sharding.Physical, len(st.Physical))
+	for name, p := range st.Physical {
+		if t.selects(name) {
+			p.Name = t.name(name)
+			physical[p.Name] = p
+		}
+	}
+	st.Physical = physical
+
+	data, err := json.Marshal(&st)
+	if err != nil {
+		return nil, fmt.Errorf("marshal sharding state: %w", err)
+	}
+	desc.ShardingState = data
+	return &st, nil
+}
+
+// renameFile returns the path a file of a shard is restored to. The files
+// of a shard are prefixed with the id of the shard, e.g. class_tenant_lsm,
+// which is the name of the version file without its extension.
+func renameFile(sd *backup.ShardDescriptor, name, fpath string) string {
+	if name == sd.Name {
+		return fpath
+	}
+	prefix := strings.TrimSuffix(sd.ShardVersionPath, path.Ext(sd.ShardVersionPath))
+	if !strings.HasSuffix(prefix, sd.Name) || !strings.HasPrefix(fpath, prefix) {
+		return fpath
+	}
+	return strings.TrimSuffix(prefix, sd.Name) + name + fpath[len(prefix):]
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func genTenantClassDescriptor(t *testing.T, cls string, tenants ...string) backup.ClassDescriptor {
+	st := sharding.State{
+		IndexID:             "myclass",
+		Physical:            map[string]sharding.Physical{},
+		PartitioningEnabled: true,
+	}
+	shards := make([]backup.ShardDescriptor, len(tenants))
+	for i, tenant := range tenants {
+		st.Physical[tenant] = sharding.Physical{Name: tenant, BelongsToNodes: []string{nodeName}}
+		shards[i] = backup.ShardDescriptor{
+			Name:                  tenant,
+			Node:                  nodeName,
+			Files:                 []string{"myclass_" + tenant + "_lsm/objects/segment-1.db"},
+			DocIDCounterPath:      "myclass_" + tenant + ".indexcount",
+			PropLengthTrackerPath: "myclass_" + tenant + ".proplengths",
+			ShardVersionPath:      "myclass_" + tenant + ".version",
+			DocIDCounter:          []byte("1"),
+			PropLengthTracker:     []byte("2"),
+			Version:               []byte("3"),
+		}
+	}
+	data, err := json.Marshal(&st)
+	require.Nil(t, err)
+	return backup.ClassDescriptor{
+		Name: cls, Schema: []byte("{}"), ShardingState: data, Shards: shards,
+	}
+}
+
+func TestTenantSelection(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, TenantSelection{}.validate())
+		assert.Nil(t, TenantSelection{Include: []string{"t1"}, Rename: map[string]string{"t1": "t2"}}.validate())
+		assert.NotNil(t, TenantSelection{Include: []string{"t1"}, Exclude: []string{"t2"}}.validate())
+		assert.NotNil(t, TenantSelection{Include: []string{"t1", "t1"}}.validate())
+		assert.NotNil(t, TenantSelection{Rename: map[string]string{"t1": "t3", "t2": "t3"}}.validate())
+		assert.NotNil(t, TenantSelection{Rename: map[string]string{"t1": ""}}.validate())
+		assert.NotNil(t, validateBackupTenants(TenantSelection{Rename: map[string]string{"t1": "t2"}}))
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		desc := genTenantClassDescriptor(t, "MyClass", "t1", "t2", "t3")
+		st, err := TenantSelection{
+			Exclude: []string{"t2"},
+			Rename:  map[string]string{"t3": "t4"},
+		}.apply(&desc)
+		require.Nil(t, err)
+		require.NotNil(t, st)
+		require.Len(t, desc.Shards, 2)
+		assert.Equal(t, "t1", desc.Shards[0].Name)
+		assert.Equal(t, "t3", desc.Shards[1].Name)
+
+		var got sharding.State
+		require.Nil(t, json.Unmarshal(desc.ShardingState, &got))
+		assert.ElementsMatch(t, []string{"t1", "t4"}, got.AllPhysicalShards())
+		assert.Equal(t, "t4", got.Physical["t4"].Name)
+
+		// classes without multi-tenancy are not affected
+		desc = genTenantClassDescriptor(t, "MyClass", "t1")
+		desc.ShardingState = []byte(`{"partitioningEnabled": false}`)
+		st, err = TenantSelection{Include: []string{"t2"}}.apply(&desc)
+		require.Nil(t, err)
+		assert.Nil(t, st)
+		assert.Len(t, desc.Shards, 1)
+	})
+
+	t.Run("rename file", func(t *testing.T) {
+		sd := genTenantClassDescriptor(t, "MyClass", "t1").Shards[0]
+		assert.Equal(t, "myclass_t2_lsm/objects/segment-1.db", renameFile(&sd, "t2", sd.Files[0]))
+		assert.Equal(t, "myclass_t2.version", renameFile(&sd, "t2", sd.ShardVersionPath))
+		assert.Equal(t, sd.Files[0], renameFile(&sd, "t1", sd.Files[0]))
+	})
+}
+
+func TestRestoreTenantsIntoExistingClass(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		cls      = "MyClass"
+		backupID = "1"
+		nodeHome = backupID + "/" + nodeName
+		dataDir  = t.TempDir()
+	)
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dataDir)
+	backend.On("WriteToFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			os.WriteFile(args.String(3), []byte("segment"), os.ModePerm)
+		}).Return(nil)
+	sourcer := &fakeSourcer{}
+	sourcer.On("ClassExists", cls).Return(true)
+	schema := &fakeSchemaManger{nodeName: nodeName}
+	m := createManager(sourcer, schema, backend, nil)
+	store := nodeStore{objStore{b: backend, BasePath: nodeHome}}
+
+	t.Run("selected tenants are renamed", func(t *testing.T) {
+		desc := genTenantClassDescriptor(t, cls, "t1", "t2")
+		tenants := TenantSelection{Include: []string{"t1"}, Rename: map[string]string{"t1": "t3"}}
+		require.Nil(t, m.restorer.restoreOne(ctx, backupID, &desc, store, tenants))
+
+		assert.Equal(t, []string{"t3"}, schema.tenants)
+		for _, f := range []string{
+			"myclass_t3_lsm/objects/segment-1.db", "myclass_t3.indexcount",
+			"myclass_t3.proplengths", "myclass_t3.version",
+		} {
+			_, err := os.Stat(filepath.Join(dataDir, f))
+			assert.Nil(t, err, f)
+		}
+		_, err := os.Stat(filepath.Join(dataDir, "myclass_t2.version"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("whole classes are not restored into existing classes", func(t *testing.T) {
+		desc := genTenantClassDescriptor(t, cls, "t1")
+		err := m.restorer.restoreOne(ctx, backupID, &desc, store, TenantSelection{})
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("replicated tenants", func(t *testing.T) {
+		desc := genTenantClassDescriptor(t, cls, "t1")
+		var st sharding.State
+		require.Nil(t, json.Unmarshal(desc.ShardingState, &st))
+		st.Physical["t1"] = sharding.Physical{Name: "t1", BelongsToNodes: []string{nodeName, "Node-2"}}
+		desc.ShardingState, _ = json.Marshal(&st)
+
+		err := m.restorer.restoreOne(ctx, backupID, &desc, store,
+			TenantSelection{Rename: map[string]string{"t1": "t5"}})
+		assert.ErrorContains(t, err, "replicated")
+	})
+}
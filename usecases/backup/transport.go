@@ -40,6 +40,12 @@ type Request struct {
 	// Classes is list of class which need to be backed up
 	Classes []string
 
+	// BaseID is the backup an incremental backup is based on
+	BaseID string
+
+	// Tenants selects the tenants of multi-tenant classes
+	Tenants TenantSelection
+
 	// Duration
 	Duration time.Duration
 }
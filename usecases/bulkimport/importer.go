@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// column is imported into a property of the class
+type column struct {
+	index    int
+	property string
+}
+
+// importer reads the rows of a file and imports them in chunks of
+// batchSize objects
+type importer struct {
+	batch     BatchImporter
+	records   array.RecordReader
+	file      File
+	principal *models.Principal
+	class     string
+	tenant    string
+	repl      *additional.ReplicationProperties
+	batchSize int
+
+	columns      []column
+	idColumn     int
+	vectorColumn int
+
+	update func(func(r *Report))
+}
+
+// mapColumns determines which columns are imported into which property.
+// Without explicit columns every column named like a property of the class
+// is imported.
+func mapColumns(sch *arrow.Schema, class *models.Class, opts Options,
+) (columns []column, idColumn, vectorColumn int, err error) {
+	index := func(name string) (int, error) {
+		indices := sch.FieldIndices(name)
+		if len(indices) != 1 {
+			return -1, fmt.Errorf("column %q not found", name)
+		}
+		return indices[0], nil
+	}
+
+	idColumn, vectorColumn = -1, -1
+	if opts.IDColumn != "" {
+		if idColumn, err = index(opts.IDColumn); err != nil {
+			return nil, -1, -1, err
+		}
+	}
+	if opts.VectorColumn != "" {
+		if vectorColumn, err = index(opts.VectorColumn); err != nil {
+			return nil, -1, -1, err
+		}
+	}
+
+	if len(opts.Columns) > 0 {
+		for name, prop := range opts.Columns {
+			i, err := index(name)
+			if err != nil {
+				return nil, -1, -1, err
+			}
+			if _, err := schema.GetPropertyByName(class, prop); err != nil {
+				return nil, -1, -1, fmt.Errorf("column %q: %w", name, err)
+			}
+			columns = append(columns, column{index: i, property: prop})
+		}
+		return columns, idColumn, vectorColumn, nil
+	}
+
+	for i, field := range sch.Fields() {
+		if i == idColumn || i == vectorColumn {
+			continue
+		}
+		if _, err := schema.GetPropertyByName(class, field.Name); err == nil {
+			columns = append(columns, column{index: i, property: field.Name})
+		}
+	}
+	return columns, idColumn, vectorColumn, nil
+}
+
+func (im *importer) run(ctx context.Context) error {
+	defer im.file.Close()
+	defer im.records.Release()
+
+	chunk := make([]*models.Object, 0, im.batchSize)
+	rows := make([]int64, 0, im.batchSize)
+	var row int64
+
+	for im.records.Next() {
+		rec := im.records.Record()
+		for i := 0; i < int(rec.NumRows()); i, row = i+1, row+1 {
+			obj, err := im.object(rec, i)
+			if err != nil {
+				im.update(func(r *Report) {
+					r.RowsRead++
+					r.addError(row, err)
+				})
+				continue
+			}
+			im.update(func(r *Report) { r.RowsRead++ })
+
+			chunk = append(chunk, obj)
+			rows = append(rows, row)
+			if len(chunk) < im.batchSize {
+				continue
+			}
+
+			if err := im.importChunk(ctx, chunk, rows); err != nil {
+				return err
+			}
+			// the batch pipeline may hold on to the objects, never reuse the chunk
+			chunk = make([]*models.Object, 0, im.batchSize)
+			rows = make([]int64, 0, im.batchSize)
+		}
+	}
+	if err := im.records.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read records: %w", err)
+	}
+
+	if len(chunk) == 0 {
+		return nil
+	}
+	return im.importChunk(ctx, chunk, rows)
+}
+
+// importChunk fails only if the chunk could not be imported as a whole,
+// errors of single objects are added to the report
+func (im *importer) importChunk(ctx context.Context, chunk []*models.Object,
+	rows []int64,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	res, backpressure, err := im.batch.AddObjectsChunk(ctx, im.principal, chunk, im.repl)
+	if err != nil {
+		return err
+	}
+
+	im.update(func(r *Report) {
+		for _, obj := range res {
+			if obj.Err != nil {
+				r.addError(rows[obj.OriginalIndex], obj.Err)
+				continue
+			}
+			r.Imported++
+		}
+	})
+
+	if backpressure.RetryAfter > 0 {
+		t := time.NewTimer(backpressure.RetryAfter)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) object(rec arrow.Record, row int) (*models.Object, error) {
+	props := make(map[string]interface{}, len(im.columns))
+	for _, c := range im.columns {
+		col := rec.Column(c.index)
+		if col.IsNull(row) {
+			continue
+		}
+		props[c.property] = jsonValue(col, row)
+	}
+
+	// values are passed through JSON, so that they have the same types as
+	// those of objects imported through the API
+	normalized, err := normalizeProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &models.Object{
+		Class:      im.class,
+		Tenant:     im.tenant,
+		Properties: normalized,
+	}
+
+	if im.idColumn >= 0 {
+		col := rec.Column(im.idColumn)
+		if col.IsNull(row) {
+			return nil, fmt.Errorf("id is null")
+		}
+		id := strfmt.UUID(col.ValueStr(row))
+		if !strfmt.IsUUID(id.String()) {
+			return nil, fmt.Errorf("id %q is not a uuid", id)
+		}
+		obj.ID = id
+	}
+
+	if im.vectorColumn >= 0 {
+		col := rec.Column(im.vectorColumn)
+		if !col.IsNull(row) {
+			encoded, err := json.Marshal(col.GetOneForMarshal(row))
+			if err != nil {
+				return nil, fmt.Errorf("vector: %w", err)
+			}
+			if err := json.Unmarshal(encoded, &obj.Vector); err != nil {
+				return nil, fmt.Errorf("vector must be a list of numbers: %w", err)
+			}
+		}
+	}
+
+	return obj, nil
+}
+
+// jsonValue returns the value of the row as it is represented in JSON.
+// Timestamps and dates are formatted as RFC3339, which is what date
+// properties expect.
+func jsonValue(col arrow.Array, row int) interface{} {
+	switch c := col.(type) {
+	case *array.Timestamp:
+		unit := c.DataType().(*arrow.TimestampType).Unit
+		return c.Value(row).ToTime(unit).UTC().Format(time.RFC3339Nano)
+	case *array.Date32:
+		return c.Value(row).ToTime().UTC().Format(time.RFC3339)
+	case *array.Date64:
+		return c.Value(row).ToTime().UTC().Format(time.RFC3339)
+	default:
+		return col.GetOneForMarshal(row)
+	}
+}
+
+func normalizeProperties(props map[string]interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.UseNumber()
+	var out map[string]interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchImporter imports chunks of objects through the batch pipeline. It is
+// implemented by the batch manager.
+type BatchImporter interface {
+	AddObjectsChunk(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, repl *additional.ReplicationProperties,
+	) (objects.BatchObjects, objects.Backpressure, error)
+}
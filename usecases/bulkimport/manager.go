@@ -0,0 +1,369 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+const (
+	// DefaultBatchSize is the number of objects imported at once
+	DefaultBatchSize = 100
+	maxBatchSize     = 10000
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Options of a single import
+type Options struct {
+	Class string `json:"class"`
+	// Source is either s3://bucket/key or a path relative to the local root
+	Source string `json:"source"`
+	// Format is derived from the extension of the source if it is not set
+	Format string `json:"format,omitempty"`
+	Tenant string `json:"tenant,omitempty"`
+	// Columns maps column names to property names. If it is empty, every
+	// column named like a property of the class is imported.
+	Columns map[string]string `json:"columns,omitempty"`
+	// IDColumn holds the object ids, random ids are generated if it is not set
+	IDColumn string `json:"idColumn,omitempty"`
+	// VectorColumn holds a list of numbers per object, objects are
+	// vectorized as configured for the class if it is not set
+	VectorColumn     string `json:"vectorColumn,omitempty"`
+	BatchSize        int    `json:"batchSize,omitempty"`
+	ConsistencyLevel string `json:"consistencyLevel,omitempty"`
+}
+
+// Manager runs imports of Parquet and Arrow files in the background. The
+// objects are imported through the batch pipeline, so they are validated,
+// vectorized and authorized like objects sent through the batch API.
+type Manager struct {
+	batch      BatchImporter
+	schema     schemaGetter
+	authorizer authorizer
+	config     config.BulkImport
+	logger     logrus.FieldLogger
+	open       openFn
+	tracker    *jobs.Manager
+
+	sync.Mutex
+	jobs map[string]*job
+}
+
+type job struct {
+	report *Report
+	cancel context.CancelFunc
+	handle *jobs.Handle
+}
+
+func NewManager(batch BatchImporter, schemaGetter schemaGetter,
+	authorizer authorizer, cfg config.BulkImport, logger logrus.FieldLogger,
+) *Manager {
+	src := &sources{config: cfg}
+	return &Manager{
+		batch:      batch,
+		schema:     schemaGetter,
+		authorizer: authorizer,
+		config:     cfg,
+		logger:     logger,
+		open:       src.open,
+		jobs:       map[string]*job{},
+	}
+}
+
+// SetJobs tracks every import in the jobs API as well
+func (m *Manager) SetJobs(tracker *jobs.Manager) {
+	m.tracker = tracker
+}
+
+// Start opens the source and maps its columns before the import is started
+// in the background, so that invalid requests fail right away
+func (m *Manager) Start(principal *models.Principal, opts Options) (*Report, error) {
+	if err := m.authorizer.Authorize(principal, "create", "batch/objects"); err != nil {
+		return nil, err
+	}
+
+	if !m.config.Enabled {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf(
+			"bulk imports are disabled, set BULK_IMPORT_ENABLED to enable them"))
+	}
+
+	class, err := m.validate(&opts)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	err = m.authorizer.Authorize(principal, "create",
+		authorization.ClassResource(opts.Class, opts.Tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	im, totalRows, err := m.newImporter(ctx, principal, class, opts)
+	if err != nil {
+		cancel()
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	id := uuid.New().String()
+	report := &Report{
+		ID:        id,
+		Class:     class.Class,
+		Tenant:    opts.Tenant,
+		Source:    opts.Source,
+		Format:    opts.Format,
+		Status:    StatusStarted,
+		StartedAt: time.Now(),
+		TotalRows: totalRows,
+	}
+	im.update = m.update(id)
+
+	handle := m.tracker.Track(jobs.TypeBulkImport, class.Class,
+		func() { m.cancel(id) })
+	report.JobID = handle.ID()
+
+	m.Lock()
+	m.jobs[id] = &job{report: report, cancel: cancel, handle: handle}
+	snapshot := report.clone()
+	m.Unlock()
+
+	go m.run(ctx, im, id)
+
+	return snapshot, nil
+}
+
+func (m *Manager) validate(opts *Options) (*models.Class, error) {
+	sch := m.schema.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(opts.Class))
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found", opts.Class)
+	}
+
+	if mt := schema.MultiTenancyEnabled(class); mt && opts.Tenant == "" {
+		return nil, fmt.Errorf("class %q has multi-tenancy enabled, but request was without tenant", opts.Class)
+	} else if !mt && opts.Tenant != "" {
+		return nil, fmt.Errorf("class %q has multi-tenancy disabled, but request was with tenant", opts.Class)
+	}
+
+	format, err := formatOf(opts.Format, opts.Source)
+	if err != nil {
+		return nil, err
+	}
+	opts.Format = format
+
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.BatchSize < 1 || opts.BatchSize > maxBatchSize {
+		return nil, fmt.Errorf("batchSize must be between 1 and %d", maxBatchSize)
+	}
+
+	return class, nil
+}
+
+func (m *Manager) newImporter(ctx context.Context, principal *models.Principal,
+	class *models.Class, opts Options,
+) (*importer, int64, error) {
+	f, err := m.open(ctx, opts.Source)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open %q: %w", opts.Source, err)
+	}
+
+	records, totalRows, err := newRecordReader(ctx, f, opts.Format, opts.BatchSize)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	columns, idColumn, vectorColumn, err := mapColumns(records.Schema(), class, opts)
+	if err != nil {
+		records.Release()
+		f.Close()
+		return nil, 0, err
+	}
+
+	var repl *additional.ReplicationProperties
+	if opts.ConsistencyLevel != "" {
+		repl = &additional.ReplicationProperties{ConsistencyLevel: opts.ConsistencyLevel}
+	}
+
+	return &importer{
+		batch:        m.batch,
+		records:      records,
+		file:         f,
+		principal:    principal,
+		class:        class.Class,
+		tenant:       opts.Tenant,
+		repl:         repl,
+		batchSize:    opts.BatchSize,
+		columns:      columns,
+		idColumn:     idColumn,
+		vectorColumn: vectorColumn,
+	}, totalRows, nil
+}
+
+// Status returns the report of the import
+func (m *Manager) Status(principal *models.Principal, id string) (*Report, error) {
+	if err := m.authorizer.Authorize(principal, "list", "batch/objects"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("import %q not found", id))
+	}
+	if err := m.authorizeReport(principal, "list", job.report); err != nil {
+		return nil, err
+	}
+
+	return job.report.clone(), nil
+}
+
+// List returns the reports of all imports since the start of the node, the
+// most recent first
+func (m *Manager) List(principal *models.Principal) ([]*Report, error) {
+	if err := m.authorizer.Authorize(principal, "list", "batch/objects"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	out := make([]*Report, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		// imports into classes the principal may not read are left out
+		if m.authorizeReport(principal, "list", job.report) == nil {
+			out = append(out, job.report.clone())
+		}
+	}
+	m.Unlock()
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].StartedAt.After(out[b].StartedAt)
+	})
+	return out, nil
+}
+
+// Cancel stops a running import, objects which are imported already are
+// kept
+func (m *Manager) Cancel(principal *models.Principal, id string) error {
+	if err := m.authorizer.Authorize(principal, "create", "batch/objects"); err != nil {
+		return err
+	}
+
+	m.Lock()
+	job, ok := m.jobs[id]
+	m.Unlock()
+	if ok {
+		if err := m.authorizeReport(principal, "create", job.report); err != nil {
+			return err
+		}
+	}
+
+	return m.cancel(id)
+}
+
+// authorizeReport checks the class and tenant an import writes to
+func (m *Manager) authorizeReport(principal *models.Principal, verb string,
+	report *Report,
+) error {
+	return m.authorizer.Authorize(principal, verb,
+		authorization.ClassResource(report.Class, report.Tenant))
+}
+
+func (m *Manager) cancel(id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("import %q not found", id))
+	}
+	if job.report.Status != StatusStarted {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("import %q is not running anymore", id))
+	}
+
+	job.report.Status = StatusCancelled
+	job.cancel()
+	return nil
+}
+
+func (m *Manager) run(ctx context.Context, im *importer, id string) {
+	err := im.run(ctx)
+
+	m.Lock()
+	defer m.Unlock()
+
+	job := m.jobs[id]
+	job.cancel()
+	report := job.report
+	now := time.Now()
+	report.CompletedAt = &now
+
+	logger := m.logger.WithField("action", "bulk_import").
+		WithField("id", id).
+		WithField("class", report.Class).
+		WithField("source", report.Source)
+
+	if report.Status == StatusCancelled {
+		job.handle.Done(context.Canceled)
+		logger.Info("bulk import cancelled")
+		return
+	}
+
+	job.handle.Done(err)
+	if err != nil {
+		report.Status = StatusFailed
+		report.Error = err.Error()
+		logger.WithError(err).Error("bulk import failed")
+		return
+	}
+
+	report.Status = StatusSuccess
+	logger.WithField("imported", report.Imported).
+		WithField("failed", report.Failed).
+		Info("bulk import completed")
+}
+
+// update gives the importer access to the shared report
+func (m *Manager) update(id string) func(func(r *Report)) {
+	return func(fn func(r *Report)) {
+		m.Lock()
+		defer m.Unlock()
+
+		job := m.jobs[id]
+		fn(job.report)
+		job.handle.Progress(job.report.RowsRead, job.report.TotalRows)
+	}
+}
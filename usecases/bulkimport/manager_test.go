@@ -0,0 +1,451 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	id1 = "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01"
+	id2 = "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"
+	id3 = "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03"
+)
+
+func TestBulkImport_Formats(t *testing.T) {
+	for _, name := range []string{"articles.parquet", "articles.arrow", "articles.arrows"} {
+		t.Run(name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTestFile(t, filepath.Join(root, name))
+
+			batch := &fakeBatch{}
+			m := newTestManager(batch, root)
+
+			report, err := m.Start(nil, Options{
+				Class:        "Article",
+				Source:       name,
+				IDColumn:     "uuid",
+				VectorColumn: "embedding",
+				BatchSize:    2,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, StatusStarted, report.Status)
+
+			report = waitForCompletion(t, m, report.ID)
+			assert.Equal(t, StatusSuccess, report.Status, report.Error)
+			assert.Equal(t, int64(3), report.RowsRead)
+			assert.Equal(t, int64(3), report.Imported)
+			assert.Equal(t, int64(0), report.Failed)
+
+			require.Len(t, batch.chunks, 2, "batch size is respected")
+			objs := batch.objects()
+			require.Len(t, objs, 3)
+
+			assert.Equal(t, strfmt.UUID(id1), objs[0].ID)
+			assert.Equal(t, "Article", objs[0].Class)
+			assert.Equal(t, []float32{1, 2}, []float32(objs[0].Vector))
+			assert.Equal(t, map[string]interface{}{
+				"title":     "first",
+				"wordCount": json.Number("120"),
+				"published": "2023-06-01T12:00:00Z",
+				"tags":      []interface{}{"a", "b"},
+			}, objs[0].Properties)
+
+			// null values are left out
+			assert.Equal(t, map[string]interface{}{
+				"wordCount": json.Number("7"),
+				"tags":      []interface{}{},
+			}, objs[1].Properties)
+			assert.Nil(t, objs[1].Vector)
+		})
+	}
+}
+
+func TestBulkImport_ColumnMapping(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+
+	batch := &fakeBatch{}
+	m := newTestManager(batch, root)
+
+	report, err := m.Start(nil, Options{
+		Class:   "Article",
+		Source:  "articles.parquet",
+		Columns: map[string]string{"heading": "title"},
+	})
+	require.Nil(t, err)
+	report = waitForCompletion(t, m, report.ID)
+	require.Equal(t, StatusSuccess, report.Status, report.Error)
+
+	objs := batch.objects()
+	require.Len(t, objs, 3)
+	assert.Equal(t, map[string]interface{}{"title": "subtitle"}, objs[0].Properties)
+	assert.Empty(t, objs[0].ID, "ids are generated by the batch pipeline")
+	assert.Nil(t, objs[0].Vector, "vectors are generated by the batch pipeline")
+}
+
+func TestBulkImport_ObjectErrors(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+
+	batch := &fakeBatch{failID: id3}
+	m := newTestManager(batch, root)
+
+	report, err := m.Start(nil, Options{
+		Class:    "Article",
+		Source:   "articles.parquet",
+		IDColumn: "uuid",
+	})
+	require.Nil(t, err)
+
+	report = waitForCompletion(t, m, report.ID)
+	assert.Equal(t, StatusSuccess, report.Status)
+	assert.Equal(t, int64(2), report.Imported)
+	assert.Equal(t, int64(1), report.Failed)
+	assert.Equal(t, []ObjectError{{Row: 2, Error: "invalid object"}}, report.Errors)
+}
+
+func TestBulkImport_Failure(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+
+	m := newTestManager(&fakeBatch{err: errors.New("forbidden")}, root)
+
+	report, err := m.Start(nil, Options{Class: "Article", Source: "articles.parquet"})
+	require.Nil(t, err)
+
+	report = waitForCompletion(t, m, report.ID)
+	assert.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, "forbidden", report.Error)
+}
+
+func TestBulkImport_Cancel(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+
+	batch := &fakeBatch{block: make(chan struct{})}
+	m := newTestManager(batch, root)
+
+	report, err := m.Start(nil, Options{
+		Class: "Article", Source: "articles.parquet", BatchSize: 1,
+	})
+	require.Nil(t, err)
+
+	require.Nil(t, m.Cancel(nil, report.ID))
+	close(batch.block)
+
+	report = waitForCompletion(t, m, report.ID)
+	assert.Equal(t, StatusCancelled, report.Status)
+	assert.Less(t, report.Imported, int64(3))
+
+	err = m.Cancel(nil, report.ID)
+	assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+
+	reports, err := m.List(nil)
+	require.Nil(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, report.ID, reports[0].ID)
+}
+
+func TestBulkImport_Jobs(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+
+	logger, _ := test.NewNullLogger()
+	tracker, err := jobs.NewManager(&fakeJobsRepo{}, &fakeAuthorizer{},
+		config.Jobs{}, logger)
+	require.Nil(t, err)
+
+	t.Run("completed", func(t *testing.T) {
+		m := newTestManager(&fakeBatch{}, root)
+		m.SetJobs(tracker)
+
+		report, err := m.Start(nil, Options{Class: "Article", Source: "articles.parquet"})
+		require.Nil(t, err)
+		require.NotEmpty(t, report.JobID)
+
+		report = waitForCompletion(t, m, report.ID)
+		assert.Equal(t, int64(3), report.TotalRows)
+
+		job, err := tracker.Get(nil, report.JobID)
+		require.Nil(t, err)
+		assert.Equal(t, jobs.TypeBulkImport, job.Type)
+		assert.Equal(t, "Article", job.Target)
+		assert.Equal(t, jobs.StatusSuccess, job.Status)
+		assert.Equal(t, 100.0, job.Progress)
+		assert.Equal(t, int64(3), job.Processed)
+	})
+
+	t.Run("cancelled through the jobs api", func(t *testing.T) {
+		batch := &fakeBatch{block: make(chan struct{})}
+		m := newTestManager(batch, root)
+		m.SetJobs(tracker)
+
+		report, err := m.Start(nil, Options{
+			Class: "Article", Source: "articles.parquet", BatchSize: 1,
+		})
+		require.Nil(t, err)
+
+		_, err = tracker.Cancel(nil, report.JobID)
+		require.Nil(t, err)
+		close(batch.block)
+
+		report = waitForCompletion(t, m, report.ID)
+		assert.Equal(t, StatusCancelled, report.Status)
+
+		job, err := tracker.Get(nil, report.JobID)
+		require.Nil(t, err)
+		assert.Equal(t, jobs.StatusCancelled, job.Status)
+		assert.NotNil(t, job.CompletedAt)
+	})
+}
+
+func TestBulkImport_Validation(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "articles.parquet"))
+	outside := t.TempDir()
+	writeTestFile(t, filepath.Join(outside, "secret.parquet"))
+	require.Nil(t, os.Symlink(filepath.Join(outside, "secret.parquet"),
+		filepath.Join(root, "link.parquet")))
+
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "unknown class", opts: Options{Class: "Unknown", Source: "articles.parquet"}},
+		{name: "tenant without multi-tenancy", opts: Options{
+			Class: "Article", Source: "articles.parquet", Tenant: "t1",
+		}},
+		{name: "unknown format", opts: Options{Class: "Article", Source: "articles.csv"}},
+		{name: "missing file", opts: Options{Class: "Article", Source: "missing.parquet"}},
+		{name: "path outside of root", opts: Options{
+			Class: "Article", Source: "../" + filepath.Base(outside) + "/secret.parquet",
+		}},
+		{name: "symlink outside of root", opts: Options{Class: "Article", Source: "link.parquet"}},
+		{name: "unknown id column", opts: Options{
+			Class: "Article", Source: "articles.parquet", IDColumn: "unknown",
+		}},
+		{name: "mapping to unknown property", opts: Options{
+			Class: "Article", Source: "articles.parquet",
+			Columns: map[string]string{"heading": "unknown"},
+		}},
+		{name: "batch size too large", opts: Options{
+			Class: "Article", Source: "articles.parquet", BatchSize: maxBatchSize + 1,
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newTestManager(&fakeBatch{}, root)
+			_, err := m.Start(nil, test.opts)
+			require.NotNil(t, err)
+			assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}), err.Error())
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		m := newTestManager(&fakeBatch{}, root)
+		m.config.Enabled = false
+		_, err := m.Start(nil, Options{Class: "Article", Source: "articles.parquet"})
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+
+	t.Run("unknown import", func(t *testing.T) {
+		m := newTestManager(&fakeBatch{}, root)
+		_, err := m.Status(nil, "unknown")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+	})
+}
+
+func newTestManager(batch *fakeBatch, root string) *Manager {
+	logger, _ := test.NewNullLogger()
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+				{Name: "published", DataType: schema.DataTypeDate.PropString()},
+				{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			},
+		},
+	}}}
+	return NewManager(batch, &fakeSchemaGetter{schema: sch}, &fakeAuthorizer{},
+		config.BulkImport{Enabled: true, LocalRoot: root}, logger)
+}
+
+func waitForCompletion(t *testing.T, m *Manager, id string) *Report {
+	var report *Report
+	require.Eventually(t, func() bool {
+		var err error
+		report, err = m.Status(nil, id)
+		require.Nil(t, err)
+		return report.CompletedAt != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return report
+}
+
+// writeTestFile writes three rows, the format is chosen by the extension.
+// The second row has null values.
+func writeTestFile(t *testing.T, path string) {
+	mem := memory.NewGoAllocator()
+	sch := arrow.NewSchema([]arrow.Field{
+		{Name: "uuid", Type: arrow.BinaryTypes.String},
+		{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "heading", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "wordCount", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "published", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
+		{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		{Name: "embedding", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32), Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, sch)
+	defer b.Release()
+	b.Field(0).(*array.StringBuilder).AppendValues([]string{id1, id2, id3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"first", "", "third"},
+		[]bool{true, false, true})
+	b.Field(2).(*array.StringBuilder).AppendValues([]string{"subtitle", "", ""},
+		[]bool{true, false, false})
+	b.Field(3).(*array.Int64Builder).AppendValues([]int64{120, 7, 1}, nil)
+	published := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	b.Field(4).(*array.TimestampBuilder).AppendValues(
+		[]arrow.Timestamp{arrow.Timestamp(published.UnixMilli()), 0, 0},
+		[]bool{true, false, false})
+	tags := b.Field(5).(*array.ListBuilder)
+	for _, row := range [][]string{{"a", "b"}, {}, {"c"}} {
+		tags.Append(true)
+		tags.ValueBuilder().(*array.StringBuilder).AppendValues(row, nil)
+	}
+	embedding := b.Field(6).(*array.ListBuilder)
+	for _, row := range [][]float32{{1, 2}, nil, {5, 6}} {
+		if row == nil {
+			embedding.AppendNull()
+			continue
+		}
+		embedding.Append(true)
+		embedding.ValueBuilder().(*array.Float32Builder).AppendValues(row, nil)
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	f, err := os.Create(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	switch filepath.Ext(path) {
+	case ".parquet":
+		w, err := pqarrow.NewFileWriter(sch, f, nil, pqarrow.DefaultWriterProps())
+		require.Nil(t, err)
+		require.Nil(t, w.Write(rec))
+		require.Nil(t, w.Close())
+	case ".arrow":
+		w, err := ipc.NewFileWriter(f, ipc.WithSchema(sch), ipc.WithAllocator(mem))
+		require.Nil(t, err)
+		require.Nil(t, w.Write(rec))
+		require.Nil(t, w.Close())
+	case ".arrows":
+		w := ipc.NewWriter(f, ipc.WithSchema(sch), ipc.WithAllocator(mem))
+		require.Nil(t, w.Write(rec))
+		require.Nil(t, w.Close())
+	default:
+		t.Fatalf("unsupported extension %s", filepath.Ext(path))
+	}
+}
+
+type fakeBatch struct {
+	sync.Mutex
+	chunks [][]*models.Object
+	failID strfmt.UUID
+	err    error
+	// block delays every chunk until it is closed
+	block chan struct{}
+}
+
+func (f *fakeBatch) AddObjectsChunk(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, objects.Backpressure, error) {
+	if f.block != nil {
+		<-f.block
+	}
+	if f.err != nil {
+		return nil, objects.Backpressure{}, f.err
+	}
+
+	f.Lock()
+	f.chunks = append(f.chunks, objs)
+	f.Unlock()
+
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj}
+		if obj.ID != "" && obj.ID == f.failID {
+			res[i].Err = fmt.Errorf("invalid object")
+		}
+	}
+	return res, objects.Backpressure{}, nil
+}
+
+func (f *fakeBatch) objects() []*models.Object {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.Object
+	for _, chunk := range f.chunks {
+		out = append(out, chunk...)
+	}
+	return out
+}
+
+type fakeAuthorizer struct{}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+type fakeJobsRepo struct{}
+
+func (r *fakeJobsRepo) Put(ctx context.Context, job *jobs.Job) error    { return nil }
+func (r *fakeJobsRepo) List(ctx context.Context) ([]*jobs.Job, error)   { return nil, nil }
+func (r *fakeJobsRepo) Delete(ctx context.Context, ids ...string) error { return nil }
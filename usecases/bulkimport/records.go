@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+)
+
+// arrowFileMagic starts every Arrow IPC file, streams start with a message
+var arrowFileMagic = []byte("ARROW1")
+
+// newRecordReader reads the record batches of a Parquet file or an Arrow
+// IPC file or stream. Parquet files are read in batches of batchSize rows.
+// The total number of rows is only known for Parquet files, it is 0
+// otherwise.
+func newRecordReader(ctx context.Context, f File, format string,
+	batchSize int,
+) (array.RecordReader, int64, error) {
+	mem := memory.NewGoAllocator()
+
+	switch format {
+	case FormatParquet:
+		pf, err := file.NewParquetReader(f)
+		if err != nil {
+			return nil, 0, fmt.Errorf("open parquet file: %w", err)
+		}
+		fr, err := pqarrow.NewFileReader(pf,
+			pqarrow.ArrowReadProperties{BatchSize: int64(batchSize)}, mem)
+		if err != nil {
+			return nil, 0, fmt.Errorf("open parquet file: %w", err)
+		}
+		rr, err := fr.GetRecordReader(ctx, nil, nil)
+		return rr, pf.NumRows(), err
+	case FormatArrow:
+		isFile, err := hasArrowFileMagic(f)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !isFile {
+			rr, err := ipc.NewReader(f, ipc.WithAllocator(mem))
+			return rr, 0, err
+		}
+
+		fr, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, 0, fmt.Errorf("open arrow file: %w", err)
+		}
+		return &arrowFileRecords{reader: fr, pos: -1}, 0, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func hasArrowFileMagic(f File) (bool, error) {
+	magic := make([]byte, len(arrowFileMagic))
+	if _, err := io.ReadFull(f, magic); err != nil && err != io.ErrUnexpectedEOF {
+		return false, fmt.Errorf("read arrow header: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(magic, arrowFileMagic), nil
+}
+
+// arrowFileRecords iterates the record batches of an Arrow IPC file
+type arrowFileRecords struct {
+	reader *ipc.FileReader
+	pos    int
+	record arrow.Record
+	err    error
+}
+
+func (r *arrowFileRecords) Retain() {}
+
+func (r *arrowFileRecords) Release() {
+	r.reader.Close()
+}
+
+func (r *arrowFileRecords) Schema() *arrow.Schema {
+	return r.reader.Schema()
+}
+
+func (r *arrowFileRecords) Next() bool {
+	if r.err != nil || r.pos+1 >= r.reader.NumRecords() {
+		return false
+	}
+
+	r.pos++
+	r.record, r.err = r.reader.Record(r.pos)
+	return r.err == nil
+}
+
+func (r *arrowFileRecords) Record() arrow.Record {
+	return r.record
+}
+
+func (r *arrowFileRecords) Err() error {
+	return r.err
+}
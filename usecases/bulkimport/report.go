@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatParquet is an Apache Parquet file
+	FormatParquet = "parquet"
+	// FormatArrow is an Apache Arrow IPC file or stream
+	FormatArrow = "arrow"
+)
+
+const (
+	StatusStarted   = "STARTED"
+	StatusSuccess   = "SUCCESS"
+	StatusFailed    = "FAILED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxReportedErrors limits the number of object errors kept in a report,
+// the count of failed objects is always complete
+const maxReportedErrors = 100
+
+// formatOf returns the given format or the one implied by the extension of
+// the source
+func formatOf(format, source string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(path.Ext(source)) {
+		case ".parquet":
+			format = FormatParquet
+		case ".arrow", ".arrows", ".feather", ".ipc":
+			format = FormatArrow
+		}
+	}
+
+	switch format {
+	case FormatParquet, FormatArrow:
+		return format, nil
+	case "":
+		return "", fmt.Errorf("format can't be derived from %q, set it explicitly", source)
+	default:
+		return "", fmt.Errorf("format must be %q or %q, got %q",
+			FormatParquet, FormatArrow, format)
+	}
+}
+
+// ObjectError is the reason why the object of a row was not imported
+type ObjectError struct {
+	// Row is the position of the row in the file, starting at 0
+	Row   int64  `json:"row"`
+	Error string `json:"error"`
+}
+
+// Report is the state of an import job
+type Report struct {
+	ID          string     `json:"id"`
+	JobID       string     `json:"jobId,omitempty"`
+	Class       string     `json:"class"`
+	Tenant      string     `json:"tenant,omitempty"`
+	Source      string     `json:"source"`
+	Format      string     `json:"format"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	StartedAt   time.Time  `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// RowsRead counts the rows read from the file so far, rows which are
+	// read but not imported yet are neither part of Imported nor Failed.
+	// TotalRows is only known for Parquet files.
+	RowsRead  int64         `json:"rowsRead"`
+	TotalRows int64         `json:"totalRows,omitempty"`
+	Imported  int64         `json:"imported"`
+	Failed    int64         `json:"failed"`
+	Errors    []ObjectError `json:"errors,omitempty"`
+}
+
+func (r *Report) addError(row int64, err error) {
+	r.Failed++
+	if len(r.Errors) < maxReportedErrors {
+		r.Errors = append(r.Errors, ObjectError{Row: row, Error: err.Error()})
+	}
+}
+
+func (r *Report) clone() *Report {
+	out := *r
+	out.Errors = make([]ObjectError, len(r.Errors))
+	copy(out.Errors, r.Errors)
+	if r.CompletedAt != nil {
+		t := *r.CompletedAt
+		out.CompletedAt = &t
+	}
+	return &out
+}
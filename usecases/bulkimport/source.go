@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/tiering"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const s3Scheme = "s3://"
+
+// File is an opened source of an import
+type File interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
+type openFn func(ctx context.Context, source string) (File, error)
+
+// sources opens files below the configured local root or in S3. Sources of
+// the form s3://bucket/key are read from S3, all others are paths relative
+// to the local root.
+type sources struct {
+	config config.BulkImport
+}
+
+func (s *sources) open(ctx context.Context, source string) (File, error) {
+	if strings.HasPrefix(source, s3Scheme) {
+		return s.openS3(ctx, source)
+	}
+	return s.openLocal(source)
+}
+
+func (s *sources) openLocal(source string) (File, error) {
+	if s.config.LocalRoot == "" {
+		return nil, fmt.Errorf("imports of local files are disabled, " +
+			"BULK_IMPORT_LOCAL_ROOT is not set")
+	}
+
+	root, err := filepath.EvalSymlinks(s.config.LocalRoot)
+	if err != nil {
+		return nil, fmt.Errorf("resolve local root: %w", err)
+	}
+
+	// cleaning the path as an absolute one removes any leading "..", symlinks
+	// are resolved, as they could point outside of the root as well
+	path, err := filepath.EvalSymlinks(
+		filepath.Join(root, filepath.Clean(string(filepath.Separator)+source)))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%q is not within the local root", source)
+	}
+
+	return os.Open(path)
+}
+
+func (s *sources) openS3(ctx context.Context, source string) (File, error) {
+	bucket, key, ok := strings.Cut(strings.TrimPrefix(source, s3Scheme), "/")
+	if !ok || bucket == "" || key == "" {
+		return nil, fmt.Errorf("%q is not of the form s3://bucket/key", source)
+	}
+
+	store, err := tiering.NewS3Bucket(s.config.S3Endpoint, bucket, s.config.S3UseSSL)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.OpenObject(ctx, key)
+}
@@ -47,8 +47,9 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return shard, nil
 }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
-func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) string              { return tenant }
+func (f *fakeSchemaGetter) TenantQuota(class, tenant string) *models.TenantQuota { return nil }
+func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string       { return string(uuid) }
 
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
@@ -66,6 +66,10 @@ func (f *fakeSchemaGetter) TenantShard(class, tenant string) string {
 	return tenant
 }
 
+func (f *fakeSchemaGetter) TenantQuota(class, tenant string) *models.TenantQuota {
+	return nil
+}
+
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	ss := f.shardState
 	return ss.Shard("", string(uuid))
@@ -450,12 +454,24 @@ func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) UpdateObjectBatch(ctx context.Context, hostName, indexName, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
 	return "", nil
 }
 
+func (f *fakeRemoteClient) GetShardUsage(ctx context.Context,
+	hostName, indexName, shardName string,
+) (map[string]models.TenantUsage, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string,
 ) error {
@@ -551,6 +567,18 @@ func (c *fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, req replica.HashTreeRequest,
+) ([]replica.Digest, error) {
+	return nil, nil
+}
+
+func (c *fakeReplicationClient) DigestObjectsInLeaves(ctx context.Context,
+	host, index, shard string, req replica.HashTreeRequest,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeReplicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -0,0 +1,721 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultRaftPort = 8300
+
+	raftProposeTimeout = 30 * time.Second
+	raftApplyTimeout   = 10 * time.Second
+	raftMembersPeriod  = 5 * time.Second
+	raftAppliedFile    = "applied_index"
+)
+
+var (
+	ErrNotLeader         = errors.New("node is not the raft leader")
+	ErrNoLeader          = errors.New("raft cluster has no leader")
+	ErrConsensusNotReady = errors.New("schema consensus is not ready")
+	// ErrStateDiverged is returned by a CommitFn if the local state can not
+	// be reconciled with the log. The node stops applying the log then.
+	ErrStateDiverged = errors.New("local state diverged from the raft log")
+
+	errProposalAbandoned = errors.New("origin did not apply its proposal")
+)
+
+// RaftConfig configures the raft log which replaces the two-phase commit of
+// schema transactions. All nodes must use the same port. The two-phase commit
+// is only kept for clusters which are not migrated to raft yet.
+type RaftConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	Port    int  `json:"port" yaml:"port"`
+	// BootstrapNodes are the names of the initial voters. Each of them
+	// bootstraps the log with the same configuration on its first start, nodes
+	// joining later are added by the leader. Without bootstrap nodes a node
+	// bootstraps a log of its own.
+	BootstrapNodes []string `json:"bootstrapNodes" yaml:"bootstrapNodes"`
+}
+
+func (c RaftConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("cluster raft: invalid port %d", c.Port)
+	}
+	return nil
+}
+
+// raftMembers resolves node names, they are used as raft server ids
+type raftMembers interface {
+	AllNames() []string
+	LocalName() string
+	NodeHostname(nodeName string) (string, bool)
+	NodeAddress(nodeName string) (string, bool)
+}
+
+// RaftClient forwards proposals of followers to the leader
+type RaftClient interface {
+	ProposeTransaction(ctx context.Context, host string, entry []byte) error
+}
+
+type (
+	UnmarshalFn func(txType TransactionType, payload json.RawMessage) (interface{}, error)
+	// BaselineFn returns a transaction with the current local state. The
+	// first leader of a new log proposes it, so nodes joining later receive
+	// state which existed before raft was enabled. Snapshots of the log
+	// contain it as well.
+	BaselineFn func() (*Transaction, error)
+)
+
+// Raft is a replicated log of write transactions. Once set as the consensus
+// log of a TxManager, transactions are no longer broadcast, instead every
+// node applies them in log order.
+type Raft struct {
+	config   RaftConfig
+	dir      string
+	members  raftMembers
+	client   RaftClient
+	tls      *TLS
+	logger   logrus.FieldLogger
+	shutdown chan struct{}
+
+	// set by SetApplier
+	commit    CommitFn
+	unmarshal UnmarshalFn
+	baseline  BaselineFn
+	restore   CommitFn
+
+	raft      *raft.Raft
+	fsm       *raftFSM
+	transport raft.Transport // only set in advance by tests
+	stores    []interface{ Close() error }
+
+	// only set in advance by tests, the raft defaults are used otherwise
+	snapshotThreshold uint64
+	trailingLogs      uint64
+
+	// proposed maps the ids of transactions proposed by this process to
+	// their ownProposal. Their origin applies them itself.
+	proposed sync.Map
+	applied  atomic.Uint64
+	halted   atomic.Pointer[error] // set once the log can't be applied anymore
+
+	queueLock sync.Mutex
+	queue     []indexedEntry
+	queued    chan struct{}
+	applierWG sync.WaitGroup
+
+	// applyLock is held while an entry is applied, so snapshots capture the
+	// local state between entries. stored is the index of the last entry
+	// which is completely applied.
+	applyLock sync.Mutex
+	stored    uint64
+}
+
+func NewRaft(config RaftConfig, dataPath string, members raftMembers,
+	client RaftClient, clusterTLS *TLS, logger logrus.FieldLogger,
+) *Raft {
+	return &Raft{
+		config:   config,
+		dir:      filepath.Join(dataPath, "raft"),
+		members:  members,
+		client:   client,
+		tls:      clusterTLS,
+		logger:   logger.WithField("action", "raft"),
+		shutdown: make(chan struct{}),
+		queued:   make(chan struct{}, 1),
+	}
+}
+
+// ownProposal tracks a transaction proposed by this process
+type ownProposal struct {
+	reached chan struct{} // closed once the entry reached the local log
+	applied chan error    // receives the outcome of the local apply
+}
+
+func newOwnProposal() *ownProposal {
+	return &ownProposal{
+		reached: make(chan struct{}),
+		applied: make(chan error, 1),
+	}
+}
+
+// done reports the outcome of the local apply, only the first is kept
+func (p *ownProposal) done(err error) {
+	select {
+	case p.applied <- err:
+	default:
+	}
+}
+
+// SetApplier sets how committed transactions of other nodes are applied. The
+// origin of a transaction applies it itself once Propose returns and reports
+// the outcome with Applied.
+//
+// restore brings a local state which is older than a snapshot of the log to
+// the state of the snapshot. Its transaction is one returned by baseline.
+func (r *Raft) SetApplier(commit CommitFn, unmarshal UnmarshalFn,
+	baseline BaselineFn, restore CommitFn,
+) {
+	r.commit = commit
+	r.unmarshal = unmarshal
+	r.baseline = baseline
+	r.restore = restore
+}
+
+// Open starts the raft node. Committed transactions are applied right away,
+// so it must only be called once the local database is ready.
+func (r *Raft) Open(ctx context.Context) error {
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return fmt.Errorf("create raft dir: %w", err)
+	}
+	applied, err := r.loadApplied()
+	if err != nil {
+		return err
+	}
+	r.applied.Store(applied)
+	r.stored = applied
+
+	store, err := raftboltdb.New(raftboltdb.Options{Path: filepath.Join(r.dir, "raft.db")})
+	if err != nil {
+		return fmt.Errorf("open raft store: %w", err)
+	}
+	r.stores = append(r.stores, store)
+
+	logOutput := newLogParser(r.logger)
+	snapshots, err := raft.NewFileSnapshotStore(r.dir, 2, logOutput)
+	if err != nil {
+		return fmt.Errorf("open raft snapshots: %w", err)
+	}
+
+	if r.transport == nil {
+		layer, err := newRaftStreamLayer(r.config.Port, r.members.LocalName(), r.tls)
+		if err != nil {
+			return err
+		}
+		r.transport = raft.NewNetworkTransportWithConfig(&raft.NetworkTransportConfig{
+			ServerAddressProvider: r,
+			Stream:                layer,
+			MaxPool:               3,
+			Timeout:               10 * time.Second,
+			Logger: hclog.New(&hclog.LoggerOptions{
+				Name: "raft-net", Output: logOutput, Level: hclog.Warn,
+			}),
+		})
+	}
+	if closer, ok := r.transport.(interface{ Close() error }); ok {
+		r.stores = append(r.stores, closer)
+	}
+
+	config := raft.DefaultConfig()
+	config.LocalID = raft.ServerID(r.members.LocalName())
+	config.Logger = hclog.New(&hclog.LoggerOptions{
+		Name: "raft", Output: logOutput, Level: hclog.Info,
+	})
+	if r.snapshotThreshold > 0 {
+		config.SnapshotThreshold = r.snapshotThreshold
+	}
+	if r.trailingLogs > 0 {
+		config.TrailingLogs = r.trailingLogs
+	}
+
+	r.fsm = newRaftFSM(r)
+	r.applierWG.Add(1)
+	go r.applyLoop()
+
+	if r.raft, err = raft.NewRaft(config, r.fsm, store, store, snapshots, r.transport); err != nil {
+		return fmt.Errorf("start raft: %w", err)
+	}
+
+	if err := r.bootstrap(store, snapshots); err != nil {
+		return err
+	}
+
+	go r.leaderLoop()
+	return nil
+}
+
+func (r *Raft) bootstrap(store *raftboltdb.BoltStore, snapshots raft.SnapshotStore) error {
+	local := r.members.LocalName()
+	var servers []raft.Server
+	names := r.config.BootstrapNodes
+	if len(names) == 0 {
+		names = []string{local}
+	}
+	for _, name := range names {
+		servers = append(servers, raft.Server{
+			ID: raft.ServerID(name), Address: raft.ServerAddress(name),
+		})
+	}
+	if !containsServer(servers, local) {
+		return nil
+	}
+
+	existing, err := raft.HasExistingState(store, store, snapshots)
+	if err != nil {
+		return fmt.Errorf("check raft state: %w", err)
+	}
+	if existing {
+		return nil
+	}
+
+	err = r.raft.BootstrapCluster(raft.Configuration{Servers: servers}).Error()
+	if err != nil && !errors.Is(err, raft.ErrCantBootstrap) {
+		return fmt.Errorf("bootstrap raft: %w", err)
+	}
+	return nil
+}
+
+func containsServer(servers []raft.Server, id string) bool {
+	for _, s := range servers {
+		if string(s.ID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Raft) Close() error {
+	close(r.shutdown)
+	var err error
+	if r.raft != nil {
+		err = r.raft.Shutdown().Error()
+	}
+	r.applierWG.Wait()
+	for _, store := range r.stores {
+		store.Close()
+	}
+	return err
+}
+
+// ServerAddr implements raft.ServerAddressProvider. Node names are used as
+// raft ids and addresses, the actual address is looked up in the member list.
+func (r *Raft) ServerAddr(id raft.ServerID) (raft.ServerAddress, error) {
+	ip, ok := r.members.NodeAddress(string(id))
+	if !ok {
+		return "", fmt.Errorf("node %q is not a cluster member", id)
+	}
+	return raft.ServerAddress(fmt.Sprintf("%s:%d", ip, r.config.Port)), nil
+}
+
+// Leader returns the name of the current leader, or an empty string if there
+// is none
+func (r *Raft) Leader() string {
+	if r.raft == nil {
+		return ""
+	}
+	_, id := r.raft.LeaderWithID()
+	return string(id)
+}
+
+// AppliedIndex is the index of the last entry applied to the local state
+func (r *Raft) AppliedIndex() uint64 {
+	return r.applied.Load()
+}
+
+// Propose appends tx to the log and returns once it is committed and reached
+// the local log, so AppliedIndex includes it. Proposals of followers are
+// forwarded to the leader. ErrConcurrentTransaction is returned if the local
+// state was outdated when tx was validated.
+//
+// If Propose succeeds, the caller must apply tx and report the outcome with
+// Applied. The entry is only recorded as applied after that, so it is
+// replayed if the node stops in between.
+func (r *Raft) Propose(ctx context.Context, tx *Transaction) error {
+	if err := r.haltErr(); err != nil {
+		return err
+	}
+	data, err := r.encode(tx, false)
+	if err != nil {
+		return err
+	}
+
+	proposal := newOwnProposal()
+	r.proposed.Store(tx.ID, proposal)
+	if err := r.propose(ctx, data); err != nil {
+		// an earlier attempt may still be committed, it is applied like the
+		// entries of other nodes then
+		proposal.done(errProposalAbandoned)
+		r.proposed.Delete(tx.ID)
+		return err
+	}
+
+	select {
+	case <-proposal.reached:
+		return nil
+	case <-ctx.Done():
+		// the entry may still be committed, it is applied like the entries
+		// of other nodes then
+		proposal.done(errProposalAbandoned)
+		return fmt.Errorf("wait for local log: %w", ctx.Err())
+	case <-r.shutdown:
+		return ErrConsensusNotReady
+	}
+}
+
+// Applied reports whether the origin of a transaction applied it locally.
+// A transaction it failed to apply is applied like the entries of other
+// nodes.
+func (r *Raft) Applied(txID string, err error) {
+	if p, ok := r.proposed.Load(txID); ok {
+		p.(*ownProposal).done(err)
+	}
+}
+
+// Err returns why the log is not applied anymore, if so
+func (r *Raft) Err() error {
+	return r.haltErr()
+}
+
+func (r *Raft) haltErr() error {
+	if err := r.halted.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+func (r *Raft) encode(tx *Transaction, baseline bool) ([]byte, error) {
+	entry, err := r.entry(tx)
+	if err != nil {
+		return nil, err
+	}
+	entry.Baseline = baseline
+	return json.Marshal(entry)
+}
+
+func (r *Raft) entry(tx *Transaction) (raftEntry, error) {
+	payload, err := json.Marshal(tx.Payload)
+	if err != nil {
+		return raftEntry{}, fmt.Errorf("marshal transaction payload: %w", err)
+	}
+	return raftEntry{
+		ID:      tx.ID,
+		Type:    tx.Type,
+		Payload: payload,
+		Origin:  r.members.LocalName(),
+		Base:    tx.BaseIndex,
+	}, nil
+}
+
+func (r *Raft) propose(ctx context.Context, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, raftProposeTimeout)
+	defer cancel()
+
+	backoff := 50 * time.Millisecond
+	for {
+		err := r.forward(ctx, data)
+		if !errors.Is(err, ErrNotLeader) && !errors.Is(err, ErrNoLeader) {
+			return err
+		}
+
+		// leadership is changing, wait for the election to finish
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("propose transaction: %w", err)
+		case <-time.After(backoff):
+		}
+		if backoff < time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+func (r *Raft) forward(ctx context.Context, data []byte) error {
+	leader := r.Leader()
+	if leader == "" {
+		return ErrNoLeader
+	}
+	if leader == r.members.LocalName() {
+		return r.ApplyProposal(ctx, data)
+	}
+
+	host, ok := r.members.NodeHostname(leader)
+	if !ok {
+		return ErrNoLeader
+	}
+	return r.client.ProposeTransaction(ctx, host, data)
+}
+
+// ApplyProposal appends an encoded entry to the log. It can only be called on
+// the leader.
+func (r *Raft) ApplyProposal(ctx context.Context, data []byte) error {
+	if r.raft == nil {
+		return ErrConsensusNotReady
+	}
+	if r.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+
+	future := r.raft.Apply(data, raftApplyTimeout)
+	if err := future.Error(); err != nil {
+		if errors.Is(err, raft.ErrNotLeader) || errors.Is(err, raft.ErrLeadershipLost) {
+			return ErrNotLeader
+		}
+		return fmt.Errorf("apply raft entry: %w", err)
+	}
+	if err, ok := future.Response().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// leaderLoop adds new members to the raft configuration and proposes the
+// baseline of a new log while this node is the leader
+func (r *Raft) leaderLoop() {
+	ticker := time.NewTicker(raftMembersPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-r.raft.LeaderCh():
+		case <-ticker.C:
+		}
+		if r.raft.State() != raft.Leader {
+			continue
+		}
+
+		r.addMembers()
+		if !r.fsm.isBaselined() {
+			if err := r.proposeBaseline(); err != nil {
+				r.logger.WithError(err).Error("could not propose raft baseline")
+			}
+		}
+	}
+}
+
+func (r *Raft) addMembers() {
+	future := r.raft.GetConfiguration()
+	if err := future.Error(); err != nil {
+		r.logger.WithError(err).Error("could not read raft configuration")
+		return
+	}
+	servers := future.Configuration().Servers
+	for _, name := range r.members.AllNames() {
+		if containsServer(servers, name) {
+			continue
+		}
+		err := r.raft.AddVoter(raft.ServerID(name), raft.ServerAddress(name), 0, 0).Error()
+		if err != nil {
+			r.logger.WithField("node", name).WithError(err).Error("could not add raft voter")
+			continue
+		}
+		r.logger.WithField("node", name).Info("added raft voter")
+	}
+}
+
+func (r *Raft) proposeBaseline() error {
+	if r.baseline == nil {
+		return ErrConsensusNotReady
+	}
+	tx, err := r.baseline()
+	if err != nil {
+		return err
+	}
+	data, err := r.encode(tx, true)
+	if err != nil {
+		return err
+	}
+
+	// the local state is the baseline, there is nothing to apply
+	proposal := newOwnProposal()
+	proposal.done(nil)
+	r.proposed.Store(tx.ID, proposal)
+	if err := r.ApplyProposal(context.Background(), data); err != nil {
+		r.proposed.Delete(tx.ID)
+		return err
+	}
+	return nil
+}
+
+func (r *Raft) enqueue(entries ...indexedEntry) {
+	r.queueLock.Lock()
+	r.queue = append(r.queue, entries...)
+	r.queueLock.Unlock()
+
+	select {
+	case r.queued <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Raft) applyLoop() {
+	defer r.applierWG.Done()
+
+	for {
+		r.queueLock.Lock()
+		entries := r.queue
+		r.queue = nil
+		r.queueLock.Unlock()
+
+		for _, entry := range entries {
+			r.applyLock.Lock()
+			ok := r.apply(entry)
+			r.applyLock.Unlock()
+			if !ok {
+				// later entries are not applied either, they are replayed
+				// after a restart
+				return
+			}
+		}
+
+		select {
+		case <-r.shutdown:
+			return
+		case <-r.queued:
+		}
+	}
+}
+
+// apply applies a committed entry and records it as applied. It returns
+// false if the entry could not be applied and the log must not be applied
+// any further. It must be called with the applyLock held.
+func (r *Raft) apply(e indexedEntry) bool {
+	if e.Index <= r.applied.Load() {
+		// replayed after a restart
+		r.fsm.compact(e.Index)
+		return true
+	}
+
+	if p, own := r.proposed.Load(e.Entry.ID); own {
+		proposal := p.(*ownProposal)
+		r.applied.Store(e.Index)
+		close(proposal.reached)
+
+		// the origin applies its own change. It validated it against all
+		// previous entries, so nothing can be applied out of order. Later
+		// entries wait until it is done.
+		var err error
+		select {
+		case err = <-proposal.applied:
+		case <-r.shutdown:
+			return false
+		}
+		r.proposed.Delete(e.Entry.ID)
+		if err == nil {
+			r.storeApplied(e.Index)
+			return true
+		}
+		r.logger.WithField("index", e.Index).WithField("type", e.Entry.Type).
+			WithError(err).Warn("origin could not apply its transaction, applying it again")
+	}
+
+	if err := r.applyRemote(e.Entry); err != nil {
+		if errors.Is(err, ErrStateDiverged) {
+			r.halted.Store(&err)
+			r.logger.WithField("index", e.Index).WithField("type", e.Entry.Type).
+				WithError(err).Error("stopped applying the raft log")
+			return false
+		}
+		// the change is committed, other nodes applied it. Like a failed
+		// commit of a two-phase transaction this can only be logged.
+		r.logger.WithField("index", e.Index).WithField("type", e.Entry.Type).
+			WithError(err).Error("could not apply committed transaction")
+	}
+
+	r.applied.Store(e.Index)
+	r.storeApplied(e.Index)
+	return true
+}
+
+func (r *Raft) applyRemote(e raftEntry) error {
+	payload, err := r.unmarshal(e.Type, e.Payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+	tx := &Transaction{
+		ID:      e.ID,
+		Type:    e.Type,
+		Payload: payload,
+	}
+	if e.Restore {
+		return r.restore(context.Background(), tx)
+	}
+	return r.commit(context.Background(), tx)
+}
+
+// captureState implements raftApplier. Nothing is captured before the first
+// entry is applied, the log contains the baseline then.
+func (r *Raft) captureState(fn func(state *raftEntry, applied uint64)) error {
+	r.applyLock.Lock()
+	defer r.applyLock.Unlock()
+
+	if err := r.haltErr(); err != nil {
+		return err
+	}
+	if r.stored == 0 {
+		fn(nil, 0)
+		return nil
+	}
+
+	tx, err := r.baseline()
+	if err != nil {
+		return err
+	}
+	state, err := r.entry(tx)
+	if err != nil {
+		return err
+	}
+	state.Restore = true
+	fn(&state, r.stored)
+	return nil
+}
+
+func (r *Raft) loadApplied() (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(r.dir, raftAppliedFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read applied raft index: %w", err)
+	}
+	if len(data) != 8 {
+		return 0, fmt.Errorf("read applied raft index: corrupt file")
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+// storeApplied persists the index of the last applied entry. The entries up
+// to it are not needed anymore.
+func (r *Raft) storeApplied(index uint64) {
+	r.stored = index
+	r.fsm.compact(index)
+
+	path := filepath.Join(r.dir, raftAppliedFile)
+	data := binary.LittleEndian.AppendUint64(nil, index)
+	err := os.WriteFile(path+".tmp", data, 0o644)
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		r.logger.WithError(err).Error("could not persist applied raft index")
+	}
+}
@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/hashicorp/raft"
+)
+
+// raftEntry is a write transaction as stored in the raft log
+type raftEntry struct {
+	ID      string          `json:"id"`
+	Type    TransactionType `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+	// Origin is the node which proposed the entry
+	Origin string `json:"origin"`
+	// Base is the index of the last entry the origin had applied when it
+	// validated the change
+	Base uint64 `json:"base"`
+	// Baseline entries carry the schema of a cluster which existed before the
+	// raft log was introduced. They must be the first accepted entry.
+	Baseline bool `json:"baseline,omitempty"`
+	// Restore entries carry the state of a snapshot. They are never proposed,
+	// nodes whose state is older than the snapshot restore them.
+	Restore bool `json:"restore,omitempty"`
+}
+
+type indexedEntry struct {
+	Index uint64    `json:"index"`
+	Entry raftEntry `json:"entry"`
+}
+
+// raftFSM decides which proposals are accepted. Every node sees the same log,
+// so every node comes to the same decision. Accepted entries are handed to
+// the applier, which applies them to the local schema outside of the raft
+// goroutine.
+//
+// A proposal is only accepted if it was validated against the latest state,
+// i.e. its origin had applied every previously accepted entry. This makes
+// schema changes linearizable without holding locks across the cluster.
+//
+// Snapshots consist of the local state and the entries which are not applied
+// to it yet, so the FSM only keeps entries until they are applied.
+type raftFSM struct {
+	sync.Mutex
+	lastAccepted uint64
+	baselined    bool
+	// pending are the accepted entries which are not applied yet, accepted
+	// maps their tx ids to their index. A retried proposal whose first
+	// attempt is already applied is rejected, since it is based on an
+	// outdated state.
+	pending  []indexedEntry
+	accepted map[string]uint64
+
+	applier raftApplier
+}
+
+// raftApplier applies accepted entries to the local state
+type raftApplier interface {
+	enqueue(entries ...indexedEntry)
+	// captureState calls fn with the local state and the index of the last
+	// entry it includes. No entries are applied while fn runs.
+	captureState(fn func(state *raftEntry, applied uint64)) error
+}
+
+func newRaftFSM(applier raftApplier) *raftFSM {
+	return &raftFSM{accepted: map[string]uint64{}, applier: applier}
+}
+
+func (f *raftFSM) Apply(l *raft.Log) interface{} {
+	if l.Type != raft.LogCommand {
+		return nil
+	}
+
+	var entry raftEntry
+	if err := json.Unmarshal(l.Data, &entry); err != nil {
+		return fmt.Errorf("decode raft entry: %w", err)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if _, ok := f.accepted[entry.ID]; ok {
+		// the proposal was retried after the first attempt already succeeded
+		return nil
+	}
+
+	switch {
+	case entry.Baseline && f.baselined:
+		// a previous leader was faster
+		return nil
+	case !entry.Baseline && !f.baselined:
+		return ErrConsensusNotReady
+	case !entry.Baseline && entry.Base < f.lastAccepted:
+		return ErrConcurrentTransaction
+	}
+
+	if entry.Baseline {
+		f.baselined = true
+	}
+	f.lastAccepted = l.Index
+	f.accepted[entry.ID] = l.Index
+	accepted := indexedEntry{Index: l.Index, Entry: entry}
+	f.pending = append(f.pending, accepted)
+	f.applier.enqueue(accepted)
+	return nil
+}
+
+// compact drops the entries up to index once they are applied
+func (f *raftFSM) compact(index uint64) {
+	f.Lock()
+	defer f.Unlock()
+
+	n := 0
+	for n < len(f.pending) && f.pending[n].Index <= index {
+		delete(f.accepted, f.pending[n].Entry.ID)
+		n++
+	}
+	f.pending = append(f.pending[:0:0], f.pending[n:]...)
+}
+
+func (f *raftFSM) isBaselined() bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.baselined
+}
+
+type raftSnapshot struct {
+	LastAccepted uint64 `json:"lastAccepted"`
+	Baselined    bool   `json:"baselined"`
+	// State is the local state including all entries up to Applied, nil if
+	// nothing was applied yet
+	State   *raftEntry `json:"state,omitempty"`
+	Applied uint64     `json:"applied"`
+	// Entries are accepted, but not part of State yet
+	Entries []indexedEntry `json:"entries"`
+}
+
+func (f *raftFSM) Snapshot() (raft.FSMSnapshot, error) {
+	snap := &raftSnapshot{}
+	err := f.applier.captureState(func(state *raftEntry, applied uint64) {
+		f.Lock()
+		defer f.Unlock()
+
+		snap.LastAccepted = f.lastAccepted
+		snap.Baselined = f.baselined
+		snap.State = state
+		snap.Applied = applied
+		for _, e := range f.pending {
+			if e.Index > applied {
+				snap.Entries = append(snap.Entries, e)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("capture state: %w", err)
+	}
+	return snap, nil
+}
+
+func (f *raftFSM) Restore(rc io.ReadCloser) error {
+	defer rc.Close()
+
+	var snap raftSnapshot
+	if err := json.NewDecoder(rc).Decode(&snap); err != nil {
+		return fmt.Errorf("decode raft snapshot: %w", err)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	f.lastAccepted = snap.LastAccepted
+	f.baselined = snap.Baselined
+	f.pending = snap.Entries
+	f.accepted = make(map[string]uint64, len(snap.Entries))
+	for _, e := range snap.Entries {
+		f.accepted[e.Entry.ID] = e.Index
+	}
+
+	// the applier skips everything it already applied before, so the state
+	// is only restored if the local state is older than the snapshot
+	var entries []indexedEntry
+	if snap.State != nil {
+		entries = append(entries, indexedEntry{Index: snap.Applied, Entry: *snap.State})
+	}
+	f.applier.enqueue(append(entries, snap.Entries...)...)
+	return nil
+}
+
+func (s *raftSnapshot) Persist(sink raft.SnapshotSink) error {
+	if err := json.NewEncoder(sink).Encode(s); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("encode raft snapshot: %w", err)
+	}
+	return sink.Close()
+}
+
+func (s *raftSnapshot) Release() {}
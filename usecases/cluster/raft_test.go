@@ -0,0 +1,484 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaftFSM(t *testing.T) {
+	applier := &fakeRaftApplier{}
+	fsm := newRaftFSM(applier)
+
+	apply := func(index uint64, e raftEntry) interface{} {
+		data, err := json.Marshal(e)
+		require.Nil(t, err)
+		return fsm.Apply(&raft.Log{Index: index, Data: data})
+	}
+
+	assert.ErrorIs(t, apply(1, raftEntry{ID: "1"}).(error), ErrConsensusNotReady,
+		"entries before the baseline are rejected")
+	assert.Nil(t, apply(2, raftEntry{ID: "baseline", Baseline: true}))
+	assert.Nil(t, apply(3, raftEntry{ID: "other-baseline", Baseline: true}),
+		"a second baseline is ignored")
+	assert.Nil(t, apply(4, raftEntry{ID: "1", Base: 2}))
+	assert.Nil(t, apply(5, raftEntry{ID: "1", Base: 2}), "a retried entry is ignored")
+	assert.ErrorIs(t, apply(6, raftEntry{ID: "2", Base: 2}).(error), ErrConcurrentTransaction,
+		"entry was validated before 1 was applied")
+	assert.Nil(t, apply(7, raftEntry{ID: "2", Base: 4}))
+
+	applied := applier.entries
+	require.Len(t, applied, 3)
+	assert.Equal(t, []uint64{2, 4, 7},
+		[]uint64{applied[0].Index, applied[1].Index, applied[2].Index})
+
+	t.Run("snapshot before anything is applied", func(t *testing.T) {
+		snap := persistSnapshot(t, fsm)
+		assert.Nil(t, snap.State)
+		assert.Equal(t, applied, snap.Entries)
+	})
+
+	t.Run("applied entries are compacted", func(t *testing.T) {
+		applier.state = &raftEntry{ID: "state", Type: "state", Payload: []byte(`"state"`), Restore: true}
+		applier.applied = 4
+		fsm.compact(4)
+
+		snap := persistSnapshot(t, fsm)
+		assert.Equal(t, applier.state, snap.State)
+		assert.Equal(t, uint64(4), snap.Applied)
+		assert.Equal(t, uint64(7), snap.LastAccepted)
+		assert.Equal(t, applied[2:], snap.Entries)
+
+		assert.ErrorIs(t, apply(8, raftEntry{ID: "1", Base: 2}).(error), ErrConcurrentTransaction,
+			"a retry of an applied entry is based on an outdated state")
+		assert.Nil(t, apply(9, raftEntry{ID: "2", Base: 4}), "a retried entry is ignored")
+	})
+
+	t.Run("restore from snapshot", func(t *testing.T) {
+		sink := &fakeSnapshotSink{}
+		snapshot, err := fsm.Snapshot()
+		require.Nil(t, err)
+		require.Nil(t, snapshot.Persist(sink))
+
+		restored := &fakeRaftApplier{}
+		other := newRaftFSM(restored)
+		require.Nil(t, other.Restore(sink))
+
+		assert.Equal(t, []indexedEntry{
+			{Index: 4, Entry: *applier.state},
+			applied[2],
+		}, restored.entries)
+		assert.True(t, other.isBaselined())
+		assert.Equal(t, ErrConcurrentTransaction, other.Apply(&raft.Log{
+			Index: 10, Data: []byte(`{"id":"3","base":4}`),
+		}))
+	})
+}
+
+func persistSnapshot(t *testing.T, fsm *raftFSM) raftSnapshot {
+	snapshot, err := fsm.Snapshot()
+	require.Nil(t, err)
+	sink := &fakeSnapshotSink{}
+	require.Nil(t, snapshot.Persist(sink))
+
+	var snap raftSnapshot
+	require.Nil(t, json.Unmarshal(sink.Bytes(), &snap))
+	return snap
+}
+
+func TestTxManagerWithConsensusLog(t *testing.T) {
+	ctx := context.Background()
+	log := &fakeConsensusLog{applied: 7}
+	remote := &fakeBroadcaster{openErr: errors.New("must not be called")}
+	logger, _ := test.NewNullLogger()
+	man := NewTxManager(remote, logger)
+	man.SetConsensusLog(log)
+
+	tx, err := man.BeginTransaction(ctx, "my-type", "my-payload", 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), tx.BaseIndex)
+	require.Len(t, log.proposed, 1)
+	assert.Equal(t, tx.ID, log.proposed[0].ID)
+
+	remote.commitErr = errors.New("must not be called")
+	require.Nil(t, man.CommitWriteTransaction(ctx, tx))
+	man.TransactionApplied(tx, nil)
+	assert.Equal(t, []string{tx.ID}, log.reported)
+
+	t.Run("rejected proposal", func(t *testing.T) {
+		log.err = ErrConcurrentTransaction
+		_, err := man.BeginTransaction(ctx, "my-type", "my-payload", 0)
+		assert.ErrorIs(t, err, ErrConcurrentTransaction)
+
+		log.err = nil
+		_, err = man.BeginTransaction(ctx, "my-type", "my-payload", 0)
+		assert.Nil(t, err, "the rejected transaction is no longer open")
+	})
+}
+
+func TestRaftCluster(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts an in-memory raft cluster")
+	}
+	ctx := context.Background()
+	c := newTestRaftCluster(t, "node1", "node2", "node3")
+	for _, name := range c.members.names {
+		c.start(t, name)
+	}
+
+	// every node but the first leader applies the baseline, the leader already
+	// has that state
+	require.Eventually(t, func() bool {
+		return c.appliedBy("baseline") == 2
+	}, 10*time.Second, 50*time.Millisecond)
+
+	for i, name := range []string{"node2", "node1", "node3", "node2"} {
+		node := c.nodes[name]
+		// followers learn about commits with a delay. Until then their state is
+		// outdated and proposals are rejected as concurrent.
+		require.Eventually(t, func() bool {
+			return c.caughtUp(i)
+		}, 10*time.Second, 10*time.Millisecond)
+		tx := &Transaction{
+			ID:        fmt.Sprintf("tx-%d", i),
+			Type:      "my-type",
+			Payload:   fmt.Sprintf("payload-%d", i),
+			BaseIndex: node.AppliedIndex(),
+		}
+		require.Nil(t, node.Propose(ctx, tx))
+		c.applyLocally(name, tx)
+		node.Applied(tx.ID, nil)
+	}
+
+	require.Eventually(t, func() bool {
+		for _, name := range c.members.names {
+			if len(c.applied(name)) != 4 {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+	expected := c.applied("node1")
+	for _, name := range c.members.names {
+		assert.Equal(t, expected, c.applied(name), "identical order on %s", name)
+	}
+
+	t.Run("stale transaction is rejected", func(t *testing.T) {
+		node := c.nodes["node3"]
+		tx := &Transaction{ID: "stale", Type: "my-type", Payload: "stale", BaseIndex: 1}
+		assert.ErrorIs(t, node.Propose(ctx, tx), ErrConcurrentTransaction)
+	})
+
+	t.Run("node joining later catches up", func(t *testing.T) {
+		// the log is compacted, so node4 has to restore a snapshot
+		for _, name := range c.members.names {
+			require.Nil(t, c.nodes[name].raft.Snapshot().Error())
+		}
+		c.members.add("node4")
+		c.start(t, "node4")
+
+		require.Eventually(t, func() bool {
+			return len(c.applied("node4")) == 4
+		}, 20*time.Second, 100*time.Millisecond)
+		assert.Equal(t, expected, c.applied("node4"))
+		assert.Equal(t, 2, c.appliedBy("baseline"), "node4 restored the snapshot instead")
+
+		// node4 is closed with this test
+		c.Lock()
+		delete(c.nodes, "node4")
+		c.Unlock()
+	})
+
+	propose := func(t *testing.T, name string, i int, payload string) *Transaction {
+		node := c.nodes[name]
+		require.Eventually(t, func() bool {
+			return c.caughtUp(i)
+		}, 10*time.Second, 10*time.Millisecond)
+		tx := &Transaction{
+			ID:        fmt.Sprintf("tx-%d", i),
+			Type:      "my-type",
+			Payload:   payload,
+			BaseIndex: node.AppliedIndex(),
+		}
+		require.Nil(t, node.Propose(ctx, tx))
+		return tx
+	}
+
+	t.Run("index is persisted after the local apply", func(t *testing.T) {
+		node := c.nodes["node1"]
+		tx := propose(t, "node1", 4, "payload-4")
+
+		stored, err := node.loadApplied()
+		require.Nil(t, err)
+		assert.Less(t, stored, node.AppliedIndex())
+
+		c.applyLocally("node1", tx)
+		node.Applied(tx.ID, nil)
+		require.Eventually(t, func() bool {
+			stored, err := node.loadApplied()
+			return err == nil && stored == node.AppliedIndex()
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("failed local apply is applied again", func(t *testing.T) {
+		tx := propose(t, "node1", 5, "payload-5")
+		c.nodes["node1"].Applied(tx.ID, errors.New("disk full"))
+
+		require.Eventually(t, func() bool {
+			return c.caughtUp(6)
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("diverged state stops the log", func(t *testing.T) {
+		tx := propose(t, "node2", 6, "diverged")
+		c.applyLocally("node2", tx)
+		c.nodes["node2"].Applied(tx.ID, nil)
+
+		require.Eventually(t, func() bool {
+			return errors.Is(c.nodes["node1"].Err(), ErrStateDiverged)
+		}, 10*time.Second, 10*time.Millisecond)
+		err := c.nodes["node1"].Propose(ctx, &Transaction{ID: "tx-7", Type: "my-type", Payload: "payload-7"})
+		assert.ErrorIs(t, err, ErrStateDiverged)
+	})
+}
+
+type testRaftCluster struct {
+	sync.Mutex
+	members    *fakeRaftMembers
+	transports map[string]*raft.InmemTransport
+	nodes      map[string]*Raft
+	state      map[string][]string
+	dir        string
+}
+
+func newTestRaftCluster(t *testing.T, names ...string) *testRaftCluster {
+	return &testRaftCluster{
+		members:    &fakeRaftMembers{names: names},
+		transports: map[string]*raft.InmemTransport{},
+		nodes:      map[string]*Raft{},
+		state:      map[string][]string{},
+		dir:        t.TempDir(),
+	}
+}
+
+func (c *testRaftCluster) start(t *testing.T, name string) {
+	_, transport := raft.NewInmemTransport(raft.ServerAddress(name))
+	for other, ot := range c.transports {
+		transport.Connect(raft.ServerAddress(other), ot)
+		ot.Connect(raft.ServerAddress(name), transport)
+	}
+	c.transports[name] = transport
+
+	logger, _ := test.NewNullLogger()
+	config := RaftConfig{Enabled: true, Port: DefaultRaftPort, BootstrapNodes: []string{"node1", "node2", "node3"}}
+	node := NewRaft(config, fmt.Sprintf("%s/%s", c.dir, name),
+		c.members.local(name), c, nil, logger)
+	node.transport = transport
+	node.trailingLogs = 2
+	node.SetApplier(
+		func(ctx context.Context, tx *Transaction) error {
+			if tx.Payload == "diverged" {
+				return fmt.Errorf("apply: %w", ErrStateDiverged)
+			}
+			c.applyLocally(name, tx)
+			return nil
+		},
+		func(txType TransactionType, payload json.RawMessage) (interface{}, error) {
+			var v string
+			err := json.Unmarshal(payload, &v)
+			return v, err
+		},
+		func() (*Transaction, error) {
+			return &Transaction{ID: "baseline-" + name, Type: "baseline", Payload: c.snapshot(name)}, nil
+		},
+		func(ctx context.Context, tx *Transaction) error {
+			c.restore(name, tx)
+			return nil
+		},
+	)
+	c.Lock()
+	c.nodes[name] = node
+	c.Unlock()
+
+	require.Nil(t, node.Open(context.Background()))
+	t.Cleanup(func() { node.Close() })
+}
+
+// ProposeTransaction routes forwarded proposals to the leader
+func (c *testRaftCluster) ProposeTransaction(ctx context.Context, host string, entry []byte) error {
+	c.Lock()
+	node := c.nodes[host]
+	c.Unlock()
+	return node.ApplyProposal(ctx, entry)
+}
+
+func (c *testRaftCluster) applyLocally(name string, tx *Transaction) {
+	c.Lock()
+	defer c.Unlock()
+	c.state[name] = append(c.state[name], tx.Payload.(string))
+}
+
+// snapshot encodes the changes of a node, a node without changes starts
+// from the baseline
+func (c *testRaftCluster) snapshot(name string) string {
+	applied := c.applied(name)
+	if len(applied) == 0 {
+		return "baseline"
+	}
+	return "state:" + strings.Join(applied, ",")
+}
+
+func (c *testRaftCluster) restore(name string, tx *Transaction) {
+	c.Lock()
+	defer c.Unlock()
+	c.state[name] = strings.Split(strings.TrimPrefix(tx.Payload.(string), "state:"), ",")
+}
+
+// caughtUp is true once every node applied the same number of changes
+func (c *testRaftCluster) caughtUp(changes int) bool {
+	c.Lock()
+	defer c.Unlock()
+	index := c.nodes["node1"].AppliedIndex()
+	for _, node := range c.nodes {
+		if node.AppliedIndex() != index {
+			return false
+		}
+	}
+	for name := range c.nodes {
+		count := 0
+		for _, p := range c.state[name] {
+			if p != "baseline" {
+				count++
+			}
+		}
+		if count != changes {
+			return false
+		}
+	}
+	return true
+}
+
+// applied returns the changes of a node without the baseline
+func (c *testRaftCluster) applied(name string) []string {
+	c.Lock()
+	defer c.Unlock()
+	var out []string
+	for _, p := range c.state[name] {
+		if p != "baseline" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (c *testRaftCluster) appliedBy(payload string) int {
+	c.Lock()
+	defer c.Unlock()
+	count := 0
+	for _, state := range c.state {
+		for _, p := range state {
+			if p == payload {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+type fakeRaftMembers struct {
+	sync.Mutex
+	names []string
+}
+
+func (f *fakeRaftMembers) add(name string) {
+	f.Lock()
+	defer f.Unlock()
+	f.names = append(f.names, name)
+}
+
+func (f *fakeRaftMembers) local(name string) *fakeLocalMember {
+	return &fakeLocalMember{fakeRaftMembers: f, name: name}
+}
+
+type fakeLocalMember struct {
+	*fakeRaftMembers
+	name string
+}
+
+func (f *fakeLocalMember) AllNames() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string(nil), f.names...)
+}
+
+func (f *fakeLocalMember) LocalName() string { return f.name }
+
+func (f *fakeLocalMember) NodeHostname(name string) (string, bool) { return name, true }
+
+func (f *fakeLocalMember) NodeAddress(name string) (string, bool) { return name, true }
+
+type fakeConsensusLog struct {
+	applied  uint64
+	proposed []*Transaction
+	reported []string
+	err      error
+}
+
+func (f *fakeConsensusLog) Propose(ctx context.Context, tx *Transaction) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.proposed = append(f.proposed, tx)
+	return nil
+}
+
+func (f *fakeConsensusLog) AppliedIndex() uint64 { return f.applied }
+
+func (f *fakeConsensusLog) Applied(txID string, err error) {
+	f.reported = append(f.reported, txID)
+}
+
+type fakeRaftApplier struct {
+	entries []indexedEntry
+	state   *raftEntry
+	applied uint64
+}
+
+func (f *fakeRaftApplier) enqueue(entries ...indexedEntry) {
+	f.entries = append(f.entries, entries...)
+}
+
+func (f *fakeRaftApplier) captureState(fn func(state *raftEntry, applied uint64)) error {
+	fn(f.state, f.applied)
+	return nil
+}
+
+type fakeSnapshotSink struct {
+	bytes.Buffer
+}
+
+func (f *fakeSnapshotSink) ID() string    { return "snapshot" }
+func (f *fakeSnapshotSink) Cancel() error { return nil }
+func (f *fakeSnapshotSink) Close() error  { return nil }
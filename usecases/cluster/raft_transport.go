@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// raftStreamLayer carries raft traffic over tcp, with TLS if the cluster
+// uses TLS
+type raftStreamLayer struct {
+	net.Listener
+	addr raftAddr
+	tls  *TLS
+}
+
+func newRaftStreamLayer(port int, localName string, clusterTLS *TLS) (*raftStreamLayer, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("listen for raft: %w", err)
+	}
+	return &raftStreamLayer{Listener: listener, addr: raftAddr(localName), tls: clusterTLS}, nil
+}
+
+func (l *raftStreamLayer) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil || !l.tls.Enabled() {
+		return conn, err
+	}
+	return tls.Server(conn, l.tls.ServerConfig()), nil
+}
+
+// Addr is the node name, raft uses it as the local address
+func (l *raftStreamLayer) Addr() net.Addr {
+	return l.addr
+}
+
+func (l *raftStreamLayer) Dial(address raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !l.tls.Enabled() {
+		return dialer.Dial("tcp", string(address))
+	}
+
+	config := l.tls.ClientConfig()
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(string(address))
+		if err != nil {
+			return nil, err
+		}
+		config.ServerName = host
+	}
+	return tls.DialWithDialer(dialer, "tcp", string(address), config)
+}
+
+type raftAddr string
+
+func (a raftAddr) Network() string { return "tcp" }
+func (a raftAddr) String() string  { return string(a) }
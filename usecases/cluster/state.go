@@ -28,11 +28,14 @@ type State struct {
 }
 
 type Config struct {
-	Hostname                string `json:"hostname" yaml:"hostname"`
-	GossipBindPort          int    `json:"gossipBindPort" yaml:"gossipBindPort"`
-	DataBindPort            int    `json:"dataBindPort" yaml:"dataBindPort"`
-	Join                    string `json:"join" yaml:"join"`
-	IgnoreStartupSchemaSync bool   `json:"ignoreStartupSchemaSync" yaml:"ignoreStartupSchemaSync"`
+	Hostname                string          `json:"hostname" yaml:"hostname"`
+	GossipBindPort          int             `json:"gossipBindPort" yaml:"gossipBindPort"`
+	DataBindPort            int             `json:"dataBindPort" yaml:"dataBindPort"`
+	Join                    string          `json:"join" yaml:"join"`
+	IgnoreStartupSchemaSync bool            `json:"ignoreStartupSchemaSync" yaml:"ignoreStartupSchemaSync"`
+	TLS                     TLSConfig       `json:"tls" yaml:"tls"`
+	Transport               TransportConfig `json:"transport" yaml:"transport"`
+	Raft                    RaftConfig      `json:"raft" yaml:"raft"`
 }
 
 func Init(userConfig Config, dataPath string, logger logrus.FieldLogger) (_ *State, err error) {
@@ -57,6 +60,9 @@ func Init(userConfig Config, dataPath string, logger logrus.FieldLogger) (_ *Sta
 	if userConfig.GossipBindPort != 0 {
 		cfg.BindPort = userConfig.GossipBindPort
 	}
+	if cfg.Keyring, err = userConfig.TLS.gossipKeyring(); err != nil {
+		return nil, errors.Wrap(err, "gossip keyring")
+	}
 
 	if state.list, err = memberlist.Create(cfg); err != nil {
 		logger.WithField("action", "memberlist_init").
@@ -172,6 +178,17 @@ func (s *State) NodeHostname(nodeName string) (string, bool) {
 	return "", false
 }
 
+// NodeAddress returns the ip of a node without a port
+func (s *State) NodeAddress(nodeName string) (string, bool) {
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			return mem.Addr.String(), true
+		}
+	}
+
+	return "", false
+}
+
 func (s *State) SchemaSyncIgnored() bool {
 	return s.config.IgnoreStartupSchemaSync
 }
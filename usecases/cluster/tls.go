@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultTLSReloadInterval is how often certificate files are checked for
+// changes if no interval is configured
+const DefaultTLSReloadInterval = time.Minute
+
+// TLSConfig configures TLS for the intra-cluster data API (schema
+// transactions, replication, shard transfer, backups, ...) and encryption of
+// the gossip protocol.
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	CertFile string `json:"certFile" yaml:"certFile"`
+	KeyFile  string `json:"keyFile" yaml:"keyFile"`
+	// CAFile is used to verify peers. If empty, the system roots are used.
+	CAFile string `json:"caFile" yaml:"caFile"`
+	// Mutual requires every peer to present a certificate signed by CAFile
+	Mutual bool `json:"mutual" yaml:"mutual"`
+	// ServerName overrides the name used to verify server certificates.
+	// Nodes address each other by IP, so this is needed unless certificates
+	// contain IP SANs.
+	ServerName         string        `json:"serverName" yaml:"serverName"`
+	InsecureSkipVerify bool          `json:"insecureSkipVerify" yaml:"insecureSkipVerify"`
+	ReloadInterval     time.Duration `json:"reloadInterval" yaml:"reloadInterval"`
+	// GossipKeys are base64 encoded AES keys used to encrypt gossip
+	// traffic. The first key is used for encryption, all of them are accepted
+	// for decryption, which allows rotating keys one node at a time.
+	GossipKeys []string `json:"-" yaml:"-"`
+}
+
+func (c TLSConfig) Validate() error {
+	if _, err := c.gossipKeys(); err != nil {
+		return err
+	}
+
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("cluster tls: cert file and key file are required")
+	}
+	if c.Mutual && c.CAFile == "" {
+		return fmt.Errorf("cluster tls: mutual tls requires a ca file")
+	}
+	if c.ReloadInterval < 0 {
+		return fmt.Errorf("cluster tls: reload interval must not be negative")
+	}
+
+	return nil
+}
+
+func (c TLSConfig) gossipKeys() ([][]byte, error) {
+	keys := make([][]byte, 0, len(c.GossipKeys))
+	for i, encoded := range c.GossipKeys {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("cluster tls: gossip key %d is not valid base64: %w", i, err)
+		}
+		if err := memberlist.ValidateKey(key); err != nil {
+			return nil, fmt.Errorf("cluster tls: gossip key %d: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// gossipKeyring returns nil if gossip encryption is disabled
+func (c TLSConfig) gossipKeyring() (*memberlist.Keyring, error) {
+	keys, err := c.gossipKeys()
+	if err != nil || len(keys) == 0 {
+		return nil, err
+	}
+	return memberlist.NewKeyring(keys, keys[0])
+}
+
+// TLS holds the certificates used for intra-cluster traffic. Certificate and
+// CA files are reloaded when they change on disk, so they can be rotated
+// without a restart. New connections pick up the new certificates, existing
+// ones are kept until they are closed.
+type TLS struct {
+	config TLSConfig
+	logger logrus.FieldLogger
+
+	sync.RWMutex
+	cert    *tls.Certificate
+	roots   *x509.CertPool
+	modTime map[string]time.Time
+}
+
+func NewTLS(config TLSConfig, logger logrus.FieldLogger) (*TLS, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.ReloadInterval == 0 {
+		config.ReloadInterval = DefaultTLSReloadInterval
+	}
+
+	t := &TLS{config: config, logger: logger}
+	if _, err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Enabled returns false for a nil receiver, so callers can pass a nil *TLS
+// when TLS is disabled.
+func (t *TLS) Enabled() bool {
+	return t != nil && t.config.Enabled
+}
+
+// Reload loads the certificate and CA files if any of them changed since the
+// last call. It reports whether anything was reloaded. On error the
+// previously loaded certificates stay in use.
+func (t *TLS) Reload() (bool, error) {
+	files := []string{t.config.CertFile, t.config.KeyFile, t.config.CAFile}
+	modTime := make(map[string]time.Time, len(files))
+	changed := false
+	for _, file := range files {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, fmt.Errorf("cluster tls: %w", err)
+		}
+		modTime[file] = info.ModTime()
+		if !t.modTime[file].Equal(info.ModTime()) {
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(t.config.CertFile, t.config.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("cluster tls: load key pair: %w", err)
+	}
+
+	var roots *x509.CertPool
+	if t.config.CAFile != "" {
+		pem, err := os.ReadFile(t.config.CAFile)
+		if err != nil {
+			return false, fmt.Errorf("cluster tls: read ca file: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("cluster tls: no certificates found in ca file %q",
+				t.config.CAFile)
+		}
+	}
+
+	t.Lock()
+	t.cert, t.roots, t.modTime = &cert, roots, modTime
+	t.Unlock()
+	return true, nil
+}
+
+// Watch periodically reloads the certificates until ctx is cancelled
+func (t *TLS) Watch(ctx context.Context) {
+	ticker := time.NewTicker(t.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := t.Reload()
+			if err != nil {
+				t.logger.WithField("action", "cluster_tls_reload").WithError(err).
+					Error("could not reload cluster certificates, keep using previous ones")
+			} else if reloaded {
+				t.logger.WithField("action", "cluster_tls_reload").
+					Info("reloaded cluster certificates")
+			}
+		}
+	}
+}
+
+func (t *TLS) current() (*tls.Certificate, *x509.CertPool) {
+	t.RLock()
+	defer t.RUnlock()
+	return t.cert, t.roots
+}
+
+// ServerConfig is used by the cluster API server. The certificates are
+// resolved per handshake, so reloads take effect immediately.
+func (t *TLS) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, roots := t.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+			}
+			if t.config.Mutual {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = roots
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig is used by the clients that call other nodes. Verification is
+// done in VerifyConnection instead of by the standard library, so that a
+// rotated CA is used without recreating the client.
+func (t *TLS) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         t.config.ServerName,
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := t.current()
+			return cert, nil
+		},
+		VerifyConnection: t.verifyServer,
+	}
+}
+
+func (t *TLS) verifyServer(cs tls.ConnectionState) error {
+	if t.config.InsecureSkipVerify {
+		return nil
+	}
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("cluster tls: peer presented no certificate")
+	}
+
+	_, roots := t.current()
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// Transport configures base to use the cluster certificates and upgrades
+// requests to https. The cluster clients build plain http urls, this keeps
+// them unaware of whether TLS is enabled.
+func (t *TLS) Transport(base *http.Transport) http.RoundTripper {
+	base.TLSClientConfig = t.ClientConfig()
+	return &httpsTransport{base}
+}
+
+type httpsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *httpsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme == "http" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = "https"
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return &testCA{cert, key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate valid for 127.0.0.1 as both server and client
+func (ca *testCA) issue(t *testing.T, dir, name string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certFile, keyFile
+}
+
+// writeFile bumps the modification time, so reloads detect the change even
+// on file systems with a coarse timestamp resolution
+func writeFile(t *testing.T, path string, data []byte) {
+	var modTime time.Time
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	} else {
+		modTime = time.Now()
+	}
+	require.Nil(t, os.WriteFile(path, data, 0o600))
+	require.Nil(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestTLSServer(t *testing.T, clusterTLS *TLS) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }))
+	server.TLS = clusterTLS.ServerConfig()
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(clusterTLS *TLS, server *httptest.Server) error {
+	// the clients build plain http urls, the transport upgrades them
+	transport := &http.Transport{DisableKeepAlives: true}
+	client := &http.Client{Transport: clusterTLS.Transport(transport)}
+	res, err := client.Get("http://" + server.Listener.Addr().String())
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func TestClusterTLS(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	caFile := filepath.Join(dir, "ca.crt")
+	writeFile(t, caFile, ca.pem)
+	certFile, keyFile := ca.issue(t, dir, "node")
+
+	config := TLSConfig{
+		Enabled:  true,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		CAFile:   caFile,
+		Mutual:   true,
+	}
+	clusterTLS, err := NewTLS(config, logger)
+	require.Nil(t, err)
+	server := newTestTLSServer(t, clusterTLS)
+
+	t.Run("nodes with certificates of the same ca can talk", func(t *testing.T) {
+		assert.Nil(t, get(clusterTLS, server))
+	})
+
+	t.Run("mutual tls rejects clients without a certificate", func(t *testing.T) {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}}
+		_, err := client.Get(server.URL)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("clients reject servers of another ca", func(t *testing.T) {
+		otherDir := t.TempDir()
+		other := newTestCA(t, "other")
+		otherCAFile := filepath.Join(otherDir, "ca.crt")
+		writeFile(t, otherCAFile, other.pem)
+		otherCert, otherKey := other.issue(t, otherDir, "node")
+		otherTLS, err := NewTLS(TLSConfig{
+			Enabled: true, CertFile: otherCert, KeyFile: otherKey, CAFile: otherCAFile,
+		}, logger)
+		require.Nil(t, err)
+
+		assert.NotNil(t, get(otherTLS, server))
+	})
+
+	t.Run("rotated certificates are used without a restart", func(t *testing.T) {
+		rotated := newTestCA(t, "rotated")
+		writeFile(t, caFile, rotated.pem)
+		rotated.issue(t, dir, "node")
+
+		reloaded, err := clusterTLS.Reload()
+		require.Nil(t, err)
+		assert.True(t, reloaded)
+		assert.Nil(t, get(clusterTLS, server))
+
+		reloaded, err = clusterTLS.Reload()
+		require.Nil(t, err)
+		assert.False(t, reloaded, "nothing changed")
+	})
+
+	t.Run("previous certificates are kept if a reload fails", func(t *testing.T) {
+		writeFile(t, certFile, []byte("not a certificate"))
+
+		_, err := clusterTLS.Reload()
+		assert.NotNil(t, err)
+		assert.Nil(t, get(clusterTLS, server))
+	})
+}
+
+func TestClusterTLSConfigValidate(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	tests := []struct {
+		name   string
+		config TLSConfig
+		valid  bool
+	}{
+		{name: "disabled", valid: true},
+		{
+			name:   "missing key file",
+			config: TLSConfig{Enabled: true, CertFile: "node.crt"},
+		},
+		{
+			name:   "mutual without ca",
+			config: TLSConfig{Enabled: true, CertFile: "node.crt", KeyFile: "node.key", Mutual: true},
+		},
+		{
+			name:   "gossip keys only",
+			config: TLSConfig{GossipKeys: []string{key, key}},
+			valid:  true,
+		},
+		{
+			name:   "gossip key with invalid size",
+			config: TLSConfig{GossipKeys: []string{base64.StdEncoding.EncodeToString([]byte("short"))}},
+		},
+		{
+			name:   "gossip key not base64",
+			config: TLSConfig{GossipKeys: []string{"%%%"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
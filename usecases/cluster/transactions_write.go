@@ -52,6 +52,9 @@ type TxManager struct {
 	commitFn   CommitFn
 	responseFn ResponseFn
 
+	// consensusLog replaces the broadcast of write transactions if set
+	consensusLog ConsensusLog
+
 	// keep the ids of expired transactions around. This way, we can return a
 	// nicer error message to the user. Instead of just an "invalid transaction"
 	// which no longer exists, they will get an explicit error message mentioning
@@ -172,6 +175,24 @@ func (c *TxManager) SetResponseFn(fn ResponseFn) {
 	c.responseFn = fn
 }
 
+// ConsensusLog orders write transactions. A proposed transaction is rejected
+// with ErrConcurrentTransaction if it was not validated against the latest
+// state, i.e. if AppliedIndex was outdated when the transaction was opened.
+// The origin of a transaction reports with Applied once it applied it.
+type ConsensusLog interface {
+	Propose(ctx context.Context, tx *Transaction) error
+	AppliedIndex() uint64
+	Applied(txID string, err error)
+}
+
+// SetConsensusLog makes the TxManager append write transactions to a log
+// instead of running a two-phase commit. Transactions are proposed when they
+// are opened, so once BeginTransaction succeeds the change is committed and
+// the caller has to apply it locally.
+func (c *TxManager) SetConsensusLog(log ConsensusLog) {
+	c.consensusLog = log
+}
+
 // Begin a Transaction with the specified type and payload. Transactions expire
 // after the specified TTL. For a transaction that does not ever expire, pass
 // in a ttl of 0. When choosing TTLs keep in mind that clocks might be slightly
@@ -223,6 +244,20 @@ func (c *TxManager) beginTransaction(ctx context.Context, trType TransactionType
 
 	c.resetTxExpiry(ttl, c.currentTransaction.ID)
 
+	if c.consensusLog != nil {
+		tx.BaseIndex = c.consensusLog.AppliedIndex()
+		if err := c.consensusLog.Propose(ctx, tx); err != nil {
+			c.Lock()
+			c.clearTransaction()
+			c.Unlock()
+			return nil, errors.Wrap(err, "propose transaction")
+		}
+
+		c.Lock()
+		defer c.Unlock()
+		return c.currentTransaction, nil
+	}
+
 	if err := c.remote.BroadcastTransaction(ctx, tx); err != nil {
 		// we could not open the transaction on every node, therefore we need to
 		// abort it everywhere.
@@ -256,6 +291,15 @@ func (c *TxManager) beginTransaction(ctx context.Context, trType TransactionType
 	return c.currentTransaction, nil
 }
 
+// TransactionApplied reports the outcome of applying a committed write
+// transaction locally. With a consensus log the transaction is only recorded
+// as applied afterwards, and applied again if err is set.
+func (c *TxManager) TransactionApplied(tx *Transaction, err error) {
+	if c.consensusLog != nil {
+		c.consensusLog.Applied(tx.ID, err)
+	}
+}
+
 func (c *TxManager) CommitWriteTransaction(ctx context.Context,
 	tx *Transaction,
 ) error {
@@ -279,6 +323,11 @@ func (c *TxManager) CommitWriteTransaction(ctx context.Context,
 		c.Unlock()
 	}()
 
+	if c.consensusLog != nil {
+		// the transaction was appended to the log when it was opened
+		return nil
+	}
+
 	if err := c.remote.BroadcastCommitTransaction(ctx, tx); err != nil {
 		// we could not open the transaction on every node, therefore we need to
 		// abort it everywhere.
@@ -373,4 +422,8 @@ type Transaction struct {
 	// opened or committed if a node is confirmed dead. If a node is only
 	// suspected dead, the TxManager will try, but abort unless all nodes ACK.
 	TolerateNodeFailures bool
+
+	// BaseIndex is the applied index of the consensus log the transaction was
+	// validated against. It is only used with a ConsensusLog.
+	BaseIndex uint64
 }
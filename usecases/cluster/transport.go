@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultTransportMaxIdleConnsPerHost = 100
+	DefaultTransportIdleConnTimeout     = 90 * time.Second
+	DefaultTransportCompressionMinSize  = 1024
+)
+
+// TransportConfig configures the connections used to call other nodes.
+// Connections are pooled and kept alive per peer. Zero values use defaults.
+type TransportConfig struct {
+	MaxIdleConnsPerHost int `json:"maxIdleConnsPerHost" yaml:"maxIdleConnsPerHost"`
+	// MaxConnsPerHost limits concurrent connections to a single peer, 0 means
+	// no limit
+	MaxConnsPerHost int           `json:"maxConnsPerHost" yaml:"maxConnsPerHost"`
+	IdleConnTimeout time.Duration `json:"idleConnTimeout" yaml:"idleConnTimeout"`
+	// Compression compresses replication payloads and shard transfers with
+	// zstd. Every node decodes compressed requests, so it must only be enabled
+	// once all nodes run a version that supports it.
+	Compression bool `json:"compression" yaml:"compression"`
+	// CompressionMinSize is the smallest request body which is compressed.
+	// Streams of unknown size are always compressed.
+	CompressionMinSize int `json:"compressionMinSize" yaml:"compressionMinSize"`
+}
+
+func (c TransportConfig) Validate() error {
+	if c.MaxIdleConnsPerHost < 0 || c.MaxConnsPerHost < 0 ||
+		c.IdleConnTimeout < 0 || c.CompressionMinSize < 0 {
+		return fmt.Errorf("cluster transport: limits must not be negative")
+	}
+	return nil
+}
+
+// WithDefaults returns a copy with defaults for all unset values
+func (c TransportConfig) WithDefaults() TransportConfig {
+	if c.MaxIdleConnsPerHost == 0 {
+		c.MaxIdleConnsPerHost = DefaultTransportMaxIdleConnsPerHost
+	}
+	if c.IdleConnTimeout == 0 {
+		c.IdleConnTimeout = DefaultTransportIdleConnTimeout
+	}
+	if c.CompressionMinSize == 0 {
+		c.CompressionMinSize = DefaultTransportCompressionMinSize
+	}
+	return c
+}
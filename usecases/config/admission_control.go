@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// AdmissionControl rejects requests with 429 before they are handled once
+// the node is at its configured capacity. Rates are in requests per second
+// and, like the concurrency limits, a value of 0 means unlimited. Batch
+// imports wait up to BatchQueueTimeoutSeconds for a free slot before they
+// are rejected.
+type AdmissionControl struct {
+	Enabled                  bool `json:"enabled" yaml:"enabled"`
+	GlobalRequestsPerSecond  int  `json:"global_requests_per_second" yaml:"global_requests_per_second"`
+	GlobalBurst              int  `json:"global_burst" yaml:"global_burst"`
+	APIKeyRequestsPerSecond  int  `json:"api_key_requests_per_second" yaml:"api_key_requests_per_second"`
+	APIKeyBurst              int  `json:"api_key_burst" yaml:"api_key_burst"`
+	MaxConcurrentQueries     int  `json:"max_concurrent_queries" yaml:"max_concurrent_queries"`
+	MaxConcurrentBatches     int  `json:"max_concurrent_batches" yaml:"max_concurrent_batches"`
+	BatchQueueTimeoutSeconds int  `json:"batch_queue_timeout_seconds" yaml:"batch_queue_timeout_seconds"`
+}
+
+func (a AdmissionControl) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	for name, val := range map[string]int{
+		"global requests per second":  a.GlobalRequestsPerSecond,
+		"global burst":                a.GlobalBurst,
+		"api key requests per second": a.APIKeyRequestsPerSecond,
+		"api key burst":               a.APIKeyBurst,
+		"max concurrent queries":      a.MaxConcurrentQueries,
+		"max concurrent batches":      a.MaxConcurrentBatches,
+		"batch queue timeout":         a.BatchQueueTimeoutSeconds,
+	} {
+		if val < 0 {
+			return fmt.Errorf("admission control: %s must not be negative", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// AuditLog records who changed which objects, classes or backups. Events
+// are written to every configured sink: a file with one JSON event per
+// line, stdout or a webhook receiving batches of events. Object mutations
+// can be sampled with ObjectsSampleRate between 0 and 1, schema and backup
+// changes are always recorded.
+type AuditLog struct {
+	Enabled           bool    `json:"enabled" yaml:"enabled"`
+	File              string  `json:"file" yaml:"file"`
+	Stdout            bool    `json:"stdout" yaml:"stdout"`
+	WebhookURL        string  `json:"webhook_url" yaml:"webhook_url"`
+	ObjectsSampleRate float64 `json:"objects_sample_rate" yaml:"objects_sample_rate"`
+}
+
+func (a AuditLog) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	if a.File == "" && !a.Stdout && a.WebhookURL == "" {
+		return fmt.Errorf("audit log: at least one of file, stdout or webhook url must be set")
+	}
+	if a.WebhookURL != "" {
+		if u, err := url.Parse(a.WebhookURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("audit log: invalid webhook url %q", a.WebhookURL)
+		}
+	}
+	if a.ObjectsSampleRate < 0 || a.ObjectsSampleRate > 1 {
+		return fmt.Errorf("audit log: objects sample rate must be between 0 and 1")
+	}
+
+	return nil
+}
@@ -58,4 +58,7 @@ type APIKey struct {
 	Enabled     bool     `json:"enabled" yaml:"enabled"`
 	Users       []string `json:"users" yaml:"users"`
 	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+	// Dynamic allows to create, rotate and revoke keys through the API in
+	// addition to the static keys above, which are optional then
+	Dynamic bool `json:"dynamic" yaml:"dynamic"`
 }
@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// BlobStorage is the S3-compatible object store the bytes of blob
+// properties with the external blob storage are kept in
+type BlobStorage struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	Bucket   string `json:"bucket" yaml:"bucket"`
+	Prefix   string `json:"prefix" yaml:"prefix"`
+	UseSSL   bool   `json:"useSSL" yaml:"useSSL"`
+}
+
+func (b BlobStorage) Validate() error {
+	if b.Enabled && b.Bucket == "" {
+		return fmt.Errorf("blob storage: bucket must be set")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// BulkImport configures imports of Parquet and Arrow files which are read by
+// the server itself. Local files can only be imported from below LocalRoot,
+// so that the API can't be used to read arbitrary files of the host. Files
+// in S3 are read with the standard AWS credentials of the server.
+type BulkImport struct {
+	Enabled    bool   `json:"enabled" yaml:"enabled"`
+	LocalRoot  string `json:"local_root" yaml:"local_root"`
+	S3Endpoint string `json:"s3_endpoint" yaml:"s3_endpoint"`
+	S3UseSSL   bool   `json:"s3_use_ssl" yaml:"s3_use_ssl"`
+}
+
+func (b BulkImport) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+
+	if b.LocalRoot != "" && !filepath.IsAbs(b.LocalRoot) {
+		return fmt.Errorf("bulk import: local root must be an absolute path")
+	}
+
+	return nil
+}
@@ -59,6 +59,17 @@ const (
 	//       the measurement is reliable. once
 	//       confirmed, we can set this to 90
 	DefaultMemUseReadonlyPercentage = uint64(0)
+
+	DefaultDiskWatchdogMinFreePercentage        = uint64(5)
+	DefaultDiskWatchdogMinFreeMB                = uint64(1024)
+	DefaultDiskWatchdogRecoveryMarginPercentage = uint64(2)
+	DefaultDiskWatchdogIntervalSeconds          = 10
+
+	DefaultMemoryGovernorShrinkCachesPercentage   = uint64(80)
+	DefaultMemoryGovernorFlushMemtablesPercentage = uint64(85)
+	DefaultMemoryGovernorRejectBatchesPercentage  = uint64(90)
+	DefaultMemoryGovernorVectorCachePercentage    = uint64(0)
+	DefaultMemoryGovernorIntervalSeconds          = 5
 )
 
 // Flags are input options
@@ -68,33 +79,50 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string         `json:"name" yaml:"name"`
-	Debug                               bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                       Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                              string         `json:"origin" yaml:"origin"`
-	Persistence                         Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                         string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                          AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                          Monitoring     `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC           `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions               bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	RecountPropertiesAtStartup          bool           `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool           `json:"disable_graphql" yaml:"disable_graphql"`
+	Name                                string                  `json:"name" yaml:"name"`
+	Debug                               bool                    `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults           `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64                   `json:"query_maximum_results" yaml:"query_maximum_results"`
+	Contextionary                       Contextionary           `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication          `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization           `json:"authorization" yaml:"authorization"`
+	Origin                              string                  `json:"origin" yaml:"origin"`
+	Persistence                         Persistence             `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string                  `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string                  `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string                  `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                         string                  `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                          AutoSchema              `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config          `json:"cluster" yaml:"cluster"`
+	Monitoring                          Monitoring              `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC                    `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling               `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage           `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64                 `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int                     `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	TrackVectorDimensions               bool                    `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup    bool                    `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	RecountPropertiesAtStartup          bool                    `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool                    `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	IndexMissingTextFilterableAtStartup bool                    `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	DisableGraphQL                      bool                    `json:"disable_graphql" yaml:"disable_graphql"`
+	Federation                          Federation              `json:"federation" yaml:"federation"`
+	CrossClusterReplication             CrossClusterReplication `json:"cross_cluster_replication" yaml:"cross_cluster_replication"`
+	ReplicaRepair                       ReplicaRepair           `json:"replica_repair" yaml:"replica_repair"`
+	HintedHandoff                       HintedHandoff           `json:"hinted_handoff" yaml:"hinted_handoff"`
+	AdmissionControl                    AdmissionControl        `json:"admission_control" yaml:"admission_control"`
+	AuditLog                            AuditLog                `json:"audit_log" yaml:"audit_log"`
+	SlowQueryLog                        SlowQueryLog            `json:"slow_query_log" yaml:"slow_query_log"`
+	BulkImport                          BulkImport              `json:"bulk_import" yaml:"bulk_import"`
+	Jobs                                Jobs                    `json:"jobs" yaml:"jobs"`
+	EmbeddingCache                      EmbeddingCache          `json:"embedding_cache" yaml:"embedding_cache"`
+	QueryVectorCache                    QueryVectorCache        `json:"query_vector_cache" yaml:"query_vector_cache"`
+	QueryResultCache                    QueryResultCache        `json:"query_result_cache" yaml:"query_result_cache"`
+	EncryptionAtRest                    EncryptionAtRest        `json:"encryption_at_rest" yaml:"encryption_at_rest"`
+	QueryPlanner                        QueryPlanner            `json:"query_planner" yaml:"query_planner"`
+	PropertyStats                       PropertyStats           `json:"property_stats" yaml:"property_stats"`
+	BlobStorage                         BlobStorage             `json:"blob_storage" yaml:"blob_storage"`
+	ShardCircuitBreaker                 ShardCircuitBreaker     `json:"shard_circuit_breaker" yaml:"shard_circuit_breaker"`
 }
 
 type moduleProvider interface {
@@ -170,6 +198,9 @@ type Monitoring struct {
 	Tool    string `json:"tool" yaml:"tool"`
 	Port    int    `json:"port" yaml:"port"`
 	Group   bool   `json:"group_classes" yaml:"group_classes"`
+	// GroupShards aggregates the lsm bucket metrics per class instead of per
+	// shard, it has no effect if Group is set
+	GroupShards bool `json:"group_shards" yaml:"group_shards"`
 }
 
 type GRPC struct {
@@ -182,11 +213,23 @@ type Profiling struct {
 }
 
 type Persistence struct {
-	DataPath                          string `json:"dataPath" yaml:"dataPath"`
-	FlushIdleMemtablesAfter           int    `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
-	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
-	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
-	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	DataPath                          string           `json:"dataPath" yaml:"dataPath"`
+	FlushIdleMemtablesAfter           int              `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
+	MemtablesMaxSizeMB                int              `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
+	MemtablesMinActiveDurationSeconds int              `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
+	MemtablesMaxActiveDurationSeconds int              `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	FilterCacheMaxEntries             int              `json:"filterCacheMaxEntries" yaml:"filterCacheMaxEntries"`
+	SegmentTiering                    SegmentTiering   `json:"segmentTiering" yaml:"segmentTiering"`
+	AsyncIndexing                     AsyncIndexing    `json:"asyncIndexing" yaml:"asyncIndexing"`
+	TenantOffloading                  TenantOffloading `json:"tenantOffloading" yaml:"tenantOffloading"`
+}
+
+// AsyncIndexing decouples imports from vector indexing. Vectors are written
+// to an on-disk queue per shard and inserted into the vector index by a
+// bounded number of workers per shard in the background.
+type AsyncIndexing struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	Workers int  `json:"workers" yaml:"workers"`
 }
 
 func (p Persistence) Validate() error {
@@ -194,6 +237,14 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	if err := p.SegmentTiering.Validate(); err != nil {
+		return fmt.Errorf("persistence: %w", err)
+	}
+
+	if err := p.TenantOffloading.Validate(); err != nil {
+		return fmt.Errorf("persistence: %w", err)
+	}
+
 	return nil
 }
 
@@ -232,8 +283,10 @@ func (m MemUse) Validate() error {
 }
 
 type ResourceUsage struct {
-	DiskUse DiskUse
-	MemUse  MemUse
+	DiskUse        DiskUse
+	MemUse         MemUse
+	DiskWatchdog   DiskWatchdog
+	MemoryGovernor MemoryGovernor
 }
 
 func (r ResourceUsage) Validate() error {
@@ -245,6 +298,14 @@ func (r ResourceUsage) Validate() error {
 		return err
 	}
 
+	if err := r.DiskWatchdog.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.MemoryGovernor.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -321,6 +382,78 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	if err := f.Config.Federation.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.Cluster.TLS.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.Cluster.Transport.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.Cluster.Raft.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.CrossClusterReplication.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.ReplicaRepair.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.HintedHandoff.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.AdmissionControl.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.AuditLog.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.SlowQueryLog.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.BulkImport.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.Jobs.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.EmbeddingCache.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.QueryVectorCache.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.QueryResultCache.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.BlobStorage.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.ShardCircuitBreaker.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.EncryptionAtRest.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	CrossClusterReplicationRolePrimary = "primary"
+	CrossClusterReplicationRoleStandby = "standby"
+)
+
+// CrossClusterReplication configures asynchronous active-passive replication
+// of objects to a standby cluster for disaster recovery. An empty role
+// disables the feature.
+type CrossClusterReplication struct {
+	Role                string `json:"role" yaml:"role"`
+	StandbyURL          string `json:"standby_url" yaml:"standby_url"`
+	StandbyAPIKey       string `json:"standby_api_key" yaml:"standby_api_key"`
+	ShipIntervalSeconds int    `json:"ship_interval_seconds" yaml:"ship_interval_seconds"`
+	BatchSize           int    `json:"batch_size" yaml:"batch_size"`
+	MaxPendingChanges   int    `json:"max_pending_changes" yaml:"max_pending_changes"`
+}
+
+func (c CrossClusterReplication) Enabled() bool {
+	return c.Role != ""
+}
+
+func (c CrossClusterReplication) Validate() error {
+	switch c.Role {
+	case "":
+		return nil
+	case CrossClusterReplicationRoleStandby:
+	case CrossClusterReplicationRolePrimary:
+		if c.StandbyURL == "" {
+			return fmt.Errorf("cross cluster replication: primary requires a standby url")
+		}
+	default:
+		return fmt.Errorf("cross cluster replication: role must be %q or %q, got %q",
+			CrossClusterReplicationRolePrimary, CrossClusterReplicationRoleStandby, c.Role)
+	}
+
+	if c.StandbyURL != "" {
+		u, err := url.Parse(c.StandbyURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("cross cluster replication: invalid standby url %q", c.StandbyURL)
+		}
+	}
+
+	if c.ShipIntervalSeconds <= 0 || c.BatchSize <= 0 || c.MaxPendingChanges <= 0 {
+		return fmt.Errorf("cross cluster replication: interval, batch size " +
+			"and max pending changes must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// DiskWatchdog monitors the free space of the filesystems the shards are
+// stored on. The shards of a filesystem with less than MinFreePercentage or
+// MinFreeMB of free space left are switched to READONLY before the disk fills
+// up, and switched back once the free space exceeds the threshold by
+// RecoveryMarginPercentage of the disk.
+type DiskWatchdog struct {
+	Enabled                  bool   `json:"enabled" yaml:"enabled"`
+	MinFreePercentage        uint64 `json:"min_free_percentage" yaml:"min_free_percentage"`
+	MinFreeMB                uint64 `json:"min_free_mb" yaml:"min_free_mb"`
+	RecoveryMarginPercentage uint64 `json:"recovery_margin_percentage" yaml:"recovery_margin_percentage"`
+	IntervalSeconds          int    `json:"interval_seconds" yaml:"interval_seconds"`
+}
+
+func (d DiskWatchdog) Validate() error {
+	if !d.Enabled {
+		return nil
+	}
+
+	if d.MinFreePercentage == 0 && d.MinFreeMB == 0 {
+		return fmt.Errorf("disk_watchdog: min_free_percentage or min_free_mb must be set")
+	}
+	if d.MinFreePercentage+d.RecoveryMarginPercentage > 100 {
+		return fmt.Errorf("disk_watchdog: min_free_percentage and recovery_margin_percentage " +
+			"must not exceed 100 together")
+	}
+	if d.IntervalSeconds < 1 {
+		return fmt.Errorf("disk_watchdog: interval_seconds must be at least 1")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// EmbeddingCache keeps the vectors returned by vectorizer modules on disk,
+// so re-imports and updates which don't change the vectorized text skip
+// the inference call. Entries expire after TTLSeconds, the oldest entries
+// are evicted once there are more than MaxEntries.
+type EmbeddingCache struct {
+	Enabled    bool `json:"enabled" yaml:"enabled"`
+	TTLSeconds int  `json:"ttl_seconds" yaml:"ttl_seconds"`
+	MaxEntries int  `json:"max_entries" yaml:"max_entries"`
+}
+
+func (e EmbeddingCache) Validate() error {
+	if !e.Enabled {
+		return nil
+	}
+
+	if e.TTLSeconds <= 0 {
+		return fmt.Errorf("embedding cache: ttl must be positive")
+	}
+
+	if e.MaxEntries <= 0 {
+		return fmt.Errorf("embedding cache: max entries must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// EncryptionAtRest encrypts all files of every shard: the buckets of the
+// objects and of the inverted index, the vector index with its commit logs
+// and queue, and the property lengths, see diskio.Encryption. Writes queued
+// for hinted handoff and the object IDs in the cross-cluster replication
+// change log are sealed with the key of their shard as well. Shards which
+// were created before encryption was enabled cannot be loaded with it. The keys are provided by
+// the module named KeyManager or, if it is empty, kept on disk wrapped with
+// MasterKey. With PerTenantKeys each tenant of a multi-tenant class gets its
+// own key, which is deleted together with the tenant.
+type EncryptionAtRest struct {
+	Enabled       bool   `json:"enabled" yaml:"enabled"`
+	KeyManager    string `json:"key_manager" yaml:"key_manager"`
+	MasterKey     string `json:"-" yaml:"master_key"`
+	PerTenantKeys bool   `json:"per_tenant_keys" yaml:"per_tenant_keys"`
+}
+
+func (e EncryptionAtRest) Validate() error {
+	if !e.Enabled || e.KeyManager != "" {
+		return nil
+	}
+
+	if _, err := e.DecodeMasterKey(); err != nil {
+		return fmt.Errorf("encryption at rest: %w", err)
+	}
+	return nil
+}
+
+// DecodeMasterKey returns the base64 encoded 32 byte master key
+func (e EncryptionAtRest) DecodeMasterKey() ([]byte, error) {
+	if e.MasterKey == "" {
+		return nil, fmt.Errorf("a master key is required without a key manager module")
+	}
+	key, err := base64.StdEncoding.DecodeString(e.MasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("master key must be base64 encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("master key must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
+}
@@ -14,8 +14,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -42,6 +44,10 @@ func FromEnv(config *Config) error {
 			// not about classes or shards.
 			config.Monitoring.Group = true
 		}
+
+		if enabled(os.Getenv("PROMETHEUS_MONITORING_GROUP_SHARDS")) {
+			config.Monitoring.GroupShards = true
+		}
 	}
 
 	if enabled(os.Getenv("TRACK_VECTOR_DIMENSIONS")) {
@@ -120,6 +126,8 @@ func FromEnv(config *Config) error {
 			keys := strings.Split(keysString, ",")
 			config.Authentication.APIKey.Users = keys
 		}
+
+		config.Authentication.APIKey.Dynamic = enabled(os.Getenv("AUTHENTICATION_APIKEY_DYNAMIC_ENABLED"))
 	}
 
 	if enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
@@ -136,6 +144,14 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		if usersString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_USERS"); ok {
+			config.Authorization.RBAC.RootUsers = strings.Split(usersString, ",")
+		}
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -150,6 +166,35 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	// disabled by default, as the cache is kept per filterable property of
+	// every shard
+	if err := parsePositiveInt(
+		"PERSISTENCE_FILTER_CACHE_MAX_ENTRIES",
+		func(val int) { config.Persistence.FilterCacheMaxEntries = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := config.parseSegmentTieringConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseTenantOffloadingConfig(); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("ASYNC_INDEXING")) {
+		config.Persistence.AsyncIndexing.Enabled = true
+	}
+	if err := parsePositiveInt(
+		"ASYNC_INDEXING_WORKERS",
+		func(val int) { config.Persistence.AsyncIndexing.Workers = val },
+		DefaultAsyncIndexingWorkers,
+	); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -269,9 +314,524 @@ func FromEnv(config *Config) error {
 	}
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
+	config.QueryPlanner.Enabled = enabled(os.Getenv("QUERY_PLANNER_ENABLED"))
+	config.PropertyStats.Enabled = enabled(os.Getenv("PROPERTY_STATS_ENABLED"))
+
+	if err := config.parseFederationConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseCrossClusterReplicationConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseReplicaRepairConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseHintedHandoffConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseAdmissionControlConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseAuditLogConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseSlowQueryLogConfig(); err != nil {
+		return err
+	}
+
+	config.parseBulkImportConfig()
+
+	if err := config.parseJobsConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseEmbeddingCacheConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQueryVectorCacheConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQueryResultCacheConfig(); err != nil {
+		return err
+	}
+
+	config.parseEncryptionAtRestConfig()
+	config.parseBlobStorageConfig()
+
+	if err := config.parseShardCircuitBreakerConfig(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseFederationConfig reads peers in the form
+// FEDERATION_PEERS=name1=https://host1,name2=https://host2 and optional
+// API keys, gRPC addresses and timeouts per peer in the same form from
+// FEDERATION_PEERS_API_KEYS, FEDERATION_PEERS_GRPC_ADDRESSES and
+// FEDERATION_PEERS_TIMEOUT_SECONDS
+func (c *Config) parseFederationConfig() error {
+	if v := os.Getenv("FEDERATION_PEERS"); v != "" {
+		peers, err := parseKeyValueList("FEDERATION_PEERS", v)
+		if err != nil {
+			return err
+		}
+
+		keys, err := parseOptionalKeyValueList("FEDERATION_PEERS_API_KEYS")
+		if err != nil {
+			return err
+		}
+
+		grpcAddresses, err := parseOptionalKeyValueList("FEDERATION_PEERS_GRPC_ADDRESSES")
+		if err != nil {
+			return err
+		}
+
+		timeouts, err := parseOptionalKeyValueList("FEDERATION_PEERS_TIMEOUT_SECONDS")
+		if err != nil {
+			return err
+		}
+
+		c.Federation.Peers = nil
+		for _, name := range sortedKeys(peers) {
+			peer := FederationPeer{
+				Name:        name,
+				URL:         peers[name],
+				GRPCAddress: grpcAddresses[name],
+				APIKey:      keys[name],
+			}
+			if t, ok := timeouts[name]; ok {
+				asInt, err := strconv.Atoi(t)
+				if err != nil || asInt <= 0 {
+					return fmt.Errorf("FEDERATION_PEERS_TIMEOUT_SECONDS: timeout for peer %q "+
+						"must be a positive integer, got %q", name, t)
+				}
+				peer.TimeoutSeconds = asInt
+			}
+			c.Federation.Peers = append(c.Federation.Peers, peer)
+		}
+	}
+
+	if err := parsePositiveInt(
+		"FEDERATION_TIMEOUT_SECONDS",
+		func(val int) { c.Federation.TimeoutSeconds = val },
+		DefaultFederationTimeoutSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"FEDERATION_CACHE_TTL_SECONDS",
+		func(val int) { c.Federation.CacheTTLSeconds = val },
+		DefaultFederationCacheTTLSeconds,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (c *Config) parseCrossClusterReplicationConfig() error {
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_ROLE"); v != "" {
+		c.CrossClusterReplication.Role = strings.ToLower(v)
+	}
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_STANDBY_URL"); v != "" {
+		c.CrossClusterReplication.StandbyURL = v
+	}
+	if v := os.Getenv("CROSS_CLUSTER_REPLICATION_STANDBY_API_KEY"); v != "" {
+		c.CrossClusterReplication.StandbyAPIKey = v
+	}
+
+	if err := parsePositiveInt(
+		"CROSS_CLUSTER_REPLICATION_SHIP_INTERVAL_SECONDS",
+		func(val int) { c.CrossClusterReplication.ShipIntervalSeconds = val },
+		DefaultCrossClusterReplicationShipIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"CROSS_CLUSTER_REPLICATION_BATCH_SIZE",
+		func(val int) { c.CrossClusterReplication.BatchSize = val },
+		DefaultCrossClusterReplicationBatchSize,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"CROSS_CLUSTER_REPLICATION_MAX_PENDING_CHANGES",
+		func(val int) { c.CrossClusterReplication.MaxPendingChanges = val },
+		DefaultCrossClusterReplicationMaxPendingChanges,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Config) parseReplicaRepairConfig() error {
+	r := &c.ReplicaRepair
+	if enabled(os.Getenv("REPLICA_REPAIR_ENABLED")) {
+		r.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"REPLICA_REPAIR_INTERVAL_SECONDS",
+		func(val int) { r.IntervalSeconds = val },
+		DefaultReplicaRepairIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"REPLICA_REPAIR_HASHTREE_HEIGHT",
+		func(val int) { r.HashTreeHeight = val },
+		DefaultReplicaRepairHashTreeHeight,
+	); err != nil {
+		return err
+	}
+
+	// the bandwidth is unlimited unless explicitly configured
+	if v := os.Getenv("REPLICA_REPAIR_MAX_BYTES_PER_SECOND"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICA_REPAIR_MAX_BYTES_PER_SECOND as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("REPLICA_REPAIR_MAX_BYTES_PER_SECOND must not be negative")
+		}
+		r.MaxBytesPerSecond = asInt
+	}
+
+	return nil
+}
+
+func (c *Config) parseHintedHandoffConfig() error {
+	h := &c.HintedHandoff
+	if enabled(os.Getenv("HINTED_HANDOFF_ENABLED")) {
+		h.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"HINTED_HANDOFF_REPLAY_INTERVAL_SECONDS",
+		func(val int) { h.ReplayIntervalSeconds = val },
+		DefaultHintedHandoffReplayIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"HINTED_HANDOFF_MAX_AGE_SECONDS",
+		func(val int) { h.MaxAgeSeconds = val },
+		DefaultHintedHandoffMaxAgeSeconds,
+	)
+}
+
+func (c *Config) parseAdmissionControlConfig() error {
+	a := &c.AdmissionControl
+	if enabled(os.Getenv("ADMISSION_CONTROL_ENABLED")) {
+		a.Enabled = true
+	}
+
+	// all limits are off unless explicitly configured
+	for varName, target := range map[string]*int{
+		"ADMISSION_CONTROL_GLOBAL_REQUESTS_PER_SECOND":  &a.GlobalRequestsPerSecond,
+		"ADMISSION_CONTROL_GLOBAL_BURST":                &a.GlobalBurst,
+		"ADMISSION_CONTROL_API_KEY_REQUESTS_PER_SECOND": &a.APIKeyRequestsPerSecond,
+		"ADMISSION_CONTROL_API_KEY_BURST":               &a.APIKeyBurst,
+		"ADMISSION_CONTROL_MAX_CONCURRENT_QUERIES":      &a.MaxConcurrentQueries,
+		"ADMISSION_CONTROL_MAX_CONCURRENT_BATCHES":      &a.MaxConcurrentBatches,
+	} {
+		if v := os.Getenv(varName); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("parse %s as int: %w", varName, err)
+			} else if asInt < 0 {
+				return fmt.Errorf("%s must not be negative", varName)
+			}
+			*target = asInt
+		}
+	}
+
+	return parsePositiveInt(
+		"ADMISSION_CONTROL_BATCH_QUEUE_TIMEOUT_SECONDS",
+		func(val int) { a.BatchQueueTimeoutSeconds = val },
+		DefaultAdmissionControlBatchQueueTimeoutSeconds,
+	)
+}
+
+func (c *Config) parseAuditLogConfig() error {
+	a := &c.AuditLog
+	if enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		a.Enabled = true
+	}
+	if enabled(os.Getenv("AUDIT_LOG_STDOUT")) {
+		a.Stdout = true
+	}
+	if v := os.Getenv("AUDIT_LOG_FILE"); v != "" {
+		a.File = v
+	}
+	if v := os.Getenv("AUDIT_LOG_WEBHOOK_URL"); v != "" {
+		a.WebhookURL = v
+	}
+
+	a.ObjectsSampleRate = DefaultAuditLogObjectsSampleRate
+	if v := os.Getenv("AUDIT_LOG_OBJECTS_SAMPLE_RATE"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse AUDIT_LOG_OBJECTS_SAMPLE_RATE as float: %w", err)
+		}
+		a.ObjectsSampleRate = rate
+	}
+
+	return nil
+}
+
+func (c *Config) parseSlowQueryLogConfig() error {
+	s := &c.SlowQueryLog
+	if enabled(os.Getenv("SLOW_QUERY_LOG_ENABLED")) {
+		s.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"SLOW_QUERY_LOG_THRESHOLD_MILLISECONDS",
+		func(val int) { s.ThresholdMilliseconds = val },
+		DefaultSlowQueryLogThresholdMilliseconds,
+	); err != nil {
+		return err
+	}
+
+	// slow queries are only logged, not kept in memory, unless configured
+	if v := os.Getenv("SLOW_QUERY_LOG_BUFFER_SIZE"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse SLOW_QUERY_LOG_BUFFER_SIZE as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("SLOW_QUERY_LOG_BUFFER_SIZE must not be negative")
+		}
+		s.BufferSize = asInt
+	}
+
+	return nil
+}
+
+func (c *Config) parseBulkImportConfig() {
+	b := &c.BulkImport
+	if enabled(os.Getenv("BULK_IMPORT_ENABLED")) {
+		b.Enabled = true
+	}
+	if v := os.Getenv("BULK_IMPORT_LOCAL_ROOT"); v != "" {
+		b.LocalRoot = v
+	}
+	if v := os.Getenv("BULK_IMPORT_S3_ENDPOINT"); v != "" {
+		b.S3Endpoint = v
+	}
+	if enabled(os.Getenv("BULK_IMPORT_S3_USE_SSL")) {
+		b.S3UseSSL = true
+	}
+}
+
+func (c *Config) parseJobsConfig() error {
+	j := &c.Jobs
+	if err := parsePositiveInt(
+		"JOBS_RETENTION_HOURS",
+		func(val int) { j.RetentionHours = val },
+		DefaultJobsRetentionHours,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"JOBS_MAX_RETAINED",
+		func(val int) { j.MaxRetained = val },
+		DefaultJobsMaxRetained,
+	)
+}
+
+func (c *Config) parseEmbeddingCacheConfig() error {
+	e := &c.EmbeddingCache
+	if enabled(os.Getenv("EMBEDDING_CACHE_ENABLED")) {
+		e.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"EMBEDDING_CACHE_TTL_SECONDS",
+		func(val int) { e.TTLSeconds = val },
+		DefaultEmbeddingCacheTTLSeconds,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"EMBEDDING_CACHE_MAX_ENTRIES",
+		func(val int) { e.MaxEntries = val },
+		DefaultEmbeddingCacheMaxEntries,
+	)
+}
+
+func (c *Config) parseQueryVectorCacheConfig() error {
+	q := &c.QueryVectorCache
+	if enabled(os.Getenv("QUERY_VECTOR_CACHE_ENABLED")) {
+		q.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_VECTOR_CACHE_MAX_ENTRIES",
+		func(val int) { q.MaxEntries = val },
+		DefaultQueryVectorCacheMaxEntries,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"QUERY_VECTOR_CACHE_TTL_SECONDS",
+		func(val int) { q.TTLSeconds = val },
+		DefaultQueryVectorCacheTTLSeconds,
+	)
+}
+
+func (c *Config) parseEncryptionAtRestConfig() {
+	e := &c.EncryptionAtRest
+	if enabled(os.Getenv("ENCRYPTION_AT_REST_ENABLED")) {
+		e.Enabled = true
+	}
+	if v := os.Getenv("ENCRYPTION_AT_REST_KEY_MANAGER"); v != "" {
+		e.KeyManager = v
+	}
+	if v := os.Getenv("ENCRYPTION_AT_REST_MASTER_KEY"); v != "" {
+		e.MasterKey = v
+	}
+	if enabled(os.Getenv("ENCRYPTION_AT_REST_PER_TENANT_KEYS")) {
+		e.PerTenantKeys = true
+	}
+}
+
+func (c *Config) parseQueryResultCacheConfig() error {
+	q := &c.QueryResultCache
+	if enabled(os.Getenv("QUERY_RESULT_CACHE_ENABLED")) {
+		q.Enabled = true
+	}
+	if v := os.Getenv("QUERY_RESULT_CACHE_CLASSES"); v != "" {
+		q.Classes = nil
+		for _, class := range strings.Split(v, ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				q.Classes = append(q.Classes, class)
+			}
+		}
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_RESULT_CACHE_MAX_MEGABYTES",
+		func(val int) { q.MaxMegabytes = val },
+		DefaultQueryResultCacheMaxMegabytes,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"QUERY_RESULT_CACHE_TTL_SECONDS",
+		func(val int) { q.TTLSeconds = val },
+		DefaultQueryResultCacheTTLSeconds,
+	)
+}
+
+func (c *Config) parseBlobStorageConfig() {
+	b := &c.BlobStorage
+	if enabled(os.Getenv("BLOB_STORAGE_ENABLED")) {
+		b.Enabled = true
+	}
+	if v := os.Getenv("BLOB_STORAGE_S3_ENDPOINT"); v != "" {
+		b.Endpoint = v
+	}
+	if v := os.Getenv("BLOB_STORAGE_S3_BUCKET"); v != "" {
+		b.Bucket = v
+	}
+	if v := os.Getenv("BLOB_STORAGE_S3_PREFIX"); v != "" {
+		b.Prefix = v
+	}
+	if enabled(os.Getenv("BLOB_STORAGE_S3_USE_SSL")) {
+		b.UseSSL = true
+	}
+}
+
+func (c *Config) parseShardCircuitBreakerConfig() error {
+	s := &c.ShardCircuitBreaker
+	if enabled(os.Getenv("SHARD_CIRCUIT_BREAKER_ENABLED")) {
+		s.Enabled = true
+	}
+
+	s.ErrorRateThreshold = DefaultShardCircuitBreakerErrorRateThreshold
+	if v := os.Getenv("SHARD_CIRCUIT_BREAKER_ERROR_RATE_THRESHOLD"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse SHARD_CIRCUIT_BREAKER_ERROR_RATE_THRESHOLD as float: %w", err)
+		}
+		s.ErrorRateThreshold = rate
+	}
+
+	// a latency threshold of 0 only trips the breaker on errors
+	s.LatencyThresholdMilliseconds = DefaultShardCircuitBreakerLatencyThresholdMilliseconds
+	if v := os.Getenv("SHARD_CIRCUIT_BREAKER_LATENCY_THRESHOLD_MILLISECONDS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse SHARD_CIRCUIT_BREAKER_LATENCY_THRESHOLD_MILLISECONDS as int: %w", err)
+		}
+		s.LatencyThresholdMilliseconds = asInt
+	}
+
+	if err := parsePositiveInt(
+		"SHARD_CIRCUIT_BREAKER_MIN_REQUESTS",
+		func(val int) { s.MinRequests = val },
+		DefaultShardCircuitBreakerMinRequests,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"SHARD_CIRCUIT_BREAKER_WINDOW_SECONDS",
+		func(val int) { s.WindowSeconds = val },
+		DefaultShardCircuitBreakerWindowSeconds,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"SHARD_CIRCUIT_BREAKER_COOLDOWN_SECONDS",
+		func(val int) { s.CooldownSeconds = val },
+		DefaultShardCircuitBreakerCooldownSeconds,
+	)
+}
+
+// parseKeyValueList parses comma separated key=value pairs
+func parseKeyValueList(varName, v string) (map[string]string, error) {
+	out := map[string]string{}
+	for _, pair := range strings.Split(v, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("parse %s: expected key=value, got %q", varName, pair)
+		}
+		out[key] = value
+	}
+
+	return out, nil
+}
+
+func sortedKeys(in map[string]string) []string {
+	keys := make([]string, 0, len(in))
+	for k := range in {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old name for flush value
 	if err := parsePositiveInt(
@@ -318,6 +878,110 @@ func (c *Config) parseMemtableConfig() error {
 	return nil
 }
 
+func (c *Config) parseSegmentTieringConfig() error {
+	t := &c.Persistence.SegmentTiering
+	if enabled(os.Getenv("PERSISTENCE_SEGMENT_TIERING_ENABLED")) {
+		t.Enabled = true
+	}
+	if v := os.Getenv("PERSISTENCE_SEGMENT_TIERING_S3_ENDPOINT"); v != "" {
+		t.Endpoint = v
+	}
+	if v := os.Getenv("PERSISTENCE_SEGMENT_TIERING_S3_BUCKET"); v != "" {
+		t.Bucket = v
+	}
+	if v := os.Getenv("PERSISTENCE_SEGMENT_TIERING_S3_PREFIX"); v != "" {
+		t.Prefix = v
+	}
+	if enabled(os.Getenv("PERSISTENCE_SEGMENT_TIERING_S3_USE_SSL")) {
+		t.UseSSL = true
+	}
+	if v := os.Getenv("PERSISTENCE_SEGMENT_TIERING_CLASSES"); v != "" {
+		t.Classes = nil
+		for _, class := range strings.Split(v, ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				t.Classes = append(t.Classes, class)
+			}
+		}
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_SEGMENT_TIERING_MIN_SEGMENT_AGE_SECONDS",
+		func(val int) { t.MinSegmentAgeSeconds = val },
+		DefaultSegmentTieringMinSegmentAgeSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_SEGMENT_TIERING_BLOCK_SIZE_KB",
+		func(val int) { t.BlockSizeKB = val },
+		DefaultSegmentTieringBlockSizeKB,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_SEGMENT_TIERING_CACHE_SIZE_MB",
+		func(val int) { t.CacheSizeMB = val },
+		DefaultSegmentTieringCacheSizeMB,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Config) parseTenantOffloadingConfig() error {
+	t := &c.Persistence.TenantOffloading
+	if enabled(os.Getenv("TENANT_OFFLOADING_ENABLED")) {
+		t.Enabled = true
+	}
+	if v := os.Getenv("TENANT_OFFLOADING_S3_ENDPOINT"); v != "" {
+		t.Endpoint = v
+	}
+	if v := os.Getenv("TENANT_OFFLOADING_S3_BUCKET"); v != "" {
+		t.Bucket = v
+	}
+	if v := os.Getenv("TENANT_OFFLOADING_S3_PREFIX"); v != "" {
+		t.Prefix = v
+	}
+	if enabled(os.Getenv("TENANT_OFFLOADING_S3_USE_SSL")) {
+		t.UseSSL = true
+	}
+	if v := os.Getenv("TENANT_OFFLOADING_BACKEND"); v != "" {
+		t.Backend = v
+	}
+
+	if err := parsePositiveInt(
+		"TENANT_OFFLOADING_WARM_AFTER_SECONDS",
+		func(val int) { t.WarmAfterSeconds = val },
+		DefaultTenantOffloadingWarmAfterSeconds,
+	); err != nil {
+		return err
+	}
+
+	// tenants are only moved to object storage if explicitly configured
+	if v := os.Getenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse TENANT_OFFLOADING_COLD_AFTER_SECONDS as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("TENANT_OFFLOADING_COLD_AFTER_SECONDS must not be negative")
+		}
+		t.ColdAfterSeconds = asInt
+	}
+
+	return nil
+}
+
+func parseOptionalKeyValueList(varName string) (map[string]string, error) {
+	v := os.Getenv(varName)
+	if v == "" {
+		return map[string]string{}, nil
+	}
+	return parseKeyValueList(varName, v)
+}
+
 func parsePositiveInt(varName string, cb func(val int), defaultValue int) error {
 	if v := os.Getenv(varName); v != "" {
 		asInt, err := strconv.Atoi(v)
@@ -344,6 +1008,50 @@ const (
 	DefaultPersistenceMemtablesMaxDuration    = 45
 	DefaultMaxConcurrentGetRequests           = 0
 	DefaultGRPCPort                           = 50051
+	DefaultFederationCacheTTLSeconds          = 60
+	DefaultFederationTimeoutSeconds           = 10
+
+	DefaultCrossClusterReplicationShipIntervalSeconds = 5
+	DefaultCrossClusterReplicationBatchSize           = 100
+	DefaultCrossClusterReplicationMaxPendingChanges   = 1_000_000
+
+	DefaultSegmentTieringMinSegmentAgeSeconds = 24 * 60 * 60
+	DefaultSegmentTieringBlockSizeKB          = 256
+	DefaultSegmentTieringCacheSizeMB          = 512
+
+	DefaultAsyncIndexingWorkers = 2
+
+	DefaultTenantOffloadingWarmAfterSeconds = 15 * 60
+
+	DefaultReplicaRepairIntervalSeconds = 5 * 60
+	DefaultReplicaRepairHashTreeHeight  = 12
+
+	DefaultHintedHandoffReplayIntervalSeconds = 10
+	DefaultHintedHandoffMaxAgeSeconds         = 3 * 60 * 60
+
+	DefaultAdmissionControlBatchQueueTimeoutSeconds = 30
+
+	DefaultAuditLogObjectsSampleRate = 1.0
+
+	DefaultSlowQueryLogThresholdMilliseconds = 1000
+
+	DefaultJobsRetentionHours = 7 * 24
+	DefaultJobsMaxRetained    = 1000
+
+	DefaultEmbeddingCacheTTLSeconds = 30 * 24 * 60 * 60
+	DefaultEmbeddingCacheMaxEntries = 1_000_000
+
+	DefaultQueryVectorCacheMaxEntries = 10_000
+	DefaultQueryVectorCacheTTLSeconds = 60 * 60
+
+	DefaultQueryResultCacheMaxMegabytes = 256
+	DefaultQueryResultCacheTTLSeconds   = 5 * 60
+
+	DefaultShardCircuitBreakerErrorRateThreshold           = 0.5
+	DefaultShardCircuitBreakerLatencyThresholdMilliseconds = 5000
+	DefaultShardCircuitBreakerMinRequests                  = 20
+	DefaultShardCircuitBreakerWindowSeconds                = 60
+	DefaultShardCircuitBreakerCooldownSeconds              = 30
 )
 
 const VectorizerModuleNone = "none"
@@ -413,6 +1121,80 @@ func parseResourceUsageEnvVars() (ResourceUsage, error) {
 		ru.MemUse.ReadOnlyPercentage = DefaultMemUseReadonlyPercentage
 	}
 
+	if enabled(os.Getenv("DISK_WATCHDOG_ENABLED")) {
+		ru.DiskWatchdog.Enabled = true
+	}
+
+	if v := os.Getenv("DISK_WATCHDOG_MIN_FREE_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, errors.Wrapf(err, "parse DISK_WATCHDOG_MIN_FREE_PERCENTAGE as uint")
+		}
+		ru.DiskWatchdog.MinFreePercentage = asUint
+	} else {
+		ru.DiskWatchdog.MinFreePercentage = DefaultDiskWatchdogMinFreePercentage
+	}
+
+	if v := os.Getenv("DISK_WATCHDOG_MIN_FREE_MB"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, errors.Wrapf(err, "parse DISK_WATCHDOG_MIN_FREE_MB as uint")
+		}
+		ru.DiskWatchdog.MinFreeMB = asUint
+	} else {
+		ru.DiskWatchdog.MinFreeMB = DefaultDiskWatchdogMinFreeMB
+	}
+
+	if v := os.Getenv("DISK_WATCHDOG_RECOVERY_MARGIN_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, errors.Wrapf(err, "parse DISK_WATCHDOG_RECOVERY_MARGIN_PERCENTAGE as uint")
+		}
+		ru.DiskWatchdog.RecoveryMarginPercentage = asUint
+	} else {
+		ru.DiskWatchdog.RecoveryMarginPercentage = DefaultDiskWatchdogRecoveryMarginPercentage
+	}
+
+	if err := parsePositiveInt(
+		"DISK_WATCHDOG_INTERVAL_SECONDS",
+		func(val int) { ru.DiskWatchdog.IntervalSeconds = val },
+		DefaultDiskWatchdogIntervalSeconds,
+	); err != nil {
+		return ru, err
+	}
+
+	if enabled(os.Getenv("MEMORY_GOVERNOR_ENABLED")) {
+		ru.MemoryGovernor.Enabled = true
+	}
+
+	for _, pct := range []struct {
+		name   string
+		target *uint64
+		def    uint64
+	}{
+		{"MEMORY_GOVERNOR_SHRINK_CACHES_PERCENTAGE", &ru.MemoryGovernor.ShrinkCachesPercentage, DefaultMemoryGovernorShrinkCachesPercentage},
+		{"MEMORY_GOVERNOR_FLUSH_MEMTABLES_PERCENTAGE", &ru.MemoryGovernor.FlushMemtablesPercentage, DefaultMemoryGovernorFlushMemtablesPercentage},
+		{"MEMORY_GOVERNOR_REJECT_BATCHES_PERCENTAGE", &ru.MemoryGovernor.RejectBatchesPercentage, DefaultMemoryGovernorRejectBatchesPercentage},
+		{"MEMORY_GOVERNOR_VECTOR_CACHE_PERCENTAGE", &ru.MemoryGovernor.VectorCachePercentage, DefaultMemoryGovernorVectorCachePercentage},
+	} {
+		*pct.target = pct.def
+		if v := os.Getenv(pct.name); v != "" {
+			asUint, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return ru, errors.Wrapf(err, "parse %s as uint", pct.name)
+			}
+			*pct.target = asUint
+		}
+	}
+
+	if err := parsePositiveInt(
+		"MEMORY_GOVERNOR_INTERVAL_SECONDS",
+		func(val int) { ru.MemoryGovernor.IntervalSeconds = val },
+		DefaultMemoryGovernorIntervalSeconds,
+	); err != nil {
+		return ru, err
+	}
+
 	return ru, nil
 }
 
@@ -455,5 +1237,101 @@ func parseClusterConfig() (cluster.Config, error) {
 	cfg.IgnoreStartupSchemaSync = enabled(
 		os.Getenv("CLUSTER_IGNORE_SCHEMA_SYNC"))
 
+	if err := parseClusterTLSConfig(&cfg.TLS); err != nil {
+		return cfg, err
+	}
+
+	if err := parseClusterTransportConfig(&cfg.Transport); err != nil {
+		return cfg, err
+	}
+
+	if err := parseClusterRaftConfig(&cfg.Raft, cfg.Join); err != nil {
+		return cfg, err
+	}
+
 	return cfg, nil
 }
+
+// parseClusterRaftConfig enables the raft log unless CLUSTER_RAFT_ENABLED
+// disables it. Nodes which join a cluster without bootstrap nodes keep the
+// two-phase commit, since they can not tell which nodes form the log. Such
+// a cluster is migrated by setting CLUSTER_RAFT_BOOTSTRAP_NODES on all nodes.
+func parseClusterRaftConfig(cfg *cluster.RaftConfig, join string) error {
+	if v := os.Getenv("CLUSTER_RAFT_BOOTSTRAP_NODES"); v != "" {
+		cfg.BootstrapNodes = strings.Split(v, ",")
+	}
+
+	if v, ok := os.LookupEnv("CLUSTER_RAFT_ENABLED"); ok {
+		cfg.Enabled = enabled(v)
+	} else {
+		cfg.Enabled = join == "" || len(cfg.BootstrapNodes) > 0
+	}
+	if !cfg.Enabled {
+		cfg.BootstrapNodes = nil
+		return nil
+	}
+
+	return parsePositiveInt(
+		"CLUSTER_RAFT_PORT",
+		func(val int) { cfg.Port = val },
+		cluster.DefaultRaftPort,
+	)
+}
+
+func parseClusterTLSConfig(cfg *cluster.TLSConfig) error {
+	cfg.Enabled = enabled(os.Getenv("CLUSTER_TLS_ENABLED"))
+	cfg.CertFile = os.Getenv("CLUSTER_TLS_CERT_FILE")
+	cfg.KeyFile = os.Getenv("CLUSTER_TLS_KEY_FILE")
+	cfg.CAFile = os.Getenv("CLUSTER_TLS_CA_FILE")
+	cfg.Mutual = enabled(os.Getenv("CLUSTER_TLS_MUTUAL"))
+	cfg.ServerName = os.Getenv("CLUSTER_TLS_SERVER_NAME")
+	cfg.InsecureSkipVerify = enabled(os.Getenv("CLUSTER_TLS_INSECURE_SKIP_VERIFY"))
+
+	if err := parsePositiveInt(
+		"CLUSTER_TLS_RELOAD_INTERVAL_SECONDS",
+		func(val int) { cfg.ReloadInterval = time.Duration(val) * time.Second },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("CLUSTER_GOSSIP_ENCRYPTION_KEYS"); v != "" {
+		cfg.GossipKeys = strings.Split(v, ",")
+	}
+
+	return nil
+}
+
+func parseClusterTransportConfig(cfg *cluster.TransportConfig) error {
+	if err := parsePositiveInt(
+		"CLUSTER_TRANSPORT_MAX_IDLE_CONNS_PER_HOST",
+		func(val int) { cfg.MaxIdleConnsPerHost = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"CLUSTER_TRANSPORT_MAX_CONNS_PER_HOST",
+		func(val int) { cfg.MaxConnsPerHost = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"CLUSTER_TRANSPORT_IDLE_CONN_TIMEOUT_SECONDS",
+		func(val int) { cfg.IdleConnTimeout = time.Duration(val) * time.Second },
+		0,
+	); err != nil {
+		return err
+	}
+
+	cfg.Compression = enabled(os.Getenv("CLUSTER_TRANSPORT_COMPRESSION"))
+
+	return parsePositiveInt(
+		"CLUSTER_TRANSPORT_COMPRESSION_MIN_SIZE",
+		func(val int) { cfg.CompressionMinSize = val },
+		0,
+	)
+}
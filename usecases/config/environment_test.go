@@ -12,9 +12,11 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -187,6 +189,35 @@ func TestEnvironmentMemtable_MinDuration(t *testing.T) {
 	}
 }
 
+func TestEnvironmentFilterCacheMaxEntries(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"1000"}, 1000, false},
+		{"not given", []string{}, 0, false},
+		{"invalid factor", []string{"-1"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_FILTER_CACHE_MAX_ENTRIES", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.Persistence.FilterCacheMaxEntries)
+			}
+		})
+	}
+}
+
 func TestEnvironmentMemtable_MaxDuration(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -218,6 +249,7 @@ func TestEnvironmentMemtable_MaxDuration(t *testing.T) {
 }
 
 func TestEnvironmentParseClusterConfig(t *testing.T) {
+	defaultRaft := cluster.RaftConfig{Enabled: true, Port: cluster.DefaultRaftPort}
 	tests := []struct {
 		name           string
 		envVars        map[string]string
@@ -233,6 +265,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 			expectedResult: cluster.Config{
 				GossipBindPort: 7100,
 				DataBindPort:   7101,
+				Raft:           defaultRaft,
 			},
 		},
 		{
@@ -240,6 +273,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 			expectedResult: cluster.Config{
 				GossipBindPort: DefaultGossipBindPort,
 				DataBindPort:   DefaultGossipBindPort + 1,
+				Raft:           defaultRaft,
 			},
 		},
 		{
@@ -250,6 +284,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 			expectedResult: cluster.Config{
 				GossipBindPort: 7777,
 				DataBindPort:   7778,
+				Raft:           defaultRaft,
 			},
 		},
 		{
@@ -277,9 +312,123 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 			expectedResult: cluster.Config{
 				GossipBindPort:          7946,
 				DataBindPort:            7947,
+				Raft:                    defaultRaft,
 				IgnoreStartupSchemaSync: true,
 			},
 		},
+		{
+			name: "tls and gossip encryption",
+			envVars: map[string]string{
+				"CLUSTER_TLS_ENABLED":                 "true",
+				"CLUSTER_TLS_CERT_FILE":               "/certs/node.crt",
+				"CLUSTER_TLS_KEY_FILE":                "/certs/node.key",
+				"CLUSTER_TLS_CA_FILE":                 "/certs/ca.crt",
+				"CLUSTER_TLS_MUTUAL":                  "true",
+				"CLUSTER_TLS_SERVER_NAME":             "weaviate",
+				"CLUSTER_TLS_RELOAD_INTERVAL_SECONDS": "30",
+				"CLUSTER_GOSSIP_ENCRYPTION_KEYS":      "a2V5MQ==,a2V5Mg==",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+				Raft:           defaultRaft,
+				TLS: cluster.TLSConfig{
+					Enabled:        true,
+					CertFile:       "/certs/node.crt",
+					KeyFile:        "/certs/node.key",
+					CAFile:         "/certs/ca.crt",
+					Mutual:         true,
+					ServerName:     "weaviate",
+					ReloadInterval: 30 * time.Second,
+					GossipKeys:     []string{"a2V5MQ==", "a2V5Mg=="},
+				},
+			},
+		},
+		{
+			name: "transport pooling and compression",
+			envVars: map[string]string{
+				"CLUSTER_TRANSPORT_MAX_IDLE_CONNS_PER_HOST":   "20",
+				"CLUSTER_TRANSPORT_MAX_CONNS_PER_HOST":        "50",
+				"CLUSTER_TRANSPORT_IDLE_CONN_TIMEOUT_SECONDS": "30",
+				"CLUSTER_TRANSPORT_COMPRESSION":               "true",
+				"CLUSTER_TRANSPORT_COMPRESSION_MIN_SIZE":      "4096",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+				Raft:           defaultRaft,
+				Transport: cluster.TransportConfig{
+					MaxIdleConnsPerHost: 20,
+					MaxConnsPerHost:     50,
+					IdleConnTimeout:     30 * time.Second,
+					Compression:         true,
+					CompressionMinSize:  4096,
+				},
+			},
+		},
+		{
+			name: "raft with default port",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED":         "true",
+				"CLUSTER_RAFT_BOOTSTRAP_NODES": "node1,node2,node3",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+				Raft: cluster.RaftConfig{
+					Enabled:        true,
+					Port:           cluster.DefaultRaftPort,
+					BootstrapNodes: []string{"node1", "node2", "node3"},
+				},
+			},
+		},
+		{
+			name: "raft port is ignored if raft is disabled",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED": "false",
+				"CLUSTER_RAFT_PORT":    "9000",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+			},
+		},
+		{
+			name: "joining without bootstrap nodes keeps the two-phase commit",
+			envVars: map[string]string{
+				"CLUSTER_JOIN": "node1:7946",
+			},
+			expectedResult: cluster.Config{
+				Join:           "node1:7946",
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+			},
+		},
+		{
+			name: "joining with bootstrap nodes uses raft",
+			envVars: map[string]string{
+				"CLUSTER_JOIN":                 "node1:7946",
+				"CLUSTER_RAFT_BOOTSTRAP_NODES": "node1,node2",
+			},
+			expectedResult: cluster.Config{
+				Join:           "node1:7946",
+				GossipBindPort: 7946,
+				DataBindPort:   7947,
+				Raft: cluster.RaftConfig{
+					Enabled:        true,
+					Port:           cluster.DefaultRaftPort,
+					BootstrapNodes: []string{"node1", "node2"},
+				},
+			},
+		},
+		{
+			name: "invalid tls reload interval",
+			envVars: map[string]string{
+				"CLUSTER_TLS_RELOAD_INTERVAL_SECONDS": "0",
+			},
+			expectedErr: errors.New("CLUSTER_TLS_RELOAD_INTERVAL_SECONDS must be " +
+				"a positive value larger 0"),
+		},
 	}
 
 	for _, test := range tests {
@@ -407,6 +556,36 @@ func TestEnvironmentDisableGraphQL(t *testing.T) {
 	}
 }
 
+func TestEnvironmentQueryPlanner(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.QueryPlanner.Enabled)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("QUERY_PLANNER_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.QueryPlanner.Enabled)
+	})
+}
+
+func TestEnvironmentPropertyStats(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.PropertyStats.Enabled)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("PROPERTY_STATS_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.PropertyStats.Enabled)
+	})
+}
+
 func TestEnvironmentPrometheusGroupClasses_OldName(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -472,3 +651,832 @@ func TestEnvironmentPrometheusGroupClasses_NewName(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentPrometheusGroupShards(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected bool
+	}{
+		{"Valid: true", []string{"true"}, true},
+		{"Valid: false", []string{"false"}, false},
+		{"not given", []string{}, false},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PROMETHEUS_MONITORING_ENABLED", "true")
+			if len(tt.value) == 1 {
+				t.Setenv("PROMETHEUS_MONITORING_GROUP_SHARDS", tt.value[0])
+			}
+			conf := Config{}
+			require.Nil(t, FromEnv(&conf))
+			require.Equal(t, tt.expected, conf.Monitoring.GroupShards)
+			require.False(t, conf.Monitoring.Group)
+		})
+	}
+}
+
+func TestEnvironmentFederationPeers(t *testing.T) {
+	t.Run("peers with api keys", func(t *testing.T) {
+		t.Setenv("FEDERATION_PEERS", "eu=https://eu.example.com, us=https://us.example.com")
+		t.Setenv("FEDERATION_PEERS_API_KEYS", "us=secret")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, []FederationPeer{
+			{Name: "eu", URL: "https://eu.example.com"},
+			{Name: "us", URL: "https://us.example.com", APIKey: "secret"},
+		}, conf.Federation.Peers)
+		assert.Equal(t, DefaultFederationCacheTTLSeconds, conf.Federation.CacheTTLSeconds)
+		assert.Nil(t, conf.Federation.Validate())
+	})
+
+	t.Run("malformed peer list", func(t *testing.T) {
+		t.Setenv("FEDERATION_PEERS", "eu")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("custom cache ttl", func(t *testing.T) {
+		t.Setenv("FEDERATION_CACHE_TTL_SECONDS", "300")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 300, conf.Federation.CacheTTLSeconds)
+	})
+
+	t.Run("grpc addresses and timeouts", func(t *testing.T) {
+		t.Setenv("FEDERATION_PEERS", "eu=https://eu.example.com,us=http://us.example.com")
+		t.Setenv("FEDERATION_PEERS_GRPC_ADDRESSES", "eu=eu.example.com:50051")
+		t.Setenv("FEDERATION_PEERS_TIMEOUT_SECONDS", "us=3")
+		t.Setenv("FEDERATION_TIMEOUT_SECONDS", "5")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, []FederationPeer{
+			{Name: "eu", URL: "https://eu.example.com", GRPCAddress: "eu.example.com:50051"},
+			{Name: "us", URL: "http://us.example.com", TimeoutSeconds: 3},
+		}, conf.Federation.Peers)
+		assert.Equal(t, 5, conf.Federation.TimeoutSeconds)
+	})
+
+	t.Run("invalid peer timeout", func(t *testing.T) {
+		t.Setenv("FEDERATION_PEERS", "eu=https://eu.example.com")
+		t.Setenv("FEDERATION_PEERS_TIMEOUT_SECONDS", "eu=soon")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentCrossClusterReplication(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.CrossClusterReplication.Enabled())
+		assert.Nil(t, conf.CrossClusterReplication.Validate())
+	})
+
+	t.Run("primary", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_ROLE", "Primary")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_STANDBY_URL", "https://standby.example.com")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_BATCH_SIZE", "50")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, CrossClusterReplication{
+			Role:                CrossClusterReplicationRolePrimary,
+			StandbyURL:          "https://standby.example.com",
+			ShipIntervalSeconds: DefaultCrossClusterReplicationShipIntervalSeconds,
+			BatchSize:           50,
+			MaxPendingChanges:   DefaultCrossClusterReplicationMaxPendingChanges,
+		}, conf.CrossClusterReplication)
+		assert.Nil(t, conf.CrossClusterReplication.Validate())
+	})
+
+	t.Run("primary without standby", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_ROLE", "primary")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.CrossClusterReplication.Validate())
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_ROLE", "leader")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.CrossClusterReplication.Validate())
+	})
+}
+
+func TestEnvironmentSegmentTiering(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.Persistence.SegmentTiering.Enabled)
+		assert.False(t, conf.Persistence.SegmentTiering.AppliesTo("Article"))
+		assert.Nil(t, conf.Persistence.SegmentTiering.Validate())
+	})
+
+	t.Run("enabled for some classes", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_ENABLED", "true")
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_S3_BUCKET", "segments")
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_S3_ENDPOINT", "minio:9000")
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_CLASSES", "Article, Author")
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_CACHE_SIZE_MB", "64")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, SegmentTiering{
+			Enabled:              true,
+			Endpoint:             "minio:9000",
+			Bucket:               "segments",
+			MinSegmentAgeSeconds: DefaultSegmentTieringMinSegmentAgeSeconds,
+			BlockSizeKB:          DefaultSegmentTieringBlockSizeKB,
+			CacheSizeMB:          64,
+			Classes:              []string{"Article", "Author"},
+		}, conf.Persistence.SegmentTiering)
+		assert.Nil(t, conf.Persistence.SegmentTiering.Validate())
+		assert.True(t, conf.Persistence.SegmentTiering.AppliesTo("Author"))
+		assert.False(t, conf.Persistence.SegmentTiering.AppliesTo("Review"))
+	})
+
+	t.Run("enabled without bucket", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_SEGMENT_TIERING_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.Persistence.SegmentTiering.Validate())
+	})
+}
+
+func TestEnvironmentTenantOffloading(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.Persistence.TenantOffloading.Enabled)
+		assert.Equal(t, DefaultTenantOffloadingWarmAfterSeconds,
+			conf.Persistence.TenantOffloading.WarmAfterSeconds)
+		assert.Nil(t, conf.Persistence.TenantOffloading.Validate())
+	})
+
+	t.Run("warm and cold", func(t *testing.T) {
+		t.Setenv("TENANT_OFFLOADING_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOADING_WARM_AFTER_SECONDS", "60")
+		t.Setenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS", "3600")
+		t.Setenv("TENANT_OFFLOADING_S3_BUCKET", "tenants")
+		t.Setenv("TENANT_OFFLOADING_S3_ENDPOINT", "minio:9000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, TenantOffloading{
+			Enabled:          true,
+			WarmAfterSeconds: 60,
+			ColdAfterSeconds: 3600,
+			Endpoint:         "minio:9000",
+			Bucket:           "tenants",
+		}, conf.Persistence.TenantOffloading)
+		assert.Nil(t, conf.Persistence.TenantOffloading.Validate())
+	})
+
+	t.Run("cold without bucket", func(t *testing.T) {
+		t.Setenv("TENANT_OFFLOADING_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS", "3600")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.Persistence.TenantOffloading.Validate())
+	})
+
+	t.Run("cold with backup backend", func(t *testing.T) {
+		t.Setenv("TENANT_OFFLOADING_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS", "3600")
+		t.Setenv("TENANT_OFFLOADING_BACKEND", "gcs")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, "gcs", conf.Persistence.TenantOffloading.Backend)
+		assert.Nil(t, conf.Persistence.TenantOffloading.Validate())
+	})
+
+	t.Run("cold before warm", func(t *testing.T) {
+		t.Setenv("TENANT_OFFLOADING_ENABLED", "true")
+		t.Setenv("TENANT_OFFLOADING_WARM_AFTER_SECONDS", "600")
+		t.Setenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS", "60")
+		t.Setenv("TENANT_OFFLOADING_S3_BUCKET", "tenants")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.Persistence.TenantOffloading.Validate())
+	})
+
+	t.Run("negative cold after", func(t *testing.T) {
+		t.Setenv("TENANT_OFFLOADING_COLD_AFTER_SECONDS", "-1")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentReplicaRepair(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.ReplicaRepair.Enabled)
+		assert.Nil(t, conf.ReplicaRepair.Validate())
+	})
+
+	t.Run("enabled with bandwidth cap", func(t *testing.T) {
+		t.Setenv("REPLICA_REPAIR_ENABLED", "true")
+		t.Setenv("REPLICA_REPAIR_INTERVAL_SECONDS", "60")
+		t.Setenv("REPLICA_REPAIR_MAX_BYTES_PER_SECOND", "1048576")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, ReplicaRepair{
+			Enabled:           true,
+			IntervalSeconds:   60,
+			HashTreeHeight:    DefaultReplicaRepairHashTreeHeight,
+			MaxBytesPerSecond: 1048576,
+		}, conf.ReplicaRepair)
+		assert.Nil(t, conf.ReplicaRepair.Validate())
+	})
+
+	t.Run("hash tree too high", func(t *testing.T) {
+		t.Setenv("REPLICA_REPAIR_ENABLED", "true")
+		t.Setenv("REPLICA_REPAIR_HASHTREE_HEIGHT", "32")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.ReplicaRepair.Validate())
+	})
+
+	t.Run("negative bandwidth", func(t *testing.T) {
+		t.Setenv("REPLICA_REPAIR_MAX_BYTES_PER_SECOND", "-1")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.HintedHandoff.Enabled)
+		assert.Nil(t, conf.HintedHandoff.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("HINTED_HANDOFF_ENABLED", "true")
+		t.Setenv("HINTED_HANDOFF_MAX_AGE_SECONDS", "600")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, HintedHandoff{
+			Enabled:               true,
+			ReplayIntervalSeconds: DefaultHintedHandoffReplayIntervalSeconds,
+			MaxAgeSeconds:         600,
+		}, conf.HintedHandoff)
+		assert.Nil(t, conf.HintedHandoff.Validate())
+	})
+
+	t.Run("invalid max age", func(t *testing.T) {
+		t.Setenv("HINTED_HANDOFF_MAX_AGE_SECONDS", "0")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAdmissionControl(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.AdmissionControl.Enabled)
+		assert.Nil(t, conf.AdmissionControl.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_ENABLED", "true")
+		t.Setenv("ADMISSION_CONTROL_GLOBAL_REQUESTS_PER_SECOND", "1000")
+		t.Setenv("ADMISSION_CONTROL_API_KEY_REQUESTS_PER_SECOND", "50")
+		t.Setenv("ADMISSION_CONTROL_API_KEY_BURST", "100")
+		t.Setenv("ADMISSION_CONTROL_MAX_CONCURRENT_QUERIES", "64")
+		t.Setenv("ADMISSION_CONTROL_MAX_CONCURRENT_BATCHES", "4")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, AdmissionControl{
+			Enabled:                  true,
+			GlobalRequestsPerSecond:  1000,
+			APIKeyRequestsPerSecond:  50,
+			APIKeyBurst:              100,
+			MaxConcurrentQueries:     64,
+			MaxConcurrentBatches:     4,
+			BatchQueueTimeoutSeconds: DefaultAdmissionControlBatchQueueTimeoutSeconds,
+		}, conf.AdmissionControl)
+		assert.Nil(t, conf.AdmissionControl.Validate())
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_MAX_CONCURRENT_QUERIES", "-1")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("invalid queue timeout", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_BATCH_QUEUE_TIMEOUT_SECONDS", "0")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAuthorizationRBAC(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("AUTHORIZATION_RBAC_ENABLED", "true")
+		t.Setenv("AUTHORIZATION_RBAC_ROOT_USERS", "alice,bob")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.True(t, conf.Authorization.RBAC.Enabled)
+		assert.Equal(t, []string{"alice", "bob"}, conf.Authorization.RBAC.RootUsers)
+		assert.Nil(t, conf.Authorization.Validate())
+	})
+
+	t.Run("without root users", func(t *testing.T) {
+		t.Setenv("AUTHORIZATION_RBAC_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.NotNil(t, conf.Authorization.Validate())
+	})
+
+	t.Run("together with the admin list", func(t *testing.T) {
+		t.Setenv("AUTHORIZATION_RBAC_ENABLED", "true")
+		t.Setenv("AUTHORIZATION_RBAC_ROOT_USERS", "alice")
+		t.Setenv("AUTHORIZATION_ADMINLIST_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.NotNil(t, conf.Authorization.Validate())
+	})
+}
+
+func TestEnvironmentAPIKeyDynamic(t *testing.T) {
+	t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+	t.Setenv("AUTHENTICATION_APIKEY_DYNAMIC_ENABLED", "true")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+
+	assert.True(t, conf.Authentication.APIKey.Enabled)
+	assert.True(t, conf.Authentication.APIKey.Dynamic)
+	assert.Empty(t, conf.Authentication.APIKey.AllowedKeys)
+}
+
+func TestEnvironmentAuditLog(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.AuditLog.Enabled)
+		assert.Equal(t, DefaultAuditLogObjectsSampleRate, conf.AuditLog.ObjectsSampleRate)
+		assert.Nil(t, conf.AuditLog.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		t.Setenv("AUDIT_LOG_FILE", "/var/log/weaviate/audit.log")
+		t.Setenv("AUDIT_LOG_WEBHOOK_URL", "https://audit.example.com/events")
+		t.Setenv("AUDIT_LOG_OBJECTS_SAMPLE_RATE", "0.1")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, AuditLog{
+			Enabled:           true,
+			File:              "/var/log/weaviate/audit.log",
+			WebhookURL:        "https://audit.example.com/events",
+			ObjectsSampleRate: 0.1,
+		}, conf.AuditLog)
+		assert.Nil(t, conf.AuditLog.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.NotNil(t, AuditLog{Enabled: true}.Validate())
+		assert.NotNil(t, AuditLog{Enabled: true, Stdout: true, ObjectsSampleRate: 2}.Validate())
+		assert.NotNil(t, AuditLog{Enabled: true, WebhookURL: "audit"}.Validate())
+	})
+}
+
+func TestEnvironmentSlowQueryLog(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, SlowQueryLog{
+			ThresholdMilliseconds: DefaultSlowQueryLogThresholdMilliseconds,
+		}, conf.SlowQueryLog)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("SLOW_QUERY_LOG_ENABLED", "true")
+		t.Setenv("SLOW_QUERY_LOG_THRESHOLD_MILLISECONDS", "250")
+		t.Setenv("SLOW_QUERY_LOG_BUFFER_SIZE", "50")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, SlowQueryLog{
+			Enabled:               true,
+			ThresholdMilliseconds: 250,
+			BufferSize:            50,
+		}, conf.SlowQueryLog)
+		assert.Nil(t, conf.SlowQueryLog.Validate())
+	})
+
+	t.Run("invalid buffer size", func(t *testing.T) {
+		t.Setenv("SLOW_QUERY_LOG_BUFFER_SIZE", "-1")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentBulkImport(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, BulkImport{}, conf.BulkImport)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("BULK_IMPORT_ENABLED", "true")
+		t.Setenv("BULK_IMPORT_LOCAL_ROOT", "/var/lib/weaviate-imports")
+		t.Setenv("BULK_IMPORT_S3_ENDPOINT", "minio:9000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, BulkImport{
+			Enabled:    true,
+			LocalRoot:  "/var/lib/weaviate-imports",
+			S3Endpoint: "minio:9000",
+		}, conf.BulkImport)
+		assert.Nil(t, conf.BulkImport.Validate())
+	})
+
+	t.Run("relative local root", func(t *testing.T) {
+		assert.NotNil(t, BulkImport{Enabled: true, LocalRoot: "imports"}.Validate())
+	})
+}
+
+func TestEnvironmentJobs(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, Jobs{
+			RetentionHours: DefaultJobsRetentionHours,
+			MaxRetained:    DefaultJobsMaxRetained,
+		}, conf.Jobs)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("JOBS_RETENTION_HOURS", "24")
+		t.Setenv("JOBS_MAX_RETAINED", "50")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, Jobs{RetentionHours: 24, MaxRetained: 50}, conf.Jobs)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("JOBS_RETENTION_HOURS", "0")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentEmbeddingCache(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.EmbeddingCache.Enabled)
+		assert.Nil(t, conf.EmbeddingCache.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("EMBEDDING_CACHE_ENABLED", "true")
+		t.Setenv("EMBEDDING_CACHE_MAX_ENTRIES", "5000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, EmbeddingCache{
+			Enabled:    true,
+			TTLSeconds: DefaultEmbeddingCacheTTLSeconds,
+			MaxEntries: 5000,
+		}, conf.EmbeddingCache)
+		assert.Nil(t, conf.EmbeddingCache.Validate())
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		t.Setenv("EMBEDDING_CACHE_TTL_SECONDS", "0")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentQueryVectorCache(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.QueryVectorCache.Enabled)
+		assert.Nil(t, conf.QueryVectorCache.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("QUERY_VECTOR_CACHE_ENABLED", "true")
+		t.Setenv("QUERY_VECTOR_CACHE_TTL_SECONDS", "300")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, QueryVectorCache{
+			Enabled:    true,
+			MaxEntries: DefaultQueryVectorCacheMaxEntries,
+			TTLSeconds: 300,
+		}, conf.QueryVectorCache)
+		assert.Nil(t, conf.QueryVectorCache.Validate())
+	})
+
+	t.Run("invalid max entries", func(t *testing.T) {
+		t.Setenv("QUERY_VECTOR_CACHE_MAX_ENTRIES", "0")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentEncryptionAtRest(t *testing.T) {
+	masterKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.EncryptionAtRest.Enabled)
+		assert.Nil(t, conf.EncryptionAtRest.Validate())
+	})
+
+	t.Run("enabled with a master key", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_AT_REST_ENABLED", "true")
+		t.Setenv("ENCRYPTION_AT_REST_MASTER_KEY", masterKey)
+		t.Setenv("ENCRYPTION_AT_REST_PER_TENANT_KEYS", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, EncryptionAtRest{
+			Enabled:       true,
+			MasterKey:     masterKey,
+			PerTenantKeys: true,
+		}, conf.EncryptionAtRest)
+		assert.Nil(t, conf.EncryptionAtRest.Validate())
+	})
+
+	t.Run("enabled with a key manager module", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_AT_REST_ENABLED", "true")
+		t.Setenv("ENCRYPTION_AT_REST_KEY_MANAGER", "kms-module")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, "kms-module", conf.EncryptionAtRest.KeyManager)
+		assert.Nil(t, conf.EncryptionAtRest.Validate())
+	})
+
+	t.Run("invalid master key", func(t *testing.T) {
+		for _, key := range []string{"", "not base64", base64.StdEncoding.EncodeToString(make([]byte, 16))} {
+			e := EncryptionAtRest{Enabled: true, MasterKey: key}
+			assert.NotNil(t, e.Validate(), key)
+		}
+	})
+}
+
+func TestEnvironmentQueryResultCache(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.QueryResultCache.Enabled)
+		assert.Nil(t, conf.QueryResultCache.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("QUERY_RESULT_CACHE_ENABLED", "true")
+		t.Setenv("QUERY_RESULT_CACHE_MAX_MEGABYTES", "64")
+		t.Setenv("QUERY_RESULT_CACHE_CLASSES", "Article, Product")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, QueryResultCache{
+			Enabled:      true,
+			MaxMegabytes: 64,
+			TTLSeconds:   DefaultQueryResultCacheTTLSeconds,
+			Classes:      []string{"Article", "Product"},
+		}, conf.QueryResultCache)
+		assert.Nil(t, conf.QueryResultCache.Validate())
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		t.Setenv("QUERY_RESULT_CACHE_TTL_SECONDS", "-1")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentBlobStorage(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.False(t, conf.BlobStorage.Enabled)
+		assert.Nil(t, conf.BlobStorage.Validate())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_ENABLED", "true")
+		t.Setenv("BLOB_STORAGE_S3_ENDPOINT", "minio:9000")
+		t.Setenv("BLOB_STORAGE_S3_BUCKET", "blobs")
+		t.Setenv("BLOB_STORAGE_S3_PREFIX", "weaviate")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, BlobStorage{
+			Enabled:  true,
+			Endpoint: "minio:9000",
+			Bucket:   "blobs",
+			Prefix:   "weaviate",
+		}, conf.BlobStorage)
+		assert.Nil(t, conf.BlobStorage.Validate())
+	})
+
+	t.Run("without bucket", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.BlobStorage.Validate())
+	})
+}
+
+func TestEnvironmentShardCircuitBreaker(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, ShardCircuitBreaker{
+			ErrorRateThreshold:           DefaultShardCircuitBreakerErrorRateThreshold,
+			LatencyThresholdMilliseconds: DefaultShardCircuitBreakerLatencyThresholdMilliseconds,
+			MinRequests:                  DefaultShardCircuitBreakerMinRequests,
+			WindowSeconds:                DefaultShardCircuitBreakerWindowSeconds,
+			CooldownSeconds:              DefaultShardCircuitBreakerCooldownSeconds,
+		}, conf.ShardCircuitBreaker)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("SHARD_CIRCUIT_BREAKER_ENABLED", "true")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_ERROR_RATE_THRESHOLD", "0.2")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_LATENCY_THRESHOLD_MILLISECONDS", "0")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_MIN_REQUESTS", "5")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_WINDOW_SECONDS", "10")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_COOLDOWN_SECONDS", "15")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, ShardCircuitBreaker{
+			Enabled:            true,
+			ErrorRateThreshold: 0.2,
+			MinRequests:        5,
+			WindowSeconds:      10,
+			CooldownSeconds:    15,
+		}, conf.ShardCircuitBreaker)
+		assert.Nil(t, conf.ShardCircuitBreaker.Validate())
+	})
+
+	t.Run("invalid error rate", func(t *testing.T) {
+		t.Setenv("SHARD_CIRCUIT_BREAKER_ENABLED", "true")
+		t.Setenv("SHARD_CIRCUIT_BREAKER_ERROR_RATE_THRESHOLD", "1.5")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.ShardCircuitBreaker.Validate())
+	})
+
+	t.Run("invalid min requests", func(t *testing.T) {
+		t.Setenv("SHARD_CIRCUIT_BREAKER_MIN_REQUESTS", "0")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentDiskWatchdog(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, DiskWatchdog{
+			MinFreePercentage:        DefaultDiskWatchdogMinFreePercentage,
+			MinFreeMB:                DefaultDiskWatchdogMinFreeMB,
+			RecoveryMarginPercentage: DefaultDiskWatchdogRecoveryMarginPercentage,
+			IntervalSeconds:          DefaultDiskWatchdogIntervalSeconds,
+		}, conf.ResourceUsage.DiskWatchdog)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("DISK_WATCHDOG_ENABLED", "true")
+		t.Setenv("DISK_WATCHDOG_MIN_FREE_PERCENTAGE", "10")
+		t.Setenv("DISK_WATCHDOG_MIN_FREE_MB", "0")
+		t.Setenv("DISK_WATCHDOG_RECOVERY_MARGIN_PERCENTAGE", "5")
+		t.Setenv("DISK_WATCHDOG_INTERVAL_SECONDS", "3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, DiskWatchdog{
+			Enabled:                  true,
+			MinFreePercentage:        10,
+			RecoveryMarginPercentage: 5,
+			IntervalSeconds:          3,
+		}, conf.ResourceUsage.DiskWatchdog)
+		assert.Nil(t, conf.ResourceUsage.Validate())
+	})
+
+	t.Run("without thresholds", func(t *testing.T) {
+		t.Setenv("DISK_WATCHDOG_ENABLED", "true")
+		t.Setenv("DISK_WATCHDOG_MIN_FREE_PERCENTAGE", "0")
+		t.Setenv("DISK_WATCHDOG_MIN_FREE_MB", "0")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.ResourceUsage.Validate())
+	})
+
+	t.Run("invalid percentage", func(t *testing.T) {
+		t.Setenv("DISK_WATCHDOG_MIN_FREE_PERCENTAGE", "ten")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentMemoryGovernor(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, MemoryGovernor{
+			ShrinkCachesPercentage:   DefaultMemoryGovernorShrinkCachesPercentage,
+			FlushMemtablesPercentage: DefaultMemoryGovernorFlushMemtablesPercentage,
+			RejectBatchesPercentage:  DefaultMemoryGovernorRejectBatchesPercentage,
+			IntervalSeconds:          DefaultMemoryGovernorIntervalSeconds,
+		}, conf.ResourceUsage.MemoryGovernor)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("MEMORY_GOVERNOR_ENABLED", "true")
+		t.Setenv("MEMORY_GOVERNOR_SHRINK_CACHES_PERCENTAGE", "70")
+		t.Setenv("MEMORY_GOVERNOR_FLUSH_MEMTABLES_PERCENTAGE", "75")
+		t.Setenv("MEMORY_GOVERNOR_REJECT_BATCHES_PERCENTAGE", "95")
+		t.Setenv("MEMORY_GOVERNOR_VECTOR_CACHE_PERCENTAGE", "30")
+		t.Setenv("MEMORY_GOVERNOR_INTERVAL_SECONDS", "2")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.Equal(t, MemoryGovernor{
+			Enabled:                  true,
+			ShrinkCachesPercentage:   70,
+			FlushMemtablesPercentage: 75,
+			RejectBatchesPercentage:  95,
+			VectorCachePercentage:    30,
+			IntervalSeconds:          2,
+		}, conf.ResourceUsage.MemoryGovernor)
+		assert.Nil(t, conf.ResourceUsage.Validate())
+	})
+
+	t.Run("stages out of order", func(t *testing.T) {
+		t.Setenv("MEMORY_GOVERNOR_ENABLED", "true")
+		t.Setenv("MEMORY_GOVERNOR_SHRINK_CACHES_PERCENTAGE", "90")
+		t.Setenv("MEMORY_GOVERNOR_REJECT_BATCHES_PERCENTAGE", "85")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.ResourceUsage.Validate())
+	})
+
+	t.Run("vector cache budget above the limit", func(t *testing.T) {
+		t.Setenv("MEMORY_GOVERNOR_ENABLED", "true")
+		t.Setenv("MEMORY_GOVERNOR_VECTOR_CACHE_PERCENTAGE", "120")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+
+		assert.NotNil(t, conf.ResourceUsage.Validate())
+	})
+
+	t.Run("invalid percentage", func(t *testing.T) {
+		t.Setenv("MEMORY_GOVERNOR_FLUSH_MEMTABLES_PERCENTAGE", "high")
+		conf := Config{}
+		assert.NotNil(t, FromEnv(&conf))
+	})
+}
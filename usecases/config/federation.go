@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Federation configures remote Weaviate clusters which can be referenced
+// from local classes
+type Federation struct {
+	Peers           []FederationPeer `json:"peers" yaml:"peers"`
+	CacheTTLSeconds int              `json:"cache_ttl_seconds" yaml:"cache_ttl_seconds"`
+	// TimeoutSeconds applies to all peers which don't set their own timeout
+	TimeoutSeconds int `json:"timeout_seconds" yaml:"timeout_seconds"`
+}
+
+type FederationPeer struct {
+	Name           string `json:"name" yaml:"name"`
+	URL            string `json:"url" yaml:"url"`
+	GRPCAddress    string `json:"grpc_address" yaml:"grpc_address"`
+	TimeoutSeconds int    `json:"timeout_seconds" yaml:"timeout_seconds"`
+	APIKey         string `json:"api_key" yaml:"api_key"`
+}
+
+func (f Federation) Validate() error {
+	seen := map[string]struct{}{}
+	for _, p := range f.Peers {
+		if err := schema.ValidatePeerName(p.Name); err != nil {
+			return fmt.Errorf("federation: %w", err)
+		}
+		if p.URL == "" {
+			return fmt.Errorf("federation: peer %q has no url", p.Name)
+		}
+		if p.TimeoutSeconds < 0 {
+			return fmt.Errorf("federation: peer %q has a negative timeout", p.Name)
+		}
+		if _, ok := seen[p.Name]; ok {
+			return fmt.Errorf("federation: peer %q configured multiple times", p.Name)
+		}
+		seen[p.Name] = struct{}{}
+	}
+
+	if f.CacheTTLSeconds < 0 {
+		return fmt.Errorf("federation: cache ttl must not be negative")
+	}
+
+	if f.TimeoutSeconds < 0 {
+		return fmt.Errorf("federation: timeout must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// HintedHandoff keeps writes which replicas missed while they were
+// unreachable on the coordinating node and replays them once the replicas
+// are back. Hints older than MaxAgeSeconds are dropped, the replica repair
+// catches up on those.
+type HintedHandoff struct {
+	Enabled               bool `json:"enabled" yaml:"enabled"`
+	ReplayIntervalSeconds int  `json:"replay_interval_seconds" yaml:"replay_interval_seconds"`
+	MaxAgeSeconds         int  `json:"max_age_seconds" yaml:"max_age_seconds"`
+}
+
+func (h HintedHandoff) Validate() error {
+	if !h.Enabled {
+		return nil
+	}
+
+	if h.ReplayIntervalSeconds <= 0 {
+		return fmt.Errorf("hinted handoff: replay interval must be positive")
+	}
+
+	if h.MaxAgeSeconds <= 0 {
+		return fmt.Errorf("hinted handoff: max age must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// Jobs configures how long the state of finished long-running operations
+// (imports, shard moves, offloading, backups, ...) is kept. A finished job
+// is removed once it is older than RetentionHours or once more than
+// MaxRetained jobs have finished after it.
+type Jobs struct {
+	RetentionHours int `json:"retention_hours" yaml:"retention_hours"`
+	MaxRetained    int `json:"max_retained" yaml:"max_retained"`
+}
+
+func (j Jobs) Validate() error {
+	if j.RetentionHours < 0 {
+		return fmt.Errorf("jobs: retention must not be negative")
+	}
+	if j.MaxRetained < 0 {
+		return fmt.Errorf("jobs: max retained must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// MemoryGovernor sheds load before the node runs out of memory. The heap is
+// compared to GOMEMLIMIT, at ShrinkCachesPercentage the vector and filter
+// caches are emptied, at FlushMemtablesPercentage all memtables are flushed
+// and at RejectBatchesPercentage new batch imports are rejected until the
+// usage drops again. Without GOMEMLIMIT the governor never acts.
+//
+// With VectorCachePercentage set, the vector caches of all shards share a
+// budget of that percentage of GOMEMLIMIT, which is halved while the memory
+// is under pressure. Each shard gets a share proportional to its objects.
+type MemoryGovernor struct {
+	Enabled                  bool   `json:"enabled" yaml:"enabled"`
+	ShrinkCachesPercentage   uint64 `json:"shrink_caches_percentage" yaml:"shrink_caches_percentage"`
+	FlushMemtablesPercentage uint64 `json:"flush_memtables_percentage" yaml:"flush_memtables_percentage"`
+	RejectBatchesPercentage  uint64 `json:"reject_batches_percentage" yaml:"reject_batches_percentage"`
+	VectorCachePercentage    uint64 `json:"vector_cache_percentage" yaml:"vector_cache_percentage"`
+	IntervalSeconds          int    `json:"interval_seconds" yaml:"interval_seconds"`
+}
+
+func (m MemoryGovernor) Validate() error {
+	if !m.Enabled {
+		return nil
+	}
+
+	for name, pct := range map[string]uint64{
+		"shrink_caches_percentage":   m.ShrinkCachesPercentage,
+		"flush_memtables_percentage": m.FlushMemtablesPercentage,
+		"reject_batches_percentage":  m.RejectBatchesPercentage,
+	} {
+		if pct == 0 || pct > 100 {
+			return fmt.Errorf("memory_governor: %s must be between 1 and 100", name)
+		}
+	}
+	if m.ShrinkCachesPercentage > m.FlushMemtablesPercentage ||
+		m.FlushMemtablesPercentage > m.RejectBatchesPercentage {
+		return fmt.Errorf("memory_governor: the percentages must not decrease from " +
+			"shrinking caches over flushing memtables to rejecting batches")
+	}
+	if m.VectorCachePercentage > 100 {
+		return fmt.Errorf("memory_governor: vector_cache_percentage must not exceed 100")
+	}
+	if m.IntervalSeconds < 1 {
+		return fmt.Errorf("memory_governor: interval_seconds must be at least 1")
+	}
+
+	return nil
+}
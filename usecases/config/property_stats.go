@@ -0,0 +1,20 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+// PropertyStats keeps statistics of the values of every filterable property,
+// such as distinct counts, min/max and histograms. They are maintained when
+// segments are flushed or compacted. Enabling them on existing data computes
+// the stats of all segments at startup.
+type PropertyStats struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
@@ -0,0 +1,20 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+// QueryPlanner chooses how filtered vector searches are executed based on
+// the estimated cost of pre-filtering, post-filtering and a flat search over
+// the matching objects. If disabled, the flat search cutoff of the vector
+// index decides.
+type QueryPlanner struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// QueryResultCache keeps the results of Get queries in memory, so repeated
+// identical queries, e.g. of dashboards, skip the search. Results are
+// dropped as soon as an object of their class and tenant is written
+// through this node and expire after TTLSeconds, which bounds how long
+// writes coordinated by other nodes go unnoticed. The results of all
+// classes are cached, unless Classes names some of them.
+type QueryResultCache struct {
+	Enabled      bool     `json:"enabled" yaml:"enabled"`
+	MaxMegabytes int      `json:"max_megabytes" yaml:"max_megabytes"`
+	TTLSeconds   int      `json:"ttl_seconds" yaml:"ttl_seconds"`
+	Classes      []string `json:"classes" yaml:"classes"`
+}
+
+func (q QueryResultCache) Validate() error {
+	if !q.Enabled {
+		return nil
+	}
+
+	if q.MaxMegabytes <= 0 {
+		return fmt.Errorf("query result cache: max megabytes must be positive")
+	}
+
+	if q.TTLSeconds <= 0 {
+		return fmt.Errorf("query result cache: ttl must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// QueryVectorCache keeps the vectors of nearText and hybrid queries in
+// memory, so repeated identical queries skip the vectorizer. At most
+// MaxEntries vectors are kept, the least recently used ones are evicted
+// first and all of them expire after TTLSeconds.
+type QueryVectorCache struct {
+	Enabled    bool `json:"enabled" yaml:"enabled"`
+	MaxEntries int  `json:"max_entries" yaml:"max_entries"`
+	TTLSeconds int  `json:"ttl_seconds" yaml:"ttl_seconds"`
+}
+
+func (q QueryVectorCache) Validate() error {
+	if !q.Enabled {
+		return nil
+	}
+
+	if q.MaxEntries <= 0 {
+		return fmt.Errorf("query vector cache: max entries must be positive")
+	}
+
+	if q.TTLSeconds <= 0 {
+		return fmt.Errorf("query vector cache: ttl must be positive")
+	}
+
+	return nil
+}
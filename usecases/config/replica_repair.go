@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// maxReplicaRepairHashTreeHeight matches replica.MaxHashTreeHeight, which
+// cannot be imported here
+const maxReplicaRepairHashTreeHeight = 20
+
+// ReplicaRepair periodically compares the replicas of every shard of
+// replicated classes using hash trees and repairs objects which are missing
+// or outdated on some replicas, in addition to read repair. Copying objects
+// is limited to MaxBytesPerSecond per node, zero means unlimited.
+type ReplicaRepair struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	IntervalSeconds   int  `json:"interval_seconds" yaml:"interval_seconds"`
+	HashTreeHeight    int  `json:"hashtree_height" yaml:"hashtree_height"`
+	MaxBytesPerSecond int  `json:"max_bytes_per_second" yaml:"max_bytes_per_second"`
+}
+
+func (r ReplicaRepair) Validate() error {
+	if !r.Enabled {
+		return nil
+	}
+
+	if r.IntervalSeconds <= 0 {
+		return fmt.Errorf("replica repair: interval must be positive")
+	}
+
+	if r.HashTreeHeight < 0 || r.HashTreeHeight > maxReplicaRepairHashTreeHeight {
+		return fmt.Errorf("replica repair: hash tree height must be between 0 and %d",
+			maxReplicaRepairHashTreeHeight)
+	}
+
+	if r.MaxBytesPerSecond < 0 {
+		return fmt.Errorf("replica repair: max bytes per second must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// SegmentTiering moves cold, fully compacted LSM segments to S3-compatible
+// object storage. Reads of tiered segments are served remotely with a local
+// cache for hot blocks. An empty list of classes applies tiering to all
+// classes.
+type SegmentTiering struct {
+	Enabled              bool     `json:"enabled" yaml:"enabled"`
+	Endpoint             string   `json:"endpoint" yaml:"endpoint"`
+	Bucket               string   `json:"bucket" yaml:"bucket"`
+	Prefix               string   `json:"prefix" yaml:"prefix"`
+	UseSSL               bool     `json:"useSSL" yaml:"useSSL"`
+	MinSegmentAgeSeconds int      `json:"minSegmentAgeSeconds" yaml:"minSegmentAgeSeconds"`
+	BlockSizeKB          int      `json:"blockSizeKB" yaml:"blockSizeKB"`
+	CacheSizeMB          int      `json:"cacheSizeMB" yaml:"cacheSizeMB"`
+	Classes              []string `json:"classes" yaml:"classes"`
+}
+
+// AppliesTo returns whether segments of the class are tiered
+func (s SegmentTiering) AppliesTo(className string) bool {
+	if !s.Enabled {
+		return false
+	}
+
+	if len(s.Classes) == 0 {
+		return true
+	}
+
+	for _, class := range s.Classes {
+		if class == className {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s SegmentTiering) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.Bucket == "" {
+		return fmt.Errorf("segment tiering: bucket must be set")
+	}
+
+	if s.MinSegmentAgeSeconds <= 0 || s.BlockSizeKB <= 0 || s.CacheSizeMB <= 0 {
+		return fmt.Errorf("segment tiering: min segment age, block size " +
+			"and cache size must be positive")
+	}
+
+	if s.BlockSizeKB*1024 > s.CacheSizeMB*1024*1024 {
+		return fmt.Errorf("segment tiering: block size must not exceed the cache size")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// ShardCircuitBreaker tracks the health of every shard and marks a shard
+// degraded once too many of its reads or writes fail, take longer than
+// LatencyThresholdMilliseconds on average or its disk is full. Reads of a
+// degraded shard are served by other replicas, requests to a degraded
+// shard without replicas fail fast. After CooldownSeconds a single request
+// probes whether the shard recovered.
+type ShardCircuitBreaker struct {
+	Enabled                      bool    `json:"enabled" yaml:"enabled"`
+	ErrorRateThreshold           float64 `json:"error_rate_threshold" yaml:"error_rate_threshold"`
+	LatencyThresholdMilliseconds int     `json:"latency_threshold_milliseconds" yaml:"latency_threshold_milliseconds"`
+	MinRequests                  int     `json:"min_requests" yaml:"min_requests"`
+	WindowSeconds                int     `json:"window_seconds" yaml:"window_seconds"`
+	CooldownSeconds              int     `json:"cooldown_seconds" yaml:"cooldown_seconds"`
+}
+
+func (s ShardCircuitBreaker) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.ErrorRateThreshold <= 0 || s.ErrorRateThreshold > 1 {
+		return fmt.Errorf("shard circuit breaker: error rate threshold must be in (0, 1], got %v",
+			s.ErrorRateThreshold)
+	}
+	if s.LatencyThresholdMilliseconds < 0 {
+		return fmt.Errorf("shard circuit breaker: latency threshold must not be negative")
+	}
+	if s.MinRequests < 1 {
+		return fmt.Errorf("shard circuit breaker: min requests must be at least 1")
+	}
+	if s.WindowSeconds < 1 || s.CooldownSeconds < 1 {
+		return fmt.Errorf("shard circuit breaker: window and cooldown must be at least 1 second")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import "fmt"
+
+// SlowQueryLog logs every query which takes longer than
+// ThresholdMilliseconds together with its normalized parameters and the
+// time spent in each phase. If BufferSize is set, the most recent slow
+// queries are also kept in memory, so they can be listed through the API.
+type SlowQueryLog struct {
+	Enabled               bool `json:"enabled" yaml:"enabled"`
+	ThresholdMilliseconds int  `json:"threshold_milliseconds" yaml:"threshold_milliseconds"`
+	BufferSize            int  `json:"buffer_size" yaml:"buffer_size"`
+}
+
+func (s SlowQueryLog) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.ThresholdMilliseconds <= 0 {
+		return fmt.Errorf("slow query log: threshold must be positive")
+	}
+	if s.BufferSize < 0 {
+		return fmt.Errorf("slow query log: buffer size must not be negative")
+	}
+
+	return nil
+}
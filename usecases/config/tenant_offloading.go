@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// TenantOffloading unloads the shards of tenants which have been idle for
+// WarmAfterSeconds from memory. If ColdAfterSeconds is set, tenants idle for
+// that long are additionally moved to S3-compatible object storage and their
+// local files are removed. Offloaded tenants are reactivated on first use.
+// If Backend names a backup backend, e.g. s3, gcs or azure, cold tenants are
+// copied there instead, together with a manifest, so they can be restored on
+// any node.
+type TenantOffloading struct {
+	Enabled          bool   `json:"enabled" yaml:"enabled"`
+	WarmAfterSeconds int    `json:"warmAfterSeconds" yaml:"warmAfterSeconds"`
+	ColdAfterSeconds int    `json:"coldAfterSeconds" yaml:"coldAfterSeconds"`
+	Endpoint         string `json:"endpoint" yaml:"endpoint"`
+	Bucket           string `json:"bucket" yaml:"bucket"`
+	Prefix           string `json:"prefix" yaml:"prefix"`
+	UseSSL           bool   `json:"useSSL" yaml:"useSSL"`
+	Backend          string `json:"backend" yaml:"backend"`
+}
+
+func (t TenantOffloading) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.WarmAfterSeconds <= 0 {
+		return fmt.Errorf("tenant offloading: warm after seconds must be positive")
+	}
+
+	if t.ColdAfterSeconds < 0 {
+		return fmt.Errorf("tenant offloading: cold after seconds must not be negative")
+	}
+
+	if t.ColdAfterSeconds > 0 {
+		if t.Bucket == "" && t.Backend == "" {
+			return fmt.Errorf("tenant offloading: bucket or backend must be set " +
+				"to offload cold tenants")
+		}
+		if t.ColdAfterSeconds < t.WarmAfterSeconds {
+			return fmt.Errorf("tenant offloading: cold after seconds must not be " +
+				"smaller than warm after seconds")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,435 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+)
+
+// Change identifies an object which was written on the primary. The log
+// only tracks which objects changed, not how. The current state of the object
+// is read at shipping time, so multiple writes to the same object collapse
+// into a single operation on the standby.
+type Change struct {
+	Class  string
+	Tenant string
+	ID     strfmt.UUID
+}
+
+// Scope is the class and tenant a change belongs to
+type Scope struct {
+	Class  string `json:"class"`
+	Tenant string `json:"tenant,omitempty"`
+}
+
+func (c Change) scope() Scope {
+	return Scope{Class: c.Class, Tenant: c.Tenant}
+}
+
+// StoredChange is a change as it is persisted. Seq orders the changes, Since
+// is the time the change was recorded.
+type StoredChange struct {
+	Change
+	Seq   uint64
+	Since time.Time
+}
+
+// Resync repairs a scope on the standby by shipping all of its objects, it
+// is started for scopes whose changes were dropped. After is the last object
+// ID shipped in the current pass. Again requests another pass, as changes
+// were dropped which the current pass may have missed.
+type Resync struct {
+	Scope
+	After strfmt.UUID `json:"after,omitempty"`
+	Again bool        `json:"again,omitempty"`
+}
+
+// ChangeStore persists the change log, so changes which have not been
+// shipped survive a restart of the primary
+type ChangeStore interface {
+	Changes() ([]StoredChange, error)
+	PutChange(c StoredChange) error
+	DeleteChanges(seqs []uint64) error
+	Resyncs() ([]Resync, error)
+	PutResync(r Resync) error
+	DeleteResync(s Scope) error
+	// ParkChange moves a change the standby keeps rejecting out of the log.
+	// A change without an ID stands for the resync of its whole scope.
+	ParkChange(c StoredChange, reason string) error
+	ParkedChanges() (int, error)
+}
+
+type pendingChange struct {
+	Change
+	seq      uint64
+	since    time.Time
+	attempts int
+}
+
+func (c pendingChange) stored() StoredChange {
+	return StoredChange{Change: c.Change, Seq: c.seq, Since: c.since}
+}
+
+type resyncState struct {
+	Resync
+	// started is set once the first page of a pass was read, changes dropped
+	// from then on may be behind the position of the pass
+	started  bool
+	attempts int
+}
+
+// ChangeLog is the queue of changes which have not been shipped to the
+// standby yet. Changes are persisted when they are recorded and removed once
+// the standby applied them, after a restart changes may be shipped twice,
+// which is harmless as the current state of the object is shipped. The queue
+// is bounded, the scope of a change which does not fit is resynced instead.
+type ChangeLog struct {
+	sync.Mutex
+	maxPending int
+	store      ChangeStore
+	logger     logrus.FieldLogger
+	now        func() time.Time
+	seq        uint64
+	queue      []pendingChange
+	queued     map[Change]uint64
+	resyncs    []*resyncState
+	dropped    int64
+	parked     int
+}
+
+// NewChangeLog loads the changes and resyncs which were pending at the last
+// shutdown from the store
+func NewChangeLog(maxPending int, store ChangeStore, logger logrus.FieldLogger) (*ChangeLog, error) {
+	l := &ChangeLog{
+		maxPending: maxPending,
+		store:      store,
+		logger:     logger,
+		now:        time.Now,
+		queued:     map[Change]uint64{},
+	}
+
+	changes, err := store.Changes()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Seq < changes[j].Seq })
+
+	var duplicates []uint64
+	for _, c := range changes {
+		if c.Seq > l.seq {
+			l.seq = c.Seq
+		}
+		// a change recorded again while it was shipped is stored twice
+		if _, ok := l.queued[c.Change]; ok {
+			duplicates = append(duplicates, c.Seq)
+			continue
+		}
+		l.queued[c.Change] = c.Seq
+		l.queue = append(l.queue, pendingChange{Change: c.Change, seq: c.Seq, since: c.Since})
+	}
+	if len(duplicates) > 0 {
+		if err := store.DeleteChanges(duplicates); err != nil {
+			return nil, err
+		}
+	}
+
+	resyncs, err := store.Resyncs()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resyncs {
+		// the page read before the shutdown may have missed changes which
+		// were dropped after it
+		l.resyncs = append(l.resyncs, &resyncState{Resync: r, started: r.After != ""})
+	}
+
+	if l.parked, err = store.ParkedChanges(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Record adds the change to the queue, unless the same object is already
+// pending. If the queue is full, the scope of the change is resynced.
+func (l *ChangeLog) Record(c Change) {
+	l.Lock()
+	if _, ok := l.queued[c]; ok {
+		l.Unlock()
+		return
+	}
+	if len(l.queue) >= l.maxPending {
+		l.dropped++
+		l.markResync(c.scope())
+		l.Unlock()
+		return
+	}
+
+	l.seq++
+	pc := pendingChange{Change: c, seq: l.seq, since: l.now()}
+	l.queued[c] = pc.seq
+	l.queue = append(l.queue, pc)
+	l.Unlock()
+
+	// the store batches concurrent writes, so it must not be called with
+	// the lock held
+	if err := l.store.PutChange(pc.stored()); err != nil {
+		l.logger.WithField("action", "cross_cluster_replication_record").
+			WithError(err).
+			Error("persisting change failed, it is lost if the node restarts before it is shipped")
+	}
+}
+
+// markResync must be called with the lock held
+func (l *ChangeLog) markResync(scope Scope) {
+	for _, r := range l.resyncs {
+		if r.Scope != scope {
+			continue
+		}
+		if r.started && !r.Again {
+			r.Again = true
+			l.persistResync(r.Resync)
+		}
+		return
+	}
+
+	r := &resyncState{Resync: Resync{Scope: scope}}
+	l.resyncs = append(l.resyncs, r)
+	l.persistResync(r.Resync)
+	l.logger.WithField("action", "cross_cluster_replication_record").
+		WithField("class", scope.Class).
+		WithField("tenant", scope.Tenant).
+		Warn("change log is full, the class will be resynced to the standby")
+}
+
+func (l *ChangeLog) persistResync(r Resync) {
+	if err := l.store.PutResync(r); err != nil {
+		l.logger.WithField("action", "cross_cluster_replication_resync").
+			WithError(err).
+			Error("persisting resync failed")
+	}
+}
+
+// take removes up to max changes from the front of the queue. They stay in
+// the store until they are acknowledged.
+func (l *ChangeLog) take(max int) []pendingChange {
+	l.Lock()
+	defer l.Unlock()
+
+	if max > len(l.queue) {
+		max = len(l.queue)
+	}
+
+	out := make([]pendingChange, max)
+	copy(out, l.queue[:max])
+	l.queue = l.queue[max:]
+	for _, c := range out {
+		delete(l.queued, c.Change)
+	}
+
+	return out
+}
+
+// ack removes shipped changes from the store
+func (l *ChangeLog) ack(changes []pendingChange) {
+	seqs := make([]uint64, len(changes))
+	for i, c := range changes {
+		seqs[i] = c.seq
+	}
+	l.deleteStored(seqs)
+}
+
+func (l *ChangeLog) deleteStored(seqs []uint64) {
+	if len(seqs) == 0 {
+		return
+	}
+	if err := l.store.DeleteChanges(seqs); err != nil {
+		l.logger.WithField("action", "cross_cluster_replication_ack").
+			WithError(err).
+			Warn("removing shipped changes failed, they are shipped again after a restart")
+	}
+}
+
+// requeue puts changes which could not be shipped back to the front of the
+// queue. Changes which were recorded again in the meantime keep their
+// original timestamp, so the lag is not underreported.
+func (l *ChangeLog) requeue(changes []pendingChange) {
+	l.Lock()
+	front := make([]pendingChange, 0, len(changes))
+	var replaced []uint64
+	for _, c := range changes {
+		if seq, ok := l.queued[c.Change]; ok {
+			l.removeQueued(c.Change)
+			replaced = append(replaced, seq)
+		}
+		l.queued[c.Change] = c.seq
+		front = append(front, c)
+	}
+
+	l.queue = append(front, l.queue...)
+	l.Unlock()
+
+	l.deleteStored(replaced)
+}
+
+// removeQueued must be called with the lock held
+func (l *ChangeLog) removeQueued(c Change) {
+	for i := range l.queue {
+		if l.queue[i].Change == c {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			break
+		}
+	}
+	delete(l.queued, c)
+}
+
+// park moves a change out of the log for good
+func (l *ChangeLog) park(c pendingChange, reason error) {
+	l.Lock()
+	l.parked++
+	l.Unlock()
+
+	if err := l.store.ParkChange(c.stored(), reason.Error()); err != nil {
+		l.logger.WithField("action", "cross_cluster_replication_park").
+			WithError(err).
+			Error("persisting parked change failed")
+	}
+}
+
+// nextResync returns the oldest resync and marks its pass as started
+func (l *ChangeLog) nextResync() (Resync, bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.resyncs) == 0 {
+		return Resync{}, false
+	}
+
+	l.resyncs[0].started = true
+	return l.resyncs[0].Resync, true
+}
+
+// resyncShipped records the progress of a resync. After the final page the
+// resync ends, unless changes were dropped during the pass.
+func (l *ChangeLog) resyncShipped(scope Scope, last strfmt.UUID, final bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	i, r := l.resync(scope)
+	if r == nil {
+		return
+	}
+
+	r.attempts = 0
+	switch {
+	case !final:
+		r.After = last
+	case r.Again:
+		r.After, r.Again = "", false
+	default:
+		l.resyncs = append(l.resyncs[:i], l.resyncs[i+1:]...)
+		if err := l.store.DeleteResync(scope); err != nil {
+			l.logger.WithField("action", "cross_cluster_replication_resync").
+				WithError(err).
+				Warn("removing finished resync failed, it is repeated after a restart")
+		}
+		return
+	}
+	l.persistResync(r.Resync)
+}
+
+// resyncFailed counts a rejected page of a resync and returns true if the
+// resync was parked, as the standby rejected it too often
+func (l *ChangeLog) resyncFailed(scope Scope, reason error) bool {
+	l.Lock()
+	i, r := l.resync(scope)
+	if r == nil {
+		l.Unlock()
+		return false
+	}
+	r.attempts++
+	if r.attempts < maxShipAttempts {
+		l.Unlock()
+		return false
+	}
+	l.resyncs = append(l.resyncs[:i], l.resyncs[i+1:]...)
+	l.Unlock()
+
+	if err := l.store.DeleteResync(scope); err != nil {
+		l.logger.WithField("action", "cross_cluster_replication_resync").
+			WithError(err).
+			Warn("removing parked resync failed, it is repeated after a restart")
+	}
+	l.park(pendingChange{Change: Change{Class: scope.Class, Tenant: scope.Tenant}, since: l.now()}, reason)
+	return true
+}
+
+// resync must be called with the lock held
+func (l *ChangeLog) resync(scope Scope) (int, *resyncState) {
+	for i, r := range l.resyncs {
+		if r.Scope == scope {
+			return i, r
+		}
+	}
+	return -1, nil
+}
+
+// Pending returns the number of changes not shipped yet
+func (l *ChangeLog) Pending() int {
+	l.Lock()
+	defer l.Unlock()
+
+	return len(l.queue)
+}
+
+// Dropped returns the number of changes which did not fit into the queue
+// since the start of this node. Their scopes are resynced.
+func (l *ChangeLog) Dropped() int64 {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.dropped
+}
+
+// Resyncs returns the number of scopes which still need to be resynced
+func (l *ChangeLog) Resyncs() int {
+	l.Lock()
+	defer l.Unlock()
+
+	return len(l.resyncs)
+}
+
+// Parked returns the number of changes the standby rejected too often. They
+// are no longer shipped and need to be repaired by hand.
+func (l *ChangeLog) Parked() int {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.parked
+}
+
+// Lag is the age of the oldest pending change, zero if there is none
+func (l *ChangeLog) Lag() time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.queue) == 0 {
+		return 0
+	}
+
+	return l.now().Sub(l.queue[0].since)
+}
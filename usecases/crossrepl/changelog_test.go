@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLog(t *testing.T, maxPending int, store *fakeChangeStore) *ChangeLog {
+	logger, _ := test.NewNullLogger()
+	l, err := NewChangeLog(maxPending, store, logger)
+	require.Nil(t, err)
+	return l
+}
+
+func TestChangeLog(t *testing.T) {
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	a := Change{Class: "Article", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01"}
+	b := Change{Class: "Article", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"}
+	c := Change{Class: "Article", Tenant: "t1", ID: "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02"}
+
+	t.Run("repeated changes collapse", func(t *testing.T) {
+		l := newTestLog(t, 10, newFakeChangeStore())
+		l.now = clock
+		l.Record(a)
+		l.Record(b)
+		l.Record(a)
+		l.Record(c)
+
+		assert.Equal(t, 3, l.Pending())
+		taken := l.take(2)
+		require.Len(t, taken, 2)
+		assert.Equal(t, a, taken[0].Change)
+		assert.Equal(t, b, taken[1].Change)
+		assert.Equal(t, 1, l.Pending())
+	})
+
+	t.Run("full log resyncs the scope of dropped changes", func(t *testing.T) {
+		store := newFakeChangeStore()
+		l := newTestLog(t, 1, store)
+		l.Record(a)
+		l.Record(b)
+		l.Record(c)
+
+		assert.Equal(t, 1, l.Pending())
+		assert.Equal(t, int64(2), l.Dropped())
+		assert.Equal(t, 2, l.Resyncs())
+		assert.ElementsMatch(t, []Resync{{Scope: Scope{Class: "Article"}}, {Scope: Scope{Class: "Article", Tenant: "t1"}}},
+			store.resyncList())
+	})
+
+	t.Run("changes dropped during a resync request another pass", func(t *testing.T) {
+		l := newTestLog(t, 0, newFakeChangeStore())
+		l.Record(a)
+		r, ok := l.nextResync()
+		require.True(t, ok)
+		assert.Equal(t, Scope{Class: "Article"}, r.Scope)
+
+		l.resyncShipped(r.Scope, a.ID, false)
+		l.Record(b)
+		l.resyncShipped(r.Scope, b.ID, true)
+		r, ok = l.nextResync()
+		require.True(t, ok)
+		assert.Equal(t, Resync{Scope: Scope{Class: "Article"}}, r, "second pass starts over")
+
+		l.resyncShipped(r.Scope, "", true)
+		_, ok = l.nextResync()
+		assert.False(t, ok)
+	})
+
+	t.Run("requeue keeps the original order and age", func(t *testing.T) {
+		l := newTestLog(t, 10, newFakeChangeStore())
+		l.now = clock
+		l.Record(a)
+		l.Record(b)
+		taken := l.take(2)
+
+		now = now.Add(time.Minute)
+		l.Record(c)
+		l.Record(b)
+		l.requeue(taken)
+
+		assert.Equal(t, 3, l.Pending())
+		assert.Equal(t, time.Minute, l.Lag())
+		order := l.take(3)
+		assert.Equal(t, []Change{a, b, c},
+			[]Change{order[0].Change, order[1].Change, order[2].Change})
+		assert.Equal(t, time.Duration(0), l.Lag())
+	})
+
+	t.Run("unshipped changes survive a restart", func(t *testing.T) {
+		store := newFakeChangeStore()
+		l := newTestLog(t, 10, store)
+		l.now = clock
+		l.Record(a)
+		l.Record(b)
+		l.ack(l.take(1))
+		taken := l.take(1)
+		l.Record(b)
+		l.Record(c)
+		l.park(taken[0], assert.AnError)
+
+		restarted := newTestLog(t, 10, store)
+		assert.Equal(t, 2, restarted.Pending())
+		assert.Equal(t, 1, restarted.Parked())
+		order := restarted.take(2)
+		assert.Equal(t, []Change{b, c}, []Change{order[0].Change, order[1].Change})
+
+		restarted.Record(a)
+		assert.Greater(t, restarted.take(1)[0].seq, order[1].seq)
+	})
+}
+
+type fakeChangeStore struct {
+	sync.Mutex
+	changes map[uint64]StoredChange
+	resyncs map[Scope]Resync
+	parked  []StoredChange
+}
+
+func newFakeChangeStore() *fakeChangeStore {
+	return &fakeChangeStore{
+		changes: map[uint64]StoredChange{},
+		resyncs: map[Scope]Resync{},
+	}
+}
+
+func (s *fakeChangeStore) Changes() ([]StoredChange, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	out := make([]StoredChange, 0, len(s.changes))
+	for _, c := range s.changes {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Seq < out[j].Seq })
+	return out, nil
+}
+
+func (s *fakeChangeStore) PutChange(c StoredChange) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.changes[c.Seq] = c
+	return nil
+}
+
+func (s *fakeChangeStore) DeleteChanges(seqs []uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, seq := range seqs {
+		delete(s.changes, seq)
+	}
+	return nil
+}
+
+func (s *fakeChangeStore) Resyncs() ([]Resync, error) {
+	return s.resyncList(), nil
+}
+
+func (s *fakeChangeStore) resyncList() []Resync {
+	s.Lock()
+	defer s.Unlock()
+
+	out := make([]Resync, 0, len(s.resyncs))
+	for _, r := range s.resyncs {
+		out = append(out, r)
+	}
+	return out
+}
+
+func (s *fakeChangeStore) PutResync(r Resync) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.resyncs[r.Scope] = r
+	return nil
+}
+
+func (s *fakeChangeStore) DeleteResync(scope Scope) error {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.resyncs, scope)
+	return nil
+}
+
+func (s *fakeChangeStore) ParkChange(c StoredChange, reason string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.parked = append(s.parked, c)
+	delete(s.changes, c.Seq)
+	return nil
+}
+
+func (s *fakeChangeStore) ParkedChanges() (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	return len(s.parked), nil
+}
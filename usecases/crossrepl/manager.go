@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// ErrStandby is returned for user writes while the cluster is a standby
+var ErrStandby = errors.New("cluster is a read-only cross-cluster replication standby, " +
+	"promote it to accept writes")
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Repo is used by the standby to apply shipped operations
+type Repo interface {
+	PutObject(ctx context.Context, obj *models.Object, vector []float32,
+		repl *additional.ReplicationProperties) error
+	DeleteObject(ctx context.Context, class string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) error
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+}
+
+// syncPageSize is the number of objects the standby reads at once when it
+// applies a sync operation
+const syncPageSize = 1000
+
+// Status is the externally visible state of cross-cluster replication
+type Status struct {
+	Role           string     `json:"role"`
+	PendingChanges int        `json:"pendingChanges"`
+	DroppedChanges int64      `json:"droppedChanges"`
+	ResyncScopes   int        `json:"resyncScopes"`
+	ParkedChanges  int        `json:"parkedChanges"`
+	LagSeconds     float64    `json:"lagSeconds"`
+	LastShippedAt  *time.Time `json:"lastShippedAt,omitempty"`
+	LastAppliedAt  *time.Time `json:"lastAppliedAt,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	PromotedAt     *time.Time `json:"promotedAt,omitempty"`
+}
+
+// Manager tracks the role of this cluster. On the primary it records
+// changes and ships them to the standby, on the standby it applies shipped
+// operations and rejects user writes until it is promoted.
+type Manager struct {
+	log        *ChangeLog
+	shipper    *Shipper
+	repo       Repo
+	schema     schemaGetter
+	config     *config.WeaviateConfig
+	authorizer authorizer
+	logger     logrus.FieldLogger
+
+	sync.RWMutex
+	role        string
+	lastApplied time.Time
+	promotedAt  time.Time
+}
+
+// NewManager creates a manager for the given role. The shipper may be nil,
+// e.g. on a standby which has nowhere to ship to after being promoted.
+func NewManager(role string, log *ChangeLog, shipper *Shipper, repo Repo,
+	schemaGetter schemaGetter, config *config.WeaviateConfig,
+	authorizer authorizer, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		role:       role,
+		log:        log,
+		shipper:    shipper,
+		repo:       repo,
+		schema:     schemaGetter,
+		config:     config,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// Start begins shipping if this cluster is the primary
+func (m *Manager) Start() {
+	if m.Role() == config.CrossClusterReplicationRolePrimary && m.shipper != nil {
+		m.shipper.Start()
+	}
+}
+
+func (m *Manager) Shutdown() {
+	if m.shipper != nil {
+		m.shipper.Stop()
+	}
+}
+
+func (m *Manager) Role() string {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.role
+}
+
+// RecordChange is called by the storage layer for every object written
+// through this node. Changes are only recorded on the primary.
+func (m *Manager) RecordChange(class, tenant string, id strfmt.UUID) {
+	if m.Role() != config.CrossClusterReplicationRolePrimary || m.shipper == nil {
+		return
+	}
+
+	m.log.Record(Change{Class: class, Tenant: tenant, ID: id})
+}
+
+// Writable returns ErrStandby if user writes need to be rejected
+func (m *Manager) Writable() error {
+	if m.Role() == config.CrossClusterReplicationRoleStandby {
+		return ErrStandby
+	}
+
+	return nil
+}
+
+func (m *Manager) Status(principal *models.Principal) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "get", "replication/cross-cluster"); err != nil {
+		return nil, err
+	}
+
+	return m.status(), nil
+}
+
+// Promote turns a standby into a primary. This is a one-way operation, the
+// former primary must be reconfigured as a standby before it can rejoin.
+func (m *Manager) Promote(principal *models.Principal) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "update", "replication/cross-cluster"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	if m.role != config.CrossClusterReplicationRoleStandby {
+		role := m.role
+		m.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("only a standby can be promoted, this cluster is %q", role))
+	}
+	m.role = config.CrossClusterReplicationRolePrimary
+	m.promotedAt = time.Now()
+	m.Unlock()
+
+	m.logger.WithField("action", "cross_cluster_replication_promote").
+		Warn("standby promoted to primary, accepting writes")
+
+	m.Start()
+	return m.status(), nil
+}
+
+// Apply writes operations shipped by the primary. Objects are stored as they
+// were on the primary: vectors are not recomputed and references are not
+// checked, as their targets may simply not have been shipped yet.
+func (m *Manager) Apply(ctx context.Context, principal *models.Principal, ops []Op) error {
+	if err := m.authorizer.Authorize(principal, "update", "replication/cross-cluster/apply"); err != nil {
+		return err
+	}
+
+	if role := m.Role(); role != config.CrossClusterReplicationRoleStandby {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("only a standby accepts shipped changes, this cluster is %q", role))
+	}
+
+	sch := m.schema.GetSchemaSkipAuth()
+	for i, op := range ops {
+		if err := m.applyOne(ctx, sch, op); err != nil {
+			return fmt.Errorf("op %d (%s %s/%s): %w", i, op.Type, op.Class, op.ID, err)
+		}
+	}
+
+	m.Lock()
+	m.lastApplied = time.Now()
+	m.Unlock()
+	return nil
+}
+
+func (m *Manager) applyOne(ctx context.Context, sch schema.Schema, op Op) error {
+	switch op.Type {
+	case OpDelete:
+		return m.repo.DeleteObject(ctx, op.Class, op.ID, nil, op.Tenant)
+	case OpPut:
+		if op.Object == nil {
+			return enterrors.NewErrUnprocessable(fmt.Errorf("put without object"))
+		}
+		class := sch.GetClass(schema.ClassName(op.Class))
+		if class == nil {
+			return enterrors.NewErrUnprocessable(
+				fmt.Errorf("class %q does not exist on the standby", op.Class))
+		}
+
+		obj := op.Object
+		obj.Class, obj.ID, obj.Tenant, obj.Additional = op.Class, op.ID, op.Tenant, nil
+		// the validator brings the json-decoded properties back into their
+		// typed form
+		v := validation.New(trustExists, m.config, nil).WithRemoteRefs(trustRemoteExists)
+		if err := v.Object(ctx, class, obj, nil); err != nil {
+			return enterrors.NewErrUnprocessable(err)
+		}
+
+		return m.repo.PutObject(ctx, obj, obj.Vector, nil)
+	case OpSync:
+		return m.applySync(ctx, op)
+	default:
+		return enterrors.NewErrUnprocessable(fmt.Errorf("unknown op type %q", op.Type))
+	}
+}
+
+// applySync deletes the objects in the range of a resync page which the
+// primary did not ship, as they were deleted on the primary
+func (m *Manager) applySync(ctx context.Context, op Op) error {
+	if !op.Final && len(op.IDs) == 0 {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("sync without ids"))
+	}
+
+	keep := make(map[strfmt.UUID]struct{}, len(op.IDs))
+	for _, id := range op.IDs {
+		keep[id] = struct{}{}
+	}
+
+	after := op.After
+	for {
+		res, qerr := m.repo.Query(ctx, &objects.QueryInput{
+			Class:  op.Class,
+			Tenant: op.Tenant,
+			Limit:  syncPageSize,
+			Cursor: &filters.Cursor{After: after.String(), Limit: syncPageSize},
+		})
+		if qerr != nil {
+			if qerr.NotFound() || qerr.UnprocessableEntity() {
+				return enterrors.NewErrUnprocessable(qerr)
+			}
+			return qerr
+		}
+
+		for _, obj := range res {
+			if !op.Final && idAfter(obj.ID, op.IDs[len(op.IDs)-1]) {
+				return nil
+			}
+			if _, ok := keep[obj.ID]; ok {
+				continue
+			}
+			if err := m.repo.DeleteObject(ctx, op.Class, obj.ID, nil, op.Tenant); err != nil {
+				return err
+			}
+		}
+
+		if len(res) < syncPageSize {
+			return nil
+		}
+		after = res[len(res)-1].ID
+	}
+}
+
+// idAfter compares object IDs in the order the cursor api returns them
+func idAfter(id, after strfmt.UUID) bool {
+	return strings.ToLower(id.String()) > strings.ToLower(after.String())
+}
+
+func (m *Manager) status() *Status {
+	m.RLock()
+	s := &Status{Role: m.role}
+	if !m.lastApplied.IsZero() {
+		t := m.lastApplied
+		s.LastAppliedAt = &t
+	}
+	if !m.promotedAt.IsZero() {
+		t := m.promotedAt
+		s.PromotedAt = &t
+	}
+	m.RUnlock()
+
+	s.PendingChanges = m.log.Pending()
+	s.DroppedChanges = m.log.Dropped()
+	s.ResyncScopes = m.log.Resyncs()
+	s.ParkedChanges = m.log.Parked()
+	s.LagSeconds = m.log.Lag().Seconds()
+	if m.shipper != nil {
+		lastShipped, lastErr := m.shipper.state()
+		if !lastShipped.IsZero() {
+			s.LastShippedAt = &lastShipped
+		}
+		if lastErr != nil {
+			s.LastError = lastErr.Error()
+		}
+	}
+
+	return s
+}
+
+func trustExists(context.Context, string, strfmt.UUID,
+	*additional.ReplicationProperties, string,
+) (bool, error) {
+	return true, nil
+}
+
+func trustRemoteExists(context.Context, *crossref.Ref) (bool, error) {
+	return true, nil
+}
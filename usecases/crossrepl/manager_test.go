@@ -0,0 +1,379 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestPrimaryShipsChanges(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	id1 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01")
+	id2 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02")
+
+	reader := &fakeReader{objects: map[strfmt.UUID]*search.Result{
+		id1: {ClassName: "Article", ID: id1, Vector: []float32{1, 2},
+			Schema: map[string]interface{}{"title": "hello"}},
+	}}
+	standby := &fakeStandby{}
+	log := newTestLog(t, 100, newFakeChangeStore())
+	shipper := NewShipper(log, reader, standby, 0, 10, logger, NewMetrics(nil))
+	m := NewManager(config.CrossClusterReplicationRolePrimary, log, shipper,
+		nil, nil, nil, &fakeAuthorizer{}, logger)
+
+	m.RecordChange("Article", "", id1)
+	m.RecordChange("Article", "", id2)
+	assert.Nil(t, m.Writable())
+
+	t.Run("failed batches are retried", func(t *testing.T) {
+		standby.err = errors.New("standby unreachable")
+		_, err := shipper.ShipOnce(context.Background())
+		require.NotNil(t, err)
+
+		status, err := m.Status(nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, status.PendingChanges)
+		assert.Equal(t, "standby unreachable", status.LastError)
+	})
+
+	t.Run("puts existing, deletes missing objects", func(t *testing.T) {
+		standby.err = nil
+		n, err := shipper.ShipOnce(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, 2, n)
+
+		require.Len(t, standby.ops, 2)
+		assert.Equal(t, OpPut, standby.ops[0].Type)
+		assert.Equal(t, []float32{1, 2}, []float32(standby.ops[0].Object.Vector))
+		assert.Equal(t, Op{Type: OpDelete, Class: "Article", ID: id2}, standby.ops[1])
+
+		status, err := m.Status(nil)
+		require.Nil(t, err)
+		assert.Equal(t, 0, status.PendingChanges)
+		assert.NotNil(t, status.LastShippedAt)
+		assert.Empty(t, status.LastError)
+	})
+
+	t.Run("primary can't be promoted or applied to", func(t *testing.T) {
+		_, err := m.Promote(nil)
+		assert.NotNil(t, err)
+		assert.NotNil(t, m.Apply(context.Background(), nil, nil))
+	})
+}
+
+func TestStandbyAppliesAndPromotes(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	id := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01")
+	repo := &fakeRepo{}
+	sg := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class:      "Article",
+			Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+		}},
+	}}}
+	m := NewManager(config.CrossClusterReplicationRoleStandby, newTestLog(t, 100, newFakeChangeStore()), nil,
+		repo, sg, &config.WeaviateConfig{}, &fakeAuthorizer{}, logger)
+
+	assert.Equal(t, ErrStandby, m.Writable())
+
+	m.RecordChange("Article", "", id)
+	status, err := m.Status(nil)
+	require.Nil(t, err)
+	assert.Equal(t, 0, status.PendingChanges, "standby must not record changes")
+
+	t.Run("apply", func(t *testing.T) {
+		err := m.Apply(context.Background(), nil, []Op{
+			{Type: OpPut, Class: "Article", ID: id, Object: &models.Object{
+				Properties: map[string]interface{}{"title": "hello"},
+				Vector:     []float32{1, 2},
+			}},
+			{Type: OpDelete, Class: "Article", ID: id},
+		})
+		require.Nil(t, err)
+
+		require.Len(t, repo.put, 1)
+		assert.Equal(t, "Article", repo.put[0].Class)
+		assert.Equal(t, id, repo.put[0].ID)
+		assert.Equal(t, []strfmt.UUID{id}, repo.deleted)
+	})
+
+	t.Run("apply to unknown class", func(t *testing.T) {
+		err := m.Apply(context.Background(), nil, []Op{
+			{Type: OpPut, Class: "Unknown", ID: id, Object: &models.Object{}},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("promote", func(t *testing.T) {
+		status, err := m.Promote(nil)
+		require.Nil(t, err)
+		assert.Equal(t, config.CrossClusterReplicationRolePrimary, status.Role)
+		assert.NotNil(t, status.PromotedAt)
+		assert.Nil(t, m.Writable())
+
+		_, err = m.Promote(nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRejectedChangesAreParked(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	id1 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01")
+	id2 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02")
+
+	reader := &fakeReader{objects: map[strfmt.UUID]*search.Result{
+		id1: {ClassName: "Article", ID: id1},
+		id2: {ClassName: "Article", ID: id2},
+	}}
+	standby := &fakeStandby{reject: id1}
+	log := newTestLog(t, 100, newFakeChangeStore())
+	shipper := NewShipper(log, reader, standby, 0, 10, logger, NewMetrics(nil))
+	m := NewManager(config.CrossClusterReplicationRolePrimary, log, shipper,
+		nil, nil, nil, &fakeAuthorizer{}, logger)
+
+	m.RecordChange("Article", "", id1)
+	m.RecordChange("Article", "", id2)
+
+	t.Run("a rejected change does not hold up the batch", func(t *testing.T) {
+		n, err := shipper.ShipOnce(context.Background())
+		assert.ErrorIs(t, err, ErrRejected)
+		assert.Equal(t, 1, n)
+		require.Len(t, standby.ops, 1)
+		assert.Equal(t, id2, standby.ops[0].ID)
+		assert.Equal(t, 1, log.Pending())
+	})
+
+	t.Run("unavailable standby does not count as rejection", func(t *testing.T) {
+		standby.err = errors.New("standby unreachable")
+		for i := 0; i < 2*maxShipAttempts; i++ {
+			_, err := shipper.ShipOnce(context.Background())
+			require.NotNil(t, err)
+		}
+		assert.Equal(t, 1, log.Pending())
+		standby.err = nil
+	})
+
+	t.Run("change is parked after repeated rejections", func(t *testing.T) {
+		for i := 1; i < maxShipAttempts; i++ {
+			_, err := shipper.ShipOnce(context.Background())
+			assert.ErrorIs(t, err, ErrRejected)
+		}
+
+		status, err := m.Status(nil)
+		require.Nil(t, err)
+		assert.Equal(t, 0, status.PendingChanges)
+		assert.Equal(t, 1, status.ParkedChanges)
+	})
+}
+
+func TestDroppedChangesAreResynced(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	id1 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01")
+	id2 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02")
+	id3 := strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03")
+
+	reader := &fakeReader{objects: map[strfmt.UUID]*search.Result{
+		id1: {ClassName: "Article", ID: id1},
+		id3: {ClassName: "Article", ID: id3},
+	}}
+	standby := &fakeStandby{}
+	log := newTestLog(t, 0, newFakeChangeStore())
+	shipper := NewShipper(log, reader, standby, 0, 1, logger, NewMetrics(nil))
+	m := NewManager(config.CrossClusterReplicationRolePrimary, log, shipper,
+		nil, nil, nil, &fakeAuthorizer{}, logger)
+
+	m.RecordChange("Article", "", id2)
+	status, err := m.Status(nil)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), status.DroppedChanges)
+	assert.Equal(t, 1, status.ResyncScopes)
+
+	for {
+		n, err := shipper.ShipOnce(context.Background())
+		require.Nil(t, err)
+		if n == 0 {
+			break
+		}
+	}
+
+	assert.Equal(t, []Op{
+		{Type: OpPut, Class: "Article", ID: id1, Object: standby.ops[0].Object},
+		{Type: OpSync, Class: "Article", IDs: []strfmt.UUID{id1}},
+		{Type: OpPut, Class: "Article", ID: id3, Object: standby.ops[2].Object},
+		{Type: OpSync, Class: "Article", After: id1, IDs: []strfmt.UUID{id3}},
+		{Type: OpSync, Class: "Article", After: id3, IDs: []strfmt.UUID{}, Final: true},
+	}, standby.ops)
+	assert.Equal(t, 0, log.Resyncs())
+}
+
+func TestStandbyAppliesSync(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ids := []strfmt.UUID{
+		"8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01",
+		"8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02",
+		"8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03",
+		"8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d04",
+	}
+	repo := &fakeRepo{stored: ids}
+	m := NewManager(config.CrossClusterReplicationRoleStandby, newTestLog(t, 1, newFakeChangeStore()), nil,
+		repo, &fakeSchemaGetter{}, &config.WeaviateConfig{}, &fakeAuthorizer{}, logger)
+
+	err := m.Apply(context.Background(), nil, []Op{
+		{Type: OpSync, Class: "Article", After: ids[0], IDs: []strfmt.UUID{ids[2]}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{ids[1]}, repo.deleted, "only the range of the page is synced")
+
+	err = m.Apply(context.Background(), nil, []Op{
+		{Type: OpSync, Class: "Article", After: ids[2], Final: true},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []strfmt.UUID{ids[1], ids[3]}, repo.deleted)
+}
+
+func TestStatusIsAuthorized(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m := NewManager(config.CrossClusterReplicationRoleStandby, newTestLog(t, 1, newFakeChangeStore()), nil,
+		nil, nil, nil, &fakeAuthorizer{err: errors.New("forbidden")}, logger)
+
+	_, err := m.Status(nil)
+	assert.NotNil(t, err)
+	_, err = m.Promote(nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrStandby, m.Writable())
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeReader struct {
+	objects map[strfmt.UUID]*search.Result
+}
+
+func (r *fakeReader) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, addl additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	return r.objects[id], nil
+}
+
+func (r *fakeReader) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	ids := make([]strfmt.UUID, 0, len(r.objects))
+	for id := range r.objects {
+		ids = append(ids, id)
+	}
+	var out search.Results
+	for _, id := range page(ids, q) {
+		out = append(out, *r.objects[id])
+	}
+	return out, nil
+}
+
+// page returns the ids a cursor query returns
+func page(ids []strfmt.UUID, q *objects.QueryInput) []strfmt.UUID {
+	sorted := append([]strfmt.UUID{}, ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var out []strfmt.UUID
+	for _, id := range sorted {
+		if id > strfmt.UUID(q.Cursor.After) && len(out) < q.Cursor.Limit {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+type fakeStandby struct {
+	err    error
+	reject strfmt.UUID
+	ops    []Op
+}
+
+func (s *fakeStandby) Apply(ctx context.Context, ops []Op) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, op := range ops {
+		if s.reject != "" && op.ID == s.reject {
+			return fmt.Errorf("%w: invalid object", ErrRejected)
+		}
+	}
+	s.ops = append(s.ops, ops...)
+	return nil
+}
+
+type fakeRepo struct {
+	put     []*models.Object
+	stored  []strfmt.UUID
+	deleted []strfmt.UUID
+}
+
+func (r *fakeRepo) PutObject(ctx context.Context, obj *models.Object, vector []float32,
+	repl *additional.ReplicationProperties,
+) error {
+	r.put = append(r.put, obj)
+	return nil
+}
+
+func (r *fakeRepo) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *fakeRepo) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	var existing []strfmt.UUID
+	for _, id := range r.stored {
+		deleted := false
+		for _, d := range r.deleted {
+			deleted = deleted || d == id
+		}
+		if !deleted {
+			existing = append(existing, id)
+		}
+	}
+
+	var out search.Results
+	for _, id := range page(existing, q) {
+		out = append(out, search.Result{ClassName: q.Class, ID: id})
+	}
+	return out, nil
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type Metrics struct {
+	enabled   bool
+	pending   prometheus.Gauge
+	lag       prometheus.Gauge
+	succeeded prometheus.Counter
+	failed    prometheus.Counter
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
+	if prom == nil {
+		return &Metrics{enabled: false}
+	}
+
+	return &Metrics{
+		enabled: true,
+		pending: prom.CrossClusterReplicationPending,
+		lag:     prom.CrossClusterReplicationLag,
+		succeeded: prom.CrossClusterReplicationShipped.With(prometheus.Labels{
+			"result": "success",
+		}),
+		failed: prom.CrossClusterReplicationShipped.With(prometheus.Labels{
+			"result": "failure",
+		}),
+	}
+}
+
+func (m *Metrics) observeLog(log *ChangeLog) {
+	if !m.enabled {
+		return
+	}
+
+	m.pending.Set(float64(log.Pending()))
+	m.lag.Set(log.Lag().Seconds())
+}
+
+func (m *Metrics) shipped(success bool, count int) {
+	if !m.enabled {
+		return
+	}
+
+	if success {
+		m.succeeded.Add(float64(count))
+	} else {
+		m.failed.Add(float64(count))
+	}
+}
@@ -0,0 +1,379 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crossrepl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type OpType string
+
+const (
+	OpPut    OpType = "put"
+	OpDelete OpType = "delete"
+	// OpSync is shipped by a resync after a page of puts. The standby
+	// deletes all objects of the scope in the page's ID range which are not
+	// listed in IDs.
+	OpSync OpType = "sync"
+)
+
+// maxShipAttempts is how often a change is shipped before it is parked, if
+// the standby keeps rejecting it
+const maxShipAttempts = 5
+
+// ErrRejected is wrapped by StandbyClient errors if the standby refused to
+// apply the operations, shipping them again will fail the same way
+var ErrRejected = errors.New("rejected by standby")
+
+// errUnreadable wraps errors reading a change on the primary, e.g. because
+// its tenant was deleted. Such changes are parked like rejected ones.
+var errUnreadable = errors.New("unreadable on primary")
+
+// Op is the unit shipped to the standby. Put operations carry the complete
+// object including its vector, so the standby does not need to vectorize.
+// The range of a sync operation starts after After and ends with the last
+// of IDs, or at the end of the scope if Final is set.
+type Op struct {
+	Type   OpType         `json:"type"`
+	Class  string         `json:"class"`
+	Tenant string         `json:"tenant,omitempty"`
+	ID     strfmt.UUID    `json:"id,omitempty"`
+	Object *models.Object `json:"object,omitempty"`
+	After  strfmt.UUID    `json:"after,omitempty"`
+	IDs    []strfmt.UUID  `json:"ids,omitempty"`
+	Final  bool           `json:"final,omitempty"`
+}
+
+// ObjectReader reads the current state of objects on the primary
+type ObjectReader interface {
+	Object(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+}
+
+// StandbyClient sends operations to the standby cluster
+type StandbyClient interface {
+	Apply(ctx context.Context, ops []Op) error
+}
+
+// Shipper periodically drains the change log and applies the changes on the
+// standby. Failed batches are put back into the log and retried on the next
+// tick. Once the log is empty, scopes whose changes were dropped are
+// resynced page by page.
+type Shipper struct {
+	log       *ChangeLog
+	reader    ObjectReader
+	client    StandbyClient
+	interval  time.Duration
+	batchSize int
+	logger    logrus.FieldLogger
+	metrics   *Metrics
+
+	sync.Mutex
+	lastShipped time.Time
+	lastErr     error
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func NewShipper(log *ChangeLog, reader ObjectReader, client StandbyClient,
+	interval time.Duration, batchSize int, logger logrus.FieldLogger,
+	metrics *Metrics,
+) *Shipper {
+	return &Shipper{
+		log:       log,
+		reader:    reader,
+		client:    client,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+		metrics:   metrics,
+	}
+}
+
+// Start runs the shipping loop in the background until Stop is called.
+// Calling Start on a running shipper has no effect.
+func (s *Shipper) Start() {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.stop, s.done)
+}
+
+// Stop ends the shipping loop and waits for the current batch to finish
+func (s *Shipper) Stop() {
+	s.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (s *Shipper) run(stop, done chan struct{}) {
+	defer close(done)
+
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			s.drain(stop)
+		}
+	}
+}
+
+// drain ships batches until the log is empty or a batch fails
+func (s *Shipper) drain(stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		n, err := s.ShipOnce(context.Background())
+		if err != nil {
+			s.logger.WithField("action", "cross_cluster_replication_ship").
+				WithError(err).
+				Warn("shipping changes to standby failed, will retry")
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// ShipOnce ships a single batch of changes, or a page of a resync if no
+// changes are pending, and returns how many operations were shipped
+func (s *Shipper) ShipOnce(ctx context.Context) (int, error) {
+	defer s.metrics.observeLog(s.log)
+
+	changes := s.log.take(s.batchSize)
+	if len(changes) == 0 {
+		return s.shipResync(ctx)
+	}
+
+	var shipped int
+	var failed []pendingChange
+	err := s.ship(ctx, changes)
+	switch {
+	case err == nil:
+		shipped = len(changes)
+	case !retryable(err) && len(changes) > 1:
+		// ship the changes one by one, so a change which can't be applied
+		// doesn't hold up the rest of the batch
+		shipped, failed, err = s.shipEach(ctx, changes)
+	default:
+		failed = changes
+	}
+	s.retry(failed, err)
+
+	return shipped, s.result(shipped, len(failed), err)
+}
+
+func (s *Shipper) ship(ctx context.Context, changes []pendingChange) error {
+	ops, err := s.buildOps(ctx, changes)
+	if err == nil {
+		err = s.client.Apply(ctx, ops)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.log.ack(changes)
+	return nil
+}
+
+func (s *Shipper) shipEach(ctx context.Context, changes []pendingChange,
+) (shipped int, failed []pendingChange, err error) {
+	for i := range changes {
+		cerr := s.ship(ctx, changes[i:i+1])
+		if cerr == nil {
+			shipped++
+			continue
+		}
+
+		err = cerr
+		if retryable(cerr) {
+			// nothing else will succeed until the standby is reachable again
+			return shipped, append(failed, changes[i:]...), err
+		}
+		failed = append(failed, changes[i])
+	}
+
+	return shipped, failed, err
+}
+
+// retry puts failed changes back into the log. Changes which failed too
+// often for a reason retrying doesn't fix are parked instead.
+func (s *Shipper) retry(changes []pendingChange, err error) {
+	if len(changes) == 0 {
+		return
+	}
+	if retryable(err) {
+		s.log.requeue(changes)
+		return
+	}
+
+	requeue := make([]pendingChange, 0, len(changes))
+	for _, c := range changes {
+		c.attempts++
+		if c.attempts < maxShipAttempts {
+			requeue = append(requeue, c)
+			continue
+		}
+
+		s.log.park(c, err)
+		s.logger.WithField("action", "cross_cluster_replication_ship").
+			WithField("class", c.Class).
+			WithField("tenant", c.Tenant).
+			WithField("id", c.ID).
+			WithError(err).
+			Error("change can't be shipped to the standby, parked it")
+	}
+	s.log.requeue(requeue)
+}
+
+// retryable is false for errors which occur again when the same operations
+// are shipped again
+func retryable(err error) bool {
+	return !errors.Is(err, ErrRejected) && !errors.Is(err, errUnreadable)
+}
+
+// shipResync ships the next page of the oldest resync. All objects of the
+// page are put and followed by a sync operation, so that the standby deletes
+// the objects which no longer exist on the primary.
+func (s *Shipper) shipResync(ctx context.Context) (int, error) {
+	r, ok := s.log.nextResync()
+	if !ok {
+		return 0, nil
+	}
+
+	res, qerr := s.reader.Query(ctx, &objects.QueryInput{
+		Class:      r.Class,
+		Tenant:     r.Tenant,
+		Limit:      s.batchSize,
+		Cursor:     &filters.Cursor{After: r.After.String(), Limit: s.batchSize},
+		Additional: additional.Properties{Vector: true},
+	})
+	if qerr != nil {
+		if qerr.NotFound() || qerr.UnprocessableEntity() {
+			// the class or tenant was deleted, which is replicated through
+			// the schema
+			s.log.resyncShipped(r.Scope, "", true)
+			return 1, nil
+		}
+		return 0, s.result(0, 1, fmt.Errorf("resync %s: %w", r.Class, qerr))
+	}
+
+	ops := make([]Op, 0, len(res)+1)
+	syncOp := Op{Type: OpSync, Class: r.Class, Tenant: r.Tenant, After: r.After,
+		IDs: make([]strfmt.UUID, len(res)), Final: len(res) < s.batchSize}
+	for i, obj := range res {
+		syncOp.IDs[i] = obj.ID
+		ops = append(ops, Op{Type: OpPut, Class: r.Class, Tenant: r.Tenant,
+			ID: obj.ID, Object: obj.ObjectWithVector(true)})
+	}
+	ops = append(ops, syncOp)
+
+	if err := s.client.Apply(ctx, ops); err != nil {
+		err = fmt.Errorf("resync %s: %w", r.Class, err)
+		if !retryable(err) && s.log.resyncFailed(r.Scope, err) {
+			s.logger.WithField("action", "cross_cluster_replication_resync").
+				WithField("class", r.Class).
+				WithField("tenant", r.Tenant).
+				WithError(err).
+				Error("resync can't be shipped to the standby, parked it")
+		}
+		return 0, s.result(0, len(ops), err)
+	}
+
+	last := r.After
+	if len(res) > 0 {
+		last = res[len(res)-1].ID
+	}
+	s.log.resyncShipped(r.Scope, last, syncOp.Final)
+	return len(ops), s.result(len(ops), 0, nil)
+}
+
+// result records the outcome of shipping and returns err
+func (s *Shipper) result(shipped, failed int, err error) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if shipped > 0 {
+		s.lastShipped = time.Now()
+		s.metrics.shipped(true, shipped)
+	}
+	if err != nil {
+		s.metrics.shipped(false, failed)
+	}
+	s.lastErr = err
+	return err
+}
+
+func (s *Shipper) buildOps(ctx context.Context, changes []pendingChange) ([]Op, error) {
+	ops := make([]Op, len(changes))
+	for i, c := range changes {
+		res, err := s.reader.Object(ctx, c.Class, c.ID, nil,
+			additional.Properties{Vector: true}, nil, c.Tenant)
+		if err != nil {
+			return nil, fmt.Errorf("read %s/%s: %w: %w", c.Class, c.ID, errUnreadable, err)
+		}
+
+		op := Op{Class: c.Class, Tenant: c.Tenant, ID: c.ID}
+		if res == nil {
+			op.Type = OpDelete
+		} else {
+			op.Type = OpPut
+			op.Object = res.ObjectWithVector(true)
+		}
+		ops[i] = op
+	}
+
+	return ops, nil
+}
+
+func (s *Shipper) state() (lastShipped time.Time, lastErr error) {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.lastShipped, s.lastErr
+}
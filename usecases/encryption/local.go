@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// KeySize is the size of the master key and of the data keys
+	KeySize = 32
+
+	keyFileExt = ".key"
+)
+
+// LocalKeyManager keeps the data keys in files next to the data, each
+// encrypted with the master key. It is used if no key management module is
+// configured. Deleting a key removes its file, so a backup or a copy of the
+// data taken before can no longer be read.
+type LocalKeyManager struct {
+	dir  string
+	aead cipher.AEAD
+
+	sync.Mutex
+	keys map[string][]byte
+}
+
+func NewLocalKeyManager(dir string, masterKey []byte) (*LocalKeyManager, error) {
+	if len(masterKey) != KeySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", KeySize, len(masterKey))
+	}
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("init master key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("init master key: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create key dir: %w", err)
+	}
+
+	return &LocalKeyManager{dir: dir, aead: aead, keys: map[string][]byte{}}, nil
+}
+
+// Name implements modulecapabilities.KeyManager
+func (m *LocalKeyManager) Name() string {
+	return "local"
+}
+
+// DataKey implements modulecapabilities.KeyManager
+func (m *LocalKeyManager) DataKey(ctx context.Context, id string) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if key, ok := m.keys[id]; ok {
+		return key, nil
+	}
+
+	key, err := m.readKey(id)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		if key, err = m.createKey(id); err != nil {
+			return nil, err
+		}
+	}
+	m.keys[id] = key
+	return key, nil
+}
+
+// DeleteKey implements modulecapabilities.KeyManager
+func (m *LocalKeyManager) DeleteKey(ctx context.Context, id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.keys, id)
+	if err := os.Remove(m.path(id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete key %q: %w", id, err)
+	}
+	return nil
+}
+
+// path hex-encodes the id, as ids contain separators such as "/"
+func (m *LocalKeyManager) path(id string) string {
+	return filepath.Join(m.dir, hex.EncodeToString([]byte(id))+keyFileExt)
+}
+
+// readKey returns nil if the key does not exist
+func (m *LocalKeyManager) readKey(id string) ([]byte, error) {
+	sealed, err := os.ReadFile(m.path(id))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read key %q: %w", id, err)
+	}
+
+	nonceSize := m.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, fmt.Errorf("key %q is corrupt", id)
+	}
+	key, err := m.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key %q, was it created with a different master key: %w", id, err)
+	}
+	return key, nil
+}
+
+func (m *LocalKeyManager) createKey(id string) ([]byte, error) {
+	key := make([]byte, KeySize)
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	// write to a temporary file first, a partially written key would make
+	// the data unreadable
+	sealed := m.aead.Seal(nonce, nonce, key, []byte(id))
+	tmp := m.path(id) + ".tmp"
+	if err := os.WriteFile(tmp, sealed, 0o600); err != nil {
+		return nil, fmt.Errorf("write key %q: %w", id, err)
+	}
+	if err := os.Rename(tmp, m.path(id)); err != nil {
+		return nil, fmt.Errorf("write key %q: %w", id, err)
+	}
+	return key, nil
+}
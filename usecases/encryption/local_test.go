@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKeyManager(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	masterKey := bytes.Repeat([]byte{1}, KeySize)
+
+	_, err := NewLocalKeyManager(dir, masterKey[:16])
+	assert.NotNil(t, err)
+
+	m, err := NewLocalKeyManager(dir, masterKey)
+	require.Nil(t, err)
+
+	key1, err := m.DataKey(ctx, "Class/tenant1")
+	require.Nil(t, err)
+	assert.Len(t, key1, KeySize)
+	key2, err := m.DataKey(ctx, "Class/tenant2")
+	require.Nil(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	t.Run("keys are persisted", func(t *testing.T) {
+		m, err := NewLocalKeyManager(dir, masterKey)
+		require.Nil(t, err)
+		key, err := m.DataKey(ctx, "Class/tenant1")
+		require.Nil(t, err)
+		assert.Equal(t, key1, key)
+	})
+
+	t.Run("keys cannot be read with a different master key", func(t *testing.T) {
+		m, err := NewLocalKeyManager(dir, bytes.Repeat([]byte{2}, KeySize))
+		require.Nil(t, err)
+		_, err = m.DataKey(ctx, "Class/tenant1")
+		assert.ErrorContains(t, err, "different master key")
+	})
+
+	t.Run("deleted keys are replaced by new keys", func(t *testing.T) {
+		require.Nil(t, m.DeleteKey(ctx, "Class/tenant1"))
+		require.Nil(t, m.DeleteKey(ctx, "Class/tenant1"))
+
+		m, err := NewLocalKeyManager(dir, masterKey)
+		require.Nil(t, err)
+		key, err := m.DataKey(ctx, "Class/tenant1")
+		require.Nil(t, err)
+		assert.NotEqual(t, key1, key)
+	})
+}
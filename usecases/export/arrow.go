@@ -0,0 +1,276 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// arrowBatchSize is the number of objects per record batch of the stream
+const arrowBatchSize = 1024
+
+// columns which are present in every export. Their names are prefixed with
+// an underscore to set them apart from the property columns, "_id" is
+// reserved, the others could still clash with the name of a property.
+const (
+	arrowColumnID                 = "_id"
+	arrowColumnCreationTimeUnix   = "_creationTimeUnix"
+	arrowColumnLastUpdateTimeUnix = "_lastUpdateTimeUnix"
+	arrowColumnVector             = "_vector"
+)
+
+// arrowEncoder writes objects as an Arrow IPC stream. Every property of the
+// class is a column, primitive types and arrays of them are mapped to the
+// equivalent Arrow types, all other types are JSON encoded strings.
+type arrowEncoder struct {
+	props     []arrowProperty
+	builder   *array.RecordBuilder
+	writer    *ipc.Writer
+	batchSize int
+	pending   int
+}
+
+type arrowProperty struct {
+	name string
+	// asJSON is set for properties without an equivalent Arrow type
+	asJSON bool
+}
+
+func newArrowEncoder(w io.Writer, class *models.Class) *arrowEncoder {
+	fields := []arrow.Field{
+		{Name: arrowColumnID, Type: arrow.BinaryTypes.String},
+		{Name: arrowColumnCreationTimeUnix, Type: arrow.PrimitiveTypes.Int64},
+		{Name: arrowColumnLastUpdateTimeUnix, Type: arrow.PrimitiveTypes.Int64},
+	}
+
+	props := make([]arrowProperty, len(class.Properties))
+	for i, prop := range class.Properties {
+		dataType, asJSON := arrowTypeOf(prop)
+		props[i] = arrowProperty{name: prop.Name, asJSON: asJSON}
+		fields = append(fields, arrow.Field{Name: prop.Name, Type: dataType, Nullable: true})
+	}
+
+	fields = append(fields, arrow.Field{
+		Name:     arrowColumnVector,
+		Type:     arrow.ListOf(arrow.PrimitiveTypes.Float32),
+		Nullable: true,
+	})
+
+	mem := memory.NewGoAllocator()
+	sch := arrow.NewSchema(fields, nil)
+	return &arrowEncoder{
+		props:     props,
+		builder:   array.NewRecordBuilder(mem, sch),
+		writer:    ipc.NewWriter(w, ipc.WithSchema(sch), ipc.WithAllocator(mem)),
+		batchSize: arrowBatchSize,
+	}
+}
+
+func arrowTypeOf(prop *models.Property) (arrow.DataType, bool) {
+	if len(prop.DataType) != 1 {
+		// cross-references to multiple classes
+		return arrow.BinaryTypes.String, true
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeUUID,
+		schema.DataTypeDate:
+		return arrow.BinaryTypes.String, false
+	case schema.DataTypeInt:
+		return arrow.PrimitiveTypes.Int64, false
+	case schema.DataTypeNumber:
+		return arrow.PrimitiveTypes.Float64, false
+	case schema.DataTypeBoolean:
+		return arrow.FixedWidthTypes.Boolean, false
+	case schema.DataTypeTextArray, schema.DataTypeStringArray,
+		schema.DataTypeUUIDArray, schema.DataTypeDateArray:
+		return arrow.ListOf(arrow.BinaryTypes.String), false
+	case schema.DataTypeIntArray:
+		return arrow.ListOf(arrow.PrimitiveTypes.Int64), false
+	case schema.DataTypeNumberArray:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float64), false
+	case schema.DataTypeBooleanArray:
+		return arrow.ListOf(arrow.FixedWidthTypes.Boolean), false
+	default:
+		return arrow.BinaryTypes.String, true
+	}
+}
+
+func (e *arrowEncoder) encode(obj *models.Object) error {
+	e.builder.Field(0).(*array.StringBuilder).Append(obj.ID.String())
+	e.builder.Field(1).(*array.Int64Builder).Append(obj.CreationTimeUnix)
+	e.builder.Field(2).(*array.Int64Builder).Append(obj.LastUpdateTimeUnix)
+
+	props, _ := obj.Properties.(map[string]interface{})
+	for i, prop := range e.props {
+		b := e.builder.Field(3 + i)
+		value, ok := props[prop.name]
+		if !ok || value == nil {
+			b.AppendNull()
+			continue
+		}
+
+		if prop.asJSON {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("property %q: %w", prop.name, err)
+			}
+			b.(*array.StringBuilder).Append(string(encoded))
+			continue
+		}
+
+		if err := appendArrowValue(b, value); err != nil {
+			return fmt.Errorf("property %q: %w", prop.name, err)
+		}
+	}
+
+	vb := e.builder.Field(3 + len(e.props)).(*array.ListBuilder)
+	if len(obj.Vector) == 0 {
+		vb.AppendNull()
+	} else {
+		vb.Append(true)
+		vb.ValueBuilder().(*array.Float32Builder).AppendValues(obj.Vector, nil)
+	}
+
+	e.pending++
+	if e.pending >= e.batchSize {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *arrowEncoder) flush() error {
+	if e.pending == 0 {
+		return nil
+	}
+
+	rec := e.builder.NewRecord()
+	defer rec.Release()
+	e.pending = 0
+
+	return e.writer.Write(rec)
+}
+
+func (e *arrowEncoder) close() error {
+	defer e.builder.Release()
+
+	if err := e.flush(); err != nil {
+		return err
+	}
+	// writes the end-of-stream marker, the schema is written even if there
+	// were no objects at all
+	return e.writer.Close()
+}
+
+func (e *arrowEncoder) abort() {
+	e.builder.Release()
+}
+
+func appendArrowValue(b array.Builder, value interface{}) error {
+	switch b := b.(type) {
+	case *array.StringBuilder:
+		s, err := arrowString(value)
+		if err != nil {
+			return err
+		}
+		b.Append(s)
+	case *array.Int64Builder:
+		n, err := arrowInt64(value)
+		if err != nil {
+			return err
+		}
+		b.Append(n)
+	case *array.Float64Builder:
+		n, err := arrowFloat64(value)
+		if err != nil {
+			return err
+		}
+		b.Append(n)
+	case *array.BooleanBuilder:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected boolean, got %T", value)
+		}
+		b.Append(v)
+	case *array.ListBuilder:
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice {
+			return fmt.Errorf("expected array, got %T", value)
+		}
+		b.Append(true)
+		for i := 0; i < items.Len(); i++ {
+			if err := appendArrowValue(b.ValueBuilder(), items.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported column type %s", b.Type())
+	}
+
+	return nil
+}
+
+func arrowString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("expected string, got %T", value)
+	}
+}
+
+func arrowInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	default:
+		return 0, fmt.Errorf("expected int, got %T", value)
+	}
+}
+
+func arrowFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	default:
+		return 0, fmt.Errorf("expected number, got %T", value)
+	}
+}
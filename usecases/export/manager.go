@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// FormatArrow streams the objects as an Apache Arrow IPC stream
+	FormatArrow = "arrow"
+	// FormatNDJSON streams the objects as newline-delimited JSON
+	FormatNDJSON = "ndjson"
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Repo reads all objects of a class including their vectors
+type Repo interface {
+	ExportObjects(ctx context.Context, className string,
+		filter *filters.LocalFilter, tenant string,
+		fn func(obj *models.Object) error) error
+}
+
+// Options of a single export, the format defaults to FormatArrow
+type Options struct {
+	Format string
+	Tenant string
+	Where  *models.WhereFilter
+}
+
+// Manager exports the objects of a class for offline use, such as training
+// on the vectors, without paginating through the search APIs
+type Manager struct {
+	repo       Repo
+	schema     schemaGetter
+	authorizer authorizer
+	logger     logrus.FieldLogger
+}
+
+func NewManager(repo Repo, schemaGetter schemaGetter, authorizer authorizer,
+	logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		repo:       repo,
+		schema:     schemaGetter,
+		authorizer: authorizer,
+		logger:     logger,
+	}
+}
+
+// Export is a validated export request. No objects are read until it is
+// written.
+type Export struct {
+	// ContentType of the encoded objects
+	ContentType string
+
+	repo   Repo
+	class  *models.Class
+	format string
+	tenant string
+	filter *filters.LocalFilter
+}
+
+// Export validates the request, so that errors can be reported before any
+// data is sent
+func (m *Manager) Export(principal *models.Principal, className string,
+	opts Options,
+) (*Export, error) {
+	if err := m.authorizer.Authorize(principal, "list", "objects/"+className); err != nil {
+		return nil, err
+	}
+	err := m.authorizer.Authorize(principal, "list",
+		authorization.ClassResource(className, opts.Tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Format == "" {
+		opts.Format = FormatArrow
+	}
+	contentType, err := contentTypeOf(opts.Format)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	sch := m.schema.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	if mt := schema.MultiTenancyEnabled(class); mt && opts.Tenant == "" {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %q has multi-tenancy enabled, but request was without tenant", className))
+	} else if !mt && opts.Tenant != "" {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %q has multi-tenancy disabled, but request was with tenant", className))
+	}
+
+	var filter *filters.LocalFilter
+	if opts.Where != nil {
+		filter, err = filterext.Parse(opts.Where, class.Class)
+		if err != nil {
+			return nil, enterrors.NewErrUnprocessable(
+				fmt.Errorf("failed to parse where filter: %w", err))
+		}
+		if err := filters.ValidateFilters(sch, filter); err != nil {
+			return nil, enterrors.NewErrUnprocessable(
+				fmt.Errorf("invalid where filter: %w", err))
+		}
+	}
+
+	return &Export{
+		ContentType: contentType,
+		repo:        m.repo,
+		class:       class,
+		format:      opts.Format,
+		tenant:      opts.Tenant,
+		filter:      filter,
+	}, nil
+}
+
+// WriteTo encodes all objects of the export to w and returns their count. If
+// an error occurs midway, the output is incomplete: an Arrow stream lacks its
+// end-of-stream marker in that case.
+func (e *Export) WriteTo(ctx context.Context, w io.Writer) (int, error) {
+	enc, err := e.newEncoder(w)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = e.repo.ExportObjects(ctx, e.class.Class, e.filter, e.tenant,
+		func(obj *models.Object) error {
+			count++
+			return enc.encode(obj)
+		})
+	if err != nil {
+		enc.abort()
+		return count, err
+	}
+
+	return count, enc.close()
+}
+
+// encoder writes objects in one of the export formats. close completes the
+// output, abort only releases the resources.
+type encoder interface {
+	encode(obj *models.Object) error
+	close() error
+	abort()
+}
+
+func (e *Export) newEncoder(w io.Writer) (encoder, error) {
+	switch e.format {
+	case FormatArrow:
+		return newArrowEncoder(w, e.class), nil
+	case FormatNDJSON:
+		return newNDJSONEncoder(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", e.format)
+	}
+}
+
+func contentTypeOf(format string) (string, error) {
+	switch format {
+	case FormatArrow:
+		return "application/vnd.apache.arrow.stream", nil
+	case FormatNDJSON:
+		return "application/x-ndjson", nil
+	default:
+		return "", fmt.Errorf("unsupported format %q, use %q or %q",
+			format, FormatArrow, FormatNDJSON)
+	}
+}
@@ -0,0 +1,345 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func TestExport_NDJSON(t *testing.T) {
+	repo := &fakeRepo{objects: testObjects()}
+	m := newTestManager(repo, &fakeAuthorizer{})
+
+	export, err := m.Export(nil, "Tenanted", Options{Format: FormatNDJSON, Tenant: "t1"})
+	require.Nil(t, err)
+	assert.Equal(t, "application/x-ndjson", export.ContentType)
+
+	var buf bytes.Buffer
+	count, err := export.WriteTo(context.Background(), &buf)
+	require.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "t1", repo.tenant)
+
+	var ids []strfmt.UUID
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var obj models.Object
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &obj))
+		ids = append(ids, obj.ID)
+		assert.Len(t, obj.Vector, 3)
+	}
+	assert.Equal(t, []strfmt.UUID{testObjects()[0].ID, testObjects()[1].ID}, ids)
+}
+
+func TestExport_Arrow(t *testing.T) {
+	repo := &fakeRepo{objects: testObjects()}
+	m := newTestManager(repo, &fakeAuthorizer{})
+
+	export, err := m.Export(nil, "Article", Options{})
+	require.Nil(t, err)
+	assert.Equal(t, "application/vnd.apache.arrow.stream", export.ContentType)
+
+	var buf bytes.Buffer
+	count, err := export.WriteTo(context.Background(), &buf)
+	require.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	r, err := ipc.NewReader(&buf)
+	require.Nil(t, err)
+	defer r.Release()
+
+	names := []string{}
+	for _, field := range r.Schema().Fields() {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{
+		"_id", "_creationTimeUnix", "_lastUpdateTimeUnix",
+		"title", "wordCount", "rating", "published", "tags", "location",
+		"_vector",
+	}, names)
+
+	require.True(t, r.Next())
+	rec := r.Record()
+	require.Equal(t, int64(2), rec.NumRows())
+
+	col := func(name string) int {
+		indices := r.Schema().FieldIndices(name)
+		require.Len(t, indices, 1)
+		return indices[0]
+	}
+
+	ids := rec.Column(col("_id")).(*array.String)
+	assert.Equal(t, testObjects()[0].ID.String(), ids.Value(0))
+	assert.Equal(t, testObjects()[1].ID.String(), ids.Value(1))
+
+	titles := rec.Column(col("title")).(*array.String)
+	assert.Equal(t, "first", titles.Value(0))
+	assert.True(t, titles.IsNull(1))
+
+	wordCount := rec.Column(col("wordCount")).(*array.Int64)
+	assert.Equal(t, int64(120), wordCount.Value(0))
+
+	rating := rec.Column(col("rating")).(*array.Float64)
+	assert.Equal(t, 4.5, rating.Value(0))
+
+	published := rec.Column(col("published")).(*array.Boolean)
+	assert.True(t, published.Value(0))
+
+	tags := rec.Column(col("tags")).(*array.List)
+	tagValues := tags.ListValues().(*array.String)
+	start, end := tags.ValueOffsets(0)
+	assert.Equal(t, int64(2), end-start)
+	assert.Equal(t, "a", tagValues.Value(int(start)))
+
+	location := rec.Column(col("location")).(*array.String)
+	assert.JSONEq(t, `{"latitude":52.5,"longitude":13.4}`, location.Value(0))
+
+	vectors := rec.Column(col("_vector")).(*array.List)
+	vectorValues := vectors.ListValues().(*array.Float32)
+	start, end = vectors.ValueOffsets(1)
+	assert.Equal(t, []float32{4, 5, 6}, vectorValues.Float32Values()[start:end])
+
+	assert.False(t, r.Next())
+	assert.Nil(t, r.Err())
+}
+
+func TestExport_ArrowMultipleBatches(t *testing.T) {
+	var buf bytes.Buffer
+	enc := newArrowEncoder(&buf, testClass())
+	enc.batchSize = 1
+	for _, obj := range testObjects() {
+		require.Nil(t, enc.encode(obj))
+	}
+	require.Nil(t, enc.close())
+
+	r, err := ipc.NewReader(&buf)
+	require.Nil(t, err)
+	defer r.Release()
+
+	batches := 0
+	for r.Next() {
+		assert.Equal(t, int64(1), r.Record().NumRows())
+		batches++
+	}
+	assert.Equal(t, 2, batches)
+}
+
+func TestExport_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		class      string
+		opts       Options
+		authorizer *fakeAuthorizer
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name:       "forbidden",
+			class:      "Article",
+			authorizer: &fakeAuthorizer{err: autherrs.NewForbidden(nil, "list", "objects/Article")},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &autherrs.Forbidden{}))
+			},
+		},
+		{
+			name:  "unknown class",
+			class: "Unknown",
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+			},
+		},
+		{
+			name:  "unknown format",
+			class: "Article",
+			opts:  Options{Format: "csv"},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+			},
+		},
+		{
+			name:  "tenant on class without multi-tenancy",
+			class: "Article",
+			opts:  Options{Tenant: "t1"},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+			},
+		},
+		{
+			name:  "missing tenant on class with multi-tenancy",
+			class: "Tenanted",
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+			},
+		},
+		{
+			name:  "filter on unknown property",
+			class: "Article",
+			opts: Options{Where: &models.WhereFilter{
+				Path:      []string{"unknown"},
+				Operator:  filters.OperatorEqual.Name(),
+				ValueText: ptString("x"),
+			}},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authorizer := test.authorizer
+			if authorizer == nil {
+				authorizer = &fakeAuthorizer{}
+			}
+			m := newTestManager(&fakeRepo{}, authorizer)
+
+			_, err := m.Export(nil, test.class, test.opts)
+			require.NotNil(t, err)
+			test.check(t, err)
+		})
+	}
+}
+
+func TestExport_Filter(t *testing.T) {
+	repo := &fakeRepo{objects: testObjects()}
+	m := newTestManager(repo, &fakeAuthorizer{})
+
+	export, err := m.Export(nil, "Article", Options{Where: &models.WhereFilter{
+		Path:      []string{"title"},
+		Operator:  filters.OperatorEqual.Name(),
+		ValueText: ptString("first"),
+	}})
+	require.Nil(t, err)
+
+	_, err = export.WriteTo(context.Background(), &bytes.Buffer{})
+	require.Nil(t, err)
+	require.NotNil(t, repo.filter)
+	assert.Equal(t, "first", repo.filter.Root.Value.Value)
+}
+
+func TestExport_RepoError(t *testing.T) {
+	repo := &fakeRepo{objects: testObjects(), err: errors.New("shard went away")}
+	m := newTestManager(repo, &fakeAuthorizer{})
+
+	export, err := m.Export(nil, "Article", Options{})
+	require.Nil(t, err)
+
+	count, err := export.WriteTo(context.Background(), &bytes.Buffer{})
+	assert.EqualError(t, err, "shard went away")
+	assert.Equal(t, 2, count)
+}
+
+func newTestManager(repo *fakeRepo, authorizer *fakeAuthorizer) *Manager {
+	logger, _ := test.NewNullLogger()
+	tenanted := testClass()
+	tenanted.Class = "Tenanted"
+	tenanted.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		testClass(), tenanted,
+	}}}
+	return NewManager(repo, &fakeSchemaGetter{schema: sch}, authorizer, logger)
+}
+
+func testClass() *models.Class {
+	return &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+			{Name: "rating", DataType: schema.DataTypeNumber.PropString()},
+			{Name: "published", DataType: schema.DataTypeBoolean.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+		},
+	}
+}
+
+func testObjects() []*models.Object {
+	return []*models.Object{
+		{
+			ID:    "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01",
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title":     "first",
+				"wordCount": float64(120),
+				"rating":    4.5,
+				"published": true,
+				"tags":      []interface{}{"a", "b"},
+				"location": &models.GeoCoordinates{
+					Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4),
+				},
+			},
+			Vector: []float32{1, 2, 3},
+		},
+		{
+			ID:         "8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02",
+			Class:      "Article",
+			Properties: map[string]interface{}{"wordCount": float64(7)},
+			Vector:     []float32{4, 5, 6},
+		},
+	}
+}
+
+func ptString(s string) *string { return &s }
+
+func ptFloat32(f float32) *float32 { return &f }
+
+type fakeRepo struct {
+	objects []*models.Object
+	err     error
+	filter  *filters.LocalFilter
+	tenant  string
+}
+
+func (r *fakeRepo) ExportObjects(ctx context.Context, className string,
+	filter *filters.LocalFilter, tenant string, fn func(obj *models.Object) error,
+) error {
+	r.filter, r.tenant = filter, tenant
+	for _, obj := range r.objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return r.err
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
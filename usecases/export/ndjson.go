@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ndjsonEncoder writes every object as a single line of JSON
+type ndjsonEncoder struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	buf := bufio.NewWriter(w)
+	return &ndjsonEncoder{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+func (e *ndjsonEncoder) encode(obj *models.Object) error {
+	return e.enc.Encode(obj)
+}
+
+func (e *ndjsonEncoder) close() error {
+	return e.buf.Flush()
+}
+
+func (e *ndjsonEncoder) abort() {
+	e.buf.Flush()
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+// MaxResolveBatchSize limits the amount of references resolved with a single
+// batch request. Peers reject larger requests, more references are split
+// into several requests.
+const MaxResolveBatchSize = 1000
+
+// BatchPeerClient resolves many references on a single peer with one
+// request. The returned objects are aligned with refs, nil marks an object
+// which does not exist on the peer. refs never exceeds MaxResolveBatchSize.
+type BatchPeerClient interface {
+	ResolveReferences(ctx context.Context, peer Peer,
+		refs []*crossref.Ref, tenant string) ([]*models.Object, error)
+}
+
+// ErrPeersUnavailable is returned by ResolveMany if at least one peer could
+// not be reached. The objects of all other peers are resolved nonetheless.
+type ErrPeersUnavailable struct {
+	Errs map[string]error
+}
+
+func (e ErrPeersUnavailable) Error() string {
+	names := make([]string, 0, len(e.Errs))
+	for name := range e.Errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = fmt.Sprintf("peer %q: %v", name, e.Errs[name])
+	}
+	return "resolve remote references: " + strings.Join(msgs, ", ")
+}
+
+// ResolveMany resolves the given remote references, keyed by their beacon.
+// Cache misses are grouped by peer and all peers are queried concurrently,
+// each bounded by its own timeout, so a slow peer only delays the query by
+// at most its timeout. All objects are read from the given tenant. Objects
+// which do not exist are part of the result as nil.
+func (r *Resolver) ResolveMany(ctx context.Context,
+	refs []*crossref.Ref, tenant string,
+) (map[string]*models.Object, error) {
+	out := make(map[string]*models.Object, len(refs))
+	byPeer := map[string][]*crossref.Ref{}
+	for _, ref := range refs {
+		if ref.Local {
+			return nil, fmt.Errorf("beacon %q is not a remote reference", ref.String())
+		}
+
+		key := ref.String()
+		if _, ok := out[key]; ok {
+			continue
+		}
+		if obj, ok := r.cached(cacheKey(ref, tenant)); ok {
+			out[key] = obj
+			continue
+		}
+
+		// reserve the key so duplicates are only requested once
+		out[key] = nil
+		byPeer[ref.PeerName] = append(byPeer[ref.PeerName], ref)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs = map[string]error{}
+	)
+	for name, peerRefs := range byPeer {
+		peer, ok := r.registry.Get(name)
+		if !ok {
+			lock.Lock()
+			errs[name] = ErrUnknownPeer{Name: name}
+			lock.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(peer Peer, refs []*crossref.Ref) {
+			defer wg.Done()
+
+			objs, err := r.resolvePeer(ctx, peer, refs, tenant)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs[peer.Name] = err
+				return
+			}
+			for i, ref := range refs {
+				out[ref.String()] = objs[i]
+				r.store(cacheKey(ref, tenant), objs[i])
+			}
+		}(peer, peerRefs)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return out, ErrPeersUnavailable{Errs: errs}
+	}
+	return out, nil
+}
+
+func (r *Resolver) resolvePeer(ctx context.Context, peer Peer,
+	refs []*crossref.Ref, tenant string,
+) ([]*models.Object, error) {
+	ctx, cancel := withPeerTimeout(ctx, peer)
+	defer cancel()
+
+	if peer.GRPCAddress != "" && r.batch != nil {
+		objs := make([]*models.Object, 0, len(refs))
+		for start := 0; start < len(refs); start += MaxResolveBatchSize {
+			end := start + MaxResolveBatchSize
+			if end > len(refs) {
+				end = len(refs)
+			}
+
+			batch, err := r.batch.ResolveReferences(ctx, peer, refs[start:end], tenant)
+			if err != nil {
+				return nil, err
+			}
+			if len(batch) != end-start {
+				return nil, fmt.Errorf("expected %d objects, got %d", end-start, len(batch))
+			}
+			objs = append(objs, batch...)
+		}
+		return objs, nil
+	}
+
+	objs := make([]*models.Object, len(refs))
+	for i, ref := range refs {
+		obj, err := r.client.GetObject(ctx, peer, ref.Class, ref.TargetID, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("resolve %q: %w", ref.String(), err)
+		}
+		objs[i] = obj
+	}
+
+	return objs, nil
+}
+
+func withPeerTimeout(ctx context.Context, peer Peer) (context.Context, context.CancelFunc) {
+	if timeout := peer.Timeout(); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
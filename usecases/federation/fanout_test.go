@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const otherRemoteID = strfmt.UUID("a1b2c3d4-0000-4000-8000-000000000002")
+
+type fakeBatchClient struct {
+	sync.Mutex
+	objects map[string]map[strfmt.UUID]*models.Object
+	fail    map[string]bool
+	delay   map[string]time.Duration
+	calls   map[string]int
+	sizes   []int
+	tenants []string
+}
+
+func (f *fakeBatchClient) ResolveReferences(ctx context.Context, peer Peer,
+	refs []*crossref.Ref, tenant string,
+) ([]*models.Object, error) {
+	f.Lock()
+	f.calls[peer.Name]++
+	f.sizes = append(f.sizes, len(refs))
+	f.tenants = append(f.tenants, tenant)
+	f.Unlock()
+
+	if d := f.delay[peer.Name]; d > 0 {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if f.fail[peer.Name] {
+		return nil, errors.New("connection refused")
+	}
+
+	out := make([]*models.Object, len(refs))
+	for i, ref := range refs {
+		out[i] = f.objects[peer.Name][ref.TargetID]
+	}
+	return out, nil
+}
+
+func newFanoutResolver(t *testing.T, peers ...Peer) (*Resolver, *fakeBatchClient) {
+	registry, err := NewRegistry(peers...)
+	require.Nil(t, err)
+
+	batch := &fakeBatchClient{
+		objects: map[string]map[strfmt.UUID]*models.Object{
+			"eu": {remoteID: {Class: "Article", ID: remoteID}},
+			"us": {otherRemoteID: {
+				Class: "Author", ID: otherRemoteID,
+				Properties: map[string]interface{}{"name": "Jane", "age": 41.0},
+			}},
+		},
+		fail:  map[string]bool{},
+		delay: map[string]time.Duration{},
+		calls: map[string]int{},
+	}
+
+	r := NewResolver(registry, &fakePeerClient{}, time.Minute)
+	r.SetBatchClient(batch)
+	return r, batch
+}
+
+func grpcPeer(name string) Peer {
+	return Peer{
+		Name:        name,
+		URL:         "https://" + name + ".example.com",
+		GRPCAddress: name + ".example.com:50051",
+	}
+}
+
+func TestResolverResolveMany(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("one request per peer", func(t *testing.T) {
+		r, batch := newFanoutResolver(t, grpcPeer("eu"), grpcPeer("us"))
+		refs := []*crossref.Ref{
+			crossref.New("eu", "Article", remoteID),
+			crossref.New("eu", "Article", otherRemoteID),
+			crossref.New("us", "Author", otherRemoteID),
+			crossref.New("eu", "Article", remoteID),
+		}
+
+		objs, err := r.ResolveMany(ctx, refs, "")
+		require.Nil(t, err)
+		assert.Len(t, objs, 3)
+		assert.Equal(t, remoteID, objs[refs[0].String()].ID)
+		assert.Nil(t, objs[refs[1].String()])
+		assert.Equal(t, otherRemoteID, objs[refs[2].String()].ID)
+		assert.Equal(t, map[string]int{"eu": 1, "us": 1}, batch.calls)
+
+		_, err = r.ResolveMany(ctx, refs, "")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]int{"eu": 1, "us": 1}, batch.calls,
+			"second query must be served from the cache")
+	})
+
+	t.Run("unavailable peer", func(t *testing.T) {
+		r, batch := newFanoutResolver(t, grpcPeer("eu"), grpcPeer("us"))
+		batch.fail["us"] = true
+		refs := []*crossref.Ref{
+			crossref.New("eu", "Article", remoteID),
+			crossref.New("us", "Author", otherRemoteID),
+		}
+
+		objs, err := r.ResolveMany(ctx, refs, "")
+		var unavailable ErrPeersUnavailable
+		require.ErrorAs(t, err, &unavailable)
+		assert.Contains(t, unavailable.Errs, "us")
+		assert.NotContains(t, unavailable.Errs, "eu")
+		assert.Equal(t, remoteID, objs[refs[0].String()].ID)
+	})
+
+	t.Run("slow peer is bounded by its timeout", func(t *testing.T) {
+		slow := grpcPeer("us")
+		slow.TimeoutSeconds = 1
+		r, batch := newFanoutResolver(t, grpcPeer("eu"), slow)
+		batch.delay["us"] = time.Minute
+
+		before := time.Now()
+		_, err := r.ResolveMany(ctx, []*crossref.Ref{
+			crossref.New("us", "Author", otherRemoteID),
+		}, "")
+		var unavailable ErrPeersUnavailable
+		require.ErrorAs(t, err, &unavailable)
+		assert.ErrorIs(t, unavailable.Errs["us"], context.DeadlineExceeded)
+		assert.Less(t, time.Since(before), 10*time.Second)
+	})
+
+	t.Run("unknown peer", func(t *testing.T) {
+		r, _ := newFanoutResolver(t, grpcPeer("eu"))
+
+		_, err := r.ResolveMany(ctx, []*crossref.Ref{
+			crossref.New("asia", "Article", remoteID),
+		}, "")
+		var unavailable ErrPeersUnavailable
+		require.ErrorAs(t, err, &unavailable)
+		assert.ErrorAs(t, unavailable.Errs["asia"], &ErrUnknownPeer{})
+	})
+
+	t.Run("peer without grpc address", func(t *testing.T) {
+		registry, err := NewRegistry(Peer{Name: "remote", URL: "https://remote.example.com"})
+		require.Nil(t, err)
+		client := &fakePeerClient{objects: map[strfmt.UUID]*models.Object{
+			remoteID: {Class: "Article", ID: remoteID},
+		}}
+		r := NewResolver(registry, client, time.Minute)
+		r.SetBatchClient(&fakeBatchClient{})
+
+		objs, err := r.ResolveMany(ctx, []*crossref.Ref{
+			crossref.New("remote", "Article", remoteID),
+		}, "tenant1")
+		require.Nil(t, err)
+		assert.Len(t, objs, 1)
+		assert.Equal(t, 1, client.calls)
+		assert.Equal(t, []string{"tenant1"}, client.tenants)
+	})
+
+	t.Run("tenants are cached separately", func(t *testing.T) {
+		r, batch := newFanoutResolver(t, grpcPeer("eu"))
+		refs := []*crossref.Ref{crossref.New("eu", "Article", remoteID)}
+
+		for _, tenant := range []string{"tenant1", "tenant2", "tenant1"} {
+			_, err := r.ResolveMany(ctx, refs, tenant)
+			require.Nil(t, err)
+		}
+		assert.Equal(t, []string{"tenant1", "tenant2"}, batch.tenants)
+	})
+
+	t.Run("large requests are split into batches", func(t *testing.T) {
+		r, batch := newFanoutResolver(t, grpcPeer("eu"))
+		refs := make([]*crossref.Ref, MaxResolveBatchSize+10)
+		for i := range refs {
+			refs[i] = crossref.New("eu", "Article", strfmt.UUID(uuid.New().String()))
+		}
+		refs[len(refs)-1] = crossref.New("eu", "Article", remoteID)
+
+		objs, err := r.ResolveMany(ctx, refs, "")
+		require.Nil(t, err)
+		assert.Equal(t, []int{MaxResolveBatchSize, 10}, batch.sizes)
+		assert.Equal(t, remoteID, objs[refs[len(refs)-1].String()].ID)
+	})
+}
+
+func TestResolverResolveSelected(t *testing.T) {
+	r, _ := newFanoutResolver(t, grpcPeer("us"))
+	ref := crossref.New("us", "Author", otherRemoteID)
+
+	props := search.SelectProperties{
+		{Name: "title", IsPrimitive: true},
+		{Name: "writtenBy", Refs: []search.SelectClass{{
+			ClassName: "us/Author",
+			RefProperties: search.SelectProperties{
+				{Name: "name", IsPrimitive: true},
+			},
+		}}},
+	}
+
+	local, remote := SplitRemoteSelections(props)
+	require.Len(t, local, 1)
+	assert.Equal(t, "title", local[0].Name)
+	require.Len(t, remote, 1)
+	assert.Equal(t, "writtenBy", remote[0].Name)
+
+	results := []interface{}{
+		map[string]interface{}{
+			"title":     "Federation",
+			"writtenBy": models.MultipleRef{{Beacon: strfmt.URI(ref.String())}},
+		},
+	}
+
+	require.Nil(t, r.ResolveSelected(context.Background(), results, remote, ""))
+
+	resolved := results[0].(map[string]interface{})["writtenBy"]
+	assert.Equal(t, []interface{}{
+		search.LocalRef{
+			Class:  "us/Author",
+			Fields: map[string]interface{}{"id": otherRemoteID, "name": "Jane"},
+		},
+	}, resolved)
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Repo persists the peers registered through the api. Implementations are
+// expected to store them on all nodes of the cluster, so that classes which
+// reference a peer can be used on every node and after a restart.
+type Repo interface {
+	LoadPeers() ([]Peer, error)
+	PutPeer(peer Peer) error
+	DeletePeer(name string) error
+}
+
+// Manager exposes peer management to the API
+type Manager struct {
+	sync.Mutex
+	registry   *Registry
+	resolver   *Resolver
+	repo       Repo
+	authorizer authorizer
+	logger     logrus.FieldLogger
+}
+
+// NewManager registers the persisted peers, they replace peers of the same
+// name from the configuration
+func NewManager(registry *Registry, resolver *Resolver, repo Repo,
+	authorizer authorizer, logger logrus.FieldLogger,
+) (*Manager, error) {
+	peers, err := repo.LoadPeers()
+	if err != nil {
+		return nil, fmt.Errorf("load federation peers: %w", err)
+	}
+	for _, peer := range peers {
+		if err := registry.Register(peer); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Manager{
+		registry:   registry,
+		resolver:   resolver,
+		repo:       repo,
+		authorizer: authorizer,
+		logger:     logger,
+	}, nil
+}
+
+func (m *Manager) ListPeers(principal *models.Principal) ([]Peer, error) {
+	if err := m.authorizer.Authorize(principal, "list", "federation/peers"); err != nil {
+		return nil, err
+	}
+
+	return m.registry.List(), nil
+}
+
+// RegisterPeer adds a new peer or replaces the URL and credentials of an
+// existing one. Cached objects of the peer are dropped.
+func (m *Manager) RegisterPeer(principal *models.Principal, peer Peer) error {
+	if err := m.authorizer.Authorize(principal, "update", "federation/peers/"+peer.Name); err != nil {
+		return err
+	}
+
+	if err := peer.Validate(); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if err := m.repo.PutPeer(peer); err != nil {
+		return fmt.Errorf("store federation peer: %w", err)
+	}
+	if err := m.registry.Register(peer); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+	m.resolver.Invalidate(peer.Name)
+
+	m.logger.WithField("action", "federation_register_peer").
+		WithField("peer", peer.Name).
+		WithField("url", peer.URL).
+		Info("registered federation peer")
+	return nil
+}
+
+// DeregisterPeer removes a peer, peers from the configuration are registered
+// again when the node restarts
+func (m *Manager) DeregisterPeer(principal *models.Principal, name string) error {
+	if err := m.authorizer.Authorize(principal, "delete", "federation/peers/"+name); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.registry.Get(name); !ok {
+		return enterrors.NewErrNotFound(fmt.Errorf("federation peer %q not found", name))
+	}
+	if err := m.repo.DeletePeer(name); err != nil {
+		return fmt.Errorf("delete federation peer: %w", err)
+	}
+	m.registry.Deregister(name)
+	m.resolver.Invalidate(name)
+
+	m.logger.WithField("action", "federation_deregister_peer").
+		WithField("peer", name).
+		Info("removed federation peer")
+	return nil
+}
+
+// ApplyPeer registers a peer which was registered on another node, the repo
+// has already stored it
+func (m *Manager) ApplyPeer(peer Peer) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.registry.Register(peer); err != nil {
+		return err
+	}
+	m.resolver.Invalidate(peer.Name)
+	return nil
+}
+
+// ApplyDeletePeer removes a peer which was removed on another node
+func (m *Manager) ApplyDeletePeer(name string) {
+	m.Lock()
+	defer m.Unlock()
+	m.registry.Deregister(name)
+	m.resolver.Invalidate(name)
+}
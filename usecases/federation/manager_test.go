@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeRepo struct {
+	peers map[string]Peer
+	err   error
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{peers: map[string]Peer{}}
+}
+
+func (r *fakeRepo) LoadPeers() ([]Peer, error) {
+	var peers []Peer
+	for _, p := range r.peers {
+		peers = append(peers, p)
+	}
+	return peers, nil
+}
+
+func (r *fakeRepo) PutPeer(peer Peer) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.peers[peer.Name] = peer
+	return nil
+}
+
+func (r *fakeRepo) DeletePeer(name string) error {
+	if r.err != nil {
+		return r.err
+	}
+	delete(r.peers, name)
+	return nil
+}
+
+func TestManager(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	registry, err := NewRegistry()
+	require.Nil(t, err)
+	resolver := NewResolver(registry, &fakePeerClient{}, time.Minute)
+	newManager := func(repo Repo, authorizer authorizer) *Manager {
+		m, err := NewManager(registry, resolver, repo, authorizer, logger)
+		require.Nil(t, err)
+		return m
+	}
+
+	t.Run("register, list and deregister", func(t *testing.T) {
+		repo := newFakeRepo()
+		m := newManager(repo, &fakeAuthorizer{})
+
+		require.Nil(t, m.RegisterPeer(nil, Peer{Name: "eu", URL: "https://eu.example.com"}))
+		peers, err := m.ListPeers(nil)
+		require.Nil(t, err)
+		require.Len(t, peers, 1)
+		assert.Contains(t, repo.peers, "eu")
+
+		require.Nil(t, m.DeregisterPeer(nil, "eu"))
+		assert.Empty(t, repo.peers)
+		err = m.DeregisterPeer(nil, "eu")
+		assert.ErrorAs(t, err, &enterrors.ErrNotFound{})
+	})
+
+	t.Run("invalid peer", func(t *testing.T) {
+		repo := newFakeRepo()
+		m := newManager(repo, &fakeAuthorizer{})
+
+		err := m.RegisterPeer(nil, Peer{Name: "EU", URL: "https://eu.example.com"})
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+		assert.Empty(t, repo.peers)
+	})
+
+	t.Run("peers are not registered if they cannot be stored", func(t *testing.T) {
+		repo := newFakeRepo()
+		repo.err = errors.New("node2 is down")
+		m := newManager(repo, &fakeAuthorizer{})
+
+		assert.Error(t, m.RegisterPeer(nil, Peer{Name: "eu", URL: "https://eu.example.com"}))
+		_, ok := registry.Get("eu")
+		assert.False(t, ok)
+	})
+
+	t.Run("persisted peers and changes of other nodes", func(t *testing.T) {
+		repo := newFakeRepo()
+		repo.peers["us"] = Peer{Name: "us", URL: "https://us.example.com", APIKey: "secret"}
+		m := newManager(repo, &fakeAuthorizer{})
+
+		peer, ok := registry.Get("us")
+		require.True(t, ok)
+		assert.Equal(t, "secret", peer.APIKey)
+
+		require.Nil(t, m.ApplyPeer(Peer{Name: "ap", URL: "https://ap.example.com"}))
+		_, ok = registry.Get("ap")
+		assert.True(t, ok)
+		m.ApplyDeletePeer("ap")
+		m.ApplyDeletePeer("us")
+		assert.Empty(t, registry.List())
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		m := newManager(newFakeRepo(), &fakeAuthorizer{errors.New("forbidden")})
+
+		_, err := m.ListPeers(nil)
+		assert.Error(t, err)
+		assert.Error(t, m.RegisterPeer(nil, Peer{Name: "eu", URL: "https://eu.example.com"}))
+		assert.Error(t, m.DeregisterPeer(nil, "eu"))
+	})
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Peer is a remote Weaviate cluster which can be the target of cross-cluster
+// references. The name of the peer is used as the host part of beacons, e.g.
+// weaviate://<name>/<Class>/<id>
+type Peer struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// GRPCAddress is the host:port of the peer's gRPC API. If set, references
+	// to objects on the peer are resolved in batches over gRPC instead of one
+	// REST request per object. TLS is used if the URL is https.
+	GRPCAddress string `json:"grpcAddress,omitempty"`
+	// TimeoutSeconds bounds every request to the peer. Zero means requests
+	// are only bounded by the context of the calling query.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// APIKey is sent as a bearer token on every request to the peer. It is
+	// never returned by the API.
+	APIKey string `json:"-"`
+}
+
+// StoredPeer is the form in which peers are persisted and sent to the other
+// nodes of the cluster. Unlike Peer it includes the api key.
+type StoredPeer struct {
+	Peer
+	APIKey string `json:"apiKey,omitempty"`
+}
+
+func (p Peer) Stored() StoredPeer {
+	return StoredPeer{Peer: p, APIKey: p.APIKey}
+}
+
+func (p StoredPeer) ToPeer() Peer {
+	peer := p.Peer
+	peer.APIKey = p.APIKey
+	return peer
+}
+
+// Validate makes sure the peer can be used to build beacons and to send
+// requests to
+func (p Peer) Validate() error {
+	if err := schema.ValidatePeerName(p.Name); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return fmt.Errorf("peer %q: invalid url: %w", p.Name, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("peer %q: url scheme must be http or https, got %q",
+			p.Name, u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("peer %q: url must contain a host", p.Name)
+	}
+
+	if p.GRPCAddress != "" {
+		if _, _, err := net.SplitHostPort(p.GRPCAddress); err != nil {
+			return fmt.Errorf("peer %q: grpc address must be host:port: %w", p.Name, err)
+		}
+	}
+	if p.TimeoutSeconds < 0 {
+		return fmt.Errorf("peer %q: timeout must not be negative", p.Name)
+	}
+
+	return nil
+}
+
+// Timeout returns the configured request timeout, zero if none is set
+func (p Peer) Timeout() time.Duration {
+	return time.Duration(p.TimeoutSeconds) * time.Second
+}
+
+// UseTLS reports whether connections to the peer should be encrypted
+func (p Peer) UseTLS() bool {
+	return strings.HasPrefix(p.URL, "https://")
+}
+
+// Registry holds all known peers of this node. It is safe for concurrent use.
+// Peers added at runtime are persisted and replicated by the Manager, the
+// registry only holds them in memory.
+type Registry struct {
+	sync.RWMutex
+	peers map[string]Peer
+}
+
+func NewRegistry(peers ...Peer) (*Registry, error) {
+	r := &Registry{peers: map[string]Peer{}}
+	for _, p := range peers {
+		if err := r.Register(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Register adds a peer or replaces an existing peer with the same name
+func (r *Registry) Register(p Peer) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.peers[p.Name] = p
+	return nil
+}
+
+// Deregister removes a peer, it returns false if no such peer was registered
+func (r *Registry) Deregister(name string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.peers[name]; !ok {
+		return false
+	}
+	delete(r.peers, name)
+	return true
+}
+
+func (r *Registry) Get(name string) (Peer, bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	p, ok := r.peers[name]
+	return p, ok
+}
+
+// List returns all peers sorted by name
+func (r *Registry) List() []Peer {
+	r.RLock()
+	defer r.RUnlock()
+
+	out := make([]Peer, 0, len(r.peers))
+	for _, p := range r.peers {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Name < out[b].Name })
+	return out
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		peer    Peer
+		wantErr bool
+	}{
+		{
+			name: "valid https peer",
+			peer: Peer{Name: "eu-cluster", URL: "https://eu.example.com"},
+		},
+		{
+			name: "valid http peer with port",
+			peer: Peer{Name: "local2", URL: "http://localhost:8081"},
+		},
+		{
+			name:    "reserved name",
+			peer:    Peer{Name: "localhost", URL: "http://localhost:8081"},
+			wantErr: true,
+		},
+		{
+			name:    "uppercase name",
+			peer:    Peer{Name: "EU", URL: "https://eu.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			peer:    Peer{Name: "eu", URL: "ftp://eu.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "missing host",
+			peer:    Peer{Name: "eu", URL: "https://"},
+			wantErr: true,
+		},
+		{
+			name: "valid grpc address and timeout",
+			peer: Peer{
+				Name: "eu", URL: "https://eu.example.com",
+				GRPCAddress: "eu.example.com:50051", TimeoutSeconds: 5,
+			},
+		},
+		{
+			name:    "grpc address without port",
+			peer:    Peer{Name: "eu", URL: "https://eu.example.com", GRPCAddress: "eu.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "negative timeout",
+			peer:    Peer{Name: "eu", URL: "https://eu.example.com", TimeoutSeconds: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.peer.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r, err := NewRegistry(Peer{Name: "b", URL: "https://b.example.com"})
+	require.Nil(t, err)
+
+	t.Run("register additional peer", func(t *testing.T) {
+		require.Nil(t, r.Register(Peer{Name: "a", URL: "https://a.example.com"}))
+
+		peers := r.List()
+		require.Len(t, peers, 2)
+		assert.Equal(t, "a", peers[0].Name)
+		assert.Equal(t, "b", peers[1].Name)
+	})
+
+	t.Run("replace existing peer", func(t *testing.T) {
+		require.Nil(t, r.Register(Peer{Name: "a", URL: "https://new-a.example.com"}))
+
+		p, ok := r.Get("a")
+		require.True(t, ok)
+		assert.Equal(t, "https://new-a.example.com", p.URL)
+	})
+
+	t.Run("invalid peer is rejected", func(t *testing.T) {
+		assert.Error(t, r.Register(Peer{Name: "c", URL: "not a url"}))
+		_, ok := r.Get("c")
+		assert.False(t, ok)
+	})
+
+	t.Run("deregister", func(t *testing.T) {
+		assert.True(t, r.Deregister("a"))
+		assert.False(t, r.Deregister("a"))
+		assert.Len(t, r.List(), 1)
+	})
+}
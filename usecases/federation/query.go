@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// SplitRemoteSelections separates selections of reference properties which
+// point to classes on federation peers from all other selections. The local
+// search can't resolve the former, they are resolved by ResolveSelected
+// once the local results are known.
+func SplitRemoteSelections(props search.SelectProperties) (local, remote search.SelectProperties) {
+	for _, prop := range props {
+		if prop.IsPrimitive || len(prop.Refs) == 0 {
+			local = append(local, prop)
+			continue
+		}
+
+		localRefs := make([]search.SelectClass, 0, len(prop.Refs))
+		remoteRefs := make([]search.SelectClass, 0, len(prop.Refs))
+		for _, ref := range prop.Refs {
+			if schema.IsFederatedClassName(schema.ClassName(ref.ClassName)) {
+				remoteRefs = append(remoteRefs, ref)
+			} else {
+				localRefs = append(localRefs, ref)
+			}
+		}
+
+		if len(localRefs) > 0 {
+			p := prop
+			p.Refs = localRefs
+			local = append(local, p)
+		}
+		if len(remoteRefs) > 0 {
+			p := prop
+			p.Refs = remoteRefs
+			remote = append(remote, p)
+		}
+	}
+
+	return local, remote
+}
+
+// ResolveSelected resolves the references selected in remote (see
+// SplitRemoteSelections) for all results of a local search at once. The
+// beacons of each selected property are replaced by the resolved objects in
+// the same form local references take after resolution, i.e. a list of
+// search.LocalRef whose class is the federated class name. Remote objects
+// are read from the given tenant. Nested references of remote objects are
+// not resolved.
+func (r *Resolver) ResolveSelected(ctx context.Context, results []interface{},
+	remote search.SelectProperties, tenant string,
+) error {
+	if len(remote) == 0 {
+		return nil
+	}
+
+	var refs []*crossref.Ref
+	for _, res := range results {
+		props, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, prop := range remote {
+			for _, ref := range beaconsOf(props[prop.Name]) {
+				if selectsRef(prop, ref) {
+					refs = append(refs, ref)
+				}
+			}
+		}
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	objs, err := r.ResolveMany(ctx, refs, tenant)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range results {
+		props, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, prop := range remote {
+			value, ok := props[prop.Name]
+			if !ok {
+				continue
+			}
+
+			resolved := []interface{}{}
+			for _, ref := range beaconsOf(value) {
+				sc := selectedClass(prop, ref)
+				if sc == nil {
+					continue
+				}
+				obj := objs[ref.String()]
+				if obj == nil {
+					continue
+				}
+
+				fields := map[string]interface{}{"id": obj.ID}
+				if objProps, ok := obj.Properties.(map[string]interface{}); ok {
+					for _, selected := range sc.RefProperties {
+						if v, ok := objProps[selected.Name]; ok && selected.IsPrimitive {
+							fields[selected.Name] = v
+						}
+					}
+				}
+				resolved = append(resolved, search.LocalRef{Class: sc.ClassName, Fields: fields})
+			}
+			props[prop.Name] = resolved
+		}
+	}
+
+	return nil
+}
+
+func selectsRef(prop search.SelectProperty, ref *crossref.Ref) bool {
+	return selectedClass(prop, ref) != nil
+}
+
+// selectedClass returns the selection matching the peer and class of the
+// beacon. Beacons without a class match the first selection of their peer.
+func selectedClass(prop search.SelectProperty, ref *crossref.Ref) *search.SelectClass {
+	if ref.Local {
+		return nil
+	}
+	for i := range prop.Refs {
+		peer, class, ok := schema.SplitFederatedClassName(prop.Refs[i].ClassName)
+		if !ok || peer != ref.PeerName {
+			continue
+		}
+		if ref.Class == "" || ref.Class == string(class) {
+			return &prop.Refs[i]
+		}
+	}
+	return nil
+}
+
+// beaconsOf extracts the parsed beacons of a reference property value, which
+// is a models.MultipleRef when read from storage, or a list of maps when it
+// went through a JSON roundtrip
+func beaconsOf(value interface{}) []*crossref.Ref {
+	var beacons []string
+	switch v := value.(type) {
+	case models.MultipleRef:
+		for _, ref := range v {
+			if ref != nil {
+				beacons = append(beacons, ref.Beacon.String())
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			switch ref := item.(type) {
+			case *models.SingleRef:
+				beacons = append(beacons, ref.Beacon.String())
+			case map[string]interface{}:
+				if b, ok := ref["beacon"].(string); ok {
+					beacons = append(beacons, b)
+				}
+			}
+		}
+	}
+
+	refs := make([]*crossref.Ref, 0, len(beacons))
+	for _, beacon := range beacons {
+		ref, err := crossref.Parse(beacon)
+		if err != nil {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+// DefaultCacheMaxEntries limits the amount of resolved remote objects which
+// are kept in memory
+const DefaultCacheMaxEntries = 10000
+
+// ErrUnknownPeer is returned when a class or beacon refers to a peer which is
+// not part of the registry
+type ErrUnknownPeer struct {
+	Name string
+}
+
+func (e ErrUnknownPeer) Error() string {
+	return fmt.Sprintf("unknown federation peer %q", e.Name)
+}
+
+// PeerClient talks to remote Weaviate clusters
+type PeerClient interface {
+	ClassExists(ctx context.Context, peer Peer, class string) (bool, error)
+	GetObject(ctx context.Context, peer Peer, class string,
+		id strfmt.UUID, tenant string) (*models.Object, error)
+}
+
+type cacheEntry struct {
+	obj     *models.Object
+	expires time.Time
+}
+
+// Resolver validates class references against peers at schema time and
+// resolves remote beacons at query time. Resolved objects (including the
+// fact that an object does not exist) are cached for the configured TTL so
+// that repeated queries don't hit the remote cluster every time.
+type Resolver struct {
+	registry   *Registry
+	client     PeerClient
+	batch      BatchPeerClient
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	sync.Mutex
+	cache map[string]cacheEntry
+}
+
+func NewResolver(registry *Registry, client PeerClient, ttl time.Duration) *Resolver {
+	return &Resolver{
+		registry:   registry,
+		client:     client,
+		ttl:        ttl,
+		maxEntries: DefaultCacheMaxEntries,
+		now:        time.Now,
+		cache:      map[string]cacheEntry{},
+	}
+}
+
+// SetBatchClient enables resolving references in batches for peers which
+// have a gRPC address configured
+func (r *Resolver) SetBatchClient(client BatchPeerClient) {
+	r.batch = client
+}
+
+// ValidateClassRef makes sure the peer is known and hosts the class
+func (r *Resolver) ValidateClassRef(ctx context.Context, peerName, class string) error {
+	peer, ok := r.registry.Get(peerName)
+	if !ok {
+		return ErrUnknownPeer{Name: peerName}
+	}
+
+	ctx, cancel := withPeerTimeout(ctx, peer)
+	defer cancel()
+
+	exists, err := r.client.ClassExists(ctx, peer, class)
+	if err != nil {
+		return fmt.Errorf("check class %q on peer %q: %w", class, peerName, err)
+	}
+	if !exists {
+		return fmt.Errorf("class %q does not exist on peer %q", class, peerName)
+	}
+
+	return nil
+}
+
+// Exists checks whether the object a remote beacon points to exists
+func (r *Resolver) Exists(ctx context.Context, ref *crossref.Ref) (bool, error) {
+	obj, err := r.Resolve(ctx, ref)
+	if err != nil {
+		return false, err
+	}
+
+	return obj != nil, nil
+}
+
+// Resolve returns the object a remote beacon points to, nil if it does not
+// exist on the peer
+func (r *Resolver) Resolve(ctx context.Context, ref *crossref.Ref) (*models.Object, error) {
+	if ref.Local {
+		return nil, fmt.Errorf("beacon %q is not a remote reference", ref.String())
+	}
+
+	key := ref.String()
+	if obj, ok := r.cached(key); ok {
+		return obj, nil
+	}
+
+	peer, ok := r.registry.Get(ref.PeerName)
+	if !ok {
+		return nil, ErrUnknownPeer{Name: ref.PeerName}
+	}
+
+	ctx, cancel := withPeerTimeout(ctx, peer)
+	defer cancel()
+
+	obj, err := r.client.GetObject(ctx, peer, ref.Class, ref.TargetID, "")
+	if err != nil {
+		return nil, fmt.Errorf("resolve %q: %w", key, err)
+	}
+
+	r.store(key, obj)
+	return obj, nil
+}
+
+// Invalidate drops all cached objects of the given peer, e.g. because the
+// peer was re-registered with a different URL
+func (r *Resolver) Invalidate(peerName string) {
+	r.Lock()
+	defer r.Unlock()
+
+	for key := range r.cache {
+		ref, err := crossref.Parse(key)
+		if err != nil || ref.PeerName == peerName {
+			delete(r.cache, key)
+		}
+	}
+}
+
+// cacheKey separates objects of the same beacon read from different tenants.
+// The tenant is appended as the fragment of the beacon, so that Invalidate
+// can still parse the key.
+func cacheKey(ref *crossref.Ref, tenant string) string {
+	if tenant == "" {
+		return ref.String()
+	}
+	return ref.String() + "#" + tenant
+}
+
+func (r *Resolver) cached(key string) (*models.Object, bool) {
+	if r.ttl <= 0 {
+		return nil, false
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	entry, ok := r.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if r.now().After(entry.expires) {
+		delete(r.cache, key)
+		return nil, false
+	}
+
+	return entry.obj, true
+}
+
+func (r *Resolver) store(key string, obj *models.Object) {
+	if r.ttl <= 0 {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.cache) >= r.maxEntries {
+		r.evict()
+	}
+	r.cache[key] = cacheEntry{obj: obj, expires: r.now().Add(r.ttl)}
+}
+
+// evict removes expired entries. If that does not free up any space, an
+// arbitrary half of the cache is dropped. Must be called with the lock held.
+func (r *Resolver) evict() {
+	now := r.now()
+	for key, entry := range r.cache {
+		if now.After(entry.expires) {
+			delete(r.cache, key)
+		}
+	}
+
+	if len(r.cache) < r.maxEntries {
+		return
+	}
+
+	toDelete := len(r.cache) / 2
+	for key := range r.cache {
+		if toDelete == 0 {
+			break
+		}
+		delete(r.cache, key)
+		toDelete--
+	}
+}
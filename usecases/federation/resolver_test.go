@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+const remoteID = strfmt.UUID("a1b2c3d4-0000-4000-8000-000000000001")
+
+type fakePeerClient struct {
+	classes map[string]bool
+	objects map[strfmt.UUID]*models.Object
+	calls   int
+	tenants []string
+}
+
+func (f *fakePeerClient) ClassExists(ctx context.Context, peer Peer, class string) (bool, error) {
+	return f.classes[class], nil
+}
+
+func (f *fakePeerClient) GetObject(ctx context.Context, peer Peer, class string,
+	id strfmt.UUID, tenant string,
+) (*models.Object, error) {
+	f.calls++
+	f.tenants = append(f.tenants, tenant)
+	return f.objects[id], nil
+}
+
+func newTestResolver(t *testing.T, ttl time.Duration) (*Resolver, *fakePeerClient) {
+	registry, err := NewRegistry(Peer{Name: "remote", URL: "https://remote.example.com"})
+	require.Nil(t, err)
+
+	client := &fakePeerClient{
+		classes: map[string]bool{"Article": true},
+		objects: map[strfmt.UUID]*models.Object{
+			remoteID: {Class: "Article", ID: remoteID},
+		},
+	}
+
+	return NewResolver(registry, client, ttl), client
+}
+
+func TestResolverValidateClassRef(t *testing.T) {
+	r, _ := newTestResolver(t, time.Minute)
+	ctx := context.Background()
+
+	assert.Nil(t, r.ValidateClassRef(ctx, "remote", "Article"))
+	assert.Error(t, r.ValidateClassRef(ctx, "remote", "Missing"))
+
+	err := r.ValidateClassRef(ctx, "unknown", "Article")
+	assert.ErrorAs(t, err, &ErrUnknownPeer{})
+}
+
+func TestResolverResolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cached within ttl", func(t *testing.T) {
+		r, client := newTestResolver(t, time.Minute)
+		ref := crossref.New("remote", "Article", remoteID)
+
+		for i := 0; i < 3; i++ {
+			obj, err := r.Resolve(ctx, ref)
+			require.Nil(t, err)
+			require.NotNil(t, obj)
+			assert.Equal(t, remoteID, obj.ID)
+		}
+		assert.Equal(t, 1, client.calls)
+	})
+
+	t.Run("expired entries are refreshed", func(t *testing.T) {
+		r, client := newTestResolver(t, time.Minute)
+		now := time.Now()
+		r.now = func() time.Time { return now }
+		ref := crossref.New("remote", "Article", remoteID)
+
+		_, err := r.Resolve(ctx, ref)
+		require.Nil(t, err)
+		now = now.Add(2 * time.Minute)
+		_, err = r.Resolve(ctx, ref)
+		require.Nil(t, err)
+
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("missing objects are cached as well", func(t *testing.T) {
+		r, client := newTestResolver(t, time.Minute)
+		ref := crossref.New("remote", "Article", "a1b2c3d4-0000-4000-8000-000000000002")
+
+		exists, err := r.Exists(ctx, ref)
+		require.Nil(t, err)
+		assert.False(t, exists)
+		exists, err = r.Exists(ctx, ref)
+		require.Nil(t, err)
+		assert.False(t, exists)
+		assert.Equal(t, 1, client.calls)
+	})
+
+	t.Run("invalidate drops entries of peer", func(t *testing.T) {
+		r, client := newTestResolver(t, time.Minute)
+		ref := crossref.New("remote", "Article", remoteID)
+
+		_, err := r.Resolve(ctx, ref)
+		require.Nil(t, err)
+		r.Invalidate("remote")
+		_, err = r.Resolve(ctx, ref)
+		require.Nil(t, err)
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("local beacons are rejected", func(t *testing.T) {
+		r, _ := newTestResolver(t, time.Minute)
+		_, err := r.Resolve(ctx, crossref.NewLocalhost("Article", remoteID))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown peer", func(t *testing.T) {
+		r, _ := newTestResolver(t, time.Minute)
+		_, err := r.Resolve(ctx, crossref.New("other", "Article", remoteID))
+		assert.ErrorAs(t, err, &ErrUnknownPeer{})
+	})
+}
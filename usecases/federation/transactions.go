@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package federation
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	// TransactionPutPeer registers or replaces a peer on all nodes
+	TransactionPutPeer cluster.TransactionType = "put_federation_peer"
+	// TransactionDeletePeer removes a peer from all nodes
+	TransactionDeletePeer cluster.TransactionType = "delete_federation_peer"
+	// TransactionReadPeers returns the peers of all nodes, it is used by
+	// nodes which join the cluster
+	TransactionReadPeers cluster.TransactionType = "read_federation_peers"
+)
+
+type TransactionPutPeerPayload struct {
+	Peer StoredPeer `json:"peer"`
+}
+
+type TransactionDeletePeerPayload struct {
+	Name string `json:"name"`
+}
+
+type TransactionReadPeersPayload struct {
+	Peers []StoredPeer `json:"peers"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPutPeer:
+		var pl TransactionPutPeerPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionDeletePeer:
+		var pl TransactionDeletePeerPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionReadPeers:
+		// the request carries no payload, the peers are part of the response
+		return TransactionReadPeersPayload{}, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized federation transaction type %q", txType)
+	}
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package jobs
+
+import "time"
+
+const (
+	StatusStarted   = "STARTED"
+	StatusSuccess   = "SUCCESS"
+	StatusFailed    = "FAILED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Types of the long-running operations which are tracked as jobs
+const (
+	TypeBackup          = "backup"
+	TypeBackupRestore   = "backup-restore"
+	TypeBulkImport      = "bulk-import"
+	TypeNodeDrain       = "node-drain"
+	TypeNearDuplicates  = "near-duplicates"
+	TypePropertyDelete  = "property-delete"
+	TypePropertyReindex = "property-reindex"
+	TypePropertyRename  = "property-rename"
+	TypeReindex         = "reindex"
+	TypeShardMove       = "shard-move"
+	TypeShardSplit      = "shard-split"
+	TypeTenantActivity  = "tenant-activity"
+	TypeTenantRestore   = "tenant-restore"
+)
+
+// Job is the state of a long-running operation. Progress is a percentage
+// which is only known if the operation reports a total, it is 100 once the
+// job succeeded.
+type Job struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Target      string     `json:"target"`
+	Status      string     `json:"status"`
+	Progress    float64    `json:"progress"`
+	Processed   int64      `json:"processed"`
+	Total       int64      `json:"total,omitempty"`
+	Cancellable bool       `json:"cancellable"`
+	Error       string     `json:"error,omitempty"`
+	StartedAt   time.Time  `json:"startedAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func (j *Job) finished() bool {
+	return j.CompletedAt != nil
+}
+
+func (j *Job) clone() *Job {
+	out := *j
+	if j.CompletedAt != nil {
+		t := *j.CompletedAt
+		out.CompletedAt = &t
+	}
+	return &out
+}
@@ -0,0 +1,341 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// persistInterval limits how often the progress of a running job is
+// written, the start and the end of a job are always written
+const persistInterval = 5 * time.Second
+
+// errInterrupted marks jobs which were still running when the node stopped
+var errInterrupted = errors.New("interrupted by a restart of the node")
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Repo persists jobs, so that their outcome is still known after a restart
+type Repo interface {
+	Put(ctx context.Context, job *Job) error
+	List(ctx context.Context) ([]*Job, error)
+	Delete(ctx context.Context, ids ...string) error
+}
+
+// Manager keeps track of the long-running operations of this node. The
+// operations run on their own and report their progress and outcome through
+// the Handle returned by Track. Jobs are only known to the node which runs
+// them.
+//
+// A nil Manager is valid, it doesn't track anything.
+type Manager struct {
+	repo       Repo
+	authorizer authorizer
+	config     config.Jobs
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	jobs map[string]*entry
+}
+
+type entry struct {
+	job         *Job
+	cancel      func() // nil if the operation can't be cancelled
+	persistedAt time.Time
+}
+
+// NewManager loads the persisted jobs. Jobs which were still running when
+// the node stopped are marked as failed.
+func NewManager(repo Repo, authorizer authorizer, cfg config.Jobs,
+	logger logrus.FieldLogger,
+) (*Manager, error) {
+	m := &Manager{
+		repo:       repo,
+		authorizer: authorizer,
+		config:     cfg,
+		logger:     logger,
+		jobs:       map[string]*entry{},
+	}
+
+	persisted, err := repo.List(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("load jobs: %w", err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, job := range persisted {
+		e := &entry{job: job}
+		m.jobs[job.ID] = e
+		if !job.finished() {
+			m.finish(e, StatusFailed, errInterrupted)
+		}
+	}
+	m.prune()
+
+	return m, nil
+}
+
+// Track registers a new running job. cancel is called when the job is
+// cancelled through the API, it may be nil if the operation can't be
+// cancelled. The job is finished by calling Done on the returned handle.
+func (m *Manager) Track(jobType, target string, cancel func()) *Handle {
+	if m == nil {
+		return nil
+	}
+
+	now := time.Now()
+	e := &entry{
+		job: &Job{
+			ID:          uuid.NewString(),
+			Type:        jobType,
+			Target:      target,
+			Status:      StatusStarted,
+			Cancellable: cancel != nil,
+			StartedAt:   now,
+			UpdatedAt:   now,
+		},
+		cancel: cancel,
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.jobs[e.job.ID] = e
+	m.persist(e)
+
+	return &Handle{manager: m, id: e.job.ID}
+}
+
+// Get returns a single job
+func (m *Manager) Get(principal *models.Principal, id string) (*Job, error) {
+	if err := m.authorizer.Authorize(principal, "get", "jobs"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	e, ok := m.jobs[id]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("job %q not found", id))
+	}
+	return e.job.clone(), nil
+}
+
+// List returns all jobs of this node, the most recent one first. If jobType
+// is set only jobs of this type are returned.
+func (m *Manager) List(principal *models.Principal, jobType string) ([]*Job, error) {
+	if err := m.authorizer.Authorize(principal, "list", "jobs"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	out := make([]*Job, 0, len(m.jobs))
+	for _, e := range m.jobs {
+		if jobType == "" || e.job.Type == jobType {
+			out = append(out, e.job.clone())
+		}
+	}
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].StartedAt.After(out[b].StartedAt)
+	})
+	return out, nil
+}
+
+// Cancel stops a running job. The job is marked as cancelled right away,
+// the operation itself may take a moment to stop.
+func (m *Manager) Cancel(principal *models.Principal, id string) (*Job, error) {
+	if err := m.authorizer.Authorize(principal, "delete", "jobs"); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	e, ok := m.jobs[id]
+	if !ok {
+		m.Unlock()
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("job %q not found", id))
+	}
+	if e.job.Status != StatusStarted {
+		m.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("job %q is not running", id))
+	}
+	if e.cancel == nil {
+		m.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("jobs of type %q can't be cancelled", e.job.Type))
+	}
+	e.job.Status = StatusCancelled
+	e.job.UpdatedAt = time.Now()
+	m.persist(e)
+	job, cancel := e.job.clone(), e.cancel
+	m.Unlock()
+
+	// outside of the lock, the operation may report its end right away
+	cancel()
+	return job, nil
+}
+
+func (m *Manager) progress(id string, processed, total int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	e, ok := m.jobs[id]
+	if !ok || e.job.finished() {
+		return
+	}
+
+	e.job.Processed, e.job.Total = processed, total
+	if total > 0 {
+		e.job.Progress = 100 * float64(processed) / float64(total)
+		if e.job.Progress > 100 {
+			e.job.Progress = 100
+		}
+	}
+	e.job.UpdatedAt = time.Now()
+	if e.job.UpdatedAt.Sub(e.persistedAt) >= persistInterval {
+		m.persist(e)
+	}
+}
+
+func (m *Manager) done(id string, err error) {
+	m.Lock()
+	defer m.Unlock()
+
+	e, ok := m.jobs[id]
+	if !ok || e.job.finished() {
+		return
+	}
+
+	switch {
+	case e.job.Status == StatusCancelled || errors.Is(err, context.Canceled):
+		m.finish(e, StatusCancelled, nil)
+	case err != nil:
+		m.finish(e, StatusFailed, err)
+	default:
+		m.finish(e, StatusSuccess, nil)
+	}
+	m.prune()
+}
+
+func (m *Manager) finish(e *entry, status string, err error) {
+	now := time.Now()
+	e.job.Status = status
+	e.job.UpdatedAt = now
+	e.job.CompletedAt = &now
+	if err != nil {
+		e.job.Error = err.Error()
+	}
+	if status == StatusSuccess {
+		e.job.Progress = 100
+	}
+	e.cancel = nil
+	m.persist(e)
+}
+
+// persist writes the job, a failure only means that the latest state of
+// the job is lost on a restart, so it is logged and otherwise ignored
+func (m *Manager) persist(e *entry) {
+	e.persistedAt = time.Now()
+	if err := m.repo.Put(context.Background(), e.job); err != nil {
+		m.logger.WithField("action", "persist_job").
+			WithField("job_id", e.job.ID).
+			WithField("job_type", e.job.Type).
+			WithError(err).
+			Error("could not persist job")
+	}
+}
+
+// prune removes finished jobs which are older than the retention period or
+// exceed the maximum number of retained jobs
+func (m *Manager) prune() {
+	var finished []*Job
+	for _, e := range m.jobs {
+		if e.job.finished() {
+			finished = append(finished, e.job)
+		}
+	}
+	sort.Slice(finished, func(a, b int) bool {
+		return finished[a].CompletedAt.After(*finished[b].CompletedAt)
+	})
+
+	cutoff := time.Now().Add(-time.Duration(m.config.RetentionHours) * time.Hour)
+	var expired []string
+	for i, job := range finished {
+		if (m.config.RetentionHours > 0 && job.CompletedAt.Before(cutoff)) ||
+			(m.config.MaxRetained > 0 && i >= m.config.MaxRetained) {
+			expired = append(expired, job.ID)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+
+	for _, id := range expired {
+		delete(m.jobs, id)
+	}
+	if err := m.repo.Delete(context.Background(), expired...); err != nil {
+		m.logger.WithField("action", "prune_jobs").
+			WithError(err).
+			Error("could not delete expired jobs")
+	}
+}
+
+// Handle is used by a running operation to report its progress and
+// outcome. A nil Handle is valid, it doesn't report anything.
+type Handle struct {
+	manager *Manager
+	id      string
+}
+
+// ID returns the id of the job, it is empty for a nil Handle
+func (h *Handle) ID() string {
+	if h == nil {
+		return ""
+	}
+	return h.id
+}
+
+// Progress reports how many of total units were processed so far, total is
+// 0 if it is not known
+func (h *Handle) Progress(processed, total int64) {
+	if h == nil {
+		return
+	}
+	h.manager.progress(h.id, processed, total)
+}
+
+// Done finishes the job. A nil error marks the job as successful, a
+// context.Canceled error as cancelled and any other error as failed.
+func (h *Handle) Done(err error) {
+	if h == nil {
+		return
+	}
+	h.manager.done(h.id, err)
+}
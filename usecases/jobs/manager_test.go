@@ -0,0 +1,261 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestJobs_Lifecycle(t *testing.T) {
+	repo := newFakeRepo()
+	m := newTestManager(t, repo, config.Jobs{})
+
+	h := m.Track(TypeBulkImport, "Article", nil)
+	job, err := m.Get(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, TypeBulkImport, job.Type)
+	assert.Equal(t, "Article", job.Target)
+	assert.Equal(t, StatusStarted, job.Status)
+	assert.False(t, job.Cancellable)
+	assert.Contains(t, repo.jobs, h.ID(), "persisted on start")
+
+	h.Progress(25, 100)
+	job, err = m.Get(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, 25.0, job.Progress)
+	assert.Equal(t, int64(25), job.Processed)
+
+	h.Progress(10, 0)
+	job, err = m.Get(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, 25.0, job.Progress, "percentage is kept without a total")
+
+	h.Done(nil)
+	job, err = m.Get(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusSuccess, job.Status)
+	assert.Equal(t, 100.0, job.Progress)
+	assert.NotNil(t, job.CompletedAt)
+	assert.Equal(t, StatusSuccess, repo.get(h.ID()).Status, "persisted on end")
+
+	failed := m.Track(TypeBackup, "s3/backup", nil)
+	failed.Done(errors.New("node down"))
+	job, err = m.Get(nil, failed.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusFailed, job.Status)
+	assert.Equal(t, "node down", job.Error)
+
+	_, err = m.Get(nil, "unknown")
+	assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+}
+
+func TestJobs_List(t *testing.T) {
+	m := newTestManager(t, newFakeRepo(), config.Jobs{})
+
+	first := m.Track(TypeBulkImport, "Article", nil)
+	time.Sleep(time.Millisecond)
+	second := m.Track(TypeBackup, "s3/backup", nil)
+
+	jobs, err := m.List(nil, "")
+	require.Nil(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, second.ID(), jobs[0].ID, "most recent first")
+	assert.Equal(t, first.ID(), jobs[1].ID)
+
+	jobs, err = m.List(nil, TypeBulkImport)
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, first.ID(), jobs[0].ID)
+}
+
+func TestJobs_Cancel(t *testing.T) {
+	m := newTestManager(t, newFakeRepo(), config.Jobs{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := m.Track(TypeBulkImport, "Article", cancel)
+
+	job, err := m.Cancel(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusCancelled, job.Status)
+	assert.NotNil(t, ctx.Err())
+
+	// the operation ends with whatever error the cancellation caused
+	h.Done(errors.New("import aborted"))
+	job, err = m.Get(nil, h.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusCancelled, job.Status)
+	assert.Empty(t, job.Error)
+	assert.NotNil(t, job.CompletedAt)
+
+	_, err = m.Cancel(nil, h.ID())
+	assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}), "not running")
+
+	notCancellable := m.Track(TypeShardSplit, "Article", nil)
+	_, err = m.Cancel(nil, notCancellable.ID())
+	assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+
+	cancelledByOperation := m.Track(TypeBulkImport, "Article", func() {})
+	cancelledByOperation.Done(context.Canceled)
+	job, err = m.Get(nil, cancelledByOperation.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusCancelled, job.Status)
+}
+
+func TestJobs_Restart(t *testing.T) {
+	repo := newFakeRepo()
+	m := newTestManager(t, repo, config.Jobs{})
+
+	running := m.Track(TypeBulkImport, "Article", nil)
+	done := m.Track(TypeBackup, "s3/backup", nil)
+	done.Done(nil)
+
+	m = newTestManager(t, repo, config.Jobs{})
+
+	job, err := m.Get(nil, running.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusFailed, job.Status)
+	assert.Equal(t, errInterrupted.Error(), job.Error)
+	assert.Equal(t, StatusFailed, repo.get(running.ID()).Status)
+
+	job, err = m.Get(nil, done.ID())
+	require.Nil(t, err)
+	assert.Equal(t, StatusSuccess, job.Status)
+}
+
+func TestJobs_Retention(t *testing.T) {
+	t.Run("max retained", func(t *testing.T) {
+		repo := newFakeRepo()
+		m := newTestManager(t, repo, config.Jobs{MaxRetained: 2})
+
+		running := m.Track(TypeBulkImport, "Article", nil)
+		var finished []*Handle
+		for i := 0; i < 3; i++ {
+			h := m.Track(TypeBackup, "s3/backup", nil)
+			h.Done(nil)
+			finished = append(finished, h)
+			time.Sleep(time.Millisecond)
+		}
+
+		_, err := m.Get(nil, finished[0].ID())
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}), "oldest one is removed")
+		assert.NotContains(t, repo.jobs, finished[0].ID())
+		for _, h := range append(finished[1:], running) {
+			_, err := m.Get(nil, h.ID())
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("retention period", func(t *testing.T) {
+		repo := newFakeRepo()
+		completed := time.Now().Add(-2 * time.Hour)
+		repo.jobs["old"] = &Job{
+			ID: "old", Type: TypeBackup, Status: StatusSuccess,
+			StartedAt: completed, CompletedAt: &completed,
+		}
+
+		m := newTestManager(t, repo, config.Jobs{RetentionHours: 1})
+		_, err := m.Get(nil, "old")
+		assert.True(t, errors.As(err, &enterrors.ErrNotFound{}))
+		assert.Empty(t, repo.jobs)
+	})
+}
+
+func TestJobs_NilManager(t *testing.T) {
+	var m *Manager
+	h := m.Track(TypeBulkImport, "Article", nil)
+	assert.Empty(t, h.ID())
+	h.Progress(1, 2)
+	h.Done(nil)
+}
+
+func TestJobs_Forbidden(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := NewManager(newFakeRepo(), &fakeAuthorizer{err: errors.New("forbidden")},
+		config.Jobs{}, logger)
+	require.Nil(t, err)
+
+	h := m.Track(TypeBulkImport, "Article", func() {})
+
+	_, err = m.Get(nil, h.ID())
+	assert.NotNil(t, err)
+	_, err = m.List(nil, "")
+	assert.NotNil(t, err)
+	_, err = m.Cancel(nil, h.ID())
+	assert.NotNil(t, err)
+}
+
+func newTestManager(t *testing.T, repo *fakeRepo, cfg config.Jobs) *Manager {
+	logger, _ := test.NewNullLogger()
+	m, err := NewManager(repo, &fakeAuthorizer{}, cfg, logger)
+	require.Nil(t, err)
+	return m
+}
+
+type fakeRepo struct {
+	sync.Mutex
+	jobs map[string]*Job
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{jobs: map[string]*Job{}}
+}
+
+func (r *fakeRepo) Put(ctx context.Context, job *Job) error {
+	r.Lock()
+	defer r.Unlock()
+	r.jobs[job.ID] = job.clone()
+	return nil
+}
+
+func (r *fakeRepo) List(ctx context.Context) ([]*Job, error) {
+	r.Lock()
+	defer r.Unlock()
+	var out []*Job
+	for _, job := range r.jobs {
+		out = append(out, job.clone())
+	}
+	return out, nil
+}
+
+func (r *fakeRepo) Delete(ctx context.Context, ids ...string) error {
+	r.Lock()
+	defer r.Unlock()
+	for _, id := range ids {
+		delete(r.jobs, id)
+	}
+	return nil
+}
+
+func (r *fakeRepo) get(id string) *Job {
+	r.Lock()
+	defer r.Unlock()
+	return r.jobs[id]
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
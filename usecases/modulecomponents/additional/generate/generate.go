@@ -20,10 +20,9 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/traverser/generative"
 )
 
-const maximumNumberOfGoroutines = 10
-
 type generativeClient interface {
 	GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
 	GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
@@ -31,12 +30,11 @@ type generativeClient interface {
 }
 
 type GenerateProvider struct {
-	client                    generativeClient
-	maximumNumberOfGoroutines int
+	pipeline *generative.Pipeline
 }
 
 func New(client generativeClient) *GenerateProvider {
-	return &GenerateProvider{client, maximumNumberOfGoroutines}
+	return &GenerateProvider{generative.NewPipeline(client)}
 }
 
 func (p *GenerateProvider) AdditionalPropertyDefaultValue() interface{} {
@@ -14,13 +14,13 @@ package generate
 import (
 	"context"
 	"regexp"
-	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
 	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+	"github.com/weaviate/weaviate/usecases/traverser/generative"
 )
 
 func (p *GenerateProvider) generateResult(ctx context.Context, in []search.Result, params *Params, limit *int, argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig) ([]search.Result, error) {
@@ -59,20 +59,13 @@ func validatePrompt(prompt *string) (*string, error) {
 }
 
 func (p *GenerateProvider) generatePerSearchResult(ctx context.Context, in []search.Result, prompt string, cfg moduletools.ClassConfig) ([]search.Result, error) {
-	var wg sync.WaitGroup
-	sem := make(chan struct{}, p.maximumNumberOfGoroutines)
-	for i, result := range in {
-		wg.Add(1)
-		textProperties := p.getTextProperties(result, nil)
-		go func(result search.Result, textProperties map[string]string, i int) {
-			sem <- struct{}{}
-			defer wg.Done()
-			defer func() { <-sem }()
-			generateResult, err := p.client.GenerateSingleResult(ctx, textProperties, prompt, cfg)
-			p.setIndividualResult(in, i, generateResult, err)
-		}(result, textProperties, i)
+	textProperties := make([]map[string]string, len(in))
+	for i := range in {
+		textProperties[i] = p.getTextProperties(in[i], nil)
+	}
+	for i, result := range p.pipeline.Map(ctx, textProperties, prompt, cfg) {
+		p.setIndividualResult(in, i, result)
 	}
-	wg.Wait()
 	return in, nil
 }
 
@@ -81,8 +74,7 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context, in [
 	for _, res := range in {
 		propertiesForAllDocs = append(propertiesForAllDocs, p.getTextProperties(res, properties))
 	}
-	generateResult, err := p.client.GenerateAllResults(ctx, propertiesForAllDocs, task, cfg)
-	p.setCombinedResult(in, 0, generateResult, err)
+	p.setCombinedResult(in, 0, p.pipeline.Reduce(ctx, propertiesForAllDocs, task, cfg))
 	return in, nil
 }
 
@@ -105,46 +97,42 @@ func (p *GenerateProvider) getTextProperties(result search.Result, properties []
 	return textProperties
 }
 
-func (p *GenerateProvider) setCombinedResult(in []search.Result, i int, generateResult *generativemodels.GenerateResponse, err error) {
+func (p *GenerateProvider) setCombinedResult(in []search.Result, i int, result generative.Result) {
 	ap := in[i].AdditionalProperties
 	if ap == nil {
 		ap = models.AdditionalProperties{}
 	}
 
-	var result *string
-	if generateResult != nil {
-		result = generateResult.Result
-	}
-
 	ap["generate"] = &generativemodels.GenerateResult{
-		GroupedResult: result,
-		Error:         err,
+		GroupedResult: result.Text,
+		Error:         result.Err,
 	}
 
 	in[i].AdditionalProperties = ap
 }
 
-func (p *GenerateProvider) setIndividualResult(in []search.Result, i int, generateResult *generativemodels.GenerateResponse, err error) {
-	var result *string
-	if generateResult != nil {
-		result = generateResult.Result
-	}
-
+func (p *GenerateProvider) setIndividualResult(in []search.Result, i int, result generative.Result) {
 	ap := in[i].AdditionalProperties
 	if ap == nil {
 		ap = models.AdditionalProperties{}
 	}
 
 	if ap["generate"] != nil {
+		grouped := ap["generate"].(*generativemodels.GenerateResult)
+		err := result.Err
+		if err == nil {
+			// keep the error of the grouped result
+			err = grouped.Error
+		}
 		ap["generate"] = &generativemodels.GenerateResult{
-			GroupedResult: ap["generate"].(*generativemodels.GenerateResult).GroupedResult,
-			SingleResult:  result,
+			GroupedResult: grouped.GroupedResult,
+			SingleResult:  result.Text,
 			Error:         err,
 		}
 	} else {
 		ap["generate"] = &generativemodels.GenerateResult{
-			SingleResult: result,
-			Error:        err,
+			SingleResult: result.Text,
+			Error:        result.Err,
 		}
 	}
 
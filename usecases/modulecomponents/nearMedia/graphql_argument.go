@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func nearMediaArgument(mediaType modulecapabilities.MediaType,
+	prefix, className string,
+) *graphql.ArgumentConfig {
+	prefixName := fmt.Sprintf("Media%s%s", prefix, className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sNear%sInpObj", prefixName, title(mediaType)),
+				Fields:      nearMediaFields(mediaType),
+				Description: descriptions.GetWhereInpObj,
+			},
+		),
+	}
+}
+
+func nearMediaFields(mediaType modulecapabilities.MediaType) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		string(mediaType): &graphql.InputObjectFieldConfig{
+			Description: fmt.Sprintf("Base64 encoded %s", mediaType),
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+// ArgumentName is the name of the search operator of the media type, e.g.
+// nearImage for images
+func ArgumentName(mediaType modulecapabilities.MediaType) string {
+	return "near" + title(mediaType)
+}
+
+func title(mediaType modulecapabilities.MediaType) string {
+	name := string(mediaType)
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func TestNearMediaGraphQLArgument(t *testing.T) {
+	t.Run("should generate nearAudio argument properly", func(t *testing.T) {
+		// the built graphQL field needs to support this structure:
+		// nearAudio: {
+		//   audio: "base64;encoded,audio",
+		//   distance: 0.4
+		// }
+		nearAudio := nearMediaArgument(modulecapabilities.MediaAudio, "Prefix", "Class")
+
+		assert.NotNil(t, nearAudio)
+		assert.Equal(t, "MediaPrefixClassNearAudioInpObj", nearAudio.Type.Name())
+		fields := nearAudio.Type.(*graphql.InputObject).Fields()
+		assert.Equal(t, 3, len(fields))
+		audio, ok := fields["audio"].Type.(*graphql.NonNull)
+		assert.True(t, ok)
+		assert.Equal(t, "String", audio.OfType.Name())
+		assert.NotNil(t, fields["certainty"])
+		assert.NotNil(t, fields["distance"])
+	})
+
+	t.Run("should name the arguments after the media types", func(t *testing.T) {
+		arguments := New([]modulecapabilities.MediaType{
+			modulecapabilities.MediaImage,
+			modulecapabilities.MediaVideo,
+		}).Arguments()
+
+		assert.Len(t, arguments, 2)
+		assert.Contains(t, arguments, "nearImage")
+		assert.Contains(t, arguments, "nearVideo")
+	})
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import "github.com/weaviate/weaviate/entities/modulecapabilities"
+
+// extractNearMediaFn returns the extract function of the media type's
+// arguments, such as "image" and "certainty" for nearImage
+func extractNearMediaFn(mediaType modulecapabilities.MediaType) func(map[string]interface{}) interface{} {
+	return func(source map[string]interface{}) interface{} {
+		args := NearMediaParams{MediaType: mediaType}
+
+		media, ok := source[string(mediaType)].(string)
+		if ok {
+			args.Media = media
+		}
+
+		certainty, ok := source["certainty"]
+		if ok {
+			args.Certainty = certainty.(float64)
+		}
+
+		distance, ok := source["distance"]
+		if ok {
+			args.Distance = distance.(float64)
+			args.WithDistance = true
+		}
+
+		return &args
+	}
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func Test_extractNearMediaFn(t *testing.T) {
+	extract := extractNearMediaFn(modulecapabilities.MediaVideo)
+
+	t.Run("should extract properly with video and certainty set", func(t *testing.T) {
+		got := extract(map[string]interface{}{
+			"video":     "base64;encoded",
+			"certainty": float64(0.9),
+		})
+		assert.Equal(t, &NearMediaParams{
+			MediaType: modulecapabilities.MediaVideo,
+			Media:     "base64;encoded",
+			Certainty: 0.9,
+		}, got)
+	})
+
+	t.Run("should extract properly with video and distance set", func(t *testing.T) {
+		got := extract(map[string]interface{}{
+			"video":    "base64;encoded",
+			"distance": 0.4,
+		})
+		assert.Equal(t, &NearMediaParams{
+			MediaType:    modulecapabilities.MediaVideo,
+			Media:        "base64;encoded",
+			Distance:     0.4,
+			WithDistance: true,
+		}, got)
+	})
+}
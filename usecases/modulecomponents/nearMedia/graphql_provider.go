@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// GraphQLArgumentsProvider provides the near<Media> arguments of each of the
+// media types of a modulecapabilities.MediaVectorizer
+type GraphQLArgumentsProvider struct {
+	mediaTypes []modulecapabilities.MediaType
+}
+
+func New(mediaTypes []modulecapabilities.MediaType) *GraphQLArgumentsProvider {
+	return &GraphQLArgumentsProvider{mediaTypes: mediaTypes}
+}
+
+func (g *GraphQLArgumentsProvider) Arguments() map[string]modulecapabilities.GraphQLArgument {
+	arguments := map[string]modulecapabilities.GraphQLArgument{}
+	for _, mediaType := range g.mediaTypes {
+		arguments[ArgumentName(mediaType)] = g.getNearMedia(mediaType)
+	}
+	return arguments
+}
+
+func (g *GraphQLArgumentsProvider) getNearMedia(mediaType modulecapabilities.MediaType) modulecapabilities.GraphQLArgument {
+	return modulecapabilities.GraphQLArgument{
+		GetArgumentsFunction: func(classname string) *graphql.ArgumentConfig {
+			return nearMediaArgument(mediaType, "GetObjects", classname)
+		},
+		AggregateArgumentsFunction: func(classname string) *graphql.ArgumentConfig {
+			return nearMediaArgument(mediaType, "Aggregate", classname)
+		},
+		ExploreArgumentsFunction: func() *graphql.ArgumentConfig {
+			return nearMediaArgument(mediaType, "Explore", "")
+		},
+		ExtractFunction:  extractNearMediaFn(mediaType),
+		ValidateFunction: validateNearMediaFn,
+	}
+}
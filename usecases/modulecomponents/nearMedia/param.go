@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+type NearMediaParams struct {
+	MediaType    modulecapabilities.MediaType
+	Media        string
+	Certainty    float64
+	Distance     float64
+	WithDistance bool
+}
+
+func (n NearMediaParams) GetCertainty() float64 {
+	return n.Certainty
+}
+
+func (n NearMediaParams) GetDistance() float64 {
+	return n.Distance
+}
+
+func (n NearMediaParams) SimilarityMetricProvided() bool {
+	return n.Certainty != 0 || n.WithDistance
+}
+
+func validateNearMediaFn(param interface{}) error {
+	nearMedia, ok := param.(*NearMediaParams)
+	if !ok {
+		return errors.New("'nearMedia' invalid parameter")
+	}
+
+	name := ArgumentName(nearMedia.MediaType)
+	if len(nearMedia.Media) == 0 {
+		return errors.Errorf("'%s.%s' needs to be defined", name, nearMedia.MediaType)
+	}
+
+	if nearMedia.Certainty != 0 && nearMedia.WithDistance {
+		return errors.Errorf(
+			"%s cannot provide both distance and certainty", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"testing"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func Test_validateNearMediaFn(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   interface{}
+		wantErr bool
+	}{
+		{
+			name: "should pass with proper values",
+			param: &NearMediaParams{
+				MediaType: modulecapabilities.MediaAudio,
+				Media:     "base64;encoded",
+			},
+		},
+		{
+			name:    "should not pass with empty media",
+			param:   &NearMediaParams{MediaType: modulecapabilities.MediaAudio},
+			wantErr: true,
+		},
+		{
+			name: "should not pass with struct param, not a pointer to struct",
+			param: NearMediaParams{
+				MediaType: modulecapabilities.MediaAudio,
+				Media:     "base64;encoded",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not pass with distance and certainty set",
+			param: &NearMediaParams{
+				MediaType:    modulecapabilities.MediaAudio,
+				Media:        "base64;encoded",
+				Distance:     0.4,
+				WithDistance: true,
+				Certainty:    0.7,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateNearMediaFn(tt.param); (err != nil) != tt.wantErr {
+				t.Errorf("validateNearMediaFn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nearMedia
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// Searcher passes the media of near<Media> searches to a
+// modulecapabilities.MediaVectorizer
+type Searcher struct {
+	vectorizer modulecapabilities.MediaVectorizer
+}
+
+func NewSearcher(vectorizer modulecapabilities.MediaVectorizer) *Searcher {
+	return &Searcher{vectorizer}
+}
+
+func (s *Searcher) VectorSearches() map[string]modulecapabilities.VectorForParams {
+	vectorSearches := map[string]modulecapabilities.VectorForParams{}
+	for _, mediaType := range s.vectorizer.MediaTypes() {
+		vectorSearches[ArgumentName(mediaType)] = s.vectorForNearMediaParam
+	}
+	return vectorSearches
+}
+
+func (s *Searcher) vectorForNearMediaParam(ctx context.Context, params interface{},
+	className string,
+	findVectorFn modulecapabilities.FindVectorFn,
+	cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	nearMedia := params.(*NearMediaParams)
+	vector, err := s.vectorizer.VectorizeMedia(ctx, nearMedia.MediaType, nearMedia.Media, cfg)
+	if err != nil {
+		return nil, errors.Errorf("vectorize %s: %v", nearMedia.MediaType, err)
+	}
+
+	return vector, nil
+}
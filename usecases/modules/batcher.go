@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBatcherMaxBatchSize   = 32
+	DefaultBatcherMaxWait        = 10 * time.Millisecond
+	DefaultBatcherMaxConcurrency = 4
+	DefaultBatcherMaxRetries     = 3
+	DefaultBatcherInitialBackoff = 100 * time.Millisecond
+	DefaultBatcherMaxBackoff     = 5 * time.Second
+)
+
+// BatchFunc embeds the texts in a single request to a vectorizer's
+// inference API and returns one vector per text
+type BatchFunc func(ctx context.Context, texts []string) ([][]float32, error)
+
+type BatcherConfig struct {
+	// MaxBatchSize is the maximum number of texts sent in a single request
+	MaxBatchSize int
+	// MaxWait is how long a batch waits for more texts before it is sent
+	MaxWait time.Duration
+	// MaxConcurrency is the maximum number of requests in flight at once
+	MaxConcurrency int
+	// MaxRetries is how often a failed request is retried
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func (c BatcherConfig) withDefaults() BatcherConfig {
+	if c.MaxBatchSize <= 0 {
+		c.MaxBatchSize = DefaultBatcherMaxBatchSize
+	}
+	if c.MaxWait <= 0 {
+		c.MaxWait = DefaultBatcherMaxWait
+	}
+	if c.MaxConcurrency <= 0 {
+		c.MaxConcurrency = DefaultBatcherMaxConcurrency
+	}
+	if c.MaxRetries < 0 {
+		c.MaxRetries = 0
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = DefaultBatcherInitialBackoff
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		c.MaxBackoff = c.InitialBackoff
+	}
+	return c
+}
+
+// Batcher coalesces vectorization requests of concurrent callers into
+// batches, so an import of many objects results in few requests to the
+// inference API of a vectorizer. Texts are only batched with other texts
+// of the same key, as texts of different classes may be vectorized with
+// different settings. There is one Batcher per vectorizer, its concurrency
+// limit applies across all keys
+type Batcher struct {
+	config  BatcherConfig
+	sem     chan struct{}
+	sleep   func(ctx context.Context, d time.Duration) error
+	mu      sync.Mutex
+	pending map[string]*pendingBatch
+}
+
+type pendingBatch struct {
+	fn    BatchFunc
+	items []*batchItem
+	timer *time.Timer
+}
+
+type batchItem struct {
+	ctx    context.Context
+	text   string
+	result chan batchResult
+}
+
+type batchResult struct {
+	vector []float32
+	err    error
+}
+
+func NewBatcher(config BatcherConfig) *Batcher {
+	config = config.withDefaults()
+	return &Batcher{
+		config:  config,
+		sem:     make(chan struct{}, config.MaxConcurrency),
+		sleep:   sleepContext,
+		pending: map[string]*pendingBatch{},
+	}
+}
+
+// Vectorize adds the text to the pending batch of the key and blocks until
+// the batch has been vectorized. A batch is sent once it is full or once
+// MaxWait has passed since its first text was added. fn is used to send
+// the batch if the text starts a new one
+func (b *Batcher) Vectorize(ctx context.Context, key, text string,
+	fn BatchFunc,
+) ([]float32, error) {
+	item := &batchItem{ctx: ctx, text: text, result: make(chan batchResult, 1)}
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &pendingBatch{fn: fn}
+		b.pending[key] = batch
+		batch.timer = time.AfterFunc(b.config.MaxWait, func() {
+			b.flush(key, batch)
+		})
+	}
+	batch.items = append(batch.items, item)
+	full := len(batch.items) >= b.config.MaxBatchSize
+	if full {
+		delete(b.pending, key)
+		batch.timer.Stop()
+	}
+	b.mu.Unlock()
+
+	if full {
+		go b.send(batch)
+	}
+
+	select {
+	case res := <-item.result:
+		return res.vector, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends the batch once MaxWait has passed, unless it has already been
+// sent because it was full
+func (b *Batcher) flush(key string, batch *pendingBatch) {
+	b.mu.Lock()
+	current := b.pending[key] == batch
+	if current {
+		delete(b.pending, key)
+	}
+	b.mu.Unlock()
+
+	if current {
+		b.send(batch)
+	}
+}
+
+func (b *Batcher) send(batch *pendingBatch) {
+	b.sem <- struct{}{}
+	defer func() { <-b.sem }()
+
+	// texts of callers which gave up waiting in the meantime are not sent
+	items := make([]*batchItem, 0, len(batch.items))
+	texts := make([]string, 0, len(batch.items))
+	for _, item := range batch.items {
+		if item.ctx.Err() != nil {
+			continue
+		}
+		items = append(items, item)
+		texts = append(texts, item.text)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	// the request is shared by all callers of the batch, so it must not be
+	// canceled if one of them gives up. Values such as API keys passed in
+	// the headers are still taken from the caller which started the batch
+	vectors, err := b.vectorizeWithRetry(detachedContext{batch.items[0].ctx},
+		batch.fn, texts)
+	if err == nil && len(vectors) != len(texts) {
+		err = fmt.Errorf("batch vectorize: expected %d vectors, got %d",
+			len(texts), len(vectors))
+	}
+
+	for i, item := range items {
+		if err != nil {
+			item.result <- batchResult{err: err}
+			continue
+		}
+		item.result <- batchResult{vector: vectors[i]}
+	}
+}
+
+func (b *Batcher) vectorizeWithRetry(ctx context.Context, fn BatchFunc,
+	texts []string,
+) ([][]float32, error) {
+	backoff := b.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		vectors, err := fn(ctx, texts)
+		if err == nil {
+			return vectors, nil
+		}
+		if attempt >= b.config.MaxRetries {
+			return nil, fmt.Errorf("batch vectorize: giving up after %d attempts: %w",
+				attempt+1, err)
+		}
+
+		if err := b.sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+		if backoff > b.config.MaxBackoff {
+			backoff = b.config.MaxBackoff
+		}
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// detachedContext keeps the values of the parent context, but is never
+// canceled
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (c detachedContext) Done() <-chan struct{}             { return nil }
+func (c detachedContext) Err() error                        { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
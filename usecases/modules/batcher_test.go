@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcher(t *testing.T) {
+	lengthVectors := func(texts []string) [][]float32 {
+		vectors := make([][]float32, len(texts))
+		for i, text := range texts {
+			vectors[i] = []float32{float32(len(text))}
+		}
+		return vectors
+	}
+
+	vectorizeConcurrently := func(b *Batcher, keys []string, fn BatchFunc) ([][]float32, []error) {
+		vectors := make([][]float32, len(keys))
+		errs := make([]error, len(keys))
+		var wg sync.WaitGroup
+		for i := range keys {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				text := fmt.Sprintf("%*s", i+1, "")
+				vectors[i], errs[i] = b.Vectorize(context.Background(), keys[i], text, fn)
+			}(i)
+		}
+		wg.Wait()
+		return vectors, errs
+	}
+
+	t.Run("coalesces concurrent requests into full batches", func(t *testing.T) {
+		var lock sync.Mutex
+		var batches []int
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			lock.Lock()
+			batches = append(batches, len(texts))
+			lock.Unlock()
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 5, MaxWait: time.Hour})
+		vectors, errs := vectorizeConcurrently(b, []string{
+			"C", "C", "C", "C", "C", "C", "C", "C", "C", "C",
+		}, fn)
+
+		for i := range vectors {
+			require.Nil(t, errs[i])
+			assert.Equal(t, []float32{float32(i + 1)}, vectors[i])
+		}
+		assert.Equal(t, []int{5, 5}, batches)
+	})
+
+	t.Run("sends partial batches after max wait", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			atomic.AddInt32(&calls, 1)
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 100, MaxWait: 10 * time.Millisecond})
+		vectors, errs := vectorizeConcurrently(b, []string{"C", "C", "C"}, fn)
+
+		for i := range vectors {
+			require.Nil(t, errs[i])
+			assert.Equal(t, []float32{float32(i + 1)}, vectors[i])
+		}
+		assert.LessOrEqual(t, atomic.LoadInt32(&calls), int32(3))
+	})
+
+	t.Run("does not mix keys in a batch", func(t *testing.T) {
+		var lock sync.Mutex
+		var batches []int
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			lock.Lock()
+			batches = append(batches, len(texts))
+			lock.Unlock()
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 2, MaxWait: time.Hour})
+		vectors, errs := vectorizeConcurrently(b, []string{"A", "B", "A", "B"}, fn)
+
+		for i := range vectors {
+			require.Nil(t, errs[i])
+			assert.Equal(t, []float32{float32(i + 1)}, vectors[i])
+		}
+		assert.Equal(t, []int{2, 2}, batches)
+	})
+
+	t.Run("limits concurrent requests", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				seen := atomic.LoadInt32(&maxInFlight)
+				if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 1, MaxConcurrency: 2})
+		_, errs := vectorizeConcurrently(b, []string{"C", "C", "C", "C", "C", "C"}, fn)
+
+		for _, err := range errs {
+			require.Nil(t, err)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+	})
+
+	t.Run("retries failed requests with backoff", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				return nil, errors.New("rate limited")
+			}
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{
+			MaxBatchSize:   1,
+			MaxRetries:     3,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     15 * time.Millisecond,
+		})
+		var backoffs []time.Duration
+		b.sleep = func(ctx context.Context, d time.Duration) error {
+			backoffs = append(backoffs, d)
+			return nil
+		}
+
+		vector, err := b.Vectorize(context.Background(), "C", "abc", fn)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{3}, vector)
+		assert.Equal(t, []time.Duration{10 * time.Millisecond, 15 * time.Millisecond}, backoffs)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, errors.New("rate limited")
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 1, MaxRetries: 2})
+		b.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+
+		_, err := b.Vectorize(context.Background(), "C", "abc", fn)
+		assert.EqualError(t, err, "batch vectorize: giving up after 3 attempts: rate limited")
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("fails if the vectorizer returns too few vectors", func(t *testing.T) {
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			return nil, nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 1})
+		_, err := b.Vectorize(context.Background(), "C", "abc", fn)
+		assert.EqualError(t, err, "batch vectorize: expected 1 vectors, got 0")
+	})
+
+	t.Run("keeps the values of the caller's context", func(t *testing.T) {
+		type ctxKey struct{}
+		var apiKey interface{}
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			apiKey = ctx.Value(ctxKey{})
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 1})
+		ctx := context.WithValue(context.Background(), ctxKey{}, "secret")
+		_, err := b.Vectorize(ctx, "C", "abc", fn)
+		require.Nil(t, err)
+		assert.Equal(t, "secret", apiKey)
+	})
+
+	t.Run("stops waiting once the context is canceled", func(t *testing.T) {
+		fn := func(ctx context.Context, texts []string) ([][]float32, error) {
+			return lengthVectors(texts), nil
+		}
+
+		b := NewBatcher(BatcherConfig{MaxBatchSize: 100, MaxWait: time.Hour})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := b.Vectorize(ctx, "C", "abc", fn)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// DistanceMetric returns the custom distance metric with the given name if
+// any of the modules provides it
+func (p *Provider) DistanceMetric(name string) (modulecapabilities.DistanceMetric, bool) {
+	for _, mod := range p.GetAll() {
+		if module, ok := mod.(modulecapabilities.DistanceMetrics); ok {
+			if metric, ok := module.DistanceMetrics()[name]; ok {
+				return metric, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// ValidateVectorIndexConfig makes sure that a custom distance metric set in
+// the vector index config is provided by a module and accepts the configured
+// params. Builtin distance metrics are validated by the vector index itself.
+func (p *Provider) ValidateVectorIndexConfig(cfg schema.VectorIndexConfig) error {
+	hnswConfig, ok := vectorindex.HnswConfig(cfg)
+	if !ok || hnsw.IsBuiltinDistance(hnswConfig.Distance) {
+		return nil
+	}
+
+	metric, ok := p.DistanceMetric(hnswConfig.Distance)
+	if !ok {
+		return errors.Errorf("unrecognized distance metric %q", hnswConfig.Distance)
+	}
+
+	if err := metric.ValidateParams(hnswConfig.DistanceParams); err != nil {
+		return errors.Wrapf(err, "distance metric %q", hnswConfig.Distance)
+	}
+
+	return nil
+}
+
+func (p *Provider) validateDistanceMetrics() []string {
+	metrics := map[string][]string{}
+	for _, mod := range p.GetAll() {
+		if module, ok := mod.(modulecapabilities.DistanceMetrics); ok {
+			for name := range module.DistanceMetrics() {
+				metrics[name] = append(metrics[name], mod.Name())
+			}
+		}
+	}
+
+	var errorMessages []string
+	for name, modules := range metrics {
+		if hnsw.IsBuiltinDistance(name) {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("distance metric: %s conflicts with weaviate's builtin distance metric in modules: %v",
+					name, modules))
+		} else if len(modules) > 1 {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("distance metric: %s is provided by multiple modules: %v",
+					name, modules))
+		}
+	}
+	sort.Strings(errorMessages)
+	return errorMessages
+}
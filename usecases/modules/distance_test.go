@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDistanceMetrics(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("validate custom distance of a vector index config", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDistanceModule("mod1", "weighted-l2"))
+		require.Nil(t, p.Init(context.Background(), nil, logger))
+
+		cfg := hnsw.NewDefaultUserConfig()
+		assert.Nil(t, p.ValidateVectorIndexConfig(cfg))
+
+		cfg.Distance = "weighted-l2"
+		cfg.DistanceParams = map[string]interface{}{"weights": []interface{}{1.0}}
+		assert.Nil(t, p.ValidateVectorIndexConfig(cfg))
+
+		cfg.DistanceParams = nil
+		err := p.ValidateVectorIndexConfig(cfg)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "weights are required")
+
+		cfg.Distance = "mahalanobis"
+		err = p.ValidateVectorIndexConfig(cfg)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized distance metric \"mahalanobis\"")
+	})
+
+	t.Run("build distance function", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDistanceModule("mod1", "weighted-l2"))
+		require.Nil(t, p.Init(context.Background(), nil, logger))
+
+		metric, ok := p.DistanceMetric("weighted-l2")
+		require.True(t, ok)
+		fn, err := metric.DistanceFn(nil)
+		require.Nil(t, err)
+		assert.Equal(t, float32(4), fn([]float32{1, 2}, []float32{3, 2}))
+
+		_, ok = p.DistanceMetric("mahalanobis")
+		assert.False(t, ok)
+	})
+
+	t.Run("metric provided by multiple modules", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDistanceModule("mod1", "weighted-l2"))
+		p.Register(newDistanceModule("mod2", "weighted-l2"))
+		err := p.Init(context.Background(), nil, logger)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "distance metric: weighted-l2 is provided by multiple modules")
+	})
+
+	t.Run("metric conflicts with a builtin metric", func(t *testing.T) {
+		p := NewProvider()
+		p.Register(newDistanceModule("mod1", hnsw.DistanceCosine))
+		err := p.Init(context.Background(), nil, logger)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "conflicts with weaviate's builtin distance metric")
+	})
+}
+
+func newDistanceModule(name, metric string) *dummyDistanceModule {
+	return &dummyDistanceModule{
+		dummyNonVectorizerModule: newDummyNonVectorizerModule(name),
+		metric:                   metric,
+	}
+}
+
+type dummyDistanceModule struct {
+	dummyNonVectorizerModule
+	metric string
+}
+
+func (m *dummyDistanceModule) DistanceMetrics() map[string]modulecapabilities.DistanceMetric {
+	return map[string]modulecapabilities.DistanceMetric{m.metric: dummyDistanceMetric{}}
+}
+
+type dummyDistanceMetric struct{}
+
+func (dummyDistanceMetric) ValidateParams(params map[string]interface{}) error {
+	if params["weights"] == nil {
+		return errors.New("weights are required")
+	}
+	return nil
+}
+
+func (dummyDistanceMetric) DistanceFn(params map[string]interface{}) (modulecapabilities.DistanceFn, error) {
+	return func(a, b []float32) float32 {
+		var sum float32
+		for i := range a {
+			sum += (a[i] - b[i]) * (a[i] - b[i])
+		}
+		return sum
+	}, nil
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// EmbeddingCache keeps the vectors of texts which were vectorized before.
+// Keys are a hash of the vectorizer, its class settings (which select the
+// model) and the text, see embeddingCacheKey. The module name is only
+// passed on for metrics
+type EmbeddingCache interface {
+	Get(module string, key []byte) ([]float32, bool, error)
+	Put(module string, key []byte, vector []float32) error
+}
+
+// SetEmbeddingCache enables the embedding cache for vectorizers which
+// implement modulecapabilities.BatchVectorizer, as only those expose the
+// text they vectorize
+func (p *Provider) SetEmbeddingCache(cache EmbeddingCache) {
+	p.embeddingCache = cache
+}
+
+func embeddingCacheKey(modName string, cfg moduletools.ClassConfig,
+	text string,
+) ([]byte, error) {
+	settings, err := json.Marshal(cfg.Class())
+	if err != nil {
+		return nil, fmt.Errorf("marshal vectorizer settings: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(modName))
+	h.Write([]byte{0})
+	h.Write(settings)
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return h.Sum(nil), nil
+}
@@ -14,6 +14,7 @@ package modules
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/mock"
@@ -70,6 +71,62 @@ func (m dummyText2VecModuleNoCapabilities) VectorizeObject(ctx context.Context,
 	return nil
 }
 
+// dummyBatchText2VecModule embeds every text as a vector holding its length
+// and records the size of each batch it receives
+type dummyBatchText2VecModule struct {
+	dummyText2VecModuleNoCapabilities
+	mu      sync.Mutex
+	batches []int
+}
+
+func (m *dummyBatchText2VecModule) ObjectText(ctx context.Context,
+	in *models.Object, objDiff *moduletools.ObjectDiff, cfg moduletools.ClassConfig,
+) (string, bool, error) {
+	if objDiff != nil && objDiff.GetVec() != nil {
+		in.Vector = objDiff.GetVec()
+		return "", false, nil
+	}
+	text, _ := in.Properties.(map[string]interface{})["text"].(string)
+	return text, true, nil
+}
+
+func (m *dummyBatchText2VecModule) VectorizeBatch(ctx context.Context,
+	texts []string, cfg moduletools.ClassConfig,
+) ([][]float32, error) {
+	m.mu.Lock()
+	m.batches = append(m.batches, len(texts))
+	m.mu.Unlock()
+
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text))}
+	}
+	return vectors, nil
+}
+
+func (m *dummyBatchText2VecModule) BatchSettings() modulecapabilities.BatchSettings {
+	return modulecapabilities.BatchSettings{MaxBatchSize: 4, MaxConcurrency: 1}
+}
+
+type fakeEmbeddingCache struct {
+	mu      sync.Mutex
+	vectors map[string][]float32
+}
+
+func (c *fakeEmbeddingCache) Get(module string, key []byte) ([]float32, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vector, ok := c.vectors[string(key)]
+	return vector, ok, nil
+}
+
+func (c *fakeEmbeddingCache) Put(module string, key []byte, vector []float32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vectors[string(key)] = vector
+	return nil
+}
+
 func newDummyRef2VecModule(name string) dummyRef2VecModuleNoCapabilities {
 	return dummyRef2VecModuleNoCapabilities{name: name}
 }
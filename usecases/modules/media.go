@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/nearMedia"
+)
+
+// moduleArguments returns the GraphQL arguments of the module. For a
+// MediaVectorizer these include the near<Media> arguments of its media
+// types, unless the module provides an argument of the same name itself
+func (p *Provider) moduleArguments(mod modulecapabilities.Module) map[string]modulecapabilities.GraphQLArgument {
+	var arguments map[string]modulecapabilities.GraphQLArgument
+	if vectorizer, ok := mod.(modulecapabilities.MediaVectorizer); ok {
+		arguments = nearMedia.New(vectorizer.MediaTypes()).Arguments()
+	}
+	if module, ok := mod.(modulecapabilities.GraphQLArguments); ok {
+		if arguments == nil {
+			return module.Arguments()
+		}
+		for name, argument := range module.Arguments() {
+			arguments[name] = argument
+		}
+	}
+	return arguments
+}
+
+// moduleVectorSearches returns the searchers of the module, see
+// moduleArguments
+func (p *Provider) moduleVectorSearches(mod modulecapabilities.Module) modulecapabilities.ArgumentVectorForParams {
+	var vectorSearches modulecapabilities.ArgumentVectorForParams
+	if vectorizer, ok := mod.(modulecapabilities.MediaVectorizer); ok {
+		vectorSearches = nearMedia.NewSearcher(vectorizer).VectorSearches()
+	}
+	if searcher, ok := mod.(modulecapabilities.Searcher); ok {
+		if vectorSearches == nil {
+			return searcher.VectorSearches()
+		}
+		for name, searchVectorFn := range searcher.VectorSearches() {
+			vectorSearches[name] = searchVectorFn
+		}
+	}
+	return vectorSearches
+}
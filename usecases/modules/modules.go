@@ -14,6 +14,7 @@ package modules
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -39,6 +40,11 @@ type Provider struct {
 	altNames               map[string]string
 	schemaGetter           schemaGetter
 	hasMultipleVectorizers bool
+	batchersLock           sync.Mutex
+	batchers               map[string]*Batcher
+	embeddingCache         EmbeddingCache    // nil unless the embedding cache is enabled
+	queryVectorCache       *QueryVectorCache // nil unless the query vector cache is enabled
+	initializedEarly       map[string]bool   // modules Init skips, see InitKeyManager
 }
 
 type schemaGetter interface {
@@ -49,6 +55,9 @@ func NewProvider() *Provider {
 	return &Provider{
 		registered: map[string]modulecapabilities.Module{},
 		altNames:   map[string]string{},
+		batchers:   map[string]*Batcher{},
+
+		initializedEarly: map[string]bool{},
 	}
 }
 
@@ -100,6 +109,9 @@ func (p *Provider) Init(ctx context.Context,
 	params moduletools.ModuleInitParams, logger logrus.FieldLogger,
 ) error {
 	for i, mod := range p.GetAll() {
+		if p.initializedEarly[mod.Name()] {
+			continue
+		}
 		if err := mod.Init(ctx, params); err != nil {
 			return errors.Wrapf(err, "init module %d (%q)", i, mod.Name())
 		} else {
@@ -144,9 +156,9 @@ func (p *Provider) validate() error {
 	additionalGraphQLProps := map[string][]string{}
 	additionalRestAPIProps := map[string][]string{}
 	for _, mod := range p.GetAll() {
-		if module, ok := mod.(modulecapabilities.GraphQLArguments); ok {
+		if arguments := p.moduleArguments(mod); arguments != nil {
 			allArguments := []string{}
-			for paraName, argument := range module.Arguments() {
+			for paraName, argument := range arguments {
 				if argument.ExtractFunction != nil {
 					allArguments = append(allArguments, paraName)
 				}
@@ -169,6 +181,7 @@ func (p *Provider) validate() error {
 		p.validateModules("graphql additional property", additionalGraphQLProps, internalAdditionalProperties)...)
 	errorMessages = append(errorMessages,
 		p.validateModules("rest api additional property", additionalRestAPIProps, internalAdditionalProperties)...)
+	errorMessages = append(errorMessages, p.validateDistanceMetrics()...)
 	if len(errorMessages) > 0 {
 		return errors.Errorf("%v", errorMessages)
 	}
@@ -295,11 +308,9 @@ func (p *Provider) GetArguments(class *models.Class) map[string]*graphql.Argumen
 	arguments := map[string]*graphql.ArgumentConfig{}
 	for _, module := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, module.Name(), module.Type()) {
-			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
-				for name, argument := range arg.Arguments() {
-					if argument.GetArgumentsFunction != nil {
-						arguments[name] = argument.GetArgumentsFunction(class.Class)
-					}
+			for name, argument := range p.moduleArguments(module) {
+				if argument.GetArgumentsFunction != nil {
+					arguments[name] = argument.GetArgumentsFunction(class.Class)
 				}
 			}
 		}
@@ -312,11 +323,9 @@ func (p *Provider) AggregateArguments(class *models.Class) map[string]*graphql.A
 	arguments := map[string]*graphql.ArgumentConfig{}
 	for _, module := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, module.Name(), module.Type()) {
-			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
-				for name, argument := range arg.Arguments() {
-					if argument.AggregateArgumentsFunction != nil {
-						arguments[name] = argument.AggregateArgumentsFunction(class.Class)
-					}
+			for name, argument := range p.moduleArguments(module) {
+				if argument.AggregateArgumentsFunction != nil {
+					arguments[name] = argument.AggregateArgumentsFunction(class.Class)
 				}
 			}
 		}
@@ -329,11 +338,9 @@ func (p *Provider) ExploreArguments(schema *models.Schema) map[string]*graphql.A
 	arguments := map[string]*graphql.ArgumentConfig{}
 	for _, module := range p.GetAll() {
 		if p.shouldIncludeArgument(schema, module.Name(), module.Type()) {
-			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
-				for name, argument := range arg.Arguments() {
-					if argument.ExploreArgumentsFunction != nil {
-						arguments[name] = argument.ExploreArgumentsFunction()
-					}
+			for name, argument := range p.moduleArguments(module) {
+				if argument.ExploreArgumentsFunction != nil {
+					arguments[name] = argument.ExploreArgumentsFunction()
 				}
 			}
 		}
@@ -362,12 +369,10 @@ func (p *Provider) extractSearchParams(arguments map[string]interface{}, class *
 	exractedParams := map[string]interface{}{}
 	for _, module := range p.GetAll() {
 		if p.shouldCrossClassIncludeClassArgument(class, module.Name(), module.Type()) {
-			if args, ok := module.(modulecapabilities.GraphQLArguments); ok {
-				for paramName, argument := range args.Arguments() {
-					if param, ok := arguments[paramName]; ok && argument.ExtractFunction != nil {
-						extracted := argument.ExtractFunction(param.(map[string]interface{}))
-						exractedParams[paramName] = extracted
-					}
+			for paramName, argument := range p.moduleArguments(module) {
+				if param, ok := arguments[paramName]; ok && argument.ExtractFunction != nil {
+					extracted := argument.ExtractFunction(param.(map[string]interface{}))
+					exractedParams[paramName] = extracted
 				}
 			}
 		}
@@ -395,11 +400,9 @@ func (p *Provider) ValidateSearchParam(name string, value interface{}, className
 func (p *Provider) validateSearchParam(name string, value interface{}, class *models.Class) error {
 	for _, module := range p.GetAll() {
 		if p.shouldCrossClassIncludeClassArgument(class, module.Name(), module.Type()) {
-			if args, ok := module.(modulecapabilities.GraphQLArguments); ok {
-				for paramName, argument := range args.Arguments() {
-					if paramName == name && argument.ValidateFunction != nil {
-						return argument.ValidateFunction(value)
-					}
+			for paramName, argument := range p.moduleArguments(module) {
+				if paramName == name && argument.ValidateFunction != nil {
+					return argument.ValidateFunction(value)
 				}
 			}
 		}
@@ -617,9 +620,9 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
 			var moduleName string
 			var vectorSearches modulecapabilities.ArgumentVectorForParams
-			if searcher, ok := mod.(modulecapabilities.Searcher); ok {
+			if searches := p.moduleVectorSearches(mod); searches != nil {
 				moduleName = mod.Name()
-				vectorSearches = searcher.VectorSearches()
+				vectorSearches = searches
 			} else if searchers, ok := mod.(modulecapabilities.DependencySearcher); ok {
 				if dependencySearchers := searchers.VectorSearches(); dependencySearchers != nil {
 					moduleName = class.Vectorizer
@@ -629,7 +632,10 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 			if vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewClassBasedModuleConfig(class, moduleName, tenant)
-					vector, err := searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
+					vector, err := p.cachedVectorFromSearchParam(moduleName, param, params, cfg, findVectorFn,
+						func(findVectorFn modulecapabilities.FindVectorFn) ([]float32, error) {
+							return searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
+						})
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -650,16 +656,14 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 	findVectorFn modulecapabilities.FindVectorFn,
 ) ([]float32, error) {
 	for _, mod := range p.GetAll() {
-		if searcher, ok := mod.(modulecapabilities.Searcher); ok {
-			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
-				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
-					cfg := NewCrossClassModuleConfig()
-					vector, err := searchVectorFn(ctx, params, "", findVectorFn, cfg)
-					if err != nil {
-						return nil, errors.Errorf("vectorize params: %v", err)
-					}
-					return vector, nil
+		if vectorSearches := p.moduleVectorSearches(mod); vectorSearches != nil {
+			if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
+				cfg := NewCrossClassModuleConfig()
+				vector, err := searchVectorFn(ctx, params, "", findVectorFn, cfg)
+				if err != nil {
+					return nil, errors.Errorf("vectorize params: %v", err)
 				}
+				return vector, nil
 			}
 		}
 	}
@@ -680,7 +684,9 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 			if vectorizer, ok := mod.(modulecapabilities.InputVectorizer); ok {
 				// does not access any objects, therefore tenant is irrelevant
 				cfg := NewClassBasedModuleConfig(class, mod.Name(), "")
-				return vectorizer.VectorizeInput(ctx, input, cfg)
+				return p.cachedVectorFromInput(mod.Name(), input, cfg, func() ([]float32, error) {
+					return vectorizer.VectorizeInput(ctx, input, cfg)
+				})
 			}
 		}
 	}
@@ -688,6 +694,28 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 	return nil, fmt.Errorf("VectorFromInput was called without vectorizer")
 }
 
+// Rerank scores the documents for their relevance to the query with the
+// reranker module of the class
+func (p *Provider) Rerank(ctx context.Context, className string,
+	query string, documents []string,
+) ([]float64, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mod := range p.GetAll() {
+		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
+			if reranker, ok := mod.(modulecapabilities.Reranker); ok {
+				cfg := NewClassBasedModuleConfig(class, mod.Name(), "")
+				return reranker.Rerank(ctx, query, documents, cfg)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no reranker module enabled for class %s", className)
+}
+
 // ParseClassifierSettings parses and adds classifier specific settings
 func (p *Provider) ParseClassifierSettings(name string,
 	params *models.Classification,
@@ -770,3 +798,36 @@ func (p *Provider) BackupBackend(backend string) (modulecapabilities.BackupBacke
 	}
 	return nil, errors.Errorf("backup: %s not found", backend)
 }
+
+// InitKeyManager initializes the key management module with the given name
+// ahead of all other modules, as its keys are needed to open the database.
+// Init skips the module afterwards.
+func (p *Provider) InitKeyManager(ctx context.Context, name string,
+	params moduletools.ModuleInitParams,
+) (modulecapabilities.KeyManager, error) {
+	manager, err := p.KeyManager(name)
+	if err != nil {
+		return nil, err
+	}
+
+	mod := p.GetByName(name)
+	if err := mod.Init(ctx, params); err != nil {
+		return nil, errors.Wrapf(err, "init module %q", mod.Name())
+	}
+	if p.initializedEarly == nil {
+		p.initializedEarly = map[string]bool{}
+	}
+	p.initializedEarly[mod.Name()] = true
+	return manager, nil
+}
+
+func (p *Provider) KeyManager(name string) (modulecapabilities.KeyManager, error) {
+	if module := p.GetByName(name); module != nil {
+		if module.Type() == modulecapabilities.KeyManagement {
+			if manager, ok := module.(modulecapabilities.KeyManager); ok {
+				return manager, nil
+			}
+		}
+	}
+	return nil, errors.Errorf("key management: %s not found", name)
+}
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// cachedSearches are the search arguments whose vectors are kept in the
+// QueryVectorCache. Their vectors only depend on the text of the query,
+// unless they move towards or away from objects, see cachedVectorFromSearchParam
+var cachedSearches = map[string]bool{
+	"nearText": true,
+}
+
+// QueryVectorCache keeps the vectors of nearText and hybrid queries, so
+// repeated identical queries, e.g. of dashboards, skip the round trip to
+// the vectorizer. It is an LRU cache whose entries expire after the TTL
+type QueryVectorCache struct {
+	maxEntries int
+	ttl        time.Duration
+	metrics    *prometheus.CounterVec // nil without monitoring
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // most recently used first
+	hits    uint64
+	misses  uint64
+}
+
+type queryVectorCacheEntry struct {
+	key     string
+	vector  []float32
+	expires time.Time
+}
+
+// QueryVectorCacheStats are counted since the start of the node
+type QueryVectorCacheStats struct {
+	Entries int     `json:"entries"`
+	Hits    uint64  `json:"hits"`
+	Misses  uint64  `json:"misses"`
+	HitRate float64 `json:"hitRate"`
+}
+
+func NewQueryVectorCache(maxEntries int, ttl time.Duration,
+	promMetrics *monitoring.PrometheusMetrics,
+) *QueryVectorCache {
+	c := &QueryVectorCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		now:        time.Now,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+	if promMetrics != nil {
+		c.metrics = promMetrics.QueryVectorCacheLookups
+	}
+	return c
+}
+
+func (c *QueryVectorCache) get(module, key string) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok && c.now().After(elem.Value.(*queryVectorCacheEntry).expires) {
+		c.remove(elem)
+		ok = false
+	}
+	if !ok {
+		c.misses++
+		c.countLookup(module, "miss")
+		return nil, false
+	}
+
+	c.hits++
+	c.countLookup(module, "hit")
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*queryVectorCacheEntry).vector, true
+}
+
+func (c *QueryVectorCache) put(key string, vector []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&queryVectorCacheEntry{
+		key:     key,
+		vector:  vector,
+		expires: c.now().Add(c.ttl),
+	})
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *QueryVectorCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*queryVectorCacheEntry).key)
+}
+
+func (c *QueryVectorCache) countLookup(module, result string) {
+	if c.metrics != nil {
+		c.metrics.With(prometheus.Labels{"module": module, "result": result}).Inc()
+	}
+}
+
+// Flush removes all entries, e.g. after the model behind a vectorizer was
+// replaced without changing its settings
+func (c *QueryVectorCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+}
+
+func (c *QueryVectorCache) Stats() QueryVectorCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := QueryVectorCacheStats{
+		Entries: c.lru.Len(),
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRate = float64(c.hits) / float64(total)
+	}
+	return stats
+}
+
+// SetQueryVectorCache enables the cache of query vectors
+func (p *Provider) SetQueryVectorCache(cache *QueryVectorCache) {
+	p.queryVectorCache = cache
+}
+
+// cachedVectorFromSearchParam returns the vector of the search from the
+// query vector cache or calls the searcher and caches its result. Results
+// of searches which looked up the vectors of stored objects, e.g. to move
+// towards them, are not cached, as those vectors may change
+func (p *Provider) cachedVectorFromSearchParam(moduleName, param string, params interface{}, cfg moduletools.ClassConfig,
+	findVectorFn modulecapabilities.FindVectorFn,
+	search func(findVectorFn modulecapabilities.FindVectorFn) ([]float32, error),
+) ([]float32, error) {
+	if p.queryVectorCache == nil || !cachedSearches[param] {
+		return search(findVectorFn)
+	}
+
+	query, err := json.Marshal(params)
+	if err != nil {
+		return search(findVectorFn)
+	}
+	key, err := embeddingCacheKey(moduleName, cfg, param+"\x00"+string(query))
+	if err != nil {
+		return search(findVectorFn)
+	}
+	if vector, ok := p.queryVectorCache.get(moduleName, string(key)); ok {
+		return vector, nil
+	}
+
+	usedObjects := false
+	vector, err := search(func(ctx context.Context, className string,
+		id strfmt.UUID, tenant string,
+	) ([]float32, error) {
+		usedObjects = true
+		return findVectorFn(ctx, className, id, tenant)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !usedObjects {
+		p.queryVectorCache.put(string(key), vector)
+	}
+	return vector, nil
+}
+
+// cachedVectorFromInput is cachedVectorFromSearchParam for the query of
+// hybrid searches
+func (p *Provider) cachedVectorFromInput(moduleName, input string, cfg moduletools.ClassConfig,
+	vectorize func() ([]float32, error),
+) ([]float32, error) {
+	if p.queryVectorCache == nil {
+		return vectorize()
+	}
+
+	key, err := embeddingCacheKey(moduleName, cfg, "input\x00"+input)
+	if err != nil {
+		return vectorize()
+	}
+	if vector, ok := p.queryVectorCache.get(moduleName, string(key)); ok {
+		return vector, nil
+	}
+
+	vector, err := vectorize()
+	if err != nil {
+		return nil, err
+	}
+	p.queryVectorCache.put(string(key), vector)
+	return vector, nil
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestQueryVectorCache(t *testing.T) {
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		c := NewQueryVectorCache(2, time.Hour, nil)
+		c.put("a", []float32{1})
+		c.put("b", []float32{2})
+		_, ok := c.get("mod", "a")
+		require.True(t, ok)
+		c.put("c", []float32{3})
+
+		_, ok = c.get("mod", "b")
+		assert.False(t, ok)
+		vector, ok := c.get("mod", "a")
+		assert.True(t, ok)
+		assert.Equal(t, []float32{1}, vector)
+		assert.Equal(t, QueryVectorCacheStats{
+			Entries: 2, Hits: 2, Misses: 1, HitRate: 2.0 / 3,
+		}, c.Stats())
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		now := time.Now()
+		c := NewQueryVectorCache(2, time.Minute, nil)
+		c.now = func() time.Time { return now }
+		c.put("a", []float32{1})
+
+		now = now.Add(2 * time.Minute)
+		_, ok := c.get("mod", "a")
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Stats().Entries)
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		c := NewQueryVectorCache(2, time.Hour, nil)
+		c.put("a", []float32{1})
+		c.Flush()
+
+		_, ok := c.get("mod", "a")
+		assert.False(t, ok)
+	})
+}
+
+func TestProviderWithQueryVectorCache(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "MyClass",
+					Vectorizer: "mod",
+					ModuleConfig: map[string]interface{}{
+						"mod": map[string]interface{}{"model": "small"},
+					},
+				},
+			},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+
+	type nearTextParams struct {
+		Values    []string
+		MoveToIDs []string
+	}
+	searches := 0
+	p := NewProvider()
+	p.SetSchemaGetter(&fakeSchemaGetter{schema: sch})
+	p.Register(newSearcherModule("mod").
+		withArg("nearText").
+		withSearcher("nearText", func(ctx context.Context, params interface{},
+			className string, findVectorFn modulecapabilities.FindVectorFn,
+			cfg moduletools.ClassConfig,
+		) ([]float32, error) {
+			searches++
+			vector := []float32{float32(len(params.(*nearTextParams).Values))}
+			for range params.(*nearTextParams).MoveToIDs {
+				moved, _ := findVectorFn(ctx, className, "123", "")
+				vector = append(vector, moved...)
+			}
+			return vector, nil
+		}),
+	)
+	require.Nil(t, p.Init(context.Background(), nil, logger))
+	p.SetQueryVectorCache(NewQueryVectorCache(10, time.Hour, nil))
+
+	t.Run("repeated queries are vectorized once", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			res, err := p.VectorFromSearchParam(context.Background(), "MyClass", "nearText",
+				&nearTextParams{Values: []string{"a", "b"}}, fakeFindVector, "")
+			require.Nil(t, err)
+			assert.Equal(t, []float32{2}, res)
+		}
+		assert.Equal(t, 1, searches)
+
+		_, err := p.VectorFromSearchParam(context.Background(), "MyClass", "nearText",
+			&nearTextParams{Values: []string{"c"}}, fakeFindVector, "")
+		require.Nil(t, err)
+		assert.Equal(t, 2, searches)
+	})
+
+	t.Run("queries which use object vectors are not cached", func(t *testing.T) {
+		searches = 0
+		for i := 0; i < 2; i++ {
+			res, err := p.VectorFromSearchParam(context.Background(), "MyClass", "nearText",
+				&nearTextParams{Values: []string{"a"}, MoveToIDs: []string{"123"}}, fakeFindVector, "")
+			require.Nil(t, err)
+			assert.Equal(t, []float32{1, 1, 2, 3}, res)
+		}
+		assert.Equal(t, 2, searches)
+	})
+}
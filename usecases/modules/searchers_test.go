@@ -110,6 +110,38 @@ func TestModulesWithSearchers(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, []float32{1, 2, 3, 4}, res)
 	})
+
+	t.Run("get a vector for media", func(t *testing.T) {
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{
+			schema: sch,
+		})
+		p.Register(&dummyMediaModule{
+			dummyText2VecModuleNoCapabilities: newDummyText2VecModule("mod"),
+		})
+		require.Nil(t, p.Init(context.Background(), nil, logger))
+
+		args := p.GetArguments(sch.Objects.Classes[0])
+		assert.Contains(t, args, "nearImage")
+		assert.Contains(t, args, "nearAudio")
+		assert.NotContains(t, args, "nearVideo")
+
+		params := p.ExtractSearchParams(map[string]interface{}{
+			"nearAudio": map[string]interface{}{"audio": "base64;encoded"},
+		}, "MyClass")
+		require.Contains(t, params, "nearAudio")
+		require.Nil(t, p.ValidateSearchParam("nearAudio", params["nearAudio"], "MyClass"))
+
+		res, err := p.VectorFromSearchParam(context.Background(), "MyClass",
+			"nearAudio", params["nearAudio"], fakeFindVector, "")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{5, 14}, res)
+
+		res, err = p.CrossClassVectorFromSearchParam(context.Background(),
+			"nearAudio", params["nearAudio"], fakeFindVector)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{5, 14}, res)
+	})
 }
 
 func fakeFindVector(ctx context.Context, className string, id strfmt.UUID, tenant string) ([]float32, error) {
@@ -148,3 +180,21 @@ func (m *dummySearcherModule) withSearcher(arg string,
 func (m *dummySearcherModule) VectorSearches() map[string]modulecapabilities.VectorForParams {
 	return m.searchers
 }
+
+// dummyMediaModule vectorizes images and audio to the length of the media
+// type and of the media
+type dummyMediaModule struct {
+	dummyText2VecModuleNoCapabilities
+}
+
+func (m *dummyMediaModule) MediaTypes() []modulecapabilities.MediaType {
+	return []modulecapabilities.MediaType{
+		modulecapabilities.MediaImage, modulecapabilities.MediaAudio,
+	}
+}
+
+func (m *dummyMediaModule) VectorizeMedia(ctx context.Context,
+	mediaType modulecapabilities.MediaType, media string, cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	return []float32{float32(len(mediaType)), float32(len(media))}, nil
+}
@@ -21,7 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
-	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -84,7 +84,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+	hnswConfig, ok := vectorindex.HnswConfig(class.VectorIndexConfig)
 	if !ok {
 		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
@@ -123,7 +123,14 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 
 	cfg := NewClassBasedModuleConfig(class, found.Name(), "")
 
-	if vectorizer, ok := found.(modulecapabilities.Vectorizer); ok {
+	if batchVectorizer, ok := found.(modulecapabilities.BatchVectorizer); ok {
+		if object.Vector == nil {
+			if err := p.vectorizeObjectBatched(ctx, batchVectorizer, found.Name(),
+				object, objectDiff, cfg, logger); err != nil {
+				return fmt.Errorf("update vector: %w", err)
+			}
+		}
+	} else if vectorizer, ok := found.(modulecapabilities.Vectorizer); ok {
 		if object.Vector == nil {
 			if err := vectorizer.VectorizeObject(ctx, object, objectDiff, cfg); err != nil {
 				return fmt.Errorf("update vector: %w", err)
@@ -140,6 +147,82 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	return nil
 }
 
+// vectorizeObjectBatched embeds the object's text together with the texts
+// of other objects of the same class which are imported concurrently. If
+// the embedding cache is enabled, texts which were vectorized before are
+// not sent again
+func (p *Provider) vectorizeObjectBatched(ctx context.Context,
+	vectorizer modulecapabilities.BatchVectorizer, modName string,
+	object *models.Object, objectDiff *moduletools.ObjectDiff,
+	cfg moduletools.ClassConfig, logger logrus.FieldLogger,
+) error {
+	text, vectorize, err := vectorizer.ObjectText(ctx, object, objectDiff, cfg)
+	if err != nil {
+		return err
+	}
+	if !vectorize {
+		return nil
+	}
+
+	var cacheKey []byte
+	if p.embeddingCache != nil {
+		cacheKey, err = embeddingCacheKey(modName, cfg, text)
+		if err != nil {
+			return err
+		}
+		// the cache is only an optimization, failing to use it must not
+		// fail the import
+		vector, ok, err := p.embeddingCache.Get(modName, cacheKey)
+		if err != nil {
+			logger.WithField("action", "embedding_cache_get").
+				WithField("module", modName).WithError(err).
+				Warn("could not read embedding cache")
+		} else if ok {
+			object.Vector = vector
+			return nil
+		}
+	}
+
+	vector, err := p.batcher(modName, vectorizer).Vectorize(ctx, object.Class, text,
+		func(ctx context.Context, texts []string) ([][]float32, error) {
+			return vectorizer.VectorizeBatch(ctx, texts, cfg)
+		})
+	if err != nil {
+		return err
+	}
+
+	if cacheKey != nil {
+		if err := p.embeddingCache.Put(modName, cacheKey, vector); err != nil {
+			logger.WithField("action", "embedding_cache_put").
+				WithField("module", modName).WithError(err).
+				Warn("could not write embedding cache")
+		}
+	}
+
+	object.Vector = vector
+	return nil
+}
+
+func (p *Provider) batcher(modName string,
+	vectorizer modulecapabilities.BatchVectorizer,
+) *Batcher {
+	p.batchersLock.Lock()
+	defer p.batchersLock.Unlock()
+
+	if b, ok := p.batchers[modName]; ok {
+		return b
+	}
+
+	settings := vectorizer.BatchSettings()
+	b := NewBatcher(BatcherConfig{
+		MaxBatchSize:   settings.MaxBatchSize,
+		MaxConcurrency: settings.MaxConcurrency,
+		MaxRetries:     DefaultBatcherMaxRetries,
+	})
+	p.batchers[modName] = b
+	return b
+}
+
 func (p *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := p.getClassVectorizer(className)
 	if err != nil {
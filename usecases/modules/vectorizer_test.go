@@ -14,12 +14,15 @@ package modules
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -106,6 +109,106 @@ func TestProvider_UsingRef2Vec(t *testing.T) {
 		assert.False(t, p.UsingRef2Vec(className))
 	})
 
+	t.Run("with BatchVectorizer", func(t *testing.T) {
+		ctx := context.Background()
+		modName := "some-vzr"
+		className := "SomeClass"
+		mod := &dummyBatchText2VecModule{
+			dummyText2VecModuleNoCapabilities: newDummyText2VecModule(modName),
+		}
+		class := &models.Class{
+			Class: className,
+			ModuleConfig: map[string]interface{}{
+				modName: struct{}{},
+			},
+			VectorIndexConfig: hnsw.UserConfig{},
+		}
+		sch := schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		}}
+		repo := &fakeObjectsRepo{}
+		logger, _ := test.NewNullLogger()
+
+		p := NewProvider()
+		p.Register(mod)
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+
+		objs := make([]*models.Object, 8)
+		errs := make([]error, len(objs))
+		var wg sync.WaitGroup
+		for i := range objs {
+			objs[i] = &models.Object{
+				Class:      className,
+				ID:         newUUID(),
+				Properties: map[string]interface{}{"text": strings.Repeat("a", i+1)},
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = p.UpdateVector(ctx, objs[i], class, nil, repo.Object, logger)
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range objs {
+			require.Nil(t, errs[i])
+			assert.Equal(t, []float32{float32(i + 1)}, []float32(objs[i].Vector))
+		}
+		assert.Equal(t, []int{4, 4}, mod.batches)
+	})
+
+	t.Run("with BatchVectorizer and embedding cache", func(t *testing.T) {
+		ctx := context.Background()
+		modName := "some-vzr"
+		className := "SomeClass"
+		mod := &dummyBatchText2VecModule{
+			dummyText2VecModuleNoCapabilities: newDummyText2VecModule(modName),
+		}
+		class := &models.Class{
+			Class: className,
+			ModuleConfig: map[string]interface{}{
+				modName: map[string]interface{}{"model": "small"},
+			},
+			VectorIndexConfig: hnsw.UserConfig{},
+		}
+		sch := schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		}}
+		repo := &fakeObjectsRepo{}
+		logger, _ := test.NewNullLogger()
+		cache := &fakeEmbeddingCache{vectors: map[string][]float32{}}
+
+		p := NewProvider()
+		p.Register(mod)
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.SetEmbeddingCache(cache)
+
+		for i := 0; i < 2; i++ {
+			obj := &models.Object{
+				Class:      className,
+				ID:         newUUID(),
+				Properties: map[string]interface{}{"text": "abc"},
+			}
+			require.Nil(t, p.UpdateVector(ctx, obj, class, nil, repo.Object, logger))
+			assert.Equal(t, []float32{3}, []float32(obj.Vector))
+		}
+		assert.Equal(t, []int{1}, mod.batches)
+		assert.Len(t, cache.vectors, 1)
+
+		// a different model must not use the cached vector
+		class.ModuleConfig = map[string]interface{}{
+			modName: map[string]interface{}{"model": "large"},
+		}
+		obj := &models.Object{
+			Class:      className,
+			ID:         newUUID(),
+			Properties: map[string]interface{}{"text": "abc"},
+		}
+		require.Nil(t, p.UpdateVector(ctx, obj, class, nil, repo.Object, logger))
+		assert.Equal(t, []int{1, 1}, mod.batches)
+		assert.Len(t, cache.vectors, 2)
+	})
+
 	t.Run("with nonexistent class", func(t *testing.T) {
 		className := "SomeClass"
 		mod := newDummyModule("", "")
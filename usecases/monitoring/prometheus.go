@@ -22,6 +22,7 @@ import (
 type PrometheusMetrics struct {
 	BatchTime                          *prometheus.HistogramVec
 	BatchDeleteTime                    *prometheus.SummaryVec
+	BatchDuplicates                    *prometheus.CounterVec
 	ObjectsTime                        *prometheus.SummaryVec
 	LSMBloomFilters                    *prometheus.SummaryVec
 	AsyncOperations                    *prometheus.GaugeVec
@@ -31,6 +32,30 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                     *prometheus.GaugeVec
 	LSMMemtableSize                    *prometheus.GaugeVec
 	LSMMemtableDurations               *prometheus.SummaryVec
+	LSMTieringBlockCacheRequests       *prometheus.CounterVec
+	LSMTieredSegments                  *prometheus.CounterVec
+	LSMBucketSegments                  *prometheus.GaugeVec
+	LSMBucketMemtableSize              *prometheus.GaugeVec
+	LSMBucketCompactionDurations       *prometheus.SummaryVec
+	LSMBucketFlushes                   *prometheus.CounterVec
+	LSMBucketOperationDurations        *prometheus.SummaryVec
+	LSMBucketBloomFilterChecks         *prometheus.CounterVec
+	LSMBucketFilterCacheLookups        *prometheus.CounterVec
+	ShardWriteStall                    *prometheus.GaugeVec
+	TenantStatus                       *prometheus.GaugeVec
+	TenantTransitions                  *prometheus.CounterVec
+	TenantActivationDurations          *prometheus.SummaryVec
+	TenantUsage                        *prometheus.GaugeVec
+	TenantQuotaRejections              *prometheus.CounterVec
+	ReplicaRepairObjects               *prometheus.CounterVec
+	ReplicaRepairBytes                 *prometheus.CounterVec
+	AdmissionRejectedRequests          *prometheus.CounterVec
+	MemoryGovernorStage                prometheus.Gauge
+	MemoryGovernorBytes                *prometheus.GaugeVec
+	ReplicaHints                       *prometheus.CounterVec
+	EmbeddingCacheLookups              *prometheus.CounterVec
+	QueryVectorCacheLookups            *prometheus.CounterVec
+	QueryResultCacheLookups            *prometheus.CounterVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
 	VectorIndexTombstoneCleanedCount   *prometheus.CounterVec
@@ -38,6 +63,16 @@ type PrometheusMetrics struct {
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
+	VectorIndexSearchEF                *prometheus.HistogramVec
+	VectorIndexTunedEF                 *prometheus.GaugeVec
+	VectorIndexRecallProxy             *prometheus.GaugeVec
+	VectorIndexCacheRequests           *prometheus.CounterVec
+	VectorIndexRescored                *prometheus.CounterVec
+	CrossClusterReplicationPending     prometheus.Gauge
+	CrossClusterReplicationLag         prometheus.Gauge
+	CrossClusterReplicationShipped     *prometheus.CounterVec
+	ClusterTransportRequests           *prometheus.CounterVec
+	ClusterTransportBytes              *prometheus.CounterVec
 	ObjectCount                        *prometheus.GaugeVec
 	QueriesCount                       *prometheus.GaugeVec
 	RequestsTotal                      *prometheus.GaugeVec
@@ -60,7 +95,8 @@ type PrometheusMetrics struct {
 	StartupDurations *prometheus.SummaryVec
 	StartupDiskIO    *prometheus.SummaryVec
 
-	Group bool
+	Group       bool
+	GroupShards bool
 }
 
 var (
@@ -74,6 +110,7 @@ func init() {
 
 func InitConfig(cfg config.Monitoring) {
 	metrics.Group = cfg.Group
+	metrics.GroupShards = cfg.GroupShards
 }
 
 func GetMetrics() *PrometheusMetrics {
@@ -91,6 +128,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "batch_delete_durations_ms",
 			Help: "Duration in ms of a single delete batch",
 		}, []string{"operation", "class_name", "shard_name"}),
+		BatchDuplicates: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "batch_duplicates_total",
+			Help: "Number of duplicates detected by batch imports, by how they were handled",
+		}, []string{"class_name", "action"}),
 
 		ObjectsTime: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "objects_durations_ms",
@@ -160,6 +201,34 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_memtable_durations_ms",
 			Help: "Time in ms for a bucket operation to complete",
 		}, []string{"strategy", "class_name", "shard_name", "path", "operation"}),
+		LSMBucketSegments: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_bucket_segments",
+			Help: "Number of segments per bucket",
+		}, []string{"strategy", "class_name", "shard_name", "bucket"}),
+		LSMBucketMemtableSize: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_bucket_memtable_size_bytes",
+			Help: "Size of the active memtable per bucket",
+		}, []string{"strategy", "class_name", "shard_name", "bucket"}),
+		LSMBucketCompactionDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "lsm_bucket_compaction_durations_ms",
+			Help: "Duration in ms of compacting a pair of segments",
+		}, []string{"strategy", "class_name", "shard_name", "bucket"}),
+		LSMBucketFlushes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_bucket_flushes_total",
+			Help: "Number of memtables flushed to disk by result (success, failure)",
+		}, []string{"strategy", "class_name", "shard_name", "bucket", "result"}),
+		LSMBucketOperationDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "lsm_bucket_operation_durations_ms",
+			Help: "Duration in ms of reads and writes on a bucket, including memtables and segments",
+		}, []string{"strategy", "class_name", "shard_name", "bucket", "operation"}),
+		LSMBucketBloomFilterChecks: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_bucket_bloom_filter_checks_total",
+			Help: "Number of segment bloom filter checks by result (true_negative, true_positive, false_positive)",
+		}, []string{"strategy", "class_name", "shard_name", "bucket", "result"}),
+		LSMBucketFilterCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_bucket_filter_cache_lookups_total",
+			Help: "Number of filter cache lookups of roaring set buckets by result (hit, partial, miss)",
+		}, []string{"strategy", "class_name", "shard_name", "bucket", "result"}),
 
 		VectorIndexTombstones: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_index_tombstones",
@@ -185,6 +254,115 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
 		}, []string{"operation", "class_name", "shard_name"}),
+		VectorIndexSearchEF: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_search_ef",
+			Help:    "The ef used for vector searches, derived from the limit according to the dynamic ef policy",
+			Buckets: prometheus.ExponentialBuckets(16, 2, 10),
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexTunedEF: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_tuned_ef",
+			Help: "The search time ef picked by the ef auto tuning",
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexRecallProxy: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_recall_proxy",
+			Help: "Smoothed overlap of sampled searches with a search at a higher ef, used by the ef auto tuning as a proxy for the recall",
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexCacheRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_cache_requests_total",
+			Help: "Reads of vectors from the vector cache by cache result (hit, miss)",
+		}, []string{"class_name", "shard_name", "result"}),
+		VectorIndexRescored: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_rescored_total",
+			Help: "Search candidates rescored with the uncompressed vectors read from disk",
+		}, []string{"class_name", "shard_name"}),
+		LSMTieringBlockCacheRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_tiering_block_cache_requests_total",
+			Help: "Reads of tiered segment blocks by cache result (hit, miss)",
+		}, []string{"result"}),
+		LSMTieredSegments: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_tiered_segments_total",
+			Help: "Number of segments moved to or restored from the remote store",
+		}, []string{"operation"}),
+		ShardWriteStall: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "shard_write_stall",
+			Help: "1 if writes to the shard are currently stalled for the given reason (memtable_flush, commit_log), 0 otherwise",
+		}, []string{"class_name", "shard_name", "reason"}),
+		TenantStatus: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "tenants",
+			Help: "Number of local tenants per offloading status (HOT, WARM, COLD)",
+		}, []string{"class_name", "status"}),
+		TenantTransitions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "tenant_transitions_total",
+			Help: "Number of tenants moved to the given offloading status",
+		}, []string{"class_name", "status"}),
+		TenantActivationDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "tenant_activation_durations_ms",
+			Help: "Duration of loading an offloaded tenant on first use in ms",
+		}, []string{"class_name"}),
+		TenantUsage: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "tenant_usage",
+			Help: "Objects, vectors and storage bytes used by the local tenants, as limited by tenant quotas",
+		}, []string{"class_name", "tenant", "resource"}),
+		TenantQuotaRejections: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "tenant_quota_rejections_total",
+			Help: "Number of writes rejected because they would exceed the quota of the tenant for the given resource",
+		}, []string{"class_name", "resource"}),
+		ReplicaRepairObjects: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replica_repair_objects_total",
+			Help: "Number of objects compared, repaired or skipped by the background repair of shard replicas",
+		}, []string{"class_name", "shard_name", "result"}),
+		ReplicaRepairBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replica_repair_bytes_total",
+			Help: "Number of bytes of objects copied to other replicas by the background repair",
+		}, []string{"class_name", "shard_name"}),
+		ReplicaHints: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replica_hints_total",
+			Help: "Number of writes missed by replicas which were stored, replayed, rejected or expired as hints",
+		}, []string{"class_name", "result"}),
+		EmbeddingCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "embedding_cache_lookups_total",
+			Help: "Number of lookups in the embedding cache of vectorizer modules which were hits or misses",
+		}, []string{"module", "result"}),
+		QueryVectorCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_vector_cache_lookups_total",
+			Help: "Number of lookups of nearText and hybrid query vectors in the query vector cache which were hits or misses",
+		}, []string{"module", "result"}),
+		QueryResultCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_result_cache_lookups_total",
+			Help: "Number of lookups of Get query results in the query result cache which were hits or misses",
+		}, []string{"class_name", "result"}),
+		AdmissionRejectedRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "admission_rejected_requests_total",
+			Help: "Number of requests rejected by the admission control, by the limit which was hit",
+		}, []string{"limit"}),
+		MemoryGovernorStage: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "memory_governor_stage",
+			Help: "Load shedding stage of the memory governor: 0 normal, 1 caches shrunk, 2 memtables flushed, 3 batches rejected",
+		}),
+		MemoryGovernorBytes: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "memory_governor_bytes",
+			Help: "Memory held by the components tracked by the memory governor (vector_cache, filter_cache, memtables) and the budget of the vector caches (vector_cache_budget)",
+		}, []string{"component"}),
+		CrossClusterReplicationPending: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "cross_cluster_replication_pending_changes",
+			Help: "Number of changed objects not yet shipped to the standby cluster",
+		}),
+		CrossClusterReplicationLag: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "cross_cluster_replication_lag_seconds",
+			Help: "Age of the oldest change not yet shipped to the standby cluster",
+		}),
+		CrossClusterReplicationShipped: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "cross_cluster_replication_shipped_changes_total",
+			Help: "Number of changes shipped to the standby cluster by result",
+		}, []string{"result"}),
+		ClusterTransportRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "cluster_transport_requests_total",
+			Help: "Number of requests to other nodes of the cluster by peer and result",
+		}, []string{"peer", "result"}),
+		ClusterTransportBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "cluster_transport_bytes_total",
+			Help: "Number of body bytes sent to or received from other nodes as transferred, after compression",
+		}, []string{"peer", "direction"}),
 		VectorIndexDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_durations_ms",
 			Help: "Duration of typical vector index operations (insert, delete)",
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neardup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Repo reads the objects of a class and searches their nearest neighbors
+// in the vector index
+type Repo interface {
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+	DenseObjectSearch(ctx context.Context, class string, vector []float32,
+		offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
+		tenant string) ([]*storobj.Object, []float32, error)
+}
+
+// Writer merges and deletes duplicates, it is implemented by the objects
+// manager so that the usual authorization and validation applies
+type Writer interface {
+	MergeObject(ctx context.Context, principal *models.Principal,
+		updates *models.Object, repl *additional.ReplicationProperties) *objects.Error
+	DeleteObject(ctx context.Context, principal *models.Principal, class string,
+		id strfmt.UUID, repl *additional.ReplicationProperties, tenant string) error
+}
+
+type finder struct {
+	repo      Repo
+	writer    Writer
+	class     *models.Class
+	opts      Options
+	principal *models.Principal
+	batchSize int
+	update    func(func(r *Report))
+
+	// pending are the pairs found from the object with the lower id, keyed
+	// by the higher id, so that they are not reported again once the higher
+	// id is scanned. The index is approximate, so a pair may only be found
+	// from one of its objects.
+	pending map[strfmt.UUID]map[strfmt.UUID]struct{}
+	// merged are the duplicates deleted so far
+	merged map[strfmt.UUID]struct{}
+}
+
+// run scans the class in the order of the ids and searches the neighbors
+// of every object
+func (f *finder) run(ctx context.Context) error {
+	f.pending = map[strfmt.UUID]map[strfmt.UUID]struct{}{}
+	f.merged = map[strfmt.UUID]struct{}{}
+
+	after := ""
+	for {
+		res, err := f.repo.Query(ctx, &objects.QueryInput{
+			Class:      f.class.Class,
+			Cursor:     &filters.Cursor{After: after, Limit: f.batchSize},
+			Limit:      f.batchSize,
+			Tenant:     f.opts.Tenant,
+			Additional: additional.Properties{Vector: true},
+		})
+		if err != nil {
+			return fmt.Errorf("read objects after %q: %w", after, err)
+		}
+		if len(res) == 0 {
+			return nil
+		}
+
+		for i := range res {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := f.checkObject(ctx, &res[i]); err != nil {
+				return err
+			}
+		}
+
+		f.update(func(r *Report) { r.ObjectsScanned += int64(len(res)) })
+		after = res[len(res)-1].ID.String()
+		if len(res) < f.batchSize {
+			return nil
+		}
+	}
+}
+
+func (f *finder) checkObject(ctx context.Context, obj *search.Result) error {
+	defer delete(f.pending, obj.ID)
+
+	if _, ok := f.merged[obj.ID]; ok || len(obj.Vector) == 0 {
+		return nil
+	}
+
+	// the object itself is usually the closest neighbor
+	neighbors, dists, err := f.repo.DenseObjectSearch(ctx, f.class.Class, obj.Vector,
+		0, f.opts.Neighbors+1, nil, additional.Properties{}, f.opts.Tenant)
+	if err != nil {
+		return fmt.Errorf("search neighbors of %s: %w", obj.ID, err)
+	}
+
+	props := propertiesOf(obj.Schema)
+	for i, neighbor := range neighbors {
+		id := neighbor.ID()
+		if id == obj.ID || dists[i] > f.opts.Distance {
+			continue
+		}
+		if _, ok := f.merged[id]; ok {
+			continue
+		}
+		if _, ok := f.pending[obj.ID][id]; ok {
+			continue
+		}
+
+		pair := Pair{ID: obj.ID, DuplicateID: id, Distance: dists[i]}
+		if id < obj.ID {
+			pair.ID, pair.DuplicateID = id, obj.ID
+		} else if f.opts.Mode == ModeReport {
+			if f.pending[id] == nil {
+				f.pending[id] = map[strfmt.UUID]struct{}{}
+			}
+			f.pending[id][obj.ID] = struct{}{}
+		}
+		f.update(func(r *Report) { r.addPair(pair) })
+
+		if f.opts.Mode != ModeMerge {
+			continue
+		}
+		if pair.ID == obj.ID {
+			if err := f.merge(ctx, obj.ID, props, id, propertiesOf(neighbor.Properties())); err != nil {
+				return err
+			}
+			continue
+		}
+		// the object is the duplicate, none of its other neighbors matter
+		return f.merge(ctx, id, propertiesOf(neighbor.Properties()), obj.ID, props)
+	}
+
+	return nil
+}
+
+// merge adds the properties the kept object lacks from the duplicate and
+// deletes the duplicate. keepProps are updated with the added properties.
+func (f *finder) merge(ctx context.Context, keep strfmt.UUID, keepProps map[string]interface{},
+	duplicate strfmt.UUID, duplicateProps map[string]interface{},
+) error {
+	missing := map[string]interface{}{}
+	for name, value := range duplicateProps {
+		if name == "id" || value == nil {
+			continue
+		}
+		if current, ok := keepProps[name]; !ok || current == nil {
+			missing[name] = value
+		}
+	}
+
+	if len(missing) > 0 {
+		if err := f.writer.MergeObject(ctx, f.principal, &models.Object{
+			Class:      f.class.Class,
+			ID:         keep,
+			Tenant:     f.opts.Tenant,
+			Properties: missing,
+		}, nil); err != nil {
+			return fmt.Errorf("merge %s into %s: %w", duplicate, keep, err)
+		}
+		for name, value := range missing {
+			keepProps[name] = value
+		}
+	}
+
+	if err := f.writer.DeleteObject(ctx, f.principal, f.class.Class, duplicate,
+		nil, f.opts.Tenant); err != nil {
+		return fmt.Errorf("delete duplicate %s: %w", duplicate, err)
+	}
+
+	f.merged[duplicate] = struct{}{}
+	f.update(func(r *Report) { r.Merged++ })
+	return nil
+}
+
+func propertiesOf(schema interface{}) map[string]interface{} {
+	props, ok := schema.(map[string]interface{})
+	if !ok || props == nil {
+		return map[string]interface{}{}
+	}
+	return props
+}
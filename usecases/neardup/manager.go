@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neardup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+const (
+	// DefaultBatchSize is the amount of objects read per iteration
+	DefaultBatchSize = 100
+	// DefaultNeighbors is the amount of nearest neighbors compared with
+	// every object
+	DefaultNeighbors = 10
+	// MaxNeighbors limits the neighbors, as every object of the class is
+	// searched for
+	MaxNeighbors = 100
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Options configure a single detection run
+type Options struct {
+	Mode   string
+	Tenant string
+	// Distance is the maximum distance of near-duplicates, in the distance
+	// metric of the vector index of the class
+	Distance  float32
+	Neighbors int
+}
+
+// Manager runs near-duplicate detection jobs in the background. There is at
+// most one job per class and tenant, the report of the last job is kept
+// until the next one is started.
+type Manager struct {
+	repo       Repo
+	writer     Writer
+	schema     schemaGetter
+	authorizer authorizer
+	logger     logrus.FieldLogger
+	tracker    *jobs.Manager
+	batchSize  int
+
+	sync.Mutex
+	jobs map[string]*job
+}
+
+type job struct {
+	report *Report
+	cancel func()
+	handle *jobs.Handle
+}
+
+func NewManager(repo Repo, writer Writer, schemaGetter schemaGetter,
+	authorizer authorizer, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		repo:       repo,
+		writer:     writer,
+		schema:     schemaGetter,
+		authorizer: authorizer,
+		logger:     logger,
+		batchSize:  DefaultBatchSize,
+		jobs:       map[string]*job{},
+	}
+}
+
+// SetJobs tracks every detection run in the jobs API as well, which also
+// allows to cancel it
+func (m *Manager) SetJobs(tracker *jobs.Manager) {
+	m.tracker = tracker
+}
+
+// Detect starts a detection job for the class. ModeMerge modifies and
+// deletes objects and requires update permissions on them.
+func (m *Manager) Detect(principal *models.Principal, className string,
+	opts Options,
+) (*Report, error) {
+	if opts.Mode == "" {
+		opts.Mode = ModeReport
+	}
+	if opts.Neighbors == 0 {
+		opts.Neighbors = DefaultNeighbors
+	}
+
+	verb := "list"
+	if opts.Mode != ModeReport {
+		verb = "update"
+	}
+	if err := m.authorizer.Authorize(principal, verb, "objects/"+className); err != nil {
+		return nil, err
+	}
+	err := m.authorizer.Authorize(principal, verb,
+		authorization.ClassResource(className, opts.Tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOptions(opts); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	sch := m.schema.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	key := jobKey(className, opts.Tenant)
+	m.Lock()
+	if j, ok := m.jobs[key]; ok && j.report.Status == StatusStarted {
+		m.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("near-duplicate detection of class %q is already running", className))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := m.tracker.Track(jobs.TypeNearDuplicates, className, cancel)
+	report := &Report{
+		Class:     className,
+		Tenant:    opts.Tenant,
+		Mode:      opts.Mode,
+		Distance:  opts.Distance,
+		Neighbors: opts.Neighbors,
+		JobID:     handle.ID(),
+		Status:    StatusStarted,
+		StartedAt: time.Now(),
+		Pairs:     []Pair{},
+	}
+	m.jobs[key] = &job{report: report, cancel: cancel, handle: handle}
+	snapshot := report.clone()
+	m.Unlock()
+
+	f := &finder{
+		repo:      m.repo,
+		writer:    m.writer,
+		class:     class,
+		opts:      opts,
+		principal: principal,
+		batchSize: m.batchSize,
+		update:    m.update(key),
+	}
+	go m.run(ctx, f, key)
+
+	return snapshot, nil
+}
+
+// Status returns the report of the current or last job of the class
+func (m *Manager) Status(principal *models.Principal, className,
+	tenant string,
+) (*Report, error) {
+	if err := m.authorizer.Authorize(principal, "list", "objects/"+className); err != nil {
+		return nil, err
+	}
+	err := m.authorizer.Authorize(principal, "list",
+		authorization.ClassResource(className, tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	j, ok := m.jobs[jobKey(className, tenant)]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(
+			fmt.Errorf("no near-duplicate detection found for class %q", className))
+	}
+
+	return j.report.clone(), nil
+}
+
+func (m *Manager) run(ctx context.Context, f *finder, key string) {
+	err := f.run(ctx)
+
+	m.Lock()
+	defer m.Unlock()
+
+	j := m.jobs[key]
+	j.cancel()
+	j.handle.Done(err)
+
+	report := j.report
+	now := time.Now()
+	report.CompletedAt = &now
+	switch {
+	case errors.Is(err, context.Canceled):
+		report.Status = StatusCancelled
+	case err != nil:
+		report.Status = StatusFailed
+		report.Error = err.Error()
+		m.logger.WithField("action", "near_duplicate_detection").
+			WithField("class", report.Class).
+			WithError(err).
+			Error("near-duplicate detection failed")
+	default:
+		report.Status = StatusSuccess
+		m.logger.WithField("action", "near_duplicate_detection").
+			WithField("class", report.Class).
+			WithField("objects_scanned", report.ObjectsScanned).
+			WithField("pairs_found", report.PairsFound).
+			Info("near-duplicate detection completed")
+	}
+}
+
+// update gives the finder access to the shared report
+func (m *Manager) update(key string) func(func(r *Report)) {
+	return func(fn func(r *Report)) {
+		m.Lock()
+		defer m.Unlock()
+
+		j := m.jobs[key]
+		fn(j.report)
+		j.handle.Progress(j.report.ObjectsScanned, 0)
+	}
+}
+
+func validateOptions(opts Options) error {
+	if err := validateMode(opts.Mode); err != nil {
+		return err
+	}
+	if opts.Distance < 0 {
+		return fmt.Errorf("distance must not be negative, got %v", opts.Distance)
+	}
+	if opts.Neighbors < 1 || opts.Neighbors > MaxNeighbors {
+		return fmt.Errorf("neighbors must be between 1 and %d, got %d",
+			MaxNeighbors, opts.Neighbors)
+	}
+	return nil
+}
+
+func jobKey(className, tenant string) string {
+	return className + "/" + tenant
+}
@@ -0,0 +1,261 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neardup
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	idA = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+	idB = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+	idC = strfmt.UUID("00000000-0000-0000-0000-00000000000c")
+	idD = strfmt.UUID("00000000-0000-0000-0000-00000000000d")
+	idE = strfmt.UUID("00000000-0000-0000-0000-00000000000e")
+)
+
+func TestDetectNearDuplicates(t *testing.T) {
+	t.Run("report", func(t *testing.T) {
+		writer := &fakeWriter{}
+		m := newTestManager(writer, &fakeAuthorizer{})
+
+		_, err := m.Detect(nil, "Article", Options{Distance: 0.2})
+		require.Nil(t, err)
+
+		report := waitForCompletion(t, m)
+		assert.Equal(t, StatusSuccess, report.Status)
+		assert.Equal(t, int64(5), report.ObjectsScanned)
+		assert.Equal(t, int64(2), report.PairsFound)
+		assert.Equal(t, []Pair{
+			{ID: idA, DuplicateID: idB, Distance: 0.1},
+			{ID: idC, DuplicateID: idD, Distance: 0.05},
+		}, roundDistances(report.Pairs))
+		assert.Empty(t, writer.merged)
+		assert.Empty(t, writer.deleted)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		writer := &fakeWriter{}
+		m := newTestManager(writer, &fakeAuthorizer{})
+
+		_, err := m.Detect(nil, "Article", Options{Mode: ModeMerge, Distance: 0.2})
+		require.Nil(t, err)
+
+		report := waitForCompletion(t, m)
+		assert.Equal(t, StatusSuccess, report.Status)
+		assert.Equal(t, int64(2), report.PairsFound)
+		assert.Equal(t, int64(2), report.Merged)
+		assert.ElementsMatch(t, []strfmt.UUID{idB, idD}, writer.deleted)
+		// only A lacks a property of its duplicate
+		assert.Equal(t, map[strfmt.UUID]map[string]interface{}{
+			idA: {"summary": "b"},
+		}, writer.merged)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		m.batchSize = 2
+
+		_, err := m.Detect(nil, "Article", Options{Distance: 0.2, Neighbors: 1})
+		require.Nil(t, err)
+
+		report := waitForCompletion(t, m)
+		assert.Equal(t, int64(5), report.ObjectsScanned)
+		assert.Equal(t, int64(2), report.PairsFound)
+	})
+}
+
+func TestDetectNearDuplicatesErrors(t *testing.T) {
+	t.Run("unknown class", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		_, err := m.Detect(nil, "Unknown", Options{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		for _, opts := range []Options{
+			{Mode: "delete"},
+			{Distance: -1},
+			{Neighbors: MaxNeighbors + 1},
+		} {
+			_, err := m.Detect(nil, "Article", opts)
+			assert.NotNil(t, err)
+		}
+	})
+
+	t.Run("no job yet", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		_, err := m.Status(nil, "Article", "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{err: errors.New("forbidden")})
+		_, err := m.Detect(nil, "Article", Options{})
+		assert.NotNil(t, err)
+	})
+}
+
+func newTestManager(writer *fakeWriter, authorizer *fakeAuthorizer) *Manager {
+	logger, _ := test.NewNullLogger()
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "summary", DataType: schema.DataTypeText.PropString()},
+		},
+	}}}}
+
+	repo := &fakeRepo{objects: []*storobj.Object{
+		object(idA, []float32{0, 0}, map[string]interface{}{"title": "a"}),
+		object(idB, []float32{0, 0.1}, map[string]interface{}{"title": "b", "summary": "b"}),
+		object(idC, []float32{5, 5}, map[string]interface{}{"title": "c", "summary": "c"}),
+		object(idD, []float32{5, 5.05}, map[string]interface{}{"title": "d", "summary": "d"}),
+		object(idE, []float32{-5, 5}, map[string]interface{}{"title": "e"}),
+	}}
+
+	return NewManager(repo, writer, &fakeSchemaGetter{schema: sch}, authorizer, logger)
+}
+
+func object(id strfmt.UUID, vector []float32, props map[string]interface{}) *storobj.Object {
+	return storobj.FromObject(&models.Object{
+		Class: "Article", ID: id, Properties: props,
+	}, vector)
+}
+
+func waitForCompletion(t *testing.T, m *Manager) *Report {
+	var report *Report
+	require.Eventually(t, func() bool {
+		var err error
+		report, err = m.Status(nil, "Article", "")
+		require.Nil(t, err)
+		return report.Status != StatusStarted
+	}, time.Second, 5*time.Millisecond)
+
+	return report
+}
+
+func roundDistances(pairs []Pair) []Pair {
+	for i := range pairs {
+		pairs[i].Distance = float32(math.Round(float64(pairs[i].Distance)*100) / 100)
+	}
+	return pairs
+}
+
+// fakeRepo searches neighbors by brute force with the euclidean distance
+type fakeRepo struct {
+	objects []*storobj.Object
+}
+
+func (r *fakeRepo) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	var out search.Results
+	for _, obj := range r.objects {
+		if obj.ID().String() > q.Cursor.After && len(out) < q.Cursor.Limit {
+			out = append(out, *obj.SearchResult(q.Additional, ""))
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeRepo) DenseObjectSearch(ctx context.Context, class string, vector []float32,
+	offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
+	tenant string,
+) ([]*storobj.Object, []float32, error) {
+	objs := make([]*storobj.Object, len(r.objects))
+	copy(objs, r.objects)
+	dist := func(obj *storobj.Object) float32 {
+		var sum float64
+		for i := range vector {
+			d := float64(vector[i] - obj.Vector[i])
+			sum += d * d
+		}
+		return float32(math.Sqrt(sum))
+	}
+	sort.Slice(objs, func(a, b int) bool { return dist(objs[a]) < dist(objs[b]) })
+	if len(objs) > limit {
+		objs = objs[:limit]
+	}
+
+	dists := make([]float32, len(objs))
+	for i, obj := range objs {
+		// the properties are copied like they are decoded from storage
+		props := map[string]interface{}{}
+		for k, v := range obj.Properties().(map[string]interface{}) {
+			props[k] = v
+		}
+		objs[i] = object(obj.ID(), obj.Vector, props)
+		dists[i] = dist(obj)
+	}
+	return objs, dists, nil
+}
+
+type fakeWriter struct {
+	sync.Mutex
+	merged  map[strfmt.UUID]map[string]interface{}
+	deleted []strfmt.UUID
+}
+
+func (w *fakeWriter) MergeObject(ctx context.Context, principal *models.Principal,
+	updates *models.Object, repl *additional.ReplicationProperties,
+) *objects.Error {
+	w.Lock()
+	defer w.Unlock()
+	if w.merged == nil {
+		w.merged = map[strfmt.UUID]map[string]interface{}{}
+	}
+	w.merged[updates.ID] = updates.Properties.(map[string]interface{})
+	return nil
+}
+
+func (w *fakeWriter) DeleteObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+) error {
+	w.Lock()
+	defer w.Unlock()
+	w.deleted = append(w.deleted, id)
+	return nil
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package neardup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// ModeReport only lists near-duplicates
+	ModeReport = "report"
+	// ModeMerge keeps the object with the lower id of each pair. Properties
+	// it lacks are taken from the duplicate, which is deleted.
+	ModeMerge = "merge"
+)
+
+const (
+	StatusStarted   = "STARTED"
+	StatusSuccess   = "SUCCESS"
+	StatusFailed    = "FAILED"
+	StatusCancelled = "CANCELLED"
+)
+
+// MaxReportedPairs limits the pairs listed in a report, all pairs are
+// counted and merged regardless
+const MaxReportedPairs = 1000
+
+func validateMode(mode string) error {
+	switch mode {
+	case ModeReport, ModeMerge:
+		return nil
+	default:
+		return fmt.Errorf("mode must be one of %q or %q, got %q",
+			ModeReport, ModeMerge, mode)
+	}
+}
+
+// Pair are two objects whose vectors are within the distance threshold. ID
+// is the lower id, which is the one kept by ModeMerge.
+type Pair struct {
+	ID          strfmt.UUID `json:"id"`
+	DuplicateID strfmt.UUID `json:"duplicateId"`
+	Distance    float32     `json:"distance"`
+}
+
+// Report is the state of a detection run
+type Report struct {
+	Class          string     `json:"class"`
+	Tenant         string     `json:"tenant,omitempty"`
+	Mode           string     `json:"mode"`
+	Distance       float32    `json:"distance"`
+	Neighbors      int        `json:"neighbors"`
+	JobID          string     `json:"jobId,omitempty"`
+	Status         string     `json:"status"`
+	Error          string     `json:"error,omitempty"`
+	StartedAt      time.Time  `json:"startedAt"`
+	CompletedAt    *time.Time `json:"completedAt,omitempty"`
+	ObjectsScanned int64      `json:"objectsScanned"`
+	PairsFound     int64      `json:"pairsFound"`
+	Merged         int64      `json:"merged"`
+	// Truncated is set if more than MaxReportedPairs pairs were found
+	Truncated bool   `json:"truncated"`
+	Pairs     []Pair `json:"pairs"`
+}
+
+func (r *Report) addPair(p Pair) {
+	r.PairsFound++
+	if len(r.Pairs) < MaxReportedPairs {
+		r.Pairs = append(r.Pairs, p)
+	} else {
+		r.Truncated = true
+	}
+}
+
+func (r *Report) clone() *Report {
+	out := *r
+	out.Pairs = make([]Pair, len(r.Pairs))
+	copy(out.Pairs, r.Pairs)
+	if r.CompletedAt != nil {
+		t := *r.CompletedAt
+		out.CompletedAt = &t
+	}
+	return &out
+}
@@ -15,17 +15,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
-	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/audit"
 )
 
 type schemaManager interface {
 	GetSchema(principal *models.Principal) (schema.Schema, error)
+	GetSchemaSkipAuth() schema.Schema
 	AddClass(ctx context.Context, principal *models.Principal,
 		class *models.Class) error
 	GetClass(ctx context.Context, principal *models.Principal,
@@ -38,11 +40,28 @@ type schemaManager interface {
 // AddObject Class Instance to the connected DB.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, object *models.Object,
 	repl *additional.ReplicationProperties,
-) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+) (obj *models.Object, err error) {
+	defer func() {
+		event := objectEvent(audit.ActionObjectCreate, object)
+		if obj != nil {
+			event = objectEvent(audit.ActionObjectCreate, obj)
+		}
+		m.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	if err = writable(m.writeGuard); err != nil {
+		return nil, err
+	}
+	err = m.authorizer.Authorize(principal, "create", "objects")
 	if err != nil {
 		return nil, err
 	}
+	if object != nil {
+		err = m.authorizeClass(principal, "create", object.Class, object.Tenant)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
@@ -93,6 +112,22 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string, id strfm
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	class, err := m.schemaManager.GetClass(ctx, principal, object.Class)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPropertyDefaults(class, object, time.UnixMilli(m.timeSource.Now())); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
+
+	if object.ID == "" {
+		derived, err := deriveObjectID(ctx, principal, m.schemaManager, object)
+		if err != nil {
+			return nil, NewErrInvalidUserInput("derive id: %v", err)
+		}
+		object.ID = derived
+	}
+
 	id, err := m.checkIDOrAssignNew(ctx, object.Class, object.ID, repl, object.Tenant)
 	if err != nil {
 		return nil, err
@@ -115,7 +150,8 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if object.Properties == nil {
 		object.Properties = map[string]interface{}{}
 	}
-	class, err := m.schemaManager.GetClass(ctx, principal, object.Class)
+	// autoSchema may have added properties to the class
+	class, err = m.schemaManager.GetClass(ctx, principal, object.Class)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +159,13 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	if err := fitVectorDimensions(class, object); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
+	if err := externalizeBlobs(ctx, m.blobs, class, object.ID,
+		object.Tenant, objectProps(object)); err != nil {
+		return nil, err
+	}
 
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, repl)
 	if err != nil {
@@ -141,7 +184,7 @@ func (m *Manager) validateObjectAndNormalizeNames(ctx context.Context,
 		return err
 	}
 
-	return validation.New(m.vectorRepo.Exists, m.config, repl).
+	return newValidator(m.vectorRepo.Exists, m.config, repl, m.remoteRefs).
 		Object(ctx, class, incoming, existing)
 }
 
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeAuditSink struct {
+	events []audit.Event
+}
+
+func (s *fakeAuditSink) Write(event audit.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *fakeAuditSink) Close() error { return nil }
+
+func TestAuditLog(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+		Class:             "Foo",
+		Vectorizer:        config.VectorizerModuleNone,
+		VectorIndexConfig: hnsw.UserConfig{},
+	}}}}
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+	authorizer := &fakeAuthorizer{}
+	logger, _ := test.NewNullLogger()
+	modulesProvider := getFakeModulesProvider()
+	modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+		Return(nil, nil)
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: sch},
+		&config.WeaviateConfig{}, logger, authorizer, vectorRepo, modulesProvider, &fakeMetrics{})
+
+	sink := &fakeAuditSink{}
+	manager.SetAuditLogger(audit.NewLogger([]audit.Sink{sink}, 1, logger))
+	ctx := audit.WithRequestID(context.Background(), "req-1")
+	alice := &models.Principal{Username: "alice"}
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	vectorRepo.On("Exists", "Foo", id).Return(false, nil).Once()
+	_, err := manager.AddObject(ctx, alice, &models.Object{
+		ID:     id,
+		Class:  "Foo",
+		Vector: []float32{0.1, 0.2, 0.3},
+	}, nil)
+	require.Nil(t, err)
+
+	authorizer.Err = errors.New("forbidden")
+	err = manager.DeleteObject(ctx, alice, "Foo", id, nil, "")
+	require.NotNil(t, err)
+
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, audit.ActionObjectCreate, sink.events[0].Action)
+	assert.Equal(t, "Foo", sink.events[0].Class)
+	assert.Equal(t, id.String(), sink.events[0].Resource)
+	assert.Equal(t, "alice", sink.events[0].User)
+	assert.Equal(t, "req-1", sink.events[0].RequestID)
+	assert.Empty(t, sink.events[0].Error)
+
+	assert.Equal(t, audit.ActionObjectDelete, sink.events[1].Action)
+	assert.Equal(t, "forbidden", sink.events[1].Error, "denied mutations are recorded")
+}
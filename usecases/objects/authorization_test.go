@@ -110,6 +110,22 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResource: "objects/foo",
 		},
 
+		{
+			methodName:       "GetBlob",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), "image", ""},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName: "MultiGetObjects",
+			additionalArgs: []interface{}{
+				[]MultiGetItem{{Class: "class", ID: strfmt.UUID("foo")}},
+				[]string(nil), additional.Properties{}, "",
+			},
+			expectedVerb:     "get",
+			expectedResource: "objects",
+		},
+
 		// query objects
 		{
 			methodName:       "Query",
@@ -153,6 +169,11 @@ func Test_Kinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Manager{}) {
+			switch method {
+			case "SetRemoteRefResolver", "SetAuditLogger", "SetBlobStore", "SetWriteGuard":
+				// not user facing, only called once during startup
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -211,16 +232,37 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedResource: "batch/objects",
 		},
 
+		{
+			methodName: "AddObjectsChunk",
+			additionalArgs: []interface{}{
+				[]*models.Object{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "create",
+			expectedResource: "batch/objects",
+		},
+
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
 				[]*models.BatchReference{},
+				(*string)(nil),
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "update",
 			expectedResource: "batch/*",
 		},
 
+		{
+			methodName: "MergeObjects",
+			additionalArgs: []interface{}{
+				[]*models.Object{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
+
 		{
 			methodName: "DeleteObjects",
 			additionalArgs: []interface{}{
@@ -233,6 +275,17 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
+
+		{
+			methodName: "UpdateObjects",
+			additionalArgs: []interface{}{
+				&models.BatchUpdate{},
+				&additional.ReplicationProperties{},
+				"",
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -242,6 +295,12 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&BatchManager{}) {
+			switch method {
+			case "SetRemoteRefResolver", "SetWriteStallFn", "SetAuditLogger", "SetObjectMerger",
+				"SetDuplicateIndex", "SetBlobStore", "SetWriteGuard":
+				// not user facing, only called once during startup
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
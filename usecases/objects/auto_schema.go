@@ -76,6 +76,10 @@ func (m *autoSchemaManager) performAutoSchema(ctx context.Context, principal *mo
 	if err != nil {
 		return err
 	}
+	if schemaClass != nil && schemaClass.VectorOnly {
+		// properties of vector-only classes are rejected by the validation
+		return nil
+	}
 	properties := m.getProperties(object)
 	if schemaClass == nil {
 		return m.createClass(ctx, principal, object.Class, properties)
@@ -22,18 +22,40 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
-	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"golang.org/x/sync/errgroup"
 )
 
 // AddObjects Class Instances in batch to the connected DB
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
-) (BatchObjects, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+) (res BatchObjects, err error) {
+	defer func() {
+		b.auditLog.Record(ctx, principal, batchEvent(objects), err)
+	}()
+
+	if err = writable(b.writeGuard); err != nil {
+		return nil, err
+	}
+	err = b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
 		return nil, err
 	}
+	resources := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		if obj != nil && obj.Class != "" {
+			resources = append(resources, authorization.ClassResource(obj.Class, obj.Tenant))
+		}
+	}
+	if err := b.authorizeResources(principal, "create", resources); err != nil {
+		return nil, err
+	}
+	if d, ok := deduplicationFrom(ctx); ok {
+		if err := b.validateDeduplication(d); err != nil {
+			return nil, err
+		}
+	}
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
@@ -49,6 +71,46 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	return b.addObjects(ctx, principal, objects, fields, repl)
 }
 
+// batchEvent describes a batch import, class and tenant are only set if all
+// objects share them
+func batchEvent(objects []*models.Object) audit.Event {
+	event := audit.Event{Action: audit.ActionBatchCreate, Count: len(objects)}
+	first := true
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		if first {
+			event.Class, event.Tenant, first = obj.Class, obj.Tenant, false
+		}
+		if obj.Class != event.Class {
+			event.Class = ""
+		}
+		if obj.Tenant != event.Tenant {
+			event.Tenant = ""
+		}
+	}
+	return event
+}
+
+// authorizeResources checks every distinct class and tenant touched by a
+// batch, a single denied resource fails the whole batch
+func (b *BatchManager) authorizeResources(principal *models.Principal,
+	verb string, resources []string,
+) error {
+	seen := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		if _, ok := seen[resource]; ok {
+			continue
+		}
+		seen[resource] = struct{}{}
+		if err := b.authorizer.Authorize(principal, verb, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
 	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
@@ -58,6 +120,16 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	}
 
 	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
+	ctx = withBatchPreconditions(ctx, batchObjects)
+
+	dedup, deduplicate := deduplicationFrom(ctx)
+	var hashes [][]byte
+	if deduplicate {
+		var err error
+		if hashes, err = b.markDuplicates(ctx, batchObjects, dedup); err != nil {
+			return nil, err
+		}
+	}
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
 
 	var (
@@ -70,6 +142,9 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl); err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
+	if deduplicate {
+		b.resolveDuplicates(ctx, principal, res, hashes, dedup, repl)
+	}
 
 	return res, nil
 }
@@ -120,13 +195,22 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 
 	ec := &errorcompounder.ErrorCompounder{}
 
+	// Defaults and computed values of absent properties
+	if class, _ := b.schemaManager.GetClass(ctx, principal, concept.Class); class != nil {
+		ec.Add(applyPropertyDefaults(class, concept, time.UnixMilli(unixNow())))
+	}
+
 	// Auto Schema
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.Add(err)
 
 	if concept.ID == "" {
-		// Generate UUID for the new object
-		uid, err := generateUUID()
+		// Derive the id from the properties if configured, otherwise generate
+		// a random one for the new object
+		uid, err := deriveObjectID(ctx, principal, b.schemaManager, concept)
+		if err == nil && uid == "" {
+			uid, err = generateUUID()
+		}
 		id = uid
 		ec.Add(err)
 	} else {
@@ -164,7 +248,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	if class == nil {
 		ec.Add(fmt.Errorf("class '%s' not present in schema", object.Class))
 	} else {
-		err = validation.New(b.vectorRepo.Exists, b.config, repl).
+		err = newValidator(b.vectorRepo.Exists, b.config, repl, b.remoteRefs).
 			Object(ctx, class, object, nil)
 		ec.Add(err)
 
@@ -172,6 +256,14 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 			// update vector only if we passed validation
 			err = b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
 			ec.Add(err)
+			if err == nil {
+				err = fitVectorDimensions(class, object)
+				ec.Add(err)
+			}
+			if err == nil {
+				ec.Add(externalizeBlobs(ctx, b.blobs, class, id,
+					object.Tenant, objectProps(object)))
+			}
 		}
 	}
 
@@ -0,0 +1,372 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+// DedupMode is what a batch import with deduplication does with an object
+// which duplicates an existing object or an earlier object of the batch
+type DedupMode string
+
+const (
+	// DedupSkip does not import the duplicate
+	DedupSkip DedupMode = "skip"
+	// DedupMerge patches the original with the properties of the duplicate
+	DedupMerge DedupMode = "merge"
+	// DedupLink imports the duplicate with a reference to the original
+	DedupLink DedupMode = "link"
+)
+
+// DedupKey is what makes two objects duplicates of each other
+type DedupKey string
+
+const (
+	// DedupByContent compares the properties of objects
+	DedupByContent DedupKey = "content"
+	// DedupByVector compares the vectors of objects
+	DedupByVector DedupKey = "vector"
+)
+
+// Deduplication configures the duplicate detection of a batch import
+type Deduplication struct {
+	Mode DedupMode
+	By   DedupKey
+	// LinkProperty is the reference property through which linked duplicates
+	// point to their original, required for DedupLink
+	LinkProperty string
+}
+
+type deduplicationKey struct{}
+
+// WithDeduplication makes batch imports with ctx detect duplicates and treat
+// them as configured
+func WithDeduplication(ctx context.Context, d Deduplication) context.Context {
+	return context.WithValue(ctx, deduplicationKey{}, d)
+}
+
+func deduplicationFrom(ctx context.Context) (Deduplication, bool) {
+	d, ok := ctx.Value(deduplicationKey{}).(Deduplication)
+	return d, ok
+}
+
+// ObjectHash maps the hash of the content or vector of an object to its id.
+// LastUpdateTimeUnix is the version of the object the hash was taken from,
+// if it is unchanged the hash does not need to be recalculated.
+type ObjectHash struct {
+	Hash               []byte
+	ID                 strfmt.UUID
+	LastUpdateTimeUnix int64
+}
+
+// DuplicateIndex keeps the hashes of imported objects, it is implemented by
+// the DB. Hashes are only recorded by imports with deduplication and never
+// removed, so entries may point to objects which were deleted or changed
+// since.
+type DuplicateIndex interface {
+	// LookupObjectHashes returns the entry of every hash, with an empty id
+	// if there is none
+	LookupObjectHashes(ctx context.Context, className, tenant string,
+		hashes [][]byte) ([]ObjectHash, error)
+	PutObjectHashes(ctx context.Context, className, tenant string,
+		entries []ObjectHash) error
+}
+
+// SetDuplicateIndex enables batch imports with deduplication
+func (b *BatchManager) SetDuplicateIndex(idx DuplicateIndex) {
+	b.duplicates = idx
+}
+
+// errDuplicate makes the DB skip duplicates which must not be imported as
+// they are, it is cleared once the batch is written
+var errDuplicate = errors.New("duplicate")
+
+func (b *BatchManager) validateDeduplication(d Deduplication) error {
+	if b.duplicates == nil {
+		return NewErrInvalidUserInput("deduplication is not supported")
+	}
+
+	switch d.Mode {
+	case DedupSkip, DedupLink:
+	case DedupMerge:
+		if b.merger == nil {
+			return NewErrInvalidUserInput("merging duplicates is not supported")
+		}
+	default:
+		return NewErrInvalidUserInput(
+			"invalid param 'dedup': must be one of skip, merge or link, got %q", d.Mode)
+	}
+
+	switch d.By {
+	case DedupByContent, DedupByVector:
+	default:
+		return NewErrInvalidUserInput(
+			"invalid param 'dedupBy': must be content or vector, got %q", d.By)
+	}
+
+	if d.Mode == DedupLink && d.LinkProperty == "" {
+		return NewErrInvalidUserInput(
+			"invalid param 'dedupLinkProperty': required to link duplicates")
+	}
+
+	return nil
+}
+
+// dedupGroup are the positions of the objects of a batch which share a
+// class and tenant
+type dedupGroup struct {
+	class, tenant string
+	members       []int
+}
+
+// markDuplicates sets the original of every duplicate in the batch. The
+// first of several equal objects in the batch is the original of the others,
+// unless an existing object is. The hash of every object is returned, nil
+// for objects which are not deduplicated.
+func (b *BatchManager) markDuplicates(ctx context.Context, batch BatchObjects,
+	d Deduplication,
+) ([][]byte, error) {
+	hashes := make([][]byte, len(batch))
+	groups := map[string]*dedupGroup{}
+	for i, obj := range batch {
+		if obj.Err != nil {
+			continue
+		}
+		hash, err := objectHash(d.By, obj.Object.Properties, obj.Vector)
+		if err != nil {
+			batch[i].Err = fmt.Errorf("hash object: %w", err)
+			continue
+		}
+		if hash == nil {
+			continue
+		}
+		hashes[i] = hash
+
+		key := obj.Object.Class + "/" + obj.Object.Tenant
+		if groups[key] == nil {
+			groups[key] = &dedupGroup{class: obj.Object.Class, tenant: obj.Object.Tenant}
+		}
+		groups[key].members = append(groups[key].members, i)
+	}
+
+	for _, group := range groups {
+		if err := b.markGroupDuplicates(ctx, batch, hashes, group, d); err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
+
+func (b *BatchManager) markGroupDuplicates(ctx context.Context, batch BatchObjects,
+	hashes [][]byte, group *dedupGroup, d Deduplication,
+) error {
+	originals := map[string]strfmt.UUID{}
+	var unique [][]byte
+	for _, i := range group.members {
+		if _, ok := originals[string(hashes[i])]; !ok {
+			originals[string(hashes[i])] = ""
+			unique = append(unique, hashes[i])
+		}
+	}
+
+	existing, err := b.existingOriginals(ctx, group, unique, d.By)
+	if err != nil {
+		return err
+	}
+	for hash, id := range existing {
+		originals[hash] = id
+	}
+
+	for _, i := range group.members {
+		obj := &batch[i]
+		original := originals[string(hashes[i])]
+		if original == "" {
+			// the first occurrence in the batch
+			originals[string(hashes[i])] = obj.UUID
+			continue
+		}
+		if original == obj.UUID {
+			// an object which is re-imported with its own id is an update
+			continue
+		}
+
+		obj.DuplicateOf = original
+		switch d.Mode {
+		case DedupLink:
+			props, _ := obj.Object.Properties.(map[string]interface{})
+			if props == nil {
+				props = map[string]interface{}{}
+				obj.Object.Properties = props
+			}
+			props[d.LinkProperty] = models.MultipleRef{
+				crossref.New("localhost", group.class, original).SingleRef(),
+			}
+		default:
+			obj.Err = errDuplicate
+		}
+	}
+
+	return nil
+}
+
+// existingOriginals returns the ids of existing objects with the given
+// hashes. Entries of the duplicate index are verified against the objects
+// they point to, as those may have been deleted or changed since.
+func (b *BatchManager) existingOriginals(ctx context.Context, group *dedupGroup,
+	hashes [][]byte, by DedupKey,
+) (map[string]strfmt.UUID, error) {
+	entries, err := b.duplicates.LookupObjectHashes(ctx, group.class, group.tenant, hashes)
+	if err != nil {
+		return nil, NewErrInternal("look up duplicates: %v", err)
+	}
+
+	var candidates []ObjectHash
+	var query []multi.Identifier
+	for _, entry := range entries {
+		if entry.ID != "" {
+			candidates = append(candidates, entry)
+			query = append(query, multi.Identifier{ID: entry.ID.String(), ClassName: group.class})
+		}
+	}
+	if len(query) == 0 {
+		return nil, nil
+	}
+
+	res, err := b.vectorRepo.MultiGet(ctx, query,
+		additional.Properties{Vector: by == DedupByVector}, group.tenant)
+	if err != nil {
+		return nil, NewErrInternal("get duplicates: %v", err)
+	}
+
+	out := make(map[string]strfmt.UUID, len(candidates))
+	for i, candidate := range candidates {
+		if i >= len(res) || res[i].ID == "" {
+			continue
+		}
+		if res[i].Updated != candidate.LastUpdateTimeUnix {
+			current := res[i].ObjectWithVector(true)
+			hash, err := objectHash(by, current.Properties, current.Vector)
+			if err != nil || string(hash) != string(candidate.Hash) {
+				continue
+			}
+		}
+		out[string(candidate.Hash)] = candidate.ID
+	}
+	return out, nil
+}
+
+// resolveDuplicates applies the mode to the duplicates once the batch is
+// written and records the hashes of the imported originals
+func (b *BatchManager) resolveDuplicates(ctx context.Context, principal *models.Principal,
+	batch BatchObjects, hashes [][]byte, d Deduplication,
+	repl *additional.ReplicationProperties,
+) {
+	recorded := map[string][]ObjectHash{}
+	var classes []*dedupGroup
+	for i := range batch {
+		obj := &batch[i]
+		if obj.DuplicateOf != "" {
+			if obj.Err == errDuplicate {
+				obj.Err = nil
+				if d.Mode == DedupMerge {
+					obj.Err = b.mergeDuplicate(ctx, principal, obj, repl)
+				}
+			}
+			if obj.Err == nil {
+				b.metrics.BatchDuplicate(obj.Object.Class, string(d.Mode))
+			}
+			continue
+		}
+		if obj.Err != nil || hashes[i] == nil {
+			continue
+		}
+
+		key := obj.Object.Class + "/" + obj.Object.Tenant
+		if recorded[key] == nil {
+			classes = append(classes, &dedupGroup{class: obj.Object.Class, tenant: obj.Object.Tenant})
+		}
+		recorded[key] = append(recorded[key], ObjectHash{
+			Hash:               hashes[i],
+			ID:                 obj.UUID,
+			LastUpdateTimeUnix: obj.Object.LastUpdateTimeUnix,
+		})
+	}
+
+	for _, group := range classes {
+		entries := recorded[group.class+"/"+group.tenant]
+		if err := b.duplicates.PutObjectHashes(ctx, group.class, group.tenant, entries); err != nil {
+			// the objects are imported, only their duplicates can't be detected
+			b.logger.WithField("action", "batch_deduplication").
+				WithField("class", group.class).
+				WithError(err).
+				Warn("failed to record object hashes")
+		}
+	}
+}
+
+func (b *BatchManager) mergeDuplicate(ctx context.Context, principal *models.Principal,
+	obj *BatchObject, repl *additional.ReplicationProperties,
+) error {
+	updates := &models.Object{
+		Class:      obj.Object.Class,
+		ID:         obj.DuplicateOf,
+		Tenant:     obj.Object.Tenant,
+		Properties: obj.Object.Properties,
+	}
+	// a nil *Error must not end up as a non-nil error interface
+	if err := b.merger.MergeObject(ctx, principal, updates, repl); err != nil {
+		return err
+	}
+	return nil
+}
+
+// objectHash returns the hash identifying duplicates, nil if the object has
+// nothing to compare by. Properties are hashed as JSON, which orders the keys
+// of maps.
+func objectHash(by DedupKey, props models.PropertySchema, vector []float32) ([]byte, error) {
+	h := sha256.New()
+	switch by {
+	case DedupByVector:
+		if len(vector) == 0 {
+			return nil, nil
+		}
+		h.Write([]byte{'v'})
+		buf := make([]byte, 4)
+		for _, x := range vector {
+			binary.LittleEndian.PutUint32(buf, math.Float32bits(x))
+			h.Write(buf)
+		}
+	default:
+		h.Write([]byte{'c'})
+		if props == nil {
+			props = map[string]interface{}{}
+		}
+		if err := json.NewEncoder(h).Encode(props); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeDuplicateIndex struct {
+	sync.Mutex
+	entries map[string]ObjectHash
+}
+
+func (f *fakeDuplicateIndex) LookupObjectHashes(ctx context.Context, className, tenant string,
+	hashes [][]byte,
+) ([]ObjectHash, error) {
+	f.Lock()
+	defer f.Unlock()
+	out := make([]ObjectHash, len(hashes))
+	for i, hash := range hashes {
+		out[i] = f.entries[string(hash)]
+		out[i].Hash = hash
+	}
+	return out, nil
+}
+
+func (f *fakeDuplicateIndex) PutObjectHashes(ctx context.Context, className, tenant string,
+	entries []ObjectHash,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, entry := range entries {
+		f.entries[string(entry.Hash)] = entry
+	}
+	return nil
+}
+
+func Test_BatchManager_AddObjects_Deduplication(t *testing.T) {
+	const (
+		existing = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		id1      = strfmt.UUID("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5")
+		id2      = strfmt.UUID("0c8e6d2b-3e3c-4a0e-9a5f-d7a8b95dc1c6")
+		id3      = strfmt.UUID("c3f1e8a2-7f4b-4f0e-8d0a-2b6a1e9c5d44")
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{{
+				Class:             "Foo",
+				Vectorizer:        config.VectorizerModuleNone,
+				VectorIndexConfig: hnsw.UserConfig{},
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "duplicateOf", DataType: []string{"Foo"}},
+				},
+			}},
+		},
+	}
+
+	var (
+		vectorRepo *fakeVectorRepo
+		index      *fakeDuplicateIndex
+		merger     *fakeObjectMerger
+		manager    *BatchManager
+	)
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		index = &fakeDuplicateIndex{entries: map[string]ObjectHash{}}
+		merger = &fakeObjectMerger{}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: sch}, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, nil)
+		manager.SetObjectMerger(merger)
+		manager.SetDuplicateIndex(index)
+	}
+
+	hashOf := func(name string) []byte {
+		hash, err := objectHash(DedupByContent, map[string]interface{}{"name": name}, nil)
+		require.Nil(t, err)
+		return hash
+	}
+	batch := func() []*models.Object {
+		return []*models.Object{
+			{Class: "Foo", ID: id1, Properties: map[string]interface{}{"name": "a"}},
+			{Class: "Foo", ID: id2, Properties: map[string]interface{}{"name": "b"}},
+			{Class: "Foo", ID: id3, Properties: map[string]interface{}{"name": "b"}},
+		}
+	}
+	// existing is recorded as the original of "a"
+	recordExisting := func(updated int64, found bool) {
+		index.entries[string(hashOf("a"))] = ObjectHash{
+			Hash: hashOf("a"), ID: existing, LastUpdateTimeUnix: 5,
+		}
+		var res []search.Result
+		if found {
+			res = []search.Result{{
+				ID: existing, ClassName: "Foo", Updated: updated,
+				Schema: map[string]interface{}{"name": "a"},
+			}}
+		} else {
+			res = []search.Result{{}}
+		}
+		vectorRepo.On("MultiGet", mock.Anything, "").Return(res, nil)
+	}
+	ctx := context.Background()
+
+	t.Run("invalid options", func(t *testing.T) {
+		for _, d := range []Deduplication{
+			{Mode: "drop", By: DedupByContent},
+			{Mode: DedupSkip, By: "name"},
+			{Mode: DedupLink, By: DedupByContent},
+		} {
+			reset()
+			_, err := manager.AddObjects(WithDeduplication(ctx, d), nil, batch(), nil, nil)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		reset()
+		recordExisting(5, true)
+		var written []strfmt.UUID
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once().
+			Run(func(args mock.Arguments) {
+				for _, obj := range args.Get(0).(BatchObjects) {
+					if obj.Err == nil {
+						written = append(written, obj.UUID)
+					}
+				}
+			})
+
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupSkip, By: DedupByContent}), nil, batch(), nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+		assert.Equal(t, existing, res[0].DuplicateOf)
+		assert.Equal(t, strfmt.UUID(""), res[1].DuplicateOf)
+		assert.Equal(t, id2, res[2].DuplicateOf)
+		assert.Equal(t, []strfmt.UUID{id2}, written)
+
+		// only the original of "b" was imported and recorded
+		assert.Equal(t, id2, index.entries[string(hashOf("b"))].ID)
+		assert.Equal(t, existing, index.entries[string(hashOf("a"))].ID)
+		assert.Empty(t, merger.merged)
+	})
+
+	t.Run("changed original", func(t *testing.T) {
+		reset()
+		recordExisting(6, true)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupSkip, By: DedupByContent}), nil, batch(), nil, nil)
+		require.Nil(t, err)
+		// the content is still equal
+		assert.Equal(t, existing, res[0].DuplicateOf)
+	})
+
+	t.Run("deleted original", func(t *testing.T) {
+		reset()
+		recordExisting(5, false)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupSkip, By: DedupByContent}), nil, batch(), nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, strfmt.UUID(""), res[0].DuplicateOf)
+		assert.Equal(t, id1, index.entries[string(hashOf("a"))].ID)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		reset()
+		recordExisting(5, true)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupMerge, By: DedupByContent}), nil, batch(), nil, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{existing, id2}, merger.merged)
+	})
+
+	t.Run("link", func(t *testing.T) {
+		reset()
+		recordExisting(5, true)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupLink, By: DedupByContent, LinkProperty: "duplicateOf"}),
+			nil, batch(), nil, nil)
+		require.Nil(t, err)
+
+		ref := res[2].Object.Properties.(map[string]interface{})["duplicateOf"].(models.MultipleRef)
+		require.Len(t, ref, 1)
+		assert.Equal(t, strfmt.URI("weaviate://localhost/Foo/"+id2), ref[0].Beacon)
+		assert.Nil(t, res[1].Object.Properties.(map[string]interface{})["duplicateOf"])
+	})
+
+	t.Run("by vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		objs := batch()
+		objs[0].Vector = []float32{1, 2}
+		objs[1].Vector = []float32{1, 2}
+		res, err := manager.AddObjects(WithDeduplication(ctx,
+			Deduplication{Mode: DedupSkip, By: DedupByVector}), nil, objs, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, id1, res[1].DuplicateOf)
+		// objects without a vector are not compared
+		assert.Equal(t, strfmt.UUID(""), res[2].DuplicateOf)
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 const (
@@ -32,11 +34,37 @@ const (
 func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
-) (*BatchDeleteResponse, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+) (res *BatchDeleteResponse, err error) {
+	defer func() {
+		if dryRun != nil && *dryRun {
+			return
+		}
+		event := audit.Event{Action: audit.ActionBatchDelete, Tenant: tenant}
+		if match != nil {
+			event.Class = match.Class
+		}
+		if res != nil {
+			event.Count = int(res.Result.Matches)
+		}
+		b.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	if dryRun == nil || !*dryRun {
+		if err = writable(b.writeGuard); err != nil {
+			return nil, err
+		}
+	}
+	err = b.authorizer.Authorize(principal, "delete", "batch/objects")
 	if err != nil {
 		return nil, err
 	}
+	if match != nil {
+		err = b.authorizer.Authorize(principal, "delete",
+			authorization.ClassResource(match.Class, tenant))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
@@ -59,14 +87,53 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 		return nil, NewErrInvalidUserInput("validate: %v", err)
 	}
 
+	actions := newReferentialActions(b.vectorRepo, b.authorizer, principal,
+		b.schemaManager.GetSchemaSkipAuth(), defaultTimeSource{}.Now, repl, tenant)
+	if actions != nil && !params.DryRun {
+		if err := b.checkReferentialActions(ctx, actions, *params, repl, tenant); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params, repl, tenant)
 	if err != nil {
 		return nil, fmt.Errorf("batch delete objects: %w", err)
 	}
 
+	if actions != nil && !result.DryRun {
+		for i, obj := range result.Objects {
+			if obj.Err != nil {
+				continue
+			}
+			if err := actions.apply(ctx, params.ClassName.String(), obj.UUID); err != nil {
+				result.Objects[i].Err = fmt.Errorf("apply onDelete rules: %w", err)
+			}
+		}
+	}
+
 	return b.toResponse(match, params.Output, result)
 }
 
+// checkReferentialActions makes sure none of the matched objects is protected
+// by a restrict rule before anything gets deleted
+func (b *BatchManager) checkReferentialActions(ctx context.Context,
+	actions *referentialActions, params BatchDeleteParams,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
+	params.DryRun = true
+	matches, err := b.vectorRepo.BatchDeleteObjects(ctx, params, repl, tenant)
+	if err != nil {
+		return fmt.Errorf("batch delete objects: %w", err)
+	}
+
+	for _, obj := range matches.Objects {
+		if err := actions.check(ctx, params.ClassName.String(), obj.UUID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 	result BatchDeleteResult,
 ) (*BatchDeleteResponse, error) {
@@ -98,48 +165,68 @@ func (b *BatchManager) validateBatchDelete(ctx context.Context, principal *model
 		return nil, errors.New("empty match.where clause")
 	}
 
+	class, filter, err := b.matchFilter(principal, match.Class, match.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRunParam := false
+	if dryRun != nil {
+		dryRunParam = *dryRun
+	}
+
+	outputParam, err := parseOutput(output)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &BatchDeleteParams{
+		ClassName: schema.ClassName(class.Class),
+		Filters:   filter,
+		DryRun:    dryRunParam,
+		Output:    outputParam,
+	}
+	return params, nil
+}
+
+// matchFilter resolves the class of a match clause and parses its where
+// filter against the schema
+func (b *BatchManager) matchFilter(principal *models.Principal,
+	className string, where *models.WhereFilter,
+) (*models.Class, *filters.LocalFilter, error) {
 	// Validate schema given in body with the weaviate schema
 	s, err := b.schemaManager.GetSchema(principal)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get schema: %s", err)
+		return nil, nil, fmt.Errorf("failed to get schema: %s", err)
 	}
 
-	class := s.FindClassByName(schema.ClassName(match.Class))
+	class := s.FindClassByName(schema.ClassName(className))
 	if class == nil {
-		return nil, fmt.Errorf("class: %v doesn't exist", match.Class)
+		return nil, nil, fmt.Errorf("class: %v doesn't exist", className)
 	}
 
-	filter, err := filterext.Parse(match.Where, class.Class)
+	filter, err := filterext.Parse(where, class.Class)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse where filter: %s", err)
+		return nil, nil, fmt.Errorf("failed to parse where filter: %s", err)
 	}
 
 	err = filters.ValidateFilters(s, filter)
 	if err != nil {
-		return nil, fmt.Errorf("invalid where filter: %s", err)
+		return nil, nil, fmt.Errorf("invalid where filter: %s", err)
 	}
 
-	dryRunParam := false
-	if dryRun != nil {
-		dryRunParam = *dryRun
-	}
+	return class, filter, nil
+}
 
-	outputParam := OutputMinimal
-	if output != nil {
-		switch *output {
-		case OutputMinimal, OutputVerbose:
-			outputParam = *output
-		default:
-			return nil, fmt.Errorf(`invalid output: "%s", possible values are: "%s", "%s"`,
-				*output, OutputMinimal, OutputVerbose)
-		}
+func parseOutput(output *string) (string, error) {
+	if output == nil {
+		return OutputMinimal, nil
 	}
-
-	params := &BatchDeleteParams{
-		ClassName: schema.ClassName(class.Class),
-		Filters:   filter,
-		DryRun:    dryRunParam,
-		Output:    outputParam,
+	switch *output {
+	case OutputMinimal, OutputVerbose:
+		return *output, nil
+	default:
+		return "", fmt.Errorf(`invalid output: "%s", possible values are: "%s", "%s"`,
+			*output, OutputMinimal, OutputVerbose)
 	}
-	return params, nil
 }
@@ -13,9 +13,14 @@ package objects
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -32,6 +37,15 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	remoteRefs        RemoteRefResolver
+	merger            ObjectMerger
+	duplicates        DuplicateIndex
+	writeStall        WriteStallFn
+	auditLog          *audit.Logger
+	blobs             BlobStore
+	writeGuard        WriteGuard
+	// chunks of streaming imports which are currently imported
+	streamedChunks atomic.Int64
 }
 
 type BatchVectorRepo interface {
@@ -44,8 +58,12 @@ type batchRepoNew interface {
 		repl *additional.ReplicationProperties) (BatchObjects, error)
 	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
 		repl *additional.ReplicationProperties, tenant string) (BatchDeleteResult, error)
+	BatchUpdateObjects(ctx context.Context, params BatchUpdateParams,
+		repl *additional.ReplicationProperties, tenant string) (BatchUpdateResult, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences,
 		repl *additional.ReplicationProperties) (BatchReferences, error)
+	MultiGet(ctx context.Context, query []multi.Identifier,
+		additional additional.Properties, tenant string) ([]search.Result, error)
 }
 
 // NewBatchManager creates a new manager
@@ -66,3 +84,32 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		metrics:           NewMetrics(prom),
 	}
 }
+
+// ObjectMerger patches a single existing object, it is implemented by
+// Manager
+type ObjectMerger interface {
+	MergeObject(ctx context.Context, principal *models.Principal,
+		updates *models.Object, repl *additional.ReplicationProperties) *Error
+}
+
+// SetObjectMerger enables batch merges, every object of a batch is patched
+// the same way as with a single PATCH request
+func (b *BatchManager) SetObjectMerger(m ObjectMerger) {
+	b.merger = m
+}
+
+// SetRemoteRefResolver enables references to objects on federation peers
+func (b *BatchManager) SetRemoteRefResolver(r RemoteRefResolver) {
+	b.remoteRefs = r
+}
+
+// SetAuditLogger records every batch import and batch deletion
+func (b *BatchManager) SetAuditLogger(l *audit.Logger) {
+	b.auditLog = l
+}
+
+// SetWriteGuard rejects all batch writes the guard does not allow, dry runs
+// are still possible
+func (b *BatchManager) SetWriteGuard(g WriteGuard) {
+	b.writeGuard = g
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"golang.org/x/sync/errgroup"
+)
+
+// MergeObjects patches existing objects in batch. Only the provided
+// properties are changed and the existing vector is kept unless a new one is
+// supplied. A failing object does not fail the batch, its error is reported
+// on the corresponding item of the result.
+func (b *BatchManager) MergeObjects(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	if err := writable(b.writeGuard); err != nil {
+		return nil, err
+	}
+	if err := b.authorizer.Authorize(principal, "update", "batch/objects"); err != nil {
+		return nil, err
+	}
+	resources := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		if obj != nil && obj.Class != "" {
+			resources = append(resources, authorization.ClassResource(obj.Class, obj.Tenant))
+		}
+	}
+	if err := b.authorizeResources(principal, "update", resources); err != nil {
+		return nil, err
+	}
+
+	if b.merger == nil {
+		return nil, NewErrInternal("batch merges are not supported")
+	}
+	if len(objects) == 0 {
+		return nil, NewErrInvalidUserInput("invalid param 'objects': " +
+			"cannot be empty, need at least one object for batching")
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	before := time.Now()
+	b.metrics.BatchInc()
+	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
+	defer b.metrics.BatchDec()
+
+	res := make(BatchObjects, len(objects))
+	eg := new(errgroup.Group)
+	eg.SetLimit(2 * runtime.GOMAXPROCS(0))
+	for i, obj := range objects {
+		i, obj := i, obj
+		eg.Go(func() error {
+			res[i] = b.mergeObject(ctx, principal, obj, i, repl)
+			return nil
+		})
+	}
+	eg.Wait()
+
+	return res, nil
+}
+
+func (b *BatchManager) mergeObject(ctx context.Context, principal *models.Principal,
+	obj *models.Object, i int, repl *additional.ReplicationProperties,
+) BatchObject {
+	if obj == nil {
+		return BatchObject{
+			OriginalIndex: i,
+			Object:        &models.Object{},
+			Err:           fmt.Errorf("empty object"),
+		}
+	}
+
+	res := BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+	if obj.LastUpdateTimeUnix != 0 {
+		ctx = WithExpectedVersion(ctx, obj.LastUpdateTimeUnix)
+	}
+	// a nil *Error must not end up as a non-nil error interface
+	if err := b.merger.MergeObject(ctx, principal, obj, repl); err != nil {
+		res.Err = err
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeObjectMerger struct {
+	sync.Mutex
+	merged []strfmt.UUID
+	errs   map[strfmt.UUID]*Error
+}
+
+func (f *fakeObjectMerger) MergeObject(ctx context.Context, principal *models.Principal,
+	updates *models.Object, repl *additional.ReplicationProperties,
+) *Error {
+	f.Lock()
+	defer f.Unlock()
+	if err, ok := f.errs[updates.ID]; ok {
+		return err
+	}
+	f.merged = append(f.merged, updates.ID)
+	return nil
+}
+
+func Test_BatchMerge(t *testing.T) {
+	const (
+		id1 = strfmt.UUID("8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5")
+		id2 = strfmt.UUID("0c8e6d2b-3e3c-4a0e-9a5f-d7a8b95dc1c6")
+	)
+
+	newManager := func() *BatchManager {
+		logger, _ := test.NewNullLogger()
+		return NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(), &fakeLocks{},
+			&fakeSchemaManager{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+	}
+	ctx := context.Background()
+
+	t.Run("without merger", func(t *testing.T) {
+		_, err := newManager().MergeObjects(ctx, nil,
+			[]*models.Object{{Class: "Foo", ID: id1}}, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("without objects", func(t *testing.T) {
+		manager := newManager()
+		manager.SetObjectMerger(&fakeObjectMerger{})
+		_, err := manager.MergeObjects(ctx, nil, []*models.Object{}, nil)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("errors are reported per object", func(t *testing.T) {
+		merger := &fakeObjectMerger{errs: map[strfmt.UUID]*Error{
+			id2: {"not found", StatusNotFound, errors.New("not found")},
+		}}
+		manager := newManager()
+		manager.SetObjectMerger(merger)
+
+		res, err := manager.MergeObjects(ctx, nil, []*models.Object{
+			{Class: "Foo", ID: id1, Properties: map[string]interface{}{"name": "a"}},
+			{Class: "Foo", ID: id2},
+			nil,
+		}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, id1, res[0].UUID)
+		assert.NotNil(t, res[1].Err)
+		assert.Equal(t, id2, res[1].UUID)
+		assert.NotNil(t, res[2].Err)
+		assert.Equal(t, []strfmt.UUID{id1}, merger.merged)
+	})
+}
@@ -20,18 +20,51 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
-// AddReferences Class Instances in batch to the connected DB
+const (
+	// ReferenceValidationSkip only parses the beacons, neither the schema nor
+	// the referenced objects are checked
+	ReferenceValidationSkip = "skipValidation"
+	// ReferenceValidationSchemaOnly checks that the source property is a
+	// reference property which can point to the target class
+	ReferenceValidationSchemaOnly = "validateSchemaOnly"
+	// ReferenceValidationExistence additionally checks that the source and
+	// target objects exist, they are looked up in bulk
+	ReferenceValidationExistence = "validateExistence"
+)
+
+// AddReferences Class Instances in batch to the connected DB. Without a
+// validation mode, only the objects of multi-tenant classes are checked.
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
-	refs []*models.BatchReference, repl *additional.ReplicationProperties,
+	refs []*models.BatchReference, validation *string,
+	repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
+	if err := writable(b.writeGuard); err != nil {
+		return nil, err
+	}
 	err := b.authorizer.Authorize(principal, "update", "batch/*")
 	if err != nil {
 		return nil, err
 	}
+	resources := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		// malformed sources are rejected by the validation later on
+		if ref == nil {
+			continue
+		}
+		if source, err := crossref.ParseSource(string(ref.From)); err == nil {
+			resources = append(resources,
+				authorization.ClassResource(source.Class.String(), ref.Tenant))
+		}
+	}
+	if err := b.authorizeResources(principal, "update", resources); err != nil {
+		return nil, err
+	}
 
 	unlock, err := b.locks.LockSchema()
 	if err != nil {
@@ -42,17 +75,33 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	b.metrics.BatchRefInc()
 	defer b.metrics.BatchRefDec()
 
-	return b.addReferences(ctx, principal, refs, repl)
+	return b.addReferences(ctx, principal, refs, validation, repl)
 }
 
 func (b *BatchManager) addReferences(ctx context.Context, principal *models.Principal,
-	refs []*models.BatchReference, repl *additional.ReplicationProperties,
+	refs []*models.BatchReference, validation *string,
+	repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
 	if err := b.validateReferenceForm(refs); err != nil {
 		return nil, NewErrInvalidUserInput("invalid params: %v", err)
 	}
 
-	batchReferences := b.validateReferencesConcurrently(ctx, principal, refs)
+	mode := ""
+	if validation != nil {
+		switch *validation {
+		case ReferenceValidationSkip, ReferenceValidationSchemaOnly, ReferenceValidationExistence:
+			mode = *validation
+		default:
+			return nil, NewErrInvalidUserInput(`invalid validation: "%s", possible values are: "%s", "%s", "%s"`,
+				*validation, ReferenceValidationSkip, ReferenceValidationSchemaOnly,
+				ReferenceValidationExistence)
+		}
+	}
+
+	batchReferences, targets := b.validateReferencesConcurrently(ctx, principal, refs, mode)
+	if mode == ReferenceValidationExistence {
+		b.validateReferencesExistence(ctx, batchReferences, targets)
+	}
 	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences, repl); err != nil {
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
 	} else {
@@ -68,25 +117,35 @@ func (b *BatchManager) validateReferenceForm(refs []*models.BatchReference) erro
 	return nil
 }
 
+// referenceTarget is where the target object of a reference is expected,
+// the class is resolved from the schema if the beacon does not contain it
+type referenceTarget struct {
+	class  string
+	tenant string
+}
+
 func (b *BatchManager) validateReferencesConcurrently(ctx context.Context,
-	principal *models.Principal, refs []*models.BatchReference,
-) BatchReferences {
+	principal *models.Principal, refs []*models.BatchReference, mode string,
+) (BatchReferences, []referenceTarget) {
 	c := make(chan BatchReference, len(refs))
 	wg := new(sync.WaitGroup)
+	// every goroutine only writes its own position
+	targets := make([]referenceTarget, len(refs))
 
 	// Generate a goroutine for each separate request
 	for i, ref := range refs {
 		wg.Add(1)
-		go b.validateReference(ctx, principal, wg, ref, i, &c)
+		go b.validateReference(ctx, principal, wg, ref, i, mode, targets, &c)
 	}
 
 	wg.Wait()
 	close(c)
-	return referencesChanToSlice(c)
+	return referencesChanToSlice(c), targets
 }
 
 func (b *BatchManager) validateReference(ctx context.Context, principal *models.Principal,
-	wg *sync.WaitGroup, ref *models.BatchReference, i int, resultsC *chan BatchReference,
+	wg *sync.WaitGroup, ref *models.BatchReference, i int, mode string,
+	targets []referenceTarget, resultsC *chan BatchReference,
 ) {
 	defer wg.Done()
 	var errors []error
@@ -113,10 +172,20 @@ func (b *BatchManager) validateReference(ctx context.Context, principal *models.
 		err = joinErrors(errors)
 	}
 
-	if err == nil && shouldValidateMultiTenantRef(ref.Tenant, source, target) {
-		// can only validate multi-tenancy when everything above succeeds
-		err = validateReferenceMultiTenancy(ctx, principal,
-			b.schemaManager, b.vectorRepo, source, target, ref.Tenant)
+	// can only validate the schema and multi-tenancy when everything above
+	// succeeds
+	if err == nil {
+		switch mode {
+		case ReferenceValidationSkip:
+		case ReferenceValidationSchemaOnly, ReferenceValidationExistence:
+			targets[i], err = validateBatchReferenceSchema(ctx, principal,
+				b.schemaManager, source, target, ref.Tenant)
+		default:
+			if shouldValidateMultiTenantRef(ref.Tenant, source, target) {
+				err = validateReferenceMultiTenancy(ctx, principal,
+					b.schemaManager, b.vectorRepo, source, target, ref.Tenant)
+			}
+		}
 	}
 
 	*resultsC <- BatchReference{
@@ -128,6 +197,122 @@ func (b *BatchManager) validateReference(ctx context.Context, principal *models.
 	}
 }
 
+// validateBatchReferenceSchema checks the source property and the target class
+// against the schema without reading any objects
+func validateBatchReferenceSchema(ctx context.Context,
+	principal *models.Principal, schemaManager schemaManager,
+	source *crossref.RefSource, target *crossref.Ref, tenant string,
+) (referenceTarget, error) {
+	sourceClass, err := schemaManager.GetClass(ctx, principal, source.Class.String())
+	if err != nil {
+		return referenceTarget{}, fmt.Errorf("get source class %q: %w", source.Class, err)
+	}
+	if sourceClass == nil {
+		return referenceTarget{}, fmt.Errorf("source class %q not found in schema", source.Class)
+	}
+
+	prop, err := schema.GetPropertyByName(sourceClass, source.Property.String())
+	if err != nil {
+		return referenceTarget{}, err
+	}
+	if !schema.IsRefDataType(prop.DataType) {
+		return referenceTarget{}, fmt.Errorf("property %q of class %q is not a reference property",
+			prop.Name, sourceClass.Class)
+	}
+
+	targetClassName := target.Class
+	if targetClassName == "" {
+		if len(prop.DataType) != 1 {
+			return referenceTarget{}, fmt.Errorf("beacon must contain the class name, "+
+				"property %q can point to multiple classes", prop.Name)
+		}
+		targetClassName = prop.DataType[0]
+	} else if !isReferenceTarget(prop, targetClassName) {
+		return referenceTarget{}, fmt.Errorf("class %q is not a valid target of property %q",
+			targetClassName, prop.Name)
+	}
+
+	targetClass, err := schemaManager.GetClass(ctx, principal, targetClassName)
+	if err != nil {
+		return referenceTarget{}, fmt.Errorf("get target class %q: %w", targetClassName, err)
+	}
+	if targetClass == nil {
+		return referenceTarget{}, fmt.Errorf("target class %q not found in schema", targetClassName)
+	}
+
+	sourceEnabled := schema.MultiTenancyEnabled(sourceClass)
+	targetEnabled := schema.MultiTenancyEnabled(targetClass)
+	if !sourceEnabled && targetEnabled {
+		return referenceTarget{}, fmt.Errorf("invalid reference: cannot reference a multi-tenant " +
+			"enabled class from a non multi-tenant enabled class")
+	}
+	if !targetEnabled {
+		tenant = ""
+	}
+	return referenceTarget{class: targetClass.Class, tenant: tenant}, nil
+}
+
+func isReferenceTarget(prop *models.Property, class string) bool {
+	for _, dt := range prop.DataType {
+		if dt == class {
+			return true
+		}
+	}
+	return false
+}
+
+// validateReferencesExistence marks references whose source or target object
+// does not exist. Objects are fetched with a single multi-get per class and
+// tenant rather than one lookup per reference.
+func (b *BatchManager) validateReferencesExistence(ctx context.Context,
+	refs BatchReferences, targets []referenceTarget,
+) {
+	type lookup struct {
+		class  string
+		tenant string
+	}
+	type member struct {
+		pos int
+		id  strfmt.UUID
+	}
+
+	sources := map[lookup][]member{}
+	targetObjects := map[lookup][]member{}
+	for i, ref := range refs {
+		if ref.Err != nil {
+			continue
+		}
+		key := lookup{class: ref.From.Class.String(), tenant: ref.Tenant}
+		sources[key] = append(sources[key], member{pos: i, id: ref.From.TargetID})
+		key = lookup{class: targets[i].class, tenant: targets[i].tenant}
+		targetObjects[key] = append(targetObjects[key], member{pos: i, id: ref.To.TargetID})
+	}
+
+	check := func(kind string, lookups map[lookup][]member) {
+		for key, members := range lookups {
+			query := make([]multi.Identifier, len(members))
+			for i, m := range members {
+				query[i] = multi.Identifier{ID: m.id.String(), ClassName: key.class}
+			}
+
+			res, err := b.vectorRepo.MultiGet(ctx, query, additional.Properties{}, key.tenant)
+			for i, m := range members {
+				if refs[m.pos].Err != nil {
+					continue
+				}
+				if err != nil {
+					refs[m.pos].Err = fmt.Errorf("%s: get object %s/%s: %w", kind, key.class, m.id, err)
+				} else if res[i].ID == "" {
+					refs[m.pos].Err = fmt.Errorf("%s: object %s/%s not found for tenant %q",
+						kind, key.class, m.id, key.tenant)
+				}
+			}
+		}
+	}
+	check("source", sources)
+	check("target", targetObjects)
+}
+
 func validateReferenceMultiTenancy(ctx context.Context,
 	principal *models.Principal, schemaManager schemaManager,
 	repo VectorRepo, source *crossref.RefSource, target *crossref.Ref,
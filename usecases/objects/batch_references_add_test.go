@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchReferences_ValidationModes(t *testing.T) {
+	const (
+		sourceID  = "8a5ab8bd-62d7-4a65-8bd4-ee3d2a87e5e5"
+		targetID  = "0c8e6d2b-3e3c-4a0e-9a5f-d7a8b95dc1c6"
+		missingID = "f1b7e1c4-1d22-4b2b-8b2c-7a0f3b5b9f11"
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Source",
+					Properties: []*models.Property{
+						{Name: "toTarget", DataType: []string{"Target"}},
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				{
+					Class:             "Target",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+
+	newManager := func() (*BatchManager, *fakeVectorRepo) {
+		vectorRepo := &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager := NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: sch}, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, nil)
+		return manager, vectorRepo
+	}
+	ref := func(from, to string) *models.BatchReference {
+		return &models.BatchReference{
+			From: strfmt.URI("weaviate://localhost/Source/" + sourceID + "/" + from),
+			To:   strfmt.URI("weaviate://localhost/" + to),
+		}
+	}
+	mode := func(m string) *string { return &m }
+	ctx := context.Background()
+
+	t.Run("invalid mode", func(t *testing.T) {
+		manager, _ := newManager()
+		_, err := manager.AddReferences(ctx, nil,
+			[]*models.BatchReference{ref("toTarget", "Target/"+targetID)}, mode("everything"), nil)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	refs := []*models.BatchReference{
+		ref("toTarget", "Target/"+targetID),
+		ref("toTarget", targetID),
+		ref("name", "Target/"+targetID),
+		ref("toTarget", "Source/"+targetID),
+		ref("toTarget", "Target/"+missingID),
+	}
+
+	t.Run("skip validation", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		res, err := manager.AddReferences(ctx, nil, refs, mode(ReferenceValidationSkip), nil)
+		require.Nil(t, err)
+		for _, r := range res {
+			assert.Nil(t, r.Err)
+		}
+	})
+
+	t.Run("validate schema only", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		res, err := manager.AddReferences(ctx, nil, refs, mode(ReferenceValidationSchemaOnly), nil)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err, "target class is resolved from the schema")
+		assert.ErrorContains(t, res[2].Err, "not a reference property")
+		assert.ErrorContains(t, res[3].Err, "not a valid target")
+		assert.Nil(t, res[4].Err, "objects are not looked up")
+		vectorRepo.AssertNotCalled(t, "MultiGet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validate existence", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+		// all sources and all targets are fetched with a single lookup each
+		vectorRepo.On("MultiGet", []multi.Identifier{
+			{ID: sourceID, ClassName: "Source"},
+			{ID: sourceID, ClassName: "Source"},
+			{ID: sourceID, ClassName: "Source"},
+		}, "").Return([]search.Result{{ID: sourceID}, {ID: sourceID}, {ID: sourceID}}, nil).Once()
+		vectorRepo.On("MultiGet", []multi.Identifier{
+			{ID: targetID, ClassName: "Target"},
+			{ID: targetID, ClassName: "Target"},
+			{ID: missingID, ClassName: "Target"},
+		}, "").Return([]search.Result{{ID: targetID}, {ID: targetID}, {}}, nil).Once()
+
+		res, err := manager.AddReferences(ctx, nil, refs, mode(ReferenceValidationExistence), nil)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+		assert.ErrorContains(t, res[2].Err, "not a reference property")
+		assert.ErrorContains(t, res[3].Err, "not a valid target")
+		assert.ErrorContains(t, res[4].Err, "target: object Target/"+missingID+" not found")
+		vectorRepo.AssertExpectations(t)
+	})
+}
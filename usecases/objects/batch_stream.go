@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// WriteStallFn reports whether writes to the local shards are stalled and
+// how long this is expected to last. An empty reason means not stalled.
+type WriteStallFn func() (reason string, retryAfter time.Duration)
+
+// Backpressure is reported to clients of streaming imports after each chunk,
+// so they can adapt their send rate
+type Backpressure struct {
+	// QueueDepth is the number of chunks of all streams which are imported
+	// at the same time
+	QueueDepth int
+	// ShardBusy is the reason why writes to the shards are stalled, it is
+	// empty if they are not
+	ShardBusy string
+	// RetryAfter is the suggested pause before the next chunk is sent
+	RetryAfter time.Duration
+}
+
+// SetWriteStallFn enables reporting of stalled shards to streaming imports
+func (b *BatchManager) SetWriteStallFn(fn WriteStallFn) {
+	b.writeStall = fn
+}
+
+// AddObjectsChunk imports a chunk of a streaming import. It behaves like
+// AddObjects, in addition the backpressure after the chunk is returned.
+func (b *BatchManager) AddObjectsChunk(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, repl *additional.ReplicationProperties,
+) (BatchObjects, Backpressure, error) {
+	b.streamedChunks.Add(1)
+	res, err := b.AddObjects(ctx, principal, objects, nil, repl)
+	queued := b.streamedChunks.Add(-1)
+	if err != nil {
+		return nil, Backpressure{}, err
+	}
+
+	return res, b.backpressure(int(queued)), nil
+}
+
+func (b *BatchManager) backpressure(queueDepth int) Backpressure {
+	out := Backpressure{QueueDepth: queueDepth}
+	if b.writeStall != nil {
+		out.ShardBusy, out.RetryAfter = b.writeStall()
+	}
+	return out
+}
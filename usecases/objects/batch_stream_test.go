@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_AddObjectsChunk(t *testing.T) {
+	newManager := func() *BatchManager {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+				Vectorizer:        config.VectorizerModuleNone,
+				Class:             "Foo",
+				VectorIndexConfig: hnsw.UserConfig{},
+			}}}},
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		return NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+	}
+	chunk := []*models.Object{{Class: "Foo", Vector: []float32{0.1, 0.2}}}
+	ctx := context.Background()
+
+	t.Run("without stalled writes", func(t *testing.T) {
+		manager := newManager()
+
+		res, backpressure, err := manager.AddObjectsChunk(ctx, nil, chunk, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, Backpressure{}, backpressure)
+	})
+
+	t.Run("with stalled writes", func(t *testing.T) {
+		manager := newManager()
+		manager.SetWriteStallFn(func() (string, time.Duration) {
+			return "memtable_flush", 2 * time.Second
+		})
+
+		_, backpressure, err := manager.AddObjectsChunk(ctx, nil, chunk, nil)
+		require.Nil(t, err)
+		assert.Equal(t, Backpressure{ShardBusy: "memtable_flush", RetryAfter: 2 * time.Second},
+			backpressure)
+	})
+
+	t.Run("queue depth counts the chunks in progress", func(t *testing.T) {
+		manager := newManager()
+		manager.streamedChunks.Add(2)
+
+		_, backpressure, err := manager.AddObjectsChunk(ctx, nil, chunk, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, backpressure.QueueDepth)
+	})
+}
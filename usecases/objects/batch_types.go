@@ -34,6 +34,9 @@ type BatchObject struct {
 	Object        *models.Object
 	UUID          strfmt.UUID
 	Vector        []float32
+	// DuplicateOf is the original of an object detected as its duplicate
+	// by an import with deduplication
+	DuplicateOf strfmt.UUID
 }
 
 // BatchObjects groups many Object items together. The order matches the
@@ -91,3 +94,26 @@ type BatchDeleteResponse struct {
 	Params BatchDeleteParams
 	Result BatchDeleteResult
 }
+
+type BatchUpdateParams struct {
+	ClassName schema.ClassName     `json:"className"`
+	Filters   *filters.LocalFilter `json:"filters"`
+	// Update is merged into every matched object, its ID is set per object
+	Update MergeDocument
+	DryRun bool
+	Output string
+}
+
+type BatchUpdateResult struct {
+	Matches int64
+	Limit   int64
+	DryRun  bool
+	Objects BatchSimpleObjects
+}
+
+type BatchUpdateResponse struct {
+	Match  *models.BatchUpdateMatch
+	DryRun bool
+	Output string
+	Result BatchUpdateResult
+}
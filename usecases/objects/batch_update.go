@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// UpdateObjects sets the given properties on, and removes the properties to
+// delete from, all objects matching the filter. The update is carried out on
+// the shards holding the objects, vectors are kept as they are.
+func (b *BatchManager) UpdateObjects(ctx context.Context, principal *models.Principal,
+	update *models.BatchUpdate, repl *additional.ReplicationProperties, tenant string,
+) (res *BatchUpdateResponse, err error) {
+	defer func() {
+		if update != nil && update.DryRun != nil && *update.DryRun {
+			return
+		}
+		event := audit.Event{Action: audit.ActionBatchUpdate, Tenant: tenant}
+		if update != nil && update.Match != nil {
+			event.Class = update.Match.Class
+		}
+		if res != nil {
+			event.Count = int(res.Result.Matches)
+		}
+		b.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	if update == nil || update.DryRun == nil || !*update.DryRun {
+		if err = writable(b.writeGuard); err != nil {
+			return nil, err
+		}
+	}
+	err = b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+	if update != nil && update.Match != nil && update.Match.Class != "" {
+		err = b.authorizer.Authorize(principal, "update",
+			authorization.ClassResource(update.Match.Class, tenant))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchUpdateInc()
+	defer b.metrics.BatchUpdateDec()
+
+	params, err := b.validateBatchUpdate(ctx, principal, update, repl)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
+
+	result, err := b.vectorRepo.BatchUpdateObjects(ctx, *params, repl, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("batch update objects: %w", err)
+	}
+
+	return &BatchUpdateResponse{
+		Match:  update.Match,
+		DryRun: result.DryRun,
+		Output: params.Output,
+		Result: result,
+	}, nil
+}
+
+func (b *BatchManager) validateBatchUpdate(ctx context.Context, principal *models.Principal,
+	update *models.BatchUpdate, repl *additional.ReplicationProperties,
+) (*BatchUpdateParams, error) {
+	if update == nil || update.Match == nil {
+		return nil, errors.New("empty match clause")
+	}
+	match := update.Match
+	if len(match.Class) == 0 {
+		return nil, errors.New("empty match.class clause")
+	}
+	if match.Where == nil {
+		return nil, errors.New("empty match.where clause")
+	}
+
+	class, filter, err := b.matchFilter(principal, match.Class, match.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{}
+	if update.Properties != nil {
+		props, ok := update.Properties.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("properties must be an object, got %T", update.Properties)
+		}
+		properties = props
+	}
+
+	// properties set to null are removed just like in a single object merge
+	toDelete := make([]string, 0, len(update.PropertiesToDelete))
+	for _, name := range update.PropertiesToDelete {
+		toDelete = append(toDelete, schema.LowercaseFirstLetter(name))
+	}
+	for name, value := range properties {
+		if value == nil {
+			toDelete = append(toDelete, schema.LowercaseFirstLetter(name))
+		}
+	}
+	if len(properties) == 0 && len(toDelete) == 0 {
+		return nil, errors.New("empty update: neither properties nor propertiesToDelete are set")
+	}
+
+	for name, value := range properties {
+		if value == nil {
+			continue
+		}
+		prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(name))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := schema.AsPrimitive(prop.DataType); !ok {
+			return nil, fmt.Errorf("property %q: references cannot be updated by filter", name)
+		}
+	}
+	for _, name := range toDelete {
+		if _, err := schema.GetPropertyByName(class, name); err != nil {
+			return nil, err
+		}
+	}
+
+	obj := &models.Object{Class: class.Class, Properties: properties}
+	if err := newValidator(b.vectorRepo.Exists, b.config, repl, b.remoteRefs).
+		Object(ctx, class, obj, nil); err != nil {
+		return nil, err
+	}
+
+	output, err := parseOutput(update.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := false
+	if update.DryRun != nil {
+		dryRun = *update.DryRun
+	}
+
+	return &BatchUpdateParams{
+		ClassName: schema.ClassName(class.Class),
+		Filters:   filter,
+		Update: MergeDocument{
+			Class:              class.Class,
+			PrimitiveSchema:    obj.Properties.(map[string]interface{}),
+			PropertiesToDelete: toDelete,
+			UpdateTime:         unixNow(),
+		},
+		DryRun: dryRun,
+		Output: output,
+	}, nil
+}
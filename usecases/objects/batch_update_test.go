@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchUpdate(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:         "name",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+						},
+						{Name: "count", DataType: schema.DataTypeInt.PropString()},
+						{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+						{Name: "ref", DataType: []string{"Foo"}},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+					Vectorizer:        config.VectorizerModuleNone,
+				},
+			},
+		},
+	}
+	where := &models.WhereFilter{
+		Operator:  "Equal",
+		Path:      []string{"name"},
+		ValueText: ptString("foo"),
+	}
+
+	newManager := func() (*BatchManager, *fakeVectorRepo) {
+		repo := &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager := NewBatchManager(repo, getFakeModulesProvider(), &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: sch}, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, nil)
+		return manager, repo
+	}
+
+	t.Run("with invalid input", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			input       *models.BatchUpdate
+			expectedErr string
+		}{
+			{
+				name:        "without match",
+				input:       &models.BatchUpdate{Properties: map[string]interface{}{"count": json.Number("1")}},
+				expectedErr: "empty match clause",
+			},
+			{
+				name: "without where",
+				input: &models.BatchUpdate{
+					Match:      &models.BatchUpdateMatch{Class: "Foo"},
+					Properties: map[string]interface{}{"count": json.Number("1")},
+				},
+				expectedErr: "empty match.where clause",
+			},
+			{
+				name: "unknown class",
+				input: &models.BatchUpdate{
+					Match:      &models.BatchUpdateMatch{Class: "Bar", Where: where},
+					Properties: map[string]interface{}{"count": json.Number("1")},
+				},
+				expectedErr: "class: Bar doesn't exist",
+			},
+			{
+				name: "without changes",
+				input: &models.BatchUpdate{
+					Match: &models.BatchUpdateMatch{Class: "Foo", Where: where},
+				},
+				expectedErr: "empty update",
+			},
+			{
+				name: "unknown property",
+				input: &models.BatchUpdate{
+					Match:      &models.BatchUpdateMatch{Class: "Foo", Where: where},
+					Properties: map[string]interface{}{"size": 1},
+				},
+				expectedErr: "no such prop",
+			},
+			{
+				name: "unknown property to delete",
+				input: &models.BatchUpdate{
+					Match:              &models.BatchUpdateMatch{Class: "Foo", Where: where},
+					PropertiesToDelete: []string{"size"},
+				},
+				expectedErr: "no such prop",
+			},
+			{
+				name: "reference property",
+				input: &models.BatchUpdate{
+					Match: &models.BatchUpdateMatch{Class: "Foo", Where: where},
+					Properties: map[string]interface{}{"ref": []interface{}{
+						map[string]interface{}{"beacon": "weaviate://localhost/Foo/8e555f0d-8590-48c2-a9a6-70772ed14c0a"},
+					}},
+				},
+				expectedErr: "references cannot be updated by filter",
+			},
+			{
+				name: "invalid value",
+				input: &models.BatchUpdate{
+					Match:      &models.BatchUpdateMatch{Class: "Foo", Where: where},
+					Properties: map[string]interface{}{"count": "many"},
+				},
+				expectedErr: "count",
+			},
+			{
+				name: "invalid output",
+				input: &models.BatchUpdate{
+					Match:      &models.BatchUpdateMatch{Class: "Foo", Where: where},
+					Properties: map[string]interface{}{"count": json.Number("1")},
+					Output:     ptString("all"),
+				},
+				expectedErr: "invalid output",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				manager, _ := newManager()
+				_, err := manager.UpdateObjects(context.Background(), nil, test.input, nil, "")
+				require.NotNil(t, err)
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+
+	t.Run("with valid input", func(t *testing.T) {
+		manager, repo := newManager()
+		result := BatchUpdateResult{
+			Matches: 2,
+			Limit:   10,
+			Objects: BatchSimpleObjects{{UUID: "8e555f0d-8590-48c2-a9a6-70772ed14c0a"}},
+		}
+		var params BatchUpdateParams
+		repo.On("BatchUpdateObjects", mock.Anything).Run(func(args mock.Arguments) {
+			params = args.Get(0).(BatchUpdateParams)
+		}).Return(result, nil)
+
+		res, err := manager.UpdateObjects(context.Background(), nil, &models.BatchUpdate{
+			Match:              &models.BatchUpdateMatch{Class: "Foo", Where: where},
+			Properties:         map[string]interface{}{"count": json.Number("3"), "Name": nil},
+			PropertiesToDelete: []string{"tags"},
+			DryRun:             ptBool(true),
+		}, nil, "")
+		require.Nil(t, err)
+
+		assert.Equal(t, schema.ClassName("Foo"), params.ClassName)
+		assert.Equal(t, filters.OperatorEqual, params.Filters.Root.Operator)
+		assert.True(t, params.DryRun)
+		assert.Equal(t, OutputMinimal, params.Output)
+		assert.Equal(t, "Foo", params.Update.Class)
+		assert.Equal(t, map[string]interface{}{"count": int64(3)}, params.Update.PrimitiveSchema)
+		assert.ElementsMatch(t, []string{"tags", "name"}, params.Update.PropertiesToDelete)
+		assert.NotZero(t, params.Update.UpdateTime)
+		assert.Equal(t, result, res.Result)
+	})
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// BlobStore keeps the bytes of blob properties with external storage, the
+// object itself only holds a reference to the key
+type BlobStore interface {
+	PutBlob(ctx context.Context, key string, data []byte) error
+	OpenBlob(ctx context.Context, key string) (io.ReadCloser, int64, error)
+	DeleteBlobs(ctx context.Context, prefix string) error
+}
+
+// SetBlobStore enables blob properties with external storage
+func (m *Manager) SetBlobStore(s BlobStore) {
+	m.blobs = s
+}
+
+// SetBlobStore enables blob properties with external storage
+func (b *BatchManager) SetBlobStore(s BlobStore) {
+	b.blobs = s
+}
+
+// GetBlob returns a reader on the bytes of a blob property and their size.
+// Inline blobs are decoded, external blobs are streamed from the blob store.
+func (m *Manager) GetBlob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, propName, tenant string,
+) (io.ReadCloser, int64, error) {
+	obj, err := m.GetObject(ctx, principal, className, id, additional.Properties{}, nil, tenant)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, 0, err
+	}
+	if class == nil {
+		return nil, 0, NewErrNotFound("class %q not found", className)
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, 0, NewErrNotFound("%v", err)
+	}
+	if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+		return nil, 0, NewErrInvalidUserInput("property %q is not a blob property", propName)
+	}
+
+	value, ok := objectProps(obj)[prop.Name].(string)
+	if !ok {
+		return nil, 0, NewErrNotFound("object %s has no value for property %q", id, propName)
+	}
+
+	if key, ok := schema.ParseExternalBlobRef(value); ok {
+		if m.blobs == nil {
+			return nil, 0, NewErrInternal("external blob storage is not configured")
+		}
+		r, size, err := m.blobs.OpenBlob(ctx, key)
+		if err != nil {
+			return nil, 0, NewErrInternal("open blob: %v", err)
+		}
+		return r, size, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, 0, NewErrInternal("decode blob: %v", err)
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func hasExternalBlobs(class *models.Class) bool {
+	for _, prop := range class.Properties {
+		if schema.StoresBlobExternally(prop) {
+			return true
+		}
+	}
+	return false
+}
+
+// externalizeBlobs uploads the bytes of all blob properties with external
+// storage and replaces them with a reference. It must run after
+// vectorization, so that vectorizers still see the bytes of new values.
+// Values which already are a reference are kept if they point to the key
+// of this object.
+func externalizeBlobs(ctx context.Context, store BlobStore, class *models.Class,
+	id strfmt.UUID, tenant string, props map[string]interface{},
+) error {
+	if class == nil || props == nil || !hasExternalBlobs(class) {
+		return nil
+	}
+
+	for _, prop := range class.Properties {
+		if !schema.StoresBlobExternally(prop) {
+			continue
+		}
+		value, ok := props[prop.Name].(string)
+		if !ok {
+			continue
+		}
+
+		blobKey := schema.BlobKey(class.Class, tenant, id, prop.Name)
+		if key, ok := schema.ParseExternalBlobRef(value); ok {
+			if key != blobKey {
+				return NewErrInvalidUserInput(
+					"property %q: blob reference does not belong to this object", prop.Name)
+			}
+			continue
+		}
+
+		if store == nil {
+			return NewErrInternal("property %q: external blob storage is not configured", prop.Name)
+		}
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return NewErrInvalidUserInput("property %q: decode blob: %v", prop.Name, err)
+		}
+		if err := store.PutBlob(ctx, blobKey, data); err != nil {
+			return NewErrInternal("property %q: store blob: %v", prop.Name, err)
+		}
+		props[prop.Name] = schema.ExternalBlobRef(blobKey)
+	}
+
+	return nil
+}
+
+// deleteBlobs removes the external blobs of a deleted object. Failures are
+// only logged, the object itself is already gone.
+func (m *Manager) deleteBlobs(ctx context.Context, className string,
+	id strfmt.UUID, tenant string,
+) {
+	if m.blobs == nil {
+		return
+	}
+	s := m.schemaManager.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil || !hasExternalBlobs(class) {
+		return
+	}
+
+	prefix := schema.BlobPrefix(className, tenant, id)
+	if err := m.blobs.DeleteBlobs(ctx, prefix); err != nil {
+		m.logger.WithField("action", "delete_blobs").
+			WithField("prefix", prefix).
+			WithError(err).
+			Warn("could not delete external blobs of deleted object")
+	}
+}
+
+func objectProps(obj *models.Object) map[string]interface{} {
+	props, _ := obj.Properties.(map[string]interface{})
+	return props
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeBlobStore struct {
+	blobs   map[string][]byte
+	deleted []string
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{blobs: map[string][]byte{}}
+}
+
+func (f *fakeBlobStore) PutBlob(ctx context.Context, key string, data []byte) error {
+	f.blobs[key] = data
+	return nil
+}
+
+func (f *fakeBlobStore) OpenBlob(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	data, ok := f.blobs[key]
+	if !ok {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (f *fakeBlobStore) DeleteBlobs(ctx context.Context, prefix string) error {
+	f.deleted = append(f.deleted, prefix)
+	for key := range f.blobs {
+		if strings.HasPrefix(key, prefix) {
+			delete(f.blobs, key)
+		}
+	}
+	return nil
+}
+
+func Test_ExternalizeBlobs(t *testing.T) {
+	id := strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")
+	class := &models.Class{
+		Class: "Image",
+		Properties: []*models.Property{
+			{Name: "raw", DataType: []string{"blob"}, BlobStorage: models.PropertyBlobStorageExternal},
+			{Name: "thumb", DataType: []string{"blob"}},
+		},
+	}
+	data := []byte("some image bytes")
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	t.Run("external blobs are uploaded and replaced with a reference", func(t *testing.T) {
+		store := newFakeBlobStore()
+		props := map[string]interface{}{"raw": encoded, "thumb": encoded}
+
+		err := externalizeBlobs(context.Background(), store, class, id, "", props)
+		require.Nil(t, err)
+		assert.Equal(t, "external:Image/"+id.String()+"/raw", props["raw"])
+		assert.Equal(t, encoded, props["thumb"])
+		assert.Equal(t, data, store.blobs["Image/"+id.String()+"/raw"])
+	})
+
+	t.Run("tenant is part of the key", func(t *testing.T) {
+		store := newFakeBlobStore()
+		props := map[string]interface{}{"raw": encoded}
+
+		err := externalizeBlobs(context.Background(), store, class, id, "t1", props)
+		require.Nil(t, err)
+		assert.Equal(t, "external:Image/tenants/t1/"+id.String()+"/raw", props["raw"])
+	})
+
+	t.Run("reference of the same object is kept", func(t *testing.T) {
+		store := newFakeBlobStore()
+		ref := "external:Image/" + id.String() + "/raw"
+		props := map[string]interface{}{"raw": ref}
+
+		err := externalizeBlobs(context.Background(), store, class, id, "", props)
+		require.Nil(t, err)
+		assert.Equal(t, ref, props["raw"])
+		assert.Empty(t, store.blobs)
+	})
+
+	t.Run("reference of another object is rejected", func(t *testing.T) {
+		props := map[string]interface{}{"raw": "external:Image/other/raw"}
+
+		err := externalizeBlobs(context.Background(), newFakeBlobStore(), class, id, "", props)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("without a blob store", func(t *testing.T) {
+		props := map[string]interface{}{"raw": encoded}
+
+		err := externalizeBlobs(context.Background(), nil, class, id, "", props)
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInternal{}, err)
+	})
+}
+
+func Test_GetBlob(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		store      *fakeBlobStore
+		manager    *Manager
+
+		id   = strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")
+		data = []byte("some image bytes")
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		store = newFakeBlobStore()
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{{
+				Class: "Image",
+				Properties: []*models.Property{
+					{Name: "raw", DataType: []string{"blob"}, BlobStorage: models.PropertyBlobStorageExternal},
+					{Name: "thumb", DataType: []string{"blob"}},
+					{Name: "title", DataType: []string{"text"}},
+				},
+			}}},
+		}}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger,
+			&fakeAuthorizer{}, vectorRepo, nil, &fakeMetrics{})
+		manager.SetBlobStore(store)
+	}
+
+	readAll := func(t *testing.T, r io.ReadCloser) []byte {
+		defer r.Close()
+		out, err := io.ReadAll(r)
+		require.Nil(t, err)
+		return out
+	}
+
+	t.Run("external blob is streamed from the store", func(t *testing.T) {
+		reset()
+		store.blobs["Image/"+id.String()+"/raw"] = data
+		vectorRepo.On("Object", "Image", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ID: id, ClassName: "Image",
+			Schema: map[string]interface{}{"raw": "external:Image/" + id.String() + "/raw"},
+		}, nil)
+
+		r, size, err := manager.GetBlob(context.Background(), nil, "Image", id, "raw", "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(data)), size)
+		assert.Equal(t, data, readAll(t, r))
+	})
+
+	t.Run("inline blob is decoded", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Image", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ID: id, ClassName: "Image",
+			Schema: map[string]interface{}{"thumb": base64.StdEncoding.EncodeToString(data)},
+		}, nil)
+
+		r, size, err := manager.GetBlob(context.Background(), nil, "Image", id, "thumb", "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(data)), size)
+		assert.Equal(t, data, readAll(t, r))
+	})
+
+	t.Run("property without a value", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Image", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ID: id, ClassName: "Image", Schema: map[string]interface{}{},
+		}, nil)
+
+		_, _, err := manager.GetBlob(context.Background(), nil, "Image", id, "thumb", "")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("property which is not a blob", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Image", id, mock.Anything, mock.Anything).Return(&search.Result{
+			ID: id, ClassName: "Image", Schema: map[string]interface{}{"title": "a"},
+		}, nil)
+
+		_, _, err := manager.GetBlob(context.Background(), nil, "Image", id, "title", "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
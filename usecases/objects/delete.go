@@ -13,11 +13,13 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 )
 
 // DeleteObject Class Instance from the conncected DB
@@ -27,15 +29,30 @@ import (
 func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
-) error {
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, audit.Event{
+			Action:   audit.ActionObjectDelete,
+			Class:    class,
+			Tenant:   tenant,
+			Resource: id.String(),
+		}, err)
+	}()
+
+	if err = writable(m.writeGuard); err != nil {
+		return err
+	}
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
 		path = fmt.Sprintf("objects/%s", id)
 	}
-	err := m.authorizer.Authorize(principal, "delete", path)
+	err = m.authorizer.Authorize(principal, "delete", path)
 	if err != nil {
 		return err
 	}
+	if err := m.authorizeClass(principal, "delete", class, tenant); err != nil {
+		return err
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -47,9 +64,13 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	defer m.metrics.DeleteObjectDec()
 
 	if class == "" { // deprecated
+		if _, ok := ExpectedVersion(ctx); ok {
+			return NewErrInvalidUserInput("conditional delete requires a class name")
+		}
 		return m.deleteObjectFromRepo(ctx, id)
 	}
 
+	ctx = withPrecondition(ctx, id)
 	ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant)
 	if err != nil {
 		switch err.(type) {
@@ -63,10 +84,29 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		return NewErrNotFound("object %v could not be found", path)
 	}
 
+	actions := newReferentialActions(m.vectorRepo, m.authorizer, principal,
+		m.schemaManager.GetSchemaSkipAuth(), m.timeSource.Now, repl, tenant)
+	if actions != nil {
+		if err := actions.check(ctx, class, id); err != nil {
+			return err
+		}
+	}
+
 	err = m.vectorRepo.DeleteObject(ctx, class, id, repl, tenant)
 	if err != nil {
+		var conflict ErrConflict
+		if errors.As(err, &conflict) {
+			return conflict
+		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
+	m.deleteBlobs(ctx, class, id, tenant)
+
+	if actions != nil {
+		if err := actions.apply(ctx, class, id); err != nil {
+			return NewErrInternal("apply onDelete rules: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// idNamespace is the UUIDv5 namespace of ids derived from properties
+var idNamespace = uuid.MustParse("3c9f6e0a-8d57-4b1e-a2f4-6e1b7d9c0a52")
+
+type idPropertiesKey struct{}
+
+// WithIDProperties makes objects without an id created with ctx get an id
+// derived from the given properties, instead of the idProperties of their
+// class
+func WithIDProperties(ctx context.Context, props []string) context.Context {
+	return context.WithValue(ctx, idPropertiesKey{}, props)
+}
+
+// deriveObjectID returns the id of an object derived from its properties, or
+// an empty id if neither the request nor the class name any id properties
+func deriveObjectID(ctx context.Context, principal *models.Principal,
+	schemaManager schemaManager, object *models.Object,
+) (strfmt.UUID, error) {
+	className := schema.UppercaseClassName(object.Class)
+	props, ok := ctx.Value(idPropertiesKey{}).([]string)
+	if !ok {
+		class, err := schemaManager.GetClass(ctx, principal, className)
+		if err != nil {
+			return "", err
+		}
+		if class != nil {
+			props = class.IDProperties
+		}
+	}
+	if len(props) == 0 {
+		return "", nil
+	}
+
+	return deriveID(className, props, object.Properties)
+}
+
+// deriveID is the UUIDv5 of the class name and the JSON encoded values of
+// props, so that re-importing an object yields the same id
+func deriveID(className string, props []string, properties interface{}) (strfmt.UUID, error) {
+	values, _ := properties.(map[string]interface{})
+	name := make([]interface{}, 0, len(props)+1)
+	name = append(name, className)
+	for _, prop := range props {
+		value, ok := values[prop]
+		if !ok || value == nil {
+			return "", fmt.Errorf("property %q is needed to derive the id, but not set", prop)
+		}
+		name = append(name, value)
+	}
+
+	raw, err := json.Marshal(name)
+	if err != nil {
+		return "", fmt.Errorf("encode id properties: %w", err)
+	}
+	return strfmt.UUID(uuid.NewSHA1(idNamespace, raw).String()), nil
+}
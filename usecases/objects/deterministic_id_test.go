@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_DeriveID(t *testing.T) {
+	props := map[string]interface{}{"title": "Dune", "year": float64(1965), "rating": 4.5}
+
+	id, err := deriveID("Book", []string{"title", "year"}, props)
+	require.Nil(t, err)
+	assert.Len(t, id, 36)
+
+	t.Run("is deterministic", func(t *testing.T) {
+		again, err := deriveID("Book", []string{"title", "year"},
+			map[string]interface{}{"year": float64(1965), "title": "Dune"})
+		require.Nil(t, err)
+		assert.Equal(t, id, again)
+	})
+
+	t.Run("depends on class and values", func(t *testing.T) {
+		other, err := deriveID("Movie", []string{"title", "year"}, props)
+		require.Nil(t, err)
+		assert.NotEqual(t, id, other)
+
+		other, err = deriveID("Book", []string{"title", "year"},
+			map[string]interface{}{"title": "Dune", "year": float64(1984)})
+		require.Nil(t, err)
+		assert.NotEqual(t, id, other)
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		_, err := deriveID("Book", []string{"title", "author"}, props)
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_AddObject_DerivedID(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Book",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					IDProperties:      []string{"title"},
+					Properties: []*models.Property{
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+						{Name: "isbn", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+	newBook := func() *models.Object {
+		return &models.Object{
+			Class:      "Book",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Properties: map[string]interface{}{"title": "Dune", "isbn": "978-0441013593"},
+		}
+	}
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+
+	t.Run("single object from class config", func(t *testing.T) {
+		repo := &fakeVectorRepo{}
+		repo.On("Exists", "Book", mock.Anything).Return(false, nil)
+		repo.On("PutObject", mock.Anything, mock.Anything).Return(nil)
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger,
+			&fakeAuthorizer{}, repo, modulesProvider, &fakeMetrics{})
+
+		expected, err := deriveID("Book", []string{"title"}, newBook().Properties)
+		require.Nil(t, err)
+
+		res, err := manager.AddObject(context.Background(), nil, newBook(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res.ID)
+
+		ctx := WithIDProperties(context.Background(), []string{"title", "isbn"})
+		res, err = manager.AddObject(ctx, nil, newBook(), nil)
+		require.Nil(t, err)
+		assert.NotEqual(t, expected, res.ID, "request overrides class config")
+	})
+
+	t.Run("batch re-import", func(t *testing.T) {
+		repo := &fakeVectorRepo{}
+		repo.On("BatchPutObjects", mock.Anything).Return(nil)
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewBatchManager(repo, modulesProvider, &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+
+		first, err := manager.AddObjects(context.Background(), nil,
+			[]*models.Object{newBook()}, nil, nil)
+		require.Nil(t, err)
+		second, err := manager.AddObjects(context.Background(), nil,
+			[]*models.Object{newBook()}, nil, nil)
+		require.Nil(t, err)
+
+		require.Nil(t, first[0].Err)
+		assert.Equal(t, first[0].UUID, second[0].UUID)
+
+		book := newBook()
+		delete(book.Properties.(map[string]interface{}), "title")
+		res, err := manager.AddObjects(context.Background(), nil, []*models.Object{book}, nil, nil)
+		require.Nil(t, err)
+		assert.NotNil(t, res[0].Err)
+	})
+}
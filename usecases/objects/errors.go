@@ -20,8 +20,10 @@ const (
 	StatusForbidden           = 403
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
+	StatusConflict            = 409
 	StatusUnprocessableEntity = 422
 	StatusInternalServerError = 500
+	StatusServiceUnavailable  = 503
 )
 
 type Error struct {
@@ -56,6 +58,10 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) Conflict() bool {
+	return e.Code == StatusConflict
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -98,6 +104,21 @@ func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
 
+// ErrConflict indicates that the object was changed since the version the
+// client based its write on
+type ErrConflict struct {
+	msg string
+}
+
+func (e ErrConflict) Error() string {
+	return e.msg
+}
+
+// NewErrConflict with Errorf signature
+func NewErrConflict(format string, args ...interface{}) ErrConflict {
+	return ErrConflict{msg: fmt.Sprintf(format, args...)}
+}
+
 type ErrMultiTenancy struct {
 	err error
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -66,9 +67,14 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, f.GetschemaErr
 }
 
-func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) { return "", nil }
-func (f *fakeSchemaManager) TenantShard(class, tenant string) string        { return tenant }
-func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string { return "" }
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.GetSchemaResponse
+}
+
+func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error)       { return "", nil }
+func (f *fakeSchemaManager) TenantShard(class, tenant string) string              { return tenant }
+func (f *fakeSchemaManager) TenantQuota(class, tenant string) *models.TenantQuota { return nil }
+func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string       { return "" }
 
 func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
 	name string,
@@ -204,6 +210,16 @@ func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchRefe
 	return batch, args.Error(0)
 }
 
+func (f *fakeVectorRepo) MultiGet(ctx context.Context, query []multi.Identifier,
+	additional additional.Properties, tenant string,
+) ([]search.Result, error) {
+	args := f.Called(query, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).([]search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
 	repl *additional.ReplicationProperties, tenant string,
 ) (BatchDeleteResult, error) {
@@ -211,6 +227,13 @@ func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDel
 	return args.Get(0).(BatchDeleteResult), args.Error(1)
 }
 
+func (f *fakeVectorRepo) BatchUpdateObjects(ctx context.Context, params BatchUpdateParams,
+	repl *additional.ReplicationProperties, tenant string,
+) (BatchUpdateResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(BatchUpdateResult), args.Error(1)
+}
+
 func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error {
 	args := f.Called(merge)
 	return args.Error(0)
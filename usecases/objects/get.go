@@ -38,6 +38,12 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	// without a class the class of the object is checked once it is found
+	if class != "" {
+		if err := m.authorizeClass(principal, "get", class, tenant); err != nil {
+			return nil, err
+		}
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -48,10 +54,18 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
+	// the vector is only decoded if it is returned or a module might need it
+	additional.SkipVector = !additional.Vector && len(additional.ModuleParams) == 0
+
 	res, err := m.getObjectFromRepo(ctx, class, id, additional, replProps, tenant)
 	if err != nil {
 		return nil, err
 	}
+	if class == "" {
+		if err := m.authorizeClass(principal, "get", res.ClassName, tenant); err != nil {
+			return nil, err
+		}
+	}
 
 	if additional.Vector {
 		m.trackUsageSingle(res)
@@ -69,6 +83,9 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.authorizeClass(principal, "list", "", tenant); err != nil {
+		return nil, err
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -101,6 +118,9 @@ func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Princip
 	if err != nil {
 		return nil, err
 	}
+	if err := m.authorizeClass(principal, "get", res.ClassName, ""); err != nil {
+		return nil, err
+	}
 
 	s, err := m.schemaManager.GetSchema(principal)
 	if err != nil {
@@ -31,6 +31,9 @@ func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, c
 	if err := m.authorizer.Authorize(principal, "head", path); err != nil {
 		return false, &Error{path, StatusForbidden, err}
 	}
+	if err := m.authorizeClass(principal, "head", class, tenant); err != nil {
+		return false, &Error{path, StatusForbidden, err}
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -28,9 +28,13 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 // Manager manages kind changes at a use-case level, i.e. agnostic of
@@ -46,6 +50,28 @@ type Manager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
+	remoteRefs        RemoteRefResolver
+	auditLog          *audit.Logger
+	blobs             BlobStore
+	writeGuard        WriteGuard
+}
+
+// WriteGuard rejects user writes while objects may only be changed by the
+// system, e.g. on a cross-cluster replication standby
+type WriteGuard interface {
+	Writable() error
+}
+
+func writable(g WriteGuard) error {
+	if g == nil {
+		return nil
+	}
+	return g.Writable()
+}
+
+// RemoteRefResolver checks the existence of objects on federation peers
+type RemoteRefResolver interface {
+	Exists(ctx context.Context, ref *crossref.Ref) (bool, error)
 }
 
 type objectsMetrics interface {
@@ -89,6 +115,16 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// authorizeClass narrows down a check on the objects paths to the class and
+// tenant which are actually touched. Class is empty on deprecated endpoints,
+// which touch objects of any class.
+func (m *Manager) authorizeClass(principal *models.Principal,
+	verb, class, tenant string,
+) error {
+	return m.authorizer.Authorize(principal, verb,
+		authorization.ClassResource(class, tenant))
+}
+
 type VectorRepo interface {
 	PutObject(ctx context.Context, concept *models.Object, vector []float32,
 		repl *additional.ReplicationProperties) error
@@ -109,6 +145,8 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	MultiGet(ctx context.Context, query []multi.Identifier,
+		additional additional.Properties, tenant string) ([]search.Result, error)
 }
 
 type ModulesProvider interface {
@@ -143,6 +181,46 @@ func NewManager(locks locks, schemaManager schemaManager,
 	}
 }
 
+// SetRemoteRefResolver enables references to objects on federation peers
+func (m *Manager) SetRemoteRefResolver(r RemoteRefResolver) {
+	m.remoteRefs = r
+}
+
+// SetAuditLogger records all creations, updates and deletions of objects
+func (m *Manager) SetAuditLogger(l *audit.Logger) {
+	m.auditLog = l
+}
+
+// SetWriteGuard rejects all object writes the guard does not allow
+func (m *Manager) SetWriteGuard(g WriteGuard) {
+	m.writeGuard = g
+}
+
+// objectEvent describes the mutation of a single object, obj may be nil
+func objectEvent(action string, obj *models.Object) audit.Event {
+	if obj == nil {
+		return audit.Event{Action: action}
+	}
+	return audit.Event{
+		Action:   action,
+		Class:    obj.Class,
+		Tenant:   obj.Tenant,
+		Resource: obj.ID.String(),
+	}
+}
+
+func newValidator(exists func(context.Context, string, strfmt.UUID,
+	*additional.ReplicationProperties, string) (bool, error),
+	config *config.WeaviateConfig, repl *additional.ReplicationProperties,
+	remoteRefs RemoteRefResolver,
+) *validation.Validator {
+	v := validation.New(exists, config, repl)
+	if remoteRefs != nil {
+		v.WithRemoteRefs(remoteRefs.Exists)
+	}
+	return v
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -38,7 +40,18 @@ type MergeDocument struct {
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	updates *models.Object, repl *additional.ReplicationProperties,
-) *Error {
+) (mergeErr *Error) {
+	defer func() {
+		var err error
+		if mergeErr != nil {
+			err = mergeErr
+		}
+		m.auditLog.Record(ctx, principal, objectEvent(audit.ActionObjectUpdate, updates), err)
+	}()
+
+	if err := writable(m.writeGuard); err != nil {
+		return &Error{"read-only", StatusServiceUnavailable, err}
+	}
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
@@ -47,10 +60,14 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.authorizeClass(principal, "update", cls, updates.Tenant); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
 
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
 
+	ctx = withPrecondition(ctx, id)
 	obj, err := m.vectorRepo.Object(ctx, cls, id, nil, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		switch err.(type) {
@@ -63,6 +80,9 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
+	if err := checkVersion(ctx, id, obj.Updated); err != nil {
+		return &Error{"version", StatusConflict, err}
+	}
 
 	var propertiesToDelete []string
 	if updates.Properties != nil {
@@ -95,15 +115,28 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
 		primitive, principal, obj.Vector, updates.Vector)
 	if err != nil {
+		if errors.As(err, &ErrInvalidUserInput{}) {
+			return &Error{"bad request", StatusBadRequest, err}
+		}
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
+	class, err := m.schemaManager.GetClass(ctx, principal, cls)
+	if err != nil {
+		return &Error{"get class", StatusInternalServerError, err}
+	}
+	if err := externalizeBlobs(ctx, m.blobs, class, id, tenant, primitive); err != nil {
+		if errors.As(err, &ErrInvalidUserInput{}) {
+			return &Error{"bad request", StatusBadRequest, err}
+		}
+		return &Error{"store blobs", StatusInternalServerError, err}
+	}
 	mergeDoc := MergeDocument{
 		Class:              cls,
 		ID:                 id,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
-		UpdateTime:         m.timeSource.Now(),
+		UpdateTime:         nextVersion(ctx, m.timeSource.Now(), obj.Updated),
 		PropertiesToDelete: propertiesToDelete,
 	}
 
@@ -112,6 +145,9 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl, tenant); err != nil {
+		if errors.As(err, &ErrConflict{}) {
+			return &Error{"version", StatusConflict, err}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
@@ -178,6 +214,9 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
 		return nil, err
 	}
+	if err := fitVectorDimensions(class, obj); err != nil {
+		return nil, err
+	}
 
 	return obj, nil
 }
@@ -21,6 +21,7 @@ import (
 type Metrics struct {
 	queriesCount       *prometheus.GaugeVec
 	batchTime          *prometheus.HistogramVec
+	batchDuplicates    *prometheus.CounterVec
 	dimensions         *prometheus.CounterVec
 	dimensionsCombined prometheus.Counter
 	groupClasses       bool
@@ -34,6 +35,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 	return &Metrics{
 		queriesCount:       prom.QueriesCount,
 		batchTime:          prom.BatchTime,
+		batchDuplicates:    prom.BatchDuplicates,
 		dimensions:         prom.QueryDimensions,
 		dimensionsCombined: prom.QueryDimensionsCombined,
 		groupClasses:       prom.Group,
@@ -86,6 +88,14 @@ func (m *Metrics) BatchDeleteDec() {
 	m.queriesDec("batch_delete")
 }
 
+func (m *Metrics) BatchUpdateInc() {
+	m.queriesInc("batch_update")
+}
+
+func (m *Metrics) BatchUpdateDec() {
+	m.queriesDec("batch_update")
+}
+
 func (m *Metrics) AddObjectInc() {
 	m.queriesInc("add_object")
 }
@@ -172,6 +182,23 @@ func (m *Metrics) BatchOp(op string, startNs int64) {
 	}).Observe(float64(took))
 }
 
+// BatchDuplicate counts a duplicate detected by a batch import, action is
+// the deduplication mode
+func (m *Metrics) BatchDuplicate(className, action string) {
+	if m == nil {
+		return
+	}
+
+	if m.groupClasses {
+		className = "n/a"
+	}
+
+	m.batchDuplicates.With(prometheus.Labels{
+		"class_name": className,
+		"action":     action,
+	}).Inc()
+}
+
 func (m *Metrics) AddUsageDimensions(className, queryType, operation string, dims int) {
 	if m == nil {
 		return
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// MaxMultiGetObjects limits the number of objects of a single multi-get
+const MaxMultiGetObjects = 1000
+
+// MultiGetItem identifies an object of a multi-get. Without a class all
+// classes are checked for the ID.
+type MultiGetItem struct {
+	Class string      `json:"class,omitempty"`
+	ID    strfmt.UUID `json:"id"`
+}
+
+// MultiGetObjects returns the objects in the order of the items, objects
+// which don't exist are nil. Properties limits the returned properties,
+// nil returns all of them.
+func (m *Manager) MultiGetObjects(ctx context.Context, principal *models.Principal,
+	items []MultiGetItem, properties []string, addl additional.Properties,
+	tenant string,
+) ([]*models.Object, error) {
+	if len(items) > MaxMultiGetObjects {
+		return nil, NewErrInvalidUserInput("at most %d objects can be fetched at once, got %d",
+			MaxMultiGetObjects, len(items))
+	}
+	if err := m.authorizer.Authorize(principal, "get", "objects"); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	// items without a class are looked up in every class, pos maps each
+	// query back to its item. Those classes are authorized once an object
+	// is found in them.
+	var classes []string
+	authorized := map[string]error{}
+	query := make([]multi.Identifier, 0, len(items))
+	pos := make([]int, 0, len(items))
+	for i, item := range items {
+		if item.ID == "" {
+			return nil, NewErrInvalidUserInput("object %d: id is required", i)
+		}
+		if item.Class != "" {
+			if _, ok := authorized[item.Class]; !ok {
+				if err := m.authorizeClass(principal, "get", item.Class, tenant); err != nil {
+					return nil, err
+				}
+				authorized[item.Class] = nil
+			}
+			query = append(query, multi.Identifier{ID: item.ID.String(), ClassName: item.Class})
+			pos = append(pos, i)
+			continue
+		}
+		if classes == nil {
+			classes = m.allClassNames()
+		}
+		for _, class := range classes {
+			query = append(query, multi.Identifier{ID: item.ID.String(), ClassName: class})
+			pos = append(pos, i)
+		}
+	}
+
+	addl.SkipVector = !addl.Vector
+	res, err := m.vectorRepo.MultiGet(ctx, query, addl, tenant)
+	if err != nil {
+		switch err.(type) {
+		case ErrMultiTenancy:
+			return nil, NewErrMultiTenancy(fmt.Errorf("repo: multi get: %w", err))
+		default:
+			return nil, NewErrInternal("repo: multi get: %v", err)
+		}
+	}
+
+	found := make(search.Results, 0, len(items))
+	out := make([]*models.Object, len(items))
+	for i, r := range res {
+		if r.ID == "" || out[pos[i]] != nil {
+			continue
+		}
+		authErr, ok := authorized[r.ClassName]
+		if !ok {
+			authErr = m.authorizeClass(principal, "get", r.ClassName, tenant)
+			authorized[r.ClassName] = authErr
+		}
+		if authErr != nil {
+			return nil, authErr
+		}
+
+		found = append(found, r)
+		out[pos[i]] = projectProperties(r.ObjectWithVector(addl.Vector), properties)
+	}
+
+	if addl.Vector {
+		m.trackUsageList(found)
+	}
+
+	return out, nil
+}
+
+func (m *Manager) allClassNames() []string {
+	sch := m.schemaManager.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return []string{}
+	}
+	names := make([]string, len(sch.Objects.Classes))
+	for i, class := range sch.Objects.Classes {
+		names[i] = class.Class
+	}
+	return names
+}
+
+func projectProperties(obj *models.Object, properties []string) *models.Object {
+	if properties == nil {
+		return obj
+	}
+	props, _ := obj.Properties.(map[string]interface{})
+	projected := make(map[string]interface{}, len(properties))
+	for _, name := range properties {
+		if value, ok := props[name]; ok {
+			projected[name] = value
+		}
+	}
+	obj.Properties = projected
+	return obj
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_MultiGetObjects(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		authorizer *fakeAuthorizer
+		manager    *Manager
+
+		id1 = strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")
+		id2 = strfmt.UUID("4d2ae3b8-2a4a-4f4a-b5b6-0a1c7fd9e2a1")
+		id3 = strfmt.UUID("6b8a1f7c-2d1e-4e3a-9f0b-1c2d3e4f5a6b")
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		authorizer = &fakeAuthorizer{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{{Class: "Article"}, {Class: "Author"}}},
+		}}
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger,
+			authorizer, vectorRepo, nil, metrics)
+	}
+
+	article := search.Result{
+		ID: id1, ClassName: "Article", Vector: []float32{1, 2},
+		Schema: map[string]interface{}{"title": "a", "body": "b"},
+	}
+	author := search.Result{
+		ID: id2, ClassName: "Author",
+		Schema: map[string]interface{}{"name": "c"},
+	}
+
+	t.Run("objects in the order of the request", func(t *testing.T) {
+		reset()
+		vectorRepo.On("MultiGet", []multi.Identifier{
+			{ID: id1.String(), ClassName: "Article"},
+			{ID: id3.String(), ClassName: "Article"},
+			{ID: id2.String(), ClassName: "Author"},
+		}, "").Return([]search.Result{article, {}, author}, nil)
+
+		res, err := manager.MultiGetObjects(context.Background(), nil, []MultiGetItem{
+			{Class: "Article", ID: id1},
+			{Class: "Article", ID: id3},
+			{Class: "Author", ID: id2},
+		}, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, id1, res[0].ID)
+		assert.Nil(t, res[0].Vector)
+		assert.Nil(t, res[1])
+		assert.Equal(t, map[string]interface{}{"name": "c"}, res[2].Properties)
+	})
+
+	t.Run("items without class", func(t *testing.T) {
+		reset()
+		vectorRepo.On("MultiGet", []multi.Identifier{
+			{ID: id2.String(), ClassName: "Article"},
+			{ID: id2.String(), ClassName: "Author"},
+		}, "").Return([]search.Result{{}, author}, nil)
+
+		res, err := manager.MultiGetObjects(context.Background(), nil,
+			[]MultiGetItem{{ID: id2}}, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "Author", res[0].Class)
+	})
+
+	t.Run("projection", func(t *testing.T) {
+		reset()
+		vectorRepo.On("MultiGet", mock.Anything, "").
+			Return([]search.Result{article}, nil)
+
+		res, err := manager.MultiGetObjects(context.Background(), nil,
+			[]MultiGetItem{{Class: "Article", ID: id1}}, []string{"title", "missing"},
+			additional.Properties{Vector: true}, "")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"title": "a"}, res[0].Properties)
+		assert.Equal(t, models.C11yVector{1, 2}, res[0].Vector)
+	})
+
+	t.Run("too many objects", func(t *testing.T) {
+		reset()
+		items := make([]MultiGetItem, MaxMultiGetObjects+1)
+		_, err := manager.MultiGetObjects(context.Background(), nil, items,
+			nil, additional.Properties{}, "")
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		reset()
+		authorizer.Err = assert.AnError
+		_, err := manager.MultiGetObjects(context.Background(), nil,
+			[]MultiGetItem{{Class: "Article", ID: id1}}, nil, additional.Properties{}, "")
+		assert.Equal(t, assert.AnError, err)
+		vectorRepo.AssertNotCalled(t, "MultiGet", mock.Anything, mock.Anything)
+	})
+}
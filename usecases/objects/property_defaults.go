@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// applyPropertyDefaults sets the defaultValue or computedValue of every
+// property of class that is absent from object. Computed values are
+// evaluated after all defaults are applied, so they can refer to defaulted
+// properties. A concat() referring to an absent property leaves the
+// property unset.
+func applyPropertyDefaults(class *models.Class, object *models.Object, now time.Time) error {
+	if class == nil {
+		return nil
+	}
+
+	var props map[string]interface{}
+	ensureProps := func() map[string]interface{} {
+		if props != nil {
+			return props
+		}
+		props, _ = object.Properties.(map[string]interface{})
+		if props == nil {
+			props = map[string]interface{}{}
+			object.Properties = props
+		}
+		return props
+	}
+	isSet := func(name string) bool {
+		values, _ := object.Properties.(map[string]interface{})
+		return values[name] != nil
+	}
+
+	for _, prop := range class.Properties {
+		if prop.DefaultValue == nil || isSet(prop.Name) {
+			continue
+		}
+		ensureProps()[prop.Name] = copyDefaultValue(prop.DefaultValue)
+	}
+
+	for _, prop := range class.Properties {
+		if prop.ComputedValue == "" || isSet(prop.Name) {
+			continue
+		}
+		computed, err := schema.ParseComputedValue(prop.ComputedValue)
+		if err != nil {
+			return fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+
+		switch computed.Func {
+		case schema.ComputedValueNow:
+			ensureProps()[prop.Name] = now.UTC().Format(time.RFC3339Nano)
+		case schema.ComputedValueConcat:
+			value, ok := concatValues(computed.Args, object.Properties)
+			if ok {
+				ensureProps()[prop.Name] = value
+			}
+		}
+	}
+
+	return nil
+}
+
+func concatValues(args []schema.ComputedValueArg, properties interface{}) (string, bool) {
+	values, _ := properties.(map[string]interface{})
+
+	var sb strings.Builder
+	for _, arg := range args {
+		if arg.IsLiteral() {
+			sb.WriteString(arg.Literal)
+			continue
+		}
+		value := values[arg.Property]
+		if value == nil {
+			return "", false
+		}
+		sb.WriteString(formatConcatValue(value))
+	}
+	return sb.String(), true
+}
+
+func formatConcatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i := range v {
+			elems[i] = formatConcatValue(v[i])
+		}
+		return strings.Join(elems, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// copyDefaultValue makes sure objects don't share the array of a default
+// value with the schema, so that modifying an object can't alter the schema
+func copyDefaultValue(value interface{}) interface{} {
+	values, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+	out := make([]interface{}, len(values))
+	copy(out, values)
+	return out
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ApplyPropertyDefaults(t *testing.T) {
+	class := &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{Name: "firstName", DataType: schema.DataTypeText.PropString()},
+			{Name: "lastName", DataType: schema.DataTypeText.PropString(), DefaultValue: "Doe"},
+			{Name: "age", DataType: schema.DataTypeInt.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), DefaultValue: []interface{}{"new"}},
+			{
+				Name: "fullName", DataType: schema.DataTypeText.PropString(),
+				ComputedValue: `concat(firstName, " ", lastName, " (", age, ")")`,
+			},
+			{Name: "createdAt", DataType: schema.DataTypeDate.PropString(), ComputedValue: "now()"},
+		},
+	}
+	now := time.Date(2023, 5, 4, 3, 2, 1, 0, time.UTC)
+
+	t.Run("absent properties", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "Person",
+			Properties: map[string]interface{}{"firstName": "Jane", "age": float64(42)},
+		}
+		require.Nil(t, applyPropertyDefaults(class, obj, now))
+
+		assert.Equal(t, map[string]interface{}{
+			"firstName": "Jane",
+			"lastName":  "Doe",
+			"age":       float64(42),
+			"tags":      []interface{}{"new"},
+			"fullName":  "Jane Doe (42)",
+			"createdAt": "2023-05-04T03:02:01Z",
+		}, obj.Properties)
+	})
+
+	t.Run("present properties are kept", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"firstName": "Jane", "lastName": "Roe", "age": float64(42),
+				"tags": []interface{}{}, "fullName": "J. Roe", "createdAt": "2020-01-01T00:00:00Z",
+			},
+		}
+		require.Nil(t, applyPropertyDefaults(class, obj, now))
+
+		props := obj.Properties.(map[string]interface{})
+		assert.Equal(t, "Roe", props["lastName"])
+		assert.Equal(t, []interface{}{}, props["tags"])
+		assert.Equal(t, "J. Roe", props["fullName"])
+		assert.Equal(t, "2020-01-01T00:00:00Z", props["createdAt"])
+	})
+
+	t.Run("concat of absent property", func(t *testing.T) {
+		obj := &models.Object{Class: "Person"}
+		require.Nil(t, applyPropertyDefaults(class, obj, now))
+
+		props := obj.Properties.(map[string]interface{})
+		assert.NotContains(t, props, "fullName")
+		assert.Equal(t, "Doe", props["lastName"])
+	})
+
+	t.Run("default arrays are not shared", func(t *testing.T) {
+		obj := &models.Object{Class: "Person"}
+		require.Nil(t, applyPropertyDefaults(class, obj, now))
+
+		obj.Properties.(map[string]interface{})["tags"].([]interface{})[0] = "changed"
+		assert.Equal(t, []interface{}{"new"}, class.Properties[3].DefaultValue)
+	})
+}
+
+func Test_AddObject_PropertyDefaults(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Book",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+						{Name: "pages", DataType: schema.DataTypeInt.PropString(), DefaultValue: float64(100)},
+						{Name: "slug", DataType: schema.DataTypeText.PropString(), ComputedValue: `concat("book-", title)`},
+						{Name: "addedAt", DataType: schema.DataTypeDate.PropString(), ComputedValue: "now()"},
+					},
+				},
+			},
+		},
+	}
+	newBook := func() *models.Object {
+		return &models.Object{
+			Class:      "Book",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Properties: map[string]interface{}{"title": "Dune"},
+		}
+	}
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+
+	t.Run("single object", func(t *testing.T) {
+		repo := &fakeVectorRepo{}
+		repo.On("Exists", "Book", mock.Anything).Return(false, nil)
+		repo.On("PutObject", mock.Anything, mock.Anything).Return(nil)
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger,
+			&fakeAuthorizer{}, repo, modulesProvider, &fakeMetrics{})
+
+		res, err := manager.AddObject(context.Background(), nil, newBook(), nil)
+		require.Nil(t, err)
+
+		props := res.Properties.(map[string]interface{})
+		assert.Equal(t, float64(100), props["pages"])
+		assert.Equal(t, "book-Dune", props["slug"])
+		assert.IsType(t, time.Time{}, props["addedAt"])
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		repo := &fakeVectorRepo{}
+		repo.On("BatchPutObjects", mock.Anything).Return(nil)
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewBatchManager(repo, modulesProvider, &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+
+		res, err := manager.AddObjects(context.Background(), nil,
+			[]*models.Object{newBook()}, nil, nil)
+		require.Nil(t, err)
+		require.Nil(t, res[0].Err)
+
+		props := res[0].Object.Properties.(map[string]interface{})
+		assert.Equal(t, float64(100), props["pages"])
+		assert.Equal(t, "book-Dune", props["slug"])
+	})
+}
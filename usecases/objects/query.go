@@ -70,6 +70,13 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
 		return nil, &Error{path, StatusForbidden, err}
 	}
+	tenant := ""
+	if params.Tenant != nil {
+		tenant = *params.Tenant
+	}
+	if err := m.authorizeClass(principal, "list", params.Class, tenant); err != nil {
+		return nil, &Error{path, StatusForbidden, err}
+	}
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, &Error{"cannot lock", StatusInternalServerError, err}
@@ -30,6 +30,10 @@ import (
 func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Principal,
 	input *AddReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	if err := writable(m.writeGuard); err != nil {
+		return &Error{"read-only", StatusServiceUnavailable, err}
+	}
+
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
@@ -52,6 +56,9 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.authorizeClass(principal, "update", input.Class, tenant); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
@@ -59,7 +66,7 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	}
 	defer unlock()
 
-	validator := validation.New(m.vectorRepo.Exists, m.config, repl)
+	validator := newValidator(m.vectorRepo.Exists, m.config, repl, m.remoteRefs)
 	if err := input.validate(ctx, principal, validator, m.schemaManager, tenant); err != nil {
 		if errors.As(err, &ErrMultiTenancy{}) {
 			return &Error{"validate inputs", StatusUnprocessableEntity, err}
@@ -36,6 +36,10 @@ type DeleteReferenceInput struct {
 func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.Principal,
 	input *DeleteReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	if err := writable(m.writeGuard); err != nil {
+		return &Error{"read-only", StatusServiceUnavailable, err}
+	}
+
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
@@ -57,6 +61,9 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.authorizeClass(principal, "update", input.Class, tenant); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
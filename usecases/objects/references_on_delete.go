@@ -0,0 +1,305 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// onDeleteBatchSize is the amount of referencing objects handled at once
+const onDeleteBatchSize = 100
+
+// onDeleteRule is a reference property with an onDelete setting, seen from
+// the class it points to
+type onDeleteRule struct {
+	class    string
+	property string
+	action   string
+}
+
+// referentialActions enforces the onDelete settings of reference properties
+// pointing to deleted objects. Cascading deletes are followed recursively,
+// cycles are broken by never visiting an object twice.
+type referentialActions struct {
+	repo       VectorRepo
+	authorizer authorizer
+	principal  *models.Principal
+	schema     schema.Schema
+	repl       *additional.ReplicationProperties
+	tenant     string
+	now        func() int64
+	checked    map[string]struct{}
+	deleted    map[string]struct{}
+}
+
+// newReferentialActions returns nil if no property in the schema has an
+// onDelete setting, so that deletes without rules don't pay for the lookups
+func newReferentialActions(repo VectorRepo, authorizer authorizer,
+	principal *models.Principal, sch schema.Schema, now func() int64,
+	repl *additional.ReplicationProperties, tenant string,
+) *referentialActions {
+	if sch.Objects == nil {
+		return nil
+	}
+
+	for _, class := range sch.Objects.Classes {
+		for _, prop := range class.Properties {
+			if prop.OnDelete != "" {
+				return &referentialActions{
+					repo:       repo,
+					authorizer: authorizer,
+					principal:  principal,
+					schema:     sch,
+					repl:       repl,
+					tenant:     tenant,
+					now:        now,
+					checked:    map[string]struct{}{},
+					deleted:    map[string]struct{}{},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *referentialActions) rules(target string) []onDeleteRule {
+	var rules []onDeleteRule
+	for _, class := range a.schema.Objects.Classes {
+		for _, prop := range class.Properties {
+			if prop.OnDelete == "" {
+				continue
+			}
+			for _, dt := range prop.DataType {
+				if dt == target {
+					rules = append(rules, onDeleteRule{
+						class:    class.Class,
+						property: prop.Name,
+						action:   prop.OnDelete,
+					})
+					break
+				}
+			}
+		}
+	}
+
+	return rules
+}
+
+// check returns ErrInvalidUserInput if deleting the object would violate a
+// restrict rule, either directly or through objects which would be deleted
+// by a cascade. It must be called before anything is deleted.
+func (a *referentialActions) check(ctx context.Context, class string, id strfmt.UUID) error {
+	key := class + "/" + id.String()
+	if _, ok := a.checked[key]; ok {
+		return nil
+	}
+	a.checked[key] = struct{}{}
+
+	for _, rule := range a.rules(class) {
+		switch rule.action {
+		case models.PropertyOnDeleteRestrict:
+			res, err := a.referencing(ctx, rule, class, id, 0, 1)
+			if err != nil {
+				return err
+			}
+			if len(res) > 0 {
+				return NewErrInvalidUserInput("object %s/%s is still referenced by %s/%s "+
+					"through property %q with onDelete %q", class, id,
+					rule.class, res[0].ID, rule.property, rule.action)
+			}
+		case models.PropertyOnDeleteCascade:
+			for offset := 0; ; offset += onDeleteBatchSize {
+				res, err := a.referencing(ctx, rule, class, id, offset, onDeleteBatchSize)
+				if err != nil {
+					return err
+				}
+				for _, obj := range res {
+					if err := a.check(ctx, rule.class, obj.ID); err != nil {
+						return err
+					}
+				}
+				if len(res) < onDeleteBatchSize {
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// apply runs the cascade and setNull rules for an object which has just
+// been deleted
+func (a *referentialActions) apply(ctx context.Context, class string, id strfmt.UUID) error {
+	a.deleted[class+"/"+id.String()] = struct{}{}
+
+	for _, rule := range a.rules(class) {
+		switch rule.action {
+		case models.PropertyOnDeleteCascade:
+			if err := a.cascade(ctx, rule, class, id); err != nil {
+				return err
+			}
+		case models.PropertyOnDeleteSetNull:
+			if err := a.setNull(ctx, rule, class, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *referentialActions) cascade(ctx context.Context, rule onDeleteRule,
+	class string, id strfmt.UUID,
+) error {
+	for {
+		res, err := a.referencing(ctx, rule, class, id, 0, onDeleteBatchSize)
+		if err != nil {
+			return err
+		}
+
+		progress := false
+		for _, obj := range res {
+			if _, ok := a.deleted[rule.class+"/"+obj.ID.String()]; ok {
+				continue
+			}
+			progress = true
+
+			path := fmt.Sprintf("objects/%s/%s", rule.class, obj.ID)
+			if err := a.authorizer.Authorize(a.principal, "delete", path); err != nil {
+				return err
+			}
+			if err := a.repo.DeleteObject(ctx, rule.class, obj.ID, a.repl, a.tenant); err != nil {
+				return fmt.Errorf("cascade delete %s: %w", path, err)
+			}
+			if err := a.apply(ctx, rule.class, obj.ID); err != nil {
+				return err
+			}
+		}
+
+		if !progress || len(res) < onDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *referentialActions) setNull(ctx context.Context, rule onDeleteRule,
+	class string, id strfmt.UUID,
+) error {
+	updated := map[strfmt.UUID]struct{}{}
+	for {
+		res, err := a.referencing(ctx, rule, class, id, 0, onDeleteBatchSize)
+		if err != nil {
+			return err
+		}
+
+		progress := false
+		for _, found := range res {
+			if _, ok := updated[found.ID]; ok {
+				// the reference could not be removed, e.g. because the target
+				// is referenced through a beacon without class
+				continue
+			}
+			updated[found.ID] = struct{}{}
+			progress = true
+
+			path := fmt.Sprintf("objects/%s/%s", rule.class, found.ID)
+			if err := a.authorizer.Authorize(a.principal, "update", path); err != nil {
+				return err
+			}
+
+			obj := found.Object()
+			obj.Tenant = a.tenant
+			if !removeReferencesTo(obj, rule.property, class, id) {
+				continue
+			}
+			obj.LastUpdateTimeUnix = a.now()
+			if err := a.repo.PutObject(ctx, obj, found.Vector, a.repl); err != nil {
+				return fmt.Errorf("remove reference from %s: %w", path, err)
+			}
+		}
+
+		if !progress || len(res) < onDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *referentialActions) referencing(ctx context.Context, rule onDeleteRule,
+	class string, id strfmt.UUID, offset, limit int,
+) (search.Results, error) {
+	res, err := a.repo.ObjectSearch(ctx, offset, limit, referencingFilter(rule, class, id),
+		nil, additional.Properties{}, a.tenant)
+	if err != nil {
+		return nil, NewErrInternal("find objects referencing %s/%s: %v", class, id, err)
+	}
+
+	return res, nil
+}
+
+// referencingFilter matches all objects of the rule's class which reference
+// the given object through the rule's property
+func referencingFilter(rule onDeleteRule, class string, id strfmt.UUID) *filters.LocalFilter {
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(rule.class),
+				Property: schema.PropertyName(rule.property),
+				Child: &filters.Path{
+					Class:    schema.ClassName(class),
+					Property: filters.InternalPropID,
+				},
+			},
+			Value: &filters.Value{
+				Value: id.String(),
+				Type:  schema.DataTypeText,
+			},
+		},
+	}
+}
+
+// removeReferencesTo removes all beacons of the property pointing to the
+// given object, it returns false if there were none
+func removeReferencesTo(obj *models.Object, prop, class string, id strfmt.UUID) bool {
+	properties, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	refs, ok := properties[prop].(models.MultipleRef)
+	if !ok {
+		return false
+	}
+
+	kept := make(models.MultipleRef, 0, len(refs))
+	for _, r := range refs {
+		ref, err := crossref.ParseSingleRef(r)
+		if err == nil && ref.TargetID == id && (ref.Class == "" || ref.Class == class) {
+			continue
+		}
+		kept = append(kept, r)
+	}
+
+	properties[prop] = kept
+	return len(kept) != len(refs)
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_DeleteObject_OnDelete(t *testing.T) {
+	var (
+		author = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		book   = strfmt.UUID("8e554ad0-9c8e-4b54-8f8c-2bcd3b3bd4a4")
+		review = strfmt.UUID("0d0a9a24-7a5a-4d7b-9f06-5e4be5d4a0a1")
+	)
+
+	onDeleteSchema := func(bookAction, reviewAction string) schema.Schema {
+		return schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{Class: "Author"},
+			{Class: "Book", Properties: []*models.Property{{
+				Name: "writtenBy", DataType: []string{"Author"}, OnDelete: bookAction,
+			}}},
+			{Class: "Review", Properties: []*models.Property{{
+				Name: "about", DataType: []string{"Book"}, OnDelete: reviewAction,
+			}}},
+		}}}
+	}
+
+	referencing := func(class string) interface{} {
+		return mock.MatchedBy(func(f *filters.LocalFilter) bool {
+			return f.Root.On.Class.String() == class
+		})
+	}
+
+	t.Run("cascade follows references recursively", func(t *testing.T) {
+		manager, repo := newDeleteDependency()
+		manager.schemaManager.(*fakeSchemaManager).GetSchemaResponse = onDeleteSchema(
+			models.PropertyOnDeleteCascade, models.PropertyOnDeleteCascade)
+
+		repo.On("Exists", "Author", author).Return(true, nil).Once()
+		repo.On("ObjectSearch", 0, onDeleteBatchSize, mock.Anything, referencing("Book"), mock.Anything).
+			Return([]search.Result{{ClassName: "Book", ID: book}}, nil).Twice()
+		repo.On("ObjectSearch", 0, onDeleteBatchSize, mock.Anything, referencing("Review"), mock.Anything).
+			Return([]search.Result{{ClassName: "Review", ID: review}}, nil).Twice()
+		repo.On("ObjectSearch", 0, onDeleteBatchSize, mock.Anything, mock.Anything, mock.Anything).
+			Return([]search.Result{}, nil)
+		repo.On("DeleteObject", "Author", author).Return(nil).Once()
+		repo.On("DeleteObject", "Book", book).Return(nil).Once()
+		repo.On("DeleteObject", "Review", review).Return(nil).Once()
+
+		err := manager.DeleteObject(context.Background(), nil, "Author", author, nil, "")
+		require.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("setNull removes the reference", func(t *testing.T) {
+		manager, repo := newDeleteDependency()
+		manager.schemaManager.(*fakeSchemaManager).GetSchemaResponse = onDeleteSchema(
+			models.PropertyOnDeleteSetNull, "")
+
+		other := strfmt.UUID("c3a5e0a5-3b3d-4f3c-8c34-4a1f4bfb2a3e")
+		found := search.Result{
+			ClassName: "Book",
+			ID:        book,
+			Schema: map[string]interface{}{
+				"writtenBy": models.MultipleRef{
+					{Beacon: strfmt.URI("weaviate://localhost/Author/" + author)},
+					{Beacon: strfmt.URI("weaviate://localhost/Author/" + other)},
+				},
+			},
+		}
+
+		repo.On("Exists", "Author", author).Return(true, nil).Once()
+		repo.On("DeleteObject", "Author", author).Return(nil).Once()
+		repo.On("ObjectSearch", 0, onDeleteBatchSize, mock.Anything, referencing("Book"), mock.Anything).
+			Return([]search.Result{found}, nil).Once()
+		repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			refs := obj.Properties.(map[string]interface{})["writtenBy"].(models.MultipleRef)
+			return obj.ID == book && len(refs) == 1 &&
+				refs[0].Beacon == strfmt.URI("weaviate://localhost/Author/"+other)
+		}), mock.Anything).Return(nil).Once()
+
+		err := manager.DeleteObject(context.Background(), nil, "Author", author, nil, "")
+		require.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("restrict blocks the delete", func(t *testing.T) {
+		manager, repo := newDeleteDependency()
+		manager.schemaManager.(*fakeSchemaManager).GetSchemaResponse = onDeleteSchema(
+			models.PropertyOnDeleteCascade, models.PropertyOnDeleteRestrict)
+
+		repo.On("Exists", "Author", author).Return(true, nil).Once()
+		repo.On("ObjectSearch", 0, onDeleteBatchSize, mock.Anything, referencing("Book"), mock.Anything).
+			Return([]search.Result{{ClassName: "Book", ID: book}}, nil).Once()
+		repo.On("ObjectSearch", 0, 1, mock.Anything, referencing("Review"), mock.Anything).
+			Return([]search.Result{{ClassName: "Review", ID: review}}, nil).Once()
+
+		err := manager.DeleteObject(context.Background(), nil, "Author", author, nil, "")
+		require.NotNil(t, err)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.Contains(t, err.Error(), "still referenced by Review")
+		repo.AssertNotCalled(t, "DeleteObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no rules means no lookups", func(t *testing.T) {
+		manager, repo := newDeleteDependency()
+		manager.schemaManager.(*fakeSchemaManager).GetSchemaResponse = onDeleteSchema("", "")
+
+		repo.On("Exists", "Author", author).Return(true, nil).Once()
+		repo.On("DeleteObject", "Author", author).Return(nil).Once()
+
+		err := manager.DeleteObject(context.Background(), nil, "Author", author, nil, "")
+		require.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+}
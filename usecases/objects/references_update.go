@@ -41,6 +41,10 @@ type PutReferenceInput struct {
 func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.Principal,
 	input *PutReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	if err := writable(m.writeGuard); err != nil {
+		return &Error{"read-only", StatusServiceUnavailable, err}
+	}
+
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
@@ -63,6 +67,9 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.authorizeClass(principal, "update", input.Class, tenant); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
@@ -70,7 +77,7 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	}
 	defer unlock()
 
-	validator := validation.New(m.vectorRepo.Exists, m.config, repl)
+	validator := newValidator(m.vectorRepo.Exists, m.config, repl, m.remoteRefs)
 	if err := input.validate(ctx, principal, validator, m.schemaManager, tenant); err != nil {
 		if errors.As(err, &ErrMultiTenancy{}) {
 			return &Error{"bad inputs", StatusUnprocessableEntity, err}
@@ -143,6 +150,9 @@ func validateReferenceSchema(class, property string, sch schema.Schema) error {
 	if dt.IsPrimitive() {
 		return fmt.Errorf("property '%s' is a primitive datatype, not a reference-type", property)
 	}
+	if dt.IsNested() {
+		return fmt.Errorf("property '%s' is a nested datatype, not a reference-type", property)
+	}
 
 	return nil
 }
@@ -14,10 +14,12 @@ package objects
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 )
 
 // UpdateObject updates object of class.
@@ -26,15 +28,31 @@ import (
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
 	repl *additional.ReplicationProperties,
-) (*models.Object, error) {
+) (obj *models.Object, err error) {
+	defer func() {
+		event := audit.Event{Action: audit.ActionObjectUpdate, Class: class, Resource: id.String()}
+		if updates != nil {
+			event.Tenant = updates.Tenant
+		}
+		m.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	if err = writable(m.writeGuard); err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
 		path = fmt.Sprintf("objects/%s", id)
 	}
-	err := m.authorizer.Authorize(principal, "update", path)
+	err = m.authorizer.Authorize(principal, "update", path)
 	if err != nil {
 		return nil, err
 	}
+	if updates != nil {
+		if err := m.authorizeClass(principal, "update", class, updates.Tenant); err != nil {
+			return nil, err
+		}
+	}
 
 	m.metrics.UpdateObjectInc()
 	defer m.metrics.UpdateObjectDec()
@@ -56,10 +74,14 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
 	}
 
+	ctx = withPrecondition(ctx, id)
 	obj, err := m.getObjectFromRepo(ctx, className, id, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVersion(ctx, id, obj.Updated); err != nil {
+		return nil, err
+	}
 
 	m.logger.
 		WithField("object", "kinds_update_requested").
@@ -68,6 +90,14 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		WithField("id", id).
 		Debug("received update kind request")
 
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPropertyDefaults(class, updates, time.UnixMilli(m.timeSource.Now())); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
+
 	err = m.validateObjectAndNormalizeNames(
 		ctx, principal, repl, updates, obj.Object())
 	if err != nil {
@@ -79,16 +109,19 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	// directly from the request body, therefore `CreationTimeUnix`
 	// inherits the zero value.
 	updates.CreationTimeUnix = obj.Created
-	updates.LastUpdateTimeUnix = m.timeSource.Now()
+	updates.LastUpdateTimeUnix = nextVersion(ctx, m.timeSource.Now(), obj.Updated)
 
-	class, err := m.schemaManager.GetClass(ctx, principal, className)
-	if err != nil {
-		return nil, err
-	}
 	err = m.modulesProvider.UpdateVector(ctx, updates, class, nil, m.findObject, m.logger)
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
 	}
+	if err := fitVectorDimensions(class, updates); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
+	if err := externalizeBlobs(ctx, m.blobs, class, updates.ID,
+		updates.Tenant, objectProps(updates)); err != nil {
+		return nil, err
+	}
 
 	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, repl)
 	if err != nil {
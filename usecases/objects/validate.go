@@ -27,6 +27,11 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	if err != nil {
 		return err
 	}
+	if obj != nil {
+		if err := m.authorizeClass(principal, "validate", obj.Class, obj.Tenant); err != nil {
+			return err
+		}
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -24,6 +24,8 @@ import (
 
 type exists func(_ context.Context, class string, _ strfmt.UUID, _ *additional.ReplicationProperties, _ string) (bool, error)
 
+type remoteExists func(_ context.Context, _ *crossref.Ref) (bool, error)
+
 const (
 	// ErrorMissingActionObjects message
 	ErrorMissingActionObjects string = "no objects, object and subject, are added. Add 'objects' by using the 'objects' key in the root of the JSON"
@@ -63,6 +65,7 @@ const (
 
 type Validator struct {
 	exists           exists
+	remoteExists     remoteExists
 	config           *config.WeaviateConfig
 	replicationProps *additional.ReplicationProperties
 }
@@ -77,6 +80,13 @@ func New(exists exists, config *config.WeaviateConfig,
 	}
 }
 
+// WithRemoteRefs enables validation of beacons pointing to objects on
+// federation peers. Without it, such beacons are rejected.
+func (v *Validator) WithRemoteRefs(exists remoteExists) *Validator {
+	v.remoteExists = exists
+	return v
+}
+
 func (v *Validator) Object(ctx context.Context, class *models.Class,
 	incoming *models.Object, existing *models.Object,
 ) error {
@@ -84,9 +94,25 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := validateVectorOnly(class, incoming); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
+// validateVectorOnly rejects property writes to classes which only store
+// the vectors of their objects
+func validateVectorOnly(class *models.Class, incoming *models.Object) error {
+	if class == nil || !class.VectorOnly || incoming.Properties == nil {
+		return nil
+	}
+	if props, ok := incoming.Properties.(map[string]interface{}); ok && len(props) == 0 {
+		return nil
+	}
+	return fmt.Errorf("class %q is vector-only, objects can't have properties", class.Class)
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
@@ -107,7 +133,7 @@ func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRe
 	}
 
 	if !ref.Local {
-		return fmt.Errorf("unrecognized cross-ref ref format")
+		return v.validateRemoteRef(ctx, ref, errorVal)
 	}
 
 	// locally check for object existence
@@ -132,6 +158,24 @@ func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRe
 	return nil
 }
 
+func (v *Validator) validateRemoteRef(ctx context.Context, ref *crossref.Ref,
+	errorVal string,
+) error {
+	if v.remoteExists == nil {
+		return fmt.Errorf("unrecognized cross-ref ref format")
+	}
+
+	ok, err := v.remoteExists(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf(ErrorNotFoundInDatabase, errorVal, ref.TargetID)
+	}
+
+	return nil
+}
+
 func (v *Validator) ValidateMultipleRef(ctx context.Context, refs models.MultipleRef,
 	errorVal string, tenant string,
 ) error {
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// nestedValue validates the value of a property of data type object or
+// object[] against its nested properties. Values of nested primitives are
+// converted like the values of top level properties.
+func (v *Validator) nestedValue(ctx context.Context, path string, pv interface{},
+	className string, dataType schema.DataType, nested []*models.NestedProperty,
+) (interface{}, error) {
+	if dataType == schema.DataTypeObject {
+		return v.nestedObject(ctx, path, pv, className, nested)
+	}
+
+	values, ok := pv.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid object array property '%s' on class '%s': "+
+			"not an array, but %T", path, className, pv)
+	}
+	out := make([]interface{}, len(values))
+	for i, value := range values {
+		obj, err := v.nestedObject(ctx, fmt.Sprintf("%s[%d]", path, i), value, className, nested)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = obj
+	}
+	return out, nil
+}
+
+func (v *Validator) nestedObject(ctx context.Context, path string, pv interface{},
+	className string, nested []*models.NestedProperty,
+) (map[string]interface{}, error) {
+	obj, ok := pv.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid object property '%s' on class '%s': "+
+			"not an object, but %T", path, className, pv)
+	}
+
+	out := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		if value == nil {
+			continue // nil values are removed like on top level properties
+		}
+
+		var np *models.NestedProperty
+		for _, candidate := range nested {
+			if candidate.Name == key {
+				np = candidate
+				break
+			}
+		}
+		npPath := path + schema.NestedPathSeparator + key
+		if np == nil {
+			return nil, fmt.Errorf("invalid object property '%s' on class '%s': "+
+				"no such nested property %q", path, className, key)
+		}
+
+		var (
+			data interface{}
+			err  error
+		)
+		if dt, ok := schema.AsNested(np.DataType); ok {
+			data, err = v.nestedValue(ctx, npPath, value, className, dt, np.NestedProperties)
+		} else {
+			dt := schema.DataType(np.DataType[0])
+			data, err = v.extractAndValidateProperty(ctx, npPath, value, className, &dt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[key] = data
+	}
+
+	return out, nil
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestValidator_NestedProperties(t *testing.T) {
+	class := &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "since", DataType: schema.DataTypeDate.PropString()},
+				},
+			},
+			{
+				Name: "pets", DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "age", DataType: schema.DataTypeInt.PropString()},
+				},
+			},
+		},
+	}
+	validate := func(props map[string]interface{}) (*models.Object, error) {
+		obj := &models.Object{Class: "Person", Properties: props}
+		err := New(fakeExists, &config.WeaviateConfig{}, nil).
+			Object(context.Background(), class, obj, nil)
+		return obj, err
+	}
+
+	t.Run("valid values", func(t *testing.T) {
+		obj, err := validate(map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":  "Berlin",
+				"since": "2020-01-02T03:04:05Z",
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Rex", "age": float64(3)},
+				map[string]interface{}{"name": "Tom", "age": nil},
+			},
+		})
+		require.Nil(t, err)
+
+		props := obj.Properties.(map[string]interface{})
+		address := props["address"].(map[string]interface{})
+		assert.Equal(t, "Berlin", address["city"])
+		assert.IsType(t, time.Time{}, address["since"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Rex", "age": float64(3)},
+			map[string]interface{}{"name": "Tom"},
+		}, props["pets"])
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			props       map[string]interface{}
+			expectedErr string
+		}{
+			{
+				name:        "object is not a map",
+				props:       map[string]interface{}{"address": "Berlin"},
+				expectedErr: "not an object",
+			},
+			{
+				name:        "object array is not an array",
+				props:       map[string]interface{}{"pets": map[string]interface{}{"name": "Rex"}},
+				expectedErr: "not an array",
+			},
+			{
+				name: "unknown nested property",
+				props: map[string]interface{}{
+					"address": map[string]interface{}{"country": "DE"},
+				},
+				expectedErr: "no such nested property \"country\"",
+			},
+			{
+				name: "wrong nested type",
+				props: map[string]interface{}{
+					"pets": []interface{}{map[string]interface{}{"age": "three"}},
+				},
+				expectedErr: "invalid integer property 'pets[0].age'",
+			},
+			{
+				name:        "dotted property key",
+				props:       map[string]interface{}{"address.city": "Berlin"},
+				expectedErr: "no such prop with name 'address.city'",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := validate(test.props)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+}
@@ -77,12 +77,23 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 		if len(propertyKey) > 1 {
 			propertyKeyLowerCase += propertyKey[1:]
 		}
+		if strings.Contains(propertyKeyLowerCase, schema.NestedPathSeparator) {
+			// dotted paths only address nested properties in filters
+			return fmt.Errorf(schema.ErrorNoSuchProperty, propertyKeyLowerCase, className)
+		}
 		dataType, err := schema.GetPropertyDataType(class, propertyKeyLowerCase)
 		if err != nil {
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+		var data interface{}
+		if *dataType == schema.DataTypeObject || *dataType == schema.DataTypeObjectArray {
+			prop, _ := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			data, err = v.nestedValue(ctx, propertyKeyLowerCase, propertyValue, className,
+				*dataType, prop.NestedProperties)
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+		}
 		if err != nil {
 			return err
 		}
@@ -417,6 +428,11 @@ func blobVal(val interface{}) (string, error) {
 		return "", fmt.Errorf("not a blob base64 string, but %T", val)
 	}
 
+	// values of externally stored blobs are references into the blob store
+	if _, ok := schema.ParseExternalBlobRef(typed); ok {
+		return typed, nil
+	}
+
 	base64Regex := regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`)
 	ok = base64Regex.MatchString(typed)
 	if !ok {
@@ -19,6 +19,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func (m *Manager) updateRefVector(ctx context.Context, principal *models.Principal,
@@ -55,6 +57,38 @@ func (m *Manager) updateRefVector(ctx context.Context, principal *models.Princip
 	return nil
 }
 
+// fitVectorDimensions enforces the number of dimensions configured for the
+// vector index of the class, so a vector of the wrong length is rejected
+// with a clear error instead of failing deep inside the index. Depending on
+// the configured policy, longer vectors are truncated or shorter ones padded
+// with zeros instead.
+func fitVectorDimensions(class *models.Class, object *models.Object) error {
+	if class == nil {
+		return nil
+	}
+
+	cfg, ok := vectorindex.HnswConfig(class.VectorIndexConfig)
+	if !ok || cfg.Skip || cfg.Dimensions == 0 || len(object.Vector) == 0 {
+		return nil
+	}
+
+	want, got := cfg.Dimensions, len(object.Vector)
+	switch {
+	case got == want:
+	case got > want && cfg.DimensionMismatch == hnsw.DimensionMismatchTruncate:
+		object.Vector = object.Vector[:want]
+	case got < want && cfg.DimensionMismatch == hnsw.DimensionMismatchPadZero:
+		padded := make([]float32, want)
+		copy(padded, object.Vector)
+		object.Vector = padded
+	default:
+		return NewErrInvalidUserInput("vector has %d dimensions, but class %q requires %d",
+			got, class.Class, want)
+	}
+
+	return nil
+}
+
 // TODO: remove this method and just pass m.vectorRepo.Object to
 // m.modulesProvider.UpdateVector when m.vectorRepo.ObjectByID
 // is finally removed
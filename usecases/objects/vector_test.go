@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFitVectorDimensions(t *testing.T) {
+	class := func(dims int, policy string) *models.Class {
+		return &models.Class{
+			Class: "Foo",
+			VectorIndexConfig: hnsw.UserConfig{
+				Dimensions:        dims,
+				DimensionMismatch: policy,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		vector   models.C11yVector
+		expected models.C11yVector
+		errMsg   string
+	}{
+		{
+			name:     "dimensions not enforced",
+			class:    class(0, hnsw.DimensionMismatchReject),
+			vector:   []float32{1, 2, 3},
+			expected: []float32{1, 2, 3},
+		},
+		{
+			name:     "matching dimensions",
+			class:    class(3, hnsw.DimensionMismatchReject),
+			vector:   []float32{1, 2, 3},
+			expected: []float32{1, 2, 3},
+		},
+		{
+			name:   "mismatch is rejected",
+			class:  class(2, hnsw.DimensionMismatchReject),
+			vector: []float32{1, 2, 3},
+			errMsg: "vector has 3 dimensions, but class \"Foo\" requires 2",
+		},
+		{
+			name:     "longer vector is truncated",
+			class:    class(2, hnsw.DimensionMismatchTruncate),
+			vector:   []float32{1, 2, 3},
+			expected: []float32{1, 2},
+		},
+		{
+			name:   "shorter vector is not truncated",
+			class:  class(4, hnsw.DimensionMismatchTruncate),
+			vector: []float32{1, 2, 3},
+			errMsg: "vector has 3 dimensions, but class \"Foo\" requires 4",
+		},
+		{
+			name:     "shorter vector is padded",
+			class:    class(5, hnsw.DimensionMismatchPadZero),
+			vector:   []float32{1, 2, 3},
+			expected: []float32{1, 2, 3, 0, 0},
+		},
+		{
+			name:   "longer vector is not padded",
+			class:  class(2, hnsw.DimensionMismatchPadZero),
+			vector: []float32{1, 2, 3},
+			errMsg: "vector has 3 dimensions, but class \"Foo\" requires 2",
+		},
+		{
+			name:     "no vector",
+			class:    class(3, hnsw.DimensionMismatchReject),
+			vector:   nil,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			obj := &models.Object{Class: "Foo", Vector: test.vector}
+			err := fitVectorDimensions(test.class, obj)
+			if test.errMsg != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.errMsg, err.Error())
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, obj.Vector)
+		})
+	}
+}
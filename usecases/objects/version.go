@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// The version of an object is the time of its last update. A client can make
+// an update or delete conditional on the version it has read, so that
+// concurrent writers don't silently overwrite each other's changes.
+//
+// The precondition is checked by the shard which stores the object, under the
+// same lock as the write itself, on every replica the write goes to.
+
+type (
+	expectedVersionKey  struct{}
+	expectedVersionsKey struct{}
+	preconditionsKey    struct{}
+)
+
+// WithExpectedVersion makes the update, merge or delete of an object
+// performed with ctx conditional on the object still being at version
+func WithExpectedVersion(ctx context.Context, version int64) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, version)
+}
+
+// ExpectedVersion returns the version set with WithExpectedVersion, if any
+func ExpectedVersion(ctx context.Context) (int64, bool) {
+	version, ok := ctx.Value(expectedVersionKey{}).(int64)
+	return version, ok
+}
+
+// WithExpectedVersions makes the objects of a batch import performed with ctx
+// conditional: versions[i] is the version the i-th object of the batch is
+// expected to be at, 0 means unconditional
+func WithExpectedVersions(ctx context.Context, versions []int64) context.Context {
+	return context.WithValue(ctx, expectedVersionsKey{}, versions)
+}
+
+func expectedVersions(ctx context.Context) []int64 {
+	versions, _ := ctx.Value(expectedVersionsKey{}).([]int64)
+	return versions
+}
+
+// Preconditions are the versions objects are expected to be at by the
+// conditional writes performed with a context, keyed by object id
+type Preconditions map[strfmt.UUID]int64
+
+// Add makes the write of object id conditional on it being at version
+func (p Preconditions) Add(id strfmt.UUID, version int64) {
+	p[preconditionKey(id)] = version
+}
+
+func preconditionKey(id strfmt.UUID) strfmt.UUID {
+	return strfmt.UUID(strings.ToLower(id.String()))
+}
+
+// WithPreconditions attaches p to ctx. Unlike WithExpectedVersion, the
+// preconditions only apply to the objects they name, so that writes to other
+// objects made on behalf of the same request, e.g. by referential actions,
+// stay unconditional.
+func WithPreconditions(ctx context.Context, p Preconditions) context.Context {
+	if len(p) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, preconditionsKey{}, p)
+}
+
+// PreconditionsFrom returns the preconditions attached to ctx, if any
+func PreconditionsFrom(ctx context.Context) Preconditions {
+	p, _ := ctx.Value(preconditionsKey{}).(Preconditions)
+	return p
+}
+
+// CheckPrecondition returns an ErrConflict if ctx carries a precondition for
+// object id which isn't met. exists tells if the object is stored at all and
+// current is its version if so.
+func CheckPrecondition(ctx context.Context, id strfmt.UUID, exists bool, current int64) error {
+	expected, ok := PreconditionsFrom(ctx)[preconditionKey(id)]
+	if !ok {
+		return nil
+	}
+	if !exists {
+		return NewErrConflict("object %s does not exist, expected version %d",
+			id, expected)
+	}
+	return versionConflict(id, current, expected)
+}
+
+func versionConflict(id strfmt.UUID, current, expected int64) error {
+	if current == expected {
+		return nil
+	}
+	return NewErrConflict("object %s is at version %d, expected version %d",
+		id, current, expected)
+}
+
+// withPrecondition binds the version set with WithExpectedVersion, if any,
+// to the object id
+func withPrecondition(ctx context.Context, id strfmt.UUID) context.Context {
+	expected, ok := ExpectedVersion(ctx)
+	if !ok {
+		return ctx
+	}
+	p := Preconditions{}
+	p.Add(id, expected)
+	return WithPreconditions(ctx, p)
+}
+
+// checkVersion returns an ErrConflict if ctx carries a precondition which
+// the current version of the object doesn't meet. It lets a write fail early,
+// the shard checks the precondition again when the write is applied.
+func checkVersion(ctx context.Context, id strfmt.UUID, current int64) error {
+	expected, ok := ExpectedVersion(ctx)
+	if !ok {
+		return nil
+	}
+	return versionConflict(id, current, expected)
+}
+
+// nextVersion is the update time for an object at version prev. The
+// version of a conditional write must strictly increase, otherwise a write
+// within the same millisecond could not be told apart from the one before.
+func nextVersion(ctx context.Context, now, prev int64) int64 {
+	if _, ok := ExpectedVersion(ctx); ok && now <= prev {
+		return prev + 1
+	}
+	return now
+}
+
+// withBatchPreconditions binds the versions set with WithExpectedVersions to
+// the objects of the batch. The update time of a conditional object is moved
+// past the version it expects, see nextVersion.
+func withBatchPreconditions(ctx context.Context, objects BatchObjects) context.Context {
+	expected := expectedVersions(ctx)
+	if len(expected) == 0 {
+		return ctx
+	}
+
+	p := Preconditions{}
+	for _, obj := range objects {
+		i := obj.OriginalIndex
+		if obj.Err != nil || i >= len(expected) || expected[i] == 0 {
+			continue
+		}
+		p.Add(obj.UUID, expected[i])
+		if obj.Object.LastUpdateTimeUnix <= expected[i] {
+			obj.Object.LastUpdateTimeUnix = expected[i] + 1
+		}
+	}
+	return WithPreconditions(ctx, p)
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_ExpectedVersion(t *testing.T) {
+	var (
+		cls     = "MyClass"
+		id      = strfmt.UUID("0d0b2a5e-7f58-4c49-9e0b-6b8c0f9b0d3e")
+		version = int64(1700000000000)
+	)
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							Name:         "foo",
+						},
+					},
+				},
+			},
+		},
+	}
+	stored := func() *search.Result {
+		return &search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Created:   version,
+			Updated:   version,
+		}
+	}
+	payload := func() *models.Object {
+		return &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"foo": "baz"}}
+	}
+
+	t.Run("update with outdated version", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(stored(), nil).Once()
+
+		ctx := WithExpectedVersion(context.Background(), version-1)
+		_, err := m.UpdateObject(ctx, nil, cls, id, payload(), nil)
+		assert.True(t, errors.As(err, &ErrConflict{}), "got %v", err)
+		m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update with current version", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(stored(), nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		ctx := WithExpectedVersion(context.Background(), version)
+		res, err := m.UpdateObject(ctx, nil, cls, id, payload(), nil)
+		require.Nil(t, err)
+		assert.Greater(t, res.LastUpdateTimeUnix, version)
+	})
+
+	t.Run("merge with outdated version", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(stored(), nil).Once()
+
+		ctx := WithExpectedVersion(context.Background(), version+1)
+		err := m.MergeObject(ctx, nil, payload(), nil)
+		require.NotNil(t, err)
+		assert.True(t, err.Conflict())
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("delete rejected by the shard", func(t *testing.T) {
+		m, repo := newDeleteDependency()
+		repo.On("Exists", cls, id).Return(true, nil).Once()
+		conflict := NewErrConflict("object %s is at version %d, expected version %d",
+			id, version, version-1)
+		repo.On("DeleteObject", cls, id).Return(fmt.Errorf("delete: %w", conflict)).Once()
+
+		ctx := WithExpectedVersion(context.Background(), version-1)
+		err := m.DeleteObject(ctx, nil, cls, id, nil, "")
+		assert.IsType(t, ErrConflict{}, err)
+	})
+
+	t.Run("delete with current version", func(t *testing.T) {
+		m, repo := newDeleteDependency()
+		repo.On("Exists", cls, id).Return(true, nil).Once()
+		repo.On("DeleteObject", cls, id).Return(nil).Once()
+
+		ctx := WithExpectedVersion(context.Background(), version)
+		require.Nil(t, m.DeleteObject(ctx, nil, cls, id, nil, ""))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("conditional delete without class", func(t *testing.T) {
+		m, _ := newDeleteDependency()
+		ctx := WithExpectedVersion(context.Background(), version)
+		err := m.DeleteObject(ctx, nil, "", id, nil, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
+
+func Test_CheckPrecondition(t *testing.T) {
+	var (
+		id      = strfmt.UUID("1F0E7A5C-3C1E-4E43-9A8E-0A9F6F4F2C01")
+		other   = strfmt.UUID("1f0e7a5c-3c1e-4e43-9a8e-0a9f6f4f2c02")
+		version = int64(1700000000000)
+	)
+
+	ctx := withPrecondition(WithExpectedVersion(context.Background(), version), id)
+
+	assert.Nil(t, CheckPrecondition(ctx, id, true, version))
+	assert.Nil(t, CheckPrecondition(ctx, strfmt.UUID(strings.ToLower(id.String())), true, version))
+	assert.IsType(t, ErrConflict{}, CheckPrecondition(ctx, id, true, version+1))
+	assert.IsType(t, ErrConflict{}, CheckPrecondition(ctx, id, false, 0))
+	assert.Nil(t, CheckPrecondition(ctx, other, false, 0), "other objects stay unconditional")
+	assert.Nil(t, CheckPrecondition(context.Background(), id, true, version+1))
+}
+
+func Test_BatchManager_ExpectedVersions(t *testing.T) {
+	var (
+		cls     = "MyClass"
+		version = int64(1700000000000)
+		ids     = []strfmt.UUID{
+			"1f0e7a5c-3c1e-4e43-9a8e-0a9f6f4f2c01",
+			"1f0e7a5c-3c1e-4e43-9a8e-0a9f6f4f2c02",
+			"1f0e7a5c-3c1e-4e43-9a8e-0a9f6f4f2c03",
+		}
+	)
+
+	objects := make(BatchObjects, len(ids))
+	for i, id := range ids {
+		objects[i] = BatchObject{
+			OriginalIndex: i,
+			UUID:          id,
+			Object:        &models.Object{Class: cls, ID: id, LastUpdateTimeUnix: version},
+		}
+	}
+	// the first object is unconditional, the second one failed validation
+	objects[1].Err = errors.New("invalid")
+
+	ctx := WithExpectedVersions(context.Background(), []int64{0, version, version})
+	p := PreconditionsFrom(withBatchPreconditions(ctx, objects))
+
+	assert.Equal(t, Preconditions{ids[2]: version}, p)
+	assert.Equal(t, version, objects[0].Object.LastUpdateTimeUnix)
+	assert.Greater(t, objects[2].Object.LastUpdateTimeUnix, version)
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeWriteGuard struct {
+	err error
+}
+
+func (g *fakeWriteGuard) Writable() error {
+	return g.err
+}
+
+func TestWriteGuard(t *testing.T) {
+	readOnly := errors.New("read-only")
+	guard := &fakeWriteGuard{err: readOnly}
+	logger, _ := test.NewNullLogger()
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	ctx := context.Background()
+
+	// the repo has no expectations, any write reaching it fails the test
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &config.WeaviateConfig{},
+		logger, &fakeAuthorizer{}, &fakeVectorRepo{}, getFakeModulesProvider(), &fakeMetrics{})
+	manager.SetWriteGuard(guard)
+	batchManager := NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(), &fakeLocks{},
+		&fakeSchemaManager{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+	batchManager.SetWriteGuard(guard)
+
+	t.Run("objects", func(t *testing.T) {
+		_, err := manager.AddObject(ctx, nil, &models.Object{Class: "Foo", ID: id}, nil)
+		assert.ErrorIs(t, err, readOnly)
+		_, err = manager.UpdateObject(ctx, nil, "Foo", id, &models.Object{Class: "Foo", ID: id}, nil)
+		assert.ErrorIs(t, err, readOnly)
+		assert.ErrorIs(t, manager.DeleteObject(ctx, nil, "Foo", id, nil, ""), readOnly)
+
+		mergeErr := manager.MergeObject(ctx, nil, &models.Object{Class: "Foo", ID: id}, nil)
+		assert.ErrorIs(t, mergeErr, readOnly)
+		refErr := manager.AddObjectReference(ctx, nil, &AddReferenceInput{Class: "Foo", ID: id}, nil, "")
+		assert.ErrorIs(t, refErr, readOnly)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		_, err := batchManager.AddObjects(ctx, nil, []*models.Object{{Class: "Foo", ID: id}}, nil, nil)
+		assert.ErrorIs(t, err, readOnly)
+		_, err = batchManager.AddReferences(ctx, nil, nil, nil, nil)
+		assert.ErrorIs(t, err, readOnly)
+		_, err = batchManager.DeleteObjects(ctx, nil, &models.BatchDeleteMatch{Class: "Foo"},
+			nil, nil, nil, "")
+		assert.ErrorIs(t, err, readOnly)
+	})
+}
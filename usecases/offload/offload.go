@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package offload copies the files of inactive shards to one of the backup
+// backends, e.g. S3, GCS or Azure. Every copy is described by a manifest, so
+// it can be restored on any node.
+package offload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+const (
+	// copies are stored below <root>/<class>/<shard>/<node> of the backend
+	root        = "offload"
+	manifestKey = "manifest.json"
+	filesPrefix = "files"
+)
+
+// BackendProvider returns the backup backend with the given name
+type BackendProvider interface {
+	BackupBackend(backend string) (modulecapabilities.BackupBackend, error)
+}
+
+// File is a file or directory of a shard. The path is relative to the data
+// root, the checksum is the hex encoded sha256 of the content of a file.
+type File struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Dir      bool   `json:"dir,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// Manifest describes the copy of a shard which a node offloaded
+type Manifest struct {
+	Class     string    `json:"class"`
+	Shard     string    `json:"shard"`
+	Node      string    `json:"node"`
+	CreatedAt time.Time `json:"createdAt"`
+	Files     []File    `json:"files"`
+}
+
+// Size returns the total size of all files of the copy
+func (m *Manifest) Size() int64 {
+	var size int64
+	for _, f := range m.Files {
+		size += f.Size
+	}
+	return size
+}
+
+// Offloader stores shard copies in the backup backend with the given name.
+// The backend is looked up on use, as modules are initialized after the
+// database. Paths are relative to the source data path of the backend.
+type Offloader struct {
+	backends BackendProvider
+	backend  string
+}
+
+func New(backends BackendProvider, backend string) *Offloader {
+	return &Offloader{backends: backends, backend: backend}
+}
+
+// Backend returns the name of the backup backend
+func (o *Offloader) Backend() string {
+	return o.backend
+}
+
+// Upload copies the files of a shard which must not change in the meantime.
+// The manifest is written last, so an interrupted upload leaves the previous
+// copy of the node intact. Files of a previous copy which are no longer part
+// of the shard are kept, as backup backends cannot delete objects.
+func (o *Offloader) Upload(ctx context.Context, class, shard, node string,
+	files []File,
+) (*Manifest, error) {
+	backend, err := o.backends.BackupBackend(o.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	id := copyID(class, shard, node)
+	if err := backend.Initialize(ctx, id); err != nil {
+		return nil, fmt.Errorf("offload shard %s: %w", shard, err)
+	}
+
+	m := &Manifest{
+		Class:     class,
+		Shard:     shard,
+		Node:      node,
+		CreatedAt: time.Now().UTC(),
+		Files:     make([]File, 0, len(files)),
+	}
+	for _, f := range files {
+		if !f.Dir {
+			size, sum, err := checksum(filepath.Join(backend.SourceDataPath(), f.Path))
+			if err != nil {
+				return nil, fmt.Errorf("offload shard %s: %w", shard, err)
+			}
+			f.Size, f.Checksum = size, sum
+
+			if err := backend.PutFile(ctx, id, fileKey(f.Path), f.Path); err != nil {
+				return nil, fmt.Errorf("offload shard %s: %w", shard, err)
+			}
+		}
+		m.Files = append(m.Files, f)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := backend.PutObject(ctx, id, manifestKey, data); err != nil {
+		return nil, fmt.Errorf("offload shard %s: %w", shard, err)
+	}
+
+	return m, nil
+}
+
+// Manifest returns the manifest of the copy of a shard which the given node
+// offloaded. A backup.ErrNotFound is returned if there is none.
+func (o *Offloader) Manifest(ctx context.Context, class, shard, node string) (*Manifest, error) {
+	backend, err := o.backends.BackupBackend(o.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	return readManifest(ctx, backend, copyID(class, shard, node))
+}
+
+// Download restores the copy of a shard which the given node offloaded into
+// the data path of this node. Every file is verified against its checksum
+// before it is moved into place.
+func (o *Offloader) Download(ctx context.Context, class, shard, node string) (*Manifest, error) {
+	backend, err := o.backends.BackupBackend(o.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	id := copyID(class, shard, node)
+	m, err := readManifest(ctx, backend, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range m.Files {
+		target := filepath.Join(backend.SourceDataPath(), f.Path)
+		if f.Dir {
+			if err := os.MkdirAll(target, 0o777); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := downloadFile(ctx, backend, id, f, target); err != nil {
+			return nil, fmt.Errorf("restore shard %s: %w", shard, err)
+		}
+	}
+
+	return m, nil
+}
+
+func readManifest(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id string,
+) (*Manifest, error) {
+	data, err := backend.GetObject(ctx, id, manifestKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parse manifest of %s: %w", id, err)
+	}
+	return &m, nil
+}
+
+func downloadFile(ctx context.Context, backend modulecapabilities.BackupBackend,
+	id string, f File, target string,
+) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o777); err != nil {
+		return err
+	}
+
+	tmp := target + ".offload"
+	if err := backend.WriteToFile(ctx, id, fileKey(f.Path), tmp); err != nil {
+		return err
+	}
+
+	size, sum, err := checksum(tmp)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if size != f.Size || sum != f.Checksum {
+		os.Remove(tmp)
+		return fmt.Errorf("file %s is corrupted: got %d bytes with checksum %s, want %d bytes with checksum %s",
+			f.Path, size, sum, f.Size, f.Checksum)
+	}
+
+	return os.Rename(tmp, target)
+}
+
+func checksum(p string) (int64, string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func copyID(class, shard, node string) string {
+	return path.Join(root, class, shard, node)
+}
+
+func fileKey(p string) string {
+	return path.Join(filesPrefix, filepath.ToSlash(p))
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// fakeObjects is shared by the backends of several nodes
+type fakeObjects struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+type fakeBackend struct {
+	*fakeObjects
+	dataPath string
+}
+
+func (f *fakeBackend) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	if name != "fake" {
+		return nil, fmt.Errorf("backup: %s not found", name)
+	}
+	return f, nil
+}
+
+func (f *fakeBackend) IsExternal() bool                         { return true }
+func (f *fakeBackend) Name() string                             { return "fake" }
+func (f *fakeBackend) HomeDir(backupID string) string           { return backupID }
+func (f *fakeBackend) SourceDataPath() string                   { return f.dataPath }
+func (f *fakeBackend) Initialize(context.Context, string) error { return nil }
+
+func (f *fakeBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	data, ok := f.objects[path.Join(backupID, key)]
+	if !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("no object %s/%s", backupID, key))
+	}
+	return data, nil
+}
+
+func (f *fakeBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	data, err := f.GetObject(ctx, backupID, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, 0o666)
+}
+
+func (f *fakeBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	data, err := os.ReadFile(filepath.Join(f.dataPath, srcPath))
+	if err != nil {
+		return err
+	}
+	return f.PutObject(ctx, backupID, key, data)
+}
+
+func (f *fakeBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.objects[path.Join(backupID, key)] = data
+	return nil
+}
+
+func TestOffloader(t *testing.T) {
+	ctx := context.Background()
+	objects := &fakeObjects{objects: map[string][]byte{}}
+	node1 := &fakeBackend{fakeObjects: objects, dataPath: t.TempDir()}
+	node2 := &fakeBackend{fakeObjects: objects, dataPath: t.TempDir()}
+
+	files := []File{
+		{Path: "article_t1_lsm", Dir: true},
+		{Path: "article_t1_lsm/objects", Dir: true},
+		{Path: filepath.Join("article_t1_lsm", "objects", "segment-1.db")},
+		{Path: "article_t1.hnsw.commitlog.d", Dir: true},
+		{Path: filepath.Join("article_t1.hnsw.commitlog.d", "1")},
+	}
+	contents := map[string]string{
+		files[2].Path: "segment contents",
+		files[4].Path: "commit log",
+	}
+	for _, f := range files {
+		p := filepath.Join(node1.dataPath, f.Path)
+		if f.Dir {
+			require.Nil(t, os.MkdirAll(p, 0o777))
+		} else {
+			require.Nil(t, os.WriteFile(p, []byte(contents[f.Path]), 0o666))
+		}
+	}
+
+	offloader := New(node1, "fake")
+	m, err := offloader.Upload(ctx, "Article", "t1", "node1", files)
+	require.Nil(t, err)
+	assert.Equal(t, "node1", m.Node)
+	assert.Len(t, m.Files, len(files))
+	assert.Equal(t, int64(len("segment contents")+len("commit log")), m.Size())
+
+	t.Run("manifest", func(t *testing.T) {
+		got, err := New(node2, "fake").Manifest(ctx, "Article", "t1", "node1")
+		require.Nil(t, err)
+		assert.Equal(t, m.Files, got.Files)
+
+		_, err = New(node2, "fake").Manifest(ctx, "Article", "t1", "node2")
+		assert.True(t, errors.As(err, &backup.ErrNotFound{}))
+	})
+
+	t.Run("restore on another node", func(t *testing.T) {
+		_, err := New(node2, "fake").Download(ctx, "Article", "t1", "node1")
+		require.Nil(t, err)
+
+		for p, content := range contents {
+			data, err := os.ReadFile(filepath.Join(node2.dataPath, p))
+			require.Nil(t, err)
+			assert.Equal(t, content, string(data))
+		}
+		info, err := os.Stat(filepath.Join(node2.dataPath, "article_t1_lsm", "objects"))
+		require.Nil(t, err)
+		assert.True(t, info.IsDir())
+	})
+
+	t.Run("corrupted file", func(t *testing.T) {
+		key := path.Join(copyID("Article", "t1", "node1"), fileKey(files[4].Path))
+		objects.objects[key] = []byte("commit lo9")
+
+		target := t.TempDir()
+		_, err := New(&fakeBackend{fakeObjects: objects, dataPath: target}, "fake").
+			Download(ctx, "Article", "t1", "node1")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "corrupted")
+
+		_, err = os.Stat(filepath.Join(target, files[4].Path))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(target, files[4].Path+".offload"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		_, err := New(node1, "gcs").Manifest(ctx, "Article", "t1", "node1")
+		assert.NotNil(t, err)
+	})
+}
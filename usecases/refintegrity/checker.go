@@ -0,0 +1,282 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package refintegrity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Repo reads source objects and checks reference targets
+type Repo interface {
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+	Exists(ctx context.Context, class string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) (bool, error)
+	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
+		addl additional.Properties, tenant string) (*search.Result, error)
+}
+
+// Writer modifies references, it is implemented by the objects manager so
+// that the usual authorization and validation applies
+type Writer interface {
+	AddObjectReference(ctx context.Context, principal *models.Principal,
+		input *objects.AddReferenceInput, repl *additional.ReplicationProperties,
+		tenant string) *objects.Error
+	DeleteObjectReference(ctx context.Context, principal *models.Principal,
+		input *objects.DeleteReferenceInput, repl *additional.ReplicationProperties,
+		tenant string) *objects.Error
+}
+
+type checker struct {
+	repo      Repo
+	writer    Writer
+	schema    schema.Schema
+	class     *models.Class
+	opts      Options
+	principal *models.Principal
+	batchSize int
+	update    func(func(r *Report))
+}
+
+func (c *checker) run(ctx context.Context) error {
+	refProps := map[string]*models.Property{}
+	for _, prop := range c.class.Properties {
+		if len(prop.DataType) > 0 && schema.IsRefDataType(prop.DataType) {
+			refProps[prop.Name] = prop
+		}
+	}
+
+	c.update(func(r *Report) {
+		for name := range refProps {
+			r.property(name)
+		}
+	})
+	if len(refProps) == 0 {
+		return nil
+	}
+
+	after := ""
+	for {
+		res, err := c.repo.Query(ctx, &objects.QueryInput{
+			Class:  c.class.Class,
+			Cursor: &filters.Cursor{After: after, Limit: c.batchSize},
+			Limit:  c.batchSize,
+			Tenant: c.opts.Tenant,
+		})
+		if err != nil {
+			return fmt.Errorf("read objects after %q: %w", after, err)
+		}
+		if len(res) == 0 {
+			return nil
+		}
+
+		for i := range res {
+			if err := c.checkObject(ctx, &res[i], refProps); err != nil {
+				return err
+			}
+		}
+
+		c.update(func(r *Report) { r.ObjectsScanned += int64(len(res)) })
+		after = res[len(res)-1].ID.String()
+		if len(res) < c.batchSize {
+			return nil
+		}
+	}
+}
+
+func (c *checker) checkObject(ctx context.Context, obj *search.Result,
+	refProps map[string]*models.Property,
+) error {
+	props, ok := obj.Schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for name, prop := range refProps {
+		for _, single := range refsOf(props[name]) {
+			counts, err := c.checkRef(ctx, obj, prop, single)
+			if err != nil {
+				return err
+			}
+			c.update(func(r *Report) {
+				p := r.property(name)
+				p.References++
+				p.Dangling += counts.Dangling
+				p.MissingClass += counts.MissingClass
+				p.Remote += counts.Remote
+				p.Nullified += counts.Nullified
+				p.Repaired += counts.Repaired
+				p.FixFailed += counts.FixFailed
+			})
+		}
+	}
+
+	return nil
+}
+
+// checkRef verifies a single reference and fixes it according to the mode.
+// The returned report only contains the counters of this reference.
+func (c *checker) checkRef(ctx context.Context, obj *search.Result,
+	prop *models.Property, single *models.SingleRef,
+) (PropertyReport, error) {
+	var counts PropertyReport
+
+	ref, err := crossref.ParseSingleRef(single)
+	if err != nil {
+		// beacons are validated on import, a malformed one can't be resolved
+		counts.Dangling++
+		c.fix(ctx, obj, prop, single, nil, &counts)
+		return counts, nil
+	}
+	if !ref.Local {
+		counts.Remote++
+		return counts, nil
+	}
+
+	exists := false
+	if ref.Class == "" {
+		target, err := c.repo.ObjectByID(ctx, ref.TargetID, nil, additional.Properties{}, c.opts.Tenant)
+		if err != nil {
+			return counts, fmt.Errorf("check target %s: %w", ref.TargetID, err)
+		}
+		exists = target != nil
+	} else if c.schema.GetClass(schema.ClassName(ref.Class)) == nil {
+		counts.MissingClass++
+		c.fix(ctx, obj, prop, single, ref, &counts)
+		return counts, nil
+	} else {
+		exists, err = c.repo.Exists(ctx, ref.Class, ref.TargetID, nil, c.opts.Tenant)
+		if err != nil {
+			return counts, fmt.Errorf("check target %s: %w", ref.String(), err)
+		}
+	}
+
+	if exists {
+		return counts, nil
+	}
+
+	counts.Dangling++
+	c.fix(ctx, obj, prop, single, ref, &counts)
+	return counts, nil
+}
+
+// fix nullifies or repairs a broken reference according to the mode. A
+// reference which can't be fixed is recorded in the report, so that a single
+// one doesn't abort the whole run.
+func (c *checker) fix(ctx context.Context, obj *search.Result, prop *models.Property,
+	single *models.SingleRef, ref *crossref.Ref, counts *PropertyReport,
+) {
+	if c.opts.Mode == ModeReport {
+		return
+	}
+
+	if c.opts.Mode == ModeRepair && ref != nil {
+		repaired, err := c.repair(ctx, obj, prop, single, ref)
+		if err != nil {
+			c.fixFailed(err, counts)
+			return
+		}
+		if repaired {
+			counts.Repaired++
+			return
+		}
+	}
+
+	if err := c.remove(ctx, obj, prop.Name, single); err != nil {
+		c.fixFailed(err, counts)
+		return
+	}
+	counts.Nullified++
+}
+
+func (c *checker) fixFailed(err error, counts *PropertyReport) {
+	counts.FixFailed++
+	c.update(func(r *Report) {
+		if len(r.FixErrors) < maxFixErrors {
+			r.FixErrors = append(r.FixErrors, err.Error())
+		}
+	})
+}
+
+// repair looks for the target object in all classes. If it lives in a class
+// the property may point to, the beacon is rewritten.
+func (c *checker) repair(ctx context.Context, obj *search.Result, prop *models.Property,
+	single *models.SingleRef, ref *crossref.Ref,
+) (bool, error) {
+	target, err := c.repo.ObjectByID(ctx, ref.TargetID, nil, additional.Properties{}, c.opts.Tenant)
+	if err != nil {
+		return false, fmt.Errorf("look up target %s: %w", ref.TargetID, err)
+	}
+	if target == nil || !allowsClass(prop, target.ClassName) {
+		return false, nil
+	}
+
+	if err := c.remove(ctx, obj, prop.Name, single); err != nil {
+		return false, err
+	}
+
+	repaired := crossref.NewLocalhost(target.ClassName, ref.TargetID).SingleRef()
+	if err := c.writer.AddObjectReference(ctx, c.principal, &objects.AddReferenceInput{
+		Class:    obj.ClassName,
+		ID:       obj.ID,
+		Property: prop.Name,
+		Ref:      *repaired,
+	}, nil, c.opts.Tenant); err != nil {
+		return false, fmt.Errorf("add repaired reference to %s: %w", obj.ID, err)
+	}
+
+	return true, nil
+}
+
+func (c *checker) remove(ctx context.Context, obj *search.Result, prop string,
+	single *models.SingleRef,
+) error {
+	if err := c.writer.DeleteObjectReference(ctx, c.principal, &objects.DeleteReferenceInput{
+		Class:     obj.ClassName,
+		ID:        obj.ID,
+		Property:  prop,
+		Reference: *single,
+	}, nil, c.opts.Tenant); err != nil {
+		return fmt.Errorf("remove reference from %s: %w", obj.ID, err)
+	}
+
+	return nil
+}
+
+func allowsClass(prop *models.Property, className string) bool {
+	for _, dt := range prop.DataType {
+		if dt == className {
+			return true
+		}
+	}
+	return false
+}
+
+func refsOf(value interface{}) models.MultipleRef {
+	switch refs := value.(type) {
+	case models.MultipleRef:
+		return refs
+	case []*models.SingleRef:
+		return refs
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package refintegrity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// DefaultBatchSize is the amount of source objects read per iteration
+const DefaultBatchSize = 100
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Options configure a single verification run
+type Options struct {
+	Mode   string
+	Tenant string
+}
+
+// Manager runs reference verification jobs in the background. There is at
+// most one job per class and tenant, the report of the last job is kept
+// until the next one is started.
+type Manager struct {
+	repo       Repo
+	writer     Writer
+	schema     schemaGetter
+	authorizer authorizer
+	logger     logrus.FieldLogger
+	batchSize  int
+
+	sync.Mutex
+	jobs map[string]*Report
+}
+
+func NewManager(repo Repo, writer Writer, schemaGetter schemaGetter,
+	authorizer authorizer, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		repo:       repo,
+		writer:     writer,
+		schema:     schemaGetter,
+		authorizer: authorizer,
+		logger:     logger,
+		batchSize:  DefaultBatchSize,
+		jobs:       map[string]*Report{},
+	}
+}
+
+// Verify starts a verification job for the class. Modes other than
+// ModeReport modify objects and require update permissions on them.
+func (m *Manager) Verify(principal *models.Principal, className string,
+	opts Options,
+) (*Report, error) {
+	if opts.Mode == "" {
+		opts.Mode = ModeReport
+	}
+
+	verb := "list"
+	if opts.Mode != ModeReport {
+		verb = "update"
+	}
+	if err := m.authorizer.Authorize(principal, verb, "objects/"+className); err != nil {
+		return nil, err
+	}
+	err := m.authorizer.Authorize(principal, verb,
+		authorization.ClassResource(className, opts.Tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMode(opts.Mode); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	sch := m.schema.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	key := jobKey(className, opts.Tenant)
+	m.Lock()
+	if job, ok := m.jobs[key]; ok && job.Status == StatusStarted {
+		m.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("verification of class %q is already running", className))
+	}
+	report := &Report{
+		Class:      className,
+		Tenant:     opts.Tenant,
+		Mode:       opts.Mode,
+		Status:     StatusStarted,
+		StartedAt:  time.Now(),
+		Properties: []PropertyReport{},
+	}
+	m.jobs[key] = report
+	snapshot := report.clone()
+	m.Unlock()
+
+	c := &checker{
+		repo:      m.repo,
+		writer:    m.writer,
+		schema:    sch,
+		class:     class,
+		opts:      opts,
+		principal: principal,
+		batchSize: m.batchSize,
+		update:    m.update(key),
+	}
+	go m.run(c, key)
+
+	return snapshot, nil
+}
+
+// Status returns the report of the current or last job of the class
+func (m *Manager) Status(principal *models.Principal, className,
+	tenant string,
+) (*Report, error) {
+	if err := m.authorizer.Authorize(principal, "list", "objects/"+className); err != nil {
+		return nil, err
+	}
+	err := m.authorizer.Authorize(principal, "list",
+		authorization.ClassResource(className, tenant))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	report, ok := m.jobs[jobKey(className, tenant)]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(
+			fmt.Errorf("no reference verification found for class %q", className))
+	}
+
+	return report.clone(), nil
+}
+
+func (m *Manager) run(c *checker, key string) {
+	err := c.run(context.Background())
+
+	m.Lock()
+	defer m.Unlock()
+
+	report := m.jobs[key]
+	now := time.Now()
+	report.CompletedAt = &now
+	if err != nil {
+		report.Status = StatusFailed
+		report.Error = err.Error()
+		m.logger.WithField("action", "reference_verification").
+			WithField("class", report.Class).
+			WithError(err).
+			Error("reference verification failed")
+		return
+	}
+
+	report.Status = StatusSuccess
+	m.logger.WithField("action", "reference_verification").
+		WithField("class", report.Class).
+		WithField("objects_scanned", report.ObjectsScanned).
+		Info("reference verification completed")
+}
+
+// update gives the checker access to the shared report
+func (m *Manager) update(key string) func(func(r *Report)) {
+	return func(fn func(r *Report)) {
+		m.Lock()
+		defer m.Unlock()
+
+		fn(m.jobs[key])
+	}
+}
+
+func jobKey(className, tenant string) string {
+	return className + "/" + tenant
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package refintegrity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	sourceID  = strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d01")
+	authorID  = strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d02")
+	deletedID = strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d03")
+	movedID   = strfmt.UUID("8a3d5e4c-2c4e-4a7c-8b1c-8f5f0b0e6d04")
+)
+
+func TestVerifyReferences(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected PropertyReport
+		removed  []strfmt.URI
+		added    []strfmt.URI
+	}{
+		{
+			mode: ModeReport,
+			expected: PropertyReport{
+				Property: "writtenBy", References: 5, Dangling: 1, MissingClass: 2, Remote: 1,
+			},
+		},
+		{
+			mode: ModeNullify,
+			expected: PropertyReport{
+				Property: "writtenBy", References: 5, Dangling: 1, MissingClass: 2, Remote: 1,
+				Nullified: 3,
+			},
+			removed: []strfmt.URI{
+				beacon("Author", deletedID), beacon("Removed", deletedID),
+				beacon("Removed", movedID),
+			},
+		},
+		{
+			mode: ModeRepair,
+			expected: PropertyReport{
+				Property: "writtenBy", References: 5, Dangling: 1, MissingClass: 2, Remote: 1,
+				Nullified: 2, Repaired: 1,
+			},
+			removed: []strfmt.URI{
+				beacon("Author", deletedID), beacon("Removed", deletedID),
+				beacon("Removed", movedID),
+			},
+			added: []strfmt.URI{beacon("Editor", movedID)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			writer := &fakeWriter{}
+			m := newTestManager(writer, &fakeAuthorizer{})
+
+			_, err := m.Verify(nil, "Article", Options{Mode: tc.mode})
+			require.Nil(t, err)
+
+			report := waitForCompletion(t, m)
+			assert.Equal(t, StatusSuccess, report.Status)
+			assert.Equal(t, int64(1), report.ObjectsScanned)
+			assert.Equal(t, []PropertyReport{tc.expected}, report.Properties)
+			assert.ElementsMatch(t, tc.removed, writer.removed)
+			assert.ElementsMatch(t, tc.added, writer.added)
+		})
+	}
+}
+
+func TestVerifyReferencesErrors(t *testing.T) {
+	t.Run("unknown class", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		_, err := m.Verify(nil, "Unknown", Options{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		_, err := m.Verify(nil, "Article", Options{Mode: "delete"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("no job yet", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{})
+		_, err := m.Status(nil, "Article", "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("failing fixes don't abort the run", func(t *testing.T) {
+		writer := &fakeWriter{err: &objects.Error{
+			Msg: "malformed beacon", Code: objects.StatusBadRequest,
+		}}
+		m := newTestManager(writer, &fakeAuthorizer{})
+		_, err := m.Verify(nil, "Article", Options{Mode: ModeNullify})
+		require.Nil(t, err)
+
+		report := waitForCompletion(t, m)
+		assert.Equal(t, StatusSuccess, report.Status)
+		assert.Equal(t, []PropertyReport{{
+			Property: "writtenBy", References: 5, Dangling: 1, MissingClass: 2, Remote: 1,
+			FixFailed: 3,
+		}}, report.Properties)
+		assert.Len(t, report.FixErrors, 3)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := newTestManager(&fakeWriter{}, &fakeAuthorizer{err: errors.New("forbidden")})
+		_, err := m.Verify(nil, "Article", Options{})
+		assert.NotNil(t, err)
+	})
+}
+
+func newTestManager(writer *fakeWriter, authorizer *fakeAuthorizer) *Manager {
+	logger, _ := test.NewNullLogger()
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "writtenBy", DataType: []string{"Author", "Editor"}},
+			},
+		},
+		{Class: "Author"},
+		{Class: "Editor"},
+	}}}
+
+	repo := &fakeRepo{
+		sources: search.Results{{
+			ClassName: "Article",
+			ID:        sourceID,
+			Schema: map[string]interface{}{
+				"title": "hello",
+				"writtenBy": models.MultipleRef{
+					{Beacon: beacon("Author", authorID)},
+					{Beacon: beacon("Author", deletedID)},
+					{Beacon: beacon("Removed", deletedID)},
+					{Beacon: beacon("Removed", movedID)},
+					{Beacon: "weaviate://remote-peer/Author/" + strfmt.URI(authorID)},
+				},
+			},
+		}},
+		existing: map[strfmt.UUID]string{authorID: "Author", movedID: "Editor"},
+	}
+
+	return NewManager(repo, writer, &fakeSchemaGetter{schema: sch}, authorizer, logger)
+}
+
+func waitForCompletion(t *testing.T, m *Manager) *Report {
+	var report *Report
+	require.Eventually(t, func() bool {
+		var err error
+		report, err = m.Status(nil, "Article", "")
+		require.Nil(t, err)
+		return report.Status != StatusStarted
+	}, time.Second, 5*time.Millisecond)
+
+	return report
+}
+
+func beacon(class string, id strfmt.UUID) strfmt.URI {
+	return crossref.NewLocalhost(class, id).SingleRef().Beacon
+}
+
+type fakeRepo struct {
+	sources  search.Results
+	existing map[strfmt.UUID]string
+}
+
+func (r *fakeRepo) Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error) {
+	if q.Cursor.After != "" {
+		return nil, nil
+	}
+	return r.sources, nil
+}
+
+func (r *fakeRepo) Exists(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) (bool, error) {
+	return r.existing[id] == class, nil
+}
+
+func (r *fakeRepo) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
+	addl additional.Properties, tenant string,
+) (*search.Result, error) {
+	class, ok := r.existing[id]
+	if !ok {
+		return nil, nil
+	}
+	return &search.Result{ClassName: class, ID: id}, nil
+}
+
+type fakeWriter struct {
+	added   []strfmt.URI
+	removed []strfmt.URI
+	err     *objects.Error
+}
+
+func (w *fakeWriter) AddObjectReference(ctx context.Context, principal *models.Principal,
+	input *objects.AddReferenceInput, repl *additional.ReplicationProperties, tenant string,
+) *objects.Error {
+	w.added = append(w.added, input.Ref.Beacon)
+	return nil
+}
+
+func (w *fakeWriter) DeleteObjectReference(ctx context.Context, principal *models.Principal,
+	input *objects.DeleteReferenceInput, repl *additional.ReplicationProperties, tenant string,
+) *objects.Error {
+	if w.err != nil {
+		return w.err
+	}
+	w.removed = append(w.removed, input.Reference.Beacon)
+	return nil
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package refintegrity
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// ModeReport only counts dangling references
+	ModeReport = "report"
+	// ModeNullify removes dangling references from their source objects
+	ModeNullify = "nullify"
+	// ModeRepair points dangling references to the class the target object
+	// actually lives in, if that class is allowed by the property, and
+	// removes them otherwise
+	ModeRepair = "repair"
+)
+
+const (
+	StatusStarted = "STARTED"
+	StatusSuccess = "SUCCESS"
+	StatusFailed  = "FAILED"
+)
+
+func validateMode(mode string) error {
+	switch mode {
+	case ModeReport, ModeNullify, ModeRepair:
+		return nil
+	default:
+		return fmt.Errorf("mode must be one of %q, %q or %q, got %q",
+			ModeReport, ModeNullify, ModeRepair, mode)
+	}
+}
+
+// PropertyReport holds the counts of a single reference property
+type PropertyReport struct {
+	Property   string `json:"property"`
+	References int64  `json:"references"`
+	// Dangling references point to objects which don't exist (anymore),
+	// MissingClass references to classes which don't exist. A reference is
+	// counted in only one of them.
+	Dangling     int64 `json:"dangling"`
+	MissingClass int64 `json:"missingClass"`
+	// Remote references point to federation peers and are not verified
+	Remote    int64 `json:"remote"`
+	Nullified int64 `json:"nullified"`
+	Repaired  int64 `json:"repaired"`
+	// FixFailed references could not be nullified or repaired, the run goes
+	// on with the next reference
+	FixFailed int64 `json:"fixFailed"`
+}
+
+// maxFixErrors is the number of errors of failed fixes kept in the report
+const maxFixErrors = 20
+
+// Report is the state of a verification run
+type Report struct {
+	Class          string           `json:"class"`
+	Tenant         string           `json:"tenant,omitempty"`
+	Mode           string           `json:"mode"`
+	Status         string           `json:"status"`
+	Error          string           `json:"error,omitempty"`
+	StartedAt      time.Time        `json:"startedAt"`
+	CompletedAt    *time.Time       `json:"completedAt,omitempty"`
+	ObjectsScanned int64            `json:"objectsScanned"`
+	Properties     []PropertyReport `json:"properties"`
+	// FixErrors are the first errors of references which could not be fixed
+	FixErrors []string `json:"fixErrors,omitempty"`
+}
+
+func (r *Report) property(name string) *PropertyReport {
+	for i := range r.Properties {
+		if r.Properties[i].Property == name {
+			return &r.Properties[i]
+		}
+	}
+
+	r.Properties = append(r.Properties, PropertyReport{Property: name})
+	sort.Slice(r.Properties, func(a, b int) bool {
+		return r.Properties[a].Property < r.Properties[b].Property
+	})
+	return r.property(name)
+}
+
+func (r *Report) clone() *Report {
+	out := *r
+	out.Properties = make([]PropertyReport, len(r.Properties))
+	copy(out.Properties, r.Properties)
+	out.FixErrors = append([]string(nil), r.FixErrors...)
+	if r.CompletedAt != nil {
+		t := *r.CompletedAt
+		out.CompletedAt = &t
+	}
+	return &out
+}
@@ -0,0 +1,392 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// leavesPerRequest is the number of hash tree leaves whose objects are
+	// compared at once
+	leavesPerRequest = 16
+	// objectsPerRepair is the number of objects copied to a replica at once
+	objectsPerRepair = 100
+)
+
+// RepairStats summarizes the anti-entropy repair of a shard
+type RepairStats struct {
+	// Leaves is the number of hash tree leaves which differed
+	Leaves int
+	// Compared is the number of local objects compared with other replicas
+	Compared int
+	// Repaired is the number of objects copied to other replicas
+	Repaired int
+	// Skipped is the number of objects which were deleted or changed on the
+	// other replica and therefore not copied
+	Skipped int
+	// Bytes is the number of bytes of the copied objects
+	Bytes int64
+	// Deleted is the number of objects deleted on the target of SyncShard
+	Deleted int
+}
+
+// RepairShard compares the local replica of a shard with all other replicas
+// and copies objects which are missing or outdated on them. Objects which
+// are newer on another replica are repaired when that replica repairs the
+// shard. Like read repair, objects deleted on another replica are not
+// restored.
+func (r *Replicator) RepairShard(ctx context.Context, shard string,
+	height int, th *Throttle,
+) (RepairStats, error) {
+	var stats RepairStats
+	nodes, err := r.stateGetter.ResolveParentNodes(r.class, shard)
+	if err != nil {
+		return stats, err
+	}
+	local := nodes[r.resolver.NodeName]
+	if local == "" {
+		return stats, fmt.Errorf("shard %q is not replicated on this node", shard)
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		if name != r.resolver.NodeName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// a replica which cannot be repaired must not prevent repairing the others
+	var firstErr error
+	for _, name := range names {
+		remote := nodes[name]
+		if remote == "" {
+			err = fmt.Errorf("%w: %q", errUnresolvedName, name)
+		} else {
+			err = r.repairReplica(ctx, shard, local, remote, height, th, false, &stats)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("repair replica %q of shard %q: %w", name, shard, err)
+		}
+	}
+	return stats, firstErr
+}
+
+// SyncShard brings the copy of a shard on the target node up to date with
+// the copy on the source node, e.g. while the shard is moved between them.
+// Unlike RepairShard it also deletes objects on the target which were
+// deleted on the source, and the nodes don't need to be replicas of the
+// shard in the sharding state.
+func (r *Replicator) SyncShard(ctx context.Context, shard, source, target string,
+	height int,
+) (RepairStats, error) {
+	var stats RepairStats
+	hosts := make([]string, 2)
+	for i, node := range []string{source, target} {
+		host, ok := r.resolver.NodeHostname(node)
+		if !ok || host == "" {
+			return stats, fmt.Errorf("%w: %q", errUnresolvedName, node)
+		}
+		hosts[i] = host
+	}
+
+	err := r.repairReplica(ctx, shard, hosts[0], hosts[1], height, nil, true, &stats)
+	if err != nil {
+		return stats, fmt.Errorf("sync shard %q from %q to %q: %w", shard, source, target, err)
+	}
+	return stats, nil
+}
+
+func (r *Replicator) repairReplica(ctx context.Context, shard, local, remote string,
+	height int, th *Throttle, deletes bool, stats *RepairStats,
+) error {
+	leaves, err := r.diffHashTrees(ctx, shard, local, remote, height)
+	if err != nil {
+		return err
+	}
+	stats.Leaves += len(leaves)
+
+	for i := 0; i < len(leaves); i += leavesPerRequest {
+		end := i + leavesPerRequest
+		if end > len(leaves) {
+			end = len(leaves)
+		}
+		if err := r.repairLeaves(ctx, shard, local, remote, height,
+			leaves[i:end], th, deletes, stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffHashTrees compares the hash trees of two replicas level by level and
+// returns the leaves which differ
+func (r *Replicator) diffHashTrees(ctx context.Context, shard, local, remote string,
+	height int,
+) ([]int, error) {
+	nodes := []int{0}
+	for level := 0; ; level++ {
+		req := HashTreeRequest{Height: height, Level: level, Nodes: nodes}
+		mine, err := r.client.HashTreeLevel(ctx, local, r.class, shard, req)
+		if err != nil {
+			return nil, fmt.Errorf("local hash tree: %w", err)
+		}
+		theirs, err := r.client.HashTreeLevel(ctx, remote, r.class, shard, req)
+		if err != nil {
+			return nil, fmt.Errorf("remote hash tree: %w", err)
+		}
+		if len(mine) != len(nodes) || len(theirs) != len(nodes) {
+			return nil, fmt.Errorf("malformed hash tree response: length expected %d got %d and %d",
+				len(nodes), len(mine), len(theirs))
+		}
+
+		diff := make([]int, 0, len(nodes))
+		for i, n := range nodes {
+			if mine[i] != theirs[i] {
+				diff = append(diff, n)
+			}
+		}
+		if len(diff) == 0 || level == height {
+			return diff, nil
+		}
+
+		nodes = make([]int, 0, 2*len(diff))
+		for _, n := range diff {
+			nodes = append(nodes, 2*n, 2*n+1)
+		}
+	}
+}
+
+// repairLeaves copies the objects of the given leaves which are missing or
+// outdated on the remote replica. If deletes is set, objects which were
+// deleted locally are deleted on the remote replica as well.
+func (r *Replicator) repairLeaves(ctx context.Context, shard, local, remote string,
+	height int, leaves []int, th *Throttle, deletes bool, stats *RepairStats,
+) error {
+	req := HashTreeRequest{Height: height, Level: height, Nodes: leaves}
+	mine, err := r.client.DigestObjectsInLeaves(ctx, local, r.class, shard, req)
+	if err != nil {
+		return fmt.Errorf("local digests: %w", err)
+	}
+	theirs, err := r.client.DigestObjectsInLeaves(ctx, remote, r.class, shard, req)
+	if err != nil {
+		return fmt.Errorf("remote digests: %w", err)
+	}
+	stats.Compared += len(mine)
+
+	remoteTimes := make(map[string]int64, len(theirs))
+	for _, x := range theirs {
+		remoteTimes[x.ID] = x.UpdateTime
+	}
+	if deletes {
+		if err := r.deleteObjects(ctx, shard, local, remote, mine, theirs, stats); err != nil {
+			return err
+		}
+	}
+
+	var (
+		ids     = make([]strfmt.UUID, 0, len(mine))
+		stale   = make([]int64, 0, len(mine))
+		missing []strfmt.UUID
+	)
+	for _, x := range mine {
+		updateTime, ok := remoteTimes[x.ID]
+		switch {
+		case !ok:
+			missing = append(missing, strfmt.UUID(x.ID))
+		case updateTime < x.UpdateTime:
+			ids = append(ids, strfmt.UUID(x.ID))
+			stale = append(stale, updateTime)
+		}
+	}
+
+	if len(missing) > 0 {
+		resp, err := r.client.DigestObjects(ctx, remote, r.class, shard, missing)
+		if err != nil {
+			return fmt.Errorf("remote digests of missing objects: %w", err)
+		}
+		if len(resp) != len(missing) {
+			return fmt.Errorf("malformed digest read response: length expected %d got %d",
+				len(missing), len(resp))
+		}
+		for i, x := range resp {
+			if x.Deleted {
+				stats.Skipped++
+				continue
+			}
+			ids = append(ids, missing[i])
+			stale = append(stale, x.UpdateTime)
+		}
+	}
+
+	for i := 0; i < len(ids); i += objectsPerRepair {
+		end := i + objectsPerRepair
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := r.copyObjects(ctx, shard, local, remote,
+			ids[i:end], stale[i:end], th, stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteObjects deletes the objects which only exist on the remote replica
+// if they were deleted locally
+func (r *Replicator) deleteObjects(ctx context.Context, shard, local, remote string,
+	mine, theirs []RepairResponse, stats *RepairStats,
+) error {
+	localIDs := make(map[string]struct{}, len(mine))
+	for _, x := range mine {
+		localIDs[x.ID] = struct{}{}
+	}
+	var extra []strfmt.UUID
+	for _, x := range theirs {
+		if _, ok := localIDs[x.ID]; !ok {
+			extra = append(extra, strfmt.UUID(x.ID))
+		}
+	}
+	if len(extra) == 0 {
+		return nil
+	}
+
+	resp, err := r.client.DigestObjects(ctx, local, r.class, shard, extra)
+	if err != nil {
+		return fmt.Errorf("local digests of remote objects: %w", err)
+	}
+	if len(resp) != len(extra) {
+		return fmt.Errorf("malformed digest read response: length expected %d got %d",
+			len(extra), len(resp))
+	}
+	for i, x := range resp {
+		if !x.Deleted {
+			continue // written to the remote replica only
+		}
+		requestID := r.requestID(opDeleteObject)
+		del, err := r.client.DeleteObject(ctx, remote, r.class, shard, requestID, extra[i])
+		if err == nil {
+			err = del.FirstError()
+		}
+		if err == nil {
+			_, err = r.simpleCommit(shard)(ctx, remote, requestID)
+		} else {
+			r.client.Abort(ctx, remote, r.class, shard, requestID)
+		}
+		if err != nil {
+			return fmt.Errorf("delete remote object %s: %w", extra[i], err)
+		}
+		stats.Deleted++
+	}
+	return nil
+}
+
+func (r *Replicator) copyObjects(ctx context.Context, shard, local, remote string,
+	ids []strfmt.UUID, stale []int64, th *Throttle, stats *RepairStats,
+) error {
+	objs, err := r.client.FetchObjects(ctx, local, r.class, shard, ids)
+	if err != nil {
+		return fmt.Errorf("read local objects: %w", err)
+	}
+
+	var size int
+	updates := make([]*objects.VObject, 0, len(objs))
+	for i, o := range objs {
+		if o.Deleted || o.Object == nil || i >= len(stale) {
+			continue // deleted locally in the meantime
+		}
+		vo := &objects.VObject{
+			LatestObject:    &o.Object.Object,
+			StaleUpdateTime: stale[i],
+		}
+		b, err := vo.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("marshal object %s: %w", o.ID, err)
+		}
+		size += len(b)
+		updates = append(updates, vo)
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if err := th.Wait(ctx, size); err != nil {
+		return err
+	}
+	resp, err := r.client.OverwriteObjects(ctx, remote, r.class, shard, updates)
+	if err != nil {
+		return fmt.Errorf("overwrite remote objects: %w", err)
+	}
+
+	failed := 0
+	for _, x := range resp {
+		if x.Err != "" {
+			failed++
+		}
+	}
+	stats.Repaired += len(updates) - failed
+	stats.Skipped += failed
+	stats.Bytes += int64(size)
+	return nil
+}
+
+// Throttle limits the number of bytes per second sent by the anti-entropy
+// repair. A nil Throttle does not limit anything.
+type Throttle struct {
+	sync.Mutex
+	bytesPerSecond int
+	next           time.Time
+}
+
+// NewThrottle returns nil if bytesPerSecond is not positive
+func NewThrottle(bytesPerSecond int) *Throttle {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &Throttle{bytesPerSecond: bytesPerSecond}
+}
+
+// Wait blocks until n more bytes may be sent
+func (t *Throttle) Wait(ctx context.Context, n int) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	delay := t.next.Sub(now)
+	t.next = t.next.Add(time.Duration(float64(n) / float64(t.bytesPerSecond) * float64(time.Second)))
+	t.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestRepairShard(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		cls    = "C1"
+		shard  = "S1"
+		nodes  = []string{"A", "B", "C"}
+		height = 1
+		idY    = strfmt.UUID("80000000-0000-0000-0000-000000000002")
+		idZ    = strfmt.UUID("80000000-0000-0000-0000-000000000003")
+		idW    = strfmt.UUID("80000000-0000-0000-0000-000000000004")
+	)
+	level := func(level int, nodes ...int) HashTreeRequest {
+		return HashTreeRequest{Height: height, Level: level, Nodes: nodes}
+	}
+
+	t.Run("replicas in sync", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes[:2])
+		f.RClient.On("HashTreeLevel", ctx, mock.Anything, cls, shard, level(0, 0)).
+			Return([]Digest{{1, 1}}, nil)
+
+		stats, err := f.newReplicator().RepairShard(ctx, shard, height, nil)
+		require.Nil(t, err)
+		assert.Equal(t, RepairStats{}, stats)
+		f.RClient.AssertNotCalled(t, "DigestObjectsInLeaves",
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("outdated and missing objects are copied", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		cl := f.RClient
+		// B is in sync, the second leaf of C differs
+		cl.On("HashTreeLevel", ctx, "A", cls, shard, level(0, 0)).Return([]Digest{{1, 1}}, nil)
+		cl.On("HashTreeLevel", ctx, "B", cls, shard, level(0, 0)).Return([]Digest{{1, 1}}, nil)
+		cl.On("HashTreeLevel", ctx, "C", cls, shard, level(0, 0)).Return([]Digest{{2, 2}}, nil)
+		cl.On("HashTreeLevel", ctx, "A", cls, shard, level(1, 0, 1)).Return([]Digest{{3, 3}, {5, 5}}, nil)
+		cl.On("HashTreeLevel", ctx, "C", cls, shard, level(1, 0, 1)).Return([]Digest{{3, 3}, {6, 6}}, nil)
+
+		cl.On("DigestObjectsInLeaves", ctx, "A", cls, shard, level(1, 1)).Return([]RepairResponse{
+			{ID: idY.String(), UpdateTime: 5},
+			{ID: idZ.String(), UpdateTime: 3},
+			{ID: idW.String(), UpdateTime: 4},
+		}, nil)
+		cl.On("DigestObjectsInLeaves", ctx, "C", cls, shard, level(1, 1)).Return([]RepairResponse{
+			{ID: idY.String(), UpdateTime: 4},
+		}, nil)
+		// W was deleted on C and must not be restored
+		cl.On("DigestObjects", ctx, "C", cls, shard, []strfmt.UUID{idZ, idW}).Return([]RepairResponse{
+			{ID: idZ.String()},
+			{ID: idW.String(), Deleted: true},
+		}, nil)
+		cl.On("FetchObjects", ctx, "A", cls, shard, []strfmt.UUID{idY, idZ}).Return([]objects.Replica{
+			replica(idY, 5, false),
+			replica(idZ, 3, false),
+		}, nil)
+		cl.On("OverwriteObjects", ctx, "C", cls, shard, mock.Anything).Return([]RepairResponse(nil), nil)
+
+		stats, err := f.newReplicator().RepairShard(ctx, shard, height, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 1, stats.Leaves)
+		assert.Equal(t, 3, stats.Compared)
+		assert.Equal(t, 2, stats.Repaired)
+		assert.Equal(t, 1, stats.Skipped)
+		assert.Greater(t, stats.Bytes, int64(0))
+
+		updates := cl.Calls[len(cl.Calls)-1].Arguments.Get(4).([]*objects.VObject)
+		require.Len(t, updates, 2)
+		assert.Equal(t, idY, updates[0].LatestObject.ID)
+		assert.Equal(t, int64(4), updates[0].StaleUpdateTime)
+		assert.Equal(t, idZ, updates[1].LatestObject.ID)
+		assert.Equal(t, int64(0), updates[1].StaleUpdateTime)
+		cl.AssertNotCalled(t, "HashTreeLevel", ctx, "B", cls, shard, level(1, 0, 1))
+	})
+
+	t.Run("other replicas are repaired if one fails", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		f.RClient.On("HashTreeLevel", ctx, "A", cls, shard, level(0, 0)).Return([]Digest{{1, 1}}, nil)
+		f.RClient.On("HashTreeLevel", ctx, "B", cls, shard, level(0, 0)).Return([]Digest(nil), errAny)
+		f.RClient.On("HashTreeLevel", ctx, "C", cls, shard, level(0, 0)).Return([]Digest{{1, 1}}, nil)
+
+		_, err := f.newReplicator().RepairShard(ctx, shard, height, nil)
+		assert.ErrorIs(t, err, errAny)
+		f.RClient.AssertCalled(t, "HashTreeLevel", ctx, "C", cls, shard, level(0, 0))
+	})
+
+	t.Run("shard not replicated on this node", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes[1:])
+		_, err := f.newReplicator().RepairShard(ctx, shard, height, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSyncShard(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cls   = "C1"
+		shard = "S1"
+		idY   = strfmt.UUID("80000000-0000-0000-0000-000000000002")
+		idZ   = strfmt.UUID("80000000-0000-0000-0000-000000000003")
+		idW   = strfmt.UUID("80000000-0000-0000-0000-000000000004")
+		req   = HashTreeRequest{Height: 0, Level: 0, Nodes: []int{0}}
+	)
+
+	// the shard is moved from B to C, neither of them is a replica yet
+	f := newFakeFactory(cls, shard, []string{"A", "B", "C"})
+	f.AddShard(shard, []string{"A"})
+	cl, wcl := f.RClient, f.WClient
+	cl.On("HashTreeLevel", ctx, "B", cls, shard, req).Return([]Digest{{1, 1}}, nil)
+	cl.On("HashTreeLevel", ctx, "C", cls, shard, req).Return([]Digest{{2, 2}}, nil)
+	cl.On("DigestObjectsInLeaves", ctx, "B", cls, shard, req).Return([]RepairResponse{
+		{ID: idY.String(), UpdateTime: 5},
+	}, nil)
+	// W was deleted on B, Z was written to C after the switch
+	cl.On("DigestObjectsInLeaves", ctx, "C", cls, shard, req).Return([]RepairResponse{
+		{ID: idY.String(), UpdateTime: 5},
+		{ID: idZ.String(), UpdateTime: 7},
+		{ID: idW.String(), UpdateTime: 3},
+	}, nil)
+	cl.On("DigestObjects", ctx, "B", cls, shard, []strfmt.UUID{idZ, idW}).Return([]RepairResponse{
+		{ID: idZ.String()},
+		{ID: idW.String(), Deleted: true},
+	}, nil)
+	wcl.On("DeleteObject", ctx, "C", cls, shard, mock.Anything, idW).Return(SimpleResponse{}, nil)
+	wcl.On("Commit", ctx, "C", cls, shard, mock.Anything, mock.Anything).Return(nil)
+
+	stats, err := f.newReplicator().SyncShard(ctx, shard, "B", "C", 0)
+	require.Nil(t, err)
+	assert.Equal(t, 1, stats.Deleted)
+	assert.Equal(t, 0, stats.Repaired)
+	wcl.AssertNotCalled(t, "DeleteObject", ctx, "C", cls, shard, mock.Anything, idZ)
+
+	_, err = f.newReplicator().SyncShard(ctx, shard, "B", "D", 0)
+	assert.ErrorIs(t, err, errUnresolvedName)
+}
+
+func TestThrottle(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, NewThrottle(0))
+	assert.Nil(t, (*Throttle)(nil).Wait(ctx, 1<<30))
+
+	th := NewThrottle(1000)
+	start := time.Now()
+	require.Nil(t, th.Wait(ctx, 50)) // first request is not delayed
+	require.Nil(t, th.Wait(ctx, 50))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// the previous request is still being paid for
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, th.Wait(ctx, 1000), context.Canceled)
+}
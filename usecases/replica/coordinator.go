@@ -14,7 +14,9 @@ package replica
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 )
@@ -37,6 +39,13 @@ type (
 		Class    string
 		Shard    string
 		TxID     string // transaction ID
+
+		// hint stores the write for the nodes which missed it, nil if
+		// hinted handoff is disabled
+		hint     func(nodes []string)
+		mu       sync.Mutex
+		hostNode map[string]string // host_address -> node_name
+		missed   []string          // nodes which missed the write
 	}
 )
 
@@ -95,6 +104,7 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 		for r := range prepare() {
 			if r.Err != nil { // connection error
 				c.log.WithField("op", "broadcast").Error(r.Err)
+				c.miss(r.Value)
 				continue
 			}
 
@@ -130,16 +140,25 @@ func (c *coordinator[T]) commitAll(ctx context.Context,
 	replyCh := make(chan _Result[T], cap(replicaCh))
 	go func() { // tells active replicas to commit
 		wg := sync.WaitGroup{}
+		var committed atomic.Int32
 		for replica := range replicaCh {
 			wg.Add(1)
 			go func(replica string) {
 				defer wg.Done()
 				resp, err := op(ctx, replica, c.TxID)
+				if err != nil {
+					c.miss(replica)
+				} else {
+					committed.Add(1)
+				}
 				replyCh <- _Result[T]{resp, err}
 			}(replica)
 		}
 		wg.Wait()
 		close(replyCh)
+		if committed.Load() > 0 {
+			c.handoff()
+		}
 	}()
 
 	return replyCh
@@ -156,10 +175,40 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
 	level := state.Level
+	c.hostNode = make(map[string]string, len(state.NodeMap))
+	for node, host := range state.NodeMap {
+		if host == "" { // unresolved nodes are down
+			c.missed = append(c.missed, node)
+		} else {
+			c.hostNode[host] = node
+		}
+	}
 	nodeCh := c.broadcast(ctx, state.Hosts, ask, level)
 	return c.commitAll(context.Background(), nodeCh, com), level, nil
 }
 
+// miss records that the replica with the given host missed the write
+func (c *coordinator[T]) miss(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if node, ok := c.hostNode[host]; ok {
+		c.missed = append(c.missed, node)
+	}
+}
+
+// handoff stores hints for the nodes which missed a write which was
+// committed by other replicas, so they can catch up once they are back
+func (c *coordinator[T]) handoff() {
+	c.mu.Lock()
+	nodes := c.missed
+	c.missed = nil
+	c.mu.Unlock()
+	if c.hint != nil && len(nodes) > 0 {
+		sort.Strings(nodes)
+		c.hint(nodes)
+	}
+}
+
 // Pull data from replica depending on consistency level
 // Pull involves just as many replicas to satisfy the consistency level.
 //
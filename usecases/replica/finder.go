@@ -22,6 +22,9 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -81,14 +84,30 @@ func (f *Finder) GetOne(ctx context.Context,
 	id strfmt.UUID,
 	props search.SelectProperties,
 	adds additional.Properties,
+) (*storobj.Object, error) {
+	ctx, span := tracing.Start(ctx, "replica.GetOne", f.spanAttributes(l, shard)...)
+	obj, err := f.getOne(ctx, l, shard, id, props, adds)
+	tracing.End(span, err)
+	return obj, err
+}
+
+func (f *Finder) getOne(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
 ) (*storobj.Object, error) {
 	c := newReadCoordinator[findOneReply](f, shard)
 	op := func(ctx context.Context, host string, fullRead bool) (findOneReply, error) {
 		if fullRead {
+			ctx, span := startRemoteRead(ctx, "replica.FullRead", host)
 			r, err := f.client.FullRead(ctx, host, f.class, shard, id, props, adds)
+			tracing.End(span, err)
 			return findOneReply{host, 0, r, r.UpdateTime(), false}, err
 		} else {
+			ctx, span := startRemoteRead(ctx, "replica.DigestRead", host)
 			xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id})
+			tracing.End(span, err)
 			var x RepairResponse
 			if len(xs) == 1 {
 				x = xs[0]
@@ -123,6 +142,12 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 	if len(xs) == 0 {
 		return nil
 	}
+	ctx, span := tracing.Start(ctx, "replica.CheckConsistency",
+		attribute.String("weaviate.class", f.class),
+		attribute.String("weaviate.consistency_level", string(l)),
+		attribute.Int("weaviate.objects", len(xs)))
+	defer func() { tracing.End(span, retErr) }()
+
 	for i, x := range xs { // check shard and node name are set
 		if x == nil {
 			return fmt.Errorf("contains nil at object at index %d", i)
@@ -159,10 +184,23 @@ func (f *Finder) Exists(ctx context.Context,
 	l ConsistencyLevel,
 	shard string,
 	id strfmt.UUID,
+) (bool, error) {
+	ctx, span := tracing.Start(ctx, "replica.Exists", f.spanAttributes(l, shard)...)
+	exists, err := f.exists(ctx, l, shard, id)
+	tracing.End(span, err)
+	return exists, err
+}
+
+func (f *Finder) exists(ctx context.Context,
+	l ConsistencyLevel,
+	shard string,
+	id strfmt.UUID,
 ) (bool, error) {
 	c := newReadCoordinator[existReply](f, shard)
 	op := func(ctx context.Context, host string, _ bool) (existReply, error) {
+		ctx, span := startRemoteRead(ctx, "replica.DigestRead", host)
 		xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id})
+		tracing.End(span, err)
 		var x RepairResponse
 		if len(xs) == 1 {
 			x = xs[0]
@@ -212,7 +250,9 @@ func (f *Finder) checkShardConsistency(ctx context.Context,
 		if fullRead { // we already have the content
 			return batchReply{Sender: host, IsDigest: false, FullData: data}, nil
 		} else {
+			ctx, span := startRemoteRead(ctx, "replica.DigestRead", host)
 			xs, err := f.client.DigestReads(ctx, host, f.class, shard, ids)
+			tracing.End(span, err)
 			return batchReply{Sender: host, IsDigest: true, DigestData: xs}, err
 		}
 	}
@@ -224,3 +264,16 @@ func (f *Finder) checkShardConsistency(ctx context.Context,
 	result := <-f.readBatchPart(ctx, batch, ids, replyCh, state)
 	return result.Value, result.Err
 }
+
+func (f *Finder) spanAttributes(l ConsistencyLevel, shard string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("weaviate.class", f.class),
+		attribute.String("weaviate.shard", shard),
+		attribute.String("weaviate.consistency_level", string(l)),
+	}
+}
+
+// startRemoteRead starts the span of a read from the replica on host
+func startRemoteRead(ctx context.Context, name, host string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, attribute.String("net.peer.name", host))
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// MaxHashTreeHeight limits the number of leaves of a hash tree to 2^20
+const MaxHashTreeHeight = 20
+
+// Digest is the digest of a node of a HashTree
+type Digest [2]uint64
+
+// HashTree is a Merkle tree over the objects of a shard replica. Its leaves
+// partition the UUID space by the leading bits of the UUID, so the objects of
+// a leaf are stored next to each other. The digest of a leaf is the XOR of
+// the digests of its objects, which makes it independent of the order
+// objects are added in. Inner nodes hash the digests of their children.
+//
+// Two replicas are in sync if their roots are equal. Otherwise, comparing the
+// trees level by level narrows the difference down to a few leaves, whose
+// objects are then compared one by one.
+type HashTree struct {
+	height int
+	// nodes are stored level by level, the root first
+	nodes []Digest
+}
+
+func NewHashTree(height int) (*HashTree, error) {
+	if height < 0 || height > MaxHashTreeHeight {
+		return nil, fmt.Errorf("hash tree height must be between 0 and %d, got %d",
+			MaxHashTreeHeight, height)
+	}
+	return &HashTree{
+		height: height,
+		nodes:  make([]Digest, 1<<(height+1)-1),
+	}, nil
+}
+
+func (t *HashTree) Height() int {
+	return t.height
+}
+
+// AddObject adds the digest of an object to its leaf. Build must be called
+// once all objects have been added.
+func (t *HashTree) AddObject(id []byte, updateTime int64) {
+	leaf := t.leafOffset() + HashTreeLeaf(t.height, id)
+	d := objectDigest(id, updateTime)
+	t.nodes[leaf][0] ^= d[0]
+	t.nodes[leaf][1] ^= d[1]
+}
+
+// Build computes the digests of the inner nodes from the leaves
+func (t *HashTree) Build() {
+	for i := t.leafOffset() - 1; i >= 0; i-- {
+		t.nodes[i] = hashDigests(t.nodes[2*i+1], t.nodes[2*i+2])
+	}
+}
+
+// Root returns the digest of the whole tree
+func (t *HashTree) Root() Digest {
+	return t.nodes[0]
+}
+
+// Level returns the digests of the given nodes of a level, the root being
+// at level 0 and the leaves at level Height()
+func (t *HashTree) Level(level int, nodes []int) ([]Digest, error) {
+	if level < 0 || level > t.height {
+		return nil, fmt.Errorf("level %d out of range [0, %d]", level, t.height)
+	}
+	offset, width := 1<<level-1, 1<<level
+	res := make([]Digest, len(nodes))
+	for i, n := range nodes {
+		if n < 0 || n >= width {
+			return nil, fmt.Errorf("node %d out of range at level %d", n, level)
+		}
+		res[i] = t.nodes[offset+n]
+	}
+	return res, nil
+}
+
+func (t *HashTree) leafOffset() int {
+	return 1<<t.height - 1
+}
+
+// HashTreeLeaf returns the leaf of a hash tree of the given height which the
+// object with the given binary UUID belongs to
+func HashTreeLeaf(height int, id []byte) int {
+	if height == 0 {
+		return 0
+	}
+	var prefix [8]byte
+	copy(prefix[:], id)
+	return int(binary.BigEndian.Uint64(prefix[:]) >> (64 - height))
+}
+
+// HashTreeLeafStart returns the smallest binary UUID which belongs to a leaf
+func HashTreeLeafStart(height, leaf int) []byte {
+	id := make([]byte, 16)
+	if height > 0 {
+		binary.BigEndian.PutUint64(id, uint64(leaf)<<(64-height))
+	}
+	return id
+}
+
+func objectDigest(id []byte, updateTime int64) Digest {
+	buf := make([]byte, len(id)+8)
+	copy(buf, id)
+	binary.BigEndian.PutUint64(buf[len(id):], uint64(updateTime))
+	return toDigest(sha256.Sum256(buf))
+}
+
+func hashDigests(left, right Digest) Digest {
+	var buf [32]byte
+	binary.BigEndian.PutUint64(buf[0:], left[0])
+	binary.BigEndian.PutUint64(buf[8:], left[1])
+	binary.BigEndian.PutUint64(buf[16:], right[0])
+	binary.BigEndian.PutUint64(buf[24:], right[1])
+	return toDigest(sha256.Sum256(buf[:]))
+}
+
+func toDigest(sum [32]byte) Digest {
+	return Digest{
+		binary.BigEndian.Uint64(sum[0:8]),
+		binary.BigEndian.Uint64(sum[8:16]),
+	}
+}
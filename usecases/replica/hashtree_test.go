@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashTree(t *testing.T) {
+	ids := make([][]byte, 100)
+	for i := range ids {
+		ids[i], _ = uuid.New().MarshalBinary()
+	}
+	build := func(height int, ids [][]byte, updateTimes ...int64) *HashTree {
+		tree, err := NewHashTree(height)
+		require.Nil(t, err)
+		for i, id := range ids {
+			updateTime := int64(1)
+			if i < len(updateTimes) {
+				updateTime = updateTimes[i]
+			}
+			tree.AddObject(id, updateTime)
+		}
+		tree.Build()
+		return tree
+	}
+
+	t.Run("order of objects does not matter", func(t *testing.T) {
+		reversed := make([][]byte, len(ids))
+		for i, id := range ids {
+			reversed[len(ids)-1-i] = id
+		}
+		assert.Equal(t, build(6, ids).Root(), build(6, reversed).Root())
+	})
+
+	t.Run("changes are narrowed down to their leaf", func(t *testing.T) {
+		height := 6
+		a, b := build(height, ids), build(height, ids, 2)
+		assert.NotEqual(t, a.Root(), b.Root())
+
+		leaves := make([]int, 1<<height)
+		for i := range leaves {
+			leaves[i] = i
+		}
+		la, err := a.Level(height, leaves)
+		require.Nil(t, err)
+		lb, err := b.Level(height, leaves)
+		require.Nil(t, err)
+
+		var diff []int
+		for i := range leaves {
+			if la[i] != lb[i] {
+				diff = append(diff, i)
+			}
+		}
+		assert.Equal(t, []int{HashTreeLeaf(height, ids[0])}, diff)
+	})
+
+	t.Run("missing objects change the root", func(t *testing.T) {
+		assert.NotEqual(t, build(6, ids).Root(), build(6, ids[1:]).Root())
+	})
+
+	t.Run("levels are validated", func(t *testing.T) {
+		tree := build(2, ids)
+		_, err := tree.Level(3, []int{0})
+		assert.NotNil(t, err)
+		_, err = tree.Level(1, []int{2})
+		assert.NotNil(t, err)
+
+		_, err = NewHashTree(MaxHashTreeHeight + 1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("leaves", func(t *testing.T) {
+		for _, height := range []int{0, 1, 7, 12} {
+			for _, id := range ids {
+				leaf := HashTreeLeaf(height, id)
+				assert.Less(t, leaf, 1<<height)
+				assert.Equal(t, leaf, HashTreeLeaf(height, HashTreeLeafStart(height, leaf)))
+			}
+		}
+		assert.Equal(t, 0, HashTreeLeaf(4, HashTreeLeafStart(4, 0)))
+		assert.Equal(t, 15, HashTreeLeaf(4, []byte{0xff, 0xff}))
+		assert.Equal(t, []byte{0xf0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			HashTreeLeafStart(4, 15))
+	})
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// kinds of writes kept as hints. Batch deletions are not hinted since
+// they refer to objects by their doc ids, which are local to a replica.
+const (
+	HintPutObjects    = "put_objects"
+	HintMergeObject   = "merge_object"
+	HintDeleteObject  = "delete_object"
+	HintAddReferences = "add_references"
+)
+
+// Hint is a write which a replica missed because it could not be reached,
+// while the consistency level was satisfied by the other replicas. The
+// coordinator keeps it and replays it once the replica is back.
+type Hint struct {
+	Node    string `json:"node"`
+	Class   string `json:"class"`
+	Shard   string `json:"shard"`
+	Created int64  `json:"created"` // unix milliseconds
+	Op      string `json:"op"`
+
+	Objects    [][]byte                `json:"objects,omitempty"` // binary objects
+	Merge      *objects.MergeDocument  `json:"merge,omitempty"`
+	ID         strfmt.UUID             `json:"id,omitempty"`
+	References objects.BatchReferences `json:"references,omitempty"`
+}
+
+// Hinter stores hints until they are replayed
+type Hinter interface {
+	AddHints(hints []Hint) error
+}
+
+func objectsHint(objs []*storobj.Object) (Hint, error) {
+	h := Hint{Op: HintPutObjects, Objects: make([][]byte, len(objs))}
+	for i, obj := range objs {
+		data, err := obj.MarshalBinary()
+		if err != nil {
+			return h, fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+		}
+		h.Objects[i] = data
+	}
+	return h, nil
+}
+
+// hints returns the function used by the coordinator to store a hint for
+// each node which missed a write, nil if hinted handoff is disabled
+func (r *Replicator) hints(shard string, newHint func() (Hint, error)) func(nodes []string) {
+	if r.hinter == nil {
+		return nil
+	}
+	return func(nodes []string) {
+		h, err := newHint()
+		if err != nil {
+			r.log.WithField("op", "hint").WithField("class", r.class).
+				WithField("shard", shard).Error(err)
+			return
+		}
+		h.Class, h.Shard, h.Created = r.class, shard, time.Now().UnixMilli()
+		hints := make([]Hint, len(nodes))
+		for i, node := range nodes {
+			hints[i] = h
+			hints[i].Node = node
+		}
+		if err := r.hinter.AddHints(hints); err != nil {
+			r.log.WithField("op", "hint").WithField("class", r.class).
+				WithField("shard", shard).WithField("nodes", nodes).Error(err)
+		}
+	}
+}
+
+// ReplayHint sends a write which a replica missed to it again. An error
+// of type *Error means that the replica rejected the write, any other
+// error that it is still unreachable.
+func (r *Replicator) ReplayHint(ctx context.Context, h Hint) error {
+	host, ok := r.resolver.NodeHostname(h.Node)
+	if !ok || host == "" {
+		return fmt.Errorf("%w %q", errUnresolvedName, h.Node)
+	}
+
+	var (
+		requestID string
+		resp      SimpleResponse
+		err       error
+	)
+	switch h.Op {
+	case HintPutObjects:
+		objs := make([]*storobj.Object, len(h.Objects))
+		for i, data := range h.Objects {
+			if objs[i], err = storobj.FromBinary(data); err != nil {
+				return NewError(StatusPreconditionFailed, fmt.Sprintf("unmarshal object: %v", err))
+			}
+		}
+		requestID = r.requestID(opPutObjects)
+		resp, err = r.client.PutObjects(ctx, host, r.class, h.Shard, requestID, objs)
+	case HintMergeObject:
+		requestID = r.requestID(opMergeObject)
+		resp, err = r.client.MergeObject(ctx, host, r.class, h.Shard, requestID, h.Merge)
+	case HintDeleteObject:
+		requestID = r.requestID(opDeleteObject)
+		resp, err = r.client.DeleteObject(ctx, host, r.class, h.Shard, requestID, h.ID)
+	case HintAddReferences:
+		requestID = r.requestID(opAddReferences)
+		resp, err = r.client.AddReferences(ctx, host, r.class, h.Shard, requestID, h.References)
+	default:
+		return NewError(StatusPreconditionFailed, fmt.Sprintf("unknown hint %q", h.Op))
+	}
+	if err == nil {
+		err = resp.FirstError()
+	}
+	if err != nil {
+		r.client.Abort(ctx, host, r.class, h.Shard, requestID)
+		return fmt.Errorf("%q: %w", host, err)
+	}
+
+	_, err = r.simpleCommit(h.Shard)(ctx, host, requestID)
+	return err
+}
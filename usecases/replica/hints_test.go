@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+type fakeHinter struct {
+	sync.Mutex
+	hints []Hint
+}
+
+func (f *fakeHinter) AddHints(hints []Hint) error {
+	f.Lock()
+	defer f.Unlock()
+	f.hints = append(f.hints, hints...)
+	return nil
+}
+
+func (f *fakeHinter) get() []Hint {
+	f.Lock()
+	defer f.Unlock()
+	return f.hints
+}
+
+func TestReplicatorHints(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		id    = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		obj   = storobj.FromObject(&models.Object{ID: id, Class: cls}, []float32{1, 2})
+		resp  = SimpleResponse{}
+	)
+
+	t.Run("unreachable replica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		hinter := &fakeHinter{}
+		f.Hinter = hinter
+		f.WClient.On("PutObject", ctx, "A", cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("PutObject", ctx, "B", cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("PutObject", ctx, "C", cls, shard, anyVal, obj).Return(resp, errAny)
+		f.WClient.On("Commit", ctx, "A", cls, shard, anyVal, anyVal).Return(nil)
+		f.WClient.On("Commit", ctx, "B", cls, shard, anyVal, anyVal).Return(nil)
+
+		require.Nil(t, f.newReplicator().PutObject(ctx, shard, obj, Quorum))
+		assert.Eventually(t, func() bool { return len(hinter.get()) == 1 },
+			time.Second, time.Millisecond)
+		h := hinter.get()[0]
+		assert.Equal(t, "C", h.Node)
+		assert.Equal(t, cls, h.Class)
+		assert.Equal(t, shard, h.Shard)
+		assert.Equal(t, HintPutObjects, h.Op)
+		require.Len(t, h.Objects, 1)
+		got, err := storobj.FromBinary(h.Objects[0])
+		require.Nil(t, err)
+		assert.Equal(t, id, got.ID())
+	})
+
+	t.Run("unresolved and failed commits", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes[:2])
+		f.AddShard(shard, nodes)
+		hinter := &fakeHinter{}
+		f.Hinter = hinter
+		f.WClient.On("DeleteObject", ctx, "A", cls, shard, anyVal, id).Return(resp, nil)
+		f.WClient.On("DeleteObject", ctx, "B", cls, shard, anyVal, id).Return(resp, nil)
+		f.WClient.On("Commit", ctx, "A", cls, shard, anyVal, anyVal).Return(nil)
+		f.WClient.On("Commit", ctx, "B", cls, shard, anyVal, anyVal).Return(errAny)
+
+		assert.Nil(t, f.newReplicator().DeleteObject(ctx, shard, id, One))
+		assert.Eventually(t, func() bool { return len(hinter.get()) == 2 },
+			time.Second, time.Millisecond)
+		hints := hinter.get()
+		assert.Equal(t, "B", hints[0].Node)
+		assert.Equal(t, "C", hints[1].Node)
+		assert.Equal(t, HintDeleteObject, hints[0].Op)
+		assert.Equal(t, id, hints[0].ID)
+	})
+
+	t.Run("no hints for failed writes", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		hinter := &fakeHinter{}
+		f.Hinter = hinter
+		f.WClient.On("PutObject", ctx, "A", cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("PutObject", ctx, "B", cls, shard, anyVal, obj).Return(resp, errAny)
+		f.WClient.On("PutObject", ctx, "C", cls, shard, anyVal, obj).Return(resp, errAny)
+		for _, n := range nodes {
+			f.WClient.On("Abort", ctx, n, cls, shard, anyVal).Return(resp, nil)
+		}
+
+		assert.NotNil(t, f.newReplicator().PutObject(ctx, shard, obj, Quorum))
+		assert.Empty(t, hinter.get())
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h, err := objectsHint([]*storobj.Object{obj})
+		require.Nil(t, err)
+		h.Node, h.Class, h.Shard = "C", cls, shard
+		f.WClient.On("PutObjects", ctx, "C", cls, shard, anyVal, anyVal).Return(resp, nil)
+		f.WClient.On("Commit", ctx, "C", cls, shard, anyVal, anyVal).Return(nil)
+
+		require.Nil(t, f.newReplicator().ReplayHint(ctx, h))
+		objs := f.WClient.Calls[0].Arguments.Get(5).([]*storobj.Object)
+		require.Len(t, objs, 1)
+		assert.Equal(t, id, objs[0].ID())
+	})
+
+	t.Run("replay rejected", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := Hint{Node: "C", Class: cls, Shard: shard, Op: HintDeleteObject, ID: id}
+		rejected := SimpleResponse{[]Error{{Code: StatusShardNotFound}}}
+		f.WClient.On("DeleteObject", ctx, "C", cls, shard, anyVal, id).Return(rejected, nil)
+		f.WClient.On("Abort", ctx, "C", cls, shard, anyVal).Return(resp, nil)
+
+		err := f.newReplicator().ReplayHint(ctx, h)
+		var rerr *Error
+		assert.ErrorAs(t, err, &rerr)
+	})
+
+	t.Run("replay to unresolved node", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes[:2])
+		h := Hint{Node: "C", Class: cls, Shard: shard, Op: HintDeleteObject, ID: id}
+		err := f.newReplicator().ReplayHint(ctx, h)
+		assert.ErrorIs(t, err, errUnresolvedName)
+	})
+}
@@ -56,6 +56,20 @@ func (f *fakeRClient) DigestObjects(ctx context.Context, host, index, shard stri
 	return args.Get(0).([]RepairResponse), args.Error(1)
 }
 
+func (f *fakeRClient) HashTreeLevel(ctx context.Context, host, index, shard string,
+	req HashTreeRequest,
+) ([]Digest, error) {
+	args := f.Called(ctx, host, index, shard, req)
+	return args.Get(0).([]Digest), args.Error(1)
+}
+
+func (f *fakeRClient) DigestObjectsInLeaves(ctx context.Context, host, index, shard string,
+	req HashTreeRequest,
+) ([]RepairResponse, error) {
+	args := f.Called(ctx, host, index, shard, req)
+	return args.Get(0).([]RepairResponse), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
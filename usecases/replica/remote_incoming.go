@@ -46,6 +46,10 @@ type RemoteIncomingRepo interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		req HashTreeRequest) ([]Digest, error)
+	DigestObjectsInLeaves(ctx context.Context, class, shardName string,
+		req HashTreeRequest) ([]RepairResponse, error)
 }
 
 type RemoteReplicaIncoming struct {
@@ -129,3 +133,15 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 ) (result []RepairResponse, err error) {
 	return rri.repo.DigestObjects(ctx, indexName, shardName, ids)
 }
+
+func (rri *RemoteReplicaIncoming) HashTreeLevel(ctx context.Context,
+	indexName, shardName string, req HashTreeRequest,
+) ([]Digest, error) {
+	return rri.repo.HashTreeLevel(ctx, indexName, shardName, req)
+}
+
+func (rri *RemoteReplicaIncoming) DigestObjectsInLeaves(ctx context.Context,
+	indexName, shardName string, req HashTreeRequest,
+) ([]RepairResponse, error) {
+	return rri.repo.DigestObjectsInLeaves(ctx, indexName, shardName, req)
+}
@@ -61,6 +61,7 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	hinter         Hinter // nil if hinted handoff is disabled
 	*Finder
 }
 
@@ -68,6 +69,7 @@ func NewReplicator(className string,
 	stateGetter shardingState,
 	nodeResolver nodeResolver,
 	client Client,
+	hinter Hinter,
 	l logrus.FieldLogger,
 ) *Replicator {
 	resolver := &resolver{
@@ -81,6 +83,7 @@ func NewReplicator(className string,
 		stateGetter: stateGetter,
 		client:      client,
 		resolver:    resolver,
+		hinter:      hinter,
 		log:         l,
 		Finder:      NewFinder(className, resolver, client, l),
 	}
@@ -92,6 +95,9 @@ func (r *Replicator) PutObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	coord.hint = r.hints(shard, func() (Hint, error) {
+		return objectsHint([]*storobj.Object{obj})
+	})
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj)
 		if err == nil {
@@ -123,6 +129,9 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	coord.hint = r.hints(shard, func() (Hint, error) {
+		return Hint{Op: HintMergeObject, Merge: doc}, nil
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc)
 		if err == nil {
@@ -153,6 +162,9 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	coord.hint = r.hints(shard, func() (Hint, error) {
+		return Hint{Op: HintDeleteObject, ID: id}, nil
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id)
 		if err == nil {
@@ -183,6 +195,9 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	l ConsistencyLevel,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjects), r.log)
+	coord.hint = r.hints(shard, func() (Hint, error) {
+		return objectsHint(objs)
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs)
 		if err == nil {
@@ -268,6 +283,9 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	l ConsistencyLevel,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opAddReferences), r.log)
+	coord.hint = r.hints(shard, func() (Hint, error) {
+		return Hint{Op: HintAddReferences, References: refs}, nil
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.AddReferences(ctx, host, r.class, shard, requestID, refs)
 		if err == nil {
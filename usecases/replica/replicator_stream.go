@@ -97,6 +97,10 @@ func (replicatorStream) flattenErrors(batchSize int,
 		for i, err := range resp.Errors {
 			if !err.Empty() && errs[i] == nil {
 				errs[i] = err.Clone()
+				if err.Code == StatusVersionConflict {
+					// let the caller tell a failed precondition apart
+					errs[i] = objects.NewErrConflict("%s", err.Msg)
+				}
 			}
 		}
 	}
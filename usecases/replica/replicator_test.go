@@ -187,6 +187,22 @@ func TestReplicatorPutObject(t *testing.T) {
 		err := rep.PutObject(ctx, shard, obj, All)
 		assert.ErrorIs(t, err, errAny)
 	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		rep := f.newReplicator()
+		resp := SimpleResponse{}
+		for _, n := range nodes {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
+				resp := a[5].(*SimpleResponse)
+				*resp = SimpleResponse{Errors: []Error{{Code: StatusVersionConflict, Msg: "outdated"}}}
+			}
+		}
+
+		err := rep.PutObject(ctx, shard, obj, All)
+		assert.True(t, errors.As(err, &objects.ErrConflict{}), "got %v", err)
+	})
 }
 
 func TestReplicatorMergeObject(t *testing.T) {
@@ -677,6 +693,7 @@ type fakeFactory struct {
 	Shard2replicas map[string][]string
 	WClient        *fakeClient
 	RClient        *fakeRClient
+	Hinter         Hinter
 	log            *logrus.Logger
 	hook           *test.Hook
 }
@@ -709,7 +726,7 @@ func (f fakeFactory) newReplicator() *Replicator {
 		struct {
 			rClient
 			wClient
-		}{f.RClient, f.WClient}, f.log)
+		}{f.RClient, f.WClient}, f.Hinter, f.log)
 }
 
 func (f fakeFactory) newFinder(thisNode string) *Finder {
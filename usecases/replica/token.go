@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// A consistency token gives a client read-your-writes semantics without
+// reading at consistency level ALL. Writes return a token naming the
+// written objects and the time of the write, and queries passing the token
+// are only served by replicas which have applied these writes.
+//
+// Replicas apply all objects of a shard written by one request together,
+// so a token holds one object per shard and request. The time of an object
+// is the time of its last update, of a deletion the time it was deleted.
+
+// MaxTokenWrites bounds the size of a token combined from the tokens of
+// several requests. The oldest writes are dropped first.
+const MaxTokenWrites = 64
+
+var ErrWritesNotApplied = errors.New("no replica has applied the writes of the consistency token yet, retry later")
+
+type TokenWrite struct {
+	Class      string      `json:"c"`
+	Shard      string      `json:"s"`
+	ID         strfmt.UUID `json:"i"`
+	UpdateTime int64       `json:"t"`
+	Deleted    bool        `json:"d,omitempty"`
+}
+
+// appliedBy reports whether a replica whose current state of the object is
+// r has applied the write. A put is superseded by a later deletion, a
+// deletion by a later put.
+func (w TokenWrite) appliedBy(r RepairResponse) bool {
+	if w.Deleted {
+		return r.UpdateTime == 0 || r.UpdateTime > w.UpdateTime
+	}
+	return r.Deleted || r.UpdateTime >= w.UpdateTime
+}
+
+type Token struct {
+	Writes []TokenWrite `json:"w"`
+}
+
+// ParseToken parses a token as returned by Encode
+func ParseToken(s string) (Token, error) {
+	var t Token
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, fmt.Errorf("invalid consistency token: %w", err)
+	}
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return t, fmt.Errorf("invalid consistency token: %w", err)
+	}
+	return t, nil
+}
+
+func (t Token) Encode() string {
+	raw, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (t Token) Empty() bool {
+	return len(t.Writes) == 0
+}
+
+// Merge combines the writes of two tokens, e.g. of two requests of the same
+// client
+func (t Token) Merge(other Token) Token {
+	writes := append(append([]TokenWrite{}, t.Writes...), other.Writes...)
+	if len(writes) > MaxTokenWrites {
+		sort.SliceStable(writes, func(a, b int) bool {
+			return writes[a].UpdateTime > writes[b].UpdateTime
+		})
+		writes = writes[:MaxTokenWrites]
+	}
+	return Token{Writes: writes}
+}
+
+// ForShard returns the writes to a shard of a class
+func (t Token) ForShard(class, shard string) []TokenWrite {
+	var out []TokenWrite
+	for _, w := range t.Writes {
+		if w.Class == class && w.Shard == shard {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// TokenRecorder collects the writes of a request
+type TokenRecorder struct {
+	mu     sync.Mutex
+	writes []TokenWrite
+}
+
+// add keeps the most recent write per shard, as the request replicated all
+// objects of a shard together
+func (r *TokenRecorder) add(w TokenWrite) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, prev := range r.writes {
+		if prev.Class == w.Class && prev.Shard == w.Shard {
+			if w.UpdateTime >= prev.UpdateTime {
+				r.writes[i] = w
+			}
+			return
+		}
+	}
+	r.writes = append(r.writes, w)
+}
+
+// Token returns the token of the recorded writes, or false if nothing was
+// written
+func (r *TokenRecorder) Token() (Token, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.writes) == 0 {
+		return Token{}, false
+	}
+	return Token{Writes: append([]TokenWrite{}, r.writes...)}, true
+}
+
+type (
+	tokenRecorderKey struct{}
+	tokenKey         struct{}
+)
+
+// WithTokenRecorder records the replicated writes performed with ctx
+func WithTokenRecorder(ctx context.Context) (context.Context, *TokenRecorder) {
+	r := &TokenRecorder{}
+	return context.WithValue(ctx, tokenRecorderKey{}, r), r
+}
+
+// RecordWrite adds a write to the recorder of ctx, if any
+func RecordWrite(ctx context.Context, w TokenWrite) {
+	if r, ok := ctx.Value(tokenRecorderKey{}).(*TokenRecorder); ok {
+		r.add(w)
+	}
+}
+
+// WithToken makes the searches performed with ctx read the writes of the
+// token
+func WithToken(ctx context.Context, t Token) context.Context {
+	return context.WithValue(ctx, tokenKey{}, t)
+}
+
+// TokenFromContext returns the token set with WithToken, if any
+func TokenFromContext(ctx context.Context) (Token, bool) {
+	t, ok := ctx.Value(tokenKey{}).(Token)
+	return t, ok && !t.Empty()
+}
+
+// ReplicaWithWrites returns the name of a node whose replica of the shard
+// has applied all writes, preferring the local node. It returns
+// ErrWritesNotApplied if no reachable replica has applied them.
+func (f *Finder) ReplicaWithWrites(ctx context.Context, shard string,
+	writes []TokenWrite,
+) (string, error) {
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(nodes))
+	for name, host := range nodes {
+		if name != "" && host != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(a, b int) bool {
+		if local := f.resolver.NodeName; names[a] == local || names[b] == local {
+			return names[a] == local
+		}
+		return names[a] < names[b]
+	})
+
+	ids := make([]strfmt.UUID, len(writes))
+	for i, w := range writes {
+		ids[i] = w.ID
+	}
+
+	for _, name := range names {
+		digests, err := f.client.DigestReads(ctx, nodes[name], f.class, shard, ids)
+		if err != nil {
+			f.log.WithField("op", "consistency_token").WithField("shard", shard).
+				WithField("node", name).Debug(err)
+			continue
+		}
+		if appliedAll(writes, digests) {
+			return name, nil
+		}
+	}
+	return "", ErrWritesNotApplied
+}
+
+func appliedAll(writes []TokenWrite, digests []RepairResponse) bool {
+	for i, w := range writes {
+		if !w.appliedBy(digests[i]) {
+			return false
+		}
+	}
+	return true
+}
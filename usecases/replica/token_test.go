@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	t.Run("encode and parse", func(t *testing.T) {
+		token := Token{Writes: []TokenWrite{
+			{Class: "C", Shard: "S1", ID: "8c29da7a-600a-43dc-85fb-83ab2b08c294", UpdateTime: 10},
+			{Class: "C", Shard: "S2", ID: "4d2ae3b8-2a4a-4f4a-b5b6-0a1c7fd9e2a1", UpdateTime: 20, Deleted: true},
+		}}
+		parsed, err := ParseToken(token.Encode())
+		require.Nil(t, err)
+		assert.Equal(t, token, parsed)
+
+		_, err = ParseToken("not a token")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("recorder keeps the latest write per shard", func(t *testing.T) {
+		ctx, recorder := WithTokenRecorder(context.Background())
+		_, ok := recorder.Token()
+		assert.False(t, ok)
+
+		RecordWrite(ctx, TokenWrite{Class: "C", Shard: "S1", ID: "a", UpdateTime: 10})
+		RecordWrite(ctx, TokenWrite{Class: "C", Shard: "S1", ID: "b", UpdateTime: 12})
+		RecordWrite(ctx, TokenWrite{Class: "C", Shard: "S1", ID: "c", UpdateTime: 11})
+		RecordWrite(ctx, TokenWrite{Class: "C", Shard: "S2", ID: "d", UpdateTime: 5})
+		// writes without a recorder are ignored
+		RecordWrite(context.Background(), TokenWrite{Class: "C", Shard: "S3"})
+
+		token, ok := recorder.Token()
+		require.True(t, ok)
+		assert.Equal(t, []TokenWrite{
+			{Class: "C", Shard: "S1", ID: "b", UpdateTime: 12},
+			{Class: "C", Shard: "S2", ID: "d", UpdateTime: 5},
+		}, token.Writes)
+		assert.Len(t, token.ForShard("C", "S1"), 1)
+		assert.Empty(t, token.ForShard("Other", "S1"))
+	})
+
+	t.Run("merge drops the oldest writes", func(t *testing.T) {
+		var a, b Token
+		for i := 0; i < MaxTokenWrites; i++ {
+			a.Writes = append(a.Writes, TokenWrite{Class: "C", Shard: "S", UpdateTime: int64(i)})
+		}
+		b.Writes = []TokenWrite{{Class: "C", Shard: "S", UpdateTime: 1000}}
+
+		merged := a.Merge(b)
+		require.Len(t, merged.Writes, MaxTokenWrites)
+		assert.Equal(t, int64(1000), merged.Writes[0].UpdateTime)
+		assert.Equal(t, int64(1), merged.Writes[MaxTokenWrites-1].UpdateTime)
+	})
+
+	t.Run("applied writes", func(t *testing.T) {
+		put := TokenWrite{UpdateTime: 10}
+		assert.True(t, put.appliedBy(RepairResponse{UpdateTime: 10}))
+		assert.True(t, put.appliedBy(RepairResponse{UpdateTime: 11}))
+		assert.True(t, put.appliedBy(RepairResponse{Deleted: true}))
+		assert.False(t, put.appliedBy(RepairResponse{UpdateTime: 9}))
+		assert.False(t, put.appliedBy(RepairResponse{}))
+
+		del := TokenWrite{UpdateTime: 10, Deleted: true}
+		assert.True(t, del.appliedBy(RepairResponse{Deleted: true}))
+		assert.True(t, del.appliedBy(RepairResponse{}))
+		assert.True(t, del.appliedBy(RepairResponse{UpdateTime: 11}))
+		assert.False(t, del.appliedBy(RepairResponse{UpdateTime: 9}))
+	})
+}
+
+func TestFinderReplicaWithWrites(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		cls    = "C1"
+		shard  = "SH1"
+		nodes  = []string{"A", "B", "C"}
+		id     = strfmt.UUID("123")
+		writes = []TokenWrite{{Class: cls, Shard: shard, ID: id, UpdateTime: 10}}
+		ids    = []strfmt.UUID{id}
+		stale  = []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+		fresh  = []RepairResponse{{ID: id.String(), UpdateTime: 10}}
+	)
+
+	t.Run("local replica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		f.RClient.On("DigestObjects", mock.Anything, "B", cls, shard, ids).Return(fresh, nil)
+
+		node, err := f.newFinder("B").ReplicaWithWrites(ctx, shard, writes)
+		require.Nil(t, err)
+		assert.Equal(t, "B", node)
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 1)
+	})
+
+	t.Run("other replica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		f.RClient.On("DigestObjects", mock.Anything, "A", cls, shard, ids).Return(stale, nil)
+		f.RClient.On("DigestObjects", mock.Anything, "B", cls, shard, ids).
+			Return([]RepairResponse(nil), errors.New("unreachable"))
+		f.RClient.On("DigestObjects", mock.Anything, "C", cls, shard, ids).Return(fresh, nil)
+
+		node, err := f.newFinder("A").ReplicaWithWrites(ctx, shard, writes)
+		require.Nil(t, err)
+		assert.Equal(t, "C", node)
+	})
+
+	t.Run("no replica applied the writes", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		f.RClient.On("DigestObjects", mock.Anything, mock.Anything, cls, shard, ids).Return(stale, nil)
+
+		_, err := f.newFinder("A").ReplicaWithWrites(ctx, shard, writes)
+		assert.ErrorIs(t, err, ErrWritesNotApplied)
+	})
+}
@@ -46,6 +46,7 @@ const (
 	StatusConflict = iota + 300
 	StatusPreconditionFailed
 	StatusReadOnly
+	StatusVersionConflict
 )
 
 // Error reports error happing during replication
@@ -102,6 +103,8 @@ func statusText(code StatusCode) string {
 		return "local index not ready"
 	case StatusReadOnly:
 		return "read only"
+	case StatusVersionConflict:
+		return "version conflict"
 	default:
 		return ""
 	}
@@ -202,6 +205,24 @@ type rClient interface {
 	// object
 	DigestObjects(ctx context.Context, host, index, shard string,
 		ids []strfmt.UUID) ([]RepairResponse, error)
+
+	// HashTreeLevel returns the digests of the requested nodes of one level
+	// of the hash tree of a shard. It is used by the anti-entropy repair.
+	HashTreeLevel(ctx context.Context, host, index, shard string,
+		req HashTreeRequest) ([]Digest, error)
+
+	// DigestObjectsInLeaves returns the digests of all objects of a shard
+	// which belong to the requested leaves of its hash tree
+	DigestObjectsInLeaves(ctx context.Context, host, index, shard string,
+		req HashTreeRequest) ([]RepairResponse, error)
+}
+
+// HashTreeRequest identifies nodes of the hash tree of a shard. Leaves are
+// requested at level Height.
+type HashTreeRequest struct {
+	Height int   `json:"height"`
+	Level  int   `json:"level"`
+	Nodes  []int `json:"nodes"`
 }
 
 // finderClient extends RClient with consistency checks
@@ -14,7 +14,9 @@ package scaler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"sort"
 
 	"github.com/sirupsen/logrus"
@@ -40,6 +42,8 @@ type fakeFactory struct {
 	NodeHostMap   map[string]string
 	Source        *fakeSource
 	Client        *fakeClient
+	Syncer        *fakeSyncer
+	Splitter      *fakeSplitter
 	logger        logrus.FieldLogger
 }
 
@@ -60,6 +64,8 @@ func newFakeFactory() *fakeFactory {
 		NodeHostMap: nodeHostMap,
 		Source:      &fakeSource{},
 		Client:      &fakeClient{},
+		Syncer:      &fakeSyncer{},
+		Splitter:    &fakeSplitter{},
 		logger:      logger,
 	}
 }
@@ -69,6 +75,8 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 	scaler := New(
 		nodeResolver,
 		f.Source,
+		f.Syncer,
+		f.Splitter,
 		f.Client,
 		f.logger,
 		dataPath)
@@ -77,8 +85,10 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 }
 
 type fakeShardingState struct {
-	LocalNode string
-	M         map[string][]string
+	LocalNode          string
+	M                  map[string][]string
+	VirtualPerPhysical int
+	Pending            *sharding.PendingSplit
 }
 
 func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
@@ -88,9 +98,32 @@ func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
 	state := sharding.State{}
 	state.Physical = make(map[string]sharding.Physical)
 	for shard, nodes := range f.M {
-		state.Physical[shard] = sharding.Physical{BelongsToNodes: nodes}
+		state.Physical[shard] = sharding.Physical{Name: shard, BelongsToNodes: nodes}
 	}
 	state.SetLocalName(f.LocalNode)
+	state.PendingSplit = f.Pending.DeepCopy()
+	if f.VirtualPerPhysical > 0 {
+		shards := make([]string, 0, len(f.M))
+		for shard := range f.M {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+		n := f.VirtualPerPhysical * len(shards)
+		for i := 0; i < n; i++ {
+			shard := shards[i%len(shards)]
+			v := sharding.Virtual{
+				Name:               fmt.Sprintf("v%d", i),
+				Upper:              uint64(i+1) * (math.MaxUint64 / uint64(n)),
+				OwnsPercentage:     1 / float64(n),
+				AssignedToPhysical: shard,
+			}
+			state.Virtual = append(state.Virtual, v)
+			p := state.Physical[shard]
+			p.OwnsVirtual = append(p.OwnsVirtual, v.Name)
+			p.OwnsPercentage += v.OwnsPercentage
+			state.Physical[shard] = p
+		}
+	}
 	return &state
 }
 
@@ -172,9 +205,34 @@ func (f *fakeClient) ReInitShard(ctx context.Context, host, class, shard string,
 	return args.Error(0)
 }
 
+func (f *fakeClient) SplitShards(ctx context.Context,
+	host, class string, split sharding.Split,
+) error {
+	args := f.Called(ctx, host, class, split)
+	return args.Error(0)
+}
+
 func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	host, class string, dist ShardDist,
 ) error {
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+type fakeSyncer struct {
+	mock.Mock
+}
+
+func (f *fakeSyncer) SyncShard(ctx context.Context, class, shard, source, target string) (int, error) {
+	args := f.Called(ctx, class, shard, source, target)
+	return args.Int(0), args.Error(1)
+}
+
+type fakeSplitter struct {
+	mock.Mock
+}
+
+func (f *fakeSplitter) SplitShards(ctx context.Context, class string, split sharding.Split) error {
+	args := f.Called(ctx, class, split)
+	return args.Error(0)
+}
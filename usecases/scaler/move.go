@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	// maxCatchUpRounds limits the number of times the copy of a moved shard
+	// is synced with its source before its ownership is switched
+	maxCatchUpRounds = 5
+	// catchUpThreshold is the number of objects changed by a sync round
+	// below which the copy is considered to have caught up with the source
+	catchUpThreshold = 100
+)
+
+// ShardSyncer syncs the objects of a shard replica with another replica
+type ShardSyncer interface {
+	// SyncShard makes the target replica of a shard equal to the source one
+	// and returns the number of objects changed on the target
+	SyncShard(ctx context.Context, class, shard, source, target string) (int, error)
+}
+
+// MoveShard moves a shard from the source to the target node:
+//
+//   - The shard is copied from a snapshot on the source node to the target
+//   - Writes which happened in the meantime are synced to the copy until
+//     only a few are left
+//   - The target replaces the source in the sharding state, so that new
+//     writes reach it. The source is kept as an additional replica.
+//   - The writes during the switch are synced, then the source is removed
+//
+// update is called with each new sharding state and must broadcast it to
+// the cluster.
+func (s *Scaler) MoveShard(ctx context.Context, className, shard, source, target string,
+	update func(*sharding.State) error,
+) error {
+	ss := s.schema.CopyShardingState(className)
+	if ss == nil {
+		return fmt.Errorf("no sharding state for class %q", className)
+	}
+	if err := s.validateMove(ss, shard, source, target); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	dist := ShardDist{shard: {target}}
+	if source == s.cluster.LocalName() {
+		if err := s.LocalScaleOut(ctx, className, dist); err != nil {
+			return fmt.Errorf("copy shard %q to node %q: %w", shard, target, err)
+		}
+	} else {
+		host, ok := s.cluster.NodeHostname(source)
+		if !ok {
+			return fmt.Errorf("%w, %q", ErrUnresolvedName, source)
+		}
+		if err := s.client.IncreaseReplicationFactor(ctx, host, className, dist); err != nil {
+			return fmt.Errorf("copy shard %q from node %q to node %q: %w", shard, source, target, err)
+		}
+	}
+
+	for i := 0; i < maxCatchUpRounds; i++ {
+		n, err := s.syncer.SyncShard(ctx, className, shard, source, target)
+		if err != nil {
+			return fmt.Errorf("catch up shard %q: %w", shard, err)
+		}
+		if n < catchUpThreshold {
+			break
+		}
+	}
+
+	// switch ownership: the target takes the place of the source
+	switched := ss.DeepCopy()
+	physical := switched.Physical[shard]
+	nodes := make([]string, 0, len(physical.BelongsToNodes)+1)
+	for _, node := range physical.BelongsToNodes {
+		if node == source {
+			node = target
+		}
+		nodes = append(nodes, node)
+	}
+	physical.BelongsToNodes = append(nodes, source)
+	switched.Physical[shard] = physical
+	if err := update(&switched); err != nil {
+		return fmt.Errorf("switch ownership of shard %q: %w", shard, err)
+	}
+
+	if _, err := s.syncer.SyncShard(ctx, className, shard, source, target); err != nil {
+		return fmt.Errorf("sync shard %q after switch: %w", shard, err)
+	}
+
+	final := switched.DeepCopy()
+	physical = final.Physical[shard]
+	physical.BelongsToNodes = nodes
+	final.Physical[shard] = physical
+	if err := update(&final); err != nil {
+		return fmt.Errorf("remove shard %q from node %q: %w", shard, source, err)
+	}
+	return nil
+}
+
+func (s *Scaler) validateMove(ss *sharding.State, shard, source, target string) error {
+	physical, ok := ss.Physical[shard]
+	if !ok {
+		return fmt.Errorf("shard %q not found", shard)
+	}
+	if source == target {
+		return fmt.Errorf("source and target node are the same")
+	}
+	if !contains(physical.BelongsToNodes, source) {
+		return fmt.Errorf("shard %q does not belong to node %q", shard, source)
+	}
+	if contains(physical.BelongsToNodes, target) {
+		return fmt.Errorf("shard %q already belongs to node %q", shard, target)
+	}
+	if !contains(s.cluster.Candidates(), target) {
+		return fmt.Errorf("node %q is not part of the cluster", target)
+	}
+	return nil
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestScalerMoveShard(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "C"
+	)
+	var states [][]string
+	update := func(ss *sharding.State) error {
+		states = append(states, ss.Physical["S3"].BelongsToNodes)
+		return nil
+	}
+
+	t.Run("Validation", func(t *testing.T) {
+		scaler := newFakeFactory().Scaler("")
+		for _, tc := range []struct{ shard, source, target, err string }{
+			{"S2", "N1", "N2", "not found"},
+			{"S1", "N1", "N1", "the same"},
+			{"S1", "N2", "N3", "does not belong"},
+			{"S3", "N3", "N4", "already belongs"},
+			{"S1", "N1", "N5", "not part of the cluster"},
+		} {
+			err := scaler.MoveShard(ctx, cls, tc.shard, tc.source, tc.target, update)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	})
+
+	t.Run("CopyFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).Return(errAny)
+		err := f.Scaler("").MoveShard(ctx, cls, "S3", "N3", "N2", update)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("RemoteSource", func(t *testing.T) {
+		states = nil
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).Return(nil)
+		f.Syncer.On("SyncShard", anyVal, cls, "S3", "N3", "N2").Return(500, nil).Once()
+		f.Syncer.On("SyncShard", anyVal, cls, "S3", "N3", "N2").Return(2, nil).Once()
+		f.Syncer.On("SyncShard", anyVal, cls, "S3", "N3", "N2").Return(0, nil).Once()
+
+		require.Nil(t, f.Scaler("").MoveShard(ctx, cls, "S3", "N3", "N2", update))
+		assert.Equal(t, [][]string{{"N2", "N4", "N3"}, {"N2", "N4"}}, states)
+		f.Syncer.AssertNumberOfCalls(t, "SyncShard", 3)
+	})
+
+	t.Run("LocalSource", func(t *testing.T) {
+		dataDir := t.TempDir()
+		file, err := os.Create(path.Join(dataDir, "f1"))
+		require.Nil(t, err)
+		file.Close()
+		bak := backup.ClassDescriptor{
+			Name:   cls,
+			Shards: []backup.ShardDescriptor{{Name: "S1", Files: []string{"f1"}}},
+		}
+
+		f := newFakeFactory()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H2", cls, "S1", anyVal, anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Syncer.On("SyncShard", anyVal, cls, "S1", "N1", "N2").Return(0, nil)
+
+		var got [][]string
+		err = f.Scaler(dataDir).MoveShard(ctx, cls, "S1", "N1", "N2", func(ss *sharding.State) error {
+			got = append(got, ss.Physical["S1"].BelongsToNodes)
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, [][]string{{"N2", "N1"}, {"N2"}}, got)
+	})
+
+	t.Run("SyncFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Syncer.On("SyncShard", anyVal, cls, "S3", "N3", "N2").Return(0, errAny)
+		err := f.Scaler("").MoveShard(ctx, cls, "S3", "N3", "N2", update)
+		assert.ErrorIs(t, err, errAny)
+	})
+}
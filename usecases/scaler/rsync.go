@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -38,6 +39,8 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+	// SplitShards splits the shards of a class on the remote node
+	SplitShards(ctx context.Context, host, class string, split sharding.Split) error
 }
 
 // rsync synchronizes shards with remote nodes
@@ -45,19 +45,23 @@ var (
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          BackUpper   // data source
+	client          client      // client for remote nodes
+	syncer          ShardSyncer // syncs moved shards
+	splitter        ShardSplitter
 	logger          logrus.FieldLogger
 	persistenceRoot string
 }
 
 // New returns a new instance of Scaler
-func New(cl cluster, source BackUpper,
+func New(cl cluster, source BackUpper, syncer ShardSyncer, splitter ShardSplitter,
 	c client, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
 		cluster:         cl,
 		source:          source,
+		syncer:          syncer,
+		splitter:        splitter,
 		client:          c,
 		logger:          logger,
 		persistenceRoot: persistenceRoot,
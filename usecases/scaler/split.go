@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"golang.org/x/sync/errgroup"
+)
+
+// ShardSplitter splits local shards, see sharding.Split
+type ShardSplitter interface {
+	SplitShards(ctx context.Context, class string, split sharding.Split) error
+}
+
+// SplitShards increases the number of shards of a class to count by
+// splitting existing shards, see sharding.State.SplitShards:
+//
+//   - Each node copies the objects of its shards which belong to the new
+//     shards after the split, while the existing shards still receive all
+//     writes
+//   - The sharding state after the split is broadcast, from now on the new
+//     shards receive the writes of their objects. The state records the
+//     split as pending, so that it can be resumed with ResumeSplit.
+//   - Each node completes the split by syncing the writes which happened
+//     during the copy and deleting the moved objects from the existing shards
+//   - The state without the pending split is broadcast
+//
+// update is called with the new sharding state and must broadcast it to the
+// cluster. If the copy is interrupted, the new shards don't receive writes
+// yet and the split can simply be started again, the objects copied so far
+// are overwritten.
+func (s *Scaler) SplitShards(ctx context.Context, className string, count int,
+	update func(*sharding.State) error,
+) error {
+	ss := s.schema.CopyShardingState(className)
+	if ss == nil {
+		return fmt.Errorf("no sharding state for class %q", className)
+	}
+
+	after := ss.DeepCopy()
+	shards, err := after.SplitShards(count)
+	if err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	nodes := splitNodes(ss, shards)
+	split := sharding.Split{Shards: shards, State: after}
+	since := time.Now().UnixMilli()
+	if err := s.splitOn(ctx, nodes, className, split); err != nil {
+		return fmt.Errorf("copy objects: %w", err)
+	}
+
+	after.PendingSplit = &sharding.PendingSplit{Shards: shards, Since: since}
+	if err := update(&after); err != nil {
+		return fmt.Errorf("switch to new shards: %w", err)
+	}
+
+	split.State = after
+	split.Since = since
+	return s.completeSplit(ctx, nodes, className, split, update)
+}
+
+// ResumeSplit completes a split of the shards of a class which was
+// interrupted after the new shards started to receive writes, e.g. because
+// the coordinating node was restarted. It does nothing if no split is
+// pending. Completing a split more than once is safe.
+func (s *Scaler) ResumeSplit(ctx context.Context, className string,
+	update func(*sharding.State) error,
+) error {
+	ss := s.schema.CopyShardingState(className)
+	if ss == nil || ss.PendingSplit == nil {
+		return nil
+	}
+
+	split := sharding.Split{
+		Shards: ss.PendingSplit.Shards,
+		State:  *ss,
+		Since:  ss.PendingSplit.Since,
+	}
+	return s.completeSplit(ctx, splitNodes(ss, split.Shards), className, split, update)
+}
+
+// completeSplit completes the split on all nodes and removes the pending
+// split from the sharding state
+func (s *Scaler) completeSplit(ctx context.Context, nodes []string,
+	className string, split sharding.Split, update func(*sharding.State) error,
+) error {
+	if err := s.splitOn(ctx, nodes, className, split); err != nil {
+		return fmt.Errorf("complete split: %w", err)
+	}
+
+	done := split.State.DeepCopy()
+	done.PendingSplit = nil
+	if err := update(&done); err != nil {
+		return fmt.Errorf("record completed split: %w", err)
+	}
+	return nil
+}
+
+// splitNodes returns the nodes which hold the shards objects are moved from
+func splitNodes(ss *sharding.State, shards map[string]string) []string {
+	var nodes []string
+	for _, source := range shards {
+		for _, node := range ss.Physical[source].BelongsToNodes {
+			if !contains(nodes, node) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// splitOn runs the split on all given nodes concurrently
+func (s *Scaler) splitOn(ctx context.Context, nodes []string,
+	className string, split sharding.Split,
+) error {
+	hosts, err := hosts(nodes, s.cluster)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i, node := range nodes {
+		i, node := i, node
+		g.Go(func() error {
+			var err error
+			if node == s.cluster.LocalName() {
+				err = s.splitter.SplitShards(ctx, className, split)
+			} else {
+				err = s.client.SplitShards(ctx, hosts[i], className, split)
+			}
+			if err != nil {
+				return fmt.Errorf("split shards of class %q on node %q: %w", className, node, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// LocalSplitShards splits the local shards of a class on request of the
+// node which coordinates the split
+func (s *Scaler) LocalSplitShards(ctx context.Context, className string,
+	split sharding.Split,
+) error {
+	return s.splitter.SplitShards(ctx, className, split)
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestScalerSplitShards(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "C"
+	)
+	copying := mock.MatchedBy(func(s sharding.Split) bool { return s.Since == 0 })
+	completing := mock.MatchedBy(func(s sharding.Split) bool { return s.Since > 0 })
+
+	t.Run("Validation", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.VirtualPerPhysical = 2
+		update := func(*sharding.State) error { return nil }
+		err := f.Scaler("").SplitShards(ctx, cls, 2, update)
+		assert.ErrorContains(t, err, "greater than")
+		err = f.Scaler("").SplitShards(ctx, cls, 5, update)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("CopyFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.VirtualPerPhysical = 4
+		f.Splitter.On("SplitShards", anyVal, cls, copying).Return(nil)
+		f.Client.On("SplitShards", anyVal, "H3", cls, copying).Return(nil)
+		f.Client.On("SplitShards", anyVal, "H4", cls, copying).Return(errAny)
+		updated := false
+		err := f.Scaler("").SplitShards(ctx, cls, 4, func(*sharding.State) error {
+			updated = true
+			return nil
+		})
+		assert.ErrorIs(t, err, errAny)
+		assert.False(t, updated, "the new shards must not receive writes")
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.VirtualPerPhysical = 4
+		var states []*sharding.State
+		update := func(ss *sharding.State) error {
+			states = append(states, ss)
+			if len(states) == 1 {
+				// the split must not complete before the new shards receive writes
+				f.Splitter.AssertNotCalled(t, "SplitShards", anyVal, cls, completing)
+			}
+			return nil
+		}
+		for _, stage := range []interface{}{copying, completing} {
+			f.Splitter.On("SplitShards", anyVal, cls, stage).Return(nil).Once()
+			f.Client.On("SplitShards", anyVal, "H3", cls, stage).Return(nil).Once()
+			f.Client.On("SplitShards", anyVal, "H4", cls, stage).Return(nil).Once()
+		}
+
+		require.Nil(t, f.Scaler("").SplitShards(ctx, cls, 4, update))
+		require.Len(t, states, 2)
+		assert.Len(t, states[0].Physical, 4)
+		assert.Equal(t, 4, states[0].Config.DesiredCount)
+		require.NotNil(t, states[0].PendingSplit, "the switch must record the pending split")
+		assert.Len(t, states[0].PendingSplit.Shards, 2)
+		assert.Len(t, states[1].Physical, 4)
+		assert.Nil(t, states[1].PendingSplit)
+		f.Splitter.AssertExpectations(t)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("CompleteFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.VirtualPerPhysical = 4
+		f.Splitter.On("SplitShards", anyVal, cls, anyVal).Return(nil)
+		f.Client.On("SplitShards", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Client.On("SplitShards", anyVal, "H4", cls, completing).Return(errAny)
+		f.Client.On("SplitShards", anyVal, "H4", cls, copying).Return(nil)
+		var states []*sharding.State
+		err := f.Scaler("").SplitShards(ctx, cls, 4, func(ss *sharding.State) error {
+			states = append(states, ss)
+			return nil
+		})
+		assert.ErrorIs(t, err, errAny)
+		require.Len(t, states, 1)
+		assert.NotNil(t, states[0].PendingSplit, "the split must stay pending")
+	})
+}
+
+func TestScalerResumeSplit(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "C"
+	)
+
+	t.Run("NothingPending", func(t *testing.T) {
+		f := newFakeFactory()
+		err := f.Scaler("").ResumeSplit(ctx, cls, func(*sharding.State) error {
+			t.Fatal("unexpected update")
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.Pending = &sharding.PendingSplit{
+			Shards: map[string]string{"S5": "S3"},
+			Since:  5,
+		}
+		completing := mock.MatchedBy(func(s sharding.Split) bool {
+			return s.Since == 5 && s.Shards["S5"] == "S3"
+		})
+		f.Client.On("SplitShards", anyVal, "H3", cls, completing).Return(nil).Once()
+		f.Client.On("SplitShards", anyVal, "H4", cls, completing).Return(nil).Once()
+
+		var state *sharding.State
+		require.Nil(t, f.Scaler("").ResumeSplit(ctx, cls, func(ss *sharding.State) error {
+			state = ss
+			return nil
+		}))
+		require.NotNil(t, state)
+		assert.Nil(t, state.PendingSplit)
+		f.Client.AssertExpectations(t)
+		f.Splitter.AssertNotCalled(t, "SplitShards", anyVal, cls, anyVal)
+	})
+}
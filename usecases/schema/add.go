@@ -31,6 +31,10 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -40,11 +44,26 @@ import (
 // AddClass to the schema
 func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class,
-) error {
-	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+) (err error) {
+	defer func() {
+		event := audit.Event{Action: audit.ActionClassCreate}
+		if class != nil {
+			event.Class = class.Class
+		}
+		m.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "create", "schema/objects")
 	if err != nil {
 		return err
 	}
+	if class != nil {
+		err = m.Authorizer.Authorize(principal, "create",
+			authorization.SchemaResource(class.Class, ""))
+		if err != nil {
+			return err
+		}
+	}
 
 	shardState, err := m.addClass(ctx, class)
 	if err != nil {
@@ -123,7 +142,7 @@ func (m *Manager) RestoreClass(ctx context.Context, d *backup.ClassDescriptor) e
 	m.logger.
 		WithField("action", "schema_restore_class").
 		Debugf("restore class %q from schema", class.Class)
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{Type: ClassAdded, Class: class.Class})
 
 	out := m.migrator.AddClass(ctx, class, &shardingState)
 	return out
@@ -201,7 +220,7 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	if err := m.addClassApplyChanges(ctx, class, shardState); err != nil {
+	if err := m.applied(tx, m.addClassApplyChanges(ctx, class, shardState)); err != nil {
 		return nil, err
 	}
 	return shardState, nil
@@ -227,7 +246,7 @@ func (m *Manager) addClassApplyChanges(ctx context.Context, class *models.Class,
 		m.schemaCache.ShardingState[class.Class] = shardingState
 	})
 
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{Type: ClassAdded, Class: class.Class})
 	return nil
 }
 
@@ -237,7 +256,7 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	}
 
 	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
+		class.VectorIndexType = vectorindex.VectorIndexTypeHNSW
 	}
 
 	if m.config.DefaultVectorDistanceMetric != "" {
@@ -259,6 +278,28 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
+	setNestedPropertyDefaults(prop.NestedProperties)
+}
+
+// setNestedPropertyDefaults applies the defaults of properties to nested
+// properties. Nested properties don't support deprecated settings, so there
+// is nothing to migrate.
+func setNestedPropertyDefaults(nested []*models.NestedProperty) {
+	for _, np := range nested {
+		prop := &models.Property{
+			DataType:        np.DataType,
+			IndexFilterable: np.IndexFilterable,
+			IndexSearchable: np.IndexSearchable,
+			Tokenization:    np.Tokenization,
+		}
+		setPropertyDefaultTokenization(prop)
+		setPropertyDefaultIndexing(prop)
+		np.IndexFilterable = prop.IndexFilterable
+		np.IndexSearchable = prop.IndexSearchable
+		np.Tokenization = prop.Tokenization
+
+		setNestedPropertyDefaults(np.NestedProperties)
+	}
 }
 
 func setPropertyDefaultTokenization(prop *models.Property) {
@@ -376,12 +417,20 @@ func (m *Manager) validateCanAddClass(
 
 	existingPropertyNames := map[string]bool{}
 	for _, property := range class.Properties {
-		if err := m.validateProperty(property, class.Class, existingPropertyNames, relaxCrossRefValidation); err != nil {
+		if err := m.validateProperty(ctx, property, class, existingPropertyNames, relaxCrossRefValidation); err != nil {
 			return err
 		}
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
+	if err := validateIDProperties(class); err != nil {
+		return err
+	}
+
+	if err := validateVectorOnly(class); err != nil {
+		return err
+	}
+
 	if err := m.validateVectorSettings(ctx, class); err != nil {
 		return err
 	}
@@ -394,14 +443,24 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateMultiTenancyConfig(class); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
 
-func (m *Manager) validateProperty(
-	property *models.Property, className string,
+func (m *Manager) validateProperty(ctx context.Context,
+	property *models.Property, class *models.Class,
 	existingPropertyNames map[string]bool, relaxCrossRefValidation bool,
 ) error {
+	className := class.Class
+
+	if class.VectorOnly {
+		return fmt.Errorf("class %q is vector-only and can't have properties", className)
+	}
+
 	if _, err := schema.ValidatePropertyName(property.Name); err != nil {
 		return err
 	}
@@ -431,20 +490,202 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := m.validateNestedProperties(property, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := validatePropertyOnDelete(property, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := m.validatePropertyBlobStorage(property, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := validatePropertyAnalyzer(property, propertyDataType, class.InvertedIndexConfig); err != nil {
+		return err
+	}
+
+	if err := validatePropertyDefaults(property, propertyDataType, class); err != nil {
+		return err
+	}
+
+	if propertyDataType.IsReference() && !relaxCrossRefValidation {
+		if err := m.validateFederatedRefs(ctx, propertyDataType.Classes()); err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %w", property.Name, err)
+		}
+	}
+
 	// all is fine!
 	return nil
 }
 
+// validatePropertyAnalyzer makes sure the selected analyzer is defined on
+// the class and only used on text properties
+func validatePropertyAnalyzer(property *models.Property, dataType schema.PropertyDataType,
+	config *models.InvertedIndexConfig,
+) error {
+	if property.Analyzer == "" {
+		return nil
+	}
+
+	if !dataType.IsPrimitive() {
+		return fmt.Errorf("property '%s': analyzer can only be set on text properties",
+			property.Name)
+	}
+	switch dataType.AsPrimitive() {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("property '%s': analyzer can only be set on text properties",
+			property.Name)
+	}
+
+	if config == nil {
+		return fmt.Errorf("property '%s': analyzer %q is not defined in invertedIndexConfig.analyzers",
+			property.Name, property.Analyzer)
+	}
+	if _, ok := config.Analyzers[property.Analyzer]; !ok {
+		return fmt.Errorf("property '%s': analyzer %q is not defined in invertedIndexConfig.analyzers",
+			property.Name, property.Analyzer)
+	}
+
+	return nil
+}
+
+// validateIDProperties makes sure ids are only derived from primitive
+// properties of the class
+func validateIDProperties(class *models.Class) error {
+	seen := make(map[string]struct{}, len(class.IDProperties))
+	for _, name := range class.IDProperties {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("idProperties: property %q listed multiple times", name)
+		}
+		seen[name] = struct{}{}
+
+		var prop *models.Property
+		for _, p := range class.Properties {
+			if p.Name == name {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return fmt.Errorf("idProperties: class %q has no property %q", class.Class, name)
+		}
+		if _, ok := schema.AsPrimitive(prop.DataType); !ok {
+			return fmt.Errorf("idProperties: property %q is not a primitive property", name)
+		}
+	}
+	return nil
+}
+
+// validateVectorOnly makes sure vector-only classes get their vectors from
+// the client, vectorizers would only see the class name
+func validateVectorOnly(class *models.Class) error {
+	if !class.VectorOnly {
+		return nil
+	}
+	if class.Vectorizer != config.VectorizerModuleNone {
+		return fmt.Errorf("class %q is vector-only and requires vectorizer %q, got %q",
+			class.Class, config.VectorizerModuleNone, class.Vectorizer)
+	}
+	return nil
+}
+
+// validatePropertyOnDelete makes sure referential actions are only set on
+// local references, as deletions on federation peers are not observed
+// validatePropertyBlobStorage makes sure blobStorage is only set on blob
+// properties and external storage is only used if a blob store is configured
+func (m *Manager) validatePropertyBlobStorage(property *models.Property,
+	dataType schema.PropertyDataType,
+) error {
+	switch property.BlobStorage {
+	case "":
+		return nil
+	case models.PropertyBlobStorageInline, models.PropertyBlobStorageExternal:
+	default:
+		return fmt.Errorf("property '%s': blobStorage must be one of %q or %q, got %q",
+			property.Name, models.PropertyBlobStorageInline,
+			models.PropertyBlobStorageExternal, property.BlobStorage)
+	}
+
+	if !dataType.IsPrimitive() || dataType.AsPrimitive() != schema.DataTypeBlob {
+		return fmt.Errorf("property '%s': blobStorage can only be set on blob properties",
+			property.Name)
+	}
+
+	if property.BlobStorage == models.PropertyBlobStorageExternal && !m.config.BlobStorage.Enabled {
+		return fmt.Errorf("property '%s': external blob storage is not configured",
+			property.Name)
+	}
+
+	return nil
+}
+
+func validatePropertyOnDelete(property *models.Property, dataType schema.PropertyDataType) error {
+	switch property.OnDelete {
+	case "":
+		return nil
+	case models.PropertyOnDeleteCascade, models.PropertyOnDeleteSetNull,
+		models.PropertyOnDeleteRestrict:
+	default:
+		return fmt.Errorf("property '%s': onDelete must be one of %q, %q or %q, got %q",
+			property.Name, models.PropertyOnDeleteCascade, models.PropertyOnDeleteSetNull,
+			models.PropertyOnDeleteRestrict, property.OnDelete)
+	}
+
+	if !dataType.IsReference() {
+		return fmt.Errorf("property '%s': onDelete can only be set on reference properties",
+			property.Name)
+	}
+
+	for _, class := range dataType.Classes() {
+		if schema.IsFederatedClassName(class) {
+			return fmt.Errorf("property '%s': onDelete can't be set on references to "+
+				"federation peers", property.Name)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) validateFederatedRefs(ctx context.Context, classes []schema.ClassName) error {
+	for _, class := range classes {
+		peer, className, ok := schema.SplitFederatedClassName(string(class))
+		if !ok {
+			continue
+		}
+
+		if m.federatedRefValidator == nil {
+			return fmt.Errorf("reference to %q: no federation peers configured", class)
+		}
+
+		if err := m.federatedRefValidator.ValidateClassRef(ctx, peer, string(className)); err != nil {
+			return fmt.Errorf("reference to %q: %w", class, err)
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
+	var (
+		parsed schema.VectorIndexConfig
+		err    error
+	)
+	switch class.VectorIndexType {
+	case vectorindex.VectorIndexTypeHNSW:
+		parsed, err = m.hnswConfigParser(class.VectorIndexConfig)
+	case vectorindex.VectorIndexTypeDynamic:
+		parsed, err = dynamic.ParseAndValidateConfig(class.VectorIndexConfig,
+			m.hnswConfigParser)
+	default:
 		return errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			class.VectorIndexType)
 	}
-
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
 	if err != nil {
 		return errors.Wrap(err, "parse vector index config")
 	}
@@ -21,13 +21,28 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
 )
 
 // AddClassProperty to an existing Class
 func (m *Manager) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property,
-) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+) (err error) {
+	defer func() {
+		event := audit.Event{Action: audit.ActionPropertyCreate, Class: class}
+		if property != nil {
+			event.Resource = property.Name
+		}
+		m.auditLog.Record(ctx, principal, event, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, ""))
 	if err != nil {
 		return err
 	}
@@ -52,10 +67,18 @@ func (m *Manager) addClassProperty(ctx context.Context,
 		existingPropertyNames[strings.ToLower(existingProperty.Name)] = true
 	}
 
+	if jobType, ok := m.propertyCleanups.Load(propertyCleanupKey(className, prop.Name)); ok {
+		verb := "deleted"
+		if jobType == jobs.TypePropertyRename {
+			verb = "renamed"
+		}
+		return fmt.Errorf("property %q of class %q is still being %s", prop.Name, className, verb)
+	}
+
 	if err := m.setNewPropDefaults(class, prop); err != nil {
 		return err
 	}
-	if err := m.validateProperty(prop, className, existingPropertyNames, false); err != nil {
+	if err := m.validateProperty(ctx, prop, class, existingPropertyNames, false); err != nil {
 		return err
 	}
 	// migrate only after validation in completed
@@ -87,7 +110,7 @@ func (m *Manager) addClassProperty(ctx context.Context,
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	return m.addClassPropertyApplyChanges(ctx, className, prop)
+	return m.applied(tx, m.addClassPropertyApplyChanges(ctx, className, prop))
 }
 
 func (m *Manager) setNewPropDefaults(class *models.Class, prop *models.Property) error {
@@ -139,7 +162,9 @@ func (m *Manager) addClassPropertyApplyChanges(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{
+		Type: PropertyAdded, Class: className, Property: prop.Name,
+	})
 
 	// will result in a mismatch between schema and index if function below fails
 	return m.migrator.AddProperty(ctx, className, prop)
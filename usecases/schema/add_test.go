@@ -871,3 +871,531 @@ func TestAddClass_DefaultsAndMigration(t *testing.T) {
 		})
 	})
 }
+
+type fakeFederatedRefValidator struct {
+	remoteClasses map[string]bool
+}
+
+func (f *fakeFederatedRefValidator) ValidateClassRef(ctx context.Context,
+	peerName, class string,
+) error {
+	if !f.remoteClasses[peerName+"/"+class] {
+		return fmt.Errorf("class %q does not exist on peer %q", class, peerName)
+	}
+	return nil
+}
+
+func TestAddClass_FederatedReferences(t *testing.T) {
+	classWithRef := func(dataType string) *models.Class {
+		return &models.Class{
+			Class: "LocalClass",
+			Properties: []*models.Property{{
+				Name:     "remoteRef",
+				DataType: []string{dataType},
+			}},
+		}
+	}
+
+	t.Run("without federation configured", func(t *testing.T) {
+		err := newSchemaManager().AddClass(context.Background(),
+			nil, classWithRef("remote/Article"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no federation peers configured")
+	})
+
+	t.Run("with existing remote class", func(t *testing.T) {
+		mgr := newSchemaManager()
+		mgr.SetFederatedRefValidator(&fakeFederatedRefValidator{
+			remoteClasses: map[string]bool{"remote/Article": true},
+		})
+
+		err := mgr.AddClass(context.Background(), nil, classWithRef("remote/Article"))
+		require.Nil(t, err)
+	})
+
+	t.Run("with missing remote class", func(t *testing.T) {
+		mgr := newSchemaManager()
+		mgr.SetFederatedRefValidator(&fakeFederatedRefValidator{})
+
+		err := mgr.AddClass(context.Background(), nil, classWithRef("remote/Article"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "does not exist on peer")
+	})
+}
+
+func TestAddClass_PropertyOnDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}{
+		{
+			name: "cascade on reference",
+			prop: &models.Property{Name: "ref", DataType: []string{"LocalClass"}, OnDelete: "cascade"},
+		},
+		{
+			name: "restrict on reference",
+			prop: &models.Property{Name: "ref", DataType: []string{"LocalClass"}, OnDelete: "restrict"},
+		},
+		{
+			name:        "on primitive",
+			prop:        &models.Property{Name: "title", DataType: []string{"text"}, OnDelete: "setNull"},
+			expectedErr: "onDelete can only be set on reference properties",
+		},
+		{
+			name:        "unknown action",
+			prop:        &models.Property{Name: "ref", DataType: []string{"LocalClass"}, OnDelete: "ignore"},
+			expectedErr: "onDelete must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, &models.Class{
+				Class:      "LocalClass",
+				Properties: []*models.Property{test.prop},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddClass_IDProperties(t *testing.T) {
+	tests := []struct {
+		name        string
+		idProps     []string
+		expectedErr string
+	}{
+		{
+			name:    "primitive properties",
+			idProps: []string{"title", "year"},
+		},
+		{
+			name:        "unknown property",
+			idProps:     []string{"isbn"},
+			expectedErr: "has no property \"isbn\"",
+		},
+		{
+			name:        "duplicate property",
+			idProps:     []string{"title", "title"},
+			expectedErr: "listed multiple times",
+		},
+		{
+			name:        "reference property",
+			idProps:     []string{"ref"},
+			expectedErr: "is not a primitive property",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, &models.Class{
+				Class:        "LocalClass",
+				IDProperties: test.idProps,
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "year", DataType: []string{"int"}},
+					{Name: "ref", DataType: []string{"LocalClass"}},
+				},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddClass_PropertyAnalyzer(t *testing.T) {
+	tests := []struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}{
+		{
+			name: "defined analyzer on text",
+			prop: &models.Property{Name: "title", DataType: []string{"text"}, Analyzer: "english"},
+		},
+		{
+			name: "defined analyzer on text array",
+			prop: &models.Property{Name: "tags", DataType: []string{"text[]"}, Analyzer: "english"},
+		},
+		{
+			name:        "undefined analyzer",
+			prop:        &models.Property{Name: "title", DataType: []string{"text"}, Analyzer: "german"},
+			expectedErr: `analyzer "german" is not defined in invertedIndexConfig.analyzers`,
+		},
+		{
+			name:        "on non-text property",
+			prop:        &models.Property{Name: "count", DataType: []string{"int"}, Analyzer: "english"},
+			expectedErr: "analyzer can only be set on text properties",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, &models.Class{
+				Class: "Article",
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					Analyzers: map[string]models.AnalyzerConfig{
+						"english": {Stemmer: "english"},
+					},
+				},
+				Properties: []*models.Property{test.prop},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddClass_PropertyDefaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}{
+		{
+			name: "text default",
+			prop: &models.Property{Name: "status", DataType: []string{"text"}, DefaultValue: "draft"},
+		},
+		{
+			name: "int default",
+			prop: &models.Property{Name: "count", DataType: []string{"int"}, DefaultValue: float64(3)},
+		},
+		{
+			name: "date array default",
+			prop: &models.Property{
+				Name: "dates", DataType: []string{"date[]"},
+				DefaultValue: []interface{}{"2023-01-01T00:00:00Z"},
+			},
+		},
+		{
+			name: "now on date",
+			prop: &models.Property{Name: "createdAt", DataType: []string{"date"}, ComputedValue: "now()"},
+		},
+		{
+			name: "concat on text",
+			prop: &models.Property{
+				Name: "fullName", DataType: []string{"text"},
+				ComputedValue: `concat(firstName, " ", year)`,
+			},
+		},
+		{
+			name:        "fractional int default",
+			prop:        &models.Property{Name: "count", DataType: []string{"int"}, DefaultValue: 1.5},
+			expectedErr: "requires an integer",
+		},
+		{
+			name:        "wrong default type",
+			prop:        &models.Property{Name: "flag", DataType: []string{"boolean"}, DefaultValue: "yes"},
+			expectedErr: "requires a boolean",
+		},
+		{
+			name:        "invalid date default",
+			prop:        &models.Property{Name: "day", DataType: []string{"date"}, DefaultValue: "today"},
+			expectedErr: "requires an RFC3339 date",
+		},
+		{
+			name: "both set",
+			prop: &models.Property{
+				Name: "createdAt", DataType: []string{"date"},
+				DefaultValue: "2023-01-01T00:00:00Z", ComputedValue: "now()",
+			},
+			expectedErr: "mutually exclusive",
+		},
+		{
+			name:        "on reference",
+			prop:        &models.Property{Name: "ref", DataType: []string{"LocalClass"}, ComputedValue: "now()"},
+			expectedErr: "can only be set on primitive properties",
+		},
+		{
+			name:        "now on int",
+			prop:        &models.Property{Name: "count", DataType: []string{"int"}, ComputedValue: "now()"},
+			expectedErr: "now() can only be used on date and text properties",
+		},
+		{
+			name:        "concat on date",
+			prop:        &models.Property{Name: "day", DataType: []string{"date"}, ComputedValue: "concat(firstName)"},
+			expectedErr: "concat() can only be used on text properties",
+		},
+		{
+			name:        "concat of unknown property",
+			prop:        &models.Property{Name: "fullName", DataType: []string{"text"}, ComputedValue: "concat(lastName)"},
+			expectedErr: "has no property \"lastName\"",
+		},
+		{
+			name:        "concat of itself",
+			prop:        &models.Property{Name: "fullName", DataType: []string{"text"}, ComputedValue: "concat(fullName)"},
+			expectedErr: "can't reference itself",
+		},
+		{
+			name:        "concat of computed property",
+			prop:        &models.Property{Name: "fullName", DataType: []string{"text"}, ComputedValue: "concat(slug)"},
+			expectedErr: "is itself computed",
+		},
+		{
+			name:        "unknown function",
+			prop:        &models.Property{Name: "fullName", DataType: []string{"text"}, ComputedValue: "upper(firstName)"},
+			expectedErr: "unknown function",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, &models.Class{
+				Class: "LocalClass",
+				Properties: []*models.Property{
+					{Name: "firstName", DataType: []string{"text"}},
+					{Name: "year", DataType: []string{"int"}},
+					{Name: "slug", DataType: []string{"text"}, ComputedValue: "now()"},
+					test.prop,
+				},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddClass_NestedProperties(t *testing.T) {
+	tests := []struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}{
+		{
+			name: "object",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{
+						Name: "location", DataType: schema.DataTypeObjectArray.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "object without nested properties",
+			prop:        &models.Property{Name: "address", DataType: schema.DataTypeObject.PropString()},
+			expectedErr: "require nestedProperties",
+		},
+		{
+			name: "nested properties on primitive",
+			prop: &models.Property{
+				Name: "city", DataType: schema.DataTypeText.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErr: "nestedProperties can only be set on data types",
+		},
+		{
+			name: "duplicate nested property",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "City", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErr: "provided multiple times",
+		},
+		{
+			name: "unsupported nested data type",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+				},
+			},
+			expectedErr: "is not supported for nested properties",
+		},
+		{
+			name: "nested reference",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "owner", DataType: []string{"LocalClass"}},
+				},
+			},
+			expectedErr: "nested properties can't be references",
+		},
+		{
+			name: "invalid nested tokenization",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "zip", DataType: schema.DataTypeInt.PropString(), Tokenization: "word"},
+				},
+			},
+			expectedErr: "property 'address.zip': Tokenization is not allowed",
+		},
+		{
+			name: "invalid nested name",
+			prop: &models.Property{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "zip.code", DataType: schema.DataTypeInt.PropString()},
+				},
+			},
+			expectedErr: "is not a valid property name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, &models.Class{
+				Class:      "LocalClass",
+				Properties: []*models.Property{test.prop},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	t.Run("nested defaults", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(context.Background(), nil, &models.Class{
+			Class: "LocalClass",
+			Properties: []*models.Property{{
+				Name: "address", DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+				},
+			}},
+		})
+		require.Nil(t, err)
+
+		sch := sm.getSchema()
+		nested := sch.FindClassByName("LocalClass").Properties[0].NestedProperties
+		assert.Equal(t, models.PropertyTokenizationWord, nested[0].Tokenization)
+		assert.True(t, *nested[0].IndexSearchable)
+		assert.True(t, *nested[1].IndexFilterable)
+		assert.False(t, *nested[1].IndexSearchable)
+	})
+}
+
+func TestAddClass_PropertyBlobStorage(t *testing.T) {
+	tests := []struct {
+		name         string
+		prop         *models.Property
+		storeEnabled bool
+		expectedErr  string
+	}{
+		{
+			name: "inline on blob",
+			prop: &models.Property{Name: "image", DataType: []string{"blob"}, BlobStorage: "inline"},
+		},
+		{
+			name:         "external on blob with store",
+			prop:         &models.Property{Name: "image", DataType: []string{"blob"}, BlobStorage: "external"},
+			storeEnabled: true,
+		},
+		{
+			name:        "external on blob without store",
+			prop:        &models.Property{Name: "image", DataType: []string{"blob"}, BlobStorage: "external"},
+			expectedErr: "external blob storage is not configured",
+		},
+		{
+			name:        "on text",
+			prop:        &models.Property{Name: "title", DataType: []string{"text"}, BlobStorage: "inline"},
+			expectedErr: "blobStorage can only be set on blob properties",
+		},
+		{
+			name:        "unknown storage",
+			prop:        &models.Property{Name: "image", DataType: []string{"blob"}, BlobStorage: "disk"},
+			expectedErr: "blobStorage must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sm := newSchemaManager()
+			sm.config.BlobStorage.Enabled = test.storeEnabled
+			err := sm.AddClass(context.Background(), nil, &models.Class{
+				Class:      "BlobClass",
+				Properties: []*models.Property{test.prop},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddClass_VectorOnly(t *testing.T) {
+	tests := []struct {
+		name        string
+		class       *models.Class
+		expectedErr string
+	}{
+		{
+			name:  "without properties",
+			class: &models.Class{Class: "Embeddings", VectorOnly: true},
+		},
+		{
+			name: "with properties",
+			class: &models.Class{
+				Class: "Embeddings", VectorOnly: true,
+				Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}},
+			},
+			expectedErr: "is vector-only and can't have properties",
+		},
+		{
+			name:        "with vectorizer",
+			class:       &models.Class{Class: "Embeddings", VectorOnly: true, Vectorizer: "model1"},
+			expectedErr: "requires vectorizer \"none\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(context.Background(), nil, test.class)
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	t.Run("adding a property", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(context.Background(), nil, &models.Class{Class: "Embeddings", VectorOnly: true})
+		require.Nil(t, err)
+
+		err = sm.AddClassProperty(context.Background(), nil, "Embeddings",
+			&models.Property{Name: "title", DataType: []string{"text"}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is vector-only and can't have properties")
+	})
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// SetAlias points alias at class. If the alias already exists it is
+// repointed in a single cluster-wide transaction, so queries using the alias
+// switch from the old class to the new one without downtime.
+func (m *Manager) SetAlias(ctx context.Context, principal *models.Principal,
+	alias, class string,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionAliasSet, Class: class, Resource: alias}, err)
+	}()
+
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return err
+	}
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, "")); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.validateAlias(alias, class); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	request := SetAliasPayload{Alias: alias, Class: class}
+	tx, err := m.cluster.BeginTransaction(ctx, setAlias, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.setAliasApplyChanges(ctx, alias, class))
+}
+
+// DeleteAlias removes alias. The class it points to is left untouched.
+func (m *Manager) DeleteAlias(ctx context.Context, principal *models.Principal,
+	alias string,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionAliasDelete, Resource: alias}, err)
+	}()
+
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.ResolveAlias(alias) == alias {
+		return fmt.Errorf("alias %q: %w", alias, ErrNotFound)
+	}
+
+	request := DeleteAliasPayload{Alias: alias}
+	tx, err := m.cluster.BeginTransaction(ctx, deleteAlias, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.deleteAliasApplyChanges(ctx, alias))
+}
+
+// GetAliases returns all aliases mapped to the classes they point to
+func (m *Manager) GetAliases(ctx context.Context, principal *models.Principal,
+) (map[string]string, error) {
+	if err := m.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+	var aliases map[string]string
+	m.schemaCache.RLockGuard(func() error {
+		aliases = copyAliases(m.schemaCache.Aliases)
+		return nil
+	})
+	return aliases, nil
+}
+
+func (m *Manager) validateAlias(alias, class string) error {
+	if _, err := schema.ValidateClassName(alias); err != nil {
+		return fmt.Errorf("alias: %w", err)
+	}
+	if m.getClassByName(class) == nil {
+		return fmt.Errorf("class %q does not exist", class)
+	}
+	for _, cls := range m.schemaCache.ObjectSchema.Classes {
+		if strings.EqualFold(alias, cls.Class) {
+			return fmt.Errorf("alias %q conflicts with existing class %q", alias, cls.Class)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) setAliasApplyChanges(ctx context.Context, alias, class string) error {
+	var aliases map[string]string
+	m.schemaCache.LockGuard(func() {
+		if m.schemaCache.Aliases == nil {
+			m.schemaCache.Aliases = make(map[string]string, 1)
+		}
+		m.schemaCache.Aliases[alias] = class
+		aliases = copyAliases(m.schemaCache.Aliases)
+	})
+
+	m.logger.WithField("action", "set_alias").
+		WithField("alias", alias).WithField("class", class).Debug("")
+	return m.repo.SaveAliases(ctx, aliases)
+}
+
+func (m *Manager) deleteAliasApplyChanges(ctx context.Context, alias string) error {
+	var aliases map[string]string
+	m.schemaCache.LockGuard(func() {
+		delete(m.schemaCache.Aliases, alias)
+		aliases = copyAliases(m.schemaCache.Aliases)
+	})
+
+	m.logger.WithField("action", "delete_alias").
+		WithField("alias", alias).Debug("")
+	return m.repo.SaveAliases(ctx, aliases)
+}
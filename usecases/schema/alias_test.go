@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAliases(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		for _, name := range []string{"ArticleV1", "ArticleV2"} {
+			require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+				Class:      name,
+				Vectorizer: "none",
+			}))
+		}
+		return m
+	}
+
+	t.Run("set and repoint", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetAlias(ctx, nil, "Article", "ArticleV1"))
+		assert.Equal(t, "ArticleV1", m.ResolveAlias("Article"))
+
+		require.Nil(t, m.SetAlias(ctx, nil, "Article", "ArticleV2"))
+		assert.Equal(t, "ArticleV2", m.ResolveAlias("Article"))
+		assert.Equal(t, "ArticleV1", m.ResolveAlias("ArticleV1"))
+
+		aliases, err := m.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"Article": "ArticleV2"}, aliases)
+		assert.Equal(t, aliases, m.repo.(*fakeRepo).schema.Aliases)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := newManager(t)
+		err := m.SetAlias(ctx, nil, "Article", "Missing")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+		err = m.SetAlias(ctx, nil, "articleV1", "ArticleV2")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+		err = m.SetAlias(ctx, nil, "not-a-class", "ArticleV2")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+	})
+
+	t.Run("class name taken by alias", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetAlias(ctx, nil, "Article", "ArticleV1"))
+		err := m.AddClass(ctx, nil, &models.Class{Class: "Article", Vectorizer: "none"})
+		assert.ErrorContains(t, err, "already exists as an alias")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetAlias(ctx, nil, "Article", "ArticleV1"))
+		require.Nil(t, m.DeleteAlias(ctx, nil, "Article"))
+		assert.Equal(t, "Article", m.ResolveAlias("Article"))
+		assert.ErrorIs(t, m.DeleteAlias(ctx, nil, "Article"), ErrNotFound)
+	})
+
+	t.Run("delete target class", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetAlias(ctx, nil, "Article", "ArticleV1"))
+		require.Nil(t, m.SetAlias(ctx, nil, "Latest", "ArticleV2"))
+		require.Nil(t, m.DeleteClass(ctx, nil, "ArticleV1"))
+
+		aliases, err := m.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"Latest": "ArticleV2"}, aliases)
+		assert.Equal(t, aliases, m.repo.(*fakeRepo).schema.Aliases)
+	})
+}
@@ -84,6 +84,42 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "UpdatePropertyTokenization",
+			additionalArgs:   []interface{}{"somename", "someprop", "field"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetSynonyms",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "UpdateSynonyms",
+			additionalArgs:   []interface{}{"somename", []*models.SynonymRule{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetStopwords",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "ReplaceStopwords",
+			additionalArgs:   []interface{}{"somename", int64(0), &models.StopwordConfig{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateStopwords",
+			additionalArgs:   []interface{}{"somename", int64(0), []string{"a"}, []string{"b"}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
@@ -96,6 +132,42 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: tenantsPath,
 		},
+		{
+			methodName:       "DrainNode",
+			additionalArgs:   []interface{}{"node1"},
+			expectedVerb:     "update",
+			expectedResource: "nodes",
+		},
+		{
+			methodName:       "DrainStatus",
+			additionalArgs:   []interface{}{"node1"},
+			expectedVerb:     "get",
+			expectedResource: "nodes",
+		},
+		{
+			methodName:       "SplitShards",
+			additionalArgs:   []interface{}{"className", 4},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "SplitStatus",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "MoveShard",
+			additionalArgs:   []interface{}{"className", "S1", "N1", "N2"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "StartShardMove",
+			additionalArgs:   []interface{}{"className", "S1", "N1", "N2"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
@@ -108,6 +180,41 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: tenantsPath,
 		},
+		{
+			methodName:       "UpdateTenantQuotas",
+			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
+			expectedVerb:     "update",
+			expectedResource: tenantsPath,
+		},
+		{
+			methodName:       "SetAlias",
+			additionalArgs:   []interface{}{"Alias", "className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "DeleteAlias",
+			additionalArgs:   []interface{}{"Alias"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetAliases",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "RenameClass",
+			additionalArgs:   []interface{}{"className", "NewName"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "RenameProperty",
+			additionalArgs:   []interface{}{"className", "prop", "newName"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -122,8 +229,10 @@ func Test_Schema_Authorization(t *testing.T) {
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
-				"CopyShardingState", "TxManager", "RestoreClass",
-				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas":
+				"CopyShardingState", "TxManager", "RestoreClass", "RestoreTenants",
+				"ShardOwner", "TenantShard", "TenantQuota", "ShardFromUUID", "ResolveAlias", "LockGuard", "RLockGuard", "ShardReplicas",
+				"SetFederatedRefValidator", "SetAuditLogger", "SetJobs", "PromoteTenant", "SetConsensus", "Subscribe",
+				"ResumeSplits":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
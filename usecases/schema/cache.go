@@ -24,6 +24,9 @@ import (
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+
+	// Aliases maps an alias name to the class it currently points to
+	Aliases map[string]string `json:"aliases,omitempty"`
 }
 
 // NewState returns a new state with room for nClasses classes
@@ -85,6 +88,17 @@ func (s *schemaCache) TenantShard(class, tenant string) string {
 	return ss.Shard(tenant, "")
 }
 
+// TenantQuota returns the quota of the tenant, nil if it has none
+func (s *schemaCache) TenantQuota(class, tenant string) *models.TenantQuota {
+	s.RLock()
+	defer s.RUnlock()
+	ss := s.ShardingState[class]
+	if ss == nil {
+		return nil
+	}
+	return ss.Physical[tenant].Quota
+}
+
 // ShardFromUUID returns shard name of the provided uuid
 func (s *schemaCache) ShardFromUUID(class string, uuid []byte) string {
 	s.RLock()
@@ -159,3 +173,40 @@ func (s *schemaCache) deleteClassState(name string) {
 	defer s.Unlock()
 	delete(s.ShardingState, name)
 }
+
+// deleteAliasesTo removes all aliases pointing to class. It reports whether
+// any alias was removed and returns the remaining aliases.
+func (s *schemaCache) deleteAliasesTo(class string) (map[string]string, bool) {
+	s.Lock()
+	defer s.Unlock()
+	deleted := false
+	for alias, target := range s.Aliases {
+		if target == class {
+			delete(s.Aliases, alias)
+			deleted = true
+		}
+	}
+	if !deleted {
+		return nil, false
+	}
+	return copyAliases(s.Aliases), true
+}
+
+// ResolveAlias returns the class the alias points to. If name is not an
+// alias it is returned unchanged.
+func (s *schemaCache) ResolveAlias(name string) string {
+	s.RLock()
+	defer s.RUnlock()
+	if class, ok := s.Aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
+func copyAliases(aliases map[string]string) map[string]string {
+	c := make(map[string]string, len(aliases))
+	for alias, class := range aliases {
+		c[alias] = class
+	}
+	return c
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// bootstrapSchema is the first entry of a new raft log. It carries the
+// schema of the first leader, so nodes which have no schema yet, such as
+// nodes joining later, start from the same state.
+const bootstrapSchema cluster.TransactionType = "bootstrap_schema"
+
+// SetConsensus replaces the two-phase commit of schema transactions with the
+// raft log. It must be called before the log is opened.
+func (m *Manager) SetConsensus(r *cluster.Raft) {
+	m.consensus = r
+	m.cluster.SetConsensusLog(r)
+	r.SetApplier(m.handleCommit, UnmarshalTransaction, m.baselineTransaction,
+		m.handleRestoreSchemaCommit)
+}
+
+// applied reports the outcome of applying a committed transaction locally.
+// With raft the transaction is only recorded as applied afterwards.
+func (m *Manager) applied(tx *cluster.Transaction, err error) error {
+	m.cluster.TransactionApplied(tx, err)
+	return err
+}
+
+func (m *Manager) baselineTransaction() (*cluster.Transaction, error) {
+	// the payload is marshalled after the lock is released, so it must not
+	// share anything with the cache
+	var data []byte
+	err := m.schemaCache.RLockGuard(func() (err error) {
+		data, err = json.Marshal(m.schemaCache.State)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("copy schema: %w", err)
+	}
+
+	return &cluster.Transaction{
+		ID:      uuid.New().String(),
+		Type:    bootstrapSchema,
+		Payload: ReadSchemaPayload{Schema: &state},
+	}, nil
+}
+
+func (m *Manager) handleBootstrapSchemaCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	pl, ok := tx.Payload.(ReadSchemaPayload)
+	if !ok {
+		return fmt.Errorf("expected commit payload to be ReadSchemaPayload, but got %T",
+			tx.Payload)
+	}
+	if isEmpty(pl.Schema) {
+		return nil
+	}
+
+	var local bool
+	var diff []string
+	m.schemaCache.RLockGuard(func() error {
+		local = !isEmpty(&m.schemaCache.State)
+		if local && Equal(&m.schemaCache.State, pl.Schema) != nil {
+			diff = Diff("local", &m.schemaCache.State, "log", pl.Schema)
+		}
+		return nil
+	})
+	if local {
+		// the log is the source of truth from now on. There is no safe way to
+		// reconcile a differing local schema automatically, so the node stops
+		// applying the log until the schema is repaired.
+		if len(diff) > 0 {
+			m.logger.WithFields(logrus.Fields{
+				"action": "raft_bootstrap_schema",
+				"diff":   diff,
+			}).Error("local schema differs from the schema the raft log started with")
+			return fmt.Errorf("bootstrap schema: %w", cluster.ErrStateDiverged)
+		}
+		return nil
+	}
+
+	for _, class := range pl.Schema.ObjectSchema.Classes {
+		ss, ok := pl.Schema.ShardingState[class.Class]
+		if !ok {
+			return fmt.Errorf("bootstrap schema: no sharding state for class %q", class.Class)
+		}
+		err := m.handleAddClassCommit(ctx, &cluster.Transaction{
+			Type:    AddClass,
+			Payload: AddClassPayload{Class: class, State: ss},
+		})
+		if err != nil {
+			return fmt.Errorf("bootstrap schema: add class %q: %w", class.Class, err)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for alias, class := range pl.Schema.Aliases {
+		if err := m.setAliasApplyChanges(ctx, alias, class); err != nil {
+			return fmt.Errorf("bootstrap schema: set alias %q: %w", alias, err)
+		}
+	}
+	return nil
+}
+
+// consensusStatus reports the health of the schema in raft mode. Every node
+// applies the same log, so the cluster is healthy as long as there is a
+// leader to append to it.
+func (m *Manager) consensusStatus(out *models.SchemaClusterStatus) (*models.SchemaClusterStatus, error) {
+	if err := m.consensus.Err(); err != nil {
+		out.Healthy = false
+		out.Error = err.Error()
+		return out, err
+	}
+	leader := m.consensus.Leader()
+	if leader == "" {
+		out.Healthy = false
+		out.Error = cluster.ErrNoLeader.Error()
+		return out, cluster.ErrNoLeader
+	}
+	out.Healthy = true
+	return out, nil
+}
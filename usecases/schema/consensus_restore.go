@@ -0,0 +1,355 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// handleRestoreSchemaCommit brings a local schema which is older than a
+// snapshot of the raft log to the schema of the snapshot. The differences are
+// applied as the transactions which caused them, so the data of the node is
+// migrated like it would have been by the truncated part of the log.
+func (m *Manager) handleRestoreSchemaCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	pl, ok := tx.Payload.(ReadSchemaPayload)
+	if !ok {
+		return fmt.Errorf("expected commit payload to be ReadSchemaPayload, but got %T",
+			tx.Payload)
+	}
+
+	var local State
+	if err := m.schemaCache.RLockGuard(func() error {
+		data, err := json.Marshal(m.schemaCache.State)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, &local)
+	}); err != nil {
+		return fmt.Errorf("restore schema: copy local schema: %w", err)
+	}
+
+	steps, err := restoreSteps(&local, pl.Schema)
+	if err != nil {
+		m.logger.WithField("action", "raft_restore_schema").
+			WithField("diff", Diff("local", &local, "snapshot", pl.Schema)).
+			WithError(err).Error("local schema can not be restored from the raft snapshot")
+		return fmt.Errorf("restore schema: %w", err)
+	}
+	for _, step := range steps {
+		if err := m.handleCommit(ctx, step); err != nil {
+			return fmt.Errorf("restore schema: %s: %w", step.Type, err)
+		}
+	}
+	return nil
+}
+
+// restoreSteps returns the transactions which turn local into target.
+//
+// Classes are identified by the id of their index, which is kept if a class
+// is renamed. Differences which can not be attributed to a transaction, such
+// as a property which was renamed, mean that the state diverged.
+func restoreSteps(local, target *State) ([]*cluster.Transaction, error) {
+	var steps []*cluster.Transaction
+	step := func(txType cluster.TransactionType, payload interface{}) {
+		steps = append(steps, &cluster.Transaction{Type: txType, Payload: payload})
+	}
+
+	localClasses := map[string]*models.Class{}
+	if local.ObjectSchema != nil {
+		for _, class := range local.ObjectSchema.Classes {
+			localClasses[indexID(class.Class, local.ShardingState[class.Class])] = class
+		}
+	}
+	var targetClasses []*models.Class
+	if target.ObjectSchema != nil {
+		targetClasses = target.ObjectSchema.Classes
+	}
+
+	// matched maps the target name of a class to its local class
+	matched := map[string]*models.Class{}
+	for _, class := range targetClasses {
+		id := indexID(class.Class, target.ShardingState[class.Class])
+		if l, ok := localClasses[id]; ok {
+			matched[class.Class] = l
+			delete(localClasses, id)
+		}
+	}
+
+	renames, err := renameOrder(local, matched)
+	if err != nil {
+		return nil, err
+	}
+	renamedFrom := make([]string, 0, len(renames))
+	for _, r := range renames {
+		step(renameClass, r)
+		renamedFrom = append(renamedFrom, r.From)
+	}
+
+	for _, class := range sortedClasses(localClasses) {
+		step(DeleteClass, DeleteClassPayload{ClassName: class.Class})
+	}
+
+	for _, class := range targetClasses {
+		if _, ok := matched[class.Class]; ok {
+			continue
+		}
+		ss, ok := target.ShardingState[class.Class]
+		if !ok {
+			return nil, fmt.Errorf("no sharding state for class %q", class.Class)
+		}
+		step(AddClass, AddClassPayload{Class: class, State: ss})
+	}
+
+	for _, class := range targetClasses {
+		from, ok := matched[class.Class]
+		if !ok {
+			continue
+		}
+		classSteps, err := restoreClassSteps(from, local.ShardingState[from.Class],
+			class, target.ShardingState[class.Class])
+		if err != nil {
+			return nil, fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		steps = append(steps, classSteps...)
+	}
+
+	aliases := make([]string, 0, len(local.Aliases)+len(renamedFrom))
+	for alias := range local.Aliases {
+		aliases = append(aliases, alias)
+	}
+	aliases = append(aliases, renamedFrom...)
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		if _, ok := target.Aliases[alias]; !ok {
+			step(deleteAlias, DeleteAliasPayload{Alias: alias})
+		}
+	}
+	for _, alias := range sortedKeys(target.Aliases) {
+		step(setAlias, SetAliasPayload{Alias: alias, Class: target.Aliases[alias]})
+	}
+
+	return steps, nil
+}
+
+func restoreClassSteps(local *models.Class, localState *sharding.State,
+	target *models.Class, targetState *sharding.State,
+) ([]*cluster.Transaction, error) {
+	var steps []*cluster.Transaction
+	step := func(txType cluster.TransactionType, payload interface{}) {
+		steps = append(steps, &cluster.Transaction{Type: txType, Payload: payload})
+	}
+	name := target.Class
+
+	localProps := map[string]*models.Property{}
+	for _, prop := range local.Properties {
+		localProps[prop.Name] = prop
+	}
+	var added []*models.Property
+	for _, prop := range target.Properties {
+		lp, ok := localProps[prop.Name]
+		if !ok {
+			added = append(added, prop)
+			continue
+		}
+		delete(localProps, prop.Name)
+		if lp.Tokenization != prop.Tokenization {
+			step(updatePropertyTokenization, UpdatePropertyTokenizationPayload{
+				Class: name, Property: prop.Name, Tokenization: prop.Tokenization,
+			})
+		}
+	}
+	if len(added) > 0 && len(localProps) > 0 {
+		// a renamed property looks like a deleted and an added one
+		return nil, fmt.Errorf("properties %v were replaced by %v: %w",
+			sortedKeys(localProps), propNames(added), cluster.ErrStateDiverged)
+	}
+	for _, prop := range sortedKeys(localProps) {
+		step(deleteProperty, DeletePropertyPayload{Class: name, Property: prop})
+	}
+	for _, prop := range added {
+		step(AddProperty, AddPropertyPayload{ClassName: name, Property: prop})
+	}
+
+	// restored holds the local state with the changes of the tenant steps
+	var restored *sharding.State
+	if localState != nil {
+		copied := localState.DeepCopy()
+		restored = &copied
+	}
+	if schema.MultiTenancyEnabled(target) && restored != nil && targetState != nil {
+		steps = append(steps, restoreTenantSteps(name, restored, targetState)...)
+	}
+
+	// the remaining differences, such as the class config or the nodes of
+	// shards, are replaced as a whole
+	updated := *local
+	updated.Class = name
+	updated.Properties = target.Properties
+	if !sameJSON(&updated, target) || !sameJSON(restored, targetState) {
+		step(UpdateClass, UpdateClassPayload{ClassName: name, Class: target, State: targetState})
+	}
+	return steps, nil
+}
+
+// restoreTenantSteps returns the tenant transactions which turn the tenants
+// of local into the ones of target, local is changed accordingly
+func restoreTenantSteps(class string, local, target *sharding.State) []*cluster.Transaction {
+	var (
+		added    []Tenant
+		updated  []Tenant
+		promoted []Tenant
+		deleted  []string
+	)
+	for _, name := range sortedKeys(target.Physical) {
+		p := target.Physical[name]
+		tenant := Tenant{
+			Name: p.Name, Nodes: p.BelongsToNodes,
+			Shared: p.Shared, SharedShard: p.SharedShard, Quota: p.Quota,
+			Centroid: p.Centroid,
+		}
+		lp, ok := local.Physical[name]
+		switch {
+		case !ok:
+			added = append(added, tenant)
+			local.Physical[name] = p
+		case lp.SharedShard != "" && p.SharedShard == "":
+			tenant.PromotedFrom = lp.SharedShard
+			promoted = append(promoted, tenant)
+			local.Physical[name] = p
+		case !sameJSON(lp.Quota, p.Quota):
+			updated = append(updated, Tenant{Name: p.Name, Quota: p.Quota})
+			lp.Quota = p.Quota
+			local.Physical[name] = lp
+		}
+	}
+	for _, name := range sortedKeys(local.Physical) {
+		if _, ok := target.Physical[name]; !ok {
+			deleted = append(deleted, name)
+			delete(local.Physical, name)
+		}
+	}
+	// shared shards must exist before the tenants which are placed in them
+	sort.SliceStable(added, func(i, j int) bool {
+		return added[i].Shared && !added[j].Shared
+	})
+
+	var steps []*cluster.Transaction
+	if len(added) > 0 {
+		steps = append(steps, &cluster.Transaction{
+			Type: addTenants, Payload: AddTenantsPayload{Class: class, Tenants: added},
+		})
+	}
+	if len(promoted) > 0 {
+		steps = append(steps, &cluster.Transaction{
+			Type: addTenants, Payload: AddTenantsPayload{Class: class, Tenants: promoted},
+		})
+	}
+	if len(updated) > 0 {
+		steps = append(steps, &cluster.Transaction{
+			Type: updateTenants, Payload: UpdateTenantsPayload{Class: class, Tenants: updated},
+		})
+	}
+	if len(deleted) > 0 {
+		steps = append(steps, &cluster.Transaction{
+			Type: deleteTenants, Payload: DeleteTenantsPayload{Class: class, Tenants: deleted},
+		})
+	}
+	return steps
+}
+
+// renameOrder returns the renames of the matched classes in an order in
+// which no class is renamed to the name of a class which is renamed later
+func renameOrder(local *State, matched map[string]*models.Class) ([]RenameClassPayload, error) {
+	taken := map[string]bool{}
+	if local.ObjectSchema != nil {
+		for _, class := range local.ObjectSchema.Classes {
+			taken[strings.ToLower(class.Class)] = true
+		}
+	}
+
+	var open []RenameClassPayload
+	for _, to := range sortedKeys(matched) {
+		if from := matched[to].Class; from != to {
+			open = append(open, RenameClassPayload{From: from, To: to})
+		}
+	}
+
+	var ordered []RenameClassPayload
+	for len(open) > 0 {
+		next := open[:0]
+		for _, r := range open {
+			if taken[strings.ToLower(r.To)] {
+				next = append(next, r)
+				continue
+			}
+			delete(taken, strings.ToLower(r.From))
+			taken[strings.ToLower(r.To)] = true
+			ordered = append(ordered, r)
+		}
+		if len(next) == len(open) {
+			return nil, fmt.Errorf("classes were renamed in a cycle: %w", cluster.ErrStateDiverged)
+		}
+		open = next
+	}
+	return ordered, nil
+}
+
+func indexID(class string, ss *sharding.State) string {
+	if ss != nil && ss.IndexID != "" {
+		return strings.ToLower(ss.IndexID)
+	}
+	return strings.ToLower(class)
+}
+
+func sortedClasses(classes map[string]*models.Class) []*models.Class {
+	out := make([]*models.Class, 0, len(classes))
+	for _, class := range classes {
+		out = append(out, class)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Class < out[j].Class })
+	return out
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func propNames(props []*models.Property) []string {
+	names := make([]string, len(props))
+	for i, prop := range props {
+		names[i] = prop.Name
+	}
+	return names
+}
+
+func sameJSON(a, b interface{}) bool {
+	da, errA := json.Marshal(a)
+	db, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(da, db)
+}
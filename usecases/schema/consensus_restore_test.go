@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRestoreSteps(t *testing.T) {
+	class := func(name string, props ...*models.Property) *models.Class {
+		return &models.Class{Class: name, Properties: props}
+	}
+	prop := func(name, tokenization string) *models.Property {
+		return &models.Property{Name: name, DataType: []string{"text"}, Tokenization: tokenization}
+	}
+	state := func(id string, tenants ...sharding.Physical) *sharding.State {
+		ss := &sharding.State{IndexID: id, Physical: map[string]sharding.Physical{}}
+		for _, p := range tenants {
+			ss.Physical[p.Name] = p
+		}
+		return ss
+	}
+	types := func(steps []*cluster.Transaction) []cluster.TransactionType {
+		out := make([]cluster.TransactionType, len(steps))
+		for i, step := range steps {
+			out[i] = step.Type
+		}
+		return out
+	}
+
+	t.Run("classes, properties and aliases", func(t *testing.T) {
+		local := &State{
+			ObjectSchema: &models.Schema{Classes: []*models.Class{
+				class("A", prop("title", "word")),
+				class("B", prop("name", "word")),
+				class("Old"),
+			}},
+			ShardingState: map[string]*sharding.State{
+				"A": state("A"), "B": state("B"), "Old": state("Old"),
+			},
+			Aliases: map[string]string{"Gone": "B"},
+		}
+		target := &State{
+			ObjectSchema: &models.Schema{Classes: []*models.Class{
+				// A was renamed to B after B was renamed to C
+				class("B", prop("title", "field"), prop("body", "word")),
+				class("C", prop("name", "word")),
+				class("New"),
+			}},
+			ShardingState: map[string]*sharding.State{
+				"B": state("A"), "C": state("B"), "New": state("New"),
+			},
+			Aliases: map[string]string{"A": "B", "Short": "C"},
+		}
+
+		steps, err := restoreSteps(local, target)
+		require.Nil(t, err)
+		assert.Equal(t, []cluster.TransactionType{
+			renameClass, renameClass, DeleteClass, AddClass,
+			updatePropertyTokenization, AddProperty,
+			deleteAlias, deleteAlias, setAlias, setAlias,
+		}, types(steps))
+		assert.Equal(t, RenameClassPayload{From: "B", To: "C"}, steps[0].Payload)
+		assert.Equal(t, RenameClassPayload{From: "A", To: "B"}, steps[1].Payload)
+		assert.Equal(t, DeleteClassPayload{ClassName: "Old"}, steps[2].Payload)
+		assert.Equal(t, "New", steps[3].Payload.(AddClassPayload).Class.Class)
+		assert.Equal(t, UpdatePropertyTokenizationPayload{
+			Class: "B", Property: "title", Tokenization: "field",
+		}, steps[4].Payload)
+		assert.Equal(t, "body", steps[5].Payload.(AddPropertyPayload).Property.Name)
+		assert.Equal(t, DeleteAliasPayload{Alias: "B"}, steps[6].Payload)
+		assert.Equal(t, DeleteAliasPayload{Alias: "Gone"}, steps[7].Payload)
+		assert.Equal(t, SetAliasPayload{Alias: "A", Class: "B"}, steps[8].Payload)
+		assert.Equal(t, SetAliasPayload{Alias: "Short", Class: "C"}, steps[9].Payload)
+	})
+
+	t.Run("tenants", func(t *testing.T) {
+		mt := class("MT")
+		mt.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+		quota := &models.TenantQuota{MaxObjects: 10}
+		local := &State{
+			ObjectSchema: &models.Schema{Classes: []*models.Class{mt}},
+			ShardingState: map[string]*sharding.State{"MT": state("MT",
+				sharding.Physical{Name: "shared", Shared: true, BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "small", SharedShard: "shared", BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "limited", BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "deleted", BelongsToNodes: []string{"n1"}},
+			)},
+		}
+		target := &State{
+			ObjectSchema: &models.Schema{Classes: []*models.Class{mt}},
+			ShardingState: map[string]*sharding.State{"MT": state("MT",
+				sharding.Physical{Name: "shared", Shared: true, BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "small", BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "limited", BelongsToNodes: []string{"n1"}, Quota: quota},
+				sharding.Physical{Name: "added", SharedShard: "shared2", BelongsToNodes: []string{"n1"}},
+				sharding.Physical{Name: "shared2", Shared: true, BelongsToNodes: []string{"n1"}},
+			)},
+		}
+
+		steps, err := restoreSteps(local, target)
+		require.Nil(t, err)
+		assert.Equal(t, []cluster.TransactionType{
+			addTenants, addTenants, updateTenants, deleteTenants,
+		}, types(steps), "the sharding state matches after the tenant steps")
+
+		added := steps[0].Payload.(AddTenantsPayload).Tenants
+		require.Len(t, added, 2)
+		assert.Equal(t, "shared2", added[0].Name, "shared shards are added first")
+		assert.Equal(t, "added", added[1].Name)
+		assert.Equal(t, []Tenant{{Name: "small", Nodes: []string{"n1"}, PromotedFrom: "shared"}},
+			steps[1].Payload.(AddTenantsPayload).Tenants)
+		assert.Equal(t, []Tenant{{Name: "limited", Quota: quota}},
+			steps[2].Payload.(UpdateTenantsPayload).Tenants)
+		assert.Equal(t, []string{"deleted"}, steps[3].Payload.(DeleteTenantsPayload).Tenants)
+	})
+
+	t.Run("class config is replaced", func(t *testing.T) {
+		local := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{class("A")}},
+			ShardingState: map[string]*sharding.State{"A": state("A")},
+		}
+		updated := class("A")
+		updated.Description = "changed"
+		target := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{updated}},
+			ShardingState: map[string]*sharding.State{"A": state("A")},
+		}
+
+		steps, err := restoreSteps(local, target)
+		require.Nil(t, err)
+		require.Equal(t, []cluster.TransactionType{UpdateClass}, types(steps))
+		assert.Equal(t, updated, steps[0].Payload.(UpdateClassPayload).Class)
+	})
+
+	t.Run("renamed property diverges", func(t *testing.T) {
+		local := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{class("A", prop("a", "word"))}},
+			ShardingState: map[string]*sharding.State{"A": state("A")},
+		}
+		target := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{class("A", prop("b", "word"))}},
+			ShardingState: map[string]*sharding.State{"A": state("A")},
+		}
+
+		_, err := restoreSteps(local, target)
+		assert.ErrorIs(t, err, cluster.ErrStateDiverged)
+	})
+
+	t.Run("swapped class names diverge", func(t *testing.T) {
+		local := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{class("A"), class("B")}},
+			ShardingState: map[string]*sharding.State{"A": state("A"), "B": state("B")},
+		}
+		target := &State{
+			ObjectSchema:  &models.Schema{Classes: []*models.Class{class("B"), class("A")}},
+			ShardingState: map[string]*sharding.State{"B": state("A"), "A": state("B")},
+		}
+
+		_, err := restoreSteps(local, target)
+		assert.ErrorIs(t, err, cluster.ErrStateDiverged)
+	})
+}
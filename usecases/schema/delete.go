@@ -16,11 +16,22 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // DeleteClass from the schema
-func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.Authorizer.Authorize(principal, "delete", "schema/objects")
+func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionClassDelete, Class: class}, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "delete", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "delete", authorization.SchemaResource(class, ""))
 	if err != nil {
 		return err
 	}
@@ -58,7 +69,7 @@ func (m *Manager) deleteClass(ctx context.Context, className string) error {
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	return m.deleteClassApplyChanges(ctx, className)
+	return m.applied(tx, m.deleteClassApplyChanges(ctx, className))
 }
 
 func (m *Manager) deleteClassApplyChanges(ctx context.Context, className string) error {
@@ -78,9 +89,15 @@ func (m *Manager) deleteClassApplyChanges(ctx context.Context, className string)
 	}
 
 	m.schemaCache.deleteClassState(className)
+	if aliases, ok := m.schemaCache.deleteAliasesTo(className); ok {
+		if err := m.repo.SaveAliases(ctx, aliases); err != nil {
+			m.logger.WithField("action", "delete_class").
+				WithField("class", className).Errorf("save aliases: %v", err)
+		}
+	}
 
 	m.logger.WithField("action", "delete_class").WithField("class", className).Debug("")
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{Type: ClassDeleted, Class: className})
 
 	return nil
 }
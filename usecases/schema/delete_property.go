@@ -13,21 +13,142 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
 )
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty removes a property from the schema. The property is
+// no longer returned or indexed once the call returns, its buckets are
+// dropped and it is stripped from the stored objects in the background,
+// which is tracked as a job.
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
-) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionPropertyDelete, Class: class, Resource: property}, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, ""))
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/weaviate/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	m.Lock()
+	defer m.Unlock()
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !hasProperty(cls, property) {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("property %q not found in class %q", property, cls.Class))
+	}
+
+	request := DeletePropertyPayload{Class: cls.Class, Property: property}
+	tx, err := m.cluster.BeginTransaction(ctx, deleteProperty, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.deletePropertyApplyChanges(ctx, cls.Class, property))
+}
+
+func hasProperty(class *models.Class, name string) bool {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) deletePropertyApplyChanges(ctx context.Context,
+	className, property string,
+) error {
+	// the drop is recorded before the schema changes, so it is completed or
+	// rolled back if the node crashes in between
+	if err := m.migrator.DropProperty(ctx, className, property); err != nil {
+		return fmt.Errorf("drop property data: %w", err)
+	}
+
+	var class *models.Class
+	m.schemaCache.LockGuard(func() {
+		if class = m.getClassByName(className); class == nil {
+			return
+		}
+		props := class.Properties[:0]
+		for _, prop := range class.Properties {
+			if prop.Name != property {
+				props = append(props, prop)
+			}
+		}
+		class.Properties = props
+	})
+	if class == nil {
+		m.logger.WithField("action", "delete_property").
+			WithField("class", className).Warn("class not found")
+		return nil
+	}
+
+	var metadata []byte
+	if err := m.schemaCache.RLockGuard(func() (err error) {
+		metadata, err = json.Marshal(class)
+		return err
+	}); err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+
+	m.logger.WithField("action", "delete_property").WithField("class", className).
+		WithField("property", property).Debug("")
+	if err := m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata}); err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks(Change{
+		Type: PropertyDeleted, Class: className, Property: property,
+	})
+
+	m.migrateProperty(jobs.TypePropertyDelete, className, property)
+	return nil
+}
+
+// migrateProperty migrates the data of a deleted or renamed property in the
+// background. Adding a property of its previous name is rejected until it is
+// done.
+func (m *Manager) migrateProperty(jobType, className, property string) {
+	key := propertyCleanupKey(className, property)
+	ctx, cancel := context.WithCancel(context.Background())
+	job := m.jobs.Track(jobType, className+"/"+property, cancel)
+	m.propertyCleanups.Store(key, jobType)
+
+	go func() {
+		defer cancel()
+		err := m.migrator.MigrateProperties(ctx, className)
+		if err != nil {
+			m.logger.WithField("action", "migrate_property").WithField("class", className).
+				WithField("property", property).WithError(err).Error("migrate property data")
+		}
+		m.propertyCleanups.Delete(key)
+		job.Done(err)
+	}()
+}
+
+func propertyCleanupKey(className, property string) string {
+	return strings.ToLower(className + "/" + property)
 }
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type dropPropertyMigrator struct {
+	NilMigrator
+	dropped  chan string
+	migrated chan string
+	release  chan struct{}
+}
+
+func (m *dropPropertyMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+	m.dropped <- className + "/" + propName
+	return nil
+}
+
+func (m *dropPropertyMigrator) MigrateProperties(ctx context.Context, className string) error {
+	m.migrated <- className
+	<-m.release
+	return nil
+}
+
+func TestDeleteClassProperty(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+	migrator := &dropPropertyMigrator{
+		dropped:  make(chan string, 1),
+		migrated: make(chan string, 1),
+		release:  make(chan struct{}),
+	}
+	m.migrator = migrator
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "body", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.ErrorIs(t, m.DeleteClassProperty(ctx, nil, "Missing", "title"), ErrNotFound)
+		err := m.DeleteClassProperty(ctx, nil, "Article", "missing")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, m.DeleteClassProperty(ctx, nil, "Article", "title"))
+
+		props := m.getClassByName("Article").Properties
+		require.Len(t, props, 1)
+		assert.Equal(t, "body", props[0].Name)
+		assert.Equal(t, "Article/title", <-migrator.dropped)
+		assert.Equal(t, "Article", <-migrator.migrated)
+	})
+
+	t.Run("re-adding waits for the cleanup", func(t *testing.T) {
+		prop := &models.Property{Name: "title", DataType: schema.DataTypeText.PropString()}
+		err := m.AddClassProperty(ctx, nil, "Article", prop)
+		assert.ErrorContains(t, err, "still being deleted")
+
+		close(migrator.release)
+		assert.Eventually(t, func() bool {
+			_, ok := m.propertyCleanups.Load(propertyCleanupKey("Article", "title"))
+			return !ok
+		}, time.Second, 10*time.Millisecond)
+
+		prop = &models.Property{Name: "title", DataType: schema.DataTypeText.PropString()}
+		require.Nil(t, m.AddClassProperty(ctx, nil, "Article", prop))
+	})
+}
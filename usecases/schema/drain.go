@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+const (
+	DrainStatusStarted = "STARTED"
+	DrainStatusSuccess = "SUCCESS"
+	DrainStatusFailed  = "FAILED"
+
+	// maxDrainPasses limits how often the shards of a node are planned
+	// again, because new shards were placed on it while it was drained
+	maxDrainPasses = 3
+)
+
+// DrainStatus reports the progress of draining a node
+type DrainStatus struct {
+	Node        string `json:"node"`
+	Status      string `json:"status"`
+	TotalShards int    `json:"totalShards"`
+	MovedShards int    `json:"movedShards"`
+	// SafeToTerminate is set once no shard belongs to the node anymore
+	SafeToTerminate bool   `json:"safeToTerminate"`
+	Error           string `json:"error,omitempty"`
+	JobID           string `json:"jobId,omitempty"`
+}
+
+// drain tracks a running drain of a node
+type drain struct {
+	sync.Mutex
+	status DrainStatus
+	job    *jobs.Handle
+}
+
+func (d *drain) get() DrainStatus {
+	d.Lock()
+	defer d.Unlock()
+	return d.status
+}
+
+func (d *drain) update(f func(s *DrainStatus)) {
+	d.Lock()
+	defer d.Unlock()
+	f(&d.status)
+	d.job.Progress(int64(d.status.MovedShards), int64(d.status.TotalShards))
+}
+
+// drainMove moves one replica of a shard away from the drained node
+type drainMove struct {
+	class, shard, target string
+}
+
+// DrainNode moves all shard replicas of a node to the remaining nodes, so
+// the node can be removed from the cluster. Each replica is moved to the
+// node with the fewest shards which doesn't hold a replica of the shard yet,
+// so the replication factor of all classes is kept.
+//
+// The shards are moved in the background, the returned status and
+// DrainStatus report the progress. The status is only known to the node
+// which coordinates the drain.
+func (m *Manager) DrainNode(ctx context.Context, principal *models.Principal,
+	node string,
+) (DrainStatus, error) {
+	if err := m.Authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return DrainStatus{}, err
+	}
+	if !contains(m.clusterState.Candidates(), node) {
+		return DrainStatus{}, fmt.Errorf("node %q: %w", node, ErrNotFound)
+	}
+
+	moves, err := m.drainMoves(node)
+	if err != nil {
+		return DrainStatus{}, enterrors.NewErrUnprocessable(err)
+	}
+
+	d := &drain{status: DrainStatus{
+		Node:        node,
+		Status:      DrainStatusStarted,
+		TotalShards: len(moves),
+	}}
+	if prev, loaded := m.drains.LoadOrStore(node, d); loaded {
+		if s := prev.(*drain).get(); s.Status == DrainStatusStarted {
+			return s, nil // already draining
+		}
+		m.drains.Store(node, d)
+	}
+
+	d.job = m.jobs.Track(jobs.TypeNodeDrain, node, nil)
+	d.update(func(s *DrainStatus) { s.JobID = d.job.ID() })
+
+	go m.drainNode(principal, node, d, moves)
+	return d.get(), nil
+}
+
+// DrainStatus returns the progress of draining a node
+func (m *Manager) DrainStatus(ctx context.Context, principal *models.Principal,
+	node string,
+) (DrainStatus, error) {
+	if err := m.Authorizer.Authorize(principal, "get", "nodes"); err != nil {
+		return DrainStatus{}, err
+	}
+	d, ok := m.drains.Load(node)
+	if !ok {
+		return DrainStatus{}, fmt.Errorf("drain of node %q: %w", node, ErrNotFound)
+	}
+	return d.(*drain).get(), nil
+}
+
+func (m *Manager) drainNode(principal *models.Principal, node string,
+	d *drain, moves []drainMove,
+) {
+	ctx := context.Background()
+	l := m.logger.WithField("action", "drain_node").WithField("node", node)
+
+	fail := func(err error) {
+		l.WithError(err).Error("drain node")
+		d.update(func(s *DrainStatus) {
+			s.Status = DrainStatusFailed
+			s.Error = err.Error()
+		})
+		d.job.Done(err)
+	}
+
+	for pass := 0; len(moves) > 0; pass++ {
+		if pass == maxDrainPasses {
+			fail(fmt.Errorf("shards are still placed on the node after %d passes", pass))
+			return
+		}
+		for _, mv := range moves {
+			err := m.MoveShard(ctx, principal, mv.class, mv.shard, node, mv.target)
+			if err != nil {
+				fail(fmt.Errorf("move shard %q of class %q to node %q: %w",
+					mv.shard, mv.class, mv.target, err))
+				return
+			}
+			l.WithField("class", mv.class).WithField("shard", mv.shard).
+				WithField("target", mv.target).Info("shard moved")
+			d.update(func(s *DrainStatus) { s.MovedShards++ })
+		}
+
+		// shards of classes or tenants which were added in the meantime
+		var err error
+		if moves, err = m.drainMoves(node); err != nil {
+			fail(err)
+			return
+		}
+		d.update(func(s *DrainStatus) { s.TotalShards += len(moves) })
+	}
+
+	d.update(func(s *DrainStatus) {
+		s.Status = DrainStatusSuccess
+		s.SafeToTerminate = true
+	})
+	d.job.Done(nil)
+	l.Info("node drained, it is safe to terminate it")
+}
+
+// drainMoves plans a target for each shard replica of the node
+func (m *Manager) drainMoves(node string) ([]drainMove, error) {
+	var targets []string
+	for _, name := range m.clusterState.Candidates() {
+		if name != node {
+			targets = append(targets, name)
+		}
+	}
+
+	m.schemaCache.RLock()
+	defer m.schemaCache.RUnlock()
+
+	load := make(map[string]int, len(targets))
+	var moves []drainMove
+	for class, ss := range m.schemaCache.ShardingState {
+		for shard, physical := range ss.Physical {
+			for _, owner := range physical.BelongsToNodes {
+				load[owner]++
+			}
+			if contains(physical.BelongsToNodes, node) {
+				moves = append(moves, drainMove{class: class, shard: shard})
+			}
+		}
+	}
+
+	// plan in a stable order, so the load is spread the same way on retries
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].class != moves[j].class {
+			return moves[i].class < moves[j].class
+		}
+		return moves[i].shard < moves[j].shard
+	})
+	for i, mv := range moves {
+		owners := m.schemaCache.ShardingState[mv.class].Physical[mv.shard].BelongsToNodes
+		target := ""
+		for _, t := range targets {
+			if !contains(owners, t) && (target == "" || load[t] < load[target]) {
+				target = t
+			}
+		}
+		if target == "" {
+			return nil, fmt.Errorf("no node left for a replica of shard %q of class %q, "+
+				"reduce its replication factor or add nodes", mv.shard, mv.class)
+		}
+		load[target]++
+		moves[i].target = target
+	}
+	return moves, nil
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// fakeShardMover moves shards by updating the sharding state directly
+type fakeShardMover struct {
+	fakeScaleOutManager
+	m     *Manager
+	moves []drainMove
+}
+
+func (f *fakeShardMover) MoveShard(ctx context.Context, className, shard,
+	source, target string, update func(*sharding.State) error,
+) error {
+	f.moves = append(f.moves, drainMove{className, shard, target})
+	ss := f.m.CopyShardingState(className)
+	physical := ss.Physical[shard]
+	for i, node := range physical.BelongsToNodes {
+		if node == source {
+			physical.BelongsToNodes[i] = target
+		}
+	}
+	ss.Physical[shard] = physical
+	f.m.schemaCache.LockGuard(func() { f.m.ShardingState[className] = ss })
+	return nil
+}
+
+func TestDrainNode(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(hosts ...string) (*Manager, *fakeShardMover) {
+		m := newSchemaManager()
+		m.clusterState = &fakeClusterState{hosts: hosts}
+		mover := &fakeShardMover{m: m}
+		m.scaleOut = mover
+		states := map[string]map[string][]string{
+			"A": {"S1": {"node1", "node2"}, "S2": {"node2", "node3"}},
+			"B": {"S1": {"node2"}},
+		}
+		for class, shards := range states {
+			m.ObjectSchema.Classes = append(m.ObjectSchema.Classes, &models.Class{Class: class})
+			ss := &sharding.State{Physical: map[string]sharding.Physical{}}
+			for shard, nodes := range shards {
+				ss.Physical[shard] = sharding.Physical{Name: shard, BelongsToNodes: nodes}
+			}
+			m.ShardingState[class] = ss
+		}
+		return m, mover
+	}
+
+	t.Run("drain", func(t *testing.T) {
+		m, mover := newManager("node1", "node2", "node3", "node4")
+		status, err := m.DrainNode(ctx, nil, "node2")
+		require.Nil(t, err)
+		assert.Equal(t, DrainStatus{Node: "node2", Status: DrainStatusStarted, TotalShards: 3}, status)
+
+		assert.Eventually(t, func() bool {
+			s, err := m.DrainStatus(ctx, nil, "node2")
+			return err == nil && s.Status == DrainStatusSuccess
+		}, time.Second, time.Millisecond)
+		status, _ = m.DrainStatus(ctx, nil, "node2")
+		assert.Equal(t, DrainStatus{
+			Node: "node2", Status: DrainStatusSuccess,
+			TotalShards: 3, MovedShards: 3, SafeToTerminate: true,
+		}, status)
+		// each replica goes to the node with the fewest shards
+		assert.Equal(t, []drainMove{
+			{"A", "S1", "node4"}, {"A", "S2", "node1"}, {"B", "S1", "node3"},
+		}, mover.moves)
+	})
+
+	t.Run("tracked as job", func(t *testing.T) {
+		m, _ := newManager("node1", "node2", "node3", "node4")
+		tracker := newJobs(t)
+		m.SetJobs(tracker)
+
+		status, err := m.DrainNode(ctx, nil, "node2")
+		require.Nil(t, err)
+		require.NotEmpty(t, status.JobID)
+
+		assert.Eventually(t, func() bool {
+			job, err := tracker.Get(nil, status.JobID)
+			return err == nil && job.Status == jobs.StatusSuccess
+		}, time.Second, time.Millisecond)
+		job, _ := tracker.Get(nil, status.JobID)
+		assert.Equal(t, jobs.TypeNodeDrain, job.Type)
+		assert.Equal(t, "node2", job.Target)
+		assert.Equal(t, int64(3), job.Processed)
+		assert.Equal(t, int64(3), job.Total)
+		assert.Equal(t, 100.0, job.Progress)
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		m, _ := newManager("node1", "node2", "node3")
+		m.ShardingState["A"].Physical["S1"] = sharding.Physical{
+			BelongsToNodes: []string{"node1", "node2", "node3"},
+		}
+		_, err := m.DrainNode(ctx, nil, "node2")
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		m, _ := newManager("node1", "node2")
+		_, err := m.DrainNode(ctx, nil, "node5")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.DrainStatus(ctx, nil, "node1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("move shard in the background", func(t *testing.T) {
+		m, mover := newManager("node1", "node2", "node3")
+		tracker := newJobs(t)
+		m.SetJobs(tracker)
+
+		id, err := m.StartShardMove(ctx, nil, "B", "S1", "node2", "node3")
+		require.Nil(t, err)
+
+		assert.Eventually(t, func() bool {
+			job, err := tracker.Get(nil, id)
+			return err == nil && job.Status == jobs.StatusSuccess
+		}, time.Second, time.Millisecond)
+		job, _ := tracker.Get(nil, id)
+		assert.Equal(t, jobs.TypeShardMove, job.Type)
+		assert.Equal(t, "B/S1", job.Target)
+		assert.Equal(t, []drainMove{{"B", "S1", "node3"}}, mover.moves)
+
+		_, err = m.StartShardMove(ctx, nil, "C", "S1", "node2", "node3")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func newJobs(t *testing.T) *jobs.Manager {
+	logger, _ := test.NewNullLogger()
+	tracker, err := jobs.NewManager(&fakeJobsRepo{}, &fakeAuthorizer{}, config.Jobs{}, logger)
+	require.Nil(t, err)
+	return tracker
+}
+
+type fakeJobsRepo struct{}
+
+func (r *fakeJobsRepo) Put(ctx context.Context, job *jobs.Job) error    { return nil }
+func (r *fakeJobsRepo) List(ctx context.Context) ([]*jobs.Job, error)   { return nil, nil }
+func (r *fakeJobsRepo) Delete(ctx context.Context, ids ...string) error { return nil }
@@ -14,3 +14,7 @@ package schema
 import "errors"
 
 var ErrNotFound = errors.New("not found")
+
+// ErrVersionConflict is returned for updates which expect a different
+// version of what they change than the current one
+var ErrVersionConflict = errors.New("version conflict")
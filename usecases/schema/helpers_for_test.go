@@ -57,6 +57,11 @@ func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) err
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(_ context.Context, aliases map[string]string) error {
+	f.schema.Aliases = aliases
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -26,6 +26,10 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case deleteProperty:
+		return m.handleDeletePropertyCommit(ctx, tx)
+	case updatePropertyTokenization:
+		return m.handleUpdatePropertyTokenizationCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
@@ -34,6 +38,18 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddTenantsCommit(ctx, tx)
 	case deleteTenants:
 		return m.handleDeleteTenantsCommit(ctx, tx)
+	case updateTenants:
+		return m.handleUpdateTenantsCommit(ctx, tx)
+	case setAlias:
+		return m.handleSetAliasCommit(ctx, tx)
+	case deleteAlias:
+		return m.handleDeleteAliasCommit(ctx, tx)
+	case renameClass:
+		return m.handleRenameClassCommit(ctx, tx)
+	case renameProperty:
+		return m.handleRenamePropertyCommit(ctx, tx)
+	case bootstrapSchema:
+		return m.handleBootstrapSchemaCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -107,6 +123,36 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleDeletePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(DeletePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteProperty, but got %T",
+			tx.Payload)
+	}
+
+	return m.deletePropertyApplyChanges(ctx, req.Class, req.Property)
+}
+
+func (m *Manager) handleUpdatePropertyTokenizationCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(UpdatePropertyTokenizationPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdatePropertyTokenization, but got %T",
+			tx.Payload)
+	}
+
+	return m.updatePropertyTokenizationApplyChanges(ctx, req.Class, req.Property, req.Tokenization)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -190,3 +236,81 @@ func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
 
 	return m.onDeleteTenants(ctx, cls, req)
 }
+
+func (m *Manager) handleUpdateTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(UpdateTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdateTenants, but got %T",
+			tx.Payload)
+	}
+	if m.getClassByName(req.Class) == nil {
+		return fmt.Errorf("class %q: %w", req.Class, ErrNotFound)
+	}
+
+	return m.onUpdateTenants(ctx, req)
+}
+
+func (m *Manager) handleSetAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(SetAliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be SetAlias, but got %T",
+			tx.Payload)
+	}
+
+	return m.setAliasApplyChanges(ctx, req.Alias, req.Class)
+}
+
+func (m *Manager) handleDeleteAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(DeleteAliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteAlias, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteAliasApplyChanges(ctx, req.Alias)
+}
+
+func (m *Manager) handleRenameClassCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(RenameClassPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenameClass, but got %T",
+			tx.Payload)
+	}
+
+	return m.renameClassApplyChanges(ctx, req.From, req.To)
+}
+
+func (m *Manager) handleRenamePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(RenamePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenameProperty, but got %T",
+			tx.Payload)
+	}
+
+	return m.renamePropertyApplyChanges(ctx, req.Class, req.From, req.To)
+}
@@ -244,6 +244,19 @@ func TestIncommingTxCommit(t *testing.T) {
 			},
 			expectedErrContains: "expected commit payload to be",
 		},
+		{
+			name: "bootstrap schema differing from the local schema",
+			tx: &cluster.Transaction{
+				Type: bootstrapSchema,
+				Payload: ReadSchemaPayload{Schema: &State{
+					ObjectSchema: &models.Schema{
+						Classes: []*models.Class{{Class: "OtherClass", VectorIndexType: "hnsw"}},
+					},
+					ShardingState: map[string]*sharding.State{"OtherClass": {}},
+				}},
+			},
+			expectedErrContains: cluster.ErrStateDiverged.Error(),
+		},
 	}
 
 	for _, test := range tests {
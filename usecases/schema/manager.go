@@ -20,8 +20,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/jobs"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
@@ -34,18 +36,27 @@ type Manager struct {
 	migrator                migrate.Migrator
 	repo                    SchemaStore
 	callbacks               []func(updatedSchema schema.Schema)
+	subscribers             []ChangeSubscriber
 	logger                  logrus.FieldLogger
 	Authorizer              authorizer
 	config                  config.Config
 	vectorizerValidator     VectorizerValidator
 	moduleConfig            ModuleConfig
 	cluster                 *cluster.TxManager
+	consensus               *cluster.Raft
 	clusterState            clusterState
 	hnswConfigParser        VectorConfigParser
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
+	federatedRefValidator   FederatedRefValidator
+	auditLog                *audit.Logger
+	jobs                    *jobs.Manager
 	RestoreStatus           sync.Map
 	RestoreError            sync.Map
+	drains                  sync.Map // node name -> *drain
+	splits                  sync.Map // class name -> *split
+	propertyCleanups        sync.Map // lowercased "class/property" of deleted and renamed properties to the job type
+	propertyReindexes       sync.Map // lowercased "class/property" of properties being reindexed
 	sync.RWMutex
 
 	schemaCache
@@ -65,9 +76,16 @@ type SchemaGetter interface {
 	CopyShardingState(class string) *sharding.State
 	ShardOwner(class, shard string) (string, error)
 	TenantShard(class, tenant string) string
+	TenantQuota(class, tenant string) *models.TenantQuota
 	ShardFromUUID(class string, uuid []byte) string
 }
 
+// FederatedRefValidator checks references to classes which are hosted on
+// federation peers, i.e. dataTypes of the form "<peer>/<Class>"
+type FederatedRefValidator interface {
+	ValidateClassRef(ctx context.Context, peerName, class string) error
+}
+
 type VectorizerValidator interface {
 	ValidateVectorizer(moduleName string) error
 }
@@ -102,6 +120,9 @@ type SchemaStore interface {
 	// DeleteShards deletes shards from a class
 	// If the class or a shard does not exist then nothing is done and a nil error is returned
 	DeleteShards(ctx context.Context, class string, shards []string) error
+
+	// SaveAliases replaces all class aliases
+	SaveAliases(ctx context.Context, aliases map[string]string) error
 }
 
 // KeyValuePair is used to serialize shards updates
@@ -141,6 +162,12 @@ type scaleOut interface {
 	SetSchemaManager(sm scaler.SchemaManager)
 	Scale(ctx context.Context, className string,
 		updated sharding.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	MoveShard(ctx context.Context, className, shard, source, target string,
+		update func(*sharding.State) error) error
+	SplitShards(ctx context.Context, className string, count int,
+		update func(*sharding.State) error) error
+	ResumeSplit(ctx context.Context, className string,
+		update func(*sharding.State) error) error
 }
 
 // NewManager creates a new manager
@@ -182,6 +209,23 @@ func NewManager(migrator migrate.Migrator, repo SchemaStore,
 	return m, nil
 }
 
+// SetFederatedRefValidator enables cross-cluster references. Without a
+// validator, properties referencing classes on federation peers are rejected.
+func (m *Manager) SetFederatedRefValidator(v FederatedRefValidator) {
+	m.federatedRefValidator = v
+}
+
+// SetAuditLogger records all changes of classes, properties and tenants
+func (m *Manager) SetAuditLogger(l *audit.Logger) {
+	m.auditLog = l
+}
+
+// SetJobs tracks node drains, shard splits, shard moves started with
+// StartShardMove and the cleanup of deleted properties in the jobs API
+func (m *Manager) SetJobs(j *jobs.Manager) {
+	m.jobs = j
+}
+
 func (m *Manager) TxManager() *cluster.TxManager {
 	return m.cluster
 }
@@ -198,7 +242,7 @@ func (m *Manager) saveSchema(ctx context.Context, st State) error {
 	if err := m.repo.Save(ctx, st); err != nil {
 		return err
 	}
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{Type: SchemaReplaced})
 	return nil
 }
 
@@ -209,7 +253,9 @@ func (m *Manager) RegisterSchemaUpdateCallback(callback func(updatedSchema schem
 	m.callbacks = append(m.callbacks, callback)
 }
 
-func (m *Manager) triggerSchemaUpdateCallbacks() {
+func (m *Manager) triggerSchemaUpdateCallbacks(change Change) {
+	m.notifySubscribers(change)
+
 	schema := m.getSchema()
 
 	for _, cb := range m.callbacks {
@@ -246,8 +292,11 @@ func (m *Manager) loadOrInitializeSchema(ctx context.Context) error {
 	// otherwise two identical schemas might fail the check based on form rather
 	// than content
 
-	if err := m.startupClusterSync(ctx); err != nil {
-		return errors.Wrap(err, "sync schema with other nodes in the cluster")
+	// with raft the log brings the schema in sync once it is opened
+	if !m.config.Cluster.Raft.Enabled {
+		if err := m.startupClusterSync(ctx); err != nil {
+			return errors.Wrap(err, "sync schema with other nodes in the cluster")
+		}
 	}
 
 	// store in persistent storage
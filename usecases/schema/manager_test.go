@@ -47,6 +47,10 @@ func (n *NilMigrator) GetShardsStatus(ctx context.Context, className string) (ma
 	return nil, nil
 }
 
+func (n *NilMigrator) TenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error {
 	return nil
 }
@@ -63,6 +67,10 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, te
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) MoveSharedTenant(ctx context.Context, class *models.Class, tenant, from, to string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -75,6 +83,14 @@ func (n *NilMigrator) DropProperty(ctx context.Context, className string, propNa
 	return nil
 }
 
+func (n *NilMigrator) MigrateProperties(ctx context.Context, className string) error {
+	return nil
+}
+
+func (n *NilMigrator) ReindexProperty(ctx context.Context, className string, propName string) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateVectorIndexConfigUpdate(ctx context.Context, old, updated schema.VectorIndexConfig) error {
 	return nil
 }
@@ -475,11 +491,6 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/weaviate/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -497,7 +508,8 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, objectClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	require.Nil(t, err)
 
 	objectClasses = testGetClasses(lsm)
 	require.Len(t, objectClasses, 1)
@@ -648,3 +660,21 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
+
+func (f *fakeScaleOutManager) MoveShard(ctx context.Context, className, shard,
+	source, target string, update func(*sharding.State) error,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) SplitShards(ctx context.Context, className string,
+	count int, update func(*sharding.State) error,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) ResumeSplit(ctx context.Context, className string,
+	update func(*sharding.State) error,
+) error {
+	return nil
+}
@@ -29,14 +29,19 @@ type Migrator interface {
 	UpdateClass(ctx context.Context, className string,
 		newClassName *string) error
 	GetShardsStatus(ctx context.Context, className string) (map[string]string, error)
+	TenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error)
 	UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error
 	AddProperty(ctx context.Context, className string,
 		prop *models.Property) error
+	DropProperty(ctx context.Context, className string, propName string) error
+	ReindexProperty(ctx context.Context, className string, propName string) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	MigrateProperties(ctx context.Context, className string) error
 
 	NewTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	MoveSharedTenant(ctx context.Context, class *models.Class, tenant, from, to string) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
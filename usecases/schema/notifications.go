@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "sort"
+
+// ChangeType is the kind of a schema change
+type ChangeType string
+
+const (
+	ClassAdded      ChangeType = "class_added"
+	ClassDeleted    ChangeType = "class_deleted"
+	ClassUpdated    ChangeType = "class_updated"
+	ClassRenamed    ChangeType = "class_renamed"
+	PropertyAdded   ChangeType = "property_added"
+	PropertyDeleted ChangeType = "property_deleted"
+	PropertyUpdated ChangeType = "property_updated"
+	PropertyRenamed ChangeType = "property_renamed"
+	TenantsAdded    ChangeType = "tenants_added"
+	TenantsDeleted  ChangeType = "tenants_deleted"
+	TenantsUpdated  ChangeType = "tenants_updated"
+	// SchemaReplaced means the whole schema may have changed, e.g. after it
+	// was synced with the cluster
+	SchemaReplaced ChangeType = "schema_replaced"
+)
+
+// Change describes a schema change which has been applied locally. Changes
+// made on other nodes are reported once they are committed on this node.
+type Change struct {
+	Type  ChangeType
+	Class string
+	// Previous is the old name of a renamed class or property
+	Previous string
+	Property string
+	Tenants  []string
+}
+
+// ChangeSubscriber is called for every applied schema change
+type ChangeSubscriber func(change Change)
+
+// Subscribe registers a subscriber for schema changes. It allows keeping
+// copies of the parts of the schema needed on hot paths, instead of reading
+// them from the Manager for every request.
+//
+// Subscribers are called synchronously while the change is applied, and
+// before it is acknowledged. They must be fast and must not call methods of
+// the Manager which take its lock, only the lock-free getters such as
+// GetSchemaSkipAuth or CopyShardingState.
+func (m *Manager) Subscribe(subscriber ChangeSubscriber) {
+	m.subscribers = append(m.subscribers, subscriber)
+}
+
+func (m *Manager) notifySubscribers(change Change) {
+	for _, subscriber := range m.subscribers {
+		subscriber(change)
+	}
+}
+
+// tenantNames are sorted, the tenants may come from a map and subscribers
+// should see the same payload for the same change
+func tenantNames(tenants []Tenant) []string {
+	names := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		names[i] = tenant.Name
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestSchemaChangeNotifications(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	var changes []Change
+	sm.Subscribe(func(change Change) {
+		// the change is visible once subscribers are notified
+		if change.Type == PropertyAdded {
+			sch := sm.GetSchemaSkipAuth()
+			class := sch.GetClass(schema.ClassName(change.Class))
+			require.NotNil(t, class)
+			assert.Len(t, class.Properties, 1)
+		}
+		changes = append(changes, change)
+	})
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	require.Nil(t, sm.AddClassProperty(ctx, nil, "C1", &models.Property{
+		Name: "name", DataType: schema.DataTypeText.PropString(),
+	}))
+	require.Nil(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{{Name: "T1"}, {Name: "T2"}}))
+	require.Nil(t, sm.DeleteTenants(ctx, nil, "C1", []string{"T1"}))
+	require.Nil(t, sm.DeleteClass(ctx, nil, "C1"))
+
+	assert.Equal(t, []Change{
+		{Type: ClassAdded, Class: "C1"},
+		{Type: PropertyAdded, Class: "C1", Property: "name"},
+		{Type: TenantsAdded, Class: "C1", Tenants: []string{"T1", "T2"}},
+		{Type: TenantsDeleted, Class: "C1", Tenants: []string{"T1"}},
+		{Type: ClassDeleted, Class: "C1"},
+	}, changes)
+}
+
+func TestReadCache(t *testing.T) {
+	loads := 0
+	load := func() int {
+		loads++
+		return loads
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		loads = 0
+		var nilCache *ReadCache[int]
+		assert.Equal(t, 1, nilCache.Get(load))
+		c := NewReadCache[int](false)
+		assert.Equal(t, 2, c.Get(load))
+		assert.Equal(t, 3, c.Get(load))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		loads = 0
+		c := NewReadCache[int](true)
+		assert.Equal(t, 1, c.Get(load))
+		assert.Equal(t, 1, c.Get(load))
+		c.Invalidate()
+		assert.Equal(t, 2, c.Get(load))
+		assert.Equal(t, 2, c.Get(load))
+	})
+
+	t.Run("load racing with invalidation is not cached", func(t *testing.T) {
+		c := NewReadCache[string](true)
+		assert.Equal(t, "stale", c.Get(func() string {
+			c.Invalidate()
+			return "stale"
+		}))
+		assert.Equal(t, "fresh", c.Get(func() string { return "fresh" }))
+	})
+}
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validatePropertyDefaults makes sure a property's defaultValue matches its
+// data type and that its computedValue is a valid expression over other
+// primitive properties of the class
+func validatePropertyDefaults(property *models.Property, dataType schema.PropertyDataType,
+	class *models.Class,
+) error {
+	if property.DefaultValue == nil && property.ComputedValue == "" {
+		return nil
+	}
+
+	if property.DefaultValue != nil && property.ComputedValue != "" {
+		return fmt.Errorf("property '%s': defaultValue and computedValue are mutually exclusive",
+			property.Name)
+	}
+
+	if !dataType.IsPrimitive() {
+		return fmt.Errorf("property '%s': defaultValue and computedValue can only be set on "+
+			"primitive properties", property.Name)
+	}
+	primitive := dataType.AsPrimitive()
+	switch primitive {
+	case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+		return fmt.Errorf("property '%s': defaultValue and computedValue are not supported "+
+			"for data type %q", property.Name, primitive)
+	}
+
+	if property.DefaultValue != nil {
+		if err := validateDefaultValue(property.DefaultValue, primitive); err != nil {
+			return fmt.Errorf("property '%s': invalid defaultValue: %w", property.Name, err)
+		}
+		return nil
+	}
+
+	if err := validateComputedValue(property, primitive, class); err != nil {
+		return fmt.Errorf("property '%s': invalid computedValue: %w", property.Name, err)
+	}
+	return nil
+}
+
+func validateDefaultValue(value interface{}, dataType schema.DataType) error {
+	var elem schema.DataType
+	switch dataType {
+	case schema.DataTypeTextArray, schema.DataTypeStringArray:
+		elem = schema.DataTypeText
+	case schema.DataTypeIntArray:
+		elem = schema.DataTypeInt
+	case schema.DataTypeNumberArray:
+		elem = schema.DataTypeNumber
+	case schema.DataTypeBooleanArray:
+		elem = schema.DataTypeBoolean
+	case schema.DataTypeDateArray:
+		elem = schema.DataTypeDate
+	case schema.DataTypeUUIDArray:
+		elem = schema.DataTypeUUID
+	default:
+		return validateDefaultScalar(value, dataType)
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("data type %q requires an array, got %T", dataType, value)
+	}
+	for i, v := range values {
+		if err := validateDefaultScalar(v, elem); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateDefaultScalar(value interface{}, dataType schema.DataType) error {
+	switch dataType {
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		var number float64
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return fmt.Errorf("data type %q requires a number, got %q", dataType, v)
+			}
+			number = f
+		default:
+			return fmt.Errorf("data type %q requires a number, got %T", dataType, value)
+		}
+		if dataType == schema.DataTypeInt && number != math.Trunc(number) {
+			return fmt.Errorf("data type %q requires an integer, got %v", dataType, number)
+		}
+		return nil
+	case schema.DataTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("data type %q requires a boolean, got %T", dataType, value)
+		}
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("data type %q requires a string, got %T", dataType, value)
+	}
+	switch dataType {
+	case schema.DataTypeDate:
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return fmt.Errorf("data type %q requires an RFC3339 date, got %q", dataType, str)
+		}
+	case schema.DataTypeUUID:
+		if _, err := uuid.Parse(str); err != nil {
+			return fmt.Errorf("data type %q requires a uuid, got %q", dataType, str)
+		}
+	case schema.DataTypeBlob:
+		if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+			return fmt.Errorf("data type %q requires a base64 string", dataType)
+		}
+	}
+	return nil
+}
+
+func validateComputedValue(property *models.Property, dataType schema.DataType,
+	class *models.Class,
+) error {
+	computed, err := schema.ParseComputedValue(property.ComputedValue)
+	if err != nil {
+		return err
+	}
+
+	switch computed.Func {
+	case schema.ComputedValueNow:
+		switch dataType {
+		case schema.DataTypeDate, schema.DataTypeText, schema.DataTypeString:
+			return nil
+		}
+		return fmt.Errorf("now() can only be used on date and text properties")
+	case schema.ComputedValueConcat:
+		switch dataType {
+		case schema.DataTypeText, schema.DataTypeString:
+		default:
+			return fmt.Errorf("concat() can only be used on text properties")
+		}
+	}
+
+	for _, arg := range computed.Args {
+		if arg.IsLiteral() {
+			continue
+		}
+		if arg.Property == property.Name {
+			return fmt.Errorf("property can't reference itself")
+		}
+
+		var ref *models.Property
+		for _, p := range class.Properties {
+			if p.Name == arg.Property {
+				ref = p
+				break
+			}
+		}
+		if ref == nil {
+			return fmt.Errorf("class %q has no property %q", class.Class, arg.Property)
+		}
+		refType, ok := schema.AsPrimitive(ref.DataType)
+		if !ok || refType == schema.DataTypeGeoCoordinates || refType == schema.DataTypePhoneNumber {
+			return fmt.Errorf("property %q can't be concatenated, only scalar and array "+
+				"primitive properties are supported", arg.Property)
+		}
+		if ref.ComputedValue != "" {
+			return fmt.Errorf("property %q is itself computed", arg.Property)
+		}
+	}
+	return nil
+}
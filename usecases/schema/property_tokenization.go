@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+// UpdatePropertyTokenization changes the tokenization of a text property.
+// The inverted buckets of the property are rebuilt from the stored objects
+// in the background, which is tracked as a job. Until it is done, filters
+// and keyword searches on the property may miss objects.
+func (m *Manager) UpdatePropertyTokenization(ctx context.Context, principal *models.Principal,
+	class, property, tokenization string,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionPropertyReindex, Class: class, Resource: property}, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, ""))
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	prop := findProperty(cls, property)
+	if prop == nil {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("property %q not found in class %q", property, cls.Class))
+	}
+	if err := m.validateTokenizationUpdate(cls, prop, tokenization); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	request := UpdatePropertyTokenizationPayload{
+		Class: cls.Class, Property: prop.Name, Tokenization: tokenization,
+	}
+	tx, err := m.cluster.BeginTransaction(ctx, updatePropertyTokenization, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.updatePropertyTokenizationApplyChanges(ctx, cls.Class, prop.Name, tokenization))
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
+
+func (m *Manager) validateTokenizationUpdate(class *models.Class,
+	prop *models.Property, tokenization string,
+) error {
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("tokenization of property %q can only be changed for data types %q and %q",
+			prop.Name, schema.DataTypeText, schema.DataTypeTextArray)
+	}
+
+	sch := m.getSchema()
+	propertyDataType, err := (&sch).FindPropertyDataTypeWithRefs(prop.DataType,
+		false, schema.ClassName(class.Class))
+	if err != nil {
+		return fmt.Errorf("property %q: invalid dataType: %w", prop.Name, err)
+	}
+	if err := m.validatePropertyTokenization(tokenization, propertyDataType); err != nil {
+		return err
+	}
+
+	if tokenization == prop.Tokenization {
+		return fmt.Errorf("property %q is already tokenized by %q", prop.Name, tokenization)
+	}
+	if _, ok := m.propertyReindexes.Load(propertyCleanupKey(class.Class, prop.Name)); ok {
+		return fmt.Errorf("property %q is still being reindexed", prop.Name)
+	}
+	return nil
+}
+
+func (m *Manager) updatePropertyTokenizationApplyChanges(ctx context.Context,
+	className, property, tokenization string,
+) error {
+	var class *models.Class
+	m.schemaCache.LockGuard(func() {
+		if class = m.getClassByName(className); class == nil {
+			return
+		}
+		if prop := findProperty(class, property); prop != nil {
+			prop.Tokenization = tokenization
+		}
+	})
+	if class == nil {
+		m.logger.WithField("action", "update_property_tokenization").
+			WithField("class", className).Warn("class not found")
+		return nil
+	}
+
+	var metadata []byte
+	if err := m.schemaCache.RLockGuard(func() (err error) {
+		metadata, err = json.Marshal(class)
+		return err
+	}); err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+
+	m.logger.WithField("action", "update_property_tokenization").WithField("class", className).
+		WithField("property", property).WithField("tokenization", tokenization).Debug("")
+	if err := m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata}); err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks(Change{
+		Type: PropertyUpdated, Class: className, Property: property,
+	})
+
+	m.reindexProperty(className, property)
+	return nil
+}
+
+// reindexProperty rebuilds the inverted buckets of a property in the
+// background. Another tokenization change of the property is rejected until
+// it is done.
+func (m *Manager) reindexProperty(className, property string) {
+	key := propertyCleanupKey(className, property)
+	ctx, cancel := context.WithCancel(context.Background())
+	job := m.jobs.Track(jobs.TypePropertyReindex, className+"/"+property, cancel)
+	m.propertyReindexes.Store(key, struct{}{})
+
+	go func() {
+		defer cancel()
+		err := m.migrator.ReindexProperty(ctx, className, property)
+		if err != nil {
+			m.logger.WithField("action", "update_property_tokenization").WithField("class", className).
+				WithField("property", property).WithError(err).Error("reindex property")
+		}
+		m.propertyReindexes.Delete(key)
+		job.Done(err)
+	}()
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type reindexPropertyMigrator struct {
+	NilMigrator
+	reindexed chan string
+	release   chan struct{}
+}
+
+func (m *reindexPropertyMigrator) ReindexProperty(ctx context.Context, className string, propName string) error {
+	m.reindexed <- className + "/" + propName
+	<-m.release
+	return nil
+}
+
+func TestUpdatePropertyTokenization(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+	migrator := &reindexPropertyMigrator{reindexed: make(chan string, 1), release: make(chan struct{})}
+	m.migrator = migrator
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+		},
+	}))
+
+	t.Run("invalid", func(t *testing.T) {
+		err := m.UpdatePropertyTokenization(ctx, nil, "Missing", "title", models.PropertyTokenizationField)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		for _, tc := range []struct{ prop, tokenization string }{
+			{"missing", models.PropertyTokenizationField},
+			{"wordCount", models.PropertyTokenizationField},
+			{"title", "unknown"},
+			{"title", models.PropertyTokenizationWord},
+		} {
+			err := m.UpdatePropertyTokenization(ctx, nil, "Article", tc.prop, tc.tokenization)
+			assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{}, tc)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		err := m.UpdatePropertyTokenization(ctx, nil, "Article", "title", models.PropertyTokenizationField)
+		require.Nil(t, err)
+
+		prop := findProperty(m.getClassByName("Article"), "title")
+		assert.Equal(t, models.PropertyTokenizationField, prop.Tokenization)
+		assert.Equal(t, "Article/title", <-migrator.reindexed)
+	})
+
+	t.Run("another update waits for the reindex", func(t *testing.T) {
+		err := m.UpdatePropertyTokenization(ctx, nil, "Article", "title", models.PropertyTokenizationWord)
+		assert.ErrorContains(t, err, "still being reindexed")
+
+		close(migrator.release)
+		assert.Eventually(t, func() bool {
+			_, ok := m.propertyReindexes.Load(propertyCleanupKey("Article", "title"))
+			return !ok
+		}, time.Second, 10*time.Millisecond)
+
+		migrator.release = make(chan struct{})
+		close(migrator.release)
+		err = m.UpdatePropertyTokenization(ctx, nil, "Article", "title", models.PropertyTokenizationWord)
+		require.Nil(t, err)
+		assert.Equal(t, "Article/title", <-migrator.reindexed)
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ReadCache holds a value derived from the schema, such as a lookup table
+// for a hot path. It is loaded on first use and dropped by Invalidate, which
+// subscribers call when they are notified about a relevant change.
+//
+// A nil or disabled cache loads on every call. It must be enabled only if
+// changes are reported, otherwise it would go stale.
+type ReadCache[T any] struct {
+	enabled bool
+
+	sync.Mutex
+	current    atomic.Pointer[T]
+	generation atomic.Uint64
+}
+
+func NewReadCache[T any](enabled bool) *ReadCache[T] {
+	return &ReadCache[T]{enabled: enabled}
+}
+
+// Get returns the cached value or loads it. A load which races with an
+// invalidation is returned, but not cached, as it might be outdated.
+func (c *ReadCache[T]) Get(load func() T) T {
+	if c == nil || !c.enabled {
+		return load()
+	}
+	if v := c.current.Load(); v != nil {
+		return *v
+	}
+
+	generation := c.generation.Load()
+	v := load()
+
+	c.Lock()
+	defer c.Unlock()
+	if c.generation.Load() == generation {
+		c.current.Store(&v)
+	}
+	return v
+}
+
+func (c *ReadCache[T]) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.generation.Add(1)
+	c.current.Store(nil)
+}
@@ -92,6 +92,21 @@ func Equal(lhs, rhs *State) error {
 	if err := equalSharding(lhs.ShardingState, rhs.ShardingState); err != nil {
 		return fmt.Errorf("sharding state mismatch: %w", err)
 	}
+	if err := equalAliases(lhs.Aliases, rhs.Aliases); err != nil {
+		return fmt.Errorf("aliases mismatch: %w", err)
+	}
+	return nil
+}
+
+func equalAliases(l, r map[string]string) error {
+	if m, n := len(l), len(r); m != n {
+		return fmt.Errorf("alias count mismatch: %d!=%d", m, n)
+	}
+	for alias, class := range l {
+		if other, ok := r[alias]; !ok || other != class {
+			return fmt.Errorf("alias %s: %q %q", alias, class, other)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,277 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
+)
+
+// RenameClass renames class from to to. The data of the class is not
+// copied: the index keeps its files and objects are labelled with the new
+// name when they are read. The old name stays usable as an alias of the
+// renamed class and cross-references to the class are updated.
+func (m *Manager) RenameClass(ctx context.Context, principal *models.Principal,
+	from, to string,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionClassRename, Class: from, Resource: to}, err)
+	}()
+
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return err
+	}
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(from, "")); err != nil {
+		return err
+	}
+	to = schema.UppercaseClassName(to)
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(to, "")); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.getClassByName(from) == nil {
+		return fmt.Errorf("class %q: %w", from, ErrNotFound)
+	}
+	if err := m.validateRenameClass(from, to); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	request := RenameClassPayload{From: from, To: to}
+	tx, err := m.cluster.BeginTransaction(ctx, renameClass, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.renameClassApplyChanges(ctx, from, to))
+}
+
+// RenameProperty renames property from of class to to. Neither the inverted
+// nor the vector index are rebuilt: the buckets of the property are renamed
+// and the stored objects rewritten in the background, which is tracked as a
+// job. Objects which are read before get the new name.
+func (m *Manager) RenameProperty(ctx context.Context, principal *models.Principal,
+	class, from, to string,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionPropertyRename, Class: class, Resource: from}, err)
+	}()
+
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return err
+	}
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, "")); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	to = schema.LowercaseFirstLetter(to)
+	if err := validateRenameProperty(cls, from, to); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	request := RenamePropertyPayload{Class: cls.Class, From: from, To: to}
+	tx, err := m.cluster.BeginTransaction(ctx, renameProperty, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.renamePropertyApplyChanges(ctx, cls.Class, from, to))
+}
+
+func (m *Manager) validateRenameClass(from, to string) error {
+	if from == to {
+		return fmt.Errorf("class %q already has this name", from)
+	}
+	if _, err := schema.ValidateClassName(to); err != nil {
+		return err
+	}
+	for _, cls := range m.schemaCache.ObjectSchema.Classes {
+		if cls.Class != from && strings.EqualFold(to, cls.Class) {
+			return fmt.Errorf("class name %q already exists", to)
+		}
+	}
+	for alias, target := range m.schemaCache.Aliases {
+		// renaming a class back to one of its aliases is fine
+		if target != from && strings.EqualFold(to, alias) {
+			return fmt.Errorf("class name %q already exists as an alias", to)
+		}
+	}
+	for class, ss := range m.schemaCache.ShardingState {
+		if class != from && ss != nil && strings.EqualFold(to, ss.IndexID) {
+			return fmt.Errorf("class name %q is still used by the data of class %q", to, class)
+		}
+	}
+	return nil
+}
+
+func validateRenameProperty(class *models.Class, from, to string) error {
+	var prop *models.Property
+	for _, p := range class.Properties {
+		if p.Name == from {
+			prop = p
+			continue
+		}
+		if strings.EqualFold(p.Name, to) {
+			return fmt.Errorf("property %q already exists in class %q", to, class.Class)
+		}
+	}
+	if prop == nil {
+		return fmt.Errorf("property %q not found in class %q", from, class.Class)
+	}
+	if from == to {
+		return fmt.Errorf("property %q already has this name", from)
+	}
+	if _, err := schema.ValidatePropertyName(to); err != nil {
+		return err
+	}
+	if err := schema.ValidateReservedPropertyName(to); err != nil {
+		return err
+	}
+	if dt, _ := schema.AsPrimitive(prop.DataType); dt == schema.DataTypeGeoCoordinates {
+		return fmt.Errorf("renaming %s properties is not supported", dt)
+	}
+	return nil
+}
+
+func (m *Manager) renameClassApplyChanges(ctx context.Context, from, to string) error {
+	found := false
+	m.schemaCache.LockGuard(func() {
+		cls := m.getClassByName(from)
+		if cls == nil {
+			return
+		}
+		found = true
+		cls.Class = to
+
+		if ss, ok := m.schemaCache.ShardingState[from]; ok {
+			// ss.IndexID is kept, the files of the class are not moved
+			m.schemaCache.ShardingState[to] = ss
+			delete(m.schemaCache.ShardingState, from)
+		}
+
+		for _, c := range m.schemaCache.ObjectSchema.Classes {
+			for _, prop := range c.Properties {
+				for i, dt := range prop.DataType {
+					if dt == from {
+						prop.DataType[i] = to
+					}
+				}
+			}
+		}
+
+		if m.schemaCache.Aliases == nil {
+			m.schemaCache.Aliases = make(map[string]string, 1)
+		}
+		for alias, target := range m.schemaCache.Aliases {
+			if target == from {
+				m.schemaCache.Aliases[alias] = to
+			}
+		}
+		delete(m.schemaCache.Aliases, to)
+		m.schemaCache.Aliases[from] = to
+	})
+	if !found {
+		m.logger.WithField("action", "rename_class").
+			WithField("class", from).Warn("class not found")
+		return nil
+	}
+
+	if err := m.schemaCache.RLockGuard(func() error {
+		return m.repo.Save(ctx, m.schemaCache.State)
+	}); err != nil {
+		return fmt.Errorf("save schema: %w", err)
+	}
+
+	// will result in a mismatch between schema and index if this fails
+	if err := m.migrator.UpdateClass(ctx, from, &to); err != nil {
+		return fmt.Errorf("rename index: %w", err)
+	}
+
+	m.logger.WithField("action", "rename_class").
+		WithField("class", from).WithField("new_name", to).Debug("")
+	m.triggerSchemaUpdateCallbacks(Change{Type: ClassRenamed, Class: to, Previous: from})
+	return nil
+}
+
+func (m *Manager) renamePropertyApplyChanges(ctx context.Context,
+	className, from, to string,
+) error {
+	// the rename is recorded before the schema changes, so the buckets of the
+	// property are found under the new name right away and the migration is
+	// completed or rolled back if the node crashes in between
+	if err := m.migrator.UpdateProperty(ctx, className, from, &to); err != nil {
+		return fmt.Errorf("rename property data: %w", err)
+	}
+
+	var class *models.Class
+	m.schemaCache.LockGuard(func() {
+		if class = m.getClassByName(className); class == nil {
+			return
+		}
+		for _, prop := range class.Properties {
+			if prop.Name == from {
+				prop.Name = to
+			}
+		}
+	})
+	if class == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	var metadata []byte
+	if err := m.schemaCache.RLockGuard(func() (err error) {
+		metadata, err = json.Marshal(class)
+		return err
+	}); err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+
+	m.logger.WithField("action", "rename_property").WithField("class", className).
+		WithField("property", from).WithField("new_name", to).Debug("")
+	if err := m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata}); err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks(Change{
+		Type: PropertyRenamed, Class: className, Property: to, Previous: from,
+	})
+
+	m.migrateProperty(jobs.TypePropertyRename, className, from)
+	return nil
+}
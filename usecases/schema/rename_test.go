@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestRenameClass(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+		}))
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:      "Author",
+			Vectorizer: "none",
+			Properties: []*models.Property{{Name: "wrote", DataType: []string{"Article"}}},
+		}))
+		return m
+	}
+
+	t.Run("rename", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetAlias(ctx, nil, "Latest", "Article"))
+		require.Nil(t, m.RenameClass(ctx, nil, "Article", "post"))
+
+		assert.Nil(t, m.getClassByName("Article"))
+		require.NotNil(t, m.getClassByName("Post"))
+		ss := m.CopyShardingState("Post")
+		require.NotNil(t, ss)
+		assert.Equal(t, "Article", ss.IndexID)
+		assert.Nil(t, m.CopyShardingState("Article"))
+		assert.Equal(t, []string{"Post"}, m.getClassByName("Author").Properties[0].DataType)
+
+		aliases, err := m.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"Latest": "Post", "Article": "Post"}, aliases)
+		assert.Equal(t, aliases, m.repo.(*fakeRepo).schema.Aliases)
+		assert.NotNil(t, m.repo.(*fakeRepo).schema.ShardingState["Post"])
+	})
+
+	t.Run("rename back to the original name", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.RenameClass(ctx, nil, "Article", "Post"))
+		require.Nil(t, m.RenameClass(ctx, nil, "Post", "Article"))
+
+		require.NotNil(t, m.getClassByName("Article"))
+		aliases, err := m.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"Post": "Article"}, aliases)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := newManager(t)
+		assert.ErrorIs(t, m.RenameClass(ctx, nil, "Missing", "Post"), ErrNotFound)
+		err := m.RenameClass(ctx, nil, "Article", "Author")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+		err = m.RenameClass(ctx, nil, "Article", "Article")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+		err = m.RenameClass(ctx, nil, "Article", "not-a-class")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+	})
+
+	t.Run("original name stays reserved for the data", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.RenameClass(ctx, nil, "Article", "Post"))
+		require.Nil(t, m.DeleteAlias(ctx, nil, "Article"))
+
+		err := m.AddClass(ctx, nil, &models.Class{Class: "Article", Vectorizer: "none"})
+		assert.ErrorContains(t, err, "still used by the data")
+		err = m.RenameClass(ctx, nil, "Author", "Article")
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+	})
+}
+
+func TestRenameProperty(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "body", DataType: schema.DataTypeText.PropString()},
+				{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+			},
+		}))
+		return m
+	}
+
+	t.Run("rename", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.RenameProperty(ctx, nil, "Article", "title", "Headline"))
+
+		props := m.getClassByName("Article").Properties
+		assert.Equal(t, "headline", props[0].Name)
+		assert.Equal(t, "body", props[1].Name)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := newManager(t)
+		assert.ErrorIs(t, m.RenameProperty(ctx, nil, "Missing", "title", "headline"), ErrNotFound)
+		for _, tc := range []struct{ from, to string }{
+			{"missing", "headline"},
+			{"title", "Body"},
+			{"title", "title"},
+			{"title", "not-a-prop"},
+			{"title", "_additional"},
+			{"location", "place"},
+		} {
+			err := m.RenameProperty(ctx, nil, "Article", tc.from, tc.to)
+			assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{}, tc)
+		}
+	})
+}
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// validateMultiTenancyConfig checks the settings of tenants in shared
+// shards, which require multi-tenancy
+func validateMultiTenancyConfig(class *models.Class) error {
+	cfg := class.MultiTenancyConfig
+	if cfg == nil {
+		return nil
+	}
+	if cfg.SharedShards < 0 {
+		return fmt.Errorf("multiTenancyConfig.sharedShards must not be negative")
+	}
+	if cfg.PromotionThreshold < 0 {
+		return fmt.Errorf("multiTenancyConfig.promotionThreshold must not be negative")
+	}
+	if !cfg.Enabled && (cfg.SharedShards > 0 || cfg.PromotionThreshold > 0) {
+		return fmt.Errorf("multiTenancyConfig.sharedShards and promotionThreshold " +
+			"require multi-tenancy to be enabled")
+	}
+	return nil
+}
+
+// validateTenantCentroids checks that centroids are only given for tenants
+// which are placed in shared shards
+func validateTenantCentroids(cls *models.Class, tenants []*models.Tenant) error {
+	if cls.MultiTenancyConfig.SharedShards > 0 {
+		return nil
+	}
+	for _, tenant := range tenants {
+		if len(tenant.Centroid) > 0 {
+			return uco.NewErrInvalidUserInput("centroid of tenant %q requires "+
+				"multiTenancyConfig.sharedShards of class %q to be set", tenant.Name, cls.Class)
+		}
+	}
+	return nil
+}
+
+// sharedTenantsPayload places new tenants in the shared shards of the class,
+// next to the tenants with the closest centroids if they have a centroid.
+// Shared shards which do not exist yet are created along with the tenants,
+// tenants which exist already are left where they are.
+func (m *Manager) sharedTenantsPayload(cls *models.Class, tenants []*models.Tenant,
+) (AddTenantsPayload, error) {
+	request := AddTenantsPayload{Class: cls.Class}
+	count := int(cls.MultiTenancyConfig.SharedShards)
+
+	placement := make(map[string]string, len(tenants))
+	nodes := make(map[string][]string)
+	var missing []string
+	err := m.schemaCache.RLockGuard(func() error {
+		st := m.schemaCache.ShardingState[cls.Class]
+		if st == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		shared := st.SharedPlacement(count)
+		for _, tenant := range tenants {
+			if _, ok := st.Physical[tenant.Name]; ok {
+				continue
+			}
+			shard, err := shared.Place(tenant.Name, tenant.Centroid)
+			if err != nil {
+				return uco.NewErrInvalidUserInput("%s", err.Error())
+			}
+			placement[tenant.Name] = shard
+			if _, ok := nodes[shard]; ok {
+				continue
+			}
+			if p, ok := st.Physical[shard]; ok {
+				nodes[shard] = p.BelongsToNodes
+			} else {
+				nodes[shard] = nil
+				missing = append(missing, shard)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return request, err
+	}
+
+	if len(missing) > 0 {
+		partitions, err := m.getPartitions(cls, missing)
+		if err != nil {
+			return request, fmt.Errorf("get partitions from class %q: %w", cls.Class, err)
+		}
+		for name, owners := range partitions {
+			nodes[name] = owners
+			request.Tenants = append(request.Tenants, Tenant{Name: name, Nodes: owners, Shared: true})
+		}
+	}
+	for _, tenant := range tenants {
+		if shard, ok := placement[tenant.Name]; ok {
+			request.Tenants = append(request.Tenants, Tenant{
+				Name: tenant.Name, Nodes: nodes[shard], SharedShard: shard,
+				Centroid: tenant.Centroid,
+			})
+		}
+	}
+	return request, nil
+}
+
+// addTo adds the tenant to the sharding state
+func (t Tenant) addTo(st *sharding.State) sharding.Physical {
+	if t.SharedShard != "" {
+		p := st.AddSharedPartition(t.Name, t.SharedShard, t.Nodes)
+		p.Quota = t.Quota
+		p.Centroid = t.Centroid
+		st.Physical[t.Name] = p
+		return p
+	}
+	p := st.AddPartition(t.Name, t.Nodes)
+	p.Shared = t.Shared
+	p.Quota = t.Quota
+	p.Centroid = t.Centroid
+	st.Physical[t.Name] = p
+	return p
+}
+
+// PromoteTenant moves a tenant out of its shared shard into a dedicated
+// shard. The shard is placed on the nodes of the shared shard, so every
+// replica moves its objects locally. Nothing is done if the tenant has a
+// dedicated shard already.
+func (m *Manager) PromoteTenant(ctx context.Context, class, tenant string) error {
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+
+	var request AddTenantsPayload
+	err := m.schemaCache.RLockGuard(func() error {
+		st := m.schemaCache.ShardingState[cls.Class]
+		if st == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		p, ok := st.Physical[tenant]
+		if !ok {
+			return fmt.Errorf("tenant %q: %w", tenant, ErrNotFound)
+		}
+		if p.SharedShard == "" {
+			return nil
+		}
+		nodes := append([]string{}, st.Physical[p.SharedShard].BelongsToNodes...)
+		copied := p.DeepCopy()
+		request = AddTenantsPayload{
+			Class: cls.Class,
+			Tenants: []Tenant{{
+				Name: tenant, Nodes: nodes, PromotedFrom: p.SharedShard,
+				Quota: copied.Quota, Centroid: copied.Centroid,
+			}},
+		}
+		return nil
+	})
+	if err != nil || len(request.Tenants) == 0 {
+		return err
+	}
+
+	m.logger.WithField("action", "promote_tenant").WithField("class", cls.Class).
+		WithField("tenant", tenant).Info("moving tenant into a dedicated shard")
+	return m.addTenants(ctx, cls, request)
+}
+
+// deleteSharedTenants deletes the objects of tenants in shared shards,
+// which are not removed along with the shards of the other tenants
+func (m *Manager) deleteSharedTenants(ctx context.Context, class *models.Class, tenants []string) {
+	shards := make(map[string]string, len(tenants))
+	m.schemaCache.RLockGuard(func() error {
+		if st := m.schemaCache.ShardingState[class.Class]; st != nil {
+			for _, name := range tenants {
+				if p, ok := st.Physical[name]; ok && p.SharedShard != "" {
+					shards[name] = p.SharedShard
+				}
+			}
+		}
+		return nil
+	})
+
+	for name, shard := range shards {
+		if err := m.migrator.MoveSharedTenant(ctx, class, name, shard, ""); err != nil {
+			m.logger.WithField("action", "delete_tenants").WithField("class", class.Class).
+				WithField("tenant", name).WithError(err).
+				Error("could not delete the objects of the tenant from its shared shard")
+		}
+	}
+}
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/jobs"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	SplitStatusStarted = "STARTED"
+	SplitStatusSuccess = "SUCCESS"
+	SplitStatusFailed  = "FAILED"
+)
+
+// SplitStatus reports the progress of increasing the shard count of a class
+type SplitStatus struct {
+	Class      string `json:"class"`
+	Status     string `json:"status"`
+	ShardCount int    `json:"shardCount"`
+	Error      string `json:"error,omitempty"`
+	JobID      string `json:"jobId,omitempty"`
+}
+
+// split tracks a running split of the shards of a class
+type split struct {
+	sync.Mutex
+	status SplitStatus
+	job    *jobs.Handle
+}
+
+func (s *split) get() SplitStatus {
+	s.Lock()
+	defer s.Unlock()
+	return s.status
+}
+
+func (s *split) done(err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.job.Done(err)
+	if err != nil {
+		s.status.Status = SplitStatusFailed
+		s.status.Error = err.Error()
+		return
+	}
+	s.status.Status = SplitStatusSuccess
+}
+
+// SplitShards increases the number of shards of a class to count, see
+// scaler.SplitShards. The objects are moved in the background, the returned
+// status and SplitStatus report the progress. The detailed status is only
+// known to the node which coordinates the split. If a split of the class is
+// pending, because it was interrupted, it is resumed instead.
+func (m *Manager) SplitShards(ctx context.Context, principal *models.Principal,
+	className string, count int,
+) (SplitStatus, error) {
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return SplitStatus{}, err
+	}
+
+	ss := m.CopyShardingState(className)
+	if ss == nil {
+		return SplitStatus{}, ErrNotFound
+	}
+	if ss.PendingSplit != nil {
+		return m.startSplit(className, len(ss.Physical), m.resumeSplit), nil
+	}
+	// fail right away if the split is not possible
+	if _, err := ss.SplitShards(count); err != nil {
+		return SplitStatus{}, enterrors.NewErrUnprocessable(err)
+	}
+
+	return m.startSplit(className, count, func(ctx context.Context, className string) error {
+		return m.splitShards(ctx, className, count)
+	}), nil
+}
+
+// ResumeSplits resumes the pending splits of all classes in the background,
+// see scaler.ResumeSplit. It is called once the node is ready to serve.
+func (m *Manager) ResumeSplits() {
+	var pending []string
+	m.schemaCache.RLock()
+	for className, ss := range m.schemaCache.ShardingState {
+		if ss.PendingSplit != nil {
+			pending = append(pending, className)
+		}
+	}
+	m.schemaCache.RUnlock()
+
+	for _, className := range pending {
+		ss := m.CopyShardingState(className)
+		if ss == nil {
+			continue
+		}
+		m.logger.WithField("action", "split_shards").WithField("class", className).
+			Info("resume pending split")
+		m.startSplit(className, len(ss.Physical), m.resumeSplit)
+	}
+}
+
+// startSplit runs fn in the background unless a split of the class is
+// running already, it returns the status of the running split
+func (m *Manager) startSplit(className string, count int,
+	fn func(ctx context.Context, className string) error,
+) SplitStatus {
+	s := &split{status: SplitStatus{
+		Class:      className,
+		Status:     SplitStatusStarted,
+		ShardCount: count,
+	}}
+	if prev, loaded := m.splits.LoadOrStore(className, s); loaded {
+		if status := prev.(*split).get(); status.Status == SplitStatusStarted {
+			return status // already splitting
+		}
+		m.splits.Store(className, s)
+	}
+
+	s.job = m.jobs.Track(jobs.TypeShardSplit, className, nil)
+	s.status.JobID = s.job.ID()
+
+	go func() {
+		err := fn(context.Background(), className)
+		if err != nil {
+			m.logger.WithField("action", "split_shards").WithField("class", className).
+				WithError(err).Error("split shards")
+		}
+		s.done(err)
+	}()
+	return s.get()
+}
+
+// SplitStatus returns the progress of increasing the shard count of a class.
+// A pending split which is not tracked by this node is reported as started.
+func (m *Manager) SplitStatus(ctx context.Context, principal *models.Principal,
+	className string,
+) (SplitStatus, error) {
+	if err := m.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return SplitStatus{}, err
+	}
+	if s, ok := m.splits.Load(className); ok {
+		return s.(*split).get(), nil
+	}
+	if ss := m.CopyShardingState(className); ss != nil && ss.PendingSplit != nil {
+		return SplitStatus{
+			Class:      className,
+			Status:     SplitStatusStarted,
+			ShardCount: len(ss.Physical),
+		}, nil
+	}
+	return SplitStatus{}, fmt.Errorf("split of class %q: %w", className, ErrNotFound)
+}
+
+func (m *Manager) splitShards(ctx context.Context, className string, count int) error {
+	return m.scaleOut.SplitShards(ctx, className, count, m.splitStateUpdate(ctx, className))
+}
+
+func (m *Manager) resumeSplit(ctx context.Context, className string) error {
+	return m.scaleOut.ResumeSplit(ctx, className, m.splitStateUpdate(ctx, className))
+}
+
+// splitStateUpdate broadcasts the sharding states of a split. The manager is
+// only locked while a state is broadcast, not while objects are copied, so
+// the state is rejected if shards were removed or moved in the meantime.
+func (m *Manager) splitStateUpdate(ctx context.Context,
+	className string,
+) func(*sharding.State) error {
+	return func(state *sharding.State) error {
+		m.Lock()
+		defer m.Unlock()
+
+		class := m.getClassByName(className)
+		if class == nil {
+			return ErrNotFound
+		}
+		if err := checkSplitState(m.CopyShardingState(className), state); err != nil {
+			return err
+		}
+
+		updated := *class
+		updated.ShardingConfig = state.Config
+		return m.updateShardingState(ctx, className, &updated, state)
+	}
+}
+
+// checkSplitState makes sure that next keeps all shards of current on the
+// same nodes
+func checkSplitState(current, next *sharding.State) error {
+	if current == nil {
+		return ErrNotFound
+	}
+	for name, shard := range current.Physical {
+		updated, ok := next.Physical[name]
+		if !ok || !sameNodes(shard.BelongsToNodes, updated.BelongsToNodes) {
+			return fmt.Errorf("shard %q was changed during the split", name)
+		}
+	}
+	return nil
+}
+
+func sameNodes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// fakeShardSplitter splits shards by updating the sharding state directly
+type fakeShardSplitter struct {
+	fakeScaleOutManager
+	m   *Manager
+	err error
+	// copying is closed once the copy started, the copy completes once
+	// proceed is closed
+	copying, proceed chan struct{}
+	// interrupt fails the split after switching to the new shards
+	interrupt error
+}
+
+func (f *fakeShardSplitter) SplitShards(ctx context.Context, className string,
+	count int, update func(*sharding.State) error,
+) error {
+	if f.err != nil {
+		return f.err
+	}
+	ss := f.m.CopyShardingState(className).DeepCopy()
+	shards, err := ss.SplitShards(count)
+	if err != nil {
+		return err
+	}
+	if f.copying != nil {
+		close(f.copying)
+		<-f.proceed
+	}
+
+	ss.PendingSplit = &sharding.PendingSplit{Shards: shards, Since: 1}
+	if err := update(&ss); err != nil {
+		return err
+	}
+	if f.interrupt != nil {
+		return f.interrupt
+	}
+	return f.ResumeSplit(ctx, className, update)
+}
+
+func (f *fakeShardSplitter) ResumeSplit(ctx context.Context, className string,
+	update func(*sharding.State) error,
+) error {
+	ss := f.m.CopyShardingState(className).DeepCopy()
+	if ss.PendingSplit == nil {
+		return nil
+	}
+	ss.PendingSplit = nil
+	return update(&ss)
+}
+
+func TestSplitShards(t *testing.T) {
+	ctx := context.Background()
+	newManager := func() (*Manager, *fakeShardSplitter) {
+		m := newSchemaManager()
+		splitter := &fakeShardSplitter{m: m}
+		m.scaleOut = splitter
+		cfg, err := sharding.ParseConfig(map[string]interface{}{
+			"desiredCount": float64(2), "virtualPerPhysical": float64(4),
+		}, 1)
+		require.Nil(t, err)
+		ss, err := sharding.InitState("A", cfg, m.clusterState, 1, false)
+		require.Nil(t, err)
+		m.ObjectSchema.Classes = append(m.ObjectSchema.Classes,
+			&models.Class{Class: "A", ShardingConfig: cfg, VectorIndexConfig: fakeVectorConfig{}})
+		m.ShardingState["A"] = ss
+		return m, splitter
+	}
+
+	t.Run("split", func(t *testing.T) {
+		m, _ := newManager()
+		status, err := m.SplitShards(ctx, nil, "A", 4)
+		require.Nil(t, err)
+		assert.Equal(t, SplitStatus{Class: "A", Status: SplitStatusStarted, ShardCount: 4}, status)
+
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusSuccess
+		}, time.Second, time.Millisecond)
+		assert.Len(t, m.CopyShardingState("A").Physical, 4)
+		cfg := m.getClassByName("A").ShardingConfig.(sharding.Config)
+		assert.Equal(t, 4, cfg.DesiredCount)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		m, splitter := newManager()
+		splitter.err = errors.New("node unreachable")
+		_, err := m.SplitShards(ctx, nil, "A", 4)
+		require.Nil(t, err)
+
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusFailed
+		}, time.Second, time.Millisecond)
+		status, _ := m.SplitStatus(ctx, nil, "A")
+		assert.Equal(t, "node unreachable", status.Error)
+		assert.Len(t, m.CopyShardingState("A").Physical, 2)
+	})
+
+	t.Run("manager is not locked while copying", func(t *testing.T) {
+		m, splitter := newManager()
+		splitter.copying = make(chan struct{})
+		splitter.proceed = make(chan struct{})
+		_, err := m.SplitShards(ctx, nil, "A", 4)
+		require.Nil(t, err)
+
+		<-splitter.copying
+		require.True(t, m.TryLock())
+		m.Unlock()
+		close(splitter.proceed)
+
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusSuccess
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("interrupted split is resumed", func(t *testing.T) {
+		m, splitter := newManager()
+		splitter.interrupt = errors.New("node restarted")
+		_, err := m.SplitShards(ctx, nil, "A", 4)
+		require.Nil(t, err)
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusFailed
+		}, time.Second, time.Millisecond)
+		require.NotNil(t, m.CopyShardingState("A").PendingSplit)
+
+		// a restart loses the status tracked in memory
+		m.splits.Delete("A")
+		status, err := m.SplitStatus(ctx, nil, "A")
+		require.Nil(t, err)
+		assert.Equal(t, SplitStatusStarted, status.Status)
+		assert.Equal(t, 4, status.ShardCount)
+
+		splitter.interrupt = nil
+		m.ResumeSplits()
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusSuccess
+		}, time.Second, time.Millisecond)
+		assert.Nil(t, m.CopyShardingState("A").PendingSplit)
+		assert.Len(t, m.CopyShardingState("A").Physical, 4)
+	})
+
+	t.Run("splitting again resumes a pending split", func(t *testing.T) {
+		m, splitter := newManager()
+		splitter.interrupt = errors.New("node unreachable")
+		_, err := m.SplitShards(ctx, nil, "A", 4)
+		require.Nil(t, err)
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusFailed
+		}, time.Second, time.Millisecond)
+
+		splitter.interrupt = nil
+		status, err := m.SplitShards(ctx, nil, "A", 8)
+		require.Nil(t, err)
+		assert.Equal(t, 4, status.ShardCount)
+		assert.Eventually(t, func() bool {
+			s, err := m.SplitStatus(ctx, nil, "A")
+			return err == nil && s.Status == SplitStatusSuccess
+		}, time.Second, time.Millisecond)
+		assert.Nil(t, m.CopyShardingState("A").PendingSplit)
+		assert.Len(t, m.CopyShardingState("A").Physical, 4)
+	})
+
+	t.Run("invalid count", func(t *testing.T) {
+		m, _ := newManager()
+		for _, count := range []int{2, 9} {
+			_, err := m.SplitShards(ctx, nil, "A", count)
+			assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+		}
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		m, _ := newManager()
+		_, err := m.SplitShards(ctx, nil, "B", 4)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = m.SplitStatus(ctx, nil, "A")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
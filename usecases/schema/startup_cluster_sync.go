@@ -142,6 +142,9 @@ func (m *Manager) ClusterStatus(ctx context.Context) (*models.SchemaClusterStatu
 
 	nodes := m.clusterState.AllNames()
 	out.NodeCount = int64(len(nodes))
+	if m.consensus != nil {
+		return m.consensusStatus(out)
+	}
 	if len(nodes) < 2 {
 		out.Healthy = true
 		return out, nil
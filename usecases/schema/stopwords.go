@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/deepcopy"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetStopwords returns the stopword config of a class
+func (m *Manager) GetStopwords(ctx context.Context, principal *models.Principal,
+	class string,
+) (*models.StopwordConfig, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	return currentStopwords(cls), nil
+}
+
+// ReplaceStopwords replaces the stopword config of a class. If version is
+// not 0, the update is only applied if it matches the current version of
+// the config. Stopwords only affect queries, so the change applies to the
+// next query without reindexing.
+func (m *Manager) ReplaceStopwords(ctx context.Context, principal *models.Principal,
+	class string, version int64, conf *models.StopwordConfig,
+) (*models.StopwordConfig, error) {
+	return m.updateStopwords(ctx, principal, class, version,
+		func(current *models.StopwordConfig) error {
+			*current = models.StopwordConfig{
+				Preset:    conf.Preset,
+				Additions: conf.Additions,
+				Removals:  conf.Removals,
+			}
+			return nil
+		})
+}
+
+// UpdateStopwords makes the words of add stopwords and the ones of remove
+// regular words, on top of the current config of a class. Words are added
+// to or removed from the additions and removals of the config as needed
+// given its preset. The version works like for ReplaceStopwords.
+func (m *Manager) UpdateStopwords(ctx context.Context, principal *models.Principal,
+	class string, version int64, add, remove []string,
+) (*models.StopwordConfig, error) {
+	return m.updateStopwords(ctx, principal, class, version,
+		func(current *models.StopwordConfig) error {
+			return applyStopwordChanges(current, add, remove)
+		})
+}
+
+func (m *Manager) updateStopwords(ctx context.Context, principal *models.Principal,
+	class string, version int64, update func(current *models.StopwordConfig) error,
+) (conf *models.StopwordConfig, err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionStopwordsUpdate, Class: class}, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+
+	conf = currentStopwords(cls)
+	if version != 0 && version != conf.Version {
+		return nil, fmt.Errorf("stopwords of class %q are at version %d, not %d: %w",
+			cls.Class, conf.Version, version, ErrVersionConflict)
+	}
+	if err := update(conf); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	updated := deepcopy.InvertedIndexConfig(cls.InvertedIndexConfig)
+	if updated == nil {
+		updated = &models.InvertedIndexConfig{}
+	}
+	updated.Stopwords = conf
+
+	if err := m.updateInvertedIndexConfig(ctx, cls, updated); err != nil {
+		return nil, err
+	}
+	return currentStopwords(m.getClassByName(cls.Class)), nil
+}
+
+func currentStopwords(cls *models.Class) *models.StopwordConfig {
+	if cls.InvertedIndexConfig == nil || cls.InvertedIndexConfig.Stopwords == nil {
+		return &models.StopwordConfig{Preset: stopwords.EnglishPreset}
+	}
+	return deepcopy.InvertedIndexConfig(cls.InvertedIndexConfig).Stopwords
+}
+
+func applyStopwordChanges(conf *models.StopwordConfig, add, remove []string) error {
+	preset := conf.Preset
+	if preset == "" {
+		preset = stopwords.EnglishPreset
+	}
+	list, ok := stopwords.Presets[preset]
+	if !ok {
+		return fmt.Errorf("stopword preset %q does not exist", preset)
+	}
+	inPreset := make(map[string]struct{}, len(list))
+	for _, word := range list {
+		inPreset[word] = struct{}{}
+	}
+
+	removed := make(map[string]struct{}, len(remove))
+	for _, word := range remove {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("cannot remove an empty stopword")
+		}
+		removed[word] = struct{}{}
+	}
+
+	for _, word := range add {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("cannot add an empty stopword")
+		}
+		if _, ok := removed[word]; ok {
+			return fmt.Errorf("stopword %q cannot be added and removed at once", word)
+		}
+		conf.Removals = withoutWord(conf.Removals, word)
+		if _, ok := inPreset[word]; !ok && !containsWord(conf.Additions, word) {
+			conf.Additions = append(conf.Additions, word)
+		}
+	}
+
+	for _, word := range remove {
+		conf.Additions = withoutWord(conf.Additions, word)
+		if _, ok := inPreset[word]; ok && !containsWord(conf.Removals, word) {
+			conf.Removals = append(conf.Removals, word)
+		}
+	}
+
+	return nil
+}
+
+func containsWord(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutWord(words []string, word string) []string {
+	out := words[:0:0]
+	for _, w := range words {
+		if w != word {
+			out = append(out, w)
+		}
+	}
+	return out
+}
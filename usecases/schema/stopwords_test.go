@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type invertedConfigMigrator struct {
+	NilMigrator
+}
+
+func (m *invertedConfigMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context,
+	old, updated *models.InvertedIndexConfig,
+) error {
+	return inverted.ValidateUserConfigUpdate(old, updated)
+}
+
+func TestStopwords(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+	m.migrator = &invertedConfigMigrator{}
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	t.Run("defaults", func(t *testing.T) {
+		conf, err := m.GetStopwords(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "en", conf.Preset)
+		assert.Equal(t, int64(0), conf.Version)
+	})
+
+	t.Run("add and remove", func(t *testing.T) {
+		conf, err := m.UpdateStopwords(ctx, nil, "Article", 0,
+			[]string{"star", "the"}, []string{"a", "planet"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"star"}, conf.Additions, "preset words are not added")
+		assert.Equal(t, []string{"a"}, conf.Removals, "non-stopwords are not removed")
+		assert.Equal(t, int64(1), conf.Version)
+
+		conf, err = m.UpdateStopwords(ctx, nil, "Article", 1,
+			[]string{"a"}, []string{"star"})
+		require.Nil(t, err)
+		assert.Empty(t, conf.Additions)
+		assert.Empty(t, conf.Removals)
+		assert.Equal(t, int64(2), conf.Version)
+
+		stored := m.getClassByName("Article").InvertedIndexConfig.Stopwords
+		assert.Equal(t, conf, stored)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		conf, err := m.ReplaceStopwords(ctx, nil, "Article", 2,
+			&models.StopwordConfig{Preset: "none", Additions: []string{"star"}, Version: 100})
+		require.Nil(t, err)
+		assert.Equal(t, "none", conf.Preset)
+		assert.Equal(t, []string{"star"}, conf.Additions)
+		assert.Equal(t, int64(3), conf.Version)
+	})
+
+	t.Run("unchanged config keeps its version", func(t *testing.T) {
+		conf, err := m.UpdateStopwords(ctx, nil, "Article", 0, []string{"star"}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), conf.Version)
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		_, err := m.UpdateStopwords(ctx, nil, "Article", 2, []string{"moon"}, nil)
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := m.UpdateStopwords(ctx, nil, "Article", 0, []string{"moon"}, []string{"moon"})
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+
+		_, err = m.UpdateStopwords(ctx, nil, "Article", 0, []string{" "}, nil)
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+
+		_, err = m.ReplaceStopwords(ctx, nil, "Article", 0, &models.StopwordConfig{Preset: "xx"})
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+
+		_, err = m.GetStopwords(ctx, nil, "Missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
+	"github.com/weaviate/weaviate/entities/deepcopy"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetSynonyms returns the synonym rules of a class
+func (m *Manager) GetSynonyms(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]*models.SynonymRule, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if cls.InvertedIndexConfig == nil || cls.InvertedIndexConfig.Synonyms == nil {
+		return []*models.SynonymRule{}, nil
+	}
+	return deepcopy.InvertedIndexConfig(cls.InvertedIndexConfig).Synonyms, nil
+}
+
+// UpdateSynonyms replaces the synonym rules of a class. Synonyms are
+// expanded at query time, so the new rules apply to the next query without
+// reindexing.
+func (m *Manager) UpdateSynonyms(ctx context.Context, principal *models.Principal,
+	class string, rules []*models.SynonymRule,
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionSynonymsUpdate, Class: class}, err)
+	}()
+
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(class, ""))
+	if err != nil {
+		return err
+	}
+
+	if err := synonyms.Validate(rules); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+
+	updated := deepcopy.InvertedIndexConfig(cls.InvertedIndexConfig)
+	if updated == nil {
+		updated = &models.InvertedIndexConfig{}
+	}
+	updated.Synonyms = rules
+
+	return m.updateInvertedIndexConfig(ctx, cls, updated)
+}
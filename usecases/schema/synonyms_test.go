@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestUpdateSynonyms(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	t.Run("no synonyms", func(t *testing.T) {
+		rules, err := m.GetSynonyms(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		rules := []*models.SynonymRule{
+			{Terms: []string{"tv", "television"}},
+			{Terms: []string{"laptop", "notebook"}, Expansion: "oneWay"},
+		}
+		require.Nil(t, m.UpdateSynonyms(ctx, nil, "Article", rules))
+
+		got, err := m.GetSynonyms(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, rules, got)
+
+		class := m.getClassByName("Article")
+		require.Len(t, class.Properties, 1, "the rest of the class is unchanged")
+		assert.Equal(t, rules, class.InvertedIndexConfig.Synonyms)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		require.Nil(t, m.UpdateSynonyms(ctx, nil, "Article", nil))
+
+		got, err := m.GetSynonyms(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := m.UpdateSynonyms(ctx, nil, "Article", []*models.SynonymRule{
+			{Terms: []string{"tv"}},
+		})
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+
+		err = m.UpdateSynonyms(ctx, nil, "Missing", nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = m.GetSynonyms(ctx, nil, "Missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -16,9 +16,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -35,9 +38,28 @@ func (m *Manager) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (err error) {
+	defer func() {
+		names := make([]string, 0, len(tenants))
+		for _, tenant := range tenants {
+			if tenant != nil {
+				names = append(names, tenant.Name)
+			}
+		}
+		m.auditLog.Record(ctx, principal, tenantsEvent(audit.ActionTenantsCreate, class, names), err)
+	}()
+
 	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
 		return err
 	}
+	for _, tenant := range tenants {
+		if tenant == nil {
+			continue
+		}
+		resource := authorization.SchemaResource(class, tenant.Name)
+		if err := m.Authorizer.Authorize(principal, "update", resource); err != nil {
+			return err
+		}
+	}
 	tenantNames := make([]string, len(tenants))
 	for i, tenant := range tenants {
 		tenantNames[i] = tenant.Name
@@ -47,6 +69,9 @@ func (m *Manager) AddTenants(ctx context.Context,
 	if err := validateTenants(tenantNames); err != nil {
 		return err
 	}
+	if err := validateTenantQuotas(tenants); err != nil {
+		return err
+	}
 	cls := m.getClassByName(class)
 	if cls == nil {
 		return fmt.Errorf("class %q: %w", class, ErrNotFound)
@@ -54,8 +79,19 @@ func (m *Manager) AddTenants(ctx context.Context,
 	if !schema.MultiTenancyEnabled(cls) {
 		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
 	}
+	if err := validateTenantCentroids(cls, tenants); err != nil {
+		return err
+	}
 
 	// create transaction payload
+	if cls.MultiTenancyConfig.SharedShards > 0 {
+		request, err := m.sharedTenantsPayload(cls, tenants)
+		if err != nil {
+			return err
+		}
+		request.setQuotas(tenants)
+		return m.addTenants(ctx, cls, request)
+	}
 	partitions, err := m.getPartitions(cls, tenantNames)
 	if err != nil {
 		return fmt.Errorf("get partitions from class %q: %w", class, err)
@@ -69,7 +105,54 @@ func (m *Manager) AddTenants(ctx context.Context,
 		request.Tenants[i] = Tenant{Name: name, Nodes: owners}
 		i++
 	}
+	request.setQuotas(tenants)
+
+	return m.addTenants(ctx, cls, request)
+}
+
+// RestoreTenants adds tenants whose files have been restored from a backup
+// on this node. The tenants are owned by this node only and must not exist.
+func (m *Manager) RestoreTenants(ctx context.Context, class string, tenants []string) error {
+	if err := validateTenants(tenants); err != nil {
+		return err
+	}
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+
+	request := AddTenantsPayload{
+		Class:   class,
+		Tenants: make([]Tenant, len(tenants)),
+	}
+	node := m.NodeName()
+	err := m.schemaCache.RLockGuard(func() error {
+		st := m.schemaCache.ShardingState[cls.Class]
+		if st == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		for i, name := range tenants {
+			if _, ok := st.Physical[name]; ok {
+				return fmt.Errorf("tenant %q already exists in class %q", name, class)
+			}
+			request.Tenants[i] = Tenant{Name: name, Nodes: []string{node}}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.addTenants(ctx, cls, request)
+}
 
+// addTenants adds tenants to all nodes using a cluster-wide transaction
+func (m *Manager) addTenants(ctx context.Context, cls *models.Class,
+	request AddTenantsPayload,
+) error {
 	// open cluster-wide transaction
 	tx, err := m.cluster.BeginTransaction(ctx, addTenants,
 		request, DefaultTxTTL)
@@ -81,7 +164,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	err = m.onAddTenants(ctx, cls, request) // actual update
+	err = m.applied(tx, m.onAddTenants(ctx, cls, request)) // actual update
 	if err != nil {
 		m.logger.WithField("action", "add_tenants").
 			WithField("n", len(request.Tenants)).
@@ -131,7 +214,7 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	pairs := make([]KeyValuePair, 0, len(request.Tenants))
 	for _, p := range request.Tenants {
 		if _, ok := st.Physical[p.Name]; !ok {
-			p := st.AddPartition(p.Name, p.Nodes)
+			p := p.addTo(&st)
 			data, err := json.Marshal(p)
 			if err != nil {
 				return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
@@ -140,8 +223,11 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 		}
 	}
 	shards := make([]string, 0, len(request.Tenants))
+	var promoted []Tenant
 	for _, p := range request.Tenants {
-		if st.IsLocalShard(p.Name) {
+		if p.PromotedFrom != "" {
+			promoted = append(promoted, p)
+		} else if p.SharedShard == "" && st.IsLocalShard(p.Name) {
 			shards = append(shards, p.Name)
 		}
 	}
@@ -151,6 +237,15 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 		return fmt.Errorf("migrator.new_tenants: %w", err)
 	}
 
+	// promoted tenants keep using their shared shard until their objects
+	// have been moved
+	for _, p := range promoted {
+		if err := m.migrator.MoveSharedTenant(ctx, class, p.Name, p.PromotedFrom, p.Name); err != nil {
+			commit(false)
+			return fmt.Errorf("move tenant %q out of shared shard: %w", p.Name, err)
+		}
+	}
+
 	m.logger.
 		WithField("action", "schema.add_tenants").
 		Debug("saving updated schema to configuration store")
@@ -166,6 +261,19 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 			ost.Physical[name] = p
 		}
 	})
+	m.notifySubscribers(Change{
+		Type: TenantsAdded, Class: class.Class, Tenants: tenantNames(request.Tenants),
+	})
+
+	// objects written while the first move was running are still in the
+	// shared shard
+	for _, p := range promoted {
+		if err := m.migrator.MoveSharedTenant(ctx, class, p.Name, p.PromotedFrom, p.Name); err != nil {
+			m.logger.WithField("action", "promote_tenant").WithField("class", class.Class).
+				WithField("tenant", p.Name).WithError(err).
+				Error("could not move the remaining objects out of the shared shard")
+		}
+	}
 
 	return nil
 }
@@ -173,10 +281,20 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 // DeleteTenants is used to delete tenants of a class.
 //
 // Class must exist and has partitioning enabled
-func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
+func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal, tenantsEvent(audit.ActionTenantsDelete, class, tenants), err)
+	}()
+
 	if err := m.Authorizer.Authorize(principal, "delete", tenantsPath); err != nil {
 		return err
 	}
+	for _, tenant := range tenants {
+		resource := authorization.SchemaResource(class, tenant)
+		if err := m.Authorizer.Authorize(principal, "delete", resource); err != nil {
+			return err
+		}
+	}
 	// validation
 	if err := validateTenants(tenants); err != nil {
 		return err
@@ -205,11 +323,13 @@ func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	return m.onDeleteTenants(ctx, cls, request) // actual update
+	return m.applied(tx, m.onDeleteTenants(ctx, cls, request)) // actual update
 }
 
 func (m *Manager) onDeleteTenants(ctx context.Context, class *models.Class, req DeleteTenantsPayload,
 ) error {
+	m.deleteSharedTenants(ctx, class, req.Tenants)
+
 	commit, err := m.migrator.DeleteTenants(ctx, class, req.Tenants)
 	if err != nil {
 		m.logger.WithField("action", "delete_tenants").
@@ -234,6 +354,7 @@ func (m *Manager) onDeleteTenants(ctx context.Context, class *models.Class, req
 			}
 		}
 	})
+	m.notifySubscribers(Change{Type: TenantsDeleted, Class: class.Class, Tenants: req.Tenants})
 
 	return nil
 }
@@ -245,6 +366,10 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 	if err := m.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
 		return nil, err
 	}
+	resource := authorization.SchemaResource(class, "")
+	if err := m.Authorizer.Authorize(principal, "get", resource); err != nil {
+		return nil, err
+	}
 	// validation
 	cls := m.getClassByName(class)
 	if cls == nil {
@@ -257,15 +382,31 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 	var tenants []*models.Tenant
 	m.schemaCache.RLockGuard(func() error {
 		if ss := m.schemaCache.ShardingState[cls.Class]; ss != nil {
-			tenants = make([]*models.Tenant, len(ss.Physical))
-			i := 0
-			for tenant := range ss.Physical {
-				tenants[i] = &models.Tenant{Name: tenant}
-				i++
+			tenants = make([]*models.Tenant, 0, len(ss.Physical))
+			for tenant, p := range ss.Physical {
+				if !p.Shared {
+					copied := p.DeepCopy()
+					tenants = append(tenants, &models.Tenant{
+						Name:     tenant,
+						Quota:    copied.Quota,
+						Centroid: copied.Centroid,
+					})
+				}
 			}
 		}
 		return nil
 	})
+	m.addTenantUsage(ctx, cls.Class, tenants)
 
 	return tenants, nil
 }
+
+// tenantsEvent lists the names of the tenants in the resource of the event
+func tenantsEvent(action, class string, tenants []string) audit.Event {
+	return audit.Event{
+		Action:   action,
+		Class:    class,
+		Resource: strings.Join(tenants, ","),
+		Count:    len(tenants),
+	}
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+// UpdateTenantQuotas replaces the quotas of existing tenants. A tenant
+// without a quota or with a quota of zeros has no limits.
+func (m *Manager) UpdateTenantQuotas(ctx context.Context, principal *models.Principal,
+	class string, tenants []*models.Tenant,
+) (err error) {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if tenant != nil {
+			names = append(names, tenant.Name)
+		}
+	}
+	defer func() {
+		m.auditLog.Record(ctx, principal, tenantsEvent(audit.ActionTenantsUpdate, class, names), err)
+	}()
+
+	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+		return err
+	}
+	for _, name := range names {
+		resource := authorization.SchemaResource(class, name)
+		if err := m.Authorizer.Authorize(principal, "update", resource); err != nil {
+			return err
+		}
+	}
+
+	// validation
+	if len(names) != len(tenants) {
+		return uco.NewErrInvalidUserInput("tenants must not be null")
+	}
+	if err := validateTenants(names); err != nil {
+		return err
+	}
+	if err := validateTenantQuotas(tenants); err != nil {
+		return err
+	}
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+
+	request := UpdateTenantsPayload{
+		Class:   cls.Class,
+		Tenants: make([]Tenant, len(tenants)),
+	}
+	err = m.schemaCache.RLockGuard(func() error {
+		st := m.schemaCache.ShardingState[cls.Class]
+		if st == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		for i, tenant := range tenants {
+			if p, ok := st.Physical[tenant.Name]; !ok || p.Shared {
+				return fmt.Errorf("tenant %q: %w", tenant.Name, ErrNotFound)
+			}
+			request.Tenants[i] = Tenant{Name: tenant.Name, Quota: normalizeQuota(tenant.Quota)}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// open cluster-wide transaction
+	tx, err := m.cluster.BeginTransaction(ctx, updateTenants, request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.applied(tx, m.onUpdateTenants(ctx, request)) // actual update
+}
+
+func (m *Manager) onUpdateTenants(ctx context.Context, req UpdateTenantsPayload) error {
+	pairs := make([]KeyValuePair, 0, len(req.Tenants))
+	err := m.schemaCache.RLockGuard(func() error {
+		st := m.schemaCache.ShardingState[req.Class]
+		if st == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		for _, tenant := range req.Tenants {
+			p, ok := st.Physical[tenant.Name]
+			if !ok {
+				continue
+			}
+			p = p.DeepCopy()
+			p.Quota = tenant.Quota
+			data, err := json.Marshal(p)
+			if err != nil {
+				return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
+			}
+			pairs = append(pairs, KeyValuePair{p.Name, data})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := m.repo.NewShards(ctx, req.Class, pairs); err != nil {
+		return err
+	}
+
+	m.schemaCache.LockGuard(func() {
+		st := m.schemaCache.ShardingState[req.Class]
+		for _, tenant := range req.Tenants {
+			if p, ok := st.Physical[tenant.Name]; ok {
+				p.Quota = tenant.Quota
+				st.Physical[tenant.Name] = p
+			}
+		}
+	})
+
+	m.notifySubscribers(Change{
+		Type: TenantsUpdated, Class: req.Class, Tenants: tenantNames(req.Tenants),
+	})
+	return nil
+}
+
+func validateTenantQuotas(tenants []*models.Tenant) error {
+	for _, tenant := range tenants {
+		if tenant == nil || tenant.Quota == nil {
+			continue
+		}
+		q := tenant.Quota
+		if q.MaxObjects < 0 || q.MaxVectors < 0 || q.MaxStorageBytes < 0 {
+			return uco.NewErrInvalidUserInput("quota of tenant %q must not be negative", tenant.Name)
+		}
+	}
+	return nil
+}
+
+// normalizeQuota returns nil for quotas without limits, so they are not
+// stored
+func normalizeQuota(q *models.TenantQuota) *models.TenantQuota {
+	if q == nil || (q.MaxObjects == 0 && q.MaxVectors == 0 && q.MaxStorageBytes == 0) {
+		return nil
+	}
+	copied := *q
+	return &copied
+}
+
+// setQuotas sets the quotas of the new tenants of the payload
+func (p *AddTenantsPayload) setQuotas(tenants []*models.Tenant) {
+	quotas := make(map[string]*models.TenantQuota, len(tenants))
+	for _, tenant := range tenants {
+		if tenant != nil {
+			quotas[tenant.Name] = normalizeQuota(tenant.Quota)
+		}
+	}
+	for i := range p.Tenants {
+		p.Tenants[i].Quota = quotas[p.Tenants[i].Name]
+	}
+}
+
+// addTenantUsage sets the usage of the tenants as counted by the nodes
+// which hold them. Tenants whose usage cannot be determined have none.
+func (m *Manager) addTenantUsage(ctx context.Context, class string, tenants []*models.Tenant) {
+	if len(tenants) == 0 {
+		return
+	}
+	names := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		names[i] = tenant.Name
+	}
+
+	usage, err := m.migrator.TenantUsage(ctx, class, names)
+	if err != nil {
+		m.logger.WithField("action", "get_tenants").WithField("class", class).
+			WithError(err).Warn("could not determine the usage of the tenants")
+		return
+	}
+	for _, tenant := range tenants {
+		tenant.Usage = usage[tenant.Name]
+	}
+}
@@ -125,6 +125,25 @@ func TestAddTenants(t *testing.T) {
 	}
 }
 
+func TestRestoreTenants(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	assert.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{{Name: "USER1"}}))
+
+	assert.Nil(t, sm.RestoreTenants(ctx, "C1", []string{"USER2"}))
+	ss := sm.schemaCache.ShardingState["C1"]
+	assert.Len(t, ss.Physical, 2)
+	assert.Equal(t, []string{sm.NodeName()}, ss.Physical["USER2"].BelongsToNodes)
+
+	assert.ErrorContains(t, sm.RestoreTenants(ctx, "C1", []string{"USER1"}), "already exists")
+	assert.ErrorContains(t, sm.RestoreTenants(ctx, "C2", []string{"USER3"}), ErrNotFound.Error())
+}
+
 func TestDeleteTenants(t *testing.T) {
 	var (
 		ctx     = context.Background()
@@ -249,3 +268,141 @@ func TestDeleteTenants(t *testing.T) {
 
 	}
 }
+
+func TestSharedTenants(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	assert.ErrorContains(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C0",
+		MultiTenancyConfig: &models.MultiTenancyConfig{SharedShards: 2},
+	}), "require multi-tenancy")
+	assert.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true, SharedShards: 2},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	tenants := []*models.Tenant{{Name: "USER1"}, {Name: "USER2"}, {Name: "USER3"}, {Name: "USER4"}}
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", tenants))
+
+	got, err := sm.GetTenants(ctx, nil, "C1")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, tenants, got)
+
+	ss := sm.schemaCache.ShardingState["C1"]
+	shared := ss.AllPhysicalShards()
+	assert.LessOrEqual(t, len(shared), 2)
+	for _, name := range shared {
+		assert.True(t, ss.Physical[name].Shared, name)
+	}
+	for _, tenant := range tenants {
+		shard := sm.TenantShard("C1", tenant.Name)
+		assert.Equal(t, ss.Physical[tenant.Name].SharedShard, shard)
+		assert.Contains(t, shared, shard)
+	}
+
+	// adding a tenant again does not move it
+	before := ss.Physical["USER1"]
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", tenants[:1]))
+	assert.Equal(t, before, sm.schemaCache.ShardingState["C1"].Physical["USER1"])
+
+	assert.Nil(t, sm.PromoteTenant(ctx, "C1", "USER1"))
+	assert.Equal(t, "USER1", sm.TenantShard("C1", "USER1"))
+	assert.Empty(t, sm.schemaCache.ShardingState["C1"].Physical["USER1"].SharedShard)
+	assert.Nil(t, sm.PromoteTenant(ctx, "C1", "USER1"), "promoting twice is a no-op")
+	assert.ErrorContains(t, sm.PromoteTenant(ctx, "C1", "USER5"), ErrNotFound.Error())
+
+	assert.Nil(t, sm.DeleteTenants(ctx, nil, "C1", []string{"USER2"}))
+	got, err = sm.GetTenants(ctx, nil, "C1")
+	assert.Nil(t, err)
+	assert.Len(t, got, 3)
+}
+
+func TestSharedTenantsByCentroid(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	assert.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true, SharedShards: 2},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	assert.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C2",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+
+	// the first two tenants seed the shards, the others join the closest
+	tenants := []*models.Tenant{
+		{Name: "A1", Centroid: []float32{0, 0}},
+		{Name: "B1", Centroid: []float32{10, 10}},
+		{Name: "B2", Centroid: []float32{9, 11}},
+		{Name: "A2", Centroid: []float32{1, -1}},
+	}
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", tenants))
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "A3", Centroid: []float32{-1, 1}},
+	}))
+
+	shardA, shardB := sm.TenantShard("C1", "A1"), sm.TenantShard("C1", "B1")
+	assert.NotEqual(t, shardA, shardB)
+	assert.Equal(t, shardA, sm.TenantShard("C1", "A2"))
+	assert.Equal(t, shardA, sm.TenantShard("C1", "A3"))
+	assert.Equal(t, shardB, sm.TenantShard("C1", "B2"))
+
+	got, err := sm.GetTenants(ctx, nil, "C1")
+	assert.Nil(t, err)
+	assert.Contains(t, got, &models.Tenant{Name: "A3", Centroid: []float32{-1, 1}})
+
+	assert.ErrorContains(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "C1", Centroid: []float32{1, 2, 3}},
+	}), "dimensions")
+	assert.ErrorContains(t, sm.AddTenants(ctx, nil, "C2", []*models.Tenant{
+		{Name: "A1", Centroid: []float32{0, 0}},
+	}), "sharedShards")
+}
+
+func TestTenantQuotas(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	assert.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}))
+	assert.Nil(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER1", Quota: &models.TenantQuota{MaxObjects: 10}},
+		{Name: "USER2", Quota: &models.TenantQuota{}},
+	}))
+	assert.Equal(t, &models.TenantQuota{MaxObjects: 10}, sm.TenantQuota("C1", "USER1"))
+	assert.Nil(t, sm.TenantQuota("C1", "USER2"))
+
+	err := sm.AddTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER3", Quota: &models.TenantQuota{MaxVectors: -1}},
+	})
+	assert.ErrorContains(t, err, "negative")
+
+	assert.Nil(t, sm.UpdateTenantQuotas(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER1"},
+		{Name: "USER2", Quota: &models.TenantQuota{MaxStorageBytes: 1 << 20}},
+	}))
+	assert.Nil(t, sm.TenantQuota("C1", "USER1"))
+	assert.Equal(t, &models.TenantQuota{MaxStorageBytes: 1 << 20}, sm.TenantQuota("C1", "USER2"))
+
+	err = sm.UpdateTenantQuotas(ctx, nil, "C1", []*models.Tenant{{Name: "USER3"}})
+	assert.ErrorIs(t, err, ErrNotFound)
+	err = sm.UpdateTenantQuotas(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER1", Quota: &models.TenantQuota{MaxObjects: -1}},
+	})
+	assert.ErrorContains(t, err, "negative")
+
+	tenants, err := sm.GetTenants(ctx, nil, "C1")
+	assert.Nil(t, err)
+	quotas := map[string]*models.TenantQuota{}
+	for _, tenant := range tenants {
+		quotas[tenant.Name] = tenant.Quota
+	}
+	assert.Equal(t, map[string]*models.TenantQuota{
+		"USER1": nil,
+		"USER2": {MaxStorageBytes: 1 << 20},
+	}, quotas)
+}
@@ -26,9 +26,22 @@ const (
 	AddClass    cluster.TransactionType = "add_class"
 	AddProperty cluster.TransactionType = "add_property"
 
+	deleteProperty cluster.TransactionType = "delete_property"
+
+	updatePropertyTokenization cluster.TransactionType = "update_property_tokenization"
+
 	// tenant types
 	addTenants    cluster.TransactionType = "add_tenants"
 	deleteTenants cluster.TransactionType = "delete_tenants"
+	updateTenants cluster.TransactionType = "update_tenants"
+
+	// alias types
+	setAlias    cluster.TransactionType = "set_alias"
+	deleteAlias cluster.TransactionType = "delete_alias"
+
+	// rename types
+	renameClass    cluster.TransactionType = "rename_class"
+	renameProperty cluster.TransactionType = "rename_property"
 
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
@@ -49,10 +62,33 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+// DeletePropertyPayload removes Property from Class
+type DeletePropertyPayload struct {
+	Class    string `json:"class"`
+	Property string `json:"property"`
+}
+
+// UpdatePropertyTokenizationPayload sets the Tokenization of Property of Class
+type UpdatePropertyTokenizationPayload struct {
+	Class        string `json:"class"`
+	Property     string `json:"property"`
+	Tokenization string `json:"tokenization"`
+}
+
 // Tenant represents properties of a specific tenant (physical shard)
 type Tenant struct {
 	Name  string   `json:"name"`
 	Nodes []string `json:"nodes"`
+
+	// Shared marks a shard for the objects of several tenants, SharedShard
+	// names the shard of a tenant in such a shard. PromotedFrom names the
+	// shared shard a tenant is moved out of.
+	Shared       bool   `json:"shared,omitempty"`
+	SharedShard  string `json:"sharedShard,omitempty"`
+	PromotedFrom string `json:"promotedFrom,omitempty"`
+
+	Quota    *models.TenantQuota `json:"quota,omitempty"`
+	Centroid []float32           `json:"centroid,omitempty"`
 }
 
 // AddTenantsPayload allows for adding multiple tenants to a class
@@ -67,6 +103,37 @@ type DeleteTenantsPayload struct {
 	Tenants []string `json:"tenants"`
 }
 
+// UpdateTenantsPayload replaces the quotas of existing tenants, the nodes
+// of the tenants are not changed
+type UpdateTenantsPayload struct {
+	Class   string   `json:"class_name"`
+	Tenants []Tenant `json:"tenants"`
+}
+
+// SetAliasPayload creates an alias or repoints an existing one to Class
+type SetAliasPayload struct {
+	Alias string `json:"alias"`
+	Class string `json:"class"`
+}
+
+// DeleteAliasPayload removes an alias
+type DeleteAliasPayload struct {
+	Alias string `json:"alias"`
+}
+
+// RenameClassPayload renames class From to To
+type RenameClassPayload struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// RenamePropertyPayload renames property From of Class to To
+type RenamePropertyPayload struct {
+	Class string `json:"class"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 }
@@ -93,16 +160,30 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddClassPayload](payload)
 	case AddProperty:
 		return unmarshalRawJson[AddPropertyPayload](payload)
+	case deleteProperty:
+		return unmarshalRawJson[DeletePropertyPayload](payload)
+	case updatePropertyTokenization:
+		return unmarshalRawJson[UpdatePropertyTokenizationPayload](payload)
 	case DeleteClass:
 		return unmarshalRawJson[DeleteClassPayload](payload)
 	case UpdateClass:
 		return unmarshalRawJson[UpdateClassPayload](payload)
-	case ReadSchema:
+	case ReadSchema, bootstrapSchema:
 		return unmarshalRawJson[ReadSchemaPayload](payload)
 	case addTenants:
 		return unmarshalRawJson[AddTenantsPayload](payload)
 	case deleteTenants:
 		return unmarshalRawJson[DeleteTenantsPayload](payload)
+	case updateTenants:
+		return unmarshalRawJson[UpdateTenantsPayload](payload)
+	case setAlias:
+		return unmarshalRawJson[SetAliasPayload](payload)
+	case deleteAlias:
+		return unmarshalRawJson[DeleteAliasPayload](payload)
+	case renameClass:
+		return unmarshalRawJson[RenameClassPayload](payload)
+	case renameProperty:
+		return unmarshalRawJson[RenamePropertyPayload](payload)
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
@@ -15,21 +15,36 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/jobs"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	className string, updated *models.Class,
-) error {
+) (err error) {
+	defer func() {
+		m.auditLog.Record(ctx, principal,
+			audit.Event{Action: audit.ActionClassUpdate, Class: className}, err)
+	}()
+
 	m.Lock()
 	defer m.Unlock()
 
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err = m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+	err = m.Authorizer.Authorize(principal, "update", authorization.SchemaResource(className, ""))
 	if err != nil {
 		return err
 	}
@@ -100,10 +115,10 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
-		return errors.Wrap(err, "commit cluster-wide transaction")
+		return m.applied(tx, errors.Wrap(err, "commit cluster-wide transaction"))
 	}
 
-	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+	return m.applied(tx, m.updateClassApplyChanges(ctx, className, updated, updatedState))
 }
 
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
@@ -115,7 +130,9 @@ func validateUpdatingMT(current, update *models.Class) (enabled bool, err error)
 		} else {
 			err = fmt.Errorf("enabling multi-tenancy for an existing class is not supported")
 		}
+		return
 	}
+	err = validateMultiTenancyConfig(update)
 	return
 }
 
@@ -137,6 +154,34 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool, cl cl
 	return nil
 }
 
+// updateInvertedIndexConfig replaces the inverted index config of a class,
+// leaving the rest of the class unchanged. As nothing else changes, the
+// transaction of a class update can be committed without validating the
+// whole class again. The caller must hold the lock.
+func (m *Manager) updateInvertedIndexConfig(ctx context.Context,
+	initial *models.Class, updatedConfig *models.InvertedIndexConfig,
+) error {
+	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
+		initial.InvertedIndexConfig, updatedConfig); err != nil {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("inverted index config: %w", err))
+	}
+
+	updated := *initial
+	updated.InvertedIndexConfig = updatedConfig
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{initial.Class, &updated, nil}, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return m.applied(tx, fmt.Errorf("commit cluster-wide transaction: %w", err))
+	}
+
+	return m.applied(tx, m.updateClassApplyChanges(ctx, initial.Class, &updated, nil))
+}
+
 func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	updated *models.Class, updatedShardingState *sharding.State,
 ) error {
@@ -167,27 +212,125 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	payload.ReplaceShards = updatedShardingState != nil
 	// can be improved by updating the diff
 
+	var moved []string // shards moved away from this node
 	if updatedShardingState != nil {
 		// do not override if transaction does not contain an updated state
 
 		// the sharding state caches the node name, we must therefore set this
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
-		m.schemaCache.LockGuard(func() { m.schemaCache.ShardingState[className] = updatedShardingState })
+		m.schemaCache.LockGuard(func() {
+			if prev := m.schemaCache.ShardingState[className]; prev != nil {
+				moved = movedShards(prev, updatedShardingState)
+			}
+			m.schemaCache.ShardingState[className] = updatedShardingState
+		})
 	}
 	m.logger.
 		WithField("action", "schema.update_class").
 		Debug("saving updated schema to configuration store")
 
+	var commit func(success bool)
+	if len(moved) > 0 {
+		if commit, err = m.migrator.DeleteTenants(ctx, updated, moved); err != nil {
+			return fmt.Errorf("drop moved shards: %w", err)
+		}
+	}
+
 	// payload.Shards
-	if err := m.repo.UpdateClass(ctx, payload); err != nil {
+	err = m.repo.UpdateClass(ctx, payload)
+	if commit != nil {
+		commit(err == nil)
+	}
+	if err != nil {
 		return err
 	}
-	m.triggerSchemaUpdateCallbacks()
+	m.triggerSchemaUpdateCallbacks(Change{Type: ClassUpdated, Class: className})
 
 	return nil
 }
 
+// movedShards returns the local shards of the previous state which still
+// exist but no longer belong to this node
+func movedShards(prev, updated *sharding.State) []string {
+	var moved []string
+	for name := range prev.Physical {
+		if _, ok := updated.Physical[name]; ok &&
+			prev.IsLocalShard(name) && !updated.IsLocalShard(name) {
+			moved = append(moved, name)
+		}
+	}
+	return moved
+}
+
+// MoveShard moves a shard of a class from the source to the target node,
+// see scaler.MoveShard. Each intermediate sharding state is broadcast as
+// part of an "update" transaction.
+func (m *Manager) MoveShard(ctx context.Context, principal *models.Principal,
+	className, shard, source, target string,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return ErrNotFound
+	}
+
+	return m.scaleOut.MoveShard(ctx, className, shard, source, target,
+		func(state *sharding.State) error {
+			return m.updateShardingState(ctx, className, class, state)
+		})
+}
+
+// StartShardMove moves a shard like MoveShard, but in the background. The
+// move is tracked in the jobs API, the id of the job is returned.
+func (m *Manager) StartShardMove(ctx context.Context, principal *models.Principal,
+	className, shard, source, target string,
+) (string, error) {
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return "", err
+	}
+
+	// the manager is locked while another shard is moved
+	if m.CopyShardingState(className) == nil {
+		return "", ErrNotFound
+	}
+
+	job := m.jobs.Track(jobs.TypeShardMove, className+"/"+shard, nil)
+	go func() {
+		err := m.MoveShard(context.Background(), principal, className, shard, source, target)
+		if err != nil {
+			m.logger.WithField("action", "move_shard").WithField("class", className).
+				WithField("shard", shard).WithError(err).Error("move shard")
+		}
+		job.Done(err)
+	}()
+
+	return job.ID(), nil
+}
+
+// updateShardingState broadcasts a new sharding state of a class as part of
+// an "update" transaction
+func (m *Manager) updateShardingState(ctx context.Context, className string,
+	class *models.Class, state *sharding.State,
+) error {
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, class, state}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return m.applied(tx, errors.Wrap(err, "commit cluster-wide transaction"))
+	}
+	return m.applied(tx, m.updateClassApplyChanges(ctx, className, class, state))
+}
+
 func (m *Manager) validateImmutableFields(initial, updated *models.Class) error {
 	immutableFields := []immutableText{
 		{
@@ -202,6 +345,14 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 			name:     "vector index type",
 			accessor: func(c *models.Class) string { return c.VectorIndexType },
 		},
+		{
+			name:     "id properties",
+			accessor: func(c *models.Class) string { return strings.Join(c.IDProperties, ",") },
+		},
+		{
+			name:     "vector only",
+			accessor: func(c *models.Class) string { return strconv.FormatBool(c.VectorOnly) },
+		},
 	}
 
 	for _, u := range immutableFields {
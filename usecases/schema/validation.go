@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -34,6 +35,17 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 			return fmt.Errorf("class name %q already exists", className)
 		}
 	}
+	for alias := range m.schemaCache.Aliases {
+		if strings.EqualFold(className, alias) {
+			return fmt.Errorf("class name %q already exists as an alias", className)
+		}
+	}
+	for class, ss := range m.schemaCache.ShardingState {
+		// a renamed class keeps its files under its original name
+		if ss != nil && strings.EqualFold(className, ss.IndexID) {
+			return fmt.Errorf("class name %q is still used by the data of class %q", className, class)
+		}
+	}
 
 	return nil
 }
@@ -99,6 +111,103 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			if (prop.IndexSearchable != nil && !*prop.IndexSearchable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexPositions` requires a searchable index, " +
+					"it can not be set together with `indexSearchable` or `indexInverted` false")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	return nil
+}
+
+// validateNestedProperties makes sure nestedProperties are set exactly on
+// properties of data type object and object[] and that every nested
+// property is a valid, indexable primitive or a further nested object
+func (m *Manager) validateNestedProperties(prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if !propertyDataType.IsNested() {
+		if len(prop.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties can only be set on "+
+				"data types %q and %q", prop.Name, schema.DataTypeObject, schema.DataTypeObjectArray)
+		}
+		return nil
+	}
+
+	return m.validateNestedPropertyList(prop.Name, prop.NestedProperties)
+}
+
+func (m *Manager) validateNestedPropertyList(path string, nested []*models.NestedProperty) error {
+	if len(nested) == 0 {
+		return fmt.Errorf("property '%s': data types %q and %q require nestedProperties",
+			path, schema.DataTypeObject, schema.DataTypeObjectArray)
+	}
+
+	names := make(map[string]struct{}, len(nested))
+	for _, np := range nested {
+		npPath := path + schema.NestedPathSeparator + np.Name
+		if _, err := schema.ValidatePropertyName(np.Name); err != nil {
+			return fmt.Errorf("property '%s': %w", path, err)
+		}
+		if _, ok := names[strings.ToLower(np.Name)]; ok {
+			return fmt.Errorf("property '%s': nested property %q provided multiple times",
+				path, np.Name)
+		}
+		names[strings.ToLower(np.Name)] = struct{}{}
+
+		if _, ok := schema.AsNested(np.DataType); ok {
+			if np.Tokenization != "" || (np.IndexSearchable != nil && *np.IndexSearchable) {
+				return fmt.Errorf("property '%s': tokenization and indexSearchable are not "+
+					"allowed for data type %q", npPath, np.DataType[0])
+			}
+			if err := m.validateNestedPropertyList(npPath, np.NestedProperties); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(np.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties can only be set on "+
+				"data types %q and %q", npPath, schema.DataTypeObject, schema.DataTypeObjectArray)
+		}
+
+		dataType, ok := schema.AsPrimitive(np.DataType)
+		switch {
+		case !ok:
+			return fmt.Errorf("property '%s': nested properties can't be references", npPath)
+		case dataType == schema.DataTypeGeoCoordinates, dataType == schema.DataTypePhoneNumber,
+			dataType == schema.DataTypeBlob, dataType == schema.DataTypeString,
+			dataType == schema.DataTypeStringArray:
+			return fmt.Errorf("property '%s': data type %q is not supported for nested "+
+				"properties", npPath, dataType)
+		}
+
+		sch := m.getSchema()
+		npDataType, err := (&sch).FindPropertyDataType(np.DataType)
+		if err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %v", npPath, err)
+		}
+		if err := m.validatePropertyTokenization(np.Tokenization, npDataType); err != nil {
+			return fmt.Errorf("property '%s': %w", npPath, err)
+		}
+		if err := m.validatePropertyIndexing(&models.Property{
+			DataType:        np.DataType,
+			IndexFilterable: np.IndexFilterable,
+			IndexSearchable: np.IndexSearchable,
+		}); err != nil {
+			return fmt.Errorf("property '%s': %w", npPath, err)
+		}
+	}
+
 	return nil
 }
 
@@ -128,7 +237,7 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
 	switch class.VectorIndexType {
-	case "hnsw":
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeDynamic:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
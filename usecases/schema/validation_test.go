@@ -529,6 +529,71 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 	})
 }
 
+func TestValidatePropertyIndexPositions(t *testing.T) {
+	vTrue := true
+	vFalse := false
+
+	tests := []struct {
+		name            string
+		dataType        schema.DataType
+		indexSearchable *bool
+		indexInverted   *bool
+		expectedErrMsg  string
+	}{
+		{name: "text", dataType: schema.DataTypeText},
+		{name: "text[]", dataType: schema.DataTypeTextArray},
+		{name: "text searchable", dataType: schema.DataTypeText, indexSearchable: &vTrue},
+		{
+			name:            "text not searchable",
+			dataType:        schema.DataTypeText,
+			indexSearchable: &vFalse,
+			expectedErrMsg: "`indexPositions` requires a searchable index, " +
+				"it can not be set together with `indexSearchable` or `indexInverted` false",
+		},
+		{
+			name:          "text not inverted",
+			dataType:      schema.DataTypeText,
+			indexInverted: &vFalse,
+			expectedErrMsg: "`indexPositions` requires a searchable index, " +
+				"it can not be set together with `indexSearchable` or `indexInverted` false",
+		},
+		{
+			name:     "int",
+			dataType: schema.DataTypeInt,
+			expectedErrMsg: "`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty",
+		},
+	}
+
+	mgr := newSchemaManager()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mgr.validatePropertyIndexing(&models.Property{
+				Name:            "prop",
+				DataType:        tc.dataType.PropString(),
+				IndexInverted:   tc.indexInverted,
+				IndexSearchable: tc.indexSearchable,
+				IndexPositions:  &vTrue,
+			})
+
+			if tc.expectedErrMsg != "" {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	t.Run("disabled positions are allowed for all data types", func(t *testing.T) {
+		err := mgr.validatePropertyIndexing(&models.Property{
+			Name:           "prop",
+			DataType:       schema.DataTypeInt.PropString(),
+			IndexPositions: &vFalse,
+		})
+		assert.Nil(t, err)
+	})
+}
+
 type fakePropertyDataType struct {
 	primitiveDataType schema.DataType
 }
@@ -556,6 +621,14 @@ func (pdt *fakePropertyDataType) IsReference() bool {
 	return !pdt.IsPrimitive()
 }
 
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
+
 func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 	if pdt.IsPrimitive() {
 		return nil
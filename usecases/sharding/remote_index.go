@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -85,7 +86,10 @@ type RemoteIndexClient interface {
 		filters *filters.LocalFilter) ([]uint64, error)
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
+	UpdateObjectBatch(ctx context.Context, hostName, indexName, shardName string,
+		docIDs []uint64, update objects.MergeDocument, dryRun bool) objects.BatchSimpleObjects
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
+	GetShardUsage(ctx context.Context, hostName, indexName, shardName string) (map[string]models.TenantUsage, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 		targetStatus string) error
 
@@ -247,6 +251,18 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 		return nil, nil, fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
 	}
 
+	return ri.SearchShardOnNode(ctx, owner, shardName, searchVector, limit, filters,
+		keywordRanking, sort, cursor, groupBy, additional, replEnabled)
+}
+
+// SearchShardOnNode searches the replica of the shard held by the given node
+// instead of the one of the shard owner
+func (ri *RemoteIndex) SearchShardOnNode(ctx context.Context, owner, shardName string,
+	searchVector []float32, limit int, filters *filters.LocalFilter,
+	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
+	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
+	additional additional.Properties, replEnabled bool,
+) ([]*storobj.Object, []float32, error) {
 	host, ok := ri.nodeResolver.NodeHostname(owner)
 	if !ok {
 		return nil, nil, errors.Errorf("resolve node name %q to host", owner)
@@ -310,6 +326,24 @@ func (ri *RemoteIndex) DeleteObjectBatch(ctx context.Context, shardName string,
 	return ri.client.DeleteObjectBatch(ctx, host, ri.class, shardName, docIDs, dryRun)
 }
 
+func (ri *RemoteIndex) UpdateObjectBatch(ctx context.Context, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
+	if err != nil {
+		err := fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(owner)
+	if !ok {
+		err := fmt.Errorf("resolve node name %q to host", owner)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	return ri.client.UpdateObjectBatch(ctx, host, ri.class, shardName, docIDs, update, dryRun)
+}
+
 func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (string, error) {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
@@ -324,6 +358,20 @@ func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (st
 	return ri.client.GetShardStatus(ctx, host, ri.class, shardName)
 }
 
+func (ri *RemoteIndex) GetShardUsage(ctx context.Context, shardName string) (map[string]models.TenantUsage, error) {
+	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(owner)
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", owner)
+	}
+
+	return ri.client.GetShardUsage(ctx, host, ri.class, shardName)
+}
+
 func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -63,7 +64,10 @@ type RemoteIndexIncomingRepo interface {
 		filters *filters.LocalFilter) ([]uint64, error)
 	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
+	IncomingUpdateObjectBatch(ctx context.Context, shardName string,
+		docIDs []uint64, update objects.MergeDocument, dryRun bool) objects.BatchSimpleObjects
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
+	IncomingGetShardUsage(ctx context.Context, shardName string) (map[string]models.TenantUsage, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
 		vobjects []*objects.VObject) ([]replica.RepairResponse, error)
@@ -226,6 +230,18 @@ func (rii *RemoteIndexIncoming) DeleteObjectBatch(ctx context.Context, indexName
 	return index.IncomingDeleteObjectBatch(ctx, shardName, docIDs, dryRun)
 }
 
+func (rii *RemoteIndexIncoming) UpdateObjectBatch(ctx context.Context, indexName, shardName string,
+	docIDs []uint64, update objects.MergeDocument, dryRun bool,
+) objects.BatchSimpleObjects {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		err := errors.Errorf("local index %q not found", indexName)
+		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
+	}
+
+	return index.IncomingUpdateObjectBatch(ctx, shardName, docIDs, update, dryRun)
+}
+
 func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	indexName, shardName string,
 ) (string, error) {
@@ -237,6 +253,17 @@ func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	return index.IncomingGetShardStatus(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) GetShardUsage(ctx context.Context,
+	indexName, shardName string,
+) (map[string]models.TenantUsage, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingGetShardUsage(ctx, shardName)
+}
+
 func (rii *RemoteIndexIncoming) UpdateShardStatus(ctx context.Context,
 	indexName, shardName, targetStatus string,
 ) error {
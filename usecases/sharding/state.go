@@ -16,20 +16,27 @@ import (
 	"math"
 	"math/rand"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/spaolacci/murmur3"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
 )
 
 const shardNameLength = 12
 
 type State struct {
-	IndexID             string              `json:"indexID"` // for monitoring, reporting purposes. Does not influence the shard-calculations
+	IndexID             string              `json:"indexID"` // name the files of the class were created under, kept when the class is renamed. Does not influence the shard-calculations
 	Config              Config              `json:"config"`
 	Physical            map[string]Physical `json:"physical"`
 	Virtual             []Virtual           `json:"virtual"`
 	PartitioningEnabled bool                `json:"partitioningEnabled"`
 
+	// PendingSplit is set while a split is completed. It is persisted with
+	// the state, so that an interrupted split can be resumed.
+	PendingSplit *PendingSplit `json:"pendingSplit,omitempty"`
+
 	// different for each node, not to be serialized
 	localNodeName string // TODO: localNodeName is static it is better to store just once
 }
@@ -63,6 +70,19 @@ type Physical struct {
 
 	LegacyBelongsToNodeForBackwardCompat string   `json:"belongsToNode,omitempty"`
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
+
+	// Shared is set for the shards which host the objects of several small
+	// tenants. SharedShard is set for such a tenant and names the shard its
+	// objects are in. A tenant with a SharedShard has no shard of its own.
+	Shared      bool   `json:"shared,omitempty"`
+	SharedShard string `json:"sharedShard,omitempty"`
+
+	// Quota limits the objects of a tenant, nil means no limits
+	Quota *models.TenantQuota `json:"quota,omitempty"`
+
+	// Centroid is a vector representative of the objects of a tenant, new
+	// tenants are placed in the shared shard with the closest tenants
+	Centroid []float32 `json:"centroid,omitempty"`
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -148,8 +168,11 @@ func InitState(id string, config Config, nodes nodes, replFactor int64, partitio
 // Shard returns the shard name if it exits and empty string otherwise
 func (s *State) Shard(partitionKey, objectID string) string {
 	if s.PartitioningEnabled {
-		if _, ok := s.Physical[partitionKey]; ok {
-			return partitionKey // will change in the future
+		if p, ok := s.Physical[partitionKey]; ok {
+			if p.SharedShard != "" {
+				return p.SharedShard
+			}
+			return partitionKey
 		}
 		return ""
 	}
@@ -182,6 +205,9 @@ func (s *State) CountPhysicalShards() int {
 func (s *State) AllPhysicalShards() []string {
 	var names []string
 	for _, physical := range s.Physical {
+		if physical.SharedShard != "" {
+			continue
+		}
 		names = append(names, physical.Name)
 	}
 
@@ -195,7 +221,7 @@ func (s *State) AllPhysicalShards() []string {
 func (s *State) AllLocalPhysicalShards() []string {
 	var names []string
 	for _, physical := range s.Physical {
-		if s.IsLocalShard(physical.Name) {
+		if physical.SharedShard == "" && s.IsLocalShard(physical.Name) {
 			names = append(names, physical.Name)
 		}
 	}
@@ -338,11 +364,213 @@ func (s *State) AddPartition(name string, nodes []string) Physical {
 	return p
 }
 
+// SharedShardName returns the name of the i-th shard the tenants of a class
+// share. The dot keeps it apart from the tenant names.
+func SharedShardName(i int) string {
+	return fmt.Sprintf("shared.%d", i)
+}
+
+// SharedShardFor picks one of count shared shards for a tenant
+func SharedShardFor(tenant string, count int) string {
+	return SharedShardName(int(murmur3.Sum64([]byte(tenant)) % uint64(count)))
+}
+
+// IsSharedShardName reports whether name was created by SharedShardName
+func IsSharedShardName(name string) bool {
+	return strings.HasPrefix(name, "shared.")
+}
+
+// SharedPlacement places new tenants in the shared shards of a class. A
+// tenant with a centroid is placed in the shard whose centroid, the mean of
+// the centroids of its tenants, is closest. Shards without such tenants are
+// filled first, so every shard has a centroid before tenants are grouped.
+// Tenants without a centroid are placed by their name.
+type SharedPlacement struct {
+	sums   [][]float64
+	counts []int
+}
+
+// SharedPlacement returns the placement of new tenants in count shared
+// shards, which starts from the centroids of the tenants of the state
+func (s *State) SharedPlacement(count int) *SharedPlacement {
+	pl := &SharedPlacement{sums: make([][]float64, count), counts: make([]int, count)}
+	for _, p := range s.Physical {
+		if p.SharedShard == "" || len(p.Centroid) == 0 {
+			continue
+		}
+		i, err := strconv.Atoi(strings.TrimPrefix(p.SharedShard, "shared."))
+		if err != nil || i < 0 || i >= count {
+			continue // the number of shared shards has been lowered
+		}
+		if pl.counts[i] == 0 || len(pl.sums[i]) == len(p.Centroid) {
+			pl.add(i, p.Centroid)
+		}
+	}
+	return pl
+}
+
+// Place returns the shared shard of a new tenant and counts its centroid
+// for the tenants which are placed after it
+func (pl *SharedPlacement) Place(tenant string, centroid []float32) (string, error) {
+	if len(centroid) == 0 {
+		return SharedShardFor(tenant, len(pl.counts)), nil
+	}
+
+	empty, nearest, minDist := -1, -1, math.Inf(1)
+	for i, n := range pl.counts {
+		if n == 0 {
+			if empty < 0 {
+				empty = i
+			}
+			continue
+		}
+		if len(pl.sums[i]) != len(centroid) {
+			return "", fmt.Errorf("centroid of tenant %q has %d dimensions, "+
+				"the centroids of the tenants of shared shard %q have %d",
+				tenant, len(centroid), SharedShardName(i), len(pl.sums[i]))
+		}
+		var dist float64
+		for d, v := range centroid {
+			diff := float64(v) - pl.sums[i][d]/float64(n)
+			dist += diff * diff
+		}
+		if dist < minDist {
+			nearest, minDist = i, dist
+		}
+	}
+
+	i := nearest
+	if empty >= 0 {
+		i = empty
+	}
+	pl.add(i, centroid)
+	return SharedShardName(i), nil
+}
+
+func (pl *SharedPlacement) add(i int, centroid []float32) {
+	if pl.counts[i] == 0 {
+		pl.sums[i] = make([]float64, len(centroid))
+	}
+	for d, v := range centroid {
+		pl.sums[i][d] += float64(v)
+	}
+	pl.counts[i]++
+}
+
+// AddSharedPartition adds a tenant whose objects are in the given shared
+// shard, nodes are the nodes of the shared shard
+func (s *State) AddSharedPartition(name, sharedShard string, nodes []string) Physical {
+	p := Physical{
+		Name:           name,
+		BelongsToNodes: nodes,
+		OwnsPercentage: 1.0,
+		SharedShard:    sharedShard,
+	}
+	s.Physical[name] = p
+	return p
+}
+
 // DeletePartition to physical shards
 func (s *State) DeletePartition(name string) {
 	delete(s.Physical, name)
 }
 
+// Split describes the physical shards which are split from existing shards
+// to increase the shard count of a class
+type Split struct {
+	// Shards maps each new shard to the existing shard its objects are in
+	Shards map[string]string `json:"shards"`
+	// State is the sharding state after the split
+	State State `json:"state"`
+	// Since is zero while the objects are copied to the new shards. Once the
+	// new shards receive writes it is the time in milliseconds at which the
+	// copy started and the split is completed.
+	Since int64 `json:"since,omitempty"`
+}
+
+// PendingSplit records a split whose new shards receive writes already, but
+// whose moved objects may not have been cleaned up yet, see Split
+type PendingSplit struct {
+	// Shards maps each new shard to the existing shard its objects are in
+	Shards map[string]string `json:"shards"`
+	// Since is the time in milliseconds at which the copy started
+	Since int64 `json:"since"`
+}
+
+// DeepCopy copies the pending split, it returns nil for a nil split
+func (p *PendingSplit) DeepCopy() *PendingSplit {
+	if p == nil {
+		return nil
+	}
+
+	shards := make(map[string]string, len(p.Shards))
+	for name, source := range p.Shards {
+		shards[name] = source
+	}
+	return &PendingSplit{Shards: shards, Since: p.Since}
+}
+
+// SplitShards increases the number of physical shards to count. Each new
+// shard takes over half of the virtual shards of the shard with the most
+// virtual shards and is placed on the same nodes, so that its objects can
+// be moved locally. It returns the existing shard each new shard was split
+// from.
+func (s *State) SplitShards(count int) (map[string]string, error) {
+	if s.PartitioningEnabled {
+		return nil, fmt.Errorf("shards of multi-tenant classes can't be split")
+	}
+	if count <= len(s.Physical) {
+		return nil, fmt.Errorf("shard count must be greater than %d", len(s.Physical))
+	}
+	if count > len(s.Virtual) {
+		return nil, fmt.Errorf("shard count can't exceed the number of virtual shards %d",
+			len(s.Virtual))
+	}
+
+	splits := make(map[string]string, count-len(s.Physical))
+	for len(s.Physical) < count {
+		source := ""
+		for name, p := range s.Physical {
+			if n, m := len(p.OwnsVirtual), len(s.Physical[source].OwnsVirtual); source == "" ||
+				n > m || (n == m && name < source) {
+				source = name
+			}
+		}
+
+		name := generateShardName()
+		for _, ok := s.Physical[name]; ok; _, ok = s.Physical[name] {
+			name = generateShardName()
+		}
+
+		src := s.Physical[source]
+		half := len(src.OwnsVirtual) / 2
+		dst := Physical{
+			Name:           name,
+			OwnsVirtual:    append([]string{}, src.OwnsVirtual[half:]...),
+			BelongsToNodes: append([]string{}, src.BelongsToNodes...),
+		}
+		src.OwnsVirtual = append([]string{}, src.OwnsVirtual[:half]...)
+		for _, vid := range dst.OwnsVirtual {
+			virtual := s.virtualByName(vid)
+			virtual.AssignedToPhysical = name
+			dst.OwnsPercentage += virtual.OwnsPercentage
+			src.OwnsPercentage -= virtual.OwnsPercentage
+		}
+		s.Physical[source], s.Physical[name] = src, dst
+
+		// a shard which was split from a new shard still finds its objects
+		// in the existing one
+		if orig, ok := splits[source]; ok {
+			source = orig
+		}
+		splits[name] = source
+	}
+
+	s.Config.DesiredCount = count
+	s.Config.ActualCount = count
+	return splits, nil
+}
+
 func (s *State) initVirtual() {
 	count := s.Config.DesiredVirtualCount
 	s.Virtual = make([]Virtual, count)
@@ -457,6 +685,7 @@ func (s State) DeepCopy() State {
 		Physical:            physicalCopy,
 		Virtual:             virtualCopy,
 		PartitioningEnabled: s.PartitioningEnabled,
+		PendingSplit:        s.PendingSplit.DeepCopy(),
 	}
 }
 
@@ -483,11 +712,27 @@ func (p Physical) DeepCopy() Physical {
 	belongsCopy := make([]string, len(p.BelongsToNodes))
 	copy(belongsCopy, p.BelongsToNodes)
 
+	var quotaCopy *models.TenantQuota
+	if p.Quota != nil {
+		q := *p.Quota
+		quotaCopy = &q
+	}
+
+	var centroidCopy []float32
+	if p.Centroid != nil {
+		centroidCopy = make([]float32, len(p.Centroid))
+		copy(centroidCopy, p.Centroid)
+	}
+
 	return Physical{
 		Name:           p.Name,
 		OwnsVirtual:    ownsVirtualCopy,
 		OwnsPercentage: p.OwnsPercentage,
 		BelongsToNodes: belongsCopy,
+		Shared:         p.Shared,
+		SharedShard:    p.SharedShard,
+		Quota:          quotaCopy,
+		Centroid:       centroidCopy,
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func TestState(t *testing.T) {
@@ -181,6 +182,58 @@ func TestInitState(t *testing.T) {
 	}
 }
 
+func TestSplitShards(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{
+		"desiredCount": float64(2), "virtualPerPhysical": float64(8),
+	}, 2)
+	require.Nil(t, err)
+	state, err := InitState("my-index", cfg, fakeNodes{[]string{"node1", "node2"}}, 1, false)
+	require.Nil(t, err)
+
+	before := state.DeepCopy()
+	names := make([][]byte, 1000)
+	for i := range names {
+		names[i] = make([]byte, 16)
+		rand.Read(names[i])
+	}
+
+	splits, err := state.SplitShards(5)
+	require.Nil(t, err)
+	require.Len(t, splits, 3)
+	assert.Len(t, state.Physical, 5)
+	assert.Equal(t, 5, state.Config.DesiredCount)
+
+	for shard, source := range splits {
+		_, ok := before.Physical[source]
+		require.True(t, ok, "shard %q must be split from an existing shard", shard)
+		assert.Equal(t, before.Physical[source].BelongsToNodes, state.Physical[shard].BelongsToNodes)
+	}
+
+	// objects either stay in their shard or move to a shard split from it
+	for _, name := range names {
+		prev, next := before.PhysicalShard(name), state.PhysicalShard(name)
+		if prev != next {
+			assert.Equal(t, prev, splits[next])
+		}
+	}
+
+	// each virtual shard belongs to the physical shard it is assigned to
+	total := 0
+	for name, p := range state.Physical {
+		assert.GreaterOrEqual(t, len(p.OwnsVirtual), 2)
+		for _, vid := range p.OwnsVirtual {
+			assert.Equal(t, name, state.virtualByName(vid).AssignedToPhysical)
+		}
+		total += len(p.OwnsVirtual)
+	}
+	assert.Equal(t, len(state.Virtual), total)
+
+	_, err = state.SplitShards(5)
+	assert.NotNil(t, err)
+	_, err = state.SplitShards(17)
+	assert.NotNil(t, err)
+}
+
 func TestAdjustReplicas(t *testing.T) {
 	t.Run("1->3", func(t *testing.T) {
 		nodes := fakeNodes{nodes: []string{"N1", "N2", "N3", "N4", "N5"}}
@@ -282,6 +335,58 @@ func TestAddPartition(t *testing.T) {
 	require.Equal(t, want, s.Physical)
 }
 
+func TestAddSharedPartition(t *testing.T) {
+	nodes := fakeNodes{[]string{"node1"}}
+	s, err := InitState("my-index", Config{}, nodes, 1, true)
+	require.Nil(t, err)
+
+	shared := SharedShardFor("A", 4)
+	assert.True(t, IsSharedShardName(shared))
+	assert.Equal(t, shared, SharedShardFor("A", 4), "placement must be stable")
+
+	p := s.AddPartition(shared, []string{"node1"})
+	p.Shared = true
+	s.Physical[shared] = p
+	s.AddSharedPartition("A", shared, []string{"node1"})
+	s.AddPartition("B", []string{"node1"})
+
+	assert.Equal(t, shared, s.Shard("A", ""))
+	assert.Equal(t, "B", s.Shard("B", ""))
+	assert.Equal(t, "", s.Shard("C", ""))
+	assert.ElementsMatch(t, []string{shared, "B"}, s.AllPhysicalShards())
+	assert.ElementsMatch(t, []string{shared, "B"}, s.AllLocalPhysicalShards())
+	assert.Equal(t, s.Physical, s.DeepCopy().Physical)
+}
+
+func TestSharedPlacement(t *testing.T) {
+	s := State{Physical: map[string]Physical{
+		"A": {Name: "A", SharedShard: SharedShardName(0), Centroid: []float32{0, 0}},
+		"B": {Name: "B", SharedShard: SharedShardName(1), Centroid: []float32{10, 10}},
+		"C": {Name: "C", SharedShard: SharedShardName(1), Centroid: []float32{12, 12}},
+		"D": {Name: "D", SharedShard: SharedShardName(5), Centroid: []float32{100, 100}},
+		"E": {Name: "E", SharedShard: SharedShardName(0)},
+	}}
+
+	pl := s.SharedPlacement(3)
+	shard, err := pl.Place("F", []float32{10, 11})
+	require.Nil(t, err)
+	assert.Equal(t, SharedShardName(2), shard, "shards without centroids are filled first")
+
+	shard, err = pl.Place("G", []float32{2, 1})
+	require.Nil(t, err)
+	assert.Equal(t, SharedShardName(0), shard, "closest to A")
+	shard, err = pl.Place("H", []float32{13, 13})
+	require.Nil(t, err)
+	assert.Equal(t, SharedShardName(1), shard, "closest to the mean of B and C")
+
+	shard, err = pl.Place("I", nil)
+	require.Nil(t, err)
+	assert.Equal(t, SharedShardFor("I", 3), shard, "tenants without centroid are placed by name")
+
+	_, err = pl.Place("J", []float32{1, 2, 3})
+	assert.ErrorContains(t, err, "dimensions")
+}
+
 func TestStateDeepCopy(t *testing.T) {
 	original := State{
 		IndexID: "original",
@@ -302,6 +407,8 @@ func TestStateDeepCopy(t *testing.T) {
 				OwnsVirtual:    []string{"original"},
 				OwnsPercentage: 7,
 				BelongsToNodes: []string{"original"},
+				Quota:          &models.TenantQuota{MaxObjects: 10},
+				Centroid:       []float32{1, 2},
 			},
 		},
 		Virtual: []Virtual{
@@ -333,6 +440,8 @@ func TestStateDeepCopy(t *testing.T) {
 				OwnsVirtual:    []string{"original"},
 				OwnsPercentage: 7,
 				BelongsToNodes: []string{"original"},
+				Quota:          &models.TenantQuota{MaxObjects: 10},
+				Centroid:       []float32{1, 2},
 			},
 		},
 		Virtual: []Virtual{
@@ -366,6 +475,8 @@ func TestStateDeepCopy(t *testing.T) {
 	physical1.BelongsToNodes = append(physical1.BelongsToNodes, "changed")
 	physical1.OwnsPercentage = 100
 	physical1.OwnsVirtual = append(physical1.OwnsVirtual, "changed")
+	physical1.Quota.MaxObjects = 100
+	physical1.Centroid[0] = 100
 	copied.Physical["physical1"] = physical1
 	copied.Physical["physical2"] = Physical{}
 	copied.Virtual[0].Name = "original"
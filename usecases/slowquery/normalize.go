@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// Normalize renders the query in GraphQL notation with all user supplied
+// values, such as filter values, search terms and vectors, replaced by a
+// placeholder. Queries which only differ in their values are therefore
+// logged identically and no user data ends up in the logs. The limit and
+// offset are kept, as they are relevant for the query cost.
+func Normalize(params dto.GetParams) string {
+	var args []string
+
+	if params.Filters != nil && params.Filters.Root != nil {
+		args = append(args, "where: "+normalizeClause(params.Filters.Root))
+	}
+	if params.NearVector != nil {
+		args = append(args, "nearVector: {vector: ?}")
+	}
+	if params.NearObject != nil {
+		args = append(args, "nearObject: {id: ?}")
+	}
+	if params.KeywordRanking != nil {
+		args = append(args, fmt.Sprintf("bm25: {query: ?%s}",
+			normalizeProperties(params.KeywordRanking.Properties)))
+	}
+	if params.HybridSearch != nil {
+		args = append(args, fmt.Sprintf("hybrid: {query: ?, alpha: ?%s}",
+			normalizeProperties(params.HybridSearch.Properties)))
+	}
+	modules := make([]string, 0, len(params.ModuleParams))
+	for name := range params.ModuleParams {
+		modules = append(modules, name)
+	}
+	sort.Strings(modules)
+	for _, name := range modules {
+		args = append(args, name+": {?}")
+	}
+	if params.GroupBy != nil {
+		if params.GroupBy.Strategy == searchparams.GroupByStrategyVector {
+			args = append(args, "groupBy: {strategy: vector}")
+		} else {
+			args = append(args, fmt.Sprintf("groupBy: {path: [%s]}", params.GroupBy.Property))
+		}
+	}
+	if len(params.Sort) > 0 {
+		sorts := make([]string, len(params.Sort))
+		for i, s := range params.Sort {
+			sorts[i] = fmt.Sprintf("{path: [%s], order: %s}",
+				strings.Join(s.Path, ", "), s.Order)
+		}
+		args = append(args, fmt.Sprintf("sort: [%s]", strings.Join(sorts, ", ")))
+	}
+	if params.Cursor != nil {
+		args = append(args, "after: ?")
+	}
+	if p := params.Pagination; p != nil {
+		if p.Limit >= 0 {
+			args = append(args, fmt.Sprintf("limit: %d", p.Limit))
+		}
+		if p.Offset > 0 {
+			args = append(args, fmt.Sprintf("offset: %d", p.Offset))
+		}
+	}
+
+	if len(args) == 0 {
+		return fmt.Sprintf("Get { %s }", params.ClassName)
+	}
+	return fmt.Sprintf("Get { %s(%s) }", params.ClassName, strings.Join(args, ", "))
+}
+
+func normalizeClause(c *filters.Clause) string {
+	if len(c.Operands) > 0 {
+		operands := make([]string, len(c.Operands))
+		for i := range c.Operands {
+			operands[i] = normalizeClause(&c.Operands[i])
+		}
+		return fmt.Sprintf("{operator: %s, operands: [%s]}",
+			c.Operator.Name(), strings.Join(operands, ", "))
+	}
+
+	var path string
+	if c.On != nil {
+		path = strings.Join(c.On.Slice(), ", ")
+	}
+	return fmt.Sprintf("{path: [%s], operator: %s, value: ?}", path, c.Operator.Name())
+}
+
+func normalizeProperties(properties []string) string {
+	if len(properties) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", properties: [%s]", strings.Join(properties, ", "))
+}
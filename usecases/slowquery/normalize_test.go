@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   dto.GetParams
+		expected string
+	}{
+		{
+			name:     "without arguments",
+			params:   dto.GetParams{ClassName: "Article"},
+			expected: "Get { Article }",
+		},
+		{
+			name: "filter values are replaced",
+			params: dto.GetParams{
+				ClassName: "Article",
+				Filters: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorAnd,
+					Operands: []filters.Clause{
+						{
+							Operator: filters.OperatorGreaterThan,
+							On:       &filters.Path{Class: "Article", Property: "price"},
+							Value:    &filters.Value{Value: 100, Type: schema.DataTypeInt},
+						},
+						{
+							Operator: filters.OperatorEqual,
+							On: &filters.Path{
+								Class: "Article", Property: "author",
+								Child: &filters.Path{Class: "Person", Property: "name"},
+							},
+							Value: &filters.Value{Value: "Jane", Type: schema.DataTypeText},
+						},
+					},
+				}},
+				Pagination: &filters.Pagination{Limit: 10, Offset: 20},
+			},
+			expected: "Get { Article(where: {operator: And, operands: [" +
+				"{path: [price], operator: GreaterThan, value: ?}, " +
+				"{path: [author, Person, name], operator: Equal, value: ?}]}, " +
+				"limit: 10, offset: 20) }",
+		},
+		{
+			name: "search terms and vectors are replaced",
+			params: dto.GetParams{
+				ClassName:  "Article",
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+				HybridSearch: &searchparams.HybridSearch{
+					Query: "secret", Alpha: 0.5, Properties: []string{"title"},
+				},
+				ModuleParams: map[string]interface{}{"nearText": nil},
+				Sort:         []filters.Sort{{Path: []string{"title"}, Order: "asc"}},
+				Pagination:   &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			},
+			expected: "Get { Article(nearVector: {vector: ?}, " +
+				"hybrid: {query: ?, alpha: ?, properties: [title]}, nearText: {?}, " +
+				"sort: [{path: [title], order: asc}]) }",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Normalize(test.params))
+		})
+	}
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package slowquery logs queries which exceed a configurable duration. The
+// time spent in each phase of a query is collected through its context, so
+// the shards can report their timings without knowing about the log.
+package slowquery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Phases of a query which are timed
+const (
+	PhaseFilter  = "filter"
+	PhaseKeyword = "keyword"
+	PhaseVector  = "vector"
+	PhaseSort    = "sort"
+	PhaseObjects = "objects"
+	PhaseFusion  = "fusion"
+)
+
+// Entry is a single slow query. Durations are in milliseconds, Phases
+// holds the phases which are not specific to a shard, such as fusion.
+type Entry struct {
+	Time       time.Time                     `json:"time"`
+	Class      string                        `json:"class"`
+	Tenant     string                        `json:"tenant,omitempty"`
+	Query      string                        `json:"query"`
+	DurationMs float64                       `json:"durationMs"`
+	Phases     map[string]float64            `json:"phasesMs,omitempty"`
+	Shards     map[string]map[string]float64 `json:"shardsMs,omitempty"`
+	Results    int                           `json:"results"`
+	Error      string                        `json:"error,omitempty"`
+}
+
+// Log logs slow queries and keeps the most recent ones in a ring buffer. A
+// nil Log tracks nothing, so callers don't need to check whether it is
+// enabled.
+type Log struct {
+	threshold time.Duration
+	logger    logrus.FieldLogger
+	now       func() time.Time
+
+	mu     sync.Mutex
+	buffer []Entry
+	next   int
+	full   bool
+}
+
+// New returns nil if the slow query log is disabled
+func New(cfg config.SlowQueryLog, logger logrus.FieldLogger) *Log {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Log{
+		threshold: time.Duration(cfg.ThresholdMilliseconds) * time.Millisecond,
+		logger:    logger,
+		now:       time.Now,
+		buffer:    make([]Entry, cfg.BufferSize),
+	}
+}
+
+// Track starts timing a query, the returned context collects the timings
+// of the individual phases until Done is called on the Tracker
+func (l *Log) Track(ctx context.Context) (context.Context, *Tracker) {
+	if l == nil {
+		return ctx, nil
+	}
+
+	t := &Tracker{
+		log:    l,
+		start:  l.now(),
+		phases: map[string]map[string]time.Duration{},
+	}
+	return context.WithValue(ctx, trackerKey{}, t), t
+}
+
+// Entries returns the buffered slow queries, the most recent one first
+func (l *Log) Entries() []Entry {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := l.next
+	if l.full {
+		n = len(l.buffer)
+	}
+
+	out := make([]Entry, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, l.buffer[(l.next-i+len(l.buffer))%len(l.buffer)])
+	}
+	return out
+}
+
+func (l *Log) record(entry Entry) {
+	fields := logrus.Fields{
+		"action":      "slow_query",
+		"class":       entry.Class,
+		"query":       entry.Query,
+		"duration_ms": entry.DurationMs,
+		"results":     entry.Results,
+	}
+	if entry.Tenant != "" {
+		fields["tenant"] = entry.Tenant
+	}
+	if len(entry.Phases) > 0 {
+		fields["phases_ms"] = entry.Phases
+	}
+	if len(entry.Shards) > 0 {
+		fields["shards_ms"] = entry.Shards
+	}
+	if entry.Error != "" {
+		fields["error"] = entry.Error
+	}
+	l.logger.WithFields(fields).Warn("slow query")
+
+	if len(l.buffer) == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.buffer[l.next] = entry
+	l.next = (l.next + 1) % len(l.buffer)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+type trackerKey struct{}
+
+// Tracker collects the timings of a single query. Phases may be observed
+// concurrently, e.g. by shards which are searched in parallel.
+type Tracker struct {
+	log   *Log
+	start time.Time
+
+	mu     sync.Mutex
+	phases map[string]map[string]time.Duration
+}
+
+// Observe adds d to the time spent in phase on shard. An empty shard name
+// is used for phases which span all shards. It is a noop if ctx is not
+// tracked.
+func Observe(ctx context.Context, shard, phase string, d time.Duration) {
+	t, ok := ctx.Value(trackerKey{}).(*Tracker)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phases[shard] == nil {
+		t.phases[shard] = map[string]time.Duration{}
+	}
+	t.phases[shard][phase] += d
+}
+
+// Done records the query if it took longer than the threshold. The
+// parameters are only normalized in that case.
+func (t *Tracker) Done(params dto.GetParams, results int, err error) {
+	if t == nil {
+		return
+	}
+
+	took := t.log.now().Sub(t.start)
+	if took < t.log.threshold {
+		return
+	}
+
+	entry := Entry{
+		Time:       t.start,
+		Class:      params.ClassName,
+		Tenant:     params.Tenant,
+		Query:      Normalize(params),
+		DurationMs: milliseconds(took),
+		Results:    results,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	t.mu.Lock()
+	for shard, phases := range t.phases {
+		ms := make(map[string]float64, len(phases))
+		for phase, d := range phases {
+			ms[phase] = milliseconds(d)
+		}
+		if shard == "" {
+			entry.Phases = ms
+			continue
+		}
+		if entry.Shards == nil {
+			entry.Shards = map[string]map[string]float64{}
+		}
+		entry.Shards[shard] = ms
+	}
+	t.mu.Unlock()
+
+	t.log.record(entry)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	log := New(config.SlowQueryLog{
+		Enabled:               true,
+		ThresholdMilliseconds: 100,
+		BufferSize:            2,
+	}, logger)
+
+	clock := time.Now()
+	log.now = func() time.Time { return clock }
+	query := func(class string, took time.Duration, err error) {
+		ctx, tracker := log.Track(context.Background())
+		Observe(ctx, "shard1", PhaseVector, took/2)
+		Observe(ctx, "shard1", PhaseVector, took/4)
+		Observe(ctx, "", PhaseFusion, took/4)
+		clock = clock.Add(took)
+		tracker.Done(dto.GetParams{
+			ClassName:  class,
+			Pagination: &filters.Pagination{Limit: 10},
+		}, 3, err)
+	}
+
+	t.Run("fast query is not recorded", func(t *testing.T) {
+		query("Fast", 50*time.Millisecond, nil)
+		assert.Empty(t, log.Entries())
+		assert.Empty(t, hook.AllEntries())
+	})
+
+	t.Run("slow query is recorded", func(t *testing.T) {
+		query("Slow", 200*time.Millisecond, errors.New("boom"))
+
+		entries := log.Entries()
+		require.Len(t, entries, 1)
+		assert.Equal(t, "Slow", entries[0].Class)
+		assert.Equal(t, "Get { Slow(limit: 10) }", entries[0].Query)
+		assert.Equal(t, float64(200), entries[0].DurationMs)
+		assert.Equal(t, map[string]float64{PhaseFusion: 50}, entries[0].Phases)
+		assert.Equal(t, map[string]map[string]float64{
+			"shard1": {PhaseVector: 150},
+		}, entries[0].Shards)
+		assert.Equal(t, 3, entries[0].Results)
+		assert.Equal(t, "boom", entries[0].Error)
+
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, "slow query", hook.LastEntry().Message)
+		assert.Equal(t, "Get { Slow(limit: 10) }", hook.LastEntry().Data["query"])
+	})
+
+	t.Run("buffer keeps the most recent queries", func(t *testing.T) {
+		query("Second", 300*time.Millisecond, nil)
+		query("Third", 400*time.Millisecond, nil)
+
+		entries := log.Entries()
+		require.Len(t, entries, 2)
+		assert.Equal(t, "Third", entries[0].Class)
+		assert.Equal(t, "Second", entries[1].Class)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var disabled *Log
+		ctx, tracker := disabled.Track(context.Background())
+		Observe(ctx, "shard1", PhaseVector, time.Second)
+		tracker.Done(dto.GetParams{}, 0, nil)
+		assert.Nil(t, disabled.Entries())
+		assert.Nil(t, New(config.SlowQueryLog{}, logger))
+	})
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tracing exports OpenTelemetry spans of the query path. It is
+// configured through the standard OTEL_* environment variables, as long as
+// no exporter is configured all spans are no-ops.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/weaviate/weaviate"
+	defaultServiceName  = "weaviate"
+)
+
+// Init sets up the global tracer provider if tracing is enabled through the
+// environment. The exporter (OTEL_EXPORTER_OTLP_*), sampler
+// (OTEL_TRACES_SAMPLER*), batching (OTEL_BSP_*) and resource
+// (OTEL_SERVICE_NAME, OTEL_RESOURCE_ATTRIBUTES) are configured by the SDK
+// itself. The returned function flushes and stops the exporter, it is never
+// nil.
+func Init(ctx context.Context, logger logrus.FieldLogger) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !enabled(os.Getenv) {
+		return noop, nil
+	}
+
+	if protocol := exporterProtocol(os.Getenv); protocol != "grpc" {
+		return noop, fmt.Errorf("unsupported OTLP protocol %q, only grpc is supported", protocol)
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return noop, fmt.Errorf("create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.NewSchemaless(attribute.String("service.name", defaultServiceName)),
+		resource.Environment(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.WithField("action", "tracing").WithError(err).Warn("could not export traces")
+	}))
+
+	logger.WithField("action", "startup").Info("OpenTelemetry tracing enabled")
+
+	return provider.Shutdown, nil
+}
+
+// enabled follows the OpenTelemetry conventions: the SDK can be turned off
+// explicitly, otherwise an exporter needs to be selected or an OTLP endpoint
+// set.
+func enabled(getenv func(string) string) bool {
+	if strings.EqualFold(getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+
+	switch strings.ToLower(getenv("OTEL_TRACES_EXPORTER")) {
+	case "otlp":
+		return true
+	case "none":
+		return false
+	}
+
+	return getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+func exporterProtocol(getenv func(string) string) string {
+	protocol := getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	if protocol == "" {
+		return "grpc"
+	}
+
+	return protocol
+}
+
+// Start starts a span as a child of the one in ctx, if any
+func Start(ctx context.Context, name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartServer starts the span of an incoming request, continuing the trace
+// propagated in its headers
+func StartServer(ctx context.Context, header http.Header, name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+	return otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+}
+
+// Handler starts a server span for every request to the cluster API of this
+// node, continuing the trace of the node which sent it
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := StartServer(r.Context(), r.Header, clusterSpanName(r),
+			attribute.String("http.method", r.Method),
+			attribute.String("http.target", r.URL.Path))
+		defer span.End()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Transport records a client span for every request to another node and
+// propagates the trace in its headers
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(instrumentationName).Start(req.Context(), clusterSpanName(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.target", req.URL.Path),
+			attribute.String("net.peer.name", req.URL.Host),
+		))
+
+	// a RoundTripper must not modify the request it was given
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		End(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+	span.End()
+	return res, nil
+}
+
+// clusterSpanName names the span after the API of the request, e.g.
+// "cluster GET replicas", to keep the number of span names low
+func clusterSpanName(r *http.Request) string {
+	api := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+	return fmt.Sprintf("cluster %s %s", r.Method, api)
+}
+
+// End records err, if any, on the span and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{
+			name:     "nothing set",
+			env:      map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "endpoint set",
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317"},
+			expected: true,
+		},
+		{
+			name:     "traces endpoint set",
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://collector:4317"},
+			expected: true,
+		},
+		{
+			name:     "otlp exporter selected",
+			env:      map[string]string{"OTEL_TRACES_EXPORTER": "otlp"},
+			expected: true,
+		},
+		{
+			name: "exporter turned off",
+			env: map[string]string{
+				"OTEL_TRACES_EXPORTER":        "none",
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317",
+			},
+			expected: false,
+		},
+		{
+			name: "sdk disabled",
+			env: map[string]string{
+				"OTEL_SDK_DISABLED":           "true",
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317",
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getenv := func(key string) string { return test.env[key] }
+			assert.Equal(t, test.expected, enabled(getenv))
+		})
+	}
+}
+
+func TestExporterProtocol(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+
+	assert.Equal(t, "grpc", exporterProtocol(getenv))
+
+	env["OTEL_EXPORTER_OTLP_PROTOCOL"] = "http/protobuf"
+	assert.Equal(t, "http/protobuf", exporterProtocol(getenv))
+
+	env["OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"] = "grpc"
+	assert.Equal(t, "grpc", exporterProtocol(getenv))
+}
+
+func TestRemoteRequestsContinueTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	var remote trace.SpanContext
+	server := httptest.NewServer(Handler(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			remote = trace.SpanContextFromContext(r.Context())
+		})))
+	defer server.Close()
+
+	ctx, root := Start(context.Background(), "root")
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"/replicas/indices/Class/shards/S1/objects", nil)
+	require.Nil(t, err)
+	res, err := client.Do(req)
+	require.Nil(t, err)
+	res.Body.Close()
+	root.End()
+
+	assert.Equal(t, root.SpanContext().TraceID(), remote.TraceID())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	kinds := map[trace.SpanKind]string{}
+	for _, span := range spans {
+		assert.Equal(t, root.SpanContext().TraceID(), span.SpanContext().TraceID())
+		kinds[span.SpanKind()] = span.Name()
+	}
+	assert.Equal(t, map[trace.SpanKind]string{
+		trace.SpanKindInternal: "root",
+		trace.SpanKindClient:   "cluster GET replicas",
+		trace.SpanKindServer:   "cluster GET replicas",
+	}, kinds)
+}
@@ -68,6 +68,11 @@ func Test_Traverser_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Traverser{}) {
+			switch method {
+			case "SetSlowQueryLog", "SetAliasResolver":
+				// not user facing, only called once during startup
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
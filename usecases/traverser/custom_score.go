@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/traverser/scoreexpr"
+)
+
+// customScore scores every result with the expression of the query and
+// reorders the results by descending score. Like rerank it only reorders the
+// results the search returned. The score of a result is set to the value of
+// the expression, results whose value is not a finite number are ranked
+// last with a score of 0.
+func (e *Explorer) customScore(res []search.Result, params dto.GetParams,
+	byDistance bool,
+) ([]search.Result, error) {
+	expr, err := e.scoreExpression(params)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, len(res))
+	for i := range res {
+		env := scoreexpr.Env{
+			Score:    float64(res[i].Score),
+			Property: scorePropertyLookup(res[i]),
+		}
+		if byDistance {
+			env.Distance = float64(res[i].Dist)
+			env.Similarity = 1 - env.Distance
+		} else {
+			env.Similarity = env.Score
+		}
+
+		values[i] = expr.Eval(env)
+		if math.IsNaN(values[i]) || math.IsInf(values[i], 0) {
+			values[i] = math.Inf(-1)
+			res[i].Score = 0
+		} else {
+			res[i].Score = float32(values[i])
+		}
+		res[i].ExplainScore = fmt.Sprintf("%s\n(custom score) %s: %v",
+			res[i].ExplainScore, expr, res[i].Score)
+	}
+
+	sort.Stable(rerankedResults{results: res, scores: values})
+	return res, nil
+}
+
+// scoreExpression compiles the expression of the query, compiled
+// expressions are cached. Every property of the expression has to exist and
+// hold numbers.
+func (e *Explorer) scoreExpression(params dto.GetParams) (*scoreexpr.Expression, error) {
+	expr, err := e.scoreExpressions.Compile(params.CustomScore.Expression)
+	if err != nil {
+		return nil, errors.Wrap(err, "customScore")
+	}
+
+	class := e.class(params.ClassName)
+	if class == nil {
+		return nil, errors.Errorf("customScore: class %q not found", params.ClassName)
+	}
+
+	for _, name := range expr.Properties() {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, errors.Errorf("customScore: unknown property or variable %q, "+
+				"variables are %s, %s and %s", name,
+				scoreexpr.VarSimilarity, scoreexpr.VarDistance, scoreexpr.VarScore)
+		}
+		if len(prop.DataType) != 1 ||
+			(prop.DataType[0] != string(schema.DataTypeInt) &&
+				prop.DataType[0] != string(schema.DataTypeNumber)) {
+			return nil, errors.Errorf("customScore: property %q is not of type int or number", name)
+		}
+	}
+	return expr, nil
+}
+
+func scorePropertyLookup(res search.Result) func(name string) float64 {
+	props, _ := res.Schema.(map[string]interface{})
+	return func(name string) float64 {
+		switch v := props[name].(type) {
+		case float64:
+			return v
+		case int64:
+			return float64(v)
+		case int:
+			return float64(v)
+		case json.Number:
+			f, _ := v.Float64()
+			return f
+		default:
+			return 0
+		}
+	}
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithCustomScore(t *testing.T) {
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{ID: "a", Dist: 0.1, Score: 3, Schema: map[string]interface{}{"name": "a", "popularity": float64(1)}},
+			{ID: "b", Dist: 0.2, Score: 2, Schema: map[string]interface{}{"name": "b", "popularity": float64(100)}},
+			{ID: "c", Dist: 0.3, Score: 1, Schema: map[string]interface{}{"name": "c", "popularity": int64(10)}},
+			{ID: "d", Dist: 0.4, Score: 0.5, Schema: map[string]interface{}{"name": "d"}},
+		}
+	}
+
+	newExplorer := func() *Explorer {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("VectorSearch", mock.Anything).Return(searchResults(), nil)
+		searcher.On("Search", mock.Anything).Return(searchResults(), nil)
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{
+					Class: "BestClass",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "popularity", DataType: schema.DataTypeInt.PropString()},
+					},
+				},
+			}}},
+		})
+		return explorer
+	}
+
+	names := func(res []interface{}) []string {
+		out := make([]string, len(res))
+		for i := range res {
+			out[i] = res[i].(map[string]interface{})["name"].(string)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		params      dto.GetParams
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name: "vector search by similarity and a property",
+			params: dto.GetParams{
+				NearVector:  &searchparams.NearVector{Vector: []float32{1, 0}},
+				CustomScore: &searchparams.CustomScore{Expression: "0.5*similarity + 0.2*log10(popularity)"},
+			},
+			// the log of a missing property is not finite, d is ranked last
+			expected: []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "bm25 by the inverted score",
+			params: dto.GetParams{
+				KeywordRanking: &searchparams.KeywordRanking{Query: "foo", Type: "bm25"},
+				CustomScore:    &searchparams.CustomScore{Expression: "score = -score"},
+			},
+			expected: []string{"d", "c", "b", "a"},
+		},
+		{
+			name: "list by a property",
+			params: dto.GetParams{
+				CustomScore: &searchparams.CustomScore{Expression: "popularity"},
+			},
+			expected: []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "invalid expression",
+			params: dto.GetParams{
+				CustomScore: &searchparams.CustomScore{Expression: "popularity +"},
+			},
+			expectedErr: "customScore: unexpected",
+		},
+		{
+			name: "unknown property",
+			params: dto.GetParams{
+				CustomScore: &searchparams.CustomScore{Expression: "likes"},
+			},
+			expectedErr: `unknown property or variable "likes"`,
+		},
+		{
+			name: "text property",
+			params: dto.GetParams{
+				CustomScore: &searchparams.CustomScore{Expression: "name * 2"},
+			},
+			expectedErr: `property "name" is not of type int or number`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.ClassName = "BestClass"
+			tt.params.Pagination = &filters.Pagination{Limit: 10}
+			res, err := newExplorer().GetClass(context.Background(), tt.params)
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, names(res))
+		})
+	}
+
+	t.Run("score is set to the value of the expression", func(t *testing.T) {
+		explorer := newExplorer()
+		res, err := explorer.customScore(searchResults(), dto.GetParams{
+			ClassName:   "BestClass",
+			CustomScore: &searchparams.CustomScore{Expression: "popularity / 2"},
+		}, false)
+		require.Nil(t, err)
+		assert.Equal(t, float32(50), res[0].Score)
+		assert.Contains(t, res[0].ExplainScore, "(custom score) popularity / 2: 50")
+		assert.Equal(t, float32(0), res[3].Score)
+		assert.Equal(t, 1, explorer.scoreExpressions.Len())
+	})
+}
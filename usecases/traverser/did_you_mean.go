@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// DidYouMeanAlternatives is the maximum number of spelling corrections
+// offered for a query
+const DidYouMeanAlternatives = 3
+
+// didYouMean looks up spelling corrections of the query of a bm25 or
+// hybrid search in the terms of the searched properties
+func (e *Explorer) didYouMean(ctx context.Context, params dto.GetParams) (*additional.DidYouMean, error) {
+	var query string
+	var properties []string
+	switch {
+	case params.KeywordRanking != nil:
+		query, properties = params.KeywordRanking.Query, params.KeywordRanking.Properties
+	case params.HybridSearch != nil:
+		query, properties = params.HybridSearch.Query, params.HybridSearch.Properties
+	}
+
+	alternatives, err := e.searcher.SpellingAlternatives(ctx, params.ClassName,
+		properties, query, DidYouMeanAlternatives, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if alternatives == nil {
+		alternatives = []string{}
+	}
+	return &additional.DidYouMean{Original: query, Alternatives: alternatives}, nil
+}
+
+// autoCorrect runs a bm25 search again with the best spelling correction of
+// its query if the query found nothing. It returns the results and the
+// params of the search which produced them.
+func (e *Explorer) autoCorrect(ctx context.Context, res []search.Result,
+	params dto.GetParams, didYouMean *additional.DidYouMean,
+) ([]search.Result, dto.GetParams, error) {
+	if len(res) > 0 || !params.KeywordRanking.AutoCorrect ||
+		didYouMean == nil || len(didYouMean.Alternatives) == 0 {
+		return res, params, nil
+	}
+
+	keywordRanking := *params.KeywordRanking
+	keywordRanking.Query = didYouMean.Alternatives[0]
+	params.KeywordRanking = &keywordRanking
+
+	res, err := e.searcher.Search(ctx, params)
+	if err != nil {
+		return nil, params, err
+	}
+	didYouMean.Applied = true
+	return res, params, nil
+}
+
+// addDidYouMean adds the spelling corrections to the additional properties
+// of every result if they were requested
+func addDidYouMean(res []search.Result, params dto.GetParams, didYouMean *additional.DidYouMean) {
+	if !params.AdditionalProperties.DidYouMean || didYouMean == nil {
+		return
+	}
+
+	for i := range res {
+		if res[i].AdditionalProperties == nil {
+			res[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+		res[i].AdditionalProperties["didYouMean"] = didYouMean
+	}
+}
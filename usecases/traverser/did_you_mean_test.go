@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_DidYouMean(t *testing.T) {
+	class := &models.Class{
+		Class: "BestClass",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+		},
+	}
+
+	withQuery := func(query string) interface{} {
+		return mock.MatchedBy(func(params dto.GetParams) bool {
+			return params.KeywordRanking.Query == query
+		})
+	}
+	results := []search.Result{{ID: "a", Schema: map[string]interface{}{"title": "Apple pie"}}}
+
+	newExplorer := func(searcher *fakeVectorSearcher) *Explorer {
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		})
+		return explorer
+	}
+
+	getParams := func(query string, autoCorrect, didYouMean bool) dto.GetParams {
+		return dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query: query, Type: "bm25", AutoCorrect: autoCorrect,
+			},
+			AdditionalProperties: additional.Properties{DidYouMean: didYouMean},
+		}
+	}
+
+	didYouMean := func(res interface{}) *additional.DidYouMean {
+		return res.(map[string]interface{})["_additional"].(map[string]interface{})["didYouMean"].(*additional.DidYouMean)
+	}
+
+	t.Run("alternatives are added to the results", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", withQuery("apple pei")).Return(results, nil)
+		searcher.On("SpellingAlternatives", "BestClass", "apple pei").
+			Return([]string{"apple pie", "apple pea"}, nil)
+
+		res, err := newExplorer(searcher).GetClass(context.Background(),
+			getParams("apple pei", false, true))
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, &additional.DidYouMean{
+			Original:     "apple pei",
+			Alternatives: []string{"apple pie", "apple pea"},
+		}, didYouMean(res[0]))
+		searcher.AssertNumberOfCalls(t, "Search", 1)
+	})
+
+	t.Run("auto correct without results", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", withQuery("appel")).Return([]search.Result{}, nil)
+		searcher.On("Search", withQuery("apple")).Return(results, nil)
+		searcher.On("SpellingAlternatives", "BestClass", "appel").
+			Return([]string{"apple"}, nil)
+
+		res, err := newExplorer(searcher).GetClass(context.Background(),
+			getParams("appel", true, true))
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, &additional.DidYouMean{
+			Original:     "appel",
+			Alternatives: []string{"apple"},
+			Applied:      true,
+		}, didYouMean(res[0]))
+	})
+
+	t.Run("auto correct with results", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", withQuery("apple")).Return(results, nil)
+
+		res, err := newExplorer(searcher).GetClass(context.Background(),
+			getParams("apple", true, false))
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		searcher.AssertNotCalled(t, "SpellingAlternatives", mock.Anything, mock.Anything)
+	})
+
+	t.Run("auto correct without alternatives", func(t *testing.T) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", withQuery("zzzz")).Return([]search.Result{}, nil)
+		searcher.On("SpellingAlternatives", "BestClass", "zzzz").Return([]string(nil), nil)
+
+		res, err := newExplorer(searcher).GetClass(context.Background(),
+			getParams("zzzz", true, false))
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+		searcher.AssertNumberOfCalls(t, "Search", 1)
+	})
+}
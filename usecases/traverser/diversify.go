@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	// DefaultDiversifyLambda weighs relevance and diversity equally
+	DefaultDiversifyLambda = 0.5
+	// DefaultDiversifyCandidateMultiplier is the factor by which the limit is
+	// raised if the query does not set one
+	DefaultDiversifyCandidateMultiplier = 3
+	// MaxDiversifyCandidateMultiplier bounds the candidates a diversified
+	// query reads
+	MaxDiversifyCandidateMultiplier = 10
+)
+
+// diversifyOptions validates the diversify params and fills in defaults
+func diversifyOptions(params dto.GetParams) (lambda float64, multiplier int, err error) {
+	lambda = params.Diversify.Lambda
+	if lambda == 0 {
+		lambda = DefaultDiversifyLambda
+	}
+	if lambda < 0 || lambda > 1 {
+		return 0, 0, errors.Errorf("diversify: lambda must be between 0 and 1, got %v", lambda)
+	}
+
+	multiplier = params.Diversify.CandidateMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultDiversifyCandidateMultiplier
+	}
+	if multiplier < 1 || multiplier > MaxDiversifyCandidateMultiplier {
+		return 0, 0, errors.Errorf("diversify: candidateMultiplier must be between 1 and %d, got %d",
+			MaxDiversifyCandidateMultiplier, multiplier)
+	}
+
+	if params.GroupBy != nil {
+		return 0, 0, errors.New("diversify: can't be combined with groupBy")
+	}
+
+	return lambda, multiplier, nil
+}
+
+// diversifyCandidates returns the params of the search the diversified
+// results are selected from. Offset and limit are applied once the
+// candidates are reordered, the vectors of the candidates are needed to
+// compare them.
+func diversifyCandidates(params dto.GetParams, multiplier int) dto.GetParams {
+	params.AdditionalProperties.Vector = true
+	if params.Pagination.Limit < 0 {
+		// searches by distance return all matches anyway
+		return params
+	}
+
+	pagination := *params.Pagination
+	pagination.Offset = 0
+	pagination.Limit = (params.Pagination.Offset + params.Pagination.Limit) * multiplier
+	params.Pagination = &pagination
+	return params
+}
+
+// diversifyResults reorders the candidates by Maximal Marginal Relevance and
+// applies the offset and limit of the query. The relevance of a candidate is
+// its distance to the query or, without a search vector, its score. The
+// similarity of candidates is the cosine similarity of their vectors,
+// regardless of the distance metric of the class.
+func diversifyResults(res []search.Result, params dto.GetParams, lambda float64,
+	byDistance bool,
+) []search.Result {
+	n := len(res)
+	if params.Pagination.Limit >= 0 && params.Pagination.Offset+params.Pagination.Limit < n {
+		n = params.Pagination.Offset + params.Pagination.Limit
+	}
+
+	relevance := make([]float64, len(res))
+	for i := range res {
+		if byDistance {
+			relevance[i] = -float64(res[i].Dist)
+		} else {
+			relevance[i] = float64(res[i].Score)
+		}
+	}
+	normalizeRelevance(relevance)
+
+	selected := mmr(res, relevance, lambda, n)
+	if params.Pagination.Offset >= len(selected) {
+		return []search.Result{}
+	}
+	return selected[params.Pagination.Offset:]
+}
+
+// mmr greedily selects n results, each one maximizing
+// lambda*relevance - (1-lambda)*max similarity to the selected results
+func mmr(res []search.Result, relevance []float64, lambda float64, n int) []search.Result {
+	selected := make([]search.Result, 0, n)
+	used := make([]bool, len(res))
+	// maxSim holds the highest similarity of every candidate to any of the
+	// selected results, updated with every selection
+	maxSim := make([]float64, len(res))
+	for i := range maxSim {
+		maxSim[i] = math.Inf(-1)
+	}
+
+	for len(selected) < n {
+		best, bestScore := -1, math.Inf(-1)
+		for i := range res {
+			if used[i] {
+				continue
+			}
+			score := lambda * relevance[i]
+			if len(selected) > 0 {
+				score -= (1 - lambda) * maxSim[i]
+			}
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		used[best] = true
+		selected = append(selected, res[best])
+		for i := range res {
+			if !used[i] {
+				maxSim[i] = math.Max(maxSim[i], cosineSimilarity(res[i].Vector, res[best].Vector))
+			}
+		}
+	}
+
+	return selected
+}
+
+// normalizeRelevance scales the relevance to 0..1, so that it is comparable
+// to the similarity of the candidates
+func normalizeRelevance(relevance []float64) {
+	if len(relevance) == 0 {
+		return
+	}
+	min, max := relevance[0], relevance[0]
+	for _, r := range relevance {
+		min, max = math.Min(min, r), math.Max(max, r)
+	}
+	for i := range relevance {
+		if max == min {
+			relevance[i] = 1
+		} else {
+			relevance[i] = (relevance[i] - min) / (max - min)
+		}
+	}
+}
+
+// cosineSimilarity is 0 for missing vectors, so that results without one
+// are ranked by their relevance only
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
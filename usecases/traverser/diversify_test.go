@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithDiversify(t *testing.T) {
+	// a and b are nearly identical, c is further from the query but
+	// different from both and d is the least relevant
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{ID: "a", Dist: 0.1, Vector: []float32{1, 0}, Schema: map[string]interface{}{"name": "a"}},
+			{ID: "b", Dist: 0.11, Vector: []float32{1, 0.01}, Schema: map[string]interface{}{"name": "b"}},
+			{ID: "c", Dist: 0.3, Vector: []float32{0, 1}, Schema: map[string]interface{}{"name": "c"}},
+			{ID: "d", Dist: 0.5, Vector: []float32{0.7, 0.7}, Schema: map[string]interface{}{"name": "d"}},
+		}
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("VectorSearch", mock.Anything).Return(searchResults(), nil)
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	names := func(res []interface{}) []string {
+		out := make([]string, len(res))
+		for i := range res {
+			out[i] = res[i].(map[string]interface{})["name"].(string)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		pagination  *filters.Pagination
+		diversify   *searchparams.Diversify
+		expected    []string
+		candidates  int
+		expectedErr string
+	}{
+		{
+			name:       "nearly identical results move down",
+			pagination: &filters.Pagination{Limit: 3},
+			diversify:  &searchparams.Diversify{},
+			expected:   []string{"a", "c", "b"},
+			candidates: 9,
+		},
+		{
+			name:       "only relevance",
+			pagination: &filters.Pagination{Limit: 3},
+			diversify:  &searchparams.Diversify{Lambda: 1, CandidateMultiplier: 2},
+			expected:   []string{"a", "b", "c"},
+			candidates: 6,
+		},
+		{
+			name:       "offset is applied to the diversified results",
+			pagination: &filters.Pagination{Offset: 1, Limit: 1},
+			diversify:  &searchparams.Diversify{},
+			expected:   []string{"c"},
+			candidates: 6,
+		},
+		{
+			name:        "invalid lambda",
+			pagination:  &filters.Pagination{Limit: 3},
+			diversify:   &searchparams.Diversify{Lambda: 2},
+			expectedErr: "lambda must be between 0 and 1",
+		},
+		{
+			name:        "invalid candidate multiplier",
+			pagination:  &filters.Pagination{Limit: 3},
+			diversify:   &searchparams.Diversify{CandidateMultiplier: MaxDiversifyCandidateMultiplier + 1},
+			expectedErr: "candidateMultiplier must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:  "BestClass",
+				Pagination: tt.pagination,
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+				Diversify:  tt.diversify,
+			}
+
+			explorer, searcher := newExplorer()
+			res, err := explorer.GetClass(context.Background(), params)
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, names(res))
+
+			searched := searcher.Calls[0].Arguments.Get(0).(dto.GetParams)
+			assert.Equal(t, 0, searched.Pagination.Offset)
+			assert.Equal(t, tt.candidates, searched.Pagination.Limit)
+			assert.True(t, searched.AdditionalProperties.Vector)
+			// the vectors are only fetched to compare the candidates
+			for _, r := range res {
+				assert.NotContains(t, r.(map[string]interface{}), "_additional")
+			}
+		})
+	}
+
+	t.Run("keyword search", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:      "BestClass",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "foo", Type: "bm25"},
+			Diversify:      &searchparams.Diversify{},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "only supported on vector and hybrid searches")
+	})
+}
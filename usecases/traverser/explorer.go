@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/autocut"
 
@@ -25,17 +26,19 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
-	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
+	"github.com/weaviate/weaviate/usecases/replica"
 	uc "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser/grouper"
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
+	"github.com/weaviate/weaviate/usecases/traverser/scoreexpr"
 )
 
 // Explorer is a helper construct to perform vector-based searches. It does not
@@ -48,6 +51,12 @@ type Explorer struct {
 	schemaGetter     uc.SchemaGetter
 	nearParamsVector *nearParamsVector
 	metrics          explorerMetrics
+	// classes are looked up on every query, they are cached if the schema
+	// getter reports changes
+	classes *uc.ReadCache[map[string]*models.Class]
+	// scoreExpressions keeps the compiled expressions of customScore
+	scoreExpressions *scoreexpr.Cache
+	resultCache      *ResultCache // nil unless the query result cache is enabled
 }
 
 type explorerMetrics interface {
@@ -68,6 +77,7 @@ type ModulesProvider interface {
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
 	VectorFromInput(ctx context.Context, className string, input string) ([]float32, error)
+	Rerank(ctx context.Context, className string, query string, documents []string) ([]float64, error)
 }
 
 type objectsSearcher interface {
@@ -86,6 +96,11 @@ type objectsSearcher interface {
 		props search.SelectProperties, additional additional.Properties,
 		properties *additional.ReplicationProperties, tenant string) (*search.Result, error)
 	ObjectsByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties, tenant string) (search.Results, error)
+	WaitForIndexing(ctx context.Context, className, tenant string) error
+
+	// spelling corrections of keyword queries
+	SpellingAlternatives(ctx context.Context, className string, properties []string,
+		query string, limit int, tenant string) ([]string, error)
 }
 
 type hybridSearcher interface {
@@ -107,11 +122,64 @@ func NewExplorer(searcher objectsSearcher, logger logrus.FieldLogger,
 		metrics:          metrics,
 		schemaGetter:     nil, // schemaGetter is set later
 		nearParamsVector: newNearParamsVector(modulesProvider, searcher),
+		scoreExpressions: scoreexpr.NewCache(scoreexpr.DefaultCacheSize),
 	}
 }
 
+type schemaNotifier interface {
+	Subscribe(subscriber uc.ChangeSubscriber)
+}
+
 func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
+	notifier, ok := sg.(schemaNotifier)
+	e.classes = uc.NewReadCache[map[string]*models.Class](ok)
+	if ok {
+		notifier.Subscribe(e.onSchemaChange)
+	}
+}
+
+// SetResultCache enables the cache of query results
+func (e *Explorer) SetResultCache(cache *ResultCache) {
+	e.resultCache = cache
+}
+
+func (e *Explorer) onSchemaChange(change uc.Change) {
+	if e.resultCache != nil {
+		switch change.Type {
+		case uc.SchemaReplaced:
+			e.resultCache.Flush()
+		case uc.ClassRenamed:
+			e.resultCache.invalidateClass(change.Previous)
+			e.resultCache.invalidateClass(change.Class)
+		default:
+			e.resultCache.invalidateClass(change.Class)
+		}
+	}
+
+	switch change.Type {
+	case uc.TenantsAdded, uc.TenantsDeleted, uc.TenantsUpdated:
+		// classes are not affected
+	default:
+		e.classes.Invalidate()
+	}
+}
+
+// class returns the class from the schema or nil if it does not exist
+func (e *Explorer) class(name string) *models.Class {
+	return e.classes.Get(e.loadClasses)[name]
+}
+
+func (e *Explorer) loadClasses() map[string]*models.Class {
+	s := e.schemaGetter.GetSchemaSkipAuth()
+	if s.Objects == nil {
+		return nil
+	}
+	classes := make(map[string]*models.Class, len(s.Objects.Classes))
+	for _, class := range s.Objects.Classes {
+		classes[class.Class] = class
+	}
+	return classes
 }
 
 // GetClass from search and connector repo
@@ -137,15 +205,70 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
-	if params.KeywordRanking != nil {
-		return e.getClassKeywordBased(ctx, params)
+	if err := e.validateVectorOnly(params); err != nil {
+		return nil, err
 	}
 
-	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
-		return e.getClassVectorSearch(ctx, params)
+	if params.WaitForIndexing {
+		if err := e.searcher.WaitForIndexing(ctx, params.ClassName, params.Tenant); err != nil {
+			return nil, errors.Wrap(err, "wait for indexing")
+		}
 	}
 
-	return e.getClassList(ctx, params)
+	vectorSearch := params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0
+	if params.Diversify != nil && (params.KeywordRanking != nil ||
+		(!vectorSearch && params.HybridSearch == nil)) {
+		return nil, errors.New("diversify is only supported on vector and hybrid searches")
+	}
+	if params.TimeDecay != nil && params.KeywordRanking == nil && params.HybridSearch == nil {
+		return nil, errors.New("time decay is only supported on hybrid and bm25 searches")
+	}
+	if params.CustomScore != nil {
+		if _, err := e.scoreExpression(params); err != nil {
+			return nil, err
+		}
+	}
+	if groupByVector(params) && (params.KeywordRanking != nil ||
+		(!vectorSearch && params.HybridSearch == nil)) {
+		return nil, errors.New("groupBy: the vector strategy is only supported on vector and hybrid searches")
+	}
+	if params.AdditionalProperties.SkipVector && (params.AdditionalProperties.Vector ||
+		params.Group != nil || params.Diversify != nil || groupByVector(params)) {
+		return nil, errors.New("skipVectors can't be combined with the vector, group, " +
+			"diversify or the vector strategy of groupBy, these need the vectors of the results")
+	}
+	if params.GroupBy != nil && params.GroupBy.Strategy != "" &&
+		params.GroupBy.Strategy != searchparams.GroupByStrategyProperty &&
+		params.GroupBy.Strategy != searchparams.GroupByStrategyVector {
+		return nil, errors.Errorf("groupBy: unknown strategy %q, use %q or %q", params.GroupBy.Strategy,
+			searchparams.GroupByStrategyProperty, searchparams.GroupByStrategyVector)
+	}
+
+	// a consistency token asks for replicas which have applied certain
+	// writes, a cached result can not guarantee that
+	var lookup *resultCacheLookup
+	if _, withToken := replica.TokenFromContext(ctx); e.resultCache != nil && !withToken {
+		var cached []interface{}
+		var hit bool
+		if cached, hit, lookup = e.resultCache.get(params); hit {
+			return cached, nil
+		}
+	}
+
+	var res []interface{}
+	var err error
+	switch {
+	case params.KeywordRanking != nil:
+		res, err = e.getClassKeywordBased(ctx, params)
+	case vectorSearch:
+		res, err = e.getClassVectorSearch(ctx, params)
+	default:
+		res, err = e.getClassList(ctx, params)
+	}
+	if err == nil && lookup != nil {
+		e.resultCache.put(lookup, res)
+	}
+	return res, err
 }
 
 func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParams) ([]interface{}, error) {
@@ -157,14 +280,26 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
-	if len(params.AdditionalProperties.ModuleParams) > 0 {
+	if len(params.AdditionalProperties.ModuleParams) > 0 && !params.AdditionalProperties.SkipVector {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
 		// asking the module based on specific conditions
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.searcher.Search(ctx, params)
+	searchParams := params
+	if params.TimeDecay != nil {
+		// the boost reorders the results, the offset can only be applied
+		// afterwards
+		pagination := *params.Pagination
+		pagination.Offset = 0
+		if pagination.Limit >= 0 {
+			pagination.Limit += params.Pagination.Offset
+		}
+		searchParams.Pagination = &pagination
+	}
+
+	res, err := e.searcher.Search(ctx, searchParams)
 	if err != nil {
 		var e inverted.MissingIndexError
 		if errors.As(err, &e) {
@@ -173,6 +308,37 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	var didYouMean *additional.DidYouMean
+	if params.AdditionalProperties.DidYouMean || (params.KeywordRanking.AutoCorrect && len(res) == 0) {
+		if didYouMean, err = e.didYouMean(ctx, params); err != nil {
+			return nil, errors.Errorf("explorer: get class: did you mean: %v", err)
+		}
+	}
+	if res, searchParams, err = e.autoCorrect(ctx, res, searchParams, didYouMean); err != nil {
+		return nil, errors.Errorf("explorer: get class: auto correct: %v", err)
+	}
+	// highlights are based on the query which found the results
+	params.KeywordRanking = searchParams.KeywordRanking
+
+	if params.TimeDecay != nil {
+		if res, err = e.timeDecay(res, params); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.CustomScore != nil {
+		if res, err = e.customScore(res, params, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Rerank != nil {
+		res, err = e.rerank(ctx, res, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: %v", err)
+		}
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -182,6 +348,12 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		res = grouped
 	}
 
+	addDidYouMean(res, params, didYouMean)
+
+	if err := e.highlight(res, params); err != nil {
+		return nil, errors.Errorf("explorer: get class: highlights: %v", err)
+	}
+
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
 			params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
@@ -193,6 +365,24 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 	return e.searchResultsToGetResponse(ctx, res, nil, params)
 }
 
+// timeDecay boosts recent results of a bm25 search the same way the hybrid
+// searcher boosts fused results and applies the offset of the query
+func (e *Explorer) timeDecay(res []search.Result, params dto.GetParams) ([]search.Result, error) {
+	results := make(hybrid.Results, len(res))
+	for i := range res {
+		results[i] = &hybrid.Result{Result: &res[i]}
+	}
+	if err := hybrid.ApplyTimeDecay(params.TimeDecay, time.Now(), results); err != nil {
+		return nil, err
+	}
+
+	out := results.SearchResults()
+	if params.Pagination.Offset >= len(out) {
+		return []search.Result{}, nil
+	}
+	return out[params.Pagination.Offset:], nil
+}
+
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]interface{}, error) {
@@ -203,15 +393,34 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 
 	params.SearchVector = searchVector
 
-	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
+	if (len(params.AdditionalProperties.ModuleParams) > 0 && !params.AdditionalProperties.SkipVector) ||
+		params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
-		// asking the module based on specific conditions
+		// asking the module based on specific conditions. Unless the vectors
+		// are skipped explicitly.
 		// if a group is set, vectors are needed
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.searcher.VectorSearch(ctx, params)
+	searchParams := params
+	var lambda float64
+	if params.Diversify != nil {
+		var multiplier int
+		if lambda, multiplier, err = diversifyOptions(params); err != nil {
+			return nil, err
+		}
+		searchParams = diversifyCandidates(params, multiplier)
+	}
+	if groupByVector(params) {
+		candidates, err := groupByVectorOptions(params)
+		if err != nil {
+			return nil, err
+		}
+		searchParams = groupByVectorCandidates(params, candidates)
+	}
+
+	res, err := e.searcher.VectorSearch(ctx, searchParams)
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
@@ -225,6 +434,29 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		res = res[:cutOff]
 	}
 
+	if params.Diversify != nil {
+		res = diversifyResults(res, params, lambda, true)
+	}
+
+	if groupByVector(params) {
+		if res, err = groupByVectorResults(res, params.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.CustomScore != nil {
+		if res, err = e.customScore(res, params, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Rerank != nil {
+		res, err = e.rerank(ctx, res, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: %v", err)
+		}
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -292,6 +524,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		Keyword:      params.KeywordRanking,
 		Class:        params.ClassName,
 		Autocut:      params.Pagination.Autocut,
+		TimeDecay:    params.TimeDecay,
 	}, e.logger, sparseSearch, denseSearch,
 		postProcess, e.modulesProvider)
 
@@ -345,7 +578,34 @@ func (e *Explorer) getClassList(ctx context.Context,
 	}
 	var res []search.Result
 	var err error
-	if params.HybridSearch != nil {
+	if params.HybridSearch != nil && params.Diversify != nil {
+		if params.Pagination.Limit <= 0 {
+			pagination := *params.Pagination
+			pagination.Limit = hybrid.DefaultLimit
+			params.Pagination = &pagination
+		}
+		lambda, multiplier, err := diversifyOptions(params)
+		if err != nil {
+			return nil, err
+		}
+		res, err = e.Hybrid(ctx, diversifyCandidates(params, multiplier))
+		if err != nil {
+			return nil, err
+		}
+		res = diversifyResults(res, params, lambda, false)
+	} else if params.HybridSearch != nil && groupByVector(params) {
+		candidates, err := groupByVectorOptions(params)
+		if err != nil {
+			return nil, err
+		}
+		res, err = e.Hybrid(ctx, groupByVectorCandidates(params, candidates))
+		if err != nil {
+			return nil, err
+		}
+		if res, err = groupByVectorResults(res, params.GroupBy); err != nil {
+			return nil, err
+		}
+	} else if params.HybridSearch != nil {
 		res, err = e.Hybrid(ctx, params)
 		if err != nil {
 			return nil, err
@@ -361,6 +621,19 @@ func (e *Explorer) getClassList(ctx context.Context,
 		}
 	}
 
+	if params.CustomScore != nil {
+		if res, err = e.customScore(res, params, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Rerank != nil {
+		res, err = e.rerank(ctx, res, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: list class: %v", err)
+		}
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -370,6 +643,18 @@ func (e *Explorer) getClassList(ctx context.Context,
 		res = grouped
 	}
 
+	if params.HybridSearch != nil && params.AdditionalProperties.DidYouMean {
+		didYouMean, err := e.didYouMean(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: list class: did you mean: %v", err)
+		}
+		addDidYouMean(res, params, didYouMean)
+	}
+
+	if err := e.highlight(res, params); err != nil {
+		return nil, errors.Errorf("explorer: list class: highlights: %v", err)
+	}
+
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.ListExploreAdditionalExtend(ctx, res,
 			params.AdditionalProperties.ModuleParams, params.ModuleParams)
@@ -681,11 +966,7 @@ func (e *Explorer) crossClassVectorFromModules(ctx context.Context,
 }
 
 func (e *Explorer) checkCertaintyCompatibility(className string) error {
-	s := e.schemaGetter.GetSchemaSkipAuth()
-	if s.Objects == nil {
-		return errors.Errorf("failed to get schema")
-	}
-	class := s.GetClass(schema.ClassName(className))
+	class := e.class(className)
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
@@ -704,8 +985,7 @@ func (e *Explorer) replicationEnabled(params dto.GetParams) (bool, error) {
 	if e.schemaGetter == nil {
 		return false, fmt.Errorf("schemaGetter not set")
 	}
-	sch := e.schemaGetter.GetSchemaSkipAuth()
-	cls := sch.GetClass(schema.ClassName(params.ClassName))
+	cls := e.class(params.ClassName)
 	if cls == nil {
 		return false, fmt.Errorf("class not found in schema: %q", params.ClassName)
 	}
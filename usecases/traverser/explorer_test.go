@@ -13,6 +13,8 @@ package traverser
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
@@ -2753,6 +2756,16 @@ func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className str
 	panic("not implemented")
 }
 
+func (p *fakeModulesProvider) Rerank(ctx context.Context, className string,
+	query string, documents []string,
+) ([]float64, error) {
+	scores := make([]float64, len(documents))
+	for i := range documents {
+		scores[i] = float64(strings.Count(documents[i], query))
+	}
+	return scores, nil
+}
+
 func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, className,
 	param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn, tenant string,
@@ -2875,6 +2888,223 @@ func getFakeModulesProviderWithCustomExtenders(
 	}
 }
 
+func Test_Explorer_GetClass_WaitForIndexing(t *testing.T) {
+	for _, wait := range []bool{true, false} {
+		t.Run(fmt.Sprintf("wait for indexing: %v", wait), func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:       "BestClass",
+				Pagination:      &filters.Pagination{Limit: 100},
+				WaitForIndexing: wait,
+			}
+
+			searcher := &fakeVectorSearcher{}
+			log, _ := test.NewNullLogger()
+			explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+					{Class: "BestClass"},
+				}}},
+			})
+			searcher.On("Search", params).Return([]search.Result{}, nil)
+
+			_, err := explorer.GetClass(context.Background(), params)
+			require.Nil(t, err)
+
+			if wait {
+				assert.Equal(t, []string{"BestClass"}, searcher.waitedForIndexing)
+			} else {
+				assert.Empty(t, searcher.waitedForIndexing)
+			}
+		})
+	}
+}
+
+func Test_Explorer_GetClass_WithTimeDecay(t *testing.T) {
+	now := time.Now()
+	searchResults := []search.Result{
+		{ID: "old", Score: 2, Schema: map[string]interface{}{
+			"published": now.Add(-30 * 24 * time.Hour).Format(time.RFC3339),
+		}},
+		{ID: "new", Score: 1.5, Schema: map[string]interface{}{
+			"published": now.Format(time.RFC3339),
+		}},
+		{ID: "undated", Score: 1, Schema: map[string]interface{}{}},
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", mock.Anything).Return(searchResults, nil)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("bm25 results are boosted by recency", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		res, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:            "BestClass",
+			Pagination:           &filters.Pagination{Offset: 1, Limit: 2},
+			KeywordRanking:       &searchparams.KeywordRanking{Query: "foo", Type: "bm25"},
+			TimeDecay:            &searchparams.TimeDecay{Property: "published", Scale: "7d"},
+			AdditionalProperties: additional.Properties{ID: true},
+		})
+		require.Nil(t, err)
+
+		// the offset is applied once the results are boosted
+		searched := searcher.Calls[0].Arguments.Get(0).(dto.GetParams)
+		assert.Equal(t, 0, searched.Pagination.Offset)
+		assert.Equal(t, 3, searched.Pagination.Limit)
+
+		require.Len(t, res, 2)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].(map[string]interface{})["_additional"].(map[string]interface{})["id"].(strfmt.UUID)
+		}
+		assert.Equal(t, []strfmt.UUID{"old", "undated"}, ids)
+	})
+
+	t.Run("vector search", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:  "BestClass",
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+			TimeDecay:  &searchparams.TimeDecay{Property: "published", Scale: "7d"},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "only supported on hybrid and bm25 searches")
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:      "BestClass",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "foo", Type: "bm25"},
+			TimeDecay:      &searchparams.TimeDecay{Property: "published"},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "scale must be a positive duration")
+	})
+}
+
 func getFakeModulesProvider() ModulesProvider {
 	return &fakeModulesProvider{}
 }
+
+func Test_Explorer_GetClass_SkipVectors(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("passed to the search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:            "BestClass",
+			Pagination:           &filters.Pagination{Limit: 100},
+			AdditionalProperties: additional.Properties{SkipVector: true},
+		}
+		explorer, searcher := newExplorer()
+		searcher.On("Search", params).Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+	})
+
+	nearVector := &searchparams.NearVector{Vector: []float32{0.8, 0.2, 0.7}}
+	for name, params := range map[string]dto.GetParams{
+		"vector": {
+			AdditionalProperties: additional.Properties{SkipVector: true, Vector: true},
+		},
+		"group": {
+			AdditionalProperties: additional.Properties{SkipVector: true},
+			Group:                &dto.GroupParams{Strategy: "closest", Force: 0.5},
+		},
+		"diversify": {
+			AdditionalProperties: additional.Properties{SkipVector: true},
+			NearVector:           nearVector,
+			Diversify:            &searchparams.Diversify{},
+		},
+		"groupBy vector": {
+			AdditionalProperties: additional.Properties{SkipVector: true},
+			NearVector:           nearVector,
+			GroupBy:              &searchparams.GroupBy{Strategy: searchparams.GroupByStrategyVector, Groups: 2},
+		},
+	} {
+		t.Run("can't be combined with "+name, func(t *testing.T) {
+			params.ClassName = "BestClass"
+			params.Pagination = &filters.Pagination{Limit: 100}
+			explorer, searcher := newExplorer()
+
+			_, err := explorer.GetClass(context.Background(), params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "skipVectors")
+			searcher.AssertNotCalled(t, "Search", mock.Anything)
+		})
+	}
+}
+
+func Test_Explorer_GetClass_VectorOnly(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "Embeddings", VectorOnly: true},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("vector search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "Embeddings",
+			Pagination: &filters.Pagination{Limit: 100},
+			NearVector: &searchparams.NearVector{Vector: []float32{0.8, 0.2, 0.7}},
+		}
+		explorer, searcher := newExplorer()
+		searcher.On("VectorSearch", mock.Anything).Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+	})
+
+	for name, params := range map[string]dto.GetParams{
+		"where filters": {
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Embeddings", Property: "id"},
+				Value:    &filters.Value{Value: "73f2eb5f-5abf-447a-81ca-74b1dd168247", Type: schema.DataTypeText},
+			}},
+		},
+		"bm25 searches": {
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "a"},
+		},
+		"hybrid searches": {
+			HybridSearch: &searchparams.HybridSearch{Query: "a", Alpha: 0.5},
+		},
+	} {
+		t.Run(name+" are rejected", func(t *testing.T) {
+			params.ClassName = "Embeddings"
+			params.Pagination = &filters.Pagination{Limit: 100}
+			explorer, searcher := newExplorer()
+
+			_, err := explorer.GetClass(context.Background(), params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "is vector-only and has no inverted index, "+name)
+			searcher.AssertNotCalled(t, "Search", mock.Anything)
+		})
+	}
+}
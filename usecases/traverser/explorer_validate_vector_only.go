@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// validateVectorOnly rejects everything which needs an inverted index on
+// classes which only store the vectors of their objects
+func (e *Explorer) validateVectorOnly(params dto.GetParams) error {
+	class := e.class(params.ClassName)
+	if class == nil || !class.VectorOnly {
+		return nil
+	}
+
+	var unsupported string
+	switch {
+	case params.Filters != nil:
+		unsupported = "where filters"
+	case params.KeywordRanking != nil:
+		unsupported = "bm25 searches"
+	case params.HybridSearch != nil:
+		unsupported = "hybrid searches"
+	case len(params.Sort) > 0:
+		unsupported = "sorting"
+	case params.GroupBy != nil && !groupByVector(params):
+		unsupported = "grouping by property"
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("class %q is vector-only and has no inverted index, %s are not supported",
+		params.ClassName, unsupported)
+}
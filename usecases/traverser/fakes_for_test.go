@@ -81,10 +81,11 @@ func (f *fakeTxt2VecVectorizer) MoveAwayFrom(source []float32, target []float32,
 
 type fakeVectorSearcher struct {
 	mock.Mock
-	calledWithVector []float32
-	calledWithLimit  int
-	calledWithOffset int
-	results          []search.Result
+	calledWithVector  []float32
+	calledWithLimit   int
+	calledWithOffset  int
+	results           []search.Result
+	waitedForIndexing []string
 }
 
 func (f *fakeVectorSearcher) CrossClassVectorSearch(ctx context.Context,
@@ -152,6 +153,20 @@ func (f *fakeVectorSearcher) ResolveReferences(ctx context.Context, objs search.
 	return nil, nil
 }
 
+func (f *fakeVectorSearcher) WaitForIndexing(ctx context.Context,
+	className, tenant string,
+) error {
+	f.waitedForIndexing = append(f.waitedForIndexing, className)
+	return nil
+}
+
+func (f *fakeVectorSearcher) SpellingAlternatives(ctx context.Context,
+	className string, properties []string, query string, limit int, tenant string,
+) ([]string, error) {
+	args := f.Called(className, query)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -231,9 +246,10 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 	panic("not implemented")
 }
 
-func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) { return shard, nil }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
-func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
+func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error)       { return shard, nil }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) string              { return tenant }
+func (f *fakeSchemaGetter) TenantQuota(class, tenant string) *models.TenantQuota { return nil }
+func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string       { return string(uuid) }
 
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+// DefaultMaxConcurrency is the number of per-result generations which run in
+// parallel
+const DefaultMaxConcurrency = 10
+
+// ErrTokenBudgetExceeded is returned for prompts which don't fit into the
+// token budget of the provider
+var ErrTokenBudgetExceeded = errors.New("prompt exceeds the token budget")
+
+// Provider generates text with a generative model. It is implemented by the
+// clients of the generative modules.
+type Provider interface {
+	GenerateSingleResult(ctx context.Context, textProperties map[string]string,
+		prompt string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+	GenerateAllResults(ctx context.Context, textProperties []map[string]string,
+		task string, cfg moduletools.ClassConfig) (*generativemodels.GenerateResponse, error)
+}
+
+// TokenBudgeter is implemented by providers which limit the size of prompts,
+// e.g. to the context window of the model minus the tokens reserved for the
+// completion. A budget of 0 means unlimited.
+type TokenBudgeter interface {
+	TokenBudget(cfg moduletools.ClassConfig) int
+}
+
+// Result is the outcome of a single generation. Errors are reported per
+// result, so a failing generation does not fail the whole query.
+type Result struct {
+	Text *string
+	Err  error
+}
+
+// Pipeline generates text for the results of a search. The map stage
+// generates one result per document from a prompt, the reduce stage a single
+// result from a task and all documents.
+type Pipeline struct {
+	provider       Provider
+	maxConcurrency int
+	countTokens    func(text string) int
+}
+
+func NewPipeline(provider Provider) *Pipeline {
+	return &Pipeline{
+		provider:       provider,
+		maxConcurrency: DefaultMaxConcurrency,
+		countTokens:    EstimateTokens,
+	}
+}
+
+// Map generates one result per document. The properties of each document
+// are filled into the prompt, documents whose prompt exceeds the token budget
+// are not sent to the provider.
+func (p *Pipeline) Map(ctx context.Context, documents []map[string]string,
+	prompt string, cfg moduletools.ClassConfig,
+) []Result {
+	out := make([]Result, len(documents))
+	budget := p.tokenBudget(cfg)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.maxConcurrency)
+	for i := range documents {
+		if budget > 0 {
+			if tokens := p.countTokens(fillPrompt(prompt, documents[i])); tokens > budget {
+				out[i].Err = fmt.Errorf("%w: %d tokens, budget is %d",
+					ErrTokenBudgetExceeded, tokens, budget)
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			sem <- struct{}{}
+			defer wg.Done()
+			defer func() { <-sem }()
+			out[i] = p.generate(func() (*generativemodels.GenerateResponse, error) {
+				return p.provider.GenerateSingleResult(ctx, documents[i], prompt, cfg)
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	return out
+}
+
+// Reduce generates a single result from the task and the documents. If the
+// documents don't fit into the token budget, the last ones are left out, as
+// they are the least relevant results of the search.
+func (p *Pipeline) Reduce(ctx context.Context, documents []map[string]string,
+	task string, cfg moduletools.ClassConfig,
+) Result {
+	if budget := p.tokenBudget(cfg); budget > 0 {
+		n, tokens := p.fitDocuments(documents, task, budget)
+		if n == 0 && len(documents) > 0 {
+			return Result{Err: fmt.Errorf("%w: %d tokens for the task and the first result, budget is %d",
+				ErrTokenBudgetExceeded, tokens, budget)}
+		}
+		documents = documents[:n]
+	}
+
+	return p.generate(func() (*generativemodels.GenerateResponse, error) {
+		return p.provider.GenerateAllResults(ctx, documents, task, cfg)
+	})
+}
+
+// fitDocuments returns how many of the documents fit into the budget together
+// with the task. If none fits, the token count of the first one is returned.
+func (p *Pipeline) fitDocuments(documents []map[string]string, task string,
+	budget int,
+) (int, int) {
+	tokens := p.countTokens(task)
+	for i := range documents {
+		// documents are sent to the model as JSON
+		marshalled, _ := json.Marshal(documents[i])
+		tokens += p.countTokens(string(marshalled))
+		if tokens > budget {
+			return i, tokens
+		}
+	}
+	return len(documents), tokens
+}
+
+func (p *Pipeline) tokenBudget(cfg moduletools.ClassConfig) int {
+	if budgeter, ok := p.provider.(TokenBudgeter); ok {
+		return budgeter.TokenBudget(cfg)
+	}
+	return 0
+}
+
+// generate calls the provider, a panic is turned into an error of the result
+func (p *Pipeline) generate(fn func() (*generativemodels.GenerateResponse, error)) (out Result) {
+	defer func() {
+		if r := recover(); r != nil {
+			out = Result{Err: fmt.Errorf("generate: %v", r)}
+		}
+	}()
+
+	res, err := fn()
+	if err != nil {
+		return Result{Err: err}
+	}
+	if res != nil {
+		out.Text = res.Result
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	generativemodels "github.com/weaviate/weaviate/usecases/modulecomponents/additional/models"
+)
+
+func TestPipeline_Map(t *testing.T) {
+	documents := []map[string]string{
+		{"title": "first"},
+		{"title": "fail"},
+		{"title": "panic"},
+		{"title": "a title which is far too long for the budget"},
+	}
+
+	p := NewPipeline(&fakeProvider{budget: 10})
+	res := p.Map(context.Background(), documents, "say {title}", nil)
+	require.Len(t, res, 4)
+
+	t.Run("generates a result per document", func(t *testing.T) {
+		require.Nil(t, res[0].Err)
+		assert.Equal(t, "say first", *res[0].Text)
+	})
+
+	t.Run("errors are isolated to their result", func(t *testing.T) {
+		assert.EqualError(t, res[1].Err, "generation failed")
+		assert.Nil(t, res[1].Text)
+		assert.EqualError(t, res[2].Err, "generate: provider panicked")
+	})
+
+	t.Run("prompts exceeding the budget are not sent", func(t *testing.T) {
+		assert.ErrorIs(t, res[3].Err, ErrTokenBudgetExceeded)
+	})
+}
+
+func TestPipeline_Reduce(t *testing.T) {
+	documents := []map[string]string{
+		{"title": "first"},
+		{"title": "second"},
+		{"title": "third"},
+	}
+
+	t.Run("without a budget all documents are used", func(t *testing.T) {
+		res := NewPipeline(&fakeProvider{}).Reduce(context.Background(), documents, "task", nil)
+		require.Nil(t, res.Err)
+		assert.Equal(t, "task on 3 documents", *res.Text)
+	})
+
+	t.Run("the last documents are left out if they exceed the budget", func(t *testing.T) {
+		// each document takes 5 tokens, the task 1
+		res := NewPipeline(&fakeProvider{budget: 11}).Reduce(context.Background(), documents, "task", nil)
+		require.Nil(t, res.Err)
+		assert.Equal(t, "task on 2 documents", *res.Text)
+	})
+
+	t.Run("fails if no document fits into the budget", func(t *testing.T) {
+		res := NewPipeline(&fakeProvider{budget: 3}).Reduce(context.Background(), documents, "task", nil)
+		assert.ErrorIs(t, res.Err, ErrTokenBudgetExceeded)
+	})
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("abc"))
+	assert.Equal(t, 2, EstimateTokens("abcde"))
+	assert.Equal(t, 1, EstimateTokens("äöü"))
+}
+
+type fakeProvider struct {
+	budget int
+}
+
+func (f *fakeProvider) GenerateSingleResult(ctx context.Context, textProperties map[string]string,
+	prompt string, cfg moduletools.ClassConfig,
+) (*generativemodels.GenerateResponse, error) {
+	switch textProperties["title"] {
+	case "fail":
+		return nil, errors.New("generation failed")
+	case "panic":
+		panic("provider panicked")
+	}
+	result := fillPrompt(prompt, textProperties)
+	return &generativemodels.GenerateResponse{Result: &result}, nil
+}
+
+func (f *fakeProvider) GenerateAllResults(ctx context.Context, textProperties []map[string]string,
+	task string, cfg moduletools.ClassConfig,
+) (*generativemodels.GenerateResponse, error) {
+	result := fmt.Sprintf("%s on %d documents", task, len(textProperties))
+	return &generativemodels.GenerateResponse{Result: &result}, nil
+}
+
+func (f *fakeProvider) TokenBudget(cfg moduletools.ClassConfig) int {
+	return f.budget
+}
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generative
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// charsPerToken is a rough average for english text, it is used when the
+// tokenizer of the model is unknown
+const charsPerToken = 4
+
+var promptProperty = regexp.MustCompile(`{([\s\w]*)}`)
+
+// EstimateTokens estimates the number of tokens of the text
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// fillPrompt replaces the {property} placeholders of the prompt with the
+// values of the properties, like the generative modules do
+func fillPrompt(prompt string, textProperties map[string]string) string {
+	return promptProperty.ReplaceAllStringFunc(prompt, func(match string) string {
+		name := strings.TrimSpace(promptProperty.FindStringSubmatch(match)[1])
+		return textProperties[name]
+	})
+}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// MaxGroupByVectorCandidates bounds the results clustered by a groupBy with
+// the vector strategy
+const MaxGroupByVectorCandidates = 1000
+
+func groupByVector(params dto.GetParams) bool {
+	return params.GroupBy != nil &&
+		params.GroupBy.Strategy == searchparams.GroupByStrategyVector
+}
+
+// groupByVectorOptions validates a groupBy with the vector strategy and
+// returns the number of top results to cluster
+func groupByVectorOptions(params dto.GetParams) (int, error) {
+	groupBy := params.GroupBy
+	if groupBy.Property != "" {
+		return 0, errors.New("groupBy: path can't be combined with the vector strategy")
+	}
+	if groupBy.Groups < 1 {
+		return 0, errors.Errorf("groupBy: groups must be at least 1, got %d", groupBy.Groups)
+	}
+	if groupBy.ObjectsPerGroup < 1 {
+		return 0, errors.Errorf("groupBy: objectsPerGroup must be at least 1, got %d",
+			groupBy.ObjectsPerGroup)
+	}
+
+	candidates := groupBy.Candidates
+	if candidates == 0 {
+		candidates = groupBy.Groups * groupBy.ObjectsPerGroup
+		if candidates > MaxGroupByVectorCandidates {
+			candidates = MaxGroupByVectorCandidates
+		}
+	}
+	if candidates < groupBy.Groups || candidates > MaxGroupByVectorCandidates {
+		return 0, errors.Errorf("groupBy: candidates must be between groups (%d) and %d, got %d",
+			groupBy.Groups, MaxGroupByVectorCandidates, candidates)
+	}
+
+	return candidates, nil
+}
+
+// groupByVectorCandidates returns the params of the search the clustered
+// results are read from. The search itself is not grouped, the vectors of
+// the candidates are needed to cluster them.
+func groupByVectorCandidates(params dto.GetParams, candidates int) dto.GetParams {
+	params.GroupBy = nil
+	params.AdditionalProperties.Vector = true
+	params.Pagination = &filters.Pagination{Offset: 0, Limit: candidates}
+	return params
+}
+
+// groupByVectorResults clusters the candidates with k-means into at most
+// groupBy.Groups groups. Every group is represented by the member closest to
+// the center of its cluster and holds its best ranked members as hits.
+// Groups are ordered by their best ranked member, so the order of the
+// candidates is kept as far as possible.
+func groupByVectorResults(res []search.Result, groupBy *searchparams.GroupBy) ([]search.Result, error) {
+	if len(res) == 0 {
+		return res, nil
+	}
+
+	dims := len(res[0].Vector)
+	data := make([][]float32, len(res))
+	for i := range res {
+		if len(res[i].Vector) == 0 || len(res[i].Vector) != dims {
+			return nil, errors.Errorf("groupBy: object %s has no vector of %d dimensions",
+				res[i].ID, dims)
+		}
+		data[i] = res[i].Vector
+	}
+
+	centers := initialCenters(data, groupBy.Groups)
+	kmeans := ssdhelpers.NewKMeansWithCenters(len(centers), dims, 0, centers)
+	if err := kmeans.Fit(data); err != nil {
+		return nil, errors.Wrap(err, "groupBy: cluster results")
+	}
+
+	// members holds the positions of the candidates of every cluster in the
+	// order they were ranked, order holds the clusters by their first member
+	members := make([][]int, len(centers))
+	order := make([]int, 0, len(centers))
+	for i := range data {
+		c := kmeans.Nearest(data[i])
+		if len(members[c]) == 0 {
+			order = append(order, int(c))
+		}
+		members[c] = append(members[c], i)
+	}
+
+	out := make([]search.Result, len(order))
+	for i, c := range order {
+		center := kmeans.Centers()[c]
+		rep, repDist := members[c][0], float32(0)
+		for j, pos := range members[c] {
+			dist, _, _ := kmeans.Distance.SingleDist(data[pos], center)
+			if j == 0 || dist < repDist {
+				rep, repDist = pos, dist
+			}
+		}
+
+		hits := members[c]
+		if len(hits) > groupBy.ObjectsPerGroup {
+			hits = hits[:groupBy.ObjectsPerGroup]
+		}
+		group := &additional.Group{
+			ID:          i,
+			GroupedBy:   &additional.GroupedBy{Value: res[rep].ID.String()},
+			Count:       len(hits),
+			Hits:        make([]map[string]interface{}, len(hits)),
+			MinDistance: res[hits[0]].Dist,
+			MaxDistance: res[hits[0]].Dist,
+		}
+		for j, pos := range hits {
+			group.Hits[j] = groupHit(res[pos])
+			if res[pos].Dist < group.MinDistance {
+				group.MinDistance = res[pos].Dist
+			}
+			if res[pos].Dist > group.MaxDistance {
+				group.MaxDistance = res[pos].Dist
+			}
+		}
+
+		representative := res[rep]
+		representative.AdditionalProperties = models.AdditionalProperties{}
+		for k, v := range res[rep].AdditionalProperties {
+			representative.AdditionalProperties[k] = v
+		}
+		representative.AdditionalProperties["group"] = group
+		out[i] = representative
+	}
+
+	return out, nil
+}
+
+// initialCenters picks up to k candidates as initial cluster centers, the
+// best ranked candidate first and then each time the candidate farthest
+// from the centers picked so far. Unlike random centers this keeps the
+// grouping of a query stable and never starts with two identical centers.
+func initialCenters(data [][]float32, k int) [][]float32 {
+	if k > len(data) {
+		k = len(data)
+	}
+
+	distancer := ssdhelpers.NewKMeans(k, len(data[0]), 0).Distance
+	// closest holds the distance of every candidate to its closest center
+	closest := make([]float32, len(data))
+	centers := make([][]float32, 0, k)
+	next := 0
+	for len(centers) < k {
+		center := make([]float32, len(data[next]))
+		copy(center, data[next])
+		centers = append(centers, center)
+
+		farthest, farthestDist := -1, float32(0)
+		for i := range data {
+			dist, _, _ := distancer.SingleDist(data[i], center)
+			if len(centers) == 1 || dist < closest[i] {
+				closest[i] = dist
+			}
+			if closest[i] > farthestDist {
+				farthest, farthestDist = i, closest[i]
+			}
+		}
+		if farthest < 0 {
+			// all remaining candidates equal one of the centers
+			break
+		}
+		next = farthest
+	}
+
+	return centers
+}
+
+func groupHit(res search.Result) map[string]interface{} {
+	hit := map[string]interface{}{}
+	if props, ok := res.Schema.(map[string]interface{}); ok {
+		for k, v := range props {
+			hit[k] = v
+		}
+	}
+	hit["_additional"] = &additional.GroupHitAdditional{
+		ID:       res.ID.String(),
+		Vector:   res.Vector,
+		Distance: res.Dist,
+	}
+	return hit
+}
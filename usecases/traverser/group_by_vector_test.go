@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithGroupByVector(t *testing.T) {
+	// a, b and e point in one direction, c and d in another
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{ID: "a", Dist: 0.1, Vector: []float32{1, 0}, Schema: map[string]interface{}{"name": "a"}},
+			{ID: "b", Dist: 0.12, Vector: []float32{0.99, 0.05}, Schema: map[string]interface{}{"name": "b"}},
+			{ID: "c", Dist: 0.3, Vector: []float32{0, 1}, Schema: map[string]interface{}{"name": "c"}},
+			{ID: "d", Dist: 0.35, Vector: []float32{0.05, 0.99}, Schema: map[string]interface{}{"name": "d"}},
+			{ID: "e", Dist: 0.4, Vector: []float32{0.8, 0.6}, Schema: map[string]interface{}{"name": "e"}},
+		}
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("VectorSearch", mock.Anything).Return(searchResults(), nil)
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	hitNames := func(group *additional.Group) []string {
+		out := make([]string, len(group.Hits))
+		for i := range group.Hits {
+			out[i] = group.Hits[i]["name"].(string)
+		}
+		return out
+	}
+
+	t.Run("clusters the top results", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		res, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 10},
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+			GroupBy: &searchparams.GroupBy{
+				Strategy:        searchparams.GroupByStrategyVector,
+				Groups:          2,
+				ObjectsPerGroup: 2,
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		searched := searcher.Calls[0].Arguments.Get(0).(dto.GetParams)
+		assert.Nil(t, searched.GroupBy)
+		assert.Equal(t, 4, searched.Pagination.Limit)
+		assert.True(t, searched.AdditionalProperties.Vector)
+
+		// b is closest to the center of the first cluster and represents it
+		first := res[0].(map[string]interface{})
+		assert.Equal(t, "b", first["name"])
+		group := first["_additional"].(map[string]interface{})["group"].(*additional.Group)
+		assert.Equal(t, 0, group.ID)
+		assert.Equal(t, "b", group.GroupedBy.Value)
+		assert.Equal(t, []string{"a", "b"}, hitNames(group))
+		assert.Equal(t, 2, group.Count)
+		assert.Equal(t, float32(0.1), group.MinDistance)
+		assert.Equal(t, float32(0.12), group.MaxDistance)
+		assert.Equal(t, "a", group.Hits[0]["_additional"].(*additional.GroupHitAdditional).ID)
+
+		second := res[1].(map[string]interface{})
+		assert.Equal(t, "c", second["name"])
+		group = second["_additional"].(map[string]interface{})["group"].(*additional.Group)
+		assert.Equal(t, 1, group.ID)
+		assert.Equal(t, []string{"c", "d"}, hitNames(group))
+	})
+
+	t.Run("more groups than candidates", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		res, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:  "BestClass",
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+			GroupBy: &searchparams.GroupBy{
+				Strategy:        searchparams.GroupByStrategyVector,
+				Groups:          5,
+				ObjectsPerGroup: 1,
+				Candidates:      5,
+			},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, 5)
+	})
+
+	errorTests := []struct {
+		name        string
+		params      dto.GetParams
+		expectedErr string
+	}{
+		{
+			name: "with path",
+			params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+				GroupBy: &searchparams.GroupBy{
+					Strategy: searchparams.GroupByStrategyVector, Property: "name",
+					Groups: 2, ObjectsPerGroup: 2,
+				},
+			},
+			expectedErr: "path can't be combined with the vector strategy",
+		},
+		{
+			name: "too few candidates",
+			params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+				GroupBy: &searchparams.GroupBy{
+					Strategy: searchparams.GroupByStrategyVector,
+					Groups:   3, ObjectsPerGroup: 2, Candidates: 2,
+				},
+			},
+			expectedErr: "candidates must be between groups (3) and 1000, got 2",
+		},
+		{
+			name: "unknown strategy",
+			params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 0}},
+				GroupBy:    &searchparams.GroupBy{Strategy: "color", Groups: 2, ObjectsPerGroup: 2},
+			},
+			expectedErr: `unknown strategy "color"`,
+		},
+		{
+			name: "keyword search",
+			params: dto.GetParams{
+				KeywordRanking: &searchparams.KeywordRanking{Query: "foo", Type: "bm25"},
+				GroupBy: &searchparams.GroupBy{
+					Strategy: searchparams.GroupByStrategyVector,
+					Groups:   2, ObjectsPerGroup: 2,
+				},
+			},
+			expectedErr: "only supported on vector and hybrid searches",
+		},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			explorer, _ := newExplorer()
+			tt.params.ClassName = "BestClass"
+			_, err := explorer.GetClass(context.Background(), tt.params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func Test_GroupByVector_IdenticalVectors(t *testing.T) {
+	res := []search.Result{
+		{ID: "a", Dist: 0.1, Vector: []float32{1, 0}},
+		{ID: "b", Dist: 0.1, Vector: []float32{1, 0}},
+		{ID: "c", Dist: 0.1, Vector: []float32{1, 0}},
+	}
+
+	grouped, err := groupByVectorResults(res, &searchparams.GroupBy{
+		Strategy: searchparams.GroupByStrategyVector, Groups: 3, ObjectsPerGroup: 3,
+	})
+	require.Nil(t, err)
+	require.Len(t, grouped, 1)
+	assert.Equal(t, 3, grouped[0].AdditionalProperties["group"].(*additional.Group).Count)
+	// the additional props of the candidates are not modified
+	assert.Nil(t, res[0].AdditionalProperties)
+}
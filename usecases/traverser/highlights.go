@@ -0,0 +1,249 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	// HighlightSnippetLength is the number of runes of a highlight snippet
+	HighlightSnippetLength = 160
+	// highlightSnippetContext is the number of runes a snippet shows ahead
+	// of its first match
+	highlightSnippetContext = 40
+
+	highlightPreTag  = "<em>"
+	highlightPostTag = "</em>"
+)
+
+// highlightField is a text property which is searched for the query terms.
+// Its stored values are tokenized again the same way they were indexed, so
+// that the offsets of the matching terms can be found.
+type highlightField struct {
+	name         string
+	tokenization string
+	pipeline     *analysis.Pipeline
+	// terms are the query terms as indexed for this property
+	terms map[string]struct{}
+}
+
+// highlight adds the query terms found in the text properties of the results
+// of a bm25 or hybrid search to the additional properties of the results.
+// Results without matches get an empty list of highlights.
+func (e *Explorer) highlight(res []search.Result, params dto.GetParams) error {
+	if !params.AdditionalProperties.Highlights || len(res) == 0 {
+		return nil
+	}
+
+	var query string
+	var properties []string
+	switch {
+	case params.KeywordRanking != nil:
+		query, properties = params.KeywordRanking.Query, params.KeywordRanking.Properties
+	case params.HybridSearch != nil:
+		query, properties = params.HybridSearch.Query, params.HybridSearch.Properties
+	default:
+		return nil
+	}
+
+	class := e.class(params.ClassName)
+	if class == nil || query == "" {
+		return nil
+	}
+	fields, err := highlightFields(class, properties, query)
+	if err != nil {
+		return err
+	}
+
+	for i := range res {
+		highlights := []*additional.Highlight{}
+		props, _ := res[i].Schema.(map[string]interface{})
+		for _, field := range fields {
+			for index, value := range textValues(props[field.name]) {
+				if hl := field.highlight(value); hl != nil {
+					hl.Index = index
+					highlights = append(highlights, hl)
+				}
+			}
+		}
+
+		if res[i].AdditionalProperties == nil {
+			res[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+		res[i].AdditionalProperties["highlights"] = highlights
+	}
+	return nil
+}
+
+// highlightFields returns the searched text properties, all text properties
+// of the class if the query does not name any
+func highlightFields(class *models.Class, properties []string, query string) ([]*highlightField, error) {
+	var names []string
+	for _, prop := range properties {
+		// strip the boost of a property, e.g. title^2
+		names = append(names, strings.Split(prop, "^")[0])
+	}
+	if len(names) == 0 {
+		for _, prop := range class.Properties {
+			names = append(names, prop.Name)
+		}
+	}
+
+	var detector *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		if detector, err = stopwords.NewDetectorFromConfig(*class.InvertedIndexConfig.Stopwords); err != nil {
+			return nil, err
+		}
+	}
+
+	var fields []*highlightField
+	for _, name := range names {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			continue
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok ||
+			(dt != schema.DataTypeText && dt != schema.DataTypeTextArray) {
+			continue
+		}
+
+		field := &highlightField{name: name, tokenization: prop.Tokenization}
+		if field.tokenization == "" {
+			field.tokenization = models.PropertyTokenizationWord
+		}
+		var terms []string
+		if prop.Analyzer != "" {
+			if field.pipeline, err = analysis.ForProperty(class, prop); err != nil {
+				return nil, err
+			}
+			field.tokenization = field.pipeline.Tokenization()
+			terms = field.pipeline.Analyze(query)
+		} else {
+			for _, term := range helpers.Tokenize(field.tokenization, query) {
+				// stopwords are not searched for with word tokenization
+				if field.tokenization == models.PropertyTokenizationWord &&
+					detector != nil && detector.IsStopword(term) {
+					continue
+				}
+				terms = append(terms, term)
+			}
+		}
+
+		field.terms = make(map[string]struct{}, len(terms))
+		for _, term := range terms {
+			field.terms[term] = struct{}{}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func textValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, _ := elem.(string)
+			values = append(values, s)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// highlight returns the matches of the query terms in the value, nil if
+// there are none
+func (f *highlightField) highlight(value string) *additional.Highlight {
+	var matches []additional.HighlightMatch
+	for _, token := range helpers.TokenizeWithOffsets(f.tokenization, value) {
+		if f.matches(token.Term) {
+			matches = append(matches, additional.HighlightMatch{
+				Term: token.Term, Start: token.Start, End: token.End,
+			})
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return &additional.Highlight{
+		Property: f.name,
+		Snippet:  snippet([]rune(value), matches),
+		Matches:  matches,
+	}
+}
+
+func (f *highlightField) matches(term string) bool {
+	if f.pipeline == nil {
+		_, ok := f.terms[term]
+		return ok
+	}
+	for _, analyzed := range f.pipeline.Analyze(term) {
+		if _, ok := f.terms[analyzed]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// snippet cuts a window of HighlightSnippetLength runes around the first
+// match out of the value and marks all matches within it
+func snippet(value []rune, matches []additional.HighlightMatch) string {
+	start := matches[0].Start - highlightSnippetContext
+	if start < 0 {
+		start = 0
+	}
+	end := start + HighlightSnippetLength
+	if end > len(value) {
+		end = len(value)
+		if start = end - HighlightSnippetLength; start < 0 {
+			start = 0
+		}
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m.Start < pos || m.End > end {
+			continue
+		}
+		sb.WriteString(string(value[pos:m.Start]))
+		sb.WriteString(highlightPreTag)
+		sb.WriteString(string(value[m.Start:m.End]))
+		sb.WriteString(highlightPostTag)
+		pos = m.End
+	}
+	sb.WriteString(string(value[pos:end]))
+	if end < len(value) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithHighlights(t *testing.T) {
+	class := &models.Class{
+		Class: "BestClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+			Analyzers: map[string]models.AnalyzerConfig{
+				"english": {Lowercase: true, Stemmer: analysis.StemmerEnglish},
+			},
+		},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "body", DataType: schema.DataTypeText.PropString(), Analyzer: "english"},
+			{Name: "rating", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{ID: "a", Schema: map[string]interface{}{
+				"title":  "The Apple pie",
+				"tags":   []interface{}{"fruit", "Apple", "apple"},
+				"body":   "Baking apples is easy",
+				"rating": int64(5),
+			}},
+			{ID: "b", Schema: map[string]interface{}{"title": "Pear cake"}},
+		}
+	}
+
+	newExplorer := func() *Explorer {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", mock.Anything).Return(searchResults(), nil)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		})
+		return explorer
+	}
+
+	highlights := func(res interface{}) []*additional.Highlight {
+		return res.(map[string]interface{})["_additional"].(map[string]interface{})["highlights"].([]*additional.Highlight)
+	}
+
+	t.Run("all text properties", func(t *testing.T) {
+		res, err := newExplorer().GetClass(context.Background(), dto.GetParams{
+			ClassName:            "BestClass",
+			Pagination:           &filters.Pagination{Limit: 10},
+			KeywordRanking:       &searchparams.KeywordRanking{Query: "the apple", Type: "bm25"},
+			AdditionalProperties: additional.Properties{Highlights: true},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, []*additional.Highlight{
+			{
+				// "the" is a stopword and not highlighted
+				Property: "title",
+				Snippet:  "The <em>Apple</em> pie",
+				Matches:  []additional.HighlightMatch{{Term: "apple", Start: 4, End: 9}},
+			},
+			{
+				// whitespace tokenization is case sensitive
+				Property: "tags",
+				Index:    2,
+				Snippet:  "<em>apple</em>",
+				Matches:  []additional.HighlightMatch{{Term: "apple", Start: 0, End: 5}},
+			},
+			{
+				// the analyzer stems apples and apple to the same term
+				Property: "body",
+				Snippet:  "Baking <em>apples</em> is easy",
+				Matches:  []additional.HighlightMatch{{Term: "apples", Start: 7, End: 13}},
+			},
+		}, highlights(res[0]))
+		assert.Empty(t, highlights(res[1]))
+	})
+
+	t.Run("searched properties only", func(t *testing.T) {
+		res, err := newExplorer().GetClass(context.Background(), dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query: "apple", Type: "bm25", Properties: []string{"title^2"},
+			},
+			AdditionalProperties: additional.Properties{Highlights: true},
+		})
+		require.Nil(t, err)
+		hl := highlights(res[0])
+		require.Len(t, hl, 1)
+		assert.Equal(t, "title", hl[0].Property)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		res, err := newExplorer().GetClass(context.Background(), dto.GetParams{
+			ClassName:      "BestClass",
+			Pagination:     &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{Query: "apple", Type: "bm25"},
+		})
+		require.Nil(t, err)
+		assert.NotContains(t, res[0].(map[string]interface{}), "_additional")
+	})
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	value := strings.Repeat("a ", 100) + "apple " + strings.Repeat("b ", 100) + "apple"
+	field := &highlightField{
+		name:         "text",
+		tokenization: models.PropertyTokenizationWord,
+		terms:        map[string]struct{}{"apple": {}},
+	}
+
+	hl := field.highlight(value)
+	require.NotNil(t, hl)
+	require.Len(t, hl.Matches, 2)
+	assert.Equal(t, 200, hl.Matches[0].Start)
+
+	// the snippet starts ahead of the first match and only marks matches
+	// within its window
+	assert.True(t, strings.HasPrefix(hl.Snippet, "…"+strings.Repeat("a ", 20)+"<em>apple</em>"))
+	assert.True(t, strings.HasSuffix(hl.Snippet, "…"))
+	assert.Equal(t, 1, strings.Count(hl.Snippet, "<em>"))
+	assert.Equal(t, HighlightSnippetLength+len("<em></em>")+2,
+		len([]rune(hl.Snippet)))
+
+	assert.Nil(t, field.highlight("no match"))
+}
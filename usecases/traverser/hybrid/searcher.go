@@ -14,6 +14,7 @@ package hybrid
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 
@@ -24,6 +25,9 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/slowquery"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const DefaultLimit = 100
@@ -33,6 +37,8 @@ type Params struct {
 	Keyword *searchparams.KeywordRanking
 	Class   string
 	Autocut int
+	// TimeDecay optionally boosts recent results after fusion
+	TimeDecay *searchparams.TimeDecay
 }
 
 // Result facilitates the pairing of a search result with its internal doc id.
@@ -102,6 +108,16 @@ func NewSearcher(params *Params, logger logrus.FieldLogger,
 
 // Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
 func (s *Searcher) Search(ctx context.Context) (Results, error) {
+	ctx, span := tracing.Start(ctx, "hybrid.Search",
+		attribute.Float64("hybrid.alpha", s.params.Alpha),
+		attribute.Int("hybrid.fusion_algorithm", s.params.FusionAlgorithm))
+	res, err := s.search(ctx)
+	span.SetAttributes(attribute.Int("weaviate.results", len(res)))
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *Searcher) search(ctx context.Context) (Results, error) {
 	var (
 		found   [][]*Result
 		weights []float64
@@ -121,6 +137,10 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 		}
 
 		if alpha > 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
 			res, err := s.denseSearch(ctx)
 			if err != nil {
 				return nil, err
@@ -139,6 +159,10 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 		}
 
 		for _, subsearch := range ss.([]searchparams.WeightedSearchResult) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
 			res, weight, err := s.handleSubSearch(ctx, &subsearch)
 			if err != nil {
 				return nil, err
@@ -156,13 +180,30 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 		return nil, fmt.Errorf("length of weights and results do not match for hybrid search %v vs. %v", len(weights), len(found))
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	beforeFusion := time.Now()
+	_, fusionSpan := tracing.Start(ctx, "hybrid.Fusion",
+		attribute.Int("hybrid.result_sets", len(found)))
 	var fused []*Result
 	if s.params.FusionAlgorithm == common_filters.HybridRankedFusion {
 		fused = FusionRanked(weights, found)
 	} else if s.params.FusionAlgorithm == common_filters.HybridRelativeScoreFusion {
 		fused = FusionRelativeScore(weights, found)
 	} else {
-		return nil, fmt.Errorf("unknown ranking algorithm %v for hybrid search", s.params.FusionAlgorithm)
+		err := fmt.Errorf("unknown ranking algorithm %v for hybrid search", s.params.FusionAlgorithm)
+		tracing.End(fusionSpan, err)
+		return nil, err
+	}
+	fusionSpan.End()
+	slowquery.Observe(ctx, "", slowquery.PhaseFusion, time.Since(beforeFusion))
+
+	if s.params.TimeDecay != nil {
+		if err := ApplyTimeDecay(s.params.TimeDecay, time.Now(), fused); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.postProcFunc != nil {
@@ -47,7 +47,7 @@ func TestSearcher(t *testing.T) {
 				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
 				dense := func([]float32) ([]*storobj.Object, []float32, error) { return nil, nil, nil }
 				provider := &fakeModuleProvider{}
-				provider.On("VectorFromInput", ctx, class, params.Query).Return([]float32{1, 2, 3}, nil)
+				provider.On("VectorFromInput", mock.Anything, class, params.Query).Return([]float32{1, 2, 3}, nil)
 				s := NewSearcher(params, logger, sparse, dense, nil, provider)
 				_, err := s.Search(ctx)
 				require.Nil(t, err)
@@ -281,10 +281,10 @@ func TestSearcher(t *testing.T) {
 					}, []float32{0.008}, nil
 				}
 				provider := &fakeModuleProvider{}
-				provider.On("VectorFromInput", ctx, class,
+				provider.On("VectorFromInput", mock.Anything, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[0].
 						SearchParams.(searchparams.NearTextParams).Values[0]).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
+				provider.On("VectorFromInput", mock.Anything, class, "").Return([]float32{1, 2, 3}, nil)
 				s := NewSearcher(params, logger, sparse, dense, nil, provider)
 				res, err := s.Search(ctx)
 				require.Nil(t, err)
@@ -332,10 +332,10 @@ func TestSearcher(t *testing.T) {
 					}, []float32{0.008}, nil
 				}
 				provider := &fakeModuleProvider{}
-				provider.On("VectorFromInput", ctx, class,
+				provider.On("VectorFromInput", mock.Anything, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[0].
 						SearchParams.(searchparams.NearVector).Vector).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
+				provider.On("VectorFromInput", mock.Anything, class, "").Return([]float32{1, 2, 3}, nil)
 				s := NewSearcher(params, logger, sparse, dense, nil, provider)
 				res, err := s.Search(ctx)
 				require.Nil(t, err)
@@ -412,13 +412,13 @@ func TestSearcher(t *testing.T) {
 					}, []float32{0.008}, nil
 				}
 				provider := &fakeModuleProvider{}
-				provider.On("VectorFromInput", ctx, class,
+				provider.On("VectorFromInput", mock.Anything, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[0].
 						SearchParams.(searchparams.NearVector).Vector).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class,
+				provider.On("VectorFromInput", mock.Anything, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[1].
 						SearchParams.(searchparams.NearTextParams).Values[0]).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
+				provider.On("VectorFromInput", mock.Anything, class, "").Return([]float32{1, 2, 3}, nil)
 				s := NewSearcher(params, logger, sparse, dense, nil, provider)
 				res, err := s.Search(ctx)
 				require.Nil(t, err)
@@ -435,6 +435,31 @@ func TestSearcher(t *testing.T) {
 				assert.Equal(t, res[1].Result.Dist, float32(0.008))
 			},
 		},
+		{
+			name: "with cancelled context",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:  "hybrid",
+						Alpha: 0.5,
+						Query: "some query",
+					},
+					Class: class,
+				}
+				denseCalled := false
+				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				dense := func([]float32) ([]*storobj.Object, []float32, error) {
+					denseCalled = true
+					return nil, nil, nil
+				}
+				cancelled, cancel := context.WithCancel(ctx)
+				cancel()
+				s := NewSearcher(params, logger, sparse, dense, nil, nil)
+				_, err := s.Search(cancelled)
+				require.ErrorIs(t, err, context.Canceled)
+				assert.False(t, denseCalled)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+const (
+	TimeDecayExp    = "exp"
+	TimeDecayGauss  = "gauss"
+	TimeDecayLinear = "linear"
+
+	// DefaultTimeDecay is the boost of a result whose date is scale away
+	// from origin plus offset
+	DefaultTimeDecay = 0.5
+	// DefaultTimeDecayWeight weighs score and boost equally
+	DefaultTimeDecayWeight = 0.5
+)
+
+// timeDecay is a validated TimeDecay with its defaults applied
+type timeDecay struct {
+	property string
+	origin   time.Time
+	scale    time.Duration
+	offset   time.Duration
+	decay    float64
+	function string
+	weight   float64
+}
+
+func parseTimeDecay(params *searchparams.TimeDecay, now time.Time) (*timeDecay, error) {
+	d := &timeDecay{
+		property: params.Property,
+		origin:   now,
+		decay:    params.Decay,
+		function: params.Function,
+		weight:   params.Weight,
+	}
+	if d.property == "" {
+		return nil, fmt.Errorf("time decay: property must be set")
+	}
+
+	var err error
+	if params.Origin != "" {
+		if d.origin, err = time.Parse(time.RFC3339, params.Origin); err != nil {
+			return nil, fmt.Errorf("time decay: origin must be an RFC3339 date: %w", err)
+		}
+	}
+	if d.scale, err = parseDecayDuration(params.Scale); err != nil || d.scale <= 0 {
+		return nil, fmt.Errorf("time decay: scale must be a positive duration such as 12h or 7d, got %q",
+			params.Scale)
+	}
+	if params.Offset != "" {
+		if d.offset, err = parseDecayDuration(params.Offset); err != nil || d.offset < 0 {
+			return nil, fmt.Errorf("time decay: offset must be a duration such as 12h or 7d, got %q",
+				params.Offset)
+		}
+	}
+
+	if d.decay == 0 {
+		d.decay = DefaultTimeDecay
+	}
+	if d.decay <= 0 || d.decay >= 1 {
+		return nil, fmt.Errorf("time decay: decay must be between 0 and 1, got %v", d.decay)
+	}
+
+	if d.weight == 0 {
+		d.weight = DefaultTimeDecayWeight
+	}
+	if d.weight < 0 || d.weight > 1 {
+		return nil, fmt.Errorf("time decay: weight must be between 0 and 1, got %v", d.weight)
+	}
+
+	switch d.function {
+	case "":
+		d.function = TimeDecayExp
+	case TimeDecayExp, TimeDecayGauss, TimeDecayLinear:
+	default:
+		return nil, fmt.Errorf("time decay: unknown function %q, use %s, %s or %s",
+			d.function, TimeDecayExp, TimeDecayGauss, TimeDecayLinear)
+	}
+
+	return d, nil
+}
+
+// parseDecayDuration parses a Go duration, which is extended by a number of
+// days such as "7d" as days are the common scale of recency boosts
+func parseDecayDuration(in string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(in, "d"); ok {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(in)
+}
+
+// boost is the decay of a date, 1 within offset of the origin
+func (d *timeDecay) boost(date time.Time) float64 {
+	dist := date.Sub(d.origin)
+	if dist < 0 {
+		dist = -dist
+	}
+	x := float64(dist-d.offset) / float64(d.scale)
+	if x <= 0 {
+		return 1
+	}
+
+	switch d.function {
+	case TimeDecayGauss:
+		return math.Pow(d.decay, x*x)
+	case TimeDecayLinear:
+		return math.Max(0, 1-(1-d.decay)*x)
+	default:
+		return math.Pow(d.decay, x)
+	}
+}
+
+// date reads the date property of a result, results without a valid date
+// are not boosted
+func (d *timeDecay) date(res *Result) (time.Time, bool) {
+	props, ok := res.Schema.(map[string]interface{})
+	if !ok {
+		return time.Time{}, false
+	}
+	switch v := props[d.property].(type) {
+	case time.Time:
+		return v, true
+	case string:
+		date, err := time.Parse(time.RFC3339Nano, v)
+		return date, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// ApplyTimeDecay combines the score of every result with the boost of its
+// date and reorders the results by the combined score. Scores are divided by
+// the highest score first, so that the weight means the same for the
+// unbounded bm25 scores as for the fused hybrid scores.
+func ApplyTimeDecay(params *searchparams.TimeDecay, now time.Time, res Results) error {
+	d, err := parseTimeDecay(params, now)
+	if err != nil {
+		return err
+	}
+
+	var maxScore float32
+	for _, r := range res {
+		if r.Score > maxScore {
+			maxScore = r.Score
+		}
+	}
+
+	for _, r := range res {
+		relevance := 0.0
+		if maxScore > 0 {
+			relevance = float64(r.Score / maxScore)
+		}
+		boost := 0.0
+		if date, ok := d.date(r); ok {
+			boost = d.boost(date)
+		}
+
+		score := float32((1-d.weight)*relevance + d.weight*boost)
+		r.ExplainScore = fmt.Sprintf("%v\n(time decay) %s boosted by %v with weight %v",
+			r.ExplainScore, d.property, boost, d.weight)
+		r.Score = score
+		if r.AdditionalProperties != nil {
+			if _, ok := r.AdditionalProperties["score"]; ok {
+				r.AdditionalProperties["score"] = float64(score)
+			}
+			if _, ok := r.AdditionalProperties["explainScore"]; ok {
+				r.AdditionalProperties["explainScore"] = r.ExplainScore
+			}
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Score > res[j].Score
+	})
+	return nil
+}
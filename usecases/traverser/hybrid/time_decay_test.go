@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestTimeDecayBoost(t *testing.T) {
+	origin := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		function string
+		offset   string
+		date     time.Time
+		expected float64
+	}{
+		{function: TimeDecayExp, date: origin, expected: 1},
+		{function: TimeDecayExp, date: origin.Add(-24 * time.Hour), expected: 0.5},
+		{function: TimeDecayExp, date: origin.Add(48 * time.Hour), expected: 0.25},
+		{function: TimeDecayGauss, date: origin.Add(-48 * time.Hour), expected: 0.0625},
+		{function: TimeDecayLinear, date: origin.Add(-24 * time.Hour), expected: 0.5},
+		{function: TimeDecayLinear, date: origin.Add(-72 * time.Hour), expected: 0},
+		{function: TimeDecayExp, offset: "12h", date: origin.Add(-12 * time.Hour), expected: 1},
+		{function: TimeDecayExp, offset: "12h", date: origin.Add(-36 * time.Hour), expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.function+" "+tt.date.String(), func(t *testing.T) {
+			d, err := parseTimeDecay(&searchparams.TimeDecay{
+				Property: "published",
+				Origin:   origin.Format(time.RFC3339),
+				Scale:    "1d",
+				Offset:   tt.offset,
+				Function: tt.function,
+			}, time.Now())
+			require.Nil(t, err)
+			assert.InDelta(t, tt.expected, d.boost(tt.date), 1e-9)
+		})
+	}
+}
+
+func TestTimeDecayValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      searchparams.TimeDecay
+		expectedErr string
+	}{
+		{
+			name:        "no property",
+			params:      searchparams.TimeDecay{Scale: "1d"},
+			expectedErr: "property must be set",
+		},
+		{
+			name:        "no scale",
+			params:      searchparams.TimeDecay{Property: "published"},
+			expectedErr: "scale must be a positive duration",
+		},
+		{
+			name:        "invalid origin",
+			params:      searchparams.TimeDecay{Property: "published", Scale: "1d", Origin: "yesterday"},
+			expectedErr: "origin must be an RFC3339 date",
+		},
+		{
+			name:        "invalid decay",
+			params:      searchparams.TimeDecay{Property: "published", Scale: "1d", Decay: 1},
+			expectedErr: "decay must be between 0 and 1",
+		},
+		{
+			name:        "invalid weight",
+			params:      searchparams.TimeDecay{Property: "published", Scale: "1d", Weight: 1.5},
+			expectedErr: "weight must be between 0 and 1",
+		},
+		{
+			name:        "unknown function",
+			params:      searchparams.TimeDecay{Property: "published", Scale: "1d", Function: "step"},
+			expectedErr: `unknown function "step"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTimeDecay(&tt.params, time.Now())
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestApplyTimeDecay(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	result := func(id string, score float32, published interface{}) *Result {
+		props := map[string]interface{}{}
+		if published != nil {
+			props["published"] = published
+		}
+		return &Result{Result: &search.Result{ID: strfmt.UUID("id-" + id), Score: score, Schema: props}}
+	}
+
+	res := Results{
+		result("old", 1, now.Add(-30*24*time.Hour).Format(time.RFC3339)),
+		result("missing", 0.9, nil),
+		result("new", 0.8, now.Add(-time.Hour).Format(time.RFC3339)),
+		result("typed", 0.85, now),
+	}
+
+	err := ApplyTimeDecay(&searchparams.TimeDecay{Property: "published", Scale: "7d"}, now, res)
+	require.Nil(t, err)
+
+	ids := make([]string, len(res))
+	for i := range res {
+		ids[i] = res[i].ID.String()
+	}
+	assert.Equal(t, []string{"id-typed", "id-new", "id-old", "id-missing"}, ids)
+	assert.InDelta(t, 0.5*0.85+0.5, res[0].Score, 1e-6)
+	assert.InDelta(t, 0.5*0.9, res[3].Score, 1e-6)
+	assert.Contains(t, res[0].ExplainScore, "(time decay) published boosted by 1")
+}
+
+func TestSearcherWithTimeDecay(t *testing.T) {
+	now := time.Now()
+	object := func(id string, published time.Time) *storobj.Object {
+		return &storobj.Object{Object: models.Object{
+			Class:      "HybridClass",
+			ID:         strfmt.UUID(id),
+			Properties: map[string]any{"published": published.Format(time.RFC3339)},
+		}}
+	}
+
+	params := &Params{
+		HybridSearch: &searchparams.HybridSearch{Type: "hybrid", Alpha: 0, Query: "some query"},
+		Class:        "HybridClass",
+		TimeDecay:    &searchparams.TimeDecay{Property: "published", Scale: "1d", Weight: 1},
+	}
+	sparse := func() ([]*storobj.Object, []float32, error) {
+		return []*storobj.Object{
+			object("1889a225-3b28-477d-b8fc-5f6071bb4731", now.Add(-10*24*time.Hour)),
+			object("2889a225-3b28-477d-b8fc-5f6071bb4731", now),
+		}, []float32{0.8, 0.4}, nil
+	}
+
+	logger, _ := test.NewNullLogger()
+	s := NewSearcher(params, logger, sparse, nil, nil, nil)
+	res, err := s.Search(context.Background())
+	require.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, "2889a225-3b28-477d-b8fc-5f6071bb4731", res[0].ID.String())
+	assert.Contains(t, res[0].ExplainScore, "(time decay)")
+}
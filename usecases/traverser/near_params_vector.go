@@ -59,7 +59,13 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	}
 
 	if nearVector != nil {
-		return nearVector.Vector, nil
+		vector, err := v.moveVector(ctx, className, nearVector.Vector,
+			nearVector.MoveTo, nearVector.MoveAwayFrom, tenant)
+		if err != nil {
+			return nil, errors.Errorf("nearVector params: %v", err)
+		}
+
+		return vector, nil
 	}
 
 	if nearObject != nil {
@@ -68,6 +74,12 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
 
+		vector, err = v.moveVector(ctx, className, vector,
+			nearObject.MoveTo, nearObject.MoveAwayFrom, tenant)
+		if err != nil {
+			return nil, errors.Errorf("nearObject params: %v", err)
+		}
+
 		return vector, nil
 	}
 
@@ -76,6 +88,67 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	panic("vectorFromParams was called without any known params present")
 }
 
+// moveVector composes the search vector server-side, so clients don't have
+// to fetch the vectors of other objects to move their search closer to or
+// further away from them
+func (v *nearParamsVector) moveVector(ctx context.Context, className string,
+	vector []float32, moveTo, moveAwayFrom *searchparams.VectorMove, tenant string,
+) ([]float32, error) {
+	if moveTo != nil && moveTo.Force > 0 {
+		target, err := v.vectorFromMove(ctx, className, moveTo, len(vector), tenant)
+		if err != nil {
+			return nil, errors.Wrap(err, "move to")
+		}
+		if target != nil {
+			if vector, err = libvectorizer.MoveTo(vector, target, moveTo.Force); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if moveAwayFrom != nil && moveAwayFrom.Force > 0 {
+		target, err := v.vectorFromMove(ctx, className, moveAwayFrom, len(vector), tenant)
+		if err != nil {
+			return nil, errors.Wrap(err, "move away from")
+		}
+		if target != nil {
+			if vector, err = libvectorizer.MoveAwayFrom(vector, target, moveAwayFrom.Force); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return vector, nil
+}
+
+// vectorFromMove combines the vectors of the objects and the provided
+// vectors of the movement, nil if there are none
+func (v *nearParamsVector) vectorFromMove(ctx context.Context, className string,
+	move *searchparams.VectorMove, dims int, tenant string,
+) ([]float32, error) {
+	vectors := make([][]float32, 0, len(move.Vectors)+len(move.Objects))
+	vectors = append(vectors, move.Vectors...)
+	for _, obj := range move.Objects {
+		vector, err := v.vectorFromNearObjectParams(ctx, className,
+			&searchparams.NearObject{ID: obj.ID, Beacon: obj.Beacon}, tenant)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, vector)
+	}
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+
+	for _, vector := range vectors {
+		if len(vector) != dims {
+			return nil, errors.Errorf("vector lengths don't match: got %d and %d",
+				dims, len(vector))
+		}
+	}
+	return libvectorizer.CombineVectors(vectors), nil
+}
+
 func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVector,
 	nearObject *searchparams.NearObject,
 	moduleParams map[string]interface{}, className ...string,
@@ -139,6 +212,38 @@ func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVecto
 		}
 	}
 
+	if nearVector != nil {
+		if err := v.validateMoves(nearVector.MoveTo, nearVector.MoveAwayFrom); err != nil {
+			return errors.Wrap(err, "nearVector")
+		}
+	}
+
+	if nearObject != nil {
+		if err := v.validateMoves(nearObject.MoveTo, nearObject.MoveAwayFrom); err != nil {
+			return errors.Wrap(err, "nearObject")
+		}
+	}
+
+	return nil
+}
+
+func (v *nearParamsVector) validateMoves(moveTo, moveAwayFrom *searchparams.VectorMove) error {
+	if moveTo != nil && (moveTo.Force < 0 || moveTo.Force > 1) {
+		return errors.Errorf("moveTo force must be between 0 and 1: got %f", moveTo.Force)
+	}
+	if moveAwayFrom != nil && moveAwayFrom.Force < 0 {
+		return errors.Errorf("moveAwayFrom force must be 0 or positive: got %f", moveAwayFrom.Force)
+	}
+	for _, move := range []*searchparams.VectorMove{moveTo, moveAwayFrom} {
+		if move == nil {
+			continue
+		}
+		for _, obj := range move.Objects {
+			if obj.ID == "" && obj.Beacon == "" {
+				return errors.New("move objects must have an id or beacon")
+			}
+		}
+	}
 	return nil
 }
 
@@ -257,6 +362,38 @@ func (v *nearParamsVector) extractCertaintyFromParams(nearVector *searchparams.N
 	return 0
 }
 
+// extractDistanceFromParams returns the distance cutoff if it was set
+// explicitly. Unlike a certainty it is not restricted to cosine distances.
+func (v *nearParamsVector) extractDistanceFromParams(nearVector *searchparams.NearVector,
+	nearObject *searchparams.NearObject, moduleParams map[string]interface{},
+) (float64, bool) {
+	if nearVector != nil {
+		if nearVector.Certainty == 0 && nearVector.WithDistance {
+			return nearVector.Distance, true
+		}
+		return 0, false
+	}
+
+	if nearObject != nil {
+		if nearObject.Certainty == 0 && nearObject.WithDistance {
+			return nearObject.Distance, true
+		}
+		return 0, false
+	}
+
+	if len(moduleParams) == 1 {
+		for _, param := range moduleParams {
+			if nearParam, ok := param.(modulecapabilities.NearParam); ok {
+				if nearParam.SimilarityMetricProvided() && nearParam.GetCertainty() == 0 {
+					return nearParam.GetDistance(), true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
 func (v *nearParamsVector) extractCertaintyFromModuleParams(moduleParams map[string]interface{}) float64 {
 	for _, param := range moduleParams {
 		if nearParam, ok := param.(modulecapabilities.NearParam); ok {
@@ -170,6 +170,29 @@ func Test_nearParamsVector_validateNearParams(t *testing.T) {
 			wantErr:    true,
 			errMessage: "nearText cannot provide both distance and certainty",
 		},
+		{
+			name: "Should throw error, when nearVector moveTo force is too large",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					MoveTo: &searchparams.VectorMove{Force: 1.5},
+				},
+			},
+			wantErr:    true,
+			errMessage: "nearVector: moveTo force must be between 0 and 1: got 1.500000",
+		},
+		{
+			name: "Should throw error, when nearObject moveAwayFrom object has no id",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					MoveAwayFrom: &searchparams.VectorMove{
+						Force:   1,
+						Objects: []searchparams.ObjectMove{{}},
+					},
+				},
+			},
+			wantErr:    true,
+			errMessage: "nearObject: move objects must have an id or beacon",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -263,6 +286,77 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 			want:    []float32{0.0, 0.0, 0.0},
 			wantErr: false,
 		},
+		{
+			name: "Should move nearVector towards provided vectors",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vector: []float32{3, 1, 1},
+					MoveTo: &searchparams.VectorMove{
+						Force:   1,
+						Vectors: [][]float32{{1, 1, 1}},
+					},
+				},
+			},
+			want:    []float32{2, 1, 1},
+			wantErr: false,
+		},
+		{
+			name: "Should move nearVector away from objects",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vector: []float32{1, 1, 1},
+					MoveAwayFrom: &searchparams.VectorMove{
+						Force: 1,
+						Objects: []searchparams.ObjectMove{
+							{Beacon: crossref.NewLocalhost("SpecifiedClass", "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf").String()},
+						},
+					},
+				},
+			},
+			want:    []float32{1.5, 1.5, 1.5},
+			wantErr: false,
+		},
+		{
+			name: "Should move nearObject towards the mean of vectors and objects",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					ID: "uuid",
+					MoveTo: &searchparams.VectorMove{
+						Force:   1,
+						Objects: []searchparams.ObjectMove{{ID: "uuid"}},
+						Vectors: [][]float32{{4, 4, 4}},
+					},
+				},
+			},
+			want:    []float32{1.75, 1.75, 1.75},
+			wantErr: false,
+		},
+		{
+			name: "Should not move without force",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vector: []float32{1, 1, 1},
+					MoveTo: &searchparams.VectorMove{
+						Vectors: [][]float32{{4, 4, 4}},
+					},
+				},
+			},
+			want:    []float32{1, 1, 1},
+			wantErr: false,
+		},
+		{
+			name: "Should fail on moves with different vector lengths",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Vector: []float32{1, 1, 1},
+					MoveTo: &searchparams.VectorMove{
+						Force:   0.5,
+						Vectors: [][]float32{{4, 4}},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -369,6 +463,85 @@ func Test_nearParamsVector_extractCertaintyFromParams(t *testing.T) {
 	}
 }
 
+func Test_nearParamsVector_extractDistanceFromParams(t *testing.T) {
+	type args struct {
+		nearVector   *searchparams.NearVector
+		nearObject   *searchparams.NearObject
+		moduleParams map[string]interface{}
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   float64
+		wantOk bool
+	}{
+		{
+			name: "Should extract distance from nearVector",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Distance:     4.5,
+					WithDistance: true,
+				},
+			},
+			want:   4.5,
+			wantOk: true,
+		},
+		{
+			name: "Should not extract distance from nearVector with certainty",
+			args: args{
+				nearVector: &searchparams.NearVector{
+					Certainty: 0.88,
+				},
+			},
+		},
+		{
+			name: "Should extract distance from nearObject",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					Distance:     0.99,
+					WithDistance: true,
+				},
+			},
+			want:   0.99,
+			wantOk: true,
+		},
+		{
+			name: "Should extract distance from nearText",
+			args: args{
+				moduleParams: map[string]interface{}{
+					"nearCustomText": &nearCustomTextParams{
+						Distance:     0.77,
+						WithDistance: true,
+					},
+				},
+			},
+			want:   0.77,
+			wantOk: true,
+		},
+		{
+			name: "Should not extract distance from nearText with certainty",
+			args: args{
+				moduleParams: map[string]interface{}{
+					"nearCustomText": &nearCustomTextParams{
+						Certainty: 0.77,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &nearParamsVector{
+				modulesProvider: &fakeModulesProvider{},
+				search:          &fakeNearParamsSearcher{},
+			}
+			got, ok := e.extractDistanceFromParams(tt.args.nearVector, tt.args.nearObject, tt.args.moduleParams)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
 type fakeNearParamsSearcher struct{}
 
 func (f *fakeNearParamsSearcher) ObjectsByID(ctx context.Context, id strfmt.UUID,
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// VectorSearchStrategy is the way a filtered vector search is executed
+type VectorSearchStrategy string
+
+const (
+	// StrategyUnfiltered searches the vector index without a filter
+	StrategyUnfiltered VectorSearchStrategy = "unfiltered"
+	// StrategyPreFilter searches the graph of the vector index, skipping
+	// all candidates which do not match the filter
+	StrategyPreFilter VectorSearchStrategy = "pre-filter"
+	// StrategyPostFilter searches the vector index without the filter for
+	// more results than requested and drops the ones not matching the filter
+	StrategyPostFilter VectorSearchStrategy = "post-filter"
+	// StrategyFlat compares the query vector with every vector matching the
+	// filter
+	StrategyFlat VectorSearchStrategy = "flat"
+)
+
+// The costs of the strategies are estimated in distance calculations. The
+// defaults match the defaults of the hnsw index.
+const (
+	plannerEFMin    = 100
+	plannerEFMax    = 500
+	plannerEFFactor = 8
+	// average number of neighbors whose distance is calculated for every
+	// node visited during a graph search
+	plannerDistancesPerVisit = 32
+	// post-filtering fetches this many more candidates than the selectivity
+	// alone would require, as matching objects are rarely evenly spread
+	plannerPostFilterOversampling = 1.2
+	// post-filtering is only considered if the expected number of matching
+	// candidates is large enough to not fall back to pre-filtering
+	plannerPostFilterMinSelectivity = 0.1
+)
+
+// VectorSearchStats describes a filtered vector search to the planner
+type VectorSearchStats struct {
+	// ObjectCount is the number of vectors in the index
+	ObjectCount int
+	// FilteredCount is the number of objects matching the filter
+	FilteredCount int
+	// Limit is the number of requested results
+	Limit int
+}
+
+// VectorSearchPlan is the strategy chosen for a vector search, along with
+// the estimates it was chosen on
+type VectorSearchPlan struct {
+	Strategy    VectorSearchStrategy
+	Selectivity float64
+	Stats       VectorSearchStats
+	// Costs contains the estimated cost of every strategy which was
+	// considered
+	Costs map[VectorSearchStrategy]float64
+	// Candidates is the number of unfiltered results fetched by a
+	// post-filter search
+	Candidates int
+	// Fallback is set if a post-filter search did not find enough matching
+	// results and was repeated with pre-filtering
+	Fallback bool
+}
+
+// String explains the plan
+func (p VectorSearchPlan) String() string {
+	if p.Strategy == StrategyUnfiltered {
+		return string(p.Strategy)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d of %d objects match the filter (selectivity %.4f)",
+		p.Strategy, p.Stats.FilteredCount, p.Stats.ObjectCount, p.Selectivity)
+	if p.Strategy == StrategyPostFilter {
+		fmt.Fprintf(&b, ", %d candidates", p.Candidates)
+	}
+	if p.Fallback {
+		fmt.Fprintf(&b, ", fell back to %s", StrategyPreFilter)
+	}
+
+	strategies := make([]string, 0, len(p.Costs))
+	for strategy := range p.Costs {
+		strategies = append(strategies, string(strategy))
+	}
+	sort.Strings(strategies)
+	costs := make([]string, len(strategies))
+	for i, strategy := range strategies {
+		costs[i] = fmt.Sprintf("%s=%.0f", strategy,
+			p.Costs[VectorSearchStrategy(strategy)])
+	}
+	fmt.Fprintf(&b, "; estimated cost %s", strings.Join(costs, " "))
+
+	return b.String()
+}
+
+// QueryPlanner chooses how filtered vector searches are executed, based on
+// the estimated cost of each strategy, instead of the fixed flat search
+// cutoff of the vector index
+type QueryPlanner struct{}
+
+func NewQueryPlanner() *QueryPlanner {
+	return &QueryPlanner{}
+}
+
+// PlanVectorSearch chooses the cheapest strategy for a filtered vector search
+func (p *QueryPlanner) PlanVectorSearch(stats VectorSearchStats) VectorSearchPlan {
+	plan := VectorSearchPlan{
+		Stats: stats,
+		Costs: map[VectorSearchStrategy]float64{},
+	}
+
+	n := float64(stats.ObjectCount)
+	filtered := float64(stats.FilteredCount)
+	if n > 0 {
+		plan.Selectivity = math.Min(filtered/n, 1)
+	}
+	if filtered == 0 || stats.Limit <= 0 {
+		// nothing to search
+		plan.Strategy = StrategyFlat
+		plan.Costs[StrategyFlat] = filtered
+		return plan
+	}
+
+	// every vector matching the filter is compared once
+	plan.Costs[StrategyFlat] = filtered
+
+	// to collect ef matching results, the graph search has to visit about
+	// ef/selectivity nodes, but never more than the whole graph
+	depth := math.Log2(n + 1)
+	visits := math.Min(float64(searchEF(stats.Limit))/plan.Selectivity+depth, n)
+	plan.Costs[StrategyPreFilter] = visits * plannerDistancesPerVisit
+
+	if plan.Selectivity >= plannerPostFilterMinSelectivity {
+		candidates := int(math.Ceil(float64(stats.Limit) / plan.Selectivity *
+			plannerPostFilterOversampling))
+		if candidates < stats.ObjectCount {
+			plan.Candidates = candidates
+			plan.Costs[StrategyPostFilter] = (float64(searchEF(candidates)) + depth) *
+				plannerDistancesPerVisit
+		}
+	}
+
+	// on equal costs prefer the strategies with the more predictable recall
+	plan.Strategy = StrategyFlat
+	for _, strategy := range []VectorSearchStrategy{StrategyPreFilter, StrategyPostFilter} {
+		if cost, ok := plan.Costs[strategy]; ok && cost < plan.Costs[plan.Strategy] {
+			plan.Strategy = strategy
+		}
+	}
+
+	return plan
+}
+
+// searchEF estimates the ef of a graph search for k results
+func searchEF(k int) int {
+	ef := k * plannerEFFactor
+	if ef > plannerEFMax {
+		ef = plannerEFMax
+	}
+	if ef < plannerEFMin {
+		ef = plannerEFMin
+	}
+	if k > ef {
+		ef = k
+	}
+	return ef
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryPlanner(t *testing.T) {
+	planner := NewQueryPlanner()
+
+	tests := []struct {
+		name     string
+		stats    VectorSearchStats
+		expected VectorSearchStrategy
+	}{
+		{
+			name:     "nothing matches the filter",
+			stats:    VectorSearchStats{ObjectCount: 1_000_000, FilteredCount: 0, Limit: 10},
+			expected: StrategyFlat,
+		},
+		{
+			name:     "very selective filter",
+			stats:    VectorSearchStats{ObjectCount: 1_000_000, FilteredCount: 1_000, Limit: 10},
+			expected: StrategyFlat,
+		},
+		{
+			name:     "selective filter on a large index",
+			stats:    VectorSearchStats{ObjectCount: 10_000_000, FilteredCount: 500_000, Limit: 10},
+			expected: StrategyPreFilter,
+		},
+		{
+			name:     "filter matching most objects",
+			stats:    VectorSearchStats{ObjectCount: 1_000_000, FilteredCount: 900_000, Limit: 100},
+			expected: StrategyPostFilter,
+		},
+		{
+			name:     "filter matching all objects",
+			stats:    VectorSearchStats{ObjectCount: 1_000_000, FilteredCount: 1_000_000, Limit: 10},
+			expected: StrategyPreFilter,
+		},
+		{
+			name:     "small index",
+			stats:    VectorSearchStats{ObjectCount: 2_000, FilteredCount: 1_000, Limit: 10},
+			expected: StrategyFlat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := planner.PlanVectorSearch(test.stats)
+			assert.Equal(t, test.expected, plan.Strategy, plan.String())
+			for strategy, cost := range plan.Costs {
+				assert.GreaterOrEqual(t, cost, plan.Costs[plan.Strategy], strategy)
+			}
+		})
+	}
+
+	t.Run("post-filter fetches more candidates than requested", func(t *testing.T) {
+		plan := planner.PlanVectorSearch(VectorSearchStats{
+			ObjectCount: 1_000_000, FilteredCount: 500_000, Limit: 10,
+		})
+		assert.Equal(t, 0.5, plan.Selectivity)
+		assert.Equal(t, 24, plan.Candidates)
+	})
+
+	t.Run("explain", func(t *testing.T) {
+		plan := planner.PlanVectorSearch(VectorSearchStats{
+			ObjectCount: 1_000_000, FilteredCount: 1_000, Limit: 10,
+		})
+		assert.Equal(t, "flat: 1000 of 1000000 objects match the filter (selectivity 0.0010); "+
+			"estimated cost flat=1000 pre-filter=3200638", plan.String())
+		assert.Equal(t, "unfiltered", VectorSearchPlan{Strategy: StrategyUnfiltered}.String())
+	})
+}
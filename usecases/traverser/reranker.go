@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// DefaultRerankLimit is the number of top results which are reranked if the
+// query does not set a limit
+const DefaultRerankLimit = 100
+
+// Reranker scores the top results of a search. One score is returned per
+// result, results are reordered by descending score.
+type Reranker interface {
+	Rerank(ctx context.Context, className string, results []search.Result,
+		params *searchparams.Rerank) ([]float64, error)
+}
+
+// moduleReranker scores the text of a property for its relevance to the
+// query with the reranker module of the class, e.g. a cross-encoder
+type moduleReranker struct {
+	modulesProvider ModulesProvider
+}
+
+func (r *moduleReranker) Rerank(ctx context.Context, className string,
+	results []search.Result, params *searchparams.Rerank,
+) ([]float64, error) {
+	if r.modulesProvider == nil {
+		return nil, errors.New("no modules provider present")
+	}
+
+	documents := make([]string, len(results))
+	for i := range results {
+		documents[i], _ = rerankPropertyValue(results[i], params.Property).(string)
+	}
+
+	return r.modulesProvider.Rerank(ctx, className, params.Query, documents)
+}
+
+// propertyReranker orders results by the numeric value of a property.
+// Results without a value are ranked last.
+type propertyReranker struct{}
+
+func (r propertyReranker) Rerank(ctx context.Context, className string,
+	results []search.Result, params *searchparams.Rerank,
+) ([]float64, error) {
+	scores := make([]float64, len(results))
+	for i := range results {
+		switch v := rerankPropertyValue(results[i], params.Property).(type) {
+		case nil:
+			scores[i] = math.Inf(-1)
+		case float64:
+			scores[i] = v
+		case int64:
+			scores[i] = float64(v)
+		case int:
+			scores[i] = float64(v)
+		default:
+			return nil, fmt.Errorf("property %q is not a number, "+
+				"set a query to rerank by text", params.Property)
+		}
+	}
+
+	return scores, nil
+}
+
+func rerankPropertyValue(res search.Result, prop string) interface{} {
+	schema, ok := res.Schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return schema[prop]
+}
+
+func (e *Explorer) reranker(params *searchparams.Rerank) Reranker {
+	if params.Query == "" {
+		return propertyReranker{}
+	}
+	return &moduleReranker{modulesProvider: e.modulesProvider}
+}
+
+// rerank reorders the top results with the reranker of the query. Results
+// beyond the rerank limit keep their position.
+func (e *Explorer) rerank(ctx context.Context, res []search.Result,
+	params dto.GetParams,
+) ([]search.Result, error) {
+	if params.Rerank == nil || len(res) == 0 {
+		return res, nil
+	}
+
+	if params.Rerank.Property == "" {
+		return nil, errors.New("rerank: property must be set")
+	}
+
+	limit := params.Rerank.Limit
+	if limit <= 0 {
+		limit = DefaultRerankLimit
+	}
+	if limit > len(res) {
+		limit = len(res)
+	}
+
+	top := res[:limit]
+	scores, err := e.reranker(params.Rerank).Rerank(ctx, params.ClassName, top, params.Rerank)
+	if err != nil {
+		return nil, errors.Wrap(err, "rerank")
+	}
+	if len(scores) != len(top) {
+		return nil, errors.Errorf("rerank: got %d scores for %d results",
+			len(scores), len(top))
+	}
+
+	sort.Stable(rerankedResults{results: top, scores: scores})
+	return res, nil
+}
+
+type rerankedResults struct {
+	results []search.Result
+	scores  []float64
+}
+
+func (r rerankedResults) Len() int {
+	return len(r.results)
+}
+
+func (r rerankedResults) Less(i, j int) bool {
+	return r.scores[i] > r.scores[j]
+}
+
+func (r rerankedResults) Swap(i, j int) {
+	r.results[i], r.results[j] = r.results[j], r.results[i]
+	r.scores[i], r.scores[j] = r.scores[j], r.scores[i]
+}
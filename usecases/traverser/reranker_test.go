@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_WithRerank(t *testing.T) {
+	searchResults := func() []search.Result {
+		return []search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"text": "a foo", "rating": 1.0}},
+			{ID: "id2", Schema: map[string]interface{}{"text": "foo foo foo", "rating": 3.0}},
+			{ID: "id3", Schema: map[string]interface{}{"text": "bar"}},
+			{ID: "id4", Schema: map[string]interface{}{"text": "foo foo", "rating": 2.0}},
+		}
+	}
+
+	newExplorer := func(params dto.GetParams) *Explorer {
+		searcher := &fakeVectorSearcher{}
+		searcher.On("Search", params).Return(searchResults(), nil)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer
+	}
+
+	texts := func(res []interface{}) []string {
+		out := make([]string, len(res))
+		for i := range res {
+			out[i] = res[i].(map[string]interface{})["text"].(string)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		rerank      *searchparams.Rerank
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:     "with a query the reranker module scores the property",
+			rerank:   &searchparams.Rerank{Property: "text", Query: "foo"},
+			expected: []string{"foo foo foo", "foo foo", "a foo", "bar"},
+		},
+		{
+			name:     "without a query results are ordered by the property value",
+			rerank:   &searchparams.Rerank{Property: "rating"},
+			expected: []string{"foo foo foo", "foo foo", "a foo", "bar"},
+		},
+		{
+			name:     "only the top results are reranked",
+			rerank:   &searchparams.Rerank{Property: "text", Query: "foo", Limit: 2},
+			expected: []string{"foo foo foo", "a foo", "bar", "foo foo"},
+		},
+		{
+			name:        "without a property",
+			rerank:      &searchparams.Rerank{Query: "foo"},
+			expectedErr: "property must be set",
+		},
+		{
+			name:        "by a non-numeric property without a query",
+			rerank:      &searchparams.Rerank{Property: "text"},
+			expectedErr: "property \"text\" is not a number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:  "BestClass",
+				Pagination: &filters.Pagination{Limit: 100},
+				Rerank:     tt.rerank,
+			}
+
+			res, err := newExplorer(params).GetClass(context.Background(), params)
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, texts(res))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// ResultCache keeps the results of Get queries, keyed by a hash of all
+// their params including query vectors. It is an LRU cache bounded by the
+// approximate size of the results, whose entries expire after the TTL.
+//
+// Every write to a local shard of a class and tenant moves its generation
+// forward, and results are only returned if the generation did not change
+// since the query started. Only queries which read local shards are
+// cached, as writes to the shards of other nodes are not seen. Results are
+// shared between queries and must not be modified.
+type ResultCache struct {
+	maxBytes int64
+	ttl      time.Duration
+	classes  map[string]bool        // nil if all classes are cached
+	metrics  *prometheus.CounterVec // nil without monitoring
+	local    LocalReads             // nil if all shards are local
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	lru     *list.List // most recently used first
+	bytes   int64
+	hits    uint64
+	misses  uint64
+
+	// clock counts invalidations. The generation of a class and tenant is
+	// the clock at their last invalidation.
+	clock          uint64
+	flushedAt      uint64
+	classChanged   map[string]uint64
+	tenantsChanged map[resultCacheScope]uint64
+}
+
+type resultCacheScope struct {
+	class  string
+	tenant string
+}
+
+type resultCacheEntry struct {
+	key        [sha256.Size]byte
+	results    []interface{}
+	bytes      int64
+	generation uint64
+	expires    time.Time
+}
+
+// resultCacheLookup is a cache miss, whose results may be stored once the
+// query completed
+type resultCacheLookup struct {
+	key        [sha256.Size]byte
+	scope      resultCacheScope
+	generation uint64
+}
+
+// LocalReads reports whether queries for the tenant of a class only read
+// shards of this node
+type LocalReads interface {
+	ReadsLocally(class, tenant string) bool
+}
+
+// ResultCacheStats are counted since the start of the node
+type ResultCacheStats struct {
+	Entries int     `json:"entries"`
+	Bytes   int64   `json:"bytes"`
+	Hits    uint64  `json:"hits"`
+	Misses  uint64  `json:"misses"`
+	HitRate float64 `json:"hitRate"`
+}
+
+func NewResultCache(maxBytes int64, ttl time.Duration, classes []string,
+	promMetrics *monitoring.PrometheusMetrics,
+) *ResultCache {
+	c := &ResultCache{
+		maxBytes:       maxBytes,
+		ttl:            ttl,
+		now:            time.Now,
+		entries:        map[[sha256.Size]byte]*list.Element{},
+		lru:            list.New(),
+		classChanged:   map[string]uint64{},
+		tenantsChanged: map[resultCacheScope]uint64{},
+	}
+	if len(classes) > 0 {
+		c.classes = map[string]bool{}
+		for _, class := range classes {
+			c.classes[class] = true
+		}
+	}
+	if promMetrics != nil {
+		c.metrics = promMetrics.QueryResultCacheLookups
+	}
+	return c
+}
+
+// SetLocalReads limits the cache to queries which only read local shards
+func (c *ResultCache) SetLocalReads(local LocalReads) {
+	c.local = local
+}
+
+// cacheable reports whether the results of a query only depend on the
+// objects of its class and tenant on this node. Queries resolving
+// references, calling modules for additional properties, asking for a
+// consistency level or reading shards of other nodes are not cached.
+func (c *ResultCache) cacheable(params dto.GetParams) bool {
+	if c.classes != nil && !c.classes[params.ClassName] {
+		return false
+	}
+	if params.ReplicationProperties != nil || len(params.AdditionalProperties.ModuleParams) > 0 {
+		return false
+	}
+	for _, prop := range params.Properties {
+		if len(prop.Refs) > 0 {
+			return false
+		}
+	}
+	return c.local == nil || c.local.ReadsLocally(params.ClassName, params.Tenant)
+}
+
+// get returns the cached results of a query. On a miss it returns a lookup
+// to pass to put with the results, or nil if the query is not cached.
+func (c *ResultCache) get(params dto.GetParams) ([]interface{}, bool, *resultCacheLookup) {
+	if !c.cacheable(params) {
+		return nil, false, nil
+	}
+	key, ok := resultCacheKey(params)
+	if !ok {
+		return nil, false, nil
+	}
+	scope := resultCacheScope{class: params.ClassName, tenant: params.Tenant}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	generation := c.generation(scope)
+	elem, ok := c.entries[key]
+	if ok {
+		entry := elem.Value.(*resultCacheEntry)
+		if entry.generation != generation || c.now().After(entry.expires) {
+			c.remove(elem)
+			ok = false
+		}
+	}
+	if !ok {
+		c.misses++
+		c.countLookup(params.ClassName, "miss")
+		return nil, false, &resultCacheLookup{key: key, scope: scope, generation: generation}
+	}
+
+	c.hits++
+	c.countLookup(params.ClassName, "hit")
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*resultCacheEntry).results, true, nil
+}
+
+// put stores the results of a query, unless its class and tenant were
+// written while it ran, as the results may not contain the write
+func (c *ResultCache) put(lookup *resultCacheLookup, results []interface{}) {
+	// the size of the serialized results approximates their memory
+	serialized, err := json.Marshal(results)
+	if err != nil {
+		return
+	}
+	bytes := int64(len(serialized))
+	if bytes > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation(lookup.scope) != lookup.generation {
+		return
+	}
+	if elem, ok := c.entries[lookup.key]; ok {
+		c.remove(elem)
+	}
+	c.entries[lookup.key] = c.lru.PushFront(&resultCacheEntry{
+		key:        lookup.key,
+		results:    results,
+		bytes:      bytes,
+		generation: lookup.generation,
+		expires:    c.now().Add(c.ttl),
+	})
+	c.bytes += bytes
+	for c.bytes > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *ResultCache) generation(scope resultCacheScope) uint64 {
+	generation := c.flushedAt
+	if changed := c.classChanged[scope.class]; changed > generation {
+		generation = changed
+	}
+	if changed := c.tenantsChanged[scope]; changed > generation {
+		generation = changed
+	}
+	return generation
+}
+
+func (c *ResultCache) remove(elem *list.Element) {
+	entry := elem.Value.(*resultCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.bytes
+}
+
+func (c *ResultCache) countLookup(class, result string) {
+	if c.metrics != nil {
+		c.metrics.With(prometheus.Labels{"class_name": class, "result": result}).Inc()
+	}
+}
+
+// RecordChange invalidates the results of the tenant of a class an object
+// was written to or deleted from. It is called by the shards of this node.
+func (c *ResultCache) RecordChange(class, tenant string, id strfmt.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clock++
+	c.tenantsChanged[resultCacheScope{class: class, tenant: tenant}] = c.clock
+}
+
+// invalidateClass invalidates the results of all tenants of a class, e.g.
+// after its schema changed
+func (c *ResultCache) invalidateClass(class string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clock++
+	c.classChanged[class] = c.clock
+}
+
+// Flush removes all entries
+func (c *ResultCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clock++
+	c.flushedAt = c.clock
+	c.entries = map[[sha256.Size]byte]*list.Element{}
+	c.lru.Init()
+	c.bytes = 0
+}
+
+func (c *ResultCache) Stats() ResultCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := ResultCacheStats{
+		Entries: c.lru.Len(),
+		Bytes:   c.bytes,
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRate = float64(c.hits) / float64(total)
+	}
+	return stats
+}
+
+// resultCacheKey hashes the params of a query. Params which do not change
+// its results are left out. It returns false if the params cannot be
+// serialized, e.g. because of module params of an unexpected type.
+func resultCacheKey(params dto.GetParams) ([sha256.Size]byte, bool) {
+	params.Timeout = 0
+	params.WaitForIndexing = false
+
+	serialized, err := json.Marshal(params)
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256.Sum256(serialized), true
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/replica"
+	uc "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func TestResultCache(t *testing.T) {
+	params := func(class, tenant string, vector ...float32) dto.GetParams {
+		return dto.GetParams{
+			ClassName:  class,
+			Tenant:     tenant,
+			Pagination: &filters.Pagination{Limit: 10},
+			NearVector: &searchparams.NearVector{Vector: vector},
+		}
+	}
+	results := []interface{}{map[string]interface{}{"name": "a"}}
+
+	store := func(c *ResultCache, p dto.GetParams) {
+		_, hit, lookup := c.get(p)
+		require.False(t, hit)
+		require.NotNil(t, lookup)
+		c.put(lookup, results)
+	}
+
+	t.Run("keyed by params including the vector", func(t *testing.T) {
+		c := NewResultCache(1<<20, time.Hour, nil, nil)
+		store(c, params("Article", "", 1, 2))
+
+		res, hit, _ := c.get(params("Article", "", 1, 2))
+		assert.True(t, hit)
+		assert.Equal(t, results, res)
+
+		_, hit, _ = c.get(params("Article", "", 1, 3))
+		assert.False(t, hit)
+
+		p := params("Article", "", 1, 2)
+		p.Timeout = time.Second
+		_, hit, _ = c.get(p)
+		assert.True(t, hit, "the timeout does not change the results")
+	})
+
+	t.Run("writes invalidate their class and tenant", func(t *testing.T) {
+		c := NewResultCache(1<<20, time.Hour, nil, nil)
+		store(c, params("Article", "t1", 1))
+		store(c, params("Article", "t2", 1))
+		store(c, params("Product", "t1", 1))
+
+		c.RecordChange("Article", "t1", "")
+
+		_, hit, _ := c.get(params("Article", "t1", 1))
+		assert.False(t, hit)
+		_, hit, _ = c.get(params("Article", "t2", 1))
+		assert.True(t, hit)
+		_, hit, _ = c.get(params("Product", "t1", 1))
+		assert.True(t, hit)
+
+		c.invalidateClass("Article")
+		_, hit, _ = c.get(params("Article", "t2", 1))
+		assert.False(t, hit)
+	})
+
+	t.Run("results of queries overlapping a write are not stored", func(t *testing.T) {
+		c := NewResultCache(1<<20, time.Hour, nil, nil)
+		_, _, lookup := c.get(params("Article", "", 1))
+		c.RecordChange("Article", "", "")
+		c.put(lookup, results)
+
+		_, hit, lookup := c.get(params("Article", "", 1))
+		assert.False(t, hit)
+		c.put(lookup, results)
+		_, hit, _ = c.get(params("Article", "", 1))
+		assert.True(t, hit)
+	})
+
+	t.Run("bounded by size", func(t *testing.T) {
+		c := NewResultCache(40, time.Hour, nil, nil)
+		store(c, params("Article", "", 1))
+		store(c, params("Article", "", 2))
+		_, hit, _ := c.get(params("Article", "", 1))
+		require.True(t, hit)
+		store(c, params("Article", "", 3))
+
+		_, hit, _ = c.get(params("Article", "", 2))
+		assert.False(t, hit)
+		_, hit, _ = c.get(params("Article", "", 1))
+		assert.True(t, hit)
+		assert.Equal(t, int64(28), c.Stats().Bytes)
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		now := time.Now()
+		c := NewResultCache(1<<20, time.Minute, nil, nil)
+		c.now = func() time.Time { return now }
+		store(c, params("Article", "", 1))
+
+		now = now.Add(2 * time.Minute)
+		_, hit, _ := c.get(params("Article", "", 1))
+		assert.False(t, hit)
+		assert.Equal(t, 0, c.Stats().Entries)
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		c := NewResultCache(1<<20, time.Hour, nil, nil)
+		store(c, params("Article", "", 1))
+		c.Flush()
+
+		_, hit, _ := c.get(params("Article", "", 1))
+		assert.False(t, hit)
+		assert.Equal(t, ResultCacheStats{Misses: 2, HitRate: 0}, c.Stats())
+	})
+
+	t.Run("queries which are not cached", func(t *testing.T) {
+		c := NewResultCache(1<<20, time.Hour, []string{"Article"}, nil)
+
+		_, _, lookup := c.get(params("Product", "", 1))
+		assert.Nil(t, lookup, "class is not cached")
+
+		p := params("Article", "", 1)
+		p.Properties = search.SelectProperties{{
+			Name: "author", Refs: []search.SelectClass{{ClassName: "Person"}},
+		}}
+		_, _, lookup = c.get(p)
+		assert.Nil(t, lookup, "references")
+
+		p = params("Article", "", 1)
+		p.ReplicationProperties = &additional.ReplicationProperties{ConsistencyLevel: "ALL"}
+		_, _, lookup = c.get(p)
+		assert.Nil(t, lookup, "consistency level")
+
+		c.SetLocalReads(fakeLocalReads{"Article/t1": true})
+		_, _, lookup = c.get(params("Article", "t1", 1))
+		assert.NotNil(t, lookup)
+		_, _, lookup = c.get(params("Article", "t2", 1))
+		assert.Nil(t, lookup, "shard of another node")
+	})
+}
+
+type fakeLocalReads map[string]bool
+
+func (f fakeLocalReads) ReadsLocally(class, tenant string) bool {
+	return f[class+"/"+tenant]
+}
+
+func Test_Explorer_GetClass_WithResultCache(t *testing.T) {
+	searcher := &fakeVectorSearcher{}
+	searcher.On("VectorSearch", mock.Anything).
+		Return([]search.Result{{ID: "id1", Schema: map[string]interface{}{"name": "a"}}}, nil)
+	metrics := &fakeMetrics{}
+	metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	log, _ := test.NewNullLogger()
+	explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics)
+	explorer.SetSchemaGetter(&fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{Class: "BestClass"},
+		}}},
+	})
+	cache := NewResultCache(1<<20, time.Hour, nil, nil)
+	explorer.SetResultCache(cache)
+
+	params := dto.GetParams{
+		ClassName:  "BestClass",
+		Pagination: &filters.Pagination{Limit: 10},
+		NearVector: &searchparams.NearVector{Vector: []float32{0.8, 0.2, 0.7}},
+	}
+
+	first, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	second, err := explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	assert.Equal(t, first, second)
+	searcher.AssertNumberOfCalls(t, "VectorSearch", 1)
+
+	cache.RecordChange("BestClass", "", "")
+	_, err = explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	searcher.AssertNumberOfCalls(t, "VectorSearch", 2)
+
+	explorer.onSchemaChange(uc.Change{Type: uc.PropertyAdded, Class: "BestClass"})
+	_, err = explorer.GetClass(context.Background(), params)
+	require.Nil(t, err)
+	searcher.AssertNumberOfCalls(t, "VectorSearch", 3)
+
+	// queries with a consistency token bypass the cache
+	ctx := replica.WithToken(context.Background(), replica.Token{Writes: []replica.TokenWrite{
+		{Class: "BestClass", Shard: "S1", ID: "id1", UpdateTime: 1},
+	}})
+	_, err = explorer.GetClass(ctx, params)
+	require.Nil(t, err)
+	searcher.AssertNumberOfCalls(t, "VectorSearch", 4)
+}
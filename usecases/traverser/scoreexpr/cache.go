@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scoreexpr
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCacheSize is the number of compiled expressions a Cache keeps
+const DefaultCacheSize = 256
+
+// Cache keeps compiled expressions, so that queries which repeat an
+// expression, e.g. of an application with a fixed ranking rule, skip
+// parsing. It is an LRU cache, invalid expressions are not cached.
+type Cache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // most recently used first
+}
+
+func NewCache(maxEntries int) *Cache {
+	return &Cache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// Compile returns the cached expression or compiles and caches it
+func (c *Cache) Compile(source string) (*Expression, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[source]; ok {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*Expression), nil
+	}
+	c.mu.Unlock()
+
+	expr, err := Compile(source)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[source]; !ok {
+		c.entries[source] = c.lru.PushFront(expr)
+		for c.lru.Len() > c.maxEntries {
+			back := c.lru.Back()
+			c.lru.Remove(back)
+			delete(c.entries, back.Value.(*Expression).source)
+		}
+	}
+	return expr, nil
+}
+
+// Len returns the number of cached expressions
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
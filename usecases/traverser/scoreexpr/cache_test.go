@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scoreexpr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	c := NewCache(2)
+
+	first, err := c.Compile("similarity")
+	require.Nil(t, err)
+	again, err := c.Compile("similarity")
+	require.Nil(t, err)
+	assert.Same(t, first, again)
+
+	_, err = c.Compile("1 +")
+	require.NotNil(t, err)
+	assert.Equal(t, 1, c.Len())
+
+	_, err = c.Compile("score")
+	require.Nil(t, err)
+	// similarity is used least recently and evicted
+	_, err = c.Compile("distance")
+	require.Nil(t, err)
+	assert.Equal(t, 2, c.Len())
+
+	evicted, err := c.Compile("similarity")
+	require.Nil(t, err)
+	assert.NotSame(t, first, evicted)
+}
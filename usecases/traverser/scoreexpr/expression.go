@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package scoreexpr implements the restricted expression language of custom
+// scoring, e.g. "0.7*similarity + 0.3*log(popularity)". Expressions consist of
+// numbers, variables, the operators + - * / ^, parentheses and a fixed set of
+// functions. Any identifier other than the built-in variables refers to a
+// numeric property of the scored object. Expressions are parsed once into a
+// tree, there is no way to reach anything but the values passed to Eval.
+package scoreexpr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxLength bounds the length of an expression in bytes
+	MaxLength = 1024
+	// MaxDepth bounds the nesting of an expression
+	MaxDepth = 32
+
+	// VarSimilarity is the similarity of the object to the search vector,
+	// 1-distance for vector searches and the score otherwise
+	VarSimilarity = "similarity"
+	// VarDistance is the distance of the object to the search vector
+	VarDistance = "distance"
+	// VarScore is the score of a bm25 or hybrid search
+	VarScore = "score"
+)
+
+var variables = map[string]bool{
+	VarSimilarity: true,
+	VarDistance:   true,
+	VarScore:      true,
+}
+
+// functions maps the name of every function to its number of arguments,
+// -1 for any number larger than zero
+var functions = map[string]int{
+	"log":   1,
+	"log10": 1,
+	"log1p": 1,
+	"exp":   1,
+	"sqrt":  1,
+	"abs":   1,
+	"pow":   2,
+	"min":   -1,
+	"max":   -1,
+}
+
+// Env holds the values an expression is evaluated against
+type Env struct {
+	Similarity float64
+	Distance   float64
+	Score      float64
+	// Property returns the numeric value of a property, missing or
+	// non-numeric values are 0
+	Property func(name string) float64
+}
+
+// Expression is a compiled expression, it is safe for concurrent use
+type Expression struct {
+	source     string
+	root       node
+	properties []string
+}
+
+// Properties returns the names of the properties the expression reads
+func (e *Expression) Properties() []string {
+	return e.properties
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Eval computes the value of the expression. It can be NaN or infinite, for
+// example for the log of 0.
+func (e *Expression) Eval(env Env) float64 {
+	return e.root.eval(&env)
+}
+
+// Compile parses an expression. An optional "score =" prefix is ignored.
+func Compile(source string) (*Expression, error) {
+	if len(source) > MaxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", MaxLength)
+	}
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > 2 && tokens[0].kind == tokenIdent &&
+		tokens[0].text == VarScore && tokens[1].text == "=" {
+		tokens = tokens[2:]
+	}
+
+	p := &parser{tokens: tokens, properties: map[string]bool{}}
+	root, err := p.parseSum(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+
+	expr := &Expression{source: source, root: root}
+	for name := range p.properties {
+		expr.properties = append(expr.properties, name)
+	}
+	sort.Strings(expr.properties)
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				i++
+				if i < len(source) && (source[i] == '+' || source[i] == '-') {
+					i++
+				}
+				for i < len(source) && isDigit(source[i]) {
+					i++
+				}
+			}
+			value, err := strconv.ParseFloat(source[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", source[start:i], start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], value: value, pos: start})
+		case isLetter(c):
+			start := i
+			for i < len(source) && (isLetter(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		case strings.IndexByte("+-*/^(),=", c) >= 0:
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(source)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+type parser struct {
+	tokens     []token
+	pos        int
+	properties map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	tok := p.peek()
+	if tok.kind != tokenOperator {
+		return false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOperator(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, got %q", op, tok.pos, tok.text)
+	}
+	p.next()
+	return nil
+}
+
+// parseSum parses terms joined by + and -
+func (p *parser) parseSum(depth int) (node, error) {
+	if depth > MaxDepth {
+		return nil, fmt.Errorf("expression is nested deeper than %d levels", MaxDepth)
+	}
+	left, err := p.parseProduct(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+", "-") {
+		op := p.next().text[0]
+		right, err := p.parseProduct(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseProduct parses factors joined by * and /
+func (p *parser) parseProduct(depth int) (node, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*", "/") {
+		op := p.next().text[0]
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary(depth int) (node, error) {
+	if p.isOperator("-") {
+		p.next()
+		if depth+1 > MaxDepth {
+			return nil, fmt.Errorf("expression is nested deeper than %d levels", MaxDepth)
+		}
+		operand, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return negate{operand}, nil
+	}
+	return p.parsePower(depth)
+}
+
+// parsePower parses a right associative ^, which binds stronger than a
+// leading minus, so -2^2 is -4
+func (p *parser) parsePower(depth int) (node, error) {
+	base, err := p.parsePrimary(depth)
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	p.next()
+	if depth+1 > MaxDepth {
+		return nil, fmt.Errorf("expression is nested deeper than %d levels", MaxDepth)
+	}
+	exponent, err := p.parseUnary(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	return binary{op: '^', left: base, right: exponent}, nil
+}
+
+func (p *parser) parsePrimary(depth int) (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		return constant(tok.value), nil
+	case tokenIdent:
+		if p.isOperator("(") {
+			return p.parseCall(tok, depth)
+		}
+		if variables[tok.text] {
+			return variable(tok.text), nil
+		}
+		if _, ok := functions[tok.text]; ok {
+			return nil, fmt.Errorf("function %s at position %d must be called", tok.text, tok.pos)
+		}
+		p.properties[tok.text] = true
+		return property(tok.text), nil
+	case tokenOperator:
+		if tok.text == "(" {
+			inner, err := p.parseSum(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+func (p *parser) parseCall(name token, depth int) (node, error) {
+	arity, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s at position %d", name.text, name.pos)
+	}
+	p.next() // (
+
+	var args []node
+	for !p.isOperator(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseSum(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	p.next() // )
+
+	if arity >= 0 && len(args) != arity || len(args) == 0 {
+		return nil, fmt.Errorf("function %s at position %d takes %s, got %d",
+			name.text, name.pos, arityString(arity), len(args))
+	}
+	return call{name: name.text, args: args}, nil
+}
+
+func arityString(arity int) string {
+	switch arity {
+	case -1:
+		return "at least 1 argument"
+	case 1:
+		return "1 argument"
+	default:
+		return fmt.Sprintf("%d arguments", arity)
+	}
+}
+
+type node interface {
+	eval(env *Env) float64
+}
+
+type constant float64
+
+func (c constant) eval(*Env) float64 {
+	return float64(c)
+}
+
+type variable string
+
+func (v variable) eval(env *Env) float64 {
+	switch v {
+	case VarSimilarity:
+		return env.Similarity
+	case VarDistance:
+		return env.Distance
+	default:
+		return env.Score
+	}
+}
+
+type property string
+
+func (p property) eval(env *Env) float64 {
+	if env.Property == nil {
+		return 0
+	}
+	return env.Property(string(p))
+}
+
+type negate struct {
+	operand node
+}
+
+func (n negate) eval(env *Env) float64 {
+	return -n.operand.eval(env)
+}
+
+type binary struct {
+	op          byte
+	left, right node
+}
+
+func (b binary) eval(env *Env) float64 {
+	left, right := b.left.eval(env), b.right.eval(env)
+	switch b.op {
+	case '+':
+		return left + right
+	case '-':
+		return left - right
+	case '*':
+		return left * right
+	case '/':
+		return left / right
+	default:
+		return math.Pow(left, right)
+	}
+}
+
+type call struct {
+	name string
+	args []node
+}
+
+func (c call) eval(env *Env) float64 {
+	arg := c.args[0].eval(env)
+	switch c.name {
+	case "log":
+		return math.Log(arg)
+	case "log10":
+		return math.Log10(arg)
+	case "log1p":
+		return math.Log1p(arg)
+	case "exp":
+		return math.Exp(arg)
+	case "sqrt":
+		return math.Sqrt(arg)
+	case "abs":
+		return math.Abs(arg)
+	case "pow":
+		return math.Pow(arg, c.args[1].eval(env))
+	case "min":
+		for _, a := range c.args[1:] {
+			arg = math.Min(arg, a.eval(env))
+		}
+		return arg
+	default:
+		for _, a := range c.args[1:] {
+			arg = math.Max(arg, a.eval(env))
+		}
+		return arg
+	}
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scoreexpr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	props := map[string]float64{"popularity": math.E, "price": 4}
+	env := Env{
+		Similarity: 0.8,
+		Distance:   0.2,
+		Score:      2.5,
+		Property:   func(name string) float64 { return props[name] },
+	}
+
+	tests := []struct {
+		expr     string
+		expected float64
+	}{
+		{expr: "0.7*similarity + 0.3*log(popularity)", expected: 0.7*0.8 + 0.3},
+		{expr: "score = 0.7*similarity + 0.3*log(popularity)", expected: 0.7*0.8 + 0.3},
+		{expr: "1 - distance", expected: 0.8},
+		{expr: "score / 2", expected: 1.25},
+		{expr: "2 + 3 * 4", expected: 14},
+		{expr: "(2 + 3) * 4", expected: 20},
+		{expr: "2 ^ 3 ^ 2", expected: 512},
+		{expr: "-2 ^ 2", expected: -4},
+		{expr: "--price", expected: 4},
+		{expr: "sqrt(price) + abs(-1)", expected: 3},
+		{expr: "pow(price, 0.5)", expected: 2},
+		{expr: "min(price, 3, 5)", expected: 3},
+		{expr: "max(price, similarity)", expected: 4},
+		{expr: "log10(100) + log1p(0) + exp(0)", expected: 3},
+		{expr: "1.5e2 + .5", expected: 150.5},
+		{expr: "missing + 1", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := Compile(tt.expr)
+			require.Nil(t, err)
+			assert.InDelta(t, tt.expected, expr.Eval(env), 1e-9)
+		})
+	}
+
+	t.Run("without property lookup", func(t *testing.T) {
+		expr, err := Compile("price + 1")
+		require.Nil(t, err)
+		assert.Equal(t, float64(1), expr.Eval(Env{}))
+	})
+
+	t.Run("log of zero", func(t *testing.T) {
+		expr, err := Compile("log(missing)")
+		require.Nil(t, err)
+		assert.True(t, math.IsInf(expr.Eval(env), -1))
+	})
+}
+
+func TestProperties(t *testing.T) {
+	expr, err := Compile("similarity * price + log(popularity) - price + score")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"popularity", "price"}, expr.Properties())
+}
+
+func TestCompileErrors(t *testing.T) {
+	deep := ""
+	for i := 0; i <= MaxDepth; i++ {
+		deep += "("
+	}
+
+	tests := []struct {
+		expr        string
+		expectedErr string
+	}{
+		{expr: "", expectedErr: `unexpected "end of expression" at position 0`},
+		{expr: "1 +", expectedErr: `unexpected "end of expression"`},
+		{expr: "1 2", expectedErr: `unexpected "2" at position 2`},
+		{expr: "(1 + 2", expectedErr: `expected ")"`},
+		{expr: "price; drop", expectedErr: `unexpected character ';' at position 5`},
+		{expr: "system(1)", expectedErr: "unknown function system"},
+		{expr: "log(1, 2)", expectedErr: "function log at position 0 takes 1 argument, got 2"},
+		{expr: "max()", expectedErr: "takes at least 1 argument, got 0"},
+		{expr: "log + 1", expectedErr: "function log at position 0 must be called"},
+		{expr: "1..2", expectedErr: `invalid number "1..2"`},
+		{expr: "similarity = 1", expectedErr: `unexpected "="`},
+		{expr: deep + "1", expectedErr: "nested deeper than"},
+		{expr: string(make([]byte, MaxLength+1)), expectedErr: "longer than"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Compile(tt.expr)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
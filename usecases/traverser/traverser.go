@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 type locks interface {
@@ -47,6 +48,13 @@ type Traverser struct {
 	nearParamsVector *nearParamsVector
 	metrics          *Metrics
 	ratelimiter      *ratelimiter.Limiter
+	slowQueryLog     *slowquery.Log
+	aliases          aliasResolver
+}
+
+// aliasResolver maps a class alias to the class it points to
+type aliasResolver interface {
+	ResolveAlias(name string) string
 }
 
 type VectorSearcher interface {
@@ -85,6 +93,24 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	}
 }
 
+// SetSlowQueryLog enables logging of Get queries which exceed the
+// threshold of the log
+func (t *Traverser) SetSlowQueryLog(log *slowquery.Log) {
+	t.slowQueryLog = log
+}
+
+// SetAliasResolver enables querying classes through their aliases
+func (t *Traverser) SetAliasResolver(r aliasResolver) {
+	t.aliases = r
+}
+
+func (t *Traverser) resolveAlias(className string) string {
+	if t.aliases == nil {
+		return className
+	}
+	return t.aliases.ResolveAlias(className)
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -20,12 +20,15 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
 ) (interface{}, error) {
+	params.ClassName = schema.ClassName(t.resolveAlias(params.ClassName.String()))
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
@@ -33,6 +36,11 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	err = t.authorizer.Authorize(principal, "get",
+		authorization.ClassResource(params.ClassName.String(), params.Tenant))
+	if err != nil {
+		return nil, err
+	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -40,6 +48,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+
 	inspector := newTypeInspector(t.schemaGetter)
 
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
@@ -55,13 +69,19 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 			return nil, err
 		}
 		params.SearchVector = searchVector
-		certainty := t.nearParamsVector.extractCertaintyFromParams(params.NearVector,
-			params.NearObject, params.ModuleParams)
+		if distance, ok := t.nearParamsVector.extractDistanceFromParams(params.NearVector,
+			params.NearObject, params.ModuleParams); ok {
+			params.Distance = distance
+			params.WithDistance = true
+		} else {
+			certainty := t.nearParamsVector.extractCertaintyFromParams(params.NearVector,
+				params.NearObject, params.ModuleParams)
 
-		if certainty == 0 && params.ObjectLimit == nil {
-			return nil, fmt.Errorf("must provide certainty or objectLimit with vector search")
+			if certainty == 0 && params.ObjectLimit == nil {
+				return nil, fmt.Errorf("must provide certainty, distance or objectLimit with vector search")
+			}
+			params.Certainty = certainty
 		}
-		params.Certainty = certainty
 	}
 
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
@@ -80,6 +100,9 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	}
 
 	res, err := t.vectorSearcher.Aggregate(ctx, *params)
+	if err != nil && params.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("query exceeded timeout of %s: %w", params.Timeout, err)
+	}
 	if err != nil || res == nil {
 		return nil, err
 	}
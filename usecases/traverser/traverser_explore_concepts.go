@@ -13,10 +13,14 @@ package traverser
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // Explore through unstructured search terms
@@ -41,7 +45,41 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.CrossClassVectorSearch(ctx, params)
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+
+	res, err := t.explorer.CrossClassVectorSearch(ctx, params)
+	if err != nil {
+		if params.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("query exceeded timeout of %s: %w", params.Timeout, err)
+		}
+		return nil, err
+	}
+	return t.readableResults(principal, res), nil
+}
+
+// readableResults drops the results of the classes the principal may not
+// read, the search spans all classes
+func (t *Traverser) readableResults(principal *models.Principal,
+	res []search.Result,
+) []search.Result {
+	readable := map[string]bool{}
+	out := res[:0]
+	for _, r := range res {
+		ok, checked := readable[r.ClassName]
+		if !checked {
+			ok = t.authorizer.Authorize(principal, "get",
+				authorization.ClassResource(r.ClassName, "")) == nil
+			readable[r.ClassName] = ok
+		}
+		if ok {
+			out = append(out, r)
+		}
+	}
+	return out
 }
 
 // ExploreParams are the parameters used by the GraphQL `Explore { }` API
@@ -52,4 +90,6 @@ type ExploreParams struct {
 	Limit             int
 	ModuleParams      map[string]interface{}
 	WithCertaintyProp bool
+	// Timeout bounds the execution time of the query, zero means no timeout
+	Timeout time.Duration
 }
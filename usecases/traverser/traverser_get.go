@@ -13,11 +13,17 @@ package traverser
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -35,6 +41,7 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	defer t.ratelimiter.Dec()
 
+	params.ClassName = t.resolveAlias(params.ClassName)
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
@@ -43,6 +50,14 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	err = t.authorizer.Authorize(principal, "get",
+		authorization.ClassResource(params.ClassName, params.Tenant))
+	if err != nil {
+		return nil, err
+	}
+	if err := t.authorizeRefs(principal, params.Properties, params.Tenant); err != nil {
+		return nil, err
+	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -60,5 +75,42 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+
+	ctx, span := tracing.Start(ctx, "traverser.GetClass",
+		attribute.String("weaviate.class", params.ClassName),
+		attribute.String("weaviate.tenant", params.Tenant))
+	ctx, tracker := t.slowQueryLog.Track(ctx)
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil && params.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		res, err = nil, fmt.Errorf("query exceeded timeout of %s: %w", params.Timeout, err)
+	}
+	tracker.Done(params, len(res), err)
+	span.SetAttributes(attribute.Int("weaviate.results", len(res)))
+	tracing.End(span, err)
+	return res, err
+}
+
+// authorizeRefs checks the classes which the selected references are
+// resolved into, their objects are returned along with the queried class
+func (t *Traverser) authorizeRefs(principal *models.Principal,
+	props search.SelectProperties, tenant string,
+) error {
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			err := t.authorizer.Authorize(principal, "get",
+				authorization.ClassResource(ref.ClassName, tenant))
+			if err != nil {
+				return err
+			}
+			if err := t.authorizeRefs(principal, ref.RefProperties, tenant); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
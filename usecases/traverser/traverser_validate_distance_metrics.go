@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -116,7 +117,7 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 }
 
 func typeAssertVectorIndex(class *models.Class) (hnsw.UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+	hnswConfig, ok := vectorindex.HnswConfig(class.VectorIndexConfig)
 	if !ok {
 		return hnsw.UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
 			class.Class, class.VectorIndexConfig)
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import "fmt"
+
+// movements are halved, so that a force of 1 moves a vector to the middle
+// between source and target, same as the vectorizer modules do for nearText
+const movementMultiplier = float32(0.5)
+
+// MoveTo moves one vector toward another
+func MoveTo(source, target []float32, force float32) ([]float32, error) {
+	if len(source) != len(target) {
+		return nil, fmt.Errorf("movement: vector lengths don't match: got %d and %d",
+			len(source), len(target))
+	}
+	if force < 0 || force > 1 {
+		return nil, fmt.Errorf("movement: force must be between 0 and 1: got %f", force)
+	}
+
+	out := make([]float32, len(source))
+	for i := range source {
+		out[i] = source[i]*(1-force*movementMultiplier) + target[i]*force*movementMultiplier
+	}
+	return out, nil
+}
+
+// MoveAwayFrom moves one vector away from another
+func MoveAwayFrom(source, target []float32, force float32) ([]float32, error) {
+	if len(source) != len(target) {
+		return nil, fmt.Errorf("movement (moveAwayFrom): vector lengths don't match: "+
+			"got %d and %d", len(source), len(target))
+	}
+	if force < 0 {
+		return nil, fmt.Errorf("movement (moveAwayFrom): force must be 0 or positive: "+
+			"got %f", force)
+	}
+
+	out := make([]float32, len(source))
+	for i := range source {
+		out[i] = source[i] + force*movementMultiplier*(source[i]-target[i])
+	}
+	return out, nil
+}
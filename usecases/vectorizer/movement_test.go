@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovements(t *testing.T) {
+	source := []float32{1, 1, 1}
+	target := []float32{3, 5, -1}
+
+	t.Run("move to", func(t *testing.T) {
+		out, err := MoveTo(source, target, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{2, 3, 0}, out)
+
+		out, err = MoveTo(source, target, 0)
+		require.Nil(t, err)
+		assert.Equal(t, source, out)
+	})
+
+	t.Run("move away from", func(t *testing.T) {
+		out, err := MoveAwayFrom(source, target, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0, -1, 2}, out)
+	})
+
+	t.Run("invalid force", func(t *testing.T) {
+		_, err := MoveTo(source, target, 1.5)
+		assert.NotNil(t, err)
+		_, err = MoveAwayFrom(source, target, -1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("different lengths", func(t *testing.T) {
+		_, err := MoveTo(source, target[:2], 1)
+		assert.NotNil(t, err)
+		_, err = MoveAwayFrom(source, target[:2], 1)
+		assert.NotNil(t, err)
+	})
+}